@@ -0,0 +1,95 @@
+package accounting
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+type ethereumCostGetter struct {
+	provider EthereumReceiptProvider
+}
+
+// NewEthereumCostGetter creates a cost getter based on the Ethereum transaction receipts
+func NewEthereumCostGetter(provider EthereumReceiptProvider) (*ethereumCostGetter, error) {
+	if check.IfNil(provider) {
+		return nil, ErrNilReceiptProvider
+	}
+
+	return &ethereumCostGetter{
+		provider: provider,
+	}, nil
+}
+
+// GetCost returns the gas used by the transaction and the fee paid, the gas used multiplied by the effective gas
+// price. The transaction is not final while it is not mined
+func (getter *ethereumCostGetter) GetCost(ctx context.Context, hash string) (uint64, *big.Int, bool, error) {
+	receipt, err := getter.provider.TransactionReceipt(ctx, common.HexToHash(hash))
+	if errors.Is(err, ethereum.NotFound) {
+		return 0, nil, false, nil
+	}
+	if err != nil {
+		return 0, nil, false, err
+	}
+	if receipt == nil {
+		return 0, nil, false, ErrNilTransactionReceipt
+	}
+
+	fee := big.NewInt(0)
+	if receipt.EffectiveGasPrice != nil {
+		fee.Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	}
+
+	return receipt.GasUsed, fee, true, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *ethereumCostGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
+
+type multiversXCostGetter struct {
+	provider MultiversXTransactionProvider
+}
+
+// NewMultiversXCostGetter creates a cost getter based on the MultiversX transactions with results
+func NewMultiversXCostGetter(provider MultiversXTransactionProvider) (*multiversXCostGetter, error) {
+	if check.IfNil(provider) {
+		return nil, ErrNilTransactionProvider
+	}
+
+	return &multiversXCostGetter{
+		provider: provider,
+	}, nil
+}
+
+// GetCost returns the gas used by the transaction and the fee paid, the gas used multiplied by the gas price. The
+// transaction is final once it was either executed or rejected
+func (getter *multiversXCostGetter) GetCost(ctx context.Context, hash string) (uint64, *big.Int, bool, error) {
+	txInfo, err := getter.provider.GetTransactionInfoWithResults(ctx, hash)
+	if err != nil {
+		return 0, nil, false, err
+	}
+	if txInfo == nil {
+		return 0, nil, false, ErrNilTransactionInfo
+	}
+
+	tx := txInfo.Data.Transaction
+	switch transaction.TxStatus(tx.Status) {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid:
+		fee := new(big.Int).Mul(new(big.Int).SetUint64(tx.GasUsed), new(big.Int).SetUint64(tx.GasPrice))
+		return tx.GasUsed, fee, true, nil
+	default:
+		return 0, nil, false, nil
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *multiversXCostGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
@@ -0,0 +1,258 @@
+package accounting
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// PeriodFormat is the layout used to identify the monthly accounting periods
+	PeriodFormat = "2006-01"
+
+	minMaxPendingExecutions = 1
+	maxQueriesPerExecution  = 100
+)
+
+// Destination is the chain on which the batches of a bridge direction are executed, with the component able to fetch
+// the cost of the transactions sent on that chain
+type Destination struct {
+	Chain      string
+	CostGetter CostGetter
+}
+
+// ArgsCostLedger is the DTO used to create a new instance of type costLedger
+type ArgsCostLedger struct {
+	Log           logger.Logger
+	StatusHandler core.StatusHandler
+	Storer        Storer
+	Timer         core.Timer
+	// Relayer is the MultiversX address identifying this relayer in the consortium, for both destination chains
+	Relayer string
+	// Destinations holds the destination of each bridge direction, by direction name
+	Destinations         map[string]Destination
+	MaxPendingExecutions int
+}
+
+type pendingExecution struct {
+	direction  string
+	batchID    uint64
+	hash       string
+	timestamp  int64
+	numQueries int
+}
+
+type costLedger struct {
+	log                  logger.Logger
+	statusHandler        core.StatusHandler
+	storer               Storer
+	timer                core.Timer
+	relayer              string
+	destinations         map[string]Destination
+	maxPendingExecutions int
+
+	mut         sync.Mutex
+	pending     []*pendingExecution
+	numRecorded int
+}
+
+// NewCostLedger creates the component recording the cost of each batch execution paid by this relayer, once the
+// execution transaction is final. The costs are saved per monthly period, to be exported and settled between the
+// consortium members
+func NewCostLedger(args ArgsCostLedger) (*costLedger, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &costLedger{
+		log:                  args.Log,
+		statusHandler:        args.StatusHandler,
+		storer:               args.Storer,
+		timer:                args.Timer,
+		relayer:              args.Relayer,
+		destinations:         args.Destinations,
+		maxPendingExecutions: args.MaxPendingExecutions,
+		pending:              make([]*pendingExecution, 0),
+	}, nil
+}
+
+func checkArgs(args ArgsCostLedger) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	if len(args.Relayer) == 0 {
+		return ErrEmptyRelayer
+	}
+	if len(args.Destinations) == 0 {
+		return ErrNoCostGetters
+	}
+	for direction, destination := range args.Destinations {
+		if check.IfNil(destination.CostGetter) {
+			return fmt.Errorf("%w for direction %s", ErrNilCostGetter, direction)
+		}
+	}
+	if args.MaxPendingExecutions < minMaxPendingExecutions {
+		return fmt.Errorf("%w for MaxPendingExecutions: %d, minimum: %d",
+			ErrInvalidValue, args.MaxPendingExecutions, minMaxPendingExecutions)
+	}
+
+	return nil
+}
+
+// RecordExecution queues the transaction sent by this relayer to execute the provided batch, so its cost is recorded
+// once it is final
+func (ledger *costLedger) RecordExecution(direction string, batchID uint64, hash string) {
+	_, found := ledger.destinations[direction]
+	if !found {
+		ledger.log.Warn("costLedger: unknown direction, the execution cost is not recorded",
+			"direction", direction, "batch ID", batchID, "hash", hash)
+		return
+	}
+
+	ledger.mut.Lock()
+	defer ledger.mut.Unlock()
+
+	ledger.pending = append(ledger.pending, &pendingExecution{
+		direction: direction,
+		batchID:   batchID,
+		hash:      hash,
+		timestamp: ledger.timer.NowUnix(),
+	})
+	if len(ledger.pending) > ledger.maxPendingExecutions {
+		dropped := ledger.pending[0]
+		ledger.pending = ledger.pending[1:]
+		ledger.log.Warn("costLedger: too many pending executions, dropping the oldest one",
+			"direction", dropped.direction, "batch ID", dropped.batchID, "hash", dropped.hash)
+	}
+	ledger.statusHandler.SetIntMetric(core.MetricNumPendingExecutionCosts, len(ledger.pending))
+}
+
+// Execute fetches the cost of the pending executions and saves the final ones in the ledger of their period
+func (ledger *costLedger) Execute(ctx context.Context) error {
+	ledger.mut.Lock()
+	pending := append(make([]*pendingExecution, 0, len(ledger.pending)), ledger.pending...)
+	ledger.mut.Unlock()
+
+	processed := make(map[*pendingExecution]struct{})
+	costs := make(map[string][]*core.ExecutionCost)
+	executions := make(map[string][]*pendingExecution)
+	for _, execution := range pending {
+		destination := ledger.destinations[execution.direction]
+		gasUsed, fee, isFinal, err := destination.CostGetter.GetCost(ctx, execution.hash)
+		execution.numQueries++
+		if err != nil {
+			ledger.log.Debug("costLedger: can not fetch the execution cost", "hash", execution.hash, "error", err)
+		}
+		if isFinal {
+			period := periodOf(execution.timestamp)
+			costs[period] = append(costs[period], &core.ExecutionCost{
+				Relayer:   ledger.relayer,
+				Chain:     destination.Chain,
+				Direction: execution.direction,
+				BatchID:   execution.batchID,
+				TxHash:    execution.hash,
+				GasUsed:   gasUsed,
+				Fee:       fee.String(),
+				Timestamp: execution.timestamp,
+			})
+			executions[period] = append(executions[period], execution)
+			processed[execution] = struct{}{}
+			continue
+		}
+		if execution.numQueries >= maxQueriesPerExecution {
+			ledger.log.Warn("costLedger: execution not final after the maximum number of queries, dropping",
+				"direction", execution.direction, "batch ID", execution.batchID, "hash", execution.hash)
+			processed[execution] = struct{}{}
+		}
+	}
+
+	var lastErr error
+	for period, periodCosts := range costs {
+		err := ledger.appendCosts(period, periodCosts)
+		if err != nil {
+			ledger.log.Warn("costLedger: can not save the execution costs, will retry", "period", period, "error", err)
+			for _, execution := range executions[period] {
+				delete(processed, execution)
+			}
+			lastErr = err
+			continue
+		}
+
+		ledger.mut.Lock()
+		ledger.numRecorded += len(periodCosts)
+		ledger.mut.Unlock()
+	}
+
+	ledger.removeProcessed(processed)
+
+	return lastErr
+}
+
+func (ledger *costLedger) appendCosts(period string, costs []*core.ExecutionCost) error {
+	existing, err := ledger.storer.GetExecutionCosts(period)
+	if err != nil {
+		return err
+	}
+
+	return ledger.storer.StoreExecutionCosts(period, append(existing, costs...))
+}
+
+func (ledger *costLedger) removeProcessed(processed map[*pendingExecution]struct{}) {
+	ledger.mut.Lock()
+	defer ledger.mut.Unlock()
+
+	remaining := make([]*pendingExecution, 0, len(ledger.pending))
+	for _, execution := range ledger.pending {
+		_, isProcessed := processed[execution]
+		if !isProcessed {
+			remaining = append(remaining, execution)
+		}
+	}
+	ledger.pending = remaining
+
+	ledger.statusHandler.SetIntMetric(core.MetricNumPendingExecutionCosts, len(ledger.pending))
+	ledger.statusHandler.SetIntMetric(core.MetricNumExecutionCostsRecorded, ledger.numRecorded)
+}
+
+// Ledger returns the execution costs paid by this relayer in the provided period, formatted as YYYY-MM
+func (ledger *costLedger) Ledger(period string) ([]*core.ExecutionCost, error) {
+	err := checkPeriod(period)
+	if err != nil {
+		return nil, err
+	}
+
+	return ledger.storer.GetExecutionCosts(period)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ledger *costLedger) IsInterfaceNil() bool {
+	return ledger == nil
+}
+
+func periodOf(unixTimestamp int64) string {
+	return time.Unix(unixTimestamp, 0).UTC().Format(PeriodFormat)
+}
+
+func checkPeriod(period string) error {
+	_, err := time.Parse(PeriodFormat, period)
+	if err != nil {
+		return fmt.Errorf("%w: %s, expected format YYYY-MM", ErrInvalidPeriod, period)
+	}
+
+	return nil
+}
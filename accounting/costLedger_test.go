@@ -0,0 +1,250 @@
+package accounting
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ethToMultiversXDirection = "EthereumToMultiversX"
+	multiversXToEthDirection = "MultiversXToEthereum"
+	testRelayer              = "erd1relayer"
+	testTimestamp            = int64(1790000000) // 2026-09-21
+	testPeriod               = "2026-09"
+)
+
+func createMockArgsCostLedger() ArgsCostLedger {
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return testTimestamp
+	}
+
+	return ArgsCostLedger{
+		Log:           &testsCommon.LoggerStub{},
+		StatusHandler: testsCommon.NewStatusHandlerMock(core.CostAccountingStatusHandlerName),
+		Storer:        &testsCommon.BatchResultsStorerStub{},
+		Timer:         timer,
+		Relayer:       testRelayer,
+		Destinations: map[string]Destination{
+			ethToMultiversXDirection: {Chain: "MultiversX", CostGetter: &testsCommon.CostGetterStub{}},
+			multiversXToEthDirection: {Chain: "Ethereum", CostGetter: &testsCommon.CostGetterStub{}},
+		},
+		MaxPendingExecutions: 10,
+	}
+}
+
+func createInMemoryStorer() *testsCommon.BatchResultsStorerStub {
+	saved := make(map[string][]*core.ExecutionCost)
+	return &testsCommon.BatchResultsStorerStub{
+		StoreExecutionCostsCalled: func(period string, costs []*core.ExecutionCost) error {
+			saved[period] = costs
+			return nil
+		},
+		GetExecutionCostsCalled: func(period string) ([]*core.ExecutionCost, error) {
+			return append(make([]*core.ExecutionCost, 0), saved[period]...), nil
+		},
+	}
+}
+
+func TestNewCostLedger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.Log = nil
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.StatusHandler = nil
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.Storer = nil
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.Timer = nil
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("empty relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.Relayer = ""
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, ErrEmptyRelayer, err)
+	})
+	t.Run("no destinations should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.Destinations = nil
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.Equal(t, ErrNoCostGetters, err)
+	})
+	t.Run("nil cost getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.Destinations[ethToMultiversXDirection] = Destination{Chain: "MultiversX"}
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.True(t, errors.Is(err, ErrNilCostGetter))
+		assert.Contains(t, err.Error(), ethToMultiversXDirection)
+	})
+	t.Run("invalid max pending executions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.MaxPendingExecutions = 0
+
+		ledger, err := NewCostLedger(args)
+		assert.True(t, check.IfNil(ledger))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ledger, err := NewCostLedger(createMockArgsCostLedger())
+		assert.False(t, check.IfNil(ledger))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCostLedger_RecordAndExecute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("final executions should be recorded in their period", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.CostAccountingStatusHandlerName)
+		args.StatusHandler = statusHandler
+		args.Storer = createInMemoryStorer()
+		args.Destinations[multiversXToEthDirection] = Destination{
+			Chain: "Ethereum",
+			CostGetter: &testsCommon.CostGetterStub{
+				GetCostCalled: func(ctx context.Context, hash string) (uint64, *big.Int, bool, error) {
+					if hash == "pending hash" {
+						return 0, nil, false, nil
+					}
+					return 250000, big.NewInt(5000), true, nil
+				},
+			},
+		}
+		ledger, _ := NewCostLedger(args)
+
+		ledger.RecordExecution(multiversXToEthDirection, 12, "hash")
+		ledger.RecordExecution(multiversXToEthDirection, 13, "pending hash")
+		ledger.RecordExecution("unknown direction", 14, "other hash")
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumPendingExecutionCosts))
+
+		err := ledger.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumPendingExecutionCosts))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumExecutionCostsRecorded))
+
+		costs, err := ledger.Ledger(testPeriod)
+		require.Nil(t, err)
+		expectedCosts := []*core.ExecutionCost{
+			{
+				Relayer:   testRelayer,
+				Chain:     "Ethereum",
+				Direction: multiversXToEthDirection,
+				BatchID:   12,
+				TxHash:    "hash",
+				GasUsed:   250000,
+				Fee:       "5000",
+				Timestamp: testTimestamp,
+			},
+		}
+		assert.Equal(t, expectedCosts, costs)
+	})
+	t.Run("storer errors should keep the executions pending", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsCostLedger()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.CostAccountingStatusHandlerName)
+		args.StatusHandler = statusHandler
+		args.Storer = &testsCommon.BatchResultsStorerStub{
+			StoreExecutionCostsCalled: func(period string, costs []*core.ExecutionCost) error {
+				return expectedErr
+			},
+		}
+		ledger, _ := NewCostLedger(args)
+
+		ledger.RecordExecution(ethToMultiversXDirection, 12, "hash")
+		err := ledger.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumPendingExecutionCosts))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumExecutionCostsRecorded))
+	})
+	t.Run("too many pending executions should drop the oldest one", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCostLedger()
+		args.MaxPendingExecutions = 1
+		args.Storer = createInMemoryStorer()
+		ledger, _ := NewCostLedger(args)
+
+		ledger.RecordExecution(ethToMultiversXDirection, 12, "hash 12")
+		ledger.RecordExecution(ethToMultiversXDirection, 13, "hash 13")
+		err := ledger.Execute(context.Background())
+		require.Nil(t, err)
+
+		costs, _ := ledger.Ledger(testPeriod)
+		require.Equal(t, 1, len(costs))
+		assert.Equal(t, uint64(13), costs[0].BatchID)
+	})
+}
+
+func TestCostLedger_Ledger(t *testing.T) {
+	t.Parallel()
+
+	ledger, _ := NewCostLedger(createMockArgsCostLedger())
+
+	costs, err := ledger.Ledger("September")
+	assert.Nil(t, costs)
+	assert.True(t, errors.Is(err, ErrInvalidPeriod))
+
+	costs, err = ledger.Ledger(testPeriod)
+	assert.Nil(t, err)
+	assert.Empty(t, costs)
+}
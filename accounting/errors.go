@@ -0,0 +1,57 @@
+package accounting
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler was provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrEmptyRelayer signals that an empty relayer address was provided
+var ErrEmptyRelayer = errors.New("empty relayer")
+
+// ErrNilCostGetter signals that a nil cost getter was provided
+var ErrNilCostGetter = errors.New("nil cost getter")
+
+// ErrNoCostGetters signals that no cost getter was provided
+var ErrNoCostGetters = errors.New("no cost getters")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNilReceiptProvider signals that a nil receipt provider was provided
+var ErrNilReceiptProvider = errors.New("nil receipt provider")
+
+// ErrNilTransactionProvider signals that a nil transaction provider was provided
+var ErrNilTransactionProvider = errors.New("nil transaction provider")
+
+// ErrNilTransactionReceipt signals that a nil transaction receipt was fetched
+var ErrNilTransactionReceipt = errors.New("nil transaction receipt")
+
+// ErrNilTransactionInfo signals that a nil transaction info was fetched
+var ErrNilTransactionInfo = errors.New("nil transaction info")
+
+// ErrInvalidPeriod signals that an invalid period was provided
+var ErrInvalidPeriod = errors.New("invalid period")
+
+// ErrUnknownSplitRule signals that an unknown cost sharing split rule was provided
+var ErrUnknownSplitRule = errors.New("unknown split rule")
+
+// ErrUnknownMember signals that an execution cost was paid by a relayer missing from the weighted members
+var ErrUnknownMember = errors.New("unknown consortium member")
+
+// ErrNoMembers signals that the settlement has no consortium members
+var ErrNoMembers = errors.New("no consortium members")
+
+// ErrInvalidFee signals that an execution cost holds an invalid fee
+var ErrInvalidFee = errors.New("invalid fee")
+
+// ErrUnknownStatementFormat signals that an unknown settlement statement format was provided
+var ErrUnknownStatementFormat = errors.New("unknown statement format")
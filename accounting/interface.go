@@ -0,0 +1,36 @@
+package accounting
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// Storer defines the component saving the execution costs of each period
+type Storer interface {
+	StoreExecutionCosts(period string, costs []*core.ExecutionCost) error
+	GetExecutionCosts(period string) ([]*core.ExecutionCost, error)
+	IsInterfaceNil() bool
+}
+
+// CostGetter is able to fetch the gas used and the fee paid by a sent transaction, once the transaction is final
+type CostGetter interface {
+	GetCost(ctx context.Context, hash string) (gasUsed uint64, fee *big.Int, isFinal bool, err error)
+	IsInterfaceNil() bool
+}
+
+// EthereumReceiptProvider defines the Ethereum operation needed to fetch a transaction receipt
+type EthereumReceiptProvider interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXTransactionProvider defines the MultiversX operation needed to fetch a transaction with its results
+type MultiversXTransactionProvider interface {
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,347 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+const (
+	// EqualSplit is the split rule sharing the execution costs equally between the consortium members
+	EqualSplit = "equal"
+	// WeightedSplit is the split rule sharing the execution costs proportionally to the weights of the members
+	WeightedSplit = "weighted"
+
+	// JSONFormat is the format used to export the settlement statements as JSON documents
+	JSONFormat = "json"
+	// CSVFormat is the format used to export the settlement statements as CSV files, e.g. for spreadsheets
+	CSVFormat = "csv"
+
+	memberRecord   = "member"
+	transferRecord = "transfer"
+)
+
+// ArgsSettlement is the DTO used to compute the cost sharing settlement of a period
+type ArgsSettlement struct {
+	Period    string
+	SplitRule string
+	// Members holds the weight of each consortium member, by relayer address. With the equal split, the weights are
+	// ignored and the relayers that paid execution costs are members even if missing from the list
+	Members map[string]uint64
+	// Costs holds the execution costs of all the members, the ones outside the period being ignored
+	Costs []*core.ExecutionCost
+}
+
+// Settlement is the cost sharing statement of a period, settled separately for each destination chain as the fees
+// are paid in the native token of the chain
+type Settlement struct {
+	Period    string             `json:"period"`
+	SplitRule string             `json:"splitRule"`
+	Chains    []*ChainSettlement `json:"chains"`
+}
+
+// ChainSettlement holds the execution costs paid on a chain, the share of each member and the transfers settling them
+type ChainSettlement struct {
+	Chain         string                `json:"chain"`
+	NumExecutions int                   `json:"numExecutions"`
+	GasUsed       uint64                `json:"gasUsed"`
+	TotalFee      string                `json:"totalFee"`
+	Members       []*MemberStatement    `json:"members"`
+	Transfers     []*SettlementTransfer `json:"transfers"`
+}
+
+// MemberStatement holds what a member paid and owes on a chain. A positive balance is owed to the member, a negative
+// one is owed by the member
+type MemberStatement struct {
+	Relayer       string `json:"relayer"`
+	Weight        uint64 `json:"weight"`
+	NumExecutions int    `json:"numExecutions"`
+	GasUsed       uint64 `json:"gasUsed"`
+	Paid          string `json:"paid"`
+	Share         string `json:"share"`
+	Balance       string `json:"balance"`
+}
+
+// SettlementTransfer is a payment between two members settling their balances
+type SettlementTransfer struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Amount string `json:"amount"`
+}
+
+type memberBalance struct {
+	relayer       string
+	weight        uint64
+	numExecutions int
+	gasUsed       uint64
+	paid          *big.Int
+	share         *big.Int
+}
+
+// ComputeSettlement attributes the execution costs of the period to the relayers that paid them and computes, for
+// each chain, the share of each member according to the split rule and the transfers settling the balances
+func ComputeSettlement(args ArgsSettlement) (*Settlement, error) {
+	err := checkPeriod(args.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	costs := filterCosts(args.Period, args.Costs)
+	weights, err := computeWeights(args.SplitRule, args.Members, costs)
+	if err != nil {
+		return nil, err
+	}
+
+	costsByChain := make(map[string][]*core.ExecutionCost)
+	for _, cost := range costs {
+		costsByChain[cost.Chain] = append(costsByChain[cost.Chain], cost)
+	}
+	chains := make([]string, 0, len(costsByChain))
+	for chain := range costsByChain {
+		chains = append(chains, chain)
+	}
+	sort.Strings(chains)
+
+	settlement := &Settlement{
+		Period:    args.Period,
+		SplitRule: args.SplitRule,
+		Chains:    make([]*ChainSettlement, 0, len(chains)),
+	}
+	for _, chain := range chains {
+		chainSettlement, errSettle := settleChain(chain, costsByChain[chain], weights)
+		if errSettle != nil {
+			return nil, errSettle
+		}
+		settlement.Chains = append(settlement.Chains, chainSettlement)
+	}
+
+	return settlement, nil
+}
+
+// filterCosts keeps the costs of the period, once, as the same ledger might be provided more than once
+func filterCosts(period string, costs []*core.ExecutionCost) []*core.ExecutionCost {
+	filtered := make([]*core.ExecutionCost, 0, len(costs))
+	seen := make(map[string]struct{})
+	for _, cost := range costs {
+		if cost == nil || periodOf(cost.Timestamp) != period {
+			continue
+		}
+
+		key := cost.Chain + "/" + cost.TxHash
+		_, found := seen[key]
+		if found {
+			continue
+		}
+		seen[key] = struct{}{}
+		filtered = append(filtered, cost)
+	}
+
+	return filtered
+}
+
+func computeWeights(splitRule string, members map[string]uint64, costs []*core.ExecutionCost) (map[string]uint64, error) {
+	weights := make(map[string]uint64)
+	switch splitRule {
+	case EqualSplit:
+		for relayer := range members {
+			weights[relayer] = 1
+		}
+		for _, cost := range costs {
+			weights[cost.Relayer] = 1
+		}
+	case WeightedSplit:
+		for relayer, weight := range members {
+			weights[relayer] = weight
+		}
+		for _, cost := range costs {
+			_, found := weights[cost.Relayer]
+			if !found {
+				return nil, fmt.Errorf("%w: %s paid the execution of batch %d on %s",
+					ErrUnknownMember, cost.Relayer, cost.BatchID, cost.Chain)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSplitRule, splitRule)
+	}
+
+	totalWeight := uint64(0)
+	for _, weight := range weights {
+		totalWeight += weight
+	}
+	if totalWeight == 0 && len(costs) > 0 {
+		return nil, ErrNoMembers
+	}
+
+	return weights, nil
+}
+
+func settleChain(chain string, costs []*core.ExecutionCost, weights map[string]uint64) (*ChainSettlement, error) {
+	balances := make(map[string]*memberBalance, len(weights))
+	relayers := make([]string, 0, len(weights))
+	totalWeight := uint64(0)
+	for relayer, weight := range weights {
+		balances[relayer] = &memberBalance{
+			relayer: relayer,
+			weight:  weight,
+			paid:    big.NewInt(0),
+			share:   big.NewInt(0),
+		}
+		relayers = append(relayers, relayer)
+		totalWeight += weight
+	}
+	sort.Strings(relayers)
+
+	chainSettlement := &ChainSettlement{
+		Chain:         chain,
+		NumExecutions: len(costs),
+		Members:       make([]*MemberStatement, 0, len(relayers)),
+	}
+	totalFee := big.NewInt(0)
+	for _, cost := range costs {
+		fee, ok := big.NewInt(0).SetString(cost.Fee, 10)
+		if !ok || fee.Sign() < 0 {
+			return nil, fmt.Errorf("%w: %s for transaction %s on %s", ErrInvalidFee, cost.Fee, cost.TxHash, chain)
+		}
+
+		balance := balances[cost.Relayer]
+		balance.numExecutions++
+		balance.gasUsed += cost.GasUsed
+		balance.paid.Add(balance.paid, fee)
+		chainSettlement.GasUsed += cost.GasUsed
+		totalFee.Add(totalFee, fee)
+	}
+	chainSettlement.TotalFee = totalFee.String()
+
+	// the shares are rounded down, the remaining units are assigned one by one to the members, in address order
+	remaining := big.NewInt(0).Set(totalFee)
+	for _, relayer := range relayers {
+		balance := balances[relayer]
+		balance.share.Mul(totalFee, new(big.Int).SetUint64(balance.weight))
+		balance.share.Div(balance.share, new(big.Int).SetUint64(totalWeight))
+		remaining.Sub(remaining, balance.share)
+	}
+	for remaining.Sign() > 0 {
+		for _, relayer := range relayers {
+			balance := balances[relayer]
+			if remaining.Sign() == 0 || balance.weight == 0 {
+				continue
+			}
+			balance.share.Add(balance.share, big.NewInt(1))
+			remaining.Sub(remaining, big.NewInt(1))
+		}
+	}
+
+	for _, relayer := range relayers {
+		balance := balances[relayer]
+		chainSettlement.Members = append(chainSettlement.Members, &MemberStatement{
+			Relayer:       relayer,
+			Weight:        balance.weight,
+			NumExecutions: balance.numExecutions,
+			GasUsed:       balance.gasUsed,
+			Paid:          balance.paid.String(),
+			Share:         balance.share.String(),
+			Balance:       new(big.Int).Sub(balance.paid, balance.share).String(),
+		})
+	}
+	chainSettlement.Transfers = computeTransfers(relayers, balances)
+
+	return chainSettlement, nil
+}
+
+// computeTransfers matches, in address order, the members owing their share with the members that paid more than
+// their share
+func computeTransfers(relayers []string, balances map[string]*memberBalance) []*SettlementTransfer {
+	debtors := make([]string, 0)
+	creditors := make([]string, 0)
+	amounts := make(map[string]*big.Int, len(relayers))
+	for _, relayer := range relayers {
+		amount := new(big.Int).Sub(balances[relayer].paid, balances[relayer].share)
+		amounts[relayer] = new(big.Int).Abs(amount)
+		switch amount.Sign() {
+		case -1:
+			debtors = append(debtors, relayer)
+		case 1:
+			creditors = append(creditors, relayer)
+		}
+	}
+
+	transfers := make([]*SettlementTransfer, 0)
+	debtorIndex, creditorIndex := 0, 0
+	for debtorIndex < len(debtors) && creditorIndex < len(creditors) {
+		debtor := debtors[debtorIndex]
+		creditor := creditors[creditorIndex]
+		amount := new(big.Int).Set(amounts[debtor])
+		if amounts[creditor].Cmp(amount) < 0 {
+			amount.Set(amounts[creditor])
+		}
+
+		transfers = append(transfers, &SettlementTransfer{
+			From:   debtor,
+			To:     creditor,
+			Amount: amount.String(),
+		})
+		amounts[debtor].Sub(amounts[debtor], amount)
+		amounts[creditor].Sub(amounts[creditor], amount)
+		if amounts[debtor].Sign() == 0 {
+			debtorIndex++
+		}
+		if amounts[creditor].Sign() == 0 {
+			creditorIndex++
+		}
+	}
+
+	return transfers
+}
+
+// Export writes the settlement statement in the provided format. The CSV format holds a line for each member and for
+// each transfer of each chain, distinguished by the first column
+func (settlement *Settlement) Export(writer io.Writer, format string) error {
+	switch format {
+	case JSONFormat:
+		buff, err := json.MarshalIndent(settlement, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(buff)
+		return err
+	case CSVFormat:
+		return settlement.exportCSV(writer)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownStatementFormat, format)
+	}
+}
+
+func (settlement *Settlement) exportCSV(writer io.Writer) error {
+	csvWriter := csv.NewWriter(writer)
+	records := [][]string{
+		{"record", "period", "chain", "relayer", "weight", "executions", "gasUsed", "paid", "share", "balance", "to", "amount"},
+	}
+	for _, chain := range settlement.Chains {
+		for _, member := range chain.Members {
+			records = append(records, []string{
+				memberRecord, settlement.Period, chain.Chain, member.Relayer, strconv.FormatUint(member.Weight, 10),
+				strconv.Itoa(member.NumExecutions), strconv.FormatUint(member.GasUsed, 10), member.Paid, member.Share,
+				member.Balance, "", "",
+			})
+		}
+		for _, transfer := range chain.Transfers {
+			records = append(records, []string{
+				transferRecord, settlement.Period, chain.Chain, transfer.From, "", "", "", "", "", "", transfer.To,
+				transfer.Amount,
+			})
+		}
+	}
+
+	err := csvWriter.WriteAll(records)
+	if err != nil {
+		return err
+	}
+
+	return csvWriter.Error()
+}
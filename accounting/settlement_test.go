@@ -0,0 +1,196 @@
+package accounting
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const previousPeriodTimestamp = int64(1786000000) // 2026-08-06
+
+func createExecutionCost(relayer string, chain string, hash string, fee string, timestamp int64) *core.ExecutionCost {
+	return &core.ExecutionCost{
+		Relayer:   relayer,
+		Chain:     chain,
+		TxHash:    hash,
+		GasUsed:   1000,
+		Fee:       fee,
+		Timestamp: timestamp,
+	}
+}
+
+func TestComputeSettlement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid period should error", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    "2026/09",
+			SplitRule: EqualSplit,
+		})
+		assert.Nil(t, settlement)
+		assert.True(t, errors.Is(err, ErrInvalidPeriod))
+	})
+	t.Run("unknown split rule should error", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    testPeriod,
+			SplitRule: "random",
+		})
+		assert.Nil(t, settlement)
+		assert.True(t, errors.Is(err, ErrUnknownSplitRule))
+	})
+	t.Run("weighted split with an unknown payer should error", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    testPeriod,
+			SplitRule: WeightedSplit,
+			Members:   map[string]uint64{"alice": 1},
+			Costs:     []*core.ExecutionCost{createExecutionCost("bob", "Ethereum", "hash", "10", testTimestamp)},
+		})
+		assert.Nil(t, settlement)
+		assert.True(t, errors.Is(err, ErrUnknownMember))
+	})
+	t.Run("weighted split without weights should error", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    testPeriod,
+			SplitRule: WeightedSplit,
+			Members:   map[string]uint64{"alice": 0},
+			Costs:     []*core.ExecutionCost{createExecutionCost("alice", "Ethereum", "hash", "10", testTimestamp)},
+		})
+		assert.Nil(t, settlement)
+		assert.Equal(t, ErrNoMembers, err)
+	})
+	t.Run("invalid fee should error", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    testPeriod,
+			SplitRule: EqualSplit,
+			Costs:     []*core.ExecutionCost{createExecutionCost("alice", "Ethereum", "hash", "ten", testTimestamp)},
+		})
+		assert.Nil(t, settlement)
+		assert.True(t, errors.Is(err, ErrInvalidFee))
+	})
+	t.Run("equal split should assign the remainder in address order", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    testPeriod,
+			SplitRule: EqualSplit,
+			Members:   map[string]uint64{"carol": 5},
+			Costs: []*core.ExecutionCost{
+				createExecutionCost("alice", "Ethereum", "hash 1", "100", testTimestamp),
+				createExecutionCost("alice", "Ethereum", "hash 1", "100", testTimestamp),
+				createExecutionCost("bob", "Ethereum", "hash 2", "1", testTimestamp),
+				createExecutionCost("bob", "Ethereum", "hash 3", "1000", previousPeriodTimestamp),
+			},
+		})
+		require.Nil(t, err)
+
+		expectedSettlement := &Settlement{
+			Period:    testPeriod,
+			SplitRule: EqualSplit,
+			Chains: []*ChainSettlement{
+				{
+					Chain:         "Ethereum",
+					NumExecutions: 2,
+					GasUsed:       2000,
+					TotalFee:      "101",
+					Members: []*MemberStatement{
+						{Relayer: "alice", Weight: 1, NumExecutions: 1, GasUsed: 1000, Paid: "100", Share: "34", Balance: "66"},
+						{Relayer: "bob", Weight: 1, NumExecutions: 1, GasUsed: 1000, Paid: "1", Share: "34", Balance: "-33"},
+						{Relayer: "carol", Weight: 1, NumExecutions: 0, GasUsed: 0, Paid: "0", Share: "33", Balance: "-33"},
+					},
+					Transfers: []*SettlementTransfer{
+						{From: "bob", To: "alice", Amount: "33"},
+						{From: "carol", To: "alice", Amount: "33"},
+					},
+				},
+			},
+		}
+		assert.Equal(t, expectedSettlement, settlement)
+	})
+	t.Run("weighted split should settle each chain separately", func(t *testing.T) {
+		t.Parallel()
+
+		settlement, err := ComputeSettlement(ArgsSettlement{
+			Period:    testPeriod,
+			SplitRule: WeightedSplit,
+			Members:   map[string]uint64{"alice": 2, "bob": 1},
+			Costs: []*core.ExecutionCost{
+				createExecutionCost("alice", "MultiversX", "hash 1", "30", testTimestamp),
+				createExecutionCost("bob", "Ethereum", "hash 2", "60", testTimestamp),
+			},
+		})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(settlement.Chains))
+
+		assert.Equal(t, "Ethereum", settlement.Chains[0].Chain)
+		assert.Equal(t, "40", settlement.Chains[0].Members[0].Share)
+		assert.Equal(t, "20", settlement.Chains[0].Members[1].Share)
+		assert.Equal(t, []*SettlementTransfer{{From: "alice", To: "bob", Amount: "40"}}, settlement.Chains[0].Transfers)
+
+		assert.Equal(t, "MultiversX", settlement.Chains[1].Chain)
+		assert.Equal(t, "20", settlement.Chains[1].Members[0].Share)
+		assert.Equal(t, "10", settlement.Chains[1].Members[1].Share)
+		assert.Equal(t, []*SettlementTransfer{{From: "bob", To: "alice", Amount: "10"}}, settlement.Chains[1].Transfers)
+	})
+}
+
+func TestSettlement_Export(t *testing.T) {
+	t.Parallel()
+
+	settlement, _ := ComputeSettlement(ArgsSettlement{
+		Period:    testPeriod,
+		SplitRule: WeightedSplit,
+		Members:   map[string]uint64{"alice": 2, "bob": 1},
+		Costs: []*core.ExecutionCost{
+			createExecutionCost("alice", "MultiversX", "hash 1", "30", testTimestamp),
+		},
+	})
+
+	t.Run("unknown format should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		err := settlement.Export(buff, "xml")
+		assert.True(t, errors.Is(err, ErrUnknownStatementFormat))
+		assert.Zero(t, buff.Len())
+	})
+	t.Run("json format should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		err := settlement.Export(buff, JSONFormat)
+		require.Nil(t, err)
+
+		exported := &Settlement{}
+		err = json.Unmarshal(buff.Bytes(), exported)
+		require.Nil(t, err)
+		assert.Equal(t, settlement, exported)
+	})
+	t.Run("csv format should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		err := settlement.Export(buff, CSVFormat)
+		require.Nil(t, err)
+
+		expectedCSV := "record,period,chain,relayer,weight,executions,gasUsed,paid,share,balance,to,amount\n" +
+			"member,2026-09,MultiversX,alice,2,1,1000,30,20,10,,\n" +
+			"member,2026-09,MultiversX,bob,1,0,0,0,10,-10,,\n" +
+			"transfer,2026-09,MultiversX,bob,,,,,,,alice,10\n"
+		assert.Equal(t, expectedCSV, buff.String())
+	})
+}
@@ -13,3 +13,15 @@ var ErrNilAntiFloodConfig = errors.New("nil antiflood config")
 
 // ErrNilApiConfig signals that a nil api config has been provided
 var ErrNilApiConfig = errors.New("nil api config")
+
+// ErrInvalidResponseCacheCapacity signals that an invalid response cache capacity has been provided
+var ErrInvalidResponseCacheCapacity = errors.New("invalid response cache capacity")
+
+// ErrEmptyAdminToken signals that an empty admin token has been provided
+var ErrEmptyAdminToken = errors.New("empty admin token")
+
+// ErrUnauthorized signals that a request was not authorized
+var ErrUnauthorized = errors.New("unauthorized")
+
+// ErrNilMessageCatalog signals that a nil message catalog has been provided
+var ErrNilMessageCatalog = errors.New("nil message catalog")
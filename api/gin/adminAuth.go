@@ -0,0 +1,56 @@
+package gin
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	chainShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const bearerPrefix = "Bearer "
+
+type adminAuth struct {
+	token []byte
+}
+
+// newAdminAuth creates a middleware rejecting the requests not carrying the provided token as a bearer token
+func newAdminAuth(token string) (*adminAuth, error) {
+	if len(token) == 0 {
+		return nil, apiErrors.ErrEmptyAdminToken
+	}
+
+	return &adminAuth{
+		token: []byte(token),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (auth *adminAuth) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		token := strings.TrimPrefix(header, bearerPrefix)
+		isAuthorized := len(token) < len(header) && subtle.ConstantTimeCompare([]byte(token), auth.token) == 1
+		if !isAuthorized {
+			log.Warn("unauthorized admin request", "path", c.Request.URL.Path, "remote address", c.ClientIP())
+			c.AbortWithStatusJSON(
+				http.StatusUnauthorized,
+				chainShared.GenericAPIResponse{
+					Data:  nil,
+					Error: apiErrors.ErrUnauthorized.Error(),
+					Code:  chainShared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (auth *adminAuth) IsInterfaceNil() bool {
+	return auth == nil
+}
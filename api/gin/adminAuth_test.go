@@ -0,0 +1,82 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createAuthEngine(auth *adminAuth, numCalls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(auth.MiddlewareHandlerFunc())
+	engine.POST("/pause", func(c *gin.Context) {
+		*numCalls++
+		c.JSON(http.StatusOK, gin.H{})
+	})
+
+	return engine
+}
+
+func doPostRequest(engine *gin.Engine, authorization string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodPost, "/pause", nil)
+	if len(authorization) > 0 {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewAdminAuth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty token should error", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := newAdminAuth("")
+		assert.True(t, check.IfNil(auth))
+		assert.Equal(t, apiErrors.ErrEmptyAdminToken, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		auth, err := newAdminAuth("token")
+		assert.False(t, check.IfNil(auth))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAdminAuth_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing or wrong token should not reach the handler", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		auth, _ := newAdminAuth("token")
+		engine := createAuthEngine(auth, &numCalls)
+
+		assert.Equal(t, http.StatusUnauthorized, doPostRequest(engine, "").Code)
+		assert.Equal(t, http.StatusUnauthorized, doPostRequest(engine, "token").Code)
+		assert.Equal(t, http.StatusUnauthorized, doPostRequest(engine, "Bearer wrong").Code)
+		assert.Equal(t, http.StatusUnauthorized, doPostRequest(engine, "Bearer token2").Code)
+		assert.Equal(t, 0, numCalls)
+	})
+	t.Run("valid token should reach the handler", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		auth, _ := newAdminAuth("token")
+		engine := createAuthEngine(auth, &numCalls)
+
+		assert.Equal(t, http.StatusOK, doPostRequest(engine, "Bearer token").Code)
+		assert.Equal(t, 1, numCalls)
+	})
+}
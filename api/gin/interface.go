@@ -11,3 +11,9 @@ type server interface {
 	ListenAndServe() error
 	Shutdown(ctx context.Context) error
 }
+
+type messageCatalog interface {
+	Code(status int, errorMessage string) string
+	Message(code string, languages []string) string
+	IsInterfaceNil() bool
+}
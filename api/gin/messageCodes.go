@@ -0,0 +1,147 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	languageQueryParam   = "lang"
+	acceptLanguageHeader = "Accept-Language"
+	messageCodeField     = "messageCode"
+	messageField         = "message"
+	errorField           = "error"
+)
+
+type errorResponseRecorder struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+// Write keeps the data of the error responses, to be completed with the message code, and writes the other data in the
+// inner writer
+func (recorder *errorResponseRecorder) Write(data []byte) (int, error) {
+	if recorder.isErrorResponse() {
+		return recorder.body.Write(data)
+	}
+
+	return recorder.ResponseWriter.Write(data)
+}
+
+// WriteString keeps the data of the error responses, to be completed with the message code, and writes the other data
+// in the inner writer
+func (recorder *errorResponseRecorder) WriteString(data string) (int, error) {
+	if recorder.isErrorResponse() {
+		return recorder.body.WriteString(data)
+	}
+
+	return recorder.ResponseWriter.WriteString(data)
+}
+
+func (recorder *errorResponseRecorder) isErrorResponse() bool {
+	return recorder.Status() >= http.StatusBadRequest
+}
+
+type messageCodes struct {
+	catalog messageCatalog
+}
+
+// newMessageCodes creates a middleware adding to the JSON error responses the stable machine code of the error and, if
+// the request asks for a language with the lang query parameter or the Accept-Language header, the human message of
+// the code from the catalog
+func newMessageCodes(catalog messageCatalog) (*messageCodes, error) {
+	if check.IfNil(catalog) {
+		return nil, apiErrors.ErrNilMessageCatalog
+	}
+
+	return &messageCodes{
+		catalog: catalog,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (mc *messageCodes) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		recorder := &errorResponseRecorder{
+			ResponseWriter: c.Writer,
+			body:           bytes.NewBuffer(nil),
+		}
+		c.Writer = recorder
+		c.Next()
+		c.Writer = recorder.ResponseWriter
+
+		if recorder.body.Len() == 0 {
+			return
+		}
+
+		body := mc.completeErrorResponse(recorder.Status(), recorder.body.Bytes(), requestedLanguages(c))
+		_, err := recorder.ResponseWriter.Write(body)
+		if err != nil {
+			log.Debug("cannot write the error response", "path", c.Request.URL.Path, "error", err)
+		}
+	}
+}
+
+// completeErrorResponse adds the message code and message fields to a JSON error response. The other bodies are
+// returned unchanged
+func (mc *messageCodes) completeErrorResponse(status int, body []byte, languages []string) []byte {
+	fields := make(map[string]json.RawMessage)
+	err := json.Unmarshal(body, &fields)
+	if err != nil {
+		return body
+	}
+
+	errorMessage := ""
+	err = json.Unmarshal(fields[errorField], &errorMessage)
+	if err != nil || len(errorMessage) == 0 {
+		return body
+	}
+
+	code := mc.catalog.Code(status, errorMessage)
+	fields[messageCodeField], _ = json.Marshal(code)
+	if len(languages) > 0 {
+		fields[messageField], _ = json.Marshal(mc.catalog.Message(code, languages))
+	}
+
+	completedBody, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+
+	return completedBody
+}
+
+// requestedLanguages returns the primary tags of the languages asked by the request, the lang query parameter first,
+// then the ones of the Accept-Language header, in the order they are listed
+func requestedLanguages(c *gin.Context) []string {
+	languages := make([]string, 0)
+	queryLanguage := c.Query(languageQueryParam)
+	if len(queryLanguage) > 0 {
+		languages = append(languages, primaryTag(queryLanguage))
+	}
+
+	for _, item := range strings.Split(c.GetHeader(acceptLanguageHeader), ",") {
+		language := primaryTag(strings.Split(item, ";")[0])
+		if len(language) == 0 || language == "*" {
+			continue
+		}
+		languages = append(languages, language)
+	}
+
+	return languages
+}
+
+func primaryTag(language string) string {
+	return strings.ToLower(strings.Split(strings.TrimSpace(language), "-")[0])
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mc *messageCodes) IsInterfaceNil() bool {
+	return mc == nil
+}
@@ -0,0 +1,126 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	"github.com/multiversx/mx-bridge-eth-go/api/groups"
+	"github.com/multiversx/mx-bridge-eth-go/api/messages"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	chainShared "github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMessageCodesEngine(t *testing.T) *gin.Engine {
+	catalog, err := messages.NewCatalog(messages.ArgsCatalog{
+		DefaultLanguage: messages.EnglishLanguage,
+		Languages:       messages.DefaultLanguages(),
+	})
+	require.Nil(t, err)
+	codes, err := newMessageCodes(catalog)
+	require.Nil(t, err)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(codes.MiddlewareHandlerFunc())
+	engine.GET("/status", func(c *gin.Context) {
+		c.JSON(http.StatusOK, chainShared.GenericAPIResponse{
+			Data: gin.H{"status": "ok"},
+			Code: chainShared.ReturnCodeSuccess,
+		})
+	})
+	engine.GET("/batch", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, chainShared.GenericAPIResponse{
+			Error: groups.ErrBatchNotFound.Error(),
+			Code:  chainShared.ReturnCodeRequestError,
+		})
+	})
+	engine.GET("/text", func(c *gin.Context) {
+		c.String(http.StatusInternalServerError, "plain error")
+	})
+
+	return engine
+}
+
+func doLanguageRequest(engine *gin.Engine, path string, acceptLanguage string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	if len(acceptLanguage) > 0 {
+		req.Header.Set(acceptLanguageHeader, acceptLanguage)
+	}
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewMessageCodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil catalog should error", func(t *testing.T) {
+		t.Parallel()
+
+		codes, err := newMessageCodes(nil)
+		assert.True(t, check.IfNil(codes))
+		assert.Equal(t, apiErrors.ErrNilMessageCatalog, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		catalog, _ := messages.NewCatalog(messages.ArgsCatalog{
+			DefaultLanguage: messages.EnglishLanguage,
+			Languages:       messages.DefaultLanguages(),
+		})
+		codes, err := newMessageCodes(catalog)
+		assert.False(t, check.IfNil(codes))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMessageCodes_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	expectedMessage := messages.DefaultLanguages()[messages.EnglishLanguage][messages.CodeBatchNotFound]
+
+	t.Run("successful responses should not change", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doLanguageRequest(createMessageCodesEngine(t), "/status", "en")
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, `{"data":{"status":"ok"},"error":"","code":"successful"}`, resp.Body.String())
+	})
+	t.Run("non JSON error responses should not change", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doLanguageRequest(createMessageCodesEngine(t), "/text", "en")
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, "plain error", resp.Body.String())
+	})
+	t.Run("error responses should get the message code", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doLanguageRequest(createMessageCodesEngine(t), "/batch", "")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.JSONEq(t, `{"data":null,"error":"batch not found","code":"`+string(chainShared.ReturnCodeRequestError)+
+			`","messageCode":"batch_not_found"}`, resp.Body.String())
+	})
+	t.Run("error responses should get the message in the requested language", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doLanguageRequest(createMessageCodesEngine(t), "/batch", "de-CH;q=0.9, en-US;q=0.8, *;q=0.5")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.JSONEq(t, `{"data":null,"error":"batch not found","code":"`+string(chainShared.ReturnCodeRequestError)+
+			`","messageCode":"batch_not_found","message":"`+expectedMessage+`"}`, resp.Body.String())
+	})
+	t.Run("unsupported language should fall back to the default one", func(t *testing.T) {
+		t.Parallel()
+
+		resp := doLanguageRequest(createMessageCodesEngine(t), "/batch?lang=ro", "")
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.JSONEq(t, `{"data":null,"error":"batch not found","code":"`+string(chainShared.ReturnCodeRequestError)+
+			`","messageCode":"batch_not_found","message":"`+expectedMessage+`"}`, resp.Body.String())
+	})
+}
@@ -0,0 +1,131 @@
+package gin
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+)
+
+const minResponseCacheCapacity = 1
+
+type cachedResponse struct {
+	contentType string
+	body        []byte
+	expiry      time.Time
+}
+
+type responseWriterRecorder struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+// Write writes the data in both the recorder and the inner writer
+func (recorder *responseWriterRecorder) Write(data []byte) (int, error) {
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+
+type responseCache struct {
+	capacity   int
+	expiration time.Duration
+	mut        sync.Mutex
+	responses  map[string]*cachedResponse
+	getTime    func() time.Time
+}
+
+// newResponseCache creates a middleware serving the successful GET responses from a small cache, so that the same
+// request made in the expiration interval does not reach the facade again
+func newResponseCache(capacity int, expiration time.Duration) (*responseCache, error) {
+	if capacity < minResponseCacheCapacity {
+		return nil, apiErrors.ErrInvalidResponseCacheCapacity
+	}
+
+	return &responseCache{
+		capacity:   capacity,
+		expiration: expiration,
+		responses:  make(map[string]*cachedResponse),
+		getTime:    time.Now,
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (cache *responseCache) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Next()
+			return
+		}
+
+		key := c.Request.URL.RequestURI()
+		response, found := cache.get(key)
+		if found {
+			c.Data(http.StatusOK, response.contentType, response.body)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseWriterRecorder{
+			ResponseWriter: c.Writer,
+			body:           bytes.NewBuffer(nil),
+		}
+		c.Writer = recorder
+		c.Next()
+
+		if recorder.Status() != http.StatusOK {
+			return
+		}
+		cache.put(key, &cachedResponse{
+			contentType: recorder.Header().Get("Content-Type"),
+			body:        recorder.body.Bytes(),
+		})
+	}
+}
+
+func (cache *responseCache) get(key string) (*cachedResponse, bool) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	response, found := cache.responses[key]
+	if !found {
+		return nil, false
+	}
+	if cache.getTime().After(response.expiry) {
+		delete(cache.responses, key)
+		return nil, false
+	}
+
+	return response, true
+}
+
+func (cache *responseCache) put(key string, response *cachedResponse) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	now := cache.getTime()
+	if len(cache.responses) >= cache.capacity {
+		cache.removeExpired(now)
+	}
+	if len(cache.responses) >= cache.capacity {
+		return
+	}
+
+	response.expiry = now.Add(cache.expiration)
+	cache.responses[key] = response
+}
+
+func (cache *responseCache) removeExpired(now time.Time) {
+	for key, response := range cache.responses {
+		if now.After(response.expiry) {
+			delete(cache.responses, key)
+		}
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cache *responseCache) IsInterfaceNil() bool {
+	return cache == nil
+}
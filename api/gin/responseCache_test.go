@@ -0,0 +1,110 @@
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createCachedEngine(cache *responseCache, numCalls *int) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.Use(cache.MiddlewareHandlerFunc())
+	engine.GET("/status", func(c *gin.Context) {
+		*numCalls++
+		c.JSON(http.StatusOK, gin.H{"calls": *numCalls})
+	})
+	engine.GET("/missing", func(c *gin.Context) {
+		*numCalls++
+		c.JSON(http.StatusNotFound, gin.H{})
+	})
+
+	return engine
+}
+
+func doGetRequest(engine *gin.Engine, path string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	resp := httptest.NewRecorder()
+	engine.ServeHTTP(resp, req)
+
+	return resp
+}
+
+func TestNewResponseCache(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := newResponseCache(0, time.Second)
+		assert.True(t, check.IfNil(cache))
+		assert.Equal(t, apiErrors.ErrInvalidResponseCacheCapacity, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cache, err := newResponseCache(1, time.Second)
+		assert.False(t, check.IfNil(cache))
+		assert.Nil(t, err)
+	})
+}
+
+func TestResponseCache_MiddlewareHandlerFunc(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should serve the successful responses from the cache until they expire", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		cache, _ := newResponseCache(10, time.Second)
+		cache.getTime = func() time.Time {
+			return currentTime
+		}
+		numCalls := 0
+		engine := createCachedEngine(cache, &numCalls)
+
+		first := doGetRequest(engine, "/status")
+		second := doGetRequest(engine, "/status")
+		assert.Equal(t, 1, numCalls)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, first.Header().Get("Content-Type"), second.Header().Get("Content-Type"))
+
+		currentTime = currentTime.Add(2 * time.Second)
+		_ = doGetRequest(engine, "/status")
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should not cache the failed responses", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := newResponseCache(10, time.Minute)
+		numCalls := 0
+		engine := createCachedEngine(cache, &numCalls)
+
+		_ = doGetRequest(engine, "/missing")
+		resp := doGetRequest(engine, "/missing")
+		assert.Equal(t, 2, numCalls)
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("full cache should not store new responses", func(t *testing.T) {
+		t.Parallel()
+
+		cache, _ := newResponseCache(1, time.Minute)
+		numCalls := 0
+		engine := createCachedEngine(cache, &numCalls)
+
+		_ = doGetRequest(engine, "/status?a=1")
+		_ = doGetRequest(engine, "/status?a=2")
+		_ = doGetRequest(engine, "/status?a=2")
+		assert.Equal(t, 3, numCalls)
+
+		_ = doGetRequest(engine, "/status?a=1")
+		assert.Equal(t, 3, numCalls)
+	})
+}
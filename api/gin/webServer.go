@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/gin-gonic/gin"
 	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
 	"github.com/multiversx/mx-bridge-eth-go/api/groups"
+	"github.com/multiversx/mx-bridge-eth-go/api/messages"
 	"github.com/multiversx/mx-bridge-eth-go/api/shared"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
@@ -26,6 +28,13 @@ import (
 
 var log = logger.GetOrCreate("api")
 
+const adminGroupName = "admin"
+
+// publicReadModeClosedGroups holds the groups not served in the public read mode, even for their read-only routes
+var publicReadModeClosedGroups = map[string]struct{}{
+	adminGroupName: {},
+}
+
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
 	Facade          shared.FacadeHandler
@@ -40,6 +49,7 @@ type webServer struct {
 	antiFloodConfig config.WebAntifloodConfig
 	httpServer      chainShared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
+	adminAuth       *adminAuth
 	cancelFunc      func()
 }
 
@@ -95,7 +105,13 @@ func (ws *webServer) StartHttpServer() error {
 	engine = gin.Default()
 	engine.Use(cors.Default())
 
-	err := ws.createGroups()
+	codes, err := ws.createMessageCodes()
+	if err != nil {
+		return err
+	}
+	engine.Use(codes.MiddlewareHandlerFunc())
+
+	err = ws.createGroups()
 	if err != nil {
 		return err
 	}
@@ -114,6 +130,11 @@ func (ws *webServer) StartHttpServer() error {
 		engine.Use(proc.MiddlewareHandlerFunc())
 	}
 
+	ws.adminAuth, err = ws.createAdminAuth()
+	if err != nil {
+		return err
+	}
+
 	ws.registerRoutes(engine)
 
 	serverInstance := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
@@ -138,6 +159,36 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["node"] = nodeGroup
 
+	adminGroup, err := groups.NewAdminGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap[adminGroupName] = adminGroup
+
+	batchGroup, err := groups.NewBatchGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["batch"] = batchGroup
+
+	bridgeGroup, err := groups.NewBridgeGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["bridge"] = bridgeGroup
+
+	claimsGroup, err := groups.NewClaimsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["claims"] = claimsGroup
+
+	configGroup, err := groups.NewConfigGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["config"] = configGroup
+
 	ws.groups = groupsMap
 
 	return nil
@@ -167,12 +218,26 @@ func (ws *webServer) UpdateFacade(facade shared.FacadeHandler) error {
 
 func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 
+	isPublicReadMode := ws.apiConfig.PublicReadMode.Enabled
 	for groupName, groupHandler := range ws.groups {
+		_, isClosed := publicReadModeClosedGroups[groupName]
+		if isPublicReadMode && isClosed {
+			log.Debug("gin API group is closed in the public read mode", "group name", groupName)
+			continue
+		}
+
 		log.Debug("registering gin API group", "group name", groupName)
 		ginGroup := ginRouter.Group(fmt.Sprintf("/%s", groupName))
+		if groupName == adminGroupName && ws.adminAuth != nil {
+			ginGroup.Use(ws.adminAuth.MiddlewareHandlerFunc())
+		}
 		groupHandler.RegisterRoutes(ginGroup, ws.apiConfig)
 	}
 
+	if isPublicReadMode {
+		return
+	}
+
 	marshalizerForLogs := &marshal.GogoProtoMarshalizer{}
 	registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 
@@ -206,6 +271,36 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 	})
 }
 
+// createMessageCodes returns the middleware adding the machine codes and the localized messages to the error responses
+func (ws *webServer) createMessageCodes() (*messageCodes, error) {
+	catalog, err := messages.NewCatalog(messages.ArgsCatalog{
+		DefaultLanguage: messages.EnglishLanguage,
+		Languages:       messages.DefaultLanguages(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return newMessageCodes(catalog)
+}
+
+// createAdminAuth returns the middleware authenticating the admin requests, or nil if the authentication is disabled
+func (ws *webServer) createAdminAuth() (*adminAuth, error) {
+	adminAuthCfg := ws.apiConfig.AdminAuth
+	if !adminAuthCfg.Enabled {
+		return nil, nil
+	}
+
+	token := os.Getenv(adminAuthCfg.TokenEnvVariable)
+	if len(token) == 0 {
+		return nil, fmt.Errorf("%w, environment variable %s is not set", apiErrors.ErrEmptyAdminToken, adminAuthCfg.TokenEnvVariable)
+	}
+
+	log.Info("the admin routes of the REST API require authentication")
+
+	return newAdminAuth(token)
+}
+
 func (ws *webServer) createMiddlewareLimiters() ([]chainShared.MiddlewareProcessor, error) {
 	middlewares := make([]chainShared.MiddlewareProcessor, 0)
 
@@ -214,17 +309,27 @@ func (ws *webServer) createMiddlewareLimiters() ([]chainShared.MiddlewareProcess
 		middlewares = append(middlewares, responseLoggerMiddleware)
 	}
 
-	antiFloodLimiters, err := ws.createAntifloodLimiters()
+	var ctx context.Context
+	ctx, ws.cancelFunc = context.WithCancel(context.Background())
+
+	antiFloodLimiters, err := ws.createAntifloodLimiters(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	middlewares = append(middlewares, antiFloodLimiters...)
 
+	publicReadModeMiddlewares, err := ws.createPublicReadModeMiddlewares(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	middlewares = append(middlewares, publicReadModeMiddlewares...)
+
 	return middlewares, nil
 }
 
-func (ws *webServer) createAntifloodLimiters() ([]chainShared.MiddlewareProcessor, error) {
+func (ws *webServer) createAntifloodLimiters(ctx context.Context) ([]chainShared.MiddlewareProcessor, error) {
 	if !ws.antiFloodConfig.Enabled {
 		return make([]chainShared.MiddlewareProcessor, 0), nil
 	}
@@ -237,10 +342,7 @@ func (ws *webServer) createAntifloodLimiters() ([]chainShared.MiddlewareProcesso
 		return nil, err
 	}
 
-	var ctx context.Context
-	ctx, ws.cancelFunc = context.WithCancel(context.Background())
-
-	go ws.sourceLimiterReset(ctx, sourceLimiter)
+	go ws.sourceLimiterReset(ctx, sourceLimiter, wsAntifloodCfg.SameSourceResetIntervalInSec)
 
 	middlewares = append(middlewares, sourceLimiter)
 
@@ -254,8 +356,34 @@ func (ws *webServer) createAntifloodLimiters() ([]chainShared.MiddlewareProcesso
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.WebServer.SameSourceResetIntervalInSec)
+func (ws *webServer) createPublicReadModeMiddlewares(ctx context.Context) ([]chainShared.MiddlewareProcessor, error) {
+	publicReadModeCfg := ws.apiConfig.PublicReadMode
+	if !publicReadModeCfg.Enabled {
+		return make([]chainShared.MiddlewareProcessor, 0), nil
+	}
+
+	log.Info("the REST API is started in the public read mode")
+
+	sourceLimiter, err := middleware.NewSourceThrottler(publicReadModeCfg.SameSourceRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	go ws.sourceLimiterReset(ctx, sourceLimiter, publicReadModeCfg.SameSourceResetIntervalInSec)
+
+	cache, err := newResponseCache(
+		publicReadModeCfg.ResponseCacheCapacity,
+		time.Second*time.Duration(publicReadModeCfg.ResponseCacheExpirationInSec),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return []chainShared.MiddlewareProcessor{sourceLimiter, cache}, nil
+}
+
+func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler, resetIntervalInSec uint32) {
+	betweenResetDuration := time.Second * time.Duration(resetIntervalInSec)
 	timer := time.NewTimer(betweenResetDuration)
 	defer timer.Stop()
 
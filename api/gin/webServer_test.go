@@ -95,6 +95,18 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 		err := ws.StartHttpServer()
 		assert.Equal(t, middleware.ErrInvalidMaxNumRequests, err)
 	})
+	t.Run("createAdminAuth returns error due to the unset token environment variable", func(t *testing.T) {
+		args := createMockArgsNewWebServer()
+		args.ApiConfig.AdminAuth = config.AdminAuthConfig{
+			Enabled:          true,
+			TokenEnvVariable: "BRIDGE_TEST_UNSET_ADMIN_TOKEN",
+		}
+		ws, _ := NewWebServerHandler(args)
+		assert.False(t, check.IfNil(ws))
+
+		err := ws.StartHttpServer()
+		assert.True(t, errors.Is(err, apiErrors.ErrEmptyAdminToken))
+	})
 	t.Run("createMiddlewareLimiters returns error due to middleware.NewGlobalThrottler error", func(t *testing.T) {
 		args := createMockArgsNewWebServer()
 		args.AntiFloodConfig.WebServer = config.WebServerAntifloodConfig{
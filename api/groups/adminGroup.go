@@ -0,0 +1,502 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	loggersPath              = "/loggers"
+	logLevelPath             = "/loglevel"
+	exportedTransactionsPath = "/exported-transactions"
+	incidentsPath            = "/incidents"
+	acknowledgeIncidentPath  = "/incidents/acknowledge"
+	deadLettersPath          = "/dead-letters"
+	resolveDeadLetterPath    = "/dead-letters/resolve"
+	directMessagesPath       = "/direct-messages"
+	sendDirectMessagePath    = "/direct-messages/send"
+	halfBridgePausesPath     = "/pause"
+	pauseHalfBridgePath      = "/pause/:halfBridge"
+	resumeHalfBridgePath     = "/resume/:halfBridge"
+	configDriftPath          = "/config-drift"
+
+	halfBridgeParam = "halfBridge"
+)
+
+// setLoggerLevelRequest is the payload used to change the level of a logger, e.g.
+// {"identifier": "EthereumMultiversX-EthereumClient", "level": "TRACE"}
+type setLoggerLevelRequest struct {
+	Identifier string `json:"identifier"`
+	Level      string `json:"level"`
+}
+
+// acknowledgeIncidentRequest is the payload used to acknowledge an incident, e.g.
+// {"id": 1, "operator": "alice", "comment": "the pause was a planned upgrade"}
+type acknowledgeIncidentRequest struct {
+	ID       uint64 `json:"id"`
+	Operator string `json:"operator"`
+	Comment  string `json:"comment"`
+}
+
+// resolveDeadLetterRequest is the payload used to resolve a dead letter, e.g.
+// {"direction": "MultiversXToEthereum", "depositNonce": 37, "action": "ignore", "operator": "alice", "comment": "..."}
+type resolveDeadLetterRequest struct {
+	Direction    string `json:"direction"`
+	DepositNonce uint64 `json:"depositNonce"`
+	Action       string `json:"action"`
+	Operator     string `json:"operator"`
+	Comment      string `json:"comment"`
+}
+
+// sendDirectMessageRequest is the payload used to send a direct message to another relayer, e.g.
+// {"to": "erd1...", "kind": "veto", "text": "the recipient of the deposit 37 is sanctioned", "batchId": 12}
+type sendDirectMessageRequest struct {
+	To      string `json:"to"`
+	Kind    string `json:"kind"`
+	Text    string `json:"text"`
+	BatchID uint64 `json:"batchId"`
+}
+
+type adminGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewAdminGroup returns a new instance of adminGroup
+func NewAdminGroup(facade shared.FacadeHandler) (*adminGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for admin group", errors.ErrNilFacadeHandler)
+	}
+
+	ag := &adminGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    loggersPath,
+			Method:  http.MethodGet,
+			Handler: ag.loggers,
+		},
+		{
+			Path:    logLevelPath,
+			Method:  http.MethodPost,
+			Handler: ag.setLoggerLevel,
+		},
+		{
+			Path:    exportedTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.exportedTransactions,
+		},
+		{
+			Path:    incidentsPath,
+			Method:  http.MethodGet,
+			Handler: ag.incidents,
+		},
+		{
+			Path:    acknowledgeIncidentPath,
+			Method:  http.MethodPost,
+			Handler: ag.acknowledgeIncident,
+		},
+		{
+			Path:    deadLettersPath,
+			Method:  http.MethodGet,
+			Handler: ag.deadLetters,
+		},
+		{
+			Path:    resolveDeadLetterPath,
+			Method:  http.MethodPost,
+			Handler: ag.resolveDeadLetter,
+		},
+		{
+			Path:    directMessagesPath,
+			Method:  http.MethodGet,
+			Handler: ag.directMessages,
+		},
+		{
+			Path:    sendDirectMessagePath,
+			Method:  http.MethodPost,
+			Handler: ag.sendDirectMessage,
+		},
+		{
+			Path:    halfBridgePausesPath,
+			Method:  http.MethodGet,
+			Handler: ag.halfBridgePauses,
+		},
+		{
+			Path:    pauseHalfBridgePath,
+			Method:  http.MethodPost,
+			Handler: ag.pauseHalfBridge,
+		},
+		{
+			Path:    resumeHalfBridgePath,
+			Method:  http.MethodPost,
+			Handler: ag.resumeHalfBridge,
+		},
+		{
+			Path:    configDriftPath,
+			Method:  http.MethodGet,
+			Handler: ag.configDrift,
+		},
+	}
+	ag.endpoints = endpoints
+
+	return ag, nil
+}
+
+// loggers returns the logger identifiers and their current levels
+func (ag *adminGroup) loggers(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"loggers": ag.getFacade().GetLoggers()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// setLoggerLevel changes the level of the loggers created with the provided identifier
+func (ag *adminGroup) setLoggerLevel(c *gin.Context) {
+	request := &setLoggerLevelRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	err = ag.getFacade().SetLoggerLevel(request.Identifier, request.Level)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrSettingLoggerLevel.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	log.Info("logger level changed through the admin API", "identifier", request.Identifier, "level", request.Level)
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"identifier": request.Identifier, "level": request.Level},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// exportedTransactions returns the last signed Ethereum execution transactions exported, as raw hex, instead of being
+// broadcast
+func (ag *adminGroup) exportedTransactions(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"transactions": ag.getFacade().GetExportedTransactions()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// incidents returns the log of the incidents that halted the bridge processing, with their acknowledgments
+func (ag *adminGroup) incidents(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"incidents": ag.getFacade().GetIncidents()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// acknowledgeIncident records the operator's acknowledgment of an incident, together with the address the request
+// came from. The processing is resumed only after all the incidents are acknowledged
+func (ag *adminGroup) acknowledgeIncident(c *gin.Context) {
+	request := &acknowledgeIncidentRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	acknowledgment := core.IncidentAcknowledgment{
+		Operator:      request.Operator,
+		RemoteAddress: c.ClientIP(),
+		Comment:       request.Comment,
+	}
+	incident, err := ag.getFacade().AcknowledgeIncident(request.ID, acknowledgment)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrAcknowledgingIncident.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	log.Info("incident acknowledged through the admin API", "ID", request.ID,
+		"operator", request.Operator, "remote address", acknowledgment.RemoteAddress)
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"incident": incident},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// deadLetters returns the deposits that repeatedly failed to be validated or executed, with their resolutions
+func (ag *adminGroup) deadLetters(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"deadLetters": ag.getFacade().GetDeadLetters()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// resolveDeadLetter records the operator's resolution of a dead letter, together with the address the request came from
+func (ag *adminGroup) resolveDeadLetter(c *gin.Context) {
+	request := &resolveDeadLetterRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	resolution := core.DeadLetterResolution{
+		Action:        request.Action,
+		Operator:      request.Operator,
+		RemoteAddress: c.ClientIP(),
+		Comment:       request.Comment,
+	}
+	deadLetter, err := ag.getFacade().ResolveDeadLetter(request.Direction, request.DepositNonce, resolution)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrResolvingDeadLetter.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	log.Info("dead letter resolved through the admin API", "direction", request.Direction,
+		"deposit nonce", request.DepositNonce, "action", request.Action, "operator", request.Operator,
+		"remote address", resolution.RemoteAddress)
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"deadLetter": deadLetter},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// directMessages returns the last direct messages sent to and received from the other relayers
+func (ag *adminGroup) directMessages(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"directMessages": ag.getFacade().GetDirectMessages()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// sendDirectMessage sends a coordination message to another relayer, outside the broadcast topics
+func (ag *adminGroup) sendDirectMessage(c *gin.Context) {
+	request := &sendDirectMessageRequest{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	message, err := ag.getFacade().SendDirectMessage(request.To, request.Kind, request.Text, request.BatchID)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrSendingDirectMessage.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	log.Info("direct message sent through the admin API", "to", request.To, "kind", request.Kind,
+		"batch ID", request.BatchID, "remote address", c.ClientIP())
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"directMessage": message},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// halfBridgePauses returns the operator pause state of each half-bridge
+func (ag *adminGroup) halfBridgePauses(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"pauses": ag.getFacade().GetHalfBridgePauses()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// pauseHalfBridge suspends the processing of a half-bridge, ethToMvx or mvxToEth, at the next step boundary, so the
+// relayer stops signing on that direction without stopping the process
+func (ag *adminGroup) pauseHalfBridge(c *gin.Context) {
+	ag.setHalfBridgePause(c, ag.getFacade().PauseHalfBridge, "half-bridge paused through the admin API")
+}
+
+// resumeHalfBridge resumes the processing of a half-bridge paused by an operator
+func (ag *adminGroup) resumeHalfBridge(c *gin.Context) {
+	ag.setHalfBridgePause(c, ag.getFacade().ResumeHalfBridge, "half-bridge resumed through the admin API")
+}
+
+func (ag *adminGroup) setHalfBridgePause(
+	c *gin.Context,
+	handler func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error),
+	message string,
+) {
+	halfBridge := c.Param(halfBridgeParam)
+	state, err := handler(halfBridge, c.ClientIP())
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrChangingHalfBridgePause.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	log.Info(message, "half-bridge", halfBridge, "remote address", c.ClientIP())
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"pause": state},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// configDrift returns the values the relayer is running with that differ from the config file, e.g. the changes
+// done at runtime and not persisted or the config file changes needing a restart, and the loggers having the level
+// changed through the admin API
+func (ag *adminGroup) configDrift(c *gin.Context) {
+	report, err := ag.getFacade().GetConfigDrift()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingConfigDrift.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"report": report},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (ag *adminGroup) getFacade() shared.FacadeHandler {
+	ag.mutFacade.RLock()
+	defer ag.mutFacade.RUnlock()
+
+	return ag.facade
+}
+
+// UpdateFacade will update the facade
+func (ag *adminGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	ag.mutFacade.Lock()
+	ag.facade = newFacade
+	ag.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *adminGroup) IsInterfaceNil() bool {
+	return ag == nil
+}
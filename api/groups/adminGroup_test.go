@@ -0,0 +1,695 @@
+package groups
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testLoggerIdentifier = "EthereumMultiversX-EthereumClient"
+
+func getAdminRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"admin": {
+				Routes: []config.RouteConfig{
+					{Name: "/loggers", Open: true},
+					{Name: "/loglevel", Open: true},
+					{Name: "/exported-transactions", Open: true},
+					{Name: "/incidents", Open: true},
+					{Name: "/incidents/acknowledge", Open: true},
+					{Name: "/dead-letters", Open: true},
+					{Name: "/dead-letters/resolve", Open: true},
+					{Name: "/direct-messages", Open: true},
+					{Name: "/direct-messages/send", Open: true},
+					{Name: "/pause", Open: true},
+					{Name: "/pause/:halfBridge", Open: true},
+					{Name: "/resume/:halfBridge", Open: true},
+					{Name: "/config-drift", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewAdminGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		ag, err := NewAdminGroup(nil)
+
+		assert.True(t, check.IfNil(ag))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		ag, err := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(ag))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAdminGroup_Loggers(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetLoggersCalled: func() []core.LoggerInfo {
+			return []core.LoggerInfo{{Identifier: testLoggerIdentifier, Level: "INFO"}}
+		},
+	}
+	ag, _ := NewAdminGroup(facade)
+	ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/loggers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"loggers":[{"identifier":"` + testLoggerIdentifier + `","level":"INFO"}]},` +
+		`"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestAdminGroup_ExportedTransactions(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetExportedTransactionsCalled: func() []*core.ExportedTransaction {
+			return []*core.ExportedTransaction{
+				{BatchID: 3, Nonce: 7, TxHash: "0xhash", RawTransaction: "0xraw", Timestamp: 1700000000},
+			}
+		},
+	}
+	ag, _ := NewAdminGroup(facade)
+	ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/exported-transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"transactions":[{"batchId":3,"nonce":7,"txHash":"0xhash","rawTransaction":"0xraw",` +
+		`"timestamp":1700000000}]},"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestAdminGroup_SetLoggerLevel(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/loglevel", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			SetLoggerLevelCalled: func(identifier string, level string) error {
+				return expectedErr
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"identifier":"` + testLoggerIdentifier + `","level":"TRACE"}`
+		req, _ := http.NewRequest("POST", "/admin/loglevel", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrSettingLoggerLevel.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		setLevelCalled := false
+		facade := &mockFacade.RelayerFacadeStub{
+			SetLoggerLevelCalled: func(identifier string, level string) error {
+				setLevelCalled = true
+				assert.Equal(t, testLoggerIdentifier, identifier)
+				assert.Equal(t, "TRACE", level)
+				return nil
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"identifier":"` + testLoggerIdentifier + `","level":"TRACE"}`
+		req, _ := http.NewRequest("POST", "/admin/loglevel", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, setLevelCalled)
+	})
+	t.Run("closed route should not be registered", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ag, "admin", config.ApiRoutesConfig{})
+
+		req, _ := http.NewRequest("POST", "/admin/loglevel", bytes.NewBufferString("{}"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+	t.Run("non GET route should not be registered in the public read mode", func(t *testing.T) {
+		t.Parallel()
+
+		apiConfig := getAdminRoutesConfig()
+		apiConfig.PublicReadMode.Enabled = true
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ag, "admin", apiConfig)
+
+		req, _ := http.NewRequest("POST", "/admin/loglevel", bytes.NewBufferString("{}"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+	})
+}
+
+func TestAdminGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := ag.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := ag.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, ag.facade == newFacade) // pointer testing
+	})
+}
+
+func TestAdminGroup_Incidents(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetIncidentsCalled: func() []*core.Incident {
+			return []*core.Incident{
+				{
+					ID:             1,
+					Source:         "GovernancePause",
+					Description:    "governance pause observed on Ethereum",
+					RaisedAt:       1700000000,
+					LastRaisedAt:   1700000000,
+					NumOccurrences: 1,
+				},
+			}
+		},
+	}
+	ag, _ := NewAdminGroup(facade)
+	ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/incidents", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"incidents":[{"id":1,"source":"GovernancePause",` +
+		`"description":"governance pause observed on Ethereum","raisedAt":1700000000,"lastRaisedAt":1700000000,` +
+		`"numOccurrences":1}]},"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestAdminGroup_AcknowledgeIncident(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/incidents/acknowledge", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			AcknowledgeIncidentCalled: func(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+				return nil, expectedErr
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/incidents/acknowledge", bytes.NewBufferString(`{"id":1,"operator":"alice"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrAcknowledgingIncident.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			AcknowledgeIncidentCalled: func(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+				assert.Equal(t, uint64(1), id)
+				assert.Equal(t, "alice", acknowledgment.Operator)
+				assert.Equal(t, "planned upgrade", acknowledgment.Comment)
+				assert.Equal(t, "10.0.0.1", acknowledgment.RemoteAddress)
+
+				acknowledgment.Timestamp = 1700000020
+				return &core.Incident{
+					ID:             id,
+					Source:         "GovernancePause",
+					Description:    "governance pause observed on Ethereum",
+					RaisedAt:       1700000000,
+					LastRaisedAt:   1700000000,
+					NumOccurrences: 1,
+					Acknowledgment: &acknowledgment,
+				}, nil
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"id":1,"operator":"alice","comment":"planned upgrade"}`
+		req, _ := http.NewRequest("POST", "/admin/incidents/acknowledge", bytes.NewBufferString(body))
+		req.RemoteAddr = "10.0.0.1:12345"
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"incident":{"id":1,"source":"GovernancePause",` +
+			`"description":"governance pause observed on Ethereum","raisedAt":1700000000,"lastRaisedAt":1700000000,` +
+			`"numOccurrences":1,"acknowledgment":{"operator":"alice","remoteAddress":"10.0.0.1",` +
+			`"comment":"planned upgrade","timestamp":1700000020}}},"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestAdminGroup_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetDeadLettersCalled: func() []*core.DeadLetter {
+			return []*core.DeadLetter{
+				{
+					Direction:     "MultiversXToEthereum",
+					BatchID:       1,
+					DepositNonce:  37,
+					Token:         "WEGLD-bd4d79",
+					Recipient:     "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+					Amount:        "1000",
+					Reason:        "unmapped token",
+					NumFailures:   20,
+					FirstFailedAt: 1700000000,
+					LastFailedAt:  1700000100,
+				},
+			}
+		},
+	}
+	ag, _ := NewAdminGroup(facade)
+	ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/dead-letters", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"deadLetters":[{"direction":"MultiversXToEthereum","batchId":1,"depositNonce":37,` +
+		`"token":"WEGLD-bd4d79","recipient":"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c","amount":"1000",` +
+		`"reason":"unmapped token","numFailures":20,"firstFailedAt":1700000000,"lastFailedAt":1700000100}]},` +
+		`"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestAdminGroup_ResolveDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/dead-letters/resolve", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			ResolveDeadLetterCalled: func(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+				return nil, expectedErr
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"direction":"EthereumToMultiversX","depositNonce":37,"action":"ignore","operator":"alice"}`
+		req, _ := http.NewRequest("POST", "/admin/dead-letters/resolve", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrResolvingDeadLetter.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			ResolveDeadLetterCalled: func(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+				assert.Equal(t, "MultiversXToEthereum", direction)
+				assert.Equal(t, uint64(37), depositNonce)
+				assert.Equal(t, core.DeadLetterIgnore, resolution.Action)
+				assert.Equal(t, "alice", resolution.Operator)
+				assert.Equal(t, "unmapped token", resolution.Comment)
+				assert.Equal(t, "10.0.0.1", resolution.RemoteAddress)
+
+				resolution.Timestamp = 1700000200
+				return &core.DeadLetter{
+					Direction:     direction,
+					BatchID:       1,
+					DepositNonce:  depositNonce,
+					Reason:        "unmapped token",
+					NumFailures:   20,
+					FirstFailedAt: 1700000000,
+					LastFailedAt:  1700000100,
+					Resolution:    &resolution,
+				}, nil
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"direction":"MultiversXToEthereum","depositNonce":37,"action":"ignore","operator":"alice","comment":"unmapped token"}`
+		req, _ := http.NewRequest("POST", "/admin/dead-letters/resolve", bytes.NewBufferString(body))
+		req.RemoteAddr = "10.0.0.1:12345"
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"deadLetter":{"direction":"MultiversXToEthereum","batchId":1,"depositNonce":37,` +
+			`"token":"","recipient":"","amount":"","reason":"unmapped token","numFailures":20,` +
+			`"firstFailedAt":1700000000,"lastFailedAt":1700000100,"resolution":{"action":"ignore","operator":"alice",` +
+			`"remoteAddress":"10.0.0.1","comment":"unmapped token","timestamp":1700000200}}},"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestAdminGroup_DirectMessages(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetDirectMessagesCalled: func() []*core.DirectMessage {
+			return []*core.DirectMessage{
+				{
+					Kind:      core.DirectMessageMaintenance,
+					Text:      "restarting in 5 minutes",
+					From:      "erd1from",
+					To:        "erd1to",
+					Direction: core.DirectMessageReceived,
+					Timestamp: 1700000000,
+				},
+			}
+		},
+	}
+	ag, _ := NewAdminGroup(facade)
+	ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/direct-messages", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"directMessages":[{"kind":"maintenance","text":"restarting in 5 minutes",` +
+		`"from":"erd1from","to":"erd1to","direction":"received","timestamp":1700000000}]},"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestAdminGroup_SendDirectMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, _ := NewAdminGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/direct-messages/send", bytes.NewBufferString("not a json"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			SendDirectMessageCalled: func(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+				return nil, expectedErr
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"to":"erd1to","kind":"maintenance","text":"restarting"}`
+		req, _ := http.NewRequest("POST", "/admin/direct-messages/send", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrSendingDirectMessage.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			SendDirectMessageCalled: func(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+				assert.Equal(t, "erd1to", to)
+				assert.Equal(t, core.DirectMessageVeto, kind)
+				assert.Equal(t, "blacklisted recipient", text)
+				assert.Equal(t, uint64(12), batchID)
+
+				return &core.DirectMessage{
+					Kind:      kind,
+					Text:      text,
+					BatchID:   batchID,
+					From:      "erd1from",
+					To:        to,
+					Direction: core.DirectMessageSent,
+					Timestamp: 1700000000,
+				}, nil
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		body := `{"to":"erd1to","kind":"veto","text":"blacklisted recipient","batchId":12}`
+		req, _ := http.NewRequest("POST", "/admin/direct-messages/send", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"directMessage":{"kind":"veto","text":"blacklisted recipient","batchId":12,` +
+			`"from":"erd1from","to":"erd1to","direction":"sent","timestamp":1700000000}},"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestAdminGroup_HalfBridgePauses(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetHalfBridgePausesCalled: func() []*core.HalfBridgePause {
+			return []*core.HalfBridgePause{
+				{HalfBridge: core.HalfBridgeEthToMvx, Paused: true, RemoteAddress: "10.0.0.1", Timestamp: 1700000000},
+				{HalfBridge: core.HalfBridgeMvxToEth},
+			}
+		},
+	}
+	ag, _ := NewAdminGroup(facade)
+	ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/pause", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"pauses":[{"halfBridge":"ethToMvx","paused":true,"remoteAddress":"10.0.0.1",` +
+		`"timestamp":1700000000},{"halfBridge":"mvxToEth","paused":false}]},"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestAdminGroup_PauseAndResumeHalfBridge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			PauseHalfBridgeCalled: func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+				return nil, expectedErr
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/pause/unknown", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrChangingHalfBridgePause.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should pause and resume", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			PauseHalfBridgeCalled: func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+				assert.Equal(t, core.HalfBridgeMvxToEth, halfBridge)
+				return &core.HalfBridgePause{HalfBridge: halfBridge, Paused: true, RemoteAddress: "10.0.0.1", Timestamp: 1700000000}, nil
+			},
+			ResumeHalfBridgeCalled: func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+				assert.Equal(t, core.HalfBridgeEthToMvx, halfBridge)
+				return &core.HalfBridgePause{HalfBridge: halfBridge, RemoteAddress: "10.0.0.1", Timestamp: 1700000100}, nil
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/admin/pause/mvxToEth", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"pause":{"halfBridge":"mvxToEth","paused":true,"remoteAddress":"10.0.0.1",` +
+			`"timestamp":1700000000}},"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+
+		req, _ = http.NewRequest("POST", "/admin/resume/ethToMvx", nil)
+		resp = httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody = `{"data":{"pause":{"halfBridge":"ethToMvx","paused":false,"remoteAddress":"10.0.0.1",` +
+			`"timestamp":1700000100}},"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestAdminGroup_ConfigDrift(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			GetConfigDriftCalled: func() (*core.ConfigDriftReport, error) {
+				return nil, expectedErr
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/admin/config-drift", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrGettingConfigDrift.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetConfigDriftCalled: func() (*core.ConfigDriftReport, error) {
+				return &core.ConfigDriftReport{
+					ConfigFile: "config.toml",
+					Drifts: []*core.ConfigDrift{
+						{Path: "Eth.GasStation.MaximumAllowedGasPrice", RunningValue: "500", FileValue: "300"},
+					},
+					ChangedLoggers: []core.LoggerInfo{{Identifier: testLoggerIdentifier, Level: "TRACE"}},
+				}, nil
+			},
+		}
+		ag, _ := NewAdminGroup(facade)
+		ws := startWebServer(ag, "admin", getAdminRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/admin/config-drift", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"report":{"configFile":"config.toml","drifts":[{` +
+			`"path":"Eth.GasStation.MaximumAllowedGasPrice","runningValue":"500","fileValue":"300"}],` +
+			`"changedLoggers":[{"identifier":"EthereumMultiversX-EthereumClient","level":"TRACE"}]}},` +
+			`"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
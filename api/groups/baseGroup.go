@@ -1,6 +1,7 @@
 package groups
 
 import (
+	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
@@ -36,6 +37,10 @@ func (bg *baseGroup) RegisterRoutes(
 			log.Debug("endpoint is closed", "path", handlerData.Path)
 			continue
 		}
+		if apiConfig.PublicReadMode.Enabled && handlerData.Method != http.MethodGet {
+			log.Debug("endpoint is closed in the public read mode", "path", handlerData.Path)
+			continue
+		}
 
 		ws.Handle(handlerData.Method, handlerData.Path, handlerData.Handler)
 	}
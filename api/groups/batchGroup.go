@@ -0,0 +1,156 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	batchIDParam         = "id"
+	directionParam       = "direction"
+	batchResultsPath     = "/results/:" + batchIDParam
+	transferReceiptsPath = "/receipts/:" + directionParam + "/:" + batchIDParam
+)
+
+type batchGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewBatchGroup returns a new instance of batchGroup
+func NewBatchGroup(facade shared.FacadeHandler) (*batchGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for batch group", errors.ErrNilFacadeHandler)
+	}
+
+	bg := &batchGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    batchResultsPath,
+			Method:  http.MethodGet,
+			Handler: bg.batchResults,
+		},
+		{
+			Path:    transferReceiptsPath,
+			Method:  http.MethodGet,
+			Handler: bg.transferReceipts,
+		},
+	}
+	bg.endpoints = endpoints
+
+	return bg, nil
+}
+
+// batchResults returns the per-deposit results of an executed batch, including the reasons of the rejected deposits
+func (bg *batchGroup) batchResults(c *gin.Context) {
+	batchID, err := strconv.ParseUint(c.Param(batchIDParam), 10, 64)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	results, err := bg.getFacade().GetBatchResults(batchID)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingBatchResults.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"results": results},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// transferReceipts returns the signed receipts of the deposits of an executed batch, for the provided direction
+func (bg *batchGroup) transferReceipts(c *gin.Context) {
+	batchID, err := strconv.ParseUint(c.Param(batchIDParam), 10, 64)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	receipts, err := bg.getFacade().GetTransferReceipts(c.Param(directionParam), batchID)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingTransferReceipts.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"receipts": receipts},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (bg *batchGroup) getFacade() shared.FacadeHandler {
+	bg.mutFacade.RLock()
+	defer bg.mutFacade.RUnlock()
+
+	return bg.facade
+}
+
+// UpdateFacade will update the facade
+func (bg *batchGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	bg.mutFacade.Lock()
+	bg.facade = newFacade
+	bg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bg *batchGroup) IsInterfaceNil() bool {
+	return bg == nil
+}
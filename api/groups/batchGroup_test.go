@@ -0,0 +1,224 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getBatchRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"batch": {
+				Routes: []config.RouteConfig{
+					{Name: "/results/:id", Open: true},
+					{Name: "/receipts/:direction/:id", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewBatchGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		bg, err := NewBatchGroup(nil)
+
+		assert.True(t, check.IfNil(bg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		bg, err := NewBatchGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(bg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchGroup_BatchResults(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid batch ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := NewBatchGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(bg, "batch", getBatchRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/batch/results/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			GetBatchResultsCalled: func(batchID uint64) (*core.BatchResults, error) {
+				return nil, expectedErr
+			},
+		}
+		bg, _ := NewBatchGroup(facade)
+		ws := startWebServer(bg, "batch", getBatchRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/batch/results/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrGettingBatchResults.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetBatchResultsCalled: func(batchID uint64) (*core.BatchResults, error) {
+				assert.Equal(t, uint64(3), batchID)
+				return &core.BatchResults{
+					BatchID: 3,
+					TxHash:  "hash",
+					Deposits: []*core.DepositResult{
+						{Nonce: 1, Status: core.DepositExecuted},
+						{Nonce: 2, Status: core.DepositRejected, Reason: "invalid destination"},
+					},
+				}, nil
+			},
+		}
+		bg, _ := NewBatchGroup(facade)
+		ws := startWebServer(bg, "batch", getBatchRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/batch/results/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"results":{"batchId":3,"txHash":"hash","deposits":[` +
+			`{"nonce":1,"status":"executed"},{"nonce":2,"status":"rejected","reason":"invalid destination"}]}},` +
+			`"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestBatchGroup_TransferReceipts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid batch ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := NewBatchGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(bg, "batch", getBatchRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/batch/receipts/MultiversXToEthereum/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			GetTransferReceiptsCalled: func(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+				return nil, expectedErr
+			},
+		}
+		bg, _ := NewBatchGroup(facade)
+		ws := startWebServer(bg, "batch", getBatchRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/batch/receipts/MultiversXToEthereum/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrGettingTransferReceipts.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetTransferReceiptsCalled: func(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+				assert.Equal(t, "MultiversXToEthereum", direction)
+				assert.Equal(t, uint64(3), batchID)
+				return []*core.SignedTransferReceipt{
+					{
+						Receipt: &core.TransferReceipt{
+							Direction:         "MultiversXToEthereum",
+							BatchID:           3,
+							DepositNonce:      1,
+							SourceTxHash:      "source hash",
+							DestinationTxHash: "destination hash",
+							From:              "erd1sender",
+							To:                "0xrecipient",
+							Token:             "USDC-abcdef",
+							Amount:            "1000",
+							Fee:               "50",
+							Status:            core.DepositExecuted,
+							Timestamp:         1000,
+						},
+						Relayer:   "erd1relayer",
+						PublicKey: "public key",
+						Signature: "signature",
+					},
+				}, nil
+			},
+		}
+		bg, _ := NewBatchGroup(facade)
+		ws := startWebServer(bg, "batch", getBatchRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/batch/receipts/MultiversXToEthereum/3", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"receipts":[{"receipt":{"direction":"MultiversXToEthereum","batchId":3,"depositNonce":1,` +
+			`"sourceTxHash":"source hash","destinationTxHash":"destination hash","from":"erd1sender","to":"0xrecipient",` +
+			`"token":"USDC-abcdef","amount":"1000","fee":"50","status":"executed","timestamp":1000},` +
+			`"relayer":"erd1relayer","publicKey":"public key","signature":"signature"}]},"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestBatchGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		bg, _ := NewBatchGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := bg.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		bg, _ := NewBatchGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := bg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, bg.facade == newFacade) // pointer testing
+	})
+}
@@ -0,0 +1,156 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	depositNonceParam   = "nonce"
+	pendingBatchesPath  = "/batches/pending"
+	batchLiveViewPath   = "/batches/:" + batchIDParam
+	depositLiveViewPath = "/deposits/:" + depositNonceParam
+)
+
+type bridgeGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewBridgeGroup returns a new instance of bridgeGroup
+func NewBridgeGroup(facade shared.FacadeHandler) (*bridgeGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for bridge group", errors.ErrNilFacadeHandler)
+	}
+
+	bg := &bridgeGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    pendingBatchesPath,
+			Method:  http.MethodGet,
+			Handler: bg.pendingBatches,
+		},
+		{
+			Path:    batchLiveViewPath,
+			Method:  http.MethodGet,
+			Handler: bg.batchLiveView,
+		},
+		{
+			Path:    depositLiveViewPath,
+			Method:  http.MethodGet,
+			Handler: bg.depositLiveView,
+		},
+	}
+	bg.endpoints = endpoints
+
+	return bg, nil
+}
+
+// pendingBatches returns the live view of the batches currently processed by the relayer, one for each half-bridge
+func (bg *bridgeGroup) pendingBatches(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"batches": bg.getFacade().GetPendingBatches()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// batchLiveView returns the live view of the processed batches with the provided ID
+func (bg *bridgeGroup) batchLiveView(c *gin.Context) {
+	batchID, err := strconv.ParseUint(c.Param(batchIDParam), 10, 64)
+	if err != nil {
+		returnBadRequest(c, err)
+		return
+	}
+
+	views := bg.getFacade().GetBatchLiveViews(batchID)
+	returnLiveViews(c, views, ErrBatchNotFound)
+}
+
+// depositLiveView returns the live view of the processed batches holding the deposit with the provided nonce
+func (bg *bridgeGroup) depositLiveView(c *gin.Context) {
+	nonce, err := strconv.ParseUint(c.Param(depositNonceParam), 10, 64)
+	if err != nil {
+		returnBadRequest(c, err)
+		return
+	}
+
+	views := bg.getFacade().GetDepositLiveViews(nonce)
+	returnLiveViews(c, views, ErrDepositNotFound)
+}
+
+func returnBadRequest(c *gin.Context, err error) {
+	c.JSON(
+		http.StatusBadRequest,
+		chainAPIShared.GenericAPIResponse{
+			Data:  nil,
+			Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			Code:  chainAPIShared.ReturnCodeRequestError,
+		},
+	)
+}
+
+func returnLiveViews(c *gin.Context, views []*core.BatchLiveView, notFoundErr error) {
+	if len(views) == 0 {
+		c.JSON(
+			http.StatusNotFound,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: notFoundErr.Error(),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"batches": views},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (bg *bridgeGroup) getFacade() shared.FacadeHandler {
+	bg.mutFacade.RLock()
+	defer bg.mutFacade.RUnlock()
+
+	return bg.facade
+}
+
+// UpdateFacade will update the facade
+func (bg *bridgeGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	bg.mutFacade.Lock()
+	bg.facade = newFacade
+	bg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bg *bridgeGroup) IsInterfaceNil() bool {
+	return bg == nil
+}
@@ -0,0 +1,204 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getBridgeRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"bridge": {
+				Routes: []config.RouteConfig{
+					{Name: "/batches/pending", Open: true},
+					{Name: "/batches/:id", Open: true},
+					{Name: "/deposits/:nonce", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func createLiveViews() []*core.BatchLiveView {
+	return []*core.BatchLiveView{
+		{
+			Direction:     "MultiversXToEthereum",
+			BatchID:       12,
+			Step:          "WaitingForQuorum",
+			ActionID:      3,
+			MessageHash:   "0x0102",
+			NumSignatures: 2,
+			Deposits: []*core.DepositLiveView{
+				{
+					Nonce:  74,
+					From:   "erd1sender",
+					To:     "0xrecipient",
+					Token:  "USDC-abcdef",
+					Amount: "1000",
+					Status: core.DepositPending,
+				},
+			},
+			Timestamp: 1000,
+		},
+	}
+}
+
+const expectedLiveViewsBody = `{"data":{"batches":[{"direction":"MultiversXToEthereum","batchId":12,` +
+	`"step":"WaitingForQuorum","actionId":3,"messageHash":"0x0102","numSignatures":2,"deposits":[{"nonce":74,` +
+	`"from":"erd1sender","to":"0xrecipient","token":"USDC-abcdef","amount":"1000","status":"pending"}],` +
+	`"timestamp":1000}]},"error":"","code":"successful"}`
+
+func TestNewBridgeGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		bg, err := NewBridgeGroup(nil)
+
+		assert.True(t, check.IfNil(bg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		bg, err := NewBridgeGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(bg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBridgeGroup_PendingBatches(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetPendingBatchesCalled: func() []*core.BatchLiveView {
+			return createLiveViews()
+		},
+	}
+	bg, _ := NewBridgeGroup(facade)
+	ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/bridge/batches/pending", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.JSONEq(t, expectedLiveViewsBody, resp.Body.String())
+}
+
+func TestBridgeGroup_BatchLiveView(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid batch ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := NewBridgeGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/bridge/batches/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("unknown batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := NewBridgeGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/bridge/batches/12", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, ErrBatchNotFound.Error(), rsp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetBatchLiveViewsCalled: func(batchID uint64) []*core.BatchLiveView {
+				assert.Equal(t, uint64(12), batchID)
+				return createLiveViews()
+			},
+		}
+		bg, _ := NewBridgeGroup(facade)
+		ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/bridge/batches/12", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, expectedLiveViewsBody, resp.Body.String())
+	})
+}
+
+func TestBridgeGroup_DepositLiveView(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := NewBridgeGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/bridge/deposits/abc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("unknown deposit should error", func(t *testing.T) {
+		t.Parallel()
+
+		bg, _ := NewBridgeGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/bridge/deposits/74", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, ErrDepositNotFound.Error(), rsp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetDepositLiveViewsCalled: func(nonce uint64) []*core.BatchLiveView {
+				assert.Equal(t, uint64(74), nonce)
+				return createLiveViews()
+			},
+		}
+		bg, _ := NewBridgeGroup(facade)
+		ws := startWebServer(bg, "bridge", getBridgeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/bridge/deposits/74", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.JSONEq(t, expectedLiveViewsBody, resp.Body.String())
+	})
+}
@@ -0,0 +1,109 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const relayClaimPath = "/relay"
+
+type claimsGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewClaimsGroup returns a new instance of claimsGroup
+func NewClaimsGroup(facade shared.FacadeHandler) (*claimsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for claims group", errors.ErrNilFacadeHandler)
+	}
+
+	cg := &claimsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    relayClaimPath,
+			Method:  http.MethodPost,
+			Handler: cg.relayClaim,
+		},
+	}
+	cg.endpoints = endpoints
+
+	return cg, nil
+}
+
+// relayClaim relays the claim transaction signed by a user, the gas being paid by the sponsor account
+func (cg *claimsGroup) relayClaim(c *gin.Context) {
+	claimTx := &transaction.FrontendTransaction{}
+	err := c.ShouldBindJSON(claimTx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	hash, err := cg.getFacade().SubmitRelayedClaim(c.Request.Context(), claimTx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrRelayingClaim.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"txHash": hash},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (cg *claimsGroup) getFacade() shared.FacadeHandler {
+	cg.mutFacade.RLock()
+	defer cg.mutFacade.RUnlock()
+
+	return cg.facade
+}
+
+// UpdateFacade will update the facade
+func (cg *claimsGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	cg.mutFacade.Lock()
+	cg.facade = newFacade
+	cg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cg *claimsGroup) IsInterfaceNil() bool {
+	return cg == nil
+}
@@ -0,0 +1,114 @@
+package groups
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getClaimsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"claims": {
+				Routes: []config.RouteConfig{
+					{Name: "/relay", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewClaimsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		cg, err := NewClaimsGroup(nil)
+
+		assert.True(t, check.IfNil(cg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		cg, err := NewClaimsGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(cg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestClaimsGroup_RelayClaim(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		cg, _ := NewClaimsGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(cg, "claims", getClaimsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/claims/relay", bytes.NewBufferString("not a transaction"))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			SubmitRelayedClaimCalled: func(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+				return "", expectedErr
+			},
+		}
+		cg, _ := NewClaimsGroup(facade)
+		ws := startWebServer(cg, "claims", getClaimsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/claims/relay", bytes.NewBufferString(`{"nonce": 1}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrRelayingClaim.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			SubmitRelayedClaimCalled: func(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+				assert.Equal(t, uint64(7), claimTx.Nonce)
+				assert.Equal(t, "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", claimTx.Sender)
+				return "hash", nil
+			},
+		}
+		cg, _ := NewClaimsGroup(facade)
+		ws := startWebServer(cg, "claims", getClaimsRoutesConfig())
+
+		body := `{"nonce": 7, "sender": "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"}`
+		req, _ := http.NewRequest("POST", "/claims/relay", bytes.NewBufferString(body))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, rsp.Error)
+		assert.Equal(t, map[string]interface{}{"txHash": "hash"}, rsp.Data)
+	})
+}
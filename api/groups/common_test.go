@@ -35,6 +35,13 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/status", Open: true},
 					{Name: "/status/list", Open: true},
+					{Name: "/appstatus", Open: true},
+					{Name: "/metrics", Open: true},
+					{Name: "/about", Open: true},
+					{Name: "/topology", Open: true},
+					{Name: "/syncreport", Open: true},
+					{Name: "/balanceproof", Open: true},
+					{Name: "/feeestimate", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
 				},
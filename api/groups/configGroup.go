@@ -0,0 +1,81 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const schemaPath = "/schema"
+
+type configGroup struct {
+	*baseGroup
+	facade    shared.FacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewConfigGroup returns a new instance of configGroup
+func NewConfigGroup(facade shared.FacadeHandler) (*configGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for config group", errors.ErrNilFacadeHandler)
+	}
+
+	cg := &configGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    schemaPath,
+			Method:  http.MethodGet,
+			Handler: cg.getSchema,
+		},
+	}
+	cg.endpoints = endpoints
+
+	return cg, nil
+}
+
+// getSchema returns the JSON Schema of the config file
+func (cg *configGroup) getSchema(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"schema": cg.getFacade().GetConfigSchema()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (cg *configGroup) getFacade() shared.FacadeHandler {
+	cg.mutFacade.RLock()
+	defer cg.mutFacade.RUnlock()
+
+	return cg.facade
+}
+
+// UpdateFacade will update the facade
+func (cg *configGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+
+	cg.mutFacade.Lock()
+	cg.facade = newFacade
+	cg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cg *configGroup) IsInterfaceNil() bool {
+	return cg == nil
+}
@@ -0,0 +1,75 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getConfigRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"config": {
+				Routes: []config.RouteConfig{
+					{Name: "/schema", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewConfigGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		cg, err := NewConfigGroup(nil)
+
+		assert.True(t, check.IfNil(cg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		cg, err := NewConfigGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(cg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestConfigGroup_GetSchema(t *testing.T) {
+	t.Parallel()
+
+	configSchema, err := schema.Generate(config.WebServerAntifloodConfig{})
+	require.Nil(t, err)
+	facade := &mockFacade.RelayerFacadeStub{
+		GetConfigSchemaCalled: func() *schema.Schema {
+			return configSchema
+		},
+	}
+	cg, _ := NewConfigGroup(facade)
+	ws := startWebServer(cg, "config", getConfigRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/config/schema", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	rsp := generalResponse{}
+	loadResponse(resp.Body, &rsp)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, rsp.Error)
+
+	data := rsp.Data.(map[string]interface{})
+	returnedSchema := data["schema"].(map[string]interface{})
+	assert.Equal(t, schema.Draft, returnedSchema["$schema"])
+	assert.Equal(t, "WebServerAntifloodConfig", returnedSchema["title"])
+	assert.Equal(t, false, returnedSchema["additionalProperties"])
+	assert.NotEmpty(t, returnedSchema["properties"])
+}
@@ -4,3 +4,42 @@ import "errors"
 
 // ErrGettingMetrics signals that an error occurred while getting the metrics
 var ErrGettingMetrics = errors.New("error getting metrics")
+
+// ErrSettingLoggerLevel signals that an error occurred while setting the level of a logger
+var ErrSettingLoggerLevel = errors.New("error setting the logger level")
+
+// ErrGettingBatchResults signals that an error occurred while getting the results of a batch
+var ErrGettingBatchResults = errors.New("error getting the batch results")
+
+// ErrGettingTransferReceipts signals that an error occurred while getting the transfer receipts of a batch
+var ErrGettingTransferReceipts = errors.New("error getting the transfer receipts")
+
+// ErrRelayingClaim signals that an error occurred while relaying a user claim
+var ErrRelayingClaim = errors.New("error relaying the claim")
+
+// ErrGettingBalanceProof signals that an error occurred while getting the balance proof
+var ErrGettingBalanceProof = errors.New("error getting the balance proof")
+
+// ErrEstimatingFee signals that an error occurred while estimating the fee of a deposit
+var ErrEstimatingFee = errors.New("error estimating the fee")
+
+// ErrAcknowledgingIncident signals that an error occurred while acknowledging an incident
+var ErrAcknowledgingIncident = errors.New("error acknowledging the incident")
+
+// ErrResolvingDeadLetter signals that an error occurred while resolving a dead letter
+var ErrResolvingDeadLetter = errors.New("error resolving the dead letter")
+
+// ErrSendingDirectMessage signals that an error occurred while sending a direct message to another relayer
+var ErrSendingDirectMessage = errors.New("error sending the direct message")
+
+// ErrChangingHalfBridgePause signals that an error occurred while pausing or resuming a half-bridge
+var ErrChangingHalfBridgePause = errors.New("error changing the half-bridge pause")
+
+// ErrBatchNotFound signals that the requested batch is not processed by the relayer
+var ErrBatchNotFound = errors.New("batch not found")
+
+// ErrDepositNotFound signals that the requested deposit is not part of a batch processed by the relayer
+var ErrDepositNotFound = errors.New("deposit not found")
+
+// ErrGettingConfigDrift signals that an error occurred while comparing the running config with the config file
+var ErrGettingConfigDrift = errors.New("error getting the config drift")
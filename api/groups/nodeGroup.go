@@ -2,11 +2,14 @@ package groups
 
 import (
 	"fmt"
+	"math/big"
 	"net/http"
+	"strconv"
 	"sync"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
@@ -16,8 +19,27 @@ const (
 	clientQueryParam = "name"
 	statusPath       = "/status"
 	statusListPath   = "/status/list"
+	appStatusPath    = "/appstatus"
+	metricsPath      = "/metrics"
+	aboutPath        = "/about"
+	topologyPath     = "/topology"
+	syncReportPath   = "/syncreport"
+	balanceProofPath = "/balanceproof"
+	feeEstimatePath  = "/feeestimate"
+	slotsQueryParam  = "slots"
+	tokenQueryParam  = "token"
+	amountQueryParam = "amount"
+	defaultNumSlots  = 10
+	maxNumSlots      = 1000
+
+	prometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
 )
 
+// nodeStatusResponse mirrors the data field returned by the MultiversX node on the /node/status route
+type nodeStatusResponse struct {
+	Metrics core.GeneralMetrics `json:"metrics"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    shared.FacadeHandler
@@ -46,6 +68,41 @@ func NewNodeGroup(facade shared.FacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.statusListMetrics,
 		},
+		{
+			Path:    appStatusPath,
+			Method:  http.MethodGet,
+			Handler: ng.appStatusMetrics,
+		},
+		{
+			Path:    metricsPath,
+			Method:  http.MethodGet,
+			Handler: ng.prometheusMetrics,
+		},
+		{
+			Path:    aboutPath,
+			Method:  http.MethodGet,
+			Handler: ng.about,
+		},
+		{
+			Path:    topologyPath,
+			Method:  http.MethodGet,
+			Handler: ng.topology,
+		},
+		{
+			Path:    syncReportPath,
+			Method:  http.MethodGet,
+			Handler: ng.syncReport,
+		},
+		{
+			Path:    balanceProofPath,
+			Method:  http.MethodGet,
+			Handler: ng.balanceProof,
+		},
+		{
+			Path:    feeEstimatePath,
+			Method:  http.MethodGet,
+			Handler: ng.feeEstimate,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -99,6 +156,155 @@ func (ng *nodeGroup) statusMetrics(c *gin.Context) {
 	)
 }
 
+// appStatusMetrics returns all the relayer metrics using the MultiversX node's /node/status response format
+func (ng *nodeGroup) appStatusMetrics(c *gin.Context) {
+	metrics := ng.getFacade().GetNodeStatusMetrics()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  nodeStatusResponse{Metrics: metrics},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// prometheusMetrics returns all the relayer metrics in the Prometheus text exposition format
+func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
+	metrics := ng.getFacade().GetPrometheusMetrics()
+
+	c.Data(http.StatusOK, prometheusContentType, metrics)
+}
+
+// about returns the structured information about the running relayer (version, contracts, chain IDs, addresses,
+// quorum and enabled features)
+func (ng *nodeGroup) about(c *gin.Context) {
+	info := ng.getFacade().GetRuntimeInfo()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  info,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// topology returns, for each bridge direction, the sorted relayers set, the position of the local relayer and the
+// leader schedule of the next slots, so external monitors can predict and verify the leaders behavior
+func (ng *nodeGroup) topology(c *gin.Context) {
+	numSlots := defaultNumSlots
+	slots := c.Query(slotsQueryParam)
+	if len(slots) > 0 {
+		var err error
+		numSlots, err = strconv.Atoi(slots)
+		if err != nil || numSlots < 1 || numSlots > maxNumSlots {
+			c.JSON(
+				http.StatusBadRequest,
+				chainAPIShared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: the number of slots should be between 1 and %d", errors.ErrValidation.Error(), maxNumSlots),
+					Code:  chainAPIShared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+	}
+
+	info := ng.getFacade().GetTopologyInfo(numSlots)
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"topology": info},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// syncReport returns the report produced at startup, comparing the last executed batch IDs on both chains, the pending
+// batches and the locally persisted state
+func (ng *nodeGroup) syncReport(c *gin.Context) {
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"report": ng.getFacade().GetSyncReport()},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// balanceProof returns, for each bridged token, the balances of the bridge contracts on both chains, the in-flight
+// amounts and the delta of the bridge invariant
+func (ng *nodeGroup) balanceProof(c *gin.Context) {
+	proof, err := ng.getFacade().GetBalanceProof(c.Request.Context())
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingBalanceProof.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"proof": proof},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// feeEstimate quotes the deposit of the token amount provided as query parameters: the fee, the amount received on the
+// destination chain, the deposit limits and the expected latency. The token is either the ERC20 address or the ESDT
+// token identifier and the amount is expressed in the token base units
+func (ng *nodeGroup) feeEstimate(c *gin.Context) {
+	token := c.Query(tokenQueryParam)
+	amount, ok := big.NewInt(0).SetString(c.Query(amountQueryParam), 10)
+	if len(token) == 0 || !ok || amount.Sign() <= 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: a token and a positive amount should be provided", errors.ErrValidation.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	estimate, err := ng.getFacade().EstimateFee(c.Request.Context(), token, amount)
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrEstimatingFee.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  gin.H{"estimate": estimate},
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
 func (ng *nodeGroup) getFacade() shared.FacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
@@ -1,7 +1,9 @@
 package groups
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -131,6 +133,383 @@ func TestGetStatusList(t *testing.T) {
 	assert.Empty(t, statusRsp.Error)
 }
 
+func TestGetAppStatus(t *testing.T) {
+	t.Parallel()
+
+	metrics := make(core.GeneralMetrics)
+	metrics["relayer_eth_client_ethereum_client_status"] = "available"
+	metrics["relayer_eth_client_num_ethereum_client_requests"] = 37
+	facade := mockFacade.RelayerFacadeStub{
+		GetNodeStatusMetricsCalled: func() core.GeneralMetrics {
+			return metrics
+		},
+	}
+
+	ng, err := NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/appstatus", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"metrics":{"relayer_eth_client_ethereum_client_status":"available",` +
+		`"relayer_eth_client_num_ethereum_client_requests":37}},"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestGetPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	providedMetrics := "# TYPE relayer_num_batches gauge\nrelayer_num_batches{handler=\"EthToMultiversX\"} 37\n"
+	facade := mockFacade.RelayerFacadeStub{
+		GetPrometheusMetricsCalled: func() []byte {
+			return []byte(providedMetrics)
+		},
+	}
+
+	ng, err := NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, prometheusContentType, resp.Header().Get("Content-Type"))
+	assert.Equal(t, providedMetrics, resp.Body.String())
+}
+
+func TestGetAbout(t *testing.T) {
+	t.Parallel()
+
+	facade := mockFacade.RelayerFacadeStub{
+		GetRuntimeInfoCalled: func() *core.RuntimeInfo {
+			return &core.RuntimeInfo{
+				AppVersion: "v3.0.0",
+				GitCommit:  "abcdef0",
+				Ethereum: core.ChainRuntimeInfo{
+					ChainID: "1",
+					Quorum:  "3",
+				},
+				MultiversX: core.ChainRuntimeInfo{
+					ChainID: "D",
+					Quorum:  "5",
+				},
+				EnabledFeatures: []string{"SLA"},
+			}
+		},
+	}
+
+	ng, err := NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/about", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"appVersion":"v3.0.0","gitCommit":"abcdef0",` +
+		`"ethereum":{"chainId":"1","multisigContractAddress":"","safeContractAddress":"","relayerAddress":"","quorum":"3"},` +
+		`"multiversx":{"chainId":"D","multisigContractAddress":"","safeContractAddress":"","relayerAddress":"","quorum":"5"},` +
+		`"enabledFeatures":["SLA"]},"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestGetTopology(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of slots should error", func(t *testing.T) {
+		t.Parallel()
+
+		ng, _ := NewNodeGroup(&mockFacade.RelayerFacadeStub{})
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		for _, slots := range []string{"abc", "0", "1001"} {
+			req, _ := http.NewRequest("GET", "/node/topology?slots="+slots, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			rsp := generalResponse{}
+			loadResponse(resp.Body, &rsp)
+			require.Equal(t, http.StatusBadRequest, resp.Code, slots)
+			assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()), slots)
+		}
+	})
+	t.Run("should use the default number of slots", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetTopologyInfoCalled: func(numSlots int) map[string]*core.TopologyInfo {
+				assert.Equal(t, defaultNumSlots, numSlots)
+				return make(map[string]*core.TopologyInfo)
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetTopologyInfoCalled: func(numSlots int) map[string]*core.TopologyInfo {
+				assert.Equal(t, 2, numSlots)
+				return map[string]*core.TopologyInfo{
+					"EthereumToMultiversX": {
+						PublicKeys:                 []string{"erd1a", "erd1b"},
+						SelfAddress:                "erd1b",
+						SelfIndex:                  1,
+						IntervalForLeaderInSeconds: 60,
+						CurrentSlot:                100,
+						Schedule: []core.LeaderSlot{
+							{Slot: 100, StartTime: 6000, LeaderIndex: 0, Leader: "erd1a"},
+							{Slot: 101, StartTime: 6060, LeaderIndex: 1, Leader: "erd1b", IsSelf: true},
+						},
+					},
+				}
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology?slots=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"topology":{"EthereumToMultiversX":{"publicKeys":["erd1a","erd1b"],` +
+			`"selfAddress":"erd1b","selfIndex":1,"intervalForLeaderInSeconds":60,"currentSlot":100,"schedule":[` +
+			`{"slot":100,"startTime":6000,"leaderIndex":0,"leader":"erd1a","isSelf":false},` +
+			`{"slot":101,"startTime":6060,"leaderIndex":1,"leader":"erd1b","isSelf":true}]}}},` +
+			`"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestGetSyncReport(t *testing.T) {
+	t.Parallel()
+
+	facade := &mockFacade.RelayerFacadeStub{
+		GetSyncReportCalled: func() *core.SyncReport {
+			return &core.SyncReport{
+				Timestamp: 1700000000,
+				EthereumToMultiversX: &core.BridgeSyncReport{
+					LastExecutedBatchID: 42,
+					PendingBatchID:      43,
+					NumPendingDeposits:  2,
+					PendingBatchFinal:   true,
+					PersistedNumBatches: 42,
+					PersistedStep:       "get pending batch",
+				},
+				MultiversXToEthereum: &core.BridgeSyncReport{
+					LastExecutedBatchID: 9,
+					Errors:              []string{"getCurrentTxBatch on MultiversX: timeout"},
+				},
+			}
+		},
+	}
+	ng, _ := NewNodeGroup(facade)
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/syncreport", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	expectedBody := `{"data":{"report":{"timestamp":1700000000,` +
+		`"ethereumToMultiversX":{"lastExecutedBatchId":42,"pendingBatchId":43,"numPendingDeposits":2,` +
+		`"pendingBatchFinal":true,"pendingExecutedOnDestination":false,"persistedNumBatches":42,` +
+		`"persistedStep":"get pending batch","persistedLastError":""},` +
+		`"multiversXToEthereum":{"lastExecutedBatchId":9,"pendingBatchId":0,"numPendingDeposits":0,` +
+		`"pendingBatchFinal":false,"pendingExecutedOnDestination":false,"persistedNumBatches":0,` +
+		`"persistedStep":"","persistedLastError":"","errors":["getCurrentTxBatch on MultiversX: timeout"]}}},` +
+		`"error":"","code":"successful"}`
+	assert.JSONEq(t, expectedBody, resp.Body.String())
+}
+
+func TestGetBalanceProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			GetBalanceProofCalled: func(ctx context.Context) (*core.BalanceProof, error) {
+				return nil, expectedErr
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/balanceproof", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrGettingBalanceProof.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			GetBalanceProofCalled: func(ctx context.Context) (*core.BalanceProof, error) {
+				return &core.BalanceProof{
+					Timestamp:           1700000000,
+					EthereumBlockNumber: 18000000,
+					MultiversXNonce:     22000000,
+					Tokens: []*core.TokenBalanceProof{
+						{
+							ERC20Address:          "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+							ESDTTokenID:           "WETH-abcdef",
+							EthereumNative:        true,
+							EthereumLocked:        "1000",
+							EthereumMinted:        "0",
+							EthereumBurned:        "0",
+							MultiversXMintBurn:    true,
+							MultiversXLocked:      "0",
+							MultiversXMinted:      "950",
+							MultiversXBurned:      "100",
+							InFlightToMultiversX:  "100",
+							InFlightToEthereum:    "50",
+							EthereumCirculating:   "900",
+							MultiversXCirculating: "900",
+							InvariantDelta:        "0",
+						},
+						{
+							ESDTTokenID: "UNKNOWN-123456",
+							Error:       "unpaired token",
+						},
+					},
+				}, nil
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/balanceproof", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"proof":{"timestamp":1700000000,"ethereumBlockNumber":18000000,"multiversXNonce":22000000,` +
+			`"tokens":[{"erc20Address":"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c","esdtTokenId":"WETH-abcdef",` +
+			`"ethereumNative":true,"ethereumMintBurn":false,"ethereumLocked":"1000","ethereumMinted":"0","ethereumBurned":"0",` +
+			`"multiversXNative":false,"multiversXMintBurn":true,"multiversXLocked":"0","multiversXMinted":"950",` +
+			`"multiversXBurned":"100","inFlightToMultiversX":"100","inFlightToEthereum":"50","ethereumCirculating":"900",` +
+			`"multiversXCirculating":"900","invariantDelta":"0"},` +
+			`{"erc20Address":"","esdtTokenId":"UNKNOWN-123456","ethereumNative":false,"ethereumMintBurn":false,` +
+			`"multiversXNative":false,"multiversXMintBurn":false,"error":"unpaired token"}]}},` +
+			`"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
+func TestGetFeeEstimate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid query parameters should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			EstimateFeeCalled: func(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+				assert.Fail(t, "should have not called the facade")
+				return nil, nil
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		for _, query := range []string{"", "?token=USDC-abcdef", "?amount=1000", "?token=USDC-abcdef&amount=abc", "?token=USDC-abcdef&amount=-5"} {
+			req, _ := http.NewRequest("GET", "/node/feeestimate"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			rsp := generalResponse{}
+			loadResponse(resp.Body, &rsp)
+			require.Equal(t, http.StatusBadRequest, resp.Code, query)
+			assert.True(t, strings.Contains(rsp.Error, apiErrors.ErrValidation.Error()), query)
+		}
+	})
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := &mockFacade.RelayerFacadeStub{
+			EstimateFeeCalled: func(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+				return nil, expectedErr
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/feeestimate?token=USDC-abcdef&amount=1000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		rsp := generalResponse{}
+		loadResponse(resp.Body, &rsp)
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(rsp.Error, ErrEstimatingFee.Error()))
+		assert.True(t, strings.Contains(rsp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mockFacade.RelayerFacadeStub{
+			EstimateFeeCalled: func(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+				assert.Equal(t, "USDC-abcdef", token)
+				assert.Equal(t, "5000000", amount.String())
+
+				return &core.FeeEstimate{
+					Direction:                 "MultiversXToEthereum",
+					SourceToken:               "USDC-abcdef",
+					DestinationToken:          "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+					Amount:                    "5000000",
+					Fee:                       "1000000",
+					DestinationAmount:         "4000000",
+					SourceDecimals:            6,
+					DestinationDecimals:       6,
+					MinAmount:                 "2000000",
+					MaxAmount:                 "100000000",
+					WithinLimits:              true,
+					PendingBatches:            1,
+					EstimatedLatencyInSeconds: 120,
+				}, nil
+			},
+		}
+		ng, _ := NewNodeGroup(facade)
+		ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/feeestimate?token=USDC-abcdef&amount=5000000", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		expectedBody := `{"data":{"estimate":{"direction":"MultiversXToEthereum","sourceToken":"USDC-abcdef",` +
+			`"destinationToken":"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c","amount":"5000000","fee":"1000000",` +
+			`"destinationAmount":"4000000","sourceDecimals":6,"destinationDecimals":6,"minAmount":"2000000",` +
+			`"maxAmount":"100000000","withinLimits":true,"pendingBatches":1,"estimatedLatencyInSeconds":120}},` +
+			`"error":"","code":"successful"}`
+		assert.JSONEq(t, expectedBody, resp.Body.String())
+	})
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,148 @@
+package messages
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	"github.com/multiversx/mx-bridge-eth-go/api/groups"
+	chainAPIErrors "github.com/multiversx/mx-chain-go/api/errors"
+)
+
+type errorCode struct {
+	err  error
+	code string
+}
+
+// errorCodes maps the errors the API responses start with to their codes
+var errorCodes = []errorCode{
+	{err: chainAPIErrors.ErrValidation, code: CodeInvalidRequest},
+	{err: apiErrors.ErrUnauthorized, code: CodeUnauthorized},
+	{err: groups.ErrGettingMetrics, code: CodeMetricsUnavailable},
+	{err: groups.ErrSettingLoggerLevel, code: CodeLoggerLevelNotSet},
+	{err: groups.ErrGettingBatchResults, code: CodeBatchResultsUnavailable},
+	{err: groups.ErrGettingTransferReceipts, code: CodeTransferReceiptsUnavailable},
+	{err: groups.ErrRelayingClaim, code: CodeClaimNotRelayed},
+	{err: groups.ErrGettingBalanceProof, code: CodeBalanceProofUnavailable},
+	{err: groups.ErrEstimatingFee, code: CodeFeeEstimateUnavailable},
+	{err: groups.ErrAcknowledgingIncident, code: CodeIncidentNotAcknowledged},
+	{err: groups.ErrResolvingDeadLetter, code: CodeDeadLetterNotResolved},
+	{err: groups.ErrSendingDirectMessage, code: CodeDirectMessageNotSent},
+	{err: groups.ErrChangingHalfBridgePause, code: CodeHalfBridgePauseNotChanged},
+	{err: groups.ErrBatchNotFound, code: CodeBatchNotFound},
+	{err: groups.ErrDepositNotFound, code: CodeDepositNotFound},
+	{err: groups.ErrGettingConfigDrift, code: CodeConfigDriftUnavailable},
+}
+
+// ArgsCatalog is the DTO used to create a new instance of type catalog
+type ArgsCatalog struct {
+	DefaultLanguage string
+	// Languages holds the messages of each language, by code
+	Languages map[string]map[string]string
+}
+
+type catalog struct {
+	defaultLanguage string
+	languages       map[string]map[string]string
+}
+
+// NewCatalog creates the catalog resolving the codes of the API errors and their messages in each language. Every
+// language should hold the messages of all the codes
+func NewCatalog(args ArgsCatalog) (*catalog, error) {
+	_, found := args.Languages[args.DefaultLanguage]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrMissingDefaultLanguage, args.DefaultLanguage)
+	}
+
+	languages := make(map[string]map[string]string, len(args.Languages))
+	for language, messages := range args.Languages {
+		for _, code := range allCodes() {
+			if len(messages[code]) == 0 {
+				return nil, fmt.Errorf("%w for code %s in language %s", ErrMissingMessage, code, language)
+			}
+		}
+		languages[strings.ToLower(language)] = messages
+	}
+
+	return &catalog{
+		defaultLanguage: strings.ToLower(args.DefaultLanguage),
+		languages:       languages,
+	}, nil
+}
+
+// DefaultLanguages returns the messages of the languages the relayer is shipped with
+func DefaultLanguages() map[string]map[string]string {
+	return map[string]map[string]string{
+		EnglishLanguage: englishMessages,
+	}
+}
+
+func allCodes() []string {
+	codes := []string{
+		CodeInvalidRequest,
+		CodeUnauthorized,
+		CodeForbidden,
+		CodeNotFound,
+		CodeTooManyRequests,
+		CodeInternalError,
+		CodeRequestFailed,
+	}
+	for _, entry := range errorCodes {
+		codes = append(codes, entry.code)
+	}
+
+	return codes
+}
+
+// Code returns the code of an API error response. The error message is matched against the known errors, the longest
+// match winning, and the unknown errors get a code derived from the HTTP status
+func (c *catalog) Code(status int, errorMessage string) string {
+	code := ""
+	matchedLength := 0
+	for _, entry := range errorCodes {
+		prefix := entry.err.Error()
+		if len(prefix) > matchedLength && strings.HasPrefix(errorMessage, prefix) {
+			code = entry.code
+			matchedLength = len(prefix)
+		}
+	}
+	if len(code) > 0 {
+		return code
+	}
+
+	switch {
+	case status == http.StatusBadRequest:
+		return CodeInvalidRequest
+	case status == http.StatusUnauthorized:
+		return CodeUnauthorized
+	case status == http.StatusForbidden:
+		return CodeForbidden
+	case status == http.StatusNotFound:
+		return CodeNotFound
+	case status == http.StatusTooManyRequests:
+		return CodeTooManyRequests
+	case status >= http.StatusInternalServerError:
+		return CodeInternalError
+	default:
+		return CodeRequestFailed
+	}
+}
+
+// Message returns the message of the code in the first supported language of the provided ones, in order of preference,
+// or in the default language if none is supported
+func (c *catalog) Message(code string, languages []string) string {
+	for _, language := range languages {
+		messages, found := c.languages[strings.ToLower(language)]
+		if found {
+			return messages[code]
+		}
+	}
+
+	return c.languages[c.defaultLanguage][code]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (c *catalog) IsInterfaceNil() bool {
+	return c == nil
+}
@@ -0,0 +1,110 @@
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	apiErrors "github.com/multiversx/mx-bridge-eth-go/api/errors"
+	"github.com/multiversx/mx-bridge-eth-go/api/groups"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	chainAPIErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func createLanguages() map[string]map[string]string {
+	french := make(map[string]string)
+	for _, code := range allCodes() {
+		french[code] = "fr " + code
+	}
+
+	return map[string]map[string]string{
+		EnglishLanguage: englishMessages,
+		"FR":            french,
+	}
+}
+
+func TestNewCatalog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing default language should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewCatalog(ArgsCatalog{
+			DefaultLanguage: "de",
+			Languages:       createLanguages(),
+		})
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, ErrMissingDefaultLanguage))
+	})
+	t.Run("missing message should error", func(t *testing.T) {
+		t.Parallel()
+
+		languages := createLanguages()
+		delete(languages["FR"], CodeBatchNotFound)
+		c, err := NewCatalog(ArgsCatalog{
+			DefaultLanguage: EnglishLanguage,
+			Languages:       languages,
+		})
+		assert.True(t, check.IfNil(c))
+		assert.True(t, errors.Is(err, ErrMissingMessage))
+		assert.Contains(t, err.Error(), CodeBatchNotFound)
+	})
+	t.Run("default languages should work", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewCatalog(ArgsCatalog{
+			DefaultLanguage: EnglishLanguage,
+			Languages:       DefaultLanguages(),
+		})
+		assert.False(t, check.IfNil(c))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCatalog_Code(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewCatalog(ArgsCatalog{
+		DefaultLanguage: EnglishLanguage,
+		Languages:       DefaultLanguages(),
+	})
+
+	t.Run("known errors should return their codes", func(t *testing.T) {
+		t.Parallel()
+
+		for _, entry := range errorCodes {
+			errorMessage := fmt.Sprintf("%s: inner error", entry.err.Error())
+			assert.Equal(t, entry.code, c.Code(http.StatusInternalServerError, errorMessage), entry.err.Error())
+		}
+		assert.Equal(t, CodeInvalidRequest, c.Code(http.StatusBadRequest, chainAPIErrors.ErrValidation.Error()+": bad nonce"))
+		assert.Equal(t, CodeUnauthorized, c.Code(http.StatusUnauthorized, apiErrors.ErrUnauthorized.Error()))
+		assert.Equal(t, CodeBatchNotFound, c.Code(http.StatusNotFound, groups.ErrBatchNotFound.Error()))
+	})
+	t.Run("unknown errors should return the code of the status", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, CodeInvalidRequest, c.Code(http.StatusBadRequest, "unknown"))
+		assert.Equal(t, CodeUnauthorized, c.Code(http.StatusUnauthorized, "unknown"))
+		assert.Equal(t, CodeForbidden, c.Code(http.StatusForbidden, "unknown"))
+		assert.Equal(t, CodeNotFound, c.Code(http.StatusNotFound, "unknown"))
+		assert.Equal(t, CodeTooManyRequests, c.Code(http.StatusTooManyRequests, "unknown"))
+		assert.Equal(t, CodeInternalError, c.Code(http.StatusServiceUnavailable, "unknown"))
+		assert.Equal(t, CodeRequestFailed, c.Code(http.StatusConflict, "unknown"))
+	})
+}
+
+func TestCatalog_Message(t *testing.T) {
+	t.Parallel()
+
+	c, _ := NewCatalog(ArgsCatalog{
+		DefaultLanguage: EnglishLanguage,
+		Languages:       createLanguages(),
+	})
+
+	assert.Equal(t, englishMessages[CodeBatchNotFound], c.Message(CodeBatchNotFound, nil))
+	assert.Equal(t, englishMessages[CodeBatchNotFound], c.Message(CodeBatchNotFound, []string{"de"}))
+	assert.Equal(t, "fr "+CodeBatchNotFound, c.Message(CodeBatchNotFound, []string{"de", "fr"}))
+	assert.Equal(t, englishMessages[CodeBatchNotFound], c.Message(CodeBatchNotFound, []string{"EN", "fr"}))
+}
@@ -0,0 +1,27 @@
+package messages
+
+// The stable machine codes of the API error responses. The codes never change once released: the frontends use them to
+// pick the message presented to the users, while the error field holds the technical details
+const (
+	CodeInvalidRequest              = "invalid_request"
+	CodeUnauthorized                = "unauthorized"
+	CodeForbidden                   = "forbidden"
+	CodeNotFound                    = "not_found"
+	CodeTooManyRequests             = "too_many_requests"
+	CodeInternalError               = "internal_error"
+	CodeRequestFailed               = "request_failed"
+	CodeMetricsUnavailable          = "metrics_unavailable"
+	CodeLoggerLevelNotSet           = "logger_level_not_set"
+	CodeBatchResultsUnavailable     = "batch_results_unavailable"
+	CodeTransferReceiptsUnavailable = "transfer_receipts_unavailable"
+	CodeClaimNotRelayed             = "claim_not_relayed"
+	CodeBalanceProofUnavailable     = "balance_proof_unavailable"
+	CodeFeeEstimateUnavailable      = "fee_estimate_unavailable"
+	CodeIncidentNotAcknowledged     = "incident_not_acknowledged"
+	CodeDeadLetterNotResolved       = "dead_letter_not_resolved"
+	CodeDirectMessageNotSent        = "direct_message_not_sent"
+	CodeHalfBridgePauseNotChanged   = "half_bridge_pause_not_changed"
+	CodeBatchNotFound               = "batch_not_found"
+	CodeDepositNotFound             = "deposit_not_found"
+	CodeConfigDriftUnavailable      = "config_drift_unavailable"
+)
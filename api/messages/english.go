@@ -0,0 +1,28 @@
+package messages
+
+// EnglishLanguage is the language of the default catalog
+const EnglishLanguage = "en"
+
+var englishMessages = map[string]string{
+	CodeInvalidRequest:              "The request is not valid. Please check the provided values and try again.",
+	CodeUnauthorized:                "You are not authorized to perform this operation.",
+	CodeForbidden:                   "This operation is not allowed.",
+	CodeNotFound:                    "The requested resource was not found.",
+	CodeTooManyRequests:             "Too many requests. Please wait a moment and try again.",
+	CodeInternalError:               "The bridge relayer could not process the request. Please try again later.",
+	CodeRequestFailed:               "The request could not be completed.",
+	CodeMetricsUnavailable:          "The bridge metrics are not available at the moment.",
+	CodeLoggerLevelNotSet:           "The logger level could not be changed.",
+	CodeBatchResultsUnavailable:     "The results of this batch are not available on this relayer.",
+	CodeTransferReceiptsUnavailable: "The transfer receipts of this batch are not available on this relayer.",
+	CodeClaimNotRelayed:             "Your claim could not be relayed. Please try again later.",
+	CodeBalanceProofUnavailable:     "The balance proof is not available at the moment.",
+	CodeFeeEstimateUnavailable:      "The fee could not be estimated for this transfer.",
+	CodeIncidentNotAcknowledged:     "The incident could not be acknowledged.",
+	CodeDeadLetterNotResolved:       "The failed transfer could not be resolved.",
+	CodeDirectMessageNotSent:        "The message could not be sent to the other relayer.",
+	CodeHalfBridgePauseNotChanged:   "The pause state of the bridge direction could not be changed.",
+	CodeBatchNotFound:               "This batch is not being processed by the bridge at the moment.",
+	CodeDepositNotFound:             "This transfer is not part of a batch being processed by the bridge at the moment.",
+	CodeConfigDriftUnavailable:      "The running configuration could not be compared with the configuration file.",
+}
@@ -0,0 +1,9 @@
+package messages
+
+import "errors"
+
+// ErrMissingDefaultLanguage signals that the default language has no catalog
+var ErrMissingDefaultLanguage = errors.New("missing catalog for the default language")
+
+// ErrMissingMessage signals that a catalog misses the message of a code
+var ErrMissingMessage = errors.New("missing message")
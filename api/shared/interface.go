@@ -1,9 +1,14 @@
 package shared
 
 import (
+	"context"
+	"math/big"
+
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 // GroupHandler defines the actions needed to be performed by an gin API group
@@ -22,6 +27,33 @@ type FacadeHandler interface {
 	PprofEnabled() bool
 	GetMetrics(name string) (core.GeneralMetrics, error)
 	GetMetricsList() core.GeneralMetrics
+	GetNodeStatusMetrics() core.GeneralMetrics
+	GetPrometheusMetrics() []byte
+	GetLoggers() []core.LoggerInfo
+	SetLoggerLevel(identifier string, level string) error
+	GetBatchResults(batchID uint64) (*core.BatchResults, error)
+	GetTransferReceipts(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error)
+	GetRuntimeInfo() *core.RuntimeInfo
+	GetTopologyInfo(numSlots int) map[string]*core.TopologyInfo
+	GetExportedTransactions() []*core.ExportedTransaction
+	GetSyncReport() *core.SyncReport
+	SubmitRelayedClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error)
+	GetBalanceProof(ctx context.Context) (*core.BalanceProof, error)
+	EstimateFee(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error)
+	GetIncidents() []*core.Incident
+	AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error)
+	GetDeadLetters() []*core.DeadLetter
+	ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error)
+	GetDirectMessages() []*core.DirectMessage
+	SendDirectMessage(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error)
+	PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	GetHalfBridgePauses() []*core.HalfBridgePause
+	GetPendingBatches() []*core.BatchLiveView
+	GetBatchLiveViews(batchID uint64) []*core.BatchLiveView
+	GetDepositLiveViews(nonce uint64) []*core.BatchLiveView
+	GetConfigSchema() *schema.Schema
+	GetConfigDrift() (*core.ConfigDriftReport, error)
 	IsInterfaceNil() bool
 }
 
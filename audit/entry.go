@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// Entry is a signature record of the signer audit log. Each entry commits to the previous one through
+// the PreviousHash field so that removing or altering any entry breaks the chain
+type Entry struct {
+	Index        uint64 `json:"index"`
+	Timestamp    int64  `json:"timestamp"`
+	Purpose      string `json:"purpose"`
+	BatchID      uint64 `json:"batchId"`
+	MessageHash  string `json:"messageHash"`
+	PreviousHash string `json:"previousHash"`
+	Hash         string `json:"hash"`
+}
+
+func (entry *Entry) computeHash() (string, error) {
+	content := *entry
+	content.Hash = ""
+
+	buff, err := json.Marshal(&content)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(buff)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// VerifyEntries checks that the provided entries form an unaltered hash chain, starting from the first entry ever recorded
+func VerifyEntries(entries []*Entry) error {
+	previousHash := ""
+	for i, entry := range entries {
+		if entry.Index != uint64(i) {
+			return fmt.Errorf("%w: expected index %d, got %d", ErrBrokenChain, i, entry.Index)
+		}
+		if entry.PreviousHash != previousHash {
+			return fmt.Errorf("%w: entry %d does not link to the previous entry", ErrBrokenChain, entry.Index)
+		}
+
+		hash, err := entry.computeHash()
+		if err != nil {
+			return err
+		}
+		if entry.Hash != hash {
+			return fmt.Errorf("%w: entry %d was altered", ErrBrokenChain, entry.Index)
+		}
+
+		previousHash = entry.Hash
+	}
+
+	return nil
+}
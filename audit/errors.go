@@ -0,0 +1,15 @@
+package audit
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyName signals that an empty name was provided
+var ErrEmptyName = errors.New("empty name")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrBrokenChain signals that the audit log entries do not form a valid hash chain
+var ErrBrokenChain = errors.New("broken audit log hash chain")
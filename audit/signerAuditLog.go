@@ -0,0 +1,182 @@
+package audit
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("audit")
+
+const headKeySuffix = "_head"
+
+// ArgsSignerAuditLog is the DTO used to create a new signer audit log
+type ArgsSignerAuditLog struct {
+	Name   string
+	Storer core.Storer
+	Timer  core.Timer
+}
+
+type chainHead struct {
+	NumEntries uint64 `json:"numEntries"`
+	LastHash   string `json:"lastHash"`
+}
+
+type signerAuditLog struct {
+	mut    sync.Mutex
+	name   string
+	storer core.Storer
+	timer  core.Timer
+	head   chainHead
+}
+
+// NewSignerAuditLog creates a new signer audit log that appends the records in the provided storer
+func NewSignerAuditLog(args ArgsSignerAuditLog) (*signerAuditLog, error) {
+	if len(args.Name) == 0 {
+		return nil, ErrEmptyName
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Timer) {
+		return nil, ErrNilTimer
+	}
+
+	auditLog := &signerAuditLog{
+		name:   args.Name,
+		storer: args.Storer,
+		timer:  args.Timer,
+	}
+
+	err := auditLog.loadHead()
+	if err != nil {
+		return nil, err
+	}
+
+	return auditLog, nil
+}
+
+func (auditLog *signerAuditLog) loadHead() error {
+	buff, err := auditLog.storer.Get(auditLog.headKey())
+	if err != nil {
+		log.Debug("signerAuditLog: no head found, starting a new chain", "name", auditLog.name)
+		return nil
+	}
+
+	return json.Unmarshal(buff, &auditLog.head)
+}
+
+// RecordSignature appends a new entry for a signature produced over the provided message hash
+func (auditLog *signerAuditLog) RecordSignature(purpose string, batchID uint64, messageHash []byte) error {
+	auditLog.mut.Lock()
+	defer auditLog.mut.Unlock()
+
+	entry := &Entry{
+		Index:        auditLog.head.NumEntries,
+		Timestamp:    auditLog.timer.NowUnix(),
+		Purpose:      purpose,
+		BatchID:      batchID,
+		MessageHash:  hex.EncodeToString(messageHash),
+		PreviousHash: auditLog.head.LastHash,
+	}
+
+	var err error
+	entry.Hash, err = entry.computeHash()
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	err = auditLog.storer.Put(auditLog.entryKey(entry.Index), buff)
+	if err != nil {
+		return err
+	}
+
+	newHead := chainHead{
+		NumEntries: entry.Index + 1,
+		LastHash:   entry.Hash,
+	}
+	buff, err = json.Marshal(&newHead)
+	if err != nil {
+		return err
+	}
+	err = auditLog.storer.Put(auditLog.headKey(), buff)
+	if err != nil {
+		return err
+	}
+
+	auditLog.head = newHead
+	log.Debug("signerAuditLog: recorded signature", "purpose", purpose, "batch ID", batchID,
+		"index", entry.Index, "hash", entry.Hash)
+
+	return nil
+}
+
+// Entries returns all the recorded entries, in order
+func (auditLog *signerAuditLog) Entries() ([]*Entry, error) {
+	auditLog.mut.Lock()
+	numEntries := auditLog.head.NumEntries
+	auditLog.mut.Unlock()
+
+	entries := make([]*Entry, 0, numEntries)
+	for i := uint64(0); i < numEntries; i++ {
+		buff, err := auditLog.storer.Get(auditLog.entryKey(i))
+		if err != nil {
+			return nil, fmt.Errorf("%w while reading entry %d", err, i)
+		}
+
+		entry := &Entry{}
+		err = json.Unmarshal(buff, entry)
+		if err != nil {
+			return nil, fmt.Errorf("%w while decoding entry %d", err, i)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Verify checks that the recorded entries form an unaltered hash chain ending in the stored head
+func (auditLog *signerAuditLog) Verify() error {
+	entries, err := auditLog.Entries()
+	if err != nil {
+		return err
+	}
+
+	err = VerifyEntries(entries)
+	if err != nil {
+		return err
+	}
+
+	auditLog.mut.Lock()
+	lastHash := auditLog.head.LastHash
+	auditLog.mut.Unlock()
+
+	if len(entries) > 0 && entries[len(entries)-1].Hash != lastHash {
+		return fmt.Errorf("%w: the last entry does not match the stored head", ErrBrokenChain)
+	}
+
+	return nil
+}
+
+func (auditLog *signerAuditLog) headKey() []byte {
+	return []byte(auditLog.name + headKeySuffix)
+}
+
+func (auditLog *signerAuditLog) entryKey(index uint64) []byte {
+	return []byte(fmt.Sprintf("%s_%d", auditLog.name, index))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (auditLog *signerAuditLog) IsInterfaceNil() bool {
+	return auditLog == nil
+}
@@ -0,0 +1,231 @@
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testName = "audit"
+
+func createMockArgsSignerAuditLog() ArgsSignerAuditLog {
+	timestamp := int64(1000)
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		timestamp++
+		return timestamp
+	}
+
+	return ArgsSignerAuditLog{
+		Name:   testName,
+		Storer: testsCommon.NewStorerMock(),
+		Timer:  timer,
+	}
+}
+
+func recordTestSignatures(t *testing.T, auditLog *signerAuditLog, numSignatures int) {
+	for i := 0; i < numSignatures; i++ {
+		err := auditLog.RecordSignature("purpose", uint64(i+1), []byte{byte(i)})
+		require.Nil(t, err)
+	}
+}
+
+func TestNewSignerAuditLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		args.Name = ""
+		auditLog, err := NewSignerAuditLog(args)
+		assert.Equal(t, ErrEmptyName, err)
+		assert.True(t, check.IfNil(auditLog))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		args.Storer = nil
+		auditLog, err := NewSignerAuditLog(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(auditLog))
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		args.Timer = nil
+		auditLog, err := NewSignerAuditLog(args)
+		assert.Equal(t, ErrNilTimer, err)
+		assert.True(t, check.IfNil(auditLog))
+	})
+	t.Run("corrupted head should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		_ = args.Storer.Put([]byte(testName+headKeySuffix), []byte("garbage"))
+		auditLog, err := NewSignerAuditLog(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(auditLog))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog, err := NewSignerAuditLog(createMockArgsSignerAuditLog())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(auditLog))
+	})
+}
+
+func TestSignerAuditLog_RecordSignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should chain the entries", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog, _ := NewSignerAuditLog(createMockArgsSignerAuditLog())
+		recordTestSignatures(t, auditLog, 3)
+
+		entries, err := auditLog.Entries()
+		assert.Nil(t, err)
+		require.Equal(t, 3, len(entries))
+		assert.Equal(t, "", entries[0].PreviousHash)
+		for i, entry := range entries {
+			assert.Equal(t, uint64(i), entry.Index)
+			assert.Equal(t, uint64(i+1), entry.BatchID)
+			assert.Equal(t, int64(1001+i), entry.Timestamp)
+			if i > 0 {
+				assert.Equal(t, entries[i-1].Hash, entry.PreviousHash)
+			}
+		}
+		assert.Nil(t, auditLog.Verify())
+	})
+	t.Run("should continue the chain after reload", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		auditLog, _ := NewSignerAuditLog(args)
+		recordTestSignatures(t, auditLog, 2)
+
+		reloadedAuditLog, err := NewSignerAuditLog(args)
+		require.Nil(t, err)
+		recordTestSignatures(t, reloadedAuditLog, 1)
+
+		entries, err := reloadedAuditLog.Entries()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(entries))
+		assert.Nil(t, reloadedAuditLog.Verify())
+	})
+	t.Run("storer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsSignerAuditLog()
+		args.Storer = &testsCommon.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+			PutCalled: func(key, data []byte) error {
+				return expectedErr
+			},
+		}
+		auditLog, _ := NewSignerAuditLog(args)
+
+		err := auditLog.RecordSignature("purpose", 1, nil)
+		assert.Equal(t, expectedErr, err)
+		entries, _ := auditLog.Entries()
+		assert.Empty(t, entries)
+	})
+}
+
+func TestSignerAuditLog_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty log should be valid", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog, _ := NewSignerAuditLog(createMockArgsSignerAuditLog())
+		assert.Nil(t, auditLog.Verify())
+	})
+	t.Run("altered entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		auditLog, _ := NewSignerAuditLog(args)
+		recordTestSignatures(t, auditLog, 3)
+
+		entries, _ := auditLog.Entries()
+		entries[1].BatchID = 100
+		buff, _ := json.Marshal(entries[1])
+		_ = args.Storer.Put(auditLog.entryKey(1), buff)
+
+		err := auditLog.Verify()
+		assert.True(t, errors.Is(err, ErrBrokenChain))
+		assert.Contains(t, err.Error(), "entry 1 was altered")
+	})
+	t.Run("re-hashed altered entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		auditLog, _ := NewSignerAuditLog(args)
+		recordTestSignatures(t, auditLog, 3)
+
+		entries, _ := auditLog.Entries()
+		entries[1].BatchID = 100
+		entries[1].Hash, _ = entries[1].computeHash()
+		buff, _ := json.Marshal(entries[1])
+		_ = args.Storer.Put(auditLog.entryKey(1), buff)
+
+		err := auditLog.Verify()
+		assert.True(t, errors.Is(err, ErrBrokenChain))
+		assert.Contains(t, err.Error(), "entry 2 does not link to the previous entry")
+	})
+	t.Run("removed last entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignerAuditLog()
+		auditLog, _ := NewSignerAuditLog(args)
+		recordTestSignatures(t, auditLog, 3)
+
+		entries, _ := auditLog.Entries()
+		buff, _ := json.Marshal(entries[1])
+		_ = args.Storer.Put(auditLog.entryKey(2), buff)
+
+		err := auditLog.Verify()
+		assert.True(t, errors.Is(err, ErrBrokenChain))
+	})
+}
+
+func TestVerifyEntries(t *testing.T) {
+	t.Parallel()
+
+	auditLog, _ := NewSignerAuditLog(createMockArgsSignerAuditLog())
+	recordTestSignatures(t, auditLog, 4)
+	entries, _ := auditLog.Entries()
+
+	t.Run("valid entries should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, VerifyEntries(entries))
+	})
+	t.Run("removed entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		shortened := []*Entry{entries[0], entries[2], entries[3]}
+		err := VerifyEntries(shortened)
+		assert.True(t, errors.Is(err, ErrBrokenChain))
+		assert.Contains(t, err.Error(), "expected index 1, got 2")
+	})
+	t.Run("removed first entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := VerifyEntries(entries[1:])
+		assert.True(t, errors.Is(err, ErrBrokenChain))
+	})
+}
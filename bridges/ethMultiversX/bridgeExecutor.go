@@ -1,9 +1,12 @@
 package ethmultiversx
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
+	"encoding/gob"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"time"
@@ -31,11 +34,30 @@ type ArgsBridgeExecutor struct {
 	EthereumClient               EthereumClient
 	TimeForWaitOnEthereum        time.Duration
 	StatusHandler                core.StatusHandler
+	Timer                        core.Timer
 	SignaturesHolder             SignaturesHolder
 	BalanceValidator             BalanceValidator
+	BatchValidator               BatchValidator
+	SignerAuditLog               SignerAuditLog
+	PostmortemCapturer           PostmortemCapturer
+	SLATracker                   SLATracker
+	ErrorReporter                ErrorReporter
+	BatchResultsStorer           BatchResultsStorer
+	TransferReceipts             TransferReceipts
+	RecipientAllowlist           RecipientAllowlist
+	RecipientValidator           RecipientValidator
+	ESDTRolesChecker             ESDTRolesChecker
+	DeadLetters                  DeadLetters
+	IdempotencyGuard             IdempotencyGuard
+	BatchTagger                  BatchTagger
+	PreAgreement                 PreAgreement
+	CostAccounting               CostAccounting
 	MaxQuorumRetriesOnEthereum   uint64
 	MaxQuorumRetriesOnMultiversX uint64
 	MaxRestriesOnWasProposed     uint64
+	ExecutionDeadline            time.Duration
+	GasPriceDeferral             time.Duration
+	RejectionDeadlineInBlocks    uint64
 }
 
 type bridgeExecutor struct {
@@ -45,18 +67,49 @@ type bridgeExecutor struct {
 	ethereumClient               EthereumClient
 	timeForWaitOnEthereum        time.Duration
 	statusHandler                core.StatusHandler
+	timer                        core.Timer
 	sigsHolder                   SignaturesHolder
 	balanceValidator             BalanceValidator
+	batchValidator               BatchValidator
+	signerAuditLog               SignerAuditLog
+	postmortemCapturer           PostmortemCapturer
+	slaTracker                   SLATracker
+	errorReporter                ErrorReporter
+	batchResultsStorer           BatchResultsStorer
+	transferReceipts             TransferReceipts
+	recipientAllowlist           RecipientAllowlist
+	recipientValidator           RecipientValidator
+	esdtRolesChecker             ESDTRolesChecker
+	deadLetters                  DeadLetters
+	idempotencyGuard             IdempotencyGuard
+	batchTagger                  BatchTagger
+	preAgreement                 PreAgreement
+	costAccounting               CostAccounting
 	maxQuorumRetriesOnEthereum   uint64
 	maxQuorumRetriesOnMultiversX uint64
 	maxRetriesOnWasProposed      uint64
+	executionDeadline            int64
+	gasPriceDeferral             int64
+	rejectionDeadlineInBlocks    uint64
 
 	batch                     *bridgeCore.TransferBatch
 	actionID                  uint64
+	prefetchedActionID        uint64
+	prefetchedActionIDBatch   *bridgeCore.TransferBatch
 	msgHash                   common.Hash
 	quorumRetriesOnEthereum   uint64
 	quorumRetriesOnMultiversX uint64
 	retriesOnWasProposed      uint64
+	performActionTxHash       string
+	executeTransferTxHash     string
+	sentTransferBatchID       uint64
+	sentTransferTxHash        string
+	isBatchRejected           bool
+	deadlineBatchID           uint64
+	deadlineStartTimestamp    int64
+	isDeadlineReported        bool
+	deferredBatchID           uint64
+	deferralStartTimestamp    int64
 }
 
 // NewBridgeExecutor creates a bridge executor, which can be used for both half-bridges
@@ -86,6 +139,9 @@ func checkArgs(args ArgsBridgeExecutor) error {
 	if check.IfNil(args.StatusHandler) {
 		return ErrNilStatusHandler
 	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
 	if args.TimeForWaitOnEthereum < durationLimit {
 		return ErrInvalidDuration
 	}
@@ -95,6 +151,51 @@ func checkArgs(args ArgsBridgeExecutor) error {
 	if check.IfNil(args.BalanceValidator) {
 		return ErrNilBalanceValidator
 	}
+	if check.IfNil(args.BatchValidator) {
+		return ErrNilBatchValidator
+	}
+	if check.IfNil(args.SignerAuditLog) {
+		return ErrNilSignerAuditLog
+	}
+	if check.IfNil(args.PostmortemCapturer) {
+		return ErrNilPostmortemCapturer
+	}
+	if check.IfNil(args.SLATracker) {
+		return ErrNilSLATracker
+	}
+	if check.IfNil(args.ErrorReporter) {
+		return ErrNilErrorReporter
+	}
+	if check.IfNil(args.BatchResultsStorer) {
+		return ErrNilBatchResultsStorer
+	}
+	if check.IfNil(args.TransferReceipts) {
+		return ErrNilTransferReceipts
+	}
+	if check.IfNil(args.RecipientAllowlist) {
+		return ErrNilRecipientAllowlist
+	}
+	if check.IfNil(args.RecipientValidator) {
+		return ErrNilRecipientValidator
+	}
+	if check.IfNil(args.ESDTRolesChecker) {
+		return ErrNilESDTRolesChecker
+	}
+	if check.IfNil(args.DeadLetters) {
+		return ErrNilDeadLetters
+	}
+	if check.IfNil(args.IdempotencyGuard) {
+		return ErrNilIdempotencyGuard
+	}
+	if check.IfNil(args.BatchTagger) {
+		return ErrNilBatchTagger
+	}
+	if check.IfNil(args.PreAgreement) {
+		return ErrNilPreAgreement
+	}
+	if check.IfNil(args.CostAccounting) {
+		return ErrNilCostAccounting
+	}
 	if args.MaxQuorumRetriesOnEthereum < minRetries {
 		return fmt.Errorf("%w for args.MaxQuorumRetriesOnEthereum, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.MaxQuorumRetriesOnEthereum, minRetries)
@@ -117,12 +218,31 @@ func createBridgeExecutor(args ArgsBridgeExecutor) *bridgeExecutor {
 		ethereumClient:               args.EthereumClient,
 		topologyProvider:             args.TopologyProvider,
 		statusHandler:                args.StatusHandler,
+		timer:                        args.Timer,
 		timeForWaitOnEthereum:        args.TimeForWaitOnEthereum,
 		sigsHolder:                   args.SignaturesHolder,
 		balanceValidator:             args.BalanceValidator,
+		batchValidator:               args.BatchValidator,
+		signerAuditLog:               args.SignerAuditLog,
+		postmortemCapturer:           args.PostmortemCapturer,
+		slaTracker:                   args.SLATracker,
+		errorReporter:                args.ErrorReporter,
+		batchResultsStorer:           args.BatchResultsStorer,
+		transferReceipts:             args.TransferReceipts,
+		recipientAllowlist:           args.RecipientAllowlist,
+		recipientValidator:           args.RecipientValidator,
+		esdtRolesChecker:             args.ESDTRolesChecker,
+		deadLetters:                  args.DeadLetters,
+		idempotencyGuard:             args.IdempotencyGuard,
+		batchTagger:                  args.BatchTagger,
+		preAgreement:                 args.PreAgreement,
+		costAccounting:               args.CostAccounting,
 		maxQuorumRetriesOnEthereum:   args.MaxQuorumRetriesOnEthereum,
 		maxQuorumRetriesOnMultiversX: args.MaxQuorumRetriesOnMultiversX,
 		maxRetriesOnWasProposed:      args.MaxRestriesOnWasProposed,
+		executionDeadline:            int64(args.ExecutionDeadline.Seconds()),
+		gasPriceDeferral:             int64(args.GasPriceDeferral.Seconds()),
+		rejectionDeadlineInBlocks:    args.RejectionDeadlineInBlocks,
 	}
 }
 
@@ -134,6 +254,47 @@ func (executor *bridgeExecutor) PrintInfo(logLevel logger.LogLevel, message stri
 	case logger.LogWarning, logger.LogError:
 		executor.setExecutionMessageInStatusHandler(logLevel, message, extras...)
 	}
+	if logLevel == logger.LogError {
+		executor.postmortemCapturer.Capture(message, executor.createPostmortemData(extras...))
+		executor.slaTracker.StepFailed(executor.statusHandler.Name())
+		executor.errorReporter.ReportError(executor.statusHandler.Name(), message, executor.createErrorReportTags(extras...))
+	}
+}
+
+func (executor *bridgeExecutor) createErrorReportTags(extras ...interface{}) map[string]string {
+	tags := make(map[string]string)
+	for i := 0; i < len(extras)-1; i += 2 {
+		tags[convertObjectToString(extras[i])] = convertObjectToString(extras[i+1])
+	}
+	if executor.batch != nil {
+		tags["batch ID"] = fmt.Sprintf("%d", executor.batch.ID)
+	}
+	if executor.actionID != 0 {
+		tags["action ID"] = fmt.Sprintf("%d", executor.actionID)
+	}
+
+	return tags
+}
+
+func (executor *bridgeExecutor) createPostmortemData(extras ...interface{}) map[string]interface{} {
+	details := make(map[string]string)
+	for i := 0; i < len(extras)-1; i += 2 {
+		details[convertObjectToString(extras[i])] = convertObjectToString(extras[i+1])
+	}
+
+	signatures := executor.sigsHolder.Signatures(executor.msgHash.Bytes())
+	hexSignatures := make([]string, 0, len(signatures))
+	for _, sig := range signatures {
+		hexSignatures = append(hexSignatures, hex.EncodeToString(sig))
+	}
+
+	return map[string]interface{}{
+		"details":     details,
+		"batch":       executor.batch,
+		"actionID":    executor.actionID,
+		"messageHash": executor.msgHash.Hex(),
+		"signatures":  hexSignatures,
+	}
 }
 
 func (executor *bridgeExecutor) setExecutionMessageInStatusHandler(level logger.LogLevel, message string, extras ...interface{}) {
@@ -166,14 +327,159 @@ func (executor *bridgeExecutor) StoreBatchFromMultiversX(batch *bridgeCore.Trans
 	}
 
 	executor.batch = batch
+	executor.performActionTxHash = ""
+	executor.executeTransferTxHash = ""
+	executor.isBatchRejected = false
+	executor.slaTracker.TransferStarted(executor.statusHandler.Name(), batch.ID)
+	executor.trackExecutionDeadline(batch)
+
 	return nil
 }
 
+// trackExecutionDeadline starts the execution deadline of the provided batch when it is seen for the first time and
+// reports the elapsed deadline once. The deadline is measured from the local time the batch was first fetched, so it is
+// restarted by a relayer restart. The batch is not rejected on this deadline, as the relayers can not agree on a local
+// time, see ShouldRejectStoredBatch for the rejection deadline counted in MultiversX blocks
+func (executor *bridgeExecutor) trackExecutionDeadline(batch *bridgeCore.TransferBatch) {
+	if executor.executionDeadline == 0 {
+		return
+	}
+
+	now := executor.timer.NowUnix()
+	if batch.ID != executor.deadlineBatchID || executor.deadlineStartTimestamp == 0 {
+		executor.deadlineBatchID = batch.ID
+		executor.deadlineStartTimestamp = now
+		executor.isDeadlineReported = false
+		return
+	}
+
+	if executor.isDeadlineReported || now-executor.deadlineStartTimestamp < executor.executionDeadline {
+		return
+	}
+
+	executor.isDeadlineReported = true
+	message := "execution deadline elapsed"
+	executor.log.Error(message, "batch ID", batch.ID, "deadline in seconds", executor.executionDeadline)
+	executor.errorReporter.ReportError(executor.statusHandler.Name(), message, map[string]string{
+		"batch ID": fmt.Sprintf("%d", batch.ID),
+	})
+}
+
+// ShouldRejectStoredBatch returns true if the stored batch should be rejected on Ethereum instead of being executed, as
+// one of its deposits was resolved as refunded by an operator or as the batch is older than the rejection deadline,
+// counted in MultiversX blocks from the block of its first deposit. The rejection executes the batch without any
+// deposit, signed by the relayers as any other execution, so it only happens if a quorum of relayers rejects the batch.
+// Once executed, the batch ID can not be executed again with the signatures given for its deposits, and the empty
+// statuses read from Ethereum make all the relayers set the deposits as rejected on MultiversX. The batch is never
+// rejected while an execution sent by this relayer for the batch is still pending
+func (executor *bridgeExecutor) ShouldRejectStoredBatch(ctx context.Context) bool {
+	executor.isBatchRejected = false
+	if executor.batch == nil {
+		return false
+	}
+
+	reason := executor.getRejectionReason(ctx)
+	if len(reason) == 0 {
+		return false
+	}
+
+	isPending, err := executor.isSentTransferPending(ctx)
+	if err != nil || isPending {
+		executor.log.Debug("the batch is not rejected while the execution sent by this relayer may be pending",
+			"batch ID", executor.batch.ID, "tx hash", executor.sentTransferTxHash, "error", err)
+		return false
+	}
+
+	executor.isBatchRejected = true
+	executor.log.Warn("the batch will be rejected on Ethereum", "batch ID", executor.batch.ID, "reason", reason)
+
+	return true
+}
+
+func (executor *bridgeExecutor) getRejectionReason(ctx context.Context) string {
+	for _, deposit := range executor.batch.Deposits {
+		if executor.deadLetters.IsRefunded(executor.statusHandler.Name(), deposit.Nonce) {
+			return fmt.Sprintf("deposit nonce %d refunded by an operator", deposit.Nonce)
+		}
+	}
+
+	if executor.rejectionDeadlineInBlocks == 0 {
+		return ""
+	}
+
+	currentNonce, err := executor.multiversXClient.GetCurrentNonce(ctx)
+	if err != nil {
+		executor.log.Debug("can not check the rejection deadline", "batch ID", executor.batch.ID, "error", err)
+		return ""
+	}
+	if currentNonce < executor.batch.BlockNumber+executor.rejectionDeadlineInBlocks {
+		return ""
+	}
+
+	return fmt.Sprintf("rejection deadline of %d blocks elapsed, batch block nonce %d, current nonce %d",
+		executor.rejectionDeadlineInBlocks, executor.batch.BlockNumber, currentNonce)
+}
+
+func (executor *bridgeExecutor) isSentTransferPending(ctx context.Context) (bool, error) {
+	if executor.sentTransferBatchID != executor.batch.ID || len(executor.sentTransferTxHash) == 0 {
+		return false, nil
+	}
+
+	return executor.ethereumClient.IsTransactionPending(ctx, executor.sentTransferTxHash)
+}
+
 // GetStoredBatch returns the stored batch
 func (executor *bridgeExecutor) GetStoredBatch() *bridgeCore.TransferBatch {
 	return executor.batch
 }
 
+// GetLiveView returns the view of the stored batch, with its action ID and the number of signatures collected, or nil if
+// no batch is stored. It must be called from the state machine's go routine, e.g. by a step hook
+func (executor *bridgeExecutor) GetLiveView() *bridgeCore.BatchLiveView {
+	if executor.batch == nil {
+		return nil
+	}
+
+	view := &bridgeCore.BatchLiveView{
+		BatchID:  executor.batch.ID,
+		ActionID: executor.actionID,
+		Deposits: make([]*bridgeCore.DepositLiveView, 0, len(executor.batch.Deposits)),
+	}
+	if executor.msgHash != (common.Hash{}) {
+		view.MessageHash = executor.msgHash.Hex()
+		view.NumSignatures = len(executor.sigsHolder.Signatures(executor.msgHash.Bytes()))
+	}
+
+	for i, deposit := range executor.batch.Deposits {
+		amount := "0"
+		if deposit.Amount != nil {
+			amount = deposit.Amount.String()
+		}
+
+		status := bridgeCore.DepositPending
+		if i < len(executor.batch.Statuses) {
+			switch executor.batch.Statuses[i] {
+			case bridgeCore.Executed:
+				status = bridgeCore.DepositExecuted
+			case bridgeCore.Rejected:
+				status = bridgeCore.DepositRejected
+			}
+		}
+
+		view.Deposits = append(view.Deposits, &bridgeCore.DepositLiveView{
+			Nonce:  deposit.Nonce,
+			From:   deposit.DisplayableFrom,
+			To:     deposit.DisplayableTo,
+			Token:  deposit.DisplayableToken,
+			Amount: amount,
+			TxHash: deposit.TxHash,
+			Status: status,
+		})
+	}
+
+	return view
+}
+
 // GetLastExecutedEthBatchIDFromMultiversX returns the last executed batch ID that is stored on the MultiversX SC
 func (executor *bridgeExecutor) GetLastExecutedEthBatchIDFromMultiversX(ctx context.Context) (uint64, error) {
 	batchID, err := executor.multiversXClient.GetLastExecutedEthBatchID(ctx)
@@ -216,9 +522,13 @@ func (executor *bridgeExecutor) GetAndStoreActionIDForProposeTransferOnMultivers
 		return InvalidActionID, ErrNilBatch
 	}
 
-	actionID, err := executor.multiversXClient.GetActionIDForProposeTransfer(ctx, executor.batch)
-	if err != nil {
-		return InvalidActionID, err
+	actionID, isPrefetched := executor.consumePrefetchedActionID()
+	if !isPrefetched {
+		var err error
+		actionID, err = executor.multiversXClient.GetActionIDForProposeTransfer(ctx, executor.batch)
+		if err != nil {
+			return InvalidActionID, err
+		}
 	}
 
 	executor.actionID = actionID
@@ -232,9 +542,13 @@ func (executor *bridgeExecutor) GetAndStoreActionIDForProposeSetStatusFromMultiv
 		return InvalidActionID, ErrNilBatch
 	}
 
-	actionID, err := executor.multiversXClient.GetActionIDForSetStatusOnPendingTransfer(ctx, executor.batch)
-	if err != nil {
-		return InvalidActionID, err
+	actionID, isPrefetched := executor.consumePrefetchedActionID()
+	if !isPrefetched {
+		var err error
+		actionID, err = executor.multiversXClient.GetActionIDForSetStatusOnPendingTransfer(ctx, executor.batch)
+		if err != nil {
+			return InvalidActionID, err
+		}
 	}
 
 	executor.actionID = actionID
@@ -242,6 +556,21 @@ func (executor *bridgeExecutor) GetAndStoreActionIDForProposeSetStatusFromMultiv
 	return actionID, nil
 }
 
+// consumePrefetchedActionID returns, only once, the action ID fetched together with the was proposed check of the
+// currently stored batch
+func (executor *bridgeExecutor) consumePrefetchedActionID() (uint64, bool) {
+	actionID := executor.prefetchedActionID
+	isPrefetched := actionID != InvalidActionID && executor.prefetchedActionIDBatch == executor.batch
+	executor.storePrefetchedActionID(InvalidActionID)
+
+	return actionID, isPrefetched
+}
+
+func (executor *bridgeExecutor) storePrefetchedActionID(actionID uint64) {
+	executor.prefetchedActionID = actionID
+	executor.prefetchedActionIDBatch = executor.batch
+}
+
 // GetStoredActionID returns the stored action ID
 func (executor *bridgeExecutor) GetStoredActionID() uint64 {
 	return executor.actionID
@@ -253,7 +582,15 @@ func (executor *bridgeExecutor) WasTransferProposedOnMultiversX(ctx context.Cont
 		return false, ErrNilBatch
 	}
 
-	return executor.multiversXClient.WasProposedTransfer(ctx, executor.batch)
+	executor.preAgreement.SetLocalView(executor.batch)
+
+	wasProposed, actionID, err := executor.multiversXClient.WasProposedTransferWithActionID(ctx, executor.batch)
+	if err != nil || !wasProposed {
+		actionID = InvalidActionID
+	}
+	executor.storePrefetchedActionID(actionID)
+
+	return wasProposed, err
 }
 
 // ProposeTransferOnMultiversX propose the transfer on MultiversX
@@ -262,13 +599,24 @@ func (executor *bridgeExecutor) ProposeTransferOnMultiversX(ctx context.Context)
 		return ErrNilBatch
 	}
 
+	idempotencyKey, err := executor.checkBatchNotCompleted()
+	if err != nil {
+		return err
+	}
+
+	err = executor.preAgreement.RequestAgreement(ctx, executor.batch)
+	if err != nil {
+		return err
+	}
+
 	hash, err := executor.multiversXClient.ProposeTransfer(ctx, executor.batch)
 	if err != nil {
+		executor.recordFailure(executor.batch, err)
 		return err
 	}
 
 	executor.log.Info("proposed transfer", "hash", hash,
-		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+		"batch ID", executor.batch.ID, "action ID", executor.actionID, "idempotency key", idempotencyKey)
 
 	return nil
 }
@@ -294,7 +642,15 @@ func (executor *bridgeExecutor) WasSetStatusProposedOnMultiversX(ctx context.Con
 		return false, ErrNilBatch
 	}
 
-	return executor.multiversXClient.WasProposedSetStatus(ctx, executor.batch)
+	executor.preAgreement.SetLocalView(executor.batch)
+
+	wasProposed, actionID, err := executor.multiversXClient.WasProposedSetStatusWithActionID(ctx, executor.batch)
+	if err != nil || !wasProposed {
+		actionID = InvalidActionID
+	}
+	executor.storePrefetchedActionID(actionID)
+
+	return wasProposed, err
 }
 
 // ProposeSetStatusOnMultiversX propose set status on MultiversX
@@ -303,13 +659,23 @@ func (executor *bridgeExecutor) ProposeSetStatusOnMultiversX(ctx context.Context
 		return ErrNilBatch
 	}
 
+	idempotencyKey, err := executor.checkBatchNotCompleted()
+	if err != nil {
+		return err
+	}
+
+	err = executor.preAgreement.RequestAgreement(ctx, executor.batch)
+	if err != nil {
+		return err
+	}
+
 	hash, err := executor.multiversXClient.ProposeSetStatus(ctx, executor.batch)
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("proposed set status", "hash", hash,
-		"batch ID", executor.batch.ID)
+		"batch ID", executor.batch.ID, "idempotency key", idempotencyKey)
 
 	return nil
 }
@@ -321,12 +687,27 @@ func (executor *bridgeExecutor) WasActionSignedOnMultiversX(ctx context.Context)
 
 // SignActionOnMultiversX calls the MultiversX client to generate and send the signature
 func (executor *bridgeExecutor) SignActionOnMultiversX(ctx context.Context) error {
+	idempotencyKey, err := executor.checkBatchNotCompleted()
+	if err != nil {
+		return err
+	}
+	if executor.topologyProvider.IsSelfOnProbation() {
+		executor.log.Info("relayer on probation, the action is not signed", "action ID", executor.actionID,
+			"idempotency key", idempotencyKey)
+		return nil
+	}
+
 	hash, err := executor.multiversXClient.Sign(ctx, executor.actionID)
 	if err != nil {
 		return err
 	}
 
-	executor.log.Info("signed proposed transfer", "hash", hash, "action ID", executor.actionID)
+	executor.log.Info("signed proposed transfer", "hash", hash, "action ID", executor.actionID,
+		"idempotency key", idempotencyKey)
+
+	actionIDBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(actionIDBytes, executor.actionID)
+	executor.recordSignature(multiversXActionSignaturePurpose, actionIDBytes)
 
 	return nil
 }
@@ -346,7 +727,8 @@ func (executor *bridgeExecutor) WaitForTransferConfirmation(ctx context.Context)
 	}
 }
 
-// WaitAndReturnFinalBatchStatuses waits for the statuses to be final
+// WaitAndReturnFinalBatchStatuses waits for the statuses to be final. If this relayer sent the execute transfer
+// transaction, the transfer receipts are generated with the final statuses
 func (executor *bridgeExecutor) WaitAndReturnFinalBatchStatuses(ctx context.Context) []byte {
 	for i := 0; i < splits; i++ {
 		if !executor.waitWithContextSucceeded(ctx) {
@@ -364,42 +746,234 @@ func (executor *bridgeExecutor) WaitAndReturnFinalBatchStatuses(ctx context.Cont
 		}
 
 		executor.log.Debug("bridgeExecutor.WaitAndReturnFinalBatchStatuses", "statuses", statuses)
+		executor.generateReceiptsOnEthereum(ctx, statuses)
+
 		return statuses
 	}
 
 	return nil
 }
 
-func (executor *bridgeExecutor) waitWithContextSucceeded(ctx context.Context) bool {
-	timer := time.NewTimer(executor.timeForWaitOnEthereum / splits)
-	defer timer.Stop()
+// generateReceiptsOnEthereum generates the transfer receipts of the batch executed on Ethereum by this relayer. The
+// statuses are in the order of the batch deposits
+func (executor *bridgeExecutor) generateReceiptsOnEthereum(ctx context.Context, statuses []byte) {
+	if executor.batch == nil || len(executor.executeTransferTxHash) == 0 {
+		return
+	}
+
+	txHash := executor.executeTransferTxHash
+	executor.executeTransferTxHash = ""
+
+	depositsStatuses := make(map[uint64]string, len(executor.batch.Deposits))
+	for i, deposit := range executor.batch.Deposits {
+		if i >= len(statuses) {
+			break
+		}
+
+		switch statuses[i] {
+		case bridgeCore.Executed:
+			depositsStatuses[deposit.Nonce] = bridgeCore.DepositExecuted
+		case bridgeCore.Rejected:
+			depositsStatuses[deposit.Nonce] = bridgeCore.DepositRejected
+		}
+	}
 
+	executor.transferReceipts.GenerateReceipts(ctx, executor.statusHandler.Name(), executor.batch, txHash, depositsStatuses)
+}
+
+func (executor *bridgeExecutor) waitWithContextSucceeded(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
 		executor.log.Debug("closing due to context expiration")
 		return false
-	case <-timer.C:
+	case <-executor.timer.After(executor.timeForWaitOnEthereum / splits):
 		return true
 	}
 }
 
-// GetBatchStatusesFromEthereum gets statuses for the batch
+// GetBatchStatusesFromEthereum gets statuses for the batch. The final statuses returned by the contract are refined
+// with the per-deposit statuses resolved from the execution events, if all of them are available. The deposits towards
+// the recipients that are not allowlisted or not valid are set as rejected, as are all the deposits of a batch executed
+// without any deposit, i.e. rejected by the relayers
 func (executor *bridgeExecutor) GetBatchStatusesFromEthereum(ctx context.Context) ([]byte, error) {
 	if executor.batch == nil {
 		return nil, ErrNilBatch
 	}
 
-	statuses, err := executor.ethereumClient.GetTransactionsStatuses(ctx, executor.batch.ID)
+	allowlistedBatch := executor.createAllowlistedBatch()
+	if allowlistedBatch != executor.batch && len(allowlistedBatch.Deposits) == 0 {
+		return executor.addRejectedStatuses(make([]byte, 0)), nil
+	}
+
+	statuses, err := executor.getBatchStatusesFromEthereum(ctx, allowlistedBatch)
 	if err != nil {
 		return nil, err
 	}
+	if len(statuses) == 0 {
+		return executor.createRejectedStatuses(), nil
+	}
+	if allowlistedBatch == executor.batch {
+		return statuses, nil
+	}
+
+	return executor.addRejectedStatuses(statuses), nil
+}
+
+func (executor *bridgeExecutor) getBatchStatusesFromEthereum(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error) {
+	statuses, err := executor.ethereumClient.GetTransactionsStatuses(ctx, batch.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return statuses, nil
+	}
+
+	eventsStatuses, err := executor.ethereumClient.GetTransactionsStatusesFromEvents(ctx, batch)
+	if err != nil {
+		executor.log.Debug("using the batch statuses, could not resolve the statuses from the execution events",
+			"batch ID", batch.ID, "error", err)
+		return statuses, nil
+	}
+	if !bytes.Equal(statuses, eventsStatuses) {
+		executor.log.Warn("the execution events statuses differ from the batch statuses", "batch ID", batch.ID,
+			"batch statuses", statuses, "events statuses", eventsStatuses)
+	}
+
+	return eventsStatuses, nil
+}
+
+// createRejectedStatuses returns the statuses of the stored batch with all the deposits rejected
+func (executor *bridgeExecutor) createRejectedStatuses() []byte {
+	statuses := make([]byte, len(executor.batch.Deposits))
+	for i := range statuses {
+		statuses[i] = bridgeCore.Rejected
+	}
+
+	return statuses
+}
+
+// createExecutableBatch returns the batch signed and executed on Ethereum: a copy of the stored batch without any
+// deposit if the batch is rejected, the allowlisted batch otherwise
+func (executor *bridgeExecutor) createExecutableBatch() *bridgeCore.TransferBatch {
+	if !executor.isBatchRejected {
+		return executor.createAllowlistedBatch()
+	}
+
+	return &bridgeCore.TransferBatch{
+		ID:          executor.batch.ID,
+		BlockNumber: executor.batch.BlockNumber,
+		Deposits:    make([]*bridgeCore.DepositTransfer, 0),
+	}
+}
+
+// createAllowlistedBatch returns a copy of the stored batch containing only the executable deposits: the ones towards
+// allowlisted and valid recipients. Only these deposits are executed on Ethereum, the other ones are set as rejected so
+// they get refunded on MultiversX. The filtered batch is signed, so only the configured rules, checked across the
+// relayers with the join messages, decide on it. The stored batch is returned as it is if all deposits are executable
+func (executor *bridgeExecutor) createAllowlistedBatch() *bridgeCore.TransferBatch {
+	allowlistedBatch := &bridgeCore.TransferBatch{
+		ID:          executor.batch.ID,
+		BlockNumber: executor.batch.BlockNumber,
+		Deposits:    make([]*bridgeCore.DepositTransfer, 0, len(executor.batch.Deposits)),
+	}
+	for _, deposit := range executor.batch.Deposits {
+		err := executor.checkDepositExecutable(deposit)
+		if err == nil {
+			allowlistedBatch.Deposits = append(allowlistedBatch.Deposits, deposit)
+			continue
+		}
+
+		executor.log.Debug("deposit will be rejected", "batch ID", executor.batch.ID, "deposit nonce", deposit.Nonce,
+			"recipient", deposit.DisplayableTo, "reason", err)
+	}
+	if len(allowlistedBatch.Deposits) == len(executor.batch.Deposits) {
+		return executor.batch
+	}
+
+	return allowlistedBatch
+}
+
+// addRejectedStatuses expands the statuses of the executable deposits to the whole stored batch, setting the other
+// deposits as rejected
+func (executor *bridgeExecutor) addRejectedStatuses(allowlistedStatuses []byte) []byte {
+	statuses := make([]byte, 0, len(executor.batch.Deposits))
+	index := 0
+	for _, deposit := range executor.batch.Deposits {
+		if executor.checkDepositExecutable(deposit) != nil {
+			statuses = append(statuses, bridgeCore.Rejected)
+			continue
+		}
+		if index < len(allowlistedStatuses) {
+			statuses = append(statuses, allowlistedStatuses[index])
+			index++
+		}
+	}
+
+	return statuses
+}
+
+// checkDepositExecutable returns the reason the provided deposit can not be executed: a recipient that is not
+// allowlisted or not valid on the destination chain
+func (executor *bridgeExecutor) checkDepositExecutable(deposit *bridgeCore.DepositTransfer) error {
+	if !executor.recipientAllowlist.IsAllowed(deposit.ToBytes) {
+		return ErrRecipientNotAllowlisted
+	}
+
+	return executor.recipientValidator.Validate(deposit.ToBytes)
+}
+
+// checkBatchNotCompleted returns the idempotency key of the action on the stored batch, or ErrBatchAlreadyCompleted if
+// the key was already marked as completed. The actions not bound to a batch are not checked
+func (executor *bridgeExecutor) checkBatchNotCompleted() (string, error) {
+	if executor.batch == nil {
+		return "", nil
+	}
 
-	return statuses, nil
+	idempotencyKey, err := executor.batch.IdempotencyKey(executor.statusHandler.Name())
+	if err != nil {
+		return "", err
+	}
+	isCompleted, err := executor.idempotencyGuard.IsCompleted(idempotencyKey)
+	if err != nil {
+		return "", err
+	}
+	if isCompleted {
+		return "", fmt.Errorf("%w: direction %s, batch ID %d, idempotency key %s",
+			ErrBatchAlreadyCompleted, executor.statusHandler.Name(), executor.batch.ID, idempotencyKey)
+	}
+
+	return idempotencyKey, nil
+}
+
+func (executor *bridgeExecutor) markBatchCompleted() {
+	idempotencyKey, err := executor.batch.IdempotencyKey(executor.statusHandler.Name())
+	if err != nil {
+		executor.log.Warn("error computing the idempotency key", "batch ID", executor.batch.ID, "error", err)
+		return
+	}
+
+	err = executor.idempotencyGuard.MarkCompleted(idempotencyKey, executor.statusHandler.Name(), executor.batch.ID)
+	if err != nil {
+		executor.log.Error("error marking the idempotency key as completed", "batch ID", executor.batch.ID,
+			"idempotency key", idempotencyKey, "error", err)
+	}
+}
+
+// recordFailure counts a failed validation or execution of the provided batch in the dead letters store
+func (executor *bridgeExecutor) recordFailure(batch *bridgeCore.TransferBatch, err error) {
+	executor.deadLetters.RecordFailure(executor.statusHandler.Name(), batch, err.Error())
 }
 
 // WasActionPerformedOnMultiversX returns true if the action was already performed
 func (executor *bridgeExecutor) WasActionPerformedOnMultiversX(ctx context.Context) (bool, error) {
-	return executor.multiversXClient.WasExecuted(ctx, executor.actionID)
+	wasPerformed, err := executor.multiversXClient.WasExecuted(ctx, executor.actionID)
+	if wasPerformed && executor.batch != nil {
+		executor.slaTracker.TransferCompleted(executor.statusHandler.Name(), executor.batch.ID)
+		executor.deadLetters.RecordSuccess(executor.statusHandler.Name(), executor.batch)
+		executor.markBatchCompleted()
+	}
+
+	return wasPerformed, err
 }
 
 // PerformActionOnMultiversX sends the perform-action transaction on the MultiversX chain
@@ -408,17 +982,76 @@ func (executor *bridgeExecutor) PerformActionOnMultiversX(ctx context.Context) e
 		return ErrNilBatch
 	}
 
+	idempotencyKey, err := executor.checkBatchNotCompleted()
+	if err != nil {
+		return err
+	}
+
 	hash, err := executor.multiversXClient.PerformAction(ctx, executor.actionID, executor.batch)
 	if err != nil {
+		executor.slaTracker.LeaderSlotMissed(executor.statusHandler.Name())
+		executor.recordFailure(executor.batch, err)
 		return err
 	}
 
 	executor.log.Info("sent perform action transaction", "hash", hash,
-		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+		"batch ID", executor.batch.ID, "action ID", executor.actionID, "idempotency key", idempotencyKey)
+	executor.performActionTxHash = hash
+	executor.costAccounting.RecordExecution(executor.statusHandler.Name(), executor.batch.ID, hash)
 
 	return nil
 }
 
+// StoreBatchResultsFromMultiversX decodes the per-deposit results from the perform action transaction sent by this
+// relayer, stores them and generates the transfer receipts. Does nothing if this relayer did not send the transaction
+func (executor *bridgeExecutor) StoreBatchResultsFromMultiversX(ctx context.Context) {
+	if executor.batch == nil || len(executor.performActionTxHash) == 0 {
+		return
+	}
+
+	txHash := executor.performActionTxHash
+	executor.performActionTxHash = ""
+
+	results, err := executor.multiversXClient.GetBatchResults(ctx, txHash, executor.batch)
+	if err != nil {
+		executor.log.Warn("error decoding the batch results", "batch ID", executor.batch.ID, "hash", txHash, "error", err)
+		return
+	}
+
+	executor.tagBatchResults(results)
+	err = executor.batchResultsStorer.StoreBatchResults(results)
+	if err != nil {
+		executor.log.Warn("error storing the batch results", "batch ID", executor.batch.ID, "hash", txHash, "error", err)
+		return
+	}
+
+	depositsStatuses := make(map[uint64]string, len(results.Deposits))
+	for _, result := range results.Deposits {
+		depositsStatuses[result.Nonce] = result.Status
+	}
+	executor.transferReceipts.GenerateReceipts(ctx, executor.statusHandler.Name(), executor.batch, txHash, depositsStatuses)
+
+	for _, result := range results.Deposits {
+		if result.Status == bridgeCore.DepositRejected {
+			executor.log.Info("deposit rejected on MultiversX", "batch ID", results.BatchID,
+				"deposit nonce", result.Nonce, "reason", result.Reason)
+		}
+	}
+}
+
+// tagBatchResults attaches the operator-defined tags to the batch results and to each deposit result
+func (executor *bridgeExecutor) tagBatchResults(results *bridgeCore.BatchResults) {
+	results.Tags = executor.batchTagger.TagBatch(executor.batch)
+
+	deposits := make(map[uint64]*bridgeCore.DepositTransfer, len(executor.batch.Deposits))
+	for _, deposit := range executor.batch.Deposits {
+		deposits[deposit.Nonce] = deposit
+	}
+	for _, result := range results.Deposits {
+		result.Tags = executor.batchTagger.TagDeposit(deposits[result.Nonce])
+	}
+}
+
 // ResolveNewDepositsStatuses resolves the new deposits statuses for batch
 func (executor *bridgeExecutor) ResolveNewDepositsStatuses(numDeposits uint64) {
 	executor.batch.ResolveNewDeposits(int(numDeposits))
@@ -456,11 +1089,85 @@ func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context
 	if err != nil {
 		return err
 	}
+	err = executor.checkRecipientsAllowlisted(batch)
+	if err != nil {
+		executor.recordFailure(batch, err)
+		return err
+	}
+	err = executor.checkESDTRoles(ctx, batch)
+	if err != nil {
+		executor.recordFailure(batch, err)
+		return err
+	}
+	executor.warnInvalidRecipients(batch)
 	executor.batch = batch
+	executor.performActionTxHash = ""
+	executor.slaTracker.TransferStarted(executor.statusHandler.Name(), batch.ID)
+	executor.trackExecutionDeadline(batch)
+
+	return nil
+}
+
+// checkRecipientsAllowlisted returns an error if the batch contains deposits towards recipients that are not
+// allowlisted. As the deposits from Ethereum can not be rejected by the relayers, such a batch is held back
+func (executor *bridgeExecutor) checkRecipientsAllowlisted(batch *bridgeCore.TransferBatch) error {
+	for _, deposit := range batch.Deposits {
+		if executor.recipientAllowlist.IsAllowed(deposit.ToBytes) {
+			continue
+		}
+
+		executor.log.Error("batch held back, deposit towards a recipient that is not allowlisted",
+			"batch ID", batch.ID, "deposit nonce", deposit.Nonce, "recipient", deposit.DisplayableTo)
+
+		return fmt.Errorf("%w, batch ID %d, deposit nonce %d, recipient %s",
+			ErrRecipientNotAllowlisted, batch.ID, deposit.Nonce, deposit.DisplayableTo)
+	}
+
+	return nil
+}
+
+// checkESDTRoles returns an error if the MultiversX safe contract does not hold the ESDT roles required by the tokens
+// of the batch. The transfers of these tokens would fail on MultiversX, so the batch is held back until the roles are set
+func (executor *bridgeExecutor) checkESDTRoles(ctx context.Context, batch *bridgeCore.TransferBatch) error {
+	checkedTokens := make(map[string]error)
+	var firstErr error
+	affectedNonces := make([]uint64, 0)
+	for _, deposit := range batch.Deposits {
+		err, checked := checkedTokens[string(deposit.DestinationTokenBytes)]
+		if !checked {
+			err = executor.esdtRolesChecker.CheckRoles(ctx, deposit.DestinationTokenBytes)
+			checkedTokens[string(deposit.DestinationTokenBytes)] = err
+		}
+		if err == nil {
+			continue
+		}
+
+		executor.log.Error("batch held back, the ESDT roles check failed for the deposit",
+			"batch ID", batch.ID, "deposit nonce", deposit.Nonce, "token", deposit.DisplayableToken, "error", err)
+		if firstErr == nil {
+			firstErr = err
+		}
+		affectedNonces = append(affectedNonces, deposit.Nonce)
+	}
+	if firstErr != nil {
+		return fmt.Errorf("%w, batch ID %d, affected deposit nonces %v", firstErr, batch.ID, affectedNonces)
+	}
 
 	return nil
 }
 
+// warnInvalidRecipients logs the deposits towards the recipients that are not valid on MultiversX. The batch is not held
+// back because of them: the MultiversX contract rejects these transfers and refunds them on Ethereum
+func (executor *bridgeExecutor) warnInvalidRecipients(batch *bridgeCore.TransferBatch) {
+	for _, deposit := range batch.Deposits {
+		err := executor.recipientValidator.Validate(deposit.ToBytes)
+		if err != nil {
+			executor.log.Warn("deposit towards an invalid recipient, it will be refunded by the MultiversX contract",
+				"batch ID", batch.ID, "deposit nonce", deposit.Nonce, "recipient", deposit.DisplayableTo, "reason", err)
+		}
+	}
+}
+
 // addBatchSCMetadata fetches the logs containing sc calls metadata for the current batch
 func (executor *bridgeExecutor) addBatchSCMetadata(ctx context.Context, transfers *bridgeCore.TransferBatch) (*bridgeCore.TransferBatch, error) {
 	if transfers == nil {
@@ -522,7 +1229,14 @@ func (executor *bridgeExecutor) WasTransferPerformedOnEthereum(ctx context.Conte
 		return false, ErrNilBatch
 	}
 
-	return executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	wasExecuted, err := executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	if wasExecuted {
+		executor.slaTracker.TransferCompleted(executor.statusHandler.Name(), executor.batch.ID)
+		executor.deadLetters.RecordSuccess(executor.statusHandler.Name(), executor.batch)
+		executor.markBatchCompleted()
+	}
+
+	return wasExecuted, err
 }
 
 // SignTransferOnEthereum generates the message hash for batch and broadcast the signature
@@ -531,48 +1245,126 @@ func (executor *bridgeExecutor) SignTransferOnEthereum() error {
 		return ErrNilBatch
 	}
 
-	argLists := batchProcessor.ExtractListMvxToEth(executor.batch)
+	idempotencyKey, err := executor.checkBatchNotCompleted()
+	if err != nil {
+		return err
+	}
+
+	argLists, err := batchProcessor.ExtractListMvxToEth(executor.createExecutableBatch())
+	if err != nil {
+		return err
+	}
+
 	hash, err := executor.ethereumClient.GenerateMessageHash(argLists, executor.batch.ID)
 	if err != nil {
 		return err
 	}
 
 	executor.log.Info("generated message hash on Ethereum", "hash", hash,
-		"batch ID", executor.batch.ID)
+		"batch ID", executor.batch.ID, "idempotency key", idempotencyKey)
 
 	executor.msgHash = hash
 	executor.ethereumClient.BroadcastSignatureForMessageHash(hash)
+	executor.recordSignature(ethereumTransferSignaturePurpose, hash.Bytes())
+
 	return nil
 }
 
+func (executor *bridgeExecutor) recordSignature(purpose string, messageHash []byte) {
+	batchID := uint64(0)
+	if executor.batch != nil {
+		batchID = executor.batch.ID
+	}
+
+	err := executor.signerAuditLog.RecordSignature(purpose, batchID, messageHash)
+	if err != nil {
+		executor.log.Error("error recording the signature in the audit log", "purpose", purpose,
+			"batch ID", batchID, "error", err)
+	}
+}
+
 // PerformTransferOnEthereum transfers a batch to Ethereum
 func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) error {
 	if executor.batch == nil {
 		return ErrNilBatch
 	}
 
+	idempotencyKey, err := executor.checkBatchNotCompleted()
+	if err != nil {
+		return err
+	}
+
 	quorumSize, err := executor.ethereumClient.GetQuorumSize(ctx)
 	if err != nil {
+		executor.slaTracker.LeaderSlotMissed(executor.statusHandler.Name())
 		return err
 	}
 
 	executor.log.Debug("fetched quorum size", "quorum", quorumSize.Int64())
 
-	argLists := batchProcessor.ExtractListMvxToEth(executor.batch)
+	executableBatch := executor.createExecutableBatch()
+	argLists, err := batchProcessor.ExtractListMvxToEth(executableBatch)
+	if err != nil {
+		return err
+	}
 
-	executor.log.Info("executing transfer " + executor.batch.String())
+	executor.log.Info("executing transfer " + executableBatch.String())
 
 	hash, err := executor.ethereumClient.ExecuteTransfer(ctx, executor.msgHash, argLists, executor.batch.ID, int(quorumSize.Int64()))
 	if err != nil {
+		if executor.isTransferDeferred(err) {
+			return nil
+		}
+		executor.slaTracker.LeaderSlotMissed(executor.statusHandler.Name())
+		executor.recordFailure(executor.batch, err)
 		return err
 	}
+	executor.setGasPriceDeferralMetrics(0, 0)
 
 	executor.log.Info("sent execute transfer", "hash", hash,
-		"batch ID", executor.batch.ID)
+		"batch ID", executor.batch.ID, "idempotency key", idempotencyKey)
+	executor.executeTransferTxHash = hash
+	executor.sentTransferBatchID = executor.batch.ID
+	executor.sentTransferTxHash = hash
+	executor.costAccounting.RecordExecution(executor.statusHandler.Name(), executor.batch.ID, hash)
 
 	return nil
 }
 
+// isTransferDeferred returns true if the execution failed because of the gas price being above the maximum allowed and
+// the deferral of the batch did not expire, so the execution is retried at the next leader slot. The deferral is
+// measured from the local time of the first deferred execution of the batch, so it is restarted by a relayer restart
+func (executor *bridgeExecutor) isTransferDeferred(err error) bool {
+	if executor.gasPriceDeferral == 0 || !errors.Is(err, clients.ErrGasPriceIsHigherThanTheMaximumSet) {
+		return false
+	}
+
+	now := executor.timer.NowUnix()
+	if executor.batch.ID != executor.deferredBatchID || executor.deferralStartTimestamp == 0 {
+		executor.deferredBatchID = executor.batch.ID
+		executor.deferralStartTimestamp = now
+	}
+
+	deferredFor := now - executor.deferralStartTimestamp
+	if deferredFor >= executor.gasPriceDeferral {
+		executor.setGasPriceDeferralMetrics(0, 0)
+		executor.log.Error("gas price deferral expired, the transfer can not be executed", "batch ID", executor.batch.ID,
+			"deferral in seconds", executor.gasPriceDeferral)
+		return false
+	}
+
+	executor.setGasPriceDeferralMetrics(executor.batch.ID, deferredFor)
+	executor.log.Warn("gas price above the maximum allowed, the transfer is deferred to the next leader slot",
+		"batch ID", executor.batch.ID, "deferred for seconds", deferredFor, "error", err)
+
+	return true
+}
+
+func (executor *bridgeExecutor) setGasPriceDeferralMetrics(batchID uint64, deferredFor int64) {
+	executor.statusHandler.SetIntMetric(core.MetricGasPriceDeferredBatch, int(batchID))
+	executor.statusHandler.SetIntMetric(core.MetricGasPriceDeferralInSeconds, int(deferredFor))
+}
+
 func (executor *bridgeExecutor) checkCumulatedTransfers(ctx context.Context, ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int, direction batchProcessor.Direction) error {
 	for i, ethToken := range ethTokens {
 		err := executor.balanceValidator.CheckToken(ctx, ethToken, mvxTokens[i], amounts[i], direction)
@@ -587,7 +1379,30 @@ func (executor *bridgeExecutor) checkCumulatedTransfers(ctx context.Context, eth
 func (executor *bridgeExecutor) CheckAvailableTokens(ctx context.Context, ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int, direction batchProcessor.Direction) error {
 	ethTokens, mvxTokens, amounts = executor.getCumulatedTransfers(ethTokens, mvxTokens, amounts)
 
-	return executor.checkCumulatedTransfers(ctx, ethTokens, mvxTokens, amounts, direction)
+	err := executor.checkCumulatedTransfers(ctx, ethTokens, mvxTokens, amounts, direction)
+	if err != nil && executor.batch != nil {
+		executor.recordFailure(executor.batch, err)
+	}
+
+	return err
+}
+
+// ValidateBatch asks the batch validator if the stored batch can be signed. A rejected batch transfers no funds, so it
+// is not validated
+func (executor *bridgeExecutor) ValidateBatch(ctx context.Context) error {
+	if executor.batch == nil {
+		return ErrNilBatch
+	}
+	if executor.isBatchRejected {
+		return nil
+	}
+
+	err := executor.batchValidator.ValidateBatch(ctx, executor.batch)
+	if err != nil {
+		executor.recordFailure(executor.batch, err)
+	}
+
+	return err
 }
 
 func (executor *bridgeExecutor) getCumulatedTransfers(ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int) ([]common.Address, [][]byte, []*big.Int) {
@@ -651,6 +1466,54 @@ func (executor *bridgeExecutor) CheckEthereumClientAvailability(ctx context.Cont
 	return executor.ethereumClient.CheckClientAvailability(ctx)
 }
 
+// executorState is the part of the executor state persisted in the state machine checkpoints. The gob encoding is
+// used because the raw bytes of the deposits are not JSON serialized
+type executorState struct {
+	Batch               *bridgeCore.TransferBatch
+	ActionID            uint64
+	MsgHash             common.Hash
+	IsBatchRejected     bool
+	SentTransferBatchID uint64
+	SentTransferTxHash  string
+}
+
+// SaveState serializes the stored batch, action ID, message hash, batch rejection and last execution sent on Ethereum
+func (executor *bridgeExecutor) SaveState() ([]byte, error) {
+	buff := bytes.Buffer{}
+	err := gob.NewEncoder(&buff).Encode(&executorState{
+		Batch:               executor.batch,
+		ActionID:            executor.actionID,
+		MsgHash:             executor.msgHash,
+		IsBatchRejected:     executor.isBatchRejected,
+		SentTransferBatchID: executor.sentTransferBatchID,
+		SentTransferTxHash:  executor.sentTransferTxHash,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// RestoreState sets the stored batch, action ID, message hash, batch rejection and last execution sent on Ethereum from
+// a buffer created by SaveState
+func (executor *bridgeExecutor) RestoreState(buff []byte) error {
+	state := &executorState{}
+	err := gob.NewDecoder(bytes.NewReader(buff)).Decode(state)
+	if err != nil {
+		return err
+	}
+
+	executor.batch = state.Batch
+	executor.actionID = state.ActionID
+	executor.msgHash = state.MsgHash
+	executor.isBatchRejected = state.IsBatchRejected
+	executor.sentTransferBatchID = state.SentTransferBatchID
+	executor.sentTransferTxHash = state.SentTransferTxHash
+
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (executor *bridgeExecutor) IsInterfaceNil() bool {
 	return executor == nil
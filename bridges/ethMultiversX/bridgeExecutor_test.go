@@ -1,6 +1,7 @@
 package ethmultiversx
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -32,9 +33,25 @@ func createMockExecutorArgs() ArgsBridgeExecutor {
 		EthereumClient:               &bridgeTests.EthereumClientStub{},
 		TopologyProvider:             &bridgeTests.TopologyProviderStub{},
 		StatusHandler:                testsCommon.NewStatusHandlerMock("test"),
+		Timer:                        &testsCommon.TimerMock{},
 		TimeForWaitOnEthereum:        time.Second,
 		SignaturesHolder:             &testsCommon.SignaturesHolderStub{},
 		BalanceValidator:             &testsCommon.BalanceValidatorStub{},
+		BatchValidator:               &testsCommon.BatchValidatorStub{},
+		SignerAuditLog:               &testsCommon.SignerAuditLogStub{},
+		PostmortemCapturer:           &testsCommon.PostmortemCapturerStub{},
+		SLATracker:                   &testsCommon.SLATrackerStub{},
+		ErrorReporter:                &testsCommon.ErrorReporterStub{},
+		BatchResultsStorer:           &testsCommon.BatchResultsStorerStub{},
+		TransferReceipts:             &testsCommon.TransferReceiptsStub{},
+		RecipientAllowlist:           &testsCommon.RecipientAllowlistStub{},
+		RecipientValidator:           &testsCommon.RecipientValidatorStub{},
+		ESDTRolesChecker:             &testsCommon.ESDTRolesCheckerStub{},
+		DeadLetters:                  &testsCommon.DeadLettersStub{},
+		IdempotencyGuard:             &testsCommon.IdempotencyGuardStub{},
+		BatchTagger:                  &testsCommon.BatchTaggerStub{},
+		PreAgreement:                 &testsCommon.PreAgreementStub{},
+		CostAccounting:               &testsCommon.CostAccountingStub{},
 		MaxQuorumRetriesOnEthereum:   minRetries,
 		MaxQuorumRetriesOnMultiversX: minRetries,
 		MaxRestriesOnWasProposed:     minRetries,
@@ -94,6 +111,16 @@ func TestNewBridgeExecutor(t *testing.T) {
 		assert.True(t, check.IfNil(executor))
 		assert.Equal(t, ErrNilStatusHandler, err)
 	})
+	t.Run("nil timer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.Timer = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilTimer, err)
+	})
 	t.Run("invalid time", func(t *testing.T) {
 		t.Parallel()
 
@@ -124,6 +151,156 @@ func TestNewBridgeExecutor(t *testing.T) {
 		assert.True(t, check.IfNil(executor))
 		assert.Equal(t, ErrNilBalanceValidator, err)
 	})
+	t.Run("nil signer audit log", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.SignerAuditLog = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilSignerAuditLog, err)
+	})
+	t.Run("nil postmortem capturer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.PostmortemCapturer = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilPostmortemCapturer, err)
+	})
+	t.Run("nil SLA tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.SLATracker = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilSLATracker, err)
+	})
+	t.Run("nil error reporter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.ErrorReporter = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilErrorReporter, err)
+	})
+	t.Run("nil batch validator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.BatchValidator = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilBatchValidator, err)
+	})
+	t.Run("nil batch results storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.BatchResultsStorer = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilBatchResultsStorer, err)
+	})
+	t.Run("nil transfer receipts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TransferReceipts = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilTransferReceipts, err)
+	})
+	t.Run("nil recipient allowlist", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.RecipientAllowlist = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilRecipientAllowlist, err)
+	})
+	t.Run("nil recipient validator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.RecipientValidator = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilRecipientValidator, err)
+	})
+	t.Run("nil ESDT roles checker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.ESDTRolesChecker = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilESDTRolesChecker, err)
+	})
+	t.Run("nil dead letters", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.DeadLetters = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilDeadLetters, err)
+	})
+	t.Run("nil idempotency guard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.IdempotencyGuard = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilIdempotencyGuard, err)
+	})
+	t.Run("nil batch tagger", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.BatchTagger = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilBatchTagger, err)
+	})
+	t.Run("nil pre-agreement", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.PreAgreement = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilPreAgreement, err)
+	})
+	t.Run("nil cost accounting", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.CostAccounting = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilCostAccounting, err)
+	})
 	t.Run("invalid MaxQuorumRetriesOnEthereum value", func(t *testing.T) {
 		t.Parallel()
 
@@ -183,10 +360,34 @@ func testPrintInfo(t *testing.T, logLevel logger.LogLevel, shouldOutputToStatusH
 	providedMessage := "message"
 	providedArgs := []interface{}{"string", 1, []byte("aaa")}
 	wasCalled := false
+	captureCalled := false
+	stepFailedCalled := false
+	reportErrorCalled := false
 
 	args := createMockExecutorArgs()
 	statusHandler := testsCommon.NewStatusHandlerMock("test")
 	args.StatusHandler = statusHandler
+	args.PostmortemCapturer = &testsCommon.PostmortemCapturerStub{
+		CaptureCalled: func(reason string, data map[string]interface{}) {
+			captureCalled = true
+			assert.Equal(t, providedMessage, reason)
+			assert.Equal(t, map[string]string{"string": "1"}, data["details"])
+		},
+	}
+	args.SLATracker = &testsCommon.SLATrackerStub{
+		StepFailedCalled: func(direction string) {
+			stepFailedCalled = true
+			assert.Equal(t, "test", direction)
+		},
+	}
+	args.ErrorReporter = &testsCommon.ErrorReporterStub{
+		ReportErrorCalled: func(direction string, message string, tags map[string]string) {
+			reportErrorCalled = true
+			assert.Equal(t, "test", direction)
+			assert.Equal(t, providedMessage, message)
+			assert.Equal(t, map[string]string{"string": "1"}, tags)
+		},
+	}
 	args.Log = &testsCommon.LoggerStub{
 		LogCalled: func(logLevel logger.LogLevel, message string, args ...interface{}) {
 			wasCalled = true
@@ -203,6 +404,9 @@ func testPrintInfo(t *testing.T, logLevel logger.LogLevel, shouldOutputToStatusH
 	if shouldOutputToStatusHandler {
 		assert.True(t, len(statusHandler.GetStringMetric(bridgeCore.MetricLastError)) > 0)
 	}
+	assert.Equal(t, logLevel == logger.LogError, captureCalled)
+	assert.Equal(t, logLevel == logger.LogError, stepFailedCalled)
+	assert.Equal(t, logLevel == logger.LogError, reportErrorCalled)
 }
 
 func TestEthToMultiversXBridgeExecutor_MyTurnAsLeader(t *testing.T) {
@@ -395,6 +599,124 @@ func TestEthToMultiversXBridgeExecutor_GetAndStoreBatchFromEthereum(t *testing.T
 		assert.True(t, expectedBatch == executor.GetStoredBatch()) // pointer testing
 		assert.True(t, expectedBatch == executor.batch)
 	})
+	t.Run("recipient not allowlisted should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		providedNonce := uint64(8346)
+		expectedBatch := &bridgeCore.TransferBatch{
+			ID: providedNonce,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, ToBytes: []byte("allowed")},
+				{Nonce: 2, ToBytes: []byte("not allowed")},
+			},
+		}
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+				return expectedBatch, true, nil
+			},
+			GetBatchSCMetadataCalled: func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
+				return make([]*contract.ERC20SafeERC20SCDeposit, 0), nil
+			},
+		}
+		args.RecipientAllowlist = &testsCommon.RecipientAllowlistStub{
+			IsAllowedCalled: func(recipient []byte) bool {
+				return string(recipient) == "allowed"
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		err := executor.GetAndStoreBatchFromEthereum(context.Background(), providedNonce)
+
+		assert.True(t, errors.Is(err, ErrRecipientNotAllowlisted))
+		assert.True(t, strings.Contains(err.Error(), "deposit nonce 2"))
+		assert.Nil(t, executor.GetStoredBatch())
+	})
+	t.Run("invalid recipient should not hold back the batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		providedNonce := uint64(8346)
+		expectedBatch := &bridgeCore.TransferBatch{
+			ID: providedNonce,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, ToBytes: []byte("valid")},
+				{Nonce: 2, ToBytes: []byte("invalid")},
+			},
+		}
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+				return expectedBatch, true, nil
+			},
+			GetBatchSCMetadataCalled: func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
+				return make([]*contract.ERC20SafeERC20SCDeposit, 0), nil
+			},
+		}
+		numValidated := 0
+		args.RecipientValidator = &testsCommon.RecipientValidatorStub{
+			ValidateCalled: func(recipient []byte) error {
+				numValidated++
+				if string(recipient) == "invalid" {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		err := executor.GetAndStoreBatchFromEthereum(context.Background(), providedNonce)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numValidated)
+		assert.True(t, expectedBatch == executor.GetStoredBatch()) // pointer testing
+	})
+	t.Run("missing ESDT role should hold back the batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		providedNonce := uint64(8346)
+		expectedBatch := &bridgeCore.TransferBatch{
+			ID: providedNonce,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, DestinationTokenBytes: []byte("tkn1")},
+				{Nonce: 2, DestinationTokenBytes: []byte("tkn2")},
+				{Nonce: 3, DestinationTokenBytes: []byte("tkn2")},
+				{Nonce: 4, DestinationTokenBytes: []byte("tkn1")},
+			},
+		}
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+				return expectedBatch, true, nil
+			},
+			GetBatchSCMetadataCalled: func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
+				return make([]*contract.ERC20SafeERC20SCDeposit, 0), nil
+			},
+		}
+		checkedTokens := make([]string, 0)
+		args.ESDTRolesChecker = &testsCommon.ESDTRolesCheckerStub{
+			CheckRolesCalled: func(ctx context.Context, token []byte) error {
+				checkedTokens = append(checkedTokens, string(token))
+				if string(token) == "tkn2" {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+		numFailures := 0
+		args.DeadLetters = &testsCommon.DeadLettersStub{
+			RecordFailureCalled: func(direction string, batch *bridgeCore.TransferBatch, reason string) {
+				numFailures++
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		err := executor.GetAndStoreBatchFromEthereum(context.Background(), providedNonce)
+
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.True(t, strings.Contains(err.Error(), "affected deposit nonces [2 3]"))
+		assert.Equal(t, []string{"tkn1", "tkn2"}, checkedTokens)
+		assert.Equal(t, 1, numFailures)
+		assert.Nil(t, executor.GetStoredBatch())
+	})
 	t.Run("should add deposits metadata for sc calls", func(t *testing.T) {
 		t.Parallel()
 
@@ -680,6 +1002,8 @@ func TestEthToMultiversXBridgeExecutor_VerifyLastDepositNonceExecutedOnEthereumB
 func TestEthToMultiversXBridgeExecutor_WasTransferProposedOnMultiversX(t *testing.T) {
 	t.Parallel()
 
+	providedActionID := uint64(2169)
+
 	t.Run("nil batch should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -696,10 +1020,21 @@ func TestEthToMultiversXBridgeExecutor_WasTransferProposedOnMultiversX(t *testin
 		args := createMockExecutorArgs()
 		wasCalled := false
 		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
-			WasProposedTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+			WasProposedTransferWithActionIDCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
 				assert.True(t, providedBatch == batch)
 				wasCalled = true
-				return true, nil
+				return true, providedActionID, nil
+			},
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error) {
+				assert.Fail(t, "should have used the prefetched action ID")
+				return 0, nil
+			},
+		}
+		localViewSet := false
+		args.PreAgreement = &testsCommon.PreAgreementStub{
+			SetLocalViewCalled: func(batch *bridgeCore.TransferBatch) {
+				assert.True(t, providedBatch == batch)
+				localViewSet = true
 			},
 		}
 
@@ -710,6 +1045,39 @@ func TestEthToMultiversXBridgeExecutor_WasTransferProposedOnMultiversX(t *testin
 		assert.True(t, wasProposed)
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
+		assert.True(t, localViewSet)
+
+		actionID, err := executor.GetAndStoreActionIDForProposeTransferOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedActionID, actionID)
+		assert.Equal(t, providedActionID, executor.GetStoredActionID())
+	})
+	t.Run("not proposed should not prefetch the action ID", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		wasQueried := false
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			WasProposedTransferWithActionIDCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
+				return false, providedActionID, nil
+			},
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error) {
+				wasQueried = true
+				return providedActionID + 1, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		wasProposed, err := executor.WasTransferProposedOnMultiversX(context.Background())
+		assert.False(t, wasProposed)
+		assert.Nil(t, err)
+
+		actionID, err := executor.GetAndStoreActionIDForProposeTransferOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasQueried)
+		assert.Equal(t, providedActionID+1, actionID)
 	})
 }
 
@@ -742,37 +1110,98 @@ func TestEthToMultiversXBridgeExecutor_ProposeTransferOnMultiversX(t *testing.T)
 		err := executor.ProposeTransferOnMultiversX(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
-	t.Run("should work", func(t *testing.T) {
+	t.Run("completed batch should not propose", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockExecutorArgs()
-		wasCalled := false
 		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
 			ProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
-				assert.True(t, providedBatch == batch)
-				wasCalled = true
-
+				assert.Fail(t, "should have not been called")
 				return "", nil
 			},
 		}
+		batch := &bridgeCore.TransferBatch{ID: 2}
+		expectedKey, _ := batch.IdempotencyKey(args.StatusHandler.Name())
+		args.IdempotencyGuard = &testsCommon.IdempotencyGuardStub{
+			IsCompletedCalled: func(key string) (bool, error) {
+				assert.Equal(t, expectedKey, key)
+				return true, nil
+			},
+		}
 		executor, _ := NewBridgeExecutor(args)
-		executor.batch = providedBatch
+		executor.batch = batch
 
 		err := executor.ProposeTransferOnMultiversX(context.Background())
-		assert.Nil(t, err)
-		assert.True(t, wasCalled)
+		assert.True(t, errors.Is(err, ErrBatchAlreadyCompleted))
 	})
-}
+	t.Run("idempotency guard error should error", func(t *testing.T) {
+		t.Parallel()
 
-func TestEthToMultiversXBridgeExecutor_WasActionSignedOnMultiversX(t *testing.T) {
-	t.Parallel()
+		args := createMockExecutorArgs()
+		args.IdempotencyGuard = &testsCommon.IdempotencyGuardStub{
+			IsCompletedCalled: func(key string) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
 
-	args := createMockExecutorArgs()
-	providedActionID := uint64(378276)
-	wasCalled := false
-	args.MultiversXClient = &bridgeTests.MultiversXClientStub{
-		WasSignedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
-			assert.Equal(t, providedActionID, actionID)
+		err := executor.ProposeTransferOnMultiversX(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("pre-agreement not reached should not propose", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			ProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+				assert.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+		args.PreAgreement = &testsCommon.PreAgreementStub{
+			RequestAgreementCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) error {
+				assert.True(t, providedBatch == batch)
+				return expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.ProposeTransferOnMultiversX(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		wasCalled := false
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			ProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+				assert.True(t, providedBatch == batch)
+				wasCalled = true
+
+				return "", nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.ProposeTransferOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
+	})
+}
+
+func TestEthToMultiversXBridgeExecutor_WasActionSignedOnMultiversX(t *testing.T) {
+	t.Parallel()
+
+	args := createMockExecutorArgs()
+	providedActionID := uint64(378276)
+	wasCalled := false
+	args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+		WasSignedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+			assert.Equal(t, providedActionID, actionID)
 			wasCalled = true
 			return true, nil
 		},
@@ -807,6 +1236,28 @@ func TestEthToMultiversXBridgeExecutor_SignActionOnMultiversX(t *testing.T) {
 		err := executor.SignActionOnMultiversX(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("relayer on probation should not sign", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+				assert.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+		args.TopologyProvider = &bridgeTests.TopologyProviderStub{
+			IsSelfOnProbationCalled: func() bool {
+				return true
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.actionID = 378276
+
+		err := executor.SignActionOnMultiversX(context.Background())
+		assert.Nil(t, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -821,12 +1272,25 @@ func TestEthToMultiversXBridgeExecutor_SignActionOnMultiversX(t *testing.T) {
 			},
 		}
 
+		wasRecorded := false
+		args.SignerAuditLog = &testsCommon.SignerAuditLogStub{
+			RecordSignatureCalled: func(purpose string, batchID uint64, messageHash []byte) error {
+				assert.Equal(t, multiversXActionSignaturePurpose, purpose)
+				assert.Equal(t, providedBatch.ID, batchID)
+				assert.Equal(t, []byte{0, 0, 0, 0, 0, 5, 197, 164}, messageHash)
+				wasRecorded = true
+				return nil
+			},
+		}
+
 		executor, _ := NewBridgeExecutor(args)
 		executor.actionID = providedActionID
+		executor.batch = providedBatch
 
 		err := executor.SignActionOnMultiversX(context.Background())
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
+		assert.True(t, wasRecorded)
 	})
 }
 
@@ -865,13 +1329,33 @@ func TestEthToMultiversXBridgeExecutor_WasActionPerformedOnMultiversX(t *testing
 			return true, nil
 		},
 	}
+	completedBatchID := uint64(0)
+	args.SLATracker = &testsCommon.SLATrackerStub{
+		TransferCompletedCalled: func(direction string, batchID uint64) {
+			completedBatchID = batchID
+		},
+	}
+	batch := &bridgeCore.TransferBatch{ID: 37}
+	expectedKey, _ := batch.IdempotencyKey(args.StatusHandler.Name())
+	markedKey := ""
+	args.IdempotencyGuard = &testsCommon.IdempotencyGuardStub{
+		MarkCompletedCalled: func(key string, direction string, batchID uint64) error {
+			assert.Equal(t, args.StatusHandler.Name(), direction)
+			assert.Equal(t, uint64(37), batchID)
+			markedKey = key
+			return nil
+		},
+	}
 	executor, _ := NewBridgeExecutor(args)
 	executor.actionID = providedActionID
+	executor.batch = batch
 
 	wasPerformed, err := executor.WasActionPerformedOnMultiversX(context.Background())
 	assert.True(t, wasPerformed)
 	assert.Nil(t, err)
 	assert.True(t, wasCalled)
+	assert.Equal(t, uint64(37), completedBatchID)
+	assert.Equal(t, expectedKey, markedKey)
 }
 
 func TestEthToMultiversXBridgeExecutor_PerformActionOnMultiversX(t *testing.T) {
@@ -898,12 +1382,19 @@ func TestEthToMultiversXBridgeExecutor_PerformActionOnMultiversX(t *testing.T) {
 				return "", expectedErr
 			},
 		}
+		leaderSlotMissed := false
+		args.SLATracker = &testsCommon.SLATrackerStub{
+			LeaderSlotMissedCalled: func(direction string) {
+				leaderSlotMissed = true
+			},
+		}
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
 		executor.actionID = providedActionID
 
 		err := executor.PerformActionOnMultiversX(context.Background())
 		assert.Equal(t, expectedErr, err)
+		assert.True(t, leaderSlotMissed)
 	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
@@ -916,7 +1407,15 @@ func TestEthToMultiversXBridgeExecutor_PerformActionOnMultiversX(t *testing.T) {
 				assert.Equal(t, providedActionID, actionID)
 				assert.True(t, providedBatch == batch)
 				wasCalled = true
-				return "", nil
+				return "hash", nil
+			},
+		}
+		recordedHash := ""
+		args.CostAccounting = &testsCommon.CostAccountingStub{
+			RecordExecutionCalled: func(direction string, batchID uint64, hash string) {
+				assert.Equal(t, "test", direction)
+				assert.Equal(t, providedBatch.ID, batchID)
+				recordedHash = hash
 			},
 		}
 		executor, _ := NewBridgeExecutor(args)
@@ -926,6 +1425,160 @@ func TestEthToMultiversXBridgeExecutor_PerformActionOnMultiversX(t *testing.T) {
 		err := executor.PerformActionOnMultiversX(context.Background())
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
+		assert.Equal(t, "hash", recordedHash)
+	})
+}
+
+func TestEthToMultiversXBridgeExecutor_StoreBatchResultsFromMultiversX(t *testing.T) {
+	t.Parallel()
+
+	providedResults := &bridgeCore.BatchResults{
+		BatchID: providedBatch.ID,
+		TxHash:  "hash",
+	}
+	createArgs := func(storedResults *[]*bridgeCore.BatchResults) ArgsBridgeExecutor {
+		args := createMockExecutorArgs()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
+				return "hash", nil
+			},
+			GetBatchResultsCalled: func(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error) {
+				assert.Equal(t, "hash", txHash)
+				assert.True(t, providedBatch == batch)
+				return providedResults, nil
+			},
+		}
+		args.BatchResultsStorer = &testsCommon.BatchResultsStorerStub{
+			StoreBatchResultsCalled: func(results *bridgeCore.BatchResults) error {
+				*storedResults = append(*storedResults, results)
+				return nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("action not performed by this relayer should not store", func(t *testing.T) {
+		t.Parallel()
+
+		storedResults := make([]*bridgeCore.BatchResults, 0)
+		executor, _ := NewBridgeExecutor(createArgs(&storedResults))
+		executor.batch = providedBatch
+
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		assert.Empty(t, storedResults)
+	})
+	t.Run("decoding errors should not store", func(t *testing.T) {
+		t.Parallel()
+
+		storedResults := make([]*bridgeCore.BatchResults, 0)
+		args := createArgs(&storedResults)
+		args.MultiversXClient.(*bridgeTests.MultiversXClientStub).GetBatchResultsCalled = func(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error) {
+			return nil, expectedErr
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.PerformActionOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		assert.Empty(t, storedResults)
+	})
+	t.Run("should store once", func(t *testing.T) {
+		t.Parallel()
+
+		storedResults := make([]*bridgeCore.BatchResults, 0)
+		executor, _ := NewBridgeExecutor(createArgs(&storedResults))
+		executor.batch = providedBatch
+
+		err := executor.PerformActionOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		assert.Equal(t, []*bridgeCore.BatchResults{providedResults}, storedResults)
+	})
+	t.Run("should generate the transfer receipts with the deposits results", func(t *testing.T) {
+		t.Parallel()
+
+		storedResults := make([]*bridgeCore.BatchResults, 0)
+		args := createArgs(&storedResults)
+		args.MultiversXClient.(*bridgeTests.MultiversXClientStub).GetBatchResultsCalled = func(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error) {
+			return &bridgeCore.BatchResults{
+				BatchID: batch.ID,
+				TxHash:  txHash,
+				Deposits: []*bridgeCore.DepositResult{
+					{Nonce: 1, Status: bridgeCore.DepositExecuted},
+					{Nonce: 2, Status: bridgeCore.DepositRejected},
+				},
+			}, nil
+		}
+		numCalls := 0
+		args.TransferReceipts = &testsCommon.TransferReceiptsStub{
+			GenerateReceiptsCalled: func(ctx context.Context, direction string, batch *bridgeCore.TransferBatch, destinationTxHash string, statuses map[uint64]string) {
+				numCalls++
+				assert.Equal(t, "test", direction)
+				assert.True(t, providedBatch == batch)
+				assert.Equal(t, "hash", destinationTxHash)
+				expectedStatuses := map[uint64]string{
+					1: bridgeCore.DepositExecuted,
+					2: bridgeCore.DepositRejected,
+				}
+				assert.Equal(t, expectedStatuses, statuses)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		err := executor.PerformActionOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("should attach the tags", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID: 1,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, Amount: big.NewInt(10)},
+				{Nonce: 2, Amount: big.NewInt(2000)},
+			},
+		}
+		storedResults := make([]*bridgeCore.BatchResults, 0)
+		args := createArgs(&storedResults)
+		args.MultiversXClient.(*bridgeTests.MultiversXClientStub).GetBatchResultsCalled = func(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error) {
+			return &bridgeCore.BatchResults{
+				BatchID: batch.ID,
+				TxHash:  txHash,
+				Deposits: []*bridgeCore.DepositResult{
+					{Nonce: 1, Status: bridgeCore.DepositExecuted},
+					{Nonce: 2, Status: bridgeCore.DepositRejected},
+				},
+			}, nil
+		}
+		args.BatchTagger = &testsCommon.BatchTaggerStub{
+			TagBatchCalled: func(b *bridgeCore.TransferBatch) []string {
+				assert.True(t, batch == b)
+				return []string{"static", "high-value"}
+			},
+			TagDepositCalled: func(deposit *bridgeCore.DepositTransfer) []string {
+				if deposit.Amount.Int64() > 1000 {
+					return []string{"high-value"}
+				}
+				return nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+
+		err := executor.PerformActionOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		executor.StoreBatchResultsFromMultiversX(context.Background())
+		assert.Equal(t, 1, len(storedResults))
+		assert.Equal(t, []string{"static", "high-value"}, storedResults[0].Tags)
+		assert.Nil(t, storedResults[0].Deposits[0].Tags)
+		assert.Equal(t, []string{"high-value"}, storedResults[0].Deposits[1].Tags)
 	})
 }
 
@@ -1071,6 +1724,8 @@ func TestMultiversXToEthBridgeExecutor_GetAndStoreActionIDForProposeSetStatusFro
 func TestMultiversXToEthBridgeExecutor_WasSetStatusProposedOnMultiversX(t *testing.T) {
 	t.Parallel()
 
+	providedActionID := uint64(2169)
+
 	t.Run("nil batch should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -1086,8 +1741,8 @@ func TestMultiversXToEthBridgeExecutor_WasSetStatusProposedOnMultiversX(t *testi
 
 		args := createMockExecutorArgs()
 		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
-			WasProposedSetStatusCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
-				return false, expectedErr
+			WasProposedSetStatusWithActionIDCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
+				return false, 0, expectedErr
 			},
 		}
 
@@ -1102,10 +1757,22 @@ func TestMultiversXToEthBridgeExecutor_WasSetStatusProposedOnMultiversX(t *testi
 		wasCalled := false
 		args := createMockExecutorArgs()
 		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
-			WasProposedSetStatusCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+			WasProposedSetStatusWithActionIDCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
 				assert.True(t, providedBatch == batch)
 				wasCalled = true
-				return true, nil
+				return true, providedActionID, nil
+			},
+			GetActionIDForSetStatusOnPendingTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error) {
+				assert.Fail(t, "should have used the prefetched action ID")
+				return 0, nil
+			},
+		}
+
+		localViewSet := false
+		args.PreAgreement = &testsCommon.PreAgreementStub{
+			SetLocalViewCalled: func(batch *bridgeCore.TransferBatch) {
+				assert.True(t, providedBatch == batch)
+				localViewSet = true
 			},
 		}
 
@@ -1115,6 +1782,11 @@ func TestMultiversXToEthBridgeExecutor_WasSetStatusProposedOnMultiversX(t *testi
 		assert.True(t, wasCalled)
 		assert.True(t, wasProposed)
 		assert.Nil(t, err)
+		assert.True(t, localViewSet)
+
+		actionID, err := executor.GetAndStoreActionIDForProposeSetStatusFromMultiversX(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, providedActionID, actionID)
 	})
 }
 
@@ -1145,6 +1817,27 @@ func TestEthToMultiversXBridgeExecutor_ProposeSetStatusOnMultiversX(t *testing.T
 		err := executor.ProposeSetStatusOnMultiversX(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("pre-agreement not reached should not propose", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			ProposeSetStatusCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+				assert.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+		args.PreAgreement = &testsCommon.PreAgreementStub{
+			RequestAgreementCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) error {
+				return expectedErr
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		err := executor.ProposeSetStatusOnMultiversX(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -1226,6 +1919,14 @@ func TestMultiversXToEthBridgeExecutor_WasTransferPerformedOnEthereum(t *testing
 			},
 		}
 
+		wasSuccessRecorded := false
+		args.DeadLetters = &testsCommon.DeadLettersStub{
+			RecordSuccessCalled: func(direction string, batch *bridgeCore.TransferBatch) {
+				assert.True(t, providedBatch == batch)
+				wasSuccessRecorded = true
+			},
+		}
+
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
 		executor.batch.ID = providedBatchID
@@ -1233,6 +1934,7 @@ func TestMultiversXToEthBridgeExecutor_WasTransferPerformedOnEthereum(t *testing
 		_, err := executor.WasTransferPerformedOnEthereum(context.Background())
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
+		assert.True(t, wasSuccessRecorded)
 	})
 }
 
@@ -1248,6 +1950,28 @@ func TestMultiversXToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 		err := executor.SignTransferOnEthereum()
 		assert.Equal(t, ErrNilBatch, err)
 	})
+	t.Run("malformed batch should not be signed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GenerateMessageHashCalled: func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error) {
+				assert.Fail(t, "should have not generated the message hash")
+				return common.Hash{}, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{
+			ID: 37,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, ToBytes: common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c").Bytes(), Amount: big.NewInt(1)},
+				{Nonce: 1, ToBytes: common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c").Bytes(), Amount: big.NewInt(2)},
+			},
+		}
+		err := executor.SignTransferOnEthereum()
+		assert.True(t, errors.Is(err, batchProcessor.ErrDuplicateNonce))
+	})
 	t.Run("GenerateMessageHash fails", func(t *testing.T) {
 		t.Parallel()
 
@@ -1278,6 +2002,16 @@ func TestMultiversXToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 				wasCalledBroadcastSignatureForMessageHashCalled = true
 			},
 		}
+		wasRecorded := false
+		args.SignerAuditLog = &testsCommon.SignerAuditLogStub{
+			RecordSignatureCalled: func(purpose string, batchID uint64, messageHash []byte) error {
+				assert.Equal(t, ethereumTransferSignaturePurpose, purpose)
+				assert.Equal(t, providedBatch.ID, batchID)
+				assert.Equal(t, common.Hash{}.Bytes(), messageHash)
+				wasRecorded = true
+				return expectedErr // recording errors should not fail the signing
+			},
+		}
 
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
@@ -1285,6 +2019,29 @@ func TestMultiversXToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalledGenerateMessageHashCalled)
 		assert.True(t, wasCalledBroadcastSignatureForMessageHashCalled)
+		assert.True(t, wasRecorded)
+	})
+	t.Run("rejected batch should sign the batch without any deposit", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GenerateMessageHashCalled: func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error) {
+				assert.Equal(t, providedBatch.ID, batchID)
+				assert.Empty(t, batch.Nonces)
+				assert.Empty(t, batch.Amounts)
+				wasCalled = true
+				return common.Hash{}, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		executor.isBatchRejected = true
+		err := executor.SignTransferOnEthereum()
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
 	})
 }
 
@@ -1359,7 +2116,15 @@ func TestMultiversXToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 				assert.True(t, providedQuorum == quorum)
 
 				wasCalledExecuteTransferCalled = true
-				return "", nil
+				return "hash", nil
+			},
+		}
+		recordedHash := ""
+		args.CostAccounting = &testsCommon.CostAccountingStub{
+			RecordExecutionCalled: func(direction string, batchID uint64, hash string) {
+				assert.Equal(t, "test", direction)
+				assert.Equal(t, providedBatch.ID, batchID)
+				recordedHash = hash
 			},
 		}
 
@@ -1370,45 +2135,307 @@ func TestMultiversXToEthBridgeExecutor_PerformTransferOnEthereum(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalledGetQuorumSizeCalled)
 		assert.True(t, wasCalledExecuteTransferCalled)
+		assert.Equal(t, "hash", recordedHash)
 	})
-}
-
-func TestMultiversXToEthBridgeExecutor_IsQuorumReachedOnEthereum(t *testing.T) {
-	t.Parallel()
-
-	t.Run("ProcessQuorumReachedOnEthereum fails", func(t *testing.T) {
+	t.Run("should not execute the deposits towards the recipients that are not allowlisted", func(t *testing.T) {
 		t.Parallel()
 
+		allowedRecipient := common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+		batch := &bridgeCore.TransferBatch{
+			ID: 37,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, ToBytes: common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c").Bytes(), Amount: big.NewInt(1)},
+				{Nonce: 2, ToBytes: allowedRecipient.Bytes(), Amount: big.NewInt(2)},
+			},
+		}
+		wasCalledExecuteTransferCalled := false
 		args := createMockExecutorArgs()
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
-			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
-				return false, expectedErr
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				assert.Equal(t, batch.ID, batchId)
+				assert.Equal(t, []common.Address{allowedRecipient}, argLists.Recipients)
+				assert.Equal(t, []*big.Int{big.NewInt(2)}, argLists.Nonces)
+
+				wasCalledExecuteTransferCalled = true
+				return "", nil
+			},
+		}
+		args.RecipientAllowlist = &testsCommon.RecipientAllowlistStub{
+			IsAllowedCalled: func(recipient []byte) bool {
+				return bytes.Equal(recipient, allowedRecipient.Bytes())
 			},
 		}
 
 		executor, _ := NewBridgeExecutor(args)
-
-		_, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
-		assert.Equal(t, expectedErr, err)
+		executor.batch = batch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasCalledExecuteTransferCalled)
+		assert.Len(t, executor.batch.Deposits, 2)
 	})
-	t.Run("should work", func(t *testing.T) {
+	t.Run("rejected batch should be executed without any deposit", func(t *testing.T) {
 		t.Parallel()
 
+		wasCalledExecuteTransferCalled := false
 		args := createMockExecutorArgs()
-		wasCalled := false
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
-			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
-				wasCalled = true
-				return true, nil
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				assert.Equal(t, providedBatch.ID, batchId)
+				assert.Empty(t, argLists.Nonces)
+				assert.Empty(t, argLists.Recipients)
+
+				wasCalledExecuteTransferCalled = true
+				return "hash", nil
 			},
 		}
 
 		executor, _ := NewBridgeExecutor(args)
-
-		isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		executor.batch = providedBatch
+		executor.isBatchRejected = true
+		err := executor.PerformTransferOnEthereum(context.Background())
 		assert.Nil(t, err)
-		assert.True(t, wasCalled)
-		assert.True(t, isReached)
+		assert.True(t, wasCalledExecuteTransferCalled)
+		assert.Equal(t, providedBatch.ID, executor.sentTransferBatchID)
+		assert.Equal(t, "hash", executor.sentTransferTxHash)
+	})
+	t.Run("should not execute the deposits towards invalid recipients", func(t *testing.T) {
+		t.Parallel()
+
+		invalidRecipient := common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c")
+		batch := &bridgeCore.TransferBatch{
+			ID: 37,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, ToBytes: common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c").Bytes(), Amount: big.NewInt(1)},
+				{Nonce: 2, ToBytes: invalidRecipient.Bytes(), Amount: big.NewInt(2)},
+			},
+		}
+		wasCalledExecuteTransferCalled := false
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				assert.Equal(t, []*big.Int{big.NewInt(1)}, argLists.Nonces)
+
+				wasCalledExecuteTransferCalled = true
+				return "", nil
+			},
+		}
+		args.RecipientValidator = &testsCommon.RecipientValidatorStub{
+			ValidateCalled: func(recipient []byte) error {
+				if bytes.Equal(recipient, invalidRecipient.Bytes()) {
+					return expectedErr
+				}
+
+				return nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasCalledExecuteTransferCalled)
+		assert.Equal(t, []byte{bridgeCore.Executed, bridgeCore.Rejected}, executor.addRejectedStatuses([]byte{bridgeCore.Executed}))
+	})
+	t.Run("execution error should be recorded in the dead letters store", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				return "", expectedErr
+			},
+		}
+		wasRecorded := false
+		args.DeadLetters = &testsCommon.DeadLettersStub{
+			RecordFailureCalled: func(direction string, batch *bridgeCore.TransferBatch, reason string) {
+				assert.Equal(t, "test", direction)
+				assert.True(t, providedBatch == batch)
+				assert.Equal(t, expectedErr.Error(), reason)
+				wasRecorded = true
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, wasRecorded)
+	})
+	t.Run("gas price above the maximum should error if the deferral is not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		gasPriceErr := fmt.Errorf("%w maximum value: 10", clients.ErrGasPriceIsHigherThanTheMaximumSet)
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				return "", gasPriceErr
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Equal(t, gasPriceErr, err)
+	})
+	t.Run("gas price above the maximum should defer the transfer until the deferral expires", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID: 37,
+		}
+		now := int64(1000)
+		gasPriceErr := fmt.Errorf("%w maximum value: 10", clients.ErrGasPriceIsHigherThanTheMaximumSet)
+		args := createMockExecutorArgs()
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+		args.Timer = timer
+		args.GasPriceDeferral = time.Minute
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				return "", gasPriceErr
+			},
+		}
+		numRecorded := 0
+		args.DeadLetters = &testsCommon.DeadLettersStub{
+			RecordFailureCalled: func(direction string, batch *bridgeCore.TransferBatch, reason string) {
+				numRecorded++
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 37, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferredBatch))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferralInSeconds))
+
+		now += 59
+		err = executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 59, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferralInSeconds))
+		assert.Equal(t, 0, numRecorded)
+
+		now++
+		err = executor.PerformTransferOnEthereum(context.Background())
+		assert.Equal(t, gasPriceErr, err)
+		assert.Equal(t, 0, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferredBatch))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferralInSeconds))
+		assert.Equal(t, 1, numRecorded)
+	})
+	t.Run("other errors should not be deferred", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.GasPriceDeferral = time.Minute
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				return "", expectedErr
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("successful transfer should reset the deferral metrics", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID: 37,
+		}
+		gasPriceTooHigh := true
+		args := createMockExecutorArgs()
+		args.GasPriceDeferral = time.Minute
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, argLists *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				if gasPriceTooHigh {
+					return "", clients.ErrGasPriceIsHigherThanTheMaximumSet
+				}
+
+				return "hash", nil
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 37, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferredBatch))
+
+		gasPriceTooHigh = false
+		err = executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, statusHandler.GetIntMetric(bridgeCore.MetricGasPriceDeferredBatch))
+	})
+}
+
+func TestMultiversXToEthBridgeExecutor_IsQuorumReachedOnEthereum(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ProcessQuorumReachedOnEthereum fails", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return false, expectedErr
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+
+		_, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		wasCalled := false
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				wasCalled = true
+				return true, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+
+		isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, wasCalled)
+		assert.True(t, isReached)
 	})
 }
 
@@ -1434,38 +2461,51 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 	t.Run("normal expiration", func(t *testing.T) {
 		t.Parallel()
 
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 2 * time.Second
+		args.Timer = timer
 		executor, _ := NewBridgeExecutor(args)
 
-		start := time.Now()
 		executor.WaitForTransferConfirmation(context.Background())
-		elapsed := time.Since(start)
 
-		assert.True(t, elapsed >= args.TimeForWaitOnEthereum)
+		assert.Equal(t, args.TimeForWaitOnEthereum, timer.TotalWaited())
 	})
 	t.Run("context expiration", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		timer := testsCommon.NewTimerStub()
+		timer.AfterCalled = func(duration time.Duration) <-chan time.Time {
+			return make(chan time.Time)
+		}
+		args.Timer = timer
+		wasExecutedCalled := false
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				wasExecutedCalled = true
+				return false, nil
+			},
+		}
 		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
-		defer cancel()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
 
-		start := time.Now()
 		executor.WaitForTransferConfirmation(ctx)
-		elapsed := time.Since(start)
 
-		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
+		assert.False(t, wasExecutedCalled)
 	})
 
 	t.Run("WasTransferPerformedOnEthereum always returns false/err", func(t *testing.T) {
 		t.Parallel()
 
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		args.Timer = timer
 		counter := 0
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
@@ -1476,19 +2516,19 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		executor.WaitForTransferConfirmation(ctx)
+		executor.WaitForTransferConfirmation(context.Background())
 
 		assert.Equal(t, 10, counter)
+		assert.Equal(t, args.TimeForWaitOnEthereum, timer.TotalWaited())
 	})
 
 	t.Run("WasTransferPerformedOnEthereum always returns true only after 4 checks", func(t *testing.T) {
 		t.Parallel()
 
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		args.Timer = timer
 		counter := 0
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
@@ -1502,16 +2542,10 @@ func TestWaitForTransferConfirmation(t *testing.T) {
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		start := time.Now()
-
-		executor.WaitForTransferConfirmation(ctx)
-		elapsed := time.Since(start)
+		executor.WaitForTransferConfirmation(context.Background())
 
-		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
 		assert.Equal(t, 5, counter)
+		assert.Equal(t, 5*time.Second, timer.TotalWaited())
 	})
 }
 
@@ -1561,6 +2595,107 @@ func TestGetBatchStatusesFromEthereum(t *testing.T) {
 		assert.True(t, wasCalled)
 		assert.Equal(t, providedStatuses, statuses)
 	})
+	t.Run("should use the execution events statuses", func(t *testing.T) {
+		t.Parallel()
+
+		eventsStatuses := []byte{bridgeCore.Executed, bridgeCore.Executed}
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
+				return []byte{bridgeCore.Executed, bridgeCore.Rejected}, nil
+			},
+			GetTransactionsStatusesFromEventsCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error) {
+				assert.True(t, providedBatch == batch)
+				return eventsStatuses, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+		statuses, err := executor.GetBatchStatusesFromEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, eventsStatuses, statuses)
+	})
+	t.Run("should set the deposits towards the recipients that are not allowlisted as rejected", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID: 37,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 1, ToBytes: []byte("allowed")},
+				{Nonce: 2, ToBytes: []byte("not allowed")},
+				{Nonce: 3, ToBytes: []byte("allowed")},
+			},
+		}
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
+				return []byte{bridgeCore.Executed, bridgeCore.Executed}, nil
+			},
+			GetTransactionsStatusesFromEventsCalled: func(ctx context.Context, allowlistedBatch *bridgeCore.TransferBatch) ([]byte, error) {
+				assert.Equal(t, []*bridgeCore.DepositTransfer{batch.Deposits[0], batch.Deposits[2]}, allowlistedBatch.Deposits)
+				return []byte{bridgeCore.Executed, bridgeCore.Rejected}, nil
+			},
+		}
+		args.RecipientAllowlist = &testsCommon.RecipientAllowlistStub{
+			IsAllowedCalled: func(recipient []byte) bool {
+				return string(recipient) == "allowed"
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		statuses, err := executor.GetBatchStatusesFromEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{bridgeCore.Executed, bridgeCore.Rejected, bridgeCore.Rejected}, statuses)
+	})
+	t.Run("no allowlisted recipients should not query Ethereum", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
+				assert.Fail(t, "should have not called GetTransactionsStatuses")
+				return nil, nil
+			},
+		}
+		args.RecipientAllowlist = &testsCommon.RecipientAllowlistStub{
+			IsAllowedCalled: func(recipient []byte) bool {
+				return false
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{
+			Deposits: []*bridgeCore.DepositTransfer{{Nonce: 1}, {Nonce: 2}},
+		}
+		statuses, err := executor.GetBatchStatusesFromEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{bridgeCore.Rejected, bridgeCore.Rejected}, statuses)
+	})
+	t.Run("batch executed without any deposit should set all the deposits as rejected", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
+				return make([]byte, 0), nil
+			},
+			GetTransactionsStatusesFromEventsCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error) {
+				assert.Fail(t, "should have not called GetTransactionsStatusesFromEvents")
+				return nil, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{
+			ID:       37,
+			Deposits: []*bridgeCore.DepositTransfer{{Nonce: 1}, {Nonce: 2}},
+		}
+		statuses, err := executor.GetBatchStatusesFromEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{bridgeCore.Rejected, bridgeCore.Rejected}, statuses)
+	})
 }
 
 func TestWaitAndReturnFinalBatchStatuses(t *testing.T) {
@@ -1569,15 +2704,15 @@ func TestWaitAndReturnFinalBatchStatuses(t *testing.T) {
 	t.Run("normal expiration", func(t *testing.T) {
 		t.Parallel()
 
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 2 * time.Second
+		args.Timer = timer
 		executor, _ := NewBridgeExecutor(args)
 
-		start := time.Now()
 		statuses := executor.WaitAndReturnFinalBatchStatuses(context.Background())
-		elapsed := time.Since(start)
 
-		assert.True(t, elapsed >= args.TimeForWaitOnEthereum)
+		assert.Equal(t, args.TimeForWaitOnEthereum, timer.TotalWaited())
 		assert.Nil(t, statuses)
 	})
 	t.Run("context expiration", func(t *testing.T) {
@@ -1585,23 +2720,36 @@ func TestWaitAndReturnFinalBatchStatuses(t *testing.T) {
 
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		timer := testsCommon.NewTimerStub()
+		timer.AfterCalled = func(duration time.Duration) <-chan time.Time {
+			return make(chan time.Time)
+		}
+		args.Timer = timer
+		getTransactionsStatusesCalled := false
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
+				getTransactionsStatusesCalled = true
+				return nil, expectedErr
+			},
+		}
 		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
-		defer cancel()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
 
-		start := time.Now()
 		statuses := executor.WaitAndReturnFinalBatchStatuses(ctx)
-		elapsed := time.Since(start)
 
-		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
+		assert.False(t, getTransactionsStatusesCalled)
 		assert.Nil(t, statuses)
 	})
 	t.Run("GetBatchStatusesFromEthereum always returns err", func(t *testing.T) {
 		t.Parallel()
 
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		args.Timer = timer
 		counter := 0
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
@@ -1612,20 +2760,20 @@ func TestWaitAndReturnFinalBatchStatuses(t *testing.T) {
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		statuses := executor.WaitAndReturnFinalBatchStatuses(ctx)
+		statuses := executor.WaitAndReturnFinalBatchStatuses(context.Background())
 
 		assert.Equal(t, 10, counter)
+		assert.Equal(t, args.TimeForWaitOnEthereum, timer.TotalWaited())
 		assert.Nil(t, statuses)
 	})
 	t.Run("GetBatchStatusesFromEthereum always returns success+statuses only after 4 checks", func(t *testing.T) {
 		t.Parallel()
 
 		providedStatuses := []byte{bridgeCore.Executed, bridgeCore.Rejected}
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		args.Timer = timer
 		counter := 0
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
@@ -1639,24 +2787,20 @@ func TestWaitAndReturnFinalBatchStatuses(t *testing.T) {
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		start := time.Now()
-
-		statuses := executor.WaitAndReturnFinalBatchStatuses(ctx)
-		elapsed := time.Since(start)
+		statuses := executor.WaitAndReturnFinalBatchStatuses(context.Background())
 
-		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
 		assert.Equal(t, 5, counter)
+		assert.Equal(t, 5*time.Second, timer.TotalWaited())
 		assert.Equal(t, providedStatuses, statuses)
 	})
 	t.Run("GetBatchStatusesFromEthereum always returns success+statuses only after 4 checks, otherwise empty slice", func(t *testing.T) {
 		t.Parallel()
 
 		providedStatuses := []byte{bridgeCore.Executed, bridgeCore.Rejected}
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
 		args := createMockExecutorArgs()
 		args.TimeForWaitOnEthereum = 10 * time.Second
+		args.Timer = timer
 		counter := 0
 		args.EthereumClient = &bridgeTests.EthereumClientStub{
 			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
@@ -1670,18 +2814,61 @@ func TestWaitAndReturnFinalBatchStatuses(t *testing.T) {
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = &bridgeCore.TransferBatch{}
 
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-
-		start := time.Now()
-
-		statuses := executor.WaitAndReturnFinalBatchStatuses(ctx)
-		elapsed := time.Since(start)
+		statuses := executor.WaitAndReturnFinalBatchStatuses(context.Background())
 
-		assert.True(t, elapsed < args.TimeForWaitOnEthereum)
 		assert.Equal(t, 5, counter)
+		assert.Equal(t, 5*time.Second, timer.TotalWaited())
 		assert.Equal(t, providedStatuses, statuses)
 	})
+	t.Run("should generate the transfer receipts once if this relayer executed the transfer", func(t *testing.T) {
+		t.Parallel()
+
+		providedStatuses := []byte{bridgeCore.Executed, bridgeCore.Rejected}
+		args := createMockExecutorArgs()
+		args.Timer = testsCommon.NewTimeTravelTimerMock(time.Now())
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetTransactionsStatusesCalled: func(ctx context.Context, batchId uint64) ([]byte, error) {
+				return providedStatuses, nil
+			},
+			ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+				return "hash", nil
+			},
+			GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		}
+		batch := &bridgeCore.TransferBatch{
+			ID: 12,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{Nonce: 37, ToBytes: bytes.Repeat([]byte{1}, 20), Amount: big.NewInt(10)},
+				{Nonce: 38, ToBytes: bytes.Repeat([]byte{2}, 20), Amount: big.NewInt(20)},
+			},
+		}
+		numCalls := 0
+		args.TransferReceipts = &testsCommon.TransferReceiptsStub{
+			GenerateReceiptsCalled: func(ctx context.Context, direction string, providedBatch *bridgeCore.TransferBatch, destinationTxHash string, statuses map[uint64]string) {
+				numCalls++
+				assert.True(t, batch == providedBatch)
+				assert.Equal(t, "hash", destinationTxHash)
+				expectedStatuses := map[uint64]string{
+					37: bridgeCore.DepositExecuted,
+					38: bridgeCore.DepositRejected,
+				}
+				assert.Equal(t, expectedStatuses, statuses)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		_ = executor.StoreBatchFromMultiversX(batch)
+		_ = executor.WaitAndReturnFinalBatchStatuses(context.Background())
+		assert.Equal(t, 0, numCalls)
+
+		err := executor.PerformTransferOnEthereum(context.Background())
+		assert.Nil(t, err)
+		_ = executor.WaitAndReturnFinalBatchStatuses(context.Background())
+		_ = executor.WaitAndReturnFinalBatchStatuses(context.Background())
+		assert.Equal(t, 1, numCalls)
+	})
 }
 
 func TestResolveNewDepositsStatuses(t *testing.T) {
@@ -1899,3 +3086,407 @@ func TestBridgeExecutor_CheckAvailableTokens(t *testing.T) {
 		assert.Equal(t, expectedAmounts, checkedAmounts)
 	})
 }
+
+func TestBridgeExecutor_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		err := executor.ValidateBatch(context.Background())
+		assert.Equal(t, ErrNilBatch, err)
+	})
+	t.Run("should return the batch validator result", func(t *testing.T) {
+		t.Parallel()
+
+		storedBatch := &bridgeCore.TransferBatch{ID: 37}
+		args := createMockExecutorArgs()
+		args.BatchValidator = &testsCommon.BatchValidatorStub{
+			ValidateBatchCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) error {
+				assert.True(t, storedBatch == batch)
+				return expectedErr
+			},
+		}
+		wasRecorded := false
+		args.DeadLetters = &testsCommon.DeadLettersStub{
+			RecordFailureCalled: func(direction string, batch *bridgeCore.TransferBatch, reason string) {
+				assert.True(t, storedBatch == batch)
+				assert.Equal(t, expectedErr.Error(), reason)
+				wasRecorded = true
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = storedBatch
+
+		err := executor.ValidateBatch(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, wasRecorded)
+	})
+	t.Run("rejected batch should not be validated", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.BatchValidator = &testsCommon.BatchValidatorStub{
+			ValidateBatchCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) error {
+				assert.Fail(t, "should have not called ValidateBatch")
+				return expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{ID: 37}
+		executor.isBatchRejected = true
+
+		err := executor.ValidateBatch(context.Background())
+		assert.Nil(t, err)
+	})
+}
+
+func TestBridgeExecutor_ShouldRejectStoredBatch(t *testing.T) {
+	t.Parallel()
+
+	batch := &bridgeCore.TransferBatch{
+		ID:          37,
+		BlockNumber: 1000,
+		Deposits: []*bridgeCore.DepositTransfer{
+			{Nonce: 74},
+			{Nonce: 75},
+		},
+	}
+	createRefundingDeadLetters := func() *testsCommon.DeadLettersStub {
+		return &testsCommon.DeadLettersStub{
+			IsRefundedCalled: func(direction string, depositNonce uint64) bool {
+				assert.Equal(t, "test", direction)
+				return depositNonce == 75
+			},
+		}
+	}
+
+	t.Run("nil batch should not reject", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+	})
+	t.Run("no refunded deposit should not reject", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		executor.batch = batch
+		executor.isBatchRejected = true
+
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+		assert.False(t, executor.isBatchRejected)
+	})
+	t.Run("refunded deposit should reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.DeadLetters = createRefundingDeadLetters()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsTransactionPendingCalled: func(ctx context.Context, txHash string) (bool, error) {
+				assert.Fail(t, "should have not called IsTransactionPending")
+				return false, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		executor.sentTransferBatchID = 36
+		executor.sentTransferTxHash = "hash"
+
+		assert.True(t, executor.ShouldRejectStoredBatch(context.Background()))
+		assert.True(t, executor.isBatchRejected)
+	})
+	t.Run("pending execution sent by this relayer should not reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.DeadLetters = createRefundingDeadLetters()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsTransactionPendingCalled: func(ctx context.Context, txHash string) (bool, error) {
+				assert.Equal(t, "hash", txHash)
+				return true, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		executor.sentTransferBatchID = batch.ID
+		executor.sentTransferTxHash = "hash"
+
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+		assert.False(t, executor.isBatchRejected)
+	})
+	t.Run("IsTransactionPending fails should not reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.DeadLetters = createRefundingDeadLetters()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsTransactionPendingCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		executor.sentTransferBatchID = batch.ID
+		executor.sentTransferTxHash = "hash"
+
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+	})
+	t.Run("mined execution sent by this relayer should reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.DeadLetters = createRefundingDeadLetters()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsTransactionPendingCalled: func(ctx context.Context, txHash string) (bool, error) {
+				return false, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+		executor.sentTransferBatchID = batch.ID
+		executor.sentTransferTxHash = "hash"
+
+		assert.True(t, executor.ShouldRejectStoredBatch(context.Background()))
+	})
+	t.Run("rejection deadline not elapsed should not reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.RejectionDeadlineInBlocks = 100
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetCurrentNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 1099, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+	})
+	t.Run("GetCurrentNonce fails should not reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.RejectionDeadlineInBlocks = 100
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetCurrentNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+	})
+	t.Run("rejection deadline elapsed should reject", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.RejectionDeadlineInBlocks = 100
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetCurrentNonceCalled: func(ctx context.Context) (uint64, error) {
+				return 1100, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+
+		assert.True(t, executor.ShouldRejectStoredBatch(context.Background()))
+		assert.True(t, executor.isBatchRejected)
+	})
+	t.Run("disabled rejection deadline should not query the current nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetCurrentNonceCalled: func(ctx context.Context) (uint64, error) {
+				assert.Fail(t, "should have not called GetCurrentNonce")
+				return 0, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = batch
+
+		assert.False(t, executor.ShouldRejectStoredBatch(context.Background()))
+	})
+}
+
+func TestBridgeExecutor_SaveAndRestoreState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("corrupted buffer should error", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		err := executor.RestoreState([]byte("corrupted"))
+		assert.NotNil(t, err)
+		assert.Nil(t, executor.GetStoredBatch())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		executor.batch = &bridgeCore.TransferBatch{
+			ID:          112243,
+			BlockNumber: 37,
+			Deposits: []*bridgeCore.DepositTransfer{
+				{
+					Nonce:                 74,
+					ToBytes:               []byte("to"),
+					FromBytes:             []byte("from"),
+					SourceTokenBytes:      []byte("source token"),
+					DestinationTokenBytes: []byte("destination token"),
+					Amount:                big.NewInt(1000),
+					Data:                  []byte("data"),
+				},
+			},
+			Statuses: []byte{bridgeCore.Executed},
+		}
+		executor.actionID = 2
+		executor.msgHash = common.HexToHash("0x0102")
+		executor.isBatchRejected = true
+		executor.sentTransferBatchID = 112243
+		executor.sentTransferTxHash = "hash"
+
+		buff, err := executor.SaveState()
+		assert.Nil(t, err)
+
+		restoredExecutor, _ := NewBridgeExecutor(createMockExecutorArgs())
+		err = restoredExecutor.RestoreState(buff)
+		assert.Nil(t, err)
+		assert.Equal(t, executor.batch, restoredExecutor.GetStoredBatch())
+		assert.Equal(t, executor.actionID, restoredExecutor.GetStoredActionID())
+		assert.Equal(t, executor.msgHash, restoredExecutor.msgHash)
+		assert.True(t, restoredExecutor.isBatchRejected)
+		assert.Equal(t, executor.sentTransferBatchID, restoredExecutor.sentTransferBatchID)
+		assert.Equal(t, executor.sentTransferTxHash, restoredExecutor.sentTransferTxHash)
+	})
+}
+
+func TestBridgeExecutor_ExecutionDeadline(t *testing.T) {
+	t.Parallel()
+
+	batch := &bridgeCore.TransferBatch{
+		ID: 112243,
+		Deposits: []*bridgeCore.DepositTransfer{
+			{Nonce: 74},
+			{Nonce: 75},
+		},
+	}
+	createArgs := func(now *int64) ArgsBridgeExecutor {
+		args := createMockExecutorArgs()
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return *now
+		}
+		args.Timer = timer
+		args.ExecutionDeadline = time.Minute
+
+		return args
+	}
+
+	t.Run("should only report the deadline once", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(1000)
+		numReports := 0
+		args := createArgs(&now)
+		args.ErrorReporter = &testsCommon.ErrorReporterStub{
+			ReportErrorCalled: func(direction string, message string, tags map[string]string) {
+				numReports++
+				assert.Equal(t, "execution deadline elapsed", message)
+				assert.Equal(t, map[string]string{"batch ID": "112243"}, tags)
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		_ = executor.StoreBatchFromMultiversX(batch)
+		now += 59
+		_ = executor.StoreBatchFromMultiversX(batch)
+		assert.Equal(t, 0, numReports)
+
+		now++
+		_ = executor.StoreBatchFromMultiversX(batch)
+		_ = executor.StoreBatchFromMultiversX(batch)
+		assert.Equal(t, 1, numReports)
+		assert.Equal(t, []byte(nil), executor.GetStoredBatch().Statuses)
+	})
+	t.Run("new batch should restart the deadline", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(1000)
+		numReports := 0
+		args := createArgs(&now)
+		args.ErrorReporter = &testsCommon.ErrorReporterStub{
+			ReportErrorCalled: func(direction string, message string, tags map[string]string) {
+				numReports++
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		_ = executor.StoreBatchFromMultiversX(batch)
+		now += 60
+		_ = executor.StoreBatchFromMultiversX(&bridgeCore.TransferBatch{ID: batch.ID + 1})
+		assert.Equal(t, 0, numReports)
+	})
+	t.Run("disabled deadline should never report", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(1000)
+		args := createArgs(&now)
+		args.ExecutionDeadline = 0
+		args.ErrorReporter = &testsCommon.ErrorReporterStub{
+			ReportErrorCalled: func(direction string, message string, tags map[string]string) {
+				assert.Fail(t, "should have not reported the deadline")
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		_ = executor.StoreBatchFromMultiversX(batch)
+		now += 1000000
+		_ = executor.StoreBatchFromMultiversX(batch)
+	})
+}
+
+func TestBridgeExecutor_GetLiveView(t *testing.T) {
+	t.Parallel()
+
+	args := createMockExecutorArgs()
+	msgHash := common.HexToHash("0x1234")
+	args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+		SignaturesCalled: func(messageHash []byte) [][]byte {
+			assert.Equal(t, msgHash.Bytes(), messageHash)
+			return [][]byte{[]byte("sig 1"), []byte("sig 2")}
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+	assert.Nil(t, executor.GetLiveView())
+
+	executor.batch = &bridgeCore.TransferBatch{
+		ID: 112243,
+		Deposits: []*bridgeCore.DepositTransfer{
+			{Nonce: 74, DisplayableFrom: "from", DisplayableTo: "to", DisplayableToken: "USDC", Amount: big.NewInt(1000), TxHash: "hash"},
+			{Nonce: 75},
+			{Nonce: 76},
+		},
+		Statuses: []byte{bridgeCore.Executed, bridgeCore.Rejected},
+	}
+	executor.actionID = 37
+	view := executor.GetLiveView()
+	assert.Equal(t, &bridgeCore.BatchLiveView{
+		BatchID:  112243,
+		ActionID: 37,
+		Deposits: []*bridgeCore.DepositLiveView{
+			{Nonce: 74, From: "from", To: "to", Token: "USDC", Amount: "1000", TxHash: "hash", Status: bridgeCore.DepositExecuted},
+			{Nonce: 75, Amount: "0", Status: bridgeCore.DepositRejected},
+			{Nonce: 76, Amount: "0", Status: bridgeCore.DepositPending},
+		},
+	}, view)
+
+	executor.msgHash = msgHash
+	view = executor.GetLiveView()
+	assert.Equal(t, msgHash.Hex(), view.MessageHash)
+	assert.Equal(t, 2, view.NumSignatures)
+}
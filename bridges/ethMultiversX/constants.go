@@ -8,3 +8,8 @@ import (
 const InvalidActionID = uint64(0)
 
 const durationLimit = time.Second
+
+const (
+	ethereumTransferSignaturePurpose = "ethereum transfer message hash"
+	multiversXActionSignaturePurpose = "multiversx action ID"
+)
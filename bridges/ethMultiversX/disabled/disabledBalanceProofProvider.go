@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ErrBalanceProofDisabled signals that the balance proof is not enabled on this process
+var ErrBalanceProofDisabled = errors.New("balance proof is disabled")
+
+type disabledBalanceProofProvider struct {
+}
+
+// NewDisabledBalanceProofProvider will return a disabled balance proof provider instance, used when the balance proof
+// is not enabled
+func NewDisabledBalanceProofProvider() *disabledBalanceProofProvider {
+	return &disabledBalanceProofProvider{}
+}
+
+// GetBalanceProof returns ErrBalanceProofDisabled
+func (disabled *disabledBalanceProofProvider) GetBalanceProof(_ context.Context) (*core.BalanceProof, error) {
+	return nil, ErrBalanceProofDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBalanceProofProvider) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBalanceProofProvider_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledBalanceProofProvider()
+	assert.False(t, check.IfNil(disabled))
+
+	proof, err := disabled.GetBalanceProof(context.Background())
+	assert.Nil(t, proof)
+	assert.Equal(t, ErrBalanceProofDisabled, err)
+}
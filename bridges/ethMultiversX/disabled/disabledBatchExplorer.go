@@ -0,0 +1,32 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledBatchExplorer struct {
+}
+
+// NewDisabledBatchExplorer will return a disabled batch explorer instance, used by the processes not running the
+// half-bridges state machines
+func NewDisabledBatchExplorer() *disabledBatchExplorer {
+	return &disabledBatchExplorer{}
+}
+
+// GetPendingBatches returns an empty slice
+func (disabled *disabledBatchExplorer) GetPendingBatches() []*core.BatchLiveView {
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetBatchLiveViews returns an empty slice
+func (disabled *disabledBatchExplorer) GetBatchLiveViews(_ uint64) []*core.BatchLiveView {
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetDepositLiveViews returns an empty slice
+func (disabled *disabledBatchExplorer) GetDepositLiveViews(_ uint64) []*core.BatchLiveView {
+	return make([]*core.BatchLiveView, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBatchExplorer) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBatchExplorer_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledBatchExplorer()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Empty(t, disabled.GetPendingBatches())
+	assert.Empty(t, disabled.GetBatchLiveViews(12))
+	assert.Empty(t, disabled.GetDepositLiveViews(74))
+}
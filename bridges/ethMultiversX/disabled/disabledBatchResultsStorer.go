@@ -0,0 +1,46 @@
+package disabled
+
+import bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledBatchResultsStorer struct {
+}
+
+// NewDisabledBatchResultsStorer will return a disabled batch results storer instance
+func NewDisabledBatchResultsStorer() *disabledBatchResultsStorer {
+	return &disabledBatchResultsStorer{}
+}
+
+// StoreBatchResults does nothing and returns nil
+func (disabled *disabledBatchResultsStorer) StoreBatchResults(_ *bridgeCore.BatchResults) error {
+	return nil
+}
+
+// GetBatchResults returns nil results
+func (disabled *disabledBatchResultsStorer) GetBatchResults(_ uint64) (*bridgeCore.BatchResults, error) {
+	return nil, nil
+}
+
+// StoreTransferReceipts does nothing and returns nil
+func (disabled *disabledBatchResultsStorer) StoreTransferReceipts(_ string, _ uint64, _ []*bridgeCore.SignedTransferReceipt) error {
+	return nil
+}
+
+// GetTransferReceipts returns nil receipts
+func (disabled *disabledBatchResultsStorer) GetTransferReceipts(_ string, _ uint64) ([]*bridgeCore.SignedTransferReceipt, error) {
+	return nil, nil
+}
+
+// StoreExecutionCosts does nothing and returns nil
+func (disabled *disabledBatchResultsStorer) StoreExecutionCosts(_ string, _ []*bridgeCore.ExecutionCost) error {
+	return nil
+}
+
+// GetExecutionCosts returns nil costs
+func (disabled *disabledBatchResultsStorer) GetExecutionCosts(_ string) ([]*bridgeCore.ExecutionCost, error) {
+	return nil, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBatchResultsStorer) IsInterfaceNil() bool {
+	return disabled == nil
+}
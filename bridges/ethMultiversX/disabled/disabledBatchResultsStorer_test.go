@@ -0,0 +1,44 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBatchResultsStorer_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledBatchResultsStorer()
+	assert.False(t, check.IfNil(disabled))
+
+	err := disabled.StoreBatchResults(nil)
+	assert.Nil(t, err)
+
+	results, err := disabled.GetBatchResults(1)
+	assert.Nil(t, results)
+	assert.Nil(t, err)
+
+	err = disabled.StoreTransferReceipts("direction", 1, nil)
+	assert.Nil(t, err)
+
+	receipts, err := disabled.GetTransferReceipts("direction", 1)
+	assert.Nil(t, receipts)
+	assert.Nil(t, err)
+
+	err = disabled.StoreExecutionCosts("2026-09", nil)
+	assert.Nil(t, err)
+
+	costs, err := disabled.GetExecutionCosts("2026-09")
+	assert.Nil(t, costs)
+	assert.Nil(t, err)
+}
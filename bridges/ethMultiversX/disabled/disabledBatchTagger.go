@@ -0,0 +1,35 @@
+package disabled
+
+import (
+	"math/big"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type disabledBatchTagger struct {
+}
+
+// NewDisabledBatchTagger will return a disabled batch tagger instance that does not attach any tag
+func NewDisabledBatchTagger() *disabledBatchTagger {
+	return &disabledBatchTagger{}
+}
+
+// TagBatch returns nil
+func (disabled *disabledBatchTagger) TagBatch(_ *bridgeCore.TransferBatch) []string {
+	return nil
+}
+
+// TagDeposit returns nil
+func (disabled *disabledBatchTagger) TagDeposit(_ *bridgeCore.DepositTransfer) []string {
+	return nil
+}
+
+// TagTransfer returns nil
+func (disabled *disabledBatchTagger) TagTransfer(_ string, _ string, _ string, _ *big.Int) []string {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledBatchTagger) IsInterfaceNil() bool {
+	return disabled == nil
+}
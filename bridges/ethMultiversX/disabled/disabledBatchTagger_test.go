@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBatchTagger_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledBatchTagger()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Nil(t, disabled.TagBatch(&bridgeCore.TransferBatch{}))
+	assert.Nil(t, disabled.TagDeposit(&bridgeCore.DepositTransfer{}))
+	assert.Nil(t, disabled.TagTransfer("from", "to", "token", big.NewInt(1)))
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledConfigDriftAuditor struct {
+}
+
+// NewDisabledConfigDriftAuditor will return a disabled config drift auditor instance, used by the processes not
+// reloading their config
+func NewDisabledConfigDriftAuditor() *disabledConfigDriftAuditor {
+	return &disabledConfigDriftAuditor{}
+}
+
+// GetConfigDrift returns an empty report
+func (disabled *disabledConfigDriftAuditor) GetConfigDrift() (*core.ConfigDriftReport, error) {
+	return &core.ConfigDriftReport{
+		Drifts:         make([]*core.ConfigDrift, 0),
+		ChangedLoggers: make([]core.LoggerInfo, 0),
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledConfigDriftAuditor) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledConfigDriftAuditor_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledConfigDriftAuditor()
+	assert.False(t, check.IfNil(disabled))
+
+	report, err := disabled.GetConfigDrift()
+	assert.Nil(t, err)
+	assert.Empty(t, report.Drifts)
+	assert.Empty(t, report.ChangedLoggers)
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledConfirmationPolicy struct {
+}
+
+// NewDisabledConfirmationPolicy will return a disabled confirmation policy instance
+func NewDisabledConfirmationPolicy() *disabledConfirmationPolicy {
+	return &disabledConfirmationPolicy{}
+}
+
+// RequiredConfirmations returns 0, the batches being final as soon as the Ethereum client reports them as final
+func (disabled *disabledConfirmationPolicy) RequiredConfirmations(_ *core.TransferBatch) uint64 {
+	return 0
+}
+
+// Hash returns nil
+func (disabled *disabledConfirmationPolicy) Hash() []byte {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledConfirmationPolicy) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledConfirmationPolicy_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledConfirmationPolicy()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Zero(t, disabled.RequiredConfirmations(nil))
+	assert.Zero(t, disabled.RequiredConfirmations(&core.TransferBatch{}))
+	assert.Nil(t, disabled.Hash())
+}
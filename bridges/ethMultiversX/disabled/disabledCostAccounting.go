@@ -0,0 +1,18 @@
+package disabled
+
+type disabledCostAccounting struct {
+}
+
+// NewDisabledCostAccounting will return a disabled cost accounting instance
+func NewDisabledCostAccounting() *disabledCostAccounting {
+	return &disabledCostAccounting{}
+}
+
+// RecordExecution does nothing
+func (disabled *disabledCostAccounting) RecordExecution(_ string, _ uint64, _ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledCostAccounting) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,47 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ErrDeadLettersDisabled signals that the dead letters store is not enabled on this process
+var ErrDeadLettersDisabled = errors.New("dead letters store is disabled")
+
+type disabledDeadLetters struct {
+}
+
+// NewDisabledDeadLetters will return a disabled dead letters store instance, used when the failing deposits are only
+// retried by the state machines
+func NewDisabledDeadLetters() *disabledDeadLetters {
+	return &disabledDeadLetters{}
+}
+
+// RecordFailure does nothing
+func (disabled *disabledDeadLetters) RecordFailure(_ string, _ *core.TransferBatch, _ string) {
+}
+
+// RecordSuccess does nothing
+func (disabled *disabledDeadLetters) RecordSuccess(_ string, _ *core.TransferBatch) {
+}
+
+// IsRefunded returns false
+func (disabled *disabledDeadLetters) IsRefunded(_ string, _ uint64) bool {
+	return false
+}
+
+// GetDeadLetters returns an empty slice
+func (disabled *disabledDeadLetters) GetDeadLetters() []*core.DeadLetter {
+	return make([]*core.DeadLetter, 0)
+}
+
+// ResolveDeadLetter returns ErrDeadLettersDisabled
+func (disabled *disabledDeadLetters) ResolveDeadLetter(_ string, _ uint64, _ core.DeadLetterResolution) (*core.DeadLetter, error) {
+	return nil, ErrDeadLettersDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledDeadLetters) IsInterfaceNil() bool {
+	return disabled == nil
+}
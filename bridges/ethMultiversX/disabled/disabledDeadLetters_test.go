@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledDeadLetters_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledDeadLetters()
+	assert.False(t, check.IfNil(disabled))
+
+	disabled.RecordFailure("direction", &core.TransferBatch{}, "reason")
+	disabled.RecordSuccess("direction", &core.TransferBatch{})
+	assert.False(t, disabled.IsRefunded("direction", 1))
+	assert.Empty(t, disabled.GetDeadLetters())
+	deadLetter, err := disabled.ResolveDeadLetter("direction", 1, core.DeadLetterResolution{Operator: "operator"})
+	assert.Nil(t, deadLetter)
+	assert.Equal(t, ErrDeadLettersDisabled, err)
+}
@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ErrDirectMessagesDisabled signals that the relayers direct messages channel is not enabled on this process
+var ErrDirectMessagesDisabled = errors.New("direct messages channel is disabled")
+
+type disabledDirectMessages struct {
+}
+
+// NewDisabledDirectMessages will return a disabled direct messages channel instance, used when the relayers only
+// communicate on the broadcast topics
+func NewDisabledDirectMessages() *disabledDirectMessages {
+	return &disabledDirectMessages{}
+}
+
+// GetDirectMessages returns an empty slice
+func (disabled *disabledDirectMessages) GetDirectMessages() []*core.DirectMessage {
+	return make([]*core.DirectMessage, 0)
+}
+
+// SendDirectMessage returns ErrDirectMessagesDisabled
+func (disabled *disabledDirectMessages) SendDirectMessage(_ string, _ string, _ string, _ uint64) (*core.DirectMessage, error) {
+	return nil, ErrDirectMessagesDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledDirectMessages) IsInterfaceNil() bool {
+	return disabled == nil
+}
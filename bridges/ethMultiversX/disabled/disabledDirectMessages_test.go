@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledDirectMessages_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledDirectMessages()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Empty(t, disabled.GetDirectMessages())
+	message, err := disabled.SendDirectMessage("erd1", core.DirectMessageMaintenance, "text", 0)
+	assert.Nil(t, message)
+	assert.Equal(t, ErrDirectMessagesDisabled, err)
+}
@@ -0,0 +1,21 @@
+package disabled
+
+import "context"
+
+type disabledESDTRolesChecker struct {
+}
+
+// NewDisabledESDTRolesChecker will return a disabled ESDT roles checker instance that accepts any token
+func NewDisabledESDTRolesChecker() *disabledESDTRolesChecker {
+	return &disabledESDTRolesChecker{}
+}
+
+// CheckRoles returns nil
+func (disabled *disabledESDTRolesChecker) CheckRoles(_ context.Context, _ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledESDTRolesChecker) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,46 @@
+package disabled
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type disabledErrorReporter struct {
+}
+
+// NewDisabledErrorReporter will return a disabled error reporter instance
+func NewDisabledErrorReporter() *disabledErrorReporter {
+	return &disabledErrorReporter{}
+}
+
+// ReportError does nothing
+func (disabled *disabledErrorReporter) ReportError(_ string, _ string, _ map[string]string) {
+}
+
+// BeforeStep does nothing
+func (disabled *disabledErrorReporter) BeforeStep(_ context.Context, _ string, _ core.StepIdentifier) {
+}
+
+// AfterStep does nothing
+func (disabled *disabledErrorReporter) AfterStep(_ context.Context, _ string, _ core.StepIdentifier, _ core.StepIdentifier, _ time.Duration) {
+}
+
+// OnError does nothing
+func (disabled *disabledErrorReporter) OnError(_ string, _ core.StepIdentifier, _ error) {
+}
+
+// OnPanic does nothing
+func (disabled *disabledErrorReporter) OnPanic(_ string, _ core.StepIdentifier, _ interface{}, _ []byte) {
+}
+
+// Close does nothing and returns nil
+func (disabled *disabledErrorReporter) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledErrorReporter) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledErrorReporter_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledErrorReporter()
+	assert.False(t, check.IfNil(disabled))
+
+	disabled.ReportError("direction", "message", nil)
+	disabled.BeforeStep(context.Background(), "direction", "step")
+	disabled.AfterStep(context.Background(), "direction", "step", "next step", time.Second)
+	disabled.OnError("direction", "step", nil)
+	disabled.OnPanic("direction", "step", "panic", nil)
+	assert.Nil(t, disabled.Close())
+}
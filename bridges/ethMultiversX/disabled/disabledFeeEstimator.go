@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ErrFeeEstimationDisabled signals that the fee estimation is not enabled on this process
+var ErrFeeEstimationDisabled = errors.New("fee estimation is disabled")
+
+type disabledFeeEstimator struct {
+}
+
+// NewDisabledFeeEstimator will return a disabled fee estimator instance, used when the fee estimation is not enabled
+func NewDisabledFeeEstimator() *disabledFeeEstimator {
+	return &disabledFeeEstimator{}
+}
+
+// EstimateFee returns ErrFeeEstimationDisabled
+func (disabled *disabledFeeEstimator) EstimateFee(_ context.Context, _ string, _ *big.Int) (*core.FeeEstimate, error) {
+	return nil, ErrFeeEstimationDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledFeeEstimator) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledFeeEstimator_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledFeeEstimator()
+	assert.False(t, check.IfNil(disabled))
+
+	estimate, err := disabled.EstimateFee(context.Background(), "token", big.NewInt(1))
+	assert.Nil(t, estimate)
+	assert.Equal(t, ErrFeeEstimationDisabled, err)
+}
@@ -0,0 +1,18 @@
+package disabled
+
+type disabledGasUsageTracker struct {
+}
+
+// NewDisabledGasUsageTracker will return a disabled gas usage tracker instance
+func NewDisabledGasUsageTracker() *disabledGasUsageTracker {
+	return &disabledGasUsageTracker{}
+}
+
+// Track does nothing
+func (disabled *disabledGasUsageTracker) Track(_ string, _ string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledGasUsageTracker) IsInterfaceNil() bool {
+	return disabled == nil
+}
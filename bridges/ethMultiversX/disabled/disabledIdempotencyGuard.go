@@ -0,0 +1,25 @@
+package disabled
+
+type disabledIdempotencyGuard struct {
+}
+
+// NewDisabledIdempotencyGuard will return a disabled idempotency guard instance, used when the repeated actions are
+// only prevented by the checks done on chain
+func NewDisabledIdempotencyGuard() *disabledIdempotencyGuard {
+	return &disabledIdempotencyGuard{}
+}
+
+// IsCompleted returns false
+func (disabled *disabledIdempotencyGuard) IsCompleted(_ string) (bool, error) {
+	return false, nil
+}
+
+// MarkCompleted does nothing
+func (disabled *disabledIdempotencyGuard) MarkCompleted(_ string, _ string, _ uint64) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledIdempotencyGuard) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledIdempotencyGuard_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledIdempotencyGuard()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Nil(t, disabled.MarkCompleted("key", "direction", 1))
+	isCompleted, err := disabled.IsCompleted("key")
+	assert.Nil(t, err)
+	assert.False(t, isCompleted)
+}
@@ -0,0 +1,44 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ErrIncidentsDisabled signals that the incidents acknowledgment is not enabled on this process
+var ErrIncidentsDisabled = errors.New("incidents acknowledgment is disabled")
+
+type disabledIncidentsQueue struct {
+}
+
+// NewDisabledIncidentsQueue will return a disabled incidents queue instance, used when the processing is resumed
+// without the operator's acknowledgment
+func NewDisabledIncidentsQueue() *disabledIncidentsQueue {
+	return &disabledIncidentsQueue{}
+}
+
+// Raise returns 0
+func (disabled *disabledIncidentsQueue) Raise(_ string, _ string) uint64 {
+	return 0
+}
+
+// HasUnacknowledgedIncidents returns false
+func (disabled *disabledIncidentsQueue) HasUnacknowledgedIncidents() bool {
+	return false
+}
+
+// GetIncidents returns an empty slice
+func (disabled *disabledIncidentsQueue) GetIncidents() []*core.Incident {
+	return make([]*core.Incident, 0)
+}
+
+// AcknowledgeIncident returns ErrIncidentsDisabled
+func (disabled *disabledIncidentsQueue) AcknowledgeIncident(_ uint64, _ core.IncidentAcknowledgment) (*core.Incident, error) {
+	return nil, ErrIncidentsDisabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledIncidentsQueue) IsInterfaceNil() bool {
+	return disabled == nil
+}
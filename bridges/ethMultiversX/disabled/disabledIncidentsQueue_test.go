@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledIncidentsQueue_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledIncidentsQueue()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Zero(t, disabled.Raise("source", "description"))
+	assert.False(t, disabled.HasUnacknowledgedIncidents())
+	assert.Empty(t, disabled.GetIncidents())
+	incident, err := disabled.AcknowledgeIncident(1, core.IncidentAcknowledgment{Operator: "operator"})
+	assert.Nil(t, incident)
+	assert.Equal(t, ErrIncidentsDisabled, err)
+}
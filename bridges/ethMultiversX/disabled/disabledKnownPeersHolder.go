@@ -0,0 +1,25 @@
+package disabled
+
+import chainCore "github.com/multiversx/mx-chain-core-go/core"
+
+type disabledKnownPeersHolder struct {
+}
+
+// NewDisabledKnownPeersHolder will return a disabled known peers holder instance
+func NewDisabledKnownPeersHolder() *disabledKnownPeersHolder {
+	return &disabledKnownPeersHolder{}
+}
+
+// AddPeer does nothing
+func (disabled *disabledKnownPeersHolder) AddPeer(_ chainCore.PeerID) {
+}
+
+// ReconnectKnownPeers returns 0
+func (disabled *disabledKnownPeersHolder) ReconnectKnownPeers() int {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledKnownPeersHolder) IsInterfaceNil() bool {
+	return disabled == nil
+}
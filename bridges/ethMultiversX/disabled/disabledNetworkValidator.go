@@ -0,0 +1,21 @@
+package disabled
+
+import "context"
+
+type disabledNetworkValidator struct {
+}
+
+// NewDisabledNetworkValidator will return a disabled network validator instance
+func NewDisabledNetworkValidator() *disabledNetworkValidator {
+	return &disabledNetworkValidator{}
+}
+
+// Validate returns nil
+func (disabled *disabledNetworkValidator) Validate(_ context.Context) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledNetworkValidator) IsInterfaceNil() bool {
+	return disabled == nil
+}
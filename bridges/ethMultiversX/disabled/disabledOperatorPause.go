@@ -0,0 +1,39 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ErrOperatorPauseDisabled signals that the process does not run the half-bridges that can be paused by an operator
+var ErrOperatorPauseDisabled = errors.New("operator pause is not available")
+
+type disabledOperatorPause struct {
+}
+
+// NewDisabledOperatorPause will return a disabled operator pause instance, used by the processes not running the
+// half-bridges state machines
+func NewDisabledOperatorPause() *disabledOperatorPause {
+	return &disabledOperatorPause{}
+}
+
+// PauseHalfBridge returns ErrOperatorPauseDisabled
+func (disabled *disabledOperatorPause) PauseHalfBridge(_ string, _ string) (*core.HalfBridgePause, error) {
+	return nil, ErrOperatorPauseDisabled
+}
+
+// ResumeHalfBridge returns ErrOperatorPauseDisabled
+func (disabled *disabledOperatorPause) ResumeHalfBridge(_ string, _ string) (*core.HalfBridgePause, error) {
+	return nil, ErrOperatorPauseDisabled
+}
+
+// GetHalfBridgePauses returns an empty slice
+func (disabled *disabledOperatorPause) GetHalfBridgePauses() []*core.HalfBridgePause {
+	return make([]*core.HalfBridgePause, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledOperatorPause) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledOperatorPause_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledOperatorPause()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Empty(t, disabled.GetHalfBridgePauses())
+	state, err := disabled.PauseHalfBridge(core.HalfBridgeEthToMvx, "127.0.0.1")
+	assert.Nil(t, state)
+	assert.Equal(t, ErrOperatorPauseDisabled, err)
+	state, err = disabled.ResumeHalfBridge(core.HalfBridgeEthToMvx, "127.0.0.1")
+	assert.Nil(t, state)
+	assert.Equal(t, ErrOperatorPauseDisabled, err)
+}
@@ -0,0 +1,18 @@
+package disabled
+
+type disabledPostmortemCapturer struct {
+}
+
+// NewDisabledPostmortemCapturer will return a disabled postmortem capturer instance
+func NewDisabledPostmortemCapturer() *disabledPostmortemCapturer {
+	return &disabledPostmortemCapturer{}
+}
+
+// Capture does nothing
+func (disabled *disabledPostmortemCapturer) Capture(_ string, _ map[string]interface{}) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledPostmortemCapturer) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type disabledPreAgreement struct {
+}
+
+// NewDisabledPreAgreement will return a disabled pre-agreement instance that lets the leader propose without waiting
+// for the other relayers
+func NewDisabledPreAgreement() *disabledPreAgreement {
+	return &disabledPreAgreement{}
+}
+
+// SetLocalView does nothing
+func (disabled *disabledPreAgreement) SetLocalView(_ *bridgeCore.TransferBatch) {
+}
+
+// RequestAgreement returns nil
+func (disabled *disabledPreAgreement) RequestAgreement(_ context.Context, _ *bridgeCore.TransferBatch) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledPreAgreement) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledPreAgreement_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledPreAgreement()
+	assert.False(t, check.IfNil(disabled))
+
+	disabled.SetLocalView(&bridgeCore.TransferBatch{})
+	assert.Nil(t, disabled.RequestAgreement(context.Background(), &bridgeCore.TransferBatch{}))
+}
@@ -0,0 +1,35 @@
+package disabled
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type disabledRawTransactionsExporter struct {
+}
+
+// NewDisabledRawTransactionsExporter will return a disabled raw transactions exporter instance, the signed transactions
+// being broadcast
+func NewDisabledRawTransactionsExporter() *disabledRawTransactionsExporter {
+	return &disabledRawTransactionsExporter{}
+}
+
+// IsEnabled returns false
+func (disabled *disabledRawTransactionsExporter) IsEnabled() bool {
+	return false
+}
+
+// ExportTransaction does nothing and returns nil
+func (disabled *disabledRawTransactionsExporter) ExportTransaction(_ uint64, _ *types.Transaction) error {
+	return nil
+}
+
+// GetExportedTransactions returns an empty slice
+func (disabled *disabledRawTransactionsExporter) GetExportedTransactions() []*core.ExportedTransaction {
+	return make([]*core.ExportedTransaction, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledRawTransactionsExporter) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRawTransactionsExporter_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledRawTransactionsExporter()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.False(t, disabled.IsEnabled())
+	assert.Nil(t, disabled.ExportTransaction(1, nil))
+	assert.Empty(t, disabled.GetExportedTransactions())
+}
@@ -0,0 +1,19 @@
+package disabled
+
+type disabledRecipientAllowlist struct {
+}
+
+// NewDisabledRecipientAllowlist will return a disabled recipient allowlist instance that allows any recipient
+func NewDisabledRecipientAllowlist() *disabledRecipientAllowlist {
+	return &disabledRecipientAllowlist{}
+}
+
+// IsAllowed returns true
+func (disabled *disabledRecipientAllowlist) IsAllowed(_ []byte) bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledRecipientAllowlist) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,19 @@
+package disabled
+
+type disabledRecipientValidator struct {
+}
+
+// NewDisabledRecipientValidator will return a disabled recipient validator instance that accepts any recipient
+func NewDisabledRecipientValidator() *disabledRecipientValidator {
+	return &disabledRecipientValidator{}
+}
+
+// Validate returns nil
+func (disabled *disabledRecipientValidator) Validate(_ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledRecipientValidator) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,35 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// ErrRelayedClaimsDisabled signals that the relayed claims are not enabled on this process
+var ErrRelayedClaimsDisabled = errors.New("relayed claims are disabled")
+
+type disabledRelayedClaimsHandler struct {
+}
+
+// NewDisabledRelayedClaimsHandler will return a disabled relayed claims handler instance, used when the gas
+// sponsorship of the user claims is not enabled
+func NewDisabledRelayedClaimsHandler() *disabledRelayedClaimsHandler {
+	return &disabledRelayedClaimsHandler{}
+}
+
+// SubmitClaim returns ErrRelayedClaimsDisabled
+func (disabled *disabledRelayedClaimsHandler) SubmitClaim(_ context.Context, _ *transaction.FrontendTransaction) (string, error) {
+	return "", ErrRelayedClaimsDisabled
+}
+
+// Close returns nil
+func (disabled *disabledRelayedClaimsHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledRelayedClaimsHandler) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRelayedClaimsHandler_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledRelayedClaimsHandler()
+	assert.False(t, check.IfNil(disabled))
+
+	hash, err := disabled.SubmitClaim(context.Background(), &transaction.FrontendTransaction{})
+	assert.Empty(t, hash)
+	assert.Equal(t, ErrRelayedClaimsDisabled, err)
+	assert.Nil(t, disabled.Close())
+}
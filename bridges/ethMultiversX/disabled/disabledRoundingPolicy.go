@@ -0,0 +1,52 @@
+package disabled
+
+import (
+	"errors"
+	"math/big"
+)
+
+const floorRoundingMode = "floor"
+
+type disabledRoundingPolicy struct {
+}
+
+// NewDisabledRoundingPolicy will return a disabled rounding policy instance, truncating the dust of all the tokens
+func NewDisabledRoundingPolicy() *disabledRoundingPolicy {
+	return &disabledRoundingPolicy{}
+}
+
+// Mode returns the floor mode
+func (disabled *disabledRoundingPolicy) Mode(_ string) string {
+	return floorRoundingMode
+}
+
+// Convert converts the amount to the destination number of decimals, truncating the dust
+func (disabled *disabledRoundingPolicy) Convert(_ string, amount *big.Int, sourceDecimals uint8, destinationDecimals uint8) (*big.Int, *big.Int, error) {
+	if amount == nil {
+		return nil, nil, errors.New("nil amount")
+	}
+
+	if destinationDecimals >= sourceDecimals {
+		multiplier := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(destinationDecimals-sourceDecimals)), nil)
+		return big.NewInt(0).Mul(amount, multiplier), big.NewInt(0), nil
+	}
+
+	divisor := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(sourceDecimals-destinationDecimals)), nil)
+	converted, dust := big.NewInt(0).DivMod(amount, divisor, big.NewInt(0))
+
+	return converted, dust, nil
+}
+
+// CarryDust does nothing
+func (disabled *disabledRoundingPolicy) CarryDust(_ string, _ *big.Int) {
+}
+
+// Hash returns nil
+func (disabled *disabledRoundingPolicy) Hash() []byte {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledRoundingPolicy) IsInterfaceNil() bool {
+	return disabled == nil
+}
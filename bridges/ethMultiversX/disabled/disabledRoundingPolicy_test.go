@@ -0,0 +1,41 @@
+package disabled
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledRoundingPolicy_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledRoundingPolicy()
+	assert.False(t, check.IfNil(disabled))
+
+	assert.Equal(t, floorRoundingMode, disabled.Mode("token"))
+	_, _, err := disabled.Convert("token", nil, 18, 6)
+	assert.NotNil(t, err)
+
+	converted, dust, err := disabled.Convert("token", big.NewInt(123456), 8, 6)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1234), converted)
+	assert.Equal(t, big.NewInt(56), dust)
+
+	converted, dust, err = disabled.Convert("token", big.NewInt(1234), 6, 8)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(123400), converted)
+	assert.Equal(t, big.NewInt(0), dust)
+
+	disabled.CarryDust("token", big.NewInt(1))
+	assert.Nil(t, disabled.Hash())
+}
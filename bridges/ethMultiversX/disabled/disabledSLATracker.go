@@ -0,0 +1,54 @@
+package disabled
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type disabledSLATracker struct {
+}
+
+// NewDisabledSLATracker will return a disabled SLA tracker instance
+func NewDisabledSLATracker() *disabledSLATracker {
+	return &disabledSLATracker{}
+}
+
+// TransferStarted does nothing
+func (disabled *disabledSLATracker) TransferStarted(_ string, _ uint64) {
+}
+
+// TransferCompleted does nothing
+func (disabled *disabledSLATracker) TransferCompleted(_ string, _ uint64) {
+}
+
+// LeaderSlotMissed does nothing
+func (disabled *disabledSLATracker) LeaderSlotMissed(_ string) {
+}
+
+// StepFailed does nothing
+func (disabled *disabledSLATracker) StepFailed(_ string) {
+}
+
+// BeforeStep does nothing
+func (disabled *disabledSLATracker) BeforeStep(_ context.Context, _ string, _ core.StepIdentifier) {
+}
+
+// AfterStep does nothing
+func (disabled *disabledSLATracker) AfterStep(_ context.Context, _ string, _ core.StepIdentifier, _ core.StepIdentifier, _ time.Duration) {
+}
+
+// OnError does nothing
+func (disabled *disabledSLATracker) OnError(_ string, _ core.StepIdentifier, _ error) {
+}
+
+// Execute does nothing and returns nil
+func (disabled *disabledSLATracker) Execute(_ context.Context) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledSLATracker) IsInterfaceNil() bool {
+	return disabled == nil
+}
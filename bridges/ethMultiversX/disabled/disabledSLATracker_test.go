@@ -0,0 +1,34 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledSLATracker_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledSLATracker()
+	assert.False(t, check.IfNil(disabled))
+
+	disabled.TransferStarted("direction", 1)
+	disabled.TransferCompleted("direction", 1)
+	disabled.LeaderSlotMissed("direction")
+	disabled.StepFailed("direction")
+	disabled.BeforeStep(context.Background(), "direction", "step")
+	disabled.AfterStep(context.Background(), "direction", "step", "next step", time.Second)
+	disabled.OnError("direction", "step", nil)
+	assert.Nil(t, disabled.Execute(context.Background()))
+}
@@ -0,0 +1,19 @@
+package disabled
+
+type disabledSignerAuditLog struct {
+}
+
+// NewDisabledSignerAuditLog will return a disabled signer audit log instance
+func NewDisabledSignerAuditLog() *disabledSignerAuditLog {
+	return &disabledSignerAuditLog{}
+}
+
+// RecordSignature does nothing and returns nil
+func (disabled *disabledSignerAuditLog) RecordSignature(_ string, _ uint64, _ []byte) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledSignerAuditLog) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledSignerAuditLog_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledSignerAuditLog()
+	assert.False(t, check.IfNil(disabled))
+
+	err := disabled.RecordSignature("purpose", 1, nil)
+	assert.Nil(t, err)
+}
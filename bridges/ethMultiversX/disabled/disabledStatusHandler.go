@@ -0,0 +1,41 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+const disabledStatusHandlerName = "disabled"
+
+type disabledStatusHandler struct {
+}
+
+// NewDisabledStatusHandler will return a disabled status handler instance, used by the one-shot commands that do not
+// expose metrics
+func NewDisabledStatusHandler() *disabledStatusHandler {
+	return &disabledStatusHandler{}
+}
+
+// SetIntMetric does nothing
+func (disabled *disabledStatusHandler) SetIntMetric(_ string, _ int) {
+}
+
+// AddIntMetric does nothing
+func (disabled *disabledStatusHandler) AddIntMetric(_ string, _ int) {
+}
+
+// SetStringMetric does nothing
+func (disabled *disabledStatusHandler) SetStringMetric(_ string, _ string) {
+}
+
+// GetAllMetrics returns an empty metrics map
+func (disabled *disabledStatusHandler) GetAllMetrics() core.GeneralMetrics {
+	return make(core.GeneralMetrics)
+}
+
+// Name returns the name of the disabled status handler
+func (disabled *disabledStatusHandler) Name() string {
+	return disabledStatusHandlerName
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledStatusHandler) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledStatusHandler_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledStatusHandler()
+	assert.False(t, check.IfNil(disabled))
+
+	disabled.SetIntMetric("metric", 1)
+	disabled.AddIntMetric("metric", 1)
+	disabled.SetStringMetric("metric", "value")
+	assert.Empty(t, disabled.GetAllMetrics())
+	assert.Equal(t, disabledStatusHandlerName, disabled.Name())
+}
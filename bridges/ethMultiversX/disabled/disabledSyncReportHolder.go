@@ -0,0 +1,22 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledSyncReportHolder struct {
+}
+
+// NewDisabledSyncReportHolder will return a disabled sync report holder instance, used by the processes that do not
+// produce a startup sync report
+func NewDisabledSyncReportHolder() *disabledSyncReportHolder {
+	return &disabledSyncReportHolder{}
+}
+
+// GetSyncReport returns nil
+func (disabled *disabledSyncReportHolder) GetSyncReport() *core.SyncReport {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledSyncReportHolder) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledTopologyInfoHolder struct {
+}
+
+// NewDisabledTopologyInfoHolder will return a disabled topology info holder instance, used by the processes that do not
+// take part in a relayers set
+func NewDisabledTopologyInfoHolder() *disabledTopologyInfoHolder {
+	return &disabledTopologyInfoHolder{}
+}
+
+// GetTopologyInfo returns an empty map
+func (disabled *disabledTopologyInfoHolder) GetTopologyInfo(_ int) map[string]*core.TopologyInfo {
+	return make(map[string]*core.TopologyInfo)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledTopologyInfoHolder) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,24 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type disabledTransferReceipts struct {
+}
+
+// NewDisabledTransferReceipts will return a disabled transfer receipts generator instance
+func NewDisabledTransferReceipts() *disabledTransferReceipts {
+	return &disabledTransferReceipts{}
+}
+
+// GenerateReceipts does nothing
+func (disabled *disabledTransferReceipts) GenerateReceipts(_ context.Context, _ string, _ *core.TransferBatch, _ string, _ map[uint64]string) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledTransferReceipts) IsInterfaceNil() bool {
+	return disabled == nil
+}
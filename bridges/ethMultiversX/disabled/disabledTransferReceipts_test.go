@@ -0,0 +1,26 @@
+package disabled
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledTransferReceipts_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledTransferReceipts()
+	assert.False(t, check.IfNil(disabled))
+
+	disabled.GenerateReceipts(context.Background(), "direction", nil, "hash", nil)
+}
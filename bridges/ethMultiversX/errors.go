@@ -40,3 +40,57 @@ var ErrNilSignaturesHolder = errors.New("nil signatures holder")
 
 // ErrNilBalanceValidator signals that a nil balance validator was provided
 var ErrNilBalanceValidator = errors.New("nil balance validator")
+
+// ErrNilSignerAuditLog signals that a nil signer audit log was provided
+var ErrNilSignerAuditLog = errors.New("nil signer audit log")
+
+// ErrNilPostmortemCapturer signals that a nil postmortem capturer was provided
+var ErrNilPostmortemCapturer = errors.New("nil postmortem capturer")
+
+// ErrNilSLATracker signals that a nil SLA tracker was provided
+var ErrNilSLATracker = errors.New("nil SLA tracker")
+
+// ErrNilBatchValidator signals that a nil batch validator was provided
+var ErrNilBatchValidator = errors.New("nil batch validator")
+
+// ErrNilBatchResultsStorer signals that a nil batch results storer was provided
+var ErrNilBatchResultsStorer = errors.New("nil batch results storer")
+
+// ErrNilTransferReceipts signals that a nil transfer receipts generator was provided
+var ErrNilTransferReceipts = errors.New("nil transfer receipts generator")
+
+// ErrNilRecipientAllowlist signals that a nil recipient allowlist was provided
+var ErrNilRecipientAllowlist = errors.New("nil recipient allowlist")
+
+// ErrNilDeadLetters signals that a nil dead letters store was provided
+var ErrNilDeadLetters = errors.New("nil dead letters store")
+
+// ErrNilRecipientValidator signals that a nil recipient validator was provided
+var ErrNilRecipientValidator = errors.New("nil recipient validator")
+
+// ErrNilESDTRolesChecker signals that a nil ESDT roles checker was provided
+var ErrNilESDTRolesChecker = errors.New("nil ESDT roles checker")
+
+// ErrRecipientNotAllowlisted signals that a batch contains a deposit towards a recipient that is not allowlisted
+var ErrRecipientNotAllowlisted = errors.New("recipient not allowlisted")
+
+// ErrNilErrorReporter signals that a nil error reporter was provided
+var ErrNilErrorReporter = errors.New("nil error reporter")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrNilIdempotencyGuard signals that a nil idempotency guard was provided
+var ErrNilIdempotencyGuard = errors.New("nil idempotency guard")
+
+// ErrBatchAlreadyCompleted signals that the action was refused as the idempotency key of the batch was already completed
+var ErrBatchAlreadyCompleted = errors.New("batch action already completed")
+
+// ErrNilBatchTagger signals that a nil batch tagger was provided
+var ErrNilBatchTagger = errors.New("nil batch tagger")
+
+// ErrNilPreAgreement signals that a nil pre-agreement component was provided
+var ErrNilPreAgreement = errors.New("nil pre-agreement")
+
+// ErrNilCostAccounting signals that a nil cost accounting component was provided
+var ErrNilCostAccounting = errors.New("nil cost accounting")
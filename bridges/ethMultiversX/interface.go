@@ -16,10 +16,12 @@ type MultiversXClient interface {
 	GetBatch(ctx context.Context, batchID uint64) (*bridgeCore.TransferBatch, error)
 	GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error)
 	WasProposedTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error)
+	WasProposedTransferWithActionID(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error)
 	QuorumReached(ctx context.Context, actionID uint64) (bool, error)
 	WasExecuted(ctx context.Context, actionID uint64) (bool, error)
 	GetActionIDForProposeTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error)
 	WasProposedSetStatus(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error)
+	WasProposedSetStatusWithActionID(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error)
 	GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error)
 	GetActionIDForSetStatusOnPendingTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error)
 	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
@@ -32,6 +34,7 @@ type MultiversXClient interface {
 	Sign(ctx context.Context, actionID uint64) (string, error)
 	WasSigned(ctx context.Context, actionID uint64) (bool, error)
 	PerformAction(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error)
+	GetBatchResults(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error)
 	CheckClientAvailability(ctx context.Context) error
 	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
 	IsNativeToken(ctx context.Context, token []byte) (bool, error)
@@ -47,11 +50,13 @@ type MultiversXClient interface {
 type EthereumClient interface {
 	GetBatch(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error)
 	WasExecuted(ctx context.Context, batchID uint64) (bool, error)
+	IsTransactionPending(ctx context.Context, txHash string) (bool, error)
 	GenerateMessageHash(batch *batchProcessor.ArgListsBatch, batchId uint64) (common.Hash, error)
 
 	BroadcastSignatureForMessageHash(msgHash common.Hash)
 	ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error)
 	GetTransactionsStatuses(ctx context.Context, batchId uint64) ([]byte, error)
+	GetTransactionsStatusesFromEvents(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error)
 	GetQuorumSize(ctx context.Context) (*big.Int, error)
 	IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool, error)
 	GetBatchSCMetadata(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error)
@@ -63,12 +68,15 @@ type EthereumClient interface {
 	MintBurnTokens(ctx context.Context, token common.Address) (bool, error)
 	NativeTokens(ctx context.Context, token common.Address) (bool, error)
 	WhitelistedTokens(ctx context.Context, token common.Address) (bool, error)
+	TokenMinLimits(ctx context.Context, token common.Address) (*big.Int, error)
+	TokenMaxLimits(ctx context.Context, token common.Address) (*big.Int, error)
 	IsInterfaceNil() bool
 }
 
 // TopologyProvider is able to manage the current relayers topology
 type TopologyProvider interface {
 	MyTurnAsLeader() bool
+	IsSelfOnProbation() bool
 	IsInterfaceNil() bool
 }
 
@@ -79,8 +87,111 @@ type SignaturesHolder interface {
 	IsInterfaceNil() bool
 }
 
+// SignerAuditLog defines the operations for a component that records every signature produced by the relayer
+type SignerAuditLog interface {
+	RecordSignature(purpose string, batchID uint64, messageHash []byte) error
+	IsInterfaceNil() bool
+}
+
+// PostmortemCapturer defines the component able to capture a snapshot of the relayer state when a critical error occurs
+type PostmortemCapturer interface {
+	Capture(reason string, data map[string]interface{})
+	IsInterfaceNil() bool
+}
+
+// SLATracker defines the component recording the transfers latencies, the failed steps and the missed leader slots
+// used in the SLA reports
+type SLATracker interface {
+	TransferStarted(direction string, batchID uint64)
+	TransferCompleted(direction string, batchID uint64)
+	LeaderSlotMissed(direction string)
+	StepFailed(direction string)
+	IsInterfaceNil() bool
+}
+
+// CostAccounting defines the component recording the cost of the batch executions paid by this relayer
+type CostAccounting interface {
+	RecordExecution(direction string, batchID uint64, hash string)
+	IsInterfaceNil() bool
+}
+
+// ErrorReporter defines the component sending the critical errors, together with the bridge context, to an external
+// error tracking service
+type ErrorReporter interface {
+	ReportError(direction string, message string, tags map[string]string)
+	IsInterfaceNil() bool
+}
+
+// BatchResultsStorer defines the component saving the per-deposit results of the executed batches
+type BatchResultsStorer interface {
+	StoreBatchResults(results *bridgeCore.BatchResults) error
+	IsInterfaceNil() bool
+}
+
+// TransferReceipts defines the component generating the signed transfer receipts of the batches executed by this
+// relayer
+type TransferReceipts interface {
+	GenerateReceipts(ctx context.Context, direction string, batch *bridgeCore.TransferBatch, destinationTxHash string, statuses map[uint64]string)
+	IsInterfaceNil() bool
+}
+
+// BatchTagger defines the component computing the operator-defined tags of the batches and of the deposits
+type BatchTagger interface {
+	TagBatch(batch *bridgeCore.TransferBatch) []string
+	TagDeposit(deposit *bridgeCore.DepositTransfer) []string
+	IsInterfaceNil() bool
+}
+
+// PreAgreement defines the component running the p2p pre-agreement round on a batch before the leader proposes it
+type PreAgreement interface {
+	SetLocalView(batch *bridgeCore.TransferBatch)
+	RequestAgreement(ctx context.Context, batch *bridgeCore.TransferBatch) error
+	IsInterfaceNil() bool
+}
+
+// RecipientAllowlist defines the component deciding if a recipient can receive the bridged transfers
+type RecipientAllowlist interface {
+	IsAllowed(recipient []byte) bool
+	IsInterfaceNil() bool
+}
+
+// RecipientValidator defines the component checking if a recipient is a valid address on the destination chain
+type RecipientValidator interface {
+	Validate(recipient []byte) error
+	IsInterfaceNil() bool
+}
+
+// ESDTRolesChecker defines the component checking if the MultiversX safe contract holds the ESDT roles required to
+// bridge a token
+type ESDTRolesChecker interface {
+	CheckRoles(ctx context.Context, token []byte) error
+	IsInterfaceNil() bool
+}
+
+// DeadLetters defines the component recording the deposits that repeatedly failed to be validated or executed
+type DeadLetters interface {
+	RecordFailure(direction string, batch *bridgeCore.TransferBatch, reason string)
+	RecordSuccess(direction string, batch *bridgeCore.TransferBatch)
+	IsRefunded(direction string, depositNonce uint64) bool
+	IsInterfaceNil() bool
+}
+
+// IdempotencyGuard defines the component persisting the idempotency keys of the completed batch actions, so the relayer
+// refuses to repeat them
+type IdempotencyGuard interface {
+	IsCompleted(key string) (bool, error)
+	MarkCompleted(key string, direction string, batchID uint64) error
+	IsInterfaceNil() bool
+}
+
 // BalanceValidator defines the operations for a component that can validate the balances on both chains for a provided token
 type BalanceValidator interface {
 	CheckToken(ctx context.Context, ethToken common.Address, mvxToken []byte, amount *big.Int, direction batchProcessor.Direction) error
 	IsInterfaceNil() bool
 }
+
+// BatchValidator defines the operations for a component that can validate a batch before it is signed
+type BatchValidator interface {
+	ValidateBatch(ctx context.Context, batch *bridgeCore.TransferBatch) error
+	IsInterfaceNil() bool
+}
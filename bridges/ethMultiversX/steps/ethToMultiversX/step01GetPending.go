@@ -50,7 +50,12 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 		return step.Identifier()
 	}
 
-	argLists := batchProcessor.ExtractListEthToMvx(batch)
+	argLists, err := batchProcessor.ExtractListEthToMvx(batch)
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "malformed batch", "error", err, "batch", batch.String())
+		return step.Identifier()
+	}
+
 	err = step.bridge.CheckAvailableTokens(ctx, argLists.EthTokens, argLists.MvxTokenBytes, argLists.Amounts, argLists.Direction)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error checking available tokens", "error", err, "batch", batch.String())
@@ -45,6 +45,13 @@ func (step *signProposedTransferStep) Execute(ctx context.Context) core.StepIden
 		return WaitingForQuorum
 	}
 
+	err = step.bridge.ValidateBatch(ctx)
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "batch not validated, will not sign the proposed transfer",
+			"batch ID", batch.ID, "error", err)
+		return GettingPendingBatchFromEthereum
+	}
+
 	err = step.bridge.SignActionOnMultiversX(ctx)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error signing the proposed transfer",
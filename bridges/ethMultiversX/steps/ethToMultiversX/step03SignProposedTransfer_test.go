@@ -71,6 +71,35 @@ func TestExecuteSignProposedTransferStep(t *testing.T) {
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 	})
 
+	t.Run("error on ValidateBatch", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutor()
+		bridgeStub.GetStoredBatchCalled = func() *bridgeCore.TransferBatch {
+			return testBatch
+		}
+		bridgeStub.WasActionSignedOnMultiversXCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		bridgeStub.GetAndStoreActionIDForProposeTransferOnMultiversXCalled = func(ctx context.Context) (uint64, error) {
+			return 2, nil
+		}
+		bridgeStub.ValidateBatchCalled = func(ctx context.Context) error {
+			return expectedError
+		}
+		bridgeStub.SignActionOnMultiversXCalled = func(ctx context.Context) error {
+			assert.Fail(t, "should have not called SignActionOnMultiversX")
+			return nil
+		}
+
+		step := signProposedTransferStep{
+			bridge: bridgeStub,
+		}
+
+		expectedStepIdentifier := core.StepIdentifier(GettingPendingBatchFromEthereum)
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+	})
+
 	t.Run("get action ID errors", func(t *testing.T) {
 		t.Parallel()
 		expectedErr := errors.New("expected error")
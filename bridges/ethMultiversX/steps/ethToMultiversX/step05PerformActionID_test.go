@@ -33,6 +33,10 @@ func TestExecutePerformActionIDStep(t *testing.T) {
 		bridgeStub.WasActionPerformedOnMultiversXCalled = func(ctx context.Context) (bool, error) {
 			return true, nil
 		}
+		wasStoreBatchResultsCalled := false
+		bridgeStub.StoreBatchResultsFromMultiversXCalled = func(ctx context.Context) {
+			wasStoreBatchResultsCalled = true
+		}
 
 		step := performActionIDStep{
 			bridge: bridgeStub,
@@ -41,6 +45,7 @@ func TestExecutePerformActionIDStep(t *testing.T) {
 		expectedStepIdentifier := core.StepIdentifier(GettingPendingBatchFromEthereum)
 		stepIdentifier := step.Execute(context.Background())
 		assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+		assert.True(t, wasStoreBatchResultsCalled)
 	})
 
 	t.Run("should work - not leader", func(t *testing.T) {
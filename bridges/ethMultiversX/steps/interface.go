@@ -40,6 +40,7 @@ type Executor interface {
 	ProcessQuorumReachedOnMultiversX(ctx context.Context) (bool, error)
 	WasActionPerformedOnMultiversX(ctx context.Context) (bool, error)
 	PerformActionOnMultiversX(ctx context.Context) error
+	StoreBatchResultsFromMultiversX(ctx context.Context)
 	ResolveNewDepositsStatuses(numDeposits uint64)
 
 	ProcessMaxQuorumRetriesOnMultiversX() bool
@@ -53,6 +54,7 @@ type Executor interface {
 	WaitForTransferConfirmation(ctx context.Context)
 	WaitAndReturnFinalBatchStatuses(ctx context.Context) []byte
 	GetBatchStatusesFromEthereum(ctx context.Context) ([]byte, error)
+	ShouldRejectStoredBatch(ctx context.Context) bool
 
 	ProcessMaxQuorumRetriesOnEthereum() bool
 	ResetRetriesCountOnEthereum()
@@ -61,6 +63,7 @@ type Executor interface {
 	CheckMultiversXClientAvailability(ctx context.Context) error
 	CheckEthereumClientAvailability(ctx context.Context) error
 	CheckAvailableTokens(ctx context.Context, ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int, direction batchProcessor.Direction) error
+	ValidateBatch(ctx context.Context) error
 
 	IsInterfaceNil() bool
 }
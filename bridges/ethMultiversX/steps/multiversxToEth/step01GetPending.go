@@ -53,8 +53,17 @@ func (step *getPendingStep) Execute(ctx context.Context) core.StepIdentifier {
 		step.bridge.PrintInfo(logger.LogInfo, "transfer performed")
 		return ResolvingSetStatusOnMultiversX
 	}
+	if step.bridge.ShouldRejectStoredBatch(ctx) {
+		step.bridge.PrintInfo(logger.LogWarning, "the batch will be rejected on Ethereum", "batch ID", batch.ID)
+		return SigningProposedTransferOnEthereum
+	}
+
+	argLists, err := batchProcessor.ExtractListMvxToEth(batch)
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "malformed batch", "error", err, "batch", batch.String())
+		return step.Identifier()
+	}
 
-	argLists := batchProcessor.ExtractListMvxToEth(batch)
 	err = step.bridge.CheckAvailableTokens(ctx, argLists.EthTokens, argLists.MvxTokenBytes, argLists.Amounts, argLists.Direction)
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error checking available tokens", "error", err, "batch", batch.String())
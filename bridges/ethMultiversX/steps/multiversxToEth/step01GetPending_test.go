@@ -147,6 +147,30 @@ func TestExecute_GetPending(t *testing.T) {
 			assert.Equal(t, expectedStepIdentifier, stepIdentifier)
 			assert.True(t, checkAvailableTokensCalled)
 		})
+		t.Run("if the batch should be rejected next step should be SigningProposedTransferOnEthereum", func(t *testing.T) {
+			t.Parallel()
+			bridgeStub := createStubExecutorGetPending()
+			bridgeStub.WasTransferPerformedOnEthereumCalled = func(ctx context.Context) (bool, error) {
+				return false, nil
+			}
+			bridgeStub.ShouldRejectStoredBatchCalled = func(ctx context.Context) bool {
+				return true
+			}
+			checkAvailableTokensCalled := false
+			bridgeStub.CheckAvailableTokensCalled = func(ctx context.Context, ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int, direction batchProcessor.Direction) error {
+				checkAvailableTokensCalled = true
+				return nil
+			}
+
+			step := getPendingStep{
+				bridge: bridgeStub,
+			}
+
+			expectedStepIdentifier := bridgeCore.StepIdentifier(SigningProposedTransferOnEthereum)
+			stepIdentifier := step.Execute(context.Background())
+			assert.Equal(t, expectedStepIdentifier, stepIdentifier)
+			assert.False(t, checkAvailableTokensCalled)
+		})
 	})
 }
 
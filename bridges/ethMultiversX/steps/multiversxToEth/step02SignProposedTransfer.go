@@ -13,14 +13,20 @@ type signProposedTransferStep struct {
 }
 
 // Execute will execute this step returning the next step to be executed
-func (step *signProposedTransferStep) Execute(_ context.Context) core.StepIdentifier {
+func (step *signProposedTransferStep) Execute(ctx context.Context) core.StepIdentifier {
 	storedBatch := step.bridge.GetStoredBatch()
 	if storedBatch == nil {
 		step.bridge.PrintInfo(logger.LogDebug, "nil batch stored")
 		return GettingPendingBatchFromMultiversX
 	}
 
-	err := step.bridge.SignTransferOnEthereum()
+	err := step.bridge.ValidateBatch(ctx)
+	if err != nil {
+		step.bridge.PrintInfo(logger.LogError, "batch not validated, will not sign", "batch ID", storedBatch.ID, "error", err)
+		return GettingPendingBatchFromMultiversX
+	}
+
+	err = step.bridge.SignTransferOnEthereum()
 	if err != nil {
 		step.bridge.PrintInfo(logger.LogError, "error signing", "batch ID", storedBatch.ID, "error", err)
 		return GettingPendingBatchFromMultiversX
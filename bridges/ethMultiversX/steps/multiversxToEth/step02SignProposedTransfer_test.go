@@ -29,6 +29,25 @@ func TestExecute_SignProposedTransfer(t *testing.T) {
 		assert.Equal(t, initialStep, stepIdentifier)
 	})
 
+	t.Run("error on ValidateBatch", func(t *testing.T) {
+		t.Parallel()
+		bridgeStub := createStubExecutorSignProposedTransfer()
+		bridgeStub.ValidateBatchCalled = func(ctx context.Context) error {
+			return expectedError
+		}
+		bridgeStub.SignTransferOnEthereumCalled = func() error {
+			assert.Fail(t, "should have not called SignTransferOnEthereum")
+			return nil
+		}
+
+		step := signProposedTransferStep{
+			bridge: bridgeStub,
+		}
+
+		stepIdentifier := step.Execute(context.Background())
+		assert.Equal(t, initialStep, stepIdentifier)
+	})
+
 	t.Run("nil batch on SignTransferOnEthereum", func(t *testing.T) {
 		t.Parallel()
 		bridgeStub := createStubExecutorSignProposedTransfer()
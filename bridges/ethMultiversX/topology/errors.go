@@ -11,4 +11,6 @@ var (
 	errEmptyAddress             = errors.New("empty address")
 	errNilLogger                = errors.New("nil logger")
 	errNilAddressConverter      = errors.New("nil address converter")
+	errNilLeaderSelector        = errors.New("nil leader selector")
+	errNilStakesProvider        = errors.New("nil stakes provider")
 )
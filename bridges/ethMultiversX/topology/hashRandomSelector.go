@@ -8,11 +8,31 @@ import (
 
 var hasher = sha256.NewSha256()
 
-const uint64Size = 8
+const (
+	uint64Size = 8
+
+	// UniformStrategy selects the leader of each slot uniformly at random, using the slot as seed
+	UniformStrategy = "uniform"
+)
 
 type hashRandomSelector struct {
 }
 
+// NewHashRandomSelector creates a leader selector implementing the uniform strategy
+func NewHashRandomSelector() *hashRandomSelector {
+	return &hashRandomSelector{}
+}
+
+// LeaderIndex returns the index of the slot's leader, selected uniformly at random with the slot as seed
+func (selector *hashRandomSelector) LeaderIndex(slot uint64, sortedPublicKeys [][]byte) int {
+	return int(selector.randomInt(slot, uint64(len(sortedPublicKeys))))
+}
+
+// Strategy returns the uniform strategy name
+func (selector *hashRandomSelector) Strategy() string {
+	return UniformStrategy
+}
+
 func (selector *hashRandomSelector) randomInt(seed uint64, max uint64) uint64 {
 	if max == 0 {
 		return 0
@@ -26,3 +46,8 @@ func (selector *hashRandomSelector) randomInt(seed uint64, max uint64) uint64 {
 
 	return result
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (selector *hashRandomSelector) IsInterfaceNil() bool {
+	return selector == nil
+}
@@ -1,7 +1,24 @@
 package topology
 
+import "math/big"
+
 // PublicKeysProvider defines the behavior of a provider able to return all public keys allowed to operate on the relayers network
 type PublicKeysProvider interface {
 	SortedPublicKeys() [][]byte
+	IsOnProbation(address []byte) bool
+	IsInterfaceNil() bool
+}
+
+// LeaderSelector defines the strategy used to select the leader of a slot from the sorted public keys. All the
+// relayers should use the same strategy, otherwise they will not agree on the leaders
+type LeaderSelector interface {
+	LeaderIndex(slot uint64, sortedPublicKeys [][]byte) int
+	Strategy() string
+	IsInterfaceNil() bool
+}
+
+// StakesProvider defines a provider able to return the amount staked by each relayer
+type StakesProvider interface {
+	Stake(publicKey []byte) *big.Int
 	IsInterfaceNil() bool
 }
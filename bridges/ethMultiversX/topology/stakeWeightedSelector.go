@@ -0,0 +1,175 @@
+package topology
+
+import (
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	// StakeWeightedStrategy selects the leaders in a weighted round-robin sequence, where each relayer gets a number
+	// of slots proportional to its stake
+	StakeWeightedStrategy = "stake-weighted"
+
+	maxSequenceLength = 10000
+)
+
+// ArgsStakeWeightedSelector is the DTO used to create a new instance of type stakeWeightedSelector
+type ArgsStakeWeightedSelector struct {
+	StakesProvider StakesProvider
+}
+
+type stakeWeightedSelector struct {
+	stakesProvider StakesProvider
+	uniform        *hashRandomSelector
+
+	mutSequence sync.Mutex
+	sequenceKey string
+	sequence    []int
+}
+
+// NewStakeWeightedSelector creates a leader selector implementing the stake-weighted strategy. The sequence of leaders
+// only depends on the sorted public keys and on their stakes so all the relayers seeing the same on-chain stakes will
+// agree on the leader of each slot
+func NewStakeWeightedSelector(args ArgsStakeWeightedSelector) (*stakeWeightedSelector, error) {
+	if check.IfNil(args.StakesProvider) {
+		return nil, errNilStakesProvider
+	}
+
+	return &stakeWeightedSelector{
+		stakesProvider: args.StakesProvider,
+		uniform:        NewHashRandomSelector(),
+	}, nil
+}
+
+// LeaderIndex returns the index of the slot's leader from the stake-weighted round-robin sequence. If no relayer has
+// a known stake, the uniform strategy is used instead
+func (selector *stakeWeightedSelector) LeaderIndex(slot uint64, sortedPublicKeys [][]byte) int {
+	if len(sortedPublicKeys) == 0 {
+		return 0
+	}
+
+	stakes := make([]*big.Int, 0, len(sortedPublicKeys))
+	for _, publicKey := range sortedPublicKeys {
+		stakes = append(stakes, selector.getStake(publicKey))
+	}
+
+	sequence := selector.getSequence(sortedPublicKeys, stakes)
+	if len(sequence) == 0 {
+		return selector.uniform.LeaderIndex(slot, sortedPublicKeys)
+	}
+
+	return sequence[slot%uint64(len(sequence))]
+}
+
+func (selector *stakeWeightedSelector) getStake(publicKey []byte) *big.Int {
+	stake := selector.stakesProvider.Stake(publicKey)
+	if stake == nil || stake.Sign() < 0 {
+		return big.NewInt(0)
+	}
+
+	return stake
+}
+
+func (selector *stakeWeightedSelector) getSequence(sortedPublicKeys [][]byte, stakes []*big.Int) []int {
+	key := createSequenceKey(sortedPublicKeys, stakes)
+
+	selector.mutSequence.Lock()
+	defer selector.mutSequence.Unlock()
+
+	if key != selector.sequenceKey {
+		selector.sequence = createWeightedSequence(computeWeights(stakes))
+		selector.sequenceKey = key
+	}
+
+	return selector.sequence
+}
+
+func createSequenceKey(sortedPublicKeys [][]byte, stakes []*big.Int) string {
+	builder := strings.Builder{}
+	for i, publicKey := range sortedPublicKeys {
+		builder.Write(publicKey)
+		builder.WriteString(":")
+		builder.WriteString(stakes[i].String())
+		builder.WriteString(";")
+	}
+
+	return builder.String()
+}
+
+// computeWeights reduces the stakes to small integer weights, keeping the proportions exact when the reduced total
+// fits the maximum sequence length and approximating them otherwise. A relayer with a non-zero stake always gets at
+// least one slot
+func computeWeights(stakes []*big.Int) []uint64 {
+	gcd := big.NewInt(0)
+	total := big.NewInt(0)
+	for _, stake := range stakes {
+		total.Add(total, stake)
+		if stake.Sign() > 0 {
+			gcd.GCD(nil, nil, gcd, stake)
+		}
+	}
+	if total.Sign() == 0 {
+		return nil
+	}
+
+	total.Div(total, gcd)
+	shouldScale := total.Cmp(big.NewInt(maxSequenceLength)) > 0
+
+	weights := make([]uint64, 0, len(stakes))
+	for _, stake := range stakes {
+		weight := big.NewInt(0).Div(stake, gcd)
+		if shouldScale {
+			weight.Mul(weight, big.NewInt(maxSequenceLength))
+			weight.Div(weight, total)
+			if weight.Sign() == 0 && stake.Sign() > 0 {
+				weight.SetUint64(1)
+			}
+		}
+
+		weights = append(weights, weight.Uint64())
+	}
+
+	return weights
+}
+
+// createWeightedSequence generates the smooth weighted round-robin sequence, in which the slots of each relayer are
+// interleaved with the others instead of being consecutive
+func createWeightedSequence(weights []uint64) []int {
+	totalWeight := int64(0)
+	for _, weight := range weights {
+		totalWeight += int64(weight)
+	}
+	if totalWeight == 0 {
+		return nil
+	}
+
+	currentWeights := make([]int64, len(weights))
+	sequence := make([]int, 0, totalWeight)
+	for i := int64(0); i < totalWeight; i++ {
+		selected := 0
+		for index, weight := range weights {
+			currentWeights[index] += int64(weight)
+			if currentWeights[index] > currentWeights[selected] {
+				selected = index
+			}
+		}
+
+		currentWeights[selected] -= totalWeight
+		sequence = append(sequence, selected)
+	}
+
+	return sequence
+}
+
+// Strategy returns the stake-weighted strategy name
+func (selector *stakeWeightedSelector) Strategy() string {
+	return StakeWeightedStrategy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (selector *stakeWeightedSelector) IsInterfaceNil() bool {
+	return selector == nil
+}
@@ -0,0 +1,150 @@
+package topology
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createStakesProviderStub(stakes map[string]*big.Int) *testsCommon.StakesProviderStub {
+	return &testsCommon.StakesProviderStub{
+		StakeCalled: func(publicKey []byte) *big.Int {
+			return stakes[string(publicKey)]
+		},
+	}
+}
+
+func createTestPublicKeys(numKeys int) [][]byte {
+	publicKeys := make([][]byte, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		publicKeys = append(publicKeys, bytes.Repeat([]byte{byte('1' + i)}, 32))
+	}
+
+	return publicKeys
+}
+
+func countLeaders(selector LeaderSelector, publicKeys [][]byte, numSlots uint64) map[int]int {
+	counters := make(map[int]int)
+	for slot := uint64(0); slot < numSlots; slot++ {
+		counters[selector.LeaderIndex(slot, publicKeys)]++
+	}
+
+	return counters
+}
+
+func TestNewStakeWeightedSelector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil stakes provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		selector, err := NewStakeWeightedSelector(ArgsStakeWeightedSelector{})
+		assert.True(t, check.IfNil(selector))
+		assert.Equal(t, errNilStakesProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		selector, err := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: &testsCommon.StakesProviderStub{},
+		})
+		assert.False(t, check.IfNil(selector))
+		assert.Nil(t, err)
+		assert.Equal(t, StakeWeightedStrategy, selector.Strategy())
+	})
+}
+
+func TestStakeWeightedSelector_LeaderIndex(t *testing.T) {
+	t.Parallel()
+
+	publicKeys := createTestPublicKeys(3)
+
+	t.Run("empty public keys should return 0", func(t *testing.T) {
+		t.Parallel()
+
+		selector, _ := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: &testsCommon.StakesProviderStub{},
+		})
+		assert.Equal(t, 0, selector.LeaderIndex(10, make([][]byte, 0)))
+	})
+	t.Run("no stakes should fallback to the uniform strategy", func(t *testing.T) {
+		t.Parallel()
+
+		selector, _ := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: &testsCommon.StakesProviderStub{},
+		})
+		uniform := NewHashRandomSelector()
+		for slot := uint64(0); slot < 100; slot++ {
+			assert.Equal(t, uniform.LeaderIndex(slot, publicKeys), selector.LeaderIndex(slot, publicKeys))
+		}
+	})
+	t.Run("should select the leaders proportionally to the stakes", func(t *testing.T) {
+		t.Parallel()
+
+		selector, _ := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: createStakesProviderStub(map[string]*big.Int{
+				string(publicKeys[0]): big.NewInt(1000),
+				string(publicKeys[1]): big.NewInt(2000),
+				string(publicKeys[2]): big.NewInt(3000),
+			}),
+		})
+
+		// weights 1, 2 and 3 give the smooth round-robin sequence 2, 1, 0, 2, 1, 2
+		expectedSequence := []int{2, 1, 0, 2, 1, 2}
+		for slot := uint64(0); slot < 12; slot++ {
+			assert.Equal(t, expectedSequence[slot%6], selector.LeaderIndex(slot, publicKeys))
+		}
+
+		counters := countLeaders(selector, publicKeys, 600)
+		assert.Equal(t, map[int]int{0: 100, 1: 200, 2: 300}, counters)
+	})
+	t.Run("relayers without stake should not be selected", func(t *testing.T) {
+		t.Parallel()
+
+		selector, _ := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: createStakesProviderStub(map[string]*big.Int{
+				string(publicKeys[0]): big.NewInt(5),
+				string(publicKeys[2]): big.NewInt(-5),
+			}),
+		})
+
+		counters := countLeaders(selector, publicKeys, 100)
+		assert.Equal(t, map[int]int{0: 100}, counters)
+	})
+	t.Run("large stakes should be scaled and keep all staked relayers", func(t *testing.T) {
+		t.Parallel()
+
+		largeStake, _ := big.NewInt(0).SetString("1000000000000000000000001", 10)
+		selector, _ := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: createStakesProviderStub(map[string]*big.Int{
+				string(publicKeys[0]): largeStake,
+				string(publicKeys[1]): big.NewInt(1),
+				string(publicKeys[2]): largeStake,
+			}),
+		})
+
+		counters := countLeaders(selector, publicKeys, 9999)
+		assert.Equal(t, map[int]int{0: 4999, 1: 1, 2: 4999}, counters)
+	})
+	t.Run("stake changes should recompute the sequence", func(t *testing.T) {
+		t.Parallel()
+
+		stakes := map[string]*big.Int{
+			string(publicKeys[0]): big.NewInt(1),
+			string(publicKeys[1]): big.NewInt(0),
+			string(publicKeys[2]): big.NewInt(0),
+		}
+		selector, _ := NewStakeWeightedSelector(ArgsStakeWeightedSelector{
+			StakesProvider: createStakesProviderStub(stakes),
+		})
+		assert.Equal(t, 0, selector.LeaderIndex(1, publicKeys))
+
+		stakes[string(publicKeys[0])] = big.NewInt(0)
+		stakes[string(publicKeys[1])] = big.NewInt(1)
+		assert.Equal(t, 1, selector.LeaderIndex(1, publicKeys))
+	})
+}
@@ -17,6 +17,7 @@ type ArgsTopologyHandler struct {
 	AddressBytes       []byte
 	Log                logger.Logger
 	AddressConverter   core.AddressConverter
+	LeaderSelector     LeaderSelector
 }
 
 // topologyHandler implements topologyProvider for a specific relay
@@ -25,7 +26,7 @@ type topologyHandler struct {
 	timer              core.Timer
 	intervalForLeader  time.Duration
 	addressBytes       []byte
-	selector           *hashRandomSelector
+	selector           LeaderSelector
 	log                logger.Logger
 	addressConverter   core.AddressConverter
 }
@@ -42,7 +43,7 @@ func NewTopologyHandler(args ArgsTopologyHandler) (*topologyHandler, error) {
 		timer:              args.Timer,
 		intervalForLeader:  args.IntervalForLeader,
 		addressBytes:       args.AddressBytes,
-		selector:           &hashRandomSelector{},
+		selector:           args.LeaderSelector,
 		log:                args.Log,
 		addressConverter:   args.AddressConverter,
 	}, nil
@@ -56,10 +57,7 @@ func (t *topologyHandler) MyTurnAsLeader() bool {
 		t.log.Warn("topology handler: can not compute my turn as leader as the list is empty")
 		return false
 	} else {
-		numberOfPeers := int64(len(sortedPublicKeys))
-
-		seed := uint64(t.timer.NowUnix() / int64(t.intervalForLeader.Seconds()))
-		index := t.selector.randomInt(seed, uint64(numberOfPeers))
+		index := t.selector.LeaderIndex(t.currentSlot(), sortedPublicKeys)
 
 		leaderAddress := sortedPublicKeys[index]
 		isLeader := bytes.Equal(leaderAddress, t.addressBytes)
@@ -73,10 +71,78 @@ func (t *topologyHandler) MyTurnAsLeader() bool {
 			"index", index,
 			"self address", t.addressConverter.ToBech32StringSilent(t.addressBytes))
 
+		if isLeader && t.IsSelfOnProbation() {
+			// the schedule is kept unchanged so all the relayers agree on it, the slot is skipped
+			t.log.Info("topology handler: skipping the leader slot as the relayer is on probation",
+				"self address", t.addressConverter.ToBech32StringSilent(t.addressBytes))
+			return false
+		}
+
 		return isLeader
 	}
 }
 
+// TopologyInfo returns the sorted public keys, the position of the current relay and the leaders of the next numSlots
+// slots, starting with the current one
+func (t *topologyHandler) TopologyInfo(numSlots int) *core.TopologyInfo {
+	if numSlots < 0 {
+		numSlots = 0
+	}
+
+	sortedPublicKeys := t.publicKeysProvider.SortedPublicKeys()
+	currentSlot := t.currentSlot()
+	intervalInSeconds := int64(t.intervalForLeader.Seconds())
+
+	info := &core.TopologyInfo{
+		PublicKeys:                 make([]string, 0, len(sortedPublicKeys)),
+		SelfAddress:                t.addressConverter.ToBech32StringSilent(t.addressBytes),
+		SelfIndex:                  -1,
+		Strategy:                   t.selector.Strategy(),
+		IntervalForLeaderInSeconds: intervalInSeconds,
+		CurrentSlot:                currentSlot,
+		Schedule:                   make([]core.LeaderSlot, 0, numSlots),
+	}
+	for i, publicKey := range sortedPublicKeys {
+		bech32Address := t.addressConverter.ToBech32StringSilent(publicKey)
+		info.PublicKeys = append(info.PublicKeys, bech32Address)
+		if bytes.Equal(publicKey, t.addressBytes) {
+			info.SelfIndex = i
+		}
+		if t.publicKeysProvider.IsOnProbation(publicKey) {
+			info.OnProbation = append(info.OnProbation, bech32Address)
+		}
+	}
+	info.SelfOnProbation = t.IsSelfOnProbation()
+	if len(sortedPublicKeys) == 0 {
+		return info
+	}
+
+	for i := 0; i < numSlots; i++ {
+		slot := currentSlot + uint64(i)
+		index := t.selector.LeaderIndex(slot, sortedPublicKeys)
+		info.Schedule = append(info.Schedule, core.LeaderSlot{
+			Slot:        slot,
+			StartTime:   int64(slot) * intervalInSeconds,
+			LeaderIndex: index,
+			Leader:      info.PublicKeys[index],
+			IsSelf:      index == info.SelfIndex,
+		})
+	}
+
+	return info
+}
+
+// IsSelfOnProbation returns true if the current relay is on probation. Its signatures are not counted by the other
+// relayers and it skips its leader slots
+func (t *topologyHandler) IsSelfOnProbation() bool {
+	return t.publicKeysProvider.IsOnProbation(t.addressBytes)
+}
+
+// currentSlot returns the seed used by the leader selector at the current time
+func (t *topologyHandler) currentSlot() uint64 {
+	return uint64(t.timer.NowUnix() / int64(t.intervalForLeader.Seconds()))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (t *topologyHandler) IsInterfaceNil() bool {
 	return t == nil
@@ -101,6 +167,9 @@ func checkArgs(args ArgsTopologyHandler) error {
 	if check.IfNil(args.AddressConverter) {
 		return errNilAddressConverter
 	}
+	if check.IfNil(args.LeaderSelector) {
+		return errNilLeaderSelector
+	}
 
 	return nil
 }
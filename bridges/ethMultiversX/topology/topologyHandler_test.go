@@ -83,6 +83,16 @@ func TestNewTopologyHandler(t *testing.T) {
 		assert.True(t, check.IfNil(tph))
 		assert.Equal(t, errNilAddressConverter, err)
 	})
+	t.Run("nil leader selector", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.LeaderSelector = nil
+		tph, err := NewTopologyHandler(args)
+
+		assert.True(t, check.IfNil(tph))
+		assert.Equal(t, errNilLeaderSelector, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -135,6 +145,103 @@ func TestMyTurnAsLeader(t *testing.T) {
 
 		assert.True(t, tph.MyTurnAsLeader())
 	})
+
+	t.Run("leader on probation should skip the slot", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		publicKeysProvider := args.PublicKeysProvider.(*testsCommon.BroadcasterStub)
+		publicKeysProvider.IsOnProbationCalled = func(address []byte) bool {
+			return bytes.Equal(address, args.AddressBytes)
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		assert.False(t, tph.MyTurnAsLeader())
+		assert.True(t, tph.IsSelfOnProbation())
+	})
+}
+
+func TestTopologyInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty public keys should return an empty schedule", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.PublicKeysProvider = &testsCommon.BroadcasterStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return make([][]byte, 0)
+			},
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		info := tph.TopologyInfo(10)
+		assert.Equal(t, 0, len(info.PublicKeys))
+		assert.Equal(t, -1, info.SelfIndex)
+		assert.Equal(t, 0, len(info.Schedule))
+	})
+	t.Run("relayers on probation should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		onProbation := bytes.Repeat([]byte("2"), 32)
+		publicKeysProvider := args.PublicKeysProvider.(*testsCommon.BroadcasterStub)
+		publicKeysProvider.IsOnProbationCalled = func(address []byte) bool {
+			return bytes.Equal(address, onProbation)
+		}
+		tph, _ := NewTopologyHandler(args)
+
+		info := tph.TopologyInfo(1)
+		assert.Equal(t, []string{args.AddressConverter.ToBech32StringSilent(onProbation)}, info.OnProbation)
+		assert.False(t, info.SelfOnProbation)
+
+		args.AddressBytes = onProbation
+		tph, _ = NewTopologyHandler(args)
+		info = tph.TopologyInfo(1)
+		assert.True(t, info.SelfOnProbation)
+	})
+	t.Run("negative number of slots should return an empty schedule", func(t *testing.T) {
+		t.Parallel()
+
+		tph, _ := NewTopologyHandler(createMockArgsTopologyHandler())
+
+		info := tph.TopologyInfo(-1)
+		assert.Equal(t, 2, len(info.PublicKeys))
+		assert.Equal(t, 0, len(info.Schedule))
+	})
+	t.Run("should match the leader selection", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTopologyHandler()
+		args.IntervalForLeader = time.Second * 60
+		timerStub := createTimerStubWithUnixValue(6000)
+		args.Timer = timerStub
+		tph, _ := NewTopologyHandler(args)
+
+		info := tph.TopologyInfo(5)
+		assert.Equal(t, args.AddressConverter.ToBech32StringSilent(args.AddressBytes), info.SelfAddress)
+		assert.Equal(t, 0, info.SelfIndex)
+		assert.Equal(t, UniformStrategy, info.Strategy)
+		assert.Equal(t, int64(60), info.IntervalForLeaderInSeconds)
+		assert.Equal(t, uint64(100), info.CurrentSlot)
+		assert.Equal(t, []string{
+			args.AddressConverter.ToBech32StringSilent(bytes.Repeat([]byte("1"), 32)),
+			args.AddressConverter.ToBech32StringSilent(bytes.Repeat([]byte("2"), 32)),
+		}, info.PublicKeys)
+		assert.Equal(t, 5, len(info.Schedule))
+
+		for _, leaderSlot := range info.Schedule {
+			startTime := leaderSlot.StartTime
+			timerStub.NowUnixCalled = func() int64 {
+				return startTime
+			}
+
+			assert.Equal(t, info.PublicKeys[leaderSlot.LeaderIndex], leaderSlot.Leader)
+			assert.Equal(t, leaderSlot.IsSelf, tph.MyTurnAsLeader())
+		}
+		assert.Equal(t, uint64(104), info.Schedule[4].Slot)
+		assert.Equal(t, int64(6240), info.Schedule[4].StartTime)
+	})
 }
 
 func createTimerStubWithUnixValue(value int64) *testsCommon.TimerStub {
@@ -161,5 +268,6 @@ func createMockArgsTopologyHandler() ArgsTopologyHandler {
 		AddressBytes:      bytes.Repeat([]byte("1"), 32),
 		Log:               logger.GetOrCreate("test"),
 		AddressConverter:  addressConverter,
+		LeaderSelector:    NewHashRandomSelector(),
 	}
 }
@@ -0,0 +1,29 @@
+package balanceMonitor
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// ParseDenominatedAmount converts a human readable amount (e.g. "0.5") in base units, using the provided number of
+// decimals. An empty value is considered 0
+func ParseDenominatedAmount(value string, decimals int) (*big.Int, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	amount, ok := big.NewRat(0, 1).SetString(value)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%w for amount %s", errInvalidValue, value)
+	}
+
+	denomination := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	amount.Mul(amount, big.NewRat(0, 1).SetInt(denomination))
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("%w for amount %s: too many decimals", errInvalidValue, value)
+	}
+
+	return amount.Num(), nil
+}
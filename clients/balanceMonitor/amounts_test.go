@@ -0,0 +1,43 @@
+package balanceMonitor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDenominatedAmount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty value should return 0", func(t *testing.T) {
+		t.Parallel()
+
+		amount, err := ParseDenominatedAmount(" ", 18)
+		assert.Nil(t, err)
+		assert.Equal(t, "0", amount.String())
+	})
+	t.Run("invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseDenominatedAmount("abc", 18)
+		assert.True(t, errors.Is(err, errInvalidValue))
+
+		_, err = ParseDenominatedAmount("-1", 18)
+		assert.True(t, errors.Is(err, errInvalidValue))
+
+		_, err = ParseDenominatedAmount("0.001", 2)
+		assert.True(t, errors.Is(err, errInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		amount, err := ParseDenominatedAmount("0.5", 18)
+		assert.Nil(t, err)
+		assert.Equal(t, "500000000000000000", amount.String())
+
+		amount, err = ParseDenominatedAmount("12", 6)
+		assert.Nil(t, err)
+		assert.Equal(t, "12000000", amount.String())
+	})
+}
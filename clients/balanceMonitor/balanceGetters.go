@@ -0,0 +1,78 @@
+package balanceMonitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+type ethereumBalanceGetter struct {
+	provider EthereumBalanceProvider
+	address  common.Address
+}
+
+// NewEthereumBalanceGetter creates a balance getter for the provided Ethereum address
+func NewEthereumBalanceGetter(provider EthereumBalanceProvider, address common.Address) (*ethereumBalanceGetter, error) {
+	if check.IfNil(provider) {
+		return nil, errNilBalanceProvider
+	}
+
+	return &ethereumBalanceGetter{
+		provider: provider,
+		address:  address,
+	}, nil
+}
+
+// GetBalance returns the latest balance of the account
+func (getter *ethereumBalanceGetter) GetBalance(ctx context.Context) (*big.Int, error) {
+	return getter.provider.BalanceAt(ctx, getter.address, nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *ethereumBalanceGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
+
+type multiversXBalanceGetter struct {
+	provider MultiversXAccountProvider
+	address  core.AddressHandler
+}
+
+// NewMultiversXBalanceGetter creates a balance getter for the provided MultiversX address
+func NewMultiversXBalanceGetter(provider MultiversXAccountProvider, address core.AddressHandler) (*multiversXBalanceGetter, error) {
+	if check.IfNil(provider) {
+		return nil, errNilBalanceProvider
+	}
+	if check.IfNil(address) {
+		return nil, errNilAddressHandler
+	}
+
+	return &multiversXBalanceGetter{
+		provider: provider,
+		address:  address,
+	}, nil
+}
+
+// GetBalance returns the latest balance of the account
+func (getter *multiversXBalanceGetter) GetBalance(ctx context.Context) (*big.Int, error) {
+	account, err := getter.provider.GetAccount(ctx, getter.address)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errInvalidBalance, account.Balance)
+	}
+
+	return balance, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *multiversXBalanceGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
@@ -0,0 +1,105 @@
+package balanceMonitor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEthereumBalanceGetter_GetBalance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewEthereumBalanceGetter(nil, common.Address{})
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, errNilBalanceProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		address := common.HexToAddress("0x3FE464Ac5aa562F7948322F92020F2b668D543d8")
+		getter, err := NewEthereumBalanceGetter(&interactors.BlockchainClientStub{
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				assert.Equal(t, address, account)
+				assert.Nil(t, blockNumber)
+				return big.NewInt(37), nil
+			},
+		}, address)
+		assert.Nil(t, err)
+
+		balance, err := getter.GetBalance(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(37), balance)
+	})
+}
+
+func TestMultiversXBalanceGetter_GetBalance(t *testing.T) {
+	t.Parallel()
+
+	address := data.NewAddressFromBytes(make([]byte, 32))
+
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewMultiversXBalanceGetter(nil, address)
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, errNilBalanceProvider, err)
+	})
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewMultiversXBalanceGetter(&interactors.ProxyStub{}, nil)
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, errNilAddressHandler, err)
+	})
+	t.Run("get account errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		getter, _ := NewMultiversXBalanceGetter(&interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}, address)
+
+		balance, err := getter.GetBalance(context.Background())
+		assert.Nil(t, balance)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, _ := NewMultiversXBalanceGetter(&interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Balance: "not a number"}, nil
+			},
+		}, address)
+
+		balance, err := getter.GetBalance(context.Background())
+		assert.Nil(t, balance)
+		assert.True(t, errors.Is(err, errInvalidBalance))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter, _ := NewMultiversXBalanceGetter(&interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Balance: "1000000000000000000"}, nil
+			},
+		}, address)
+
+		balance, err := getter.GetBalance(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "1000000000000000000", balance.String())
+	})
+}
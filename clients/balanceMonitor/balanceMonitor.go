@@ -0,0 +1,213 @@
+package balanceMonitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	secondsInDay            = 86400
+	minBurnRateWindow       = time.Minute
+	minSamplesTimeSpan      = 60
+	balanceDisplayDecimals  = 6
+	daysToEmptyUnknownValue = "unknown"
+)
+
+// ArgsBalanceMonitor is the DTO used to create a new balance monitor
+type ArgsBalanceMonitor struct {
+	Name               string
+	BalanceGetter      BalanceGetter
+	Decimals           int
+	MinimumBalance     *big.Int
+	MinimumDaysToEmpty uint64
+	BurnRateWindow     time.Duration
+	StatusHandler      core.StatusHandler
+	Log                logger.Logger
+	Timer              core.Timer
+}
+
+type balanceSample struct {
+	timestamp int64
+	balance   *big.Int
+}
+
+type balanceMonitor struct {
+	name               string
+	balanceGetter      BalanceGetter
+	denomination       *big.Int
+	minimumBalance     *big.Int
+	minimumDaysToEmpty uint64
+	burnRateWindow     int64
+	statusHandler      core.StatusHandler
+	log                logger.Logger
+	timer              core.Timer
+
+	mut     sync.Mutex
+	samples []*balanceSample
+}
+
+// NewBalanceMonitor creates a component that periodically tracks the balance of a relayer account, exposes it as
+// metrics and alerts when it drops below the configured thresholds
+func NewBalanceMonitor(args ArgsBalanceMonitor) (*balanceMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	minimumBalance := big.NewInt(0)
+	if args.MinimumBalance != nil {
+		minimumBalance.Set(args.MinimumBalance)
+	}
+
+	return &balanceMonitor{
+		name:               args.Name,
+		balanceGetter:      args.BalanceGetter,
+		denomination:       big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(args.Decimals)), nil),
+		minimumBalance:     minimumBalance,
+		minimumDaysToEmpty: args.MinimumDaysToEmpty,
+		burnRateWindow:     int64(args.BurnRateWindow.Seconds()),
+		statusHandler:      args.StatusHandler,
+		log:                args.Log,
+		timer:              args.Timer,
+		samples:            make([]*balanceSample, 0),
+	}, nil
+}
+
+func checkArgs(args ArgsBalanceMonitor) error {
+	if len(args.Name) == 0 {
+		return errEmptyName
+	}
+	if check.IfNil(args.BalanceGetter) {
+		return errNilBalanceGetter
+	}
+	if args.Decimals < 0 {
+		return fmt.Errorf("%w for Decimals: %d", errInvalidValue, args.Decimals)
+	}
+	if args.MinimumBalance != nil && args.MinimumBalance.Sign() < 0 {
+		return fmt.Errorf("%w for MinimumBalance: %s", errInvalidValue, args.MinimumBalance.String())
+	}
+	if args.BurnRateWindow < minBurnRateWindow {
+		return fmt.Errorf("%w for BurnRateWindow: %v, minimum: %v", errInvalidValue, args.BurnRateWindow, minBurnRateWindow)
+	}
+	if check.IfNil(args.StatusHandler) {
+		return errNilStatusHandler
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
+
+	return nil
+}
+
+// Execute fetches the current balance, updates the metrics and raises the alerts
+func (monitor *balanceMonitor) Execute(ctx context.Context) error {
+	balance, err := monitor.balanceGetter.GetBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	burnRatePerDay := monitor.addSampleAndComputeBurnRate(monitor.timer.NowUnix(), balance)
+	daysToEmpty, canBeEmptied := computeDaysToEmpty(balance, burnRatePerDay)
+
+	monitor.statusHandler.SetStringMetric(monitor.metricName("balance"), monitor.denominate(balance))
+	monitor.statusHandler.SetStringMetric(monitor.metricName("burn rate per day"), monitor.denominate(burnRatePerDay))
+	daysToEmptyValue := daysToEmptyUnknownValue
+	if canBeEmptied {
+		daysToEmptyValue = fmt.Sprintf("%.1f", daysToEmpty)
+	}
+	monitor.statusHandler.SetStringMetric(monitor.metricName("days to empty"), daysToEmptyValue)
+
+	isLowBalance := balance.Cmp(monitor.minimumBalance) < 0
+	isEmptyingSoon := canBeEmptied && monitor.minimumDaysToEmpty > 0 && daysToEmpty < float64(monitor.minimumDaysToEmpty)
+	alert := 0
+	if isLowBalance || isEmptyingSoon {
+		alert = 1
+	}
+	monitor.statusHandler.SetIntMetric(monitor.metricName("balance alert"), alert)
+
+	if isLowBalance {
+		monitor.log.Error(fmt.Sprintf("%s relayer balance is below the threshold, the relayer will soon not be able to send transactions", monitor.name),
+			"balance", monitor.denominate(balance), "threshold", monitor.denominate(monitor.minimumBalance),
+			"days to empty", daysToEmptyValue)
+		return nil
+	}
+	if isEmptyingSoon {
+		monitor.log.Warn(fmt.Sprintf("%s relayer balance will be emptied soon", monitor.name),
+			"balance", monitor.denominate(balance), "days to empty", daysToEmptyValue,
+			"threshold in days", monitor.minimumDaysToEmpty)
+		return nil
+	}
+
+	monitor.log.Debug(fmt.Sprintf("%s relayer balance", monitor.name),
+		"balance", monitor.denominate(balance), "days to empty", daysToEmptyValue)
+
+	return nil
+}
+
+// addSampleAndComputeBurnRate stores the new sample, discards the ones older than the window and returns the amount
+// spent per day, computed only from the balance decreases so the top-ups are not accounted
+func (monitor *balanceMonitor) addSampleAndComputeBurnRate(timestamp int64, balance *big.Int) *big.Int {
+	monitor.mut.Lock()
+	defer monitor.mut.Unlock()
+
+	monitor.samples = append(monitor.samples, &balanceSample{
+		timestamp: timestamp,
+		balance:   big.NewInt(0).Set(balance),
+	})
+
+	firstIndex := 0
+	for firstIndex < len(monitor.samples)-1 && monitor.samples[firstIndex].timestamp < timestamp-monitor.burnRateWindow {
+		firstIndex++
+	}
+	monitor.samples = monitor.samples[firstIndex:]
+
+	timeSpan := timestamp - monitor.samples[0].timestamp
+	if timeSpan < minSamplesTimeSpan {
+		return big.NewInt(0)
+	}
+
+	burned := big.NewInt(0)
+	for i := 1; i < len(monitor.samples); i++ {
+		diff := big.NewInt(0).Sub(monitor.samples[i-1].balance, monitor.samples[i].balance)
+		if diff.Sign() > 0 {
+			burned.Add(burned, diff)
+		}
+	}
+
+	burned.Mul(burned, big.NewInt(secondsInDay))
+
+	return burned.Div(burned, big.NewInt(timeSpan))
+}
+
+func computeDaysToEmpty(balance *big.Int, burnRatePerDay *big.Int) (float64, bool) {
+	if burnRatePerDay.Sign() == 0 {
+		return 0, false
+	}
+
+	daysToEmpty, _ := big.NewRat(0, 1).SetFrac(balance, burnRatePerDay).Float64()
+
+	return daysToEmpty, true
+}
+
+func (monitor *balanceMonitor) denominate(value *big.Int) string {
+	return big.NewRat(0, 1).SetFrac(value, monitor.denomination).FloatString(balanceDisplayDecimals)
+}
+
+func (monitor *balanceMonitor) metricName(metric string) string {
+	return fmt.Sprintf("%s relayer %s", monitor.name, metric)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (monitor *balanceMonitor) IsInterfaceNil() bool {
+	return monitor == nil
+}
@@ -0,0 +1,279 @@
+package balanceMonitor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceGetterStub struct {
+	GetBalanceCalled func(ctx context.Context) (*big.Int, error)
+}
+
+func (stub *balanceGetterStub) GetBalance(ctx context.Context) (*big.Int, error) {
+	return stub.GetBalanceCalled(ctx)
+}
+
+func (stub *balanceGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func eth(value int64) *big.Int {
+	return big.NewInt(0).Mul(big.NewInt(value), big.NewInt(1000000000000000000))
+}
+
+func createMockArgsBalanceMonitor() ArgsBalanceMonitor {
+	return ArgsBalanceMonitor{
+		Name: "Ethereum",
+		BalanceGetter: &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return eth(10), nil
+			},
+		},
+		Decimals:           18,
+		MinimumBalance:     eth(1),
+		MinimumDaysToEmpty: 7,
+		BurnRateWindow:     time.Hour * 24,
+		StatusHandler:      testsCommon.NewStatusHandlerMock("balance-monitor"),
+		Log:                &testsCommon.LoggerStub{},
+		Timer:              &testsCommon.TimerMock{},
+	}
+}
+
+func TestNewBalanceMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Name = ""
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errEmptyName, err)
+	})
+	t.Run("nil balance getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.BalanceGetter = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilBalanceGetter, err)
+	})
+	t.Run("negative decimals should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Decimals = -1
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "Decimals"))
+	})
+	t.Run("negative minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.MinimumBalance = big.NewInt(-1)
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MinimumBalance"))
+	})
+	t.Run("invalid burn rate window should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.BurnRateWindow = time.Second
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "BurnRateWindow"))
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.StatusHandler = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Log = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Timer = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilTimer, err)
+	})
+	t.Run("nil minimum balance should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.MinimumBalance = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.False(t, check.IfNil(monitor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBalanceMonitor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("balance getter errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBalanceMonitor()
+		args.BalanceGetter = &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("first sample should set the metrics without a burn rate", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		statusHandler := testsCommon.NewStatusHandlerMock("balance-monitor")
+		args.StatusHandler = statusHandler
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "10.000000", statusHandler.GetStringMetric("Ethereum relayer balance"))
+		assert.Equal(t, "0.000000", statusHandler.GetStringMetric("Ethereum relayer burn rate per day"))
+		assert.Equal(t, daysToEmptyUnknownValue, statusHandler.GetStringMetric("Ethereum relayer days to empty"))
+		assert.Equal(t, 0, statusHandler.GetIntMetric("Ethereum relayer balance alert"))
+	})
+	t.Run("balance below threshold should alert", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.BalanceGetter = &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(0).Div(eth(1), big.NewInt(2)), nil
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("balance-monitor")
+		args.StatusHandler = statusHandler
+		errorLogged := false
+		args.Log = &testsCommon.LoggerStub{
+			ErrorCalled: func(message string, args ...interface{}) {
+				errorLogged = true
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, errorLogged)
+		assert.Equal(t, "0.500000", statusHandler.GetStringMetric("Ethereum relayer balance"))
+		assert.Equal(t, 1, statusHandler.GetIntMetric("Ethereum relayer balance alert"))
+	})
+	t.Run("high burn rate should alert on the projected days to empty", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		balances := []*big.Int{eth(10), eth(9), eth(20), eth(18)}
+		timestamps := []int64{0, secondsInDay / 2, secondsInDay / 2, secondsInDay}
+		index := 0
+		args.BalanceGetter = &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return balances[index], nil
+			},
+		}
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return timestamps[index]
+		}
+		args.Timer = timer
+		statusHandler := testsCommon.NewStatusHandlerMock("balance-monitor")
+		args.StatusHandler = statusHandler
+		warnLogged := false
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				warnLogged = true
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		for index = 0; index < len(balances); index++ {
+			err := monitor.Execute(context.Background())
+			require.Nil(t, err)
+		}
+
+		// 3 ETH spent in a day (the top-up is ignored), 18 ETH left
+		assert.Equal(t, "18.000000", statusHandler.GetStringMetric("Ethereum relayer balance"))
+		assert.Equal(t, "3.000000", statusHandler.GetStringMetric("Ethereum relayer burn rate per day"))
+		assert.Equal(t, "6.0", statusHandler.GetStringMetric("Ethereum relayer days to empty"))
+		assert.Equal(t, 1, statusHandler.GetIntMetric("Ethereum relayer balance alert"))
+		assert.True(t, warnLogged)
+	})
+	t.Run("old samples should be discarded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.BurnRateWindow = time.Hour
+		args.MinimumDaysToEmpty = 0
+		balances := []*big.Int{eth(100), eth(50), eth(49)}
+		timestamps := []int64{0, 7200, 7200 + 3600}
+		index := 0
+		args.BalanceGetter = &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return balances[index], nil
+			},
+		}
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return timestamps[index]
+		}
+		args.Timer = timer
+		statusHandler := testsCommon.NewStatusHandlerMock("balance-monitor")
+		args.StatusHandler = statusHandler
+		monitor, _ := NewBalanceMonitor(args)
+
+		for index = 0; index < len(balances); index++ {
+			err := monitor.Execute(context.Background())
+			require.Nil(t, err)
+		}
+
+		// only the last hour is accounted: 1 ETH per hour. The days to empty alert is disabled
+		assert.Equal(t, "24.000000", statusHandler.GetStringMetric("Ethereum relayer burn rate per day"))
+		assert.Equal(t, "2.0", statusHandler.GetStringMetric("Ethereum relayer days to empty"))
+		assert.Equal(t, 0, statusHandler.GetIntMetric("Ethereum relayer balance alert"))
+	})
+}
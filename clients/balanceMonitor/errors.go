@@ -0,0 +1,15 @@
+package balanceMonitor
+
+import "errors"
+
+var (
+	errNilBalanceGetter   = errors.New("nil balance getter")
+	errNilBalanceProvider = errors.New("nil balance provider")
+	errNilAddressHandler  = errors.New("nil address handler")
+	errNilStatusHandler   = errors.New("nil status handler")
+	errNilLogger          = errors.New("nil logger")
+	errNilTimer           = errors.New("nil timer")
+	errEmptyName          = errors.New("empty name")
+	errInvalidValue       = errors.New("invalid value")
+	errInvalidBalance     = errors.New("invalid balance")
+)
@@ -0,0 +1,28 @@
+package balanceMonitor
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// BalanceGetter is able to fetch the current native balance of a monitored account
+type BalanceGetter interface {
+	GetBalance(ctx context.Context) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// EthereumBalanceProvider defines the Ethereum operation needed to fetch an account balance
+type EthereumBalanceProvider interface {
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXAccountProvider defines the MultiversX operation needed to fetch an account balance
+type MultiversXAccountProvider interface {
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,395 @@
+package balanceProof
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsBalanceProof represents the DTO struct used in the NewBalanceProof constructor function
+type ArgsBalanceProof struct {
+	Log              logger.Logger
+	EthereumClient   EthereumClient
+	EthereumChain    EthereumChain
+	MultiversXClient MultiversXClient
+	DataGetter       MultiversXDataGetter
+	ERC20Tokens      []string
+	CacheDuration    time.Duration
+	QueryTimeout     time.Duration
+}
+
+type balanceProof struct {
+	log              logger.Logger
+	ethereumClient   EthereumClient
+	ethereumChain    EthereumChain
+	multiversXClient MultiversXClient
+	dataGetter       MultiversXDataGetter
+	erc20Tokens      []common.Address
+	cacheDuration    time.Duration
+	queryTimeout     time.Duration
+	getTime          func() time.Time
+
+	mutProof       sync.Mutex
+	proof          *core.BalanceProof
+	lastComputedAt time.Time
+}
+
+// NewBalanceProof creates the component computing the view of the bridge reserves for the provided ERC20 tokens or, if
+// none is provided, for all the tokens known by the MultiversX safe contract
+func NewBalanceProof(args ArgsBalanceProof) (*balanceProof, error) {
+	erc20Tokens, err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balanceProof{
+		log:              args.Log,
+		ethereumClient:   args.EthereumClient,
+		ethereumChain:    args.EthereumChain,
+		multiversXClient: args.MultiversXClient,
+		dataGetter:       args.DataGetter,
+		erc20Tokens:      erc20Tokens,
+		cacheDuration:    args.CacheDuration,
+		queryTimeout:     args.QueryTimeout,
+		getTime:          time.Now,
+	}, nil
+}
+
+func checkArgs(args ArgsBalanceProof) ([]common.Address, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.EthereumClient) {
+		return nil, ErrNilEthereumClient
+	}
+	if check.IfNil(args.EthereumChain) {
+		return nil, ErrNilEthereumChain
+	}
+	if check.IfNil(args.MultiversXClient) {
+		return nil, ErrNilMultiversXClient
+	}
+	if check.IfNil(args.DataGetter) {
+		return nil, ErrNilMultiversXDataGetter
+	}
+	if args.QueryTimeout <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidQueryTimeout, args.QueryTimeout)
+	}
+
+	erc20Tokens := make([]common.Address, 0, len(args.ERC20Tokens))
+	for _, token := range args.ERC20Tokens {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTokenAddress, token)
+		}
+		erc20Tokens = append(erc20Tokens, common.HexToAddress(token))
+	}
+
+	return erc20Tokens, nil
+}
+
+// GetBalanceProof returns the view of the bridge reserves. The proof is recomputed only if the cached one is older than
+// the cache duration, the concurrent callers waiting for the same computation
+func (proof *balanceProof) GetBalanceProof(ctx context.Context) (*core.BalanceProof, error) {
+	proof.mutProof.Lock()
+	defer proof.mutProof.Unlock()
+
+	now := proof.getTime()
+	if proof.proof != nil && now.Sub(proof.lastComputedAt) < proof.cacheDuration {
+		return proof.proof, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, proof.queryTimeout)
+	defer cancel()
+
+	computed, err := proof.compute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.proof = computed
+	proof.lastComputedAt = now
+
+	return computed, nil
+}
+
+func (proof *balanceProof) compute(ctx context.Context) (*core.BalanceProof, error) {
+	ethereumBlockNumber, err := proof.ethereumChain.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the Ethereum block number", err)
+	}
+	multiversXNonce, err := proof.multiversXClient.GetCurrentNonce(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the MultiversX nonce", err)
+	}
+
+	inFlightToMultiversX, err := proof.getInFlightToMultiversX(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the pending Ethereum batches", err)
+	}
+	inFlightToEthereum, err := proof.getInFlightToEthereum(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the pending MultiversX batches", err)
+	}
+
+	tokens, err := proof.getTokenPairs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the bridged tokens", err)
+	}
+
+	for _, tokenProof := range tokens {
+		if len(tokenProof.Error) == 0 {
+			err = proof.computeTokenProof(ctx, tokenProof, inFlightToMultiversX, inFlightToEthereum)
+			if err != nil {
+				tokenProof.Error = err.Error()
+			}
+		}
+		if len(tokenProof.Error) > 0 {
+			proof.log.Warn("balance proof: can not compute the token balances",
+				"ERC20 token", tokenProof.ERC20Address, "ESDT token", tokenProof.ESDTTokenID, "error", tokenProof.Error)
+		}
+	}
+
+	return &core.BalanceProof{
+		Timestamp:           proof.getTime().Unix(),
+		EthereumBlockNumber: ethereumBlockNumber,
+		MultiversXNonce:     multiversXNonce,
+		Tokens:              tokens,
+	}, nil
+}
+
+// getTokenPairs returns the token proofs holding the ERC20 and the ESDT tokens of each bridged pair. The tokens that
+// could not be paired have the Error field set
+func (proof *balanceProof) getTokenPairs(ctx context.Context) ([]*core.TokenBalanceProof, error) {
+	if len(proof.erc20Tokens) > 0 {
+		tokens := make([]*core.TokenBalanceProof, 0, len(proof.erc20Tokens))
+		for _, erc20Token := range proof.erc20Tokens {
+			tokenProof := &core.TokenBalanceProof{
+				ERC20Address: erc20Token.Hex(),
+			}
+			esdtToken, err := getPairedToken(proof.dataGetter.GetTokenIdForErc20Address(ctx, erc20Token.Bytes()))
+			if err != nil {
+				tokenProof.Error = err.Error()
+			}
+			tokenProof.ESDTTokenID = string(esdtToken)
+			tokens = append(tokens, tokenProof)
+		}
+
+		return tokens, nil
+	}
+
+	esdtTokens, err := proof.dataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*core.TokenBalanceProof, 0, len(esdtTokens))
+	for _, esdtToken := range esdtTokens {
+		tokenProof := &core.TokenBalanceProof{
+			ESDTTokenID: string(esdtToken),
+		}
+		erc20Token, errPair := getPairedToken(proof.dataGetter.GetERC20AddressForTokenId(ctx, esdtToken))
+		if errPair != nil {
+			tokenProof.Error = errPair.Error()
+		} else {
+			tokenProof.ERC20Address = common.BytesToAddress(erc20Token).Hex()
+		}
+		tokens = append(tokens, tokenProof)
+	}
+
+	return tokens, nil
+}
+
+func getPairedToken(response [][]byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 || len(response[0]) == 0 {
+		return nil, ErrUnpairedToken
+	}
+
+	return response[0], nil
+}
+
+func (proof *balanceProof) computeTokenProof(
+	ctx context.Context,
+	tokenProof *core.TokenBalanceProof,
+	inFlightToMultiversX map[string]*big.Int,
+	inFlightToEthereum map[string]*big.Int,
+) error {
+	erc20Token := common.HexToAddress(tokenProof.ERC20Address)
+	esdtToken := []byte(tokenProof.ESDTTokenID)
+
+	ethBalances, err := proof.getEthereumBalances(ctx, erc20Token)
+	if err != nil {
+		return err
+	}
+	mvxBalances, err := proof.getMultiversXBalances(ctx, esdtToken)
+	if err != nil {
+		return err
+	}
+
+	inFlightToMvx := getAmount(inFlightToMultiversX, erc20Token.Bytes())
+	inFlightToEth := getAmount(inFlightToEthereum, esdtToken)
+	ethCirculating := ethBalances.circulating(inFlightToMvx)
+	mvxCirculating := mvxBalances.circulating(inFlightToEth)
+
+	tokenProof.EthereumNative = ethBalances.isNative
+	tokenProof.EthereumMintBurn = ethBalances.isMintBurn
+	tokenProof.EthereumLocked = ethBalances.total.String()
+	tokenProof.EthereumMinted = ethBalances.minted.String()
+	tokenProof.EthereumBurned = ethBalances.burned.String()
+	tokenProof.MultiversXNative = mvxBalances.isNative
+	tokenProof.MultiversXMintBurn = mvxBalances.isMintBurn
+	tokenProof.MultiversXLocked = mvxBalances.total.String()
+	tokenProof.MultiversXMinted = mvxBalances.minted.String()
+	tokenProof.MultiversXBurned = mvxBalances.burned.String()
+	tokenProof.InFlightToMultiversX = inFlightToMvx.String()
+	tokenProof.InFlightToEthereum = inFlightToEth.String()
+	tokenProof.EthereumCirculating = ethCirculating.String()
+	tokenProof.MultiversXCirculating = mvxCirculating.String()
+	tokenProof.InvariantDelta = big.NewInt(0).Sub(ethCirculating, mvxCirculating).String()
+
+	return nil
+}
+
+func (proof *balanceProof) getEthereumBalances(ctx context.Context, erc20Token common.Address) (*tokenBalances, error) {
+	var err error
+	balances := &tokenBalances{}
+	balances.isNative, err = proof.ethereumClient.NativeTokens(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	balances.isMintBurn, err = proof.ethereumClient.MintBurnTokens(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	balances.total, err = proof.ethereumClient.TotalBalances(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	balances.minted, err = proof.ethereumClient.MintBalances(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	balances.burned, err = proof.ethereumClient.BurnBalances(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+func (proof *balanceProof) getMultiversXBalances(ctx context.Context, esdtToken []byte) (*tokenBalances, error) {
+	var err error
+	balances := &tokenBalances{}
+	balances.isNative, err = proof.multiversXClient.IsNativeToken(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	balances.isMintBurn, err = proof.multiversXClient.IsMintBurnToken(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	balances.total, err = proof.multiversXClient.TotalBalances(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	balances.minted, err = proof.multiversXClient.MintBalances(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	balances.burned, err = proof.multiversXClient.BurnBalances(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// getInFlightToMultiversX returns, for each ERC20 token, the amount of the Ethereum batches not yet executed on MultiversX
+func (proof *balanceProof) getInFlightToMultiversX(ctx context.Context) (map[string]*big.Int, error) {
+	batchID, err := proof.multiversXClient.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make(map[string]*big.Int)
+	for {
+		batch, _, errGetBatch := proof.ethereumClient.GetBatch(ctx, batchID+1) // all the batches, regardless if they are final or not
+		if errGetBatch != nil {
+			return nil, errGetBatch
+		}
+
+		isBatchInvalid := batch.ID != batchID+1 || len(batch.Deposits) == 0
+		if isBatchInvalid {
+			return amounts, nil
+		}
+
+		addDepositsAmounts(amounts, batch)
+		batchID++
+	}
+}
+
+// getInFlightToEthereum returns, for each ESDT token, the amount of the MultiversX batches not yet executed on Ethereum
+func (proof *balanceProof) getInFlightToEthereum(ctx context.Context) (map[string]*big.Int, error) {
+	batchID, err := proof.multiversXClient.GetLastMvxBatchID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make(map[string]*big.Int)
+	for ; batchID > 0; batchID-- {
+		batch, errGetBatch := proof.multiversXClient.GetBatch(ctx, batchID)
+		if errors.Is(errGetBatch, clients.ErrNoBatchAvailable) {
+			return amounts, nil
+		}
+		if errGetBatch != nil {
+			return nil, errGetBatch
+		}
+
+		wasExecuted, errWasExecuted := proof.ethereumClient.WasExecuted(ctx, batch.ID)
+		if errWasExecuted != nil {
+			return nil, errWasExecuted
+		}
+		if wasExecuted {
+			return amounts, nil
+		}
+
+		addDepositsAmounts(amounts, batch)
+	}
+
+	return amounts, nil
+}
+
+func addDepositsAmounts(amounts map[string]*big.Int, batch *core.TransferBatch) {
+	for _, deposit := range batch.Deposits {
+		amount, found := amounts[string(deposit.SourceTokenBytes)]
+		if !found {
+			amount = big.NewInt(0)
+			amounts[string(deposit.SourceTokenBytes)] = amount
+		}
+		amount.Add(amount, deposit.Amount)
+	}
+}
+
+func getAmount(amounts map[string]*big.Int, token []byte) *big.Int {
+	amount, found := amounts[string(token)]
+	if !found {
+		return big.NewInt(0)
+	}
+
+	return amount
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (proof *balanceProof) IsInterfaceNil() bool {
+	return proof == nil
+}
@@ -0,0 +1,415 @@
+package balanceProof
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ethBlockNumber  = uint64(18000000)
+	mvxNonce        = uint64(22000000)
+	esdtToken       = "WETH-abcdef"
+	erc20TokenHex   = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	lastEthBatchID  = uint64(5)
+	lastMvxBatchID  = uint64(3)
+	lockedOnEth     = int64(1000)
+	inFlightToMvx   = int64(100)
+	mintedOnMvx     = int64(950)
+	burnedOnMvx     = int64(100)
+	inFlightToEth   = int64(50)
+	otherTokenValue = int64(7)
+)
+
+var erc20Token = common.HexToAddress(erc20TokenHex)
+
+// createMockArgsBalanceProof creates the arguments of a bridge where the ERC20 token is locked on Ethereum and the ESDT
+// token is minted and burned on MultiversX, with one pending batch in each direction and fully backed reserves
+func createMockArgsBalanceProof() ArgsBalanceProof {
+	return ArgsBalanceProof{
+		Log: &testsCommon.LoggerStub{},
+		EthereumClient: &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error) {
+				if nonce != lastEthBatchID+1 {
+					return &core.TransferBatch{}, false, nil
+				}
+
+				return &core.TransferBatch{
+					ID: nonce,
+					Deposits: []*core.DepositTransfer{
+						{SourceTokenBytes: erc20Token.Bytes(), Amount: big.NewInt(inFlightToMvx)},
+						{SourceTokenBytes: []byte("other token"), Amount: big.NewInt(otherTokenValue)},
+					},
+				}, true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return batchID < lastMvxBatchID, nil
+			},
+			NativeTokensCalled: func(ctx context.Context, token common.Address) (bool, error) {
+				return true, nil
+			},
+			MintBurnTokensCalled: func(ctx context.Context, token common.Address) (bool, error) {
+				return false, nil
+			},
+			TotalBalancesCalled: func(ctx context.Context, token common.Address) (*big.Int, error) {
+				return big.NewInt(lockedOnEth), nil
+			},
+			MintBalancesCalled: func(ctx context.Context, token common.Address) (*big.Int, error) {
+				return big.NewInt(0), nil
+			},
+			BurnBalancesCalled: func(ctx context.Context, token common.Address) (*big.Int, error) {
+				return big.NewInt(0), nil
+			},
+		},
+		EthereumChain: &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return ethBlockNumber, nil
+			},
+		},
+		MultiversXClient: &bridgeTests.MultiversXClientStub{
+			GetCurrentNonceCalled: func(ctx context.Context) (uint64, error) {
+				return mvxNonce, nil
+			},
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return lastEthBatchID, nil
+			},
+			GetLastMvxBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return lastMvxBatchID, nil
+			},
+			GetBatchCalled: func(ctx context.Context, batchID uint64) (*core.TransferBatch, error) {
+				return &core.TransferBatch{
+					ID: batchID,
+					Deposits: []*core.DepositTransfer{
+						{SourceTokenBytes: []byte(esdtToken), Amount: big.NewInt(inFlightToEth)},
+					},
+				}, nil
+			},
+			IsNativeTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return false, nil
+			},
+			IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return true, nil
+			},
+			TotalBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return big.NewInt(0), nil
+			},
+			MintBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return big.NewInt(mintedOnMvx), nil
+			},
+			BurnBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return big.NewInt(burnedOnMvx), nil
+			},
+		},
+		DataGetter: &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				if common.BytesToAddress(erc20Address) != erc20Token {
+					return make([][]byte, 0), nil
+				}
+
+				return [][]byte{[]byte(esdtToken)}, nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				if string(tokenId) != esdtToken {
+					return make([][]byte, 0), nil
+				}
+
+				return [][]byte{erc20Token.Bytes()}, nil
+			},
+			GetAllKnownTokensCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{[]byte(esdtToken)}, nil
+			},
+		},
+		ERC20Tokens:   []string{erc20TokenHex},
+		CacheDuration: time.Minute,
+		QueryTimeout:  time.Second,
+	}
+}
+
+func TestNewBalanceProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.Log = nil
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.EthereumClient = nil
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("nil Ethereum chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.EthereumChain = nil
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilEthereumChain, err)
+	})
+	t.Run("nil MultiversX client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.MultiversXClient = nil
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilMultiversXClient, err)
+	})
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.DataGetter = nil
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilMultiversXDataGetter, err)
+	})
+	t.Run("invalid query timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.QueryTimeout = 0
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.True(t, errors.Is(err, ErrInvalidQueryTimeout))
+	})
+	t.Run("invalid token address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.ERC20Tokens = append(args.ERC20Tokens, "not an address")
+
+		instance, err := NewBalanceProof(args)
+		assert.True(t, check.IfNil(instance))
+		assert.True(t, errors.Is(err, ErrInvalidTokenAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewBalanceProof(createMockArgsBalanceProof())
+		assert.False(t, check.IfNil(instance))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBalanceProof_GetBalanceProof(t *testing.T) {
+	t.Parallel()
+
+	expectedTokenProof := &core.TokenBalanceProof{
+		ERC20Address:          erc20Token.Hex(),
+		ESDTTokenID:           esdtToken,
+		EthereumNative:        true,
+		EthereumMintBurn:      false,
+		EthereumLocked:        "1000",
+		EthereumMinted:        "0",
+		EthereumBurned:        "0",
+		MultiversXNative:      false,
+		MultiversXMintBurn:    true,
+		MultiversXLocked:      "0",
+		MultiversXMinted:      "950",
+		MultiversXBurned:      "100",
+		InFlightToMultiversX:  "100",
+		InFlightToEthereum:    "50",
+		EthereumCirculating:   "900",
+		MultiversXCirculating: "900",
+		InvariantDelta:        "0",
+	}
+
+	t.Run("fully backed reserves should have a 0 delta", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewBalanceProof(createMockArgsBalanceProof())
+		instance.getTime = func() time.Time {
+			return time.Unix(1700000000, 0)
+		}
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, int64(1700000000), proof.Timestamp)
+		assert.Equal(t, ethBlockNumber, proof.EthereumBlockNumber)
+		assert.Equal(t, mvxNonce, proof.MultiversXNonce)
+		require.Equal(t, 1, len(proof.Tokens))
+		assert.Equal(t, expectedTokenProof, proof.Tokens[0])
+	})
+	t.Run("missing reserves should have a non-zero delta", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		ethClient := args.EthereumClient.(*bridgeTests.EthereumClientStub)
+		ethClient.TotalBalancesCalled = func(ctx context.Context, token common.Address) (*big.Int, error) {
+			return big.NewInt(lockedOnEth - 30), nil
+		}
+		instance, _ := NewBalanceProof(args)
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, "870", proof.Tokens[0].EthereumCirculating)
+		assert.Equal(t, "-30", proof.Tokens[0].InvariantDelta)
+	})
+	t.Run("no configured tokens should use all the known tokens", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		args.ERC20Tokens = nil
+		dataGetter := args.DataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.GetAllKnownTokensCalled = func(ctx context.Context) ([][]byte, error) {
+			return [][]byte{[]byte(esdtToken), []byte("UNKNOWN-123456")}, nil
+		}
+		instance, _ := NewBalanceProof(args)
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, 2, len(proof.Tokens))
+		assert.Equal(t, expectedTokenProof, proof.Tokens[0])
+		assert.Equal(t, &core.TokenBalanceProof{
+			ESDTTokenID: "UNKNOWN-123456",
+			Error:       ErrUnpairedToken.Error(),
+		}, proof.Tokens[1])
+	})
+	t.Run("token errors should be reported in the token proof", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		otherToken := common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c")
+		args := createMockArgsBalanceProof()
+		args.ERC20Tokens = []string{otherToken.Hex(), erc20TokenHex}
+		dataGetter := args.DataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.GetTokenIdForErc20AddressCalled = func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+			return [][]byte{erc20Address}, nil
+		}
+		mvxClient := args.MultiversXClient.(*bridgeTests.MultiversXClientStub)
+		mvxClient.MintBalancesCalled = func(ctx context.Context, token []byte) (*big.Int, error) {
+			if string(token) == string(otherToken.Bytes()) {
+				return nil, expectedErr
+			}
+
+			return big.NewInt(mintedOnMvx), nil
+		}
+		instance, _ := NewBalanceProof(args)
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, 2, len(proof.Tokens))
+		assert.Equal(t, otherToken.Hex(), proof.Tokens[0].ERC20Address)
+		assert.Equal(t, expectedErr.Error(), proof.Tokens[0].Error)
+		assert.Empty(t, proof.Tokens[0].InvariantDelta)
+		assert.Empty(t, proof.Tokens[1].Error)
+	})
+	t.Run("height query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBalanceProof()
+		args.EthereumChain = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		instance, _ := NewBalanceProof(args)
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("pending batches query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBalanceProof()
+		mvxClient := args.MultiversXClient.(*bridgeTests.MultiversXClientStub)
+		mvxClient.GetBatchCalled = func(ctx context.Context, batchID uint64) (*core.TransferBatch, error) {
+			return nil, expectedErr
+		}
+		instance, _ := NewBalanceProof(args)
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		assert.Nil(t, proof)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("no more MultiversX batches should end the in-flight amounts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceProof()
+		ethClient := args.EthereumClient.(*bridgeTests.EthereumClientStub)
+		ethClient.WasExecutedCalled = func(ctx context.Context, batchID uint64) (bool, error) {
+			return false, nil
+		}
+		mvxClient := args.MultiversXClient.(*bridgeTests.MultiversXClientStub)
+		mvxClient.GetBatchCalled = func(ctx context.Context, batchID uint64) (*core.TransferBatch, error) {
+			if batchID < 2 {
+				return nil, clients.ErrNoBatchAvailable
+			}
+
+			return &core.TransferBatch{
+				ID: batchID,
+				Deposits: []*core.DepositTransfer{
+					{SourceTokenBytes: []byte(esdtToken), Amount: big.NewInt(inFlightToEth)},
+				},
+			}, nil
+		}
+		instance, _ := NewBalanceProof(args)
+
+		proof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, "100", proof.Tokens[0].InFlightToEthereum)
+	})
+	t.Run("should serve the cached proof in the cache duration", func(t *testing.T) {
+		t.Parallel()
+
+		numHeightQueries := 0
+		args := createMockArgsBalanceProof()
+		args.EthereumChain = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				numHeightQueries++
+				return ethBlockNumber + uint64(numHeightQueries), nil
+			},
+		}
+		instance, _ := NewBalanceProof(args)
+		currentTime := time.Unix(1700000000, 0)
+		instance.getTime = func() time.Time {
+			return currentTime
+		}
+
+		firstProof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+
+		currentTime = currentTime.Add(args.CacheDuration - time.Second)
+		proof, err := instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		assert.True(t, firstProof == proof)
+		assert.Equal(t, 1, numHeightQueries)
+
+		currentTime = currentTime.Add(time.Second)
+		proof, err = instance.GetBalanceProof(context.Background())
+		require.Nil(t, err)
+		assert.False(t, firstProof == proof)
+		assert.Equal(t, ethBlockNumber+2, proof.EthereumBlockNumber)
+		assert.Equal(t, 2, numHeightQueries)
+	})
+}
@@ -0,0 +1,27 @@
+package balanceProof
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilEthereumClient signals that a nil Ethereum client has been provided
+var ErrNilEthereumClient = errors.New("nil Ethereum client")
+
+// ErrNilEthereumChain signals that a nil Ethereum chain has been provided
+var ErrNilEthereumChain = errors.New("nil Ethereum chain")
+
+// ErrNilMultiversXClient signals that a nil MultiversX client has been provided
+var ErrNilMultiversXClient = errors.New("nil MultiversX client")
+
+// ErrNilMultiversXDataGetter signals that a nil MultiversX data getter has been provided
+var ErrNilMultiversXDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrInvalidTokenAddress signals that an invalid ERC20 token address has been provided
+var ErrInvalidTokenAddress = errors.New("invalid token address")
+
+// ErrInvalidQueryTimeout signals that an invalid query timeout has been provided
+var ErrInvalidQueryTimeout = errors.New("invalid query timeout")
+
+// ErrUnpairedToken signals that the token is not paired with a token on the other chain
+var ErrUnpairedToken = errors.New("unpaired token")
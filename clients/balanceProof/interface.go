@@ -0,0 +1,49 @@
+package balanceProof
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// EthereumClient defines the Ethereum client operations used to compute the balance proof
+type EthereumClient interface {
+	GetBatch(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error)
+	WasExecuted(ctx context.Context, mvxBatchID uint64) (bool, error)
+	TotalBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	MintBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	BurnBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	MintBurnTokens(ctx context.Context, token common.Address) (bool, error)
+	NativeTokens(ctx context.Context, token common.Address) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// EthereumChain defines the component able to return the current Ethereum block number
+type EthereumChain interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXClient defines the MultiversX client operations used to compute the balance proof
+type MultiversXClient interface {
+	GetBatch(ctx context.Context, batchID uint64) (*core.TransferBatch, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetLastMvxBatchID(ctx context.Context) (uint64, error)
+	GetCurrentNonce(ctx context.Context) (uint64, error)
+	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
+	IsNativeToken(ctx context.Context, token []byte) (bool, error)
+	TotalBalances(ctx context.Context, token []byte) (*big.Int, error)
+	MintBalances(ctx context.Context, token []byte) (*big.Int, error)
+	BurnBalances(ctx context.Context, token []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXDataGetter defines the MultiversX queries used to list the bridged tokens and pair them
+type MultiversXDataGetter interface {
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
+	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,28 @@
+package balanceProof
+
+import "math/big"
+
+type tokenBalances struct {
+	isNative   bool
+	isMintBurn bool
+	total      *big.Int
+	minted     *big.Int
+	burned     *big.Int
+}
+
+// circulating returns the amount the bridge is accountable for on the chain, computed the same way the balance
+// validator does: the locked balance for the lock/unlock tokens or the difference between the minted and the burned
+// balances for the mint/burn tokens. The in-flight amount was already locked or burned when the deposits were
+// registered in the contract, so it is canceled out
+func (balances *tokenBalances) circulating(inFlight *big.Int) *big.Int {
+	if !balances.isMintBurn {
+		return big.NewInt(0).Sub(balances.total, inFlight)
+	}
+
+	burned := big.NewInt(0).Sub(balances.burned, inFlight)
+	if balances.isNative {
+		return burned.Sub(burned, balances.minted)
+	}
+
+	return big.NewInt(0).Sub(balances.minted, burned)
+}
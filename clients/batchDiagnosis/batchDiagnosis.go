@@ -0,0 +1,470 @@
+package batchDiagnosis
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	pauseCheck       = "pause"
+	progressCheck    = "progress"
+	batchCheck       = "batch"
+	quorumCheck      = "quorum"
+	signaturesCheck  = "signatures"
+	safeBalanceCheck = "safe balance"
+	gasCheck         = "relayer gas"
+
+	ethereumChainName   = "Ethereum"
+	multiversXChainName = "MultiversX"
+
+	numFieldsForDeposit = 6
+	tokenFieldIndex     = 4
+	amountFieldIndex    = 5
+)
+
+// Severity ranks how likely a finding blocks the batch
+type Severity int
+
+const (
+	// SeverityInfo marks a precondition that holds
+	SeverityInfo Severity = iota
+	// SeverityWarning marks a precondition that could not be checked or that can delay the batch
+	SeverityWarning
+	// SeverityBlocker marks a precondition that stops the batch
+	SeverityBlocker
+)
+
+// String returns the readable form of the severity
+func (severity Severity) String() string {
+	switch severity {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityBlocker:
+		return "blocker"
+	default:
+		return fmt.Sprintf("unknown severity %d", int(severity))
+	}
+}
+
+// Finding is the outcome of one precondition check
+type Finding struct {
+	Severity Severity
+	Check    string
+	Message  string
+}
+
+// ArgsBatchDiagnosis is the DTO used to create a new batch diagnosis
+type ArgsBatchDiagnosis struct {
+	EthereumChain            EthereumChain
+	MultiversXChain          MultiversXChain
+	EthereumRelayerBalance   BalanceGetter
+	MultiversXRelayerBalance BalanceGetter
+	EthereumMinimumBalance   *big.Int
+	MultiversXMinimumBalance *big.Int
+	AddressConverter         core.AddressConverter
+}
+
+type batchDiagnosis struct {
+	ethereumChain            EthereumChain
+	multiversXChain          MultiversXChain
+	ethereumRelayerBalance   BalanceGetter
+	multiversXRelayerBalance BalanceGetter
+	ethereumMinimumBalance   *big.Int
+	multiversXMinimumBalance *big.Int
+	addressConverter         core.AddressConverter
+}
+
+type tokenAmount struct {
+	token  []byte
+	amount *big.Int
+}
+
+// NewBatchDiagnosis creates a component that walks, using only read-only queries, the preconditions of a stuck batch
+func NewBatchDiagnosis(args ArgsBatchDiagnosis) (*batchDiagnosis, error) {
+	if check.IfNil(args.EthereumChain) {
+		return nil, ErrNilEthereumChain
+	}
+	if check.IfNil(args.MultiversXChain) {
+		return nil, ErrNilMultiversXChain
+	}
+	if check.IfNil(args.EthereumRelayerBalance) {
+		return nil, fmt.Errorf("%w for the Ethereum relayer", ErrNilBalanceGetter)
+	}
+	if check.IfNil(args.MultiversXRelayerBalance) {
+		return nil, fmt.Errorf("%w for the MultiversX relayer", ErrNilBalanceGetter)
+	}
+	if args.EthereumMinimumBalance == nil {
+		return nil, fmt.Errorf("%w for the Ethereum relayer", ErrNilMinimumBalance)
+	}
+	if args.MultiversXMinimumBalance == nil {
+		return nil, fmt.Errorf("%w for the MultiversX relayer", ErrNilMinimumBalance)
+	}
+	if check.IfNil(args.AddressConverter) {
+		return nil, ErrNilAddressConverter
+	}
+
+	return &batchDiagnosis{
+		ethereumChain:            args.EthereumChain,
+		multiversXChain:          args.MultiversXChain,
+		ethereumRelayerBalance:   args.EthereumRelayerBalance,
+		multiversXRelayerBalance: args.MultiversXRelayerBalance,
+		ethereumMinimumBalance:   args.EthereumMinimumBalance,
+		multiversXMinimumBalance: args.MultiversXMinimumBalance,
+		addressConverter:         args.AddressConverter,
+	}, nil
+}
+
+// Diagnose checks the preconditions of the batch in the provided direction and returns the findings ranked from the
+// probable blockers to the preconditions that hold. The query errors are recorded as findings. The action ID, logged
+// by the relayers when proposing the transfer on MultiversX, is optional and enables the signatures check
+func (diagnosis *batchDiagnosis) Diagnose(ctx context.Context, direction batchProcessor.Direction, batchID uint64, actionID uint64) ([]*Finding, error) {
+	if batchID == 0 {
+		return nil, ErrInvalidBatchID
+	}
+
+	var findings []*Finding
+	switch direction {
+	case batchProcessor.ToMultiversX:
+		findings = diagnosis.diagnoseToMultiversX(ctx, batchID, actionID)
+	case batchProcessor.FromMultiversX:
+		findings = diagnosis.diagnoseFromMultiversX(ctx, batchID)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownDirection, direction)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Severity > findings[j].Severity
+	})
+
+	return findings, nil
+}
+
+func (diagnosis *batchDiagnosis) diagnoseToMultiversX(ctx context.Context, batchID uint64, actionID uint64) []*Finding {
+	findings := checkPause(ctx, multiversXChainName, diagnosis.multiversXChain.IsPaused)
+
+	lastExecutedBatchID, err := diagnosis.multiversXChain.GetLastExecutedEthBatchID(ctx)
+	switch {
+	case err != nil:
+		findings = append(findings, newQueryErrorFinding(progressCheck, "getLastExecutedEthBatchId on MultiversX", err))
+	case lastExecutedBatchID >= batchID:
+		return append(findings, newFinding(SeverityInfo, progressCheck,
+			"the batch was executed on MultiversX, the last executed Ethereum batch is %d", lastExecutedBatchID))
+	case lastExecutedBatchID+1 < batchID:
+		findings = append(findings, newFinding(SeverityBlocker, progressCheck,
+			"the batches are executed in order and batch %d was not executed on MultiversX yet", lastExecutedBatchID+1))
+	default:
+		findings = append(findings, newFinding(SeverityInfo, progressCheck,
+			"the batch is the next one to be executed on MultiversX"))
+	}
+
+	findings = append(findings, diagnosis.checkEthereumBatch(ctx, batchID))
+	findings = append(findings, diagnosis.checkMultiversXQuorum(ctx, actionID)...)
+	findings = append(findings, checkRelayerBalance(ctx, multiversXChainName, diagnosis.multiversXRelayerBalance, diagnosis.multiversXMinimumBalance))
+
+	return findings
+}
+
+func (diagnosis *batchDiagnosis) diagnoseFromMultiversX(ctx context.Context, batchID uint64) []*Finding {
+	findings := checkPause(ctx, ethereumChainName, diagnosis.ethereumChain.IsPaused)
+
+	wasExecuted, err := diagnosis.ethereumChain.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(batchID))
+	if err != nil {
+		findings = append(findings, newQueryErrorFinding(progressCheck, "wasBatchExecuted on Ethereum", err))
+	}
+	if wasExecuted {
+		return append(findings, diagnosis.checkSetStatus(ctx, batchID))
+	}
+
+	batchFindings, amounts := diagnosis.checkMultiversXBatch(ctx, batchID)
+	findings = append(findings, batchFindings...)
+	findings = append(findings, diagnosis.checkEthereumQuorum(ctx))
+	findings = append(findings, diagnosis.checkSafeBalances(ctx, amounts)...)
+	findings = append(findings, checkRelayerBalance(ctx, ethereumChainName, diagnosis.ethereumRelayerBalance, diagnosis.ethereumMinimumBalance))
+
+	return findings
+}
+
+func checkPause(ctx context.Context, chainName string, isPaused func(ctx context.Context) (bool, error)) []*Finding {
+	paused, err := isPaused(ctx)
+	if err != nil {
+		return []*Finding{newQueryErrorFinding(pauseCheck, "paused on "+chainName, err)}
+	}
+	if paused {
+		return []*Finding{newFinding(SeverityBlocker, pauseCheck, "the %s multisig contract is paused", chainName)}
+	}
+
+	return []*Finding{newFinding(SeverityInfo, pauseCheck, "the %s multisig contract is not paused", chainName)}
+}
+
+func (diagnosis *batchDiagnosis) checkEthereumBatch(ctx context.Context, batchID uint64) *Finding {
+	batch, isFinal, err := diagnosis.ethereumChain.GetBatch(ctx, big.NewInt(0).SetUint64(batchID))
+	if err != nil {
+		return newQueryErrorFinding(batchCheck, "getBatch on Ethereum", err)
+	}
+	if batch.DepositsCount == 0 {
+		return newFinding(SeverityBlocker, batchCheck, "the batch does not exist on Ethereum or it has no deposits")
+	}
+	if !isFinal {
+		return newFinding(SeverityBlocker, batchCheck,
+			"the batch with %d deposit(s) is not final on Ethereum, it was last updated at block %d",
+			batch.DepositsCount, batch.LastUpdatedBlockNumber)
+	}
+
+	return newFinding(SeverityInfo, batchCheck, "the batch has %d deposit(s) and it is final on Ethereum", batch.DepositsCount)
+}
+
+func (diagnosis *batchDiagnosis) checkMultiversXQuorum(ctx context.Context, actionID uint64) []*Finding {
+	quorum, err := diagnosis.multiversXChain.GetQuorum(ctx)
+	if err != nil {
+		return []*Finding{newQueryErrorFinding(quorumCheck, "getQuorum on MultiversX", err)}
+	}
+	relayers, err := diagnosis.multiversXChain.GetAllStakedRelayers(ctx)
+	if err != nil {
+		return []*Finding{newQueryErrorFinding(quorumCheck, "getAllStakedRelayers on MultiversX", err)}
+	}
+
+	findings := []*Finding{checkQuorum(multiversXChainName, quorum, len(relayers))}
+	if actionID == 0 {
+		return append(findings, newFinding(SeverityInfo, signaturesCheck,
+			"the proposal and its signatures were not checked, provide the action ID logged by the relayers"))
+	}
+
+	return append(findings, diagnosis.checkSignatures(ctx, actionID, quorum, relayers))
+}
+
+func (diagnosis *batchDiagnosis) checkSignatures(ctx context.Context, actionID uint64, quorum uint64, relayers [][]byte) *Finding {
+	wasExecuted, err := diagnosis.multiversXChain.WasExecuted(ctx, actionID)
+	if err != nil {
+		return newQueryErrorFinding(signaturesCheck, "wasActionExecuted on MultiversX", err)
+	}
+	if wasExecuted {
+		return newFinding(SeverityInfo, signaturesCheck, "the action %d was executed on MultiversX", actionID)
+	}
+
+	signers := make([]string, 0, len(relayers))
+	missingSigners := make([]string, 0, len(relayers))
+	for _, relayer := range relayers {
+		wasSigned, errSigned := diagnosis.multiversXChain.WasSignedBy(ctx, actionID, relayer)
+		if errSigned != nil {
+			return newQueryErrorFinding(signaturesCheck, "signed on MultiversX", errSigned)
+		}
+
+		address := diagnosis.addressConverter.ToBech32StringSilent(relayer)
+		if wasSigned {
+			signers = append(signers, address)
+		} else {
+			missingSigners = append(missingSigners, address)
+		}
+	}
+
+	if len(signers) == 0 {
+		return newFinding(SeverityBlocker, signaturesCheck,
+			"the action %d has no signatures, either the transfer was not proposed or the action ID is wrong", actionID)
+	}
+	if uint64(len(signers)) < quorum {
+		return newFinding(SeverityBlocker, signaturesCheck,
+			"the action %d has %d of the %d required signatures, missing signatures from: %s",
+			actionID, len(signers), quorum, strings.Join(missingSigners, ", "))
+	}
+
+	return newFinding(SeverityWarning, signaturesCheck,
+		"the action %d reached the quorum but it was not performed yet, signed by: %s",
+		actionID, strings.Join(signers, ", "))
+}
+
+func (diagnosis *batchDiagnosis) checkSetStatus(ctx context.Context, batchID uint64) *Finding {
+	response, err := diagnosis.multiversXChain.GetCurrentBatchAsDataBytes(ctx)
+	if err != nil {
+		return newQueryErrorFinding(progressCheck, "getCurrentTxBatch on MultiversX", err)
+	}
+	if !isEmptyResponse(response) && big.NewInt(0).SetBytes(response[0]).Uint64() == batchID {
+		return newFinding(SeverityWarning, progressCheck,
+			"the batch was executed on Ethereum but its statuses were not set on MultiversX yet")
+	}
+
+	return newFinding(SeverityInfo, progressCheck, "the batch was executed on Ethereum and its statuses were set on MultiversX")
+}
+
+func (diagnosis *batchDiagnosis) checkMultiversXBatch(ctx context.Context, batchID uint64) ([]*Finding, []*tokenAmount) {
+	response, err := diagnosis.multiversXChain.GetBatchAsDataBytes(ctx, batchID)
+	if err != nil {
+		return []*Finding{newQueryErrorFinding(batchCheck, "getBatch on MultiversX", err)}, nil
+	}
+	if isEmptyResponse(response) {
+		return []*Finding{newFinding(SeverityBlocker, batchCheck, "the batch does not exist on MultiversX")}, nil
+	}
+
+	findings := make([]*Finding, 0, 2)
+	amounts, numDeposits, err := parseBatchAmounts(response)
+	if err != nil {
+		findings = append(findings, newFinding(SeverityWarning, batchCheck, "the batch could not be parsed: %v", err))
+	} else {
+		findings = append(findings, newFinding(SeverityInfo, batchCheck, "the batch has %d deposit(s) on MultiversX", numDeposits))
+	}
+
+	return append(findings, diagnosis.checkPendingBatch(ctx, batchID)), amounts
+}
+
+func (diagnosis *batchDiagnosis) checkPendingBatch(ctx context.Context, batchID uint64) *Finding {
+	response, err := diagnosis.multiversXChain.GetCurrentBatchAsDataBytes(ctx)
+	if err != nil {
+		return newQueryErrorFinding(progressCheck, "getCurrentTxBatch on MultiversX", err)
+	}
+	if isEmptyResponse(response) {
+		return newFinding(SeverityWarning, progressCheck,
+			"no batch is pending on MultiversX, the batch is either not final yet or it was resolved without being executed on Ethereum")
+	}
+
+	pendingBatchID := big.NewInt(0).SetBytes(response[0]).Uint64()
+	switch {
+	case pendingBatchID < batchID:
+		return newFinding(SeverityBlocker, progressCheck,
+			"the batches are executed in order and batch %d is pending on MultiversX", pendingBatchID)
+	case pendingBatchID > batchID:
+		return newFinding(SeverityWarning, progressCheck,
+			"the batch was resolved on MultiversX without being executed on Ethereum, batch %d is pending", pendingBatchID)
+	default:
+		return newFinding(SeverityInfo, progressCheck, "the batch is the pending one on MultiversX")
+	}
+}
+
+func (diagnosis *batchDiagnosis) checkEthereumQuorum(ctx context.Context) *Finding {
+	quorum, err := diagnosis.ethereumChain.Quorum(ctx)
+	if err != nil {
+		return newQueryErrorFinding(quorumCheck, "quorum on Ethereum", err)
+	}
+	relayers, err := diagnosis.ethereumChain.GetRelayers(ctx)
+	if err != nil {
+		return newQueryErrorFinding(quorumCheck, "getRelayers on Ethereum", err)
+	}
+
+	return checkQuorum(ethereumChainName, quorum.Uint64(), len(relayers))
+}
+
+func (diagnosis *batchDiagnosis) checkSafeBalances(ctx context.Context, amounts []*tokenAmount) []*Finding {
+	findings := make([]*Finding, 0, len(amounts))
+	for _, amount := range amounts {
+		findings = append(findings, diagnosis.checkSafeBalance(ctx, amount))
+	}
+
+	return findings
+}
+
+func (diagnosis *batchDiagnosis) checkSafeBalance(ctx context.Context, amount *tokenAmount) *Finding {
+	response, err := diagnosis.multiversXChain.GetERC20AddressForTokenId(ctx, amount.token)
+	if err != nil {
+		return newQueryErrorFinding(safeBalanceCheck, "getErc20AddressForTokenId on MultiversX", err)
+	}
+	if isEmptyResponse(response) {
+		return newFinding(SeverityBlocker, safeBalanceCheck, "the token %s has no ERC20 address on MultiversX", amount.token)
+	}
+
+	erc20Address := common.BytesToAddress(response[0])
+	isMintBurn, err := diagnosis.ethereumChain.MintBurnTokens(ctx, erc20Address)
+	if err != nil {
+		return newQueryErrorFinding(safeBalanceCheck, "mintBurnTokens on Ethereum", err)
+	}
+	if isMintBurn {
+		return newFinding(SeverityInfo, safeBalanceCheck, "the token %s (%s) is minted on Ethereum", amount.token, erc20Address.Hex())
+	}
+
+	totalBalance, err := diagnosis.ethereumChain.TotalBalances(ctx, erc20Address)
+	if err != nil {
+		return newQueryErrorFinding(safeBalanceCheck, "totalBalances on Ethereum", err)
+	}
+	if totalBalance.Cmp(amount.amount) < 0 {
+		return newFinding(SeverityBlocker, safeBalanceCheck,
+			"the Ethereum safe holds %s of the token %s (%s) while the batch transfers %s",
+			totalBalance.String(), amount.token, erc20Address.Hex(), amount.amount.String())
+	}
+
+	return newFinding(SeverityInfo, safeBalanceCheck, "the Ethereum safe holds enough of the token %s (%s)", amount.token, erc20Address.Hex())
+}
+
+func checkQuorum(chainName string, quorum uint64, numRelayers int) *Finding {
+	if quorum == 0 {
+		return newFinding(SeverityBlocker, quorumCheck, "the %s quorum is 0", chainName)
+	}
+	if quorum > uint64(numRelayers) {
+		return newFinding(SeverityBlocker, quorumCheck, "the %s quorum of %d exceeds the %d relayers", chainName, quorum, numRelayers)
+	}
+
+	return newFinding(SeverityInfo, quorumCheck, "the %s quorum is %d of %d relayers", chainName, quorum, numRelayers)
+}
+
+func checkRelayerBalance(ctx context.Context, chainName string, balanceGetter BalanceGetter, minimumBalance *big.Int) *Finding {
+	balance, err := balanceGetter.GetBalance(ctx)
+	if err != nil {
+		return newQueryErrorFinding(gasCheck, "balance of the "+chainName+" relayer", err)
+	}
+	if balance.Sign() == 0 {
+		return newFinding(SeverityBlocker, gasCheck, "the %s relayer account has no balance to pay for gas", chainName)
+	}
+	if balance.Cmp(minimumBalance) < 0 {
+		return newFinding(SeverityWarning, gasCheck, "the %s relayer balance of %s is below the minimum of %s",
+			chainName, balance.String(), minimumBalance.String())
+	}
+
+	return newFinding(SeverityInfo, gasCheck, "the %s relayer balance is %s", chainName, balance.String())
+}
+
+// parseBatchAmounts returns the amounts transferred by the MultiversX batch, summed for each token, and the number of deposits
+func parseBatchAmounts(response [][]byte) ([]*tokenAmount, int, error) {
+	if (len(response)-1)%numFieldsForDeposit != 0 {
+		return nil, 0, fmt.Errorf("unexpected number of arguments: %d", len(response))
+	}
+
+	amounts := make([]*tokenAmount, 0)
+	indexes := make(map[string]int)
+	numDeposits := 0
+	for i := 1; i < len(response); i += numFieldsForDeposit {
+		token := response[i+tokenFieldIndex]
+		amount := big.NewInt(0).SetBytes(response[i+amountFieldIndex])
+		numDeposits++
+
+		index, found := indexes[string(token)]
+		if found {
+			amounts[index].amount.Add(amounts[index].amount, amount)
+			continue
+		}
+
+		indexes[string(token)] = len(amounts)
+		amounts = append(amounts, &tokenAmount{
+			token:  token,
+			amount: amount,
+		})
+	}
+
+	return amounts, numDeposits, nil
+}
+
+func isEmptyResponse(response [][]byte) bool {
+	return len(response) == 0 || (len(response) == 1 && len(response[0]) == 0)
+}
+
+func newFinding(severity Severity, checkName string, format string, args ...interface{}) *Finding {
+	return &Finding{
+		Severity: severity,
+		Check:    checkName,
+		Message:  fmt.Sprintf(format, args...),
+	}
+}
+
+func newQueryErrorFinding(checkName string, query string, err error) *Finding {
+	return newFinding(SeverityWarning, checkName, "could not query %s: %v", query, err)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (diagnosis *batchDiagnosis) IsInterfaceNil() bool {
+	return diagnosis == nil
+}
@@ -0,0 +1,420 @@
+package batchDiagnosis
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-bridge-eth-go/core/converters"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	relayer1   = bytes.Repeat([]byte{1}, 32)
+	relayer2   = bytes.Repeat([]byte{2}, 32)
+	relayer3   = bytes.Repeat([]byte{3}, 32)
+	erc20Token = common.HexToAddress("0x3E3b5A43a6A0E3b4e6E3b6B8B7E8c5a1F4d3c2b1")
+	errQuery   = errors.New("query error")
+)
+
+type balanceGetterStub struct {
+	GetBalanceCalled func(ctx context.Context) (*big.Int, error)
+}
+
+func (stub *balanceGetterStub) GetBalance(ctx context.Context) (*big.Int, error) {
+	return stub.GetBalanceCalled(ctx)
+}
+
+func (stub *balanceGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createBalanceGetter(balance int64) *balanceGetterStub {
+	return &balanceGetterStub{
+		GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(balance), nil
+		},
+	}
+}
+
+func createMultiversXBatchResponse(batchID uint64, tokens []string, amounts []int64) [][]byte {
+	response := [][]byte{big.NewInt(0).SetUint64(batchID).Bytes()}
+	for i := range tokens {
+		response = append(response,
+			[]byte{1},
+			big.NewInt(int64(i+1)).Bytes(),
+			relayer1,
+			erc20Token.Bytes(),
+			[]byte(tokens[i]),
+			big.NewInt(amounts[i]).Bytes(),
+		)
+	}
+
+	return response
+}
+
+func createMockArgs() ArgsBatchDiagnosis {
+	addressConverter, _ := converters.NewAddressConverter()
+
+	return ArgsBatchDiagnosis{
+		EthereumChain: &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error) {
+				return contract.Batch{Nonce: batchNonce, DepositsCount: 2}, true, nil
+			},
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+				return false, nil
+			},
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return make([]common.Address, 3), nil
+			},
+			TotalBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(1000), nil
+			},
+		},
+		MultiversXChain: &bridgeTests.DataGetterStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 6, nil
+			},
+			GetQuorumCalled: func(ctx context.Context) (uint64, error) {
+				return 2, nil
+			},
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{relayer1, relayer2, relayer3}, nil
+			},
+			GetBatchAsDataBytesCalled: func(ctx context.Context, batchID uint64) ([][]byte, error) {
+				return createMultiversXBatchResponse(batchID, []string{"USDC-abcdef", "USDC-abcdef"}, []int64{300, 400}), nil
+			},
+			GetCurrentBatchAsDataBytesCalled: func(ctx context.Context) ([][]byte, error) {
+				return createMultiversXBatchResponse(7, []string{"USDC-abcdef"}, []int64{300}), nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				return [][]byte{erc20Token.Bytes()}, nil
+			},
+		},
+		EthereumRelayerBalance:   createBalanceGetter(100),
+		MultiversXRelayerBalance: createBalanceGetter(100),
+		EthereumMinimumBalance:   big.NewInt(50),
+		MultiversXMinimumBalance: big.NewInt(50),
+		AddressConverter:         addressConverter,
+	}
+}
+
+func findingsOfSeverity(findings []*Finding, severity Severity) []*Finding {
+	result := make([]*Finding, 0)
+	for _, finding := range findings {
+		if finding.Severity == severity {
+			result = append(result, finding)
+		}
+	}
+
+	return result
+}
+
+func findFinding(findings []*Finding, checkName string) *Finding {
+	for _, finding := range findings {
+		if finding.Check == checkName {
+			return finding
+		}
+	}
+
+	return nil
+}
+
+func TestNewBatchDiagnosis(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil Ethereum chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.EthereumChain = nil
+		diagnosis, err := NewBatchDiagnosis(args)
+		assert.Equal(t, ErrNilEthereumChain, err)
+		assert.True(t, check.IfNil(diagnosis))
+	})
+	t.Run("nil MultiversX chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MultiversXChain = nil
+		diagnosis, err := NewBatchDiagnosis(args)
+		assert.Equal(t, ErrNilMultiversXChain, err)
+		assert.True(t, check.IfNil(diagnosis))
+	})
+	t.Run("nil balance getters should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.EthereumRelayerBalance = nil
+		diagnosis, err := NewBatchDiagnosis(args)
+		assert.True(t, errors.Is(err, ErrNilBalanceGetter))
+		assert.True(t, check.IfNil(diagnosis))
+
+		args = createMockArgs()
+		args.MultiversXRelayerBalance = nil
+		diagnosis, err = NewBatchDiagnosis(args)
+		assert.True(t, errors.Is(err, ErrNilBalanceGetter))
+		assert.True(t, check.IfNil(diagnosis))
+	})
+	t.Run("nil minimum balances should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.EthereumMinimumBalance = nil
+		diagnosis, err := NewBatchDiagnosis(args)
+		assert.True(t, errors.Is(err, ErrNilMinimumBalance))
+		assert.True(t, check.IfNil(diagnosis))
+
+		args = createMockArgs()
+		args.MultiversXMinimumBalance = nil
+		diagnosis, err = NewBatchDiagnosis(args)
+		assert.True(t, errors.Is(err, ErrNilMinimumBalance))
+		assert.True(t, check.IfNil(diagnosis))
+	})
+	t.Run("nil address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.AddressConverter = nil
+		diagnosis, err := NewBatchDiagnosis(args)
+		assert.Equal(t, ErrNilAddressConverter, err)
+		assert.True(t, check.IfNil(diagnosis))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		diagnosis, err := NewBatchDiagnosis(createMockArgs())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(diagnosis))
+	})
+}
+
+func TestBatchDiagnosis_Diagnose(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		diagnosis, _ := NewBatchDiagnosis(createMockArgs())
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 0, 0)
+		assert.Equal(t, ErrInvalidBatchID, err)
+		assert.Nil(t, findings)
+
+		findings, err = diagnosis.Diagnose(context.Background(), "unknown", 7, 0)
+		assert.True(t, errors.Is(err, ErrUnknownDirection))
+		assert.Nil(t, findings)
+	})
+	t.Run("to MultiversX: healthy preconditions should not report blockers", func(t *testing.T) {
+		t.Parallel()
+
+		diagnosis, _ := NewBatchDiagnosis(createMockArgs())
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 7, 0)
+		require.Nil(t, err)
+
+		assert.Empty(t, findingsOfSeverity(findings, SeverityBlocker))
+		assert.Empty(t, findingsOfSeverity(findings, SeverityWarning))
+		assert.Len(t, findings, 6)
+		assert.NotNil(t, findFinding(findings, signaturesCheck))
+	})
+	t.Run("to MultiversX: executed batch should stop the checks", func(t *testing.T) {
+		t.Parallel()
+
+		diagnosis, _ := NewBatchDiagnosis(createMockArgs())
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 6, 0)
+		require.Nil(t, err)
+
+		assert.Len(t, findings, 2)
+		assert.Equal(t, SeverityInfo, findFinding(findings, progressCheck).Severity)
+	})
+	t.Run("to MultiversX: blockers should be ranked first", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.MultiversXRelayerBalance = createBalanceGetter(0)
+		ethereumChain := args.EthereumChain.(*bridgeTests.EthereumClientWrapperStub)
+		ethereumChain.GetBatchCalled = func(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error) {
+			return contract.Batch{}, false, errQuery
+		}
+		multiversXChain := args.MultiversXChain.(*bridgeTests.DataGetterStub)
+		multiversXChain.IsPausedCalled = func(ctx context.Context) (bool, error) {
+			return true, nil
+		}
+		multiversXChain.GetQuorumCalled = func(ctx context.Context) (uint64, error) {
+			return 4, nil
+		}
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 9, 0)
+		require.Nil(t, err)
+
+		expectedChecks := []string{pauseCheck, progressCheck, quorumCheck, gasCheck, batchCheck, signaturesCheck}
+		checks := make([]string, 0, len(findings))
+		for _, finding := range findings {
+			checks = append(checks, finding.Check)
+		}
+		assert.Equal(t, expectedChecks, checks)
+		assert.Len(t, findingsOfSeverity(findings, SeverityBlocker), 4)
+		assert.True(t, strings.Contains(findings[1].Message, "batch 7 was not executed"))
+		assert.True(t, strings.Contains(findings[4].Message, errQuery.Error()))
+	})
+	t.Run("to MultiversX: not final batch should be a blocker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		ethereumChain := args.EthereumChain.(*bridgeTests.EthereumClientWrapperStub)
+		ethereumChain.GetBatchCalled = func(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error) {
+			return contract.Batch{Nonce: batchNonce, DepositsCount: 2}, false, nil
+		}
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 7, 0)
+		require.Nil(t, err)
+
+		assert.Equal(t, batchCheck, findings[0].Check)
+		assert.Equal(t, SeverityBlocker, findings[0].Severity)
+	})
+	t.Run("to MultiversX: should report the missing signatures", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		multiversXChain := args.MultiversXChain.(*bridgeTests.DataGetterStub)
+		multiversXChain.WasSignedByCalled = func(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error) {
+			assert.Equal(t, uint64(44), actionID)
+			return bytes.Equal(relayerAddress, relayer2), nil
+		}
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 7, 44)
+		require.Nil(t, err)
+
+		addressConverter, _ := converters.NewAddressConverter()
+		assert.Equal(t, signaturesCheck, findings[0].Check)
+		assert.Equal(t, SeverityBlocker, findings[0].Severity)
+		assert.True(t, strings.Contains(findings[0].Message, "1 of the 2 required signatures"))
+		assert.True(t, strings.Contains(findings[0].Message, addressConverter.ToBech32StringSilent(relayer1)))
+		assert.False(t, strings.Contains(findings[0].Message, addressConverter.ToBech32StringSilent(relayer2)))
+	})
+	t.Run("to MultiversX: reached quorum should be a warning", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		multiversXChain := args.MultiversXChain.(*bridgeTests.DataGetterStub)
+		multiversXChain.WasSignedByCalled = func(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error) {
+			return !bytes.Equal(relayerAddress, relayer3), nil
+		}
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.ToMultiversX, 7, 44)
+		require.Nil(t, err)
+
+		assert.Equal(t, signaturesCheck, findings[0].Check)
+		assert.Equal(t, SeverityWarning, findings[0].Severity)
+	})
+	t.Run("from MultiversX: healthy preconditions should not report blockers", func(t *testing.T) {
+		t.Parallel()
+
+		diagnosis, _ := NewBatchDiagnosis(createMockArgs())
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.FromMultiversX, 7, 0)
+		require.Nil(t, err)
+
+		assert.Empty(t, findingsOfSeverity(findings, SeverityBlocker))
+		assert.Empty(t, findingsOfSeverity(findings, SeverityWarning))
+		assert.Len(t, findings, 6)
+		assert.True(t, strings.Contains(findFinding(findings, batchCheck).Message, "2 deposit(s)"))
+	})
+	t.Run("from MultiversX: executed batch waiting for the set status should be a warning", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		ethereumChain := args.EthereumChain.(*bridgeTests.EthereumClientWrapperStub)
+		ethereumChain.WasBatchExecutedCalled = func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+			return true, nil
+		}
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.FromMultiversX, 7, 0)
+		require.Nil(t, err)
+
+		assert.Len(t, findings, 2)
+		assert.Equal(t, progressCheck, findings[0].Check)
+		assert.Equal(t, SeverityWarning, findings[0].Severity)
+	})
+	t.Run("from MultiversX: should report the insufficient safe balance and the earlier pending batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		ethereumChain := args.EthereumChain.(*bridgeTests.EthereumClientWrapperStub)
+		ethereumChain.TotalBalancesCalled = func(ctx context.Context, account common.Address) (*big.Int, error) {
+			assert.Equal(t, erc20Token, account)
+			return big.NewInt(500), nil
+		}
+		ethereumChain.IsPausedCalled = func(ctx context.Context) (bool, error) {
+			return false, errQuery
+		}
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.FromMultiversX, 8, 0)
+		require.Nil(t, err)
+
+		blockers := findingsOfSeverity(findings, SeverityBlocker)
+		require.Len(t, blockers, 2)
+		assert.Equal(t, progressCheck, blockers[0].Check)
+		assert.Equal(t, safeBalanceCheck, blockers[1].Check)
+		assert.True(t, strings.Contains(blockers[1].Message, "holds 500 of the token USDC-abcdef"))
+		assert.True(t, strings.Contains(blockers[1].Message, "transfers 700"))
+
+		warnings := findingsOfSeverity(findings, SeverityWarning)
+		require.Len(t, warnings, 1)
+		assert.Equal(t, pauseCheck, warnings[0].Check)
+	})
+	t.Run("from MultiversX: missing batch should be a blocker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		multiversXChain := args.MultiversXChain.(*bridgeTests.DataGetterStub)
+		multiversXChain.GetBatchAsDataBytesCalled = func(ctx context.Context, batchID uint64) ([][]byte, error) {
+			return [][]byte{{}}, nil
+		}
+		args.EthereumRelayerBalance = createBalanceGetter(10)
+
+		diagnosis, _ := NewBatchDiagnosis(args)
+		findings, err := diagnosis.Diagnose(context.Background(), batchProcessor.FromMultiversX, 7, 0)
+		require.Nil(t, err)
+
+		assert.Equal(t, batchCheck, findings[0].Check)
+		assert.Equal(t, SeverityBlocker, findings[0].Severity)
+		assert.Equal(t, gasCheck, findings[1].Check)
+		assert.Equal(t, SeverityWarning, findings[1].Severity)
+	})
+}
+
+func TestParseBatchAmounts(t *testing.T) {
+	t.Parallel()
+
+	amounts, numDeposits, err := parseBatchAmounts([][]byte{{1}, {2}})
+	assert.NotNil(t, err)
+	assert.Nil(t, amounts)
+	assert.Zero(t, numDeposits)
+
+	response := createMultiversXBatchResponse(3, []string{"USDC-abcdef", "ETHUSDC-123456", "USDC-abcdef"}, []int64{1, 2, 3})
+	amounts, numDeposits, err = parseBatchAmounts(response)
+	require.Nil(t, err)
+	assert.Equal(t, 3, numDeposits)
+	expectedAmounts := []*tokenAmount{
+		{token: []byte("USDC-abcdef"), amount: big.NewInt(4)},
+		{token: []byte("ETHUSDC-123456"), amount: big.NewInt(2)},
+	}
+	assert.Equal(t, expectedAmounts, amounts)
+}
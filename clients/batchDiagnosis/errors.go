@@ -0,0 +1,24 @@
+package batchDiagnosis
+
+import "errors"
+
+// ErrNilEthereumChain signals that a nil Ethereum chain was provided
+var ErrNilEthereumChain = errors.New("nil Ethereum chain")
+
+// ErrNilMultiversXChain signals that a nil MultiversX chain was provided
+var ErrNilMultiversXChain = errors.New("nil MultiversX chain")
+
+// ErrNilBalanceGetter signals that a nil balance getter was provided
+var ErrNilBalanceGetter = errors.New("nil balance getter")
+
+// ErrNilMinimumBalance signals that a nil minimum balance was provided
+var ErrNilMinimumBalance = errors.New("nil minimum balance")
+
+// ErrInvalidBatchID signals that an invalid batch ID was provided
+var ErrInvalidBatchID = errors.New("invalid batch ID")
+
+// ErrUnknownDirection signals that an unknown bridge direction was provided
+var ErrUnknownDirection = errors.New("unknown direction")
+
+// ErrNilAddressConverter signals that a nil address converter was provided
+var ErrNilAddressConverter = errors.New("nil address converter")
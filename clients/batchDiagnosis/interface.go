@@ -0,0 +1,41 @@
+package batchDiagnosis
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+)
+
+// EthereumChain defines the read-only Ethereum operations used to diagnose a batch
+type EthereumChain interface {
+	GetBatch(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error)
+	WasBatchExecuted(ctx context.Context, batchNonce *big.Int) (bool, error)
+	GetRelayers(ctx context.Context) ([]common.Address, error)
+	Quorum(ctx context.Context) (*big.Int, error)
+	IsPaused(ctx context.Context) (bool, error)
+	TotalBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	MintBurnTokens(ctx context.Context, token common.Address) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXChain defines the read-only MultiversX operations used to diagnose a batch
+type MultiversXChain interface {
+	GetBatchAsDataBytes(ctx context.Context, batchID uint64) ([][]byte, error)
+	GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	GetQuorum(ctx context.Context) (uint64, error)
+	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	WasExecuted(ctx context.Context, actionID uint64) (bool, error)
+	WasSignedBy(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error)
+	IsPaused(ctx context.Context) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// BalanceGetter is able to fetch the current native balance of a relayer account
+type BalanceGetter interface {
+	GetBalance(ctx context.Context) (*big.Int, error)
+	IsInterfaceNil() bool
+}
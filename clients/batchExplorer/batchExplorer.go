@@ -0,0 +1,146 @@
+package batchExplorer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsBatchExplorer is the DTO used to create a new instance of type batchExplorer
+type ArgsBatchExplorer struct {
+	// Providers holds the live view provider of each state machine, by the state machine name
+	Providers map[string]LiveViewProvider
+}
+
+type batchExplorer struct {
+	providers map[string]LiveViewProvider
+	getTime   func() int64
+
+	mut   sync.RWMutex
+	views map[string]*core.BatchLiveView
+}
+
+// NewBatchExplorer creates the component keeping the live view of the batch processed by each state machine. It is a
+// step hook: the view is taken after each step, from the state machine's go routine, so the executors are never read
+// concurrently with their steps
+func NewBatchExplorer(args ArgsBatchExplorer) (*batchExplorer, error) {
+	if len(args.Providers) == 0 {
+		return nil, ErrNoLiveViewProviders
+	}
+	for name, provider := range args.Providers {
+		if check.IfNil(provider) {
+			return nil, fmt.Errorf("%w for state machine %s", ErrNilLiveViewProvider, name)
+		}
+	}
+
+	return &batchExplorer{
+		providers: args.Providers,
+		getTime: func() int64 {
+			return time.Now().Unix()
+		},
+		views: make(map[string]*core.BatchLiveView),
+	}, nil
+}
+
+// BeforeStep does nothing
+func (explorer *batchExplorer) BeforeStep(_ context.Context, _ string, _ core.StepIdentifier) {
+}
+
+// AfterStep takes the live view of the batch processed by the state machine
+func (explorer *batchExplorer) AfterStep(_ context.Context, stateMachineName string, _ core.StepIdentifier, nextStep core.StepIdentifier, _ time.Duration) {
+	provider, found := explorer.providers[stateMachineName]
+	if !found {
+		return
+	}
+
+	view := provider.GetLiveView()
+
+	explorer.mut.Lock()
+	defer explorer.mut.Unlock()
+
+	if view == nil {
+		delete(explorer.views, stateMachineName)
+		return
+	}
+
+	view.Direction = stateMachineName
+	view.Step = string(nextStep)
+	view.Timestamp = explorer.getTime()
+	explorer.views[stateMachineName] = view
+}
+
+// OnError does nothing, the view being updated after the failed step
+func (explorer *batchExplorer) OnError(_ string, _ core.StepIdentifier, _ error) {
+}
+
+// GetPendingBatches returns the views of the batches currently processed, sorted by direction
+func (explorer *batchExplorer) GetPendingBatches() []*core.BatchLiveView {
+	return explorer.filter(func(view *core.BatchLiveView) *core.BatchLiveView {
+		return copyView(view, view.Deposits)
+	})
+}
+
+// GetBatchLiveViews returns the views of the processed batches with the provided ID, one for each direction at most
+func (explorer *batchExplorer) GetBatchLiveViews(batchID uint64) []*core.BatchLiveView {
+	return explorer.filter(func(view *core.BatchLiveView) *core.BatchLiveView {
+		if view.BatchID != batchID {
+			return nil
+		}
+
+		return copyView(view, view.Deposits)
+	})
+}
+
+// GetDepositLiveViews returns the views of the processed batches holding a deposit with the provided nonce, each view
+// holding only that deposit
+func (explorer *batchExplorer) GetDepositLiveViews(nonce uint64) []*core.BatchLiveView {
+	return explorer.filter(func(view *core.BatchLiveView) *core.BatchLiveView {
+		for _, deposit := range view.Deposits {
+			if deposit.Nonce == nonce {
+				return copyView(view, []*core.DepositLiveView{deposit})
+			}
+		}
+
+		return nil
+	})
+}
+
+func (explorer *batchExplorer) filter(handler func(view *core.BatchLiveView) *core.BatchLiveView) []*core.BatchLiveView {
+	explorer.mut.RLock()
+	defer explorer.mut.RUnlock()
+
+	views := make([]*core.BatchLiveView, 0, len(explorer.views))
+	for _, view := range explorer.views {
+		result := handler(view)
+		if result != nil {
+			views = append(views, result)
+		}
+	}
+
+	sort.Slice(views, func(i, j int) bool {
+		return views[i].Direction < views[j].Direction
+	})
+
+	return views
+}
+
+func copyView(view *core.BatchLiveView, deposits []*core.DepositLiveView) *core.BatchLiveView {
+	viewCopy := *view
+	viewCopy.Deposits = make([]*core.DepositLiveView, 0, len(deposits))
+	for _, deposit := range deposits {
+		depositCopy := *deposit
+		viewCopy.Deposits = append(viewCopy.Deposits, &depositCopy)
+	}
+
+	return &viewCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (explorer *batchExplorer) IsInterfaceNil() bool {
+	return explorer == nil
+}
@@ -0,0 +1,164 @@
+package batchExplorer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ethToMultiversXName = "EthereumToMultiversX"
+	multiversXToEthName = "MultiversXToEthereum"
+)
+
+func createView(batchID uint64, nonces ...uint64) *core.BatchLiveView {
+	view := &core.BatchLiveView{
+		BatchID:  batchID,
+		ActionID: 37,
+		Deposits: make([]*core.DepositLiveView, 0, len(nonces)),
+	}
+	for _, nonce := range nonces {
+		view.Deposits = append(view.Deposits, &core.DepositLiveView{
+			Nonce:  nonce,
+			Amount: "1000",
+			Status: core.DepositPending,
+		})
+	}
+
+	return view
+}
+
+func createExplorerWithViews(t *testing.T) *batchExplorer {
+	explorer, err := NewBatchExplorer(ArgsBatchExplorer{
+		Providers: map[string]LiveViewProvider{
+			ethToMultiversXName: &testsCommon.LiveViewProviderStub{
+				GetLiveViewCalled: func() *core.BatchLiveView {
+					return createView(12, 74, 75)
+				},
+			},
+			multiversXToEthName: &testsCommon.LiveViewProviderStub{
+				GetLiveViewCalled: func() *core.BatchLiveView {
+					return createView(12, 75, 76)
+				},
+			},
+		},
+	})
+	require.Nil(t, err)
+	explorer.getTime = func() int64 {
+		return 1000
+	}
+
+	explorer.AfterStep(context.Background(), multiversXToEthName, "current", "signing", 0)
+	explorer.AfterStep(context.Background(), ethToMultiversXName, "current", "proposing", 0)
+
+	return explorer
+}
+
+func TestNewBatchExplorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no providers should error", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, err := NewBatchExplorer(ArgsBatchExplorer{})
+		assert.True(t, check.IfNil(explorer))
+		assert.Equal(t, ErrNoLiveViewProviders, err)
+	})
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, err := NewBatchExplorer(ArgsBatchExplorer{
+			Providers: map[string]LiveViewProvider{
+				ethToMultiversXName: nil,
+			},
+		})
+		assert.True(t, check.IfNil(explorer))
+		assert.True(t, errors.Is(err, ErrNilLiveViewProvider))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		explorer, err := NewBatchExplorer(ArgsBatchExplorer{
+			Providers: map[string]LiveViewProvider{
+				ethToMultiversXName: &testsCommon.LiveViewProviderStub{},
+			},
+		})
+		assert.False(t, check.IfNil(explorer))
+		assert.Nil(t, err)
+		assert.Empty(t, explorer.GetPendingBatches())
+	})
+}
+
+func TestBatchExplorer_AfterStep(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown state machine should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		explorer := createExplorerWithViews(t)
+		explorer.AfterStep(context.Background(), "unknown", "current", "next", 0)
+
+		assert.Equal(t, 2, len(explorer.GetPendingBatches()))
+	})
+	t.Run("no stored batch should remove the view", func(t *testing.T) {
+		t.Parallel()
+
+		explorer := createExplorerWithViews(t)
+		explorer.providers[ethToMultiversXName] = &testsCommon.LiveViewProviderStub{}
+		explorer.AfterStep(context.Background(), ethToMultiversXName, "current", "getting pending batch", 0)
+
+		views := explorer.GetPendingBatches()
+		require.Equal(t, 1, len(views))
+		assert.Equal(t, multiversXToEthName, views[0].Direction)
+	})
+}
+
+func TestBatchExplorer_GetPendingBatches(t *testing.T) {
+	t.Parallel()
+
+	explorer := createExplorerWithViews(t)
+
+	views := explorer.GetPendingBatches()
+	require.Equal(t, 2, len(views))
+	expectedView := createView(12, 74, 75)
+	expectedView.Direction = ethToMultiversXName
+	expectedView.Step = "proposing"
+	expectedView.Timestamp = 1000
+	assert.Equal(t, expectedView, views[0])
+	assert.Equal(t, multiversXToEthName, views[1].Direction)
+	assert.Equal(t, "signing", views[1].Step)
+
+	views[0].Deposits[0].Status = core.DepositExecuted
+	assert.Equal(t, core.DepositPending, explorer.GetPendingBatches()[0].Deposits[0].Status)
+}
+
+func TestBatchExplorer_GetBatchLiveViews(t *testing.T) {
+	t.Parallel()
+
+	explorer := createExplorerWithViews(t)
+
+	assert.Empty(t, explorer.GetBatchLiveViews(13))
+	assert.Equal(t, 2, len(explorer.GetBatchLiveViews(12)))
+}
+
+func TestBatchExplorer_GetDepositLiveViews(t *testing.T) {
+	t.Parallel()
+
+	explorer := createExplorerWithViews(t)
+
+	assert.Empty(t, explorer.GetDepositLiveViews(77))
+
+	views := explorer.GetDepositLiveViews(74)
+	require.Equal(t, 1, len(views))
+	assert.Equal(t, ethToMultiversXName, views[0].Direction)
+	require.Equal(t, 1, len(views[0].Deposits))
+	assert.Equal(t, uint64(74), views[0].Deposits[0].Nonce)
+
+	assert.Equal(t, 2, len(explorer.GetDepositLiveViews(75)))
+}
@@ -0,0 +1,9 @@
+package batchExplorer
+
+import "errors"
+
+// ErrNilLiveViewProvider signals that a nil live view provider has been provided
+var ErrNilLiveViewProvider = errors.New("nil live view provider")
+
+// ErrNoLiveViewProviders signals that no live view provider has been provided
+var ErrNoLiveViewProviders = errors.New("no live view providers")
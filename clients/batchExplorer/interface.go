@@ -0,0 +1,9 @@
+package batchExplorer
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// LiveViewProvider defines the component returning the live view of the batch processed by a state machine
+type LiveViewProvider interface {
+	GetLiveView() *core.BatchLiveView
+	IsInterfaceNil() bool
+}
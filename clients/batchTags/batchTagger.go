@@ -0,0 +1,196 @@
+package batchTags
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+const hexPrefix = "0x"
+
+// ArgsBatchTagger represents the DTO struct used in the NewBatchTagger constructor function
+type ArgsBatchTagger struct {
+	StaticTags []string
+	Rules      []config.BatchTagRuleConfig
+}
+
+type tagRule struct {
+	tag        string
+	tokens     map[string]struct{}
+	senders    map[string]struct{}
+	recipients map[string]struct{}
+	minAmount  *big.Int
+}
+
+type batchTagger struct {
+	staticTags []string
+	rules      []*tagRule
+}
+
+// NewBatchTagger creates a new component attaching the configured static and rule-based tags to the bridged transfers
+func NewBatchTagger(args ArgsBatchTagger) (*batchTagger, error) {
+	if len(args.StaticTags) == 0 && len(args.Rules) == 0 {
+		return nil, ErrNoTagsConfigured
+	}
+
+	tagger := &batchTagger{
+		staticTags: make([]string, 0, len(args.StaticTags)),
+		rules:      make([]*tagRule, 0, len(args.Rules)),
+	}
+	for _, tag := range args.StaticTags {
+		if len(tag) == 0 {
+			return nil, fmt.Errorf("%w in static tags", ErrEmptyTag)
+		}
+
+		tagger.staticTags = appendUnique(tagger.staticTags, tag)
+	}
+	for i, ruleConfig := range args.Rules {
+		rule, err := newTagRule(ruleConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%w for rule at index %d", err, i)
+		}
+
+		tagger.rules = append(tagger.rules, rule)
+	}
+
+	return tagger, nil
+}
+
+func newTagRule(cfg config.BatchTagRuleConfig) (*tagRule, error) {
+	if len(cfg.Tag) == 0 {
+		return nil, ErrEmptyTag
+	}
+
+	rule := &tagRule{
+		tag:        cfg.Tag,
+		tokens:     createValuesSet(cfg.Tokens),
+		senders:    createValuesSet(cfg.Senders),
+		recipients: createValuesSet(cfg.Recipients),
+	}
+	if len(cfg.MinAmount) > 0 {
+		minAmount, ok := big.NewInt(0).SetString(cfg.MinAmount, 10)
+		if !ok || minAmount.Sign() < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMinAmount, cfg.MinAmount)
+		}
+
+		rule.minAmount = minAmount
+	}
+
+	hasCriteria := len(rule.tokens) > 0 || len(rule.senders) > 0 || len(rule.recipients) > 0 || rule.minAmount != nil
+	if !hasCriteria {
+		return nil, fmt.Errorf("%w, tag %s", ErrRuleWithoutCriteria, cfg.Tag)
+	}
+
+	return rule, nil
+}
+
+func createValuesSet(values []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[normalize(value)] = struct{}{}
+	}
+
+	return set
+}
+
+// normalize allows the Ethereum addresses to be configured with or without the prefix and with any letter case
+func normalize(value string) string {
+	return strings.TrimPrefix(strings.ToLower(value), hexPrefix)
+}
+
+func (rule *tagRule) matches(from string, to string, token string, amount *big.Int) bool {
+	if !matchesSet(rule.tokens, token) || !matchesSet(rule.senders, from) || !matchesSet(rule.recipients, to) {
+		return false
+	}
+	if rule.minAmount == nil {
+		return true
+	}
+
+	return amount != nil && amount.Cmp(rule.minAmount) >= 0
+}
+
+func matchesSet(set map[string]struct{}, value string) bool {
+	if len(set) == 0 {
+		return true
+	}
+
+	_, found := set[normalize(value)]
+	return found
+}
+
+// TagTransfer returns the static tags followed by the tags of the rules matched by the provided standalone transfer
+func (tagger *batchTagger) TagTransfer(from string, to string, token string, amount *big.Int) []string {
+	tags := make([]string, 0, len(tagger.staticTags))
+	tags = append(tags, tagger.staticTags...)
+	for _, tag := range tagger.matchRules(from, to, token, amount) {
+		tags = appendUnique(tags, tag)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return tags
+}
+
+// TagDeposit returns the tags of the rules matched by the provided deposit, in the order of the rules. The static tags
+// are only attached to the deposit's batch
+func (tagger *batchTagger) TagDeposit(deposit *bridgeCore.DepositTransfer) []string {
+	if deposit == nil {
+		return nil
+	}
+
+	return tagger.matchRules(deposit.DisplayableFrom, deposit.DisplayableTo, deposit.DisplayableToken, deposit.Amount)
+}
+
+func (tagger *batchTagger) matchRules(from string, to string, token string, amount *big.Int) []string {
+	tags := make([]string, 0)
+	for _, rule := range tagger.rules {
+		if rule.matches(from, to, token, amount) {
+			tags = appendUnique(tags, rule.tag)
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return tags
+}
+
+// TagBatch returns the static tags followed by the tags of all the deposits of the provided batch
+func (tagger *batchTagger) TagBatch(batch *bridgeCore.TransferBatch) []string {
+	tags := make([]string, 0, len(tagger.staticTags))
+	tags = append(tags, tagger.staticTags...)
+	if batch != nil {
+		for _, deposit := range batch.Deposits {
+			for _, tag := range tagger.TagDeposit(deposit) {
+				tags = appendUnique(tags, tag)
+			}
+		}
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	return tags
+}
+
+func appendUnique(tags []string, tag string) []string {
+	for _, existing := range tags {
+		if existing == tag {
+			return tags
+		}
+	}
+
+	return append(tags, tag)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tagger *batchTagger) IsInterfaceNil() bool {
+	return tagger == nil
+}
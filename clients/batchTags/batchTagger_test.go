@@ -0,0 +1,176 @@
+package batchTags
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ethAddress   = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	mvxAddress   = "erd132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqvjzv73"
+	ethToken     = "0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c"
+	otherAddress = "erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf"
+)
+
+func createMockArgsBatchTagger() ArgsBatchTagger {
+	return ArgsBatchTagger{
+		StaticTags: []string{"bridge-a"},
+		Rules: []config.BatchTagRuleConfig{
+			{
+				Tag:       "high-value",
+				MinAmount: "1000",
+			},
+			{
+				Tag:        "institutional",
+				Senders:    []string{ethAddress},
+				Recipients: []string{mvxAddress},
+			},
+			{
+				Tag:    "usdc",
+				Tokens: []string{ethToken},
+			},
+		},
+	}
+}
+
+func createDeposit(from string, to string, token string, amount int64) *bridgeCore.DepositTransfer {
+	return &bridgeCore.DepositTransfer{
+		DisplayableFrom:  from,
+		DisplayableTo:    to,
+		DisplayableToken: token,
+		Amount:           big.NewInt(amount),
+	}
+}
+
+func TestNewBatchTagger(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no tags should error", func(t *testing.T) {
+		t.Parallel()
+
+		tagger, err := NewBatchTagger(ArgsBatchTagger{})
+		assert.True(t, check.IfNil(tagger))
+		assert.Equal(t, ErrNoTagsConfigured, err)
+	})
+	t.Run("empty static tag should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchTagger()
+		args.StaticTags = append(args.StaticTags, "")
+		tagger, err := NewBatchTagger(args)
+		assert.True(t, check.IfNil(tagger))
+		assert.True(t, errors.Is(err, ErrEmptyTag))
+	})
+	t.Run("empty rule tag should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchTagger()
+		args.Rules[1].Tag = ""
+		tagger, err := NewBatchTagger(args)
+		assert.True(t, check.IfNil(tagger))
+		assert.True(t, errors.Is(err, ErrEmptyTag))
+		assert.Contains(t, err.Error(), "index 1")
+	})
+	t.Run("rule without criteria should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchTagger()
+		args.Rules = append(args.Rules, config.BatchTagRuleConfig{Tag: "all"})
+		tagger, err := NewBatchTagger(args)
+		assert.True(t, check.IfNil(tagger))
+		assert.True(t, errors.Is(err, ErrRuleWithoutCriteria))
+	})
+	t.Run("invalid minimum amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBatchTagger()
+		args.Rules[0].MinAmount = "1e18"
+		tagger, err := NewBatchTagger(args)
+		assert.True(t, check.IfNil(tagger))
+		assert.True(t, errors.Is(err, ErrInvalidMinAmount))
+
+		args.Rules[0].MinAmount = "-1"
+		tagger, err = NewBatchTagger(args)
+		assert.True(t, check.IfNil(tagger))
+		assert.True(t, errors.Is(err, ErrInvalidMinAmount))
+	})
+	t.Run("only static tags should work", func(t *testing.T) {
+		t.Parallel()
+
+		tagger, err := NewBatchTagger(ArgsBatchTagger{StaticTags: []string{"bridge-a"}})
+		assert.False(t, check.IfNil(tagger))
+		assert.Nil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tagger, err := NewBatchTagger(createMockArgsBatchTagger())
+		assert.False(t, check.IfNil(tagger))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchTagger_TagDeposit(t *testing.T) {
+	t.Parallel()
+
+	tagger, _ := NewBatchTagger(createMockArgsBatchTagger())
+
+	assert.Nil(t, tagger.TagDeposit(nil))
+	assert.Nil(t, tagger.TagDeposit(createDeposit(ethAddress, otherAddress, "0x0", 999)))
+	assert.Equal(t, []string{"high-value"}, tagger.TagDeposit(createDeposit(ethAddress, otherAddress, "0x0", 1000)))
+	assert.Equal(t, []string{"institutional"}, tagger.TagDeposit(createDeposit(ethAddress, mvxAddress, "0x0", 1)))
+	assert.Nil(t, tagger.TagDeposit(createDeposit(otherAddress, mvxAddress, "0x0", 1)))
+
+	// the Ethereum addresses are matched regardless of the case and the prefix
+	assert.Equal(t, []string{"high-value", "institutional", "usdc"},
+		tagger.TagDeposit(createDeposit("3009d97ffed62e57d444e552a9edf9ee6bc8644c", mvxAddress, "A6504CC508889BBDBD4B748AFF6EA6B5D0D2684C", 5000)))
+
+	deposit := createDeposit(ethAddress, otherAddress, "0x0", 0)
+	deposit.Amount = nil
+	assert.Nil(t, tagger.TagDeposit(deposit))
+}
+
+func TestBatchTagger_TagTransfer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBatchTagger()
+	args.Rules = append(args.Rules, config.BatchTagRuleConfig{
+		Tag:       "high-value",
+		Tokens:    []string{"USDC-123456"},
+		MinAmount: "10",
+	})
+	tagger, _ := NewBatchTagger(args)
+
+	assert.Equal(t, []string{"bridge-a", "high-value"}, tagger.TagTransfer(ethAddress, otherAddress, "USDC-123456", big.NewInt(10)))
+	assert.Equal(t, []string{"bridge-a"}, tagger.TagTransfer(ethAddress, otherAddress, "WETH-123456", big.NewInt(10)))
+
+	tagger, _ = NewBatchTagger(ArgsBatchTagger{Rules: args.Rules})
+	assert.Nil(t, tagger.TagTransfer(ethAddress, otherAddress, "WETH-123456", big.NewInt(10)))
+}
+
+func TestBatchTagger_TagBatch(t *testing.T) {
+	t.Parallel()
+
+	tagger, _ := NewBatchTagger(createMockArgsBatchTagger())
+
+	assert.Equal(t, []string{"bridge-a"}, tagger.TagBatch(nil))
+
+	batch := &bridgeCore.TransferBatch{
+		Deposits: []*bridgeCore.DepositTransfer{
+			createDeposit(ethAddress, mvxAddress, "0x0", 1),
+			createDeposit(ethAddress, otherAddress, "0x0", 1),
+			createDeposit(otherAddress, otherAddress, ethToken, 2000),
+			createDeposit(ethAddress, mvxAddress, "0x0", 2),
+		},
+	}
+	assert.Equal(t, []string{"bridge-a", "institutional", "high-value", "usdc"}, tagger.TagBatch(batch))
+
+	tagger, _ = NewBatchTagger(ArgsBatchTagger{Rules: createMockArgsBatchTagger().Rules})
+	assert.Nil(t, tagger.TagBatch(&bridgeCore.TransferBatch{}))
+}
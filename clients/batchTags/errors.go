@@ -0,0 +1,15 @@
+package batchTags
+
+import "errors"
+
+// ErrEmptyTag signals that an empty tag has been provided
+var ErrEmptyTag = errors.New("empty tag")
+
+// ErrNoTagsConfigured signals that neither static tags nor rules have been provided
+var ErrNoTagsConfigured = errors.New("no tags configured")
+
+// ErrRuleWithoutCriteria signals that a rule without any matching criteria has been provided
+var ErrRuleWithoutCriteria = errors.New("rule without criteria")
+
+// ErrInvalidMinAmount signals that an invalid minimum amount has been provided
+var ErrInvalidMinAmount = errors.New("invalid minimum amount")
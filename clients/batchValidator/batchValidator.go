@@ -0,0 +1,156 @@
+package batchValidator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const minRequestTime = time.Millisecond
+const logPath = "BatchValidator"
+
+// ArgsBatchValidator is the DTO used for the creating a new batch validator instance
+type ArgsBatchValidator struct {
+	Direction     batchProcessor.Direction
+	RequestURL    string
+	RequestTime   time.Duration
+	FailurePolicy string
+}
+
+type batchValidator struct {
+	direction   batchProcessor.Direction
+	requestURL  string
+	requestTime time.Duration
+	failOpen    bool
+	log         logger.Logger
+	httpClient  HTTPClient
+}
+
+// NewBatchValidator returns a new batch validator instance that posts the batches to an external risk engine
+func NewBatchValidator(args ArgsBatchValidator) (*batchValidator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchValidator{
+		direction:   args.Direction,
+		requestURL:  args.RequestURL,
+		requestTime: args.RequestTime,
+		failOpen:    args.FailurePolicy == FailOpenPolicy,
+		log:         logger.GetOrCreate(logPath),
+		httpClient:  http.DefaultClient,
+	}, nil
+}
+
+func checkArgs(args ArgsBatchValidator) error {
+	switch args.Direction {
+	case batchProcessor.ToMultiversX, batchProcessor.FromMultiversX:
+	default:
+		return fmt.Errorf("%w in checkArgs for value Direction", clients.ErrInvalidValue)
+	}
+	if len(args.RequestURL) == 0 {
+		return fmt.Errorf("%w in checkArgs for value RequestURL", clients.ErrInvalidValue)
+	}
+	if args.RequestTime < minRequestTime {
+		return fmt.Errorf("%w in checkArgs for value RequestTime", clients.ErrInvalidValue)
+	}
+
+	switch args.FailurePolicy {
+	case FailOpenPolicy, FailClosedPolicy:
+	default:
+		return fmt.Errorf("%w: %q", ErrInvalidFailurePolicy, args.FailurePolicy)
+	}
+
+	return nil
+}
+
+// ValidateBatch posts the batch to the external risk engine and returns nil if the batch is allowed to be signed.
+// A denied batch is always rejected, while a timed out or invalid answer is handled as set by the failure policy
+func (validator *batchValidator) ValidateBatch(ctx context.Context, batch *core.TransferBatch) error {
+	if batch == nil {
+		return clients.ErrNilBatch
+	}
+
+	response, err := validator.doRequest(ctx, batch)
+	if err != nil {
+		return validator.handleFailure(batch, err)
+	}
+
+	switch response.Decision {
+	case DecisionAllow:
+		validator.log.Debug("batch allowed by the batch validator", "direction", validator.direction, "batch ID", batch.ID)
+		return nil
+	case DecisionDeny:
+		return fmt.Errorf("%w, direction %s, batch ID %d, reason: %s", ErrBatchDenied, validator.direction, batch.ID, response.Reason)
+	default:
+		return validator.handleFailure(batch, fmt.Errorf("%w: %q", ErrInvalidDecision, response.Decision))
+	}
+}
+
+func (validator *batchValidator) handleFailure(batch *core.TransferBatch, err error) error {
+	if validator.failOpen {
+		validator.log.Warn("batch validator failed, allowing the batch as set by the fail-open policy",
+			"direction", validator.direction, "batch ID", batch.ID, "error", err)
+		return nil
+	}
+
+	return fmt.Errorf("%w, direction %s, batch ID %d: %v", ErrBatchValidationFailed, validator.direction, batch.ID, err)
+}
+
+func (validator *batchValidator) doRequest(ctx context.Context, batch *core.TransferBatch) (*batchValidationResponse, error) {
+	requestContext, cancel := context.WithTimeout(ctx, validator.requestTime)
+	defer cancel()
+
+	body, err := json.Marshal(&batchValidationRequest{
+		Direction: validator.direction,
+		Batch:     batch,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(requestContext, http.MethodPost, validator.requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	httpResponse, err := validator.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = httpResponse.Body.Close()
+	}()
+
+	responseBytes, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w %d: %q", errUnexpectedStatusCode, httpResponse.StatusCode, string(responseBytes))
+	}
+
+	response := &batchValidationResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", err, string(responseBytes))
+	}
+
+	return response, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *batchValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
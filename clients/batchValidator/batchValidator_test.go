@@ -0,0 +1,222 @@
+package batchValidator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBatchValidator() ArgsBatchValidator {
+	return ArgsBatchValidator{
+		Direction:     batchProcessor.FromMultiversX,
+		RequestURL:    "http://localhost:8080/validate",
+		RequestTime:   time.Second,
+		FailurePolicy: FailClosedPolicy,
+	}
+}
+
+func createTestBatch() *core.TransferBatch {
+	return &core.TransferBatch{
+		ID: 37,
+		Deposits: []*core.DepositTransfer{
+			{
+				Nonce:            1,
+				DisplayableTo:    "to",
+				DisplayableFrom:  "from",
+				DisplayableToken: "token",
+				Amount:           big.NewInt(1000),
+			},
+		},
+	}
+}
+
+func createTestServer(t *testing.T, handler func(rw http.ResponseWriter, request *batchValidationRequest)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		request := &batchValidationRequest{}
+		err := json.NewDecoder(req.Body).Decode(request)
+		require.Nil(t, err)
+		assert.Equal(t, http.MethodPost, req.Method)
+
+		handler(rw, request)
+	}))
+}
+
+func writeResponse(rw http.ResponseWriter, decision string, reason string) {
+	buff, _ := json.Marshal(&batchValidationResponse{
+		Decision: decision,
+		Reason:   reason,
+	})
+	_, _ = rw.Write(buff)
+}
+
+func TestNewBatchValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid direction", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.Direction = "unknown"
+
+		validator, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value Direction"))
+	})
+	t.Run("empty request URL", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.RequestURL = ""
+
+		validator, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value RequestURL"))
+	})
+	t.Run("invalid request time", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.RequestTime = time.Duration(minRequestTime.Nanoseconds() - 1)
+
+		validator, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "checkArgs for value RequestTime"))
+	})
+	t.Run("invalid failure policy", func(t *testing.T) {
+		args := createMockArgsBatchValidator()
+		args.FailurePolicy = "fail-sometimes"
+
+		validator, err := NewBatchValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, ErrInvalidFailurePolicy))
+	})
+	t.Run("should work", func(t *testing.T) {
+		validator, err := NewBatchValidator(createMockArgsBatchValidator())
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBatchValidator_ValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		validator, _ := NewBatchValidator(createMockArgsBatchValidator())
+		err := validator.ValidateBatch(context.Background(), nil)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("allowed batch should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createTestBatch()
+		server := createTestServer(t, func(rw http.ResponseWriter, request *batchValidationRequest) {
+			assert.Equal(t, batchProcessor.FromMultiversX, request.Direction)
+			assert.Equal(t, batch.ID, request.Batch.ID)
+			assert.Equal(t, batch.Deposits[0].Amount, request.Batch.Deposits[0].Amount)
+
+			writeResponse(rw, DecisionAllow, "")
+		})
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		validator, _ := NewBatchValidator(args)
+
+		err := validator.ValidateBatch(context.Background(), batch)
+		assert.Nil(t, err)
+	})
+	t.Run("denied batch should error regardless of the failure policy", func(t *testing.T) {
+		t.Parallel()
+
+		server := createTestServer(t, func(rw http.ResponseWriter, request *batchValidationRequest) {
+			writeResponse(rw, DecisionDeny, "sanctioned address")
+		})
+		defer server.Close()
+
+		args := createMockArgsBatchValidator()
+		args.RequestURL = server.URL
+		args.FailurePolicy = FailOpenPolicy
+		validator, _ := NewBatchValidator(args)
+
+		err := validator.ValidateBatch(context.Background(), createTestBatch())
+		assert.True(t, errors.Is(err, ErrBatchDenied))
+		assert.True(t, strings.Contains(err.Error(), "sanctioned address"))
+	})
+
+	testFailures := func(t *testing.T, policy string, checkErr func(t *testing.T, err error)) {
+		t.Run("timeout", func(t *testing.T) {
+			t.Parallel()
+
+			server := createTestServer(t, func(rw http.ResponseWriter, request *batchValidationRequest) {
+				time.Sleep(time.Millisecond * 200)
+				writeResponse(rw, DecisionAllow, "")
+			})
+			defer server.Close()
+
+			args := createMockArgsBatchValidator()
+			args.RequestURL = server.URL
+			args.RequestTime = time.Millisecond * 10
+			args.FailurePolicy = policy
+			validator, _ := NewBatchValidator(args)
+
+			checkErr(t, validator.ValidateBatch(context.Background(), createTestBatch()))
+		})
+		t.Run("unexpected status code", func(t *testing.T) {
+			t.Parallel()
+
+			server := createTestServer(t, func(rw http.ResponseWriter, request *batchValidationRequest) {
+				rw.WriteHeader(http.StatusInternalServerError)
+			})
+			defer server.Close()
+
+			args := createMockArgsBatchValidator()
+			args.RequestURL = server.URL
+			args.FailurePolicy = policy
+			validator, _ := NewBatchValidator(args)
+
+			checkErr(t, validator.ValidateBatch(context.Background(), createTestBatch()))
+		})
+		t.Run("invalid decision", func(t *testing.T) {
+			t.Parallel()
+
+			server := createTestServer(t, func(rw http.ResponseWriter, request *batchValidationRequest) {
+				writeResponse(rw, "maybe", "")
+			})
+			defer server.Close()
+
+			args := createMockArgsBatchValidator()
+			args.RequestURL = server.URL
+			args.FailurePolicy = policy
+			validator, _ := NewBatchValidator(args)
+
+			checkErr(t, validator.ValidateBatch(context.Background(), createTestBatch()))
+		})
+	}
+
+	t.Run("fail-closed policy should error on failures", func(t *testing.T) {
+		t.Parallel()
+
+		testFailures(t, FailClosedPolicy, func(t *testing.T, err error) {
+			assert.True(t, errors.Is(err, ErrBatchValidationFailed))
+		})
+	})
+	t.Run("fail-open policy should allow the batch on failures", func(t *testing.T) {
+		t.Parallel()
+
+		testFailures(t, FailOpenPolicy, func(t *testing.T, err error) {
+			assert.Nil(t, err)
+		})
+	})
+}
@@ -0,0 +1,20 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// DisabledBatchValidator implementation in case no external batch validator is used
+type DisabledBatchValidator struct{}
+
+// ValidateBatch returns nil, allowing all batches
+func (dbv *DisabledBatchValidator) ValidateBatch(_ context.Context, _ *core.TransferBatch) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dbv *DisabledBatchValidator) IsInterfaceNil() bool {
+	return dbv == nil
+}
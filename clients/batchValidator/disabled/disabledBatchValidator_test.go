@@ -0,0 +1,17 @@
+package disabled
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledBatchValidator(t *testing.T) {
+	dbv := &DisabledBatchValidator{}
+
+	assert.False(t, check.IfNil(dbv))
+	assert.Nil(t, dbv.ValidateBatch(context.Background(), &core.TransferBatch{}))
+}
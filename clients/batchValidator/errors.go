@@ -0,0 +1,17 @@
+package batchValidator
+
+import "errors"
+
+// ErrBatchDenied signals that the external risk engine denied the batch
+var ErrBatchDenied = errors.New("batch denied by the batch validator")
+
+// ErrBatchValidationFailed signals that the batch could not be validated by the external risk engine
+var ErrBatchValidationFailed = errors.New("batch validation failed")
+
+// ErrInvalidFailurePolicy signals that an invalid failure policy has been provided
+var ErrInvalidFailurePolicy = errors.New("invalid failure policy")
+
+// ErrInvalidDecision signals that the external risk engine responded with an unknown decision
+var ErrInvalidDecision = errors.New("invalid decision")
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
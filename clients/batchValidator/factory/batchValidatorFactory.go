@@ -0,0 +1,15 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchValidator"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchValidator/disabled"
+)
+
+// CreateBatchValidator generates an implementation of BatchValidator
+func CreateBatchValidator(args batchValidator.ArgsBatchValidator, enabled bool) (clients.BatchValidator, error) {
+	if enabled {
+		return batchValidator.NewBatchValidator(args)
+	}
+	return &disabled.DisabledBatchValidator{}, nil
+}
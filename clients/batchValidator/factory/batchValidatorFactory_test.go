@@ -0,0 +1,40 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchValidator"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchValidator/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsBatchValidator() batchValidator.ArgsBatchValidator {
+	return batchValidator.ArgsBatchValidator{
+		Direction:     batchProcessor.ToMultiversX,
+		RequestURL:    "http://localhost:8080/validate",
+		RequestTime:   time.Second,
+		FailurePolicy: batchValidator.FailClosedPolicy,
+	}
+}
+
+func TestCreateBatchValidator(t *testing.T) {
+	t.Parallel()
+	args := createMockArgsBatchValidator()
+	t.Run("disabled batch validator", func(t *testing.T) {
+		validator, err := CreateBatchValidator(args, false)
+
+		_, ok := validator.(*disabled.DisabledBatchValidator)
+
+		assert.True(t, ok)
+		assert.Nil(t, err)
+	})
+	t.Run("normal batch validator", func(t *testing.T) {
+		validator, err := CreateBatchValidator(args, true)
+
+		assert.Equal(t, "*batchValidator.batchValidator", fmt.Sprintf("%T", validator))
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,28 @@
+package batchValidator
+
+import (
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+)
+
+// The decisions an external risk engine can respond with
+const (
+	DecisionAllow = "allow"
+	DecisionDeny  = "deny"
+)
+
+// The policies applied when the external risk engine can not be reached in time or responds with an invalid answer
+const (
+	FailOpenPolicy   = "fail-open"
+	FailClosedPolicy = "fail-closed"
+)
+
+type batchValidationRequest struct {
+	Direction batchProcessor.Direction `json:"direction"`
+	Batch     *core.TransferBatch      `json:"batch"`
+}
+
+type batchValidationResponse struct {
+	Decision string `json:"decision"`
+	Reason   string `json:"reason"`
+}
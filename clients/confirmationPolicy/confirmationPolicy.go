@@ -0,0 +1,164 @@
+package confirmationPolicy
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type tier struct {
+	MinimumAmount    *big.Int `json:"minimumAmount"`
+	NumConfirmations uint64   `json:"numConfirmations"`
+}
+
+type tokenTiers struct {
+	ERC20Address string  `json:"erc20Address"`
+	Tiers        []*tier `json:"tiers"`
+}
+
+// policy is the normalized form of the configuration, marshalled to compute the policy hash. The tokens are sorted by
+// address and the tiers by amount, so equivalent configurations produce the same hash
+type policy struct {
+	DefaultNumConfirmations uint64        `json:"defaultNumConfirmations"`
+	Tokens                  []*tokenTiers `json:"tokens"`
+}
+
+type confirmationPolicy struct {
+	defaultNumConfirmations uint64
+	tiers                   map[string][]*tier
+	hash                    []byte
+}
+
+// NewConfirmationPolicy creates the component that computes the number of confirmations required by a batch, based on
+// the tokens and the amounts of its deposits
+func NewConfirmationPolicy(cfg config.ConfirmationPolicyConfig) (*confirmationPolicy, error) {
+	normalized := &policy{
+		DefaultNumConfirmations: cfg.DefaultNumConfirmations,
+		Tokens:                  make([]*tokenTiers, 0, len(cfg.Tokens)),
+	}
+	confirmation := &confirmationPolicy{
+		defaultNumConfirmations: cfg.DefaultNumConfirmations,
+		tiers:                   make(map[string][]*tier, len(cfg.Tokens)),
+	}
+
+	for _, token := range cfg.Tokens {
+		if !common.IsHexAddress(token.ERC20Address) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTokenAddress, token.ERC20Address)
+		}
+		address := common.HexToAddress(token.ERC20Address)
+		_, found := confirmation.tiers[string(address.Bytes())]
+		if found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedToken, token.ERC20Address)
+		}
+
+		tiers, err := parseTiers(token)
+		if err != nil {
+			return nil, err
+		}
+
+		confirmation.tiers[string(address.Bytes())] = tiers
+		normalized.Tokens = append(normalized.Tokens, &tokenTiers{
+			ERC20Address: strings.ToLower(address.Hex()),
+			Tiers:        tiers,
+		})
+	}
+
+	sort.Slice(normalized.Tokens, func(i, j int) bool {
+		return normalized.Tokens[i].ERC20Address < normalized.Tokens[j].ERC20Address
+	})
+	buff, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(buff)
+	confirmation.hash = hash[:]
+
+	return confirmation, nil
+}
+
+func parseTiers(token config.TokenConfirmationTiersConfig) ([]*tier, error) {
+	if len(token.Tiers) == 0 {
+		return nil, fmt.Errorf("%w for token %s", ErrEmptyTiers, token.ERC20Address)
+	}
+
+	tiers := make([]*tier, 0, len(token.Tiers))
+	for _, tierConfig := range token.Tiers {
+		amount, ok := big.NewInt(0).SetString(tierConfig.MinimumAmount, 10)
+		if !ok || amount.Sign() < 0 {
+			return nil, fmt.Errorf("%w %q for token %s", ErrInvalidAmount, tierConfig.MinimumAmount, token.ERC20Address)
+		}
+
+		tiers = append(tiers, &tier{
+			MinimumAmount:    amount,
+			NumConfirmations: tierConfig.NumConfirmations,
+		})
+	}
+
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].MinimumAmount.Cmp(tiers[j].MinimumAmount) < 0
+	})
+	for i := 1; i < len(tiers); i++ {
+		if tiers[i].MinimumAmount.Cmp(tiers[i-1].MinimumAmount) == 0 {
+			return nil, fmt.Errorf("%w for token %s: duplicated minimum amount %s",
+				ErrInvalidTiers, token.ERC20Address, tiers[i].MinimumAmount.String())
+		}
+		if tiers[i].NumConfirmations < tiers[i-1].NumConfirmations {
+			return nil, fmt.Errorf("%w for token %s: the tier with the minimum amount %s requires less confirmations than a smaller tier",
+				ErrInvalidTiers, token.ERC20Address, tiers[i].MinimumAmount.String())
+		}
+	}
+
+	return tiers, nil
+}
+
+// RequiredConfirmations returns the number of confirmations required by the provided batch: the highest number of
+// confirmations required by its deposits
+func (confirmation *confirmationPolicy) RequiredConfirmations(batch *bridgeCore.TransferBatch) uint64 {
+	required := confirmation.defaultNumConfirmations
+	if batch == nil {
+		return required
+	}
+
+	for _, deposit := range batch.Deposits {
+		depositRequired := confirmation.requiredConfirmationsForDeposit(deposit)
+		if depositRequired > required {
+			required = depositRequired
+		}
+	}
+
+	return required
+}
+
+func (confirmation *confirmationPolicy) requiredConfirmationsForDeposit(deposit *bridgeCore.DepositTransfer) uint64 {
+	if deposit == nil || deposit.Amount == nil {
+		return 0
+	}
+
+	tiers := confirmation.tiers[string(deposit.SourceTokenBytes)]
+	required := uint64(0)
+	for _, t := range tiers {
+		if deposit.Amount.Cmp(t.MinimumAmount) < 0 {
+			break
+		}
+		required = t.NumConfirmations
+	}
+
+	return required
+}
+
+// Hash returns the hash of the policy, equal on all the relayers using the same policy
+func (confirmation *confirmationPolicy) Hash() []byte {
+	return confirmation.hash
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (confirmation *confirmationPolicy) IsInterfaceNil() bool {
+	return confirmation == nil
+}
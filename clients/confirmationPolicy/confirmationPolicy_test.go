@@ -0,0 +1,201 @@
+package confirmationPolicy
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token1 = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	token2 = "0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c"
+)
+
+func createTestConfig() config.ConfirmationPolicyConfig {
+	return config.ConfirmationPolicyConfig{
+		Enabled:                 true,
+		DefaultNumConfirmations: 2,
+		Tokens: []config.TokenConfirmationTiersConfig{
+			{
+				ERC20Address: token1,
+				Tiers: []config.ConfirmationTierConfig{
+					{MinimumAmount: "10000", NumConfirmations: 64},
+					{MinimumAmount: "100", NumConfirmations: 12},
+				},
+			},
+			{
+				ERC20Address: token2,
+				Tiers: []config.ConfirmationTierConfig{
+					{MinimumAmount: "0", NumConfirmations: 1},
+				},
+			},
+		},
+	}
+}
+
+func createDeposit(token string, amount int64) *bridgeCore.DepositTransfer {
+	return &bridgeCore.DepositTransfer{
+		SourceTokenBytes: common.HexToAddress(token).Bytes(),
+		Amount:           big.NewInt(amount),
+	}
+}
+
+func TestNewConfirmationPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid token address should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[0].ERC20Address = "not an address"
+
+		policy, err := NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidTokenAddress))
+	})
+	t.Run("duplicated token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[1].ERC20Address = token1
+
+		policy, err := NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrDuplicatedToken))
+	})
+	t.Run("empty tiers should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[1].Tiers = nil
+
+		policy, err := NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrEmptyTiers))
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[0].Tiers[0].MinimumAmount = "1.5"
+
+		policy, err := NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+		cfg.Tokens[0].Tiers[0].MinimumAmount = "-1"
+		policy, err = NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidAmount))
+	})
+	t.Run("duplicated tier amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[0].Tiers[0].MinimumAmount = "100"
+
+		policy, err := NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidTiers))
+	})
+	t.Run("larger tier requiring less confirmations should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[0].Tiers[0].NumConfirmations = 6
+
+		policy, err := NewConfirmationPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidTiers))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewConfirmationPolicy(createTestConfig())
+		assert.False(t, check.IfNil(policy))
+		assert.Nil(t, err)
+	})
+}
+
+func TestConfirmationPolicy_RequiredConfirmations(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewConfirmationPolicy(createTestConfig())
+	require.Nil(t, err)
+
+	t.Run("nil batch should return the default", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, uint64(2), policy.RequiredConfirmations(nil))
+	})
+	t.Run("deposits below any tier or of unknown tokens should return the default", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			Deposits: []*bridgeCore.DepositTransfer{
+				createDeposit(token1, 99),
+				createDeposit(token2, 1000000),
+				createDeposit("0x0000000000000000000000000000000000000001", 1000000),
+			},
+		}
+		assert.Equal(t, uint64(2), policy.RequiredConfirmations(batch))
+	})
+	t.Run("should return the highest tier of the deposits", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			Deposits: []*bridgeCore.DepositTransfer{
+				createDeposit(token1, 100),
+				createDeposit(token2, 1),
+			},
+		}
+		assert.Equal(t, uint64(12), policy.RequiredConfirmations(batch))
+
+		batch.Deposits = append(batch.Deposits, createDeposit(token1, 10000))
+		assert.Equal(t, uint64(64), policy.RequiredConfirmations(batch))
+	})
+}
+
+func TestConfirmationPolicy_Hash(t *testing.T) {
+	t.Parallel()
+
+	policy, _ := NewConfirmationPolicy(createTestConfig())
+
+	t.Run("equivalent configurations should have the same hash", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Enabled = false
+		cfg.Tokens[0], cfg.Tokens[1] = cfg.Tokens[1], cfg.Tokens[0]
+		cfg.Tokens[1].ERC20Address = "0x3009d97ffed62e57d444e552a9edf9ee6bc8644c"
+		cfg.Tokens[1].Tiers[0], cfg.Tokens[1].Tiers[1] = cfg.Tokens[1].Tiers[1], cfg.Tokens[1].Tiers[0]
+
+		equivalentPolicy, err := NewConfirmationPolicy(cfg)
+		require.Nil(t, err)
+		assert.Equal(t, policy.Hash(), equivalentPolicy.Hash())
+	})
+	t.Run("different configurations should have different hashes", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[0].Tiers[0].NumConfirmations = 65
+
+		differentPolicy, err := NewConfirmationPolicy(cfg)
+		require.Nil(t, err)
+		assert.NotEqual(t, policy.Hash(), differentPolicy.Hash())
+
+		cfg = createTestConfig()
+		cfg.DefaultNumConfirmations = 3
+
+		differentPolicy, err = NewConfirmationPolicy(cfg)
+		require.Nil(t, err)
+		assert.NotEqual(t, policy.Hash(), differentPolicy.Hash())
+	})
+}
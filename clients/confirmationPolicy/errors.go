@@ -0,0 +1,18 @@
+package confirmationPolicy
+
+import "errors"
+
+// ErrInvalidTokenAddress signals that an invalid ERC20 token address has been provided
+var ErrInvalidTokenAddress = errors.New("invalid token address")
+
+// ErrDuplicatedToken signals that the tiers of a token have been provided more than once
+var ErrDuplicatedToken = errors.New("duplicated token")
+
+// ErrEmptyTiers signals that a token has been provided without tiers
+var ErrEmptyTiers = errors.New("empty tiers")
+
+// ErrInvalidAmount signals that an invalid tier amount has been provided
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// ErrInvalidTiers signals that the tiers of a token are not consistent
+var ErrInvalidTiers = errors.New("invalid tiers")
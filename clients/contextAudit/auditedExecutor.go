@@ -0,0 +1,66 @@
+package contextAudit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const minStepTimeout = time.Second
+
+// ArgsAuditedExecutor is the DTO used to create a new audited executor
+type ArgsAuditedExecutor struct {
+	Executor    Executor
+	Auditor     Auditor
+	Name        string
+	StepTimeout time.Duration
+}
+
+type auditedExecutor struct {
+	executor    Executor
+	auditor     Auditor
+	name        string
+	stepTimeout time.Duration
+}
+
+// NewAuditedExecutor creates an executor wrapper that bounds each execution with a deadline, so all the outbound calls
+// made with the execution's context are interrupted after the step timeout, and that audits the execution once done
+func NewAuditedExecutor(args ArgsAuditedExecutor) (*auditedExecutor, error) {
+	if check.IfNil(args.Executor) {
+		return nil, ErrNilExecutor
+	}
+	if check.IfNil(args.Auditor) {
+		return nil, ErrNilAuditor
+	}
+	if len(args.Name) == 0 {
+		return nil, ErrEmptyName
+	}
+	if args.StepTimeout < minStepTimeout {
+		return nil, fmt.Errorf("%w, got %v, minimum %v", ErrInvalidStepTimeout, args.StepTimeout, minStepTimeout)
+	}
+
+	return &auditedExecutor{
+		executor:    args.Executor,
+		auditor:     args.Auditor,
+		name:        args.Name,
+		stepTimeout: args.StepTimeout,
+	}, nil
+}
+
+// Execute calls the wrapped executor with a context expiring after the step timeout
+func (executor *auditedExecutor) Execute(ctx context.Context) error {
+	stepCtx, cancel := context.WithTimeout(ctx, executor.stepTimeout)
+	defer cancel()
+
+	err := executor.executor.Execute(stepCtx)
+	executor.auditor.CheckCall(stepCtx, executor.name)
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *auditedExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
@@ -0,0 +1,103 @@
+package contextAudit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAuditedExecutor() ArgsAuditedExecutor {
+	return ArgsAuditedExecutor{
+		Executor:    &testsCommon.ExecutorStub{},
+		Auditor:     &testsCommon.ContextAuditorStub{},
+		Name:        "ethToMvx",
+		StepTimeout: time.Minute,
+	}
+}
+
+func TestNewAuditedExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil executor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuditedExecutor()
+		args.Executor = nil
+		executor, err := NewAuditedExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilExecutor, err)
+	})
+	t.Run("nil auditor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuditedExecutor()
+		args.Auditor = nil
+		executor, err := NewAuditedExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilAuditor, err)
+	})
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuditedExecutor()
+		args.Name = ""
+		executor, err := NewAuditedExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("invalid step timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsAuditedExecutor()
+		args.StepTimeout = time.Millisecond
+		executor, err := NewAuditedExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.True(t, errors.Is(err, ErrInvalidStepTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewAuditedExecutor(createMockArgsAuditedExecutor())
+		assert.False(t, check.IfNil(executor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAuditedExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAuditedExecutor()
+	var executionCtx context.Context
+	args.Executor = &testsCommon.ExecutorStub{
+		ExecuteCalled: func(ctx context.Context) error {
+			executionCtx = ctx
+			return expectedErr
+		},
+	}
+	numChecks := 0
+	args.Auditor = &testsCommon.ContextAuditorStub{
+		CheckCallCalled: func(ctx context.Context, call string) {
+			assert.Equal(t, executionCtx, ctx)
+			assert.Equal(t, "ethToMvx", call)
+			numChecks++
+		},
+	}
+	executor, _ := NewAuditedExecutor(args)
+
+	startTime := time.Now()
+	err := executor.Execute(context.Background())
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numChecks)
+
+	deadline, hasDeadline := executionCtx.Deadline()
+	require.True(t, hasDeadline)
+	assert.False(t, deadline.Before(startTime.Add(time.Minute)))
+	assert.NotNil(t, executionCtx.Err(), "the step context should be cancelled once the step is done")
+}
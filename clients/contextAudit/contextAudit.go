@@ -0,0 +1,88 @@
+package contextAudit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsContextAudit is the DTO used to create a new instance of type contextAudit
+type ArgsContextAudit struct {
+	Log              logger.Logger
+	StatusHandler    core.StatusHandler
+	OverrunTolerance time.Duration
+}
+
+type contextAudit struct {
+	log              logger.Logger
+	statusHandler    core.StatusHandler
+	overrunTolerance time.Duration
+	getTime          func() time.Time
+
+	mut             sync.Mutex
+	numOverruns     int
+	maxOverrun      time.Duration
+	lastOverrunCall string
+}
+
+// NewContextAudit creates the component detecting, at runtime, the calls blocked past the deadline of their context.
+// Such a call ignores the context, so it can not be interrupted on shutdown either
+func NewContextAudit(args ArgsContextAudit) (*contextAudit, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+
+	audit := &contextAudit{
+		log:              args.Log,
+		statusHandler:    args.StatusHandler,
+		overrunTolerance: args.OverrunTolerance,
+		getTime:          time.Now,
+	}
+	audit.updateMetricsUnprotected()
+
+	return audit, nil
+}
+
+// CheckCall must be called right after the provided call returned. It records the call if it returned later than the
+// deadline of its context plus the tolerance. The contexts without a deadline are not audited
+func (audit *contextAudit) CheckCall(ctx context.Context, call string) {
+	deadline, hasDeadline := ctx.Deadline()
+	if !hasDeadline {
+		return
+	}
+
+	overrun := audit.getTime().Sub(deadline)
+	if overrun <= audit.overrunTolerance {
+		return
+	}
+
+	audit.mut.Lock()
+	audit.numOverruns++
+	if overrun > audit.maxOverrun {
+		audit.maxOverrun = overrun
+	}
+	audit.lastOverrunCall = call
+	audit.updateMetricsUnprotected()
+	audit.mut.Unlock()
+
+	audit.log.Warn("call returned after the deadline of its context, it ignores the context",
+		"call", call, "overrun", overrun)
+}
+
+func (audit *contextAudit) updateMetricsUnprotected() {
+	audit.statusHandler.SetIntMetric(core.MetricNumContextDeadlineOverruns, audit.numOverruns)
+	audit.statusHandler.SetIntMetric(core.MetricMaxContextDeadlineOverrunInMillis, int(audit.maxOverrun.Milliseconds()))
+	audit.statusHandler.SetStringMetric(core.MetricLastContextDeadlineOverrun, audit.lastOverrunCall)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (audit *contextAudit) IsInterfaceNil() bool {
+	return audit == nil
+}
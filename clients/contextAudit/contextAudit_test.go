@@ -0,0 +1,105 @@
+package contextAudit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsContextAudit() ArgsContextAudit {
+	return ArgsContextAudit{
+		Log:              &testsCommon.LoggerStub{},
+		StatusHandler:    testsCommon.NewStatusHandlerMock(core.ContextAuditStatusHandlerName),
+		OverrunTolerance: time.Second,
+	}
+}
+
+func TestNewContextAudit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContextAudit()
+		args.Log = nil
+		audit, err := NewContextAudit(args)
+		assert.True(t, check.IfNil(audit))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContextAudit()
+		args.StatusHandler = nil
+		audit, err := NewContextAudit(args)
+		assert.True(t, check.IfNil(audit))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		audit, err := NewContextAudit(createMockArgsContextAudit())
+		assert.False(t, check.IfNil(audit))
+		assert.Nil(t, err)
+	})
+}
+
+func TestContextAudit_CheckCall(t *testing.T) {
+	t.Parallel()
+
+	deadline := time.Unix(1000, 0)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	t.Run("context without deadline should not be audited", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContextAudit()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.ContextAuditStatusHandlerName)
+		args.StatusHandler = statusHandler
+		audit, _ := NewContextAudit(args)
+
+		audit.CheckCall(context.Background(), "call")
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumContextDeadlineOverruns))
+	})
+	t.Run("call returned within the tolerance should not be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContextAudit()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.ContextAuditStatusHandlerName)
+		args.StatusHandler = statusHandler
+		audit, _ := NewContextAudit(args)
+		audit.getTime = func() time.Time {
+			return deadline.Add(time.Second)
+		}
+
+		audit.CheckCall(ctx, "call")
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumContextDeadlineOverruns))
+	})
+	t.Run("calls returned after the tolerance should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContextAudit()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.ContextAuditStatusHandlerName)
+		args.StatusHandler = statusHandler
+		audit, _ := NewContextAudit(args)
+
+		audit.getTime = func() time.Time {
+			return deadline.Add(time.Second * 5)
+		}
+		audit.CheckCall(ctx, "ethToMvx")
+		audit.getTime = func() time.Time {
+			return deadline.Add(time.Second * 3)
+		}
+		audit.CheckCall(ctx, "mvxToEth")
+
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumContextDeadlineOverruns))
+		assert.Equal(t, 5000, statusHandler.GetIntMetric(core.MetricMaxContextDeadlineOverrunInMillis))
+		assert.Equal(t, "mvxToEth", statusHandler.GetStringMetric(core.MetricLastContextDeadlineOverrun))
+	})
+}
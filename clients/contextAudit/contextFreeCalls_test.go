@@ -0,0 +1,118 @@
+package contextAudit
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const repositoryRoot = "../.."
+
+// skippedDirectories are not checked: the binaries create the root contexts of their commands and the test helpers
+// are not part of the relayer
+var skippedDirectories = map[string]struct{}{
+	"cmd":              {},
+	"integrationTests": {},
+	"testsCommon":      {},
+	"docker":           {},
+}
+
+// allowedContextFreeCalls lists, per file, the number of context.Background and context.TODO calls allowed. Each of
+// them either creates the root context of a go routine stopped by the component's Close, or bounds a request sent in
+// the background with its own timeout. Any other outbound call must use the context of its caller, i.e. of the state
+// machine step or of the component's loop, so it is interrupted by the step deadline and on shutdown
+var allowedContextFreeCalls = map[string]int{
+	"api/gin/httpServer.go":                                 1, // graceful shutdown of the http server
+	"api/gin/webServer.go":                                  1, // loop resetting the source limiters
+	"clients/gasManagement/gasStation.go":                   1, // gas price polling loop
+	"clients/multiversx/stuckTransactionsResender.go":       1, // stuck transactions resend loop
+	"clients/reloadablePolling/reloadablePollingHandler.go": 1, // processing loop
+	"errorReporting/sentryReporter.go":                      1, // errors sent in the background
+	"executors/multiversx/webhook/webhookNotifier.go":       1, // notifications sent in the background
+	"factory/ethMultiversXBridgeComponents.go":              2, // join topic retries loop, p2p antiflood components
+	"p2p/signaturesVerifier.go":                             1, // signatures verification workers
+	"retention/retentionStorer.go":                          1, // retention pruning loop
+	"status/asyncStorer.go":                                 1, // status metrics write loop
+}
+
+func TestContextFreeCalls(t *testing.T) {
+	t.Parallel()
+
+	found := make(map[string]int)
+	err := filepath.WalkDir(repositoryRoot, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(repositoryRoot, path)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		if entry.IsDir() {
+			_, isSkipped := skippedDirectories[relativePath]
+			if isSkipped || strings.HasPrefix(entry.Name(), ".") && relativePath != "." {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		numCalls, err := countContextFreeCalls(path)
+		if err != nil {
+			return err
+		}
+		if numCalls > 0 {
+			found[relativePath] = numCalls
+		}
+
+		return nil
+	})
+	require.Nil(t, err)
+
+	for file, numCalls := range found {
+		assert.LessOrEqual(t, numCalls, allowedContextFreeCalls[file],
+			"%s creates contexts unrelated to its caller, the outbound calls must use the caller's context", file)
+	}
+}
+
+func countContextFreeCalls(path string) (int, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	numCalls := 0
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, isCall := node.(*ast.CallExpr)
+		if !isCall {
+			return true
+		}
+		selector, isSelector := call.Fun.(*ast.SelectorExpr)
+		if !isSelector {
+			return true
+		}
+		pkg, isIdent := selector.X.(*ast.Ident)
+		if !isIdent || pkg.Name != "context" {
+			return true
+		}
+		if selector.Sel.Name == "Background" || selector.Sel.Name == "TODO" {
+			numCalls++
+		}
+
+		return true
+	})
+
+	return numCalls, nil
+}
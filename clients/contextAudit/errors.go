@@ -0,0 +1,21 @@
+package contextAudit
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilExecutor signals that a nil executor has been provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrNilAuditor signals that a nil auditor has been provided
+var ErrNilAuditor = errors.New("nil auditor")
+
+// ErrEmptyName signals that an empty name has been provided
+var ErrEmptyName = errors.New("empty name")
+
+// ErrInvalidStepTimeout signals that an invalid step timeout has been provided
+var ErrInvalidStepTimeout = errors.New("invalid step timeout")
@@ -0,0 +1,15 @@
+package contextAudit
+
+import "context"
+
+// Executor defines the component executing a step with the provided context
+type Executor interface {
+	Execute(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+// Auditor defines the component checking if a call returned later than the deadline of its context
+type Auditor interface {
+	CheckCall(ctx context.Context, call string)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,436 @@
+package deadLetters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	deadLettersFilePermissions = 0644
+	deadLettersDirPermissions  = 0755
+)
+
+// ArgsDeadLetters is the DTO used to create a new instance of type deadLetters
+type ArgsDeadLetters struct {
+	Log                  logger.Logger
+	StatusHandler        core.StatusHandler
+	FilePath             string
+	MaxFailures          uint64
+	MaxDeadLetters       int
+	RefundableDirections []string
+}
+
+type depositKey struct {
+	direction    string
+	depositNonce uint64
+}
+
+type failuresCounter struct {
+	numFailures   uint64
+	firstFailedAt int64
+}
+
+type deadLetters struct {
+	log                  logger.Logger
+	statusHandler        core.StatusHandler
+	filePath             string
+	maxFailures          uint64
+	maxDeadLetters       int
+	refundableDirections map[string]struct{}
+	getTime              func() int64
+
+	mut         sync.RWMutex
+	deadLetters []*core.DeadLetter
+	failures    map[depositKey]*failuresCounter
+}
+
+// NewDeadLetters creates the store of the deposits that repeatedly failed to be validated or executed. The store is
+// loaded from the provided file, so the resolutions taken before a restart are not lost
+func NewDeadLetters(args ArgsDeadLetters) (*deadLetters, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if args.MaxFailures < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxFailures, args.MaxFailures)
+	}
+	if args.MaxDeadLetters < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxDeadLetters, args.MaxDeadLetters)
+	}
+
+	store := &deadLetters{
+		log:                  args.Log,
+		statusHandler:        args.StatusHandler,
+		filePath:             args.FilePath,
+		maxFailures:          args.MaxFailures,
+		maxDeadLetters:       args.MaxDeadLetters,
+		refundableDirections: make(map[string]struct{}),
+		failures:             make(map[depositKey]*failuresCounter),
+		getTime: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+	for _, direction := range args.RefundableDirections {
+		store.refundableDirections[direction] = struct{}{}
+	}
+
+	// a corrupted store is not discarded, otherwise the operators' resolutions would be silently lost
+	err := store.load()
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the dead letters store %s", err, args.FilePath)
+	}
+	store.updateMetricsUnprotected()
+
+	return store, nil
+}
+
+func (store *deadLetters) load() error {
+	buff, err := os.ReadFile(store.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		store.deadLetters = make([]*core.DeadLetter, 0)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deadLetters := make([]*core.DeadLetter, 0)
+	err = json.Unmarshal(buff, &deadLetters)
+	if err != nil {
+		return err
+	}
+	store.deadLetters = deadLetters
+
+	return nil
+}
+
+// RecordFailure counts a failed validation or execution of the provided batch for each of its deposits. A deposit
+// becomes a dead letter once it reaches the maximum number of failures. The deposits already resolved as refunded or
+// ignored are not counted anymore
+func (store *deadLetters) RecordFailure(direction string, batch *core.TransferBatch, reason string) {
+	if batch == nil {
+		return
+	}
+
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	now := store.getTime()
+	changed := false
+	for _, deposit := range batch.Deposits {
+		key := depositKey{
+			direction:    direction,
+			depositNonce: deposit.Nonce,
+		}
+
+		deadLetter := store.getDeadLetterUnprotected(key)
+		if deadLetter != nil {
+			if deadLetter.Resolution == nil {
+				deadLetter.Reason = reason
+				deadLetter.NumFailures++
+				deadLetter.LastFailedAt = now
+				changed = true
+			}
+			continue
+		}
+
+		counter, found := store.failures[key]
+		if !found {
+			counter = &failuresCounter{
+				firstFailedAt: now,
+			}
+			store.failures[key] = counter
+		}
+		counter.numFailures++
+		if counter.numFailures < store.maxFailures {
+			continue
+		}
+
+		delete(store.failures, key)
+		store.deadLetters = append(store.deadLetters, createDeadLetter(direction, batch.ID, deposit, reason, counter, now))
+		store.trimUnprotected()
+		changed = true
+
+		store.log.Warn("deposit moved to the dead letters store, waiting for an operator's resolution",
+			"direction", direction, "batch ID", batch.ID, "deposit nonce", deposit.Nonce,
+			"token", deposit.DisplayableToken, "recipient", deposit.DisplayableTo, "reason", reason)
+	}
+	if !changed {
+		return
+	}
+
+	store.updateMetricsUnprotected()
+	err := store.saveUnprotected()
+	if err != nil {
+		store.log.Error("unable to save the dead letters store", "file", store.filePath, "error", err)
+	}
+}
+
+func createDeadLetter(
+	direction string,
+	batchID uint64,
+	deposit *core.DepositTransfer,
+	reason string,
+	counter *failuresCounter,
+	now int64,
+) *core.DeadLetter {
+	amount := ""
+	if deposit.Amount != nil {
+		amount = deposit.Amount.String()
+	}
+
+	return &core.DeadLetter{
+		Direction:     direction,
+		BatchID:       batchID,
+		DepositNonce:  deposit.Nonce,
+		Token:         deposit.DisplayableToken,
+		Recipient:     deposit.DisplayableTo,
+		Amount:        amount,
+		Reason:        reason,
+		NumFailures:   counter.numFailures,
+		FirstFailedAt: counter.firstFailedAt,
+		LastFailedAt:  now,
+	}
+}
+
+// RecordSuccess clears the failures of the deposits of the provided batch, as the batch was processed. The dead
+// letters of these deposits that were not resolved are removed
+func (store *deadLetters) RecordSuccess(direction string, batch *core.TransferBatch) {
+	if batch == nil {
+		return
+	}
+
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	processedDeposits := make(map[depositKey]struct{}, len(batch.Deposits))
+	for _, deposit := range batch.Deposits {
+		key := depositKey{
+			direction:    direction,
+			depositNonce: deposit.Nonce,
+		}
+		processedDeposits[key] = struct{}{}
+		delete(store.failures, key)
+	}
+
+	deadLetters := make([]*core.DeadLetter, 0, len(store.deadLetters))
+	for _, deadLetter := range store.deadLetters {
+		_, isProcessed := processedDeposits[createKey(deadLetter)]
+		if isProcessed && deadLetter.Resolution == nil {
+			continue
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	if len(deadLetters) == len(store.deadLetters) {
+		return
+	}
+	store.deadLetters = deadLetters
+
+	store.updateMetricsUnprotected()
+	err := store.saveUnprotected()
+	if err != nil {
+		store.log.Error("unable to save the dead letters store", "file", store.filePath, "error", err)
+	}
+}
+
+// IsRefunded returns true if an operator resolved the provided deposit as refunded
+func (store *deadLetters) IsRefunded(direction string, depositNonce uint64) bool {
+	store.mut.RLock()
+	defer store.mut.RUnlock()
+
+	deadLetter := store.getDeadLetterUnprotected(depositKey{
+		direction:    direction,
+		depositNonce: depositNonce,
+	})
+	if deadLetter == nil || deadLetter.Resolution == nil {
+		return false
+	}
+
+	return deadLetter.Resolution.Action == core.DeadLetterRefund
+}
+
+// ResolveDeadLetter records the operator's resolution of the provided dead letter. A retried dead letter is removed
+// from the store and its failures are counted again from zero. The resolution timestamp is set by the store
+func (store *deadLetters) ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+	resolution.Operator = strings.TrimSpace(resolution.Operator)
+	if len(resolution.Operator) == 0 {
+		return nil, ErrEmptyOperator
+	}
+
+	switch resolution.Action {
+	case core.DeadLetterRetry, core.DeadLetterIgnore:
+	case core.DeadLetterRefund:
+		_, isRefundable := store.refundableDirections[direction]
+		if !isRefundable {
+			return nil, fmt.Errorf("%w for the %s direction", ErrRefundNotSupported, direction)
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidResolutionAction, resolution.Action)
+	}
+
+	store.mut.Lock()
+	defer store.mut.Unlock()
+
+	key := depositKey{
+		direction:    direction,
+		depositNonce: depositNonce,
+	}
+	deadLetter := store.getDeadLetterUnprotected(key)
+	if deadLetter == nil {
+		return nil, fmt.Errorf("%w: direction %s, deposit nonce %d", ErrDeadLetterNotFound, direction, depositNonce)
+	}
+	if deadLetter.Resolution != nil {
+		return nil, fmt.Errorf("%w: direction %s, deposit nonce %d, action %s by %s", ErrDeadLetterAlreadyResolved,
+			direction, depositNonce, deadLetter.Resolution.Action, deadLetter.Resolution.Operator)
+	}
+
+	resolution.Timestamp = store.getTime()
+	deadLetter.Resolution = &resolution
+
+	deadLetters := store.deadLetters
+	if resolution.Action == core.DeadLetterRetry {
+		store.removeUnprotected(key)
+	}
+
+	// the resolution is reverted if it can not be persisted, so it can not be lost on a restart
+	err := store.saveUnprotected()
+	if err != nil {
+		deadLetter.Resolution = nil
+		store.deadLetters = deadLetters
+		return nil, err
+	}
+	store.updateMetricsUnprotected()
+
+	store.log.Info("dead letter resolved", "direction", direction, "batch ID", deadLetter.BatchID,
+		"deposit nonce", depositNonce, "action", resolution.Action, "operator", resolution.Operator,
+		"remote address", resolution.RemoteAddress)
+
+	return copyDeadLetter(deadLetter), nil
+}
+
+func (store *deadLetters) getDeadLetterUnprotected(key depositKey) *core.DeadLetter {
+	for _, deadLetter := range store.deadLetters {
+		if createKey(deadLetter) == key {
+			return deadLetter
+		}
+	}
+
+	return nil
+}
+
+func (store *deadLetters) removeUnprotected(key depositKey) {
+	deadLetters := make([]*core.DeadLetter, 0, len(store.deadLetters))
+	for _, deadLetter := range store.deadLetters {
+		if createKey(deadLetter) != key {
+			deadLetters = append(deadLetters, deadLetter)
+		}
+	}
+	store.deadLetters = deadLetters
+}
+
+func createKey(deadLetter *core.DeadLetter) depositKey {
+	return depositKey{
+		direction:    deadLetter.Direction,
+		depositNonce: deadLetter.DepositNonce,
+	}
+}
+
+// trimUnprotected removes the oldest resolved dead letters above the maximum number of dead letters. The dead letters
+// not yet resolved are never removed. The mutex should be held
+func (store *deadLetters) trimUnprotected() {
+	numToRemove := len(store.deadLetters) - store.maxDeadLetters
+	if numToRemove <= 0 {
+		return
+	}
+
+	deadLetters := make([]*core.DeadLetter, 0, len(store.deadLetters))
+	for _, deadLetter := range store.deadLetters {
+		if numToRemove > 0 && deadLetter.Resolution != nil {
+			numToRemove--
+			continue
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+	store.deadLetters = deadLetters
+}
+
+func (store *deadLetters) updateMetricsUnprotected() {
+	depth := 0
+	numRefunded := 0
+	for _, deadLetter := range store.deadLetters {
+		if deadLetter.Resolution == nil {
+			depth++
+			continue
+		}
+		if deadLetter.Resolution.Action == core.DeadLetterRefund {
+			numRefunded++
+		}
+	}
+
+	store.statusHandler.SetIntMetric(core.MetricDeadLettersDepth, depth)
+	store.statusHandler.SetIntMetric(core.MetricDeadLettersNumRefunded, numRefunded)
+}
+
+func (store *deadLetters) saveUnprotected() error {
+	buff, err := json.MarshalIndent(store.deadLetters, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(store.filePath), deadLettersDirPermissions)
+	if err != nil {
+		return err
+	}
+
+	// the file is replaced atomically so a crash while saving does not lose the dead letters store
+	tempFilePath := store.filePath + ".tmp"
+	err = os.WriteFile(tempFilePath, buff, deadLettersFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, store.filePath)
+}
+
+// GetDeadLetters returns the dead letters, the oldest one first
+func (store *deadLetters) GetDeadLetters() []*core.DeadLetter {
+	store.mut.RLock()
+	defer store.mut.RUnlock()
+
+	deadLetters := make([]*core.DeadLetter, 0, len(store.deadLetters))
+	for _, deadLetter := range store.deadLetters {
+		deadLetters = append(deadLetters, copyDeadLetter(deadLetter))
+	}
+
+	return deadLetters
+}
+
+func copyDeadLetter(deadLetter *core.DeadLetter) *core.DeadLetter {
+	deadLetterCopy := *deadLetter
+	if deadLetter.Resolution != nil {
+		resolutionCopy := *deadLetter.Resolution
+		deadLetterCopy.Resolution = &resolutionCopy
+	}
+
+	return &deadLetterCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (store *deadLetters) IsInterfaceNil() bool {
+	return store == nil
+}
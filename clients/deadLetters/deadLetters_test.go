@@ -0,0 +1,353 @@
+package deadLetters
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	mvxToEthDirection = "MultiversXToEthereum"
+	ethToMvxDirection = "EthereumToMultiversX"
+)
+
+func createMockArgsDeadLetters(tb testing.TB) ArgsDeadLetters {
+	return ArgsDeadLetters{
+		Log:                  &testsCommon.LoggerStub{},
+		StatusHandler:        testsCommon.NewStatusHandlerMock("mock"),
+		FilePath:             filepath.Join(tb.TempDir(), "deadLetters", "deadLetters.json"),
+		MaxFailures:          2,
+		MaxDeadLetters:       10,
+		RefundableDirections: []string{mvxToEthDirection},
+	}
+}
+
+func createStoreWithTime(tb testing.TB, args ArgsDeadLetters, currentTime *int64) *deadLetters {
+	store, err := NewDeadLetters(args)
+	require.Nil(tb, err)
+	store.getTime = func() int64 {
+		return *currentTime
+	}
+
+	return store
+}
+
+func createBatch(id uint64, nonces ...uint64) *core.TransferBatch {
+	batch := &core.TransferBatch{
+		ID: id,
+	}
+	for _, nonce := range nonces {
+		batch.Deposits = append(batch.Deposits, &core.DepositTransfer{
+			Nonce:            nonce,
+			DisplayableTo:    "recipient",
+			DisplayableToken: "token",
+			Amount:           big.NewInt(int64(nonce * 100)),
+		})
+	}
+
+	return batch
+}
+
+func createResolution(action string) core.DeadLetterResolution {
+	return core.DeadLetterResolution{
+		Action:        action,
+		Operator:      "alice",
+		RemoteAddress: "10.0.0.1",
+		Comment:       "checked",
+	}
+}
+
+func TestNewDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDeadLetters(t)
+		args.Log = nil
+
+		store, err := NewDeadLetters(args)
+		assert.True(t, check.IfNil(store))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDeadLetters(t)
+		args.StatusHandler = nil
+
+		store, err := NewDeadLetters(args)
+		assert.True(t, check.IfNil(store))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDeadLetters(t)
+		args.FilePath = ""
+
+		store, err := NewDeadLetters(args)
+		assert.True(t, check.IfNil(store))
+		assert.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("invalid maximum number of failures should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDeadLetters(t)
+		args.MaxFailures = 0
+
+		store, err := NewDeadLetters(args)
+		assert.True(t, check.IfNil(store))
+		assert.True(t, errors.Is(err, ErrInvalidMaxFailures))
+	})
+	t.Run("invalid maximum number of dead letters should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDeadLetters(t)
+		args.MaxDeadLetters = 0
+
+		store, err := NewDeadLetters(args)
+		assert.True(t, check.IfNil(store))
+		assert.True(t, errors.Is(err, ErrInvalidMaxDeadLetters))
+	})
+	t.Run("corrupted dead letters store should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDeadLetters(t)
+		args.FilePath = filepath.Join(t.TempDir(), "deadLetters.json")
+		err := os.WriteFile(args.FilePath, []byte("not a json"), deadLettersFilePermissions)
+		require.Nil(t, err)
+
+		store, err := NewDeadLetters(args)
+		assert.True(t, check.IfNil(store))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		store, err := NewDeadLetters(createMockArgsDeadLetters(t))
+		assert.False(t, check.IfNil(store))
+		assert.Nil(t, err)
+		assert.Empty(t, store.GetDeadLetters())
+	})
+}
+
+func TestDeadLetters_RecordFailure(t *testing.T) {
+	t.Parallel()
+
+	currentTime := int64(1700000000)
+	args := createMockArgsDeadLetters(t)
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	store := createStoreWithTime(t, args, &currentTime)
+
+	store.RecordFailure(mvxToEthDirection, nil, "reason")
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "unmapped token")
+	assert.Empty(t, store.GetDeadLetters())
+
+	currentTime += 10
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "invalid recipient")
+	deadLetters := store.GetDeadLetters()
+	require.Equal(t, 2, len(deadLetters))
+	expectedDeadLetter := &core.DeadLetter{
+		Direction:     mvxToEthDirection,
+		BatchID:       1,
+		DepositNonce:  5,
+		Token:         "token",
+		Recipient:     "recipient",
+		Amount:        "500",
+		Reason:        "invalid recipient",
+		NumFailures:   2,
+		FirstFailedAt: 1700000000,
+		LastFailedAt:  1700000010,
+	}
+	assert.Equal(t, expectedDeadLetter, deadLetters[0])
+	assert.Equal(t, uint64(6), deadLetters[1].DepositNonce)
+	assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricDeadLettersDepth))
+
+	currentTime += 10
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "execution failed")
+	deadLetters = store.GetDeadLetters()
+	assert.Equal(t, uint64(3), deadLetters[0].NumFailures)
+	assert.Equal(t, int64(1700000020), deadLetters[0].LastFailedAt)
+	assert.Equal(t, "execution failed", deadLetters[0].Reason)
+
+	store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "other direction")
+	assert.Equal(t, 2, len(store.GetDeadLetters()))
+}
+
+func TestDeadLetters_RecordSuccess(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDeadLetters(t)
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	store, _ := NewDeadLetters(args)
+
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "reason")
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "reason")
+	store.RecordFailure(mvxToEthDirection, createBatch(2, 7), "reason")
+	_, err := store.ResolveDeadLetter(mvxToEthDirection, 6, createResolution(core.DeadLetterRefund))
+	require.Nil(t, err)
+
+	store.RecordSuccess(mvxToEthDirection, nil)
+	store.RecordSuccess(mvxToEthDirection, createBatch(1, 5, 6))
+	deadLetters := store.GetDeadLetters()
+	require.Equal(t, 1, len(deadLetters))
+	assert.Equal(t, uint64(6), deadLetters[0].DepositNonce)
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricDeadLettersDepth))
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricDeadLettersNumRefunded))
+
+	// the failures counted before the success are cleared
+	store.RecordSuccess(mvxToEthDirection, createBatch(2, 7))
+	store.RecordFailure(mvxToEthDirection, createBatch(2, 7), "reason")
+	assert.Equal(t, 1, len(store.GetDeadLetters()))
+}
+
+func TestDeadLetters_ResolveDeadLetter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid resolutions should error", func(t *testing.T) {
+		t.Parallel()
+
+		store, _ := NewDeadLetters(createMockArgsDeadLetters(t))
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+
+		resolution := createResolution(core.DeadLetterIgnore)
+		resolution.Operator = " "
+		_, err := store.ResolveDeadLetter(ethToMvxDirection, 5, resolution)
+		assert.Equal(t, ErrEmptyOperator, err)
+
+		_, err = store.ResolveDeadLetter(ethToMvxDirection, 5, createResolution("unknown"))
+		assert.True(t, errors.Is(err, ErrInvalidResolutionAction))
+
+		_, err = store.ResolveDeadLetter(ethToMvxDirection, 5, createResolution(core.DeadLetterRefund))
+		assert.True(t, errors.Is(err, ErrRefundNotSupported))
+
+		_, err = store.ResolveDeadLetter(ethToMvxDirection, 6, createResolution(core.DeadLetterIgnore))
+		assert.True(t, errors.Is(err, ErrDeadLetterNotFound))
+	})
+	t.Run("refund should work", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1700000000)
+		store := createStoreWithTime(t, createMockArgsDeadLetters(t), &currentTime)
+		store.RecordFailure(mvxToEthDirection, createBatch(1, 5), "reason")
+		store.RecordFailure(mvxToEthDirection, createBatch(1, 5), "reason")
+		assert.False(t, store.IsRefunded(mvxToEthDirection, 5))
+
+		currentTime += 10
+		deadLetter, err := store.ResolveDeadLetter(mvxToEthDirection, 5, createResolution(core.DeadLetterRefund))
+		require.Nil(t, err)
+		expectedResolution := &core.DeadLetterResolution{
+			Action:        core.DeadLetterRefund,
+			Operator:      "alice",
+			RemoteAddress: "10.0.0.1",
+			Comment:       "checked",
+			Timestamp:     1700000010,
+		}
+		assert.Equal(t, expectedResolution, deadLetter.Resolution)
+		assert.True(t, store.IsRefunded(mvxToEthDirection, 5))
+		assert.False(t, store.IsRefunded(ethToMvxDirection, 5))
+
+		_, err = store.ResolveDeadLetter(mvxToEthDirection, 5, createResolution(core.DeadLetterIgnore))
+		assert.True(t, errors.Is(err, ErrDeadLetterAlreadyResolved))
+
+		// the failures of a resolved deposit are not counted anymore
+		store.RecordFailure(mvxToEthDirection, createBatch(1, 5), "reason")
+		assert.Equal(t, uint64(2), store.GetDeadLetters()[0].NumFailures)
+	})
+	t.Run("ignore should work", func(t *testing.T) {
+		t.Parallel()
+
+		store, _ := NewDeadLetters(createMockArgsDeadLetters(t))
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+
+		deadLetter, err := store.ResolveDeadLetter(ethToMvxDirection, 5, createResolution(core.DeadLetterIgnore))
+		require.Nil(t, err)
+		assert.Equal(t, core.DeadLetterIgnore, deadLetter.Resolution.Action)
+		assert.False(t, store.IsRefunded(ethToMvxDirection, 5))
+		assert.Equal(t, 1, len(store.GetDeadLetters()))
+	})
+	t.Run("retry should count the failures again", func(t *testing.T) {
+		t.Parallel()
+
+		store, _ := NewDeadLetters(createMockArgsDeadLetters(t))
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+
+		deadLetter, err := store.ResolveDeadLetter(ethToMvxDirection, 5, createResolution(core.DeadLetterRetry))
+		require.Nil(t, err)
+		assert.Equal(t, core.DeadLetterRetry, deadLetter.Resolution.Action)
+		assert.Empty(t, store.GetDeadLetters())
+
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+		assert.Empty(t, store.GetDeadLetters())
+		store.RecordFailure(ethToMvxDirection, createBatch(1, 5), "reason")
+		assert.Equal(t, 1, len(store.GetDeadLetters()))
+	})
+}
+
+func TestDeadLetters_ReturnedDeadLettersAreCopies(t *testing.T) {
+	t.Parallel()
+
+	store, _ := NewDeadLetters(createMockArgsDeadLetters(t))
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5), "reason")
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5), "reason")
+	deadLetter, _ := store.ResolveDeadLetter(mvxToEthDirection, 5, createResolution(core.DeadLetterRefund))
+
+	deadLetter.Resolution.Action = core.DeadLetterIgnore
+	store.GetDeadLetters()[0].Resolution = nil
+
+	assert.True(t, store.IsRefunded(mvxToEthDirection, 5))
+}
+
+func TestDeadLetters_ShouldPersistTheResolutions(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDeadLetters(t)
+	store, _ := NewDeadLetters(args)
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "reason")
+	store.RecordFailure(mvxToEthDirection, createBatch(1, 5, 6), "reason")
+	_, err := store.ResolveDeadLetter(mvxToEthDirection, 5, createResolution(core.DeadLetterRefund))
+	require.Nil(t, err)
+
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	reloadedStore, err := NewDeadLetters(args)
+	require.Nil(t, err)
+	assert.True(t, reloadedStore.IsRefunded(mvxToEthDirection, 5))
+	assert.Equal(t, store.GetDeadLetters(), reloadedStore.GetDeadLetters())
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricDeadLettersDepth))
+}
+
+func TestDeadLetters_ShouldOnlyTrimTheResolvedDeadLetters(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsDeadLetters(t)
+	args.MaxFailures = 1
+	args.MaxDeadLetters = 2
+	store, _ := NewDeadLetters(args)
+
+	store.RecordFailure(ethToMvxDirection, createBatch(1, 1, 2, 3), "reason")
+	assert.Equal(t, 3, len(store.GetDeadLetters()))
+
+	_, _ = store.ResolveDeadLetter(ethToMvxDirection, 2, createResolution(core.DeadLetterIgnore))
+	store.RecordFailure(ethToMvxDirection, createBatch(2, 4), "reason")
+
+	deadLetters := store.GetDeadLetters()
+	require.Equal(t, 3, len(deadLetters))
+	assert.Equal(t, uint64(1), deadLetters[0].DepositNonce)
+	assert.Equal(t, uint64(3), deadLetters[1].DepositNonce)
+	assert.Equal(t, uint64(4), deadLetters[2].DepositNonce)
+}
@@ -0,0 +1,33 @@
+package deadLetters
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrInvalidMaxFailures signals that an invalid maximum number of failures has been provided
+var ErrInvalidMaxFailures = errors.New("invalid maximum number of failures")
+
+// ErrInvalidMaxDeadLetters signals that an invalid maximum number of dead letters has been provided
+var ErrInvalidMaxDeadLetters = errors.New("invalid maximum number of dead letters")
+
+// ErrDeadLetterNotFound signals that the dead letter to resolve was not found
+var ErrDeadLetterNotFound = errors.New("dead letter not found")
+
+// ErrDeadLetterAlreadyResolved signals that the dead letter was already resolved
+var ErrDeadLetterAlreadyResolved = errors.New("dead letter already resolved")
+
+// ErrInvalidResolutionAction signals that an unknown resolution action has been provided
+var ErrInvalidResolutionAction = errors.New("invalid resolution action")
+
+// ErrRefundNotSupported signals that the deposits of the provided direction can not be refunded by the relayers
+var ErrRefundNotSupported = errors.New("refund not supported")
+
+// ErrEmptyOperator signals that a resolution without the operator identity has been provided
+var ErrEmptyOperator = errors.New("empty operator")
@@ -0,0 +1,170 @@
+package dependenciesWaiter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsDependenciesWaiter represents the DTO struct used in the NewDependenciesWaiter constructor function
+type ArgsDependenciesWaiter struct {
+	Log             logger.Logger
+	EthereumClient  EthereumClient
+	MultiversXProxy MultiversXProxy
+	InitialBackoff  time.Duration
+	MaxBackoff      time.Duration
+	MaxWait         time.Duration
+	RequestTimeout  time.Duration
+}
+
+type dependency struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+type dependenciesWaiter struct {
+	log            logger.Logger
+	dependencies   []*dependency
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	maxWait        time.Duration
+	requestTimeout time.Duration
+}
+
+// NewDependenciesWaiter creates a component that waits, at startup, for the Ethereum RPC node and the MultiversX proxy
+// to become reachable, so the relayer survives the dependencies being started after it
+func NewDependenciesWaiter(args ArgsDependenciesWaiter) (*dependenciesWaiter, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	waiter := &dependenciesWaiter{
+		log:            args.Log,
+		initialBackoff: args.InitialBackoff,
+		maxBackoff:     args.MaxBackoff,
+		maxWait:        args.MaxWait,
+		requestTimeout: args.RequestTimeout,
+	}
+	waiter.dependencies = []*dependency{
+		{
+			name: "Ethereum RPC",
+			check: func(ctx context.Context) error {
+				_, errCheck := args.EthereumClient.ChainID(ctx)
+				return errCheck
+			},
+		},
+		{
+			name: "MultiversX proxy",
+			check: func(ctx context.Context) error {
+				_, errCheck := args.MultiversXProxy.GetNetworkConfig(ctx)
+				return errCheck
+			},
+		},
+	}
+
+	return waiter, nil
+}
+
+func checkArgs(args ArgsDependenciesWaiter) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNilReflect(args.EthereumClient) {
+		return ErrNilEthereumClient
+	}
+	if check.IfNil(args.MultiversXProxy) {
+		return ErrNilMultiversXProxy
+	}
+	if args.InitialBackoff <= 0 {
+		return fmt.Errorf("%w for InitialBackoff: %v", ErrInvalidValue, args.InitialBackoff)
+	}
+	if args.MaxBackoff < args.InitialBackoff {
+		return fmt.Errorf("%w for MaxBackoff: %v, it should be at least the InitialBackoff %v",
+			ErrInvalidValue, args.MaxBackoff, args.InitialBackoff)
+	}
+	if args.MaxWait <= 0 {
+		return fmt.Errorf("%w for MaxWait: %v", ErrInvalidValue, args.MaxWait)
+	}
+	if args.RequestTimeout <= 0 {
+		return fmt.Errorf("%w for RequestTimeout: %v", ErrInvalidValue, args.RequestTimeout)
+	}
+
+	return nil
+}
+
+// WaitForDependencies blocks until all the dependencies were reachable at least once. The unreachable dependencies are
+// checked again with an exponential backoff, capped to the maximum backoff, and an error naming them is returned if
+// they are still unreachable after the maximum wait
+func (waiter *dependenciesWaiter) WaitForDependencies(ctx context.Context) error {
+	start := time.Now()
+	pending := waiter.dependencies
+	backoff := waiter.initialBackoff
+	for attempt := 1; ; attempt++ {
+		var errs []string
+		pending, errs = waiter.checkDependencies(ctx, pending)
+		if len(pending) == 0 {
+			if attempt > 1 {
+				waiter.log.Info("dependenciesWaiter: all the dependencies are reachable",
+					"attempts", attempt, "waited", time.Since(start).Truncate(time.Millisecond))
+			}
+			return nil
+		}
+
+		remaining := waiter.maxWait - time.Since(start)
+		if remaining <= 0 {
+			return fmt.Errorf("%w after %v: %s", ErrDependenciesUnreachable, waiter.maxWait, strings.Join(errs, ", "))
+		}
+		if backoff > remaining {
+			backoff = remaining
+		}
+
+		waiter.log.Warn("dependenciesWaiter: dependencies not reachable yet, retrying",
+			"attempt", attempt, "retry in", backoff, "errors", strings.Join(errs, ", "))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > waiter.maxBackoff {
+			backoff = waiter.maxBackoff
+		}
+	}
+}
+
+// checkDependencies returns the dependencies that are still unreachable along with their errors
+func (waiter *dependenciesWaiter) checkDependencies(ctx context.Context, dependencies []*dependency) ([]*dependency, []string) {
+	unreachable := make([]*dependency, 0, len(dependencies))
+	errs := make([]string, 0, len(dependencies))
+	for _, dep := range dependencies {
+		err := waiter.checkDependency(ctx, dep)
+		if err != nil {
+			unreachable = append(unreachable, dep)
+			errs = append(errs, fmt.Sprintf("%s: %s", dep.name, err.Error()))
+			continue
+		}
+
+		waiter.log.Debug("dependenciesWaiter: dependency reachable", "dependency", dep.name)
+	}
+
+	return unreachable, errs
+}
+
+func (waiter *dependenciesWaiter) checkDependency(ctx context.Context, dep *dependency) error {
+	ctxCheck, cancel := context.WithTimeout(ctx, waiter.requestTimeout)
+	defer cancel()
+
+	return dep.check(ctxCheck)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (waiter *dependenciesWaiter) IsInterfaceNil() bool {
+	return waiter == nil
+}
@@ -0,0 +1,193 @@
+package dependenciesWaiter
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsDependenciesWaiter() ArgsDependenciesWaiter {
+	return ArgsDependenciesWaiter{
+		Log: &testsCommon.LoggerStub{},
+		EthereumClient: &bridgeTests.EthereumBackendStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		},
+		MultiversXProxy: &interactors.ProxyStub{},
+		InitialBackoff:  time.Millisecond * 10,
+		MaxBackoff:      time.Millisecond * 40,
+		MaxWait:         time.Second,
+		RequestTimeout:  time.Second,
+	}
+}
+
+func TestNewDependenciesWaiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.Log = nil
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.EthereumClient = nil
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("nil MultiversX proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.MultiversXProxy = nil
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.Equal(t, ErrNilMultiversXProxy, err)
+	})
+	t.Run("invalid initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.InitialBackoff = 0
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "InitialBackoff")
+	})
+	t.Run("maximum backoff lower than the initial backoff should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.MaxBackoff = args.InitialBackoff - 1
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "MaxBackoff")
+	})
+	t.Run("invalid maximum wait should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.MaxWait = 0
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "MaxWait")
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.RequestTimeout = 0
+		waiter, err := NewDependenciesWaiter(args)
+		assert.True(t, check.IfNil(waiter))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "RequestTimeout")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		waiter, err := NewDependenciesWaiter(createMockArgsDependenciesWaiter())
+		assert.False(t, check.IfNil(waiter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestDependenciesWaiter_WaitForDependencies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reachable dependencies should return immediately", func(t *testing.T) {
+		t.Parallel()
+
+		waiter, _ := NewDependenciesWaiter(createMockArgsDependenciesWaiter())
+
+		err := waiter.WaitForDependencies(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("should wait for the dependencies started later", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		numEthereumChecks := uint32(0)
+		args.EthereumClient = &bridgeTests.EthereumBackendStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				if atomic.AddUint32(&numEthereumChecks, 1) < 3 {
+					return nil, errors.New("connection refused")
+				}
+				return big.NewInt(1), nil
+			},
+		}
+		numProxyChecks := uint32(0)
+		args.MultiversXProxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				if atomic.AddUint32(&numProxyChecks, 1) < 2 {
+					return nil, errors.New("connection refused")
+				}
+				return &data.NetworkConfig{}, nil
+			},
+		}
+		waiter, _ := NewDependenciesWaiter(args)
+
+		err := waiter.WaitForDependencies(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numEthereumChecks))
+		// a reachable dependency is not checked again
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numProxyChecks))
+	})
+	t.Run("unreachable dependency after the maximum wait should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.MaxWait = time.Millisecond * 100
+		args.MultiversXProxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return nil, errors.New("connection refused")
+			},
+		}
+		waiter, _ := NewDependenciesWaiter(args)
+
+		start := time.Now()
+		err := waiter.WaitForDependencies(context.Background())
+		assert.True(t, errors.Is(err, ErrDependenciesUnreachable))
+		assert.True(t, strings.Contains(err.Error(), "MultiversX proxy: connection refused"))
+		assert.False(t, strings.Contains(err.Error(), "Ethereum RPC"))
+		assert.True(t, time.Since(start) >= args.MaxWait)
+	})
+	t.Run("context done should stop waiting", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDependenciesWaiter()
+		args.MaxWait = time.Minute
+		args.EthereumClient = &bridgeTests.EthereumBackendStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, errors.New("connection refused")
+			},
+		}
+		waiter, _ := NewDependenciesWaiter(args)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+
+		err := waiter.WaitForDependencies(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+}
@@ -0,0 +1,18 @@
+package dependenciesWaiter
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilEthereumClient signals that a nil Ethereum client has been provided
+var ErrNilEthereumClient = errors.New("nil Ethereum client")
+
+// ErrNilMultiversXProxy signals that a nil MultiversX proxy has been provided
+var ErrNilMultiversXProxy = errors.New("nil MultiversX proxy")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrDependenciesUnreachable signals that the dependencies were not reachable in the maximum wait
+var ErrDependenciesUnreachable = errors.New("dependencies unreachable")
@@ -0,0 +1,19 @@
+package dependenciesWaiter
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// EthereumClient defines the Ethereum RPC operation used to check that the node is reachable
+type EthereumClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// MultiversXProxy defines the MultiversX proxy operation used to check that the proxy is reachable
+type MultiversXProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,250 @@
+package directMessages
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// MaxTextLength is the maximum length of a direct message text, so the signed payload stays below the maximum size
+// accepted by the relayers
+const MaxTextLength = 512
+
+// ArgsDirectMessages is the DTO used to create a new instance of type directMessages
+type ArgsDirectMessages struct {
+	Log           logger.Logger
+	StatusHandler core.StatusHandler
+	Sender        Sender
+	PublicKey     []byte
+	MaxMessages   int
+}
+
+// directMessagePayload is the signed content of a direct message. The recipient is part of the signed content, so a
+// relayer can not forward a message it received to another relayer
+type directMessagePayload struct {
+	Kind    string `json:"kind"`
+	Text    string `json:"text"`
+	BatchID uint64 `json:"batchId,omitempty"`
+	To      string `json:"to"`
+}
+
+type directMessages struct {
+	log           logger.Logger
+	statusHandler core.StatusHandler
+	sender        Sender
+	address       string
+	maxMessages   int
+	getTime       func() int64
+
+	mut         sync.RWMutex
+	messages    []*core.DirectMessage
+	numSent     int
+	numReceived int
+	numDropped  int
+}
+
+// NewDirectMessages creates the component exchanging operational coordination messages (maintenance notices,
+// execution claims, veto reasons) with the other whitelisted relayers, on direct streams instead of the broadcast
+// topics. The last sent and received messages are kept in memory for the operators
+func NewDirectMessages(args ArgsDirectMessages) (*directMessages, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+	if check.IfNil(args.Sender) {
+		return nil, ErrNilSender
+	}
+	if len(args.PublicKey) == 0 {
+		return nil, ErrEmptyPublicKey
+	}
+	if args.MaxMessages < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxMessages, args.MaxMessages)
+	}
+
+	address, err := data.NewAddressFromBytes(args.PublicKey).AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	holder := &directMessages{
+		log:           args.Log,
+		statusHandler: args.StatusHandler,
+		sender:        args.Sender,
+		address:       address,
+		maxMessages:   args.MaxMessages,
+		getTime: func() int64 {
+			return time.Now().Unix()
+		},
+		messages: make([]*core.DirectMessage, 0),
+	}
+	holder.updateMetricsUnprotected()
+
+	return holder, nil
+}
+
+// SendDirectMessage signs and sends the provided message to the relayer owning the recipient address
+func (holder *directMessages) SendDirectMessage(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+	err := checkContent(kind, text)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := data.NewAddressFromBech32String(to)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %s", ErrInvalidRecipient, to, err.Error())
+	}
+	if to == holder.address {
+		return nil, fmt.Errorf("%w %s: the message can not be sent to this relayer", ErrInvalidRecipient, to)
+	}
+
+	payload, err := json.Marshal(&directMessagePayload{
+		Kind:    kind,
+		Text:    text,
+		BatchID: batchID,
+		To:      to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = holder.sender.SendDirectMessage(payload, recipient.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	message := &core.DirectMessage{
+		Kind:      kind,
+		Text:      text,
+		BatchID:   batchID,
+		From:      holder.address,
+		To:        to,
+		Direction: core.DirectMessageSent,
+		Timestamp: holder.getTime(),
+	}
+
+	holder.mut.Lock()
+	holder.numSent++
+	holder.addUnprotected(message)
+	holder.mut.Unlock()
+
+	holder.log.Info("direct message sent", "to", to, "kind", kind, "batch ID", batchID, "text", text)
+
+	return copyMessage(message), nil
+}
+
+func checkContent(kind string, text string) error {
+	switch kind {
+	case core.DirectMessageMaintenance, core.DirectMessageExecutionClaim, core.DirectMessageVeto:
+	default:
+		return fmt.Errorf("%w %s, supported kinds: %s, %s, %s", ErrInvalidKind, kind,
+			core.DirectMessageMaintenance, core.DirectMessageExecutionClaim, core.DirectMessageVeto)
+	}
+	if len(text) == 0 {
+		return ErrEmptyText
+	}
+	if len(text) > MaxTextLength {
+		return fmt.Errorf("%w, got %d, maximum %d", ErrTextTooLong, len(text), MaxTextLength)
+	}
+
+	return nil
+}
+
+// ProcessDirectMessage stores the direct message received from a whitelisted relayer, if it is addressed to this
+// relayer. The signature and the whitelisting of the sender were checked by the broadcaster
+func (holder *directMessages) ProcessDirectMessage(msg *core.SignedMessage) {
+	from, err := data.NewAddressFromBytes(msg.PublicKeyBytes).AddressAsBech32String()
+	if err != nil {
+		holder.drop("invalid sender public key", err)
+		return
+	}
+
+	payload := &directMessagePayload{}
+	err = json.Unmarshal(msg.Payload, payload)
+	if err != nil {
+		holder.drop("invalid payload from "+from, err)
+		return
+	}
+	err = checkContent(payload.Kind, payload.Text)
+	if err != nil {
+		holder.drop("invalid content from "+from, err)
+		return
+	}
+	if payload.To != holder.address {
+		holder.drop("message from "+from+" addressed to "+payload.To, ErrInvalidRecipient)
+		return
+	}
+
+	message := &core.DirectMessage{
+		Kind:      payload.Kind,
+		Text:      payload.Text,
+		BatchID:   payload.BatchID,
+		From:      from,
+		To:        payload.To,
+		Direction: core.DirectMessageReceived,
+		Timestamp: holder.getTime(),
+	}
+
+	holder.mut.Lock()
+	holder.numReceived++
+	holder.addUnprotected(message)
+	holder.mut.Unlock()
+
+	holder.log.Info("direct message received", "from", from, "kind", payload.Kind,
+		"batch ID", payload.BatchID, "text", payload.Text)
+}
+
+func (holder *directMessages) drop(reason string, err error) {
+	holder.mut.Lock()
+	holder.numDropped++
+	holder.updateMetricsUnprotected()
+	holder.mut.Unlock()
+
+	holder.log.Debug("dropped direct message", "reason", reason, "error", err)
+}
+
+func (holder *directMessages) addUnprotected(message *core.DirectMessage) {
+	holder.messages = append(holder.messages, message)
+	if len(holder.messages) > holder.maxMessages {
+		holder.messages = holder.messages[len(holder.messages)-holder.maxMessages:]
+	}
+
+	holder.updateMetricsUnprotected()
+}
+
+func (holder *directMessages) updateMetricsUnprotected() {
+	holder.statusHandler.SetIntMetric(core.MetricNumDirectMessagesSent, holder.numSent)
+	holder.statusHandler.SetIntMetric(core.MetricNumDirectMessagesReceived, holder.numReceived)
+	holder.statusHandler.SetIntMetric(core.MetricNumDirectMessagesDropped, holder.numDropped)
+}
+
+// GetDirectMessages returns the last sent and received direct messages, the oldest one first
+func (holder *directMessages) GetDirectMessages() []*core.DirectMessage {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	messages := make([]*core.DirectMessage, 0, len(holder.messages))
+	for _, message := range holder.messages {
+		messages = append(messages, copyMessage(message))
+	}
+
+	return messages
+}
+
+func copyMessage(message *core.DirectMessage) *core.DirectMessage {
+	messageCopy := *message
+
+	return &messageCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *directMessages) IsInterfaceNil() bool {
+	return holder == nil
+}
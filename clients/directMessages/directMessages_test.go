@@ -0,0 +1,280 @@
+package directMessages
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	selfPublicKey  = bytes.Repeat([]byte{1}, 32)
+	otherPublicKey = bytes.Repeat([]byte{2}, 32)
+	thirdPublicKey = bytes.Repeat([]byte{3}, 32)
+)
+
+func createMockArgsDirectMessages() ArgsDirectMessages {
+	return ArgsDirectMessages{
+		Log:           &testsCommon.LoggerStub{},
+		StatusHandler: testsCommon.NewStatusHandlerMock(core.DirectMessagesStatusHandlerName),
+		Sender:        &testsCommon.BroadcasterStub{},
+		PublicKey:     selfPublicKey,
+		MaxMessages:   10,
+	}
+}
+
+func toBech32(t *testing.T, publicKey []byte) string {
+	address, err := data.NewAddressFromBytes(publicKey).AddressAsBech32String()
+	require.Nil(t, err)
+
+	return address
+}
+
+func createSignedMessage(t *testing.T, publicKey []byte, payload *directMessagePayload) *core.SignedMessage {
+	buff, err := json.Marshal(payload)
+	require.Nil(t, err)
+
+	return &core.SignedMessage{
+		Payload:        buff,
+		PublicKeyBytes: publicKey,
+		Nonce:          1,
+	}
+}
+
+func TestNewDirectMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		args.Log = nil
+		holder, err := NewDirectMessages(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		args.StatusHandler = nil
+		holder, err := NewDirectMessages(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		args.Sender = nil
+		holder, err := NewDirectMessages(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrNilSender, err)
+	})
+	t.Run("empty public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		args.PublicKey = nil
+		holder, err := NewDirectMessages(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrEmptyPublicKey, err)
+	})
+	t.Run("invalid maximum number of messages should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		args.MaxMessages = 0
+		holder, err := NewDirectMessages(args)
+		assert.True(t, check.IfNil(holder))
+		assert.True(t, errors.Is(err, ErrInvalidMaxMessages))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		holder, err := NewDirectMessages(createMockArgsDirectMessages())
+		assert.False(t, check.IfNil(holder))
+		assert.Nil(t, err)
+		assert.Empty(t, holder.GetDirectMessages())
+	})
+}
+
+func TestDirectMessages_SendDirectMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid content should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := NewDirectMessages(createMockArgsDirectMessages())
+		to := toBech32(t, otherPublicKey)
+
+		_, err := holder.SendDirectMessage(to, "gossip", "text", 0)
+		assert.True(t, errors.Is(err, ErrInvalidKind))
+
+		_, err = holder.SendDirectMessage(to, core.DirectMessageMaintenance, "", 0)
+		assert.Equal(t, ErrEmptyText, err)
+
+		_, err = holder.SendDirectMessage(to, core.DirectMessageMaintenance, strings.Repeat("a", MaxTextLength+1), 0)
+		assert.True(t, errors.Is(err, ErrTextTooLong))
+	})
+	t.Run("invalid recipient should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := NewDirectMessages(createMockArgsDirectMessages())
+
+		_, err := holder.SendDirectMessage("erd1invalid", core.DirectMessageMaintenance, "text", 0)
+		assert.True(t, errors.Is(err, ErrInvalidRecipient))
+
+		_, err = holder.SendDirectMessage(toBech32(t, selfPublicKey), core.DirectMessageMaintenance, "text", 0)
+		assert.True(t, errors.Is(err, ErrInvalidRecipient))
+	})
+	t.Run("sender errors should error and not store the message", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsDirectMessages()
+		args.Sender = &testsCommon.BroadcasterStub{
+			SendDirectMessageCalled: func(payload []byte, publicKey []byte) error {
+				return expectedErr
+			},
+		}
+		holder, _ := NewDirectMessages(args)
+
+		message, err := holder.SendDirectMessage(toBech32(t, otherPublicKey), core.DirectMessageMaintenance, "text", 0)
+		assert.Nil(t, message)
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, holder.GetDirectMessages())
+	})
+	t.Run("should send and store the message", func(t *testing.T) {
+		t.Parallel()
+
+		to := toBech32(t, otherPublicKey)
+		args := createMockArgsDirectMessages()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.DirectMessagesStatusHandlerName)
+		args.StatusHandler = statusHandler
+		args.Sender = &testsCommon.BroadcasterStub{
+			SendDirectMessageCalled: func(payload []byte, publicKey []byte) error {
+				assert.Equal(t, otherPublicKey, publicKey)
+
+				sentPayload := &directMessagePayload{}
+				err := json.Unmarshal(payload, sentPayload)
+				require.Nil(t, err)
+				assert.Equal(t, &directMessagePayload{
+					Kind:    core.DirectMessageVeto,
+					Text:    "the deposit 37 recipient is blacklisted",
+					BatchID: 12,
+					To:      to,
+				}, sentPayload)
+
+				return nil
+			},
+		}
+		holder, _ := NewDirectMessages(args)
+		holder.getTime = func() int64 {
+			return 1000
+		}
+
+		message, err := holder.SendDirectMessage(to, core.DirectMessageVeto, "the deposit 37 recipient is blacklisted", 12)
+		require.Nil(t, err)
+		expectedMessage := &core.DirectMessage{
+			Kind:      core.DirectMessageVeto,
+			Text:      "the deposit 37 recipient is blacklisted",
+			BatchID:   12,
+			From:      toBech32(t, selfPublicKey),
+			To:        to,
+			Direction: core.DirectMessageSent,
+			Timestamp: 1000,
+		}
+		assert.Equal(t, expectedMessage, message)
+		assert.Equal(t, []*core.DirectMessage{expectedMessage}, holder.GetDirectMessages())
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumDirectMessagesSent))
+	})
+}
+
+func TestDirectMessages_ProcessDirectMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid messages should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.DirectMessagesStatusHandlerName)
+		args.StatusHandler = statusHandler
+		holder, _ := NewDirectMessages(args)
+		self := toBech32(t, selfPublicKey)
+
+		holder.ProcessDirectMessage(&core.SignedMessage{
+			Payload:        []byte("not a direct message"),
+			PublicKeyBytes: otherPublicKey,
+		})
+		holder.ProcessDirectMessage(createSignedMessage(t, otherPublicKey, &directMessagePayload{
+			Kind: "gossip",
+			Text: "text",
+			To:   self,
+		}))
+		holder.ProcessDirectMessage(createSignedMessage(t, otherPublicKey, &directMessagePayload{
+			Kind: core.DirectMessageMaintenance,
+			Text: strings.Repeat("a", MaxTextLength+1),
+			To:   self,
+		}))
+
+		assert.Empty(t, holder.GetDirectMessages())
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricNumDirectMessagesDropped))
+	})
+	t.Run("message forwarded from another relayer should be dropped", func(t *testing.T) {
+		t.Parallel()
+
+		holder, _ := NewDirectMessages(createMockArgsDirectMessages())
+		holder.ProcessDirectMessage(createSignedMessage(t, otherPublicKey, &directMessagePayload{
+			Kind: core.DirectMessageMaintenance,
+			Text: "text",
+			To:   toBech32(t, thirdPublicKey),
+		}))
+
+		assert.Empty(t, holder.GetDirectMessages())
+	})
+	t.Run("should store the messages and keep only the last ones", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectMessages()
+		args.MaxMessages = 2
+		statusHandler := testsCommon.NewStatusHandlerMock(core.DirectMessagesStatusHandlerName)
+		args.StatusHandler = statusHandler
+		holder, _ := NewDirectMessages(args)
+		holder.getTime = func() int64 {
+			return 1000
+		}
+		self := toBech32(t, selfPublicKey)
+
+		texts := []string{"maintenance in 10 minutes", "back online", "I will execute the batch 12"}
+		kinds := []string{core.DirectMessageMaintenance, core.DirectMessageMaintenance, core.DirectMessageExecutionClaim}
+		for i := range texts {
+			holder.ProcessDirectMessage(createSignedMessage(t, otherPublicKey, &directMessagePayload{
+				Kind: kinds[i],
+				Text: texts[i],
+				To:   self,
+			}))
+		}
+
+		messages := holder.GetDirectMessages()
+		require.Equal(t, 2, len(messages))
+		assert.Equal(t, "back online", messages[0].Text)
+		assert.Equal(t, &core.DirectMessage{
+			Kind:      core.DirectMessageExecutionClaim,
+			Text:      "I will execute the batch 12",
+			From:      toBech32(t, otherPublicKey),
+			To:        self,
+			Direction: core.DirectMessageReceived,
+			Timestamp: 1000,
+		}, messages[1])
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricNumDirectMessagesReceived))
+	})
+}
@@ -0,0 +1,30 @@
+package directMessages
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilSender signals that a nil direct messages sender has been provided
+var ErrNilSender = errors.New("nil direct messages sender")
+
+// ErrEmptyPublicKey signals that an empty public key has been provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrInvalidMaxMessages signals that an invalid maximum number of stored messages has been provided
+var ErrInvalidMaxMessages = errors.New("invalid maximum number of stored messages")
+
+// ErrInvalidKind signals that an unknown direct message kind has been provided
+var ErrInvalidKind = errors.New("invalid direct message kind")
+
+// ErrEmptyText signals that a direct message without text has been provided
+var ErrEmptyText = errors.New("empty direct message text")
+
+// ErrTextTooLong signals that the text of the direct message exceeds the maximum length
+var ErrTextTooLong = errors.New("direct message text too long")
+
+// ErrInvalidRecipient signals that the recipient of the direct message is not a valid relayer address
+var ErrInvalidRecipient = errors.New("invalid direct message recipient")
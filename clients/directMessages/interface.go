@@ -0,0 +1,7 @@
+package directMessages
+
+// Sender defines the component able to send a signed payload to the relayer owning the provided public key
+type Sender interface {
+	SendDirectMessage(payload []byte, publicKey []byte) error
+	IsInterfaceNil() bool
+}
@@ -6,6 +6,9 @@ var (
 	// ErrNilLogger signals that a nil logger was provided
 	ErrNilLogger = errors.New("nil logger")
 
+	// ErrNilTimer signals that a nil timer was provided
+	ErrNilTimer = errors.New("nil timer")
+
 	// ErrNilDataGetter signals that a nil data getter was provided
 	ErrNilDataGetter = errors.New("nil data getter")
 
@@ -38,4 +41,7 @@ var (
 
 	// ErrNilCryptoHandler signals that a nil crypto handler was provided
 	ErrNilCryptoHandler = errors.New("nil crypto handler")
+
+	// ErrGasPriceIsHigherThanTheMaximumSet signals that the fetched gas price is higher than the maximum set
+	ErrGasPriceIsHigherThanTheMaximumSet = errors.New("fetched gas price is higher than the maximum set")
 )
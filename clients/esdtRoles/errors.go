@@ -0,0 +1,21 @@
+package esdtRoles
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilDataGetter signals that a nil MultiversX data getter has been provided
+var ErrNilDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrNilMultiversXClient signals that a nil MultiversX client has been provided
+var ErrNilMultiversXClient = errors.New("nil MultiversX client")
+
+// ErrNilSafeContractAddress signals that a nil safe contract address has been provided
+var ErrNilSafeContractAddress = errors.New("nil safe contract address")
+
+// ErrInvalidSpecialRolesEntry signals that the ESDT system smart contract returned an entry that can not be parsed
+var ErrInvalidSpecialRolesEntry = errors.New("invalid special roles entry")
+
+// ErrMissingESDTRole signals that the safe contract does not hold an ESDT role required to bridge the token
+var ErrMissingESDTRole = errors.New("missing ESDT role")
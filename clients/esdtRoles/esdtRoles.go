@@ -0,0 +1,218 @@
+package esdtRoles
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// ESDTSystemSCAddress is the address of the ESDT system smart contract, holding the special roles of the tokens
+	ESDTSystemSCAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+
+	// RoleLocalMint is the role allowing an address to mint the token
+	RoleLocalMint = "ESDTRoleLocalMint"
+	// RoleLocalBurn is the role allowing an address to burn the token
+	RoleLocalBurn = "ESDTRoleLocalBurn"
+	// RoleTransfer is the role allowing an address to transfer a token with restricted transfers
+	RoleTransfer = "ESDTTransferRole"
+
+	getSpecialRolesFuncName = "getSpecialRoles"
+	addressRolesSeparator   = ":"
+	rolesSeparator          = ","
+)
+
+// ArgsESDTRolesChecker represents the DTO struct used in the NewESDTRolesChecker constructor function
+type ArgsESDTRolesChecker struct {
+	Log                 logger.Logger
+	DataGetter          MultiversXDataGetter
+	MultiversXClient    MultiversXClient
+	SafeContractAddress sdkCore.AddressHandler
+	CacheDuration       time.Duration
+}
+
+type cachedRoles struct {
+	missingRoles []string
+	checkedAt    time.Time
+}
+
+type esdtRolesChecker struct {
+	log                 logger.Logger
+	dataGetter          MultiversXDataGetter
+	multiversXClient    MultiversXClient
+	safeContractAddress string
+	systemSCAddress     sdkCore.AddressHandler
+	cacheDuration       time.Duration
+	getTime             func() time.Time
+
+	mutCache sync.Mutex
+	cache    map[string]*cachedRoles
+}
+
+// NewESDTRolesChecker creates the component verifying that the MultiversX safe contract holds the ESDT roles required
+// to bridge a token: the local mint and burn roles for the mint/burn tokens and, if the token has restricted transfers,
+// the transfer role
+func NewESDTRolesChecker(args ArgsESDTRolesChecker) (*esdtRolesChecker, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.DataGetter) {
+		return nil, ErrNilDataGetter
+	}
+	if check.IfNil(args.MultiversXClient) {
+		return nil, ErrNilMultiversXClient
+	}
+	if check.IfNil(args.SafeContractAddress) {
+		return nil, ErrNilSafeContractAddress
+	}
+
+	safeContractAddress, err := args.SafeContractAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, fmt.Errorf("%w for the safe contract address", err)
+	}
+	systemSCAddress, err := data.NewAddressFromBech32String(ESDTSystemSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &esdtRolesChecker{
+		log:                 args.Log,
+		dataGetter:          args.DataGetter,
+		multiversXClient:    args.MultiversXClient,
+		safeContractAddress: safeContractAddress,
+		systemSCAddress:     systemSCAddress,
+		cacheDuration:       args.CacheDuration,
+		getTime:             time.Now,
+		cache:               make(map[string]*cachedRoles),
+	}, nil
+}
+
+// CheckRoles returns ErrMissingESDTRole if the safe contract does not hold all the roles required to bridge the token.
+// The result is cached for the configured duration, the query errors are not cached
+func (checker *esdtRolesChecker) CheckRoles(ctx context.Context, token []byte) error {
+	missingRoles, err := checker.getMissingRoles(ctx, token)
+	if err != nil {
+		return err
+	}
+	if len(missingRoles) > 0 {
+		return fmt.Errorf("%w: the safe contract %s misses %s for the token %s",
+			ErrMissingESDTRole, checker.safeContractAddress, strings.Join(missingRoles, rolesSeparator), token)
+	}
+
+	return nil
+}
+
+func (checker *esdtRolesChecker) getMissingRoles(ctx context.Context, token []byte) ([]string, error) {
+	checker.mutCache.Lock()
+	defer checker.mutCache.Unlock()
+
+	now := checker.getTime()
+	cached, found := checker.cache[string(token)]
+	if found && now.Sub(cached.checkedAt) < checker.cacheDuration {
+		return cached.missingRoles, nil
+	}
+
+	missingRoles, err := checker.computeMissingRoles(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	checker.cache[string(token)] = &cachedRoles{
+		missingRoles: missingRoles,
+		checkedAt:    now,
+	}
+
+	return missingRoles, nil
+}
+
+func (checker *esdtRolesChecker) computeMissingRoles(ctx context.Context, token []byte) ([]string, error) {
+	holders, err := checker.getSpecialRoles(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the special roles of the token %s", err, token)
+	}
+	isMintBurn, err := checker.multiversXClient.IsMintBurnToken(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking if the token %s is mint/burn", err, token)
+	}
+
+	requiredRoles := make([]string, 0, 3)
+	if isMintBurn {
+		requiredRoles = append(requiredRoles, RoleLocalMint, RoleLocalBurn)
+	}
+	if hasTransferRestrictions(holders) {
+		requiredRoles = append(requiredRoles, RoleTransfer)
+	}
+
+	safeRoles := holders[checker.safeContractAddress]
+	missingRoles := make([]string, 0)
+	for _, role := range requiredRoles {
+		if !safeRoles[role] {
+			missingRoles = append(missingRoles, role)
+		}
+	}
+	if len(missingRoles) > 0 {
+		checker.log.Warn("the safe contract does not hold the required ESDT roles",
+			"token", token, "missing roles", strings.Join(missingRoles, rolesSeparator))
+	}
+
+	return missingRoles, nil
+}
+
+// getSpecialRoles returns the roles of each address holding special roles on the token. The ESDT system smart contract
+// returns one entry for each address, formatted as <bech32 address>:<role>,<role>
+func (checker *esdtRolesChecker) getSpecialRoles(ctx context.Context, token []byte) (map[string]map[string]bool, error) {
+	request, err := builders.NewVMQueryBuilder().
+		Address(checker.systemSCAddress).
+		Function(getSpecialRolesFuncName).
+		ArgBytes(token).
+		ToVmValueRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := checker.dataGetter.ExecuteQueryReturningBytes(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make(map[string]map[string]bool, len(response))
+	for _, entry := range response {
+		address, roles, found := strings.Cut(string(entry), addressRolesSeparator)
+		if !found || len(address) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSpecialRolesEntry, entry)
+		}
+
+		addressRoles := make(map[string]bool)
+		for _, role := range strings.Split(roles, rolesSeparator) {
+			addressRoles[role] = true
+		}
+		holders[address] = addressRoles
+	}
+
+	return holders, nil
+}
+
+// hasTransferRestrictions returns true if any address holds the transfer role, in which case only these addresses can
+// transfer the token
+func hasTransferRestrictions(holders map[string]map[string]bool) bool {
+	for _, roles := range holders {
+		if roles[RoleTransfer] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *esdtRolesChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
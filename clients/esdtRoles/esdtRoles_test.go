@@ -0,0 +1,242 @@
+package esdtRoles
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	safeAddress  = "erd1qqqqqqqqqqqqqpgqgftcwj09u0nhmskrw7xxqcqh8qmzwyexd8ss7ftcxx"
+	otherAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	token        = "WETH-abcdef"
+)
+
+func createMockArgsESDTRolesChecker() ArgsESDTRolesChecker {
+	safeContractAddress, _ := data.NewAddressFromBech32String(safeAddress)
+
+	return ArgsESDTRolesChecker{
+		Log: &testsCommon.LoggerStub{},
+		DataGetter: &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				return [][]byte{[]byte(safeAddress + ":ESDTRoleLocalMint,ESDTRoleLocalBurn")}, nil
+			},
+		},
+		MultiversXClient: &bridgeTests.MultiversXClientStub{
+			IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return true, nil
+			},
+		},
+		SafeContractAddress: safeContractAddress,
+		CacheDuration:       time.Minute,
+	}
+}
+
+func TestNewESDTRolesChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.Log = nil
+
+		checker, err := NewESDTRolesChecker(args)
+		assert.Equal(t, ErrNilLogger, err)
+		assert.True(t, check.IfNil(checker))
+	})
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = nil
+
+		checker, err := NewESDTRolesChecker(args)
+		assert.Equal(t, ErrNilDataGetter, err)
+		assert.True(t, check.IfNil(checker))
+	})
+	t.Run("nil MultiversX client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.MultiversXClient = nil
+
+		checker, err := NewESDTRolesChecker(args)
+		assert.Equal(t, ErrNilMultiversXClient, err)
+		assert.True(t, check.IfNil(checker))
+	})
+	t.Run("nil safe contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.SafeContractAddress = nil
+
+		checker, err := NewESDTRolesChecker(args)
+		assert.Equal(t, ErrNilSafeContractAddress, err)
+		assert.True(t, check.IfNil(checker))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewESDTRolesChecker(createMockArgsESDTRolesChecker())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(checker))
+	})
+}
+
+func TestEsdtRolesChecker_CheckRoles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Empty(t, checker.cache)
+	})
+	t.Run("is mint burn token error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsESDTRolesChecker()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("invalid special roles entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				return [][]byte{[]byte("ESDTRoleLocalMint")}, nil
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, ErrInvalidSpecialRolesEntry))
+	})
+	t.Run("mint burn token with the local roles should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				assert.Equal(t, ESDTSystemSCAddress, request.Address)
+				assert.Equal(t, getSpecialRolesFuncName, request.FuncName)
+
+				return [][]byte{
+					[]byte(otherAddress + ":ESDTRoleNFTCreate"),
+					[]byte(safeAddress + ":ESDTRoleLocalMint,ESDTRoleLocalBurn"),
+				}, nil
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.Nil(t, err)
+	})
+	t.Run("mint burn token without the local burn role should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				return [][]byte{
+					[]byte(otherAddress + ":ESDTRoleLocalBurn"),
+					[]byte(safeAddress + ":ESDTRoleLocalMint"),
+				}, nil
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, ErrMissingESDTRole))
+		assert.Contains(t, err.Error(), RoleLocalBurn)
+		assert.NotContains(t, err.Error(), RoleLocalMint)
+	})
+	t.Run("native token without special roles should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{}
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+				return false, nil
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.Nil(t, err)
+	})
+	t.Run("token with restricted transfers should require the transfer role", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				return [][]byte{
+					[]byte(otherAddress + ":ESDTTransferRole"),
+					[]byte(safeAddress + ":ESDTRoleLocalMint,ESDTRoleLocalBurn"),
+				}, nil
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, ErrMissingESDTRole))
+		assert.Contains(t, err.Error(), RoleTransfer)
+	})
+	t.Run("should cache the result for the cache duration", func(t *testing.T) {
+		t.Parallel()
+
+		numQueries := 0
+		args := createMockArgsESDTRolesChecker()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				numQueries++
+				return make([][]byte, 0), nil
+			},
+		}
+		checker, _ := NewESDTRolesChecker(args)
+		now := time.Now()
+		checker.getTime = func() time.Time {
+			return now
+		}
+
+		err := checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, ErrMissingESDTRole))
+		err = checker.CheckRoles(context.Background(), []byte(token))
+		assert.True(t, errors.Is(err, ErrMissingESDTRole))
+		require.Equal(t, 1, numQueries)
+
+		now = now.Add(args.CacheDuration)
+		_ = checker.CheckRoles(context.Background(), []byte(token))
+		assert.Equal(t, 2, numQueries)
+	})
+}
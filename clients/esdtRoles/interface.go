@@ -0,0 +1,19 @@
+package esdtRoles
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// MultiversXDataGetter defines the component able to execute the VM queries
+type MultiversXDataGetter interface {
+	ExecuteQueryReturningBytes(ctx context.Context, request *data.VmValueRequest) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXClient defines the MultiversX client operations used to find out the roles required by a token
+type MultiversXClient interface {
+	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
+	IsInterfaceNil() bool
+}
@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -12,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/decoder"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
@@ -22,48 +24,69 @@ import (
 const (
 	messagePrefix                   = "\u0019Ethereum Signed Message:\n32"
 	minQuorumValue                  = uint64(1)
+	executeTransferFuncName         = "executeTransfer"
 	minClientAvailabilityAllowDelta = 1
+	maxCachedDepositsTxInfo         = 10000
 )
 
 // ArgsEthereumClient is the DTO used in the ethereum's client constructor
 type ArgsEthereumClient struct {
-	ClientWrapper                ClientWrapper
-	Erc20ContractsHandler        Erc20ContractsHolder
-	Log                          chainCore.Logger
-	AddressConverter             core.AddressConverter
-	Broadcaster                  Broadcaster
-	CryptoHandler                CryptoHandler
-	TokensMapper                 TokensMapper
-	SignatureHolder              SignaturesHolder
-	SafeContractAddress          common.Address
-	GasHandler                   GasHandler
-	TransferGasLimitBase         uint64
-	TransferGasLimitForEach      uint64
-	ClientAvailabilityAllowDelta uint64
-	EventsBlockRangeFrom         int64
-	EventsBlockRangeTo           int64
+	ClientWrapper                 ClientWrapper
+	Erc20ContractsHandler         Erc20ContractsHolder
+	Log                           chainCore.Logger
+	AddressConverter              core.AddressConverter
+	Broadcaster                   Broadcaster
+	CryptoHandler                 CryptoHandler
+	TokensMapper                  TokensMapper
+	SignatureHolder               SignaturesHolder
+	SafeContractAddress           common.Address
+	GasHandler                    GasHandler
+	RawTransactionsExporter       RawTransactionsExporter
+	GasUsageTracker               GasUsageTracker
+	ConfirmationPolicy            ConfirmationPolicy
+	TransferGasLimitBase          uint64
+	TransferGasLimitForEach       uint64
+	ClientAvailabilityAllowDelta  uint64
+	EventsBlockRangeFrom          int64
+	EventsBlockRangeTo            int64
+	ExecutionEventsLookbackBlocks uint64
 }
 
 type client struct {
-	clientWrapper                ClientWrapper
-	erc20ContractsHandler        Erc20ContractsHolder
-	log                          chainCore.Logger
-	addressConverter             core.AddressConverter
-	broadcaster                  Broadcaster
-	cryptoHandler                CryptoHandler
-	tokensMapper                 TokensMapper
-	signatureHolder              SignaturesHolder
-	safeContractAddress          common.Address
-	gasHandler                   GasHandler
-	transferGasLimitBase         uint64
-	transferGasLimitForEach      uint64
-	clientAvailabilityAllowDelta uint64
-	eventsBlockRangeFrom         int64
-	eventsBlockRangeTo           int64
+	clientWrapper                 ClientWrapper
+	erc20ContractsHandler         Erc20ContractsHolder
+	log                           chainCore.Logger
+	addressConverter              core.AddressConverter
+	broadcaster                   Broadcaster
+	cryptoHandler                 CryptoHandler
+	tokensMapper                  TokensMapper
+	signatureHolder               SignaturesHolder
+	safeContractAddress           common.Address
+	gasHandler                    GasHandler
+	rawTransactionsExporter       RawTransactionsExporter
+	gasUsageTracker               GasUsageTracker
+	confirmationPolicy            ConfirmationPolicy
+	transferGasLimitBase          uint64
+	transferGasLimitForEach       uint64
+	clientAvailabilityAllowDelta  uint64
+	eventsBlockRangeFrom          int64
+	eventsBlockRangeTo            int64
+	executionEventsLookbackBlocks uint64
+	depositDecoder                DepositDecoder
 
 	lastBlockNumber          uint64
 	retriesAvailabilityCheck uint64
 	mut                      sync.RWMutex
+
+	depositsTxInfo  map[uint64]*depositTxInfo
+	blockTimestamps map[uint64]uint64
+	mutTxInfo       sync.Mutex
+}
+
+type depositTxInfo struct {
+	txHash      string
+	blockNumber uint64
+	timestamp   uint64
 }
 
 // NewEthereumClient will create a new Ethereum client
@@ -73,22 +96,34 @@ func NewEthereumClient(args ArgsEthereumClient) (*client, error) {
 		return nil, err
 	}
 
+	depositDecoder, err := decoder.NewDepositDecoder(decoder.SafeV3)
+	if err != nil {
+		return nil, err
+	}
+
 	c := &client{
-		clientWrapper:                args.ClientWrapper,
-		erc20ContractsHandler:        args.Erc20ContractsHandler,
-		log:                          args.Log,
-		addressConverter:             args.AddressConverter,
-		broadcaster:                  args.Broadcaster,
-		cryptoHandler:                args.CryptoHandler,
-		tokensMapper:                 args.TokensMapper,
-		signatureHolder:              args.SignatureHolder,
-		safeContractAddress:          args.SafeContractAddress,
-		gasHandler:                   args.GasHandler,
-		transferGasLimitBase:         args.TransferGasLimitBase,
-		transferGasLimitForEach:      args.TransferGasLimitForEach,
-		clientAvailabilityAllowDelta: args.ClientAvailabilityAllowDelta,
-		eventsBlockRangeFrom:         args.EventsBlockRangeFrom,
-		eventsBlockRangeTo:           args.EventsBlockRangeTo,
+		clientWrapper:                 args.ClientWrapper,
+		erc20ContractsHandler:         args.Erc20ContractsHandler,
+		log:                           args.Log,
+		addressConverter:              args.AddressConverter,
+		broadcaster:                   args.Broadcaster,
+		cryptoHandler:                 args.CryptoHandler,
+		tokensMapper:                  args.TokensMapper,
+		signatureHolder:               args.SignatureHolder,
+		safeContractAddress:           args.SafeContractAddress,
+		gasHandler:                    args.GasHandler,
+		rawTransactionsExporter:       args.RawTransactionsExporter,
+		gasUsageTracker:               args.GasUsageTracker,
+		confirmationPolicy:            args.ConfirmationPolicy,
+		transferGasLimitBase:          args.TransferGasLimitBase,
+		transferGasLimitForEach:       args.TransferGasLimitForEach,
+		clientAvailabilityAllowDelta:  args.ClientAvailabilityAllowDelta,
+		eventsBlockRangeFrom:          args.EventsBlockRangeFrom,
+		eventsBlockRangeTo:            args.EventsBlockRangeTo,
+		executionEventsLookbackBlocks: args.ExecutionEventsLookbackBlocks,
+		depositDecoder:                depositDecoder,
+		depositsTxInfo:                make(map[uint64]*depositTxInfo),
+		blockTimestamps:               make(map[uint64]uint64),
 	}
 
 	c.log.Info("NewEthereumClient",
@@ -126,6 +161,15 @@ func checkArgs(args ArgsEthereumClient) error {
 	if check.IfNil(args.GasHandler) {
 		return errNilGasHandler
 	}
+	if check.IfNil(args.RawTransactionsExporter) {
+		return errNilRawTransactionsExporter
+	}
+	if check.IfNil(args.GasUsageTracker) {
+		return errNilGasUsageTracker
+	}
+	if check.IfNil(args.ConfirmationPolicy) {
+		return errNilConfirmationPolicy
+	}
 	if args.TransferGasLimitBase == 0 {
 		return errInvalidGasLimit
 	}
@@ -197,8 +241,147 @@ func (c *client) GetBatch(ctx context.Context, nonce uint64) (*bridgeCore.Transf
 	}
 
 	transferBatch.Statuses = make([]byte, len(transferBatch.Deposits))
+	c.addDepositsTxInfo(ctx, transferBatch)
+
+	isFinal := isFinalBatch && areFinalDeposits
+	if isFinal {
+		isFinal, err = c.hasRequiredConfirmations(ctx, transferBatch)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	return transferBatch, isFinal, nil
+}
+
+// hasRequiredConfirmations returns true if the latest block of the batch and of its deposits is buried under the number
+// of confirmations the confirmation policy requires for the batch
+func (c *client) hasRequiredConfirmations(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+	requiredConfirmations := c.confirmationPolicy.RequiredConfirmations(batch)
+	if requiredConfirmations == 0 {
+		return true, nil
+	}
+
+	currentBlock, err := c.clientWrapper.BlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	lastBlock := batch.BlockNumber
+	for _, deposit := range batch.Deposits {
+		if deposit.BlockNumber > lastBlock {
+			lastBlock = deposit.BlockNumber
+		}
+	}
+
+	if currentBlock < lastBlock+requiredConfirmations {
+		c.log.Debug("batch does not have the required confirmations yet", "batch ID", batch.ID,
+			"last block", lastBlock, "current block", currentBlock, "required confirmations", requiredConfirmations)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// addDepositsTxInfo sets the originating transaction hash, block number and timestamp on each deposit. The info is
+// only informative, so any error is logged and the deposits are left as they are
+func (c *client) addDepositsTxInfo(ctx context.Context, batch *bridgeCore.TransferBatch) {
+	c.mutTxInfo.Lock()
+	defer c.mutTxInfo.Unlock()
+
+	if !c.hasAllDepositsTxInfo(batch) {
+		err := c.fetchDepositsTxInfo(ctx, batch.ID, int64(batch.BlockNumber))
+		if err != nil {
+			c.log.Warn("could not fetch the deposits originating transactions", "batch ID", batch.ID, "error", err)
+		}
+	}
+
+	for _, deposit := range batch.Deposits {
+		info, found := c.depositsTxInfo[deposit.Nonce]
+		if !found {
+			continue
+		}
+
+		deposit.TxHash = info.txHash
+		deposit.BlockNumber = info.blockNumber
+		deposit.Timestamp = info.timestamp
+	}
+}
+
+func (c *client) hasAllDepositsTxInfo(batch *bridgeCore.TransferBatch) bool {
+	for _, deposit := range batch.Deposits {
+		_, found := c.depositsTxInfo[deposit.Nonce]
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *client) fetchDepositsTxInfo(ctx context.Context, nonce uint64, blockNumber int64) error {
+	safeAbi, err := contract.ERC20SafeMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{c.safeContractAddress},
+		Topics: [][]common.Hash{
+			{safeAbi.Events["ERC20Deposit"].ID},
+		},
+		FromBlock: big.NewInt(blockNumber + c.eventsBlockRangeFrom),
+		ToBlock:   big.NewInt(blockNumber + c.eventsBlockRangeTo),
+	}
+
+	logs, err := c.clientWrapper.FilterLogs(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if len(c.depositsTxInfo) > maxCachedDepositsTxInfo {
+		c.depositsTxInfo = make(map[uint64]*depositTxInfo)
+		c.blockTimestamps = make(map[uint64]uint64)
+	}
+
+	for _, vLog := range logs {
+		depositLog, errDecode := c.depositDecoder.DecodeEventData(decoder.DepositEvent, vLog.Data)
+		if errDecode != nil {
+			return errDecode
+		}
+		if depositLog.BatchID != nonce {
+			continue
+		}
+
+		timestamp, err := c.getBlockTimestamp(ctx, vLog.BlockNumber)
+		if err != nil {
+			return err
+		}
+
+		c.depositsTxInfo[depositLog.DepositNonce] = &depositTxInfo{
+			txHash:      vLog.TxHash.Hex(),
+			blockNumber: vLog.BlockNumber,
+			timestamp:   timestamp,
+		}
+	}
+
+	return nil
+}
+
+func (c *client) getBlockTimestamp(ctx context.Context, blockNumber uint64) (uint64, error) {
+	timestamp, found := c.blockTimestamps[blockNumber]
+	if found {
+		return timestamp, nil
+	}
+
+	header, err := c.clientWrapper.HeaderByNumber(ctx, big.NewInt(0).SetUint64(blockNumber))
+	if err != nil {
+		return 0, err
+	}
+
+	c.blockTimestamps[blockNumber] = header.Time
 
-	return transferBatch, isFinalBatch && areFinalDeposits, nil
+	return header.Time, nil
 }
 
 // GetBatchSCMetadata returns the emitted logs in a batch that hold metadata for SC execution on MVX
@@ -225,15 +408,17 @@ func (c *client) GetBatchSCMetadata(ctx context.Context, nonce uint64, blockNumb
 
 	depositEvents := make([]*contract.ERC20SafeERC20SCDeposit, 0)
 	for _, vLog := range logs {
-		event := new(contract.ERC20SafeERC20SCDeposit)
-		err = scExecAbi.UnpackIntoInterface(event, "ERC20SCDeposit", vLog.Data)
-		if err != nil {
-			return nil, err
+		depositLog, errDecode := c.depositDecoder.DecodeEventData(decoder.SCDepositEvent, vLog.Data)
+		if errDecode != nil {
+			return nil, errDecode
 		}
 
-		// Add this manually since UnpackIntoInterface only unpacks non-indexed arguments
-		event.BatchId = big.NewInt(0).SetUint64(nonce)
-		depositEvents = append(depositEvents, event)
+		// the batch ID is indexed, so it is not part of the decoded data
+		depositEvents = append(depositEvents, &contract.ERC20SafeERC20SCDeposit{
+			BatchId:      big.NewInt(0).SetUint64(nonce),
+			DepositNonce: big.NewInt(0).SetUint64(depositLog.DepositNonce),
+			CallData:     depositLog.CallData,
+		})
 	}
 
 	return depositEvents, nil
@@ -244,6 +429,19 @@ func (c *client) WasExecuted(ctx context.Context, mvxBatchID uint64) (bool, erro
 	return c.clientWrapper.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(mvxBatchID))
 }
 
+// IsTransactionPending returns true if the provided transaction has no receipt yet, i.e. it was sent but not mined
+func (c *client) IsTransactionPending(ctx context.Context, txHash string) (bool, error) {
+	_, err := c.clientWrapper.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if errors.Is(err, ethereum.NotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
 // BroadcastSignatureForMessageHash will send the signature for the provided message hash
 func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash) {
 	signature, err := c.cryptoHandler.Sign(msgHash)
@@ -374,6 +572,9 @@ func (c *client) ExecuteTransfer(
 		return "", err
 	}
 
+	// the transaction is only signed, not sent, if it should be exported
+	auth.NoSend = c.rawTransactionsExporter.IsEnabled()
+
 	batchID := big.NewInt(0).SetUint64(batchId)
 	tx, err := c.clientWrapper.ExecuteTransfer(auth, argLists.EthTokens, argLists.Recipients, argLists.Amounts, argLists.Nonces, batchID, signatures)
 	if err != nil {
@@ -381,7 +582,19 @@ func (c *client) ExecuteTransfer(
 	}
 
 	txHash := tx.Hash().String()
+	if auth.NoSend {
+		err = c.rawTransactionsExporter.ExportTransaction(batchId, tx)
+		if err != nil {
+			return "", err
+		}
+
+		c.log.Info("Exported the signed transfer transaction instead of broadcasting it", "batchID", batchID, "hash", txHash)
+
+		return txHash, nil
+	}
+
 	c.log.Info("Executed transfer transaction", "batchID", batchID, "hash", txHash)
+	c.gasUsageTracker.Track(txHash, executeTransferFuncName)
 
 	return txHash, err
 }
@@ -488,6 +701,16 @@ func (c *client) WhitelistedTokens(ctx context.Context, token common.Address) (b
 	return c.clientWrapper.WhitelistedTokens(ctx, token)
 }
 
+// TokenMinLimits returns the minimum amount of the token that can be deposited in the safe contract
+func (c *client) TokenMinLimits(ctx context.Context, token common.Address) (*big.Int, error) {
+	return c.clientWrapper.TokenMinLimits(ctx, token)
+}
+
+// TokenMaxLimits returns the maximum amount of the token that can be deposited in the safe contract
+func (c *client) TokenMaxLimits(ctx context.Context, token common.Address) (*big.Int, error) {
+	return c.clientWrapper.TokenMaxLimits(ctx, token)
+}
+
 func (c *client) checkRelayerFundsForFee(ctx context.Context, transferFee *big.Int) error {
 	existingBalance, err := c.clientWrapper.BalanceAt(ctx, c.cryptoHandler.GetAddress(), nil)
 	if err != nil {
@@ -52,6 +52,9 @@ func createMockEthereumClientArgs() ArgsEthereumClient {
 		SignatureHolder:              &testsCommon.SignaturesHolderStub{},
 		SafeContractAddress:          testsCommon.CreateRandomEthereumAddress(),
 		GasHandler:                   &testsCommon.GasHandlerStub{},
+		RawTransactionsExporter:      &testsCommon.RawTransactionsExporterStub{},
+		GasUsageTracker:              &testsCommon.GasUsageTrackerStub{},
+		ConfirmationPolicy:           &testsCommon.ConfirmationPolicyStub{},
 		TransferGasLimitBase:         50,
 		TransferGasLimitForEach:      20,
 		ClientAvailabilityAllowDelta: 5,
@@ -166,6 +169,30 @@ func TestNewEthereumClient(t *testing.T) {
 		assert.Equal(t, errNilGasHandler, err)
 		assert.True(t, check.IfNil(c))
 	})
+	t.Run("nil raw transactions exporter", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.RawTransactionsExporter = nil
+		c, err := NewEthereumClient(args)
+
+		assert.Equal(t, errNilRawTransactionsExporter, err)
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("nil gas usage tracker", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.GasUsageTracker = nil
+		c, err := NewEthereumClient(args)
+
+		assert.Equal(t, errNilGasUsageTracker, err)
+		assert.True(t, check.IfNil(c))
+	})
+	t.Run("nil confirmation policy", func(t *testing.T) {
+		args := createMockEthereumClientArgs()
+		args.ConfirmationPolicy = nil
+		c, err := NewEthereumClient(args)
+
+		assert.Equal(t, errNilConfirmationPolicy, err)
+		assert.True(t, check.IfNil(c))
+	})
 	t.Run("0 transfer gas limit base", func(t *testing.T) {
 		args := createMockEthereumClientArgs()
 		args.TransferGasLimitBase = 0
@@ -442,6 +469,256 @@ func TestClient_GetBatch(t *testing.T) {
 	})
 }
 
+func TestClient_GetBatchWithDepositsTxInfo(t *testing.T) {
+	t.Parallel()
+
+	safeAbi, _ := contract.ERC20SafeMetaData.GetAbi()
+	packDepositEvent := func(batchID int64, depositNonce int64) []byte {
+		packed, err := safeAbi.Events["ERC20Deposit"].Inputs.NonIndexed().Pack(big.NewInt(batchID), big.NewInt(depositNonce))
+		require.Nil(t, err)
+
+		return packed
+	}
+	txHash1 := common.BytesToHash([]byte("tx hash 1"))
+	txHash2 := common.BytesToHash([]byte("tx hash 2"))
+
+	createClientWrapper := func() *bridgeTests.EthereumClientWrapperStub {
+		return &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error) {
+				return contract.Batch{
+					Nonce:         batchNonce,
+					BlockNumber:   1000,
+					DepositsCount: 2,
+				}, true, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, bool, error) {
+				return []contract.Deposit{
+					{
+						Nonce:  big.NewInt(10),
+						Amount: big.NewInt(20),
+					},
+					{
+						Nonce:  big.NewInt(30),
+						Amount: big.NewInt(40),
+					},
+				}, true, nil
+			},
+			FilterLogsCalled: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+				return []types.Log{
+					{
+						Data:        packDepositEvent(1, 10),
+						TxHash:      txHash1,
+						BlockNumber: 998,
+					},
+					{
+						Data:        packDepositEvent(2, 20),
+						TxHash:      common.BytesToHash([]byte("other batch")),
+						BlockNumber: 999,
+					},
+					{
+						Data:        packDepositEvent(1, 30),
+						TxHash:      txHash2,
+						BlockNumber: 1000,
+					},
+				}, nil
+			},
+			HeaderByNumberCalled: func(ctx context.Context, number *big.Int) (*types.Header, error) {
+				return &types.Header{
+					Number: number,
+					Time:   number.Uint64() * 12,
+				}, nil
+			},
+		}
+	}
+
+	t.Run("should add the transactions info", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		clientWrapper := createClientWrapper()
+		args.ClientWrapper = clientWrapper
+		numFilterLogsCalls := 0
+		filterLogsHandler := clientWrapper.FilterLogsCalled
+		clientWrapper.FilterLogsCalled = func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+			numFilterLogsCalls++
+			return filterLogsHandler(ctx, q)
+		}
+		numHeaderCalls := 0
+		headerHandler := clientWrapper.HeaderByNumberCalled
+		clientWrapper.HeaderByNumberCalled = func(ctx context.Context, number *big.Int) (*types.Header, error) {
+			numHeaderCalls++
+			return headerHandler(ctx, number)
+		}
+		c, _ := NewEthereumClient(args)
+
+		for i := 0; i < 2; i++ {
+			batch, _, err := c.GetBatch(context.Background(), 1)
+			require.Nil(t, err)
+			assert.Equal(t, txHash1.Hex(), batch.Deposits[0].TxHash)
+			assert.Equal(t, uint64(998), batch.Deposits[0].BlockNumber)
+			assert.Equal(t, uint64(998*12), batch.Deposits[0].Timestamp)
+			assert.Equal(t, txHash2.Hex(), batch.Deposits[1].TxHash)
+			assert.Equal(t, uint64(1000), batch.Deposits[1].BlockNumber)
+			assert.Equal(t, uint64(1000*12), batch.Deposits[1].Timestamp)
+		}
+		assert.Equal(t, 1, numFilterLogsCalls)
+		assert.Equal(t, 2, numHeaderCalls)
+	})
+	t.Run("filter logs error should not fail the batch fetching", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		clientWrapper := createClientWrapper()
+		clientWrapper.FilterLogsCalled = func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+			return nil, errors.New("filter logs error")
+		}
+		args.ClientWrapper = clientWrapper
+		c, _ := NewEthereumClient(args)
+
+		batch, _, err := c.GetBatch(context.Background(), 1)
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(batch.Deposits))
+		assert.Empty(t, batch.Deposits[0].TxHash)
+		assert.Empty(t, batch.Deposits[1].TxHash)
+	})
+	t.Run("header error should not fail the batch fetching", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		clientWrapper := createClientWrapper()
+		clientWrapper.HeaderByNumberCalled = func(ctx context.Context, number *big.Int) (*types.Header, error) {
+			return nil, errors.New("header error")
+		}
+		args.ClientWrapper = clientWrapper
+		c, _ := NewEthereumClient(args)
+
+		batch, _, err := c.GetBatch(context.Background(), 1)
+		require.Nil(t, err)
+		assert.Empty(t, batch.Deposits[0].TxHash)
+		assert.Zero(t, batch.Deposits[0].Timestamp)
+	})
+}
+
+func TestClient_GetBatchWithConfirmationPolicy(t *testing.T) {
+	t.Parallel()
+
+	createClientWrapper := func(currentBlock uint64) *bridgeTests.EthereumClientWrapperStub {
+		return &bridgeTests.EthereumClientWrapperStub{
+			GetBatchCalled: func(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error) {
+				return contract.Batch{
+					Nonce:         batchNonce,
+					BlockNumber:   1000,
+					DepositsCount: 1,
+				}, true, nil
+			},
+			GetBatchDepositsCalled: func(ctx context.Context, batchNonce *big.Int) ([]contract.Deposit, bool, error) {
+				return []contract.Deposit{
+					{
+						Nonce:  big.NewInt(10),
+						Amount: big.NewInt(1000000),
+					},
+				}, true, nil
+			},
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return currentBlock, nil
+			},
+		}
+	}
+	createConfirmationPolicy := func(requiredConfirmations uint64) *testsCommon.ConfirmationPolicyStub {
+		return &testsCommon.ConfirmationPolicyStub{
+			RequiredConfirmationsCalled: func(batch *bridgeCore.TransferBatch) uint64 {
+				return requiredConfirmations
+			},
+		}
+	}
+
+	t.Run("no required confirmations should not query the block number", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		clientWrapper := createClientWrapper(0)
+		clientWrapper.BlockNumberCalled = func(ctx context.Context) (uint64, error) {
+			assert.Fail(t, "should have not called BlockNumber")
+			return 0, nil
+		}
+		args.ClientWrapper = clientWrapper
+		c, _ := NewEthereumClient(args)
+
+		batch, isFinal, err := c.GetBatch(context.Background(), 1)
+		assert.NotNil(t, batch)
+		assert.Nil(t, err)
+		assert.True(t, isFinal)
+	})
+	t.Run("block number error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		clientWrapper := createClientWrapper(0)
+		clientWrapper.BlockNumberCalled = func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		}
+		args.ClientWrapper = clientWrapper
+		args.ConfirmationPolicy = createConfirmationPolicy(64)
+		c, _ := NewEthereumClient(args)
+
+		batch, isFinal, err := c.GetBatch(context.Background(), 1)
+		assert.Nil(t, batch)
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, isFinal)
+	})
+	t.Run("not enough confirmations should return a non final batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = createClientWrapper(1063)
+		args.ConfirmationPolicy = createConfirmationPolicy(64)
+		c, _ := NewEthereumClient(args)
+
+		batch, isFinal, err := c.GetBatch(context.Background(), 1)
+		assert.NotNil(t, batch)
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+	})
+	t.Run("enough confirmations should return a final batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = createClientWrapper(1064)
+		args.ConfirmationPolicy = createConfirmationPolicy(64)
+		c, _ := NewEthereumClient(args)
+
+		batch, isFinal, err := c.GetBatch(context.Background(), 1)
+		assert.NotNil(t, batch)
+		assert.Nil(t, err)
+		assert.True(t, isFinal)
+	})
+	t.Run("non final batch should not query the block number", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		clientWrapper := createClientWrapper(0)
+		clientWrapper.GetBatchCalled = func(ctx context.Context, batchNonce *big.Int) (contract.Batch, bool, error) {
+			return contract.Batch{
+				Nonce:         batchNonce,
+				BlockNumber:   1000,
+				DepositsCount: 1,
+			}, false, nil
+		}
+		clientWrapper.BlockNumberCalled = func(ctx context.Context) (uint64, error) {
+			assert.Fail(t, "should have not called BlockNumber")
+			return 0, nil
+		}
+		args.ClientWrapper = clientWrapper
+		args.ConfirmationPolicy = createConfirmationPolicy(64)
+		c, _ := NewEthereumClient(args)
+
+		_, isFinal, err := c.GetBatch(context.Background(), 1)
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+	})
+}
+
 func TestClient_GenerateMessageHash(t *testing.T) {
 	t.Parallel()
 
@@ -457,7 +734,7 @@ func TestClient_GenerateMessageHash(t *testing.T) {
 	})
 	t.Run("should work", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
-		argLists := batchProcessor.ExtractListMvxToEth(batch)
+		argLists, _ := batchProcessor.ExtractListMvxToEth(batch)
 		assert.Equal(t, expectedAmounts, argLists.Amounts)
 		assert.Equal(t, expectedTokens, argLists.EthTokens)
 		assert.Equal(t, expectedRecipients, argLists.Recipients)
@@ -541,6 +818,59 @@ func TestClient_WasExecuted(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestClient_IsTransactionPending(t *testing.T) {
+	t.Parallel()
+
+	txHash := "0x3c4d"
+	t.Run("transaction without receipt should be pending", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				assert.Equal(t, common.HexToHash(txHash), hash)
+				return nil, ethereum.NotFound
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		isPending, err := c.IsTransactionPending(context.Background(), txHash)
+
+		assert.Nil(t, err)
+		assert.True(t, isPending)
+	})
+	t.Run("error fetching the receipt should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		isPending, err := c.IsTransactionPending(context.Background(), txHash)
+
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, isPending)
+	})
+	t.Run("mined transaction should not be pending", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				return &types.Receipt{Status: types.ReceiptStatusFailed}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		isPending, err := c.IsTransactionPending(context.Background(), txHash)
+
+		assert.Nil(t, err)
+		assert.False(t, isPending)
+	})
+}
+
 func TestClient_ExecuteTransfer(t *testing.T) {
 	t.Parallel()
 
@@ -551,7 +881,7 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		},
 	}
 	batch := createMockTransferBatch()
-	argLists := batchProcessor.ExtractListMvxToEth(batch)
+	argLists, _ := batchProcessor.ExtractListMvxToEth(batch)
 	signatures := make([][]byte, 10)
 	for i := range signatures {
 		signatures[i] = []byte(fmt.Sprintf("sig %d", i))
@@ -692,7 +1022,7 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 			Amount:                big.NewInt(80),
 			DestinationTokenBytes: []byte("ERC20token1"),
 		})
-		newArgLists := batchProcessor.ExtractListMvxToEth(newBatch)
+		newArgLists, _ := batchProcessor.ExtractListMvxToEth(newBatch)
 		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, newArgLists, newBatch.ID, 9)
 		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, errInsufficientBalance))
@@ -749,11 +1079,19 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 				return types.NewTx(txData), nil
 			},
 		}
+		trackedHash := ""
+		c.gasUsageTracker = &testsCommon.GasUsageTrackerStub{
+			TrackCalled: func(hash string, function string) {
+				assert.Equal(t, "executeTransfer", function)
+				trackedHash = hash
+			},
+		}
 
 		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, argLists, batch.ID, 9)
 		assert.Equal(t, "0xc5b2c658f5fa236c598a6e7fbf7f21413dc42e2a41dd982eb772b30707cba2eb", hash)
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
+		assert.Equal(t, hash, trackedHash)
 	})
 	t.Run("should work - more signatures should trim", func(t *testing.T) {
 		c, _ := NewEthereumClient(args)
@@ -790,6 +1128,78 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, wasCalled)
 	})
+	t.Run("export errors", func(t *testing.T) {
+		expectedErr := errors.New("expected error export")
+		c, _ := NewEthereumClient(args)
+		c.signatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return signatures[:9]
+			},
+		}
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return big.NewInt(10000), nil
+			},
+		}
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
+				return types.NewTx(&types.LegacyTx{}), nil
+			},
+		}
+		c.rawTransactionsExporter = &testsCommon.RawTransactionsExporterStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			ExportTransactionCalled: func(batchID uint64, tx *types.Transaction) error {
+				return expectedErr
+			},
+		}
+
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, argLists, batch.ID, 9)
+		assert.Equal(t, "", hash)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work - export instead of broadcast", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		c.signatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return signatures[:9]
+			},
+		}
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return big.NewInt(10000), nil
+			},
+		}
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
+				assert.True(t, opts.NoSend)
+
+				return types.NewTx(&types.LegacyTx{}), nil
+			},
+		}
+		var exportedTx *types.Transaction
+		c.rawTransactionsExporter = &testsCommon.RawTransactionsExporterStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			ExportTransactionCalled: func(batchID uint64, tx *types.Transaction) error {
+				assert.Equal(t, batch.ID, batchID)
+				exportedTx = tx
+				return nil
+			},
+		}
+		c.gasUsageTracker = &testsCommon.GasUsageTrackerStub{
+			TrackCalled: func(hash string, function string) {
+				assert.Fail(t, "should have not tracked an exported transaction")
+			},
+		}
+
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, argLists, batch.ID, 9)
+		assert.Equal(t, "0xc5b2c658f5fa236c598a6e7fbf7f21413dc42e2a41dd982eb772b30707cba2eb", hash)
+		assert.Nil(t, err)
+		assert.NotNil(t, exportedTx)
+	})
 }
 
 func TestClient_CheckRequiredBalance(t *testing.T) {
@@ -1019,6 +1429,32 @@ func TestClient_NativeTokens(t *testing.T) {
 	})
 }
 
+func TestClient_TokenLimits(t *testing.T) {
+	t.Parallel()
+
+	token := common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	args := createMockEthereumClientArgs()
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		TokenMinLimitsCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+			assert.Equal(t, token, account)
+			return big.NewInt(100), nil
+		},
+		TokenMaxLimitsCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+			assert.Equal(t, token, account)
+			return big.NewInt(1000), nil
+		},
+	}
+	c, _ := NewEthereumClient(args)
+
+	minLimit, err := c.TokenMinLimits(context.Background(), token)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(100), minLimit)
+
+	maxLimit, err := c.TokenMaxLimits(context.Background(), token)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), maxLimit)
+}
+
 func TestClient_GetTransactionsStatuses(t *testing.T) {
 	t.Parallel()
 
@@ -4,8 +4,10 @@ import (
 	"crypto/ecdsa"
 	"math/big"
 	"os"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/multiversx/mx-bridge-eth-go/core/converters"
@@ -23,12 +25,85 @@ func NewCryptoHandler(privateKeyFilename string) (*cryptoHandler, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	return newCryptoHandlerFromHex(privateKeyBytes)
+}
+
+// NewCryptoHandlerFromKeyFile creates a new instance of type cryptoHandler using the private key stored in the provided
+// file, either as raw hex or as a go-ethereum JSON keystore. The passphrase is requested only for a keystore file
+func NewCryptoHandlerFromKeyFile(keyFilename string, getPassphrase func() (string, error)) (*cryptoHandler, error) {
+	if getPassphrase == nil {
+		return nil, errNilPassphraseProvider
+	}
+
+	keyBytes, err := os.ReadFile(keyFilename)
+	if err != nil {
+		return nil, err
+	}
+	if !isKeystore(keyBytes) {
+		return newCryptoHandlerFromHex(keyBytes)
+	}
+
+	passphrase, err := getPassphrase()
+	if err != nil {
+		return nil, err
+	}
+
+	return newCryptoHandlerFromKeystoreBytes(keyBytes, passphrase)
+}
+
+// isKeystore returns true if the provided key file content is a JSON object, the hex encoded keys never start with a brace
+func isKeystore(keyBytes []byte) bool {
+	return strings.HasPrefix(converters.TrimWhiteSpaceCharacters(string(keyBytes)), "{")
+}
+
+func newCryptoHandlerFromHex(privateKeyBytes []byte) (*cryptoHandler, error) {
 	privateKeyString := converters.TrimWhiteSpaceCharacters(string(privateKeyBytes))
 	privateKey, err := ethCrypto.HexToECDSA(privateKeyString)
 	if err != nil {
 		return nil, err
 	}
 
+	return newCryptoHandlerFromPrivateKey(privateKey)
+}
+
+// NewCryptoHandlerFromMnemonic creates a new instance of type cryptoHandler using the private key derived from the
+// mnemonic stored in the provided file, on the provided derivation path
+func NewCryptoHandlerFromMnemonic(mnemonicFilename string, derivationPath string) (*cryptoHandler, error) {
+	mnemonicBytes, err := os.ReadFile(mnemonicFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKey, err := DerivePrivateKeyFromMnemonic(string(mnemonicBytes), derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCryptoHandlerFromPrivateKey(privateKey)
+}
+
+// NewCryptoHandlerFromKeystore creates a new instance of type cryptoHandler using the private key stored in the provided
+// go-ethereum JSON keystore file, decrypted with the provided passphrase
+func NewCryptoHandlerFromKeystore(keystoreFilename string, passphrase string) (*cryptoHandler, error) {
+	keystoreBytes, err := os.ReadFile(keystoreFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCryptoHandlerFromKeystoreBytes(keystoreBytes, passphrase)
+}
+
+func newCryptoHandlerFromKeystoreBytes(keystoreBytes []byte, passphrase string) (*cryptoHandler, error) {
+	key, err := keystore.DecryptKey(keystoreBytes, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCryptoHandlerFromPrivateKey(key.PrivateKey)
+}
+
+func newCryptoHandlerFromPrivateKey(privateKey *ecdsa.PrivateKey) (*cryptoHandler, error) {
 	publicKey := privateKey.Public()
 	publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
 	if !ok {
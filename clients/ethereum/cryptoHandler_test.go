@@ -2,9 +2,11 @@ package ethereum
 
 import (
 	"encoding/hex"
+	"errors"
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 )
@@ -37,6 +39,134 @@ func TestNewCryptoHandler(t *testing.T) {
 	})
 }
 
+func TestNewCryptoHandlerFromMnemonic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid file should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromMnemonic("missing file", DefaultDerivationPath)
+		assert.Nil(t, handler)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "open missing file: no such file or directory")
+	})
+	t.Run("invalid mnemonic file", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromMnemonic("./testdata/ok-ethereum-key", DefaultDerivationPath)
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, errInvalidMnemonic)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromMnemonic("./testdata/ok-mnemonic", DefaultDerivationPath)
+		assert.Nil(t, err)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", handler.GetAddress().Hex())
+	})
+}
+
+func TestNewCryptoHandlerFromKeystore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid file should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeystore("missing file", "password")
+		assert.Nil(t, handler)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "open missing file: no such file or directory")
+	})
+	t.Run("wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeystore("./testdata/ok-ethereum-keystore.json", "wrong password")
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, keystore.ErrDecrypt)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeystore("./testdata/ok-ethereum-keystore.json", "password")
+		assert.Nil(t, err)
+
+		expectedHandler, _ := NewCryptoHandler("./testdata/ok-ethereum-key")
+		assert.Equal(t, expectedHandler.GetAddress(), handler.GetAddress())
+	})
+}
+
+func TestNewCryptoHandlerFromKeyFile(t *testing.T) {
+	t.Parallel()
+
+	getPassphrase := func() (string, error) {
+		return "password", nil
+	}
+
+	t.Run("nil passphrase provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeyFile("./testdata/ok-ethereum-key", nil)
+		assert.Nil(t, handler)
+		assert.Equal(t, errNilPassphraseProvider, err)
+	})
+	t.Run("invalid file should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeyFile("missing file", getPassphrase)
+		assert.Nil(t, handler)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "open missing file: no such file or directory")
+	})
+	t.Run("hex file should not request the passphrase", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeyFile("./testdata/ok-ethereum-key", func() (string, error) {
+			assert.Fail(t, "should have not requested the passphrase")
+			return "", nil
+		})
+		assert.Nil(t, err)
+
+		expectedHandler, _ := NewCryptoHandler("./testdata/ok-ethereum-key")
+		assert.Equal(t, expectedHandler.GetAddress(), handler.GetAddress())
+	})
+	t.Run("invalid hex file should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeyFile("./testdata/nok-ethereum-key", getPassphrase)
+		assert.Nil(t, handler)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid hex data for private key")
+	})
+	t.Run("passphrase provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		handler, err := NewCryptoHandlerFromKeyFile("./testdata/ok-ethereum-keystore.json", func() (string, error) {
+			return "", expectedErr
+		})
+		assert.Nil(t, handler)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("wrong passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeyFile("./testdata/ok-ethereum-keystore.json", func() (string, error) {
+			return "wrong password", nil
+		})
+		assert.Nil(t, handler)
+		assert.ErrorIs(t, err, keystore.ErrDecrypt)
+	})
+	t.Run("keystore file should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewCryptoHandlerFromKeyFile("./testdata/ok-ethereum-keystore.json", getPassphrase)
+		assert.Nil(t, err)
+
+		expectedHandler, _ := NewCryptoHandler("./testdata/ok-ethereum-key")
+		assert.Equal(t, expectedHandler.GetAddress(), handler.GetAddress())
+	})
+}
+
 func TestCryptoHandler_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,16 @@
+package decoder
+
+// safeV2ABI describes the deposit event and method of the v2 ERC20Safe contract. The event has the same signature as
+// the v3 one but with the deposit nonce first
+const safeV2ABI = `[
+	{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint112","name":"depositNonce","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"batchId","type":"uint112"}],"name":"ERC20Deposit","type":"event"},
+	{"inputs":[{"internalType":"address","name":"tokenAddress","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes32","name":"recipientAddress","type":"bytes32"}],"name":"deposit","outputs":[],"stateMutability":"nonpayable","type":"function"}
+]`
+
+// safeV3ABI describes the deposit events and methods of the v3 ERC20Safe contract, the smart contract calls included
+const safeV3ABI = `[
+	{"anonymous":false,"inputs":[{"indexed":false,"internalType":"uint112","name":"batchId","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"depositNonce","type":"uint112"}],"name":"ERC20Deposit","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint112","name":"batchId","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"depositNonce","type":"uint112"},{"indexed":false,"internalType":"bytes","name":"callData","type":"bytes"}],"name":"ERC20SCDeposit","type":"event"},
+	{"inputs":[{"internalType":"address","name":"tokenAddress","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes32","name":"recipientAddress","type":"bytes32"}],"name":"deposit","outputs":[],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"internalType":"address","name":"tokenAddress","type":"address"},{"internalType":"uint256","name":"amount","type":"uint256"},{"internalType":"bytes32","name":"recipientAddress","type":"bytes32"},{"internalType":"bytes","name":"callData","type":"bytes"}],"name":"depositWithSCExecution","outputs":[],"stateMutability":"nonpayable","type":"function"}
+]`
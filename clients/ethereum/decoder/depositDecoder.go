@@ -0,0 +1,214 @@
+package decoder
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SafeVersion is the version of the ERC20Safe contract emitting the deposit events
+type SafeVersion string
+
+const (
+	// SafeV2 is the ERC20Safe contract without the smart contract calls support
+	SafeV2 SafeVersion = "v2"
+	// SafeV3 is the ERC20Safe contract with the smart contract calls support
+	SafeV3 SafeVersion = "v3"
+)
+
+const (
+	// DepositEvent is the name of the event emitted for each deposit
+	DepositEvent = "ERC20Deposit"
+	// SCDepositEvent is the name of the event emitted for each deposit with a smart contract call on MultiversX
+	SCDepositEvent = "ERC20SCDeposit"
+	// DepositMethod is the name of the deposit method
+	DepositMethod = "deposit"
+	// DepositWithSCExecutionMethod is the name of the deposit method with a smart contract call on MultiversX
+	DepositWithSCExecutionMethod = "depositWithSCExecution"
+)
+
+const (
+	batchIDField          = "batchId"
+	depositNonceField     = "depositNonce"
+	callDataField         = "callData"
+	tokenAddressField     = "tokenAddress"
+	amountField           = "amount"
+	recipientAddressField = "recipientAddress"
+	methodSelectorLength  = 4
+)
+
+var versionsABIs = map[SafeVersion]string{
+	SafeV2: safeV2ABI,
+	SafeV3: safeV3ABI,
+}
+
+// DepositLog is a deposit event decoded from a log of the safe contract
+type DepositLog struct {
+	Event        string
+	BatchID      uint64
+	DepositNonce uint64
+	CallData     []byte
+	TxHash       common.Hash
+	BlockNumber  uint64
+}
+
+// DepositCall is a deposit method call decoded from the input of a transaction sent to the safe contract
+type DepositCall struct {
+	Method           string
+	TokenAddress     common.Address
+	Amount           *big.Int
+	RecipientAddress [32]byte
+	CallData         []byte
+}
+
+type depositDecoder struct {
+	version SafeVersion
+	safeAbi abi.ABI
+}
+
+// SupportedVersions returns the ERC20Safe contract versions known by the decoder
+func SupportedVersions() []SafeVersion {
+	return []SafeVersion{SafeV2, SafeV3}
+}
+
+// NewDepositDecoder creates a decoder for the deposit events and the deposit calls of the provided ERC20Safe contract
+// version. The version must be known beforehand as the ERC20Deposit event has the same signature, and therefore the
+// same ID, in all the versions while the order of its fields differs
+func NewDepositDecoder(version SafeVersion) (*depositDecoder, error) {
+	abiJSON, found := versionsABIs[version]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSafeVersion, version)
+	}
+
+	safeAbi, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	return &depositDecoder{
+		version: version,
+		safeAbi: safeAbi,
+	}, nil
+}
+
+// Version returns the ERC20Safe contract version of the decoder
+func (decoder *depositDecoder) Version() SafeVersion {
+	return decoder.version
+}
+
+// EventsIDs returns the IDs of the deposit events of the safe contract version, to be used as the first topic of the
+// logs filter queries
+func (decoder *depositDecoder) EventsIDs() []common.Hash {
+	ids := make([]common.Hash, 0, len(decoder.safeAbi.Events))
+	for _, eventName := range []string{DepositEvent, SCDepositEvent} {
+		event, found := decoder.safeAbi.Events[eventName]
+		if found {
+			ids = append(ids, event.ID)
+		}
+	}
+
+	return ids
+}
+
+// DecodeLog decodes a deposit event log. The event is identified by the first topic of the log
+func (decoder *depositDecoder) DecodeLog(vLog types.Log) (*DepositLog, error) {
+	if len(vLog.Topics) == 0 {
+		return nil, ErrMissingTopics
+	}
+
+	event, err := decoder.safeAbi.EventByID(vLog.Topics[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w with ID %s for safe %s", ErrUnknownEvent, vLog.Topics[0].Hex(), decoder.version)
+	}
+
+	depositLog, err := decoder.DecodeEventData(event.Name, vLog.Data)
+	if err != nil {
+		return nil, err
+	}
+	if event.Name == SCDepositEvent {
+		// the batch ID is indexed so it is only found in the topics
+		if len(vLog.Topics) < 2 {
+			return nil, fmt.Errorf("%w for event %s", ErrMissingTopics, event.Name)
+		}
+
+		depositLog.BatchID = big.NewInt(0).SetBytes(vLog.Topics[1].Bytes()).Uint64()
+	}
+
+	depositLog.TxHash = vLog.TxHash
+	depositLog.BlockNumber = vLog.BlockNumber
+
+	return depositLog, nil
+}
+
+// DecodeEventData decodes the non-indexed fields of the provided deposit event. The batch ID of the ERC20SCDeposit
+// event is indexed, so it is not set
+func (decoder *depositDecoder) DecodeEventData(eventName string, data []byte) (*DepositLog, error) {
+	if eventName != DepositEvent && eventName != SCDepositEvent {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEvent, eventName)
+	}
+	_, found := decoder.safeAbi.Events[eventName]
+	if !found {
+		return nil, fmt.Errorf("%w: %s for safe %s", ErrUnknownEvent, eventName, decoder.version)
+	}
+
+	values := make(map[string]interface{})
+	err := decoder.safeAbi.UnpackIntoMap(values, eventName, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding event %s", err, eventName)
+	}
+
+	depositLog := &DepositLog{
+		Event:        eventName,
+		BatchID:      getUint64(values, batchIDField),
+		DepositNonce: getUint64(values, depositNonceField),
+	}
+	depositLog.CallData, _ = values[callDataField].([]byte)
+
+	return depositLog, nil
+}
+
+// DecodeCallData decodes the input of a transaction calling one of the deposit methods of the safe contract version
+func (decoder *depositDecoder) DecodeCallData(input []byte) (*DepositCall, error) {
+	if len(input) < methodSelectorLength {
+		return nil, fmt.Errorf("%w, length: %d", ErrInvalidCallData, len(input))
+	}
+
+	method, err := decoder.safeAbi.MethodById(input[:methodSelectorLength])
+	if err != nil {
+		return nil, fmt.Errorf("%w with selector %x for safe %s", ErrUnknownMethod, input[:methodSelectorLength], decoder.version)
+	}
+
+	values := make(map[string]interface{})
+	err = method.Inputs.UnpackIntoMap(values, input[methodSelectorLength:])
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding method %s", err, method.Name)
+	}
+
+	call := &DepositCall{
+		Method: method.Name,
+	}
+	call.TokenAddress, _ = values[tokenAddressField].(common.Address)
+	call.Amount, _ = values[amountField].(*big.Int)
+	call.RecipientAddress, _ = values[recipientAddressField].([32]byte)
+	call.CallData, _ = values[callDataField].([]byte)
+
+	return call, nil
+}
+
+func getUint64(values map[string]interface{}, field string) uint64 {
+	value, ok := values[field].(*big.Int)
+	if !ok || value == nil {
+		return 0
+	}
+
+	return value.Uint64()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (decoder *depositDecoder) IsInterfaceNil() bool {
+	return decoder == nil
+}
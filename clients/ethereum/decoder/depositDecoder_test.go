@@ -0,0 +1,259 @@
+package decoder
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	tokenAddress     = common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	recipientAddress = [32]byte{1, 2, 3}
+	txHash           = common.HexToHash("0x0102")
+)
+
+func createDecoder(t *testing.T, version SafeVersion) *depositDecoder {
+	decoder, err := NewDepositDecoder(version)
+	require.Nil(t, err)
+
+	return decoder
+}
+
+func createLog(t *testing.T, decoder *depositDecoder, eventName string, topics []common.Hash, values ...interface{}) types.Log {
+	event := decoder.safeAbi.Events[eventName]
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	require.Nil(t, err)
+
+	return types.Log{
+		Topics:      append([]common.Hash{event.ID}, topics...),
+		Data:        data,
+		TxHash:      txHash,
+		BlockNumber: 1000,
+	}
+}
+
+func TestNewDepositDecoder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown version should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder, err := NewDepositDecoder("v1")
+		assert.True(t, check.IfNil(decoder))
+		assert.True(t, errors.Is(err, ErrUnknownSafeVersion))
+	})
+	t.Run("supported versions should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, version := range SupportedVersions() {
+			decoder, err := NewDepositDecoder(version)
+			assert.False(t, check.IfNil(decoder))
+			assert.Nil(t, err)
+			assert.Equal(t, version, decoder.Version())
+		}
+	})
+}
+
+func TestDepositDecoder_EventsIDs(t *testing.T) {
+	t.Parallel()
+
+	decoderV2 := createDecoder(t, SafeV2)
+	decoderV3 := createDecoder(t, SafeV3)
+
+	idsV2 := decoderV2.EventsIDs()
+	idsV3 := decoderV3.EventsIDs()
+	require.Equal(t, 1, len(idsV2))
+	require.Equal(t, 2, len(idsV3))
+	// the ERC20Deposit event has the same signature in all the versions
+	assert.Equal(t, idsV2[0], idsV3[0])
+}
+
+func TestDepositDecoder_DecodeLog(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing topics should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		depositLog, err := decoder.DecodeLog(types.Log{})
+		assert.Nil(t, depositLog)
+		assert.Equal(t, ErrMissingTopics, err)
+	})
+	t.Run("unknown event should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV2)
+		scDepositLog := createLog(t, createDecoder(t, SafeV3), SCDepositEvent, []common.Hash{{}}, big.NewInt(1), []byte("call"))
+
+		depositLog, err := decoder.DecodeLog(scDepositLog)
+		assert.Nil(t, depositLog)
+		assert.True(t, errors.Is(err, ErrUnknownEvent))
+	})
+	t.Run("invalid data should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		vLog := createLog(t, decoder, DepositEvent, nil, big.NewInt(2), big.NewInt(7))
+		vLog.Data = vLog.Data[:10]
+
+		depositLog, err := decoder.DecodeLog(vLog)
+		assert.Nil(t, depositLog)
+		assert.NotNil(t, err)
+	})
+	t.Run("v2 deposit should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV2)
+		vLog := createLog(t, decoder, DepositEvent, nil, big.NewInt(7), big.NewInt(2))
+
+		depositLog, err := decoder.DecodeLog(vLog)
+		require.Nil(t, err)
+		assert.Equal(t, &DepositLog{
+			Event:        DepositEvent,
+			BatchID:      2,
+			DepositNonce: 7,
+			TxHash:       txHash,
+			BlockNumber:  1000,
+		}, depositLog)
+	})
+	t.Run("v3 deposit should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		vLog := createLog(t, decoder, DepositEvent, nil, big.NewInt(2), big.NewInt(7))
+
+		depositLog, err := decoder.DecodeLog(vLog)
+		require.Nil(t, err)
+		assert.Equal(t, &DepositLog{
+			Event:        DepositEvent,
+			BatchID:      2,
+			DepositNonce: 7,
+			TxHash:       txHash,
+			BlockNumber:  1000,
+		}, depositLog)
+	})
+	t.Run("v3 smart contract deposit without the batch ID topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		vLog := createLog(t, decoder, SCDepositEvent, nil, big.NewInt(7), []byte("call"))
+
+		depositLog, err := decoder.DecodeLog(vLog)
+		assert.Nil(t, depositLog)
+		assert.True(t, errors.Is(err, ErrMissingTopics))
+	})
+	t.Run("v3 smart contract deposit should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		batchIDTopic := common.BytesToHash(big.NewInt(2).Bytes())
+		vLog := createLog(t, decoder, SCDepositEvent, []common.Hash{batchIDTopic}, big.NewInt(7), []byte("call"))
+
+		depositLog, err := decoder.DecodeLog(vLog)
+		require.Nil(t, err)
+		assert.Equal(t, &DepositLog{
+			Event:        SCDepositEvent,
+			BatchID:      2,
+			DepositNonce: 7,
+			CallData:     []byte("call"),
+			TxHash:       txHash,
+			BlockNumber:  1000,
+		}, depositLog)
+	})
+}
+
+func TestDepositDecoder_DecodeEventData(t *testing.T) {
+	t.Parallel()
+
+	decoder := createDecoder(t, SafeV2)
+
+	depositLog, err := decoder.DecodeEventData("Transfer", nil)
+	assert.Nil(t, depositLog)
+	assert.True(t, errors.Is(err, ErrUnknownEvent))
+
+	depositLog, err = decoder.DecodeEventData(SCDepositEvent, nil)
+	assert.Nil(t, depositLog)
+	assert.True(t, errors.Is(err, ErrUnknownEvent))
+
+	vLog := createLog(t, decoder, DepositEvent, nil, big.NewInt(7), big.NewInt(2))
+	depositLog, err = decoder.DecodeEventData(DepositEvent, vLog.Data)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), depositLog.BatchID)
+	assert.Equal(t, uint64(7), depositLog.DepositNonce)
+}
+
+func TestDepositDecoder_DecodeCallData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("short input should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		call, err := decoder.DecodeCallData([]byte{1, 2, 3})
+		assert.Nil(t, call)
+		assert.True(t, errors.Is(err, ErrInvalidCallData))
+	})
+	t.Run("unknown method should error", func(t *testing.T) {
+		t.Parallel()
+
+		input, err := createDecoder(t, SafeV3).safeAbi.Pack(DepositWithSCExecutionMethod, tokenAddress, big.NewInt(100), recipientAddress, []byte("call"))
+		require.Nil(t, err)
+
+		decoder := createDecoder(t, SafeV2)
+		call, err := decoder.DecodeCallData(input)
+		assert.Nil(t, call)
+		assert.True(t, errors.Is(err, ErrUnknownMethod))
+	})
+	t.Run("invalid arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		input, err := decoder.safeAbi.Pack(DepositMethod, tokenAddress, big.NewInt(100), recipientAddress)
+		require.Nil(t, err)
+
+		call, err := decoder.DecodeCallData(input[:20])
+		assert.Nil(t, call)
+		assert.NotNil(t, err)
+	})
+	t.Run("deposit should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, version := range SupportedVersions() {
+			decoder := createDecoder(t, version)
+			input, err := decoder.safeAbi.Pack(DepositMethod, tokenAddress, big.NewInt(100), recipientAddress)
+			require.Nil(t, err)
+
+			call, err := decoder.DecodeCallData(input)
+			require.Nil(t, err)
+			assert.Equal(t, &DepositCall{
+				Method:           DepositMethod,
+				TokenAddress:     tokenAddress,
+				Amount:           big.NewInt(100),
+				RecipientAddress: recipientAddress,
+			}, call)
+		}
+	})
+	t.Run("deposit with smart contract execution should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoder := createDecoder(t, SafeV3)
+		input, err := decoder.safeAbi.Pack(DepositWithSCExecutionMethod, tokenAddress, big.NewInt(100), recipientAddress, []byte("call"))
+		require.Nil(t, err)
+
+		call, err := decoder.DecodeCallData(input)
+		require.Nil(t, err)
+		assert.Equal(t, &DepositCall{
+			Method:           DepositWithSCExecutionMethod,
+			TokenAddress:     tokenAddress,
+			Amount:           big.NewInt(100),
+			RecipientAddress: recipientAddress,
+			CallData:         []byte("call"),
+		}, call)
+	})
+}
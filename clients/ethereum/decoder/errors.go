@@ -0,0 +1,18 @@
+package decoder
+
+import "errors"
+
+// ErrUnknownSafeVersion signals that an unknown ERC20Safe contract version was provided
+var ErrUnknownSafeVersion = errors.New("unknown safe version")
+
+// ErrUnknownEvent signals that the log was not emitted by a deposit event of the safe contract version
+var ErrUnknownEvent = errors.New("unknown deposit event")
+
+// ErrUnknownMethod signals that the transaction input does not call a deposit method of the safe contract version
+var ErrUnknownMethod = errors.New("unknown deposit method")
+
+// ErrMissingTopics signals that the log does not contain the topics required by the event
+var ErrMissingTopics = errors.New("missing log topics")
+
+// ErrInvalidCallData signals that the transaction input is too short to contain a method selector
+var ErrInvalidCallData = errors.New("invalid call data")
@@ -91,6 +91,17 @@ func (h *erc20SafeContractsHolder) Decimals(ctx context.Context, erc20Address et
 	return wrapper.Decimals(ctx)
 }
 
+// Symbol returns the ERC20 symbol for the current ERC20 contract
+// if the ERC20 contract does not exist in the map of contract wrappers, it will create and add it first
+func (h *erc20SafeContractsHolder) Symbol(ctx context.Context, erc20Address ethCommon.Address) (string, error) {
+	wrapper, err := h.getOrCreateWrapper(erc20Address)
+	if err != nil {
+		return "", err
+	}
+
+	return wrapper.Symbol(ctx)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (h *erc20SafeContractsHolder) IsInterfaceNil() bool {
 	return h == nil
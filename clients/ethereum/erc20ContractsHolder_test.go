@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
@@ -262,6 +263,47 @@ func TestErc20SafeContractsHolder_Decimals(t *testing.T) {
 	})
 }
 
+func TestErc20SafeContractsHolder_Symbol(t *testing.T) {
+	t.Parallel()
+
+	t.Run("address does not exist on blockchain", func(t *testing.T) {
+		expectedError := errors.New("no contract code at given address")
+		args := createMockArgsContractsHolder()
+		args.EthClient = &bridgeTests.ContractBackendStub{
+			CallContractCalled: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+				return nil, expectedError
+			},
+		}
+		ch, _ := NewErc20SafeContractsHolder(args)
+
+		result, err := ch.Symbol(context.Background(), testsCommon.CreateRandomEthereumAddress())
+		assert.Equal(t, expectedError, err)
+		assert.Empty(t, result)
+		assert.Equal(t, 1, len(ch.contracts))
+	})
+	t.Run("should work", func(t *testing.T) {
+		stringType, _ := abi.NewType("string", "", nil)
+		returnedSymbol, _ := abi.Arguments{{Type: stringType}}.Pack("USDC")
+		args := createMockArgsContractsHolder()
+		args.EthClient = &bridgeTests.ContractBackendStub{
+			CallContractCalled: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+				return returnedSymbol, nil
+			},
+		}
+		ch, _ := NewErc20SafeContractsHolder(args)
+		contractAddress := testsCommon.CreateRandomEthereumAddress()
+
+		result, err := ch.Symbol(context.Background(), contractAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, "USDC", result)
+
+		result, err = ch.Symbol(context.Background(), contractAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, "USDC", result)
+		assert.Equal(t, 1, len(ch.contracts))
+	})
+}
+
 func convertBigToAbiCompatible(number *big.Int) []byte {
 	numberAsBytes := number.Bytes()
 	size := len(numberAsBytes)
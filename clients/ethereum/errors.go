@@ -16,4 +16,13 @@ var (
 	errNilEthClient                        = errors.New("nil eth client")
 	errDepositsAndBatchDepositsCountDiffer = errors.New("deposits and batch.DepositsCount differs")
 	errStatusIsNotFinal                    = errors.New("status is not final")
+	errInvalidMnemonic                     = errors.New("invalid mnemonic")
+	errInvalidDerivedKey                   = errors.New("invalid derived key")
+	errExecutionEventsDisabled             = errors.New("execution events are disabled")
+	errMissingExecutionEvent               = errors.New("missing execution event")
+	errNilRawTransactionsExporter          = errors.New("nil raw transactions exporter")
+	errNilTransaction                      = errors.New("nil transaction")
+	errNilGasUsageTracker                  = errors.New("nil gas usage tracker")
+	errNilConfirmationPolicy               = errors.New("nil confirmation policy")
+	errNilPassphraseProvider               = errors.New("nil passphrase provider")
 )
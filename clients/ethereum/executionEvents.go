@@ -0,0 +1,105 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+const (
+	transferPerformedEvent = "TransferPerformed"
+	transferRejectedEvent  = "TransferRejected"
+)
+
+// executionEventsABI describes the events emitted by the safe contract for each deposit of an executed batch
+const executionEventsABI = `[
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint112","name":"batchId","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"depositNonce","type":"uint112"}],"name":"TransferPerformed","type":"event"},
+	{"anonymous":false,"inputs":[{"indexed":true,"internalType":"uint112","name":"batchId","type":"uint112"},{"indexed":false,"internalType":"uint112","name":"depositNonce","type":"uint112"}],"name":"TransferRejected","type":"event"}
+]`
+
+var executionEventsStatuses = map[string]byte{
+	transferPerformedEvent: bridgeCore.Executed,
+	transferRejectedEvent:  bridgeCore.Rejected,
+}
+
+type executionEvent struct {
+	DepositNonce *big.Int
+}
+
+// GetTransactionsStatusesFromEvents returns the statuses of the batch deposits as resolved from the transfer
+// performed/rejected events emitted by the safe contract. Errors if any of the deposits has no matching event
+func (c *client) GetTransactionsStatusesFromEvents(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error) {
+	if batch == nil {
+		return nil, clients.ErrNilBatch
+	}
+	if c.executionEventsLookbackBlocks == 0 {
+		return nil, errExecutionEventsDisabled
+	}
+
+	eventsAbi, err := abi.JSON(strings.NewReader(executionEventsABI))
+	if err != nil {
+		return nil, err
+	}
+
+	lastBlock, err := c.clientWrapper.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fromBlock := uint64(0)
+	if lastBlock > c.executionEventsLookbackBlocks {
+		fromBlock = lastBlock - c.executionEventsLookbackBlocks
+	}
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{c.safeContractAddress},
+		Topics: [][]common.Hash{
+			{eventsAbi.Events[transferPerformedEvent].ID, eventsAbi.Events[transferRejectedEvent].ID},
+			{common.BytesToHash(new(big.Int).SetUint64(batch.ID).Bytes())},
+		},
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(lastBlock),
+	}
+
+	logs, err := c.clientWrapper.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	depositsStatuses := make(map[uint64]byte)
+	for _, vLog := range logs {
+		if len(vLog.Topics) == 0 {
+			continue
+		}
+		eventDefinition, errEvent := eventsAbi.EventByID(vLog.Topics[0])
+		if errEvent != nil {
+			continue
+		}
+
+		event := &executionEvent{}
+		err = eventsAbi.UnpackIntoInterface(event, eventDefinition.Name, vLog.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		depositsStatuses[event.DepositNonce.Uint64()] = executionEventsStatuses[eventDefinition.Name]
+	}
+
+	statuses := make([]byte, 0, len(batch.Deposits))
+	for _, deposit := range batch.Deposits {
+		status, found := depositsStatuses[deposit.Nonce]
+		if !found {
+			return nil, fmt.Errorf("%w for batch ID %d, deposit nonce %d", errMissingExecutionEvent, batch.ID, deposit.Nonce)
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,109 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createExecutionEventLog(t *testing.T, eventName string, depositNonce int64) types.Log {
+	eventsAbi, err := abi.JSON(strings.NewReader(executionEventsABI))
+	require.Nil(t, err)
+
+	data, err := eventsAbi.Events[eventName].Inputs.NonIndexed().Pack(big.NewInt(depositNonce))
+	require.Nil(t, err)
+
+	return types.Log{
+		Topics: []common.Hash{eventsAbi.Events[eventName].ID},
+		Data:   data,
+	}
+}
+
+func TestClient_GetTransactionsStatusesFromEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+		statuses, err := c.GetTransactionsStatusesFromEvents(context.Background(), nil)
+		assert.Nil(t, statuses)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("disabled execution events should error", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := NewEthereumClient(createMockEthereumClientArgs())
+		statuses, err := c.GetTransactionsStatusesFromEvents(context.Background(), createMockTransferBatch())
+		assert.Nil(t, statuses)
+		assert.Equal(t, errExecutionEventsDisabled, err)
+	})
+	t.Run("filter logs errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ExecutionEventsLookbackBlocks = 100
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			FilterLogsCalled: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		statuses, err := c.GetTransactionsStatusesFromEvents(context.Background(), createMockTransferBatch())
+		assert.Nil(t, statuses)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("missing event should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ExecutionEventsLookbackBlocks = 100
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			FilterLogsCalled: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+				return []types.Log{createExecutionEventLog(t, transferPerformedEvent, 10)}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		statuses, err := c.GetTransactionsStatusesFromEvents(context.Background(), createMockTransferBatch())
+		assert.Nil(t, statuses)
+		assert.True(t, errors.Is(err, errMissingExecutionEvent))
+	})
+	t.Run("should resolve the statuses in the deposits order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ExecutionEventsLookbackBlocks = 100
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return 1000, nil
+			},
+			FilterLogsCalled: func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+				assert.Equal(t, big.NewInt(900), q.FromBlock)
+				assert.Equal(t, big.NewInt(1000), q.ToBlock)
+				assert.Equal(t, common.BytesToHash(big.NewInt(332).Bytes()), q.Topics[1][0])
+
+				return []types.Log{
+					createExecutionEventLog(t, transferRejectedEvent, 30),
+					createExecutionEventLog(t, transferPerformedEvent, 10),
+				}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+		statuses, err := c.GetTransactionsStatusesFromEvents(context.Background(), createMockTransferBatch())
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{bridgeCore.Executed, bridgeCore.Rejected}, statuses)
+	})
+}
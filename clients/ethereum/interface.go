@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/decoder"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 )
 
@@ -34,14 +35,20 @@ type ClientWrapper interface {
 	MintBurnTokens(ctx context.Context, arg0 common.Address) (bool, error)
 	NativeTokens(ctx context.Context, arg0 common.Address) (bool, error)
 	WhitelistedTokens(ctx context.Context, arg0 common.Address) (bool, error)
+	TokenMinLimits(ctx context.Context, arg0 common.Address) (*big.Int, error)
+	TokenMaxLimits(ctx context.Context, arg0 common.Address) (*big.Int, error)
 	IsPaused(ctx context.Context) (bool, error)
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
 
 // Erc20ContractsHolder defines the Ethereum ERC20 contract operations
 type Erc20ContractsHolder interface {
 	BalanceOf(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error)
 	Decimals(ctx context.Context, erc20Address common.Address) (uint8, error)
+	Symbol(ctx context.Context, erc20Address common.Address) (string, error)
 	IsInterfaceNil() bool
 }
 
@@ -70,9 +77,29 @@ type SignaturesHolder interface {
 	IsInterfaceNil() bool
 }
 
+// GasUsageTracker defines the component recording the gas used by the sent transactions
+type GasUsageTracker interface {
+	Track(hash string, function string)
+	IsInterfaceNil() bool
+}
+
+// ConfirmationPolicy defines the component computing the number of confirmations required by a batch
+type ConfirmationPolicy interface {
+	RequiredConfirmations(batch *core.TransferBatch) uint64
+	IsInterfaceNil() bool
+}
+
+// RawTransactionsExporter defines the component exporting the signed transactions instead of broadcasting them
+type RawTransactionsExporter interface {
+	IsEnabled() bool
+	ExportTransaction(batchID uint64, tx *types.Transaction) error
+	IsInterfaceNil() bool
+}
+
 type erc20ContractWrapper interface {
 	BalanceOf(ctx context.Context, account common.Address) (*big.Int, error)
 	Decimals(ctx context.Context) (uint8, error)
+	Symbol(ctx context.Context) (string, error)
 	IsInterfaceNil() bool
 }
 
@@ -83,3 +110,9 @@ type CryptoHandler interface {
 	CreateKeyedTransactor(chainId *big.Int) (*bind.TransactOpts, error)
 	IsInterfaceNil() bool
 }
+
+// DepositDecoder defines the component decoding the deposit events emitted by the safe contract
+type DepositDecoder interface {
+	DecodeEventData(eventName string, data []byte) (*decoder.DepositLog, error)
+	IsInterfaceNil() bool
+}
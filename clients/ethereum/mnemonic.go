@@ -0,0 +1,87 @@
+package ethereum
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// DefaultDerivationPath is the BIP-44 derivation path of the first Ethereum account
+	DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+	masterKeySeed   = "Bitcoin seed"
+	keyLength       = 32
+	hardenedKeyFlag = uint32(0x80000000)
+)
+
+// DerivePrivateKeyFromMnemonic derives the secp256k1 private key for the provided BIP-32 derivation path,
+// using the seed of the provided BIP-39 mnemonic (no passphrase)
+func DerivePrivateKeyFromMnemonic(mnemonic string, derivationPath string) (*ecdsa.PrivateKey, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidMnemonic, err.Error())
+	}
+
+	path, err := accounts.ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return derivePrivateKeyFromSeed(seed, path)
+}
+
+func derivePrivateKeyFromSeed(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	curveOrder := ethCrypto.S256().Params().N
+
+	digest := hmac.New(sha512.New, []byte(masterKeySeed))
+	digest.Write(seed)
+	intermediary := digest.Sum(nil)
+	key := new(big.Int).SetBytes(intermediary[:keyLength])
+	chainCode := intermediary[keyLength:]
+	if key.Sign() == 0 || key.Cmp(curveOrder) >= 0 {
+		return nil, errInvalidDerivedKey
+	}
+
+	for _, childIndex := range path {
+		privateKey, err := ethCrypto.ToECDSA(key.FillBytes(make([]byte, keyLength)))
+		if err != nil {
+			return nil, err
+		}
+
+		buff := make([]byte, 0, 1+keyLength+4)
+		if childIndex >= hardenedKeyFlag {
+			buff = append(buff, 0x00)
+			buff = append(buff, key.FillBytes(make([]byte, keyLength))...)
+		} else {
+			buff = append(buff, ethCrypto.CompressPubkey(&privateKey.PublicKey)...)
+		}
+		buff = binary.BigEndian.AppendUint32(buff, childIndex)
+
+		digest = hmac.New(sha512.New, chainCode)
+		digest.Write(buff)
+		intermediary = digest.Sum(nil)
+
+		tweak := new(big.Int).SetBytes(intermediary[:keyLength])
+		if tweak.Cmp(curveOrder) >= 0 {
+			return nil, fmt.Errorf("%w for child index %d", errInvalidDerivedKey, childIndex)
+		}
+		key = tweak.Add(tweak, key)
+		key.Mod(key, curveOrder)
+		if key.Sign() == 0 {
+			return nil, fmt.Errorf("%w for child index %d", errInvalidDerivedKey, childIndex)
+		}
+		chainCode = intermediary[keyLength:]
+	}
+
+	return ethCrypto.ToECDSA(key.FillBytes(make([]byte, keyLength)))
+}
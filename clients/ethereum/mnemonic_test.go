@@ -0,0 +1,91 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestDerivePrivateKeyFromMnemonic(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := DerivePrivateKeyFromMnemonic("test test test", DefaultDerivationPath)
+		assert.Nil(t, privateKey)
+		assert.True(t, errors.Is(err, errInvalidMnemonic))
+	})
+	t.Run("invalid derivation path should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := DerivePrivateKeyFromMnemonic(testMnemonic, "m/invalid")
+		assert.Nil(t, privateKey)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := DerivePrivateKeyFromMnemonic(testMnemonic, DefaultDerivationPath)
+		require.Nil(t, err)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+
+		privateKey, err = DerivePrivateKeyFromMnemonic(testMnemonic, "m/44'/60'/0'/0/1")
+		require.Nil(t, err)
+		assert.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+	})
+	t.Run("should work with extra white spaces", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := DerivePrivateKeyFromMnemonic("\n test test test test test test\ntest test test test test  junk\n", DefaultDerivationPath)
+		require.Nil(t, err)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+	})
+}
+
+func TestDerivePrivateKeyFromSeed(t *testing.T) {
+	t.Parallel()
+
+	// test vector 1 from the BIP-32 specification
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	testCases := []struct {
+		path        string
+		expectedKey string
+	}{
+		{
+			path:        "m",
+			expectedKey: "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		},
+		{
+			path:        "m/0'",
+			expectedKey: "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		},
+		{
+			path:        "m/0'/1",
+			expectedKey: "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		},
+		{
+			path:        "m/0'/1/2'/2/1000000000",
+			expectedKey: "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+		},
+	}
+
+	for _, testCase := range testCases {
+		path, err := accounts.ParseDerivationPath(testCase.path)
+		if testCase.path == "m" {
+			path, err = accounts.DerivationPath{}, nil
+		}
+		require.Nil(t, err)
+
+		privateKey, err := derivePrivateKeyFromSeed(seed, path)
+		require.Nil(t, err)
+		assert.Equal(t, testCase.expectedKey, hex.EncodeToString(ethCrypto.FromECDSA(privateKey)), "path %s", testCase.path)
+	}
+}
@@ -0,0 +1,134 @@
+package ethereum
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	rawTransactionFilePermissions = 0644
+	rawTransactionDirPermissions  = 0755
+	minExportedTransactions       = 1
+)
+
+// ArgsRawTransactionsExporter is the DTO used in the raw transactions exporter constructor
+type ArgsRawTransactionsExporter struct {
+	Log             chainCore.Logger
+	Directory       string
+	MaxTransactions int
+}
+
+type rawTransactionsExporter struct {
+	log             chainCore.Logger
+	directory       string
+	maxTransactions int
+	getTime         func() time.Time
+
+	mut          sync.RWMutex
+	transactions []*core.ExportedTransaction
+}
+
+// NewRawTransactionsExporter creates a component that keeps the last signed execution transactions, exported instead
+// of being broadcast, and writes each of them as raw hex in the provided directory. An empty directory only keeps them
+// in memory, for the REST API
+func NewRawTransactionsExporter(args ArgsRawTransactionsExporter) (*rawTransactionsExporter, error) {
+	if check.IfNil(args.Log) {
+		return nil, clients.ErrNilLogger
+	}
+	if args.MaxTransactions < minExportedTransactions {
+		return nil, fmt.Errorf("%w for args.MaxTransactions, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxTransactions, minExportedTransactions)
+	}
+
+	return &rawTransactionsExporter{
+		log:             args.Log,
+		directory:       args.Directory,
+		maxTransactions: args.MaxTransactions,
+		getTime:         time.Now,
+		transactions:    make([]*core.ExportedTransaction, 0, args.MaxTransactions),
+	}, nil
+}
+
+// IsEnabled returns true as the signed transactions should be exported instead of being broadcast
+func (exporter *rawTransactionsExporter) IsEnabled() bool {
+	return true
+}
+
+// ExportTransaction records the provided signed transaction and, if a directory was configured, writes it as raw hex
+// in the <batch ID>-<nonce>.hex file
+func (exporter *rawTransactionsExporter) ExportTransaction(batchID uint64, tx *types.Transaction) error {
+	if tx == nil {
+		return errNilTransaction
+	}
+
+	buff, err := tx.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	exportedTx := &core.ExportedTransaction{
+		BatchID:        batchID,
+		Nonce:          tx.Nonce(),
+		TxHash:         tx.Hash().String(),
+		RawTransaction: hexutil.Encode(buff),
+		Timestamp:      exporter.getTime().Unix(),
+	}
+
+	err = exporter.writeToFile(exportedTx)
+	if err != nil {
+		return err
+	}
+
+	exporter.mut.Lock()
+	exporter.transactions = append(exporter.transactions, exportedTx)
+	if len(exporter.transactions) > exporter.maxTransactions {
+		exporter.transactions = exporter.transactions[len(exporter.transactions)-exporter.maxTransactions:]
+	}
+	exporter.mut.Unlock()
+
+	exporter.log.Info("exported the signed transaction", "batch ID", batchID, "nonce", exportedTx.Nonce,
+		"hash", exportedTx.TxHash)
+
+	return nil
+}
+
+func (exporter *rawTransactionsExporter) writeToFile(exportedTx *core.ExportedTransaction) error {
+	if len(exporter.directory) == 0 {
+		return nil
+	}
+
+	err := os.MkdirAll(exporter.directory, rawTransactionDirPermissions)
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf("%d-%d.hex", exportedTx.BatchID, exportedTx.Nonce)
+
+	return os.WriteFile(filepath.Join(exporter.directory, fileName), []byte(exportedTx.RawTransaction), rawTransactionFilePermissions)
+}
+
+// GetExportedTransactions returns the last exported transactions, the oldest first
+func (exporter *rawTransactionsExporter) GetExportedTransactions() []*core.ExportedTransaction {
+	exporter.mut.RLock()
+	defer exporter.mut.RUnlock()
+
+	transactions := make([]*core.ExportedTransaction, len(exporter.transactions))
+	copy(transactions, exporter.transactions)
+
+	return transactions
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (exporter *rawTransactionsExporter) IsInterfaceNil() bool {
+	return exporter == nil
+}
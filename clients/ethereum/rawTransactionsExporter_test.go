@@ -0,0 +1,114 @@
+package ethereum
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRawTransactionsExporter(tb testing.TB) ArgsRawTransactionsExporter {
+	return ArgsRawTransactionsExporter{
+		Log:             logger.GetOrCreate("test"),
+		Directory:       filepath.Join(tb.TempDir(), "exported"),
+		MaxTransactions: 10,
+	}
+}
+
+func TestNewRawTransactionsExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsRawTransactionsExporter(t)
+		args.Log = nil
+
+		exporter, err := NewRawTransactionsExporter(args)
+		assert.True(t, check.IfNil(exporter))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("invalid max transactions should error", func(t *testing.T) {
+		args := createMockArgsRawTransactionsExporter(t)
+		args.MaxTransactions = 0
+
+		exporter, err := NewRawTransactionsExporter(args)
+		assert.True(t, check.IfNil(exporter))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		exporter, err := NewRawTransactionsExporter(createMockArgsRawTransactionsExporter(t))
+		assert.False(t, check.IfNil(exporter))
+		assert.Nil(t, err)
+		assert.True(t, exporter.IsEnabled())
+		assert.Empty(t, exporter.GetExportedTransactions())
+	})
+}
+
+func TestRawTransactionsExporter_ExportTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		exporter, _ := NewRawTransactionsExporter(createMockArgsRawTransactionsExporter(t))
+
+		err := exporter.ExportTransaction(1, nil)
+		assert.Equal(t, errNilTransaction, err)
+	})
+	t.Run("should write the raw transaction in the directory", func(t *testing.T) {
+		args := createMockArgsRawTransactionsExporter(t)
+		exporter, _ := NewRawTransactionsExporter(args)
+		exporter.getTime = func() time.Time {
+			return time.Unix(1700000000, 0)
+		}
+
+		tx := types.NewTx(&types.LegacyTx{Nonce: 7, GasPrice: big.NewInt(1), Gas: 21000})
+		err := exporter.ExportTransaction(332, tx)
+		require.Nil(t, err)
+
+		buff, _ := tx.MarshalBinary()
+		expectedRaw := hexutil.Encode(buff)
+		fileContent, err := os.ReadFile(filepath.Join(args.Directory, "332-7.hex"))
+		require.Nil(t, err)
+		assert.Equal(t, expectedRaw, string(fileContent))
+
+		exported := exporter.GetExportedTransactions()
+		require.Len(t, exported, 1)
+		assert.Equal(t, uint64(332), exported[0].BatchID)
+		assert.Equal(t, uint64(7), exported[0].Nonce)
+		assert.Equal(t, tx.Hash().String(), exported[0].TxHash)
+		assert.Equal(t, expectedRaw, exported[0].RawTransaction)
+		assert.Equal(t, int64(1700000000), exported[0].Timestamp)
+	})
+	t.Run("empty directory should only keep the transactions in memory", func(t *testing.T) {
+		args := createMockArgsRawTransactionsExporter(t)
+		args.Directory = ""
+		exporter, _ := NewRawTransactionsExporter(args)
+
+		err := exporter.ExportTransaction(1, types.NewTx(&types.LegacyTx{}))
+		assert.Nil(t, err)
+		assert.Len(t, exporter.GetExportedTransactions(), 1)
+	})
+	t.Run("should keep only the last transactions", func(t *testing.T) {
+		args := createMockArgsRawTransactionsExporter(t)
+		args.MaxTransactions = 2
+		exporter, _ := NewRawTransactionsExporter(args)
+
+		for i := uint64(1); i <= 3; i++ {
+			err := exporter.ExportTransaction(i, types.NewTx(&types.LegacyTx{Nonce: i}))
+			require.Nil(t, err)
+		}
+
+		exported := exporter.GetExportedTransactions()
+		require.Len(t, exported, 2)
+		assert.Equal(t, uint64(2), exported[0].BatchID)
+		assert.Equal(t, uint64(3), exported[1].BatchID)
+	})
+}
@@ -49,6 +49,12 @@ func (wrapper *erc20ContractWrapper) Decimals(ctx context.Context) (uint8, error
 	return wrapper.erc20Contract.Decimals(&bind.CallOpts{Context: ctx})
 }
 
+// Symbol returns the ERC20 set symbol for the token
+func (wrapper *erc20ContractWrapper) Symbol(ctx context.Context) (string, error) {
+	wrapper.statusHandler.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.erc20Contract.Symbol(&bind.CallOpts{Context: ctx})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wrapper *erc20ContractWrapper) IsInterfaceNil() bool {
 	return wrapper == nil
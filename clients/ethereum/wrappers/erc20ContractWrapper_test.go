@@ -88,3 +88,22 @@ func TestErc20ContractWrapper_Decimals(t *testing.T) {
 	assert.True(t, handlerCalled)
 	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
 }
+
+func TestErc20ContractWrapper_Symbol(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsErc20ContractWrapper()
+	handlerCalled := false
+	args.Erc20Contract = &interactors.GenericErc20ContractStub{
+		SymbolCalled: func() (string, error) {
+			handlerCalled = true
+			return "USDC", nil
+		},
+	}
+	wrapper, _ := NewErc20ContractWrapper(args)
+	symbol, err := wrapper.Symbol(context.TODO())
+	assert.Nil(t, err)
+	assert.Equal(t, "USDC", symbol)
+	assert.True(t, handlerCalled)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
@@ -7,4 +7,8 @@ var (
 	errNilBlockchainClient = errors.New("nil blockchain client")
 	errNilMultiSigContract = errors.New("nil multi sig contract")
 	errNilSafeContract     = errors.New("nil safe contract")
+
+	errMethodNotAvailableInLightMode  = errors.New("JSON-RPC method not available in light mode")
+	errInvalidLightModeNetworkAddress = errors.New("the light mode requires an HTTP network address")
+	errLightModeProbeFailed           = errors.New("light mode capabilities probing failed")
 )
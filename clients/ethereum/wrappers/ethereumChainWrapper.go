@@ -97,6 +97,24 @@ func (wrapper *ethereumChainWrapper) FilterLogs(ctx context.Context, q ethereum.
 	return wrapper.blockchainClient.FilterLogs(ctx, q)
 }
 
+// HeaderByNumber returns the block header with the given number
+func (wrapper *ethereumChainWrapper) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.HeaderByNumber(ctx, number)
+}
+
+// CodeAt returns the contract code of the given account at the specified block number
+func (wrapper *ethereumChainWrapper) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.CodeAt(ctx, account, blockNumber)
+}
+
+// TransactionReceipt returns the receipt of a mined transaction
+func (wrapper *ethereumChainWrapper) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.TransactionReceipt(ctx, txHash)
+}
+
 // BlockNumber returns the current ethereum block number
 func (wrapper *ethereumChainWrapper) BlockNumber(ctx context.Context) (uint64, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
@@ -177,6 +195,18 @@ func (wrapper *ethereumChainWrapper) WhitelistedTokens(ctx context.Context, toke
 	return wrapper.safeContract.WhitelistedTokens(&bind.CallOpts{Context: ctx}, token)
 }
 
+// TokenMinLimits returns the minimum amount of the given token that can be deposited
+func (wrapper *ethereumChainWrapper) TokenMinLimits(ctx context.Context, token common.Address) (*big.Int, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.safeContract.TokenMinLimits(&bind.CallOpts{Context: ctx}, token)
+}
+
+// TokenMaxLimits returns the maximum amount of the given token that can be deposited
+func (wrapper *ethereumChainWrapper) TokenMaxLimits(ctx context.Context, token common.Address) (*big.Int, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.safeContract.TokenMaxLimits(&bind.CallOpts{Context: ctx}, token)
+}
+
 // IsPaused returns true if the multisig contract is paused
 func (wrapper *ethereumChainWrapper) IsPaused(ctx context.Context) (bool, error) {
 	return wrapper.multiSigContract.Paused(&bind.CallOpts{Context: ctx})
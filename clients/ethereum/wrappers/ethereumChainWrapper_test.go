@@ -231,6 +231,43 @@ func TestEthClientWrapper_NonceAt(t *testing.T) {
 	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
 }
 
+func TestEthClientWrapper_CodeAt(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	expectedAccount := common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		CodeAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+			assert.Equal(t, expectedAccount, account)
+			return []byte("code"), nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+	code, err := wrapper.CodeAt(context.Background(), expectedAccount, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("code"), code)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestEthClientWrapper_TransactionReceipt(t *testing.T) {
+	t.Parallel()
+
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	expectedHash := common.HexToHash("0x6f3e5c0d7e0b0ad4c2cbb9e5c8b0d3f4a1f2e3d4c5b6a7988776655443322110")
+	expectedReceipt := &types.Receipt{GasUsed: 123456}
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+			assert.Equal(t, expectedHash, txHash)
+			return expectedReceipt, nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+	receipt, err := wrapper.TransactionReceipt(context.Background(), expectedHash)
+	assert.Nil(t, err)
+	assert.True(t, receipt == expectedReceipt) // pointer testing
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
 func TestEthClientWrapper_ExecuteTransfer(t *testing.T) {
 	t.Parallel()
 
@@ -14,6 +14,7 @@ import (
 type genericErc20Contract interface {
 	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
 	Decimals(opts *bind.CallOpts) (uint8, error)
+	Symbol(opts *bind.CallOpts) (string, error)
 }
 
 type multiSigContract interface {
@@ -34,6 +35,8 @@ type safeContract interface {
 	MintBurnTokens(opts *bind.CallOpts, arg0 common.Address) (bool, error)
 	NativeTokens(opts *bind.CallOpts, arg0 common.Address) (bool, error)
 	WhitelistedTokens(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	TokenMinLimits(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	TokenMaxLimits(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
 }
 
 type blockchainClient interface {
@@ -42,4 +45,18 @@ type blockchainClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// EthereumBackend defines the ethereum JSON-RPC operations used by the relayer, both by the contract bindings and by
+// the ethereum chain wrapper
+type EthereumBackend interface {
+	bind.ContractBackend
+	BlockNumber(ctx context.Context) (uint64, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	ChainID(ctx context.Context) (*big.Int, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
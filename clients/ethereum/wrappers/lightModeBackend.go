@@ -0,0 +1,124 @@
+package wrappers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	// FullRPCMode uses the ethereum client as it is, allowing any JSON-RPC method
+	FullRPCMode = "full"
+	// LightRPCMode restricts the ethereum client to the widely supported JSON-RPC methods: no pending state queries,
+	// no filters or subscriptions and no websockets
+	LightRPCMode = "light"
+
+	quorumMethod = "quorum"
+)
+
+// lightModeBackend wraps an ethereum backend, replacing the pending state queries with the latest block queries and
+// rejecting the subscriptions. The contract calls only use eth_call, the events are only fetched with eth_getLogs
+type lightModeBackend struct {
+	EthereumBackend
+}
+
+// NewLightModeBackend creates a new light mode backend instance
+func NewLightModeBackend(backend EthereumBackend) (*lightModeBackend, error) {
+	if check.IfNilReflect(backend) {
+		return nil, errNilBlockchainClient
+	}
+
+	return &lightModeBackend{
+		EthereumBackend: backend,
+	}, nil
+}
+
+// PendingCodeAt returns the code of the given account at the latest block
+func (backend *lightModeBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return backend.CodeAt(ctx, account, nil)
+}
+
+// PendingNonceAt returns the account nonce of the given account at the latest block
+func (backend *lightModeBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return backend.NonceAt(ctx, account, nil)
+}
+
+// SuggestGasTipCap returns an error as the dynamic fee transactions are not used in light mode
+func (backend *lightModeBackend) SuggestGasTipCap(_ context.Context) (*big.Int, error) {
+	return nil, fmt.Errorf("%w: eth_maxPriorityFeePerGas", errMethodNotAvailableInLightMode)
+}
+
+// SubscribeFilterLogs returns an error as the subscriptions are not available in light mode
+func (backend *lightModeBackend) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, _ chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, fmt.Errorf("%w: eth_subscribe", errMethodNotAvailableInLightMode)
+}
+
+// CheckLightModeNetworkAddress returns an error if the provided network address does not use HTTP, as the websockets
+// are not available in light mode
+func CheckLightModeNetworkAddress(networkAddress string) error {
+	if strings.HasPrefix(networkAddress, "http://") || strings.HasPrefix(networkAddress, "https://") {
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s", errInvalidLightModeNetworkAddress, networkAddress)
+}
+
+// ProbeLightModeCapabilities calls, once, each JSON-RPC method used in light mode so an unsupported method is
+// reported at startup instead of failing a bridge step
+func ProbeLightModeCapabilities(ctx context.Context, backend EthereumBackend, multisigAddress common.Address) error {
+	_, err := backend.ChainID(ctx)
+	if err != nil {
+		return createProbeError("eth_chainId", err)
+	}
+
+	blockNumber, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return createProbeError("eth_blockNumber", err)
+	}
+
+	latestBlock := big.NewInt(0).SetUint64(blockNumber)
+	_, err = backend.HeaderByNumber(ctx, latestBlock)
+	if err != nil {
+		return createProbeError("eth_getBlockByNumber", err)
+	}
+
+	_, err = backend.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: latestBlock,
+		ToBlock:   latestBlock,
+		Addresses: []common.Address{multisigAddress},
+	})
+	if err != nil {
+		return createProbeError("eth_getLogs", err)
+	}
+
+	_, err = backend.NonceAt(ctx, multisigAddress, latestBlock)
+	if err != nil {
+		return createProbeError("eth_getTransactionCount", err)
+	}
+
+	bridgeABI, err := contract.BridgeMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	input, err := bridgeABI.Pack(quorumMethod)
+	if err != nil {
+		return err
+	}
+	_, err = backend.CallContract(ctx, ethereum.CallMsg{To: &multisigAddress, Data: input}, latestBlock)
+	if err != nil {
+		return createProbeError("eth_call", err)
+	}
+
+	return nil
+}
+
+func createProbeError(method string, err error) error {
+	return fmt.Errorf("%w: %s returned %s", errLightModeProbeFailed, method, err.Error())
+}
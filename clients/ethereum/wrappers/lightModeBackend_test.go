@@ -0,0 +1,165 @@
+package wrappers
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/stretchr/testify/assert"
+)
+
+func createWorkingEthereumBackendStub() *bridgeTests.EthereumBackendStub {
+	return &bridgeTests.EthereumBackendStub{
+		ContractBackendStub: bridgeTests.ContractBackendStub{
+			CodeAtCalled: func(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+				return []byte("code"), nil
+			},
+			CallContractCalled: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+				return make([]byte, 32), nil
+			},
+			HeaderByNumberCalled: func(ctx context.Context, number *big.Int) (*types.Header, error) {
+				return &types.Header{Number: number}, nil
+			},
+			FilterLogsCalled: func(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+				return make([]types.Log, 0), nil
+			},
+		},
+		BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+			return 100, nil
+		},
+		NonceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+			return 1, nil
+		},
+		ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(1), nil
+		},
+	}
+}
+
+func TestNewLightModeBackend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil backend should error", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewLightModeBackend(nil)
+		assert.Nil(t, backend)
+		assert.Equal(t, errNilBlockchainClient, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		backend, err := NewLightModeBackend(createWorkingEthereumBackendStub())
+		assert.NotNil(t, backend)
+		assert.Nil(t, err)
+	})
+}
+
+func TestLightModeBackend_PendingMethodsShouldUseTheLatestBlock(t *testing.T) {
+	t.Parallel()
+
+	stub := createWorkingEthereumBackendStub()
+	stub.PendingCodeAtCalled = func(ctx context.Context, account common.Address) ([]byte, error) {
+		assert.Fail(t, "should have not called PendingCodeAt")
+		return nil, nil
+	}
+	stub.PendingNonceAtCalled = func(ctx context.Context, account common.Address) (uint64, error) {
+		assert.Fail(t, "should have not called PendingNonceAt")
+		return 0, nil
+	}
+	stub.NonceAtCalled = func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+		assert.Nil(t, blockNumber)
+		return 37, nil
+	}
+	backend, _ := NewLightModeBackend(stub)
+
+	code, err := backend.PendingCodeAt(context.Background(), common.Address{})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("code"), code)
+
+	nonce, err := backend.PendingNonceAt(context.Background(), common.Address{})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(37), nonce)
+}
+
+func TestLightModeBackend_UnavailableMethodsShouldError(t *testing.T) {
+	t.Parallel()
+
+	backend, _ := NewLightModeBackend(createWorkingEthereumBackendStub())
+
+	tipCap, err := backend.SuggestGasTipCap(context.Background())
+	assert.Nil(t, tipCap)
+	assert.True(t, errors.Is(err, errMethodNotAvailableInLightMode))
+
+	subscription, err := backend.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{}, make(chan types.Log))
+	assert.Nil(t, subscription)
+	assert.True(t, errors.Is(err, errMethodNotAvailableInLightMode))
+}
+
+func TestCheckLightModeNetworkAddress(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, CheckLightModeNetworkAddress("http://127.0.0.1:8545"))
+	assert.Nil(t, CheckLightModeNetworkAddress("https://mainnet.provider.io/key"))
+
+	err := CheckLightModeNetworkAddress("wss://mainnet.provider.io/key")
+	assert.True(t, errors.Is(err, errInvalidLightModeNetworkAddress))
+	err = CheckLightModeNetworkAddress("/tmp/geth.ipc")
+	assert.True(t, errors.Is(err, errInvalidLightModeNetworkAddress))
+}
+
+func TestProbeLightModeCapabilities(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("method not found")
+	t.Run("eth_getLogs unavailable should error", func(t *testing.T) {
+		t.Parallel()
+
+		stub := createWorkingEthereumBackendStub()
+		stub.FilterLogsCalled = func(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+			return nil, expectedErr
+		}
+
+		err := ProbeLightModeCapabilities(context.Background(), stub, common.Address{})
+		assert.True(t, errors.Is(err, errLightModeProbeFailed))
+		assert.True(t, strings.Contains(err.Error(), "eth_getLogs"))
+	})
+	t.Run("eth_call unavailable should error", func(t *testing.T) {
+		t.Parallel()
+
+		stub := createWorkingEthereumBackendStub()
+		stub.CallContractCalled = func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			return nil, expectedErr
+		}
+
+		err := ProbeLightModeCapabilities(context.Background(), stub, common.Address{})
+		assert.True(t, errors.Is(err, errLightModeProbeFailed))
+		assert.True(t, strings.Contains(err.Error(), "eth_call"))
+	})
+	t.Run("should only query the latest block", func(t *testing.T) {
+		t.Parallel()
+
+		multisigAddress := common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+		stub := createWorkingEthereumBackendStub()
+		stub.FilterLogsCalled = func(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+			assert.Equal(t, big.NewInt(100), query.FromBlock)
+			assert.Equal(t, big.NewInt(100), query.ToBlock)
+			assert.Equal(t, []common.Address{multisigAddress}, query.Addresses)
+			return make([]types.Log, 0), nil
+		}
+		stub.CallContractCalled = func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+			assert.Equal(t, &multisigAddress, call.To)
+			assert.Equal(t, big.NewInt(100), blockNumber)
+			return make([]byte, 32), nil
+		}
+
+		err := ProbeLightModeCapabilities(context.Background(), stub, multisigAddress)
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,15 @@
+package faucet
+
+import "errors"
+
+var (
+	errEmptyName            = errors.New("empty name")
+	errNilBalanceGetter     = errors.New("nil balance getter")
+	errNilHTTPClient        = errors.New("nil HTTP client")
+	errEmptyURL             = errors.New("empty URL")
+	errEmptyAddress         = errors.New("empty address")
+	errInvalidValue         = errors.New("invalid value")
+	errNilLogger            = errors.New("nil logger")
+	errNilTimer             = errors.New("nil timer")
+	errUnexpectedHTTPStatus = errors.New("unexpected HTTP status")
+)
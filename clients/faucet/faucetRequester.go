@@ -0,0 +1,172 @@
+package faucet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// AddressPlaceholder is replaced with the funded address in the faucet URL and request body
+const AddressPlaceholder = "{address}"
+
+const maxResponseBodyLogLength = 256
+
+// ArgsFaucetRequester is the DTO used to create a new faucet requester
+type ArgsFaucetRequester struct {
+	Name           string
+	BalanceGetter  BalanceGetter
+	HTTPClient     HTTPClient
+	URL            string
+	Method         string
+	Body           string
+	Address        string
+	MinimumBalance *big.Int
+	Cooldown       time.Duration
+	Log            logger.Logger
+	Timer          core.Timer
+}
+
+type faucetRequester struct {
+	name           string
+	balanceGetter  BalanceGetter
+	httpClient     HTTPClient
+	url            string
+	method         string
+	body           string
+	minimumBalance *big.Int
+	cooldown       int64
+	log            logger.Logger
+	timer          core.Timer
+
+	lastRequestTimestamp int64
+}
+
+// NewFaucetRequester creates a test-support component that requests funds from a faucet each time the balance of the
+// relayer account drops below the configured minimum. Meant to be used only on test networks
+func NewFaucetRequester(args ArgsFaucetRequester) (*faucetRequester, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	method := strings.ToUpper(args.Method)
+	if len(method) == 0 {
+		method = http.MethodPost
+	}
+
+	return &faucetRequester{
+		name:                 args.Name,
+		balanceGetter:        args.BalanceGetter,
+		httpClient:           args.HTTPClient,
+		url:                  strings.ReplaceAll(args.URL, AddressPlaceholder, args.Address),
+		method:               method,
+		body:                 strings.ReplaceAll(args.Body, AddressPlaceholder, args.Address),
+		minimumBalance:       big.NewInt(0).Set(args.MinimumBalance),
+		cooldown:             int64(args.Cooldown.Seconds()),
+		log:                  args.Log,
+		timer:                args.Timer,
+		lastRequestTimestamp: -int64(args.Cooldown.Seconds()),
+	}, nil
+}
+
+func checkArgs(args ArgsFaucetRequester) error {
+	if len(args.Name) == 0 {
+		return errEmptyName
+	}
+	if check.IfNil(args.BalanceGetter) {
+		return errNilBalanceGetter
+	}
+	if check.IfNilReflect(args.HTTPClient) {
+		return errNilHTTPClient
+	}
+	if len(args.URL) == 0 {
+		return errEmptyURL
+	}
+	if len(args.Address) == 0 {
+		return errEmptyAddress
+	}
+	if args.MinimumBalance == nil || args.MinimumBalance.Sign() <= 0 {
+		return fmt.Errorf("%w for MinimumBalance", errInvalidValue)
+	}
+	if args.Cooldown < 0 {
+		return fmt.Errorf("%w for Cooldown: %v", errInvalidValue, args.Cooldown)
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
+
+	return nil
+}
+
+// Execute checks the balance of the account and requests funds if it is below the minimum. Only one request is
+// issued in a cooldown interval so the faucet is not flooded while the funds are on their way
+func (requester *faucetRequester) Execute(ctx context.Context) error {
+	balance, err := requester.balanceGetter.GetBalance(ctx)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(requester.minimumBalance) >= 0 {
+		return nil
+	}
+
+	now := requester.timer.NowUnix()
+	if now-requester.lastRequestTimestamp < requester.cooldown {
+		requester.log.Debug(fmt.Sprintf("%s faucet: balance still below the minimum, waiting for the cooldown", requester.name),
+			"balance", balance.String(), "minimum", requester.minimumBalance.String())
+		return nil
+	}
+	requester.lastRequestTimestamp = now
+
+	requester.log.Info(fmt.Sprintf("%s faucet: balance below the minimum, requesting funds", requester.name),
+		"balance", balance.String(), "minimum", requester.minimumBalance.String(), "URL", requester.url)
+
+	return requester.requestFunds(ctx)
+}
+
+func (requester *faucetRequester) requestFunds(ctx context.Context) error {
+	var body io.Reader
+	if len(requester.body) > 0 {
+		body = strings.NewReader(requester.body)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, requester.method, requester.url, body)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		request.Header.Set("Content-Type", "application/json")
+	}
+
+	response, err := requester.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxResponseBodyLogLength))
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w %d from the %s faucet: %s", errUnexpectedHTTPStatus, response.StatusCode, requester.name, string(responseBody))
+	}
+
+	requester.log.Info(fmt.Sprintf("%s faucet: funds requested", requester.name), "response", string(responseBody))
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (requester *faucetRequester) IsInterfaceNil() bool {
+	return requester == nil
+}
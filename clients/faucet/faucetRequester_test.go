@@ -0,0 +1,255 @@
+package faucet
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type balanceGetterStub struct {
+	GetBalanceCalled func(ctx context.Context) (*big.Int, error)
+}
+
+func (stub *balanceGetterStub) GetBalance(ctx context.Context) (*big.Int, error) {
+	return stub.GetBalanceCalled(ctx)
+}
+
+func (stub *balanceGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsFaucetRequester() ArgsFaucetRequester {
+	return ArgsFaucetRequester{
+		Name: "MultiversX",
+		BalanceGetter: &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		},
+		HTTPClient:     http.DefaultClient,
+		URL:            "http://localhost/faucet",
+		Method:         "post",
+		Body:           `{"address":"{address}"}`,
+		Address:        "erd1address",
+		MinimumBalance: big.NewInt(10),
+		Cooldown:       time.Minute,
+		Log:            &testsCommon.LoggerStub{},
+		Timer:          &testsCommon.TimerMock{},
+	}
+}
+
+func TestNewFaucetRequester(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.Name = ""
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errEmptyName, err)
+	})
+	t.Run("nil balance getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.BalanceGetter = nil
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errNilBalanceGetter, err)
+	})
+	t.Run("nil HTTP client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.HTTPClient = nil
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errNilHTTPClient, err)
+	})
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.URL = ""
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errEmptyURL, err)
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.Address = ""
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errEmptyAddress, err)
+	})
+	t.Run("invalid minimum balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.MinimumBalance = nil
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.True(t, errors.Is(err, errInvalidValue))
+
+		args.MinimumBalance = big.NewInt(0)
+		requester, err = NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.True(t, errors.Is(err, errInvalidValue))
+	})
+	t.Run("negative cooldown should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.Cooldown = -time.Second
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.True(t, errors.Is(err, errInvalidValue))
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.Log = nil
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.Timer = nil
+
+		requester, err := NewFaucetRequester(args)
+		assert.True(t, check.IfNil(requester))
+		assert.Equal(t, errNilTimer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		requester, err := NewFaucetRequester(createMockArgsFaucetRequester())
+		assert.False(t, check.IfNil(requester))
+		assert.Nil(t, err)
+		assert.Equal(t, http.MethodPost, requester.method)
+		assert.Equal(t, `{"address":"erd1address"}`, requester.body)
+	})
+}
+
+func TestFaucetRequester_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("balance getter errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsFaucetRequester()
+		args.BalanceGetter = &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		requester, _ := NewFaucetRequester(args)
+
+		err := requester.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("balance above the minimum should not request funds", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFaucetRequester()
+		args.BalanceGetter = &balanceGetterStub{
+			GetBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(10), nil
+			},
+		}
+		args.HTTPClient = &testsCommon.HTTPClientStub{
+			DoCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Fail(t, "should have not requested funds")
+				return nil, nil
+			},
+		}
+		requester, _ := NewFaucetRequester(args)
+
+		err := requester.Execute(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("faucet error status should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(http.StatusTooManyRequests)
+			_, _ = writer.Write([]byte("slow down"))
+		}))
+		defer server.Close()
+
+		args := createMockArgsFaucetRequester()
+		args.URL = server.URL
+		requester, _ := NewFaucetRequester(args)
+
+		err := requester.Execute(context.Background())
+		assert.True(t, errors.Is(err, errUnexpectedHTTPStatus))
+		assert.True(t, strings.Contains(err.Error(), "slow down"))
+	})
+	t.Run("should request funds once per cooldown", func(t *testing.T) {
+		t.Parallel()
+
+		numRequests := uint32(0)
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			atomic.AddUint32(&numRequests, 1)
+			assert.Equal(t, http.MethodPost, request.Method)
+			assert.Equal(t, "/faucet/erd1address", request.URL.Path)
+			body, _ := io.ReadAll(request.Body)
+			assert.Equal(t, `{"address":"erd1address"}`, string(body))
+			assert.Equal(t, "application/json", request.Header.Get("Content-Type"))
+		}))
+		defer server.Close()
+
+		now := int64(1000)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return now
+		}
+
+		args := createMockArgsFaucetRequester()
+		args.URL = server.URL + "/faucet/" + AddressPlaceholder
+		args.Timer = timer
+		requester, _ := NewFaucetRequester(args)
+
+		err := requester.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numRequests))
+
+		now += 59
+		err = requester.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numRequests))
+
+		now++
+		err = requester.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numRequests))
+	})
+}
@@ -0,0 +1,18 @@
+package faucet
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+)
+
+// HTTPClient is the interface we expect to call in order to do the HTTP requests
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// BalanceGetter is able to fetch the current native balance of the funded account
+type BalanceGetter interface {
+	GetBalance(ctx context.Context) (*big.Int, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,36 @@
+package feeEstimator
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilEthereumClient signals that a nil Ethereum client has been provided
+var ErrNilEthereumClient = errors.New("nil Ethereum client")
+
+// ErrNilErc20ContractsHolder signals that a nil ERC20 contracts holder has been provided
+var ErrNilErc20ContractsHolder = errors.New("nil ERC20 contracts holder")
+
+// ErrNilMultiversXClient signals that a nil MultiversX client has been provided
+var ErrNilMultiversXClient = errors.New("nil MultiversX client")
+
+// ErrNilMultiversXDataGetter signals that a nil MultiversX data getter has been provided
+var ErrNilMultiversXDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrNilRoundingPolicy signals that a nil rounding policy has been provided
+var ErrNilRoundingPolicy = errors.New("nil rounding policy")
+
+// ErrInvalidBatchLatency signals that an invalid batch latency has been provided
+var ErrInvalidBatchLatency = errors.New("invalid batch latency")
+
+// ErrInvalidQueryTimeout signals that an invalid query timeout has been provided
+var ErrInvalidQueryTimeout = errors.New("invalid query timeout")
+
+// ErrInvalidAmount signals that an invalid amount has been provided
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// ErrUnpairedToken signals that the token is not paired with a token on the other chain
+var ErrUnpairedToken = errors.New("unpaired token")
+
+// ErrMissingDecimals signals that the ESDT system smart contract did not return the number of decimals of the token
+var ErrMissingDecimals = errors.New("missing number of decimals")
@@ -0,0 +1,431 @@
+package feeEstimator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	"github.com/multiversx/mx-bridge-eth-go/clients/esdtRoles"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	getTokenPropertiesFuncName  = "getTokenProperties"
+	numDecimalsPropertyPrefix   = "NumDecimals-"
+	decimalsBase                = 10
+	maxNumDecimalsBitSize       = 8
+	estimatedLatencyBatchesBase = 1
+)
+
+// ArgsFeeEstimator represents the DTO struct used in the NewFeeEstimator constructor function
+type ArgsFeeEstimator struct {
+	Log                  logger.Logger
+	Chain                chain.Chain
+	EthereumClient       EthereumClient
+	Erc20ContractsHolder Erc20ContractsHolder
+	MultiversXClient     MultiversXClient
+	DataGetter           MultiversXDataGetter
+	RoundingPolicy       RoundingPolicy
+	BatchLatency         time.Duration
+	CacheDuration        time.Duration
+	QueryTimeout         time.Duration
+}
+
+// tokenInfo holds the settings of a bridged token, as seen from the source chain of the deposit
+type tokenInfo struct {
+	direction           string
+	sourceToken         string
+	destinationToken    string
+	sourceDecimals      uint8
+	destinationDecimals uint8
+	fee                 *big.Int
+	minAmount           *big.Int
+	maxAmount           *big.Int
+	computedAt          time.Time
+}
+
+type pendingBatches struct {
+	numBatches uint64
+	computedAt time.Time
+}
+
+type feeEstimator struct {
+	log                  logger.Logger
+	ethereumClient       EthereumClient
+	erc20ContractsHolder Erc20ContractsHolder
+	multiversXClient     MultiversXClient
+	dataGetter           MultiversXDataGetter
+	roundingPolicy       RoundingPolicy
+	systemSCAddress      sdkCore.AddressHandler
+	toMultiversXName     string
+	toEthereumName       string
+	batchLatency         time.Duration
+	cacheDuration        time.Duration
+	queryTimeout         time.Duration
+	getTime              func() time.Time
+
+	mutCache       sync.Mutex
+	tokens         map[string]*tokenInfo
+	pendingBatches map[string]*pendingBatches
+}
+
+// NewFeeEstimator creates the component quoting the deposits before they are made: the fee, the amount received on the
+// destination chain, the deposit limits and the expected latency of the transfer
+func NewFeeEstimator(args ArgsFeeEstimator) (*feeEstimator, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	systemSCAddress, err := data.NewAddressFromBech32String(esdtRoles.ESDTSystemSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return &feeEstimator{
+		log:                  args.Log,
+		ethereumClient:       args.EthereumClient,
+		erc20ContractsHolder: args.Erc20ContractsHolder,
+		multiversXClient:     args.MultiversXClient,
+		dataGetter:           args.DataGetter,
+		roundingPolicy:       args.RoundingPolicy,
+		systemSCAddress:      systemSCAddress,
+		toMultiversXName:     args.Chain.EvmCompatibleChainToMultiversXName(),
+		toEthereumName:       args.Chain.MultiversXToEvmCompatibleChainName(),
+		batchLatency:         args.BatchLatency,
+		cacheDuration:        args.CacheDuration,
+		queryTimeout:         args.QueryTimeout,
+		getTime:              time.Now,
+		tokens:               make(map[string]*tokenInfo),
+		pendingBatches:       make(map[string]*pendingBatches),
+	}, nil
+}
+
+func checkArgs(args ArgsFeeEstimator) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.EthereumClient) {
+		return ErrNilEthereumClient
+	}
+	if check.IfNil(args.Erc20ContractsHolder) {
+		return ErrNilErc20ContractsHolder
+	}
+	if check.IfNil(args.MultiversXClient) {
+		return ErrNilMultiversXClient
+	}
+	if check.IfNil(args.DataGetter) {
+		return ErrNilMultiversXDataGetter
+	}
+	if check.IfNil(args.RoundingPolicy) {
+		return ErrNilRoundingPolicy
+	}
+	if args.BatchLatency <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidBatchLatency, args.BatchLatency)
+	}
+	if args.QueryTimeout <= 0 {
+		return fmt.Errorf("%w: %v", ErrInvalidQueryTimeout, args.QueryTimeout)
+	}
+
+	return nil
+}
+
+// EstimateFee quotes the deposit of the provided amount. The token is either the ERC20 address, for the deposits made
+// on Ethereum, or the ESDT token identifier, for the deposits made on MultiversX. The token settings and the number of
+// pending batches are cached for the configured duration
+func (estimator *feeEstimator) EstimateFee(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+	if amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAmount, amount)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, estimator.queryTimeout)
+	defer cancel()
+
+	info, err := estimator.getTokenInfo(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the settings of the token %s", err, token)
+	}
+	numPendingBatches, err := estimator.getPendingBatches(ctx, info.direction)
+	if err != nil {
+		return nil, fmt.Errorf("%w while getting the pending batches", err)
+	}
+
+	destinationAmount := big.NewInt(0)
+	dust := big.NewInt(0)
+	if amount.Cmp(info.fee) > 0 {
+		destinationAmount, dust, err = estimator.roundingPolicy.Convert(info.sourceToken, big.NewInt(0).Sub(amount, info.fee),
+			info.sourceDecimals, info.destinationDecimals)
+		if err != nil {
+			return nil, err
+		}
+	}
+	withinLimits := amount.Cmp(info.minAmount) >= 0 && amount.Cmp(info.maxAmount) <= 0
+	estimatedLatency := estimator.batchLatency * time.Duration(numPendingBatches+estimatedLatencyBatchesBase)
+
+	return &core.FeeEstimate{
+		Direction:                 info.direction,
+		SourceToken:               info.sourceToken,
+		DestinationToken:          info.destinationToken,
+		Amount:                    amount.String(),
+		Fee:                       info.fee.String(),
+		DestinationAmount:         destinationAmount.String(),
+		Dust:                      dust.String(),
+		RoundingMode:              estimator.roundingPolicy.Mode(info.sourceToken),
+		SourceDecimals:            info.sourceDecimals,
+		DestinationDecimals:       info.destinationDecimals,
+		MinAmount:                 info.minAmount.String(),
+		MaxAmount:                 info.maxAmount.String(),
+		WithinLimits:              withinLimits,
+		PendingBatches:            numPendingBatches,
+		EstimatedLatencyInSeconds: uint64(estimatedLatency.Seconds()),
+	}, nil
+}
+
+func (estimator *feeEstimator) getTokenInfo(ctx context.Context, token string) (*tokenInfo, error) {
+	estimator.mutCache.Lock()
+	defer estimator.mutCache.Unlock()
+
+	now := estimator.getTime()
+	cached, found := estimator.tokens[token]
+	if found && now.Sub(cached.computedAt) < estimator.cacheDuration {
+		return cached, nil
+	}
+
+	var info *tokenInfo
+	var err error
+	if common.IsHexAddress(token) {
+		info, err = estimator.getERC20TokenInfo(ctx, common.HexToAddress(token))
+	} else {
+		info, err = estimator.getESDTTokenInfo(ctx, []byte(token))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	estimator.log.Debug("fee estimator: refreshed the token settings", "token", token, "direction", info.direction,
+		"fee", info.fee, "min amount", info.minAmount, "max amount", info.maxAmount)
+
+	info.computedAt = now
+	estimator.tokens[token] = info
+
+	return info, nil
+}
+
+// getERC20TokenInfo returns the settings of a token deposited on Ethereum. The Ethereum safe contract does not charge
+// a fee on deposits
+func (estimator *feeEstimator) getERC20TokenInfo(ctx context.Context, erc20Token common.Address) (*tokenInfo, error) {
+	esdtToken, err := getPairedToken(estimator.dataGetter.GetTokenIdForErc20Address(ctx, erc20Token.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &tokenInfo{
+		direction:        estimator.toMultiversXName,
+		sourceToken:      erc20Token.Hex(),
+		destinationToken: string(esdtToken),
+		fee:              big.NewInt(0),
+	}
+	info.minAmount, err = estimator.ethereumClient.TokenMinLimits(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	info.maxAmount, err = estimator.ethereumClient.TokenMaxLimits(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	info.sourceDecimals, err = estimator.erc20ContractsHolder.Decimals(ctx, erc20Token)
+	if err != nil {
+		return nil, err
+	}
+	info.destinationDecimals, err = estimator.getESDTDecimals(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// getESDTTokenInfo returns the settings of a token deposited on MultiversX, where the safe contract charges the fee
+// required to execute the transfer on Ethereum
+func (estimator *feeEstimator) getESDTTokenInfo(ctx context.Context, esdtToken []byte) (*tokenInfo, error) {
+	erc20Token, err := getPairedToken(estimator.dataGetter.GetERC20AddressForTokenId(ctx, esdtToken))
+	if err != nil {
+		return nil, err
+	}
+	erc20Address := common.BytesToAddress(erc20Token)
+
+	info := &tokenInfo{
+		direction:        estimator.toEthereumName,
+		sourceToken:      string(esdtToken),
+		destinationToken: erc20Address.Hex(),
+	}
+	info.fee, err = estimator.dataGetter.GetRequiredFee(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	info.minAmount, err = estimator.dataGetter.GetTokenMinLimit(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	info.maxAmount, err = estimator.dataGetter.GetTokenMaxLimit(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	info.sourceDecimals, err = estimator.getESDTDecimals(ctx, esdtToken)
+	if err != nil {
+		return nil, err
+	}
+	info.destinationDecimals, err = estimator.erc20ContractsHolder.Decimals(ctx, erc20Address)
+	if err != nil {
+		return nil, err
+	}
+
+	return info, nil
+}
+
+func getPairedToken(response [][]byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 || len(response[0]) == 0 {
+		return nil, ErrUnpairedToken
+	}
+
+	return response[0], nil
+}
+
+// getESDTDecimals returns the number of decimals of the ESDT token. The ESDT system smart contract returns the token
+// properties as entries, the number of decimals being formatted as NumDecimals-<value>
+func (estimator *feeEstimator) getESDTDecimals(ctx context.Context, token []byte) (uint8, error) {
+	request, err := builders.NewVMQueryBuilder().
+		Address(estimator.systemSCAddress).
+		Function(getTokenPropertiesFuncName).
+		ArgBytes(token).
+		ToVmValueRequest()
+	if err != nil {
+		return 0, err
+	}
+
+	response, err := estimator.dataGetter.ExecuteQueryReturningBytes(ctx, request)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range response {
+		value, found := strings.CutPrefix(string(entry), numDecimalsPropertyPrefix)
+		if !found {
+			continue
+		}
+
+		numDecimals, errParse := strconv.ParseUint(value, decimalsBase, maxNumDecimalsBitSize)
+		if errParse != nil {
+			return 0, fmt.Errorf("%w: %s", ErrMissingDecimals, entry)
+		}
+
+		return uint8(numDecimals), nil
+	}
+
+	return 0, fmt.Errorf("%w for the token %s", ErrMissingDecimals, token)
+}
+
+func (estimator *feeEstimator) getPendingBatches(ctx context.Context, direction string) (uint64, error) {
+	estimator.mutCache.Lock()
+	defer estimator.mutCache.Unlock()
+
+	now := estimator.getTime()
+	cached, found := estimator.pendingBatches[direction]
+	if found && now.Sub(cached.computedAt) < estimator.cacheDuration {
+		return cached.numBatches, nil
+	}
+
+	var numBatches uint64
+	var err error
+	if direction == estimator.toMultiversXName {
+		numBatches, err = estimator.countPendingToMultiversX(ctx)
+	} else {
+		numBatches, err = estimator.countPendingToEthereum(ctx)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	estimator.pendingBatches[direction] = &pendingBatches{
+		numBatches: numBatches,
+		computedAt: now,
+	}
+
+	return numBatches, nil
+}
+
+// countPendingToMultiversX returns the number of Ethereum batches not yet executed on MultiversX
+func (estimator *feeEstimator) countPendingToMultiversX(ctx context.Context) (uint64, error) {
+	batchID, err := estimator.multiversXClient.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	numBatches := uint64(0)
+	for {
+		batch, _, errGetBatch := estimator.ethereumClient.GetBatch(ctx, batchID+1) // all the batches, regardless if they are final or not
+		if errGetBatch != nil {
+			return 0, errGetBatch
+		}
+
+		isBatchInvalid := batch.ID != batchID+1 || len(batch.Deposits) == 0
+		if isBatchInvalid {
+			return numBatches, nil
+		}
+
+		numBatches++
+		batchID++
+	}
+}
+
+// countPendingToEthereum returns the number of MultiversX batches not yet executed on Ethereum
+func (estimator *feeEstimator) countPendingToEthereum(ctx context.Context) (uint64, error) {
+	batchID, err := estimator.multiversXClient.GetLastMvxBatchID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	numBatches := uint64(0)
+	for ; batchID > 0; batchID-- {
+		batch, errGetBatch := estimator.multiversXClient.GetBatch(ctx, batchID)
+		if errors.Is(errGetBatch, clients.ErrNoBatchAvailable) {
+			return numBatches, nil
+		}
+		if errGetBatch != nil {
+			return 0, errGetBatch
+		}
+
+		wasExecuted, errWasExecuted := estimator.ethereumClient.WasExecuted(ctx, batch.ID)
+		if errWasExecuted != nil {
+			return 0, errWasExecuted
+		}
+		if wasExecuted {
+			return numBatches, nil
+		}
+
+		numBatches++
+	}
+
+	return numBatches, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (estimator *feeEstimator) IsInterfaceNil() bool {
+	return estimator == nil
+}
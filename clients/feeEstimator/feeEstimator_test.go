@@ -0,0 +1,411 @@
+package feeEstimator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	"github.com/multiversx/mx-bridge-eth-go/clients/roundingPolicy"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	esdtToken      = "USDC-abcdef"
+	erc20TokenHex  = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	erc20Decimals  = uint8(18)
+	lastEthBatchID = uint64(5)
+	lastMvxBatchID = uint64(3)
+	mvxFee         = int64(1000000)
+)
+
+var erc20Token = common.HexToAddress(erc20TokenHex)
+
+// createMockArgsFeeEstimator creates the arguments of a bridge where the ERC20 token has 18 decimals and the ESDT token
+// has 6 decimals, with 2 pending batches towards MultiversX and 1 pending batch towards Ethereum
+func createMockArgsFeeEstimator() ArgsFeeEstimator {
+	return ArgsFeeEstimator{
+		Log:   &testsCommon.LoggerStub{},
+		Chain: chain.Ethereum,
+		EthereumClient: &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error) {
+				if nonce > lastEthBatchID+2 {
+					return &core.TransferBatch{}, false, nil
+				}
+
+				return &core.TransferBatch{
+					ID:       nonce,
+					Deposits: []*core.DepositTransfer{{Amount: big.NewInt(1)}},
+				}, true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return batchID < lastMvxBatchID, nil
+			},
+			TokenMinLimitsCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(1000), nil
+			},
+			TokenMaxLimitsCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(0).Exp(big.NewInt(10), big.NewInt(24), nil), nil
+			},
+		},
+		Erc20ContractsHolder: &bridgeTests.ERC20ContractsHolderStub{
+			DecimalsCalled: func(ctx context.Context, erc20Address common.Address) (uint8, error) {
+				return erc20Decimals, nil
+			},
+		},
+		MultiversXClient: &bridgeTests.MultiversXClientStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return lastEthBatchID, nil
+			},
+			GetLastMvxBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return lastMvxBatchID, nil
+			},
+			GetBatchCalled: func(ctx context.Context, batchID uint64) (*core.TransferBatch, error) {
+				return &core.TransferBatch{
+					ID:       batchID,
+					Deposits: []*core.DepositTransfer{{Amount: big.NewInt(1)}},
+				}, nil
+			},
+		},
+		DataGetter: &bridgeTests.DataGetterStub{
+			GetTokenIdForErc20AddressCalled: func(ctx context.Context, erc20Address []byte) ([][]byte, error) {
+				if common.BytesToAddress(erc20Address) != erc20Token {
+					return make([][]byte, 0), nil
+				}
+
+				return [][]byte{[]byte(esdtToken)}, nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				if string(tokenId) != esdtToken {
+					return make([][]byte, 0), nil
+				}
+
+				return [][]byte{erc20Token.Bytes()}, nil
+			},
+			GetRequiredFeeCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return big.NewInt(mvxFee), nil
+			},
+			GetTokenMinLimitCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return big.NewInt(2000000), nil
+			},
+			GetTokenMaxLimitCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return big.NewInt(100000000), nil
+			},
+			ExecuteQueryReturningBytesCalled: func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+				if request.FuncName != getTokenPropertiesFuncName {
+					return nil, errors.New("unexpected function " + request.FuncName)
+				}
+
+				return [][]byte{
+					[]byte("USDC"),
+					[]byte("FungibleESDT"),
+					[]byte("owner"),
+					[]byte("0"),
+					[]byte("0"),
+					[]byte("NumDecimals-6"),
+					[]byte("IsPaused-false"),
+				}, nil
+			},
+		},
+		RoundingPolicy: createRoundingPolicy(roundingPolicy.ModeFloor),
+		BatchLatency:   time.Minute,
+		CacheDuration:  time.Minute,
+		QueryTimeout:   time.Second,
+	}
+}
+
+func createRoundingPolicy(mode string) RoundingPolicy {
+	policy, _ := roundingPolicy.NewRoundingPolicy(config.RoundingPolicyConfig{
+		Enabled:     true,
+		DefaultMode: mode,
+	})
+
+	return policy
+}
+
+func TestNewFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.Log = nil
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.EthereumClient = nil
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("nil ERC20 contracts holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.Erc20ContractsHolder = nil
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilErc20ContractsHolder, err)
+	})
+	t.Run("nil MultiversX client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.MultiversXClient = nil
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilMultiversXClient, err)
+	})
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.DataGetter = nil
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilMultiversXDataGetter, err)
+	})
+	t.Run("nil rounding policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.RoundingPolicy = nil
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilRoundingPolicy, err)
+	})
+	t.Run("invalid batch latency should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.BatchLatency = 0
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.True(t, errors.Is(err, ErrInvalidBatchLatency))
+	})
+	t.Run("invalid query timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.QueryTimeout = 0
+
+		instance, err := NewFeeEstimator(args)
+		assert.True(t, check.IfNil(instance))
+		assert.True(t, errors.Is(err, ErrInvalidQueryTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewFeeEstimator(createMockArgsFeeEstimator())
+		assert.False(t, check.IfNil(instance))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFeeEstimator_EstimateFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewFeeEstimator(createMockArgsFeeEstimator())
+
+		estimate, err := instance.EstimateFee(context.Background(), esdtToken, nil)
+		assert.Nil(t, estimate)
+		assert.True(t, errors.Is(err, ErrInvalidAmount))
+
+		estimate, err = instance.EstimateFee(context.Background(), esdtToken, big.NewInt(0))
+		assert.Nil(t, estimate)
+		assert.True(t, errors.Is(err, ErrInvalidAmount))
+	})
+	t.Run("unpaired token should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewFeeEstimator(createMockArgsFeeEstimator())
+
+		estimate, err := instance.EstimateFee(context.Background(), "OTHER-abcdef", big.NewInt(1000))
+		assert.Nil(t, estimate)
+		assert.True(t, errors.Is(err, ErrUnpairedToken))
+	})
+	t.Run("missing ESDT decimals should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		dataGetter := args.DataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.ExecuteQueryReturningBytesCalled = func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+			return [][]byte{[]byte("USDC"), []byte("FungibleESDT")}, nil
+		}
+		instance, _ := NewFeeEstimator(args)
+
+		estimate, err := instance.EstimateFee(context.Background(), esdtToken, big.NewInt(1000))
+		assert.Nil(t, estimate)
+		assert.True(t, errors.Is(err, ErrMissingDecimals))
+	})
+	t.Run("deposit on Ethereum should have no fee and convert the decimals", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewFeeEstimator(createMockArgsFeeEstimator())
+
+		amount, _ := big.NewInt(0).SetString("1234567890000000000", 10)
+		estimate, err := instance.EstimateFee(context.Background(), erc20TokenHex, amount)
+		require.Nil(t, err)
+		expectedEstimate := &core.FeeEstimate{
+			Direction:                 "EthereumToMultiversX",
+			SourceToken:               erc20Token.Hex(),
+			DestinationToken:          esdtToken,
+			Amount:                    "1234567890000000000",
+			Fee:                       "0",
+			DestinationAmount:         "1234567",
+			Dust:                      "890000000000",
+			RoundingMode:              roundingPolicy.ModeFloor,
+			SourceDecimals:            erc20Decimals,
+			DestinationDecimals:       6,
+			MinAmount:                 "1000",
+			MaxAmount:                 "1000000000000000000000000",
+			WithinLimits:              true,
+			PendingBatches:            2,
+			EstimatedLatencyInSeconds: 180,
+		}
+		assert.Equal(t, expectedEstimate, estimate)
+	})
+	t.Run("deposit on MultiversX should subtract the fee and convert the decimals", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewFeeEstimator(createMockArgsFeeEstimator())
+
+		estimate, err := instance.EstimateFee(context.Background(), esdtToken, big.NewInt(5000000))
+		require.Nil(t, err)
+		expectedEstimate := &core.FeeEstimate{
+			Direction:                 "MultiversXToEthereum",
+			SourceToken:               esdtToken,
+			DestinationToken:          erc20Token.Hex(),
+			Amount:                    "5000000",
+			Fee:                       "1000000",
+			DestinationAmount:         "4000000000000000000",
+			Dust:                      "0",
+			RoundingMode:              roundingPolicy.ModeFloor,
+			SourceDecimals:            6,
+			DestinationDecimals:       erc20Decimals,
+			MinAmount:                 "2000000",
+			MaxAmount:                 "100000000",
+			WithinLimits:              true,
+			PendingBatches:            1,
+			EstimatedLatencyInSeconds: 120,
+		}
+		assert.Equal(t, expectedEstimate, estimate)
+	})
+	t.Run("dust rejected by the rounding policy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		args.RoundingPolicy = createRoundingPolicy(roundingPolicy.ModeRejectOnDust)
+		instance, _ := NewFeeEstimator(args)
+
+		amount, _ := big.NewInt(0).SetString("1234567890000000000", 10)
+		estimate, err := instance.EstimateFee(context.Background(), erc20TokenHex, amount)
+		assert.Nil(t, estimate)
+		assert.True(t, errors.Is(err, roundingPolicy.ErrDustAmount))
+
+		amount, _ = big.NewInt(0).SetString("1234567000000000000", 10)
+		estimate, err = instance.EstimateFee(context.Background(), erc20TokenHex, amount)
+		require.Nil(t, err)
+		assert.Equal(t, "1234567", estimate.DestinationAmount)
+		assert.Equal(t, "0", estimate.Dust)
+	})
+	t.Run("amount not covering the fee should have a 0 destination amount", func(t *testing.T) {
+		t.Parallel()
+
+		instance, _ := NewFeeEstimator(createMockArgsFeeEstimator())
+
+		estimate, err := instance.EstimateFee(context.Background(), esdtToken, big.NewInt(mvxFee))
+		require.Nil(t, err)
+		assert.Equal(t, "0", estimate.DestinationAmount)
+		assert.False(t, estimate.WithinLimits)
+	})
+	t.Run("no more MultiversX batches should end the pending batches", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeEstimator()
+		mvxClient := args.MultiversXClient.(*bridgeTests.MultiversXClientStub)
+		mvxClient.GetBatchCalled = func(ctx context.Context, batchID uint64) (*core.TransferBatch, error) {
+			return nil, clients.ErrNoBatchAvailable
+		}
+		instance, _ := NewFeeEstimator(args)
+
+		estimate, err := instance.EstimateFee(context.Background(), esdtToken, big.NewInt(5000000))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), estimate.PendingBatches)
+		assert.Equal(t, uint64(60), estimate.EstimatedLatencyInSeconds)
+	})
+	t.Run("pending batches query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsFeeEstimator()
+		mvxClient := args.MultiversXClient.(*bridgeTests.MultiversXClientStub)
+		mvxClient.GetLastExecutedEthBatchIDCalled = func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		}
+		instance, _ := NewFeeEstimator(args)
+
+		estimate, err := instance.EstimateFee(context.Background(), erc20TokenHex, big.NewInt(5000))
+		assert.Nil(t, estimate)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should serve the cached settings in the cache duration", func(t *testing.T) {
+		t.Parallel()
+
+		numFeeQueries := 0
+		args := createMockArgsFeeEstimator()
+		dataGetter := args.DataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.GetRequiredFeeCalled = func(ctx context.Context, token []byte) (*big.Int, error) {
+			numFeeQueries++
+			return big.NewInt(mvxFee * int64(numFeeQueries)), nil
+		}
+		instance, _ := NewFeeEstimator(args)
+		currentTime := time.Unix(1700000000, 0)
+		instance.getTime = func() time.Time {
+			return currentTime
+		}
+
+		estimate, err := instance.EstimateFee(context.Background(), esdtToken, big.NewInt(5000000))
+		require.Nil(t, err)
+		assert.Equal(t, "1000000", estimate.Fee)
+
+		currentTime = currentTime.Add(args.CacheDuration - time.Second)
+		estimate, err = instance.EstimateFee(context.Background(), esdtToken, big.NewInt(5000000))
+		require.Nil(t, err)
+		assert.Equal(t, "1000000", estimate.Fee)
+		assert.Equal(t, 1, numFeeQueries)
+
+		currentTime = currentTime.Add(time.Second)
+		estimate, err = instance.EstimateFee(context.Background(), esdtToken, big.NewInt(5000000))
+		require.Nil(t, err)
+		assert.Equal(t, "2000000", estimate.Fee)
+		assert.Equal(t, 2, numFeeQueries)
+	})
+}
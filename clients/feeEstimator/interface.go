@@ -0,0 +1,51 @@
+package feeEstimator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// EthereumClient defines the Ethereum client operations used to quote a deposit
+type EthereumClient interface {
+	GetBatch(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error)
+	WasExecuted(ctx context.Context, mvxBatchID uint64) (bool, error)
+	TokenMinLimits(ctx context.Context, token common.Address) (*big.Int, error)
+	TokenMaxLimits(ctx context.Context, token common.Address) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// Erc20ContractsHolder defines the component able to return the decimals of an ERC20 token
+type Erc20ContractsHolder interface {
+	Decimals(ctx context.Context, erc20Address common.Address) (uint8, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXClient defines the MultiversX client operations used to count the pending batches
+type MultiversXClient interface {
+	GetBatch(ctx context.Context, batchID uint64) (*core.TransferBatch, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetLastMvxBatchID(ctx context.Context) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXDataGetter defines the MultiversX queries used to pair the tokens and to get the safe contract settings
+type MultiversXDataGetter interface {
+	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	GetRequiredFee(ctx context.Context, token []byte) (*big.Int, error)
+	GetTokenMinLimit(ctx context.Context, token []byte) (*big.Int, error)
+	GetTokenMaxLimit(ctx context.Context, token []byte) (*big.Int, error)
+	ExecuteQueryReturningBytes(ctx context.Context, request *data.VmValueRequest) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// RoundingPolicy defines the component converting the amounts between tokens with different numbers of decimals
+type RoundingPolicy interface {
+	Mode(token string) string
+	Convert(token string, amount *big.Int, sourceDecimals uint8, destinationDecimals uint8) (*big.Int, *big.Int, error)
+	IsInterfaceNil() bool
+}
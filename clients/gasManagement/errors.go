@@ -7,6 +7,3 @@ var ErrLatestGasPricesWereNotFetched = errors.New("latest gas price values could
 
 // ErrInvalidGasPriceSelector signals that an invalid gas price selector has been provided
 var ErrInvalidGasPriceSelector = errors.New("invalid gas price selector")
-
-// ErrGasPriceIsHigherThanTheMaximumSet signals that the fetched gas price is higher than the maximum set
-var ErrGasPriceIsHigherThanTheMaximumSet = errors.New("fetched gas price is higher than the maximum set")
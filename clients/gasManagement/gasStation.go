@@ -115,7 +115,7 @@ func (gs *gasStation) processLoop(ctx context.Context) {
 	gs.loopStatus.SetValue(true)
 	defer gs.loopStatus.SetValue(false)
 
-	timer := time.NewTimer(gs.requestPollingInterval)
+	timer := time.NewTimer(gs.getSettings().RequestPollingInterval)
 	defer timer.Stop()
 
 	for {
@@ -132,27 +132,41 @@ func (gs *gasStation) processLoop(ctx context.Context) {
 }
 
 func (gs *gasStation) doRequestWithRetryMechanism(ctx context.Context) time.Duration {
-	requestContext, cancel := context.WithTimeout(ctx, gs.requestTime)
+	settings := gs.getSettings()
+	requestContext, cancel := context.WithTimeout(ctx, settings.RequestTime)
 	defer cancel()
-	err := gs.doRequest(requestContext)
+	err := gs.doRequest(requestContext, settings.RequestURL)
 	if err == nil {
 		gs.fetchRetries = 0
-		return gs.requestPollingInterval
+		return settings.RequestPollingInterval
 	}
 
 	gs.fetchRetries++
-	if gs.fetchRetries <= gs.maximumFetchRetries {
+	if gs.fetchRetries <= settings.MaximumFetchRetries {
 		gs.log.Debug("gasHandler.processLoop", "message", err.Error())
-		return gs.requestRetryDelay
+		return settings.RequestRetryDelay
 	}
 
 	gs.log.Error("gasHandler.processLoop", "error", err.Error())
 	gs.fetchRetries = 0
-	return gs.requestPollingInterval
+	return settings.RequestPollingInterval
 }
 
-func (gs *gasStation) doRequest(ctx context.Context) error {
-	bytes, err := gs.doRequestReturningBytes(ctx)
+func (gs *gasStation) getSettings() core.GasStationSettings {
+	gs.mut.RLock()
+	defer gs.mut.RUnlock()
+
+	return core.GasStationSettings{
+		RequestURL:             gs.requestURL,
+		RequestPollingInterval: gs.requestPollingInterval,
+		RequestRetryDelay:      gs.requestRetryDelay,
+		MaximumFetchRetries:    gs.maximumFetchRetries,
+		RequestTime:            gs.requestTime,
+	}
+}
+
+func (gs *gasStation) doRequest(ctx context.Context, requestURL string) error {
+	bytes, err := gs.doRequestReturningBytes(ctx, requestURL)
 	if err != nil {
 		return fmt.Errorf("%w: %q", err, string(bytes))
 	}
@@ -185,8 +199,8 @@ func (gs *gasStation) doRequest(ctx context.Context) error {
 	return nil
 }
 
-func (gs *gasStation) doRequestReturningBytes(ctx context.Context) ([]byte, error) {
-	request, err := http.NewRequestWithContext(ctx, http.MethodGet, gs.requestURL, nil)
+func (gs *gasStation) doRequestReturningBytes(ctx context.Context, requestURL string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +234,7 @@ func (gs *gasStation) GetCurrentGasPrice() (*big.Int, error) {
 
 	if gs.latestGasPrice > gs.maximumGasPrice {
 		return big.NewInt(0), fmt.Errorf("%w maximum value: %d, fetched value: %d, gas price selector: %s",
-			ErrGasPriceIsHigherThanTheMaximumSet, gs.maximumGasPrice, gs.latestGasPrice, gs.gasPriceSelector)
+			clients.ErrGasPriceIsHigherThanTheMaximumSet, gs.maximumGasPrice, gs.latestGasPrice, gs.gasPriceSelector)
 	}
 
 	result := big.NewInt(int64(gs.latestGasPrice))
@@ -230,6 +244,42 @@ func (gs *gasStation) GetCurrentGasPrice() (*big.Int, error) {
 	return result.Mul(result, gs.gasPriceMultiplier), nil
 }
 
+// ReloadConfig validates and applies the reloaded gas station settings. The new request settings are used starting
+// with the next request, while the new gas price settings are used starting with the next fetched gas price
+func (gs *gasStation) ReloadConfig(cfg core.ReloadableConfig) error {
+	settings := cfg.GasStation
+	err := checkArgs(ArgsGasStation{
+		RequestURL:             settings.RequestURL,
+		RequestPollingInterval: settings.RequestPollingInterval,
+		RequestRetryDelay:      settings.RequestRetryDelay,
+		MaximumFetchRetries:    settings.MaximumFetchRetries,
+		RequestTime:            settings.RequestTime,
+		MaximumGasPrice:        settings.MaximumGasPrice,
+		GasPriceSelector:       settings.GasPriceSelector,
+		GasPriceMultiplier:     settings.GasPriceMultiplier,
+	})
+	if err != nil {
+		return err
+	}
+
+	gs.mut.Lock()
+	gs.requestURL = settings.RequestURL
+	gs.requestPollingInterval = settings.RequestPollingInterval
+	gs.requestRetryDelay = settings.RequestRetryDelay
+	gs.maximumFetchRetries = settings.MaximumFetchRetries
+	gs.requestTime = settings.RequestTime
+	gs.maximumGasPrice = settings.MaximumGasPrice
+	gs.gasPriceSelector = settings.GasPriceSelector
+	gs.gasPriceMultiplier = big.NewInt(int64(settings.GasPriceMultiplier))
+	gs.mut.Unlock()
+
+	gs.log.Info("gas station: settings reloaded", "polling interval", settings.RequestPollingInterval,
+		"maximum gas price", settings.MaximumGasPrice, "gas price selector", settings.GasPriceSelector,
+		"gas price multiplier", settings.GasPriceMultiplier)
+
+	return nil
+}
+
 // Close will stop any started go routines
 func (gs *gasStation) Close() error {
 	gs.cancel()
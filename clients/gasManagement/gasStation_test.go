@@ -410,7 +410,7 @@ func TestGasStation_GetCurrentGasPriceExceededMaximum(t *testing.T) {
 	assert.True(t, gs.loopStatus.IsSet())
 
 	price, err := gs.GetCurrentGasPrice()
-	require.True(t, errors.Is(err, ErrGasPriceIsHigherThanTheMaximumSet))
+	require.True(t, errors.Is(err, clients.ErrGasPriceIsHigherThanTheMaximumSet))
 	assert.Equal(t, big.NewInt(0), price)
 	_ = gs.Close()
 }
@@ -444,6 +444,73 @@ func TestGasStation_GetCurrentGasPriceBelowMin(t *testing.T) {
 	_ = gs.Close()
 }
 
+func TestGasStation_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	createSettings := func() core.GasStationSettings {
+		args := createMockArgsGasStation()
+		return core.GasStationSettings{
+			RequestURL:             args.RequestURL,
+			RequestPollingInterval: args.RequestPollingInterval,
+			RequestRetryDelay:      args.RequestRetryDelay,
+			MaximumFetchRetries:    args.MaximumFetchRetries,
+			RequestTime:            args.RequestTime,
+			MaximumGasPrice:        args.MaximumGasPrice,
+			GasPriceSelector:       args.GasPriceSelector,
+			GasPriceMultiplier:     args.GasPriceMultiplier,
+		}
+	}
+
+	t.Run("invalid settings should error", func(t *testing.T) {
+		t.Parallel()
+
+		gs, _ := NewGasStation(createMockArgsGasStation())
+		defer func() {
+			_ = gs.Close()
+		}()
+
+		settings := createSettings()
+		settings.GasPriceSelector = "invalid"
+		err := gs.ReloadConfig(core.ReloadableConfig{GasStation: settings})
+		assert.True(t, errors.Is(err, ErrInvalidGasPriceSelector))
+
+		settings = createSettings()
+		settings.RequestPollingInterval = 0
+		err = gs.ReloadConfig(core.ReloadableConfig{GasStation: settings})
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Equal(t, time.Second, gs.getSettings().RequestPollingInterval)
+	})
+	t.Run("should apply the new settings", func(t *testing.T) {
+		t.Parallel()
+
+		gs, _ := NewGasStation(createMockArgsGasStation())
+		defer func() {
+			_ = gs.Close()
+		}()
+		gs.mut.Lock()
+		gs.latestGasPrice = 81
+		gs.mut.Unlock()
+
+		settings := createSettings()
+		settings.RequestPollingInterval = time.Minute
+		settings.MaximumGasPrice = 80
+		settings.GasPriceMultiplier = 2
+		err := gs.ReloadConfig(core.ReloadableConfig{GasStation: settings})
+		require.Nil(t, err)
+		assert.Equal(t, time.Minute, gs.getSettings().RequestPollingInterval)
+
+		_, err = gs.GetCurrentGasPrice()
+		assert.True(t, errors.Is(err, clients.ErrGasPriceIsHigherThanTheMaximumSet))
+
+		settings.MaximumGasPrice = 100
+		err = gs.ReloadConfig(core.ReloadableConfig{GasStation: settings})
+		require.Nil(t, err)
+		price, err := gs.GetCurrentGasPrice()
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(162), price)
+	})
+}
+
 func createMockGasStationResponse() gasStationResponse {
 	return gasStationResponse{
 		Status:  "1",
@@ -0,0 +1,16 @@
+package gasUsageTracker
+
+import "errors"
+
+var (
+	errEmptyName              = errors.New("empty name")
+	errNilGasUsedGetter       = errors.New("nil gas used getter")
+	errNilReceiptProvider     = errors.New("nil receipt provider")
+	errNilTransactionProvider = errors.New("nil transaction provider")
+	errNilStorer              = errors.New("nil storer")
+	errNilStatusHandler       = errors.New("nil status handler")
+	errNilLogger              = errors.New("nil logger")
+	errInvalidValue           = errors.New("invalid value")
+	errNilTransactionInfo     = errors.New("nil transaction info")
+	errNilTransactionReceipt  = errors.New("nil transaction receipt")
+)
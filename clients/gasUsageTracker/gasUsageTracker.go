@@ -0,0 +1,315 @@
+package gasUsageTracker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	storerKeyPrefix           = "gas-usage-"
+	minWindowSize             = 2
+	minMaxPendingTransactions = 1
+	maxQueriesPerTransaction  = 100
+)
+
+// ArgsGasUsageTracker is the DTO used to create a new gas usage tracker
+type ArgsGasUsageTracker struct {
+	Name                   string
+	GasUsedGetter          GasUsedGetter
+	Storer                 core.Storer
+	StatusHandler          core.StatusHandler
+	Log                    logger.Logger
+	WindowSize             int
+	ThresholdPercent       uint64
+	MaxPendingTransactions int
+}
+
+// functionGasUsage holds the gas usage history of a contract function, persisted so it survives the restarts
+type functionGasUsage struct {
+	Baseline  uint64   `json:"baseline"`
+	Samples   []uint64 `json:"samples"`
+	Regressed bool     `json:"regressed"`
+}
+
+type pendingTransaction struct {
+	hash       string
+	function   string
+	numQueries int
+}
+
+type gasUsageTracker struct {
+	name                   string
+	storerKey              string
+	gasUsedGetter          GasUsedGetter
+	storer                 core.Storer
+	statusHandler          core.StatusHandler
+	log                    logger.Logger
+	windowSize             int
+	thresholdPercent       uint64
+	maxPendingTransactions int
+
+	mut     sync.Mutex
+	pending []*pendingTransaction
+	usage   map[string]*functionGasUsage
+}
+
+// NewGasUsageTracker creates a component that records the gas used by the sent transactions for each contract
+// function and alerts when the gas used by a function stays above its baseline by more than the configured threshold
+func NewGasUsageTracker(args ArgsGasUsageTracker) (*gasUsageTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := &gasUsageTracker{
+		name:                   args.Name,
+		storerKey:              storerKeyPrefix + args.Name,
+		gasUsedGetter:          args.GasUsedGetter,
+		storer:                 args.Storer,
+		statusHandler:          args.StatusHandler,
+		log:                    args.Log,
+		windowSize:             args.WindowSize,
+		thresholdPercent:       args.ThresholdPercent,
+		maxPendingTransactions: args.MaxPendingTransactions,
+		pending:                make([]*pendingTransaction, 0),
+		usage:                  make(map[string]*functionGasUsage),
+	}
+	tracker.loadUsage()
+	tracker.updateMetrics()
+
+	return tracker, nil
+}
+
+func checkArgs(args ArgsGasUsageTracker) error {
+	if len(args.Name) == 0 {
+		return errEmptyName
+	}
+	if check.IfNil(args.GasUsedGetter) {
+		return errNilGasUsedGetter
+	}
+	if check.IfNil(args.Storer) {
+		return errNilStorer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return errNilStatusHandler
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if args.WindowSize < minWindowSize {
+		return fmt.Errorf("%w for WindowSize: %d, minimum: %d", errInvalidValue, args.WindowSize, minWindowSize)
+	}
+	if args.ThresholdPercent == 0 {
+		return fmt.Errorf("%w for ThresholdPercent: %d", errInvalidValue, args.ThresholdPercent)
+	}
+	if args.MaxPendingTransactions < minMaxPendingTransactions {
+		return fmt.Errorf("%w for MaxPendingTransactions: %d, minimum: %d",
+			errInvalidValue, args.MaxPendingTransactions, minMaxPendingTransactions)
+	}
+
+	return nil
+}
+
+func (tracker *gasUsageTracker) loadUsage() {
+	buff, err := tracker.storer.Get([]byte(tracker.storerKey))
+	if err != nil {
+		return
+	}
+
+	usage := make(map[string]*functionGasUsage)
+	err = json.Unmarshal(buff, &usage)
+	if err != nil {
+		tracker.log.Warn("gasUsageTracker: corrupted gas usage history, discarding", "error", err)
+		return
+	}
+
+	tracker.usage = usage
+}
+
+// Track queues the sent transaction so its gas used is recorded for the provided contract function once it is final
+func (tracker *gasUsageTracker) Track(hash string, function string) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	tracker.pending = append(tracker.pending, &pendingTransaction{
+		hash:     hash,
+		function: function,
+	})
+	if len(tracker.pending) <= tracker.maxPendingTransactions {
+		return
+	}
+
+	dropped := tracker.pending[0]
+	tracker.pending = tracker.pending[1:]
+	tracker.log.Debug("gasUsageTracker: too many pending transactions, dropping the oldest one",
+		"hash", dropped.hash, "function", dropped.function)
+}
+
+// Execute fetches the gas used by the pending transactions, records it for the final ones and raises the alerts
+func (tracker *gasUsageTracker) Execute(ctx context.Context) error {
+	tracker.mut.Lock()
+	pending := append(make([]*pendingTransaction, 0, len(tracker.pending)), tracker.pending...)
+	tracker.mut.Unlock()
+
+	processed := make(map[*pendingTransaction]struct{})
+	numRecorded := 0
+	for _, tx := range pending {
+		gasUsed, isFinal, err := tracker.gasUsedGetter.GetGasUsed(ctx, tx.hash)
+		tx.numQueries++
+		if err != nil {
+			tracker.log.Debug("gasUsageTracker: can not fetch the gas used", "hash", tx.hash, "error", err)
+		}
+		if isFinal {
+			tracker.recordGasUsed(tx.function, gasUsed)
+			processed[tx] = struct{}{}
+			numRecorded++
+			continue
+		}
+		if tx.numQueries >= maxQueriesPerTransaction {
+			tracker.log.Debug("gasUsageTracker: transaction not final after the maximum number of queries, dropping",
+				"hash", tx.hash, "function", tx.function)
+			processed[tx] = struct{}{}
+		}
+	}
+
+	tracker.removeProcessed(processed)
+	if numRecorded == 0 {
+		return nil
+	}
+
+	tracker.updateMetrics()
+
+	return tracker.saveUsage()
+}
+
+func (tracker *gasUsageTracker) removeProcessed(processed map[*pendingTransaction]struct{}) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	remaining := make([]*pendingTransaction, 0, len(tracker.pending))
+	for _, tx := range tracker.pending {
+		_, isProcessed := processed[tx]
+		if !isProcessed {
+			remaining = append(remaining, tx)
+		}
+	}
+	tracker.pending = remaining
+}
+
+// recordGasUsed adds the sample to the function window. The baseline is established from the first full window and
+// then follows the gradual changes that stay within the threshold. A regression is raised only when all the samples
+// from the window exceed the threshold, so a single expensive transaction does not trigger the alert
+func (tracker *gasUsageTracker) recordGasUsed(function string, gasUsed uint64) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	usage, found := tracker.usage[function]
+	if !found {
+		usage = &functionGasUsage{
+			Samples: make([]uint64, 0, tracker.windowSize),
+		}
+		tracker.usage[function] = usage
+	}
+
+	usage.Samples = append(usage.Samples, gasUsed)
+	if len(usage.Samples) > tracker.windowSize {
+		usage.Samples = usage.Samples[len(usage.Samples)-tracker.windowSize:]
+	}
+	if len(usage.Samples) < tracker.windowSize {
+		return
+	}
+
+	average, minimum, maximum := computeStatistics(usage.Samples)
+	if usage.Baseline == 0 {
+		usage.Baseline = average
+		tracker.log.Info(fmt.Sprintf("%s gas usage baseline established", tracker.name),
+			"function", function, "baseline", usage.Baseline)
+		return
+	}
+
+	limit := usage.Baseline + usage.Baseline*tracker.thresholdPercent/100
+	wasRegressed := usage.Regressed
+	usage.Regressed = minimum > limit
+	if maximum <= limit {
+		usage.Baseline = average
+	}
+
+	if usage.Regressed && !wasRegressed {
+		tracker.log.Warn(fmt.Sprintf("%s gas usage regression detected, check the contract deployment and the configured gas limits", tracker.name),
+			"function", function, "baseline", usage.Baseline, "minimum in window", minimum,
+			"average in window", average, "threshold percent", tracker.thresholdPercent)
+	}
+	if !usage.Regressed && wasRegressed {
+		tracker.log.Info(fmt.Sprintf("%s gas usage is back within the threshold", tracker.name),
+			"function", function, "baseline", usage.Baseline, "average in window", average)
+	}
+}
+
+func computeStatistics(samples []uint64) (uint64, uint64, uint64) {
+	sum := uint64(0)
+	minimum := samples[0]
+	maximum := samples[0]
+	for _, sample := range samples {
+		sum += sample
+		if sample < minimum {
+			minimum = sample
+		}
+		if sample > maximum {
+			maximum = sample
+		}
+	}
+
+	return sum / uint64(len(samples)), minimum, maximum
+}
+
+func (tracker *gasUsageTracker) updateMetrics() {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	functions := make([]string, 0, len(tracker.usage))
+	for function := range tracker.usage {
+		functions = append(functions, function)
+	}
+	sort.Strings(functions)
+
+	numRegressions := 0
+	for _, function := range functions {
+		usage := tracker.usage[function]
+		if len(usage.Samples) > 0 {
+			average, _, _ := computeStatistics(usage.Samples)
+			tracker.statusHandler.SetIntMetric(tracker.metricName(function, "average gas used"), int(average))
+		}
+		tracker.statusHandler.SetIntMetric(tracker.metricName(function, "gas baseline"), int(usage.Baseline))
+		if usage.Regressed {
+			numRegressions++
+		}
+	}
+	tracker.statusHandler.SetIntMetric(fmt.Sprintf("%s gas usage regressions", tracker.name), numRegressions)
+}
+
+func (tracker *gasUsageTracker) metricName(function string, metric string) string {
+	return fmt.Sprintf("%s %s %s", tracker.name, function, metric)
+}
+
+func (tracker *gasUsageTracker) saveUsage() error {
+	tracker.mut.Lock()
+	buff, err := json.Marshal(tracker.usage)
+	tracker.mut.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return tracker.storer.Put([]byte(tracker.storerKey), buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracker *gasUsageTracker) IsInterfaceNil() bool {
+	return tracker == nil
+}
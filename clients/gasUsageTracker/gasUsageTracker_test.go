@@ -0,0 +1,347 @@
+package gasUsageTracker
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type gasUsedGetterStub struct {
+	GetGasUsedCalled func(ctx context.Context, hash string) (uint64, bool, error)
+}
+
+func (stub *gasUsedGetterStub) GetGasUsed(ctx context.Context, hash string) (uint64, bool, error) {
+	return stub.GetGasUsedCalled(ctx, hash)
+}
+
+func (stub *gasUsedGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsGasUsageTracker() ArgsGasUsageTracker {
+	return ArgsGasUsageTracker{
+		Name: "Ethereum",
+		GasUsedGetter: &gasUsedGetterStub{
+			GetGasUsedCalled: func(ctx context.Context, hash string) (uint64, bool, error) {
+				return 0, false, nil
+			},
+		},
+		Storer:                 testsCommon.NewStorerMock(),
+		StatusHandler:          testsCommon.NewStatusHandlerMock("gas-usage-tracker"),
+		Log:                    &testsCommon.LoggerStub{},
+		WindowSize:             3,
+		ThresholdPercent:       20,
+		MaxPendingTransactions: 10,
+	}
+}
+
+// recordSamples tracks and executes one transaction for each provided gas value
+func recordSamples(t *testing.T, tracker *gasUsageTracker, getter *gasUsedGetterStub, function string, gasValues ...uint64) {
+	for _, gasUsed := range gasValues {
+		value := gasUsed
+		getter.GetGasUsedCalled = func(ctx context.Context, hash string) (uint64, bool, error) {
+			return value, true, nil
+		}
+		tracker.Track("hash", function)
+		require.Nil(t, tracker.Execute(context.Background()))
+	}
+}
+
+func TestNewGasUsageTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.Name = ""
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errEmptyName, err)
+	})
+	t.Run("nil gas used getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.GasUsedGetter = nil
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilGasUsedGetter, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.Storer = nil
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilStorer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.StatusHandler = nil
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.Log = nil
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("invalid window size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.WindowSize = 1
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "WindowSize"))
+	})
+	t.Run("invalid threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.ThresholdPercent = 0
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "ThresholdPercent"))
+	})
+	t.Run("invalid max pending transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.MaxPendingTransactions = 0
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxPendingTransactions"))
+	})
+	t.Run("corrupted history should be discarded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		_ = args.Storer.Put([]byte("gas-usage-Ethereum"), []byte("not a json"))
+		warnCalled := false
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				warnCalled = true
+			},
+		}
+
+		tracker, err := NewGasUsageTracker(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tracker))
+		assert.True(t, warnCalled)
+		assert.Empty(t, tracker.usage)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := NewGasUsageTracker(createMockArgsGasUsageTracker())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tracker))
+	})
+}
+
+func TestGasUsageTracker_Track(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGasUsageTracker()
+	args.MaxPendingTransactions = 2
+	tracker, _ := NewGasUsageTracker(args)
+
+	tracker.Track("hash1", "function")
+	tracker.Track("hash2", "function")
+	tracker.Track("hash3", "function")
+
+	require.Len(t, tracker.pending, 2)
+	assert.Equal(t, "hash2", tracker.pending[0].hash)
+	assert.Equal(t, "hash3", tracker.pending[1].hash)
+}
+
+func TestGasUsageTracker_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should keep the not final transactions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		args.GasUsedGetter = &gasUsedGetterStub{
+			GetGasUsedCalled: func(ctx context.Context, hash string) (uint64, bool, error) {
+				if hash == "failing" {
+					return 0, false, errors.New("expected error")
+				}
+
+				return 1000, hash == "final", nil
+			},
+		}
+		tracker, _ := NewGasUsageTracker(args)
+		tracker.Track("pending", "function")
+		tracker.Track("final", "function")
+		tracker.Track("failing", "function")
+
+		err := tracker.Execute(context.Background())
+		assert.Nil(t, err)
+		require.Len(t, tracker.pending, 2)
+		assert.Equal(t, "pending", tracker.pending[0].hash)
+		assert.Equal(t, "failing", tracker.pending[1].hash)
+		assert.Equal(t, []uint64{1000}, tracker.usage["function"].Samples)
+	})
+	t.Run("should drop the transactions never becoming final", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, _ := NewGasUsageTracker(createMockArgsGasUsageTracker())
+		tracker.Track("pending", "function")
+
+		for i := 0; i < maxQueriesPerTransaction-1; i++ {
+			_ = tracker.Execute(context.Background())
+		}
+		assert.Len(t, tracker.pending, 1)
+
+		_ = tracker.Execute(context.Background())
+		assert.Empty(t, tracker.pending)
+		assert.Empty(t, tracker.usage)
+	})
+	t.Run("should establish the baseline from the first full window", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		getter := args.GasUsedGetter.(*gasUsedGetterStub)
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		tracker, _ := NewGasUsageTracker(args)
+
+		recordSamples(t, tracker, getter, "executeTransfer", 100, 110)
+		assert.Equal(t, uint64(0), tracker.usage["executeTransfer"].Baseline)
+		assert.Equal(t, 105, statusHandler.GetIntMetric("Ethereum executeTransfer average gas used"))
+
+		recordSamples(t, tracker, getter, "executeTransfer", 120)
+		assert.Equal(t, uint64(110), tracker.usage["executeTransfer"].Baseline)
+		assert.Equal(t, 110, statusHandler.GetIntMetric("Ethereum executeTransfer gas baseline"))
+		assert.Equal(t, 0, statusHandler.GetIntMetric("Ethereum gas usage regressions"))
+	})
+	t.Run("a single expensive transaction should not raise the alert", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		getter := args.GasUsedGetter.(*gasUsedGetterStub)
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		tracker, _ := NewGasUsageTracker(args)
+
+		recordSamples(t, tracker, getter, "executeTransfer", 100, 100, 100, 500, 100)
+		assert.False(t, tracker.usage["executeTransfer"].Regressed)
+		assert.Equal(t, uint64(100), tracker.usage["executeTransfer"].Baseline)
+		assert.Equal(t, 0, statusHandler.GetIntMetric("Ethereum gas usage regressions"))
+	})
+	t.Run("a sustained increase should raise the alert until the gas used recovers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		getter := args.GasUsedGetter.(*gasUsedGetterStub)
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		warnMessages := make([]string, 0)
+		mutMessages := sync.Mutex{}
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				mutMessages.Lock()
+				warnMessages = append(warnMessages, message)
+				mutMessages.Unlock()
+			},
+		}
+		tracker, _ := NewGasUsageTracker(args)
+
+		recordSamples(t, tracker, getter, "executeTransfer", 100, 100, 100)
+		recordSamples(t, tracker, getter, "performAction", 50, 50, 50)
+		recordSamples(t, tracker, getter, "executeTransfer", 130, 130)
+		assert.False(t, tracker.usage["executeTransfer"].Regressed)
+
+		recordSamples(t, tracker, getter, "executeTransfer", 130)
+		assert.True(t, tracker.usage["executeTransfer"].Regressed)
+		assert.False(t, tracker.usage["performAction"].Regressed)
+		assert.Equal(t, uint64(100), tracker.usage["executeTransfer"].Baseline)
+		assert.Equal(t, 1, statusHandler.GetIntMetric("Ethereum gas usage regressions"))
+
+		recordSamples(t, tracker, getter, "executeTransfer", 130)
+		mutMessages.Lock()
+		assert.Len(t, warnMessages, 1)
+		assert.True(t, strings.Contains(warnMessages[0], "regression"))
+		mutMessages.Unlock()
+
+		recordSamples(t, tracker, getter, "executeTransfer", 100)
+		assert.False(t, tracker.usage["executeTransfer"].Regressed)
+		assert.Equal(t, 0, statusHandler.GetIntMetric("Ethereum gas usage regressions"))
+	})
+	t.Run("the baseline should follow the gradual changes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		getter := args.GasUsedGetter.(*gasUsedGetterStub)
+		tracker, _ := NewGasUsageTracker(args)
+
+		recordSamples(t, tracker, getter, "executeTransfer", 100, 100, 100, 90, 90, 90)
+		assert.Equal(t, uint64(90), tracker.usage["executeTransfer"].Baseline)
+	})
+	t.Run("the history should be restored after a restart", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasUsageTracker()
+		getter := args.GasUsedGetter.(*gasUsedGetterStub)
+		tracker, _ := NewGasUsageTracker(args)
+		recordSamples(t, tracker, getter, "executeTransfer", 100, 100, 100, 200)
+
+		args.StatusHandler = testsCommon.NewStatusHandlerMock("gas-usage-tracker")
+		restartedTracker, err := NewGasUsageTracker(args)
+		require.Nil(t, err)
+		assert.Equal(t, tracker.usage, restartedTracker.usage)
+		statusHandler := args.StatusHandler.(*testsCommon.StatusHandlerMock)
+		assert.Equal(t, 100, statusHandler.GetIntMetric("Ethereum executeTransfer gas baseline"))
+		assert.Equal(t, 133, statusHandler.GetIntMetric("Ethereum executeTransfer average gas used"))
+	})
+	t.Run("storer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsGasUsageTracker()
+		args.Storer = &testsCommon.StorerStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+			PutCalled: func(key, val []byte) error {
+				return expectedErr
+			},
+		}
+		args.GasUsedGetter = &gasUsedGetterStub{
+			GetGasUsedCalled: func(ctx context.Context, hash string) (uint64, bool, error) {
+				return 1000, true, nil
+			},
+		}
+		tracker, _ := NewGasUsageTracker(args)
+		tracker.Track("hash", "function")
+
+		err := tracker.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+}
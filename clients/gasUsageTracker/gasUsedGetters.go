@@ -0,0 +1,85 @@
+package gasUsageTracker
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+type ethereumGasUsedGetter struct {
+	provider EthereumReceiptProvider
+}
+
+// NewEthereumGasUsedGetter creates a gas used getter based on the Ethereum transaction receipts
+func NewEthereumGasUsedGetter(provider EthereumReceiptProvider) (*ethereumGasUsedGetter, error) {
+	if check.IfNil(provider) {
+		return nil, errNilReceiptProvider
+	}
+
+	return &ethereumGasUsedGetter{
+		provider: provider,
+	}, nil
+}
+
+// GetGasUsed returns the gas used by the transaction. The transaction is not final while it is not mined
+func (getter *ethereumGasUsedGetter) GetGasUsed(ctx context.Context, hash string) (uint64, bool, error) {
+	receipt, err := getter.provider.TransactionReceipt(ctx, common.HexToHash(hash))
+	if errors.Is(err, ethereum.NotFound) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	if receipt == nil {
+		return 0, false, errNilTransactionReceipt
+	}
+
+	return receipt.GasUsed, true, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *ethereumGasUsedGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
+
+type multiversXGasUsedGetter struct {
+	provider MultiversXTransactionProvider
+}
+
+// NewMultiversXGasUsedGetter creates a gas used getter based on the MultiversX transactions with results
+func NewMultiversXGasUsedGetter(provider MultiversXTransactionProvider) (*multiversXGasUsedGetter, error) {
+	if check.IfNil(provider) {
+		return nil, errNilTransactionProvider
+	}
+
+	return &multiversXGasUsedGetter{
+		provider: provider,
+	}, nil
+}
+
+// GetGasUsed returns the gas used by the transaction. The transaction is final once it was either executed or rejected
+func (getter *multiversXGasUsedGetter) GetGasUsed(ctx context.Context, hash string) (uint64, bool, error) {
+	txInfo, err := getter.provider.GetTransactionInfoWithResults(ctx, hash)
+	if err != nil {
+		return 0, false, err
+	}
+	if txInfo == nil {
+		return 0, false, errNilTransactionInfo
+	}
+
+	switch transaction.TxStatus(txInfo.Data.Transaction.Status) {
+	case transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid:
+		return txInfo.Data.Transaction.GasUsed, true, nil
+	default:
+		return 0, false, nil
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *multiversXGasUsedGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
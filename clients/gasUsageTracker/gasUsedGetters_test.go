@@ -0,0 +1,135 @@
+package gasUsageTracker
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEthereumGasUsedGetter_GetGasUsed(t *testing.T) {
+	t.Parallel()
+
+	hash := "0x6f3e5c0d7e0b0ad4c2cbb9e5c8b0d3f4a1f2e3d4c5b6a7988776655443322110"
+
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewEthereumGasUsedGetter(nil)
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, errNilReceiptProvider, err)
+	})
+	t.Run("not mined transaction should not be final", func(t *testing.T) {
+		t.Parallel()
+
+		getter, _ := NewEthereumGasUsedGetter(&interactors.BlockchainClientStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				return nil, ethereum.NotFound
+			},
+		})
+
+		gasUsed, isFinal, err := getter.GetGasUsed(context.Background(), hash)
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+		assert.Zero(t, gasUsed)
+	})
+	t.Run("receipt error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		getter, _ := NewEthereumGasUsedGetter(&interactors.BlockchainClientStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				return nil, expectedErr
+			},
+		})
+
+		_, isFinal, err := getter.GetGasUsed(context.Background(), hash)
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, isFinal)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter, _ := NewEthereumGasUsedGetter(&interactors.BlockchainClientStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				assert.Equal(t, common.HexToHash(hash), txHash)
+				return &types.Receipt{GasUsed: 250000}, nil
+			},
+		})
+
+		gasUsed, isFinal, err := getter.GetGasUsed(context.Background(), hash)
+		assert.Nil(t, err)
+		assert.True(t, isFinal)
+		assert.Equal(t, uint64(250000), gasUsed)
+	})
+}
+
+func TestMultiversXGasUsedGetter_GetGasUsed(t *testing.T) {
+	t.Parallel()
+
+	createProxy := func(status transaction.TxStatus) *interactors.ProxyStub {
+		return &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				assert.Equal(t, "hash", hash)
+
+				txInfo := &data.TransactionInfo{}
+				txInfo.Data.Transaction.Status = string(status)
+				txInfo.Data.Transaction.GasUsed = 3000000
+
+				return txInfo, nil
+			},
+		}
+	}
+
+	t.Run("nil provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter, err := NewMultiversXGasUsedGetter(nil)
+		assert.True(t, check.IfNil(getter))
+		assert.Equal(t, errNilTransactionProvider, err)
+	})
+	t.Run("proxy error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		getter, _ := NewMultiversXGasUsedGetter(&interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return nil, expectedErr
+			},
+		})
+
+		_, isFinal, err := getter.GetGasUsed(context.Background(), "hash")
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, isFinal)
+	})
+	t.Run("pending transaction should not be final", func(t *testing.T) {
+		t.Parallel()
+
+		getter, _ := NewMultiversXGasUsedGetter(createProxy(transaction.TxStatusPending))
+
+		gasUsed, isFinal, err := getter.GetGasUsed(context.Background(), "hash")
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+		assert.Zero(t, gasUsed)
+	})
+	t.Run("executed and failed transactions should be final", func(t *testing.T) {
+		t.Parallel()
+
+		for _, status := range []transaction.TxStatus{transaction.TxStatusSuccess, transaction.TxStatusFail, transaction.TxStatusInvalid} {
+			getter, _ := NewMultiversXGasUsedGetter(createProxy(status))
+
+			gasUsed, isFinal, err := getter.GetGasUsed(context.Background(), "hash")
+			assert.Nil(t, err)
+			assert.True(t, isFinal)
+			assert.Equal(t, uint64(3000000), gasUsed)
+		}
+	})
+}
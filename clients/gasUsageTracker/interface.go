@@ -0,0 +1,27 @@
+package gasUsageTracker
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// GasUsedGetter is able to fetch the gas used by a sent transaction, once the transaction is final
+type GasUsedGetter interface {
+	GetGasUsed(ctx context.Context, hash string) (gasUsed uint64, isFinal bool, err error)
+	IsInterfaceNil() bool
+}
+
+// EthereumReceiptProvider defines the Ethereum operation needed to fetch a transaction receipt
+type EthereumReceiptProvider interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXTransactionProvider defines the MultiversX operation needed to fetch a transaction with its results
+type MultiversXTransactionProvider interface {
+	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
+	IsInterfaceNil() bool
+}
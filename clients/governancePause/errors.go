@@ -0,0 +1,27 @@
+package governancePause
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilEthereumPauseGetter signals that a nil Ethereum pause getter has been provided
+var ErrNilEthereumPauseGetter = errors.New("nil Ethereum pause getter")
+
+// ErrNilMultiversXPauseGetter signals that a nil MultiversX pause getter has been provided
+var ErrNilMultiversXPauseGetter = errors.New("nil MultiversX pause getter")
+
+// ErrNilIncidentsQueue signals that a nil incidents queue has been provided
+var ErrNilIncidentsQueue = errors.New("nil incidents queue")
+
+// ErrNilExecutor signals that a nil executor has been provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrNilPauseChecker signals that a nil pause checker has been provided
+var ErrNilPauseChecker = errors.New("nil pause checker")
+
+// ErrPauseFlagNotRead signals that the pause flag of a chain could not be read
+var ErrPauseFlagNotRead = errors.New("pause flag not read")
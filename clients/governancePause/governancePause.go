@@ -0,0 +1,172 @@
+package governancePause
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	ethereumChainName   = "Ethereum"
+	multiversXChainName = "MultiversX"
+	chainsSeparator     = ","
+	incidentSource      = "GovernancePause"
+)
+
+// ArgsGovernancePause is the DTO used to create a new governance pause watcher
+type ArgsGovernancePause struct {
+	EthereumPauseGetter   PauseGetter
+	MultiversXPauseGetter PauseGetter
+	StatusHandler         core.StatusHandler
+	Incidents             IncidentsQueue
+	Log                   logger.Logger
+}
+
+type chainPauseGetter struct {
+	name   string
+	getter PauseGetter
+}
+
+type governancePause struct {
+	getters       []chainPauseGetter
+	statusHandler core.StatusHandler
+	incidents     IncidentsQueue
+	log           logger.Logger
+
+	mut          sync.RWMutex
+	pausedChains map[string]bool
+	isPaused     bool
+}
+
+// NewGovernancePause creates a component that periodically reads the pause flag of the bridge contracts on both chains.
+// The local processing is halted as soon as one of the flags is observed set, raising an incident, and resumed after all
+// of them are cleared and all the incidents are acknowledged by an operator
+func NewGovernancePause(args ArgsGovernancePause) (*governancePause, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governancePause{
+		getters: []chainPauseGetter{
+			{name: ethereumChainName, getter: args.EthereumPauseGetter},
+			{name: multiversXChainName, getter: args.MultiversXPauseGetter},
+		},
+		statusHandler: args.StatusHandler,
+		incidents:     args.Incidents,
+		log:           args.Log,
+		pausedChains:  make(map[string]bool),
+	}, nil
+}
+
+func checkArgs(args ArgsGovernancePause) error {
+	if check.IfNil(args.EthereumPauseGetter) {
+		return ErrNilEthereumPauseGetter
+	}
+	if check.IfNil(args.MultiversXPauseGetter) {
+		return ErrNilMultiversXPauseGetter
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.Incidents) {
+		return ErrNilIncidentsQueue
+	}
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+
+	return nil
+}
+
+// Execute reads the pause flags and updates the pause state. A flag that can not be read keeps its last observed
+// value so a failing query never resumes the processing
+func (watcher *governancePause) Execute(ctx context.Context) error {
+	var lastErr error
+	for _, chain := range watcher.getters {
+		isPaused, err := chain.getter.IsPaused(ctx)
+		if err != nil {
+			watcher.log.Warn("governance pause watcher: can not read the pause flag", "chain", chain.name, "error", err)
+			lastErr = fmt.Errorf("%w for %s: %s", ErrPauseFlagNotRead, chain.name, err.Error())
+			continue
+		}
+
+		watcher.setChainPaused(chain.name, isPaused)
+	}
+
+	watcher.updatePauseState()
+
+	return lastErr
+}
+
+func (watcher *governancePause) setChainPaused(chainName string, isPaused bool) {
+	watcher.mut.Lock()
+	defer watcher.mut.Unlock()
+
+	if isPaused {
+		watcher.pausedChains[chainName] = true
+		return
+	}
+
+	delete(watcher.pausedChains, chainName)
+}
+
+func (watcher *governancePause) updatePauseState() {
+	watcher.mut.Lock()
+	wasPaused := watcher.isPaused
+	watcher.isPaused = len(watcher.pausedChains) > 0
+	isPaused := watcher.isPaused
+	pausedChains := watcher.getPausedChainsUnprotected()
+	watcher.mut.Unlock()
+
+	pausedMetric := 0
+	if isPaused {
+		pausedMetric = 1
+	}
+	watcher.statusHandler.SetIntMetric(core.MetricGovernancePaused, pausedMetric)
+	watcher.statusHandler.SetStringMetric(core.MetricGovernancePausedChains, pausedChains)
+
+	if isPaused && !wasPaused {
+		watcher.log.Warn("governance pause observed, the bridge processing is halted", "paused chains", pausedChains)
+		watcher.incidents.Raise(incidentSource, fmt.Sprintf("governance pause observed on %s", pausedChains))
+		return
+	}
+	if !isPaused && wasPaused {
+		if watcher.incidents.HasUnacknowledgedIncidents() {
+			watcher.log.Warn("governance pause lifted, the bridge processing is resumed after all the incidents are acknowledged")
+			return
+		}
+		watcher.log.Info("governance pause lifted, the bridge processing is resumed")
+	}
+}
+
+func (watcher *governancePause) getPausedChainsUnprotected() string {
+	chains := make([]string, 0, len(watcher.pausedChains))
+	for _, chain := range watcher.getters {
+		if watcher.pausedChains[chain.name] {
+			chains = append(chains, chain.name)
+		}
+	}
+
+	return strings.Join(chains, chainsSeparator)
+}
+
+// IsPaused returns true if the pause flag was observed set on at least one of the chains or if an incident was not yet
+// acknowledged by an operator
+func (watcher *governancePause) IsPaused() bool {
+	watcher.mut.RLock()
+	isPaused := watcher.isPaused
+	watcher.mut.RUnlock()
+
+	return isPaused || watcher.incidents.HasUnacknowledgedIncidents()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (watcher *governancePause) IsInterfaceNil() bool {
+	return watcher == nil
+}
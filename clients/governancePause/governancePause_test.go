@@ -0,0 +1,242 @@
+package governancePause
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+type pauseGetterStub struct {
+	IsPausedCalled func(ctx context.Context) (bool, error)
+}
+
+func (stub *pauseGetterStub) IsPaused(ctx context.Context) (bool, error) {
+	if stub.IsPausedCalled != nil {
+		return stub.IsPausedCalled(ctx)
+	}
+
+	return false, nil
+}
+
+func (stub *pauseGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createPauseGetter(isPaused *bool, err *error) *pauseGetterStub {
+	return &pauseGetterStub{
+		IsPausedCalled: func(ctx context.Context) (bool, error) {
+			return *isPaused, *err
+		},
+	}
+}
+
+func createMockArgsGovernancePause() ArgsGovernancePause {
+	return ArgsGovernancePause{
+		EthereumPauseGetter:   &pauseGetterStub{},
+		MultiversXPauseGetter: &pauseGetterStub{},
+		StatusHandler:         testsCommon.NewStatusHandlerMock("governance-pause"),
+		Incidents:             &testsCommon.IncidentsQueueStub{},
+		Log:                   &testsCommon.LoggerStub{},
+	}
+}
+
+func TestNewGovernancePause(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil Ethereum pause getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernancePause()
+		args.EthereumPauseGetter = nil
+
+		watcher, err := NewGovernancePause(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, ErrNilEthereumPauseGetter, err)
+	})
+	t.Run("nil MultiversX pause getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernancePause()
+		args.MultiversXPauseGetter = nil
+
+		watcher, err := NewGovernancePause(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, ErrNilMultiversXPauseGetter, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernancePause()
+		args.StatusHandler = nil
+
+		watcher, err := NewGovernancePause(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil incidents queue should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernancePause()
+		args.Incidents = nil
+
+		watcher, err := NewGovernancePause(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, ErrNilIncidentsQueue, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernancePause()
+		args.Log = nil
+
+		watcher, err := NewGovernancePause(args)
+		assert.True(t, check.IfNil(watcher))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		watcher, err := NewGovernancePause(createMockArgsGovernancePause())
+		assert.False(t, check.IfNil(watcher))
+		assert.Nil(t, err)
+		assert.False(t, watcher.IsPaused())
+	})
+}
+
+func TestGovernancePause_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pause when any of the chains is paused and resume when both are unpaused", func(t *testing.T) {
+		t.Parallel()
+
+		ethPaused, mvxPaused := false, false
+		var noErr error
+		args := createMockArgsGovernancePause()
+		args.EthereumPauseGetter = createPauseGetter(&ethPaused, &noErr)
+		args.MultiversXPauseGetter = createPauseGetter(&mvxPaused, &noErr)
+		statusHandler := testsCommon.NewStatusHandlerMock("governance-pause")
+		args.StatusHandler = statusHandler
+		watcher, _ := NewGovernancePause(args)
+
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.False(t, watcher.IsPaused())
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricGovernancePaused))
+
+		mvxPaused = true
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.True(t, watcher.IsPaused())
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricGovernancePaused))
+		assert.Equal(t, "MultiversX", statusHandler.GetStringMetric(core.MetricGovernancePausedChains))
+
+		ethPaused = true
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.True(t, watcher.IsPaused())
+		assert.Equal(t, "Ethereum,MultiversX", statusHandler.GetStringMetric(core.MetricGovernancePausedChains))
+
+		mvxPaused = false
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.True(t, watcher.IsPaused())
+		assert.Equal(t, "Ethereum", statusHandler.GetStringMetric(core.MetricGovernancePausedChains))
+
+		ethPaused = false
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.False(t, watcher.IsPaused())
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricGovernancePaused))
+		assert.Empty(t, statusHandler.GetStringMetric(core.MetricGovernancePausedChains))
+	})
+	t.Run("a flag that can not be read should keep its last value", func(t *testing.T) {
+		t.Parallel()
+
+		ethPaused := true
+		var ethErr, noErr error
+		mvxPaused := false
+		args := createMockArgsGovernancePause()
+		args.EthereumPauseGetter = createPauseGetter(&ethPaused, &ethErr)
+		args.MultiversXPauseGetter = createPauseGetter(&mvxPaused, &noErr)
+		watcher, _ := NewGovernancePause(args)
+
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.True(t, watcher.IsPaused())
+
+		expectedErr := errors.New("expected error")
+		ethPaused = false
+		ethErr = expectedErr
+		err := watcher.Execute(context.Background())
+		assert.True(t, errors.Is(err, ErrPauseFlagNotRead))
+		assert.Contains(t, err.Error(), expectedErr.Error())
+		assert.Contains(t, err.Error(), "Ethereum")
+		assert.True(t, watcher.IsPaused())
+
+		ethErr = nil
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.False(t, watcher.IsPaused())
+	})
+	t.Run("a flag that was never read should not pause", func(t *testing.T) {
+		t.Parallel()
+
+		isPaused := false
+		ethErr := errors.New("expected error")
+		var noErr error
+		args := createMockArgsGovernancePause()
+		args.EthereumPauseGetter = createPauseGetter(&isPaused, &ethErr)
+		args.MultiversXPauseGetter = createPauseGetter(&isPaused, &noErr)
+		watcher, _ := NewGovernancePause(args)
+
+		err := watcher.Execute(context.Background())
+		assert.True(t, errors.Is(err, ErrPauseFlagNotRead))
+		assert.False(t, watcher.IsPaused())
+	})
+	t.Run("should raise an incident and resume only after its acknowledgment", func(t *testing.T) {
+		t.Parallel()
+
+		ethPaused := false
+		var noErr error
+		raisedDescriptions := make([]string, 0)
+		hasUnacknowledgedIncidents := false
+		args := createMockArgsGovernancePause()
+		args.EthereumPauseGetter = createPauseGetter(&ethPaused, &noErr)
+		args.Incidents = &testsCommon.IncidentsQueueStub{
+			RaiseCalled: func(source string, description string) uint64 {
+				assert.Equal(t, incidentSource, source)
+				raisedDescriptions = append(raisedDescriptions, description)
+				hasUnacknowledgedIncidents = true
+				return 1
+			},
+			HasUnacknowledgedIncidentsCalled: func() bool {
+				return hasUnacknowledgedIncidents
+			},
+		}
+		watcher, _ := NewGovernancePause(args)
+
+		ethPaused = true
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.True(t, watcher.IsPaused())
+		assert.Equal(t, []string{"governance pause observed on Ethereum"}, raisedDescriptions)
+
+		ethPaused = false
+		assert.Nil(t, watcher.Execute(context.Background()))
+		assert.True(t, watcher.IsPaused())
+
+		hasUnacknowledgedIncidents = false
+		assert.False(t, watcher.IsPaused())
+	})
+	t.Run("unacknowledged incidents should pause from the start", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGovernancePause()
+		args.Incidents = &testsCommon.IncidentsQueueStub{
+			HasUnacknowledgedIncidentsCalled: func() bool {
+				return true
+			},
+		}
+		watcher, _ := NewGovernancePause(args)
+
+		assert.True(t, watcher.IsPaused())
+	})
+}
@@ -0,0 +1,29 @@
+package governancePause
+
+import "context"
+
+// PauseGetter defines the operation able to read the pause flag set by the governance on a bridge contract
+type PauseGetter interface {
+	IsPaused(ctx context.Context) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// PauseChecker returns the last pause state observed by the governance pause watcher
+type PauseChecker interface {
+	IsPaused() bool
+	IsInterfaceNil() bool
+}
+
+// Executor defines a component executed periodically by a polling handler
+type Executor interface {
+	Execute(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+// IncidentsQueue defines the queue of the incidents that must be acknowledged by an operator before the processing is
+// resumed
+type IncidentsQueue interface {
+	Raise(source string, description string) uint64
+	HasUnacknowledgedIncidents() bool
+	IsInterfaceNil() bool
+}
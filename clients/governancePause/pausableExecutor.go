@@ -0,0 +1,55 @@
+package governancePause
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsPausableExecutor is the DTO used to create a new pausable executor
+type ArgsPausableExecutor struct {
+	Executor     Executor
+	PauseChecker PauseChecker
+	Log          logger.Logger
+}
+
+type pausableExecutor struct {
+	executor     Executor
+	pauseChecker PauseChecker
+	log          logger.Logger
+}
+
+// NewPausableExecutor creates an executor wrapper that skips the executions while the governance pause is observed
+func NewPausableExecutor(args ArgsPausableExecutor) (*pausableExecutor, error) {
+	if check.IfNil(args.Executor) {
+		return nil, ErrNilExecutor
+	}
+	if check.IfNil(args.PauseChecker) {
+		return nil, ErrNilPauseChecker
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+
+	return &pausableExecutor{
+		executor:     args.Executor,
+		pauseChecker: args.PauseChecker,
+		log:          args.Log,
+	}, nil
+}
+
+// Execute calls the wrapped executor if the governance pause is not observed
+func (executor *pausableExecutor) Execute(ctx context.Context) error {
+	if executor.pauseChecker.IsPaused() {
+		executor.log.Debug("governance pause is active, execution skipped")
+		return nil
+	}
+
+	return executor.executor.Execute(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *pausableExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
@@ -0,0 +1,48 @@
+package heartbeat
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilTimer signals that a nil timer has been provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrNilSender signals that a nil heartbeat sender has been provided
+var ErrNilSender = errors.New("nil heartbeat sender")
+
+// ErrNilProxy signals that a nil proxy has been provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilNonceTxHandler signals that a nil nonce transaction handler has been provided
+var ErrNilNonceTxHandler = errors.New("nil nonce transaction handler")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilHTTPClient signals that a nil HTTP client has been provided
+var ErrNilHTTPClient = errors.New("nil HTTP client")
+
+// ErrEmptyAddress signals that an empty relayer address has been provided
+var ErrEmptyAddress = errors.New("empty address")
+
+// ErrEmptyFunction signals that an empty contract function has been provided
+var ErrEmptyFunction = errors.New("empty contract function")
+
+// ErrEmptyURL signals that an empty collector URL has been provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrCostCapReached signals that sending the heartbeat transaction would exceed the maximum daily cost
+var ErrCostCapReached = errors.New("heartbeat cost cap reached")
+
+// ErrUnexpectedHTTPStatus signals that the collector answered with an unexpected HTTP status
+var ErrUnexpectedHTTPStatus = errors.New("unexpected HTTP status")
@@ -0,0 +1,146 @@
+package heartbeat
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// ContractMode publishes the heartbeats as transactions calling the heartbeat contract
+	ContractMode = "contract"
+	// CollectorMode posts the signed heartbeats to the off-chain collector
+	CollectorMode = "collector"
+
+	// MinInterval is the minimum interval between two heartbeats, so a misconfiguration can not flood the heartbeat
+	// contract or the collector
+	MinInterval = time.Minute
+)
+
+// ArgsHeartbeatPublisher is the DTO used to create a new heartbeat publisher
+type ArgsHeartbeatPublisher struct {
+	Address       string
+	Sender        Sender
+	Interval      time.Duration
+	StatusHandler core.StatusHandler
+	Log           logger.Logger
+	Timer         core.Timer
+}
+
+type heartbeatPublisher struct {
+	address       string
+	sender        Sender
+	interval      int64
+	statusHandler core.StatusHandler
+	log           logger.Logger
+	timer         core.Timer
+
+	mut                  sync.Mutex
+	sequence             uint64
+	lastAttemptTimestamp int64
+	hasAttempted         bool
+	numPublished         int
+	numFailed            int
+}
+
+// NewHeartbeatPublisher creates a component that periodically publishes a heartbeat proving the relayer liveness, at
+// most once per interval. A failed publication is not retried before the next interval, so the errors can not
+// increase the publications rate nor the costs
+func NewHeartbeatPublisher(args ArgsHeartbeatPublisher) (*heartbeatPublisher, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &heartbeatPublisher{
+		address:       args.Address,
+		sender:        args.Sender,
+		interval:      int64(args.Interval.Seconds()),
+		statusHandler: args.StatusHandler,
+		log:           args.Log,
+		timer:         args.Timer,
+	}, nil
+}
+
+func checkArgs(args ArgsHeartbeatPublisher) error {
+	if len(args.Address) == 0 {
+		return ErrEmptyAddress
+	}
+	if check.IfNil(args.Sender) {
+		return ErrNilSender
+	}
+	if args.Interval < MinInterval {
+		return fmt.Errorf("%w for Interval: %v, minimum: %v", ErrInvalidValue, args.Interval, MinInterval)
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+
+	return nil
+}
+
+// Execute publishes the heartbeat if the interval elapsed since the last attempt
+func (publisher *heartbeatPublisher) Execute(ctx context.Context) error {
+	message, shouldPublish := publisher.createMessage()
+	if !shouldPublish {
+		return nil
+	}
+
+	reference, err := publisher.sender.Send(ctx, message)
+
+	publisher.mut.Lock()
+	if err != nil {
+		publisher.numFailed++
+	} else {
+		publisher.numPublished++
+	}
+	publisher.statusHandler.SetIntMetric("heartbeat published", publisher.numPublished)
+	publisher.statusHandler.SetIntMetric("heartbeat failed", publisher.numFailed)
+	publisher.mut.Unlock()
+
+	if err != nil {
+		publisher.log.Warn("heartbeatPublisher: can not publish the heartbeat", "sequence", message.Sequence, "error", err)
+		return nil
+	}
+
+	publisher.statusHandler.SetIntMetric("heartbeat last timestamp", int(message.Timestamp))
+	publisher.log.Debug("heartbeatPublisher: heartbeat published", "sequence", message.Sequence, "reference", reference)
+
+	return nil
+}
+
+func (publisher *heartbeatPublisher) createMessage() (*core.HeartbeatMessage, bool) {
+	publisher.mut.Lock()
+	defer publisher.mut.Unlock()
+
+	now := publisher.timer.NowUnix()
+	if publisher.hasAttempted && now-publisher.lastAttemptTimestamp < publisher.interval {
+		return nil, false
+	}
+
+	publisher.hasAttempted = true
+	publisher.lastAttemptTimestamp = now
+	publisher.sequence++
+
+	return &core.HeartbeatMessage{
+		Address:   publisher.address,
+		Timestamp: now,
+		Sequence:  publisher.sequence,
+	}, true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (publisher *heartbeatPublisher) IsInterfaceNil() bool {
+	return publisher == nil
+}
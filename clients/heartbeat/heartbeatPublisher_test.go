@@ -0,0 +1,164 @@
+package heartbeat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const relayerAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createTimerStub(now *int64) *testsCommon.TimerStub {
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return *now
+	}
+
+	return timer
+}
+
+func createMockArgsHeartbeatPublisher() ArgsHeartbeatPublisher {
+	return ArgsHeartbeatPublisher{
+		Address:       relayerAddress,
+		Sender:        &testsCommon.HeartbeatSenderStub{},
+		Interval:      time.Minute * 10,
+		StatusHandler: testsCommon.NewStatusHandlerMock("heartbeat"),
+		Log:           &testsCommon.LoggerStub{},
+		Timer:         testsCommon.NewTimerStub(),
+	}
+}
+
+func TestNewHeartbeatPublisher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatPublisher()
+		args.Address = ""
+		publisher, err := NewHeartbeatPublisher(args)
+		assert.True(t, check.IfNil(publisher))
+		assert.Equal(t, ErrEmptyAddress, err)
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatPublisher()
+		args.Sender = nil
+		publisher, err := NewHeartbeatPublisher(args)
+		assert.True(t, check.IfNil(publisher))
+		assert.Equal(t, ErrNilSender, err)
+	})
+	t.Run("interval below the minimum should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatPublisher()
+		args.Interval = MinInterval - time.Second
+		publisher, err := NewHeartbeatPublisher(args)
+		assert.True(t, check.IfNil(publisher))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "Interval")
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatPublisher()
+		args.StatusHandler = nil
+		publisher, err := NewHeartbeatPublisher(args)
+		assert.True(t, check.IfNil(publisher))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatPublisher()
+		args.Log = nil
+		publisher, err := NewHeartbeatPublisher(args)
+		assert.True(t, check.IfNil(publisher))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsHeartbeatPublisher()
+		args.Timer = nil
+		publisher, err := NewHeartbeatPublisher(args)
+		assert.True(t, check.IfNil(publisher))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		publisher, err := NewHeartbeatPublisher(createMockArgsHeartbeatPublisher())
+		assert.False(t, check.IfNil(publisher))
+		assert.Nil(t, err)
+	})
+}
+
+func TestHeartbeatPublisher_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should publish at most once per interval", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(1000)
+		sentMessages := make([]*core.HeartbeatMessage, 0)
+		args := createMockArgsHeartbeatPublisher()
+		args.Timer = createTimerStub(&now)
+		args.Sender = &testsCommon.HeartbeatSenderStub{
+			SendCalled: func(ctx context.Context, message *core.HeartbeatMessage) (string, error) {
+				sentMessages = append(sentMessages, message)
+				return "hash", nil
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("heartbeat")
+		args.StatusHandler = statusHandler
+		publisher, _ := NewHeartbeatPublisher(args)
+
+		require.Nil(t, publisher.Execute(context.Background()))
+		now += 599
+		require.Nil(t, publisher.Execute(context.Background()))
+		require.Equal(t, 1, len(sentMessages))
+
+		now++
+		require.Nil(t, publisher.Execute(context.Background()))
+		require.Equal(t, 2, len(sentMessages))
+		assert.Equal(t, &core.HeartbeatMessage{Address: relayerAddress, Timestamp: 1000, Sequence: 1}, sentMessages[0])
+		assert.Equal(t, &core.HeartbeatMessage{Address: relayerAddress, Timestamp: 1600, Sequence: 2}, sentMessages[1])
+		assert.Equal(t, 2, statusHandler.GetIntMetric("heartbeat published"))
+		assert.Equal(t, 0, statusHandler.GetIntMetric("heartbeat failed"))
+		assert.Equal(t, 1600, statusHandler.GetIntMetric("heartbeat last timestamp"))
+	})
+	t.Run("failed publication should not be retried before the next interval", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(1000)
+		numSent := 0
+		args := createMockArgsHeartbeatPublisher()
+		args.Timer = createTimerStub(&now)
+		args.Sender = &testsCommon.HeartbeatSenderStub{
+			SendCalled: func(ctx context.Context, message *core.HeartbeatMessage) (string, error) {
+				numSent++
+				return "", ErrCostCapReached
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("heartbeat")
+		args.StatusHandler = statusHandler
+		publisher, _ := NewHeartbeatPublisher(args)
+
+		require.Nil(t, publisher.Execute(context.Background()))
+		now += 10
+		require.Nil(t, publisher.Execute(context.Background()))
+		assert.Equal(t, 1, numSent)
+		assert.Equal(t, 0, statusHandler.GetIntMetric("heartbeat published"))
+		assert.Equal(t, 1, statusHandler.GetIntMetric("heartbeat failed"))
+		assert.Equal(t, 0, statusHandler.GetIntMetric("heartbeat last timestamp"))
+	})
+}
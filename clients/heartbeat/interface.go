@@ -0,0 +1,36 @@
+package heartbeat
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// Sender publishes a heartbeat message and returns a reference of the publication (e.g. the transaction hash)
+type Sender interface {
+	Send(ctx context.Context, message *core.HeartbeatMessage) (string, error)
+	IsInterfaceNil() bool
+}
+
+// Proxy defines the MultiversX proxy operations used by the heartbeat contract sender
+type Proxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	IsInterfaceNil() bool
+}
+
+// NonceTransactionsHandler represents the interface able to handle the current nonce and the transactions resend mechanism
+type NonceTransactionsHandler interface {
+	ApplyNonceAndGasPrice(ctx context.Context, address sdkCore.AddressHandler, tx *transaction.FrontendTransaction) error
+	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// HTTPClient is the interface we expect to call in order to do the HTTP requests
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
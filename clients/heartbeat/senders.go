@@ -0,0 +1,348 @@
+package heartbeat
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	secondsInDay             = 86400
+	zeroValue                = "0"
+	maxResponseBodyLogLength = 256
+)
+
+// ArgsContractSender is the DTO used to create a new heartbeat contract sender
+type ArgsContractSender struct {
+	Proxy           Proxy
+	NonceTxHandler  NonceTransactionsHandler
+	PrivateKey      crypto.PrivateKey
+	SingleSigner    crypto.SingleSigner
+	ContractAddress string
+	Function        string
+	GasLimit        uint64
+	MaxDailyCost    *big.Int
+	Timer           core.Timer
+}
+
+type spentFee struct {
+	timestamp int64
+	fee       *big.Int
+}
+
+type contractSender struct {
+	proxy           Proxy
+	nonceTxHandler  NonceTransactionsHandler
+	privateKey      crypto.PrivateKey
+	singleSigner    crypto.SingleSigner
+	address         sdkCore.AddressHandler
+	bech32Address   string
+	contractAddress string
+	function        string
+	gasLimit        uint64
+	maxDailyCost    *big.Int
+	timer           core.Timer
+
+	mut       sync.Mutex
+	spentFees []*spentFee
+}
+
+// NewContractSender creates a heartbeat sender calling the heartbeat contract with a transaction signed by the relayer.
+// The fees paid in the last 24 hours are capped to the provided maximum daily cost
+func NewContractSender(args ArgsContractSender) (*contractSender, error) {
+	err := checkContractSenderArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	contractAddress, err := data.NewAddressFromBech32String(args.ContractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the heartbeat contract address %s", err, args.ContractAddress)
+	}
+	contractBech32Address, err := contractAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	address := data.NewAddressFromBytes(publicKeyBytes)
+	bech32Address, err := address.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return &contractSender{
+		proxy:           args.Proxy,
+		nonceTxHandler:  args.NonceTxHandler,
+		privateKey:      args.PrivateKey,
+		singleSigner:    args.SingleSigner,
+		address:         address,
+		bech32Address:   bech32Address,
+		contractAddress: contractBech32Address,
+		function:        args.Function,
+		gasLimit:        args.GasLimit,
+		maxDailyCost:    big.NewInt(0).Set(args.MaxDailyCost),
+		timer:           args.Timer,
+		spentFees:       make([]*spentFee, 0),
+	}, nil
+}
+
+func checkContractSenderArgs(args ArgsContractSender) error {
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.NonceTxHandler) {
+		return ErrNilNonceTxHandler
+	}
+	if check.IfNil(args.PrivateKey) {
+		return ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if len(args.Function) == 0 {
+		return ErrEmptyFunction
+	}
+	if args.GasLimit == 0 {
+		return fmt.Errorf("%w for GasLimit, got: %d", ErrInvalidValue, args.GasLimit)
+	}
+	if args.MaxDailyCost == nil || args.MaxDailyCost.Sign() <= 0 {
+		return fmt.Errorf("%w for MaxDailyCost", ErrInvalidValue)
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+
+	return nil
+}
+
+// Send calls the heartbeat function of the contract with the message timestamp and sequence, if the maximum fee of the
+// transaction fits in the daily cost cap. Returns the transaction hash
+func (sender *contractSender) Send(ctx context.Context, message *core.HeartbeatMessage) (string, error) {
+	networkConfig, err := sender.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w while fetching network configs", err)
+	}
+
+	dataBytes, err := builders.NewTxDataBuilder().
+		Function(sender.function).
+		ArgInt64(message.Timestamp).
+		ArgInt64(int64(message.Sequence)).
+		ToDataBytes()
+	if err != nil {
+		return "", err
+	}
+
+	tx := &transaction.FrontendTransaction{
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+		GasLimit: networkConfig.MinGasLimit + uint64(len(dataBytes))*networkConfig.GasPerDataByte + sender.gasLimit,
+		Data:     dataBytes,
+		Sender:   sender.bech32Address,
+		Receiver: sender.contractAddress,
+		Value:    zeroValue,
+		GasPrice: networkConfig.MinGasPrice,
+	}
+
+	// the cap is checked before the nonce is applied, so a skipped heartbeat does not leave a nonce gap
+	now := sender.timer.NowUnix()
+	err = sender.checkCostCap(now, computeMaxFee(tx))
+	if err != nil {
+		return "", err
+	}
+
+	err = sender.nonceTxHandler.ApplyNonceAndGasPrice(ctx, sender.address, tx)
+	if err != nil {
+		return "", err
+	}
+
+	err = sender.signTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := sender.nonceTxHandler.SendTransaction(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	sender.recordFee(now, computeMaxFee(tx))
+
+	return hash, nil
+}
+
+func computeMaxFee(tx *transaction.FrontendTransaction) *big.Int {
+	fee := big.NewInt(0).SetUint64(tx.GasLimit)
+
+	return fee.Mul(fee, big.NewInt(0).SetUint64(tx.GasPrice))
+}
+
+func (sender *contractSender) checkCostCap(now int64, fee *big.Int) error {
+	sender.mut.Lock()
+	defer sender.mut.Unlock()
+
+	firstIndex := 0
+	for firstIndex < len(sender.spentFees) && sender.spentFees[firstIndex].timestamp <= now-secondsInDay {
+		firstIndex++
+	}
+	sender.spentFees = sender.spentFees[firstIndex:]
+
+	spent := big.NewInt(0).Set(fee)
+	for _, entry := range sender.spentFees {
+		spent.Add(spent, entry.fee)
+	}
+	if spent.Cmp(sender.maxDailyCost) > 0 {
+		return fmt.Errorf("%w: the fees in the last 24 hours would be %s, maximum: %s",
+			ErrCostCapReached, spent.String(), sender.maxDailyCost.String())
+	}
+
+	return nil
+}
+
+func (sender *contractSender) recordFee(now int64, fee *big.Int) {
+	sender.mut.Lock()
+	defer sender.mut.Unlock()
+
+	sender.spentFees = append(sender.spentFees, &spentFee{
+		timestamp: now,
+		fee:       fee,
+	})
+}
+
+func (sender *contractSender) signTransaction(tx *transaction.FrontendTransaction) error {
+	tx.Signature = ""
+	buff, err := json.Marshal(&tx)
+	if err != nil {
+		return err
+	}
+
+	signature, err := sender.singleSigner.Sign(sender.privateKey, buff)
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// Close closes the nonce transaction handler
+func (sender *contractSender) Close() error {
+	return sender.nonceTxHandler.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *contractSender) IsInterfaceNil() bool {
+	return sender == nil
+}
+
+// ArgsCollectorSender is the DTO used to create a new heartbeat collector sender
+type ArgsCollectorSender struct {
+	HTTPClient   HTTPClient
+	URL          string
+	PrivateKey   crypto.PrivateKey
+	SingleSigner crypto.SingleSigner
+}
+
+type collectorSender struct {
+	httpClient   HTTPClient
+	url          string
+	privateKey   crypto.PrivateKey
+	publicKey    string
+	singleSigner crypto.SingleSigner
+}
+
+// NewCollectorSender creates a heartbeat sender posting the messages, signed with the relayer's key, to a collector.
+// The off-chain heartbeats have no cost
+func NewCollectorSender(args ArgsCollectorSender) (*collectorSender, error) {
+	if check.IfNilReflect(args.HTTPClient) {
+		return nil, ErrNilHTTPClient
+	}
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if check.IfNil(args.PrivateKey) {
+		return nil, ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return nil, ErrNilSingleSigner
+	}
+
+	publicKeyBytes, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &collectorSender{
+		httpClient:   args.HTTPClient,
+		url:          args.URL,
+		privateKey:   args.PrivateKey,
+		publicKey:    hex.EncodeToString(publicKeyBytes),
+		singleSigner: args.SingleSigner,
+	}, nil
+}
+
+// Send posts the signed heartbeat to the collector. Returns the collector URL
+func (sender *collectorSender) Send(ctx context.Context, message *core.HeartbeatMessage) (string, error) {
+	messageBytes, err := json.Marshal(message)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := sender.singleSigner.Sign(sender.privateKey, messageBytes)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(&core.SignedHeartbeat{
+		Message:   message,
+		PublicKey: sender.publicKey,
+		Signature: hex.EncodeToString(signature),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, sender.url, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := sender.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		responseBody, _ := io.ReadAll(io.LimitReader(response.Body, maxResponseBodyLogLength))
+		return "", fmt.Errorf("%w %d from the heartbeat collector: %s", ErrUnexpectedHTTPStatus, response.StatusCode, string(responseBody))
+	}
+
+	return sender.url, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sender *collectorSender) IsInterfaceNil() bool {
+	return sender == nil
+}
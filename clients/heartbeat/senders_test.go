@@ -0,0 +1,405 @@
+package heartbeat
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	testCrypto "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testHeartbeatContract = "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"
+	testCollectorURL      = "http://collector.local/heartbeat"
+	testMinGasPrice       = uint64(1000000000)
+)
+
+func createMockArgsContractSender() ArgsContractSender {
+	return ArgsContractSender{
+		Proxy: &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					ChainID:               "T",
+					MinGasPrice:           testMinGasPrice,
+					MinGasLimit:           50000,
+					GasPerDataByte:        1500,
+					MinTransactionVersion: 1,
+				}, nil
+			},
+		},
+		NonceTxHandler: &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				return "hash", nil
+			},
+		},
+		PrivateKey:      testCrypto.NewPrivateKeyMock(),
+		SingleSigner:    &testCrypto.SingleSignerStub{},
+		ContractAddress: testHeartbeatContract,
+		Function:        "heartbeat",
+		GasLimit:        1000000,
+		MaxDailyCost:    big.NewInt(2000000000000000),
+		Timer:           testsCommon.NewTimerStub(),
+	}
+}
+
+func createMockArgsCollectorSender() ArgsCollectorSender {
+	return ArgsCollectorSender{
+		HTTPClient:   &testsCommon.HTTPClientStub{},
+		URL:          testCollectorURL,
+		PrivateKey:   testCrypto.NewPrivateKeyMock(),
+		SingleSigner: &testCrypto.SingleSignerStub{},
+	}
+}
+
+func createHTTPResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func TestNewContractSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.Proxy = nil
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil nonce transactions handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.NonceTxHandler = nil
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilNonceTxHandler, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.PrivateKey = nil
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilPrivateKey, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.SingleSigner = nil
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilSingleSigner, err)
+	})
+	t.Run("empty function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.Function = ""
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrEmptyFunction, err)
+	})
+	t.Run("zero gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.GasLimit = 0
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "GasLimit")
+	})
+	t.Run("invalid maximum daily cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.MaxDailyCost = nil
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+
+		args.MaxDailyCost = big.NewInt(0)
+		sender, err = NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "MaxDailyCost")
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.Timer = nil
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("invalid contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.ContractAddress = "invalid"
+		sender, err := NewContractSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sender, err := NewContractSender(createMockArgsContractSender())
+		assert.False(t, check.IfNil(sender))
+		assert.Nil(t, err)
+	})
+}
+
+func TestContractSender_Send(t *testing.T) {
+	t.Parallel()
+
+	message := &core.HeartbeatMessage{
+		Address:   relayerAddress,
+		Timestamp: 1000,
+		Sequence:  1,
+	}
+
+	t.Run("get network config errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsContractSender()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return nil, expectedErr
+			},
+		}
+		sender, _ := NewContractSender(args)
+
+		hash, err := sender.Send(context.Background(), message)
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should send the heartbeat transaction", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsContractSender()
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("signature"), nil
+			},
+		}
+		var sentTx *transaction.FrontendTransaction
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address sdkCore.AddressHandler, tx *transaction.FrontendTransaction) error {
+				tx.Nonce = 7
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				sentTx = tx
+				return "hash", nil
+			},
+		}
+		sender, _ := NewContractSender(args)
+
+		hash, err := sender.Send(context.Background(), message)
+		require.Nil(t, err)
+		assert.Equal(t, "hash", hash)
+
+		expectedData := "heartbeat@03e8@01"
+		require.NotNil(t, sentTx)
+		assert.Equal(t, expectedData, string(sentTx.Data))
+		assert.Equal(t, uint64(7), sentTx.Nonce)
+		assert.Equal(t, testHeartbeatContract, sentTx.Receiver)
+		assert.Equal(t, sender.bech32Address, sentTx.Sender)
+		assert.Equal(t, zeroValue, sentTx.Value)
+		assert.Equal(t, testMinGasPrice, sentTx.GasPrice)
+		assert.Equal(t, uint64(50000+len(expectedData)*1500+1000000), sentTx.GasLimit)
+		assert.Equal(t, hex.EncodeToString([]byte("signature")), sentTx.Signature)
+	})
+	t.Run("cost cap reached should not apply the nonce", func(t *testing.T) {
+		t.Parallel()
+
+		now := int64(1000)
+		args := createMockArgsContractSender()
+		args.Timer = createTimerStub(&now)
+		numApplied := 0
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address sdkCore.AddressHandler, tx *transaction.FrontendTransaction) error {
+				numApplied++
+				return nil
+			},
+		}
+		sender, _ := NewContractSender(args)
+
+		// one heartbeat costs 1075500 * 10^9, so only one fits in the daily cap of 2 * 10^15
+		_, err := sender.Send(context.Background(), message)
+		require.Nil(t, err)
+
+		now += 3600
+		_, err = sender.Send(context.Background(), message)
+		assert.True(t, errors.Is(err, ErrCostCapReached))
+		assert.Equal(t, 1, numApplied)
+
+		now = 1000 + secondsInDay
+		_, err = sender.Send(context.Background(), message)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numApplied)
+	})
+	t.Run("failed send should not count in the cost cap", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsContractSender()
+		shouldFail := true
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				if shouldFail {
+					return "", expectedErr
+				}
+				return "hash", nil
+			},
+		}
+		sender, _ := NewContractSender(args)
+
+		_, err := sender.Send(context.Background(), message)
+		assert.Equal(t, expectedErr, err)
+
+		shouldFail = false
+		hash, err := sender.Send(context.Background(), message)
+		assert.Nil(t, err)
+		assert.Equal(t, "hash", hash)
+	})
+}
+
+func TestNewCollectorSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCollectorSender()
+		args.HTTPClient = nil
+		sender, err := NewCollectorSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilHTTPClient, err)
+	})
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCollectorSender()
+		args.URL = ""
+		sender, err := NewCollectorSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCollectorSender()
+		args.PrivateKey = nil
+		sender, err := NewCollectorSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilPrivateKey, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCollectorSender()
+		args.SingleSigner = nil
+		sender, err := NewCollectorSender(args)
+		assert.True(t, check.IfNil(sender))
+		assert.Equal(t, ErrNilSingleSigner, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sender, err := NewCollectorSender(createMockArgsCollectorSender())
+		assert.False(t, check.IfNil(sender))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCollectorSender_Send(t *testing.T) {
+	t.Parallel()
+
+	message := &core.HeartbeatMessage{
+		Address:   relayerAddress,
+		Timestamp: 1000,
+		Sequence:  1,
+	}
+
+	t.Run("unexpected status should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCollectorSender()
+		args.HTTPClient = &testsCommon.HTTPClientStub{
+			DoCalled: func(req *http.Request) (*http.Response, error) {
+				return createHTTPResponse(http.StatusUnauthorized, "unknown relayer"), nil
+			},
+		}
+		sender, _ := NewCollectorSender(args)
+
+		reference, err := sender.Send(context.Background(), message)
+		assert.Empty(t, reference)
+		assert.True(t, errors.Is(err, ErrUnexpectedHTTPStatus))
+		assert.Contains(t, err.Error(), "unknown relayer")
+	})
+	t.Run("should post the signed heartbeat", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCollectorSender()
+		expectedMessageBytes, _ := json.Marshal(message)
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				assert.Equal(t, expectedMessageBytes, msg)
+				return []byte("signature"), nil
+			},
+		}
+		var postedHeartbeat *core.SignedHeartbeat
+		args.HTTPClient = &testsCommon.HTTPClientStub{
+			DoCalled: func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, http.MethodPost, req.Method)
+				assert.Equal(t, testCollectorURL, req.URL.String())
+
+				postedHeartbeat = &core.SignedHeartbeat{}
+				err := json.NewDecoder(req.Body).Decode(postedHeartbeat)
+				assert.Nil(t, err)
+
+				return createHTTPResponse(http.StatusOK, ""), nil
+			},
+		}
+		sender, _ := NewCollectorSender(args)
+
+		reference, err := sender.Send(context.Background(), message)
+		require.Nil(t, err)
+		assert.Equal(t, testCollectorURL, reference)
+
+		publicKeyBytes, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+		expectedHeartbeat := &core.SignedHeartbeat{
+			Message:   message,
+			PublicKey: hex.EncodeToString(publicKeyBytes),
+			Signature: hex.EncodeToString([]byte("signature")),
+		}
+		assert.Equal(t, expectedHeartbeat, postedHeartbeat)
+	})
+}
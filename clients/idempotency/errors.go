@@ -0,0 +1,15 @@
+package idempotency
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilTimer signals that a nil timer has been provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrEmptyIdempotencyKey signals that an empty idempotency key has been provided
+var ErrEmptyIdempotencyKey = errors.New("empty idempotency key")
@@ -0,0 +1,122 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const storerKeyPrefix = "idempotency-"
+
+// ArgsIdempotencyGuard is the DTO used to create a new instance of type idempotencyGuard
+type ArgsIdempotencyGuard struct {
+	Log    logger.Logger
+	Storer core.Storer
+	Timer  core.Timer
+}
+
+// completedKey is the record persisted for each completed idempotency key
+type completedKey struct {
+	Direction   string `json:"direction"`
+	BatchID     uint64 `json:"batchID"`
+	CompletedAt int64  `json:"completedAt"`
+}
+
+type idempotencyGuard struct {
+	log    logger.Logger
+	storer core.Storer
+	timer  core.Timer
+
+	mut       sync.RWMutex
+	completed map[string]struct{}
+}
+
+// NewIdempotencyGuard creates the component persisting the idempotency keys of the completed batch actions. As the keys
+// are deterministic, a relayer refuses to repeat an action already completed, even after a restart or a leader change
+func NewIdempotencyGuard(args ArgsIdempotencyGuard) (*idempotencyGuard, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Timer) {
+		return nil, ErrNilTimer
+	}
+
+	return &idempotencyGuard{
+		log:       args.Log,
+		storer:    args.Storer,
+		timer:     args.Timer,
+		completed: make(map[string]struct{}),
+	}, nil
+}
+
+// IsCompleted returns true if the provided idempotency key was marked as completed. The completed keys are cached, the
+// storer is only queried for the keys not yet seen as completed
+func (guard *idempotencyGuard) IsCompleted(key string) (bool, error) {
+	if len(key) == 0 {
+		return false, ErrEmptyIdempotencyKey
+	}
+
+	guard.mut.RLock()
+	_, found := guard.completed[key]
+	guard.mut.RUnlock()
+	if found {
+		return true, nil
+	}
+
+	// the storer returns an error for the missing keys
+	buff, err := guard.storer.Get([]byte(storerKeyPrefix + key))
+	if err != nil || len(buff) == 0 {
+		return false, nil
+	}
+
+	guard.mut.Lock()
+	guard.completed[key] = struct{}{}
+	guard.mut.Unlock()
+
+	return true, nil
+}
+
+// MarkCompleted persists the provided idempotency key as completed
+func (guard *idempotencyGuard) MarkCompleted(key string, direction string, batchID uint64) error {
+	if len(key) == 0 {
+		return ErrEmptyIdempotencyKey
+	}
+
+	guard.mut.Lock()
+	defer guard.mut.Unlock()
+
+	_, found := guard.completed[key]
+	if found {
+		return nil
+	}
+
+	buff, err := json.Marshal(&completedKey{
+		Direction:   direction,
+		BatchID:     batchID,
+		CompletedAt: guard.timer.NowUnix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = guard.storer.Put([]byte(storerKeyPrefix+key), buff)
+	if err != nil {
+		return err
+	}
+	guard.completed[key] = struct{}{}
+
+	guard.log.Debug("idempotency key marked as completed", "key", key, "direction", direction, "batch ID", batchID)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (guard *idempotencyGuard) IsInterfaceNil() bool {
+	return guard == nil
+}
@@ -0,0 +1,139 @@
+package idempotency
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testKey       = "2527b2002ae2ed50f71797ad50fabc81d55d33451b15fd15b1ef901f36102c4e"
+	testDirection = "EthereumToMultiversX"
+)
+
+func createMockArgsIdempotencyGuard() ArgsIdempotencyGuard {
+	return ArgsIdempotencyGuard{
+		Log:    &testsCommon.LoggerStub{},
+		Storer: testsCommon.NewStorerMock(),
+		Timer:  testsCommon.NewTimerStub(),
+	}
+}
+
+func TestNewIdempotencyGuard(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIdempotencyGuard()
+		args.Log = nil
+
+		guard, err := NewIdempotencyGuard(args)
+		assert.Equal(t, ErrNilLogger, err)
+		assert.True(t, check.IfNil(guard))
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIdempotencyGuard()
+		args.Storer = nil
+
+		guard, err := NewIdempotencyGuard(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(guard))
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIdempotencyGuard()
+		args.Timer = nil
+
+		guard, err := NewIdempotencyGuard(args)
+		assert.Equal(t, ErrNilTimer, err)
+		assert.True(t, check.IfNil(guard))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		guard, err := NewIdempotencyGuard(createMockArgsIdempotencyGuard())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(guard))
+	})
+}
+
+func TestIdempotencyGuard_MarkCompleted(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		guard, _ := NewIdempotencyGuard(createMockArgsIdempotencyGuard())
+
+		err := guard.MarkCompleted("", testDirection, 1)
+		assert.Equal(t, ErrEmptyIdempotencyKey, err)
+
+		isCompleted, err := guard.IsCompleted("")
+		assert.Equal(t, ErrEmptyIdempotencyKey, err)
+		assert.False(t, isCompleted)
+	})
+	t.Run("storer error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsIdempotencyGuard()
+		args.Storer = &testsCommon.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				return expectedErr
+			},
+		}
+		guard, _ := NewIdempotencyGuard(args)
+
+		err := guard.MarkCompleted(testKey, testDirection, 1)
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, guard.completed)
+	})
+	t.Run("should persist the completed key", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIdempotencyGuard()
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return 1700000000
+		}
+		args.Timer = timer
+		guard, _ := NewIdempotencyGuard(args)
+
+		isCompleted, err := guard.IsCompleted(testKey)
+		assert.Nil(t, err)
+		assert.False(t, isCompleted)
+
+		err = guard.MarkCompleted(testKey, testDirection, 7)
+		require.Nil(t, err)
+
+		isCompleted, err = guard.IsCompleted(testKey)
+		assert.Nil(t, err)
+		assert.True(t, isCompleted)
+
+		buff, err := args.Storer.Get([]byte(storerKeyPrefix + testKey))
+		require.Nil(t, err)
+		record := &completedKey{}
+		err = json.Unmarshal(buff, record)
+		require.Nil(t, err)
+		assert.Equal(t, &completedKey{
+			Direction:   testDirection,
+			BatchID:     7,
+			CompletedAt: 1700000000,
+		}, record)
+
+		// a new instance, as after a restart, loads the completed key from the storer
+		otherGuard, _ := NewIdempotencyGuard(args)
+		isCompleted, err = otherGuard.IsCompleted(testKey)
+		assert.Nil(t, err)
+		assert.True(t, isCompleted)
+	})
+}
@@ -0,0 +1,21 @@
+package incidents
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrEmptyFilePath signals that an empty file path has been provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrInvalidMaxIncidents signals that an invalid maximum number of incidents has been provided
+var ErrInvalidMaxIncidents = errors.New("invalid maximum number of incidents")
+
+// ErrIncidentNotFound signals that the incident to acknowledge was not found
+var ErrIncidentNotFound = errors.New("incident not found")
+
+// ErrIncidentAlreadyAcknowledged signals that the incident was already acknowledged
+var ErrIncidentAlreadyAcknowledged = errors.New("incident already acknowledged")
+
+// ErrEmptyOperator signals that an acknowledgment without the operator identity has been provided
+var ErrEmptyOperator = errors.New("empty operator")
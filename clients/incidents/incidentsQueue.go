@@ -0,0 +1,270 @@
+package incidents
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	incidentsFilePermissions = 0644
+	incidentsDirPermissions  = 0755
+)
+
+// ArgsIncidentsQueue is the DTO used to create a new instance of type incidentsQueue
+type ArgsIncidentsQueue struct {
+	Log          logger.Logger
+	FilePath     string
+	MaxIncidents int
+}
+
+type incidentsQueue struct {
+	log          logger.Logger
+	filePath     string
+	maxIncidents int
+	getTime      func() int64
+
+	mut       sync.RWMutex
+	incidents []*core.Incident
+	lastID    uint64
+}
+
+// NewIncidentsQueue creates the component recording the incidents that halted the bridge processing. The incidents log
+// is loaded from the provided file, so the incidents not acknowledged before a restart still block the processing
+func NewIncidentsQueue(args ArgsIncidentsQueue) (*incidentsQueue, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if args.MaxIncidents < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxIncidents, args.MaxIncidents)
+	}
+
+	queue := &incidentsQueue{
+		log:          args.Log,
+		filePath:     args.FilePath,
+		maxIncidents: args.MaxIncidents,
+		getTime: func() int64 {
+			return time.Now().Unix()
+		},
+	}
+
+	// a corrupted incidents log is not discarded, otherwise the unacknowledged incidents would be silently lost
+	err := queue.load()
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the incidents log %s", err, args.FilePath)
+	}
+
+	return queue, nil
+}
+
+func (queue *incidentsQueue) load() error {
+	buff, err := os.ReadFile(queue.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		queue.incidents = make([]*core.Incident, 0)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	incidents := make([]*core.Incident, 0)
+	err = json.Unmarshal(buff, &incidents)
+	if err != nil {
+		return err
+	}
+
+	for _, incident := range incidents {
+		if incident.ID > queue.lastID {
+			queue.lastID = incident.ID
+		}
+	}
+	queue.incidents = incidents
+
+	return nil
+}
+
+// Raise records an incident raised by the provided source and returns its ID. If the source already has an
+// unacknowledged incident, its occurrences are counted instead of queueing a new incident
+func (queue *incidentsQueue) Raise(source string, description string) uint64 {
+	queue.mut.Lock()
+	defer queue.mut.Unlock()
+
+	now := queue.getTime()
+	incident := queue.getUnacknowledgedIncidentUnprotected(source)
+	if incident != nil {
+		incident.Description = description
+		incident.LastRaisedAt = now
+		incident.NumOccurrences++
+	} else {
+		queue.lastID++
+		incident = &core.Incident{
+			ID:             queue.lastID,
+			Source:         source,
+			Description:    description,
+			RaisedAt:       now,
+			LastRaisedAt:   now,
+			NumOccurrences: 1,
+		}
+		queue.incidents = append(queue.incidents, incident)
+		queue.trimUnprotected()
+	}
+
+	queue.log.Warn("incident raised, the processing will not resume before the operator's acknowledgment",
+		"ID", incident.ID, "source", source, "description", description, "occurrences", incident.NumOccurrences)
+
+	err := queue.saveUnprotected()
+	if err != nil {
+		queue.log.Error("unable to save the incidents log", "file", queue.filePath, "error", err)
+	}
+
+	return incident.ID
+}
+
+func (queue *incidentsQueue) getUnacknowledgedIncidentUnprotected(source string) *core.Incident {
+	for _, incident := range queue.incidents {
+		if incident.Source == source && incident.Acknowledgment == nil {
+			return incident
+		}
+	}
+
+	return nil
+}
+
+// trimUnprotected removes the oldest acknowledged incidents above the maximum number of incidents. The unacknowledged
+// incidents are never removed. The mutex should be held
+func (queue *incidentsQueue) trimUnprotected() {
+	numToRemove := len(queue.incidents) - queue.maxIncidents
+	if numToRemove <= 0 {
+		return
+	}
+
+	incidents := make([]*core.Incident, 0, len(queue.incidents))
+	for _, incident := range queue.incidents {
+		if numToRemove > 0 && incident.Acknowledgment != nil {
+			numToRemove--
+			continue
+		}
+		incidents = append(incidents, incident)
+	}
+	queue.incidents = incidents
+}
+
+// AcknowledgeIncident records the operator's acknowledgment of the provided incident. The acknowledgment timestamp is
+// set by the queue
+func (queue *incidentsQueue) AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+	acknowledgment.Operator = strings.TrimSpace(acknowledgment.Operator)
+	if len(acknowledgment.Operator) == 0 {
+		return nil, ErrEmptyOperator
+	}
+
+	queue.mut.Lock()
+	defer queue.mut.Unlock()
+
+	incident := queue.getIncidentUnprotected(id)
+	if incident == nil {
+		return nil, fmt.Errorf("%w: %d", ErrIncidentNotFound, id)
+	}
+	if incident.Acknowledgment != nil {
+		return nil, fmt.Errorf("%w: %d by %s", ErrIncidentAlreadyAcknowledged, id, incident.Acknowledgment.Operator)
+	}
+
+	acknowledgment.Timestamp = queue.getTime()
+	incident.Acknowledgment = &acknowledgment
+
+	// the acknowledgment is reverted if it can not be persisted, so it can not be lost on a restart
+	err := queue.saveUnprotected()
+	if err != nil {
+		incident.Acknowledgment = nil
+		return nil, err
+	}
+
+	queue.log.Info("incident acknowledged", "ID", id, "source", incident.Source,
+		"operator", acknowledgment.Operator, "remote address", acknowledgment.RemoteAddress)
+
+	return copyIncident(incident), nil
+}
+
+func (queue *incidentsQueue) getIncidentUnprotected(id uint64) *core.Incident {
+	for _, incident := range queue.incidents {
+		if incident.ID == id {
+			return incident
+		}
+	}
+
+	return nil
+}
+
+func (queue *incidentsQueue) saveUnprotected() error {
+	buff, err := json.MarshalIndent(queue.incidents, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(queue.filePath), incidentsDirPermissions)
+	if err != nil {
+		return err
+	}
+
+	// the file is replaced atomically so a crash while saving does not lose the incidents log
+	tempFilePath := queue.filePath + ".tmp"
+	err = os.WriteFile(tempFilePath, buff, incidentsFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tempFilePath, queue.filePath)
+}
+
+// HasUnacknowledgedIncidents returns true if at least one incident was not acknowledged by an operator
+func (queue *incidentsQueue) HasUnacknowledgedIncidents() bool {
+	queue.mut.RLock()
+	defer queue.mut.RUnlock()
+
+	for _, incident := range queue.incidents {
+		if incident.Acknowledgment == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetIncidents returns the incidents log, the oldest incident first
+func (queue *incidentsQueue) GetIncidents() []*core.Incident {
+	queue.mut.RLock()
+	defer queue.mut.RUnlock()
+
+	incidents := make([]*core.Incident, 0, len(queue.incidents))
+	for _, incident := range queue.incidents {
+		incidents = append(incidents, copyIncident(incident))
+	}
+
+	return incidents
+}
+
+func copyIncident(incident *core.Incident) *core.Incident {
+	incidentCopy := *incident
+	if incident.Acknowledgment != nil {
+		acknowledgmentCopy := *incident.Acknowledgment
+		incidentCopy.Acknowledgment = &acknowledgmentCopy
+	}
+
+	return &incidentCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (queue *incidentsQueue) IsInterfaceNil() bool {
+	return queue == nil
+}
@@ -0,0 +1,200 @@
+package incidents
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const governancePauseSource = "GovernancePause"
+
+func createMockArgsIncidentsQueue(tb testing.TB) ArgsIncidentsQueue {
+	return ArgsIncidentsQueue{
+		Log:          &testsCommon.LoggerStub{},
+		FilePath:     filepath.Join(tb.TempDir(), "incidents", "incidents.json"),
+		MaxIncidents: 10,
+	}
+}
+
+func createQueueWithTime(tb testing.TB, args ArgsIncidentsQueue, currentTime *int64) *incidentsQueue {
+	queue, err := NewIncidentsQueue(args)
+	require.Nil(tb, err)
+	queue.getTime = func() int64 {
+		return *currentTime
+	}
+
+	return queue
+}
+
+func createAcknowledgment(operator string) core.IncidentAcknowledgment {
+	return core.IncidentAcknowledgment{
+		Operator:      operator,
+		RemoteAddress: "10.0.0.1",
+		Comment:       "checked",
+	}
+}
+
+func TestNewIncidentsQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIncidentsQueue(t)
+		args.Log = nil
+
+		queue, err := NewIncidentsQueue(args)
+		assert.True(t, check.IfNil(queue))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("empty file path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIncidentsQueue(t)
+		args.FilePath = ""
+
+		queue, err := NewIncidentsQueue(args)
+		assert.True(t, check.IfNil(queue))
+		assert.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("invalid maximum number of incidents should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIncidentsQueue(t)
+		args.MaxIncidents = 0
+
+		queue, err := NewIncidentsQueue(args)
+		assert.True(t, check.IfNil(queue))
+		assert.True(t, errors.Is(err, ErrInvalidMaxIncidents))
+	})
+	t.Run("corrupted incidents log should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsIncidentsQueue(t)
+		args.FilePath = filepath.Join(t.TempDir(), "incidents.json")
+		err := os.WriteFile(args.FilePath, []byte("not a json"), incidentsFilePermissions)
+		require.Nil(t, err)
+
+		queue, err := NewIncidentsQueue(args)
+		assert.True(t, check.IfNil(queue))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		queue, err := NewIncidentsQueue(createMockArgsIncidentsQueue(t))
+		assert.False(t, check.IfNil(queue))
+		assert.Nil(t, err)
+		assert.False(t, queue.HasUnacknowledgedIncidents())
+		assert.Empty(t, queue.GetIncidents())
+	})
+}
+
+func TestIncidentsQueue_RaiseAndAcknowledge(t *testing.T) {
+	t.Parallel()
+
+	currentTime := int64(1700000000)
+	queue := createQueueWithTime(t, createMockArgsIncidentsQueue(t), &currentTime)
+
+	id := queue.Raise(governancePauseSource, "governance pause observed on Ethereum")
+	assert.Equal(t, uint64(1), id)
+	assert.True(t, queue.HasUnacknowledgedIncidents())
+
+	currentTime += 10
+	id = queue.Raise(governancePauseSource, "governance pause observed on Ethereum,MultiversX")
+	assert.Equal(t, uint64(1), id)
+
+	currentTime += 10
+	_, err := queue.AcknowledgeIncident(1, createAcknowledgment(" "))
+	assert.Equal(t, ErrEmptyOperator, err)
+	_, err = queue.AcknowledgeIncident(2, createAcknowledgment("alice"))
+	assert.True(t, errors.Is(err, ErrIncidentNotFound))
+
+	incident, err := queue.AcknowledgeIncident(1, createAcknowledgment("alice"))
+	require.Nil(t, err)
+	expectedIncident := &core.Incident{
+		ID:             1,
+		Source:         governancePauseSource,
+		Description:    "governance pause observed on Ethereum,MultiversX",
+		RaisedAt:       1700000000,
+		LastRaisedAt:   1700000010,
+		NumOccurrences: 2,
+		Acknowledgment: &core.IncidentAcknowledgment{
+			Operator:      "alice",
+			RemoteAddress: "10.0.0.1",
+			Comment:       "checked",
+			Timestamp:     1700000020,
+		},
+	}
+	assert.Equal(t, expectedIncident, incident)
+	assert.False(t, queue.HasUnacknowledgedIncidents())
+	assert.Equal(t, []*core.Incident{expectedIncident}, queue.GetIncidents())
+
+	_, err = queue.AcknowledgeIncident(1, createAcknowledgment("bob"))
+	assert.True(t, errors.Is(err, ErrIncidentAlreadyAcknowledged))
+
+	id = queue.Raise(governancePauseSource, "governance pause observed on MultiversX")
+	assert.Equal(t, uint64(2), id)
+	assert.True(t, queue.HasUnacknowledgedIncidents())
+}
+
+func TestIncidentsQueue_ReturnedIncidentsAreCopies(t *testing.T) {
+	t.Parallel()
+
+	queue, _ := NewIncidentsQueue(createMockArgsIncidentsQueue(t))
+	queue.Raise(governancePauseSource, "description")
+	incident, _ := queue.AcknowledgeIncident(1, createAcknowledgment("alice"))
+
+	incident.Acknowledgment.Operator = "mallory"
+	queue.GetIncidents()[0].Acknowledgment = nil
+
+	assert.Equal(t, "alice", queue.GetIncidents()[0].Acknowledgment.Operator)
+}
+
+func TestIncidentsQueue_ShouldPersistTheIncidentsLog(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsIncidentsQueue(t)
+	queue, _ := NewIncidentsQueue(args)
+	queue.Raise(governancePauseSource, "first")
+	_, err := queue.AcknowledgeIncident(1, createAcknowledgment("alice"))
+	require.Nil(t, err)
+	queue.Raise("other source", "second")
+
+	reloadedQueue, err := NewIncidentsQueue(args)
+	require.Nil(t, err)
+	assert.True(t, reloadedQueue.HasUnacknowledgedIncidents())
+	assert.Equal(t, queue.GetIncidents(), reloadedQueue.GetIncidents())
+
+	id := reloadedQueue.Raise(governancePauseSource, "third")
+	assert.Equal(t, uint64(3), id)
+}
+
+func TestIncidentsQueue_ShouldOnlyTrimTheAcknowledgedIncidents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsIncidentsQueue(t)
+	args.MaxIncidents = 2
+	queue, _ := NewIncidentsQueue(args)
+
+	queue.Raise("source 1", "description")
+	queue.Raise("source 2", "description")
+	queue.Raise("source 3", "description")
+	assert.Equal(t, 3, len(queue.GetIncidents()))
+
+	_, _ = queue.AcknowledgeIncident(2, createAcknowledgment("alice"))
+	queue.Raise("source 4", "description")
+
+	incidents := queue.GetIncidents()
+	require.Equal(t, 3, len(incidents))
+	assert.Equal(t, uint64(1), incidents[0].ID)
+	assert.Equal(t, uint64(3), incidents[1].ID)
+	assert.Equal(t, uint64(4), incidents[2].ID)
+}
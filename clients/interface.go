@@ -1,7 +1,10 @@
 package clients
 
 import (
+	"context"
 	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
 )
 
 // GasHandler defines the component able to fetch the current gas price
@@ -10,3 +13,9 @@ type GasHandler interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// BatchValidator defines the component able to validate a batch before it is signed
+type BatchValidator interface {
+	ValidateBatch(ctx context.Context, batch *core.TransferBatch) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,112 @@
+package multiversx
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+const transferPerformedEvent = "transferPerformedEvent"
+
+// minTransferEventTopics - the event name, the batch ID and the deposit nonce
+const minTransferEventTopics = 3
+
+// rejectedDepositReasons maps the events emitted by the multi-transfer contract for the rejected deposits
+var rejectedDepositReasons = map[string]string{
+	"transferFailedInvalidDestination":       "invalid destination",
+	"transferFailedFrozenDestinationAccount": "frozen destination account",
+	"transferOverMaxAmount":                  "amount over the maximum bridged amount",
+}
+
+// GetBatchResults decodes the events of the provided perform action transaction into the results of each deposit of
+// the batch. The deposits for which no event was found are marked as unknown
+func (c *client) GetBatchResults(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error) {
+	if batch == nil {
+		return nil, clients.ErrNilBatch
+	}
+
+	txInfo, err := c.proxy.GetTransactionInfoWithResults(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if txInfo == nil {
+		return nil, errNilTransactionInfo
+	}
+
+	depositResults := make(map[uint64]*bridgeCore.DepositResult)
+	for _, event := range collectTransactionEvents(txInfo.Data.Transaction.Logs, txInfo.Data.Transaction.ScResults) {
+		result, batchID := decodeTransferEvent(event)
+		if result == nil || batchID != batch.ID {
+			continue
+		}
+
+		depositResults[result.Nonce] = result
+	}
+
+	batchResults := &bridgeCore.BatchResults{
+		BatchID:  batch.ID,
+		TxHash:   txHash,
+		Deposits: make([]*bridgeCore.DepositResult, 0, len(batch.Deposits)),
+	}
+	for _, deposit := range batch.Deposits {
+		result, found := depositResults[deposit.Nonce]
+		if !found {
+			result = &bridgeCore.DepositResult{
+				Nonce:  deposit.Nonce,
+				Status: bridgeCore.DepositUnknown,
+			}
+		}
+
+		batchResults.Deposits = append(batchResults.Deposits, result)
+	}
+
+	return batchResults, nil
+}
+
+func collectTransactionEvents(txLogs *transaction.ApiLogs, scResults []*transaction.ApiSmartContractResult) []*transaction.Events {
+	events := make([]*transaction.Events, 0)
+	if txLogs != nil {
+		events = append(events, txLogs.Events...)
+	}
+	for _, scr := range scResults {
+		if scr == nil || scr.Logs == nil {
+			continue
+		}
+
+		events = append(events, scr.Logs.Events...)
+	}
+
+	return events
+}
+
+// decodeTransferEvent returns the deposit result and the batch ID of a transfer event, or nil if the event is not a
+// transfer event
+func decodeTransferEvent(event *transaction.Events) (*bridgeCore.DepositResult, uint64) {
+	if event == nil || len(event.Topics) < minTransferEventTopics {
+		return nil, 0
+	}
+
+	eventName := string(event.Topics[0])
+	batchID := big.NewInt(0).SetBytes(event.Topics[1]).Uint64()
+	result := &bridgeCore.DepositResult{
+		Nonce: big.NewInt(0).SetBytes(event.Topics[2]).Uint64(),
+	}
+
+	if eventName == transferPerformedEvent {
+		result.Status = bridgeCore.DepositExecuted
+		return result, batchID
+	}
+
+	reason, isRejected := rejectedDepositReasons[eventName]
+	if !isRejected {
+		return nil, 0
+	}
+
+	result.Status = bridgeCore.DepositRejected
+	result.Reason = reason
+
+	return result, batchID
+}
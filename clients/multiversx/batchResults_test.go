@@ -0,0 +1,129 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTransferEvent(name string, batchID byte, depositNonce byte) *transaction.Events {
+	return &transaction.Events{
+		Identifier: "batchTransferEsdtToken",
+		Topics:     [][]byte{[]byte(name), {batchID}, {depositNonce}},
+	}
+}
+
+func createClientWithProxy(t *testing.T, proxy *interactors.ProxyStub) *client {
+	args := createMockClientArgs()
+	args.Proxy = proxy
+	c, err := NewClient(args)
+	require.Nil(t, err)
+
+	return c
+}
+
+func TestClient_GetBatchResults(t *testing.T) {
+	t.Parallel()
+
+	batch := &bridgeCore.TransferBatch{
+		ID: 2,
+		Deposits: []*bridgeCore.DepositTransfer{
+			{Nonce: 10},
+			{Nonce: 11},
+			{Nonce: 12},
+			{Nonce: 13},
+		},
+	}
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		c := createClientWithProxy(t, &interactors.ProxyStub{})
+		results, err := c.GetBatchResults(context.Background(), "hash", nil)
+		assert.Nil(t, results)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("proxy errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		c := createClientWithProxy(t, &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(_ context.Context, _ string) (*data.TransactionInfo, error) {
+				return nil, expectedErr
+			},
+		})
+		results, err := c.GetBatchResults(context.Background(), "hash", batch)
+		assert.Nil(t, results)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should decode the transaction and the smart contract results events", func(t *testing.T) {
+		t.Parallel()
+
+		txInfo := &data.TransactionInfo{}
+		txInfo.Data.Transaction.Logs = &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				createTransferEvent(transferPerformedEvent, 2, 10),
+				{
+					Identifier: "writeLog",
+					Topics:     [][]byte{[]byte("address")},
+				},
+			},
+		}
+		txInfo.Data.Transaction.ScResults = []*transaction.ApiSmartContractResult{
+			{
+				Logs: &transaction.ApiLogs{
+					Events: []*transaction.Events{
+						createTransferEvent("transferFailedInvalidDestination", 2, 11),
+						createTransferEvent("transferOverMaxAmount", 2, 12),
+						createTransferEvent(transferPerformedEvent, 1, 13),
+						createTransferEvent("unknownEvent", 2, 13),
+					},
+				},
+			},
+			{},
+		}
+
+		c := createClientWithProxy(t, &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(_ context.Context, hash string) (*data.TransactionInfo, error) {
+				assert.Equal(t, "hash", hash)
+				return txInfo, nil
+			},
+		})
+		results, err := c.GetBatchResults(context.Background(), "hash", batch)
+		require.Nil(t, err)
+
+		expectedResults := &bridgeCore.BatchResults{
+			BatchID: 2,
+			TxHash:  "hash",
+			Deposits: []*bridgeCore.DepositResult{
+				{
+					Nonce:  10,
+					Status: bridgeCore.DepositExecuted,
+				},
+				{
+					Nonce:  11,
+					Status: bridgeCore.DepositRejected,
+					Reason: "invalid destination",
+				},
+				{
+					Nonce:  12,
+					Status: bridgeCore.DepositRejected,
+					Reason: "amount over the maximum bridged amount",
+				},
+				{
+					Nonce:  13,
+					Status: bridgeCore.DepositUnknown,
+				},
+			},
+		}
+		assert.Equal(t, expectedResults, results)
+	})
+}
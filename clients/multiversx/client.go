@@ -30,23 +30,32 @@ const (
 	signFuncName                    = "sign"
 	performActionFuncName           = "performAction"
 	minClientAvailabilityAllowDelta = 1
+	minLeftoverTransactionsTimeout  = time.Second
 
 	multiversXDataGetterLogId = "MultiversXEth-MultiversXDataGetter"
 )
 
 // ClientArgs represents the argument for the NewClient constructor function
 type ClientArgs struct {
-	GasMapConfig                 config.MultiversXGasMapConfig
-	Proxy                        Proxy
-	Log                          logger.Logger
-	RelayerPrivateKey            crypto.PrivateKey
-	MultisigContractAddress      core.AddressHandler
-	SafeContractAddress          core.AddressHandler
-	IntervalToResendTxsInSeconds uint64
-	TokensMapper                 TokensMapper
-	RoleProvider                 roleProvider
-	StatusHandler                bridgeCore.StatusHandler
-	ClientAvailabilityAllowDelta uint64
+	GasMapConfig                     config.MultiversXGasMapConfig
+	Proxy                            Proxy
+	Log                              logger.Logger
+	RelayerPrivateKey                crypto.PrivateKey
+	MultisigContractAddress          core.AddressHandler
+	SafeContractAddress              core.AddressHandler
+	IntervalToResendTxsInSeconds     uint64
+	MaxParallelVMQueries             int
+	QueriesCacheNonceRefreshInterval time.Duration
+	TokensMapper                     TokensMapper
+	RoleProvider                     roleProvider
+	StatusHandler                    bridgeCore.StatusHandler
+	ClientAvailabilityAllowDelta     uint64
+	SentTransactionsStorer           bridgeCore.Storer
+	LeftoverTransactionsTimeout      time.Duration
+	GasUsageTracker                  GasUsageTracker
+	StuckTransactions                config.MultiversXStuckTransactionsConfig
+	Timer                            bridgeCore.Timer
+	GasPayerPrivateKey               crypto.PrivateKey // optional, the relayer pays the gas of its transactions if nil
 }
 
 // client represents the MultiversX Client implementation
@@ -63,6 +72,9 @@ type client struct {
 	addressPublicKeyConverter    bridgeCore.AddressConverter
 	statusHandler                bridgeCore.StatusHandler
 	clientAvailabilityAllowDelta uint64
+	sentTxsJournal               *sentTransactionsJournal
+	leftoverTxsTimeout           time.Duration
+	leftoverTxsPollingInterval   time.Duration
 
 	lastNonce                uint64
 	retriesAvailabilityCheck uint64
@@ -93,12 +105,19 @@ func NewClient(args ClientArgs) (*client, error) {
 
 	relayerAddress := data.NewAddressFromBytes(publicKeyBytes)
 
+	gasPayerAddress, err := getGasPayerAddress(args.GasPayerPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
 	argsMXClientDataGetter := ArgsMXClientDataGetter{
-		MultisigContractAddress: args.MultisigContractAddress,
-		SafeContractAddress:     args.SafeContractAddress,
-		RelayerAddress:          relayerAddress,
-		Proxy:                   args.Proxy,
-		Log:                     bridgeCore.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXDataGetterLogId), multiversXDataGetterLogId),
+		MultisigContractAddress:          args.MultisigContractAddress,
+		SafeContractAddress:              args.SafeContractAddress,
+		RelayerAddress:                   relayerAddress,
+		Proxy:                            args.Proxy,
+		Log:                              bridgeCore.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXDataGetterLogId), multiversXDataGetterLogId),
+		MaxParallelVMQueries:             args.MaxParallelVMQueries,
+		QueriesCacheNonceRefreshInterval: args.QueriesCacheNonceRefreshInterval,
 	}
 	getter, err := NewMXClientDataGetter(argsMXClientDataGetter)
 	if err != nil {
@@ -120,16 +139,37 @@ func NewClient(args ClientArgs) (*client, error) {
 		return nil, fmt.Errorf("%w for %x", err, args.SafeContractAddress.AddressBytes())
 	}
 
+	sentTxsJournal := newSentTransactionsJournal(args.SentTransactionsStorer, args.Log)
+	txHandlerInstance := &transactionHandler{
+		proxy:                   args.Proxy,
+		relayerAddress:          relayerAddress,
+		multisigAddress:         args.MultisigContractAddress,
+		multisigAddressAsBech32: bech23MultisigAddress,
+		nonceTxHandler:          nonceTxsHandler,
+		relayerPrivateKey:       args.RelayerPrivateKey,
+		singleSigner:            &singlesig.Ed25519Signer{},
+		roleProvider:            args.RoleProvider,
+		sentTxsJournal:          sentTxsJournal,
+		gasUsageTracker:         args.GasUsageTracker,
+		gasPayerPrivateKey:      args.GasPayerPrivateKey,
+		gasPayerAddress:         gasPayerAddress,
+		log:                     args.Log,
+	}
+	txHandlerInstance.stuckTxsResender = newStuckTransactionsResender(argsStuckTransactionsResender{
+		config:                  args.StuckTransactions,
+		proxy:                   args.Proxy,
+		nonceTxHandler:          nonceTxsHandler,
+		queryExecutor:           getter,
+		relayerAddress:          relayerAddress,
+		multisigAddressAsBech32: bech23MultisigAddress,
+		signTransaction:         txHandlerInstance.signTransactionWithPrivateKey,
+		log:                     args.Log,
+		timer:                   args.Timer,
+	})
+	txHandlerInstance.stuckTxsResender.start()
+
 	c := &client{
-		txHandler: &transactionHandler{
-			proxy:                   args.Proxy,
-			relayerAddress:          relayerAddress,
-			multisigAddressAsBech32: bech23MultisigAddress,
-			nonceTxHandler:          nonceTxsHandler,
-			relayerPrivateKey:       args.RelayerPrivateKey,
-			singleSigner:            &singlesig.Ed25519Signer{},
-			roleProvider:            args.RoleProvider,
-		},
+		txHandler:                    txHandlerInstance,
 		mxClientDataGetter:           getter,
 		relayerPublicKey:             publicKey,
 		relayerAddress:               relayerAddress,
@@ -141,6 +181,9 @@ func NewClient(args ClientArgs) (*client, error) {
 		tokensMapper:                 args.TokensMapper,
 		statusHandler:                args.StatusHandler,
 		clientAvailabilityAllowDelta: args.ClientAvailabilityAllowDelta,
+		sentTxsJournal:               sentTxsJournal,
+		leftoverTxsTimeout:           args.LeftoverTransactionsTimeout,
+		leftoverTxsPollingInterval:   defaultLeftoverTxsPollingInterval,
 	}
 
 	bech32RelayerAddress, _ := relayerAddress.AddressAsBech32String()
@@ -177,15 +220,45 @@ func checkArgs(args ClientArgs) error {
 	if check.IfNil(args.StatusHandler) {
 		return clients.ErrNilStatusHandler
 	}
+	if check.IfNil(args.SentTransactionsStorer) {
+		return errNilStorer
+	}
+	if check.IfNil(args.GasUsageTracker) {
+		return errNilGasUsageTracker
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
+	if args.LeftoverTransactionsTimeout < minLeftoverTransactionsTimeout {
+		return fmt.Errorf("%w for args.LeftoverTransactionsTimeout, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.LeftoverTransactionsTimeout, minLeftoverTransactionsTimeout)
+	}
 	if args.ClientAvailabilityAllowDelta < minClientAvailabilityAllowDelta {
 		return fmt.Errorf("%w for args.ClientAvailabilityAllowDelta, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.ClientAvailabilityAllowDelta, minClientAvailabilityAllowDelta)
 	}
+	if !check.IfNil(args.GasPayerPrivateKey) && args.StuckTransactions.Enabled {
+		return errGasPayerWithStuckTransactions
+	}
 	err := checkGasMapValues(args.GasMapConfig)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return checkStuckTransactionsConfig(args.StuckTransactions)
+}
+
+func getGasPayerAddress(gasPayerPrivateKey crypto.PrivateKey) (core.AddressHandler, error) {
+	if check.IfNil(gasPayerPrivateKey) {
+		return nil, nil
+	}
+
+	publicKeyBytes, err := gasPayerPrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return data.NewAddressFromBytes(publicKeyBytes), nil
 }
 
 func checkGasMapValues(gasMap config.MultiversXGasMapConfig) error {
@@ -253,10 +326,14 @@ func (c *client) createPendingBatchFromResponse(ctx context.Context, responseDat
 		ID: batchID,
 	}
 
-	cachedTokens := make(map[string][]byte)
+	// the block nonce of the first deposit is the batch block number, used for the rejection deadline
+	batch.BlockNumber, err = parseUInt64FromByteSlice(responseData[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the block nonce", err)
+	}
+
 	transferIndex := 0
 	for i := 1; i < dataLen; i += numFieldsForTransaction {
-		// blockNonce is the i-th element, let's ignore it for now
 		depositNonce, errParse := parseUInt64FromByteSlice(responseData[i+1])
 		if errParse != nil {
 			return nil, fmt.Errorf("%w while parsing the deposit nonce, transfer index %d", errParse, transferIndex)
@@ -274,21 +351,15 @@ func (c *client) createPendingBatchFromResponse(ctx context.Context, responseDat
 			Amount:           amount,
 		}
 
-		storedConvertedTokenBytes, exists := cachedTokens[deposit.DisplayableToken]
-		if !exists {
-			deposit.DestinationTokenBytes, err = c.tokensMapper.ConvertToken(ctx, deposit.SourceTokenBytes)
-			if err != nil {
-				return nil, fmt.Errorf("%w while converting token bytes, transfer index %d", err, transferIndex)
-			}
-			cachedTokens[deposit.DisplayableToken] = deposit.DestinationTokenBytes
-		} else {
-			deposit.DestinationTokenBytes = storedConvertedTokenBytes
-		}
-
 		batch.Deposits = append(batch.Deposits, deposit)
 		transferIndex++
 	}
 
+	err = c.convertTokens(ctx, batch.Deposits)
+	if err != nil {
+		return nil, err
+	}
+
 	batch.Statuses = make([]byte, len(batch.Deposits))
 
 	c.log.Debug("created batch " + batch.String())
@@ -296,10 +367,73 @@ func (c *client) createPendingBatchFromResponse(ctx context.Context, responseDat
 	return batch, nil
 }
 
-func (c *client) createCommonTxDataBuilder(funcName string, id int64) builders.TxDataBuilder {
+// convertTokens will fetch the destination tokens for all the unique source tokens found in the provided deposits.
+// The conversions are done in parallel, bounded by the data getter's maximum number of parallel VM queries
+func (c *client) convertTokens(ctx context.Context, deposits []*bridgeCore.DepositTransfer) error {
+	uniqueTokens := make([][]byte, 0)
+	transferIndexes := make([]int, 0)
+	tokenIndexes := make(map[string]int)
+	for transferIndex, deposit := range deposits {
+		_, exists := tokenIndexes[deposit.DisplayableToken]
+		if exists {
+			continue
+		}
+
+		tokenIndexes[deposit.DisplayableToken] = len(uniqueTokens)
+		uniqueTokens = append(uniqueTokens, deposit.SourceTokenBytes)
+		transferIndexes = append(transferIndexes, transferIndex)
+	}
+
+	convertedTokens := make([][]byte, len(uniqueTokens))
+	err := c.executeInParallel(ctx, len(uniqueTokens), func(index int) error {
+		convertedToken, errConvert := c.tokensMapper.ConvertToken(ctx, uniqueTokens[index])
+		if errConvert != nil {
+			return fmt.Errorf("%w while converting token bytes, transfer index %d", errConvert, transferIndexes[index])
+		}
+
+		convertedTokens[index] = convertedToken
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range deposits {
+		deposit.DestinationTokenBytes = convertedTokens[tokenIndexes[deposit.DisplayableToken]]
+	}
+
+	return nil
+}
+
+func createCommonTxDataBuilder(funcName string, id int64) builders.TxDataBuilder {
 	return builders.NewTxDataBuilder().Function(funcName).ArgInt64(id)
 }
 
+// CreateProposeSetStatusTxDataBuilder returns the tx data builder used when proposing the statuses of the provided batch
+func CreateProposeSetStatusTxDataBuilder(batch *bridgeCore.TransferBatch) builders.TxDataBuilder {
+	txBuilder := createCommonTxDataBuilder(proposeSetStatusFuncName, int64(batch.ID))
+	for _, stat := range batch.Statuses {
+		txBuilder.ArgBytes([]byte{stat})
+	}
+
+	return txBuilder
+}
+
+// CreateProposeTransferTxDataBuilder returns the tx data builder used when proposing the transfer of the provided batch
+func CreateProposeTransferTxDataBuilder(batch *bridgeCore.TransferBatch) builders.TxDataBuilder {
+	txBuilder := createCommonTxDataBuilder(proposeTransferFuncName, int64(batch.ID))
+	for _, dt := range batch.Deposits {
+		txBuilder.ArgBytes(dt.FromBytes).
+			ArgBytes(dt.ToBytes).
+			ArgBytes(dt.DestinationTokenBytes).
+			ArgBigInt(dt.Amount).
+			ArgInt64(int64(dt.Nonce)).
+			ArgBytes(dt.Data)
+	}
+
+	return txBuilder
+}
+
 // ProposeSetStatus will trigger the proposal of the ESDT safe set current transaction batch status operation
 func (c *client) ProposeSetStatus(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
 	if batch == nil {
@@ -311,10 +445,7 @@ func (c *client) ProposeSetStatus(ctx context.Context, batch *bridgeCore.Transfe
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(proposeSetStatusFuncName, int64(batch.ID))
-	for _, stat := range batch.Statuses {
-		txBuilder.ArgBytes([]byte{stat})
-	}
+	txBuilder := CreateProposeSetStatusTxDataBuilder(batch)
 
 	gasLimit := c.gasMapConfig.ProposeStatusBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeStatusForEach
 	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, gasLimit)
@@ -336,16 +467,7 @@ func (c *client) ProposeTransfer(ctx context.Context, batch *bridgeCore.Transfer
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(proposeTransferFuncName, int64(batch.ID))
-
-	for _, dt := range batch.Deposits {
-		txBuilder.ArgBytes(dt.FromBytes).
-			ArgBytes(dt.ToBytes).
-			ArgBytes(dt.DestinationTokenBytes).
-			ArgBigInt(dt.Amount).
-			ArgInt64(int64(dt.Nonce)).
-			ArgBytes(dt.Data)
-	}
+	txBuilder := CreateProposeTransferTxDataBuilder(batch)
 
 	gasLimit := c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach
 	extraGasForScCalls := c.computeExtraGasForSCCallsBasic(batch, false)
@@ -365,7 +487,7 @@ func (c *client) Sign(ctx context.Context, actionID uint64) (string, error) {
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(signFuncName, int64(actionID))
+	txBuilder := createCommonTxDataBuilder(signFuncName, int64(actionID))
 
 	hash, err := c.txHandler.SendTransactionReturnHash(ctx, txBuilder, c.gasMapConfig.Sign)
 	if err == nil {
@@ -386,7 +508,7 @@ func (c *client) PerformAction(ctx context.Context, actionID uint64, batch *brid
 		return "", err
 	}
 
-	txBuilder := c.createCommonTxDataBuilder(performActionFuncName, int64(actionID))
+	txBuilder := createCommonTxDataBuilder(performActionFuncName, int64(actionID))
 
 	gasLimit := c.gasMapConfig.PerformActionBase + uint64(len(batch.Statuses))*c.gasMapConfig.PerformActionForEach
 	gasLimit += c.computeExtraGasForSCCallsBasic(batch, true)
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/config"
@@ -62,6 +63,10 @@ func createMockClientArgs() ClientArgs {
 		RoleProvider:                 &roleproviders.MultiversXRoleProviderStub{},
 		StatusHandler:                &testsCommon.StatusHandlerStub{},
 		ClientAvailabilityAllowDelta: 5,
+		SentTransactionsStorer:       testsCommon.NewStorerMock(),
+		LeftoverTransactionsTimeout:  time.Second,
+		GasUsageTracker:              &testsCommon.GasUsageTrackerStub{},
+		Timer:                        testsCommon.NewTimerStub(),
 	}
 }
 
@@ -217,12 +222,95 @@ func TestNewClient(t *testing.T) {
 		require.True(t, errors.Is(err, clients.ErrInvalidValue))
 		require.True(t, strings.Contains(err.Error(), "for args.ClientAvailabilityAllowDelta"))
 	})
+	t.Run("nil sent transactions storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.SentTransactionsStorer = nil
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, errNilStorer, err)
+	})
+	t.Run("nil gas usage tracker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.GasUsageTracker = nil
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, errNilGasUsageTracker, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Timer = nil
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, errNilTimer, err)
+	})
+	t.Run("invalid LeftoverTransactionsTimeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.LeftoverTransactionsTimeout = time.Millisecond
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.True(t, errors.Is(err, clients.ErrInvalidValue))
+		require.True(t, strings.Contains(err.Error(), "for args.LeftoverTransactionsTimeout"))
+	})
+	t.Run("invalid stuck transactions config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.StuckTransactions = config.MultiversXStuckTransactionsConfig{
+			Enabled: true,
+		}
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.True(t, errors.Is(err, errInvalidStuckTransactionsConfig))
+		require.True(t, strings.Contains(err.Error(), "for StuckTransactions.StuckAfterRounds"))
+	})
+	t.Run("gas payer with stuck transactions resender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.GasPayerPrivateKey, _ = testKeyGen.PrivateKeyFromByteArray(bytes.Repeat([]byte{2}, 32))
+		args.StuckTransactions = config.MultiversXStuckTransactionsConfig{
+			Enabled: true,
+		}
+
+		c, err := NewClient(args)
+
+		require.True(t, check.IfNil(c))
+		require.Equal(t, errGasPayerWithStuckTransactions, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockClientArgs()
 		c, err := NewClient(args)
 
+		require.False(t, check.IfNil(c))
+		require.Nil(t, err)
+	})
+	t.Run("should work with gas payer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.GasPayerPrivateKey, _ = testKeyGen.PrivateKeyFromByteArray(bytes.Repeat([]byte{2}, 32))
+		c, err := NewClient(args)
+
 		require.False(t, check.IfNil(c))
 		require.Nil(t, err)
 	})
@@ -296,6 +384,21 @@ func TestClient_GetPendingBatch(t *testing.T) {
 		assert.True(t, errors.Is(err, errNotUint64Bytes))
 		assert.True(t, strings.Contains(err.Error(), "while parsing batch ID"))
 	})
+	t.Run("invalid block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		buff := createMockPendingBatchBytes(2)
+		buff[1] = bytes.Repeat([]byte{1}, 32)
+		args.Proxy = createMockProxy(buff)
+
+		c, _ := NewClient(args)
+		batch, err := c.GetPendingBatch(context.Background())
+
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errNotUint64Bytes))
+		assert.True(t, strings.Contains(err.Error(), "while parsing the block nonce"))
+	})
 	t.Run("invalid deposit nonce", func(t *testing.T) {
 		t.Parallel()
 
@@ -383,6 +486,25 @@ func TestClient_GetPendingBatch(t *testing.T) {
 		assert.Equal(t, expectedBatch, batch)
 		assert.Nil(t, err)
 	})
+	t.Run("should set the block nonce of the first deposit as the batch block number", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.TokensMapper = &bridgeTests.TokensMapperStub{
+			ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+				return sourceBytes, nil
+			},
+		}
+		buff := createMockPendingBatchBytes(2)
+		buff[1] = big.NewInt(1234).Bytes()
+		buff[7] = big.NewInt(1240).Bytes()
+		args.Proxy = createMockProxy(buff)
+
+		c, _ := NewClient(args)
+		batch, err := c.GetPendingBatch(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1234), batch.BlockNumber)
+	})
 }
 
 func TestClient_GetBatch(t *testing.T) {
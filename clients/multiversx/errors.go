@@ -18,4 +18,16 @@ var (
 	errNilNodeStatusResponse    = errors.New("nil node status response")
 	errInvalidBalance           = errors.New("invalid balance")
 	errInsufficientESDTBalance  = errors.New("insufficient ESDT balance")
+	errInvalidMnemonic          = errors.New("invalid mnemonic")
+	errNilStorer                = errors.New("nil storer")
+	errLeftoverTxsNotExecuted   = errors.New("leftover transactions from the previous run were not executed in time")
+	errNilTransactionInfo       = errors.New("nil transaction info")
+	errNilGasUsageTracker       = errors.New("nil gas usage tracker")
+	errNilTimer                 = errors.New("nil timer")
+
+	errInvalidStuckTransactionsConfig = errors.New("invalid stuck transactions config")
+	errNilNetworkStatus               = errors.New("nil network status")
+	errGasPayerWithStuckTransactions  = errors.New("the gas payer can not be used together with the stuck transactions resender")
+	errNoProxies                      = errors.New("no proxies provided")
+	errInvalidFailoverConfig          = errors.New("invalid proxy failover config")
 )
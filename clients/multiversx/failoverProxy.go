@@ -0,0 +1,323 @@
+package multiversx
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsFailoverProxy is the DTO used to create a new failover proxy. The first proxy is the preferred one, the others
+// being used, in order, when the active proxy errors or falls behind the most synced proxy with more than
+// MaxNoncesBehind metachain nonces
+type ArgsFailoverProxy struct {
+	Proxies         []Proxy
+	Names           []string
+	Log             logger.Logger
+	Timer           bridgeCore.Timer
+	CheckInterval   time.Duration
+	MaxNoncesBehind uint64
+}
+
+// failoverProxy serves the requests from the active proxy and transparently switches to the next proxy when the
+// active one errors. A go routine periodically compares the metachain nonces of all proxies so a stale or out of sync
+// active proxy is replaced with the most synced one
+type failoverProxy struct {
+	proxies         []Proxy
+	names           []string
+	log             logger.Logger
+	timer           bridgeCore.Timer
+	checkInterval   time.Duration
+	maxNoncesBehind uint64
+	cancel          func()
+
+	mut         sync.RWMutex
+	activeIndex int
+}
+
+// NewFailoverProxy creates a new failover proxy instance and starts the go routine checking the proxies
+func NewFailoverProxy(args ArgsFailoverProxy) (*failoverProxy, error) {
+	err := checkArgsFailoverProxy(args)
+	if err != nil {
+		return nil, err
+	}
+
+	fp := &failoverProxy{
+		proxies:         args.Proxies,
+		names:           args.Names,
+		log:             args.Log,
+		timer:           args.Timer,
+		checkInterval:   args.CheckInterval,
+		maxNoncesBehind: args.MaxNoncesBehind,
+		cancel:          func() {},
+	}
+
+	if len(fp.proxies) > 1 {
+		ctx, cancel := context.WithCancel(context.Background())
+		fp.cancel = cancel
+		go fp.processLoop(ctx)
+	}
+
+	return fp, nil
+}
+
+func checkArgsFailoverProxy(args ArgsFailoverProxy) error {
+	if len(args.Proxies) == 0 {
+		return errNoProxies
+	}
+	if len(args.Names) != len(args.Proxies) {
+		return fmt.Errorf("%w: %d names for %d proxies", errInvalidNumberOfArguments, len(args.Names), len(args.Proxies))
+	}
+	for i, proxy := range args.Proxies {
+		if check.IfNil(proxy) {
+			return fmt.Errorf("%w at index %d", errNilProxy, i)
+		}
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
+	if len(args.Proxies) > 1 && args.CheckInterval == 0 {
+		return fmt.Errorf("%w for the check interval", errInvalidFailoverConfig)
+	}
+
+	return nil
+}
+
+func (fp *failoverProxy) processLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-fp.timer.After(fp.checkInterval):
+			fp.checkProxies(ctx)
+		}
+	}
+}
+
+// checkProxies switches to the most synced proxy if the active one is unreachable or behind it with more than the
+// allowed number of nonces. The metachain is used as all the proxies can serve its status
+func (fp *failoverProxy) checkProxies(ctx context.Context) {
+	nonces := make([]uint64, len(fp.proxies))
+	isReachable := make([]bool, len(fp.proxies))
+	bestIndex := -1
+	for i, proxy := range fp.proxies {
+		status, err := proxy.GetNetworkStatus(ctx, chainCore.MetachainShardId)
+		if err != nil || status == nil {
+			fp.log.Debug("failoverProxy: proxy is unreachable", "proxy", fp.names[i], "error", err)
+			continue
+		}
+
+		nonces[i] = status.Nonce
+		isReachable[i] = true
+		if bestIndex < 0 || status.Nonce > nonces[bestIndex] {
+			bestIndex = i
+		}
+	}
+	if bestIndex < 0 {
+		fp.log.Warn("failoverProxy: no proxy is reachable")
+		return
+	}
+
+	activeIndex := fp.getActiveIndex()
+	if isReachable[activeIndex] && nonces[bestIndex]-nonces[activeIndex] <= fp.maxNoncesBehind {
+		return
+	}
+
+	fp.switchTo(activeIndex, bestIndex, "the active proxy is unreachable or out of sync",
+		"active proxy nonce", nonces[activeIndex], "best nonce", nonces[bestIndex])
+}
+
+func (fp *failoverProxy) getActiveIndex() int {
+	fp.mut.RLock()
+	defer fp.mut.RUnlock()
+
+	return fp.activeIndex
+}
+
+func (fp *failoverProxy) switchTo(fromIndex int, toIndex int, reason string, args ...interface{}) {
+	fp.mut.Lock()
+	if fp.activeIndex != fromIndex {
+		// another request already switched the active proxy
+		fp.mut.Unlock()
+		return
+	}
+	fp.activeIndex = toIndex
+	fp.mut.Unlock()
+
+	logArgs := append([]interface{}{"from", fp.names[fromIndex], "to", fp.names[toIndex]}, args...)
+	fp.log.Warn("failoverProxy: switching the MultiversX proxy, "+reason, logArgs...)
+}
+
+// executeWithFailover calls the handler on the active proxy and, if it errors, on the next proxies. The first proxy
+// serving the request becomes the active one. The error of the last tried proxy is returned if all of them error
+func (fp *failoverProxy) executeWithFailover(ctx context.Context, operation string, handler func(proxy Proxy) error) error {
+	activeIndex := fp.getActiveIndex()
+	var err error
+	for i := 0; i < len(fp.proxies); i++ {
+		index := (activeIndex + i) % len(fp.proxies)
+		err = handler(fp.proxies[index])
+		if err == nil {
+			if index != activeIndex {
+				fp.switchTo(activeIndex, index, "the active proxy errored", "operation", operation)
+			}
+			return nil
+		}
+		if ctx.Err() != nil {
+			return err
+		}
+
+		fp.log.Debug("failoverProxy: request failed", "proxy", fp.names[index], "operation", operation, "error", err)
+	}
+
+	return err
+}
+
+// GetNetworkConfig returns the network config from the active proxy
+func (fp *failoverProxy) GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	var result *data.NetworkConfig
+	err := fp.executeWithFailover(ctx, "GetNetworkConfig", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.GetNetworkConfig(ctx)
+		return errCall
+	})
+
+	return result, err
+}
+
+// SendTransaction sends the transaction through the active proxy. The same signed transaction is sent to the next proxy
+// on error, which is safe as a transaction can only be executed once
+func (fp *failoverProxy) SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+	var result string
+	err := fp.executeWithFailover(ctx, "SendTransaction", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.SendTransaction(ctx, tx)
+		return errCall
+	})
+
+	return result, err
+}
+
+// SendTransactions sends the transactions through the active proxy
+func (fp *failoverProxy) SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+	var result []string
+	err := fp.executeWithFailover(ctx, "SendTransactions", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.SendTransactions(ctx, txs)
+		return errCall
+	})
+
+	return result, err
+}
+
+// ExecuteVMQuery executes the VM query on the active proxy
+func (fp *failoverProxy) ExecuteVMQuery(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+	var result *data.VmValuesResponseData
+	err := fp.executeWithFailover(ctx, "ExecuteVMQuery", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.ExecuteVMQuery(ctx, vmRequest)
+		return errCall
+	})
+
+	return result, err
+}
+
+// GetAccount returns the account from the active proxy
+func (fp *failoverProxy) GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+	var result *data.Account
+	err := fp.executeWithFailover(ctx, "GetAccount", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.GetAccount(ctx, address)
+		return errCall
+	})
+
+	return result, err
+}
+
+// GetNetworkStatus returns the network status of the provided shard from the active proxy
+func (fp *failoverProxy) GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+	var result *data.NetworkStatus
+	err := fp.executeWithFailover(ctx, "GetNetworkStatus", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.GetNetworkStatus(ctx, shardID)
+		return errCall
+	})
+
+	return result, err
+}
+
+// GetShardOfAddress returns the shard of the provided address from the active proxy
+func (fp *failoverProxy) GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error) {
+	var result uint32
+	err := fp.executeWithFailover(ctx, "GetShardOfAddress", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.GetShardOfAddress(ctx, bech32Address)
+		return errCall
+	})
+
+	return result, err
+}
+
+// GetESDTTokenData returns the ESDT token data of the provided address from the active proxy
+func (fp *failoverProxy) GetESDTTokenData(
+	ctx context.Context,
+	address core.AddressHandler,
+	tokenIdentifier string,
+	queryOptions api.AccountQueryOptions,
+) (*data.ESDTFungibleTokenData, error) {
+	var result *data.ESDTFungibleTokenData
+	err := fp.executeWithFailover(ctx, "GetESDTTokenData", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.GetESDTTokenData(ctx, address, tokenIdentifier, queryOptions)
+		return errCall
+	})
+
+	return result, err
+}
+
+// GetTransactionInfoWithResults returns the transaction info from the active proxy
+func (fp *failoverProxy) GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+	var result *data.TransactionInfo
+	err := fp.executeWithFailover(ctx, "GetTransactionInfoWithResults", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.GetTransactionInfoWithResults(ctx, hash)
+		return errCall
+	})
+
+	return result, err
+}
+
+// ProcessTransactionStatus returns the processed transaction status from the active proxy
+func (fp *failoverProxy) ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+	var result transaction.TxStatus
+	err := fp.executeWithFailover(ctx, "ProcessTransactionStatus", func(proxy Proxy) error {
+		var errCall error
+		result, errCall = proxy.ProcessTransactionStatus(ctx, hexTxHash)
+		return errCall
+	})
+
+	return result, err
+}
+
+// Close stops the go routine checking the proxies
+func (fp *failoverProxy) Close() error {
+	fp.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (fp *failoverProxy) IsInterfaceNil() bool {
+	return fp == nil
+}
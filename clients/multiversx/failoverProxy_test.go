@@ -0,0 +1,275 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFailoverProxy(proxies ...Proxy) ArgsFailoverProxy {
+	timer := testsCommon.NewTimerStub()
+	timer.AfterCalled = func(duration time.Duration) <-chan time.Time {
+		return make(chan time.Time)
+	}
+
+	names := make([]string, 0, len(proxies))
+	for i := range proxies {
+		names = append(names, string(rune('a'+i)))
+	}
+
+	return ArgsFailoverProxy{
+		Proxies:         proxies,
+		Names:           names,
+		Log:             logger.GetOrCreate("test"),
+		Timer:           timer,
+		CheckInterval:   time.Second,
+		MaxNoncesBehind: 5,
+	}
+}
+
+func createNetworkStatusProxy(nonce uint64, err error) *interactors.ProxyStub {
+	return &interactors.ProxyStub{
+		GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			if err != nil {
+				return nil, err
+			}
+
+			return &data.NetworkStatus{Nonce: nonce}, nil
+		},
+	}
+}
+
+func TestNewFailoverProxy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no proxies should error", func(t *testing.T) {
+		t.Parallel()
+
+		fp, err := NewFailoverProxy(createMockArgsFailoverProxy())
+		assert.Nil(t, fp)
+		assert.Equal(t, errNoProxies, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		fp, err := NewFailoverProxy(createMockArgsFailoverProxy(&interactors.ProxyStub{}, nil))
+		assert.Nil(t, fp)
+		assert.ErrorIs(t, err, errNilProxy)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("names mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverProxy(&interactors.ProxyStub{})
+		args.Names = nil
+		fp, err := NewFailoverProxy(args)
+		assert.Nil(t, fp)
+		assert.ErrorIs(t, err, errInvalidNumberOfArguments)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverProxy(&interactors.ProxyStub{})
+		args.Log = nil
+		fp, err := NewFailoverProxy(args)
+		assert.Nil(t, fp)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverProxy(&interactors.ProxyStub{})
+		args.Timer = nil
+		fp, err := NewFailoverProxy(args)
+		assert.Nil(t, fp)
+		assert.Equal(t, errNilTimer, err)
+	})
+	t.Run("zero check interval with more proxies should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFailoverProxy(&interactors.ProxyStub{}, &interactors.ProxyStub{})
+		args.CheckInterval = 0
+		fp, err := NewFailoverProxy(args)
+		assert.Nil(t, fp)
+		assert.ErrorIs(t, err, errInvalidFailoverConfig)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fp, err := NewFailoverProxy(createMockArgsFailoverProxy(&interactors.ProxyStub{}, &interactors.ProxyStub{}))
+		require.Nil(t, err)
+		assert.False(t, fp.IsInterfaceNil())
+		assert.Nil(t, fp.Close())
+	})
+}
+
+func TestFailoverProxy_ExecuteWithFailover(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("active proxy working should not switch", func(t *testing.T) {
+		t.Parallel()
+
+		first := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 1, nil
+			},
+		}
+		second := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				assert.Fail(t, "should have not called the second proxy")
+				return 0, nil
+			},
+		}
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(first, second))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		shardID, err := fp.GetShardOfAddress(context.Background(), "erd1")
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(1), shardID)
+		assert.Equal(t, 0, fp.getActiveIndex())
+	})
+	t.Run("active proxy erroring should switch to the next one", func(t *testing.T) {
+		t.Parallel()
+
+		numFirstCalls := 0
+		first := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				numFirstCalls++
+				return 0, expectedErr
+			},
+		}
+		second := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 2, nil
+			},
+		}
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(first, second))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		shardID, err := fp.GetShardOfAddress(context.Background(), "erd1")
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(2), shardID)
+		assert.Equal(t, 1, fp.getActiveIndex())
+
+		// the next request is served directly by the new active proxy
+		_, _ = fp.GetShardOfAddress(context.Background(), "erd1")
+		assert.Equal(t, 1, numFirstCalls)
+	})
+	t.Run("all proxies erroring should return the last error and keep the active proxy", func(t *testing.T) {
+		t.Parallel()
+
+		lastErr := errors.New("last error")
+		first := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 0, expectedErr
+			},
+		}
+		second := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 0, lastErr
+			},
+		}
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(first, second))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		_, err := fp.GetShardOfAddress(context.Background(), "erd1")
+		assert.Equal(t, lastErr, err)
+		assert.Equal(t, 0, fp.getActiveIndex())
+	})
+	t.Run("context done should not try the next proxies", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		first := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				cancel()
+				return 0, expectedErr
+			},
+		}
+		second := &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				assert.Fail(t, "should have not called the second proxy")
+				return 0, nil
+			},
+		}
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(first, second))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		_, err := fp.GetShardOfAddress(ctx, "erd1")
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 0, fp.getActiveIndex())
+	})
+}
+
+func TestFailoverProxy_CheckProxies(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+
+	t.Run("active proxy in sync should not switch", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(createNetworkStatusProxy(100, nil), createNetworkStatusProxy(105, nil)))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		fp.checkProxies(context.Background())
+		assert.Equal(t, 0, fp.getActiveIndex())
+	})
+	t.Run("active proxy out of sync should switch to the most synced one", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(
+			createNetworkStatusProxy(100, nil),
+			createNetworkStatusProxy(104, nil),
+			createNetworkStatusProxy(110, nil),
+		))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		fp.checkProxies(context.Background())
+		assert.Equal(t, 2, fp.getActiveIndex())
+	})
+	t.Run("unreachable active proxy should switch", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(createNetworkStatusProxy(0, expectedErr), createNetworkStatusProxy(100, nil)))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		fp.checkProxies(context.Background())
+		assert.Equal(t, 1, fp.getActiveIndex())
+	})
+	t.Run("no reachable proxy should not switch", func(t *testing.T) {
+		t.Parallel()
+
+		fp, _ := NewFailoverProxy(createMockArgsFailoverProxy(createNetworkStatusProxy(0, expectedErr), createNetworkStatusProxy(0, expectedErr)))
+		defer func() {
+			_ = fp.Close()
+		}()
+
+		fp.checkProxies(context.Background())
+		assert.Equal(t, 0, fp.getActiveIndex())
+	})
+}
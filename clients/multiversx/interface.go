@@ -32,12 +32,19 @@ type NonceTransactionsHandler interface {
 	Close() error
 }
 
-// TokensMapper can convert a token bytes from one chain to another
+// TokensMapper can convert a token bytes from one chain to another. ConvertToken is called concurrently for the
+// tokens of a batch so the implementations must be safe for concurrent use
 type TokensMapper interface {
 	ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error)
 	IsInterfaceNil() bool
 }
 
+// GasUsageTracker defines the component recording the gas used by the sent transactions
+type GasUsageTracker interface {
+	Track(hash string, function string)
+	IsInterfaceNil() bool
+}
+
 type txHandler interface {
 	SendTransactionReturnHash(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error)
 	Close() error
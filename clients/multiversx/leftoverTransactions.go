@@ -0,0 +1,84 @@
+package multiversx
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const defaultLeftoverTxsPollingInterval = time.Second * 2
+
+// WaitForLeftoverTransactions checks the transactions sent by the relayer before its last restart. If some of them are
+// still not executed, it waits until the relayer's account nonce goes past all of them so the state machines will not
+// duplicate the actions and the new transactions will not clash on nonces. Should be called before the state machines
+// are started
+func (c *client) WaitForLeftoverTransactions(ctx context.Context) error {
+	sentTxs := c.sentTxsJournal.getAll()
+	if len(sentTxs) == 0 {
+		return nil
+	}
+
+	maxNonce := uint64(0)
+	for _, sentTx := range sentTxs {
+		if sentTx.Nonce > maxNonce {
+			maxNonce = sentTx.Nonce
+		}
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, c.leftoverTxsTimeout)
+	defer cancel()
+
+	initialNonce, err := c.getRelayerAccountNonce(ctxTimeout)
+	if err != nil {
+		return err
+	}
+
+	accountNonce := initialNonce
+	for accountNonce <= maxNonce {
+		c.log.Info("waiting for leftover transactions from the previous run",
+			"account nonce", accountNonce, "last sent nonce", maxNonce)
+
+		select {
+		case <-ctxTimeout.Done():
+			return fmt.Errorf("%w, account nonce %d, last sent nonce %d",
+				errLeftoverTxsNotExecuted, accountNonce, maxNonce)
+		case <-time.After(c.leftoverTxsPollingInterval):
+		}
+
+		accountNonce, err = c.getRelayerAccountNonce(ctxTimeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	c.logLeftoverTransactions(ctxTimeout, sentTxs, initialNonce)
+
+	return c.sentTxsJournal.clear()
+}
+
+func (c *client) getRelayerAccountNonce(ctx context.Context) (uint64, error) {
+	account, err := c.proxy.GetAccount(ctx, c.relayerAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	return account.Nonce, nil
+}
+
+func (c *client) logLeftoverTransactions(ctx context.Context, sentTxs []*sentTransaction, initialNonce uint64) {
+	for _, sentTx := range sentTxs {
+		if sentTx.Nonce < initialNonce {
+			continue
+		}
+
+		status, err := c.proxy.ProcessTransactionStatus(ctx, sentTx.Hash)
+		if err != nil {
+			c.log.Warn("leftover transaction executed, can not fetch its status",
+				"hash", sentTx.Hash, "nonce", sentTx.Nonce, "function", sentTx.Function, "error", err)
+			continue
+		}
+
+		c.log.Info("leftover transaction executed",
+			"hash", sentTx.Hash, "nonce", sentTx.Nonce, "function", sentTx.Function, "status", status)
+	}
+}
@@ -0,0 +1,158 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createClientWithSentTransactions(t *testing.T, proxy *interactors.ProxyStub, sentTxs ...*sentTransaction) *client {
+	storer := testsCommon.NewStorerMock()
+	journal := newSentTransactionsJournal(storer, &testsCommon.LoggerStub{})
+	for _, sentTx := range sentTxs {
+		err := journal.record(sentTx.Hash, sentTx.Nonce, []byte(sentTx.Function+"@01"))
+		require.Nil(t, err)
+	}
+
+	args := createMockClientArgs()
+	args.Proxy = proxy
+	args.SentTransactionsStorer = storer
+	c, err := NewClient(args)
+	require.Nil(t, err)
+	c.leftoverTxsPollingInterval = time.Millisecond * 10
+
+	return c
+}
+
+func TestClient_WaitForLeftoverTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no sent transactions should not query the proxy", func(t *testing.T) {
+		t.Parallel()
+
+		proxy := &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				assert.Fail(t, "should have not called GetAccount")
+				return nil, nil
+			},
+		}
+		c := createClientWithSentTransactions(t, proxy)
+
+		err := c.WaitForLeftoverTransactions(context.Background())
+		assert.Nil(t, err)
+	})
+	t.Run("get account errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		proxy := &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+		c := createClientWithSentTransactions(t, proxy, &sentTransaction{Hash: "hash", Nonce: 10, Function: signFuncName})
+
+		err := c.WaitForLeftoverTransactions(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, len(c.sentTxsJournal.getAll()))
+	})
+	t.Run("all sent transactions already executed should clear the journal", func(t *testing.T) {
+		t.Parallel()
+
+		proxy := &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: 12}, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				assert.Fail(t, "should have not fetched the status of already executed transactions")
+				return "", nil
+			},
+		}
+		c := createClientWithSentTransactions(t, proxy,
+			&sentTransaction{Hash: "hash1", Nonce: 10, Function: signFuncName},
+			&sentTransaction{Hash: "hash2", Nonce: 11, Function: performActionFuncName},
+		)
+
+		err := c.WaitForLeftoverTransactions(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, c.sentTxsJournal.getAll())
+
+		journal := newSentTransactionsJournal(c.sentTxsJournal.storer, &testsCommon.LoggerStub{})
+		assert.Empty(t, journal.getAll())
+	})
+	t.Run("should wait for the pending transactions", func(t *testing.T) {
+		t.Parallel()
+
+		numGetAccountCalls := uint64(0)
+		statusRequested := make([]string, 0)
+		proxy := &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				numCalls := atomic.AddUint64(&numGetAccountCalls, 1)
+				return &data.Account{Nonce: 9 + numCalls}, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				statusRequested = append(statusRequested, hexTxHash)
+				return transaction.TxStatusSuccess, nil
+			},
+		}
+		c := createClientWithSentTransactions(t, proxy,
+			&sentTransaction{Hash: "hash1", Nonce: 8, Function: signFuncName},
+			&sentTransaction{Hash: "hash2", Nonce: 10, Function: signFuncName},
+			&sentTransaction{Hash: "hash3", Nonce: 11, Function: performActionFuncName},
+		)
+
+		err := c.WaitForLeftoverTransactions(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(3), atomic.LoadUint64(&numGetAccountCalls))
+		assert.Equal(t, []string{"hash2", "hash3"}, statusRequested)
+		assert.Empty(t, c.sentTxsJournal.getAll())
+	})
+	t.Run("pending transactions not executed in time should error", func(t *testing.T) {
+		t.Parallel()
+
+		proxy := &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: 10}, nil
+			},
+		}
+		c := createClientWithSentTransactions(t, proxy, &sentTransaction{Hash: "hash", Nonce: 10, Function: signFuncName})
+		c.leftoverTxsTimeout = time.Millisecond * 50
+
+		err := c.WaitForLeftoverTransactions(context.Background())
+		assert.True(t, errors.Is(err, errLeftoverTxsNotExecuted))
+		assert.Equal(t, 1, len(c.sentTxsJournal.getAll()))
+	})
+}
+
+func TestSentTransactionsJournal_Record(t *testing.T) {
+	t.Parallel()
+
+	storer := testsCommon.NewStorerMock()
+	journal := newSentTransactionsJournal(storer, &testsCommon.LoggerStub{})
+	for i := 0; i < maxSentTransactionsInJournal+5; i++ {
+		err := journal.record("hash", uint64(i), []byte(signFuncName))
+		require.Nil(t, err)
+	}
+
+	sentTxs := journal.getAll()
+	assert.Equal(t, maxSentTransactionsInJournal, len(sentTxs))
+	assert.Equal(t, uint64(5), sentTxs[0].Nonce)
+	assert.Equal(t, signFuncName, sentTxs[0].Function)
+
+	reloaded := newSentTransactionsJournal(storer, &testsCommon.LoggerStub{})
+	assert.Equal(t, sentTxs, reloaded.getAll())
+
+	_ = storer.Put([]byte(sentTransactionsJournalKey), []byte("garbage"))
+	reloaded = newSentTransactionsJournal(storer, &testsCommon.LoggerStub{})
+	assert.Empty(t, reloaded.getAll())
+}
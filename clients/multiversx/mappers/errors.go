@@ -3,3 +3,23 @@ package mappers
 import "errors"
 
 var errUnknownToken = errors.New("unknown token")
+
+var errEmptyMapperType = errors.New("empty tokens mapper type")
+
+var errNilTokensMapperFactory = errors.New("nil tokens mapper factory")
+
+var errMapperTypeAlreadyRegistered = errors.New("tokens mapper type already registered")
+
+var errUnknownMapperType = errors.New("unknown tokens mapper type")
+
+var errUnknownDirection = errors.New("unknown direction")
+
+var errNilBlockNumberGetter = errors.New("nil block number getter")
+
+var errEmptyMigrationAddress = errors.New("empty token migration address")
+
+var errSameMigrationAddresses = errors.New("token migration old and new addresses are the same")
+
+var errInvalidMigrationBoundaries = errors.New("invalid token migration boundaries")
+
+var errDuplicatedMigration = errors.New("duplicated token migration")
@@ -8,3 +8,16 @@ type DataGetter interface {
 	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
 	IsInterfaceNil() bool
 }
+
+// TokensMapper can convert a token bytes from one chain to another. ConvertToken is called concurrently for the
+// tokens of a batch so the implementations must be safe for concurrent use
+type TokensMapper interface {
+	ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// BlockNumberGetter is able to return the current Ethereum block number
+type BlockNumberGetter interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,186 @@
+package mappers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// The phases of a token migration, relative to the current Ethereum block
+const (
+	MigrationPending    = "pending"
+	MigrationSwitchover = "switchover"
+	MigrationCompleted  = "completed"
+)
+
+// TokenMigration describes the move of a token to a new ERC20 contract. Starting with the EffectiveBlock, the transfers
+// towards Ethereum use the new address and the deposits still made on the old address are bridged as if they were made
+// on the new one. Starting with the ExpiryBlock the old address is no longer handled. A 0 ExpiryBlock never expires
+type TokenMigration struct {
+	OldERC20Address []byte
+	NewERC20Address []byte
+	EffectiveBlock  uint64
+	ExpiryBlock     uint64
+}
+
+// Phase returns the migration phase at the provided Ethereum block
+func (migration *TokenMigration) Phase(blockNumber uint64) string {
+	if blockNumber < migration.EffectiveBlock {
+		return MigrationPending
+	}
+	if migration.ExpiryBlock > 0 && blockNumber >= migration.ExpiryBlock {
+		return MigrationCompleted
+	}
+
+	return MigrationSwitchover
+}
+
+// ArgsMigrationTokensMapper is the DTO used to create a new migration tokens mapper
+type ArgsMigrationTokensMapper struct {
+	Mapper            TokensMapper
+	Direction         batchProcessor.Direction
+	BlockNumberGetter BlockNumberGetter
+	Migrations        []TokenMigration
+}
+
+type migrationTokensMapper struct {
+	mapper            TokensMapper
+	direction         batchProcessor.Direction
+	blockNumberGetter BlockNumberGetter
+	migrations        []TokenMigration
+}
+
+// NewMigrationTokensMapper creates a tokens mapper that applies the provided ERC20 migrations on top of the results
+// of the wrapped mapper
+func NewMigrationTokensMapper(args ArgsMigrationTokensMapper) (*migrationTokensMapper, error) {
+	err := checkArgsMigrationTokensMapper(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrationTokensMapper{
+		mapper:            args.Mapper,
+		direction:         args.Direction,
+		blockNumberGetter: args.BlockNumberGetter,
+		migrations:        args.Migrations,
+	}, nil
+}
+
+func checkArgsMigrationTokensMapper(args ArgsMigrationTokensMapper) error {
+	if check.IfNil(args.Mapper) {
+		return clients.ErrNilTokensMapper
+	}
+	if check.IfNil(args.BlockNumberGetter) {
+		return errNilBlockNumberGetter
+	}
+	if args.Direction != batchProcessor.ToMultiversX && args.Direction != batchProcessor.FromMultiversX {
+		return fmt.Errorf("%w: %s", errUnknownDirection, args.Direction)
+	}
+
+	return CheckTokenMigrations(args.Migrations)
+}
+
+// CheckTokenMigrations verifies that the provided migrations are consistent
+func CheckTokenMigrations(migrations []TokenMigration) error {
+	oldAddresses := make(map[string]struct{})
+	for i, migration := range migrations {
+		if len(migration.OldERC20Address) == 0 || len(migration.NewERC20Address) == 0 {
+			return fmt.Errorf("%w for migration at index %d", errEmptyMigrationAddress, i)
+		}
+		if bytes.Equal(migration.OldERC20Address, migration.NewERC20Address) {
+			return fmt.Errorf("%w for migration at index %d", errSameMigrationAddresses, i)
+		}
+		if migration.ExpiryBlock > 0 && migration.ExpiryBlock <= migration.EffectiveBlock {
+			return fmt.Errorf("%w for migration at index %d, effective block %d, expiry block %d",
+				errInvalidMigrationBoundaries, i, migration.EffectiveBlock, migration.ExpiryBlock)
+		}
+
+		key := string(migration.OldERC20Address)
+		_, exists := oldAddresses[key]
+		if exists {
+			return fmt.Errorf("%w for %s", errDuplicatedMigration, hex.EncodeToString(migration.OldERC20Address))
+		}
+		oldAddresses[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// ConvertToken converts the token using the wrapped mapper. During the switchover of a migration, the old ERC20
+// address is converted as the new one for the deposits from Ethereum and replaced with the new one for the transfers
+// towards Ethereum
+func (mapper *migrationTokensMapper) ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+	if mapper.direction == batchProcessor.ToMultiversX {
+		return mapper.convertErc20Token(ctx, sourceBytes)
+	}
+
+	return mapper.convertToErc20Token(ctx, sourceBytes)
+}
+
+func (mapper *migrationTokensMapper) convertErc20Token(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+	migration := mapper.findMigration(sourceBytes)
+	if migration == nil {
+		return mapper.mapper.ConvertToken(ctx, sourceBytes)
+	}
+
+	inSwitchover, err := mapper.isInSwitchover(ctx, migration)
+	if err != nil {
+		return nil, err
+	}
+	if !inSwitchover {
+		return mapper.mapper.ConvertToken(ctx, sourceBytes)
+	}
+
+	return mapper.mapper.ConvertToken(ctx, migration.NewERC20Address)
+}
+
+func (mapper *migrationTokensMapper) convertToErc20Token(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+	erc20Address, err := mapper.mapper.ConvertToken(ctx, sourceBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	migration := mapper.findMigration(erc20Address)
+	if migration == nil {
+		return erc20Address, nil
+	}
+
+	blockNumber, err := mapper.blockNumberGetter.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if migration.Phase(blockNumber) == MigrationPending {
+		return erc20Address, nil
+	}
+
+	return migration.NewERC20Address, nil
+}
+
+func (mapper *migrationTokensMapper) isInSwitchover(ctx context.Context, migration *TokenMigration) (bool, error) {
+	blockNumber, err := mapper.blockNumberGetter.BlockNumber(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return migration.Phase(blockNumber) == MigrationSwitchover, nil
+}
+
+func (mapper *migrationTokensMapper) findMigration(erc20Address []byte) *TokenMigration {
+	for i := range mapper.migrations {
+		if bytes.Equal(mapper.migrations[i].OldERC20Address, erc20Address) {
+			return &mapper.migrations[i]
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mapper *migrationTokensMapper) IsInterfaceNil() bool {
+	return mapper == nil
+}
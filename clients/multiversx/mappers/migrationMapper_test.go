@@ -0,0 +1,192 @@
+package mappers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	oldErc20Address = []byte("old erc20 address")
+	newErc20Address = []byte("new erc20 address")
+)
+
+func createMockArgsMigrationTokensMapper(currentBlock uint64) ArgsMigrationTokensMapper {
+	return ArgsMigrationTokensMapper{
+		Mapper: &bridgeTests.TokensMapperStub{
+			ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+				return append([]byte("converted "), sourceBytes...), nil
+			},
+		},
+		Direction: batchProcessor.ToMultiversX,
+		BlockNumberGetter: &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return currentBlock, nil
+			},
+		},
+		Migrations: []TokenMigration{
+			{
+				OldERC20Address: oldErc20Address,
+				NewERC20Address: newErc20Address,
+				EffectiveBlock:  100,
+				ExpiryBlock:     200,
+			},
+		},
+	}
+}
+
+func TestNewMigrationTokensMapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil mapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.Mapper = nil
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.Equal(t, clients.ErrNilTokensMapper, err)
+	})
+	t.Run("nil block number getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.BlockNumberGetter = nil
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.Equal(t, errNilBlockNumberGetter, err)
+	})
+	t.Run("unknown direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.Direction = "unknown"
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, errUnknownDirection))
+	})
+	t.Run("empty address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.Migrations[0].NewERC20Address = nil
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, errEmptyMigrationAddress))
+	})
+	t.Run("same addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.Migrations[0].NewERC20Address = oldErc20Address
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, errSameMigrationAddresses))
+	})
+	t.Run("expiry before the effective block should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.Migrations[0].ExpiryBlock = 100
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, errInvalidMigrationBoundaries))
+	})
+	t.Run("duplicated migration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationTokensMapper(0)
+		args.Migrations = append(args.Migrations, TokenMigration{
+			OldERC20Address: oldErc20Address,
+			NewERC20Address: []byte("another address"),
+		})
+		mapper, err := NewMigrationTokensMapper(args)
+		assert.True(t, check.IfNil(mapper))
+		assert.True(t, errors.Is(err, errDuplicatedMigration))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		mapper, err := NewMigrationTokensMapper(createMockArgsMigrationTokensMapper(0))
+		assert.False(t, check.IfNil(mapper))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokenMigration_Phase(t *testing.T) {
+	t.Parallel()
+
+	migration := TokenMigration{
+		EffectiveBlock: 100,
+		ExpiryBlock:    200,
+	}
+	assert.Equal(t, MigrationPending, migration.Phase(99))
+	assert.Equal(t, MigrationSwitchover, migration.Phase(100))
+	assert.Equal(t, MigrationSwitchover, migration.Phase(199))
+	assert.Equal(t, MigrationCompleted, migration.Phase(200))
+
+	migration.ExpiryBlock = 0
+	assert.Equal(t, MigrationSwitchover, migration.Phase(1000000))
+}
+
+func TestMigrationTokensMapper_ConvertToken(t *testing.T) {
+	t.Parallel()
+
+	t.Run("to MultiversX", func(t *testing.T) {
+		t.Parallel()
+
+		testConvert := func(currentBlock uint64, source []byte, expected []byte) {
+			mapper, _ := NewMigrationTokensMapper(createMockArgsMigrationTokensMapper(currentBlock))
+			converted, err := mapper.ConvertToken(context.Background(), source)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, converted)
+		}
+
+		testConvert(150, []byte("other"), []byte("converted other"))
+		testConvert(99, oldErc20Address, []byte("converted old erc20 address"))
+		testConvert(150, oldErc20Address, []byte("converted new erc20 address"))
+		testConvert(200, oldErc20Address, []byte("converted old erc20 address"))
+	})
+	t.Run("from MultiversX", func(t *testing.T) {
+		t.Parallel()
+
+		testConvert := func(currentBlock uint64, expected []byte) {
+			args := createMockArgsMigrationTokensMapper(currentBlock)
+			args.Direction = batchProcessor.FromMultiversX
+			args.Mapper = &bridgeTests.TokensMapperStub{
+				ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+					return oldErc20Address, nil
+				},
+			}
+			mapper, _ := NewMigrationTokensMapper(args)
+			converted, err := mapper.ConvertToken(context.Background(), []byte("TKN-123456"))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, converted)
+		}
+
+		testConvert(99, oldErc20Address)
+		testConvert(150, newErc20Address)
+		testConvert(200, newErc20Address)
+	})
+	t.Run("block number errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsMigrationTokensMapper(0)
+		args.BlockNumberGetter = &bridgeTests.EthereumClientWrapperStub{
+			BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		mapper, _ := NewMigrationTokensMapper(args)
+		converted, err := mapper.ConvertToken(context.Background(), oldErc20Address)
+		assert.Nil(t, converted)
+		assert.Equal(t, expectedErr, err)
+	})
+}
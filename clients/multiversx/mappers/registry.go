@@ -0,0 +1,107 @@
+package mappers
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// OnChainMapperType is the type of the default tokens mapper, reading the mapping from the MultiversX safe contract
+const OnChainMapperType = "on-chain"
+
+// ArgsTokensMapperFactory is the DTO provided to a tokens mapper factory
+type ArgsTokensMapperFactory struct {
+	Direction  batchProcessor.Direction
+	DataGetter DataGetter
+	Parameters map[string]string
+}
+
+// TokensMapperFactory is able to create a tokens mapper for the provided direction
+type TokensMapperFactory func(args ArgsTokensMapperFactory) (TokensMapper, error)
+
+var (
+	mutRegistry sync.RWMutex
+	registry    = map[string]TokensMapperFactory{
+		OnChainMapperType: createOnChainTokensMapper,
+	}
+)
+
+// RegisterTokensMapperFactory registers a new tokens mapper type. Integrators should call it, usually from an init
+// function, before the bridge components are created. An already registered type can not be replaced
+func RegisterTokensMapperFactory(mapperType string, factory TokensMapperFactory) error {
+	if len(mapperType) == 0 {
+		return errEmptyMapperType
+	}
+	if factory == nil {
+		return errNilTokensMapperFactory
+	}
+
+	mutRegistry.Lock()
+	defer mutRegistry.Unlock()
+
+	_, exists := registry[mapperType]
+	if exists {
+		return fmt.Errorf("%w: %s", errMapperTypeAlreadyRegistered, mapperType)
+	}
+	registry[mapperType] = factory
+
+	return nil
+}
+
+// RegisteredTokensMapperTypes returns the sorted list of the registered tokens mapper types
+func RegisteredTokensMapperTypes() []string {
+	mutRegistry.RLock()
+	defer mutRegistry.RUnlock()
+
+	types := make([]string, 0, len(registry))
+	for mapperType := range registry {
+		types = append(types, mapperType)
+	}
+	sort.Strings(types)
+
+	return types
+}
+
+// CreateTokensMapper creates the tokens mapper of the provided type. An empty type selects the on-chain mapper.
+// The mappers created by the registered factories are not required to be safe for concurrent use, their calls are
+// serialized
+func CreateTokensMapper(mapperType string, args ArgsTokensMapperFactory) (TokensMapper, error) {
+	if len(mapperType) == 0 {
+		mapperType = OnChainMapperType
+	}
+
+	mutRegistry.RLock()
+	factory, exists := registry[mapperType]
+	mutRegistry.RUnlock()
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", errUnknownMapperType, mapperType)
+	}
+
+	mapper, err := factory(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the %s tokens mapper", err, mapperType)
+	}
+	if check.IfNil(mapper) {
+		return nil, fmt.Errorf("%w: factory for %s returned a nil mapper", clients.ErrNilTokensMapper, mapperType)
+	}
+	if mapperType == OnChainMapperType {
+		return mapper, nil
+	}
+
+	return newSerializedTokensMapper(mapper), nil
+}
+
+func createOnChainTokensMapper(args ArgsTokensMapperFactory) (TokensMapper, error) {
+	switch args.Direction {
+	case batchProcessor.ToMultiversX:
+		return NewErc20ToMultiversXMapper(args.DataGetter)
+	case batchProcessor.FromMultiversX:
+		return NewMultiversXToErc20Mapper(args.DataGetter)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownDirection, args.Direction)
+	}
+}
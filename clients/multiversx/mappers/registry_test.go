@@ -0,0 +1,138 @@
+package mappers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterTokensMapperFactory(t *testing.T) {
+	t.Parallel()
+
+	factory := func(args ArgsTokensMapperFactory) (TokensMapper, error) {
+		return &bridgeTests.TokensMapperStub{}, nil
+	}
+
+	t.Run("empty type should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := RegisterTokensMapperFactory("", factory)
+		assert.Equal(t, errEmptyMapperType, err)
+	})
+	t.Run("nil factory should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := RegisterTokensMapperFactory("nil factory", nil)
+		assert.Equal(t, errNilTokensMapperFactory, err)
+	})
+	t.Run("on-chain type can not be replaced", func(t *testing.T) {
+		t.Parallel()
+
+		err := RegisterTokensMapperFactory(OnChainMapperType, factory)
+		assert.True(t, errors.Is(err, errMapperTypeAlreadyRegistered))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := RegisterTokensMapperFactory("registration test", factory)
+		assert.Nil(t, err)
+		assert.Contains(t, RegisteredTokensMapperTypes(), "registration test")
+
+		err = RegisterTokensMapperFactory("registration test", factory)
+		assert.True(t, errors.Is(err, errMapperTypeAlreadyRegistered))
+	})
+}
+
+func TestCreateTokensMapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		mapper, err := CreateTokensMapper("unknown", ArgsTokensMapperFactory{})
+		assert.Nil(t, mapper)
+		assert.True(t, errors.Is(err, errUnknownMapperType))
+	})
+	t.Run("on-chain mapper with unknown direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		mapper, err := CreateTokensMapper(OnChainMapperType, ArgsTokensMapperFactory{
+			Direction:  "unknown",
+			DataGetter: &bridgeTests.DataGetterStub{},
+		})
+		assert.Nil(t, mapper)
+		assert.True(t, errors.Is(err, errUnknownDirection))
+	})
+	t.Run("on-chain mapper with nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		mapper, err := CreateTokensMapper(OnChainMapperType, ArgsTokensMapperFactory{
+			Direction: batchProcessor.ToMultiversX,
+		})
+		assert.Nil(t, mapper)
+		assert.True(t, errors.Is(err, clients.ErrNilDataGetter))
+	})
+	t.Run("empty type should create the on-chain mappers", func(t *testing.T) {
+		t.Parallel()
+
+		mapper, err := CreateTokensMapper("", ArgsTokensMapperFactory{
+			Direction:  batchProcessor.ToMultiversX,
+			DataGetter: &bridgeTests.DataGetterStub{},
+		})
+		assert.Nil(t, err)
+		assert.IsType(t, &erc20ToMultiversX{}, mapper)
+
+		mapper, err = CreateTokensMapper("", ArgsTokensMapperFactory{
+			Direction:  batchProcessor.FromMultiversX,
+			DataGetter: &bridgeTests.DataGetterStub{},
+		})
+		assert.Nil(t, err)
+		assert.IsType(t, &multiversXToErc20{}, mapper)
+	})
+	t.Run("factory returning nil mapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := RegisterTokensMapperFactory("nil mapper", func(args ArgsTokensMapperFactory) (TokensMapper, error) {
+			return nil, nil
+		})
+		require.Nil(t, err)
+
+		mapper, err := CreateTokensMapper("nil mapper", ArgsTokensMapperFactory{})
+		assert.Nil(t, mapper)
+		assert.True(t, errors.Is(err, clients.ErrNilTokensMapper))
+	})
+	t.Run("custom mapper should receive the parameters", func(t *testing.T) {
+		t.Parallel()
+
+		parameters := map[string]string{"URL": "http://registry.local"}
+		err := RegisterTokensMapperFactory("custom", func(args ArgsTokensMapperFactory) (TokensMapper, error) {
+			assert.Equal(t, batchProcessor.FromMultiversX, args.Direction)
+			assert.Equal(t, parameters, args.Parameters)
+
+			return &bridgeTests.TokensMapperStub{
+				ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+					return append([]byte("converted-"), sourceBytes...), nil
+				},
+			}, nil
+		})
+		require.Nil(t, err)
+
+		mapper, err := CreateTokensMapper("custom", ArgsTokensMapperFactory{
+			Direction:  batchProcessor.FromMultiversX,
+			Parameters: parameters,
+		})
+		require.Nil(t, err)
+
+		assert.IsType(t, &serializedTokensMapper{}, mapper)
+
+		converted, err := mapper.ConvertToken(context.Background(), []byte("token"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("converted-token"), converted)
+	})
+}
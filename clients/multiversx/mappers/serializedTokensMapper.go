@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"context"
+	"sync"
+)
+
+// serializedTokensMapper wraps a tokens mapper that does not guarantee to be safe for concurrent use. The MultiversX
+// client converts the tokens of a batch in parallel so the calls towards the wrapped mapper are serialized
+type serializedTokensMapper struct {
+	mut    sync.Mutex
+	mapper TokensMapper
+}
+
+func newSerializedTokensMapper(mapper TokensMapper) *serializedTokensMapper {
+	return &serializedTokensMapper{
+		mapper: mapper,
+	}
+}
+
+// ConvertToken calls the wrapped mapper, one call at a time
+func (mapper *serializedTokensMapper) ConvertToken(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+	mapper.mut.Lock()
+	defer mapper.mut.Unlock()
+
+	return mapper.mapper.ConvertToken(ctx, sourceBytes)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (mapper *serializedTokensMapper) IsInterfaceNil() bool {
+	return mapper == nil
+}
@@ -0,0 +1,49 @@
+package mappers
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSerializedTokensMapper_ConvertToken(t *testing.T) {
+	t.Parallel()
+
+	numInProgress := int32(0)
+	maxInProgress := int32(0)
+	mapper := newSerializedTokensMapper(&bridgeTests.TokensMapperStub{
+		ConvertTokenCalled: func(ctx context.Context, sourceBytes []byte) ([]byte, error) {
+			current := atomic.AddInt32(&numInProgress, 1)
+			defer atomic.AddInt32(&numInProgress, -1)
+			if current > atomic.LoadInt32(&maxInProgress) {
+				atomic.StoreInt32(&maxInProgress, current)
+			}
+			time.Sleep(time.Millisecond)
+
+			return append([]byte("converted-"), sourceBytes...), nil
+		},
+	})
+	assert.False(t, check.IfNil(mapper))
+
+	wg := sync.WaitGroup{}
+	numCalls := 10
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func() {
+			defer wg.Done()
+
+			converted, err := mapper.ConvertToken(context.Background(), []byte("token"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("converted-token"), converted)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&maxInProgress))
+}
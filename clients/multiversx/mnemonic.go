@@ -0,0 +1,28 @@
+package multiversx
+
+import (
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// LoadPrivateKeyFromMnemonicFile derives the MultiversX private key bytes from the BIP-39 mnemonic stored in the
+// provided file, using the m/44'/508'/account'/0'/addressIndex' derivation path
+func LoadPrivateKeyFromMnemonicFile(mnemonicFilename string, account uint32, addressIndex uint32) ([]byte, error) {
+	mnemonicBytes, err := os.ReadFile(mnemonicFilename)
+	if err != nil {
+		return nil, err
+	}
+
+	mnemonic := strings.Join(strings.Fields(string(mnemonicBytes)), " ")
+	if !bip39.IsMnemonicValid(mnemonic) {
+		return nil, errInvalidMnemonic
+	}
+
+	wallet := interactors.NewWallet()
+
+	return wallet.GetPrivateKeyFromMnemonic(data.Mnemonic(mnemonic), account, addressIndex), nil
+}
@@ -0,0 +1,45 @@
+package multiversx
+
+import (
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPrivateKeyFromMnemonicFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := LoadPrivateKeyFromMnemonicFile("testdata/missing-mnemonic", 0, 0)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+		assert.Nil(t, privateKey)
+	})
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "mnemonic")
+		require.Nil(t, os.WriteFile(filename, []byte("acid twice post genre"), 0600))
+
+		privateKey, err := LoadPrivateKeyFromMnemonicFile(filename, 0, 0)
+		assert.Equal(t, errInvalidMnemonic, err)
+		assert.Nil(t, privateKey)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		privateKey, err := LoadPrivateKeyFromMnemonicFile("testdata/ok-mnemonic", 0, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, "0b7966138e80b8f3bb64046f56aea4250fd7bacad6ed214165cea6767fd0bc2c", hex.EncodeToString(privateKey))
+
+		privateKey, err = LoadPrivateKeyFromMnemonicFile("testdata/ok-mnemonic", 0, 1)
+		assert.Nil(t, err)
+		assert.Equal(t, "1648ad209d6b157a289884933e3bb30f161ec7113221ec16f87c3578b05830b0", hex.EncodeToString(privateKey))
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"math/big"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/clients"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
@@ -31,10 +32,13 @@ const (
 	getTokenIdForErc20AddressFuncName                         = "getTokenIdForErc20Address"
 	getErc20AddressForTokenIdFuncName                         = "getErc20AddressForTokenId"
 	quorumReachedFuncName                                     = "quorumReached"
+	getQuorumFuncName                                         = "getQuorum"
 	getLastExecutedEthBatchIdFuncName                         = "getLastExecutedEthBatchId"
 	getLastExecutedEthTxId                                    = "getLastExecutedEthTxId"
 	signedFuncName                                            = "signed"
 	getAllStakedRelayersFuncName                              = "getAllStakedRelayers"
+	getAmountStakedFuncName                                   = "getAmountStaked"
+	getActionLastIndexFuncName                                = "getActionLastIndex"
 	isPausedFuncName                                          = "isPaused"
 	isMintBurnTokenFuncName                                   = "isMintBurnToken"
 	isNativeTokenFuncName                                     = "isNativeToken"
@@ -43,27 +47,39 @@ const (
 	getBurnBalances                                           = "getBurnBalances"
 	getAllKnownTokens                                         = "getAllKnownTokens"
 	getLastBatchId                                            = "getLastBatchId"
+	calculateRequiredFeeFuncName                              = "calculateRequiredFee"
+	getTokenMinLimitFuncName                                  = "getTokenMinLimit"
+	getTokenMaxLimitFuncName                                  = "getTokenMaxLimit"
+	minParallelVMQueries                                      = 1
 )
 
 // ArgsMXClientDataGetter is the arguments DTO used in the NewMXClientDataGetter constructor
 type ArgsMXClientDataGetter struct {
-	MultisigContractAddress core.AddressHandler
-	SafeContractAddress     core.AddressHandler
-	RelayerAddress          core.AddressHandler
-	Proxy                   Proxy
-	Log                     logger.Logger
+	MultisigContractAddress          core.AddressHandler
+	SafeContractAddress              core.AddressHandler
+	RelayerAddress                   core.AddressHandler
+	Proxy                            Proxy
+	Log                              logger.Logger
+	MaxParallelVMQueries             int
+	QueriesCacheNonceRefreshInterval time.Duration
 }
 
 type mxClientDataGetter struct {
-	multisigContractAddress       core.AddressHandler
-	safeContractAddress           core.AddressHandler
-	bech32MultisigContractAddress string
-	relayerAddress                core.AddressHandler
-	proxy                         Proxy
-	log                           logger.Logger
-	mutNodeStatus                 sync.Mutex
-	wasShardIDFetched             bool
-	shardID                       uint32
+	multisigContractAddress          core.AddressHandler
+	safeContractAddress              core.AddressHandler
+	bech32MultisigContractAddress    string
+	relayerAddress                   core.AddressHandler
+	proxy                            Proxy
+	log                              logger.Logger
+	mutNodeStatus                    sync.Mutex
+	wasShardIDFetched                bool
+	shardID                          uint32
+	maxParallelVMQueries             int
+	queriesCache                     *vmQueriesCache
+	queriesCacheNonceRefreshInterval time.Duration
+	mutQueriesCacheNonce             sync.Mutex
+	queriesCacheNonce                uint64
+	queriesCacheNonceTimestamp       time.Time
 }
 
 // NewMXClientDataGetter creates a new instance of the dataGetter type
@@ -87,15 +103,26 @@ func NewMXClientDataGetter(args ArgsMXClientDataGetter) (*mxClientDataGetter, er
 	if err != nil {
 		return nil, fmt.Errorf("%w for %x", err, args.MultisigContractAddress.AddressBytes())
 	}
+	maxParallelVMQueries := args.MaxParallelVMQueries
+	if maxParallelVMQueries < minParallelVMQueries {
+		maxParallelVMQueries = minParallelVMQueries
+	}
 
-	return &mxClientDataGetter{
+	dataGetter := &mxClientDataGetter{
 		multisigContractAddress:       args.MultisigContractAddress,
 		safeContractAddress:           args.SafeContractAddress,
 		bech32MultisigContractAddress: bech32Address,
 		relayerAddress:                args.RelayerAddress,
 		proxy:                         args.Proxy,
 		log:                           args.Log,
-	}, nil
+		maxParallelVMQueries:          maxParallelVMQueries,
+	}
+	if args.QueriesCacheNonceRefreshInterval > 0 {
+		dataGetter.queriesCache = newVMQueriesCache()
+		dataGetter.queriesCacheNonceRefreshInterval = args.QueriesCacheNonceRefreshInterval
+	}
+
+	return dataGetter, nil
 }
 
 // ExecuteQueryReturningBytes will try to execute the provided query and return the result as slice of byte slices
@@ -103,7 +130,54 @@ func (dataGetter *mxClientDataGetter) ExecuteQueryReturningBytes(ctx context.Con
 	if request == nil {
 		return nil, errNilRequest
 	}
+	if dataGetter.queriesCache == nil {
+		return dataGetter.executeQueryReturningBytes(ctx, request)
+	}
+
+	nonce, err := dataGetter.getQueriesCacheNonce(ctx)
+	if err != nil {
+		dataGetter.log.Debug("can not fetch the nonce for the VM queries cache, querying directly", "error", err)
+		return dataGetter.executeQueryReturningBytes(ctx, request)
+	}
+
+	cachedResponse, found := dataGetter.queriesCache.get(nonce, request)
+	if found {
+		dataGetter.log.Trace("VMQuery response served from cache", "FuncName", request.FuncName,
+			"Args", request.Args, "SC address", request.Address, "nonce", nonce)
+		return cachedResponse, nil
+	}
+
+	response, err := dataGetter.executeQueryReturningBytes(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	dataGetter.queriesCache.put(nonce, request, response)
+
+	return response, nil
+}
 
+// getQueriesCacheNonce returns the block nonce used to key the VM queries cache. The nonce is re-fetched only after
+// the configured refresh interval elapsed so the cache lookups do not trigger a network status request each time
+func (dataGetter *mxClientDataGetter) getQueriesCacheNonce(ctx context.Context) (uint64, error) {
+	dataGetter.mutQueriesCacheNonce.Lock()
+	defer dataGetter.mutQueriesCacheNonce.Unlock()
+
+	if time.Since(dataGetter.queriesCacheNonceTimestamp) < dataGetter.queriesCacheNonceRefreshInterval {
+		return dataGetter.queriesCacheNonce, nil
+	}
+
+	nonce, err := dataGetter.GetCurrentNonce(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	dataGetter.queriesCacheNonce = nonce
+	dataGetter.queriesCacheNonceTimestamp = time.Now()
+
+	return nonce, nil
+}
+
+func (dataGetter *mxClientDataGetter) executeQueryReturningBytes(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
 	response, err := dataGetter.proxy.ExecuteVMQuery(ctx, request)
 	if err != nil {
 		dataGetter.log.Error("got error on VMQuery", "FuncName", request.FuncName,
@@ -126,6 +200,90 @@ func (dataGetter *mxClientDataGetter) ExecuteQueryReturningBytes(ctx context.Con
 	return response.Data.ReturnData, nil
 }
 
+// ExecuteQueriesReturningBytes will execute the provided queries, at most MaxParallelVMQueries at a time, returning the
+// results in the same order as the requests. The first encountered error is returned.
+func (dataGetter *mxClientDataGetter) ExecuteQueriesReturningBytes(ctx context.Context, requests []*data.VmValueRequest) ([][][]byte, error) {
+	results := make([][][]byte, len(requests))
+	err := dataGetter.executeInParallel(ctx, len(requests), func(index int) error {
+		response, errQuery := dataGetter.ExecuteQueryReturningBytes(ctx, requests[index])
+		results[index] = response
+
+		return errQuery
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// executeInParallel calls the handler for each index in [0, numTasks) using a bounded number of go routines.
+// It stops launching new tasks after the first error or when the context is done.
+func (dataGetter *mxClientDataGetter) executeInParallel(ctx context.Context, numTasks int, handler func(index int) error) error {
+	if dataGetter.maxParallelVMQueries <= minParallelVMQueries || numTasks <= 1 {
+		for i := 0; i < numTasks; i++ {
+			err := handler(i)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	var firstErr error
+	mutErr := sync.Mutex{}
+	setErr := func(err error) {
+		mutErr.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mutErr.Unlock()
+	}
+	hasErr := func() bool {
+		mutErr.Lock()
+		defer mutErr.Unlock()
+
+		return firstErr != nil
+	}
+
+	throttler := make(chan struct{}, dataGetter.maxParallelVMQueries)
+	wg := sync.WaitGroup{}
+	for i := 0; i < numTasks; i++ {
+		if ctx.Err() != nil {
+			setErr(ctx.Err())
+		}
+		if hasErr() {
+			break
+		}
+
+		select {
+		case throttler <- struct{}{}:
+		case <-ctx.Done():
+			setErr(ctx.Err())
+		}
+		if hasErr() {
+			break
+		}
+
+		wg.Add(1)
+		go func(index int) {
+			defer func() {
+				<-throttler
+				wg.Done()
+			}()
+
+			err := handler(index)
+			if err != nil {
+				setErr(err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
 // GetCurrentNonce will get from the shard containing the multisig contract the latest block's nonce
 func (dataGetter *mxClientDataGetter) GetCurrentNonce(ctx context.Context) (uint64, error) {
 	shardID, err := dataGetter.getShardID(ctx)
@@ -168,6 +326,10 @@ func (dataGetter *mxClientDataGetter) ExecuteQueryReturningBool(ctx context.Cont
 		return false, err
 	}
 
+	return dataGetter.parseBoolResponse(response, request)
+}
+
+func (dataGetter *mxClientDataGetter) parseBoolResponse(response [][]byte, request *data.VmValueRequest) (bool, error) {
 	if len(response) == 0 {
 		return false, nil
 	}
@@ -201,6 +363,10 @@ func (dataGetter *mxClientDataGetter) ExecuteQueryReturningUint64(ctx context.Co
 		return 0, err
 	}
 
+	return parseUint64Response(response, request)
+}
+
+func parseUint64Response(response [][]byte, request *data.VmValueRequest) (uint64, error) {
 	if len(response) == 0 {
 		return 0, nil
 	}
@@ -340,6 +506,54 @@ func (dataGetter *mxClientDataGetter) WasProposedTransfer(ctx context.Context, b
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
+// WasProposedTransferWithActionID queries in parallel if the transfer action was proposed and its action ID
+func (dataGetter *mxClientDataGetter) WasProposedTransferWithActionID(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
+	if batch == nil {
+		return false, 0, clients.ErrNilBatch
+	}
+
+	wasProposedBuilder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	wasProposedBuilder.Function(wasTransferActionProposedFuncName).ArgInt64(int64(batch.ID))
+	dataGetter.addBatchInfo(wasProposedBuilder, batch)
+
+	actionIDBuilder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	actionIDBuilder.Function(getActionIdForTransferBatchFuncName).ArgInt64(int64(batch.ID))
+	dataGetter.addBatchInfo(actionIDBuilder, batch)
+
+	return dataGetter.executeWasProposedWithActionIDQueries(ctx, wasProposedBuilder, actionIDBuilder)
+}
+
+func (dataGetter *mxClientDataGetter) executeWasProposedWithActionIDQueries(
+	ctx context.Context,
+	wasProposedBuilder builders.VMQueryBuilder,
+	actionIDBuilder builders.VMQueryBuilder,
+) (bool, uint64, error) {
+	wasProposedRequest, err := wasProposedBuilder.ToVmValueRequest()
+	if err != nil {
+		return false, 0, err
+	}
+	actionIDRequest, err := actionIDBuilder.ToVmValueRequest()
+	if err != nil {
+		return false, 0, err
+	}
+
+	responses, err := dataGetter.ExecuteQueriesReturningBytes(ctx, []*data.VmValueRequest{wasProposedRequest, actionIDRequest})
+	if err != nil {
+		return false, 0, err
+	}
+
+	wasProposed, err := dataGetter.parseBoolResponse(responses[0], wasProposedRequest)
+	if err != nil {
+		return false, 0, err
+	}
+	actionID, err := parseUint64Response(responses[1], actionIDRequest)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return wasProposed, actionID, nil
+}
+
 // WasExecuted returns true if the provided actionID was executed or not
 func (dataGetter *mxClientDataGetter) WasExecuted(ctx context.Context, actionID uint64) (bool, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
@@ -376,6 +590,24 @@ func (dataGetter *mxClientDataGetter) WasProposedSetStatus(ctx context.Context,
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
+// WasProposedSetStatusWithActionID queries in parallel if the set status action was proposed and its action ID
+func (dataGetter *mxClientDataGetter) WasProposedSetStatusWithActionID(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
+	if batch == nil {
+		return false, 0, clients.ErrNilBatch
+	}
+
+	wasProposedBuilder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	wasProposedBuilder.Function(wasSetCurrentTransactionBatchStatusActionProposedFuncName).ArgInt64(int64(batch.ID))
+	actionIDBuilder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	actionIDBuilder.Function(getActionIdForSetCurrentTransactionBatchStatusFuncName).ArgInt64(int64(batch.ID))
+	for _, stat := range batch.Statuses {
+		wasProposedBuilder.ArgBytes([]byte{stat})
+		actionIDBuilder.ArgBytes([]byte{stat})
+	}
+
+	return dataGetter.executeWasProposedWithActionIDQueries(ctx, wasProposedBuilder, actionIDBuilder)
+}
+
 // GetTransactionsStatuses will return the transactions statuses from the batch ID
 func (dataGetter *mxClientDataGetter) GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
@@ -442,6 +674,13 @@ func (dataGetter *mxClientDataGetter) GetLastExecutedEthBatchID(ctx context.Cont
 	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
 }
 
+// GetQuorum returns the quorum set on the multisig contract
+func (dataGetter *mxClientDataGetter) GetQuorum(ctx context.Context) (uint64, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder().Function(getQuorumFuncName)
+
+	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
+}
+
 // GetLastExecutedEthTxID returns the last executed Ethereum deposit ID
 func (dataGetter *mxClientDataGetter) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder().Function(getLastExecutedEthTxId)
@@ -457,6 +696,14 @@ func (dataGetter *mxClientDataGetter) WasSigned(ctx context.Context, actionID ui
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
+// WasSignedBy returns true if the action was already signed by the provided relayer
+func (dataGetter *mxClientDataGetter) WasSignedBy(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	builder.Function(signedFuncName).ArgBytes(relayerAddress).ArgInt64(int64(actionID))
+
+	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
+}
+
 // GetAllStakedRelayers returns all staked relayers defined in MultiversX SC
 func (dataGetter *mxClientDataGetter) GetAllStakedRelayers(ctx context.Context) ([][]byte, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
@@ -465,6 +712,21 @@ func (dataGetter *mxClientDataGetter) GetAllStakedRelayers(ctx context.Context)
 	return dataGetter.executeQueryFromBuilder(ctx, builder)
 }
 
+// GetAmountStaked returns the amount staked by the provided relayer in the MultiversX SC
+func (dataGetter *mxClientDataGetter) GetAmountStaked(ctx context.Context, relayerAddress []byte) (*big.Int, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	builder.Function(getAmountStakedFuncName).ArgBytes(relayerAddress)
+
+	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
+}
+
+// GetActionLastIndex returns the ID of the last action proposed on the multisig contract
+func (dataGetter *mxClientDataGetter) GetActionLastIndex(ctx context.Context) (uint64, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder().Function(getActionLastIndexFuncName)
+
+	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
+}
+
 // IsPaused returns true if the multisig contract is paused
 func (dataGetter *mxClientDataGetter) IsPaused(ctx context.Context) (bool, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
@@ -544,6 +806,30 @@ func (dataGetter *mxClientDataGetter) GetLastMvxBatchID(ctx context.Context) (ui
 	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
 }
 
+// GetRequiredFee returns the fee the safe contract charges for a deposit of the provided token
+func (dataGetter *mxClientDataGetter) GetRequiredFee(ctx context.Context, token []byte) (*big.Int, error) {
+	builder := dataGetter.createSafeDefaultVmQueryBuilder()
+	builder.Function(calculateRequiredFeeFuncName).ArgBytes(token)
+
+	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
+}
+
+// GetTokenMinLimit returns the minimum amount of the provided token that can be deposited in the safe contract
+func (dataGetter *mxClientDataGetter) GetTokenMinLimit(ctx context.Context, token []byte) (*big.Int, error) {
+	builder := dataGetter.createSafeDefaultVmQueryBuilder()
+	builder.Function(getTokenMinLimitFuncName).ArgBytes(token)
+
+	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
+}
+
+// GetTokenMaxLimit returns the maximum amount of the provided token that can be deposited in the safe contract
+func (dataGetter *mxClientDataGetter) GetTokenMaxLimit(ctx context.Context, token []byte) (*big.Int, error) {
+	builder := dataGetter.createSafeDefaultVmQueryBuilder()
+	builder.Function(getTokenMaxLimitFuncName).ArgBytes(token)
+
+	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (dataGetter *mxClientDataGetter) IsInterfaceNil() bool {
 	return dataGetter == nil
@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/clients"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
@@ -268,6 +271,265 @@ func TestMXClientDataGetter_ExecuteQueryReturningBytes(t *testing.T) {
 	})
 }
 
+func TestMXClientDataGetter_ExecuteQueryReturningBytesWithCache(t *testing.T) {
+	t.Parallel()
+
+	createProxy := func(nonce *uint64, numQueries *int32, numStatusRequests *int32) *interactors.ProxyStub {
+		return &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 0, nil
+			},
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				atomic.AddInt32(numStatusRequests, 1)
+				return &data.NetworkStatus{
+					Nonce: atomic.LoadUint64(nonce),
+				}, nil
+			},
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				atomic.AddInt32(numQueries, 1)
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{[]byte(vmRequest.FuncName)},
+					},
+				}, nil
+			},
+		}
+	}
+
+	t.Run("cache disabled should always query", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(1)
+		numQueries := int32(0)
+		numStatusRequests := int32(0)
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = createProxy(&nonce, &numQueries, &numStatusRequests)
+		dg, _ := NewMXClientDataGetter(args)
+
+		for i := 0; i < 3; i++ {
+			result, err := dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte("func")}, result)
+		}
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numQueries))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&numStatusRequests))
+	})
+	t.Run("cache enabled should serve identical queries from the same nonce", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(1)
+		numQueries := int32(0)
+		numStatusRequests := int32(0)
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = createProxy(&nonce, &numQueries, &numStatusRequests)
+		args.QueriesCacheNonceRefreshInterval = time.Hour
+		dg, _ := NewMXClientDataGetter(args)
+
+		for i := 0; i < 3; i++ {
+			result, err := dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte("func")}, result)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numQueries))
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numStatusRequests))
+
+		_, _ = dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func2"})
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numQueries))
+	})
+	t.Run("cache enabled should query again on nonce change", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(1)
+		numQueries := int32(0)
+		numStatusRequests := int32(0)
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = createProxy(&nonce, &numQueries, &numStatusRequests)
+		args.QueriesCacheNonceRefreshInterval = time.Nanosecond
+		dg, _ := NewMXClientDataGetter(args)
+
+		_, _ = dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+		time.Sleep(time.Millisecond)
+		_, _ = dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+		assert.Equal(t, int32(1), atomic.LoadInt32(&numQueries))
+
+		atomic.StoreUint64(&nonce, 2)
+		time.Sleep(time.Millisecond)
+		_, _ = dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numQueries))
+		assert.Equal(t, int32(3), atomic.LoadInt32(&numStatusRequests))
+	})
+	t.Run("nonce fetch error should query directly", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(1)
+		numQueries := int32(0)
+		numStatusRequests := int32(0)
+		proxy := createProxy(&nonce, &numQueries, &numStatusRequests)
+		proxy.GetNetworkStatusCalled = func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return nil, errors.New("network status error")
+		}
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = proxy
+		args.QueriesCacheNonceRefreshInterval = time.Hour
+		dg, _ := NewMXClientDataGetter(args)
+
+		for i := 0; i < 2; i++ {
+			result, err := dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+			assert.Nil(t, err)
+			assert.Equal(t, [][]byte{[]byte("func")}, result)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numQueries))
+	})
+	t.Run("errored queries should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(1)
+		numQueries := int32(0)
+		numStatusRequests := int32(0)
+		proxy := createProxy(&nonce, &numQueries, &numStatusRequests)
+		expectedErr := errors.New("expected error")
+		proxy.ExecuteVMQueryCalled = func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			atomic.AddInt32(&numQueries, 1)
+			return nil, expectedErr
+		}
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = proxy
+		args.QueriesCacheNonceRefreshInterval = time.Hour
+		dg, _ := NewMXClientDataGetter(args)
+
+		for i := 0; i < 2; i++ {
+			result, err := dg.ExecuteQueryReturningBytes(context.Background(), &data.VmValueRequest{FuncName: "func"})
+			assert.Equal(t, expectedErr, err)
+			assert.Nil(t, result)
+		}
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numQueries))
+	})
+}
+
+func TestMXClientDataGetter_ExecuteQueriesReturningBytes(t *testing.T) {
+	t.Parallel()
+
+	createRequests := func(numRequests int) []*data.VmValueRequest {
+		requests := make([]*data.VmValueRequest, 0, numRequests)
+		for i := 0; i < numRequests; i++ {
+			requests = append(requests, &data.VmValueRequest{
+				FuncName: fmt.Sprintf("func%d", i),
+			})
+		}
+
+		return requests
+	}
+	createEchoProxy := func(numInProgress *int32, maxInProgress *int32) *interactors.ProxyStub {
+		return &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				current := atomic.AddInt32(numInProgress, 1)
+				defer atomic.AddInt32(numInProgress, -1)
+				for {
+					max := atomic.LoadInt32(maxInProgress)
+					if current <= max || atomic.CompareAndSwapInt32(maxInProgress, max, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond * 10)
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{[]byte(vmRequest.FuncName)},
+					},
+				}, nil
+			},
+		}
+	}
+
+	t.Run("empty requests should return empty results", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := NewMXClientDataGetter(createMockArgsMXClientDataGetter())
+
+		results, err := dg.ExecuteQueriesReturningBytes(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Empty(t, results)
+	})
+	t.Run("one request errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.MaxParallelVMQueries = 3
+		dg, _ := NewMXClientDataGetter(args)
+
+		expectedErr := errors.New("expected error")
+		dg.proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				if vmRequest.FuncName == "func2" {
+					return nil, expectedErr
+				}
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+					},
+				}, nil
+			},
+		}
+
+		results, err := dg.ExecuteQueriesReturningBytes(context.Background(), createRequests(10))
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, results)
+	})
+	t.Run("context done should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.MaxParallelVMQueries = 2
+		dg, _ := NewMXClientDataGetter(args)
+		dg.proxy = createMockProxy(nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		results, err := dg.ExecuteQueriesReturningBytes(ctx, createRequests(10))
+		assert.Equal(t, context.Canceled, err)
+		assert.Nil(t, results)
+	})
+	t.Run("should work sequentially", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := NewMXClientDataGetter(createMockArgsMXClientDataGetter())
+		numInProgress := int32(0)
+		maxInProgress := int32(0)
+		dg.proxy = createEchoProxy(&numInProgress, &maxInProgress)
+
+		results, err := dg.ExecuteQueriesReturningBytes(context.Background(), createRequests(5))
+		assert.Nil(t, err)
+		assert.Equal(t, 5, len(results))
+		for i, result := range results {
+			assert.Equal(t, [][]byte{[]byte(fmt.Sprintf("func%d", i))}, result)
+		}
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxInProgress))
+	})
+	t.Run("should work in parallel with bounded concurrency", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.MaxParallelVMQueries = 3
+		dg, _ := NewMXClientDataGetter(args)
+		numInProgress := int32(0)
+		maxInProgress := int32(0)
+		dg.proxy = createEchoProxy(&numInProgress, &maxInProgress)
+
+		results, err := dg.ExecuteQueriesReturningBytes(context.Background(), createRequests(20))
+		assert.Nil(t, err)
+		assert.Equal(t, 20, len(results))
+		for i, result := range results {
+			assert.Equal(t, [][]byte{[]byte(fmt.Sprintf("func%d", i))}, result)
+		}
+		assert.LessOrEqual(t, atomic.LoadInt32(&maxInProgress), int32(3))
+		assert.Greater(t, atomic.LoadInt32(&maxInProgress), int32(1))
+	})
+}
+
 func TestMXClientDataGetter_ExecuteQueryReturningBool(t *testing.T) {
 	t.Parallel()
 
@@ -915,6 +1177,135 @@ func TestMXClientDataGetter_WasProposedSetStatus(t *testing.T) {
 	})
 }
 
+func createWasProposedWithActionIDProxy(
+	wasProposedFuncName string,
+	actionIDFuncName string,
+	numCalls *int32,
+	argsByFunc map[string][]string,
+	mutArgs *sync.Mutex,
+) *interactors.ProxyStub {
+	return &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			atomic.AddInt32(numCalls, 1)
+			mutArgs.Lock()
+			argsByFunc[vmRequest.FuncName] = vmRequest.Args
+			mutArgs.Unlock()
+
+			returnData := [][]byte{{1}}
+			switch vmRequest.FuncName {
+			case wasProposedFuncName:
+			case actionIDFuncName:
+				returnData = [][]byte{big.NewInt(1122).Bytes()}
+			default:
+				return nil, fmt.Errorf("unexpected function %s", vmRequest.FuncName)
+			}
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: returnData,
+				},
+			}, nil
+		},
+	}
+}
+
+func TestMXClientDataGetter_WasProposedTransferWithActionID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := NewMXClientDataGetter(createMockArgsMXClientDataGetter())
+
+		wasProposed, actionID, err := dg.WasProposedTransferWithActionID(context.Background(), nil)
+		assert.False(t, wasProposed)
+		assert.Zero(t, actionID)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("query errors", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.MaxParallelVMQueries = 2
+		expectedErr := errors.New("expected error")
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		dg, _ := NewMXClientDataGetter(args)
+
+		wasProposed, actionID, err := dg.WasProposedTransferWithActionID(context.Background(), createMockBatch())
+		assert.False(t, wasProposed)
+		assert.Zero(t, actionID)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.MaxParallelVMQueries = 2
+		numCalls := int32(0)
+		argsByFunc := make(map[string][]string)
+		mutArgs := &sync.Mutex{}
+		args.Proxy = createWasProposedWithActionIDProxy(wasTransferActionProposedFuncName, getActionIdForTransferBatchFuncName,
+			&numCalls, argsByFunc, mutArgs)
+		dg, _ := NewMXClientDataGetter(args)
+
+		wasProposed, actionID, err := dg.WasProposedTransferWithActionID(context.Background(), createMockBatch())
+		assert.Nil(t, err)
+		assert.True(t, wasProposed)
+		assert.Equal(t, uint64(1122), actionID)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+		assert.Equal(t, argsByFunc[wasTransferActionProposedFuncName], argsByFunc[getActionIdForTransferBatchFuncName])
+		assert.Equal(t, hex.EncodeToString(big.NewInt(112233).Bytes()), argsByFunc[wasTransferActionProposedFuncName][0])
+	})
+}
+
+func TestMXClientDataGetter_WasProposedSetStatusWithActionID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch", func(t *testing.T) {
+		t.Parallel()
+
+		dg, _ := NewMXClientDataGetter(createMockArgsMXClientDataGetter())
+
+		wasProposed, actionID, err := dg.WasProposedSetStatusWithActionID(context.Background(), nil)
+		assert.False(t, wasProposed)
+		assert.Zero(t, actionID)
+		assert.Equal(t, clients.ErrNilBatch, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.MaxParallelVMQueries = 2
+		numCalls := int32(0)
+		argsByFunc := make(map[string][]string)
+		mutArgs := &sync.Mutex{}
+		args.Proxy = createWasProposedWithActionIDProxy(wasSetCurrentTransactionBatchStatusActionProposedFuncName,
+			getActionIdForSetCurrentTransactionBatchStatusFuncName, &numCalls, argsByFunc, mutArgs)
+		dg, _ := NewMXClientDataGetter(args)
+		batch := createMockBatch()
+
+		wasProposed, actionID, err := dg.WasProposedSetStatusWithActionID(context.Background(), batch)
+		assert.Nil(t, err)
+		assert.True(t, wasProposed)
+		assert.Equal(t, uint64(1122), actionID)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&numCalls))
+
+		expectedArgs := []string{
+			hex.EncodeToString(big.NewInt(112233).Bytes()),
+		}
+		for _, stat := range batch.Statuses {
+			expectedArgs = append(expectedArgs, hex.EncodeToString([]byte{stat}))
+		}
+		assert.Equal(t, expectedArgs, argsByFunc[wasSetCurrentTransactionBatchStatusActionProposedFuncName])
+		assert.Equal(t, expectedArgs, argsByFunc[getActionIdForSetCurrentTransactionBatchStatusFuncName])
+	})
+}
+
 func TestMXClientDataGetter_GetTransactionsStatuses(t *testing.T) {
 	t.Parallel()
 
@@ -1191,6 +1582,68 @@ func TestMXClientDataGetter_GetLastExecutedEthTxID(t *testing.T) {
 	assert.Equal(t, val.Uint64(), result)
 }
 
+func TestMXClientDataGetter_GetQuorum(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMXClientDataGetter()
+	proxyCalled := false
+	val := big.NewInt(7)
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			proxyCalled = true
+			assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+			assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+			assert.Equal(t, "", vmRequest.CallValue)
+			assert.Equal(t, getQuorumFuncName, vmRequest.FuncName)
+			assert.Nil(t, vmRequest.Args)
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{val.Bytes()},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewMXClientDataGetter(args)
+
+	result, err := dg.GetQuorum(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, proxyCalled)
+	assert.Equal(t, val.Uint64(), result)
+}
+
+func TestMXClientDataGetter_GetActionLastIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMXClientDataGetter()
+	proxyCalled := false
+	val := big.NewInt(37)
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			proxyCalled = true
+			assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+			assert.Equal(t, getActionLastIndexFuncName, vmRequest.FuncName)
+			assert.Nil(t, vmRequest.Args)
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{val.Bytes()},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewMXClientDataGetter(args)
+
+	result, err := dg.GetActionLastIndex(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, proxyCalled)
+	assert.Equal(t, val.Uint64(), result)
+}
+
 func TestMXClientDataGetter_WasSigned(t *testing.T) {
 	t.Parallel()
 
@@ -1228,6 +1681,43 @@ func TestMXClientDataGetter_WasSigned(t *testing.T) {
 	assert.True(t, result)
 }
 
+func TestMXClientDataGetter_WasSignedBy(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMXClientDataGetter()
+	proxyCalled := false
+	actionID := big.NewInt(112233)
+	relayerAddress := []byte("relayer address")
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			proxyCalled = true
+			assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+			assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+			assert.Equal(t, signedFuncName, vmRequest.FuncName)
+
+			expectedArgs := []string{
+				hex.EncodeToString(relayerAddress),
+				hex.EncodeToString(actionID.Bytes()),
+			}
+			assert.Equal(t, expectedArgs, vmRequest.Args)
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{{}},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewMXClientDataGetter(args)
+
+	result, err := dg.WasSignedBy(context.Background(), actionID.Uint64(), relayerAddress)
+	assert.Nil(t, err)
+	assert.True(t, proxyCalled)
+	assert.False(t, result)
+}
+
 func TestMXClientDataGetter_GetAllStakedRelayers(t *testing.T) {
 	t.Parallel()
 
@@ -1258,6 +1748,38 @@ func TestMXClientDataGetter_GetAllStakedRelayers(t *testing.T) {
 	assert.Equal(t, providedRelayers, result)
 }
 
+func TestMXClientDataGetter_GetAmountStaked(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMXClientDataGetter()
+	proxyCalled := false
+	providedStake := big.NewInt(1000)
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			proxyCalled = true
+			assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+			assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+			assert.Equal(t, "", vmRequest.CallValue)
+			assert.Equal(t, getAmountStakedFuncName, vmRequest.FuncName)
+			assert.Equal(t, []string{hex.EncodeToString([]byte("relayer1"))}, vmRequest.Args)
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{providedStake.Bytes()},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewMXClientDataGetter(args)
+
+	result, err := dg.GetAmountStaked(context.Background(), []byte("relayer1"))
+	assert.Nil(t, err)
+	assert.Equal(t, providedStake, result)
+	assert.True(t, proxyCalled)
+}
+
 func TestMXClientDataGetter_GetAllKnownTokens(t *testing.T) {
 	t.Parallel()
 
@@ -1621,3 +2143,62 @@ func TestMultiversXClientDataGetter_GetLastMvxBatchID(t *testing.T) {
 	assert.Equal(t, uint64(3737), result)
 	assert.True(t, proxyCalled)
 }
+
+func TestMultiversXClientDataGetter_TokenFeeAndLimits(t *testing.T) {
+	t.Parallel()
+
+	expectedValue := big.NewInt(1000)
+	createDataGetter := func(expectedFunction string, proxyCalled *bool) *mxClientDataGetter {
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				*proxyCalled = true
+				assert.Equal(t, getBech32Address(args.SafeContractAddress), vmRequest.Address)
+				assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+				assert.Equal(t, expectedFunction, vmRequest.FuncName)
+				assert.Equal(t, []string{"746f6b656e"}, vmRequest.Args)
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{expectedValue.Bytes()},
+					},
+				}, nil
+			},
+		}
+		dg, _ := NewMXClientDataGetter(args)
+
+		return dg
+	}
+
+	t.Run("GetRequiredFee", func(t *testing.T) {
+		t.Parallel()
+
+		proxyCalled := false
+		dg := createDataGetter(calculateRequiredFeeFuncName, &proxyCalled)
+		result, err := dg.GetRequiredFee(context.Background(), []byte("token"))
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, result)
+		assert.True(t, proxyCalled)
+	})
+	t.Run("GetTokenMinLimit", func(t *testing.T) {
+		t.Parallel()
+
+		proxyCalled := false
+		dg := createDataGetter(getTokenMinLimitFuncName, &proxyCalled)
+		result, err := dg.GetTokenMinLimit(context.Background(), []byte("token"))
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, result)
+		assert.True(t, proxyCalled)
+	})
+	t.Run("GetTokenMaxLimit", func(t *testing.T) {
+		t.Parallel()
+
+		proxyCalled := false
+		dg := createDataGetter(getTokenMaxLimitFuncName, &proxyCalled)
+		result, err := dg.GetTokenMaxLimit(context.Background(), []byte("token"))
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, result)
+		assert.True(t, proxyCalled)
+	})
+}
@@ -0,0 +1,95 @@
+package multiversx
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	sentTransactionsJournalKey   = "multiversx-sent-transactions"
+	maxSentTransactionsInJournal = 100
+)
+
+// sentTransaction is a transaction sent by the relayer on MultiversX, kept so it can be tracked after a restart
+type sentTransaction struct {
+	Hash     string `json:"hash"`
+	Nonce    uint64 `json:"nonce"`
+	Function string `json:"function"`
+}
+
+// sentTransactionsJournal persists the last transactions sent by the relayer
+type sentTransactionsJournal struct {
+	mut     sync.Mutex
+	storer  bridgeCore.Storer
+	entries []*sentTransaction
+}
+
+func newSentTransactionsJournal(storer bridgeCore.Storer, log logger.Logger) *sentTransactionsJournal {
+	journal := &sentTransactionsJournal{
+		storer:  storer,
+		entries: make([]*sentTransaction, 0),
+	}
+
+	buff, err := storer.Get([]byte(sentTransactionsJournalKey))
+	if err != nil {
+		return journal
+	}
+
+	err = json.Unmarshal(buff, &journal.entries)
+	if err != nil {
+		log.Warn("sentTransactionsJournal: corrupted journal, discarding", "error", err)
+		journal.entries = make([]*sentTransaction, 0)
+	}
+
+	return journal
+}
+
+func (journal *sentTransactionsJournal) record(hash string, nonce uint64, txData []byte) error {
+	journal.mut.Lock()
+	defer journal.mut.Unlock()
+
+	journal.entries = append(journal.entries, &sentTransaction{
+		Hash:     hash,
+		Nonce:    nonce,
+		Function: getFunctionName(txData),
+	})
+	if len(journal.entries) > maxSentTransactionsInJournal {
+		journal.entries = journal.entries[len(journal.entries)-maxSentTransactionsInJournal:]
+	}
+
+	return journal.save()
+}
+
+func (journal *sentTransactionsJournal) getAll() []*sentTransaction {
+	journal.mut.Lock()
+	defer journal.mut.Unlock()
+
+	return append(make([]*sentTransaction, 0, len(journal.entries)), journal.entries...)
+}
+
+func (journal *sentTransactionsJournal) clear() error {
+	journal.mut.Lock()
+	defer journal.mut.Unlock()
+
+	journal.entries = make([]*sentTransaction, 0)
+
+	return journal.save()
+}
+
+func (journal *sentTransactionsJournal) save() error {
+	buff, err := json.Marshal(journal.entries)
+	if err != nil {
+		return err
+	}
+
+	return journal.storer.Put([]byte(sentTransactionsJournalKey), buff)
+}
+
+// getFunctionName returns the called contract function from the transaction data
+func getFunctionName(txData []byte) string {
+	return strings.SplitN(string(txData), "@", 2)[0]
+}
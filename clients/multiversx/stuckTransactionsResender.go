@@ -0,0 +1,359 @@
+package multiversx
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	txDataSeparator = "@"
+	percentDivisor  = 100
+)
+
+// reconcileQueries maps the functions of the relayer's transactions to the multisig views that, called with the same
+// arguments, return true if the action was already done, possibly by a competing relayer's transaction
+var reconcileQueries = map[string]string{
+	proposeTransferFuncName:  wasTransferActionProposedFuncName,
+	proposeSetStatusFuncName: wasSetCurrentTransactionBatchStatusActionProposedFuncName,
+	signFuncName:             wasActionExecutedFuncName,
+	performActionFuncName:    wasActionExecutedFuncName,
+}
+
+type boolQueryExecutor interface {
+	ExecuteQueryReturningBool(ctx context.Context, request *data.VmValueRequest) (bool, error)
+}
+
+type argsStuckTransactionsResender struct {
+	config                  config.MultiversXStuckTransactionsConfig
+	proxy                   Proxy
+	nonceTxHandler          NonceTransactionsHandler
+	queryExecutor           boolQueryExecutor
+	relayerAddress          core.AddressHandler
+	multisigAddressAsBech32 string
+	signTransaction         func(tx *transaction.FrontendTransaction) error
+	log                     logger.Logger
+	timer                   bridgeCore.Timer
+}
+
+// pendingTransaction is a transaction sent by the relayer that was not yet included in a block
+type pendingTransaction struct {
+	tx          *transaction.FrontendTransaction
+	hash        string
+	sentAtRound uint64
+	numResends  int
+}
+
+// stuckTransactionsResender tracks the transactions sent by the relayer. A transaction not included in a block after
+// the configured number of rounds is resent with the same nonce and, if the network accepts the replacement of the
+// pending transactions, with an increased gas price. If a competing relayer already did the action, the stuck
+// transaction is replaced with a cheap transaction to self so the nonce is not wasted on a failing contract call
+type stuckTransactionsResender struct {
+	args     argsStuckTransactionsResender
+	cancel   func()
+	mut      sync.Mutex
+	pending  map[uint64]*pendingTransaction
+	shardID  uint32
+	hasShard bool
+}
+
+func newStuckTransactionsResender(args argsStuckTransactionsResender) *stuckTransactionsResender {
+	return &stuckTransactionsResender{
+		args:    args,
+		cancel:  func() {},
+		pending: make(map[uint64]*pendingTransaction),
+	}
+}
+
+func checkStuckTransactionsConfig(cfg config.MultiversXStuckTransactionsConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.StuckAfterRounds == 0 {
+		return fmt.Errorf("%w for StuckTransactions.StuckAfterRounds", errInvalidStuckTransactionsConfig)
+	}
+	if cfg.CheckIntervalInSeconds == 0 {
+		return fmt.Errorf("%w for StuckTransactions.CheckIntervalInSeconds", errInvalidStuckTransactionsConfig)
+	}
+	if cfg.MaxResends == 0 {
+		return fmt.Errorf("%w for StuckTransactions.MaxResends", errInvalidStuckTransactionsConfig)
+	}
+	if !cfg.GasPriceBumpEnabled {
+		return nil
+	}
+	if cfg.GasPriceBumpPercent == 0 {
+		return fmt.Errorf("%w for StuckTransactions.GasPriceBumpPercent", errInvalidStuckTransactionsConfig)
+	}
+	if cfg.MaxGasPrice == 0 {
+		return fmt.Errorf("%w for StuckTransactions.MaxGasPrice", errInvalidStuckTransactionsConfig)
+	}
+
+	return nil
+}
+
+// start launches the go routine periodically checking the pending transactions
+func (resender *stuckTransactionsResender) start() {
+	if !resender.args.config.Enabled {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	resender.cancel = cancel
+	go resender.processLoop(ctx)
+}
+
+func (resender *stuckTransactionsResender) processLoop(ctx context.Context) {
+	interval := time.Second * time.Duration(resender.args.config.CheckIntervalInSeconds)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-resender.args.timer.After(interval):
+			resender.checkPendingTransactions(ctx)
+		}
+	}
+}
+
+// track records a transaction sent by the relayer
+func (resender *stuckTransactionsResender) track(ctx context.Context, tx *transaction.FrontendTransaction, hash string) {
+	if !resender.args.config.Enabled {
+		return
+	}
+
+	round, err := resender.getCurrentRound(ctx)
+	if err != nil {
+		resender.args.log.Debug("stuckTransactionsResender: can not get the current round, the transaction is not tracked",
+			"hash", hash, "error", err)
+		return
+	}
+
+	resender.mut.Lock()
+	resender.pending[tx.Nonce] = &pendingTransaction{
+		tx:          tx,
+		hash:        hash,
+		sentAtRound: round,
+	}
+	resender.mut.Unlock()
+}
+
+func (resender *stuckTransactionsResender) checkPendingTransactions(ctx context.Context) {
+	account, err := resender.args.proxy.GetAccount(ctx, resender.args.relayerAddress)
+	if err != nil {
+		resender.args.log.Debug("stuckTransactionsResender: can not get the relayer account", "error", err)
+		return
+	}
+	round, err := resender.getCurrentRound(ctx)
+	if err != nil {
+		resender.args.log.Debug("stuckTransactionsResender: can not get the current round", "error", err)
+		return
+	}
+
+	for _, pendingTx := range resender.getStuckTransactions(account.Nonce, round) {
+		err = resender.resend(ctx, pendingTx, round)
+		if err != nil {
+			resender.args.log.Warn("stuckTransactionsResender: can not resend the stuck transaction",
+				"hash", pendingTx.hash, "nonce", pendingTx.tx.Nonce, "error", err)
+		}
+	}
+}
+
+// getStuckTransactions removes the transactions whose nonces were already consumed and returns, sorted by nonce, the
+// ones pending for at least the configured number of rounds
+func (resender *stuckTransactionsResender) getStuckTransactions(accountNonce uint64, round uint64) []*pendingTransaction {
+	resender.mut.Lock()
+	defer resender.mut.Unlock()
+
+	stuckTxs := make([]*pendingTransaction, 0)
+	for nonce, pendingTx := range resender.pending {
+		if nonce < accountNonce {
+			delete(resender.pending, nonce)
+			continue
+		}
+		if round < pendingTx.sentAtRound+resender.args.config.StuckAfterRounds {
+			continue
+		}
+		if pendingTx.numResends >= resender.args.config.MaxResends {
+			resender.args.log.Warn("stuckTransactionsResender: transaction still stuck after the maximum number of resends",
+				"hash", pendingTx.hash, "nonce", nonce, "resends", pendingTx.numResends)
+			delete(resender.pending, nonce)
+			continue
+		}
+
+		stuckTxs = append(stuckTxs, pendingTx)
+	}
+
+	sort.Slice(stuckTxs, func(i, j int) bool {
+		return stuckTxs[i].tx.Nonce < stuckTxs[j].tx.Nonce
+	})
+
+	return stuckTxs
+}
+
+func (resender *stuckTransactionsResender) resend(ctx context.Context, pendingTx *pendingTransaction, round uint64) error {
+	alreadyDone, err := resender.wasActionAlreadyDone(ctx, pendingTx.tx)
+	if err != nil {
+		return err
+	}
+
+	cfg := resender.args.config
+	tx := pendingTx.tx
+	if alreadyDone {
+		if !cfg.GasPriceBumpEnabled {
+			// the pending transaction can not be replaced, it will fail when included in a block
+			resender.args.log.Info("stuckTransactionsResender: the action of the stuck transaction was done by another relayer",
+				"hash", pendingTx.hash, "nonce", tx.Nonce, "function", getFunctionName(tx.Data))
+			resender.remove(tx.Nonce)
+			return nil
+		}
+
+		tx, err = resender.createCancelTransaction(ctx, tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.GasPriceBumpEnabled {
+		tx = resender.bumpGasPrice(tx)
+	}
+	if tx != pendingTx.tx {
+		err = resender.args.signTransaction(tx)
+		if err != nil {
+			return err
+		}
+	}
+
+	hash, err := resender.args.nonceTxHandler.SendTransaction(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	resender.args.log.Info("stuckTransactionsResender: resent the stuck transaction",
+		"old hash", pendingTx.hash, "new hash", hash, "nonce", tx.Nonce, "gas price", tx.GasPrice,
+		"function", getFunctionName(tx.Data), "action done by another relayer", alreadyDone)
+
+	resender.mut.Lock()
+	resender.pending[tx.Nonce] = &pendingTransaction{
+		tx:          tx,
+		hash:        hash,
+		sentAtRound: round,
+		numResends:  pendingTx.numResends + 1,
+	}
+	resender.mut.Unlock()
+
+	return nil
+}
+
+// wasActionAlreadyDone queries the multisig contract, with the arguments of the transaction, if the action was already
+// done. The transactions to self, used to cancel the stuck ones, are never done
+func (resender *stuckTransactionsResender) wasActionAlreadyDone(ctx context.Context, tx *transaction.FrontendTransaction) (bool, error) {
+	if tx.Receiver != resender.args.multisigAddressAsBech32 {
+		return false, nil
+	}
+
+	parts := strings.Split(string(tx.Data), txDataSeparator)
+	queryFunction, found := reconcileQueries[parts[0]]
+	if !found {
+		return false, nil
+	}
+
+	return resender.args.queryExecutor.ExecuteQueryReturningBool(ctx, &data.VmValueRequest{
+		Address:    resender.args.multisigAddressAsBech32,
+		FuncName:   queryFunction,
+		CallerAddr: tx.Sender,
+		Args:       parts[1:],
+	})
+}
+
+func (resender *stuckTransactionsResender) createCancelTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (*transaction.FrontendTransaction, error) {
+	networkConfig, err := resender.args.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cancelTx := *tx
+	cancelTx.Receiver = tx.Sender
+	cancelTx.Value = "0"
+	cancelTx.Data = nil
+	cancelTx.GasLimit = networkConfig.MinGasLimit
+
+	return &cancelTx, nil
+}
+
+// bumpGasPrice returns a copy of the transaction with the gas price increased by the configured percent, capped to the
+// maximum gas price. Returns the same transaction if the gas price can not be increased
+func (resender *stuckTransactionsResender) bumpGasPrice(tx *transaction.FrontendTransaction) *transaction.FrontendTransaction {
+	cfg := resender.args.config
+	gasPrice := tx.GasPrice + tx.GasPrice*cfg.GasPriceBumpPercent/percentDivisor
+	if gasPrice > cfg.MaxGasPrice {
+		gasPrice = cfg.MaxGasPrice
+	}
+	if gasPrice <= tx.GasPrice {
+		return tx
+	}
+
+	bumpedTx := *tx
+	bumpedTx.GasPrice = gasPrice
+
+	return &bumpedTx
+}
+
+func (resender *stuckTransactionsResender) remove(nonce uint64) {
+	resender.mut.Lock()
+	delete(resender.pending, nonce)
+	resender.mut.Unlock()
+}
+
+func (resender *stuckTransactionsResender) getCurrentRound(ctx context.Context) (uint64, error) {
+	shardID, err := resender.getShardID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := resender.args.proxy.GetNetworkStatus(ctx, shardID)
+	if err != nil {
+		return 0, err
+	}
+	if status == nil {
+		return 0, errNilNetworkStatus
+	}
+
+	return status.CurrentRound, nil
+}
+
+func (resender *stuckTransactionsResender) getShardID(ctx context.Context) (uint32, error) {
+	resender.mut.Lock()
+	defer resender.mut.Unlock()
+
+	if resender.hasShard {
+		return resender.shardID, nil
+	}
+
+	bech32Address, err := resender.args.relayerAddress.AddressAsBech32String()
+	if err != nil {
+		return 0, err
+	}
+	shardID, err := resender.args.proxy.GetShardOfAddress(ctx, bech32Address)
+	if err != nil {
+		return 0, err
+	}
+
+	resender.shardID = shardID
+	resender.hasShard = true
+
+	return shardID, nil
+}
+
+// close stops the process loop
+func (resender *stuckTransactionsResender) close() {
+	resender.cancel()
+}
@@ -0,0 +1,375 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type boolQueryExecutorStub struct {
+	executeQueryReturningBoolCalled func(ctx context.Context, request *data.VmValueRequest) (bool, error)
+}
+
+func (stub *boolQueryExecutorStub) ExecuteQueryReturningBool(ctx context.Context, request *data.VmValueRequest) (bool, error) {
+	if stub.executeQueryReturningBoolCalled != nil {
+		return stub.executeQueryReturningBoolCalled(ctx, request)
+	}
+
+	return false, nil
+}
+
+func createMockStuckTransactionsConfig() config.MultiversXStuckTransactionsConfig {
+	return config.MultiversXStuckTransactionsConfig{
+		Enabled:                true,
+		StuckAfterRounds:       10,
+		CheckIntervalInSeconds: 30,
+		MaxResends:             2,
+		GasPriceBumpEnabled:    true,
+		GasPriceBumpPercent:    20,
+		MaxGasPrice:            1500000000,
+	}
+}
+
+func createMockArgsStuckTransactionsResender() argsStuckTransactionsResender {
+	relayer, _ := data.NewAddressFromBech32String(relayerAddress)
+
+	return argsStuckTransactionsResender{
+		config: createMockStuckTransactionsConfig(),
+		proxy: &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				return &data.NetworkStatus{
+					CurrentRound: 100,
+				}, nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					MinGasLimit: 50000,
+				}, nil
+			},
+		},
+		nonceTxHandler:          &bridgeTests.NonceTransactionsHandlerStub{},
+		queryExecutor:           &boolQueryExecutorStub{},
+		relayerAddress:          relayer,
+		multisigAddressAsBech32: testMultisigAddress,
+		signTransaction: func(tx *transaction.FrontendTransaction) error {
+			tx.Signature = "signature"
+			return nil
+		},
+		log:   logger.GetOrCreate("test"),
+		timer: testsCommon.NewTimerStub(),
+	}
+}
+
+func createMockPendingTransaction(nonce uint64, sentAtRound uint64) *pendingTransaction {
+	return &pendingTransaction{
+		tx: &transaction.FrontendTransaction{
+			Nonce:    nonce,
+			Value:    "0",
+			Receiver: testMultisigAddress,
+			Sender:   relayerAddress,
+			GasPrice: 1000000000,
+			GasLimit: 20000000,
+			Data:     []byte(signFuncName + "@05"),
+		},
+		hash:        "hash",
+		sentAtRound: sentAtRound,
+	}
+}
+
+func TestCheckStuckTransactionsConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled config should not be checked", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, checkStuckTransactionsConfig(config.MultiversXStuckTransactionsConfig{}))
+	})
+	t.Run("invalid values should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockStuckTransactionsConfig()
+		cfg.StuckAfterRounds = 0
+		err := checkStuckTransactionsConfig(cfg)
+		assert.True(t, errors.Is(err, errInvalidStuckTransactionsConfig))
+		assert.True(t, strings.Contains(err.Error(), "StuckAfterRounds"))
+
+		cfg = createMockStuckTransactionsConfig()
+		cfg.CheckIntervalInSeconds = 0
+		err = checkStuckTransactionsConfig(cfg)
+		assert.True(t, errors.Is(err, errInvalidStuckTransactionsConfig))
+		assert.True(t, strings.Contains(err.Error(), "CheckIntervalInSeconds"))
+
+		cfg = createMockStuckTransactionsConfig()
+		cfg.MaxResends = 0
+		err = checkStuckTransactionsConfig(cfg)
+		assert.True(t, errors.Is(err, errInvalidStuckTransactionsConfig))
+		assert.True(t, strings.Contains(err.Error(), "MaxResends"))
+
+		cfg = createMockStuckTransactionsConfig()
+		cfg.GasPriceBumpPercent = 0
+		err = checkStuckTransactionsConfig(cfg)
+		assert.True(t, errors.Is(err, errInvalidStuckTransactionsConfig))
+		assert.True(t, strings.Contains(err.Error(), "GasPriceBumpPercent"))
+
+		cfg = createMockStuckTransactionsConfig()
+		cfg.MaxGasPrice = 0
+		err = checkStuckTransactionsConfig(cfg)
+		assert.True(t, errors.Is(err, errInvalidStuckTransactionsConfig))
+		assert.True(t, strings.Contains(err.Error(), "MaxGasPrice"))
+	})
+	t.Run("gas price bump values should not be checked if the bump is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockStuckTransactionsConfig()
+		cfg.GasPriceBumpEnabled = false
+		cfg.GasPriceBumpPercent = 0
+		cfg.MaxGasPrice = 0
+		assert.Nil(t, checkStuckTransactionsConfig(cfg))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, checkStuckTransactionsConfig(createMockStuckTransactionsConfig()))
+	})
+}
+
+func TestStuckTransactionsResender_Track(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should not track", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStuckTransactionsResender()
+		args.config.Enabled = false
+		args.proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+
+		resender.track(context.Background(), createMockPendingTransaction(1, 0).tx, "hash")
+		assert.Empty(t, resender.pending)
+	})
+	t.Run("network status error should not track", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStuckTransactionsResender()
+		args.proxy = &interactors.ProxyStub{
+			GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+
+		resender.track(context.Background(), createMockPendingTransaction(1, 0).tx, "hash")
+		assert.Empty(t, resender.pending)
+	})
+	t.Run("should track with the current round", func(t *testing.T) {
+		t.Parallel()
+
+		resender := newStuckTransactionsResender(createMockArgsStuckTransactionsResender())
+
+		resender.track(context.Background(), createMockPendingTransaction(7, 0).tx, "hash")
+		require.Equal(t, 1, len(resender.pending))
+		assert.Equal(t, uint64(100), resender.pending[7].sentAtRound)
+		assert.Equal(t, "hash", resender.pending[7].hash)
+	})
+}
+
+func TestStuckTransactionsResender_GetStuckTransactions(t *testing.T) {
+	t.Parallel()
+
+	resender := newStuckTransactionsResender(createMockArgsStuckTransactionsResender())
+	resender.pending[3] = createMockPendingTransaction(3, 80) // nonce already consumed
+	resender.pending[6] = createMockPendingTransaction(6, 90)
+	resender.pending[4] = createMockPendingTransaction(4, 85)
+	resender.pending[5] = createMockPendingTransaction(5, 95) // not yet stuck
+	resender.pending[7] = createMockPendingTransaction(7, 80)
+	resender.pending[7].numResends = 2 // maximum number of resends reached
+
+	stuckTxs := resender.getStuckTransactions(4, 100)
+	require.Equal(t, 2, len(stuckTxs))
+	assert.Equal(t, uint64(4), stuckTxs[0].tx.Nonce)
+	assert.Equal(t, uint64(6), stuckTxs[1].tx.Nonce)
+
+	assert.Equal(t, 3, len(resender.pending))
+	assert.NotContains(t, resender.pending, uint64(3))
+	assert.NotContains(t, resender.pending, uint64(7))
+}
+
+func TestStuckTransactionsResender_BumpGasPrice(t *testing.T) {
+	t.Parallel()
+
+	resender := newStuckTransactionsResender(createMockArgsStuckTransactionsResender())
+	tx := createMockPendingTransaction(1, 0).tx
+
+	bumpedTx := resender.bumpGasPrice(tx)
+	assert.Equal(t, uint64(1200000000), bumpedTx.GasPrice)
+	assert.Equal(t, uint64(1000000000), tx.GasPrice)
+
+	bumpedTx = resender.bumpGasPrice(bumpedTx)
+	assert.Equal(t, uint64(1440000000), bumpedTx.GasPrice)
+
+	bumpedTx = resender.bumpGasPrice(bumpedTx)
+	assert.Equal(t, uint64(1500000000), bumpedTx.GasPrice)
+
+	sameTx := resender.bumpGasPrice(bumpedTx)
+	assert.True(t, sameTx == bumpedTx)
+}
+
+func TestStuckTransactionsResender_Resend(t *testing.T) {
+	t.Parallel()
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsStuckTransactionsResender()
+		args.queryExecutor = &boolQueryExecutorStub{
+			executeQueryReturningBoolCalled: func(ctx context.Context, request *data.VmValueRequest) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+		pendingTx := createMockPendingTransaction(1, 80)
+		resender.pending[1] = pendingTx
+
+		err := resender.resend(context.Background(), pendingTx, 100)
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, resender.pending[1] == pendingTx)
+	})
+	t.Run("should query with the transaction arguments", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStuckTransactionsResender()
+		args.queryExecutor = &boolQueryExecutorStub{
+			executeQueryReturningBoolCalled: func(ctx context.Context, request *data.VmValueRequest) (bool, error) {
+				assert.Equal(t, testMultisigAddress, request.Address)
+				assert.Equal(t, wasActionExecutedFuncName, request.FuncName)
+				assert.Equal(t, relayerAddress, request.CallerAddr)
+				assert.Equal(t, []string{"05"}, request.Args)
+
+				return false, nil
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+
+		err := resender.resend(context.Background(), createMockPendingTransaction(1, 80), 100)
+		assert.Nil(t, err)
+	})
+	t.Run("gas price bump disabled should resend the same transaction", func(t *testing.T) {
+		t.Parallel()
+
+		pendingTx := createMockPendingTransaction(1, 80)
+		args := createMockArgsStuckTransactionsResender()
+		args.config.GasPriceBumpEnabled = false
+		args.signTransaction = func(tx *transaction.FrontendTransaction) error {
+			assert.Fail(t, "should have not been called")
+			return nil
+		}
+		args.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				assert.True(t, tx == pendingTx.tx)
+				return "new hash", nil
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+		resender.pending[1] = pendingTx
+
+		err := resender.resend(context.Background(), pendingTx, 100)
+		assert.Nil(t, err)
+		assert.Equal(t, "new hash", resender.pending[1].hash)
+		assert.Equal(t, uint64(100), resender.pending[1].sentAtRound)
+		assert.Equal(t, 1, resender.pending[1].numResends)
+	})
+	t.Run("should resend with an increased gas price", func(t *testing.T) {
+		t.Parallel()
+
+		pendingTx := createMockPendingTransaction(1, 80)
+		args := createMockArgsStuckTransactionsResender()
+		args.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				assert.Equal(t, uint64(1200000000), tx.GasPrice)
+				assert.Equal(t, "signature", tx.Signature)
+				assert.Equal(t, pendingTx.tx.Data, tx.Data)
+				return "new hash", nil
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+		resender.pending[1] = pendingTx
+
+		err := resender.resend(context.Background(), pendingTx, 100)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1200000000), resender.pending[1].tx.GasPrice)
+	})
+	t.Run("action done by another relayer without gas price bump should stop tracking", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStuckTransactionsResender()
+		args.config.GasPriceBumpEnabled = false
+		args.queryExecutor = &boolQueryExecutorStub{
+			executeQueryReturningBoolCalled: func(ctx context.Context, request *data.VmValueRequest) (bool, error) {
+				return true, nil
+			},
+		}
+		args.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				assert.Fail(t, "should have not been called")
+				return "", nil
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+		pendingTx := createMockPendingTransaction(1, 80)
+		resender.pending[1] = pendingTx
+
+		err := resender.resend(context.Background(), pendingTx, 100)
+		assert.Nil(t, err)
+		assert.Empty(t, resender.pending)
+	})
+	t.Run("action done by another relayer should send a transaction to self", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStuckTransactionsResender()
+		args.queryExecutor = &boolQueryExecutorStub{
+			executeQueryReturningBoolCalled: func(ctx context.Context, request *data.VmValueRequest) (bool, error) {
+				return true, nil
+			},
+		}
+		args.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				assert.Equal(t, relayerAddress, tx.Receiver)
+				assert.Empty(t, tx.Data)
+				assert.Equal(t, uint64(50000), tx.GasLimit)
+				assert.Equal(t, uint64(1200000000), tx.GasPrice)
+				assert.Equal(t, uint64(1), tx.Nonce)
+				return "cancel hash", nil
+			},
+		}
+		resender := newStuckTransactionsResender(args)
+		pendingTx := createMockPendingTransaction(1, 80)
+		resender.pending[1] = pendingTx
+
+		err := resender.resend(context.Background(), pendingTx, 100)
+		assert.Nil(t, err)
+		assert.Equal(t, "cancel hash", resender.pending[1].hash)
+		assert.Equal(t, testMultisigAddress, pendingTx.tx.Receiver)
+
+		// the transaction to self is never reconciled
+		alreadyDone, err := resender.wasActionAlreadyDone(context.Background(), resender.pending[1].tx)
+		assert.Nil(t, err)
+		assert.False(t, alreadyDone)
+	})
+}
@@ -5,20 +5,31 @@ import (
 	"encoding/hex"
 	"encoding/json"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/core"
 )
 
+const relayedTxV2Function = "relayedTxV2"
+
 type transactionHandler struct {
 	proxy                   Proxy
 	relayerAddress          core.AddressHandler
+	multisigAddress         core.AddressHandler
 	multisigAddressAsBech32 string
 	nonceTxHandler          NonceTransactionsHandler
 	relayerPrivateKey       crypto.PrivateKey
 	singleSigner            crypto.SingleSigner
 	roleProvider            roleProvider
+	sentTxsJournal          *sentTransactionsJournal
+	gasUsageTracker         GasUsageTracker
+	stuckTxsResender        *stuckTransactionsResender
+	gasPayerPrivateKey      crypto.PrivateKey
+	gasPayerAddress         core.AddressHandler
+	log                     logger.Logger
 }
 
 // SendTransactionReturnHash will try to assemble a transaction, sign it, send it and, if everything is OK, returns the transaction's hash
@@ -26,12 +37,118 @@ func (txHandler *transactionHandler) SendTransactionReturnHash(ctx context.Conte
 	if !txHandler.roleProvider.IsWhitelisted(txHandler.relayerAddress) {
 		return "", errRelayerNotWhitelisted
 	}
+	if txHandler.hasGasPayer() {
+		return txHandler.sendRelayedTransaction(ctx, builder, gasLimit)
+	}
+
 	tx, err := txHandler.signTransaction(ctx, builder, gasLimit)
 	if err != nil {
 		return "", err
 	}
 
-	return txHandler.nonceTxHandler.SendTransaction(context.Background(), tx)
+	hash, err := txHandler.nonceTxHandler.SendTransaction(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	txHandler.recordSentTransaction(tx, hash)
+	txHandler.stuckTxsResender.track(ctx, tx, hash)
+
+	return hash, nil
+}
+
+// sendRelayedTransaction signs the transaction with the relayer's key and sends it wrapped in a relayed v2
+// transaction paid by the gas payer account
+func (txHandler *transactionHandler) sendRelayedTransaction(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
+	// the inner transaction of a relayed v2 transaction is signed with a 0 gas limit, its gas is provided by the
+	// relayed transaction
+	innerTx, err := txHandler.signTransaction(ctx, builder, 0)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := txHandler.createRelayedTransaction(ctx, innerTx, gasLimit)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := txHandler.nonceTxHandler.SendTransaction(ctx, tx)
+	if err != nil {
+		return "", err
+	}
+
+	// the relayer's nonce and the called function are the ones of the inner transaction
+	txHandler.recordSentTransaction(innerTx, hash)
+
+	return hash, nil
+}
+
+func (txHandler *transactionHandler) createRelayedTransaction(
+	ctx context.Context,
+	innerTx *transaction.FrontendTransaction,
+	gasLimit uint64,
+) (*transaction.FrontendTransaction, error) {
+	networkConfig, err := txHandler.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := hex.DecodeString(innerTx.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	dataBytes, err := builders.NewTxDataBuilder().
+		Function(relayedTxV2Function).
+		ArgAddress(txHandler.multisigAddress).
+		ArgInt64(int64(innerTx.Nonce)).
+		ArgBytes(innerTx.Data).
+		ArgBytes(signature).
+		ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	bech32Address, err := txHandler.gasPayerAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.FrontendTransaction{
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+		GasLimit: networkConfig.MinGasLimit + uint64(len(dataBytes))*networkConfig.GasPerDataByte + gasLimit,
+		Data:     dataBytes,
+		Sender:   bech32Address,
+		Receiver: innerTx.Sender,
+		Value:    "0",
+	}
+
+	err = txHandler.nonceTxHandler.ApplyNonceAndGasPrice(ctx, txHandler.gasPayerAddress, tx)
+	if err != nil {
+		return nil, err
+	}
+	// the relayed and the inner transactions must have the same gas price
+	tx.GasPrice = innerTx.GasPrice
+
+	err = txHandler.signTransactionWithKey(tx, txHandler.gasPayerPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (txHandler *transactionHandler) recordSentTransaction(tx *transaction.FrontendTransaction, hash string) {
+	err := txHandler.sentTxsJournal.record(hash, tx.Nonce, tx.Data)
+	if err != nil {
+		txHandler.log.Warn("transactionHandler: can not record the sent transaction", "hash", hash, "error", err)
+	}
+	txHandler.gasUsageTracker.Track(hash, getFunctionName(tx.Data))
+}
+
+func (txHandler *transactionHandler) hasGasPayer() bool {
+	return !check.IfNil(txHandler.gasPayerPrivateKey)
 }
 
 func (txHandler *transactionHandler) signTransaction(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (*transaction.FrontendTransaction, error) {
@@ -60,7 +177,7 @@ func (txHandler *transactionHandler) signTransaction(ctx context.Context, builde
 		Value:    "0",
 	}
 
-	err = txHandler.nonceTxHandler.ApplyNonceAndGasPrice(context.Background(), txHandler.relayerAddress, tx)
+	err = txHandler.nonceTxHandler.ApplyNonceAndGasPrice(ctx, txHandler.relayerAddress, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -75,13 +192,17 @@ func (txHandler *transactionHandler) signTransaction(ctx context.Context, builde
 
 // signTransactionWithPrivateKey signs a transaction with the client's private key
 func (txHandler *transactionHandler) signTransactionWithPrivateKey(tx *transaction.FrontendTransaction) error {
+	return txHandler.signTransactionWithKey(tx, txHandler.relayerPrivateKey)
+}
+
+func (txHandler *transactionHandler) signTransactionWithKey(tx *transaction.FrontendTransaction, privateKey crypto.PrivateKey) error {
 	tx.Signature = ""
 	bytes, err := json.Marshal(&tx)
 	if err != nil {
 		return err
 	}
 
-	signature, err := txHandler.singleSigner.Sign(txHandler.relayerPrivateKey, bytes)
+	signature, err := txHandler.singleSigner.Sign(privateKey, bytes)
 	if err != nil {
 		return err
 	}
@@ -93,5 +214,7 @@ func (txHandler *transactionHandler) signTransactionWithPrivateKey(tx *transacti
 
 // Close will close any sub-components it uses
 func (txHandler *transactionHandler) Close() error {
+	txHandler.stuckTxsResender.close()
+
 	return txHandler.nonceTxHandler.Close()
 }
@@ -3,9 +3,14 @@ package multiversx
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"math/big"
+	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	cryptoMock "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
@@ -13,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
@@ -30,15 +36,21 @@ func createTransactionHandlerWithMockComponents() *transactionHandler {
 	sk, _ := testKeyGen.PrivateKeyFromByteArray(skBytes)
 	pk := sk.GeneratePublic()
 	pkBytes, _ := pk.ToByteArray()
+	multisigAddress, _ := data.NewAddressFromBech32String(testMultisigAddress)
 
 	return &transactionHandler{
 		proxy:                   &interactors.ProxyStub{},
 		relayerAddress:          data.NewAddressFromBytes(pkBytes),
+		multisigAddress:         multisigAddress,
 		multisigAddressAsBech32: testMultisigAddress,
 		nonceTxHandler:          &bridgeTests.NonceTransactionsHandlerStub{},
 		relayerPrivateKey:       sk,
 		singleSigner:            testSigner,
 		roleProvider:            &roleproviders.MultiversXRoleProviderStub{},
+		sentTxsJournal:          newSentTransactionsJournal(testsCommon.NewStorerMock(), logger.GetOrCreate("test")),
+		gasUsageTracker:         &testsCommon.GasUsageTrackerStub{},
+		stuckTxsResender:        newStuckTransactionsResender(argsStuckTransactionsResender{}),
+		log:                     logger.GetOrCreate("test"),
 	}
 }
 
@@ -167,10 +179,144 @@ func TestTransactionHandler_SendTransactionReturnHash(t *testing.T) {
 			},
 		}
 
+		trackedFunction := ""
+		txHandlerInstance.gasUsageTracker = &testsCommon.GasUsageTrackerStub{
+			TrackCalled: func(hash string, function string) {
+				assert.Equal(t, txHash, hash)
+				trackedFunction = function
+			},
+		}
+
+		hash, err := txHandlerInstance.SendTransactionReturnHash(context.Background(), builder, gasLimit)
+
+		assert.Nil(t, err)
+		assert.Equal(t, txHash, hash)
+		assert.True(t, sendWasCalled)
+		assert.Equal(t, "function", trackedFunction)
+
+		sentTxs := txHandlerInstance.sentTxsJournal.getAll()
+		assert.Equal(t, []*sentTransaction{{Hash: txHash, Nonce: nonce, Function: "function"}}, sentTxs)
+	})
+	t.Run("the provided context should be used for all the outbound calls", func(t *testing.T) {
+		type contextKey string
+		ctx := context.WithValue(context.Background(), contextKey("step"), "sign")
+		checkContext := func(callCtx context.Context) {
+			assert.Equal(t, "sign", callCtx.Value(contextKey("step")))
+		}
+
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		txHandlerInstance.proxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				checkContext(ctx)
+				return &data.NetworkConfig{}, nil
+			},
+		}
+		numCalls := 0
+		txHandlerInstance.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				checkContext(ctx)
+				numCalls++
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				checkContext(ctx)
+				numCalls++
+				return "tx hash", nil
+			},
+		}
+
+		_, err := txHandlerInstance.SendTransactionReturnHash(ctx, builder, gasLimit)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("should work with gas payer", func(t *testing.T) {
+		nonce := uint64(55273)
+		gasPayerNonce := uint64(8812)
+		txHandlerInstance := createTransactionHandlerWithMockComponents()
+		gasPayerSk, _ := testKeyGen.PrivateKeyFromByteArray(bytes.Repeat([]byte{2}, 32))
+		gasPayerPkBytes, _ := gasPayerSk.GeneratePublic().ToByteArray()
+		txHandlerInstance.gasPayerPrivateKey = gasPayerSk
+		txHandlerInstance.gasPayerAddress = data.NewAddressFromBytes(gasPayerPkBytes)
+		gasPayerAddress := getBech32Address(txHandlerInstance.gasPayerAddress)
+		txHash := "tx hash"
+		sendWasCalled := false
+
+		minGasPrice := uint64(12234)
+		minGasLimit := uint64(50000)
+		gasPerDataByte := uint64(1500)
+
+		txHandlerInstance.proxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					ChainID:               "chain ID",
+					MinGasPrice:           minGasPrice,
+					MinGasLimit:           minGasLimit,
+					GasPerDataByte:        gasPerDataByte,
+					MinTransactionVersion: 1,
+				}, nil
+			},
+		}
+
+		innerNonceApplied := false
+		txHandlerInstance.nonceTxHandler = &bridgeTests.NonceTransactionsHandlerStub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				switch getBech32Address(address) {
+				case relayerAddress:
+					innerNonceApplied = true
+					assert.Equal(t, uint64(0), tx.GasLimit)
+					tx.Nonce = nonce
+				case gasPayerAddress:
+					tx.Nonce = gasPayerNonce
+				default:
+					return errors.New("unexpected address to fetch the nonce")
+				}
+				tx.GasPrice = minGasPrice
+
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				sendWasCalled = true
+				assert.Equal(t, gasPayerAddress, tx.Sender)
+				assert.Equal(t, relayerAddress, tx.Receiver)
+				assert.Equal(t, gasPayerNonce, tx.Nonce)
+				assert.Equal(t, "0", tx.Value)
+				assert.Equal(t, minGasPrice, tx.GasPrice)
+				assert.Equal(t, minGasLimit+uint64(len(tx.Data))*gasPerDataByte+gasLimit, tx.GasLimit)
+
+				parts := strings.Split(string(tx.Data), "@")
+				assert.Equal(t, 5, len(parts))
+				assert.Equal(t, relayedTxV2Function, parts[0])
+				assert.Equal(t, hex.EncodeToString(txHandlerInstance.multisigAddress.AddressBytes()), parts[1])
+				assert.Equal(t, hex.EncodeToString(big.NewInt(int64(nonce)).Bytes()), parts[2])
+				assert.Equal(t, hex.EncodeToString([]byte("function@62756666@16")), parts[3])
+
+				signature, _ := hex.DecodeString(tx.Signature)
+				txCopy := *tx
+				txCopy.Signature = ""
+				txBytes, _ := json.Marshal(&txCopy)
+				assert.Nil(t, testSigner.Verify(gasPayerSk.GeneratePublic(), txBytes, signature))
+
+				return txHash, nil
+			},
+		}
+
+		trackedFunction := ""
+		txHandlerInstance.gasUsageTracker = &testsCommon.GasUsageTrackerStub{
+			TrackCalled: func(hash string, function string) {
+				assert.Equal(t, txHash, hash)
+				trackedFunction = function
+			},
+		}
+
 		hash, err := txHandlerInstance.SendTransactionReturnHash(context.Background(), builder, gasLimit)
 
 		assert.Nil(t, err)
 		assert.Equal(t, txHash, hash)
 		assert.True(t, sendWasCalled)
+		assert.True(t, innerNonceApplied)
+		assert.Equal(t, "function", trackedFunction)
+
+		sentTxs := txHandlerInstance.sentTxsJournal.getAll()
+		assert.Equal(t, []*sentTransaction{{Hash: txHash, Nonce: nonce, Function: "function"}}, sentTxs)
 	})
 }
@@ -0,0 +1,64 @@
+package multiversx
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const maxCachedVMQueries = 1000
+
+// vmQueriesCache holds the VM query responses obtained while the multisig's shard was at a given block nonce.
+// All the stored responses are discarded as soon as a different nonce is provided
+type vmQueriesCache struct {
+	mut       sync.Mutex
+	nonce     uint64
+	responses map[string][][]byte
+}
+
+func newVMQueriesCache() *vmQueriesCache {
+	return &vmQueriesCache{
+		responses: make(map[string][][]byte),
+	}
+}
+
+// get returns the cached response for the provided request, if the request was executed at the same block nonce
+func (cache *vmQueriesCache) get(nonce uint64, request *data.VmValueRequest) ([][]byte, bool) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	if cache.nonce != nonce {
+		return nil, false
+	}
+
+	response, found := cache.responses[createVMQueryCacheKey(request)]
+
+	return response, found
+}
+
+// put stores the response for the provided request and block nonce
+func (cache *vmQueriesCache) put(nonce uint64, request *data.VmValueRequest, response [][]byte) {
+	cache.mut.Lock()
+	defer cache.mut.Unlock()
+
+	if nonce < cache.nonce {
+		return
+	}
+	if nonce > cache.nonce || len(cache.responses) >= maxCachedVMQueries {
+		cache.nonce = nonce
+		cache.responses = make(map[string][][]byte)
+	}
+
+	cache.responses[createVMQueryCacheKey(request)] = response
+}
+
+func createVMQueryCacheKey(request *data.VmValueRequest) string {
+	return strings.Join([]string{
+		request.Address,
+		request.FuncName,
+		request.CallerAddr,
+		request.CallValue,
+		strings.Join(request.Args, "@"),
+	}, "|")
+}
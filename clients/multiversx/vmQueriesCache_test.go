@@ -0,0 +1,93 @@
+package multiversx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func createVMRequest(funcName string, args ...string) *data.VmValueRequest {
+	return &data.VmValueRequest{
+		Address:    "address",
+		FuncName:   funcName,
+		CallerAddr: "caller",
+		Args:       args,
+	}
+}
+
+func TestVmQueriesCache_GetPut(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty cache should not find", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newVMQueriesCache()
+		response, found := cache.get(0, createVMRequest("func"))
+		assert.False(t, found)
+		assert.Nil(t, response)
+	})
+	t.Run("same request on the same nonce should find", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newVMQueriesCache()
+		expectedResponse := [][]byte{[]byte("response")}
+		cache.put(10, createVMRequest("func", "arg1"), expectedResponse)
+
+		response, found := cache.get(10, createVMRequest("func", "arg1"))
+		assert.True(t, found)
+		assert.Equal(t, expectedResponse, response)
+	})
+	t.Run("different arguments should not find", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newVMQueriesCache()
+		cache.put(10, createVMRequest("func", "arg1"), [][]byte{[]byte("response")})
+
+		_, found := cache.get(10, createVMRequest("func", "arg2"))
+		assert.False(t, found)
+		_, found = cache.get(10, createVMRequest("func", "arg1", "arg2"))
+		assert.False(t, found)
+		_, found = cache.get(10, createVMRequest("func2", "arg1"))
+		assert.False(t, found)
+	})
+	t.Run("different nonce should not find and should clean the old entries", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newVMQueriesCache()
+		cache.put(10, createVMRequest("func"), [][]byte{[]byte("response")})
+
+		_, found := cache.get(11, createVMRequest("func"))
+		assert.False(t, found)
+
+		cache.put(11, createVMRequest("func2"), [][]byte{[]byte("response2")})
+		_, found = cache.get(10, createVMRequest("func"))
+		assert.False(t, found)
+		assert.Equal(t, 1, len(cache.responses))
+	})
+	t.Run("older nonce should not be stored", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newVMQueriesCache()
+		cache.put(11, createVMRequest("func"), [][]byte{[]byte("response")})
+		cache.put(10, createVMRequest("func2"), [][]byte{[]byte("response2")})
+
+		_, found := cache.get(11, createVMRequest("func2"))
+		assert.False(t, found)
+		_, found = cache.get(11, createVMRequest("func"))
+		assert.True(t, found)
+	})
+	t.Run("full cache should be emptied", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newVMQueriesCache()
+		for i := 0; i < maxCachedVMQueries; i++ {
+			cache.put(10, createVMRequest(fmt.Sprintf("func%d", i)), [][]byte{[]byte("response")})
+		}
+		assert.Equal(t, maxCachedVMQueries, len(cache.responses))
+
+		cache.put(10, createVMRequest("new func"), [][]byte{[]byte("response")})
+		assert.Equal(t, 1, len(cache.responses))
+	})
+}
@@ -0,0 +1,18 @@
+package networkValidator
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilEthereumClient signals that a nil Ethereum client has been provided
+var ErrNilEthereumClient = errors.New("nil Ethereum client")
+
+// ErrNilMultiversXDataGetter signals that a nil MultiversX data getter has been provided
+var ErrNilMultiversXDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrWrongNetwork signals that the configured contracts were not found on the connected networks
+var ErrWrongNetwork = errors.New("wrong network configuration")
+
+// ErrInvalidQuorum signals that the quorum set on a multisig contract can not be reached by its relayers
+var ErrInvalidQuorum = errors.New("invalid quorum")
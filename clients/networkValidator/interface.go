@@ -0,0 +1,27 @@
+package networkValidator
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthereumClient defines the Ethereum operations used to check the configured contracts
+type EthereumClient interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	Quorum(ctx context.Context) (*big.Int, error)
+	GetRelayers(ctx context.Context) ([]common.Address, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXDataGetter defines the MultiversX views queried to check the configured contracts
+type MultiversXDataGetter interface {
+	IsPaused(ctx context.Context) (bool, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetLastMvxBatchID(ctx context.Context) (uint64, error)
+	GetQuorum(ctx context.Context) (uint64, error)
+	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	IsInterfaceNil() bool
+}
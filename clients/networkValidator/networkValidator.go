@@ -0,0 +1,179 @@
+package networkValidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsNetworkValidator represents the DTO struct used in the NewNetworkValidator constructor function
+type ArgsNetworkValidator struct {
+	Log                       logger.Logger
+	EthereumClient            EthereumClient
+	MultiversXDataGetter      MultiversXDataGetter
+	EthereumMultisigAddress   common.Address
+	EthereumSafeAddress       common.Address
+	MultiversXMultisigAddress string
+	MultiversXSafeAddress     string
+}
+
+type networkValidator struct {
+	log                       logger.Logger
+	ethereumClient            EthereumClient
+	multiversXDataGetter      MultiversXDataGetter
+	ethereumMultisigAddress   common.Address
+	ethereumSafeAddress       common.Address
+	multiversXMultisigAddress string
+	multiversXSafeAddress     string
+}
+
+// NewNetworkValidator creates a new instance of type networkValidator
+func NewNetworkValidator(args ArgsNetworkValidator) (*networkValidator, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.EthereumClient) {
+		return nil, ErrNilEthereumClient
+	}
+	if check.IfNil(args.MultiversXDataGetter) {
+		return nil, ErrNilMultiversXDataGetter
+	}
+
+	return &networkValidator{
+		log:                       args.Log,
+		ethereumClient:            args.EthereumClient,
+		multiversXDataGetter:      args.MultiversXDataGetter,
+		ethereumMultisigAddress:   args.EthereumMultisigAddress,
+		ethereumSafeAddress:       args.EthereumSafeAddress,
+		multiversXMultisigAddress: args.MultiversXMultisigAddress,
+		multiversXSafeAddress:     args.MultiversXSafeAddress,
+	}, nil
+}
+
+// Validate returns an error explaining the mismatch if the configured contracts do not exist on the connected
+// Ethereum network or do not respond to the expected views on the connected MultiversX network. It also errors if the
+// quorum of a multisig contract can not be reached by the relayers of the same chain
+func (validator *networkValidator) Validate(ctx context.Context) error {
+	err := validator.validateEthereum(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = validator.validateMultiversX(ctx)
+	if err != nil {
+		return err
+	}
+
+	validator.log.Info("the configured contracts were found on the connected networks")
+
+	return validator.validateQuorums(ctx)
+}
+
+// validateQuorums checks, for each chain, that the quorum set on the multisig contract can be reached by the relayers
+// whitelisted on the same chain. The two quorums are checked independently as they are not required to be equal
+func (validator *networkValidator) validateQuorums(ctx context.Context) error {
+	ethereumQuorum, err := validator.ethereumClient.Quorum(ctx)
+	if err != nil {
+		return err
+	}
+	ethereumRelayers, err := validator.ethereumClient.GetRelayers(ctx)
+	if err != nil {
+		return err
+	}
+	err = checkQuorum("Ethereum", ethereumQuorum.Uint64(), len(ethereumRelayers))
+	if err != nil {
+		return err
+	}
+
+	multiversXQuorum, err := validator.multiversXDataGetter.GetQuorum(ctx)
+	if err != nil {
+		return err
+	}
+	multiversXRelayers, err := validator.multiversXDataGetter.GetAllStakedRelayers(ctx)
+	if err != nil {
+		return err
+	}
+	err = checkQuorum("MultiversX", multiversXQuorum, len(multiversXRelayers))
+	if err != nil {
+		return err
+	}
+
+	validator.log.Info("the quorums set on the multisig contracts are reachable",
+		"Ethereum quorum", ethereumQuorum.Uint64(), "Ethereum relayers", len(ethereumRelayers),
+		"MultiversX quorum", multiversXQuorum, "MultiversX relayers", len(multiversXRelayers))
+
+	return nil
+}
+
+func (validator *networkValidator) validateEthereum(ctx context.Context) error {
+	chainID, err := validator.ethereumClient.ChainID(ctx)
+	if err != nil {
+		return err
+	}
+
+	contracts := []struct {
+		configName string
+		address    common.Address
+	}{
+		{"Eth.MultisigContractAddress", validator.ethereumMultisigAddress},
+		{"Eth.SafeContractAddress", validator.ethereumSafeAddress},
+	}
+	for _, contract := range contracts {
+		code, errCode := validator.ethereumClient.CodeAt(ctx, contract.address, nil)
+		if errCode != nil {
+			return errCode
+		}
+		if len(code) == 0 {
+			return fmt.Errorf("%w: there is no contract at the %s %s on the Ethereum network with chain ID %s, "+
+				"check that Eth.NetworkAddress points to the network this configuration was made for",
+				ErrWrongNetwork, contract.configName, contract.address.Hex(), chainID.String())
+		}
+	}
+
+	return nil
+}
+
+func (validator *networkValidator) validateMultiversX(ctx context.Context) error {
+	_, err := validator.multiversXDataGetter.IsPaused(ctx)
+	if err != nil {
+		return createMultiversXError("MultiversX.MultisigContractAddress", validator.multiversXMultisigAddress, "isPaused", err)
+	}
+
+	_, err = validator.multiversXDataGetter.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return createMultiversXError("MultiversX.MultisigContractAddress", validator.multiversXMultisigAddress, "getLastExecutedEthBatchId", err)
+	}
+
+	_, err = validator.multiversXDataGetter.GetLastMvxBatchID(ctx)
+	if err != nil {
+		return createMultiversXError("MultiversX.SafeContractAddress", validator.multiversXSafeAddress, "getLastBatchId", err)
+	}
+
+	return nil
+}
+
+func checkQuorum(chainName string, quorum uint64, numRelayers int) error {
+	if quorum == 0 {
+		return fmt.Errorf("%w: the quorum set on the %s multisig contract is 0", ErrInvalidQuorum, chainName)
+	}
+	if quorum > uint64(numRelayers) {
+		return fmt.Errorf("%w: the quorum set on the %s multisig contract is %d but only %d relayers are whitelisted",
+			ErrInvalidQuorum, chainName, quorum, numRelayers)
+	}
+
+	return nil
+}
+
+func createMultiversXError(configName string, address string, view string, err error) error {
+	return fmt.Errorf("%w: the contract at the %s %s does not respond to the %s view (%s), "+
+		"check that MultiversX.NetworkAddress points to the network this configuration was made for",
+		ErrWrongNetwork, configName, address, view, err.Error())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *networkValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
@@ -0,0 +1,235 @@
+package networkValidator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	ethMultisigAddress = common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	ethSafeAddress     = common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c")
+)
+
+func createMockArgsNetworkValidator() ArgsNetworkValidator {
+	return ArgsNetworkValidator{
+		Log: &testsCommon.LoggerStub{},
+		EthereumClient: &bridgeTests.EthereumClientWrapperStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			CodeAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+				return []byte("code"), nil
+			},
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return make([]common.Address, 3), nil
+			},
+		},
+		MultiversXDataGetter: &bridgeTests.DataGetterStub{
+			GetQuorumCalled: func(ctx context.Context) (uint64, error) {
+				return 3, nil
+			},
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return make([][]byte, 3), nil
+			},
+		},
+		EthereumMultisigAddress:   ethMultisigAddress,
+		EthereumSafeAddress:       ethSafeAddress,
+		MultiversXMultisigAddress: "erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf",
+		MultiversXSafeAddress:     "erd1qqqqqqqqqqqqqpgqtvnswnzxxz8susupesys0hvg7q2z5nawrcjq06qdus",
+	}
+}
+
+func TestNewNetworkValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.Log = nil
+
+		validator, err := NewNetworkValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.EthereumClient = nil
+
+		validator, err := NewNetworkValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("nil MultiversX data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.MultiversXDataGetter = nil
+
+		validator, err := NewNetworkValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.Equal(t, ErrNilMultiversXDataGetter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		validator, err := NewNetworkValidator(createMockArgsNetworkValidator())
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestNetworkValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("Ethereum client errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.EthereumClient = &bridgeTests.EthereumClientWrapperStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			CodeAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("missing Ethereum safe contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.EthereumClient = &bridgeTests.EthereumClientWrapperStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(11155111), nil
+			},
+			CodeAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+				if account == ethSafeAddress {
+					return make([]byte, 0), nil
+				}
+
+				return []byte("code"), nil
+			},
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.True(t, errors.Is(err, ErrWrongNetwork))
+		assert.True(t, strings.Contains(err.Error(), "Eth.SafeContractAddress"))
+		assert.True(t, strings.Contains(err.Error(), ethSafeAddress.Hex()))
+		assert.True(t, strings.Contains(err.Error(), "chain ID 11155111"))
+	})
+	t.Run("MultiversX safe not responding should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.MultiversXDataGetter = &bridgeTests.DataGetterStub{
+			GetLastMvxBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.True(t, errors.Is(err, ErrWrongNetwork))
+		assert.True(t, strings.Contains(err.Error(), "MultiversX.SafeContractAddress"))
+		assert.True(t, strings.Contains(err.Error(), "getLastBatchId"))
+		assert.True(t, strings.Contains(err.Error(), expectedErr.Error()))
+	})
+	t.Run("MultiversX multisig not responding should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		args.MultiversXDataGetter = &bridgeTests.DataGetterStub{
+			IsPausedCalled: func(ctx context.Context) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.True(t, errors.Is(err, ErrWrongNetwork))
+		assert.True(t, strings.Contains(err.Error(), "MultiversX.MultisigContractAddress"))
+	})
+	t.Run("Ethereum quorum of 0 should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		ethClient := args.EthereumClient.(*bridgeTests.EthereumClientWrapperStub)
+		ethClient.QuorumCalled = func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(0), nil
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.True(t, errors.Is(err, ErrInvalidQuorum))
+		assert.True(t, strings.Contains(err.Error(), "Ethereum multisig contract is 0"))
+	})
+	t.Run("Ethereum quorum read error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		ethClient := args.EthereumClient.(*bridgeTests.EthereumClientWrapperStub)
+		ethClient.GetRelayersCalled = func(ctx context.Context) ([]common.Address, error) {
+			return nil, expectedErr
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MultiversX quorum above the number of relayers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		dataGetter := args.MultiversXDataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.GetQuorumCalled = func(ctx context.Context) (uint64, error) {
+			return 4, nil
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.True(t, errors.Is(err, ErrInvalidQuorum))
+		assert.True(t, strings.Contains(err.Error(), "MultiversX multisig contract is 4 but only 3 relayers"))
+	})
+	t.Run("MultiversX quorum read error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNetworkValidator()
+		dataGetter := args.MultiversXDataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.GetQuorumCalled = func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		}
+		validator, _ := NewNetworkValidator(args)
+
+		err := validator.Validate(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		validator, _ := NewNetworkValidator(createMockArgsNetworkValidator())
+
+		err := validator.Validate(context.Background())
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,18 @@
+package operatorPause
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilExecutor signals that a nil executor has been provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrNilPauseChecker signals that a nil pause checker has been provided
+var ErrNilPauseChecker = errors.New("nil pause checker")
+
+// ErrUnknownHalfBridge signals that an unknown half-bridge has been provided
+var ErrUnknownHalfBridge = errors.New("unknown half-bridge")
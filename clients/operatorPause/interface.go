@@ -0,0 +1,15 @@
+package operatorPause
+
+import "context"
+
+// PauseChecker returns the operator pause state of a half-bridge
+type PauseChecker interface {
+	IsHalfBridgePaused(halfBridge string) bool
+	IsInterfaceNil() bool
+}
+
+// Executor defines a component executed periodically by a polling handler
+type Executor interface {
+	Execute(ctx context.Context) error
+	IsInterfaceNil() bool
+}
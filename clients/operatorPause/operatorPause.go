@@ -0,0 +1,139 @@
+package operatorPause
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// halfBridges holds the half-bridges that can be paused, in the order they are reported
+var halfBridges = []string{core.HalfBridgeEthToMvx, core.HalfBridgeMvxToEth}
+
+// ArgsOperatorPause is the DTO used to create a new instance of type operatorPause
+type ArgsOperatorPause struct {
+	Log           logger.Logger
+	StatusHandler core.StatusHandler
+}
+
+type operatorPause struct {
+	log           logger.Logger
+	statusHandler core.StatusHandler
+	getTime       func() int64
+
+	mut    sync.RWMutex
+	pauses map[string]*core.HalfBridgePause
+}
+
+// NewOperatorPause creates the component holding the operator pause state of each half-bridge. A paused half-bridge
+// does not execute any state machine step, so the relayer stops signing and proposing on that direction until it is
+// resumed, without stopping the process. The state is kept in memory, a restart resumes all the half-bridges
+func NewOperatorPause(args ArgsOperatorPause) (*operatorPause, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+
+	pause := &operatorPause{
+		log:           args.Log,
+		statusHandler: args.StatusHandler,
+		getTime: func() int64 {
+			return time.Now().Unix()
+		},
+		pauses: make(map[string]*core.HalfBridgePause),
+	}
+	for _, halfBridge := range halfBridges {
+		pause.pauses[halfBridge] = &core.HalfBridgePause{
+			HalfBridge: halfBridge,
+		}
+	}
+	pause.updateMetricsUnprotected()
+
+	return pause, nil
+}
+
+// PauseHalfBridge suspends the processing of the provided half-bridge. The step in progress, if any, completes
+// before the pause takes effect
+func (pause *operatorPause) PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	return pause.setPaused(halfBridge, true, remoteAddress)
+}
+
+// ResumeHalfBridge resumes the processing of the provided half-bridge, from the step it was paused at
+func (pause *operatorPause) ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	return pause.setPaused(halfBridge, false, remoteAddress)
+}
+
+func (pause *operatorPause) setPaused(halfBridge string, paused bool, remoteAddress string) (*core.HalfBridgePause, error) {
+	pause.mut.Lock()
+	defer pause.mut.Unlock()
+
+	state, found := pause.pauses[halfBridge]
+	if !found {
+		return nil, fmt.Errorf("%w %s, supported half-bridges: %s", ErrUnknownHalfBridge, halfBridge,
+			strings.Join(halfBridges, ", "))
+	}
+
+	state.Paused = paused
+	state.RemoteAddress = remoteAddress
+	state.Timestamp = pause.getTime()
+	pause.updateMetricsUnprotected()
+
+	if paused {
+		pause.log.Warn("half-bridge paused by an operator", "half-bridge", halfBridge, "remote address", remoteAddress)
+	} else {
+		pause.log.Info("half-bridge resumed by an operator", "half-bridge", halfBridge, "remote address", remoteAddress)
+	}
+
+	return copyPause(state), nil
+}
+
+func (pause *operatorPause) updateMetricsUnprotected() {
+	paused := make([]string, 0, len(halfBridges))
+	for _, halfBridge := range halfBridges {
+		if pause.pauses[halfBridge].Paused {
+			paused = append(paused, halfBridge)
+		}
+	}
+
+	pause.statusHandler.SetStringMetric(core.MetricOperatorPausedHalfBridges, strings.Join(paused, ","))
+}
+
+// IsHalfBridgePaused returns true if the provided half-bridge was paused by an operator
+func (pause *operatorPause) IsHalfBridgePaused(halfBridge string) bool {
+	pause.mut.RLock()
+	defer pause.mut.RUnlock()
+
+	state, found := pause.pauses[halfBridge]
+
+	return found && state.Paused
+}
+
+// GetHalfBridgePauses returns the operator pause state of each half-bridge
+func (pause *operatorPause) GetHalfBridgePauses() []*core.HalfBridgePause {
+	pause.mut.RLock()
+	defer pause.mut.RUnlock()
+
+	pauses := make([]*core.HalfBridgePause, 0, len(halfBridges))
+	for _, halfBridge := range halfBridges {
+		pauses = append(pauses, copyPause(pause.pauses[halfBridge]))
+	}
+
+	return pauses
+}
+
+func copyPause(state *core.HalfBridgePause) *core.HalfBridgePause {
+	stateCopy := *state
+
+	return &stateCopy
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pause *operatorPause) IsInterfaceNil() bool {
+	return pause == nil
+}
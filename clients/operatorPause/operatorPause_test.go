@@ -0,0 +1,108 @@
+package operatorPause
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsOperatorPause() ArgsOperatorPause {
+	return ArgsOperatorPause{
+		Log:           &testsCommon.LoggerStub{},
+		StatusHandler: testsCommon.NewStatusHandlerMock(core.OperatorPauseStatusHandlerName),
+	}
+}
+
+func TestNewOperatorPause(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOperatorPause()
+		args.Log = nil
+		pause, err := NewOperatorPause(args)
+		assert.True(t, check.IfNil(pause))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOperatorPause()
+		args.StatusHandler = nil
+		pause, err := NewOperatorPause(args)
+		assert.True(t, check.IfNil(pause))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pause, err := NewOperatorPause(createMockArgsOperatorPause())
+		assert.False(t, check.IfNil(pause))
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.HalfBridgePause{
+			{HalfBridge: core.HalfBridgeEthToMvx},
+			{HalfBridge: core.HalfBridgeMvxToEth},
+		}, pause.GetHalfBridgePauses())
+	})
+}
+
+func TestOperatorPause_PauseAndResume(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown half-bridge should error", func(t *testing.T) {
+		t.Parallel()
+
+		pause, _ := NewOperatorPause(createMockArgsOperatorPause())
+
+		state, err := pause.PauseHalfBridge("EthereumToMultiversX", "127.0.0.1")
+		assert.Nil(t, state)
+		assert.True(t, errors.Is(err, ErrUnknownHalfBridge))
+
+		state, err = pause.ResumeHalfBridge("", "127.0.0.1")
+		assert.Nil(t, state)
+		assert.True(t, errors.Is(err, ErrUnknownHalfBridge))
+		assert.False(t, pause.IsHalfBridgePaused("EthereumToMultiversX"))
+	})
+	t.Run("should pause and resume each half-bridge separately", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsOperatorPause()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.OperatorPauseStatusHandlerName)
+		args.StatusHandler = statusHandler
+		pause, _ := NewOperatorPause(args)
+		pause.getTime = func() int64 {
+			return 1000
+		}
+
+		state, err := pause.PauseHalfBridge(core.HalfBridgeMvxToEth, "127.0.0.1")
+		assert.Nil(t, err)
+		assert.Equal(t, &core.HalfBridgePause{
+			HalfBridge:    core.HalfBridgeMvxToEth,
+			Paused:        true,
+			RemoteAddress: "127.0.0.1",
+			Timestamp:     1000,
+		}, state)
+		assert.True(t, pause.IsHalfBridgePaused(core.HalfBridgeMvxToEth))
+		assert.False(t, pause.IsHalfBridgePaused(core.HalfBridgeEthToMvx))
+		assert.Equal(t, core.HalfBridgeMvxToEth, statusHandler.GetStringMetric(core.MetricOperatorPausedHalfBridges))
+
+		_, _ = pause.PauseHalfBridge(core.HalfBridgeEthToMvx, "127.0.0.2")
+		assert.Equal(t, "ethToMvx,mvxToEth", statusHandler.GetStringMetric(core.MetricOperatorPausedHalfBridges))
+
+		state, err = pause.ResumeHalfBridge(core.HalfBridgeMvxToEth, "127.0.0.3")
+		assert.Nil(t, err)
+		assert.False(t, state.Paused)
+		assert.Equal(t, "127.0.0.3", state.RemoteAddress)
+		assert.False(t, pause.IsHalfBridgePaused(core.HalfBridgeMvxToEth))
+		assert.True(t, pause.IsHalfBridgePaused(core.HalfBridgeEthToMvx))
+		assert.Equal(t, core.HalfBridgeEthToMvx, statusHandler.GetStringMetric(core.MetricOperatorPausedHalfBridges))
+
+		state.Paused = true
+		assert.False(t, pause.IsHalfBridgePaused(core.HalfBridgeMvxToEth))
+	})
+}
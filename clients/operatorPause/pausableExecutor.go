@@ -0,0 +1,73 @@
+package operatorPause
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsPausableExecutor is the DTO used to create a new pausable executor
+type ArgsPausableExecutor struct {
+	Executor     Executor
+	PauseChecker PauseChecker
+	HalfBridge   string
+	Log          logger.Logger
+}
+
+type pausableExecutor struct {
+	executor     Executor
+	pauseChecker PauseChecker
+	halfBridge   string
+	log          logger.Logger
+}
+
+// NewPausableExecutor creates an executor wrapper that skips the executions while its half-bridge is paused by an
+// operator. Wrapping a state machine, the pause takes effect at the boundary between two steps
+func NewPausableExecutor(args ArgsPausableExecutor) (*pausableExecutor, error) {
+	if check.IfNil(args.Executor) {
+		return nil, ErrNilExecutor
+	}
+	if check.IfNil(args.PauseChecker) {
+		return nil, ErrNilPauseChecker
+	}
+	if !isKnownHalfBridge(args.HalfBridge) {
+		return nil, fmt.Errorf("%w %s", ErrUnknownHalfBridge, args.HalfBridge)
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+
+	return &pausableExecutor{
+		executor:     args.Executor,
+		pauseChecker: args.PauseChecker,
+		halfBridge:   args.HalfBridge,
+		log:          args.Log,
+	}, nil
+}
+
+func isKnownHalfBridge(halfBridge string) bool {
+	for _, known := range halfBridges {
+		if known == halfBridge {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Execute calls the wrapped executor if its half-bridge is not paused by an operator
+func (executor *pausableExecutor) Execute(ctx context.Context) error {
+	if executor.pauseChecker.IsHalfBridgePaused(executor.halfBridge) {
+		executor.log.Debug("half-bridge paused by an operator, execution skipped", "half-bridge", executor.halfBridge)
+		return nil
+	}
+
+	return executor.executor.Execute(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *pausableExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
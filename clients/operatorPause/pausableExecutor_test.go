@@ -0,0 +1,111 @@
+package operatorPause
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsPausableExecutor() ArgsPausableExecutor {
+	checker, _ := NewOperatorPause(createMockArgsOperatorPause())
+
+	return ArgsPausableExecutor{
+		Executor:     &testsCommon.ExecutorStub{},
+		PauseChecker: checker,
+		HalfBridge:   core.HalfBridgeEthToMvx,
+		Log:          &testsCommon.LoggerStub{},
+	}
+}
+
+func TestNewPausableExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil executor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPausableExecutor()
+		args.Executor = nil
+
+		executor, err := NewPausableExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilExecutor, err)
+	})
+	t.Run("nil pause checker should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPausableExecutor()
+		args.PauseChecker = nil
+
+		executor, err := NewPausableExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilPauseChecker, err)
+	})
+	t.Run("unknown half-bridge should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPausableExecutor()
+		args.HalfBridge = "EthereumToMultiversX"
+
+		executor, err := NewPausableExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.True(t, errors.Is(err, ErrUnknownHalfBridge))
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPausableExecutor()
+		args.Log = nil
+
+		executor, err := NewPausableExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewPausableExecutor(createMockArgsPausableExecutor())
+		assert.False(t, check.IfNil(executor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPausableExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numExecutions := 0
+	checker, _ := NewOperatorPause(createMockArgsOperatorPause())
+	args := createMockArgsPausableExecutor()
+	args.PauseChecker = checker
+	args.Executor = &testsCommon.ExecutorStub{
+		ExecuteCalled: func(ctx context.Context) error {
+			numExecutions++
+			return expectedErr
+		},
+	}
+	executor, _ := NewPausableExecutor(args)
+
+	err := executor.Execute(context.Background())
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numExecutions)
+
+	_, _ = checker.PauseHalfBridge(core.HalfBridgeMvxToEth, "127.0.0.1")
+	err = executor.Execute(context.Background())
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 2, numExecutions)
+
+	_, _ = checker.PauseHalfBridge(core.HalfBridgeEthToMvx, "127.0.0.1")
+	err = executor.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numExecutions)
+
+	_, _ = checker.ResumeHalfBridge(core.HalfBridgeEthToMvx, "127.0.0.1")
+	err = executor.Execute(context.Background())
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 3, numExecutions)
+}
@@ -0,0 +1,31 @@
+package preAgreement
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilBroadcaster signals that a nil broadcaster has been provided
+var ErrNilBroadcaster = errors.New("nil broadcaster")
+
+// ErrNilRelayersProvider signals that a nil relayers provider has been provided
+var ErrNilRelayersProvider = errors.New("nil relayers provider")
+
+// ErrEmptyDirection signals that an empty direction has been provided
+var ErrEmptyDirection = errors.New("empty direction")
+
+// ErrEmptyPublicKey signals that an empty public key has been provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrInvalidTimeout signals that an invalid timeout has been provided
+var ErrInvalidTimeout = errors.New("invalid timeout")
+
+// ErrNilBatch signals that a nil batch has been provided
+var ErrNilBatch = errors.New("nil batch")
+
+// ErrDivergentViews signals that too many relayers answered with a different view of the batch for the majority to
+// be reached
+var ErrDivergentViews = errors.New("divergent views of the batch")
+
+// ErrAgreementNotReached signals that the majority did not agree on the batch before the timeout
+var ErrAgreementNotReached = errors.New("pre-agreement not reached")
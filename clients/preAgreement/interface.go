@@ -0,0 +1,13 @@
+package preAgreement
+
+// Broadcaster defines the component able to send the pre-agreement messages to the other relayers
+type Broadcaster interface {
+	BroadcastPreAgreementMessage(payload []byte)
+	IsInterfaceNil() bool
+}
+
+// RelayersProvider defines the component providing the public keys of the whitelisted relayers
+type RelayersProvider interface {
+	SortedPublicKeys() [][]byte
+	IsInterfaceNil() bool
+}
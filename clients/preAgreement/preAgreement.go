@@ -0,0 +1,304 @@
+package preAgreement
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	requestKind = "request"
+	ackKind     = "ack"
+)
+
+// ArgsPreAgreement is the DTO used to create a new instance of type preAgreement
+type ArgsPreAgreement struct {
+	Log              logger.Logger
+	Direction        string
+	Broadcaster      Broadcaster
+	RelayersProvider RelayersProvider
+	PublicKey        []byte
+	Timeout          time.Duration
+}
+
+type batchView struct {
+	batchID uint64
+	view    string
+}
+
+// agreementRound is the pre-agreement round started by this relayer as leader
+type agreementRound struct {
+	batchView
+	threshold   int
+	numRelayers int
+	agreed      map[string]struct{}
+	divergent   map[string]string
+	finished    bool
+	chDone      chan error
+}
+
+type preAgreement struct {
+	log              logger.Logger
+	direction        string
+	broadcaster      Broadcaster
+	relayersProvider RelayersProvider
+	publicKey        []byte
+	timeout          time.Duration
+
+	mut               sync.Mutex
+	localView         *batchView
+	hasPendingRequest bool
+	pendingBatchID    uint64
+	currentRound      *agreementRound
+}
+
+// NewPreAgreement creates the component running the pre-agreement round of a bridge direction. Before proposing a batch
+// on chain, the leader broadcasts its view of the batch and waits for a majority of the whitelisted relayers to answer
+// with the same view, so the divergent views are caught before any gas is spent
+func NewPreAgreement(args ArgsPreAgreement) (*preAgreement, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preAgreement{
+		log:              args.Log,
+		direction:        args.Direction,
+		broadcaster:      args.Broadcaster,
+		relayersProvider: args.RelayersProvider,
+		publicKey:        args.PublicKey,
+		timeout:          args.Timeout,
+	}, nil
+}
+
+func checkArgs(args ArgsPreAgreement) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Broadcaster) {
+		return ErrNilBroadcaster
+	}
+	if check.IfNil(args.RelayersProvider) {
+		return ErrNilRelayersProvider
+	}
+	if len(args.Direction) == 0 {
+		return ErrEmptyDirection
+	}
+	if len(args.PublicKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if args.Timeout <= 0 {
+		return fmt.Errorf("%w, provided: %v", ErrInvalidTimeout, args.Timeout)
+	}
+
+	return nil
+}
+
+// SetLocalView records this relayer's view of the batch it is about to process, used to answer the leader's
+// pre-agreement requests. A request received before the view was set is answered now
+func (pa *preAgreement) SetLocalView(batch *core.TransferBatch) {
+	view, err := pa.computeView(batch)
+	if err != nil {
+		pa.log.Debug("preAgreement: can not compute the view of the batch", "error", err)
+		return
+	}
+
+	pa.mut.Lock()
+	pa.localView = view
+	shouldAnswer := pa.hasPendingRequest && pa.pendingBatchID == view.batchID
+	if shouldAnswer {
+		pa.hasPendingRequest = false
+	}
+	pa.mut.Unlock()
+
+	if shouldAnswer {
+		pa.broadcast(ackKind, view)
+	}
+}
+
+// RequestAgreement broadcasts this relayer's view of the batch and waits until a majority of the whitelisted relayers,
+// this relayer included, answers with the same view. Returns an error if the majority can not be reached anymore
+// because of the divergent answers or if it was not reached before the timeout
+func (pa *preAgreement) RequestAgreement(ctx context.Context, batch *core.TransferBatch) error {
+	view, err := pa.computeView(batch)
+	if err != nil {
+		return err
+	}
+
+	numRelayers := len(pa.relayersProvider.SortedPublicKeys())
+	round := &agreementRound{
+		batchView:   *view,
+		threshold:   numRelayers/2 + 1,
+		numRelayers: numRelayers,
+		agreed:      map[string]struct{}{string(pa.publicKey): {}},
+		divergent:   make(map[string]string),
+		chDone:      make(chan error, 1),
+	}
+	if len(round.agreed) >= round.threshold {
+		return nil
+	}
+
+	pa.mut.Lock()
+	pa.localView = view
+	pa.currentRound = round
+	pa.mut.Unlock()
+
+	defer func() {
+		pa.mut.Lock()
+		if pa.currentRound == round {
+			pa.currentRound = nil
+		}
+		pa.mut.Unlock()
+	}()
+
+	pa.broadcast(requestKind, view)
+
+	timer := time.NewTimer(pa.timeout)
+	defer timer.Stop()
+
+	select {
+	case err = <-round.chDone:
+	case <-timer.C:
+		pa.mut.Lock()
+		numAgreed := len(round.agreed)
+		pa.mut.Unlock()
+		err = fmt.Errorf("%w for batch ID %d in %v, agreed: %d, required: %d",
+			ErrAgreementNotReached, batch.ID, pa.timeout, numAgreed, round.threshold)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	pa.log.Debug("preAgreement: the majority agreed on the batch", "direction", pa.direction,
+		"batch ID", batch.ID, "view", view.view)
+
+	return nil
+}
+
+func (pa *preAgreement) computeView(batch *core.TransferBatch) (*batchView, error) {
+	if batch == nil {
+		return nil, ErrNilBatch
+	}
+
+	view, err := batch.IdempotencyKey(pa.direction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &batchView{
+		batchID: batch.ID,
+		view:    view,
+	}, nil
+}
+
+// ProcessPreAgreementMessage processes a pre-agreement message sent by another whitelisted relayer
+func (pa *preAgreement) ProcessPreAgreementMessage(msg *core.SignedMessage) {
+	if msg == nil || bytes.Equal(msg.PublicKeyBytes, pa.publicKey) {
+		return
+	}
+
+	message := &core.PreAgreementMessage{}
+	err := json.Unmarshal(msg.Payload, message)
+	if err != nil {
+		pa.log.Debug("preAgreement: can not decode the message", "error", err)
+		return
+	}
+	if message.Direction != pa.direction {
+		return
+	}
+
+	switch message.Kind {
+	case requestKind:
+		pa.processRequest(msg.PublicKeyBytes, message)
+	case ackKind:
+		pa.processAck(msg.PublicKeyBytes, message)
+	default:
+		pa.log.Debug("preAgreement: unknown message kind", "kind", message.Kind)
+	}
+}
+
+func (pa *preAgreement) processRequest(publicKey []byte, message *core.PreAgreementMessage) {
+	pa.mut.Lock()
+	view := pa.localView
+	hasView := view != nil && view.batchID == message.BatchID
+	if !hasView {
+		// the batch was not fetched yet, the request is answered when the view is set
+		pa.hasPendingRequest = true
+		pa.pendingBatchID = message.BatchID
+	}
+	pa.mut.Unlock()
+
+	if !hasView {
+		return
+	}
+	if view.view != message.View {
+		pa.log.Warn("preAgreement: the leader's view of the batch differs from this relayer's view",
+			"direction", pa.direction, "batch ID", message.BatchID, "leader", hex.EncodeToString(publicKey),
+			"leader's view", message.View, "own view", view.view)
+	}
+
+	pa.broadcast(ackKind, view)
+}
+
+func (pa *preAgreement) processAck(publicKey []byte, message *core.PreAgreementMessage) {
+	pa.mut.Lock()
+	defer pa.mut.Unlock()
+
+	round := pa.currentRound
+	if round == nil || round.finished || round.batchID != message.BatchID {
+		return
+	}
+
+	key := string(publicKey)
+	if message.View == round.view {
+		delete(round.divergent, key)
+		round.agreed[key] = struct{}{}
+	} else {
+		delete(round.agreed, key)
+		round.divergent[key] = message.View
+		pa.log.Warn("preAgreement: relayer with a different view of the batch",
+			"direction", pa.direction, "batch ID", message.BatchID, "relayer", hex.EncodeToString(publicKey),
+			"relayer's view", message.View, "own view", round.view)
+	}
+
+	if len(round.agreed) >= round.threshold {
+		round.finished = true
+		round.chDone <- nil
+		return
+	}
+	if len(round.divergent) > round.numRelayers-round.threshold {
+		round.finished = true
+		round.chDone <- fmt.Errorf("%w for batch ID %d, divergent: %d, relayers: %d, required: %d",
+			ErrDivergentViews, message.BatchID, len(round.divergent), round.numRelayers, round.threshold)
+	}
+}
+
+func (pa *preAgreement) broadcast(kind string, view *batchView) {
+	payload, err := json.Marshal(&core.PreAgreementMessage{
+		Kind:      kind,
+		Direction: pa.direction,
+		BatchID:   view.batchID,
+		View:      view.view,
+	})
+	if err != nil {
+		pa.log.Error("preAgreement: can not encode the message", "error", err)
+		return
+	}
+
+	pa.broadcaster.BroadcastPreAgreementMessage(payload)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pa *preAgreement) IsInterfaceNil() bool {
+	return pa == nil
+}
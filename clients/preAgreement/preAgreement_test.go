@@ -0,0 +1,310 @@
+package preAgreement
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDirection = "EthToMultiversX"
+
+var ownPublicKey = []byte("pk0")
+
+func createMockArgsPreAgreement() ArgsPreAgreement {
+	return ArgsPreAgreement{
+		Log:         &testsCommon.LoggerStub{},
+		Direction:   testDirection,
+		Broadcaster: &testsCommon.BroadcasterStub{},
+		RelayersProvider: &testsCommon.BroadcasterStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return [][]byte{ownPublicKey, []byte("pk1"), []byte("pk2")}
+			},
+		},
+		PublicKey: ownPublicKey,
+		Timeout:   time.Second,
+	}
+}
+
+func createTestBatch(amount int64) *core.TransferBatch {
+	return &core.TransferBatch{
+		ID: 7,
+		Deposits: []*core.DepositTransfer{
+			{
+				Nonce:  1,
+				Amount: big.NewInt(amount),
+			},
+		},
+	}
+}
+
+func createSignedMessage(publicKey []byte, kind string, direction string, batch *core.TransferBatch) *core.SignedMessage {
+	view, _ := batch.IdempotencyKey(testDirection)
+	payload, _ := json.Marshal(&core.PreAgreementMessage{
+		Kind:      kind,
+		Direction: direction,
+		BatchID:   batch.ID,
+		View:      view,
+	})
+
+	return &core.SignedMessage{
+		Payload:        payload,
+		PublicKeyBytes: publicKey,
+	}
+}
+
+func decodeMessage(t *testing.T, payload []byte) *core.PreAgreementMessage {
+	message := &core.PreAgreementMessage{}
+	err := json.Unmarshal(payload, message)
+	require.Nil(t, err)
+
+	return message
+}
+
+func TestNewPreAgreement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.Log = nil
+		pa, err := NewPreAgreement(args)
+		assert.True(t, check.IfNil(pa))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil broadcaster should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.Broadcaster = nil
+		pa, err := NewPreAgreement(args)
+		assert.True(t, check.IfNil(pa))
+		assert.Equal(t, ErrNilBroadcaster, err)
+	})
+	t.Run("nil relayers provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.RelayersProvider = nil
+		pa, err := NewPreAgreement(args)
+		assert.True(t, check.IfNil(pa))
+		assert.Equal(t, ErrNilRelayersProvider, err)
+	})
+	t.Run("empty direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.Direction = ""
+		pa, err := NewPreAgreement(args)
+		assert.True(t, check.IfNil(pa))
+		assert.Equal(t, ErrEmptyDirection, err)
+	})
+	t.Run("empty public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.PublicKey = nil
+		pa, err := NewPreAgreement(args)
+		assert.True(t, check.IfNil(pa))
+		assert.Equal(t, ErrEmptyPublicKey, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.Timeout = 0
+		pa, err := NewPreAgreement(args)
+		assert.True(t, check.IfNil(pa))
+		assert.True(t, errors.Is(err, ErrInvalidTimeout))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pa, err := NewPreAgreement(createMockArgsPreAgreement())
+		assert.False(t, check.IfNil(pa))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPreAgreement_RequestAgreement(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		pa, _ := NewPreAgreement(createMockArgsPreAgreement())
+		err := pa.RequestAgreement(context.Background(), nil)
+		assert.Equal(t, ErrNilBatch, err)
+	})
+	t.Run("single relayer should not broadcast", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.RelayersProvider = &testsCommon.BroadcasterStub{
+			SortedPublicKeysCalled: func() [][]byte {
+				return [][]byte{ownPublicKey}
+			},
+		}
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastPreAgreementMessageCalled: func(payload []byte) {
+				assert.Fail(t, "should have not called BroadcastPreAgreementMessage")
+			},
+		}
+		pa, _ := NewPreAgreement(args)
+
+		err := pa.RequestAgreement(context.Background(), createTestBatch(10))
+		assert.Nil(t, err)
+	})
+	t.Run("majority agreeing should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createTestBatch(10)
+		args := createMockArgsPreAgreement()
+		var pa *preAgreement
+		numBroadcasts := 0
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastPreAgreementMessageCalled: func(payload []byte) {
+				numBroadcasts++
+				message := decodeMessage(t, payload)
+				assert.Equal(t, requestKind, message.Kind)
+				assert.Equal(t, testDirection, message.Direction)
+				assert.Equal(t, batch.ID, message.BatchID)
+
+				// a divergent minority does not finish the round, own acks and acks for other directions are ignored
+				pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), ackKind, testDirection, createTestBatch(20)))
+				pa.ProcessPreAgreementMessage(createSignedMessage(ownPublicKey, ackKind, testDirection, batch))
+				pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), ackKind, "other direction", batch))
+				pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk2"), ackKind, testDirection, batch))
+			},
+		}
+		pa, _ = NewPreAgreement(args)
+
+		err := pa.RequestAgreement(context.Background(), batch)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numBroadcasts)
+	})
+	t.Run("divergent majority should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createTestBatch(10)
+		args := createMockArgsPreAgreement()
+		var pa *preAgreement
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastPreAgreementMessageCalled: func(payload []byte) {
+				pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), ackKind, testDirection, createTestBatch(11)))
+				pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk2"), ackKind, testDirection, createTestBatch(12)))
+			},
+		}
+		pa, _ = NewPreAgreement(args)
+
+		err := pa.RequestAgreement(context.Background(), batch)
+		assert.True(t, errors.Is(err, ErrDivergentViews))
+	})
+	t.Run("timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.Timeout = time.Millisecond * 10
+		pa, _ := NewPreAgreement(args)
+
+		err := pa.RequestAgreement(context.Background(), createTestBatch(10))
+		assert.True(t, errors.Is(err, ErrAgreementNotReached))
+		assert.Nil(t, pa.currentRound)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		pa, _ := NewPreAgreement(createMockArgsPreAgreement())
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := pa.RequestAgreement(ctx, createTestBatch(10))
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestPreAgreement_ProcessPreAgreementMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("request after the view was set should be answered", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createTestBatch(10)
+		expectedView, _ := batch.IdempotencyKey(testDirection)
+		sentMessages := make([]*core.PreAgreementMessage, 0)
+		args := createMockArgsPreAgreement()
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastPreAgreementMessageCalled: func(payload []byte) {
+				sentMessages = append(sentMessages, decodeMessage(t, payload))
+			},
+		}
+		pa, _ := NewPreAgreement(args)
+
+		pa.SetLocalView(batch)
+		assert.Empty(t, sentMessages)
+
+		// the leader has a different view, this relayer answers with its own view
+		pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), requestKind, testDirection, createTestBatch(11)))
+		require.Equal(t, 1, len(sentMessages))
+		assert.Equal(t, ackKind, sentMessages[0].Kind)
+		assert.Equal(t, batch.ID, sentMessages[0].BatchID)
+		assert.Equal(t, expectedView, sentMessages[0].View)
+	})
+	t.Run("request before the view was set should be answered when the view is set", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createTestBatch(10)
+		expectedView, _ := batch.IdempotencyKey(testDirection)
+		sentMessages := make([]*core.PreAgreementMessage, 0)
+		args := createMockArgsPreAgreement()
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastPreAgreementMessageCalled: func(payload []byte) {
+				sentMessages = append(sentMessages, decodeMessage(t, payload))
+			},
+		}
+		pa, _ := NewPreAgreement(args)
+
+		pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), requestKind, testDirection, batch))
+		assert.Empty(t, sentMessages)
+
+		otherBatch := createTestBatch(10)
+		otherBatch.ID = 6
+		pa.SetLocalView(otherBatch)
+		assert.Empty(t, sentMessages)
+
+		pa.SetLocalView(batch)
+		require.Equal(t, 1, len(sentMessages))
+		assert.Equal(t, expectedView, sentMessages[0].View)
+
+		// the request is answered once
+		pa.SetLocalView(batch)
+		assert.Equal(t, 1, len(sentMessages))
+	})
+	t.Run("invalid messages should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPreAgreement()
+		args.Broadcaster = &testsCommon.BroadcasterStub{
+			BroadcastPreAgreementMessageCalled: func(payload []byte) {
+				assert.Fail(t, "should have not called BroadcastPreAgreementMessage")
+			},
+		}
+		pa, _ := NewPreAgreement(args)
+		pa.SetLocalView(createTestBatch(10))
+
+		pa.ProcessPreAgreementMessage(nil)
+		pa.ProcessPreAgreementMessage(&core.SignedMessage{Payload: []byte("gibberish"), PublicKeyBytes: []byte("pk1")})
+		pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), "unknown", testDirection, createTestBatch(10)))
+		pa.ProcessPreAgreementMessage(createSignedMessage([]byte("pk1"), requestKind, "other direction", createTestBatch(10)))
+		pa.ProcessPreAgreementMessage(createSignedMessage(ownPublicKey, requestKind, testDirection, createTestBatch(10)))
+	})
+}
@@ -0,0 +1,12 @@
+package recipientAllowlist
+
+import "errors"
+
+// ErrEmptyRecipientsList signals that an empty recipients list has been provided
+var ErrEmptyRecipientsList = errors.New("empty recipients list")
+
+// ErrInvalidRecipient signals that an invalid recipient address has been provided
+var ErrInvalidRecipient = errors.New("invalid recipient")
+
+// ErrInvalidDirection signals that an invalid direction has been provided
+var ErrInvalidDirection = errors.New("invalid direction")
@@ -0,0 +1,74 @@
+package recipientAllowlist
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsRecipientAllowlist represents the DTO struct used in the NewRecipientAllowlist constructor function
+type ArgsRecipientAllowlist struct {
+	Direction  batchProcessor.Direction
+	Recipients []string
+}
+
+type recipientAllowlist struct {
+	recipients map[string]struct{}
+}
+
+// NewRecipientAllowlist creates a new allowlist of the recipients that can receive the bridged transfers in the
+// provided direction. The recipients are Ethereum hex addresses for the transfers from MultiversX and bech32
+// addresses for the transfers to MultiversX
+func NewRecipientAllowlist(args ArgsRecipientAllowlist) (*recipientAllowlist, error) {
+	if len(args.Recipients) == 0 {
+		return nil, fmt.Errorf("%w for direction %s", ErrEmptyRecipientsList, args.Direction)
+	}
+
+	allowlist := &recipientAllowlist{
+		recipients: make(map[string]struct{}, len(args.Recipients)),
+	}
+	for _, recipient := range args.Recipients {
+		recipientBytes, err := decodeRecipient(recipient, args.Direction)
+		if err != nil {
+			return nil, err
+		}
+
+		allowlist.recipients[string(recipientBytes)] = struct{}{}
+	}
+
+	return allowlist, nil
+}
+
+func decodeRecipient(recipient string, direction batchProcessor.Direction) ([]byte, error) {
+	switch direction {
+	case batchProcessor.FromMultiversX:
+		if !common.IsHexAddress(recipient) {
+			return nil, fmt.Errorf("%w: %s is not an Ethereum address", ErrInvalidRecipient, recipient)
+		}
+
+		return common.HexToAddress(recipient).Bytes(), nil
+	case batchProcessor.ToMultiversX:
+		address, err := data.NewAddressFromBech32String(recipient)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s is not a MultiversX address: %s", ErrInvalidRecipient, recipient, err.Error())
+		}
+
+		return address.AddressBytes(), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDirection, direction)
+	}
+}
+
+// IsAllowed returns true if the provided recipient can receive bridged transfers
+func (allowlist *recipientAllowlist) IsAllowed(recipient []byte) bool {
+	_, found := allowlist.recipients[string(recipient)]
+
+	return found
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (allowlist *recipientAllowlist) IsInterfaceNil() bool {
+	return allowlist == nil
+}
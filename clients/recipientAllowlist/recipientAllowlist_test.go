@@ -0,0 +1,100 @@
+package recipientAllowlist
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	ethRecipient = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	mvxRecipient = "erd132yw8ht5p8cetl2jmvknewjawt9xwzdlrk2pyxlnwjyqrdq0dawqvjzv73"
+)
+
+func TestNewRecipientAllowlist(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty recipients should error", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, err := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction: batchProcessor.FromMultiversX,
+		})
+		assert.True(t, check.IfNil(allowlist))
+		assert.True(t, errors.Is(err, ErrEmptyRecipientsList))
+	})
+	t.Run("invalid Ethereum recipient should error", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, err := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction:  batchProcessor.FromMultiversX,
+			Recipients: []string{ethRecipient, mvxRecipient},
+		})
+		assert.True(t, check.IfNil(allowlist))
+		assert.True(t, errors.Is(err, ErrInvalidRecipient))
+	})
+	t.Run("invalid MultiversX recipient should error", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, err := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction:  batchProcessor.ToMultiversX,
+			Recipients: []string{ethRecipient},
+		})
+		assert.True(t, check.IfNil(allowlist))
+		assert.True(t, errors.Is(err, ErrInvalidRecipient))
+	})
+	t.Run("invalid direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, err := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction:  "direction",
+			Recipients: []string{ethRecipient},
+		})
+		assert.True(t, check.IfNil(allowlist))
+		assert.True(t, errors.Is(err, ErrInvalidDirection))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, err := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction:  batchProcessor.FromMultiversX,
+			Recipients: []string{ethRecipient},
+		})
+		assert.False(t, check.IfNil(allowlist))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRecipientAllowlist_IsAllowed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ethereum recipients", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, _ := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction:  batchProcessor.FromMultiversX,
+			Recipients: []string{ethRecipient},
+		})
+
+		assert.True(t, allowlist.IsAllowed(common.HexToAddress(ethRecipient).Bytes()))
+		assert.False(t, allowlist.IsAllowed(common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c").Bytes()))
+		assert.False(t, allowlist.IsAllowed(nil))
+	})
+	t.Run("MultiversX recipients", func(t *testing.T) {
+		t.Parallel()
+
+		allowlist, _ := NewRecipientAllowlist(ArgsRecipientAllowlist{
+			Direction:  batchProcessor.ToMultiversX,
+			Recipients: []string{mvxRecipient},
+		})
+
+		address, _ := data.NewAddressFromBech32String(mvxRecipient)
+		assert.True(t, allowlist.IsAllowed(address.AddressBytes()))
+		assert.False(t, allowlist.IsAllowed(make([]byte, 32)))
+	})
+}
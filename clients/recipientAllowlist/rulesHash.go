@@ -0,0 +1,83 @@
+package recipientAllowlist
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+)
+
+// recipientRules is the normalized form of the recipients configuration, marshalled to compute the rules hash. The
+// addresses are hex encoded and sorted so equivalent configurations produce the same hash
+type recipientRules struct {
+	AllowlistEnabled     bool     `json:"allowlistEnabled"`
+	EthereumRecipients   []string `json:"ethereumRecipients"`
+	MultiversXRecipients []string `json:"multiversXRecipients"`
+	ValidationEnabled    bool     `json:"validationEnabled"`
+	EthereumDenylist     []string `json:"ethereumDenylist"`
+	NumShards            uint32   `json:"numShards"`
+}
+
+// RulesHash returns the hash of the rules deciding which deposits are rejected instead of being executed: the transfer
+// allowlist and the recipients validation. The rejected deposits are removed from the signed batches, so all the
+// relayers should use the same rules. Empty when both the allowlist and the validation are disabled
+func RulesHash(allowlistConfig config.TransferAllowlistConfig, validationConfig config.RecipientValidationConfig) ([]byte, error) {
+	if !allowlistConfig.Enabled && !validationConfig.Enabled {
+		return make([]byte, 0), nil
+	}
+
+	rules := &recipientRules{
+		AllowlistEnabled:     allowlistConfig.Enabled,
+		EthereumRecipients:   make([]string, 0),
+		MultiversXRecipients: make([]string, 0),
+		ValidationEnabled:    validationConfig.Enabled,
+		EthereumDenylist:     make([]string, 0),
+	}
+
+	var err error
+	if allowlistConfig.Enabled {
+		rules.EthereumRecipients, err = normalizeRecipients(allowlistConfig.EthereumRecipients, batchProcessor.FromMultiversX)
+		if err != nil {
+			return nil, err
+		}
+		rules.MultiversXRecipients, err = normalizeRecipients(allowlistConfig.MultiversXRecipients, batchProcessor.ToMultiversX)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if validationConfig.Enabled {
+		// the denylist entries are checked by the recipients validator, here they are only normalized
+		for _, entry := range validationConfig.EthereumDenylist {
+			rules.EthereumDenylist = append(rules.EthereumDenylist, hex.EncodeToString(common.HexToAddress(entry).Bytes()))
+		}
+		sort.Strings(rules.EthereumDenylist)
+		rules.NumShards = validationConfig.NumShards
+	}
+
+	buff, err := json.Marshal(rules)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(buff)
+
+	return hash[:], nil
+}
+
+func normalizeRecipients(recipients []string, direction batchProcessor.Direction) ([]string, error) {
+	normalized := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		recipientBytes, err := decodeRecipient(recipient, direction)
+		if err != nil {
+			return nil, err
+		}
+
+		normalized = append(normalized, hex.EncodeToString(recipientBytes))
+	}
+	sort.Strings(normalized)
+
+	return normalized, nil
+}
@@ -0,0 +1,72 @@
+package recipientAllowlist
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulesHash(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled rules should return empty hash", func(t *testing.T) {
+		t.Parallel()
+
+		hash, err := RulesHash(config.TransferAllowlistConfig{}, config.RecipientValidationConfig{})
+		assert.Nil(t, err)
+		assert.Empty(t, hash)
+	})
+	t.Run("invalid recipient should error", func(t *testing.T) {
+		t.Parallel()
+
+		hash, err := RulesHash(config.TransferAllowlistConfig{
+			Enabled:            true,
+			EthereumRecipients: []string{"not an address"},
+		}, config.RecipientValidationConfig{})
+		assert.Nil(t, hash)
+		assert.True(t, errors.Is(err, ErrInvalidRecipient))
+	})
+	t.Run("equivalent configurations should have the same hash", func(t *testing.T) {
+		t.Parallel()
+
+		otherEthRecipient := "0x0000000000000000000000000000000000000011"
+		hash, err := RulesHash(config.TransferAllowlistConfig{
+			Enabled:              true,
+			EthereumRecipients:   []string{ethRecipient, otherEthRecipient},
+			MultiversXRecipients: []string{mvxRecipient},
+		}, config.RecipientValidationConfig{
+			Enabled:          true,
+			EthereumDenylist: []string{otherEthRecipient},
+			NumShards:        3,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, hash, 32)
+
+		equivalentHash, err := RulesHash(config.TransferAllowlistConfig{
+			Enabled:              true,
+			EthereumRecipients:   []string{otherEthRecipient, strings.ToLower(ethRecipient)},
+			MultiversXRecipients: []string{mvxRecipient},
+		}, config.RecipientValidationConfig{
+			Enabled:          true,
+			EthereumDenylist: []string{strings.ToUpper(otherEthRecipient[2:])},
+			NumShards:        3,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, hash, equivalentHash)
+
+		differentHash, err := RulesHash(config.TransferAllowlistConfig{
+			Enabled:              true,
+			EthereumRecipients:   []string{ethRecipient, otherEthRecipient},
+			MultiversXRecipients: []string{mvxRecipient},
+		}, config.RecipientValidationConfig{
+			Enabled:          true,
+			EthereumDenylist: []string{otherEthRecipient},
+			NumShards:        2,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, hash, differentHash)
+	})
+}
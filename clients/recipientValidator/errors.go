@@ -0,0 +1,34 @@
+package recipientValidator
+
+import "errors"
+
+// ErrInvalidDirection signals that an invalid direction has been provided
+var ErrInvalidDirection = errors.New("invalid direction")
+
+// ErrInvalidDenylistEntry signals that an invalid address has been provided in the denylist
+var ErrInvalidDenylistEntry = errors.New("invalid denylist entry")
+
+// ErrInvalidChecksum signals that a mixed-case Ethereum address does not match its EIP-55 checksum
+var ErrInvalidChecksum = errors.New("invalid EIP-55 checksum")
+
+// ErrInvalidBridgeContract signals that an invalid bridge contract address has been provided
+var ErrInvalidBridgeContract = errors.New("invalid bridge contract address")
+
+// ErrInvalidRecipientLength signals that the recipient does not have the address length of the destination chain
+var ErrInvalidRecipientLength = errors.New("invalid recipient length")
+
+// ErrZeroAddressRecipient signals that the recipient is the zero address
+var ErrZeroAddressRecipient = errors.New("zero address recipient")
+
+// ErrDeniedRecipient signals that the recipient is denylisted
+var ErrDeniedRecipient = errors.New("denied recipient")
+
+// ErrInvalidBech32Recipient signals that the recipient can not be encoded as a bech32 address
+var ErrInvalidBech32Recipient = errors.New("invalid bech32 recipient")
+
+// ErrMetachainRecipient signals that the recipient is a metachain address, which can not receive the bridged tokens
+var ErrMetachainRecipient = errors.New("metachain recipient")
+
+// ErrCrossShardContractRecipient signals that the recipient is a smart contract deployed in another shard than the
+// bridge contract
+var ErrCrossShardContractRecipient = errors.New("smart contract recipient in another shard")
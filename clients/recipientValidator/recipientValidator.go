@@ -0,0 +1,167 @@
+package recipientValidator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	ethereumAddressLength   = 20
+	multiversXAddressLength = 32
+)
+
+// ArgsRecipientValidator represents the DTO struct used in the NewRecipientValidator constructor function
+type ArgsRecipientValidator struct {
+	Direction batchProcessor.Direction
+
+	// EthereumDenylist and EthereumBridgeContracts are used for the Ethereum recipients of the transfers from
+	// MultiversX. The denylist entries are hex addresses, checked against their EIP-55 checksum if written in mixed case
+	EthereumDenylist        []string
+	EthereumBridgeContracts [][]byte
+
+	// NumShards and MultiversXBridgeContract are used for the MultiversX recipients of the transfers from Ethereum
+	NumShards                uint32
+	MultiversXBridgeContract []byte
+}
+
+type recipientValidator struct {
+	direction        batchProcessor.Direction
+	deniedRecipients map[string]struct{}
+	shardCoordinator sharding.Coordinator
+	bridgeShardID    uint32
+}
+
+// NewRecipientValidator creates the component checking the recipients of the transfers in the provided direction
+// against the address constraints of the destination chain
+func NewRecipientValidator(args ArgsRecipientValidator) (*recipientValidator, error) {
+	validator := &recipientValidator{
+		direction:        args.Direction,
+		deniedRecipients: make(map[string]struct{}),
+	}
+
+	switch args.Direction {
+	case batchProcessor.FromMultiversX:
+		err := validator.createDenylist(args)
+		if err != nil {
+			return nil, err
+		}
+	case batchProcessor.ToMultiversX:
+		if len(args.MultiversXBridgeContract) != multiversXAddressLength {
+			return nil, fmt.Errorf("%w, length %d", ErrInvalidBridgeContract, len(args.MultiversXBridgeContract))
+		}
+
+		var err error
+		validator.shardCoordinator, err = sharding.NewMultiShardCoordinator(args.NumShards, 0)
+		if err != nil {
+			return nil, err
+		}
+		validator.bridgeShardID = validator.shardCoordinator.ComputeId(args.MultiversXBridgeContract)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDirection, args.Direction)
+	}
+
+	return validator, nil
+}
+
+func (validator *recipientValidator) createDenylist(args ArgsRecipientValidator) error {
+	for _, entry := range args.EthereumDenylist {
+		address, err := decodeChecksumAddress(entry)
+		if err != nil {
+			return err
+		}
+
+		validator.deniedRecipients[string(address)] = struct{}{}
+	}
+	for _, contract := range args.EthereumBridgeContracts {
+		if len(contract) != ethereumAddressLength {
+			return fmt.Errorf("%w, length %d", ErrInvalidBridgeContract, len(contract))
+		}
+
+		validator.deniedRecipients[string(contract)] = struct{}{}
+	}
+
+	return nil
+}
+
+// decodeChecksumAddress decodes the provided Ethereum hex address. The addresses written in mixed case should match
+// their EIP-55 checksum, the all lower case and all upper case ones do not carry a checksum
+func decodeChecksumAddress(hexAddress string) ([]byte, error) {
+	if !common.IsHexAddress(hexAddress) {
+		return nil, fmt.Errorf("%w: %s is not an Ethereum address", ErrInvalidDenylistEntry, hexAddress)
+	}
+
+	address := common.HexToAddress(hexAddress)
+	hexDigits := strings.TrimPrefix(strings.TrimPrefix(hexAddress, "0x"), "0X")
+	isMixedCase := strings.ToLower(hexDigits) != hexDigits && strings.ToUpper(hexDigits) != hexDigits
+	if isMixedCase && strings.TrimPrefix(address.Hex(), "0x") != hexDigits {
+		return nil, fmt.Errorf("%w: %s, expected %s", ErrInvalidChecksum, hexAddress, address.Hex())
+	}
+
+	return address.Bytes(), nil
+}
+
+// Validate returns an error if the provided recipient can not receive the bridged transfers on the destination chain
+func (validator *recipientValidator) Validate(recipient []byte) error {
+	if validator.direction == batchProcessor.FromMultiversX {
+		return validator.validateEthereumRecipient(recipient)
+	}
+
+	return validator.validateMultiversXRecipient(recipient)
+}
+
+func (validator *recipientValidator) validateEthereumRecipient(recipient []byte) error {
+	if len(recipient) != ethereumAddressLength {
+		return fmt.Errorf("%w, expected %d, got %d", ErrInvalidRecipientLength, ethereumAddressLength, len(recipient))
+	}
+	if isZeroAddress(recipient) {
+		return ErrZeroAddressRecipient
+	}
+
+	_, isDenied := validator.deniedRecipients[string(recipient)]
+	if isDenied {
+		return fmt.Errorf("%w: %s", ErrDeniedRecipient, common.BytesToAddress(recipient).Hex())
+	}
+
+	return nil
+}
+
+func (validator *recipientValidator) validateMultiversXRecipient(recipient []byte) error {
+	if len(recipient) != multiversXAddressLength {
+		return fmt.Errorf("%w, expected %d, got %d", ErrInvalidRecipientLength, multiversXAddressLength, len(recipient))
+	}
+	if isZeroAddress(recipient) {
+		return ErrZeroAddressRecipient
+	}
+
+	bech32Address, err := data.NewAddressFromBytes(recipient).AddressAsBech32String()
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidBech32Recipient, err.Error())
+	}
+
+	shardID := validator.shardCoordinator.ComputeId(recipient)
+	if shardID == chainCore.MetachainShardId {
+		return fmt.Errorf("%w: %s", ErrMetachainRecipient, bech32Address)
+	}
+	if chainCore.IsSmartContractAddress(recipient) && shardID != validator.bridgeShardID {
+		return fmt.Errorf("%w: %s in shard %d, bridge contract in shard %d", ErrCrossShardContractRecipient,
+			bech32Address, shardID, validator.bridgeShardID)
+	}
+
+	return nil
+}
+
+func isZeroAddress(address []byte) bool {
+	return bytes.Equal(address, make([]byte, len(address)))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (validator *recipientValidator) IsInterfaceNil() bool {
+	return validator == nil
+}
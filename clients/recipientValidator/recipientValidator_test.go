@@ -0,0 +1,162 @@
+package recipientValidator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	checksumAddress      = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+	lowerCaseAddress     = "0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"
+	wrongChecksumAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAeD"
+	ethRecipient         = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	ethBridgeContract    = "0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c"
+)
+
+// createMultiversXAddress returns an address in the shard given by the last byte, for 3 shards
+func createMultiversXAddress(isSmartContract bool, lastByte byte) []byte {
+	address := make([]byte, 32)
+	for i := range address {
+		address[i] = 0x11
+	}
+	if isSmartContract {
+		copy(address, []byte{0, 0, 0, 0, 0, 0, 0, 0, 5, 0})
+	}
+	address[31] = lastByte
+
+	return address
+}
+
+func createMetachainAddress() []byte {
+	address := make([]byte, 32)
+	address[30] = 0xff
+	address[31] = 0xff
+
+	return address
+}
+
+func createMockEthereumArgs() ArgsRecipientValidator {
+	return ArgsRecipientValidator{
+		Direction:               batchProcessor.FromMultiversX,
+		EthereumDenylist:        []string{checksumAddress},
+		EthereumBridgeContracts: [][]byte{common.HexToAddress(ethBridgeContract).Bytes()},
+	}
+}
+
+func createMockMultiversXArgs() ArgsRecipientValidator {
+	return ArgsRecipientValidator{
+		Direction:                batchProcessor.ToMultiversX,
+		NumShards:                3,
+		MultiversXBridgeContract: createMultiversXAddress(true, 1),
+	}
+}
+
+func TestNewRecipientValidator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumArgs()
+		args.Direction = "direction"
+
+		validator, err := NewRecipientValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, ErrInvalidDirection))
+	})
+	t.Run("invalid denylist entry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumArgs()
+		args.EthereumDenylist = []string{"erd1"}
+
+		validator, err := NewRecipientValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, ErrInvalidDenylistEntry))
+	})
+	t.Run("wrong checksum should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumArgs()
+		args.EthereumDenylist = []string{wrongChecksumAddress}
+
+		validator, err := NewRecipientValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, ErrInvalidChecksum))
+	})
+	t.Run("invalid Ethereum bridge contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumArgs()
+		args.EthereumBridgeContracts = [][]byte{[]byte("short")}
+
+		validator, err := NewRecipientValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, ErrInvalidBridgeContract))
+	})
+	t.Run("invalid MultiversX bridge contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockMultiversXArgs()
+		args.MultiversXBridgeContract = nil
+
+		validator, err := NewRecipientValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.True(t, errors.Is(err, ErrInvalidBridgeContract))
+	})
+	t.Run("invalid number of shards should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockMultiversXArgs()
+		args.NumShards = 0
+
+		validator, err := NewRecipientValidator(args)
+		assert.True(t, check.IfNil(validator))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumArgs()
+		args.EthereumDenylist = []string{checksumAddress, lowerCaseAddress, "0x3009D97FFED62E57D444E552A9EDF9EE6BC8644C"}
+		validator, err := NewRecipientValidator(args)
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+
+		validator, err = NewRecipientValidator(createMockMultiversXArgs())
+		assert.False(t, check.IfNil(validator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRecipientValidator_ValidateEthereumRecipient(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := NewRecipientValidator(createMockEthereumArgs())
+
+	assert.Nil(t, validator.Validate(common.HexToAddress(ethRecipient).Bytes()))
+	assert.True(t, errors.Is(validator.Validate([]byte("short")), ErrInvalidRecipientLength))
+	assert.True(t, errors.Is(validator.Validate(make([]byte, 32)), ErrInvalidRecipientLength))
+	assert.Equal(t, ErrZeroAddressRecipient, validator.Validate(make([]byte, 20)))
+	assert.True(t, errors.Is(validator.Validate(common.HexToAddress(lowerCaseAddress).Bytes()), ErrDeniedRecipient))
+	assert.True(t, errors.Is(validator.Validate(common.HexToAddress(ethBridgeContract).Bytes()), ErrDeniedRecipient))
+}
+
+func TestRecipientValidator_ValidateMultiversXRecipient(t *testing.T) {
+	t.Parallel()
+
+	validator, _ := NewRecipientValidator(createMockMultiversXArgs())
+
+	assert.Nil(t, validator.Validate(createMultiversXAddress(false, 1)))
+	assert.Nil(t, validator.Validate(createMultiversXAddress(false, 2)))
+	assert.Nil(t, validator.Validate(createMultiversXAddress(true, 5)))
+	assert.True(t, errors.Is(validator.Validate(make([]byte, 20)), ErrInvalidRecipientLength))
+	assert.Equal(t, ErrZeroAddressRecipient, validator.Validate(make([]byte, 32)))
+	assert.True(t, errors.Is(validator.Validate(createMetachainAddress()), ErrMetachainRecipient))
+	assert.True(t, errors.Is(validator.Validate(createMultiversXAddress(true, 2)), ErrCrossShardContractRecipient))
+}
@@ -0,0 +1,48 @@
+package relayedClaims
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilProxy signals that a nil proxy has been provided
+var ErrNilProxy = errors.New("nil proxy")
+
+// ErrNilNonceTxHandler signals that a nil nonce transaction handler has been provided
+var ErrNilNonceTxHandler = errors.New("nil nonce transaction handler")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilKeyGenerator signals that a nil key generator has been provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrEmptyClaimFunction signals that an empty claim function has been provided
+var ErrEmptyClaimFunction = errors.New("empty claim function")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrNoSponsoredTokens signals that no sponsored token has been provided
+var ErrNoSponsoredTokens = errors.New("no sponsored tokens")
+
+// ErrInvalidClaim signals that the submitted claim transaction is not a valid sponsored claim
+var ErrInvalidClaim = errors.New("invalid claim")
+
+// ErrInvalidSignature signals that the user signature of the claim transaction is not valid
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrFeeTooLow signals that the fee of the claim is lower than the minimum fee of the claimed token
+var ErrFeeTooLow = errors.New("fee too low")
+
+// ErrReplayedClaim signals that the nonce of the claim transaction was already used
+var ErrReplayedClaim = errors.New("replayed claim")
+
+// ErrRateLimitExceeded signals that the user submitted too many claims in the rate limit window
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+// ErrGasPriceMismatch signals that the gas price of the claim transaction differs from the sponsor transaction gas price
+var ErrGasPriceMismatch = errors.New("gas price mismatch")
@@ -0,0 +1,24 @@
+package relayedClaims
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// Proxy defines the MultiversX proxy operations used by the relayed claims handler
+type Proxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
+
+// NonceTransactionsHandler represents the interface able to handle the current nonce and the transactions resend mechanism
+type NonceTransactionsHandler interface {
+	ApplyNonceAndGasPrice(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error
+	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,367 @@
+package relayedClaims
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	relayedTxV2Function  = "relayedTxV2"
+	claimDataSeparator   = "@"
+	numClaimDataElements = 4
+	zeroValue            = "0"
+	minClaimsPerUser     = 1
+)
+
+// ArgsRelayedClaimsHandler represents the DTO struct used in the NewRelayedClaimsHandler constructor function
+type ArgsRelayedClaimsHandler struct {
+	Log                  logger.Logger
+	Proxy                Proxy
+	NonceTxHandler       NonceTransactionsHandler
+	SponsorPrivateKey    crypto.PrivateKey
+	SingleSigner         crypto.SingleSigner
+	KeyGen               crypto.KeyGenerator
+	ClaimContractAddress string
+	ClaimFunction        string
+	ClaimGasLimit        uint64
+	MinimumFees          map[string]*big.Int
+	MaxClaimsPerUser     int
+	RateLimitWindow      time.Duration
+}
+
+type relayedClaimsHandler struct {
+	log                  logger.Logger
+	proxy                Proxy
+	nonceTxHandler       NonceTransactionsHandler
+	sponsorPrivateKey    crypto.PrivateKey
+	sponsorAddress       core.AddressHandler
+	sponsorBech32Address string
+	singleSigner         crypto.SingleSigner
+	keyGen               crypto.KeyGenerator
+	claimContractAddress string
+	claimFunction        string
+	claimGasLimit        uint64
+	minimumFees          map[string]*big.Int
+	claimsTracker        *userClaimsTracker
+}
+
+// NewRelayedClaimsHandler creates a component that wraps the claim transactions signed by the users into relayed
+// transactions paid by the sponsor account. The fee, deducted by the claim contract from the claimed amount and
+// transferred to the sponsor, is part of the data signed by the user
+func NewRelayedClaimsHandler(args ArgsRelayedClaimsHandler) (*relayedClaimsHandler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	claimContractAddress, err := data.NewAddressFromBech32String(args.ClaimContractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the claim contract address %s", err, args.ClaimContractAddress)
+	}
+	claimContractBech32Address, err := claimContractAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := args.SponsorPrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	sponsorAddress := data.NewAddressFromBytes(publicKeyBytes)
+	sponsorBech32Address, err := sponsorAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return &relayedClaimsHandler{
+		log:                  args.Log,
+		proxy:                args.Proxy,
+		nonceTxHandler:       args.NonceTxHandler,
+		sponsorPrivateKey:    args.SponsorPrivateKey,
+		sponsorAddress:       sponsorAddress,
+		sponsorBech32Address: sponsorBech32Address,
+		singleSigner:         args.SingleSigner,
+		keyGen:               args.KeyGen,
+		claimContractAddress: claimContractBech32Address,
+		claimFunction:        args.ClaimFunction,
+		claimGasLimit:        args.ClaimGasLimit,
+		minimumFees:          args.MinimumFees,
+		claimsTracker:        newUserClaimsTracker(args.MaxClaimsPerUser, args.RateLimitWindow),
+	}, nil
+}
+
+func checkArgs(args ArgsRelayedClaimsHandler) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Proxy) {
+		return ErrNilProxy
+	}
+	if check.IfNil(args.NonceTxHandler) {
+		return ErrNilNonceTxHandler
+	}
+	if check.IfNil(args.SponsorPrivateKey) {
+		return ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.KeyGen) {
+		return ErrNilKeyGenerator
+	}
+	if len(args.ClaimFunction) == 0 {
+		return ErrEmptyClaimFunction
+	}
+	if args.ClaimGasLimit == 0 {
+		return fmt.Errorf("%w for ClaimGasLimit, got: %d", ErrInvalidValue, args.ClaimGasLimit)
+	}
+	if len(args.MinimumFees) == 0 {
+		return ErrNoSponsoredTokens
+	}
+	for token, minimumFee := range args.MinimumFees {
+		if minimumFee == nil || minimumFee.Sign() < 0 {
+			return fmt.Errorf("%w for the minimum fee of token %s", ErrInvalidValue, token)
+		}
+	}
+	if args.MaxClaimsPerUser < minClaimsPerUser {
+		return fmt.Errorf("%w for MaxClaimsPerUser, minimum: %d, got: %d", ErrInvalidValue, minClaimsPerUser, args.MaxClaimsPerUser)
+	}
+	if args.RateLimitWindow <= 0 {
+		return fmt.Errorf("%w for RateLimitWindow, got: %v", ErrInvalidValue, args.RateLimitWindow)
+	}
+
+	return nil
+}
+
+// SubmitClaim checks the claim transaction signed by the user, wraps it in a relayed transaction paid by the sponsor
+// account and sends it. The user signs the claim transaction with a 0 gas limit and the network minimum gas price.
+// Returns the hash of the relayed transaction
+func (handler *relayedClaimsHandler) SubmitClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+	if claimTx == nil {
+		return "", fmt.Errorf("%w: nil transaction", ErrInvalidClaim)
+	}
+
+	user, err := data.NewAddressFromBech32String(claimTx.Sender)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s for the sender %s", ErrInvalidClaim, err.Error(), claimTx.Sender)
+	}
+
+	networkConfig, err := handler.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%w while fetching network configs", err)
+	}
+
+	err = handler.checkClaimTransaction(claimTx, networkConfig)
+	if err != nil {
+		return "", err
+	}
+
+	signature, err := handler.checkUserSignature(user, claimTx)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := handler.proxy.GetAccount(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	if claimTx.Nonce < account.Nonce {
+		return "", fmt.Errorf("%w: nonce %d, account nonce %d", ErrReplayedClaim, claimTx.Nonce, account.Nonce)
+	}
+
+	err = handler.claimsTracker.reserve(claimTx.Sender, claimTx.Nonce)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := handler.sendRelayedTransaction(ctx, claimTx, signature, networkConfig)
+	if err != nil {
+		handler.claimsTracker.release(claimTx.Sender, claimTx.Nonce)
+		return "", err
+	}
+
+	handler.log.Info("relayedClaimsHandler: sent relayed claim transaction",
+		"hash", hash, "user", claimTx.Sender, "user nonce", claimTx.Nonce, "data", string(claimTx.Data))
+
+	return hash, nil
+}
+
+func (handler *relayedClaimsHandler) checkClaimTransaction(claimTx *transaction.FrontendTransaction, networkConfig *data.NetworkConfig) error {
+	if claimTx.Receiver != handler.claimContractAddress {
+		return fmt.Errorf("%w: the receiver should be the claim contract %s, got %s",
+			ErrInvalidClaim, handler.claimContractAddress, claimTx.Receiver)
+	}
+	if claimTx.Value != zeroValue {
+		return fmt.Errorf("%w: the value should be %s, got %s", ErrInvalidClaim, zeroValue, claimTx.Value)
+	}
+	if claimTx.GasLimit != 0 {
+		return fmt.Errorf("%w: the gas limit should be 0, got %d", ErrInvalidClaim, claimTx.GasLimit)
+	}
+	if claimTx.GasPrice != networkConfig.MinGasPrice {
+		return fmt.Errorf("%w: the gas price should be %d, got %d", ErrInvalidClaim, networkConfig.MinGasPrice, claimTx.GasPrice)
+	}
+	if claimTx.ChainID != networkConfig.ChainID {
+		return fmt.Errorf("%w: the chain ID should be %s, got %s", ErrInvalidClaim, networkConfig.ChainID, claimTx.ChainID)
+	}
+	if claimTx.Options != 0 {
+		return fmt.Errorf("%w: the transaction options are not supported", ErrInvalidClaim)
+	}
+
+	return handler.checkClaimData(claimTx.Data)
+}
+
+// checkClaimData checks the claim call: <claim function>@<token>@<fee>@<sponsor address>
+func (handler *relayedClaimsHandler) checkClaimData(claimData []byte) error {
+	elements := strings.Split(string(claimData), claimDataSeparator)
+	if len(elements) != numClaimDataElements {
+		return fmt.Errorf("%w: expected %d data elements, got %d", ErrInvalidClaim, numClaimDataElements, len(elements))
+	}
+	if elements[0] != handler.claimFunction {
+		return fmt.Errorf("%w: the function should be %s, got %s", ErrInvalidClaim, handler.claimFunction, elements[0])
+	}
+
+	token, err := hex.DecodeString(elements[1])
+	if err != nil {
+		return fmt.Errorf("%w: %s for the token", ErrInvalidClaim, err.Error())
+	}
+	minimumFee, found := handler.minimumFees[string(token)]
+	if !found {
+		return fmt.Errorf("%w: the claims of token %s are not sponsored", ErrInvalidClaim, token)
+	}
+
+	feeBytes, err := hex.DecodeString(elements[2])
+	if err != nil {
+		return fmt.Errorf("%w: %s for the fee", ErrInvalidClaim, err.Error())
+	}
+	fee := big.NewInt(0).SetBytes(feeBytes)
+	if fee.Cmp(minimumFee) < 0 {
+		return fmt.Errorf("%w for token %s: minimum %s, got %s", ErrFeeTooLow, token, minimumFee.String(), fee.String())
+	}
+
+	feeReceiver, err := hex.DecodeString(elements[3])
+	if err != nil {
+		return fmt.Errorf("%w: %s for the fee receiver", ErrInvalidClaim, err.Error())
+	}
+	feeReceiverBech32, err := data.NewAddressFromBytes(feeReceiver).AddressAsBech32String()
+	if err != nil || feeReceiverBech32 != handler.sponsorBech32Address {
+		return fmt.Errorf("%w: the fee receiver should be the sponsor %s", ErrInvalidClaim, handler.sponsorBech32Address)
+	}
+
+	return nil
+}
+
+func (handler *relayedClaimsHandler) checkUserSignature(user core.AddressHandler, claimTx *transaction.FrontendTransaction) ([]byte, error) {
+	signature, err := hex.DecodeString(claimTx.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	unsignedTx := *claimTx
+	unsignedTx.Signature = ""
+	message, err := json.Marshal(&unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := handler.keyGen.PublicKeyFromByteArray(user.AddressBytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	err = handler.singleSigner.Verify(publicKey, message, signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	return signature, nil
+}
+
+func (handler *relayedClaimsHandler) sendRelayedTransaction(
+	ctx context.Context,
+	claimTx *transaction.FrontendTransaction,
+	signature []byte,
+	networkConfig *data.NetworkConfig,
+) (string, error) {
+	claimContract, err := data.NewAddressFromBech32String(claimTx.Receiver)
+	if err != nil {
+		return "", err
+	}
+
+	dataBytes, err := builders.NewTxDataBuilder().
+		Function(relayedTxV2Function).
+		ArgAddress(claimContract).
+		ArgInt64(int64(claimTx.Nonce)).
+		ArgBytes(claimTx.Data).
+		ArgBytes(signature).
+		ToDataBytes()
+	if err != nil {
+		return "", err
+	}
+
+	tx := &transaction.FrontendTransaction{
+		ChainID:  networkConfig.ChainID,
+		Version:  networkConfig.MinTransactionVersion,
+		GasLimit: networkConfig.MinGasLimit + uint64(len(dataBytes))*networkConfig.GasPerDataByte + handler.claimGasLimit,
+		Data:     dataBytes,
+		Sender:   handler.sponsorBech32Address,
+		Receiver: claimTx.Sender,
+		Value:    zeroValue,
+		GasPrice: claimTx.GasPrice,
+	}
+
+	err = handler.nonceTxHandler.ApplyNonceAndGasPrice(ctx, handler.sponsorAddress, tx)
+	if err != nil {
+		return "", err
+	}
+	if tx.GasPrice != claimTx.GasPrice {
+		// the relayed v2 transactions require the same gas price for the user and the sponsor transactions
+		return "", fmt.Errorf("%w: sponsor %d, user %d", ErrGasPriceMismatch, tx.GasPrice, claimTx.GasPrice)
+	}
+
+	err = handler.signTransaction(tx)
+	if err != nil {
+		return "", err
+	}
+
+	return handler.nonceTxHandler.SendTransaction(ctx, tx)
+}
+
+func (handler *relayedClaimsHandler) signTransaction(tx *transaction.FrontendTransaction) error {
+	tx.Signature = ""
+	bytes, err := json.Marshal(&tx)
+	if err != nil {
+		return err
+	}
+
+	signature, err := handler.singleSigner.Sign(handler.sponsorPrivateKey, bytes)
+	if err != nil {
+		return err
+	}
+
+	tx.Signature = hex.EncodeToString(signature)
+
+	return nil
+}
+
+// Close closes the nonce transaction handler
+func (handler *relayedClaimsHandler) Close() error {
+	return handler.nonceTxHandler.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *relayedClaimsHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,464 @@
+package relayedClaims
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	testCrypto "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testClaimContract = "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"
+	testUser          = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testToken         = "USDC-aabbcc"
+	testChainID       = "T"
+	testMinGasPrice   = uint64(1000000000)
+)
+
+func createMockArgsRelayedClaimsHandler() ArgsRelayedClaimsHandler {
+	return ArgsRelayedClaimsHandler{
+		Log: &testsCommon.LoggerStub{},
+		Proxy: &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					ChainID:               testChainID,
+					MinGasPrice:           testMinGasPrice,
+					MinGasLimit:           50000,
+					GasPerDataByte:        1500,
+					MinTransactionVersion: 1,
+				}, nil
+			},
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Nonce: 3}, nil
+			},
+		},
+		NonceTxHandler: &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				tx.Nonce = 100
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				return "hash", nil
+			},
+		},
+		SponsorPrivateKey:    testCrypto.NewPrivateKeyMock(),
+		SingleSigner:         &testCrypto.SingleSignerStub{},
+		KeyGen:               &testCrypto.KeyGenStub{},
+		ClaimContractAddress: testClaimContract,
+		ClaimFunction:        "claimRefund",
+		ClaimGasLimit:        20000000,
+		MinimumFees: map[string]*big.Int{
+			testToken: big.NewInt(1000),
+		},
+		MaxClaimsPerUser: 2,
+		RateLimitWindow:  time.Minute,
+	}
+}
+
+func getSponsorAddressHex(args ArgsRelayedClaimsHandler) string {
+	publicKeyBytes, _ := args.SponsorPrivateKey.GeneratePublic().ToByteArray()
+
+	return hex.EncodeToString(publicKeyBytes)
+}
+
+func createTestClaimTransaction(args ArgsRelayedClaimsHandler, nonce uint64, fee int64) *transaction.FrontendTransaction {
+	claimData := strings.Join([]string{
+		args.ClaimFunction,
+		hex.EncodeToString([]byte(testToken)),
+		hex.EncodeToString(big.NewInt(fee).Bytes()),
+		getSponsorAddressHex(args),
+	}, "@")
+
+	return &transaction.FrontendTransaction{
+		Nonce:     nonce,
+		Value:     "0",
+		Receiver:  testClaimContract,
+		Sender:    testUser,
+		GasPrice:  testMinGasPrice,
+		Data:      []byte(claimData),
+		Signature: hex.EncodeToString([]byte("user signature")),
+		ChainID:   testChainID,
+		Version:   1,
+	}
+}
+
+func TestNewRelayedClaimsHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.Log = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.Proxy = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil nonce tx handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.NonceTxHandler = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilNonceTxHandler, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.SponsorPrivateKey = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilPrivateKey, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.SingleSigner = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilSingleSigner, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.KeyGen = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilKeyGenerator, err)
+	})
+	t.Run("empty claim function should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.ClaimFunction = ""
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrEmptyClaimFunction, err)
+	})
+	t.Run("invalid claim gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.ClaimGasLimit = 0
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "ClaimGasLimit"))
+	})
+	t.Run("no sponsored tokens should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.MinimumFees = nil
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNoSponsoredTokens, err)
+	})
+	t.Run("invalid minimum fee should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.MinimumFees[testToken] = big.NewInt(-1)
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), testToken))
+	})
+	t.Run("invalid max claims per user should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.MaxClaimsPerUser = 0
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxClaimsPerUser"))
+	})
+	t.Run("invalid rate limit window should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.RateLimitWindow = 0
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "RateLimitWindow"))
+	})
+	t.Run("invalid claim contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.ClaimContractAddress = "invalid"
+
+		handler, err := NewRelayedClaimsHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, strings.Contains(err.Error(), "for the claim contract address invalid"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewRelayedClaimsHandler(createMockArgsRelayedClaimsHandler())
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRelayedClaimsHandler_SubmitClaim(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := NewRelayedClaimsHandler(createMockArgsRelayedClaimsHandler())
+
+		hash, err := handler.SubmitClaim(context.Background(), nil)
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, ErrInvalidClaim))
+	})
+	t.Run("invalid claim transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		testCases := map[string]func(tx *transaction.FrontendTransaction){
+			"for the sender":               func(tx *transaction.FrontendTransaction) { tx.Sender = "invalid" },
+			"should be the claim contract": func(tx *transaction.FrontendTransaction) { tx.Receiver = testUser },
+			"the value should be":          func(tx *transaction.FrontendTransaction) { tx.Value = "1" },
+			"the gas limit should be 0":    func(tx *transaction.FrontendTransaction) { tx.GasLimit = 50000 },
+			"the gas price should be":      func(tx *transaction.FrontendTransaction) { tx.GasPrice++ },
+			"the chain ID should be":       func(tx *transaction.FrontendTransaction) { tx.ChainID = "1" },
+			"options are not supported":    func(tx *transaction.FrontendTransaction) { tx.Options = 1 },
+			"expected 4 data elements":     func(tx *transaction.FrontendTransaction) { tx.Data = []byte("claimRefund") },
+			"the function should be": func(tx *transaction.FrontendTransaction) {
+				tx.Data = []byte(strings.Replace(string(tx.Data), "claimRefund", "claim", 1))
+			},
+			"are not sponsored": func(tx *transaction.FrontendTransaction) {
+				tx.Data = []byte(strings.Replace(string(tx.Data), hex.EncodeToString([]byte(testToken)), "aa", 1))
+			},
+			"the fee receiver should be": func(tx *transaction.FrontendTransaction) {
+				tx.Data = []byte(strings.Replace(string(tx.Data), getSponsorAddressHex(args), "aa", 1))
+			},
+			"encoding/hex: invalid byte: U+": func(tx *transaction.FrontendTransaction) {
+				tx.Data = []byte(strings.Replace(string(tx.Data), "@", "@zz", 1))
+			},
+		}
+		for expectedMessage, modify := range testCases {
+			claimTx := createTestClaimTransaction(args, 3, 1000)
+			modify(claimTx)
+
+			hash, err := handler.SubmitClaim(context.Background(), claimTx)
+			assert.Empty(t, hash)
+			assert.True(t, errors.Is(err, ErrInvalidClaim), expectedMessage)
+			assert.True(t, strings.Contains(err.Error(), expectedMessage), err.Error())
+		}
+	})
+	t.Run("fee too low should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 999))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, ErrFeeTooLow))
+		assert.True(t, strings.Contains(err.Error(), "minimum 1000, got 999"))
+	})
+	t.Run("invalid user signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			VerifyCalled: func(public crypto.PublicKey, msg []byte, sig []byte) error {
+				assert.Equal(t, []byte("user signature"), sig)
+				assert.False(t, strings.Contains(string(msg), hex.EncodeToString([]byte("user signature"))))
+				return expectedErr
+			},
+		}
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, ErrInvalidSignature))
+	})
+	t.Run("already used nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 2, 1000))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, ErrReplayedClaim))
+		assert.True(t, strings.Contains(err.Error(), "account nonce 3"))
+	})
+	t.Run("resubmitted claim should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Equal(t, "hash", hash)
+		assert.Nil(t, err)
+
+		hash, err = handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, ErrReplayedClaim))
+	})
+	t.Run("too many claims should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		_, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Nil(t, err)
+		_, err = handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 4, 1000))
+		assert.Nil(t, err)
+		_, err = handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 5, 1000))
+		assert.True(t, errors.Is(err, ErrRateLimitExceeded))
+	})
+	t.Run("send error should release the nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		numSendCalls := 0
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				numSendCalls++
+				if numSendCalls == 1 {
+					return "", expectedErr
+				}
+
+				return "hash", nil
+			},
+		}
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Empty(t, hash)
+		assert.Equal(t, expectedErr, err)
+
+		hash, err = handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Equal(t, "hash", hash)
+		assert.Nil(t, err)
+	})
+	t.Run("gas price mismatch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				tx.GasPrice = testMinGasPrice * 2
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				assert.Fail(t, "should have not sent the transaction")
+				return "", nil
+			},
+		}
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), createTestClaimTransaction(args, 3, 1000))
+		assert.Empty(t, hash)
+		assert.True(t, errors.Is(err, ErrGasPriceMismatch))
+	})
+	t.Run("should send the relayed transaction", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayedClaimsHandler()
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("sponsor signature"), nil
+			},
+		}
+		claimTx := createTestClaimTransaction(args, 3, 1000)
+		var sentTx *transaction.FrontendTransaction
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				sentTx = tx
+				return "hash", nil
+			},
+		}
+		handler, _ := NewRelayedClaimsHandler(args)
+
+		hash, err := handler.SubmitClaim(context.Background(), claimTx)
+		assert.Equal(t, "hash", hash)
+		assert.Nil(t, err)
+
+		claimContract, _ := data.NewAddressFromBech32String(testClaimContract)
+		expectedData := strings.Join([]string{
+			relayedTxV2Function,
+			hex.EncodeToString(claimContract.AddressBytes()),
+			"03",
+			hex.EncodeToString(claimTx.Data),
+			hex.EncodeToString([]byte("user signature")),
+		}, "@")
+		assert.Equal(t, expectedData, string(sentTx.Data))
+		assert.Equal(t, testUser, sentTx.Receiver)
+		assert.Equal(t, handler.sponsorBech32Address, sentTx.Sender)
+		assert.Equal(t, "0", sentTx.Value)
+		assert.Equal(t, testMinGasPrice, sentTx.GasPrice)
+		assert.Equal(t, testChainID, sentTx.ChainID)
+		expectedGasLimit := 50000 + uint64(len(expectedData))*1500 + args.ClaimGasLimit
+		assert.Equal(t, expectedGasLimit, sentTx.GasLimit)
+		assert.Equal(t, hex.EncodeToString([]byte("sponsor signature")), sentTx.Signature)
+	})
+}
+
+func TestRelayedClaimsHandler_Close(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRelayedClaimsHandler()
+	closeCalled := false
+	args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	handler, _ := NewRelayedClaimsHandler(args)
+
+	assert.Nil(t, handler.Close())
+	assert.True(t, closeCalled)
+}
@@ -0,0 +1,92 @@
+package relayedClaims
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+type userClaims struct {
+	hasLastNonce bool
+	lastNonce    uint64
+	timestamps   []time.Time
+}
+
+// userClaimsTracker keeps, for each user, the last relayed nonce and the times of the claims submitted in the rate
+// limit window. A user without claims in the window is removed as its nonce is then checked against the account nonce
+type userClaimsTracker struct {
+	maxClaims int
+	window    time.Duration
+	getTime   func() time.Time
+
+	mut   sync.Mutex
+	users map[string]*userClaims
+}
+
+func newUserClaimsTracker(maxClaims int, window time.Duration) *userClaimsTracker {
+	return &userClaimsTracker{
+		maxClaims: maxClaims,
+		window:    window,
+		getTime:   time.Now,
+		users:     make(map[string]*userClaims),
+	}
+}
+
+// reserve records the claim of the user if the nonce was not already relayed and the user did not exceed the rate limit
+func (tracker *userClaimsTracker) reserve(user string, nonce uint64) error {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	now := tracker.getTime()
+	tracker.removeExpired(now)
+
+	claims, found := tracker.users[user]
+	if !found {
+		claims = &userClaims{}
+		tracker.users[user] = claims
+	}
+	if claims.hasLastNonce && nonce <= claims.lastNonce {
+		return fmt.Errorf("%w: nonce %d, last relayed nonce %d", ErrReplayedClaim, nonce, claims.lastNonce)
+	}
+	if len(claims.timestamps) >= tracker.maxClaims {
+		return fmt.Errorf("%w: maximum %d claims in %v", ErrRateLimitExceeded, tracker.maxClaims, tracker.window)
+	}
+
+	claims.hasLastNonce = true
+	claims.lastNonce = nonce
+	claims.timestamps = append(claims.timestamps, now)
+
+	return nil
+}
+
+// release reverts the last relayed nonce of the user if the claim could not be sent. The claim still counts for the
+// rate limit
+func (tracker *userClaimsTracker) release(user string, nonce uint64) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	claims, found := tracker.users[user]
+	if !found || !claims.hasLastNonce || claims.lastNonce != nonce {
+		return
+	}
+
+	claims.hasLastNonce = nonce > 0
+	if claims.hasLastNonce {
+		claims.lastNonce = nonce - 1
+	}
+}
+
+// removeExpired removes the claims older than the rate limit window. The mutex should be held
+func (tracker *userClaimsTracker) removeExpired(now time.Time) {
+	for user, claims := range tracker.users {
+		index := 0
+		for index < len(claims.timestamps) && now.Sub(claims.timestamps[index]) >= tracker.window {
+			index++
+		}
+		claims.timestamps = claims.timestamps[index:]
+
+		if len(claims.timestamps) == 0 {
+			delete(tracker.users, user)
+		}
+	}
+}
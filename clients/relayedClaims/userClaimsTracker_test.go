@@ -0,0 +1,67 @@
+package relayedClaims
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserClaimsTracker_Reserve(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should reject the already relayed nonces", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newUserClaimsTracker(10, time.Minute)
+		assert.Nil(t, tracker.reserve("user", 5))
+
+		err := tracker.reserve("user", 5)
+		assert.True(t, errors.Is(err, ErrReplayedClaim))
+		err = tracker.reserve("user", 4)
+		assert.True(t, errors.Is(err, ErrReplayedClaim))
+
+		assert.Nil(t, tracker.reserve("user", 6))
+		assert.Nil(t, tracker.reserve("another user", 0))
+	})
+	t.Run("should apply the rate limit for each user", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		tracker := newUserClaimsTracker(2, time.Minute)
+		tracker.getTime = func() time.Time {
+			return currentTime
+		}
+
+		assert.Nil(t, tracker.reserve("user", 1))
+		assert.Nil(t, tracker.reserve("user", 2))
+		err := tracker.reserve("user", 3)
+		assert.True(t, errors.Is(err, ErrRateLimitExceeded))
+		assert.Nil(t, tracker.reserve("another user", 1))
+
+		currentTime = currentTime.Add(time.Minute)
+		assert.Nil(t, tracker.reserve("user", 3))
+		assert.Len(t, tracker.users, 1)
+	})
+}
+
+func TestUserClaimsTracker_Release(t *testing.T) {
+	t.Parallel()
+
+	tracker := newUserClaimsTracker(10, time.Minute)
+	tracker.release("user", 0)
+	assert.Empty(t, tracker.users)
+
+	assert.Nil(t, tracker.reserve("user", 0))
+	tracker.release("user", 0)
+	assert.Nil(t, tracker.reserve("user", 0))
+
+	assert.Nil(t, tracker.reserve("user", 7))
+	tracker.release("user", 6)
+	assert.True(t, errors.Is(tracker.reserve("user", 7), ErrReplayedClaim))
+
+	tracker.release("user", 7)
+	assert.Nil(t, tracker.reserve("user", 7))
+	assert.Len(t, tracker.users["user"].timestamps, 4)
+}
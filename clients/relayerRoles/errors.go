@@ -0,0 +1,12 @@
+package relayerRoles
+
+import "errors"
+
+// ErrNilEthereumChain signals that a nil Ethereum chain was provided
+var ErrNilEthereumChain = errors.New("nil Ethereum chain")
+
+// ErrNilMultiversXChain signals that a nil MultiversX chain was provided
+var ErrNilMultiversXChain = errors.New("nil MultiversX chain")
+
+// ErrInvalidNumRecentActions signals that an invalid number of recent actions was provided
+var ErrInvalidNumRecentActions = errors.New("invalid number of recent actions")
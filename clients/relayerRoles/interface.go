@@ -0,0 +1,24 @@
+package relayerRoles
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// EthereumChain defines the read-only Ethereum operations used to report the roles of an address
+type EthereumChain interface {
+	GetRelayers(ctx context.Context) ([]common.Address, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXChain defines the read-only MultiversX operations used to report the roles of an address
+type MultiversXChain interface {
+	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	GetAmountStaked(ctx context.Context, relayerAddress []byte) (*big.Int, error)
+	GetActionLastIndex(ctx context.Context) (uint64, error)
+	WasSignedBy(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error)
+	WasExecuted(ctx context.Context, actionID uint64) (bool, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,166 @@
+package relayerRoles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsRolesReporter is the DTO used to create a new roles reporter
+type ArgsRolesReporter struct {
+	EthereumChain    EthereumChain
+	MultiversXChain  MultiversXChain
+	NumRecentActions uint64
+}
+
+// EthereumRoles holds the roles of an address on the Ethereum multisig contract
+type EthereumRoles struct {
+	Address       string `json:"address"`
+	IsWhitelisted bool   `json:"isWhitelisted"`
+	NumRelayers   int    `json:"numRelayers"`
+}
+
+// MultiversXRoles holds the roles and the recent activity of an address on the MultiversX multisig contract
+type MultiversXRoles struct {
+	Address              string            `json:"address"`
+	IsWhitelisted        bool              `json:"isWhitelisted"`
+	NumRelayers          int               `json:"numRelayers"`
+	Stake                *big.Int          `json:"stake"`
+	LastActionID         uint64            `json:"lastActionId"`
+	FirstScannedActionID uint64            `json:"firstScannedActionId"`
+	SignedActions        []*ActionActivity `json:"signedActions"`
+}
+
+// ActionActivity is a MultiversX multisig action signed by the reported address
+type ActionActivity struct {
+	ActionID uint64 `json:"actionId"`
+	Executed bool   `json:"executed"`
+}
+
+type rolesReporter struct {
+	ethereumChain    EthereumChain
+	multiversXChain  MultiversXChain
+	numRecentActions uint64
+}
+
+// NewRolesReporter creates the component reporting, using only read-only queries, the roles an address has on the
+// bridge multisig contracts
+func NewRolesReporter(args ArgsRolesReporter) (*rolesReporter, error) {
+	if check.IfNil(args.EthereumChain) {
+		return nil, ErrNilEthereumChain
+	}
+	if check.IfNil(args.MultiversXChain) {
+		return nil, ErrNilMultiversXChain
+	}
+	if args.NumRecentActions == 0 {
+		return nil, ErrInvalidNumRecentActions
+	}
+
+	return &rolesReporter{
+		ethereumChain:    args.EthereumChain,
+		multiversXChain:  args.MultiversXChain,
+		numRecentActions: args.NumRecentActions,
+	}, nil
+}
+
+// ReportEthereumAddress returns the roles of the address on the Ethereum multisig contract
+func (reporter *rolesReporter) ReportEthereumAddress(ctx context.Context, address common.Address) (*EthereumRoles, error) {
+	relayers, err := reporter.ethereumChain.GetRelayers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the Ethereum relayers", err)
+	}
+
+	roles := &EthereumRoles{
+		Address:     address.String(),
+		NumRelayers: len(relayers),
+	}
+	for _, relayer := range relayers {
+		if relayer == address {
+			roles.IsWhitelisted = true
+			break
+		}
+	}
+
+	return roles, nil
+}
+
+// ReportMultiversXAddress returns the roles of the address on the MultiversX multisig contract along with the
+// recent actions signed by the address. The multisig contract clears the signatures of an action once it is performed,
+// so the reported actions are mostly the pending ones
+func (reporter *rolesReporter) ReportMultiversXAddress(ctx context.Context, address []byte, displayableAddress string) (*MultiversXRoles, error) {
+	relayers, err := reporter.multiversXChain.GetAllStakedRelayers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the MultiversX relayers", err)
+	}
+	stake, err := reporter.multiversXChain.GetAmountStaked(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the stake", err)
+	}
+	lastActionID, err := reporter.multiversXChain.GetActionLastIndex(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the last action ID", err)
+	}
+
+	roles := &MultiversXRoles{
+		Address:       displayableAddress,
+		NumRelayers:   len(relayers),
+		Stake:         stake,
+		LastActionID:  lastActionID,
+		SignedActions: make([]*ActionActivity, 0),
+	}
+	for _, relayer := range relayers {
+		if bytes.Equal(relayer, address) {
+			roles.IsWhitelisted = true
+			break
+		}
+	}
+
+	if lastActionID == 0 {
+		return roles, nil
+	}
+
+	roles.FirstScannedActionID = 1
+	if lastActionID > reporter.numRecentActions {
+		roles.FirstScannedActionID = lastActionID - reporter.numRecentActions + 1
+	}
+	for actionID := lastActionID; actionID >= roles.FirstScannedActionID; actionID-- {
+		activity, errScan := reporter.scanAction(ctx, actionID, address)
+		if errScan != nil {
+			return nil, errScan
+		}
+		if activity != nil {
+			roles.SignedActions = append(roles.SignedActions, activity)
+		}
+	}
+
+	return roles, nil
+}
+
+func (reporter *rolesReporter) scanAction(ctx context.Context, actionID uint64, address []byte) (*ActionActivity, error) {
+	signed, err := reporter.multiversXChain.WasSignedBy(ctx, actionID, address)
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking the signature of the action %d", err, actionID)
+	}
+	if !signed {
+		return nil, nil
+	}
+
+	executed, err := reporter.multiversXChain.WasExecuted(ctx, actionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w while checking the execution of the action %d", err, actionID)
+	}
+
+	return &ActionActivity{
+		ActionID: actionID,
+		Executed: executed,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reporter *rolesReporter) IsInterfaceNil() bool {
+	return reporter == nil
+}
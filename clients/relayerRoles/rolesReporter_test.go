@@ -0,0 +1,227 @@
+package relayerRoles
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	relayerAddress = []byte("relayer address")
+	otherAddress   = []byte("other address")
+	expectedErr    = errors.New("expected error")
+)
+
+func createMockArgsRolesReporter() ArgsRolesReporter {
+	return ArgsRolesReporter{
+		EthereumChain:    &bridgeTests.EthereumClientWrapperStub{},
+		MultiversXChain:  &bridgeTests.DataGetterStub{},
+		NumRecentActions: 5,
+	}
+}
+
+func TestNewRolesReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil Ethereum chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.EthereumChain = nil
+
+		reporter, err := NewRolesReporter(args)
+		assert.Equal(t, ErrNilEthereumChain, err)
+		assert.True(t, check.IfNil(reporter))
+	})
+	t.Run("nil MultiversX chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.MultiversXChain = nil
+
+		reporter, err := NewRolesReporter(args)
+		assert.Equal(t, ErrNilMultiversXChain, err)
+		assert.True(t, check.IfNil(reporter))
+	})
+	t.Run("invalid number of recent actions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.NumRecentActions = 0
+
+		reporter, err := NewRolesReporter(args)
+		assert.Equal(t, ErrInvalidNumRecentActions, err)
+		assert.True(t, check.IfNil(reporter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, err := NewRolesReporter(createMockArgsRolesReporter())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(reporter))
+	})
+}
+
+func TestRolesReporter_ReportEthereumAddress(t *testing.T) {
+	t.Parallel()
+
+	address := common.HexToAddress("0x3fa5b6c5b8c7e3ed1e4e1bc4a2e8e8bd2c0c5b3e")
+	t.Run("get relayers error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.EthereumChain = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return nil, expectedErr
+			},
+		}
+		reporter, _ := NewRolesReporter(args)
+
+		roles, err := reporter.ReportEthereumAddress(context.Background(), address)
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, roles)
+	})
+	t.Run("should report the whitelisting", func(t *testing.T) {
+		t.Parallel()
+
+		relayers := []common.Address{common.HexToAddress("0x01")}
+		args := createMockArgsRolesReporter()
+		args.EthereumChain = &bridgeTests.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return relayers, nil
+			},
+		}
+		reporter, _ := NewRolesReporter(args)
+
+		roles, err := reporter.ReportEthereumAddress(context.Background(), address)
+		require.Nil(t, err)
+		assert.False(t, roles.IsWhitelisted)
+		assert.Equal(t, 1, roles.NumRelayers)
+		assert.Equal(t, address.String(), roles.Address)
+
+		relayers = append(relayers, address)
+		roles, err = reporter.ReportEthereumAddress(context.Background(), address)
+		require.Nil(t, err)
+		assert.True(t, roles.IsWhitelisted)
+		assert.Equal(t, 2, roles.NumRelayers)
+	})
+}
+
+func TestRolesReporter_ReportMultiversXAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get staked relayers error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.MultiversXChain = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		reporter, _ := NewRolesReporter(args)
+
+		roles, err := reporter.ReportMultiversXAddress(context.Background(), relayerAddress, "erd1")
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, roles)
+	})
+	t.Run("get amount staked error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.MultiversXChain = &bridgeTests.DataGetterStub{
+			GetAmountStakedCalled: func(ctx context.Context, relayerAddress []byte) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		reporter, _ := NewRolesReporter(args)
+
+		roles, err := reporter.ReportMultiversXAddress(context.Background(), relayerAddress, "erd1")
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Nil(t, roles)
+	})
+	t.Run("was signed by error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRolesReporter()
+		args.MultiversXChain = &bridgeTests.DataGetterStub{
+			GetActionLastIndexCalled: func(ctx context.Context) (uint64, error) {
+				return 3, nil
+			},
+			WasSignedByCalled: func(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		reporter, _ := NewRolesReporter(args)
+
+		roles, err := reporter.ReportMultiversXAddress(context.Background(), relayerAddress, "erd1")
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Contains(t, err.Error(), "action 3")
+		assert.Nil(t, roles)
+	})
+	t.Run("no actions should work", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, _ := NewRolesReporter(createMockArgsRolesReporter())
+
+		roles, err := reporter.ReportMultiversXAddress(context.Background(), relayerAddress, "erd1")
+		require.Nil(t, err)
+		assert.False(t, roles.IsWhitelisted)
+		assert.Equal(t, uint64(0), roles.LastActionID)
+		assert.Equal(t, uint64(0), roles.FirstScannedActionID)
+		assert.Empty(t, roles.SignedActions)
+	})
+	t.Run("should report the roles and the recent signed actions", func(t *testing.T) {
+		t.Parallel()
+
+		scannedActions := make([]uint64, 0)
+		args := createMockArgsRolesReporter()
+		args.MultiversXChain = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{otherAddress, relayerAddress}, nil
+			},
+			GetAmountStakedCalled: func(ctx context.Context, address []byte) (*big.Int, error) {
+				assert.Equal(t, relayerAddress, address)
+				return big.NewInt(1000), nil
+			},
+			GetActionLastIndexCalled: func(ctx context.Context) (uint64, error) {
+				return 12, nil
+			},
+			WasSignedByCalled: func(ctx context.Context, actionID uint64, address []byte) (bool, error) {
+				assert.Equal(t, relayerAddress, address)
+				scannedActions = append(scannedActions, actionID)
+				return actionID%2 == 0, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return actionID == 10, nil
+			},
+		}
+		reporter, _ := NewRolesReporter(args)
+
+		roles, err := reporter.ReportMultiversXAddress(context.Background(), relayerAddress, "erd1")
+		require.Nil(t, err)
+
+		expectedRoles := &MultiversXRoles{
+			Address:              "erd1",
+			IsWhitelisted:        true,
+			NumRelayers:          2,
+			Stake:                big.NewInt(1000),
+			LastActionID:         12,
+			FirstScannedActionID: 8,
+			SignedActions: []*ActionActivity{
+				{ActionID: 12, Executed: false},
+				{ActionID: 10, Executed: true},
+				{ActionID: 8, Executed: false},
+			},
+		}
+		assert.Equal(t, expectedRoles, roles)
+		assert.Equal(t, []uint64{12, 11, 10, 9, 8}, scannedActions)
+	})
+}
@@ -0,0 +1,18 @@
+package reloadablePolling
+
+import "errors"
+
+// ErrNilExecutor signals that a nil executor has been provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrEmptyName signals that an empty name has been provided
+var ErrEmptyName = errors.New("empty name")
+
+// ErrInvalidPollingInterval signals that an invalid polling interval has been provided
+var ErrInvalidPollingInterval = errors.New("invalid polling interval")
+
+// ErrLoopAlreadyStarted signals that the processing loop was already started
+var ErrLoopAlreadyStarted = errors.New("loop already started")
@@ -0,0 +1,9 @@
+package reloadablePolling
+
+import "context"
+
+// Executor defines a component executed periodically by a polling handler
+type Executor interface {
+	Execute(ctx context.Context) error
+	IsInterfaceNil() bool
+}
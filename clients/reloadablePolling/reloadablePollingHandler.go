@@ -0,0 +1,176 @@
+package reloadablePolling
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const minPollingInterval = time.Millisecond
+
+// ArgsReloadablePollingHandler is the DTO used to create a new reloadable polling handler
+type ArgsReloadablePollingHandler struct {
+	Log              logger.Logger
+	Name             string
+	PollingInterval  time.Duration
+	PollingWhenError time.Duration
+	Executor         Executor
+}
+
+type reloadablePollingHandler struct {
+	log              logger.Logger
+	name             string
+	executor         Executor
+	mut              sync.RWMutex
+	pollingInterval  time.Duration
+	pollingWhenError time.Duration
+	cancelLoop       func()
+	isRunning        bool
+}
+
+// NewReloadablePollingHandler creates a polling handler that calls the executor periodically and whose polling interval
+// can be changed at runtime, see ReloadConfig
+func NewReloadablePollingHandler(args ArgsReloadablePollingHandler) (*reloadablePollingHandler, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reloadablePollingHandler{
+		log:              args.Log,
+		name:             args.Name,
+		executor:         args.Executor,
+		pollingInterval:  args.PollingInterval,
+		pollingWhenError: args.PollingWhenError,
+	}, nil
+}
+
+func checkArgs(args ArgsReloadablePollingHandler) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if len(args.Name) == 0 {
+		return ErrEmptyName
+	}
+	if args.PollingInterval < minPollingInterval {
+		return fmt.Errorf("%w for PollingInterval, got: %v, minimum: %v", ErrInvalidPollingInterval, args.PollingInterval, minPollingInterval)
+	}
+	if args.PollingWhenError < minPollingInterval {
+		return fmt.Errorf("%w for PollingWhenError, got: %v, minimum: %v", ErrInvalidPollingInterval, args.PollingWhenError, minPollingInterval)
+	}
+	if check.IfNil(args.Executor) {
+		return ErrNilExecutor
+	}
+
+	return nil
+}
+
+// StartProcessingLoop starts the processing loop in a new go routine
+func (handler *reloadablePollingHandler) StartProcessingLoop() error {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	if handler.isRunning {
+		return ErrLoopAlreadyStarted
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handler.cancelLoop = cancel
+	handler.isRunning = true
+	go handler.processLoop(ctx)
+
+	return nil
+}
+
+func (handler *reloadablePollingHandler) processLoop(ctx context.Context) {
+	defer handler.setNotRunning()
+
+	for {
+		err := handler.executor.Execute(ctx)
+		interval := handler.getPollingInterval(err != nil)
+		if err != nil {
+			handler.log.Error("reloadablePollingHandler.processLoop", "name", handler.name, "error", err)
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			handler.log.Debug("reloadablePollingHandler: processing loop is closing", "name", handler.name)
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (handler *reloadablePollingHandler) getPollingInterval(isError bool) time.Duration {
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	if isError {
+		return handler.pollingWhenError
+	}
+
+	return handler.pollingInterval
+}
+
+func (handler *reloadablePollingHandler) setNotRunning() {
+	handler.mut.Lock()
+	handler.isRunning = false
+	handler.mut.Unlock()
+}
+
+// ReloadConfig applies the new polling interval of this handler, if the reloaded config contains it. The new interval
+// is used starting with the next wait
+func (handler *reloadablePollingHandler) ReloadConfig(cfg core.ReloadableConfig) error {
+	interval, found := cfg.PollingIntervals[handler.name]
+	if !found {
+		return nil
+	}
+	if interval < minPollingInterval {
+		return fmt.Errorf("%w for the %s polling handler, got: %v, minimum: %v", ErrInvalidPollingInterval, handler.name, interval, minPollingInterval)
+	}
+
+	handler.mut.Lock()
+	oldInterval := handler.pollingInterval
+	handler.pollingInterval = interval
+	handler.mut.Unlock()
+
+	if oldInterval != interval {
+		handler.log.Info("reloadablePollingHandler: polling interval changed", "name", handler.name,
+			"old interval", oldInterval, "new interval", interval)
+	}
+
+	return nil
+}
+
+// IsRunning returns true if the processing loop is running
+func (handler *reloadablePollingHandler) IsRunning() bool {
+	handler.mut.RLock()
+	defer handler.mut.RUnlock()
+
+	return handler.isRunning
+}
+
+// Close stops the processing loop
+func (handler *reloadablePollingHandler) Close() error {
+	handler.mut.RLock()
+	cancel := handler.cancelLoop
+	handler.mut.RUnlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *reloadablePollingHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
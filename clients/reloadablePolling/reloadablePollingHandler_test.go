@@ -0,0 +1,145 @@
+package reloadablePolling
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const handlerName = "test polling handler"
+
+func createMockArgsReloadablePollingHandler() ArgsReloadablePollingHandler {
+	return ArgsReloadablePollingHandler{
+		Log:              &testsCommon.LoggerStub{},
+		Name:             handlerName,
+		PollingInterval:  time.Millisecond * 10,
+		PollingWhenError: time.Millisecond * 10,
+		Executor:         &testsCommon.ExecutorStub{},
+	}
+}
+
+func TestNewReloadablePollingHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReloadablePollingHandler()
+		args.Log = nil
+		handler, err := NewReloadablePollingHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("empty name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReloadablePollingHandler()
+		args.Name = ""
+		handler, err := NewReloadablePollingHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("invalid polling interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReloadablePollingHandler()
+		args.PollingInterval = 0
+		handler, err := NewReloadablePollingHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidPollingInterval))
+	})
+	t.Run("invalid polling when error interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReloadablePollingHandler()
+		args.PollingWhenError = 0
+		handler, err := NewReloadablePollingHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.True(t, errors.Is(err, ErrInvalidPollingInterval))
+	})
+	t.Run("nil executor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsReloadablePollingHandler()
+		args.Executor = nil
+		handler, err := NewReloadablePollingHandler(args)
+		assert.True(t, check.IfNil(handler))
+		assert.Equal(t, ErrNilExecutor, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewReloadablePollingHandler(createMockArgsReloadablePollingHandler())
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+		assert.False(t, handler.IsRunning())
+	})
+}
+
+func TestReloadablePollingHandler_StartProcessingLoop(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+	args := createMockArgsReloadablePollingHandler()
+	args.Executor = &testsCommon.ExecutorStub{
+		ExecuteCalled: func(ctx context.Context) error {
+			atomic.AddUint32(&numCalls, 1)
+			return nil
+		},
+	}
+	handler, _ := NewReloadablePollingHandler(args)
+
+	require.Nil(t, handler.StartProcessingLoop())
+	assert.Equal(t, ErrLoopAlreadyStarted, handler.StartProcessingLoop())
+	time.Sleep(time.Millisecond * 100)
+	assert.True(t, handler.IsRunning())
+	assert.True(t, atomic.LoadUint32(&numCalls) > 1)
+
+	_ = handler.Close()
+	time.Sleep(time.Millisecond * 50)
+	assert.False(t, handler.IsRunning())
+}
+
+func TestReloadablePollingHandler_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing interval should not change the polling interval", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := NewReloadablePollingHandler(createMockArgsReloadablePollingHandler())
+		err := handler.ReloadConfig(core.ReloadableConfig{
+			PollingIntervals: map[string]time.Duration{"another handler": time.Second},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, time.Millisecond*10, handler.getPollingInterval(false))
+	})
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := NewReloadablePollingHandler(createMockArgsReloadablePollingHandler())
+		err := handler.ReloadConfig(core.ReloadableConfig{
+			PollingIntervals: map[string]time.Duration{handlerName: 0},
+		})
+		assert.True(t, errors.Is(err, ErrInvalidPollingInterval))
+		assert.Equal(t, time.Millisecond*10, handler.getPollingInterval(false))
+	})
+	t.Run("should change the polling interval", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _ := NewReloadablePollingHandler(createMockArgsReloadablePollingHandler())
+		err := handler.ReloadConfig(core.ReloadableConfig{
+			PollingIntervals: map[string]time.Duration{handlerName: time.Second},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, time.Second, handler.getPollingInterval(false))
+		assert.Equal(t, time.Millisecond*10, handler.getPollingInterval(true))
+	})
+}
@@ -13,3 +13,6 @@ var ErrInvalidSignature = errors.New("invalid signature")
 
 // ErrInvalidAddressBytes signals that an invalid address bytes were provided
 var ErrInvalidAddressBytes = errors.New("invalid address bytes")
+
+// ErrInvalidProbationAddress signals that an invalid probation address was provided
+var ErrInvalidProbationAddress = errors.New("invalid probation address")
@@ -2,6 +2,7 @@ package roleproviders
 
 import (
 	"context"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -12,6 +13,13 @@ type DataGetter interface {
 	IsInterfaceNil() bool
 }
 
+// StakesDataGetter defines the interface able to fetch the staked relayers and their stakes from the MultiversX chain
+type StakesDataGetter interface {
+	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	GetAmountStaked(ctx context.Context, relayerAddress []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
 // EthereumChainInteractor defines an Ethereum client able to respond to requests
 type EthereumChainInteractor interface {
 	GetRelayers(ctx context.Context) ([]common.Address, error)
@@ -8,8 +8,10 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/clients"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/core"
@@ -18,14 +20,22 @@ import (
 
 // ArgsMultiversXRoleProvider is the argument for the MultiversX role provider constructor
 type ArgsMultiversXRoleProvider struct {
-	DataGetter DataGetter
-	Log        logger.Logger
+	DataGetter         DataGetter
+	Log                logger.Logger
+	Timer              bridgeCore.Timer
+	ProbationPeriod    time.Duration
+	ProbationAddresses []string
 }
 
 type multiversXRoleProvider struct {
 	dataGetter           DataGetter
 	log                  logger.Logger
+	timer                bridgeCore.Timer
+	probationPeriod      int64
+	probationAddresses   map[string]struct{}
 	whitelistedAddresses map[string]struct{}
+	probationStarts      map[string]int64
+	wasFetched           bool
 	mut                  sync.RWMutex
 }
 
@@ -36,15 +46,38 @@ func NewMultiversXRoleProvider(args ArgsMultiversXRoleProvider) (*multiversXRole
 		return nil, err
 	}
 
+	probationAddresses, err := createProbationAddresses(args.ProbationAddresses)
+	if err != nil {
+		return nil, err
+	}
+
 	erp := &multiversXRoleProvider{
 		dataGetter:           args.DataGetter,
 		log:                  args.Log,
+		timer:                args.Timer,
+		probationPeriod:      int64(args.ProbationPeriod.Seconds()),
+		probationAddresses:   probationAddresses,
 		whitelistedAddresses: make(map[string]struct{}),
+		probationStarts:      make(map[string]int64),
 	}
 
 	return erp, nil
 }
 
+func createProbationAddresses(bech32Addresses []string) (map[string]struct{}, error) {
+	probationAddresses := make(map[string]struct{}, len(bech32Addresses))
+	for _, bech32Address := range bech32Addresses {
+		address, err := data.NewAddressFromBech32String(bech32Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s, %s", ErrInvalidProbationAddress, bech32Address, err.Error())
+		}
+
+		probationAddresses[string(address.AddressBytes())] = struct{}{}
+	}
+
+	return probationAddresses, nil
+}
+
 func checkMultiversXRoleProviderSpecificArgs(args ArgsMultiversXRoleProvider) error {
 	if check.IfNil(args.DataGetter) {
 		return clients.ErrNilDataGetter
@@ -52,6 +85,12 @@ func checkMultiversXRoleProviderSpecificArgs(args ArgsMultiversXRoleProvider) er
 	if check.IfNil(args.Log) {
 		return clients.ErrNilLogger
 	}
+	if check.IfNil(args.Timer) {
+		return clients.ErrNilTimer
+	}
+	if args.ProbationPeriod < 0 {
+		return fmt.Errorf("%w for ProbationPeriod: %v", clients.ErrInvalidValue, args.ProbationPeriod)
+	}
 
 	return nil
 }
@@ -87,7 +126,9 @@ func (erp *multiversXRoleProvider) processResults(results [][]byte) error {
 	}
 
 	erp.mut.Lock()
+	erp.updateProbationStarts(temporaryMap)
 	erp.whitelistedAddresses = temporaryMap
+	erp.wasFetched = true
 	erp.mut.Unlock()
 
 	erp.log.Debug("fetched whitelisted addresses:\n" + strings.Join(currentList, "\n"))
@@ -95,6 +136,40 @@ func (erp *multiversXRoleProvider) processResults(results [][]byte) error {
 	return nil
 }
 
+// updateProbationStarts puts on probation the configured relayers and the relayers added to the whitelist after the
+// first fetch. The relayers removed from the whitelist are forgotten, so they go through the probation if re-added
+func (erp *multiversXRoleProvider) updateProbationStarts(newWhitelistedAddresses map[string]struct{}) {
+	if erp.probationPeriod == 0 {
+		return
+	}
+
+	now := erp.timer.NowUnix()
+	probationStarts := make(map[string]int64)
+	for addr := range newWhitelistedAddresses {
+		start, found := erp.probationStarts[addr]
+		if found {
+			probationStarts[addr] = start
+			continue
+		}
+
+		_, wasWhitelisted := erp.whitelistedAddresses[addr]
+		if wasWhitelisted {
+			continue
+		}
+		_, isConfigured := erp.probationAddresses[addr]
+		if !isConfigured && !erp.wasFetched {
+			continue
+		}
+
+		probationStarts[addr] = now
+		bech32Address, _ := data.NewAddressFromBytes([]byte(addr)).AddressAsBech32String()
+		erp.log.Info("relayer on probation, its signatures are not counted", "address", bech32Address,
+			"until", time.Unix(now+erp.probationPeriod, 0).Format(time.RFC3339))
+	}
+
+	erp.probationStarts = probationStarts
+}
+
 // IsWhitelisted returns true if the non-nil address provided is whitelisted or not
 func (erp *multiversXRoleProvider) IsWhitelisted(address core.AddressHandler) bool {
 	if check.IfNil(address) {
@@ -109,6 +184,20 @@ func (erp *multiversXRoleProvider) IsWhitelisted(address core.AddressHandler) bo
 	return exists
 }
 
+// IsOnProbation returns true if the provided whitelisted address is still in its probation period. The signatures of
+// the relayers on probation are verified but not counted
+func (erp *multiversXRoleProvider) IsOnProbation(address []byte) bool {
+	erp.mut.RLock()
+	defer erp.mut.RUnlock()
+
+	start, found := erp.probationStarts[string(address)]
+	if !found {
+		return false
+	}
+
+	return erp.timer.NowUnix()-start < erp.probationPeriod
+}
+
 // SortedPublicKeys will return all the sorted public keys
 func (erp *multiversXRoleProvider) SortedPublicKeys() [][]byte {
 	erp.mut.RLock()
@@ -7,8 +7,10 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -20,6 +22,7 @@ func createMockArgs() ArgsMultiversXRoleProvider {
 	return ArgsMultiversXRoleProvider{
 		Log:        logger.GetOrCreate("test"),
 		DataGetter: &bridgeTests.DataGetterStub{},
+		Timer:      testsCommon.NewTimerStub(),
 	}
 }
 
@@ -46,6 +49,36 @@ func TestNewMultiversXRoleProvider(t *testing.T) {
 		assert.True(t, check.IfNil(erp))
 		assert.Equal(t, clients.ErrNilLogger, err)
 	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Timer = nil
+
+		erp, err := NewMultiversXRoleProvider(args)
+		assert.True(t, check.IfNil(erp))
+		assert.Equal(t, clients.ErrNilTimer, err)
+	})
+	t.Run("negative probation period should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ProbationPeriod = -time.Second
+
+		erp, err := NewMultiversXRoleProvider(args)
+		assert.True(t, check.IfNil(erp))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+	})
+	t.Run("invalid probation address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.ProbationAddresses = []string{"not a bech32 address"}
+
+		erp, err := NewMultiversXRoleProvider(args)
+		assert.True(t, check.IfNil(erp))
+		assert.True(t, errors.Is(err, ErrInvalidProbationAddress))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -145,3 +178,84 @@ func TestMultiversXRoleProvider_MisconfiguredAddressesShouldError(t *testing.T)
 	assert.True(t, strings.Contains(err.Error(), hex.EncodeToString(misconfiguredAddresses[2])))
 	assert.Zero(t, len(erp.whitelistedAddresses))
 }
+
+func TestMultiversXRoleProvider_IsOnProbation(t *testing.T) {
+	t.Parallel()
+
+	initialAddress := bytes.Repeat([]byte("1"), 32)
+	configuredAddress := bytes.Repeat([]byte("2"), 32)
+	addedAddress := bytes.Repeat([]byte("3"), 32)
+	configuredBech32, _ := data.NewAddressFromBytes(configuredAddress).AddressAsBech32String()
+
+	t.Run("probation disabled should not put relayers on probation", func(t *testing.T) {
+		t.Parallel()
+
+		relayers := [][]byte{initialAddress, configuredAddress}
+		args := createMockArgs()
+		args.ProbationAddresses = []string{configuredBech32}
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return relayers, nil
+			},
+		}
+
+		erp, _ := NewMultiversXRoleProvider(args)
+		_ = erp.Execute(context.Background())
+		relayers = append(relayers, addedAddress)
+		_ = erp.Execute(context.Background())
+
+		assert.False(t, erp.IsOnProbation(initialAddress))
+		assert.False(t, erp.IsOnProbation(configuredAddress))
+		assert.False(t, erp.IsOnProbation(addedAddress))
+	})
+	t.Run("configured and added relayers should be on probation for the period", func(t *testing.T) {
+		t.Parallel()
+
+		relayers := [][]byte{initialAddress, configuredAddress}
+		args := createMockArgs()
+		args.ProbationPeriod = time.Hour
+		args.ProbationAddresses = []string{configuredBech32}
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return relayers, nil
+			},
+		}
+
+		currentTime := time.Unix(1000, 0)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return currentTime.Unix()
+		}
+		args.Timer = timer
+		erp, _ := NewMultiversXRoleProvider(args)
+
+		err := erp.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, erp.IsOnProbation(initialAddress))
+		assert.True(t, erp.IsOnProbation(configuredAddress))
+		assert.False(t, erp.IsOnProbation(addedAddress))
+
+		currentTime = currentTime.Add(time.Minute * 30)
+		relayers = append(relayers, addedAddress)
+		err = erp.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, erp.IsOnProbation(initialAddress))
+		assert.True(t, erp.IsOnProbation(configuredAddress))
+		assert.True(t, erp.IsOnProbation(addedAddress))
+
+		currentTime = currentTime.Add(time.Minute * 30)
+		assert.False(t, erp.IsOnProbation(configuredAddress))
+		assert.True(t, erp.IsOnProbation(addedAddress))
+
+		currentTime = currentTime.Add(time.Minute * 30)
+		assert.False(t, erp.IsOnProbation(addedAddress))
+
+		// a removed and re-added relayer goes through the probation again
+		relayers = [][]byte{initialAddress, configuredAddress}
+		_ = erp.Execute(context.Background())
+		relayers = append(relayers, addedAddress)
+		_ = erp.Execute(context.Background())
+		assert.True(t, erp.IsOnProbation(addedAddress))
+		assert.False(t, erp.IsOnProbation(configuredAddress))
+	})
+}
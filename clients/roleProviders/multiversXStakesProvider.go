@@ -0,0 +1,89 @@
+package roleproviders
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsMultiversXStakesProvider is the argument for the MultiversX stakes provider constructor
+type ArgsMultiversXStakesProvider struct {
+	DataGetter StakesDataGetter
+	Log        logger.Logger
+}
+
+type multiversXStakesProvider struct {
+	dataGetter StakesDataGetter
+	log        logger.Logger
+	stakes     map[string]*big.Int
+	mut        sync.RWMutex
+}
+
+// NewMultiversXStakesProvider creates a new multiversXStakesProvider instance able to fetch the amount staked by
+// each of the relayers
+func NewMultiversXStakesProvider(args ArgsMultiversXStakesProvider) (*multiversXStakesProvider, error) {
+	if check.IfNil(args.DataGetter) {
+		return nil, clients.ErrNilDataGetter
+	}
+	if check.IfNil(args.Log) {
+		return nil, clients.ErrNilLogger
+	}
+
+	return &multiversXStakesProvider{
+		dataGetter: args.DataGetter,
+		log:        args.Log,
+		stakes:     make(map[string]*big.Int),
+	}, nil
+}
+
+// Execute will fetch the staked relayers together with their stakes and store them in the inner map
+func (provider *multiversXStakesProvider) Execute(ctx context.Context) error {
+	relayers, err := provider.dataGetter.GetAllStakedRelayers(ctx)
+	if err != nil {
+		return err
+	}
+
+	temporaryMap := make(map[string]*big.Int, len(relayers))
+	for _, relayer := range relayers {
+		stake, errGet := provider.dataGetter.GetAmountStaked(ctx, relayer)
+		if errGet != nil {
+			return errGet
+		}
+		if stake == nil {
+			stake = big.NewInt(0)
+		}
+
+		temporaryMap[string(relayer)] = stake
+		bech32Address, _ := data.NewAddressFromBytes(relayer).AddressAsBech32String()
+		provider.log.Debug("fetched relayer stake", "relayer", bech32Address, "stake", stake.String())
+	}
+
+	provider.mut.Lock()
+	provider.stakes = temporaryMap
+	provider.mut.Unlock()
+
+	return nil
+}
+
+// Stake returns the amount staked by the provided relayer or nil if the relayer is not known
+func (provider *multiversXStakesProvider) Stake(publicKey []byte) *big.Int {
+	provider.mut.RLock()
+	defer provider.mut.RUnlock()
+
+	stake, found := provider.stakes[string(publicKey)]
+	if !found || stake == nil {
+		return nil
+	}
+
+	return big.NewInt(0).Set(stake)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *multiversXStakesProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
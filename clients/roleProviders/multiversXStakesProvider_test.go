@@ -0,0 +1,132 @@
+package roleproviders
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsStakesProvider() ArgsMultiversXStakesProvider {
+	return ArgsMultiversXStakesProvider{
+		Log:        logger.GetOrCreate("test"),
+		DataGetter: &bridgeTests.DataGetterStub{},
+	}
+}
+
+func TestNewMultiversXStakesProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStakesProvider()
+		args.DataGetter = nil
+
+		provider, err := NewMultiversXStakesProvider(args)
+		assert.True(t, check.IfNil(provider))
+		assert.Equal(t, clients.ErrNilDataGetter, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStakesProvider()
+		args.Log = nil
+
+		provider, err := NewMultiversXStakesProvider(args)
+		assert.True(t, check.IfNil(provider))
+		assert.Equal(t, clients.ErrNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := NewMultiversXStakesProvider(createMockArgsStakesProvider())
+		assert.False(t, check.IfNil(provider))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMultiversXStakesProvider_Execute(t *testing.T) {
+	t.Parallel()
+
+	relayer1 := bytes.Repeat([]byte("1"), 32)
+	relayer2 := bytes.Repeat([]byte("2"), 32)
+	expectedErr := errors.New("expected error")
+
+	t.Run("get all staked relayers errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStakesProvider()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+
+		provider, _ := NewMultiversXStakesProvider(args)
+		err := provider.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("get amount staked errors should error and keep the previous stakes", func(t *testing.T) {
+		t.Parallel()
+
+		shouldFail := false
+		args := createMockArgsStakesProvider()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{relayer1}, nil
+			},
+			GetAmountStakedCalled: func(ctx context.Context, relayerAddress []byte) (*big.Int, error) {
+				if shouldFail {
+					return nil, expectedErr
+				}
+				return big.NewInt(100), nil
+			},
+		}
+
+		provider, _ := NewMultiversXStakesProvider(args)
+		err := provider.Execute(context.Background())
+		assert.Nil(t, err)
+
+		shouldFail = true
+		err = provider.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, big.NewInt(100), provider.Stake(relayer1))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		stakes := map[string]*big.Int{
+			string(relayer1): big.NewInt(100),
+			string(relayer2): big.NewInt(300),
+		}
+		args := createMockArgsStakesProvider()
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{relayer1, relayer2}, nil
+			},
+			GetAmountStakedCalled: func(ctx context.Context, relayerAddress []byte) (*big.Int, error) {
+				return stakes[string(relayerAddress)], nil
+			},
+		}
+
+		provider, _ := NewMultiversXStakesProvider(args)
+		assert.Nil(t, provider.Stake(relayer1))
+
+		err := provider.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(100), provider.Stake(relayer1))
+		assert.Equal(t, big.NewInt(300), provider.Stake(relayer2))
+		assert.Nil(t, provider.Stake(bytes.Repeat([]byte("3"), 32)))
+
+		provider.Stake(relayer1).SetInt64(1)
+		assert.Equal(t, big.NewInt(100), provider.Stake(relayer1))
+	})
+}
@@ -0,0 +1,18 @@
+package roundingPolicy
+
+import "errors"
+
+// ErrInvalidMode signals that an unknown rounding mode has been provided
+var ErrInvalidMode = errors.New("invalid rounding mode")
+
+// ErrEmptyToken signals that a token rounding mode has been provided without the token
+var ErrEmptyToken = errors.New("empty token")
+
+// ErrDuplicatedToken signals that the rounding mode of a token has been provided more than once
+var ErrDuplicatedToken = errors.New("duplicated token")
+
+// ErrNilAmount signals that a nil amount has been provided
+var ErrNilAmount = errors.New("nil amount")
+
+// ErrDustAmount signals that the amount can not be converted without dust and the token rejects the dust
+var ErrDustAmount = errors.New("amount not representable without dust")
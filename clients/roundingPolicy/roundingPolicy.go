@@ -0,0 +1,190 @@
+package roundingPolicy
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+)
+
+const (
+	// ModeFloor truncates the fraction not representable with the destination number of decimals
+	ModeFloor = "floor"
+	// ModeRejectOnDust refuses the amounts not representable with the destination number of decimals
+	ModeRejectOnDust = "reject-on-dust"
+	// ModeCarryDustForward truncates the fraction and adds it to the next conversion of the same token
+	ModeCarryDustForward = "carry-dust-forward"
+
+	decimalsBase = 10
+)
+
+type tokenMode struct {
+	Token string `json:"token"`
+	Mode  string `json:"mode"`
+}
+
+// policy is the normalized form of the configuration, marshalled to compute the policy hash. The tokens are sorted so
+// equivalent configurations produce the same hash
+type policy struct {
+	DefaultMode string       `json:"defaultMode"`
+	Tokens      []*tokenMode `json:"tokens"`
+}
+
+type roundingPolicy struct {
+	defaultMode string
+	modes       map[string]string
+	hash        []byte
+
+	mutDust     sync.RWMutex
+	carriedDust map[string]*big.Int
+}
+
+// NewRoundingPolicy creates the component rounding the amounts converted between tokens with different numbers of
+// decimals, with the mode configured for each token
+func NewRoundingPolicy(cfg config.RoundingPolicyConfig) (*roundingPolicy, error) {
+	err := checkMode(cfg.DefaultMode)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the default mode", err)
+	}
+
+	normalized := &policy{
+		DefaultMode: cfg.DefaultMode,
+		Tokens:      make([]*tokenMode, 0, len(cfg.Tokens)),
+	}
+	rounding := &roundingPolicy{
+		defaultMode: cfg.DefaultMode,
+		modes:       make(map[string]string, len(cfg.Tokens)),
+		carriedDust: make(map[string]*big.Int),
+	}
+
+	for _, token := range cfg.Tokens {
+		if len(token.Token) == 0 {
+			return nil, ErrEmptyToken
+		}
+		err = checkMode(token.Mode)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the token %s", err, token.Token)
+		}
+
+		key := normalizeToken(token.Token)
+		_, found := rounding.modes[key]
+		if found {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedToken, token.Token)
+		}
+
+		rounding.modes[key] = token.Mode
+		normalized.Tokens = append(normalized.Tokens, &tokenMode{
+			Token: key,
+			Mode:  token.Mode,
+		})
+	}
+
+	sort.Slice(normalized.Tokens, func(i, j int) bool {
+		return normalized.Tokens[i].Token < normalized.Tokens[j].Token
+	})
+	buff, err := json.Marshal(normalized)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(buff)
+	rounding.hash = hash[:]
+
+	return rounding, nil
+}
+
+func checkMode(mode string) error {
+	switch mode {
+	case ModeFloor, ModeRejectOnDust, ModeCarryDustForward:
+		return nil
+	default:
+		return fmt.Errorf("%w %q", ErrInvalidMode, mode)
+	}
+}
+
+// normalizeToken returns the ERC20 addresses in lower case, so the checksummed and the plain forms match. The ESDT
+// identifiers are returned unchanged
+func normalizeToken(token string) string {
+	if common.IsHexAddress(token) {
+		return strings.ToLower(common.HexToAddress(token).Hex())
+	}
+
+	return token
+}
+
+// Mode returns the rounding mode of the provided token
+func (rounding *roundingPolicy) Mode(token string) string {
+	mode, found := rounding.modes[normalizeToken(token)]
+	if !found {
+		return rounding.defaultMode
+	}
+
+	return mode
+}
+
+// Convert converts the amount expressed with the source number of decimals to the destination number of decimals and
+// returns the converted amount together with the dust, expressed with the source number of decimals. The dust carried
+// forward from the previous conversions of the token is added to the amount. The provided amount is not altered
+func (rounding *roundingPolicy) Convert(token string, amount *big.Int, sourceDecimals uint8, destinationDecimals uint8) (*big.Int, *big.Int, error) {
+	if amount == nil {
+		return nil, nil, ErrNilAmount
+	}
+
+	mode := rounding.Mode(token)
+	total := big.NewInt(0).Set(amount)
+	if mode == ModeCarryDustForward {
+		total.Add(total, rounding.getCarriedDust(token))
+	}
+
+	if destinationDecimals >= sourceDecimals {
+		multiplier := big.NewInt(0).Exp(big.NewInt(decimalsBase), big.NewInt(int64(destinationDecimals-sourceDecimals)), nil)
+		return total.Mul(total, multiplier), big.NewInt(0), nil
+	}
+
+	divisor := big.NewInt(0).Exp(big.NewInt(decimalsBase), big.NewInt(int64(sourceDecimals-destinationDecimals)), nil)
+	converted, dust := big.NewInt(0).DivMod(total, divisor, big.NewInt(0))
+	if mode == ModeRejectOnDust && dust.Sign() != 0 {
+		return nil, nil, fmt.Errorf("%w: %s of the token %s has a dust of %s", ErrDustAmount, amount.String(), token, dust.String())
+	}
+
+	return converted, dust, nil
+}
+
+// CarryDust records the dust left by a processed conversion, added to the next conversion of the token. The dust is
+// ignored for the tokens not using the carry-dust-forward mode
+func (rounding *roundingPolicy) CarryDust(token string, dust *big.Int) {
+	if dust == nil || rounding.Mode(token) != ModeCarryDustForward {
+		return
+	}
+
+	rounding.mutDust.Lock()
+	rounding.carriedDust[normalizeToken(token)] = big.NewInt(0).Set(dust)
+	rounding.mutDust.Unlock()
+}
+
+func (rounding *roundingPolicy) getCarriedDust(token string) *big.Int {
+	rounding.mutDust.RLock()
+	defer rounding.mutDust.RUnlock()
+
+	dust, found := rounding.carriedDust[normalizeToken(token)]
+	if !found {
+		return big.NewInt(0)
+	}
+
+	return dust
+}
+
+// Hash returns the hash of the policy, equal on all the relayers using the same policy
+func (rounding *roundingPolicy) Hash() []byte {
+	return rounding.hash
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rounding *roundingPolicy) IsInterfaceNil() bool {
+	return rounding == nil
+}
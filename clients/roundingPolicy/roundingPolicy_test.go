@@ -0,0 +1,177 @@
+package roundingPolicy
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	erc20Token = "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"
+	esdtToken  = "WETH-abcdef"
+	floorToken = "USDC-abcdef"
+)
+
+func createTestConfig() config.RoundingPolicyConfig {
+	return config.RoundingPolicyConfig{
+		Enabled:     true,
+		DefaultMode: ModeFloor,
+		Tokens: []config.TokenRoundingConfig{
+			{Token: erc20Token, Mode: ModeRejectOnDust},
+			{Token: esdtToken, Mode: ModeCarryDustForward},
+		},
+	}
+}
+
+func TestNewRoundingPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid default mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.DefaultMode = "ceil"
+
+		policy, err := NewRoundingPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidMode))
+	})
+	t.Run("invalid token mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[1].Mode = ""
+
+		policy, err := NewRoundingPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrInvalidMode))
+	})
+	t.Run("empty token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[0].Token = ""
+
+		policy, err := NewRoundingPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.Equal(t, ErrEmptyToken, err)
+	})
+	t.Run("duplicated token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.Tokens[1].Token = "0x3009d97ffed62e57d444e552a9edf9ee6bc8644c"
+
+		policy, err := NewRoundingPolicy(cfg)
+		assert.True(t, check.IfNil(policy))
+		assert.True(t, errors.Is(err, ErrDuplicatedToken))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := NewRoundingPolicy(createTestConfig())
+		assert.False(t, check.IfNil(policy))
+		assert.Nil(t, err)
+		assert.Equal(t, ModeRejectOnDust, policy.Mode("0x3009D97FFED62E57D444E552A9EDF9EE6BC8644C"))
+		assert.Equal(t, ModeCarryDustForward, policy.Mode(esdtToken))
+		assert.Equal(t, ModeFloor, policy.Mode(floorToken))
+	})
+}
+
+func TestRoundingPolicy_Convert(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewRoundingPolicy(createTestConfig())
+		converted, dust, err := policy.Convert(floorToken, nil, 18, 6)
+		assert.Nil(t, converted)
+		assert.Nil(t, dust)
+		assert.Equal(t, ErrNilAmount, err)
+	})
+	t.Run("more destination decimals should scale up without dust", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewRoundingPolicy(createTestConfig())
+		amount := big.NewInt(1234)
+		converted, dust, err := policy.Convert(erc20Token, amount, 6, 8)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(123400), converted)
+		assert.Equal(t, big.NewInt(0), dust)
+		assert.Equal(t, big.NewInt(1234), amount)
+	})
+	t.Run("floor should truncate the dust", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewRoundingPolicy(createTestConfig())
+		amount := big.NewInt(123456)
+		converted, dust, err := policy.Convert(floorToken, amount, 8, 6)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1234), converted)
+		assert.Equal(t, big.NewInt(56), dust)
+		assert.Equal(t, big.NewInt(123456), amount)
+	})
+	t.Run("reject on dust should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewRoundingPolicy(createTestConfig())
+		converted, dust, err := policy.Convert(erc20Token, big.NewInt(123456), 8, 6)
+		assert.Nil(t, converted)
+		assert.Nil(t, dust)
+		assert.True(t, errors.Is(err, ErrDustAmount))
+
+		converted, dust, err = policy.Convert(erc20Token, big.NewInt(123400), 8, 6)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1234), converted)
+		assert.Equal(t, big.NewInt(0), dust)
+	})
+	t.Run("carry dust forward should add the carried dust", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewRoundingPolicy(createTestConfig())
+		converted, dust, err := policy.Convert(esdtToken, big.NewInt(170), 2, 0)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(1), converted)
+		assert.Equal(t, big.NewInt(70), dust)
+
+		policy.CarryDust(esdtToken, dust)
+		converted, dust, err = policy.Convert(esdtToken, big.NewInt(150), 2, 0)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(2), converted)
+		assert.Equal(t, big.NewInt(20), dust)
+	})
+	t.Run("carried dust should be ignored for the other modes", func(t *testing.T) {
+		t.Parallel()
+
+		policy, _ := NewRoundingPolicy(createTestConfig())
+		policy.CarryDust(floorToken, big.NewInt(70))
+		converted, dust, err := policy.Convert(floorToken, big.NewInt(150), 2, 0)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(1), converted)
+		assert.Equal(t, big.NewInt(50), dust)
+	})
+}
+
+func TestRoundingPolicy_Hash(t *testing.T) {
+	t.Parallel()
+
+	policy1, _ := NewRoundingPolicy(createTestConfig())
+
+	cfg := createTestConfig()
+	cfg.Tokens[0], cfg.Tokens[1] = cfg.Tokens[1], cfg.Tokens[0]
+	cfg.Tokens[1].Token = "0x3009d97ffed62e57d444e552a9edf9ee6bc8644c"
+	policy2, _ := NewRoundingPolicy(cfg)
+	assert.Equal(t, policy1.Hash(), policy2.Hash())
+	assert.Equal(t, 32, len(policy1.Hash()))
+
+	cfg = createTestConfig()
+	cfg.Tokens[1].Mode = ModeFloor
+	policy3, _ := NewRoundingPolicy(cfg)
+	assert.NotEqual(t, policy1.Hash(), policy3.Hash())
+}
@@ -0,0 +1,15 @@
+package syncReporter
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilEthereumClient signals that a nil Ethereum client has been provided
+var ErrNilEthereumClient = errors.New("nil Ethereum client")
+
+// ErrNilMultiversXClient signals that a nil MultiversX client has been provided
+var ErrNilMultiversXClient = errors.New("nil MultiversX client")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
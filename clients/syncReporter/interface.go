@@ -0,0 +1,22 @@
+package syncReporter
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// EthereumClient defines the Ethereum client operations used to build the sync report
+type EthereumClient interface {
+	GetBatch(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error)
+	WasExecuted(ctx context.Context, batchID uint64) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXClient defines the MultiversX client operations used to build the sync report
+type MultiversXClient interface {
+	GetPendingBatch(ctx context.Context) (*core.TransferBatch, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetLastMvxBatchID(ctx context.Context) (uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,214 @@
+package syncReporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsSyncReporter represents the DTO struct used in the NewSyncReporter constructor function
+type ArgsSyncReporter struct {
+	Log                          logger.Logger
+	EthereumClient               EthereumClient
+	MultiversXClient             MultiversXClient
+	EthToMultiversXStatusHandler core.StatusHandler
+	MultiversXToEthStatusHandler core.StatusHandler
+}
+
+type syncReporter struct {
+	log                          logger.Logger
+	ethereumClient               EthereumClient
+	multiversXClient             MultiversXClient
+	ethToMultiversXStatusHandler core.StatusHandler
+	multiversXToEthStatusHandler core.StatusHandler
+	getTime                      func() time.Time
+
+	mutReport sync.RWMutex
+	report    *core.SyncReport
+}
+
+// NewSyncReporter creates a new instance of type syncReporter
+func NewSyncReporter(args ArgsSyncReporter) (*syncReporter, error) {
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.EthereumClient) {
+		return nil, ErrNilEthereumClient
+	}
+	if check.IfNil(args.MultiversXClient) {
+		return nil, ErrNilMultiversXClient
+	}
+	if check.IfNil(args.EthToMultiversXStatusHandler) {
+		return nil, fmt.Errorf("%w for the Ethereum to MultiversX direction", ErrNilStatusHandler)
+	}
+	if check.IfNil(args.MultiversXToEthStatusHandler) {
+		return nil, fmt.Errorf("%w for the MultiversX to Ethereum direction", ErrNilStatusHandler)
+	}
+
+	return &syncReporter{
+		log:                          args.Log,
+		ethereumClient:               args.EthereumClient,
+		multiversXClient:             args.MultiversXClient,
+		ethToMultiversXStatusHandler: args.EthToMultiversXStatusHandler,
+		multiversXToEthStatusHandler: args.MultiversXToEthStatusHandler,
+		getTime:                      time.Now,
+	}, nil
+}
+
+// Generate compares the last executed batch IDs on both chains, the pending batches and the locally persisted state,
+// then prints the report and keeps it for the REST API. The query errors are recorded in the report, they do not stop
+// the relayer
+func (reporter *syncReporter) Generate(ctx context.Context) *core.SyncReport {
+	report := &core.SyncReport{
+		Timestamp:            reporter.getTime().Unix(),
+		EthereumToMultiversX: reporter.createEthToMultiversXReport(ctx),
+		MultiversXToEthereum: reporter.createMultiversXToEthReport(ctx),
+	}
+
+	reporter.mutReport.Lock()
+	reporter.report = report
+	reporter.mutReport.Unlock()
+
+	reporter.logReport("Ethereum to MultiversX", report.EthereumToMultiversX)
+	reporter.logReport("MultiversX to Ethereum", report.MultiversXToEthereum)
+
+	buff, err := json.Marshal(report)
+	if err != nil {
+		reporter.log.Warn("sync report: can not marshal", "error", err)
+		return report
+	}
+	reporter.log.Info("sync report JSON", "report", string(buff))
+
+	return report
+}
+
+func (reporter *syncReporter) createEthToMultiversXReport(ctx context.Context) *core.BridgeSyncReport {
+	report := createBridgeSyncReport(reporter.ethToMultiversXStatusHandler)
+
+	lastExecutedBatchID, err := reporter.multiversXClient.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		addError(report, "getLastExecutedEthBatchId on MultiversX", err)
+		return report
+	}
+	report.LastExecutedBatchID = lastExecutedBatchID
+
+	batch, isFinal, err := reporter.ethereumClient.GetBatch(ctx, lastExecutedBatchID+1)
+	if err != nil {
+		addError(report, "getBatch on Ethereum", err)
+	}
+	if err == nil && len(batch.Deposits) > 0 {
+		report.PendingBatchID = lastExecutedBatchID + 1
+		report.NumPendingDeposits = len(batch.Deposits)
+		report.PendingBatchFinal = isFinal
+	}
+
+	checkPersistedNumBatches(report)
+
+	return report
+}
+
+func (reporter *syncReporter) createMultiversXToEthReport(ctx context.Context) *core.BridgeSyncReport {
+	report := createBridgeSyncReport(reporter.multiversXToEthStatusHandler)
+
+	batch, err := reporter.multiversXClient.GetPendingBatch(ctx)
+	if errors.Is(err, clients.ErrNoPendingBatchAvailable) {
+		// all the MultiversX batches were resolved, the last one created is also the last one executed
+		report.LastExecutedBatchID, err = reporter.multiversXClient.GetLastMvxBatchID(ctx)
+		if err != nil {
+			addError(report, "getLastBatchId on MultiversX", err)
+			return report
+		}
+
+		checkPersistedNumBatches(report)
+
+		return report
+	}
+	if err != nil {
+		addError(report, "getCurrentTxBatch on MultiversX", err)
+		return report
+	}
+
+	report.LastExecutedBatchID = batch.ID - 1
+	report.PendingBatchID = batch.ID
+	report.NumPendingDeposits = len(batch.Deposits)
+	report.PendingBatchFinal = true
+	report.PendingExecutedOnDestination, err = reporter.ethereumClient.WasExecuted(ctx, batch.ID)
+	if err != nil {
+		addError(report, "wasBatchExecuted on Ethereum", err)
+	}
+
+	checkPersistedNumBatches(report)
+
+	return report
+}
+
+func createBridgeSyncReport(statusHandler core.StatusHandler) *core.BridgeSyncReport {
+	report := &core.BridgeSyncReport{}
+
+	metrics := statusHandler.GetAllMetrics()
+	report.PersistedNumBatches, _ = metrics[core.MetricNumBatches].(int)
+	report.PersistedStep, _ = metrics[core.MetricCurrentStateMachineStep].(string)
+	report.PersistedLastError, _ = metrics[core.MetricLastError].(string)
+
+	return report
+}
+
+func addError(report *core.BridgeSyncReport, query string, err error) {
+	report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", query, err.Error()))
+}
+
+// checkPersistedNumBatches records a discrepancy if the locally persisted state does not match the on-chain progress,
+// e.g. after the storage was restored from an old backup or another relayer executed the batches while this one was down
+func checkPersistedNumBatches(report *core.BridgeSyncReport) {
+	isFreshStorage := report.PersistedNumBatches == 0 && len(report.PersistedStep) == 0
+	if isFreshStorage || uint64(report.PersistedNumBatches) == report.LastExecutedBatchID {
+		return
+	}
+
+	report.Discrepancies = append(report.Discrepancies, fmt.Sprintf(
+		"the persisted number of batches %d differs from the last executed batch ID %d",
+		report.PersistedNumBatches, report.LastExecutedBatchID))
+}
+
+func (reporter *syncReporter) logReport(direction string, report *core.BridgeSyncReport) {
+	reporter.log.Info("sync report",
+		"direction", direction,
+		"last executed batch ID", report.LastExecutedBatchID,
+		"pending batch ID", report.PendingBatchID,
+		"num pending deposits", report.NumPendingDeposits,
+		"pending batch final", report.PendingBatchFinal,
+		"pending executed on destination", report.PendingExecutedOnDestination,
+		"persisted num batches", report.PersistedNumBatches,
+		"persisted step", report.PersistedStep,
+	)
+	if len(report.PersistedLastError) > 0 {
+		reporter.log.Info("sync report: persisted last error", "direction", direction, "error", report.PersistedLastError)
+	}
+	for _, discrepancy := range report.Discrepancies {
+		reporter.log.Warn("sync report: discrepancy", "direction", direction, "details", discrepancy)
+	}
+	for _, queryError := range report.Errors {
+		reporter.log.Warn("sync report: query failed", "direction", direction, "error", queryError)
+	}
+}
+
+// GetSyncReport returns the report produced at startup or nil if it was not yet generated
+func (reporter *syncReporter) GetSyncReport() *core.SyncReport {
+	reporter.mutReport.RLock()
+	defer reporter.mutReport.RUnlock()
+
+	return reporter.report
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reporter *syncReporter) IsInterfaceNil() bool {
+	return reporter == nil
+}
@@ -0,0 +1,212 @@
+package syncReporter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSyncReporter() ArgsSyncReporter {
+	return ArgsSyncReporter{
+		Log: &testsCommon.LoggerStub{},
+		EthereumClient: &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error) {
+				return &core.TransferBatch{}, false, nil
+			},
+		},
+		MultiversXClient: &bridgeTests.MultiversXClientStub{
+			GetPendingBatchCalled: func(ctx context.Context) (*core.TransferBatch, error) {
+				return nil, clients.ErrNoPendingBatchAvailable
+			},
+		},
+		EthToMultiversXStatusHandler: testsCommon.NewStatusHandlerMock("EthToMultiversX"),
+		MultiversXToEthStatusHandler: testsCommon.NewStatusHandlerMock("MultiversXToEth"),
+	}
+}
+
+func createDeposits(numDeposits int) []*core.DepositTransfer {
+	deposits := make([]*core.DepositTransfer, 0, numDeposits)
+	for i := 0; i < numDeposits; i++ {
+		deposits = append(deposits, &core.DepositTransfer{Nonce: uint64(i + 1)})
+	}
+
+	return deposits
+}
+
+func TestNewSyncReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		args.Log = nil
+
+		reporter, err := NewSyncReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil Ethereum client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		args.EthereumClient = nil
+
+		reporter, err := NewSyncReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.Equal(t, ErrNilEthereumClient, err)
+	})
+	t.Run("nil MultiversX client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		args.MultiversXClient = nil
+
+		reporter, err := NewSyncReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.Equal(t, ErrNilMultiversXClient, err)
+	})
+	t.Run("nil status handlers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		args.EthToMultiversXStatusHandler = nil
+		reporter, err := NewSyncReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.True(t, errors.Is(err, ErrNilStatusHandler))
+
+		args = createMockArgsSyncReporter()
+		args.MultiversXToEthStatusHandler = nil
+		reporter, err = NewSyncReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.True(t, errors.Is(err, ErrNilStatusHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, err := NewSyncReporter(createMockArgsSyncReporter())
+		assert.False(t, check.IfNil(reporter))
+		assert.Nil(t, err)
+		assert.Nil(t, reporter.GetSyncReport())
+	})
+}
+
+func TestSyncReporter_Generate(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("pending batches on both directions", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		ethToMultiversXStatusHandler := testsCommon.NewStatusHandlerMock("EthToMultiversX")
+		ethToMultiversXStatusHandler.SetIntMetric(core.MetricNumBatches, 41)
+		ethToMultiversXStatusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, "get pending batch")
+		ethToMultiversXStatusHandler.SetStringMetric(core.MetricLastError, "last error")
+		args.EthToMultiversXStatusHandler = ethToMultiversXStatusHandler
+		multiversXToEthStatusHandler := testsCommon.NewStatusHandlerMock("MultiversXToEth")
+		multiversXToEthStatusHandler.SetIntMetric(core.MetricNumBatches, 9)
+		multiversXToEthStatusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, "sign proposed set status")
+		args.MultiversXToEthStatusHandler = multiversXToEthStatusHandler
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 42, nil
+			},
+			GetPendingBatchCalled: func(ctx context.Context) (*core.TransferBatch, error) {
+				return &core.TransferBatch{ID: 10, Deposits: createDeposits(3)}, nil
+			},
+		}
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*core.TransferBatch, bool, error) {
+				assert.Equal(t, uint64(43), nonce)
+				return &core.TransferBatch{ID: 43, Deposits: createDeposits(2)}, true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				assert.Equal(t, uint64(10), batchID)
+				return true, nil
+			},
+		}
+		reporter, _ := NewSyncReporter(args)
+		reporter.getTime = func() time.Time {
+			return time.Unix(1700000000, 0)
+		}
+
+		report := reporter.Generate(context.Background())
+		expectedReport := &core.SyncReport{
+			Timestamp: 1700000000,
+			EthereumToMultiversX: &core.BridgeSyncReport{
+				LastExecutedBatchID: 42,
+				PendingBatchID:      43,
+				NumPendingDeposits:  2,
+				PendingBatchFinal:   true,
+				PersistedNumBatches: 41,
+				PersistedStep:       "get pending batch",
+				PersistedLastError:  "last error",
+				Discrepancies:       []string{"the persisted number of batches 41 differs from the last executed batch ID 42"},
+			},
+			MultiversXToEthereum: &core.BridgeSyncReport{
+				LastExecutedBatchID:          9,
+				PendingBatchID:               10,
+				NumPendingDeposits:           3,
+				PendingBatchFinal:            true,
+				PendingExecutedOnDestination: true,
+				PersistedNumBatches:          9,
+				PersistedStep:                "sign proposed set status",
+			},
+		}
+		assert.Equal(t, expectedReport, report)
+		assert.True(t, report == reporter.GetSyncReport())
+	})
+	t.Run("no pending batches with fresh storage", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 42, nil
+			},
+			GetPendingBatchCalled: func(ctx context.Context) (*core.TransferBatch, error) {
+				return nil, clients.ErrNoPendingBatchAvailable
+			},
+			GetLastMvxBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 15, nil
+			},
+		}
+		reporter, _ := NewSyncReporter(args)
+
+		report := reporter.Generate(context.Background())
+		assert.Equal(t, &core.BridgeSyncReport{LastExecutedBatchID: 42}, report.EthereumToMultiversX)
+		assert.Equal(t, &core.BridgeSyncReport{LastExecutedBatchID: 15}, report.MultiversXToEthereum)
+	})
+	t.Run("query errors should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSyncReporter()
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 0, expectedErr
+			},
+			GetPendingBatchCalled: func(ctx context.Context) (*core.TransferBatch, error) {
+				return nil, expectedErr
+			},
+		}
+		reporter, _ := NewSyncReporter(args)
+
+		report := reporter.Generate(context.Background())
+		require.Len(t, report.EthereumToMultiversX.Errors, 1)
+		assert.True(t, strings.Contains(report.EthereumToMultiversX.Errors[0], "getLastExecutedEthBatchId"))
+		assert.True(t, strings.Contains(report.EthereumToMultiversX.Errors[0], expectedErr.Error()))
+		require.Len(t, report.MultiversXToEthereum.Errors, 1)
+		assert.True(t, strings.Contains(report.MultiversXToEthereum.Errors[0], "getCurrentTxBatch"))
+	})
+}
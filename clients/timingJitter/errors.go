@@ -0,0 +1,15 @@
+package timingJitter
+
+import "errors"
+
+// ErrNilExecutor signals that a nil executor has been provided
+var ErrNilExecutor = errors.New("nil executor")
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilTimer signals that a nil timer has been provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrInvalidMaxJitter signals that an invalid maximum jitter has been provided
+var ErrInvalidMaxJitter = errors.New("invalid maximum jitter")
@@ -0,0 +1,76 @@
+package timingJitter
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsJitteredExecutor is the DTO used to create a new jittered executor
+type ArgsJitteredExecutor struct {
+	Executor  Executor
+	MaxJitter time.Duration
+	Log       logger.Logger
+	Timer     core.Timer
+}
+
+type jitteredExecutor struct {
+	executor     Executor
+	maxJitter    time.Duration
+	log          logger.Logger
+	timer        core.Timer
+	randomJitter func(maxJitter time.Duration) time.Duration
+}
+
+// NewJitteredExecutor creates an executor wrapper that delays each execution with a random duration, lower than the
+// maximum jitter, so the relayers started at the same time do not query the same endpoints in lockstep
+func NewJitteredExecutor(args ArgsJitteredExecutor) (*jitteredExecutor, error) {
+	if check.IfNil(args.Executor) {
+		return nil, ErrNilExecutor
+	}
+	if args.MaxJitter <= 0 {
+		return nil, fmt.Errorf("%w, got: %v", ErrInvalidMaxJitter, args.MaxJitter)
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return nil, ErrNilTimer
+	}
+
+	return &jitteredExecutor{
+		executor:     args.Executor,
+		maxJitter:    args.MaxJitter,
+		log:          args.Log,
+		timer:        args.Timer,
+		randomJitter: randomJitter,
+	}, nil
+}
+
+func randomJitter(maxJitter time.Duration) time.Duration {
+	return time.Duration(rand.Int63n(int64(maxJitter)))
+}
+
+// Execute waits a random jitter and calls the wrapped executor. The wait is aborted if the context is done
+func (executor *jitteredExecutor) Execute(ctx context.Context) error {
+	jitter := executor.randomJitter(executor.maxJitter)
+	executor.log.Trace("jittered executor: delaying the execution", "jitter", jitter)
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-executor.timer.After(jitter):
+	}
+
+	return executor.executor.Execute(ctx)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *jitteredExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
@@ -0,0 +1,144 @@
+package timingJitter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsJitteredExecutor() ArgsJitteredExecutor {
+	return ArgsJitteredExecutor{
+		Executor:  &testsCommon.ExecutorStub{},
+		MaxJitter: time.Millisecond * 10,
+		Log:       &testsCommon.LoggerStub{},
+		Timer:     testsCommon.NewTimerStub(),
+	}
+}
+
+func TestNewJitteredExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil executor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJitteredExecutor()
+		args.Executor = nil
+
+		executor, err := NewJitteredExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilExecutor, err)
+	})
+	t.Run("invalid maximum jitter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJitteredExecutor()
+		args.MaxJitter = 0
+
+		executor, err := NewJitteredExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.True(t, errors.Is(err, ErrInvalidMaxJitter))
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJitteredExecutor()
+		args.Log = nil
+
+		executor, err := NewJitteredExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJitteredExecutor()
+		args.Timer = nil
+
+		executor, err := NewJitteredExecutor(args)
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewJitteredExecutor(createMockArgsJitteredExecutor())
+		assert.False(t, check.IfNil(executor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestJitteredExecutor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should delay the execution", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		numExecutions := 0
+		jitter := time.Millisecond * 50
+		waitedJitter := time.Duration(0)
+		args := createMockArgsJitteredExecutor()
+		args.Executor = &testsCommon.ExecutorStub{
+			ExecuteCalled: func(ctx context.Context) error {
+				assert.Equal(t, jitter, waitedJitter)
+				numExecutions++
+				return expectedErr
+			},
+		}
+		timer := testsCommon.NewTimerStub()
+		timer.AfterCalled = func(duration time.Duration) <-chan time.Time {
+			waitedJitter = duration
+			ch := make(chan time.Time, 1)
+			ch <- time.Time{}
+			return ch
+		}
+		args.Timer = timer
+		executor, _ := NewJitteredExecutor(args)
+		executor.randomJitter = func(maxJitter time.Duration) time.Duration {
+			assert.Equal(t, args.MaxJitter, maxJitter)
+			return jitter
+		}
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numExecutions)
+		assert.Equal(t, jitter, waitedJitter)
+	})
+	t.Run("context done should not execute", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsJitteredExecutor()
+		args.Executor = &testsCommon.ExecutorStub{
+			ExecuteCalled: func(ctx context.Context) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+		executor, _ := NewJitteredExecutor(args)
+		executor.randomJitter = func(maxJitter time.Duration) time.Duration {
+			return time.Hour
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := executor.Execute(ctx)
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestRandomJitter(t *testing.T) {
+	t.Parallel()
+
+	maxJitter := time.Millisecond * 100
+	for i := 0; i < 1000; i++ {
+		jitter := randomJitter(maxJitter)
+		assert.GreaterOrEqual(t, jitter, time.Duration(0))
+		assert.Less(t, jitter, maxJitter)
+	}
+}
@@ -0,0 +1,27 @@
+package tokenMetadata
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilMetadataGetter signals that a nil ERC20 metadata getter has been provided
+var ErrNilMetadataGetter = errors.New("nil ERC20 metadata getter")
+
+// ErrNilDataGetter signals that a nil MultiversX data getter has been provided
+var ErrNilDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrNilIncidentsQueue signals that a nil incidents queue has been provided
+var ErrNilIncidentsQueue = errors.New("nil incidents queue")
+
+// ErrUnpairedToken signals that the MultiversX token is not paired with an ERC20 token
+var ErrUnpairedToken = errors.New("unpaired token")
+
+// ErrInvalidTokenAddress signals that an invalid ERC20 token address has been provided
+var ErrInvalidTokenAddress = errors.New("invalid token address")
@@ -0,0 +1,27 @@
+package tokenMetadata
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ERC20MetadataGetter defines the operations able to read the metadata of an ERC20 contract
+type ERC20MetadataGetter interface {
+	Decimals(ctx context.Context, erc20Address common.Address) (uint8, error)
+	Symbol(ctx context.Context, erc20Address common.Address) (string, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXDataGetter defines the MultiversX queries used to list the bridged ERC20 tokens
+type MultiversXDataGetter interface {
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// IncidentsQueue defines the queue of the incidents that must be acknowledged by an operator
+type IncidentsQueue interface {
+	Raise(source string, description string) uint64
+	IsInterfaceNil() bool
+}
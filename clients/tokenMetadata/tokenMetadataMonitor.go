@@ -0,0 +1,244 @@
+package tokenMetadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	storerKey      = "token-metadata-registry"
+	incidentSource = "TokenMetadata"
+)
+
+// ArgsTokenMetadataMonitor is the DTO used to create a new token metadata monitor
+type ArgsTokenMetadataMonitor struct {
+	ERC20Tokens    []string
+	MetadataGetter ERC20MetadataGetter
+	DataGetter     MultiversXDataGetter
+	Storer         core.Storer
+	StatusHandler  core.StatusHandler
+	Incidents      IncidentsQueue
+	Log            logger.Logger
+}
+
+// tokenMetadata holds the last observed metadata of a bridged ERC20 token, persisted so the changes made while the
+// relayer was stopped are also detected
+type tokenMetadata struct {
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+}
+
+type tokenMetadataMonitor struct {
+	erc20Tokens    []common.Address
+	metadataGetter ERC20MetadataGetter
+	dataGetter     MultiversXDataGetter
+	storer         core.Storer
+	statusHandler  core.StatusHandler
+	incidents      IncidentsQueue
+	log            logger.Logger
+
+	mut                sync.Mutex
+	registry           map[string]*tokenMetadata
+	numDecimalsChanges int
+}
+
+// NewTokenMetadataMonitor creates a component that periodically reads the symbol and the decimals of the bridged ERC20
+// tokens, refreshes its registry when they drift (e.g. after a proxy upgrade) and raises an incident when the decimals
+// of a token change, as the amounts conversion of that token can not be trusted anymore
+func NewTokenMetadataMonitor(args ArgsTokenMetadataMonitor) (*tokenMetadataMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	erc20Tokens := make([]common.Address, 0, len(args.ERC20Tokens))
+	for _, token := range args.ERC20Tokens {
+		if !common.IsHexAddress(token) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTokenAddress, token)
+		}
+		erc20Tokens = append(erc20Tokens, common.HexToAddress(token))
+	}
+
+	monitor := &tokenMetadataMonitor{
+		erc20Tokens:    erc20Tokens,
+		metadataGetter: args.MetadataGetter,
+		dataGetter:     args.DataGetter,
+		storer:         args.Storer,
+		statusHandler:  args.StatusHandler,
+		incidents:      args.Incidents,
+		log:            args.Log,
+		registry:       make(map[string]*tokenMetadata),
+	}
+	monitor.loadRegistry()
+	monitor.updateMetrics()
+
+	return monitor, nil
+}
+
+func checkArgs(args ArgsTokenMetadataMonitor) error {
+	if check.IfNil(args.MetadataGetter) {
+		return ErrNilMetadataGetter
+	}
+	if check.IfNil(args.DataGetter) {
+		return ErrNilDataGetter
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.Incidents) {
+		return ErrNilIncidentsQueue
+	}
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+
+	return nil
+}
+
+func (monitor *tokenMetadataMonitor) loadRegistry() {
+	buff, err := monitor.storer.Get([]byte(storerKey))
+	if err != nil {
+		return
+	}
+
+	registry := make(map[string]*tokenMetadata)
+	err = json.Unmarshal(buff, &registry)
+	if err != nil {
+		monitor.log.Warn("tokenMetadataMonitor: corrupted token metadata registry, discarding", "error", err)
+		return
+	}
+
+	monitor.registry = registry
+}
+
+// Execute reads the metadata of the bridged tokens, compares it with the registry and raises the alerts
+func (monitor *tokenMetadataMonitor) Execute(ctx context.Context) error {
+	tokens, err := monitor.getTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	isRegistryChanged := false
+	for _, token := range tokens {
+		decimals, errDecimals := monitor.metadataGetter.Decimals(ctx, token)
+		if errDecimals != nil {
+			monitor.log.Debug("tokenMetadataMonitor: can not read the token decimals", "token", token.Hex(), "error", errDecimals)
+			continue
+		}
+		// some tokens do not implement the optional symbol method or return it with a non-standard type, the decimals
+		// are still tracked for them
+		symbol, errSymbol := monitor.metadataGetter.Symbol(ctx, token)
+		if errSymbol != nil {
+			monitor.log.Debug("tokenMetadataMonitor: can not read the token symbol", "token", token.Hex(), "error", errSymbol)
+		}
+
+		isRegistryChanged = monitor.checkToken(token.Hex(), symbol, errSymbol == nil, decimals) || isRegistryChanged
+	}
+
+	monitor.updateMetrics()
+	if !isRegistryChanged {
+		return nil
+	}
+
+	return monitor.saveRegistry()
+}
+
+func (monitor *tokenMetadataMonitor) getTokens(ctx context.Context) ([]common.Address, error) {
+	if len(monitor.erc20Tokens) > 0 {
+		return monitor.erc20Tokens, nil
+	}
+
+	esdtTokens, err := monitor.dataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]common.Address, 0, len(esdtTokens))
+	for _, esdtToken := range esdtTokens {
+		response, errPair := monitor.dataGetter.GetERC20AddressForTokenId(ctx, esdtToken)
+		if errPair == nil && (len(response) == 0 || len(response[0]) == 0) {
+			errPair = ErrUnpairedToken
+		}
+		if errPair != nil {
+			monitor.log.Debug("tokenMetadataMonitor: can not get the ERC20 token", "token", string(esdtToken), "error", errPair)
+			continue
+		}
+
+		tokens = append(tokens, common.BytesToAddress(response[0]))
+	}
+
+	return tokens, nil
+}
+
+// checkToken compares the read metadata with the registry, refreshes the registry and returns true if it was changed
+func (monitor *tokenMetadataMonitor) checkToken(token string, symbol string, hasSymbol bool, decimals uint8) bool {
+	monitor.mut.Lock()
+	defer monitor.mut.Unlock()
+
+	known, found := monitor.registry[token]
+	if !found {
+		monitor.registry[token] = &tokenMetadata{
+			Symbol:   symbol,
+			Decimals: decimals,
+		}
+		monitor.log.Info("tokenMetadataMonitor: token registered", "token", token, "symbol", symbol, "decimals", decimals)
+		return true
+	}
+
+	isChanged := false
+	if hasSymbol && known.Symbol != symbol {
+		if len(known.Symbol) > 0 {
+			monitor.log.Warn("tokenMetadataMonitor: the token symbol changed, refreshing the registry",
+				"token", token, "old symbol", known.Symbol, "new symbol", symbol)
+		}
+		known.Symbol = symbol
+		isChanged = true
+	}
+	if known.Decimals != decimals {
+		description := fmt.Sprintf("decimals of the ERC20 token %s (%s) changed from %d to %d, the bridged amounts conversion must be reviewed",
+			token, known.Symbol, known.Decimals, decimals)
+		id := monitor.incidents.Raise(incidentSource, description)
+		monitor.log.Error("tokenMetadataMonitor: the token decimals changed, the amounts of this token will be wrongly converted",
+			"token", token, "symbol", known.Symbol, "old decimals", known.Decimals, "new decimals", decimals, "incident", id)
+		monitor.statusHandler.SetStringMetric("token metadata last decimals change", description)
+		monitor.numDecimalsChanges++
+		known.Decimals = decimals
+		isChanged = true
+	}
+
+	return isChanged
+}
+
+func (monitor *tokenMetadataMonitor) updateMetrics() {
+	monitor.mut.Lock()
+	defer monitor.mut.Unlock()
+
+	monitor.statusHandler.SetIntMetric("token metadata tracked tokens", len(monitor.registry))
+	monitor.statusHandler.SetIntMetric("token metadata decimals changes", monitor.numDecimalsChanges)
+}
+
+func (monitor *tokenMetadataMonitor) saveRegistry() error {
+	monitor.mut.Lock()
+	buff, err := json.Marshal(monitor.registry)
+	monitor.mut.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return monitor.storer.Put([]byte(storerKey), buff)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (monitor *tokenMetadataMonitor) IsInterfaceNil() bool {
+	return monitor == nil
+}
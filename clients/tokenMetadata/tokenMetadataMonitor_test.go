@@ -0,0 +1,262 @@
+package tokenMetadata
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	token1 = common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	token2 = common.HexToAddress("0xA6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c")
+)
+
+func createMockArgsTokenMetadataMonitor() ArgsTokenMetadataMonitor {
+	return ArgsTokenMetadataMonitor{
+		ERC20Tokens:    []string{token1.Hex()},
+		MetadataGetter: &bridgeTests.ERC20ContractsHolderStub{},
+		DataGetter:     &bridgeTests.DataGetterStub{},
+		Storer:         testsCommon.NewStorerMock(),
+		StatusHandler:  testsCommon.NewStatusHandlerMock("token-metadata"),
+		Incidents:      &testsCommon.IncidentsQueueStub{},
+		Log:            &testsCommon.LoggerStub{},
+	}
+}
+
+func createMetadataGetter(symbols map[common.Address]string, decimals map[common.Address]uint8) *bridgeTests.ERC20ContractsHolderStub {
+	return &bridgeTests.ERC20ContractsHolderStub{
+		DecimalsCalled: func(ctx context.Context, erc20Address common.Address) (uint8, error) {
+			return decimals[erc20Address], nil
+		},
+		SymbolCalled: func(ctx context.Context, erc20Address common.Address) (string, error) {
+			return symbols[erc20Address], nil
+		},
+	}
+}
+
+func TestNewTokenMetadataMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metadata getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.MetadataGetter = nil
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilMetadataGetter, err)
+	})
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.DataGetter = nil
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilDataGetter, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.Storer = nil
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.StatusHandler = nil
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil incidents queue should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.Incidents = nil
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilIncidentsQueue, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.Log = nil
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("invalid token address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.ERC20Tokens = append(args.ERC20Tokens, "USDC-123456")
+		monitor, err := NewTokenMetadataMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, ErrInvalidTokenAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		monitor, err := NewTokenMetadataMonitor(createMockArgsTokenMetadataMonitor())
+		assert.False(t, check.IfNil(monitor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokenMetadataMonitor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("get all known tokens errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsTokenMetadataMonitor()
+		args.ERC20Tokens = nil
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllKnownTokensCalled: func(ctx context.Context) ([][]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		monitor, _ := NewTokenMetadataMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("tokens from the MultiversX contract should be registered", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.ERC20Tokens = nil
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetAllKnownTokensCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{[]byte("USDC-123456"), []byte("WETH-123456"), []byte("UNPAIRED-123456")}, nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				switch string(tokenId) {
+				case "USDC-123456":
+					return [][]byte{token1.Bytes()}, nil
+				case "WETH-123456":
+					return [][]byte{token2.Bytes()}, nil
+				default:
+					return make([][]byte, 0), nil
+				}
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("token-metadata")
+		args.StatusHandler = statusHandler
+		args.MetadataGetter = createMetadataGetter(
+			map[common.Address]string{token1: "USDC", token2: "WETH"},
+			map[common.Address]uint8{token1: 6, token2: 18},
+		)
+		monitor, _ := NewTokenMetadataMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, &tokenMetadata{Symbol: "USDC", Decimals: 6}, monitor.registry[token1.Hex()])
+		assert.Equal(t, &tokenMetadata{Symbol: "WETH", Decimals: 18}, monitor.registry[token2.Hex()])
+		assert.Equal(t, 2, statusHandler.GetIntMetric("token metadata tracked tokens"))
+	})
+	t.Run("symbol change should refresh the registry without raising an incident", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		symbols := map[common.Address]string{token1: "USDC"}
+		args.MetadataGetter = createMetadataGetter(symbols, map[common.Address]uint8{token1: 6})
+		args.Incidents = &testsCommon.IncidentsQueueStub{
+			RaiseCalled: func(source string, description string) uint64 {
+				assert.Fail(t, "should have not raised an incident")
+				return 0
+			},
+		}
+		monitor, _ := NewTokenMetadataMonitor(args)
+		require.Nil(t, monitor.Execute(context.Background()))
+
+		symbols[token1] = "USDC.e"
+		require.Nil(t, monitor.Execute(context.Background()))
+		assert.Equal(t, &tokenMetadata{Symbol: "USDC.e", Decimals: 6}, monitor.registry[token1.Hex()])
+	})
+	t.Run("unreadable symbol should keep tracking the decimals", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		args.MetadataGetter = &bridgeTests.ERC20ContractsHolderStub{
+			DecimalsCalled: func(ctx context.Context, erc20Address common.Address) (uint8, error) {
+				return 18, nil
+			},
+			SymbolCalled: func(ctx context.Context, erc20Address common.Address) (string, error) {
+				return "", errors.New("abi: cannot unmarshal [32]uint8 in to string")
+			},
+		}
+		monitor, _ := NewTokenMetadataMonitor(args)
+
+		require.Nil(t, monitor.Execute(context.Background()))
+		assert.Equal(t, &tokenMetadata{Decimals: 18}, monitor.registry[token1.Hex()])
+	})
+	t.Run("decimals change should raise an incident and refresh the registry", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		decimals := map[common.Address]uint8{token1: 6}
+		args.MetadataGetter = createMetadataGetter(map[common.Address]string{token1: "USDC"}, decimals)
+		statusHandler := testsCommon.NewStatusHandlerMock("token-metadata")
+		args.StatusHandler = statusHandler
+		raisedDescriptions := make([]string, 0)
+		args.Incidents = &testsCommon.IncidentsQueueStub{
+			RaiseCalled: func(source string, description string) uint64 {
+				assert.Equal(t, incidentSource, source)
+				raisedDescriptions = append(raisedDescriptions, description)
+				return 1
+			},
+		}
+		monitor, _ := NewTokenMetadataMonitor(args)
+		require.Nil(t, monitor.Execute(context.Background()))
+		assert.Empty(t, raisedDescriptions)
+
+		decimals[token1] = 18
+		require.Nil(t, monitor.Execute(context.Background()))
+		require.Equal(t, 1, len(raisedDescriptions))
+		assert.True(t, strings.Contains(raisedDescriptions[0], "from 6 to 18"))
+		assert.Equal(t, uint8(18), monitor.registry[token1.Hex()].Decimals)
+		assert.Equal(t, 1, statusHandler.GetIntMetric("token metadata decimals changes"))
+		assert.Equal(t, raisedDescriptions[0], statusHandler.GetStringMetric("token metadata last decimals change"))
+
+		// the refreshed registry does not raise the same incident again
+		require.Nil(t, monitor.Execute(context.Background()))
+		assert.Equal(t, 1, len(raisedDescriptions))
+	})
+	t.Run("decimals changed while the relayer was stopped should be detected", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTokenMetadataMonitor()
+		decimals := map[common.Address]uint8{token1: 6}
+		args.MetadataGetter = createMetadataGetter(map[common.Address]string{token1: "USDC"}, decimals)
+		numRaised := 0
+		args.Incidents = &testsCommon.IncidentsQueueStub{
+			RaiseCalled: func(source string, description string) uint64 {
+				numRaised++
+				return 1
+			},
+		}
+		monitor, _ := NewTokenMetadataMonitor(args)
+		require.Nil(t, monitor.Execute(context.Background()))
+
+		decimals[token1] = 8
+		restartedMonitor, _ := NewTokenMetadataMonitor(args)
+		require.Nil(t, restartedMonitor.Execute(context.Background()))
+		assert.Equal(t, 1, numRaised)
+	})
+}
@@ -0,0 +1,27 @@
+package transferReceipts
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilStorer signals that a nil transfer receipts storer has been provided
+var ErrNilStorer = errors.New("nil transfer receipts storer")
+
+// ErrNilDataGetter signals that a nil MultiversX data getter has been provided
+var ErrNilDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrNilPrivateKey signals that a nil private key has been provided
+var ErrNilPrivateKey = errors.New("nil private key")
+
+// ErrNilSingleSigner signals that a nil single signer has been provided
+var ErrNilSingleSigner = errors.New("nil single signer")
+
+// ErrNilTimer signals that a nil timer has been provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrEmptyDirection signals that an empty bridge direction name has been provided
+var ErrEmptyDirection = errors.New("empty direction")
@@ -0,0 +1,20 @@
+package transferReceipts
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// Storer defines the component saving the signed transfer receipts of the executed batches
+type Storer interface {
+	StoreTransferReceipts(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error
+	IsInterfaceNil() bool
+}
+
+// DataGetter defines the component able to return the fee charged by the MultiversX safe contract for a deposit
+type DataGetter interface {
+	GetRequiredFee(ctx context.Context, token []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
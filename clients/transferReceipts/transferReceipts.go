@@ -0,0 +1,234 @@
+package transferReceipts
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsTransferReceipts is the DTO used to create a new instance of type transferReceipts
+type ArgsTransferReceipts struct {
+	Log                      logger.Logger
+	StatusHandler            core.StatusHandler
+	Storer                   Storer
+	DataGetter               DataGetter
+	PrivateKey               crypto.PrivateKey
+	SingleSigner             crypto.SingleSigner
+	Timer                    core.Timer
+	MultiversXToEthDirection string
+}
+
+type transferReceipts struct {
+	log                      logger.Logger
+	statusHandler            core.StatusHandler
+	storer                   Storer
+	dataGetter               DataGetter
+	privateKey               crypto.PrivateKey
+	singleSigner             crypto.SingleSigner
+	timer                    core.Timer
+	multiversXToEthDirection string
+	relayer                  string
+	publicKey                string
+
+	mut          sync.Mutex
+	numGenerated int
+	numFailed    int
+}
+
+// NewTransferReceipts creates the component generating, for each deposit of the batches executed by this relayer, a
+// receipt signed with the relayer's MultiversX key. The receipts let the users prove their transfers completed
+// without relying on a block explorer
+func NewTransferReceipts(args ArgsTransferReceipts) (*transferReceipts, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := args.PrivateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+	relayer, err := data.NewAddressFromBytes(publicKeyBytes).AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return &transferReceipts{
+		log:                      args.Log,
+		statusHandler:            args.StatusHandler,
+		storer:                   args.Storer,
+		dataGetter:               args.DataGetter,
+		privateKey:               args.PrivateKey,
+		singleSigner:             args.SingleSigner,
+		timer:                    args.Timer,
+		multiversXToEthDirection: args.MultiversXToEthDirection,
+		relayer:                  relayer,
+		publicKey:                hex.EncodeToString(publicKeyBytes),
+	}, nil
+}
+
+func checkArgs(args ArgsTransferReceipts) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.DataGetter) {
+		return ErrNilDataGetter
+	}
+	if check.IfNil(args.PrivateKey) {
+		return ErrNilPrivateKey
+	}
+	if check.IfNil(args.SingleSigner) {
+		return ErrNilSingleSigner
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	if len(args.MultiversXToEthDirection) == 0 {
+		return ErrEmptyDirection
+	}
+
+	return nil
+}
+
+// GenerateReceipts signs and stores the receipts of the deposits of the provided batch, executed by this relayer with
+// the destination transaction. The statuses are mapped by deposit nonce, a missing status being reported as unknown.
+// The errors are only logged, as the receipts do not affect the bridge operation
+func (generator *transferReceipts) GenerateReceipts(ctx context.Context, direction string, batch *core.TransferBatch, destinationTxHash string, statuses map[uint64]string) {
+	if batch == nil || len(batch.Deposits) == 0 {
+		return
+	}
+
+	err := generator.generateReceipts(ctx, direction, batch, destinationTxHash, statuses)
+
+	generator.mut.Lock()
+	if err != nil {
+		generator.numFailed++
+	} else {
+		generator.numGenerated += len(batch.Deposits)
+	}
+	generator.statusHandler.SetIntMetric(core.MetricNumTransferReceiptsGenerated, generator.numGenerated)
+	generator.statusHandler.SetIntMetric(core.MetricNumTransferReceiptsFailed, generator.numFailed)
+	generator.mut.Unlock()
+
+	if err != nil {
+		generator.log.Warn("error generating the transfer receipts", "direction", direction, "batch ID", batch.ID,
+			"hash", destinationTxHash, "error", err)
+		return
+	}
+
+	generator.log.Info("generated the transfer receipts", "direction", direction, "batch ID", batch.ID,
+		"num receipts", len(batch.Deposits), "hash", destinationTxHash)
+}
+
+func (generator *transferReceipts) generateReceipts(ctx context.Context, direction string, batch *core.TransferBatch, destinationTxHash string, statuses map[uint64]string) error {
+	fees, err := generator.getFees(ctx, direction, batch)
+	if err != nil {
+		return err
+	}
+
+	timestamp := generator.timer.NowUnix()
+	receipts := make([]*core.SignedTransferReceipt, 0, len(batch.Deposits))
+	for _, deposit := range batch.Deposits {
+		status, found := statuses[deposit.Nonce]
+		if !found {
+			status = core.DepositUnknown
+		}
+
+		receipt := &core.TransferReceipt{
+			Direction:         direction,
+			BatchID:           batch.ID,
+			DepositNonce:      deposit.Nonce,
+			SourceTxHash:      deposit.TxHash,
+			DestinationTxHash: destinationTxHash,
+			From:              deposit.DisplayableFrom,
+			To:                deposit.DisplayableTo,
+			Token:             deposit.DisplayableToken,
+			Amount:            bigIntToString(deposit.Amount),
+			Fee:               bigIntToString(fees[string(deposit.SourceTokenBytes)]),
+			Status:            status,
+			Timestamp:         timestamp,
+		}
+
+		signedReceipt, errSign := generator.sign(receipt)
+		if errSign != nil {
+			return errSign
+		}
+
+		receipts = append(receipts, signedReceipt)
+	}
+
+	return generator.storer.StoreTransferReceipts(direction, batch.ID, receipts)
+}
+
+// getFees returns the fee charged for each token of the batch. Only the MultiversX safe contract charges a fee on
+// deposit, the Ethereum deposits are free of charge
+func (generator *transferReceipts) getFees(ctx context.Context, direction string, batch *core.TransferBatch) (map[string]*big.Int, error) {
+	fees := make(map[string]*big.Int)
+	if direction != generator.multiversXToEthDirection {
+		return fees, nil
+	}
+
+	for _, deposit := range batch.Deposits {
+		token := string(deposit.SourceTokenBytes)
+		_, found := fees[token]
+		if found {
+			continue
+		}
+
+		fee, err := generator.dataGetter.GetRequiredFee(ctx, deposit.SourceTokenBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w while fetching the fee of token %s", err, deposit.DisplayableToken)
+		}
+
+		fees[token] = fee
+	}
+
+	return fees, nil
+}
+
+func (generator *transferReceipts) sign(receipt *core.TransferReceipt) (*core.SignedTransferReceipt, error) {
+	receiptBytes, err := json.Marshal(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := generator.singleSigner.Sign(generator.privateKey, receiptBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.SignedTransferReceipt{
+		Receipt:   receipt,
+		Relayer:   generator.relayer,
+		PublicKey: generator.publicKey,
+		Signature: hex.EncodeToString(signature),
+	}, nil
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (generator *transferReceipts) IsInterfaceNil() bool {
+	return generator == nil
+}
@@ -0,0 +1,301 @@
+package transferReceipts
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	testCrypto "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ethToMultiversXDirection = "EthereumToMultiversX"
+	multiversXToEthDirection = "MultiversXToEthereum"
+	testTimestamp            = int64(1000)
+)
+
+func createMockArgsTransferReceipts() ArgsTransferReceipts {
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return testTimestamp
+	}
+
+	return ArgsTransferReceipts{
+		Log:                      &testsCommon.LoggerStub{},
+		StatusHandler:            testsCommon.NewStatusHandlerMock(core.TransferReceiptsStatusHandlerName),
+		Storer:                   &testsCommon.BatchResultsStorerStub{},
+		DataGetter:               &bridgeTests.DataGetterStub{},
+		PrivateKey:               testCrypto.NewPrivateKeyMock(),
+		SingleSigner:             &testCrypto.SingleSignerStub{},
+		Timer:                    timer,
+		MultiversXToEthDirection: multiversXToEthDirection,
+	}
+}
+
+func createTestBatch() *core.TransferBatch {
+	return &core.TransferBatch{
+		ID: 12,
+		Deposits: []*core.DepositTransfer{
+			{
+				Nonce:            37,
+				DisplayableFrom:  "from 1",
+				DisplayableTo:    "to 1",
+				SourceTokenBytes: []byte("USDC-abcdef"),
+				DisplayableToken: "USDC-abcdef",
+				Amount:           big.NewInt(1000),
+				TxHash:           "source hash 1",
+			},
+			{
+				Nonce:            38,
+				DisplayableFrom:  "from 2",
+				DisplayableTo:    "to 2",
+				SourceTokenBytes: []byte("USDC-abcdef"),
+				DisplayableToken: "USDC-abcdef",
+				Amount:           big.NewInt(2000),
+				TxHash:           "source hash 2",
+			},
+		},
+	}
+}
+
+func TestNewTransferReceipts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.Log = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.StatusHandler = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.Storer = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.DataGetter = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilDataGetter, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.PrivateKey = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilPrivateKey, err)
+	})
+	t.Run("nil single signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.SingleSigner = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilSingleSigner, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.Timer = nil
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilTimer, err)
+	})
+	t.Run("empty direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.MultiversXToEthDirection = ""
+		generator, err := NewTransferReceipts(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrEmptyDirection, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		generator, err := NewTransferReceipts(createMockArgsTransferReceipts())
+		assert.False(t, check.IfNil(generator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTransferReceipts_GenerateReceipts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty batch should not store receipts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		args.Storer = &testsCommon.BatchResultsStorerStub{
+			StoreTransferReceiptsCalled: func(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error {
+				assert.Fail(t, "should have not stored the receipts")
+				return nil
+			},
+		}
+		generator, _ := NewTransferReceipts(args)
+
+		generator.GenerateReceipts(context.Background(), ethToMultiversXDirection, nil, "hash", nil)
+		generator.GenerateReceipts(context.Background(), ethToMultiversXDirection, &core.TransferBatch{ID: 1}, "hash", nil)
+	})
+	t.Run("should sign and store the receipts of an Ethereum batch without fees", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.TransferReceiptsStatusHandlerName)
+		args.StatusHandler = statusHandler
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetRequiredFeeCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				assert.Fail(t, "should have not fetched the fee")
+				return nil, nil
+			},
+		}
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				receipt := &core.TransferReceipt{}
+				err := json.Unmarshal(msg, receipt)
+				require.Nil(t, err)
+
+				return []byte("signature " + receipt.SourceTxHash), nil
+			},
+		}
+		var storedReceipts []*core.SignedTransferReceipt
+		args.Storer = &testsCommon.BatchResultsStorerStub{
+			StoreTransferReceiptsCalled: func(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error {
+				assert.Equal(t, ethToMultiversXDirection, direction)
+				assert.Equal(t, uint64(12), batchID)
+				storedReceipts = receipts
+
+				return nil
+			},
+		}
+		generator, _ := NewTransferReceipts(args)
+
+		statuses := map[uint64]string{
+			37: core.DepositExecuted,
+		}
+		generator.GenerateReceipts(context.Background(), ethToMultiversXDirection, createTestBatch(), "destination hash", statuses)
+
+		publicKeyBytes, _ := args.PrivateKey.GeneratePublic().ToByteArray()
+		relayer, _ := data.NewAddressFromBytes(publicKeyBytes).AddressAsBech32String()
+		require.Equal(t, 2, len(storedReceipts))
+		assert.Equal(t, &core.SignedTransferReceipt{
+			Receipt: &core.TransferReceipt{
+				Direction:         ethToMultiversXDirection,
+				BatchID:           12,
+				DepositNonce:      37,
+				SourceTxHash:      "source hash 1",
+				DestinationTxHash: "destination hash",
+				From:              "from 1",
+				To:                "to 1",
+				Token:             "USDC-abcdef",
+				Amount:            "1000",
+				Fee:               "0",
+				Status:            core.DepositExecuted,
+				Timestamp:         testTimestamp,
+			},
+			Relayer:   relayer,
+			PublicKey: hex.EncodeToString(publicKeyBytes),
+			Signature: hex.EncodeToString([]byte("signature source hash 1")),
+		}, storedReceipts[0])
+		assert.Equal(t, core.DepositUnknown, storedReceipts[1].Receipt.Status)
+		assert.Equal(t, hex.EncodeToString([]byte("signature source hash 2")), storedReceipts[1].Signature)
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumTransferReceiptsGenerated))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumTransferReceiptsFailed))
+	})
+	t.Run("should include the fees of a MultiversX batch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTransferReceipts()
+		numFeeQueries := 0
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetRequiredFeeCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				assert.Equal(t, []byte("USDC-abcdef"), token)
+				numFeeQueries++
+
+				return big.NewInt(50), nil
+			},
+		}
+		var storedReceipts []*core.SignedTransferReceipt
+		args.Storer = &testsCommon.BatchResultsStorerStub{
+			StoreTransferReceiptsCalled: func(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error {
+				assert.Equal(t, multiversXToEthDirection, direction)
+				storedReceipts = receipts
+
+				return nil
+			},
+		}
+		generator, _ := NewTransferReceipts(args)
+
+		generator.GenerateReceipts(context.Background(), multiversXToEthDirection, createTestBatch(), "destination hash", nil)
+
+		assert.Equal(t, 1, numFeeQueries)
+		require.Equal(t, 2, len(storedReceipts))
+		assert.Equal(t, "50", storedReceipts[0].Receipt.Fee)
+		assert.Equal(t, "50", storedReceipts[1].Receipt.Fee)
+	})
+	t.Run("errors should not store receipts and should count the failures", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsTransferReceipts()
+		statusHandler := testsCommon.NewStatusHandlerMock(core.TransferReceiptsStatusHandlerName)
+		args.StatusHandler = statusHandler
+		args.DataGetter = &bridgeTests.DataGetterStub{
+			GetRequiredFeeCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		args.SingleSigner = &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		args.Storer = &testsCommon.BatchResultsStorerStub{
+			StoreTransferReceiptsCalled: func(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error {
+				assert.Fail(t, "should have not stored the receipts")
+				return nil
+			},
+		}
+		generator, _ := NewTransferReceipts(args)
+
+		generator.GenerateReceipts(context.Background(), multiversXToEthDirection, createTestBatch(), "hash", nil)
+		generator.GenerateReceipts(context.Background(), ethToMultiversXDirection, createTestBatch(), "hash", nil)
+
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricNumTransferReceiptsGenerated))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(core.MetricNumTransferReceiptsFailed))
+	})
+}
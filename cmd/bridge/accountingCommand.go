@@ -0,0 +1,205 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/accounting"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-bridge-eth-go/results"
+	"github.com/urfave/cli"
+)
+
+var (
+	accountingPeriod = cli.StringFlag{
+		Name:  "period",
+		Usage: "The accounting period, formatted as YYYY-MM. Defaults to the current month.",
+	}
+	accountingLedgers = cli.StringSliceFlag{
+		Name:  "ledger",
+		Usage: "The `" + filePathPlaceholder + "` of a ledger exported by a consortium member. Can be repeated, once for each member.",
+	}
+	accountingFormat = cli.StringFlag{
+		Name:  "format",
+		Usage: "The statement format: " + accounting.JSONFormat + " or " + accounting.CSVFormat + ".",
+		Value: accounting.JSONFormat,
+	}
+	accountingFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `" + filePathPlaceholder + "` where the file will be written. Defaults to <command>-<period>.<format>.",
+	}
+)
+
+func getAccountingCommand() cli.Command {
+	return cli.Command{
+		Name:  "accounting",
+		Usage: "Cost sharing accounting helpers for the relayer consortiums",
+		Subcommands: []cli.Command{
+			{
+				Name: "ledger",
+				Usage: "Exports the execution costs paid by this relayer in the period, as JSON. The relayer should be stopped " +
+					"as the command opens its database",
+				Flags:  []cli.Flag{accountingPeriod, accountingFile},
+				Action: exportLedger,
+			},
+			{
+				Name:   "settlement",
+				Usage:  "Computes the settlement statement of the period from the ledgers of all the consortium members",
+				Flags:  []cli.Flag{accountingPeriod, accountingLedgers, accountingFormat, accountingFile},
+				Action: generateSettlement,
+			},
+		},
+	}
+}
+
+func getAccountingPeriod(ctx *cli.Context) (string, error) {
+	period := ctx.String(accountingPeriod.Name)
+	if len(period) == 0 {
+		return time.Now().UTC().Format(accounting.PeriodFormat), nil
+	}
+
+	_, err := time.Parse(accounting.PeriodFormat, period)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s, expected format YYYY-MM", accounting.ErrInvalidPeriod, period)
+	}
+
+	return period, nil
+}
+
+func exportLedger(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+	period, err := getAccountingPeriod(ctx)
+	if err != nil {
+		return err
+	}
+
+	dbFullPath := path.Join(flagsConfig.WorkingDir, dbPath)
+	storer, err := factory.CreateUnitStorer(cfg.Relayer.BatchResultsStorage, dbFullPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	batchResults, err := results.NewResultsStorer(results.ArgsResultsStorer{
+		Storer: storer,
+	})
+	if err != nil {
+		return err
+	}
+	costs, err := batchResults.GetExecutionCosts(period)
+	if err != nil {
+		return err
+	}
+	buff, err := json.MarshalIndent(costs, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filename := ctx.String(accountingFile.Name)
+	if len(filename) == 0 {
+		filename = fmt.Sprintf("ledger-%s.%s", period, accounting.JSONFormat)
+	}
+	err = os.WriteFile(filename, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("exported %d execution costs of %s in %s\n", len(costs), period, filename)
+
+	return nil
+}
+
+func generateSettlement(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+	period, err := getAccountingPeriod(ctx)
+	if err != nil {
+		return err
+	}
+
+	costs := make([]*core.ExecutionCost, 0)
+	for _, ledgerFile := range ctx.StringSlice(accountingLedgers.Name) {
+		ledgerCosts, errLoad := loadLedger(ledgerFile)
+		if errLoad != nil {
+			return errLoad
+		}
+		costs = append(costs, ledgerCosts...)
+	}
+
+	settlement, err := accounting.ComputeSettlement(accounting.ArgsSettlement{
+		Period:    period,
+		SplitRule: cfg.Relayer.CostAccounting.SplitRule,
+		Members:   getCostSharingMembers(cfg.Relayer.CostAccounting),
+		Costs:     costs,
+	})
+	if err != nil {
+		return err
+	}
+
+	format := ctx.String(accountingFormat.Name)
+	filename := ctx.String(accountingFile.Name)
+	if len(filename) == 0 {
+		filename = fmt.Sprintf("settlement-%s.%s", period, format)
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	err = settlement.Export(file, format)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("exported the %s settlement statement in %s\n", period, filename)
+
+	return nil
+}
+
+func loadLedger(filename string) ([]*core.ExecutionCost, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	costs := make([]*core.ExecutionCost, 0)
+	err = json.Unmarshal(buff, &costs)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the ledger %s", err, filename)
+	}
+
+	return costs, nil
+}
+
+func getCostSharingMembers(cfg config.CostAccountingConfig) map[string]uint64 {
+	members := make(map[string]uint64, len(cfg.Members))
+	for _, member := range cfg.Members {
+		members[member.Relayer] = member.Weight
+	}
+
+	return members
+}
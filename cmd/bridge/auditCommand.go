@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/multiversx/mx-bridge-eth-go/audit"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/timer"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/urfave/cli"
+)
+
+var (
+	auditExportFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `" + filePathPlaceholder + "` for the JSON file where the audit log entries will be exported.",
+		Value: "signer-audit-log.json",
+	}
+)
+
+func getAuditCommand() cli.Command {
+	return cli.Command{
+		Name:  "audit",
+		Usage: "Signer audit log helpers. The relayer should be stopped as the commands open its database",
+		Subcommands: []cli.Command{
+			{
+				Name:   "export",
+				Usage:  "Exports all the signer audit log entries in a JSON file",
+				Flags:  []cli.Flag{auditExportFile},
+				Action: exportSignerAuditLog,
+			},
+			{
+				Name:   "verify",
+				Usage:  "Verifies that no signer audit log entry was removed or altered",
+				Action: verifySignerAuditLog,
+			},
+		},
+	}
+}
+
+func exportSignerAuditLog(ctx *cli.Context) error {
+	return processStoredSignerAuditLog(ctx, func(auditLog storedSignerAuditLog) error {
+		entries, err := auditLog.Entries()
+		if err != nil {
+			return err
+		}
+
+		buff, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		filename := ctx.String(auditExportFile.Name)
+		err = os.WriteFile(filename, buff, 0644)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("exported %d signer audit log entries in %s\n", len(entries), filename)
+
+		return nil
+	})
+}
+
+func verifySignerAuditLog(ctx *cli.Context) error {
+	return processStoredSignerAuditLog(ctx, func(auditLog storedSignerAuditLog) error {
+		err := auditLog.Verify()
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("the signer audit log is valid")
+
+		return nil
+	})
+}
+
+type storedSignerAuditLog interface {
+	Entries() ([]*audit.Entry, error)
+	Verify() error
+}
+
+func processStoredSignerAuditLog(ctx *cli.Context, handler func(auditLog storedSignerAuditLog) error) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	dbFullPath := path.Join(flagsConfig.WorkingDir, dbPath)
+	storer, err := factory.CreateUnitStorer(cfg.Relayer.SignerAuditLog.Storage, dbFullPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	auditLog, err := audit.NewSignerAuditLog(audit.ArgsSignerAuditLog{
+		Name:   core.SignerAuditLogName,
+		Storer: storer,
+		Timer:  timer.NewNTPTimer(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return handler(auditLog)
+}
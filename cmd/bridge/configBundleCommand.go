@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/configBundle"
+	"github.com/multiversx/mx-bridge-eth-go/core/converters"
+	"github.com/urfave/cli"
+)
+
+var (
+	bundleContentFile = cli.StringFlag{
+		Name:  "content",
+		Usage: "The `" + filePathPlaceholder + "` for the JSON file containing the bundle content.",
+		Value: "bundle-content.json",
+	}
+	bundleGovernanceKeyFile = cli.StringFlag{
+		Name:  "governance-key",
+		Usage: "The `" + filePathPlaceholder + "` for the file containing the hex encoded governance private key.",
+		Value: "keys/governance.sk",
+	}
+	bundleOutputFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `" + filePathPlaceholder + "` for the signed bundle file.",
+		Value: "bundle.json",
+	}
+)
+
+func getConfigBundleCommand() cli.Command {
+	return cli.Command{
+		Name:  "config-bundle",
+		Usage: "Signed configuration bundle helpers",
+		Subcommands: []cli.Command{
+			{
+				Name:  "sign",
+				Usage: "Signs the bundle content with the governance key and writes the bundle to be served to the relayers",
+				Flags: []cli.Flag{
+					bundleContentFile,
+					bundleGovernanceKeyFile,
+					bundleOutputFile,
+				},
+				Action: signConfigBundle,
+			},
+		},
+	}
+}
+
+func signConfigBundle(ctx *cli.Context) error {
+	content, err := os.ReadFile(ctx.String(bundleContentFile.Name))
+	if err != nil {
+		return err
+	}
+
+	privateKeyBytes, err := os.ReadFile(ctx.String(bundleGovernanceKeyFile.Name))
+	if err != nil {
+		return err
+	}
+	privateKey, err := ethCrypto.HexToECDSA(converters.TrimWhiteSpaceCharacters(string(privateKeyBytes)))
+	if err != nil {
+		return err
+	}
+
+	bundle, err := configBundle.SignBundle(content, privateKey)
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	filename := ctx.String(bundleOutputFile.Name)
+	err = os.WriteFile(filename, buff, 0644)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("bundle signed by %s written in %s\n", ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex(), filename)
+
+	return nil
+}
+
+func applyConfigBundle(cfg config.Config) config.Config {
+	bundleConfig := cfg.ConfigBundle
+	if !bundleConfig.Enabled {
+		return cfg
+	}
+
+	timeout := time.Second * time.Duration(bundleConfig.RequestTimeoutInSeconds)
+	fetcher, err := configBundle.NewBundleFetcher(configBundle.ArgsBundleFetcher{
+		HTTPClient:        &http.Client{Timeout: timeout},
+		URL:               bundleConfig.URL,
+		GovernanceAddress: bundleConfig.GovernanceAddress,
+	})
+	if err != nil {
+		log.Error("can not create the configuration bundle fetcher, using the local configuration", "error", err)
+		return cfg
+	}
+
+	newConfig, err := fetcher.FetchAndApply(context.Background(), cfg)
+	if err != nil {
+		log.Error("can not apply the configuration bundle, using the local configuration", "error", err)
+		return cfg
+	}
+
+	return newConfig
+}
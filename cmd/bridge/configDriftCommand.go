@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/urfave/cli"
+)
+
+const (
+	configDriftRequestTimeout = time.Second * 30
+	configDriftRoute          = "/admin/config-drift"
+)
+
+var (
+	configDriftAddress = cli.StringFlag{
+		Name: "address",
+		Usage: "The `address and port` of the REST API of the running relayer. Defaults to the value of the " +
+			restApiInterface.Name + " flag.",
+	}
+	configDriftFailOnDrift = cli.BoolFlag{
+		Name:  "fail-on-drift",
+		Usage: "Boolean option for exiting with an error if any drift is found, useful for the fleet checks.",
+	}
+)
+
+// errConfigDriftFound signals that the running config differs from the config file
+var errConfigDriftFound = errors.New("config drift found")
+
+type configDriftResponse struct {
+	Data struct {
+		Report *core.ConfigDriftReport `json:"report"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+func getConfigDriftCommand() cli.Command {
+	return cli.Command{
+		Name:  "config",
+		Usage: "Running config helpers",
+		Subcommands: []cli.Command{
+			{
+				Name: "drift",
+				Usage: "Asks the running relayer for the values it runs with that differ from its config file, e.g. " +
+					"the changes done at runtime and not persisted or the config file changes needing a restart. The " +
+					"admin token is read from the environment variable set in the API config file, if enabled",
+				Flags:  []cli.Flag{configDriftAddress, configDriftFailOnDrift},
+				Action: reportConfigDrift,
+			},
+		},
+	}
+}
+
+func reportConfigDrift(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	apiRoutesConfig, err := loadApiConfig(flagsConfig.ConfigurationApiFile)
+	if err != nil {
+		return err
+	}
+
+	address := ctx.String(configDriftAddress.Name)
+	if len(address) == 0 {
+		address = flagsConfig.RestApiInterface
+	}
+	token := ""
+	if apiRoutesConfig.AdminAuth.Enabled {
+		token = os.Getenv(apiRoutesConfig.AdminAuth.TokenEnvVariable)
+	}
+
+	report, err := fetchConfigDrift(address, token)
+	if err != nil {
+		return err
+	}
+
+	printConfigDrift(os.Stdout, report)
+	isDrifted := len(report.Drifts) > 0 || len(report.ChangedLoggers) > 0
+	if isDrifted && ctx.Bool(configDriftFailOnDrift.Name) {
+		return errConfigDriftFound
+	}
+
+	return nil
+}
+
+func fetchConfigDrift(address string, token string) (*core.ConfigDriftReport, error) {
+	if strings.HasPrefix(address, ":") {
+		address = "localhost" + address
+	}
+	if !strings.Contains(address, "://") {
+		address = "http://" + address
+	}
+
+	requestCtx, cancel := context.WithTimeout(context.Background(), configDriftRequestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(requestCtx, http.MethodGet, address+configDriftRoute, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(token) > 0 {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	driftResponse := &configDriftResponse{}
+	err = json.Unmarshal(body, driftResponse)
+	if err != nil {
+		return nil, fmt.Errorf("%w, HTTP status %d", err, response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP status %d: %s", response.StatusCode, driftResponse.Error)
+	}
+	if driftResponse.Data.Report == nil {
+		return nil, fmt.Errorf("no config drift report in the response of %s", address)
+	}
+
+	return driftResponse.Data.Report, nil
+}
+
+func printConfigDrift(writer io.Writer, report *core.ConfigDriftReport) {
+	if len(report.Drifts) == 0 && len(report.ChangedLoggers) == 0 {
+		_, _ = fmt.Fprintf(writer, "no drift, the relayer runs with the settings of %s\n", report.ConfigFile)
+		return
+	}
+
+	for _, drift := range report.Drifts {
+		_, _ = fmt.Fprintf(writer, "%s: running %s, %s %s\n", drift.Path, drift.RunningValue, report.ConfigFile, drift.FileValue)
+	}
+	for _, changedLogger := range report.ChangedLoggers {
+		_, _ = fmt.Fprintf(writer, "logger %s: level %s set through the admin API\n", changedLogger.Identifier, changedLogger.Level)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
+	"github.com/multiversx/mx-bridge-eth-go/relayer"
+	"github.com/multiversx/mx-chain-communication-go/p2p/libp2p"
+	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
+)
+
+const devClusterRelayerDirFormat = "relayer%d"
+
+type runCloser interface {
+	Run(ctx context.Context) error
+	Close() error
+}
+
+// startDevCluster starts the configured number of relayers in this process. The relayers are connected through
+// in-memory messengers and each one uses its own indexed key files, database directory and REST API port
+func startDevCluster(cfg config.Config, apiRoutesConfig config.ApiRoutesConfig, flagsConfig config.ContextFlagsConfig) error {
+	numRelayers := flagsConfig.DevClusterSize
+	log.Warn("starting an in-process dev cluster, this should only be used for local development", "num relayers", numRelayers)
+
+	messengers, err := createDevClusterMessengers(cfg, numRelayers)
+	if err != nil {
+		return err
+	}
+
+	// a close signal, or a relayer failing to start, stops the whole cluster
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	instances := make([]runCloser, 0, numRelayers)
+	defer func() {
+		for _, instance := range instances {
+			log.LogIfError(instance.Close())
+		}
+	}()
+
+	for i := 0; i < numRelayers; i++ {
+		relayerCfg, relayerFlags, errAdjust := adjustDevClusterRelayerConfigs(cfg, flagsConfig, i)
+		if errAdjust != nil {
+			return errAdjust
+		}
+
+		dbFullPath := path.Join(flagsConfig.WorkingDir, dbPath, fmt.Sprintf(devClusterRelayerDirFormat, i))
+		argsRelayer := createArgsRelayer(relayerCfg, apiRoutesConfig, relayerFlags, dbFullPath)
+		argsRelayer.Messenger = messengers[i]
+		instance, errCreate := relayer.New(ctx, argsRelayer)
+		if errCreate != nil {
+			return fmt.Errorf("%w for dev cluster relayer %d", errCreate, i)
+		}
+		instances = append(instances, instance)
+
+		log.Info("created dev cluster relayer", "index", i,
+			"Ethereum key", relayerCfg.Eth.PrivateKeyFile,
+			"MultiversX key", relayerCfg.MultiversX.PrivateKeyFile,
+			"REST API", relayerFlags.RestApiInterface)
+	}
+
+	errs := make(chan error, numRelayers)
+	for i, instance := range instances {
+		go func(index int, instance runCloser) {
+			errRun := instance.Run(ctx)
+			if errRun != nil {
+				errRun = fmt.Errorf("%w while running dev cluster relayer %d", errRun, index)
+			}
+			errs <- errRun
+		}(i, instance)
+	}
+
+	log.Info("dev cluster running", "num relayers", numRelayers)
+
+	for range instances {
+		errRun := <-errs
+		if errRun != nil {
+			err = errRun
+			stop()
+		}
+	}
+
+	log.Info("application closing, calling Close on all dev cluster relayers...")
+
+	return err
+}
+
+func createDevClusterMessengers(cfg config.Config, numRelayers int) ([]p2p.NetMessenger, error) {
+	marshaller, err := factoryMarshaller.NewMarshalizer(cfg.Relayer.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	mockNet := mocknet.New()
+	messengers := make([]p2p.NetMessenger, 0, numRelayers)
+	for i := 0; i < numRelayers; i++ {
+		args, errArgs := relayer.CreateNetMessengerArgs(log, cfg, marshaller)
+		if errArgs != nil {
+			return nil, errArgs
+		}
+		args.P2pConfig.KadDhtPeerDiscovery.Enabled = false
+
+		messenger, errCreate := libp2p.NewMockMessenger(args, mockNet)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		messengers = append(messengers, messenger)
+	}
+
+	err = mockNet.LinkAll()
+	if err != nil {
+		return nil, err
+	}
+
+	err = mockNet.ConnectAllButSelf()
+	if err != nil {
+		return nil, err
+	}
+
+	return messengers, nil
+}
+
+func adjustDevClusterRelayerConfigs(
+	cfg config.Config,
+	flagsConfig config.ContextFlagsConfig,
+	index int,
+) (config.Config, config.ContextFlagsConfig, error) {
+	cfg.Eth.PrivateKeyFile = indexedFilePath(cfg.Eth.PrivateKeyFile, index)
+	cfg.Eth.PrivateKeyMnemonic.MnemonicFile = indexedFilePath(cfg.Eth.PrivateKeyMnemonic.MnemonicFile, index)
+	cfg.Eth.PrivateKeyKeystore.KeystoreFile = indexedFilePath(cfg.Eth.PrivateKeyKeystore.KeystoreFile, index)
+	cfg.MultiversX.PrivateKeyFile = indexedFilePath(cfg.MultiversX.PrivateKeyFile, index)
+	cfg.MultiversX.PrivateKeyMnemonic.MnemonicFile = indexedFilePath(cfg.MultiversX.PrivateKeyMnemonic.MnemonicFile, index)
+	cfg.MultiversX.PrivateKeyKeystore.KeystoreFile = indexedFilePath(cfg.MultiversX.PrivateKeyKeystore.KeystoreFile, index)
+
+	restApiInterface, err := indexedRestApiInterface(flagsConfig.RestApiInterface, index)
+	if err != nil {
+		return config.Config{}, config.ContextFlagsConfig{}, err
+	}
+	flagsConfig.RestApiInterface = restApiInterface
+
+	return cfg, flagsConfig, nil
+}
+
+// indexedFilePath inserts the index before the file extension: keys/ethereum.sk becomes keys/ethereum2.sk
+func indexedFilePath(filePath string, index int) string {
+	if len(filePath) == 0 {
+		return filePath
+	}
+
+	extension := filepath.Ext(filePath)
+	return fmt.Sprintf("%s%d%s", strings.TrimSuffix(filePath, extension), index, extension)
+}
+
+// indexedRestApiInterface adds the index to the port of the provided interface so each relayer gets its own REST API
+func indexedRestApiInterface(restApiInterface string, index int) (string, error) {
+	if restApiInterface == core.WebServerOffString {
+		return restApiInterface, nil
+	}
+
+	host, port, err := net.SplitHostPort(restApiInterface)
+	if err != nil {
+		return "", fmt.Errorf("%w for the REST API interface %s", err, restApiInterface)
+	}
+
+	portValue, err := strconv.Atoi(port)
+	if err != nil {
+		return "", fmt.Errorf("%w for the REST API interface %s", err, restApiInterface)
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(portValue+index)), nil
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/clients/balanceMonitor"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchDiagnosis"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-bridge-eth-go/core/converters"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-bridge-eth-go/relayer"
+	"github.com/urfave/cli"
+)
+
+const (
+	diagnoseRequestsTimeout = time.Minute
+	nativeCurrencyDecimals  = 18
+)
+
+var (
+	diagnoseBatchID = cli.Uint64Flag{
+		Name:  "batch-id",
+		Usage: "The `ID` of the stuck batch, on its source chain.",
+	}
+	diagnoseDirection = cli.StringFlag{
+		Name: "direction",
+		Usage: "The `direction` of the batch: " + string(batchProcessor.ToMultiversX) + " for an Ethereum batch or " +
+			string(batchProcessor.FromMultiversX) + " for a MultiversX batch. Defaults to both directions.",
+	}
+	diagnoseActionID = cli.Uint64Flag{
+		Name: "action-id",
+		Usage: "The MultiversX action `ID` logged by the relayers when proposing the transfer of an Ethereum batch. " +
+			"Enables the signatures check.",
+	}
+)
+
+type batchDiagnoser interface {
+	Diagnose(ctx context.Context, direction batchProcessor.Direction, batchID uint64, actionID uint64) ([]*batchDiagnosis.Finding, error)
+}
+
+func getDiagnoseCommand() cli.Command {
+	return cli.Command{
+		Name: "diagnose",
+		Usage: "Walks, using only read-only queries, the preconditions of a stuck batch (proposal, signatures, " +
+			"quorum, paused contracts, balances, gas) and prints the probable blockers first",
+		Flags:  []cli.Flag{diagnoseBatchID, diagnoseDirection, diagnoseActionID},
+		Action: diagnoseBatch,
+	}
+}
+
+func diagnoseBatch(ctx *cli.Context) error {
+	batchID := ctx.Uint64(diagnoseBatchID.Name)
+	if batchID == 0 {
+		return fmt.Errorf("the --%s flag is required", diagnoseBatchID.Name)
+	}
+
+	directions, err := getDiagnoseDirections(ctx.String(diagnoseDirection.Name))
+	if err != nil {
+		return err
+	}
+
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	if err != nil {
+		return err
+	}
+	defer ethClient.Close()
+
+	diagnosis, err := createBatchDiagnosis(cfg, ethClient)
+	if err != nil {
+		return err
+	}
+
+	requestsCtx, cancel := context.WithTimeout(context.Background(), diagnoseRequestsTimeout)
+	defer cancel()
+
+	for _, direction := range directions {
+		findings, errDiagnose := diagnosis.Diagnose(requestsCtx, direction, batchID, ctx.Uint64(diagnoseActionID.Name))
+		if errDiagnose != nil {
+			return errDiagnose
+		}
+
+		fmt.Printf("%s batch %d:\n", direction, batchID)
+		for index, finding := range findings {
+			fmt.Printf("  %d. [%s] %s: %s\n", index+1, finding.Severity, finding.Check, finding.Message)
+		}
+	}
+
+	return nil
+}
+
+func getDiagnoseDirections(value string) ([]batchProcessor.Direction, error) {
+	direction := batchProcessor.Direction(value)
+	switch direction {
+	case "":
+		return []batchProcessor.Direction{batchProcessor.ToMultiversX, batchProcessor.FromMultiversX}, nil
+	case batchProcessor.ToMultiversX, batchProcessor.FromMultiversX:
+		return []batchProcessor.Direction{direction}, nil
+	default:
+		return nil, fmt.Errorf("invalid direction %s, the available options are %s and %s",
+			value, batchProcessor.ToMultiversX, batchProcessor.FromMultiversX)
+	}
+}
+
+func createEthereumChainWrapper(chainConfigs config.EthereumConfig, dialedEthClient *ethclient.Client) (ethereum.ClientWrapper, error) {
+	ethClient, err := relayer.CreateEthereumBackend(log, chainConfigs, dialedEthClient)
+	if err != nil {
+		return nil, err
+	}
+
+	multiSigInstance, err := contract.NewBridge(ethCommon.HexToAddress(chainConfigs.MultisigContractAddress), ethClient)
+	if err != nil {
+		return nil, err
+	}
+	safeInstance, err := contract.NewERC20Safe(ethCommon.HexToAddress(chainConfigs.SafeContractAddress), ethClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return wrappers.NewEthereumChainWrapper(wrappers.ArgsEthereumChainWrapper{
+		StatusHandler:    disabled.NewDisabledStatusHandler(),
+		MultiSigContract: multiSigInstance,
+		SafeContract:     safeInstance,
+		BlockchainClient: ethClient,
+	})
+}
+
+func createBatchDiagnosis(cfg config.Config, dialedEthClient *ethclient.Client) (batchDiagnoser, error) {
+	clientWrapper, err := createEthereumChainWrapper(cfg.Eth, dialedEthClient)
+	if err != nil {
+		return nil, err
+	}
+
+	ethereumRelayerAddress, err := factory.LoadEthereumRelayerAddress(cfg.Eth)
+	if err != nil {
+		return nil, err
+	}
+	ethereumRelayerBalance, err := balanceMonitor.NewEthereumBalanceGetter(clientWrapper, ethereumRelayerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	multiversXRelayerAddress, err := factory.LoadMultiversXRelayerAddress(cfg.MultiversX)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err := createMultiversXProxy(cfg.MultiversX)
+	if err != nil {
+		return nil, err
+	}
+	dataGetter, err := createMultiversXDataGetter(cfg.MultiversX, multiversXRelayerAddress, proxy)
+	if err != nil {
+		return nil, err
+	}
+	multiversXRelayerBalance, err := balanceMonitor.NewMultiversXBalanceGetter(proxy, multiversXRelayerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds := cfg.Relayer.BalanceMonitor
+	ethereumMinimumBalance, err := balanceMonitor.ParseDenominatedAmount(thresholds.Ethereum.MinimumBalance, nativeCurrencyDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w for Relayer.BalanceMonitor.Ethereum.MinimumBalance", err)
+	}
+	multiversXMinimumBalance, err := balanceMonitor.ParseDenominatedAmount(thresholds.MultiversX.MinimumBalance, nativeCurrencyDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w for Relayer.BalanceMonitor.MultiversX.MinimumBalance", err)
+	}
+
+	addressConverter, err := converters.NewAddressConverter()
+	if err != nil {
+		return nil, err
+	}
+
+	return batchDiagnosis.NewBatchDiagnosis(batchDiagnosis.ArgsBatchDiagnosis{
+		EthereumChain:            clientWrapper,
+		MultiversXChain:          dataGetter,
+		EthereumRelayerBalance:   ethereumRelayerBalance,
+		MultiversXRelayerBalance: multiversXRelayerBalance,
+		EthereumMinimumBalance:   ethereumMinimumBalance,
+		MultiversXMinimumBalance: multiversXMinimumBalance,
+		AddressConverter:         addressConverter,
+	})
+}
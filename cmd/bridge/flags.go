@@ -1,12 +1,19 @@
 package main
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/precedence"
+	"github.com/multiversx/mx-bridge-eth-go/config/profiles"
 	"github.com/multiversx/mx-chain-go/facade"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
 
+// envPrefix is prepended to the environment variables that can replace the flags and the config values
+const envPrefix = "BRIDGE_"
+
 var (
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -75,10 +82,52 @@ var (
 		Name:  "log-logger-name",
 		Usage: "Boolean option for logger name in the logs.",
 	}
+	// devCluster starts several in-process relayers connected through in-memory messengers, for local development
+	devCluster = cli.IntFlag{
+		Name: "dev-cluster",
+		Usage: "Starts the provided `number` of in-process relayers connected through in-memory messengers. " +
+			"Each relayer uses its own key files, obtained by adding its index before the file extension " +
+			"(keys/ethereum.sk becomes keys/ethereum0.sk). For local development only.",
+		Value: 0,
+	}
+	// configOverrides overrides config values, it takes precedence over the environment variables and the config file
+	configOverrides = cli.StringSliceFlag{
+		Name: "set",
+		Usage: "Overrides the config value found at the provided `Path=value`, for example " +
+			"-set Eth.NetworkAddress=http://127.0.0.1:8545. Can be used multiple times. The slices are provided as " +
+			"comma-separated values. The config values can also be provided through the " + envPrefix + "<PATH> " +
+			"environment variables, where the path separators are replaced with underscores.",
+	}
+	// printEffectiveConfig prints the merged config, with the secrets masked, and exits
+	printEffectiveConfig = cli.BoolFlag{
+		Name: "print-effective-config",
+		Usage: "Boolean option for printing the effective config, obtained by applying the flags, the environment " +
+			"variables and the config file, in this order of precedence, over the defaults. The secrets are masked. " +
+			"The application exits after printing.",
+	}
+	// exportConfigSchema prints the JSON Schema of the config file and exits
+	exportConfigSchema = cli.BoolFlag{
+		Name: "export-config-schema",
+		Usage: "Boolean option for printing the JSON Schema of the config file, for the editors autocompletion and " +
+			"the external config linting. The application exits after printing.",
+	}
+	// profile selects a named configuration profile, replacing the configuration files with the ones of the profile
+	profile = cli.StringFlag{
+		Name: "profile",
+		Usage: "The `name` of the configuration profile to run with. A profile is a directory, found in the profiles " +
+			"directory, holding its own " + profiles.ConfigFileName + " and, optionally, " + profiles.ApiConfigFileName +
+			". Can not be used together with the -" + configurationFile.Name + " flag.",
+	}
+	// profilesDirectory defines the directory where the configuration profiles are searched
+	profilesDirectory = cli.StringFlag{
+		Name:  "profiles-directory",
+		Usage: "The `directory` holding the configuration profiles.",
+		Value: "config/profiles",
+	}
 )
 
 func getFlags() []cli.Flag {
-	return []cli.Flag{
+	return precedence.WithEnvVars(envPrefix, []cli.Flag{
 		workingDirectory,
 		logLevel,
 		disableAnsiColor,
@@ -88,9 +137,16 @@ func getFlags() []cli.Flag {
 		logWithLoggerName,
 		profileMode,
 		restApiInterface,
-	}
+		devCluster,
+		configOverrides,
+		printEffectiveConfig,
+		exportConfigSchema,
+		profile,
+		profilesDirectory,
+	})
 }
-func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
+
+func getFlagsConfig(ctx *cli.Context) (config.ContextFlagsConfig, error) {
 	flagsConfig := config.ContextFlagsConfig{}
 
 	flagsConfig.WorkingDir = ctx.GlobalString(workingDirectory.Name)
@@ -102,6 +158,29 @@ func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 	flagsConfig.EnableLogName = ctx.GlobalBool(logWithLoggerName.Name)
 	flagsConfig.EnablePprof = ctx.GlobalBool(profileMode.Name)
 	flagsConfig.RestApiInterface = ctx.GlobalString(restApiInterface.Name)
+	flagsConfig.DevClusterSize = ctx.GlobalInt(devCluster.Name)
+	flagsConfig.ConfigOverrides = ctx.GlobalStringSlice(configOverrides.Name)
+	flagsConfig.PrintEffectiveConfig = ctx.GlobalBool(printEffectiveConfig.Name)
+	flagsConfig.ExportConfigSchema = ctx.GlobalBool(exportConfigSchema.Name)
+	flagsConfig.Profile = ctx.GlobalString(profile.Name)
+	flagsConfig.ProfilesDirectory = ctx.GlobalString(profilesDirectory.Name)
+
+	if len(flagsConfig.Profile) == 0 {
+		return flagsConfig, nil
+	}
+	if ctx.GlobalIsSet(configurationFile.Name) {
+		return config.ContextFlagsConfig{}, fmt.Errorf("the -%s and -%s flags can not be used together",
+			profile.Name, configurationFile.Name)
+	}
+
+	selectedProfile, err := profiles.Load(flagsConfig.ProfilesDirectory, flagsConfig.Profile)
+	if err != nil {
+		return config.ContextFlagsConfig{}, err
+	}
+	flagsConfig.ConfigurationFile = selectedProfile.ConfigurationFile
+	if len(selectedProfile.ConfigurationApiFile) > 0 {
+		flagsConfig.ConfigurationApiFile = selectedProfile.ConfigurationApiFile
+	}
 
-	return flagsConfig
+	return flagsConfig, nil
 }
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/urfave/cli"
+)
+
+var (
+	mnemonicFile = cli.StringFlag{
+		Name:  "mnemonic-file",
+		Usage: "The `" + filePathPlaceholder + "` for the file containing the BIP-39 mnemonic.",
+		Value: "keys/mnemonic",
+	}
+	ethDerivationPath = cli.StringFlag{
+		Name:  "eth-derivation-path",
+		Usage: "The BIP-32 derivation path used for the Ethereum key.",
+		Value: ethereum.DefaultDerivationPath,
+	}
+	mvxAccount = cli.UintFlag{
+		Name:  "mvx-account",
+		Usage: "The account index used in the MultiversX derivation path m/44'/508'/account'/0'/index'.",
+		Value: 0,
+	}
+	mvxAddressIndex = cli.UintFlag{
+		Name:  "mvx-address-index",
+		Usage: "The address index used in the MultiversX derivation path m/44'/508'/account'/0'/index'.",
+		Value: 0,
+	}
+)
+
+func getKeysCommand() cli.Command {
+	return cli.Command{
+		Name:  "keys",
+		Usage: "Key management helpers",
+		Subcommands: []cli.Command{
+			{
+				Name:  "derive",
+				Usage: "Derives the relayer keys from a BIP-39 mnemonic and prints the resulting public addresses",
+				Flags: []cli.Flag{
+					mnemonicFile,
+					ethDerivationPath,
+					mvxAccount,
+					mvxAddressIndex,
+				},
+				Action: deriveKeys,
+			},
+		},
+	}
+}
+
+func deriveKeys(ctx *cli.Context) error {
+	filename := ctx.String(mnemonicFile.Name)
+
+	cryptoHandler, err := ethereum.NewCryptoHandlerFromMnemonic(filename, ctx.String(ethDerivationPath.Name))
+	if err != nil {
+		return fmt.Errorf("%w while deriving the Ethereum key", err)
+	}
+
+	multiversXPrivateKeyBytes, err := multiversx.LoadPrivateKeyFromMnemonicFile(
+		filename,
+		uint32(ctx.Uint(mvxAccount.Name)),
+		uint32(ctx.Uint(mvxAddressIndex.Name)),
+	)
+	if err != nil {
+		return fmt.Errorf("%w while deriving the MultiversX key", err)
+	}
+
+	multiversXAddress, err := interactors.NewWallet().GetAddressFromPrivateKey(multiversXPrivateKeyBytes)
+	if err != nil {
+		return err
+	}
+	multiversXBech32Address, err := multiversXAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Ethereum address:   %s\n", cryptoHandler.GetAddress().Hex())
+	fmt.Printf("MultiversX address: %s\n", multiversXBech32Address)
+
+	return nil
+}
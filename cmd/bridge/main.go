@@ -1,7 +1,10 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"path"
@@ -9,50 +12,24 @@ import (
 	"syscall"
 	"time"
 
-	ethCommon "github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
-	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
-	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
-	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
 	"github.com/multiversx/mx-bridge-eth-go/config"
-	"github.com/multiversx/mx-bridge-eth-go/core"
-	"github.com/multiversx/mx-bridge-eth-go/factory"
-	"github.com/multiversx/mx-bridge-eth-go/p2p"
-	"github.com/multiversx/mx-bridge-eth-go/status"
-	"github.com/multiversx/mx-chain-communication-go/p2p/libp2p"
+	"github.com/multiversx/mx-bridge-eth-go/config/precedence"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
+	"github.com/multiversx/mx-bridge-eth-go/relayer"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
-	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
-	"github.com/multiversx/mx-chain-core-go/marshal"
-	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
-	"github.com/multiversx/mx-chain-crypto-go/signing"
-	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
-	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1/singlesig"
 	chainFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
 	chainCommon "github.com/multiversx/mx-chain-go/common"
-	p2pConfig "github.com/multiversx/mx-chain-go/p2p/config"
-	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
-	"github.com/multiversx/mx-chain-go/statusHandler"
-	"github.com/multiversx/mx-chain-go/statusHandler/persister"
-	"github.com/multiversx/mx-chain-go/storage/cache"
-	"github.com/multiversx/mx-chain-go/update/disabled"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-logger-go/file"
-	"github.com/multiversx/mx-sdk-go/blockchain"
-	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/urfave/cli"
 )
 
 const (
-	filePathPlaceholder      = "[path]"
-	defaultLogsPath          = "logs"
-	logFilePrefix            = "multiversx-eth-bridge"
-	p2pPeerNetworkDiscoverer = "optimized"
-	nilListSharderType       = "NilListSharder"
-	disabledWatcher          = "disabled"
-	dbPath                   = "db"
-	timeForBootstrap         = time.Second * 20
-	timeBeforeRepeatJoin     = time.Minute * 5
+	filePathPlaceholder = "[path]"
+	defaultLogsPath     = "logs"
+	logFilePrefix       = "multiversx-eth-bridge"
+	dbPath              = "db"
 )
 
 var log = logger.GetOrCreate("main")
@@ -69,6 +46,12 @@ var log = logger.GetOrCreate("main")
 //	go build -i -v -ldflags="-X main.appVersion=%VERS%"
 var appVersion = chainCommon.UnVersionedAppString
 
+// appCommit should be populated at build time using ldflags
+// Usage example:
+//
+//	go build -i -v -ldflags="-X main.appCommit=$(git rev-parse HEAD)"
+var appCommit = chainCommon.UnVersionedAppString
+
 func main() {
 	app := cli.NewApp()
 	app.Name = "Relay CLI app"
@@ -83,6 +66,19 @@ func main() {
 		},
 	}
 
+	app.Commands = []cli.Command{
+		getKeysCommand(),
+		getAuditCommand(),
+		getSLACommand(),
+		getAccountingCommand(),
+		getConfigBundleCommand(),
+		getConfigDriftCommand(),
+		getTokensMigrationCommand(),
+		getTopologyCommand(),
+		getDiagnoseCommand(),
+		getRolesCommand(),
+	}
+
 	app.Action = func(c *cli.Context) error {
 		return startRelay(c, app.Version)
 	}
@@ -95,7 +91,10 @@ func main() {
 }
 
 func startRelay(ctx *cli.Context, version string) error {
-	flagsConfig := getFlagsConfig(ctx)
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
 
 	fileLogging, errLogger := attachFileLogger(log, flagsConfig)
 	if errLogger != nil {
@@ -104,12 +103,23 @@ func startRelay(ctx *cli.Context, version string) error {
 
 	log.Info("starting bridge node", "version", version, "pid", os.Getpid())
 
-	err := logger.SetLogLevel(flagsConfig.LogLevel)
+	err = logger.SetLogLevel(flagsConfig.LogLevel)
 	if err != nil {
 		return err
 	}
 
-	cfg, err := loadConfig(flagsConfig.ConfigurationFile)
+	if flagsConfig.ExportConfigSchema {
+		return printConfigSchema(os.Stdout)
+	}
+
+	cfg, configFields, err := loadEffectiveConfig(flagsConfig)
+	if err != nil {
+		return err
+	}
+	if flagsConfig.PrintEffectiveConfig {
+		return precedence.PrintEffectiveConfig(os.Stdout, configFields)
+	}
+	err = applyConfigLogLevel(cfg.Logs)
 	if err != nil {
 		return err
 	}
@@ -129,172 +139,182 @@ func startRelay(ctx *cli.Context, version string) error {
 		}
 	}
 
-	dbFullPath := path.Join(flagsConfig.WorkingDir, dbPath)
-	statusStorer, err := factory.CreateUnitStorer(cfg.Relayer.StatusMetricsStorage, dbFullPath)
-	if err != nil {
-		return err
+	if flagsConfig.DevClusterSize > 0 {
+		return startDevCluster(cfg, apiRoutesConfig, flagsConfig)
 	}
 
-	metricsHolder := status.NewMetricsHolder()
-	ethClientStatusHandler, err := status.NewStatusHandler(core.EthClientStatusHandlerName, statusStorer)
-	if err != nil {
-		return err
-	}
-	err = metricsHolder.AddStatusHandler(ethClientStatusHandler)
-	if err != nil {
-		return err
-	}
+	argsRelayer := createArgsRelayer(cfg, apiRoutesConfig, flagsConfig, path.Join(flagsConfig.WorkingDir, dbPath))
+	argsRelayer.LoadConfigFile = createConfigFileLoader(flagsConfig)
+	// a close signal interrupts the startup sequence as well
+	runCtx, stopRun := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stopRun()
 
-	multiversXClientStatusHandler, err := status.NewStatusHandler(core.MultiversXClientStatusHandlerName, statusStorer)
-	if err != nil {
-		return err
-	}
-	err = metricsHolder.AddStatusHandler(multiversXClientStatusHandler)
+	instance, err := relayer.New(runCtx, argsRelayer)
 	if err != nil {
 		return err
 	}
 
-	if len(cfg.MultiversX.NetworkAddress) == 0 {
-		return fmt.Errorf("empty MultiversX.NetworkAddress in config file")
-	}
+	go waitForReloadSignals(runCtx, flagsConfig, instance)
 
-	argsProxy := blockchain.ArgsProxy{
-		ProxyURL:            cfg.MultiversX.NetworkAddress,
-		SameScState:         false,
-		ShouldBeSynced:      false,
-		FinalityCheck:       cfg.MultiversX.Proxy.FinalityCheck,
-		AllowedDeltaToFinal: cfg.MultiversX.Proxy.MaxNoncesDelta,
-		CacheExpirationTime: time.Second * time.Duration(cfg.MultiversX.Proxy.CacherExpirationSeconds),
-		EntityType:          sdkCore.RestAPIEntityType(cfg.MultiversX.Proxy.RestAPIEntityType),
-	}
-	proxy, err := blockchain.NewProxy(argsProxy)
-	if err != nil {
-		return err
-	}
+	err = instance.Run(runCtx)
 
-	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	log.Info("application closing, calling Close on all subcomponents...")
+	errClose := instance.Close()
 	if err != nil {
 		return err
 	}
 
-	bridgeEthAddress := ethCommon.HexToAddress(cfg.Eth.MultisigContractAddress)
-	multiSigInstance, err := contract.NewBridge(bridgeEthAddress, ethClient)
-	if err != nil {
-		return err
-	}
+	return errClose
+}
 
-	safeEthAddress := ethCommon.HexToAddress(cfg.Eth.SafeContractAddress)
-	safeInstance, err := contract.NewERC20Safe(safeEthAddress, ethClient)
-	if err != nil {
-		return err
+// createArgsRelayer creates the arguments of a relayer using the config files and the flags, its storers and its
+// messenger being created from the config
+func createArgsRelayer(
+	cfg config.Config,
+	apiRoutesConfig config.ApiRoutesConfig,
+	flagsConfig config.ContextFlagsConfig,
+	dbFullPath string,
+) relayer.ArgsRelayer {
+	return relayer.ArgsRelayer{
+		Configs: config.Configs{
+			GeneralConfig:   cfg,
+			ApiRoutesConfig: apiRoutesConfig,
+			FlagsConfig:     flagsConfig,
+		},
+		DBPath:     dbFullPath,
+		AppVersion: appVersion,
+		GitCommit:  appCommit,
+		Log:        log,
 	}
+}
+
+type configReloader interface {
+	ReloadConfig(cfg config.Config) error
+}
 
-	argsContractsHolder := ethereum.ArgsErc20SafeContractsHolder{
-		EthClient:              ethClient,
-		EthClientStatusHandler: ethClientStatusHandler,
+// waitForReloadSignals re-reads the config file on each SIGHUP, until the provided context is done, and applies its
+// reloadable settings to the running relayer
+func waitForReloadSignals(ctx context.Context, flagsConfig config.ContextFlagsConfig, reloader configReloader) {
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGHUP)
+	defer signal.Stop(sigs)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigs:
+			reloadConfig(flagsConfig, reloader)
+		}
 	}
-	erc20ContractsHolder, err := ethereum.NewErc20SafeContractsHolder(argsContractsHolder)
+}
+
+// reloadConfig re-reads the config file, with the environment variables and the flag overrides, and applies the log
+// level, the gas station settings, the polling intervals and the state machines step durations. An invalid config file
+// is ignored, the relayer continuing with the current settings
+func reloadConfig(flagsConfig config.ContextFlagsConfig, reloader configReloader) {
+	log.Info("SIGHUP received, reloading the config", "file", flagsConfig.ConfigurationFile)
+
+	cfg, _, err := loadEffectiveConfig(flagsConfig)
 	if err != nil {
-		return err
+		log.Error("can not reload the config, keeping the current settings", "error", err)
+		return
 	}
 
-	marshaller, err := factoryMarshaller.NewMarshalizer(cfg.Relayer.Marshalizer.Type)
+	err = applyConfigLogLevel(cfg.Logs)
 	if err != nil {
-		return err
+		log.Error("can not apply the reloaded log level", "error", err)
 	}
 
-	messenger, err := buildNetMessenger(cfg, marshaller)
+	err = reloader.ReloadConfig(cfg)
 	if err != nil {
-		return err
+		log.Error("the reloaded config was partially applied", "error", err)
+		return
 	}
 
-	configs := config.Configs{
-		GeneralConfig:   cfg,
-		ApiRoutesConfig: apiRoutesConfig,
-		FlagsConfig:     flagsConfig,
-	}
+	log.Info("config reloaded, the settings other than the log level, the gas station, the polling intervals and " +
+		"the step durations need a restart")
+}
 
-	argsClientWrapper := wrappers.ArgsEthereumChainWrapper{
-		StatusHandler:    ethClientStatusHandler,
-		MultiSigContract: multiSigInstance,
-		SafeContract:     safeInstance,
-		BlockchainClient: ethClient,
+// applyConfigLogLevel sets the log level from the config file, if any, overriding the log level flag
+func applyConfigLogLevel(logsConfig config.LogsConfig) error {
+	if len(logsConfig.LogLevel) == 0 {
+		return nil
 	}
 
-	clientWrapper, err := wrappers.NewEthereumChainWrapper(argsClientWrapper)
+	err := logger.SetLogLevel(logsConfig.LogLevel)
 	if err != nil {
 		return err
 	}
+	log.Info("log level set from the config", "level", logsConfig.LogLevel)
 
-	var appStatusHandlers []chainCore.AppStatusHandler
-	statusMetrics := statusHandler.NewStatusMetrics()
-	appStatusHandlers = append(appStatusHandlers, statusMetrics)
+	return nil
+}
 
-	persistentHandler, err := persister.NewPersistentStatusHandler(marshaller, uint64ByteSlice.NewBigEndianConverter())
+// loadEffectiveConfig loads the config file and applies the configuration bundle, the environment variables and the
+// flag overrides. The overrides are applied before the bundle as well, so they can also configure the bundle fetching
+func loadEffectiveConfig(flagsConfig config.ContextFlagsConfig) (config.Config, []*precedence.Field, error) {
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
 	if err != nil {
-		return err
+		return config.Config{}, nil, err
 	}
-	appStatusHandlers = append(appStatusHandlers, persistentHandler)
-	appStatusHandler, err := statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
+
+	cfg = applyConfigBundle(cfg)
+	fields, err := precedence.ApplyOverrides(&cfg, createArgsOverrides(flagsConfig))
 	if err != nil {
-		return err
+		return config.Config{}, nil, err
 	}
 
-	args := factory.ArgsEthereumToMultiversXBridge{
-		Configs:                       configs,
-		Messenger:                     messenger,
-		StatusStorer:                  statusStorer,
-		Proxy:                         proxy,
-		Erc20ContractsHolder:          erc20ContractsHolder,
-		ClientWrapper:                 clientWrapper,
-		TimeForBootstrap:              timeForBootstrap,
-		TimeBeforeRepeatJoin:          timeBeforeRepeatJoin,
-		MetricsHolder:                 metricsHolder,
-		AppStatusHandler:              appStatusHandler,
-		MultiversXClientStatusHandler: multiversXClientStatusHandler,
-	}
+	return cfg, fields, nil
+}
 
-	ethToMultiversXComponents, err := factory.NewEthMultiversXBridgeComponents(args)
-	if err != nil {
-		return err
+// createConfigFileLoader returns the loader used to compare the running config with the config file. The environment
+// variables and the flag overrides are applied, as on a restart, while the configuration bundle is not fetched so
+// its settings are reported as drift
+func createConfigFileLoader(flagsConfig config.ContextFlagsConfig) func() (config.Config, error) {
+	return func() (config.Config, error) {
+		cfg, _, err := loadConfigWithOverrides(flagsConfig)
+		return cfg, err
 	}
+}
 
-	webServer, err := factory.StartWebServer(configs, metricsHolder)
+// loadConfigWithOverrides loads the config file and applies the environment variables and the flag overrides
+func loadConfigWithOverrides(flagsConfig config.ContextFlagsConfig) (config.Config, []*precedence.Field, error) {
+	cfg, err := loadConfig(flagsConfig.ConfigurationFile)
 	if err != nil {
-		return err
+		return config.Config{}, nil, err
 	}
 
-	log.Info("Starting relay")
-
-	err = ethToMultiversXComponents.Start()
+	fields, err := precedence.ApplyOverrides(&cfg, createArgsOverrides(flagsConfig))
 	if err != nil {
-		return err
+		return config.Config{}, nil, err
 	}
 
-	sigs := make(chan os.Signal, 1)
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-
-	<-sigs
+	return cfg, fields, nil
+}
 
-	log.Info("application closing, calling Close on all subcomponents...")
+func createArgsOverrides(flagsConfig config.ContextFlagsConfig) precedence.ArgsOverrides {
+	return precedence.ArgsOverrides{
+		EnvPrefix:      envPrefix,
+		FlagOverrides:  flagsConfig.ConfigOverrides,
+		ConfigFilePath: flagsConfig.ConfigurationFile,
+	}
+}
 
-	var lastErr error
-	err = ethToMultiversXComponents.Close()
+// loadConfig checks the config file against the schema of the config, so the misspelled keys and the values of a wrong
+// type are reported instead of being silently ignored, and loads it
+func loadConfig(filepath string) (config.Config, error) {
+	configSchema, err := schema.Generate(config.Config{})
 	if err != nil {
-		lastErr = err
+		return config.Config{}, err
 	}
-
-	err = webServer.Close()
+	err = configSchema.ValidateFile(filepath)
 	if err != nil {
-		lastErr = err
+		return config.Config{}, fmt.Errorf("%w in %s", err, filepath)
 	}
 
-	return lastErr
-}
-
-func loadConfig(filepath string) (config.Config, error) {
 	cfg := config.Config{}
-	err := chainCore.LoadTomlFile(&cfg, filepath)
+	err = chainCore.LoadTomlFile(&cfg, filepath)
 	if err != nil {
 		return config.Config{}, err
 	}
@@ -302,6 +322,23 @@ func loadConfig(filepath string) (config.Config, error) {
 	return cfg, nil
 }
 
+// printConfigSchema writes the JSON Schema of the config file, to be used by the editors and the external linters
+func printConfigSchema(writer io.Writer) error {
+	configSchema, err := schema.Generate(config.Config{})
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(configSchema, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.Write(append(buff, '\n'))
+
+	return err
+}
+
 // LoadApiConfig returns a ApiRoutesConfig by reading the config file provided
 func loadApiConfig(filepath string) (config.ApiRoutesConfig, error) {
 	cfg := config.ApiRoutesConfig{}
@@ -352,73 +389,3 @@ func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig)
 
 	return fileLogging, nil
 }
-
-func buildNetMessenger(cfg config.Config, marshalizer marshal.Marshalizer) (p2p.NetMessenger, error) {
-	nodeConfig := p2pConfig.NodeConfig{
-		Port:                       cfg.P2P.Port,
-		MaximumExpectedPeerCount:   0,
-		ThresholdMinConnectedPeers: 0,
-		Transports:                 cfg.P2P.Transports,
-		ResourceLimiter:            cfg.P2P.ResourceLimiter,
-	}
-	peerDiscoveryConfig := p2pConfig.KadDhtPeerDiscoveryConfig{
-		Enabled:                          true,
-		RefreshIntervalInSec:             5,
-		ProtocolID:                       cfg.P2P.ProtocolID,
-		InitialPeerList:                  cfg.P2P.InitialPeerList,
-		BucketSize:                       0,
-		RoutingTableRefreshIntervalInSec: 300,
-		Type:                             p2pPeerNetworkDiscoverer,
-	}
-
-	p2pCfg := p2pConfig.P2PConfig{
-		Node:                nodeConfig,
-		KadDhtPeerDiscovery: peerDiscoveryConfig,
-		Sharding: p2pConfig.ShardingConfig{
-			TargetPeerCount:         0,
-			MaxIntraShardValidators: 0,
-			MaxCrossShardValidators: 0,
-			MaxIntraShardObservers:  0,
-			MaxCrossShardObservers:  0,
-			Type:                    nilListSharderType,
-		},
-	}
-
-	p2pLog := logger.GetOrCreate("p2p")
-	topRatedCache, err := cache.NewLRUCache(cfg.PeersRatingConfig.TopRatedCacheCapacity)
-	if err != nil {
-		return nil, err
-	}
-	badRatedCache, err := cache.NewLRUCache(cfg.PeersRatingConfig.BadRatedCacheCapacity)
-	if err != nil {
-		return nil, err
-	}
-	argsPeersRatingHandler := p2pFactory.ArgPeersRatingHandler{
-		TopRatedCache: topRatedCache,
-		BadRatedCache: badRatedCache,
-		Logger:        p2pLog,
-	}
-	peersRatingHandler, err := p2pFactory.NewPeersRatingHandler(argsPeersRatingHandler)
-	if err != nil {
-		return nil, err
-	}
-
-	p2pSingleSigner := &singlesig.Secp256k1Signer{}
-	p2pKeyGen := signing.NewKeyGenerator(secp256k1.NewSecp256k1())
-	p2pPrivKey, _ := p2pKeyGen.GeneratePair()
-
-	args := libp2p.ArgsNetworkMessenger{
-		Marshaller:            marshalizer,
-		P2pConfig:             p2pCfg,
-		SyncTimer:             &libp2p.LocalSyncTimer{},
-		PreferredPeersHolder:  disabled.NewPreferredPeersHolder(),
-		PeersRatingHandler:    peersRatingHandler,
-		ConnectionWatcherType: disabledWatcher,
-		P2pPrivateKey:         p2pPrivKey,
-		P2pSingleSigner:       p2pSingleSigner,
-		P2pKeyGenerator:       p2pKeyGen,
-		Logger:                p2pLog,
-	}
-
-	return libp2p.NewNetworkMessenger(args)
-}
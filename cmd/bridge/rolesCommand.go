@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/multiversx/mx-bridge-eth-go/clients/relayerRoles"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/urfave/cli"
+)
+
+const (
+	rolesRequestsTimeout  = time.Minute
+	ethereumAddressPrefix = "0x"
+)
+
+var (
+	rolesAddress = cli.StringSliceFlag{
+		Name: "address",
+		Usage: "The `address` to be checked: a bech32 MultiversX address or a 0x prefixed Ethereum address. Can be " +
+			"provided multiple times, for example once for each of the relayer's addresses.",
+	}
+	rolesNumActions = cli.Uint64Flag{
+		Name:  "actions",
+		Usage: "The `number` of the most recent MultiversX multisig actions scanned for the address' signatures.",
+		Value: 20,
+	}
+)
+
+func getRolesCommand() cli.Command {
+	return cli.Command{
+		Name: "roles",
+		Usage: "Reports, using only read-only queries, whether an address is whitelisted on each multisig contract, " +
+			"its stake and the recent MultiversX actions it signed",
+		Flags:  []cli.Flag{rolesAddress, rolesNumActions},
+		Action: reportRoles,
+	}
+}
+
+func reportRoles(ctx *cli.Context) error {
+	addresses := ctx.StringSlice(rolesAddress.Name)
+	if len(addresses) == 0 {
+		return fmt.Errorf("the --%s flag is required", rolesAddress.Name)
+	}
+
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	if err != nil {
+		return err
+	}
+	defer ethClient.Close()
+
+	clientWrapper, err := createEthereumChainWrapper(cfg.Eth, ethClient)
+	if err != nil {
+		return err
+	}
+
+	// the queries do not depend on the caller, so the multisig contract address is used as caller
+	multisigAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.MultisigContractAddress)
+	if err != nil {
+		return fmt.Errorf("%w for MultiversX.MultisigContractAddress", err)
+	}
+	proxy, err := createMultiversXProxy(cfg.MultiversX)
+	if err != nil {
+		return err
+	}
+	dataGetter, err := createMultiversXDataGetter(cfg.MultiversX, multisigAddress, proxy)
+	if err != nil {
+		return err
+	}
+
+	reporter, err := relayerRoles.NewRolesReporter(relayerRoles.ArgsRolesReporter{
+		EthereumChain:    clientWrapper,
+		MultiversXChain:  dataGetter,
+		NumRecentActions: ctx.Uint64(rolesNumActions.Name),
+	})
+	if err != nil {
+		return err
+	}
+
+	requestsCtx, cancel := context.WithTimeout(context.Background(), rolesRequestsTimeout)
+	defer cancel()
+
+	for _, address := range addresses {
+		err = reportAddressRoles(requestsCtx, reporter, address)
+		if err != nil {
+			return fmt.Errorf("%w for address %s", err, address)
+		}
+	}
+
+	return nil
+}
+
+type rolesReporter interface {
+	ReportEthereumAddress(ctx context.Context, address ethCommon.Address) (*relayerRoles.EthereumRoles, error)
+	ReportMultiversXAddress(ctx context.Context, address []byte, displayableAddress string) (*relayerRoles.MultiversXRoles, error)
+}
+
+func reportAddressRoles(ctx context.Context, reporter rolesReporter, address string) error {
+	if strings.HasPrefix(address, ethereumAddressPrefix) {
+		if !ethCommon.IsHexAddress(address) {
+			return errors.New("invalid Ethereum address")
+		}
+
+		roles, err := reporter.ReportEthereumAddress(ctx, ethCommon.HexToAddress(address))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Ethereum address %s:\n", roles.Address)
+		fmt.Printf("  whitelisted on the multisig: %v (%d relayers)\n", roles.IsWhitelisted, roles.NumRelayers)
+
+		return nil
+	}
+
+	addressHandler, err := data.NewAddressFromBech32String(address)
+	if err != nil {
+		return err
+	}
+
+	roles, err := reporter.ReportMultiversXAddress(ctx, addressHandler.AddressBytes(), address)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("MultiversX address %s:\n", roles.Address)
+	fmt.Printf("  whitelisted on the multisig: %v (%d relayers)\n", roles.IsWhitelisted, roles.NumRelayers)
+	fmt.Printf("  stake: %s\n", roles.Stake.String())
+	if roles.LastActionID == 0 {
+		fmt.Println("  no actions proposed on the multisig")
+		return nil
+	}
+
+	fmt.Printf("  signed actions between %d and %d: %d\n", roles.FirstScannedActionID, roles.LastActionID, len(roles.SignedActions))
+	for _, activity := range roles.SignedActions {
+		fmt.Printf("    action %d, executed: %v\n", activity.ActionID, activity.Executed)
+	}
+
+	return nil
+}
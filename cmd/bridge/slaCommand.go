@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core/timer"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-bridge-eth-go/sla"
+	"github.com/urfave/cli"
+)
+
+var (
+	slaReportMonth = cli.StringFlag{
+		Name:  "month",
+		Usage: "The month of the report, formatted as YYYY-MM. Defaults to the current month.",
+	}
+	slaReportFormat = cli.StringFlag{
+		Name:  "format",
+		Usage: "The report format: " + sla.JSONFormat + " or " + sla.HTMLFormat + ".",
+		Value: sla.JSONFormat,
+	}
+	slaReportFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `" + filePathPlaceholder + "` where the report will be written. Defaults to sla-report-<month>.<format>.",
+	}
+)
+
+func getSLACommand() cli.Command {
+	return cli.Command{
+		Name:  "sla",
+		Usage: "SLA helpers. The relayer should be stopped as the commands open its database",
+		Subcommands: []cli.Command{
+			{
+				Name:   "report",
+				Usage:  "Generates the monthly SLA report with the uptime, availability, transfer latencies and missed leader slots",
+				Flags:  []cli.Flag{slaReportMonth, slaReportFormat, slaReportFile},
+				Action: generateSLAReport,
+			},
+		},
+	}
+}
+
+func generateSLAReport(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	dbFullPath := path.Join(flagsConfig.WorkingDir, dbPath)
+	storer, err := factory.CreateUnitStorer(cfg.Relayer.SLA.Storage, dbFullPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	tracker, err := sla.NewSLATracker(sla.ArgsSLATracker{
+		Storer:            storer,
+		Timer:             timer.NewNTPTimer(),
+		HeartbeatInterval: time.Duration(cfg.Relayer.SLA.HeartbeatIntervalInSeconds) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+
+	month := ctx.String(slaReportMonth.Name)
+	if len(month) == 0 {
+		month = time.Now().UTC().Format(sla.MonthFormat)
+	}
+	report, err := tracker.Report(month)
+	if err != nil {
+		return err
+	}
+
+	format := ctx.String(slaReportFormat.Name)
+	filename := ctx.String(slaReportFile.Name)
+	if len(filename) == 0 {
+		filename = fmt.Sprintf("sla-report-%s.%s", month, format)
+	}
+
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	err = report.Export(file, format)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("exported the %s SLA report in %s\n", month, filename)
+
+	return nil
+}
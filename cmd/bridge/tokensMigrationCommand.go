@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/urfave/cli"
+)
+
+func getTokensMigrationCommand() cli.Command {
+	return cli.Command{
+		Name:  "tokens-migration",
+		Usage: "ERC20 contracts migration helpers",
+		Subcommands: []cli.Command{
+			{
+				Name:   "status",
+				Usage:  "Checks the configured migrations and prints their phase at the current Ethereum block",
+				Action: printTokensMigrationStatus,
+			},
+		},
+	}
+}
+
+func printTokensMigrationStatus(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	migrations, err := factory.CreateTokenMigrations(cfg.TokensMapper.Migrations)
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		fmt.Println("no token migrations configured")
+		return nil
+	}
+
+	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	if err != nil {
+		return err
+	}
+	defer ethClient.Close()
+
+	blockNumber, err := ethClient.BlockNumber(context.Background())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("current Ethereum block: %d\n", blockNumber)
+	for _, migration := range migrations {
+		fmt.Printf("%s -> %s: %s (effective block %d, expiry block %d)\n",
+			common.BytesToAddress(migration.OldERC20Address).Hex(),
+			common.BytesToAddress(migration.NewERC20Address).Hex(),
+			migration.Phase(blockNumber),
+			migration.EffectiveBlock,
+			migration.ExpiryBlock,
+		)
+	}
+
+	return nil
+}
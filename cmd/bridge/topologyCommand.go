@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/topology"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchDiagnosis"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-bridge-eth-go/clients/relayerRoles"
+	roleproviders "github.com/multiversx/mx-bridge-eth-go/clients/roleProviders"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/converters"
+	"github.com/multiversx/mx-bridge-eth-go/core/timer"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/urfave/cli"
+)
+
+const topologyRequestsTimeout = time.Minute
+
+var (
+	topologySlots = cli.IntFlag{
+		Name:  "slots",
+		Usage: "The `number` of leader slots to export, starting with the current one.",
+		Value: 10,
+	}
+	topologyAddress = cli.StringFlag{
+		Name: "address",
+		Usage: "The MultiversX `address` whose position is exported. Defaults to the address of the MultiversX key " +
+			"configured for the relayer.",
+	}
+	topologyFile = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `" + filePathPlaceholder + "` where the topology will be written. Defaults to the standard output.",
+	}
+)
+
+func getTopologyCommand() cli.Command {
+	return cli.Command{
+		Name:  "topology",
+		Usage: "Relayers set topology helpers",
+		Subcommands: []cli.Command{
+			{
+				Name: "export",
+				Usage: "Fetches the relayers set from the MultiversX multisig contract and exports, for each direction, " +
+					"the sorted public keys, the position of the relayer and the leader schedule of the next slots",
+				Flags:  []cli.Flag{topologySlots, topologyAddress, topologyFile},
+				Action: exportTopology,
+			},
+		},
+	}
+}
+
+func exportTopology(ctx *cli.Context) error {
+	flagsConfig, err := getFlagsConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg, _, err := loadConfigWithOverrides(flagsConfig)
+	if err != nil {
+		return err
+	}
+
+	numSlots := ctx.Int(topologySlots.Name)
+	if numSlots < 1 {
+		return fmt.Errorf("invalid number of slots: %d", numSlots)
+	}
+
+	relayerAddress, err := getTopologyRelayerAddress(ctx.String(topologyAddress.Name), cfg.MultiversX)
+	if err != nil {
+		return err
+	}
+
+	dataGetter, err := createTopologyDataGetter(cfg.MultiversX, relayerAddress)
+	if err != nil {
+		return err
+	}
+
+	ntpTimer := timer.NewNTPTimer()
+	defer func() {
+		_ = ntpTimer.Close()
+	}()
+
+	roleProvider, err := roleproviders.NewMultiversXRoleProvider(roleproviders.ArgsMultiversXRoleProvider{
+		DataGetter:         dataGetter,
+		Log:                log,
+		Timer:              ntpTimer,
+		ProbationPeriod:    time.Duration(cfg.Relayer.RoleProvider.ProbationPeriodInSeconds) * time.Second,
+		ProbationAddresses: cfg.Relayer.RoleProvider.ProbationAddresses,
+	})
+	if err != nil {
+		return err
+	}
+
+	requestsCtx, cancel := context.WithTimeout(context.Background(), topologyRequestsTimeout)
+	defer cancel()
+
+	err = roleProvider.Execute(requestsCtx)
+	if err != nil {
+		return err
+	}
+
+	leaderSelector, err := createTopologyLeaderSelector(requestsCtx, cfg.Relayer.LeaderSelection, dataGetter)
+	if err != nil {
+		return err
+	}
+
+	addressConverter, err := converters.NewAddressConverter()
+	if err != nil {
+		return err
+	}
+
+	result := make(map[string]*core.TopologyInfo, len(cfg.StateMachine))
+	for name, stateMachineConfig := range cfg.StateMachine {
+		topologyHandler, errCreate := topology.NewTopologyHandler(topology.ArgsTopologyHandler{
+			PublicKeysProvider: roleProvider,
+			Timer:              ntpTimer,
+			IntervalForLeader:  time.Second * time.Duration(stateMachineConfig.IntervalForLeaderInSeconds),
+			AddressBytes:       relayerAddress.AddressBytes(),
+			Log:                log,
+			AddressConverter:   addressConverter,
+			LeaderSelector:     leaderSelector,
+		})
+		if errCreate != nil {
+			return fmt.Errorf("%w for %s", errCreate, name)
+		}
+
+		result[name] = topologyHandler.TopologyInfo(numSlots)
+	}
+
+	return writeTopology(result, ctx.String(topologyFile.Name))
+}
+
+func getTopologyRelayerAddress(bech32Address string, chainConfigs config.MultiversXConfig) (sdkCore.AddressHandler, error) {
+	if len(bech32Address) > 0 {
+		return data.NewAddressFromBech32String(bech32Address)
+	}
+
+	return factory.LoadMultiversXRelayerAddress(chainConfigs)
+}
+
+func createTopologyDataGetter(chainConfigs config.MultiversXConfig, relayerAddress sdkCore.AddressHandler) (roleproviders.StakesDataGetter, error) {
+	proxy, err := createMultiversXProxy(chainConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return createMultiversXDataGetter(chainConfigs, relayerAddress, proxy)
+}
+
+func createMultiversXProxy(chainConfigs config.MultiversXConfig) (multiversx.Proxy, error) {
+	argsProxy := blockchain.ArgsProxy{
+		ProxyURL:            chainConfigs.NetworkAddress,
+		SameScState:         false,
+		ShouldBeSynced:      false,
+		FinalityCheck:       chainConfigs.Proxy.FinalityCheck,
+		AllowedDeltaToFinal: chainConfigs.Proxy.MaxNoncesDelta,
+		CacheExpirationTime: time.Second * time.Duration(chainConfigs.Proxy.CacherExpirationSeconds),
+		EntityType:          sdkCore.RestAPIEntityType(chainConfigs.Proxy.RestAPIEntityType),
+	}
+
+	return blockchain.NewProxy(argsProxy)
+}
+
+// multiversXDataGetter defines the read-only MultiversX queries used by the commands
+type multiversXDataGetter interface {
+	roleproviders.StakesDataGetter
+	batchDiagnosis.MultiversXChain
+	relayerRoles.MultiversXChain
+}
+
+func createMultiversXDataGetter(
+	chainConfigs config.MultiversXConfig,
+	relayerAddress sdkCore.AddressHandler,
+	proxy multiversx.Proxy,
+) (multiversXDataGetter, error) {
+	multisigAddress, err := data.NewAddressFromBech32String(chainConfigs.MultisigContractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MultiversX.MultisigContractAddress", err)
+	}
+	safeAddress, err := data.NewAddressFromBech32String(chainConfigs.SafeContractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for MultiversX.SafeContractAddress", err)
+	}
+
+	return multiversx.NewMXClientDataGetter(multiversx.ArgsMXClientDataGetter{
+		MultisigContractAddress: multisigAddress,
+		SafeContractAddress:     safeAddress,
+		RelayerAddress:          relayerAddress,
+		Proxy:                   proxy,
+		Log:                     log,
+		MaxParallelVMQueries:    chainConfigs.Proxy.MaxParallelVMQueries,
+	})
+}
+
+func createTopologyLeaderSelector(
+	ctx context.Context,
+	leaderSelectionConfig config.LeaderSelectionConfig,
+	dataGetter roleproviders.StakesDataGetter,
+) (topology.LeaderSelector, error) {
+	switch leaderSelectionConfig.Strategy {
+	case "", topology.UniformStrategy:
+		return topology.NewHashRandomSelector(), nil
+	case topology.StakeWeightedStrategy:
+	default:
+		return nil, fmt.Errorf("unknown leader selection strategy: %q", leaderSelectionConfig.Strategy)
+	}
+
+	stakesProvider, err := roleproviders.NewMultiversXStakesProvider(roleproviders.ArgsMultiversXStakesProvider{
+		DataGetter: dataGetter,
+		Log:        log,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = stakesProvider.Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return topology.NewStakeWeightedSelector(topology.ArgsStakeWeightedSelector{
+		StakesProvider: stakesProvider,
+	})
+}
+
+func writeTopology(result map[string]*core.TopologyInfo, filename string) error {
+	var writer io.Writer = os.Stdout
+	if len(filename) > 0 {
+		file, err := os.Create(filename)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = file.Close()
+		}()
+
+		writer = file
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
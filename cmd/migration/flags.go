@@ -4,10 +4,14 @@ import (
 	"path"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/precedence"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/urfave/cli"
 )
 
+// envPrefix is prepended to the environment variables that can replace the flags and the config values
+const envPrefix = "MIGRATION_"
+
 var (
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -50,10 +54,25 @@ var (
 			"the amounts will be added. The amount should be specified as a denominated value (does not contain all decimals, the conversion will be done " +
 			"automatically by the tool). Real example: `-partial-migration token1:amount1,token2:amount2,token1:amount3`",
 	}
+	// configOverrides overrides config values, it takes precedence over the environment variables and the config file
+	configOverrides = cli.StringSliceFlag{
+		Name: "set",
+		Usage: "Overrides the config value found at the provided `Path=value`, for example " +
+			"-set Eth.NetworkAddress=http://127.0.0.1:8545. Can be used multiple times. The slices are provided as " +
+			"comma-separated values. The config values can also be provided through the " + envPrefix + "<PATH> " +
+			"environment variables, where the path separators are replaced with underscores.",
+	}
+	// printEffectiveConfig prints the merged config, with the secrets masked, and exits
+	printEffectiveConfig = cli.BoolFlag{
+		Name: "print-effective-config",
+		Usage: "Boolean option for printing the effective config, obtained by applying the flags, the environment " +
+			"variables and the config file, in this order of precedence, over the defaults. The secrets are masked. " +
+			"The application exits after printing.",
+	}
 )
 
 func getFlags() []cli.Flag {
-	return []cli.Flag{
+	return precedence.WithEnvVars(envPrefix, []cli.Flag{
 		logLevel,
 		configurationFile,
 		mode,
@@ -61,13 +80,18 @@ func getFlags() []cli.Flag {
 		signatureJsonFile,
 		newSafeAddress,
 		partialMigration,
-	}
+		configOverrides,
+		printEffectiveConfig,
+	})
 }
+
 func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 	flagsConfig := config.ContextFlagsConfig{}
 
 	flagsConfig.LogLevel = ctx.GlobalString(logLevel.Name)
 	flagsConfig.ConfigurationFile = ctx.GlobalString(configurationFile.Name)
+	flagsConfig.ConfigOverrides = ctx.GlobalStringSlice(configOverrides.Name)
+	flagsConfig.PrintEffectiveConfig = ctx.GlobalBool(printEffectiveConfig.Name)
 
 	return flagsConfig
 }
@@ -18,7 +18,10 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
 	"github.com/multiversx/mx-bridge-eth-go/cmd/migration/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/precedence"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/passphrase"
+	"github.com/multiversx/mx-bridge-eth-go/core/timer"
 	"github.com/multiversx/mx-bridge-eth-go/executors/ethereum"
 	"github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers"
 	"github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
@@ -88,6 +91,18 @@ func execute(ctx *cli.Context) error {
 		return err
 	}
 
+	configFields, err := precedence.ApplyOverrides(&cfg, precedence.ArgsOverrides{
+		EnvPrefix:      envPrefix,
+		FlagOverrides:  flagsConfig.ConfigOverrides,
+		ConfigFilePath: flagsConfig.ConfigurationFile,
+	})
+	if err != nil {
+		return err
+	}
+	if flagsConfig.PrintEffectiveConfig {
+		return precedence.PrintEffectiveConfig(os.Stdout, configFields)
+	}
+
 	log.Info("starting migration help tool", "pid", os.Getpid())
 
 	operationMode := strings.ToLower(ctx.GlobalString(mode.Name))
@@ -200,6 +215,7 @@ func createInternalComponentsWithBatchCreator(cfg config.MigrationToolConfig) (*
 		SafeContractAddress:  safeEthAddress,
 		EthereumChainWrapper: ethereumChainWrapper,
 		Logger:               log,
+		Timer:                timer.NewNTPTimer(),
 	}
 
 	creator, err := ethereum.NewMigrationBatchCreator(argsCreator)
@@ -241,7 +257,12 @@ func generateAndSign(ctx *cli.Context, cfg config.MigrationToolConfig) (*interna
 		return nil, err
 	}
 
-	components.cryptoHandler, err = ethereumClient.NewCryptoHandler(cfg.Eth.PrivateKeyFile)
+	components.cryptoHandler, err = ethereumClient.NewCryptoHandlerFromKeyFile(cfg.Eth.PrivateKeyFile, func() (string, error) {
+		return passphrase.Read("Ethereum", passphrase.Source{
+			EnvVariable: cfg.Eth.PrivateKeyKeystore.PassphraseEnvVariable,
+			File:        cfg.Eth.PrivateKeyKeystore.PassphraseFile,
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -2,14 +2,21 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"path"
 	"runtime"
 	"syscall"
 	"time"
 
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
+	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/module"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-bridge-eth-go/status"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	chainFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
@@ -23,6 +30,7 @@ const (
 	filePathPlaceholder = "[path]"
 	defaultLogsPath     = "logs"
 	logFilePrefix       = "sc-calls-executor"
+	dbPath              = "db"
 )
 
 var log = logger.GetOrCreate("main")
@@ -84,6 +92,11 @@ func startExecutor(ctx *cli.Context, version string) error {
 		return err
 	}
 
+	apiRoutesConfig, err := loadApiConfig(flagsConfig.ConfigurationApiFile)
+	if err != nil {
+		return err
+	}
+
 	if !check.IfNil(fileLogging) {
 		timeLogLifeSpan := time.Second * time.Duration(cfg.Logs.LogFileLifeSpanInSec)
 		sizeLogLifeSpanInMB := uint64(cfg.Logs.LogFileLifeSpanInMB)
@@ -112,9 +125,11 @@ func startExecutor(ctx *cli.Context, version string) error {
 
 	args := config.ScCallsModuleConfig{
 		ScProxyBech32Address:            cfg.ScProxyBech32Address,
+		ScProxyBech32Addresses:          cfg.ScProxyBech32Addresses,
 		ExtraGasToExecute:               cfg.ExtraGasToExecute,
 		MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
 		GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
+		MaxConcurrentExecutions:         cfg.MaxConcurrentExecutions,
 		NetworkAddress:                  cfg.NetworkAddress,
 		ProxyMaxNoncesDelta:             cfg.ProxyMaxNoncesDelta,
 		ProxyFinalityCheck:              cfg.ProxyFinalityCheck,
@@ -126,10 +141,35 @@ func startExecutor(ctx *cli.Context, version string) error {
 		Filter:                          cfg.Filter,
 		Logs:                            cfg.Logs,
 		TransactionChecks:               cfg.TransactionChecks,
+		Webhook:                         cfg.Webhook,
+		BatchTags:                       cfg.BatchTags,
+	}
+
+	metricsHolder := status.NewMetricsHolder()
+	statusStorer, err := factory.CreateUnitStorer(cfg.StatusMetricsStorage, path.Join(flagsConfig.WorkingDir, dbPath))
+	if err != nil {
+		return err
+	}
+	err = status.MigrateStatusKeys(statusStorer, []string{core.ScCallsModuleStatusHandlerName})
+	if err != nil {
+		return err
+	}
+	statusHandler, err := status.NewStatusHandler(core.ScCallsModuleStatusHandlerName, statusStorer)
+	if err != nil {
+		return err
+	}
+	err = metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
 	}
 
 	chCloseApp := make(chan struct{}, 1)
-	scCallsExecutor, err := module.NewScCallsModule(args, log, chCloseApp)
+	scCallsExecutor, err := module.NewScCallsModule(args, log, chCloseApp, statusHandler)
+	if err != nil {
+		return err
+	}
+
+	webServer, err := startWebServer(cfg, apiRoutesConfig, flagsConfig, metricsHolder)
 	if err != nil {
 		return err
 	}
@@ -144,7 +184,68 @@ func startExecutor(ctx *cli.Context, version string) error {
 		log.Info("application closing, requested internally, calling Close on all subcomponents...")
 	}
 
-	return scCallsExecutor.Close()
+	errWebServer := webServer.Close()
+	errScCallsExecutor := scCallsExecutor.Close()
+	errStatusStorer := statusStorer.Close()
+	if errWebServer != nil {
+		return errWebServer
+	}
+	if errScCallsExecutor != nil {
+		return errScCallsExecutor
+	}
+	return errStatusStorer
+}
+
+// startWebServer starts the web server exposing the SC calls module metrics on the same status routes as the relayer.
+// The relayer specific holders are disabled
+func startWebServer(
+	cfg config.ScCallsModuleConfig,
+	apiRoutesConfig config.ApiRoutesConfig,
+	flagsConfig config.ContextFlagsConfig,
+	metricsHolder core.MetricsHolder,
+) (io.Closer, error) {
+	configs := config.Configs{
+		GeneralConfig: config.Config{
+			WebAntiflood: cfg.WebAntiflood,
+		},
+		ApiRoutesConfig: apiRoutesConfig,
+		FlagsConfig:     flagsConfig,
+	}
+	runtimeInfo := &core.RuntimeInfo{
+		AppVersion:      appVersion,
+		EnabledFeatures: make([]string, 0),
+	}
+	if cfg.Webhook.Enabled {
+		runtimeInfo.EnabledFeatures = append(runtimeInfo.EnabledFeatures, "Webhook")
+	}
+	if cfg.BatchTags.Enabled {
+		runtimeInfo.EnabledFeatures = append(runtimeInfo.EnabledFeatures, "BatchTags")
+	}
+
+	configSchema, err := schema.Generate(config.ScCallsModuleConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.StartWebServer(factory.ArgsWebServer{
+		Configs:        configs,
+		MetricsHolder:  metricsHolder,
+		BatchResults:   disabled.NewDisabledBatchResultsStorer(),
+		RuntimeInfo:    runtimeInfo,
+		Topology:       disabled.NewDisabledTopologyInfoHolder(),
+		ExportedTxs:    disabled.NewDisabledRawTransactionsExporter(),
+		SyncReport:     disabled.NewDisabledSyncReportHolder(),
+		RelayedClaims:  disabled.NewDisabledRelayedClaimsHandler(),
+		BalanceProof:   disabled.NewDisabledBalanceProofProvider(),
+		FeeEstimator:   disabled.NewDisabledFeeEstimator(),
+		Incidents:      disabled.NewDisabledIncidentsQueue(),
+		DeadLetters:    disabled.NewDisabledDeadLetters(),
+		DirectMessages: disabled.NewDisabledDirectMessages(),
+		OperatorPause:  disabled.NewDisabledOperatorPause(),
+		BatchExplorer:  disabled.NewDisabledBatchExplorer(),
+		ConfigDrift:    disabled.NewDisabledConfigDriftAuditor(),
+		ConfigSchema:   configSchema,
+	})
 }
 
 func loadConfig(filepath string) (config.ScCallsModuleConfig, error) {
@@ -157,6 +258,17 @@ func loadConfig(filepath string) (config.ScCallsModuleConfig, error) {
 	return cfg, nil
 }
 
+// loadApiConfig returns a ApiRoutesConfig by reading the config file provided
+func loadApiConfig(filepath string) (config.ApiRoutesConfig, error) {
+	cfg := config.ApiRoutesConfig{}
+	err := chainCore.LoadTomlFile(&cfg, filepath)
+	if err != nil {
+		return config.ApiRoutesConfig{}, err
+	}
+
+	return cfg, nil
+}
+
 func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig) (chainFactory.FileLoggingHandler, error) {
 	var fileLogging chainFactory.FileLoggingHandler
 	var err error
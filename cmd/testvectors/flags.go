@@ -0,0 +1,35 @@
+package main
+
+import (
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	mode = cli.StringFlag{
+		Name:  "mode",
+		Usage: "This flag specifies the operation mode. Usage: generate or verify",
+		Value: verifyMode,
+	}
+	vectorsFile = cli.StringFlag{
+		Name:  "file",
+		Usage: "The `" + filePathPlaceholder + "` for the .json file containing the test vectors",
+		Value: "testvectors/testdata/vectors.json",
+	}
+)
+
+func getFlags() []cli.Flag {
+	return []cli.Flag{
+		logLevel,
+		mode,
+		vectorsFile,
+	}
+}
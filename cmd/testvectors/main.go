@@ -0,0 +1,94 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-bridge-eth-go/testvectors"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+	generateMode        = "generate"
+	verifyMode          = "verify"
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Encoding test vectors CLI tool"
+	app.Usage = "This tool generates and verifies the canonical batches encoding vectors shared with the contracts teams"
+	app.Flags = getFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+
+	app.Action = func(c *cli.Context) error {
+		return execute(c)
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+
+	log.Info("process finished successfully")
+}
+
+func execute(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	filename := ctx.GlobalString(vectorsFile.Name)
+	operationMode := strings.ToLower(ctx.GlobalString(mode.Name))
+	switch operationMode {
+	case generateMode:
+		return generateVectors(filename)
+	case verifyMode:
+		return verifyVectors(filename)
+	}
+
+	return fmt.Errorf("unknown execution mode: %s", operationMode)
+}
+
+func generateVectors(filename string) error {
+	vectors, err := testvectors.Generate()
+	if err != nil {
+		return err
+	}
+
+	err = testvectors.SaveToFile(filename, vectors)
+	if err != nil {
+		return err
+	}
+
+	log.Info("generated test vectors", "file", filename, "num vectors", len(vectors))
+
+	return nil
+}
+
+func verifyVectors(filename string) error {
+	vectors, err := testvectors.LoadFromFile(filename)
+	if err != nil {
+		return err
+	}
+
+	err = testvectors.Verify(vectors)
+	if err != nil {
+		return err
+	}
+
+	log.Info("verified test vectors", "file", filename, "num vectors", len(vectors))
+
+	return nil
+}
@@ -23,15 +23,51 @@ type Config struct {
 	Logs              LogsConfig
 	WebAntiflood      WebAntifloodConfig
 	PeersRatingConfig PeersRatingConfig
+	ConfigBundle      ConfigBundleConfig
+	TokensMapper      TokensMapperConfig
+	Branding          BrandingConfig
+}
+
+// BrandingConfig holds the name under which the deployment is presented in the logs and in the API, so third parties
+// running the relayer against their own contracts do not show up as the MultiversX bridge
+type BrandingConfig struct {
+	Name string
+}
+
+// TokensMapperConfig selects the tokens mapper used by both clients. The parameters are passed as they are to
+// the selected mapper
+type TokensMapperConfig struct {
+	Type       string
+	Parameters map[string]string
+	Migrations []TokenMigrationConfig
+}
+
+// TokenMigrationConfig describes the move of a token to a new ERC20 contract. The boundaries are Ethereum block numbers
+type TokenMigrationConfig struct {
+	OldERC20Address string
+	NewERC20Address string
+	EffectiveBlock  uint64
+	ExpiryBlock     uint64
+}
+
+// ConfigBundleConfig defines the optional source of governance signed configuration bundles, applied at startup
+type ConfigBundleConfig struct {
+	Enabled                 bool
+	URL                     string
+	GovernanceAddress       string
+	RequestTimeoutInSeconds uint64
 }
 
 // EthereumConfig represents the Ethereum Config parameters
 type EthereumConfig struct {
 	Chain                              chain.Chain
 	NetworkAddress                     string
+	RPCMode                            string
 	MultisigContractAddress            string
 	SafeContractAddress                string
 	PrivateKeyFile                     string
+	PrivateKeyMnemonic                 EthereumMnemonicConfig
+	PrivateKeyKeystore                 KeystoreConfig
 	IntervalToResendTxsInSeconds       uint64
 	GasLimitBase                       uint64
 	GasLimitForEach                    uint64
@@ -41,6 +77,55 @@ type EthereumConfig struct {
 	ClientAvailabilityAllowDelta       uint64
 	EventsBlockRangeFrom               int64
 	EventsBlockRangeTo                 int64
+	ExecutionEventsLookbackBlocks      uint64
+	RawTransactionsExport              RawTransactionsExportConfig
+	ConfirmationPolicy                 ConfirmationPolicyConfig
+}
+
+// ConfirmationPolicyConfig defines the number of blocks a batch must be confirmed with, on top of the contract
+// finality, before it is transferred. The larger deposits can require more confirmations than the small ones. The
+// policy must be the same on all the relayers, the divergences are detected through the policy hash sent in the
+// join messages
+type ConfirmationPolicyConfig struct {
+	Enabled                 bool
+	DefaultNumConfirmations uint64
+	Tokens                  []TokenConfirmationTiersConfig
+}
+
+// TokenConfirmationTiersConfig holds the confirmation tiers of an ERC20 token
+type TokenConfirmationTiersConfig struct {
+	ERC20Address string
+	Tiers        []ConfirmationTierConfig
+}
+
+// ConfirmationTierConfig defines the number of confirmations required by the deposits with an amount, in the token
+// base units, of at least MinimumAmount
+type ConfirmationTierConfig struct {
+	MinimumAmount    string
+	NumConfirmations uint64
+}
+
+// RawTransactionsExportConfig holds the settings used by the leader to export the signed Ethereum execution
+// transactions, as raw hex, instead of broadcasting them
+type RawTransactionsExportConfig struct {
+	Enabled         bool
+	Directory       string
+	MaxTransactions int
+}
+
+// EthereumMnemonicConfig holds the settings used to derive the Ethereum relayer key from a BIP-39 mnemonic.
+// If the MnemonicFile is empty, the PrivateKeyFile is used instead
+type EthereumMnemonicConfig struct {
+	MnemonicFile   string
+	DerivationPath string
+}
+
+// KeystoreConfig holds the settings used to load a relayer key from an encrypted JSON keystore file.
+// The passphrase is read from the environment variable, then from the passphrase file, then from an interactive prompt
+type KeystoreConfig struct {
+	KeystoreFile          string
+	PassphraseEnvVariable string
+	PassphraseFile        string
 }
 
 // GasStationConfig represents the configuration for the gas station handler
@@ -58,25 +143,402 @@ type GasStationConfig struct {
 
 // ConfigP2P configuration for the P2P communication
 type ConfigP2P struct {
-	Port            string
-	InitialPeerList []string
-	ProtocolID      string
-	Transports      p2pConfig.P2PTransportConfig
-	AntifloodConfig config.AntifloodConfig
-	ResourceLimiter p2pConfig.P2PResourceLimiterConfig
+	Port                   string
+	InitialPeerList        []string
+	ProtocolID             string
+	Transports             p2pConfig.P2PTransportConfig
+	AntifloodConfig        config.AntifloodConfig
+	ResourceLimiter        p2pConfig.P2PResourceLimiterConfig
+	MessageCompression     MessageCompressionConfig
+	KnownPeers             KnownPeersConfig
+	SignaturesVerification SignaturesVerificationConfig
+	TopicsMetrics          TopicsMetricsConfig
+}
+
+// TopicsMetricsConfig is the configuration of the p2p status metrics: the connected peers of each topic, the messages
+// received and sent per minute, the rejected messages and the timestamp of the last received message
+type TopicsMetricsConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+}
+
+// SignaturesVerificationConfig is the configuration for the workers pool verifying the signatures of the received
+// catch-up messages and for the cache of the successfully verified signatures
+type SignaturesVerificationConfig struct {
+	NumWorkers int
+	CacheSize  int
+}
+
+// KnownPeersConfig is the configuration for the persistence of the addresses of the whitelisted relayers' peers. The
+// known peers are reconnected after a restart, before the DHT discovery starts
+type KnownPeersConfig struct {
+	Enabled               bool
+	FilePath              string
+	MaxPeers              int
+	SaveIntervalInSeconds uint64
+}
+
+// MessageCompressionConfig is the configuration for the compression of the messages sent to the other relayers. The
+// messages smaller than ThresholdInBytes are sent uncompressed
+type MessageCompressionConfig struct {
+	Type             string
+	ThresholdInBytes int
 }
 
 // ConfigRelayer configuration for general relayer configuration
 type ConfigRelayer struct {
 	Marshalizer          config.MarshalizerConfig
 	RoleProvider         RoleProviderConfig
+	LeaderSelection      LeaderSelectionConfig
+	NetworkCheck         NetworkCheckConfig
+	DependenciesWait     DependenciesWaitConfig
 	StatusMetricsStorage config.StorageConfig
+	StatusWriteBuffer    int
+	BatchResultsStorage  config.StorageConfig
+	StorageRetention     StorageRetentionConfig
+	SignerAuditLog       SignerAuditLogConfig
+	BalanceMonitor       BalanceMonitorConfig
+	RuntimeMonitor       RuntimeMonitorConfig
+	BatchValidator       BatchValidatorConfig
+	TransferAllowlist    TransferAllowlistConfig
+	RecipientValidation  RecipientValidationConfig
+	Faucet               FaucetConfig
+	Postmortem           PostmortemConfig
+	SLA                  SLAConfig
+	ErrorReporting       ErrorReportingConfig
+	GovernancePause      GovernancePauseConfig
+	Incidents            IncidentsConfig
+	DeadLetters          DeadLettersConfig
+	Idempotency          IdempotencyConfig
+	GasUsageTracker      GasUsageTrackerConfig
+	BalanceProof         BalanceProofConfig
+	FeeEstimation        FeeEstimationConfig
+	RoundingPolicy       RoundingPolicyConfig
+	TimingJitter         TimingJitterConfig
+	BatchTags            BatchTagsConfig
+	PreAgreement         PreAgreementConfig
+	TokenMetadata        TokenMetadataConfig
+	Heartbeat            HeartbeatConfig
+	StateMachineRecovery StateMachineRecoveryConfig
+	DirectMessages       DirectMessagesConfig
+	TransferReceipts     TransferReceiptsConfig
+	ContextAudit         ContextAuditConfig
+	CostAccounting       CostAccountingConfig
+}
+
+// PreAgreementConfig holds the settings of the p2p round run by the leader before proposing a batch on MultiversX: the
+// leader broadcasts its view of the batch and proposes it only after a majority of the relayers answered with the
+// same view. The timeout must be lower than the step duration of the state machines
+type PreAgreementConfig struct {
+	Enabled         bool
+	TimeoutInMillis uint64
+}
+
+// BatchTagsConfig holds the operator-defined tags attached to the batches and deposits in the stored batch results, the
+// API responses and the webhook notifications. The static tags are attached to every batch, the tag of a rule is
+// attached to the deposits matching all the rule's criteria and to their batches
+type BatchTagsConfig struct {
+	Enabled    bool
+	StaticTags []string
+	Rules      []BatchTagRuleConfig
+}
+
+// BatchTagRuleConfig is a rule attaching the tag to the transfers matching all the defined criteria. The tokens, the
+// senders and the recipients are compared, case-insensitive, with the displayable values of the transfer and the
+// minimum amount is a base 10 number in the token's denomination
+type BatchTagRuleConfig struct {
+	Tag        string
+	Tokens     []string
+	Senders    []string
+	Recipients []string
+	MinAmount  string
+}
+
+// TimingJitterConfig holds the maximum random delay added before each execution of the polling components, the state
+// machines included, so a fleet of relayers restarted at the same time does not query the same endpoints in lockstep.
+// The leader slots are computed without the jitter, which must be lower than the step duration of the state machines
+type TimingJitterConfig struct {
+	Enabled           bool
+	MaxJitterInMillis uint64
+}
+
+// BalanceProofConfig is the configuration of the balance proof exposed by the REST API: the reserves of the provided
+// ERC20 tokens, on both chains, and the delta of the bridge invariant. The proof is recomputed at most once every
+// CacheDurationInSeconds, as it requires many contract queries
+type BalanceProofConfig struct {
+	Enabled                bool
+	ERC20Tokens            []string
+	CacheDurationInSeconds uint64
+	QueryTimeoutInSeconds  uint64
+}
+
+// FeeEstimationConfig is the configuration of the deposits quotes exposed by the REST API for the wallet frontends. The
+// expected latency is the configured duration of a batch multiplied by the number of pending batches in the deposit
+// direction, plus the batch of the deposit
+type FeeEstimationConfig struct {
+	Enabled                bool
+	BatchLatencyInSeconds  uint64
+	CacheDurationInSeconds uint64
+	QueryTimeoutInSeconds  uint64
+}
+
+// RoundingPolicyConfig defines how the amounts are rounded when converted between tokens with different numbers of
+// decimals: "floor" truncates the dust, "reject-on-dust" refuses the amounts not representable on the destination
+// chain and "carry-dust-forward" adds the dust to the next conversion of the same token. The policy must be the same on
+// all the relayers, the divergences are detected through the policy hash sent in the join messages
+type RoundingPolicyConfig struct {
+	Enabled     bool
+	DefaultMode string
+	Tokens      []TokenRoundingConfig
+}
+
+// TokenRoundingConfig holds the rounding mode of a token, identified by its ERC20 address or its ESDT identifier
+type TokenRoundingConfig struct {
+	Token string
+	Mode  string
+}
+
+// GasUsageTrackerConfig is the configuration for recording the gas used by the relayer transactions for each contract
+// function. An alert is raised when all the last WindowSize transactions of a function used more than ThresholdPercent
+// above the function baseline
+type GasUsageTrackerConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+	WindowSize               int
+	ThresholdPercent         uint64
+	MaxPendingTransactions   int
+}
+
+// TokenMetadataConfig is the configuration for monitoring the symbol and the decimals of the bridged ERC20 tokens. An
+// empty ERC20Tokens list selects all the tokens known by the MultiversX safe contract
+type TokenMetadataConfig struct {
+	Enabled                  bool
+	ERC20Tokens              []string
+	PollingIntervalInSeconds uint64
+}
+
+// StateMachineRecoveryConfig is the configuration for persisting, after each step, the current step and the stored
+// batch, action ID and message hash of both state machines, so a restarted relayer resumes the flow from where it was
+// stopped. Checkpoints older than MaxCheckpointAgeInSeconds are ignored (0 means no limit)
+type StateMachineRecoveryConfig struct {
+	Enabled                   bool
+	MaxCheckpointAgeInSeconds uint64
+}
+
+// DirectMessagesConfig is the configuration of the direct messages channel between the whitelisted relayers, used for
+// operational coordination messages (maintenance notices, execution claims, veto reasons) sent through the admin API.
+// The last MaxMessages sent and received messages are kept in memory
+type DirectMessagesConfig struct {
+	Enabled     bool
+	MaxMessages int
+}
+
+// TransferReceiptsConfig enables the signed receipts of the deposits of the batches executed by this relayer, stored
+// with the batch results and retrievable through the API
+type TransferReceiptsConfig struct {
+	Enabled bool
+}
+
+// ContextAuditConfig bounds each state machine step with a deadline, propagated to all the outbound calls made by the
+// step, and counts the steps returning later than their deadline plus the tolerance, i.e. blocked in a call that ignores
+// the context
+type ContextAuditConfig struct {
+	Enabled                  bool
+	StepTimeoutInSeconds     uint64
+	OverrunToleranceInMillis uint64
+}
+
+// CostAccountingConfig enables the ledger of the execution costs paid by this relayer when it is the leader, and
+// holds the rules used to settle the costs between the consortium members
+type CostAccountingConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+	MaxPendingExecutions     int
+	SplitRule                string
+	Members                  []CostSharingMemberConfig
+}
+
+// CostSharingMemberConfig is a consortium member identified by its MultiversX relayer address
+type CostSharingMemberConfig struct {
+	Relayer string
+	Weight  uint64
+}
+
+// HeartbeatConfig is the configuration for publishing a periodic heartbeat, signed with the MultiversX relayer key, that
+// proves the relayer liveness. The "contract" mode calls the heartbeat contract, the fees paid in the last 24 hours
+// being capped to MaxDailyCost (denominated, in EGLD), while the "collector" mode posts the signed message to the
+// off-chain collector
+type HeartbeatConfig struct {
+	Enabled                      bool
+	Mode                         string
+	IntervalInSeconds            uint64
+	ContractAddress              string
+	ContractFunction             string
+	GasLimit                     uint64
+	MaxDailyCost                 string
+	IntervalToResendTxsInSeconds uint64
+	CollectorURL                 string
+	RequestTimeoutInSeconds      uint64
+}
+
+// GovernancePauseConfig is the configuration for halting the local processing while the pause flag is set, by the
+// governance, on the bridge contracts of either chain
+type GovernancePauseConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+}
+
+// IncidentsConfig is the configuration of the incidents log. When enabled, the processing halted by the governance pause
+// is not resumed before an operator acknowledges the incident through the admin API
+type IncidentsConfig struct {
+	Enabled      bool
+	FilePath     string
+	MaxIncidents int
+}
+
+// DeadLettersConfig is the configuration of the dead letters store. When enabled, a deposit whose batch failed to be
+// validated or executed MaxFailures times is listed through the admin API until an operator resolves it
+type DeadLettersConfig struct {
+	Enabled        bool
+	FilePath       string
+	MaxFailures    uint64
+	MaxDeadLetters int
+}
+
+// IdempotencyConfig is the configuration of the idempotency guard. When enabled, the idempotency keys of the completed
+// batch actions are persisted in the status storer and the relayer refuses to repeat these actions
+type IdempotencyConfig struct {
+	Enabled bool
+}
+
+// ErrorReportingConfig is the configuration for sending the critical errors and the state machine panics, together
+// with the bridge context (direction, step, batch ID), to a Sentry-compatible error tracking service
+type ErrorReportingConfig struct {
+	Enabled                 bool
+	DSN                     string `secret:"true"`
+	Environment             string
+	RequestTimeoutInSeconds int
+	QueueSize               int
+}
+
+// StorageRetentionConfig is the configuration for the removal of the old entries from the status metrics and batch
+// results storages. The entries not written in the last RetentionInDays days are removed on each sweep. 0 days
+// disables the retention
+type StorageRetentionConfig struct {
+	RetentionInDays        uint64
+	SweepIntervalInMinutes uint64
+}
+
+// BatchValidatorConfig is the configuration for the external risk engine asked to validate each batch before the
+// relayer signs it. The failure policy ("fail-open" or "fail-closed") decides what happens if the risk engine can not
+// be reached in time or responds with an invalid answer
+type BatchValidatorConfig struct {
+	Enabled              bool
+	URL                  string
+	RequestTimeInSeconds int
+	FailurePolicy        string
+}
+
+// TransferAllowlistConfig is the configuration for the strict mode where only the allowlisted recipients can receive
+// bridged transfers. The Ethereum recipients apply to the transfers from MultiversX and the MultiversX (bech32)
+// recipients apply to the transfers from Ethereum
+type TransferAllowlistConfig struct {
+	Enabled              bool
+	EthereumRecipients   []string
+	MultiversXRecipients []string
+}
+
+// RecipientValidationConfig is the configuration of the recipients checks done when a batch is fetched. The Ethereum
+// recipients should be non-zero addresses, other than the bridge contracts and the ones in EthereumDenylist. The
+// MultiversX recipients should be valid bech32 addresses, outside the metachain and, for the smart contracts, in the
+// shard of the bridge contracts, computed with NumShards
+type RecipientValidationConfig struct {
+	Enabled          bool
+	EthereumDenylist []string
+	NumShards        uint32
+}
+
+// SLAConfig is the configuration for the monthly SLA data recorded by the relayer (uptime, availability per
+// direction, transfer latencies and missed leader slots)
+type SLAConfig struct {
+	Enabled                    bool
+	HeartbeatIntervalInSeconds uint64
+	Storage                    config.StorageConfig
+}
+
+// PostmortemConfig defines the snapshots of the relayer state captured when a critical error occurs. The directory
+// is relative to the working directory
+type PostmortemConfig struct {
+	Enabled              bool
+	Directory            string
+	MinIntervalInSeconds uint64
+	MaxSnapshots         int
+}
+
+// FaucetConfig is the configuration for the test-support component that requests funds from faucets for the relayer
+// accounts. Must only be enabled on test networks
+type FaucetConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+	CooldownInSeconds        uint64
+	RequestTimeoutInSeconds  uint64
+	Ethereum                 FaucetEndpointConfig
+	MultiversX               FaucetEndpointConfig
+}
+
+// FaucetEndpointConfig defines a faucet endpoint. The {address} placeholder is replaced in the URL and the body
+// with the relayer address. An empty URL disables the faucet for that chain
+type FaucetEndpointConfig struct {
+	URL            string
+	Method         string
+	Body           string
+	MinimumBalance string
+}
+
+// BalanceMonitorConfig is the configuration for the relayer accounts balance monitoring
+type BalanceMonitorConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+	BurnRateWindowInHours    uint64
+	Ethereum                 BalanceThresholdsConfig
+	MultiversX               BalanceThresholdsConfig
+}
+
+// RuntimeMonitorConfig is the configuration for the monitoring of the relayer process goroutines, open file
+// descriptors and heap in use. A 0 baseline or growth percent disables the corresponding check. The profiles
+// directory is relative to the working directory, an empty value disables the profiles writing
+type RuntimeMonitorConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+	TrendWindowInMinutes     uint64
+	MaxGoroutines            int
+	MaxOpenFileDescriptors   int
+	MaxHeapInUseInMB         uint64
+	MaxGrowthPercent         uint64
+	ProfilesDirectory        string
+}
+
+// BalanceThresholdsConfig holds the thresholds below which the balance monitor alerts. The minimum balance is
+// expressed in denominated units (e.g. "0.5")
+type BalanceThresholdsConfig struct {
+	MinimumBalance     string
+	MinimumDaysToEmpty uint64
+}
+
+// SignerAuditLogConfig is the configuration for the hash-chained log of all the signatures produced by the relayer
+type SignerAuditLogConfig struct {
+	Enabled bool
+	Storage config.StorageConfig
 }
 
 // ConfigStateMachine the configuration for the state machine
 type ConfigStateMachine struct {
 	StepDurationInMillis       uint64
 	IntervalForLeaderInSeconds uint64
+	MaxRetriesOnQuorumReached  uint64
+	ExecutionDeadlineInSeconds uint64
+	GasPriceDeferralInSeconds  uint64
+	RejectionDeadlineInBlocks  uint64
 }
 
 // ContextFlagsConfig the configuration for flags
@@ -90,6 +552,12 @@ type ContextFlagsConfig struct {
 	EnableLogName        bool
 	RestApiInterface     string
 	EnablePprof          bool
+	DevClusterSize       int
+	ConfigOverrides      []string
+	PrintEffectiveConfig bool
+	ExportConfigSchema   bool
+	Profile              string
+	ProfilesDirectory    string
 }
 
 // WebServerAntifloodConfig will hold the anti-flooding parameters for the web server
@@ -107,8 +575,27 @@ type WebAntifloodConfig struct {
 
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging        ApiLoggingConfig
+	PublicReadMode PublicReadModeConfig
+	AdminAuth      AdminAuthConfig
+	APIPackages    map[string]APIPackageConfig
+}
+
+// AdminAuthConfig holds the settings of the admin routes authentication. When enabled, the admin requests must carry
+// the token read from the provided environment variable as a bearer token
+type AdminAuthConfig struct {
+	Enabled          bool
+	TokenEnvVariable string
+}
+
+// PublicReadModeConfig holds the settings of the public read mode, in which only the read-only routes are served,
+// rate limited per source IP and cached for a short interval
+type PublicReadModeConfig struct {
+	Enabled                      bool
+	SameSourceRequests           uint32
+	SameSourceResetIntervalInSec uint32
+	ResponseCacheCapacity        int
+	ResponseCacheExpirationInSec uint32
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -132,11 +619,39 @@ type RouteConfig struct {
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
 	LogFileLifeSpanInMB  int
+	LogLevel             string
 }
 
-// RoleProviderConfig is the configuration for the role provider component
+// RoleProviderConfig is the configuration for the role provider component. The relayers on probation, the configured
+// ones and the ones added to the whitelist while the relayer runs, verify everything but their signatures are not
+// counted and they skip their leader slots for the probation period. A 0 period disables the probation
 type RoleProviderConfig struct {
-	PollingIntervalInMillis uint64
+	PollingIntervalInMillis  uint64
+	ProbationPeriodInSeconds uint64
+	ProbationAddresses       []string
+}
+
+// LeaderSelectionConfig is the configuration for the strategy used to select the leader of each slot. All the relayers
+// must use the same strategy, otherwise they will not agree on the leaders
+type LeaderSelectionConfig struct {
+	Strategy string
+}
+
+// NetworkCheckConfig is the configuration of the startup check verifying that the configured contracts exist on the
+// connected Ethereum and MultiversX networks
+type NetworkCheckConfig struct {
+	Enabled bool
+}
+
+// DependenciesWaitConfig is the configuration of the startup phase waiting for the Ethereum RPC node and the MultiversX
+// proxy to become reachable. The checks are retried with an exponential backoff, capped to MaxBackoffInSeconds, and the
+// startup is aborted if the dependencies are still unreachable after MaxWaitInSeconds
+type DependenciesWaitConfig struct {
+	Enabled                 bool
+	InitialBackoffInMillis  uint64
+	MaxBackoffInSeconds     uint64
+	MaxWaitInSeconds        uint64
+	RequestTimeoutInSeconds uint64
 }
 
 // MultiversXConfig represents the MultiversX Config parameters
@@ -145,20 +660,94 @@ type MultiversXConfig struct {
 	MultisigContractAddress         string
 	SafeContractAddress             string
 	PrivateKeyFile                  string
+	PrivateKeyMnemonic              MultiversXMnemonicConfig
+	PrivateKeyKeystore              KeystoreConfig
 	IntervalToResendTxsInSeconds    uint64
 	GasMap                          MultiversXGasMapConfig
 	MaxRetriesOnQuorumReached       uint64
 	MaxRetriesOnWasTransferProposed uint64
 	ClientAvailabilityAllowDelta    uint64
+	LeftoverTxsTimeoutInSeconds     uint64
 	Proxy                           ProxyConfig
+	RelayedClaims                   RelayedClaimsConfig
+	ESDTRolesCheck                  ESDTRolesCheckConfig
+	StuckTransactions               MultiversXStuckTransactionsConfig
+	GasPayer                        MultiversXGasPayerConfig
+}
+
+// MultiversXGasPayerConfig holds the settings of the account paying the gas of the relayer's transactions. When enabled,
+// the transactions signed with the whitelisted relayer key are wrapped in relayed v2 transactions sent by this account,
+// so the whitelisted key does not need to hold any funds
+type MultiversXGasPayerConfig struct {
+	Enabled        bool
+	PrivateKeyFile string
+}
+
+// MultiversXStuckTransactionsConfig holds the settings used to resend, with the same nonce, the relayer's transactions
+// not included in a block after StuckAfterRounds rounds. The gas price is increased only if GasPriceBumpEnabled is set,
+// for the networks accepting the replacement of the pending transactions
+type MultiversXStuckTransactionsConfig struct {
+	Enabled                bool
+	StuckAfterRounds       uint64
+	CheckIntervalInSeconds uint64
+	MaxResends             int
+	GasPriceBumpEnabled    bool
+	GasPriceBumpPercent    uint64
+	MaxGasPrice            uint64
+}
+
+// ESDTRolesCheckConfig holds the settings of the preflight verifying that the safe contract holds the ESDT roles required
+// by the tokens bridged towards MultiversX. The roles of a token are re-queried after CacheDurationInSeconds
+type ESDTRolesCheckConfig struct {
+	Enabled                bool
+	CacheDurationInSeconds uint64
+}
+
+// RelayedClaimsConfig holds the settings used to relay the claim transactions signed by the users, the gas being paid
+// by the sponsor account
+type RelayedClaimsConfig struct {
+	Enabled                      bool
+	SponsorPrivateKeyFile        string
+	ClaimContractAddress         string
+	ClaimFunction                string
+	ClaimGasLimit                uint64
+	IntervalToResendTxsInSeconds uint64
+	MaxClaimsPerUser             int
+	RateLimitWindowInSeconds     uint64
+	Tokens                       []RelayedClaimTokenConfig
+}
+
+// RelayedClaimTokenConfig holds the minimum fee, deducted from the claimed amount, accepted for a sponsored token
+type RelayedClaimTokenConfig struct {
+	Token      string
+	MinimumFee string
+}
+
+// MultiversXMnemonicConfig holds the settings used to derive the MultiversX relayer key from a BIP-39 mnemonic.
+// If the MnemonicFile is empty, the PrivateKeyFile is used instead
+type MultiversXMnemonicConfig struct {
+	MnemonicFile string
+	Account      uint32
+	AddressIndex uint32
 }
 
 // ProxyConfig represents the configuration for the MultiversX proxy
 type ProxyConfig struct {
-	CacherExpirationSeconds uint64
-	RestAPIEntityType       string
-	MaxNoncesDelta          int
-	FinalityCheck           bool
+	CacherExpirationSeconds                  uint64
+	RestAPIEntityType                        string
+	MaxNoncesDelta                           int
+	FinalityCheck                            bool
+	MaxParallelVMQueries                     int
+	QueriesCacheNonceRefreshIntervalInMillis uint64
+	Failover                                 ProxyFailoverConfig
+}
+
+// ProxyFailoverConfig holds the backup proxies used when the MultiversX.NetworkAddress proxy errors or falls behind the
+// most synced proxy with more than MaxNoncesBehind metachain nonces. The failover is disabled if no address is set
+type ProxyFailoverConfig struct {
+	NetworkAddresses       []string
+	CheckIntervalInSeconds uint64
+	MaxNoncesBehind        uint64
 }
 
 // MultiversXGasMapConfig represents the gas limits for MultiversX operations
@@ -193,9 +782,11 @@ type PendingOperationsFilterConfig struct {
 // ScCallsModuleConfig will hold the settings for the SC calls module
 type ScCallsModuleConfig struct {
 	ScProxyBech32Address            string
+	ScProxyBech32Addresses          []string
 	ExtraGasToExecute               uint64
 	MaxGasLimitToUse                uint64
 	GasLimitForOutOfGasTransactions uint64
+	MaxConcurrentExecutions         int
 	NetworkAddress                  string
 	ProxyMaxNoncesDelta             int
 	ProxyFinalityCheck              bool
@@ -207,6 +798,19 @@ type ScCallsModuleConfig struct {
 	Filter                          PendingOperationsFilterConfig
 	Logs                            LogsConfig
 	TransactionChecks               TransactionChecksConfig
+	Webhook                         WebhookConfig
+	BatchTags                       BatchTagsConfig
+	StatusMetricsStorage            StorageConfig
+	WebAntiflood                    WebAntifloodConfig
+}
+
+// WebhookConfig will hold the settings for the SC calls execution results webhook
+type WebhookConfig struct {
+	Enabled                 bool
+	URL                     string
+	SecretKeyFile           string
+	RequestTimeoutInSeconds uint64
+	QueueSize               int
 }
 
 // TransactionChecksConfig will hold the setting for how to handle the transaction execution
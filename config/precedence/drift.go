@@ -0,0 +1,105 @@
+package precedence
+
+import (
+	"reflect"
+	"sort"
+)
+
+// missingValue is displayed on the side where a map entry or a slice element does not exist
+const missingValue = "<missing>"
+
+// Drift is a config leaf having a different value in the running config than in the config file
+type Drift struct {
+	Path         string `json:"path"`
+	RunningValue string `json:"runningValue"`
+	FileValue    string `json:"fileValue"`
+}
+
+// Diff compares the running config with the one loaded from the config file and returns the leaves having different
+// values, sorted by path. Both configs should be pointers to structs of the same type. The secret values are compared
+// as they are but displayed masked
+func Diff(running interface{}, file interface{}) ([]*Drift, error) {
+	if reflect.TypeOf(running) != reflect.TypeOf(file) {
+		return nil, ErrInvalidConfig
+	}
+	runningFields, err := flatten(running)
+	if err != nil {
+		return nil, err
+	}
+	fileFields, err := flatten(file)
+	if err != nil {
+		return nil, err
+	}
+
+	drifts := make([]*Drift, 0)
+	for path, runningField := range runningFields {
+		fileField, found := fileFields[path]
+		if !found {
+			drifts = append(drifts, &Drift{
+				Path:         path,
+				RunningValue: runningField.displayValue(),
+				FileValue:    missingValue,
+			})
+			continue
+		}
+		if isSameValue(runningField.value, fileField.value) {
+			continue
+		}
+
+		drifts = append(drifts, &Drift{
+			Path:         path,
+			RunningValue: runningField.displayValue(),
+			FileValue:    fileField.displayValue(),
+		})
+	}
+	for path, fileField := range fileFields {
+		_, found := runningFields[path]
+		if !found {
+			drifts = append(drifts, &Drift{
+				Path:         path,
+				RunningValue: missingValue,
+				FileValue:    fileField.displayValue(),
+			})
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Path < drifts[j].Path
+	})
+
+	return drifts, nil
+}
+
+func flatten(cfg interface{}) (map[string]*Field, error) {
+	value := reflect.ValueOf(cfg)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, ErrInvalidConfig
+	}
+
+	w := &walker{}
+	w.walk(value.Elem(), "", false)
+
+	fields := make(map[string]*Field, len(w.fields))
+	for _, field := range w.fields {
+		fields[field.Path] = field
+	}
+
+	return fields, nil
+}
+
+// isSameValue compares two leaves of the same type, the nil and the empty slices being considered equal
+func isSameValue(first reflect.Value, second reflect.Value) bool {
+	if first.Kind() != reflect.Slice {
+		return first.Interface() == second.Interface()
+	}
+	if first.Len() != second.Len() {
+		return false
+	}
+	for i := 0; i < first.Len(); i++ {
+		if first.Index(i).Interface() != second.Index(i).Interface() {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,15 @@
+package precedence
+
+import "errors"
+
+// ErrInvalidConfig signals that the provided config is not a pointer to a struct
+var ErrInvalidConfig = errors.New("the config should be a pointer to a struct")
+
+// ErrInvalidOverride signals that a config override is not in the Path=value format
+var ErrInvalidOverride = errors.New("invalid config override, expected Path=value")
+
+// ErrUnknownConfigPath signals that a config override targets a path that does not exist in the config
+var ErrUnknownConfigPath = errors.New("unknown config path")
+
+// ErrInvalidValue signals that the value of an override can not be parsed into the field type
+var ErrInvalidValue = errors.New("invalid value")
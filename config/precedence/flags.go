@@ -0,0 +1,44 @@
+package precedence
+
+import (
+	"github.com/urfave/cli"
+)
+
+// WithEnvVars sets, on each of the provided flags that does not already have one, the environment variable obtained
+// from the prefix and the flag name (log-level becomes <prefix>LOG_LEVEL) so the flags can be provided through the
+// environment as well
+func WithEnvVars(prefix string, flags []cli.Flag) []cli.Flag {
+	result := make([]cli.Flag, 0, len(flags))
+	for _, flag := range flags {
+		result = append(result, withEnvVar(prefix, flag))
+	}
+
+	return result
+}
+
+func withEnvVar(prefix string, flag cli.Flag) cli.Flag {
+	switch typedFlag := flag.(type) {
+	case cli.StringFlag:
+		if len(typedFlag.EnvVar) == 0 {
+			typedFlag.EnvVar = EnvName(prefix, typedFlag.Name)
+		}
+		return typedFlag
+	case cli.BoolFlag:
+		if len(typedFlag.EnvVar) == 0 {
+			typedFlag.EnvVar = EnvName(prefix, typedFlag.Name)
+		}
+		return typedFlag
+	case cli.IntFlag:
+		if len(typedFlag.EnvVar) == 0 {
+			typedFlag.EnvVar = EnvName(prefix, typedFlag.Name)
+		}
+		return typedFlag
+	case cli.Uint64Flag:
+		if len(typedFlag.EnvVar) == 0 {
+			typedFlag.EnvVar = EnvName(prefix, typedFlag.Name)
+		}
+		return typedFlag
+	default:
+		return flag
+	}
+}
@@ -0,0 +1,336 @@
+package precedence
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+const (
+	// SourceDefault is the source of the values not provided by any layer
+	SourceDefault = "default"
+	// SourceConfigFile is the source of the values read from the config file
+	SourceConfigFile = "config file"
+	// SourceEnv is the source of the values provided by the environment variables
+	SourceEnv = "env"
+	// SourceFlag is the source of the values provided by the command line flags
+	SourceFlag = "flag"
+
+	pathSeparator     = "."
+	envSeparator      = "_"
+	overrideSeparator = "="
+	listSeparator     = ","
+	tomlTag           = "toml"
+	secretTag         = "secret"
+)
+
+// ArgsOverrides is the DTO used to apply the environment and command line overrides on a loaded config
+type ArgsOverrides struct {
+	EnvPrefix      string
+	FlagOverrides  []string
+	ConfigFilePath string
+	LookupEnv      func(key string) (string, bool)
+}
+
+// Field is a leaf of the configuration together with the layer that provided its value
+type Field struct {
+	Path     string
+	EnvName  string
+	Source   string
+	IsSecret bool
+	value    reflect.Value
+}
+
+// ApplyOverrides applies, in this order, the environment variables and the Path=value flag overrides on top of the
+// config already loaded from the config file, so the precedence is flags > env > config file > defaults.
+// The config should be a pointer to a struct. It returns all the config leaves together with their sources
+func ApplyOverrides(cfg interface{}, args ArgsOverrides) ([]*Field, error) {
+	value := reflect.ValueOf(cfg)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return nil, ErrInvalidConfig
+	}
+	lookupEnv := args.LookupEnv
+	if lookupEnv == nil {
+		lookupEnv = os.LookupEnv
+	}
+
+	w := &walker{
+		envPrefix: args.EnvPrefix,
+	}
+	w.walk(value.Elem(), "", false)
+
+	err := setFileSources(w.fields, args.ConfigFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range w.fields {
+		raw, found := lookupEnv(field.EnvName)
+		if !found {
+			continue
+		}
+
+		err = field.set(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w for environment variable %s", err, field.EnvName)
+		}
+		field.Source = SourceEnv
+	}
+
+	fieldsByPath := make(map[string]*Field, len(w.fields))
+	for _, field := range w.fields {
+		fieldsByPath[strings.ToLower(field.Path)] = field
+	}
+	for _, override := range args.FlagOverrides {
+		path, raw, err := splitOverride(override)
+		if err != nil {
+			return nil, err
+		}
+
+		field, found := fieldsByPath[strings.ToLower(path)]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownConfigPath, path)
+		}
+
+		err = field.set(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w for config override %s", err, path)
+		}
+		field.Source = SourceFlag
+	}
+
+	// the map entries were changed on copies, write them back
+	for _, commit := range w.commits {
+		commit()
+	}
+
+	return w.fields, nil
+}
+
+// EnvName returns the environment variable name used for the provided config path or flag name
+func EnvName(prefix string, path string) string {
+	name := strings.Map(func(r rune) rune {
+		isAllowed := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if isAllowed {
+			return r
+		}
+		return '_'
+	}, path)
+
+	return prefix + strings.ToUpper(name)
+}
+
+func splitOverride(override string) (string, string, error) {
+	parts := strings.SplitN(override, overrideSeparator, 2)
+	if len(parts) != 2 || len(strings.TrimSpace(parts[0])) == 0 {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidOverride, override)
+	}
+
+	return strings.TrimSpace(parts[0]), parts[1], nil
+}
+
+func setFileSources(fields []*Field, configFilePath string) error {
+	var tree *toml.Tree
+	if len(configFilePath) > 0 {
+		var err error
+		tree, err = toml.LoadFile(configFilePath)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, field := range fields {
+		field.Source = SourceDefault
+		if tree != nil && tree.Has(fileKey(field.Path)) {
+			field.Source = SourceConfigFile
+		}
+	}
+
+	return nil
+}
+
+// fileKey returns the key to be searched in the config file. The slice elements are not addressable by index in a
+// TOML tree so the key of the whole slice is used instead
+func fileKey(path string) string {
+	segments := strings.Split(path, pathSeparator)
+	for i, segment := range segments {
+		_, err := strconv.Atoi(segment)
+		if err == nil {
+			return strings.Join(segments[:i], pathSeparator)
+		}
+	}
+
+	return path
+}
+
+type walker struct {
+	envPrefix string
+	fields    []*Field
+	commits   []func()
+}
+
+func (w *walker) walk(value reflect.Value, path string, isSecret bool) {
+	switch {
+	case isLeaf(value.Type()):
+		w.fields = append(w.fields, &Field{
+			Path:     path,
+			EnvName:  EnvName(w.envPrefix, path),
+			IsSecret: isSecret,
+			value:    value,
+		})
+	case value.Kind() == reflect.Struct:
+		w.walkStruct(value, path, isSecret)
+	case value.Kind() == reflect.Map:
+		w.walkMap(value, path, isSecret)
+	case value.Kind() == reflect.Slice:
+		for i := 0; i < value.Len(); i++ {
+			w.walk(value.Index(i), joinPath(path, strconv.Itoa(i)), isSecret)
+		}
+	}
+}
+
+func (w *walker) walkStruct(value reflect.Value, path string, isSecret bool) {
+	valueType := value.Type()
+	for i := 0; i < valueType.NumField(); i++ {
+		structField := valueType.Field(i)
+		if len(structField.PkgPath) > 0 {
+			// unexported field
+			continue
+		}
+
+		name := fieldName(structField)
+		if len(name) == 0 {
+			continue
+		}
+
+		fieldIsSecret := isSecret || structField.Tag.Get(secretTag) == "true"
+		w.walk(value.Field(i), joinPath(path, name), fieldIsSecret)
+	}
+}
+
+func (w *walker) walkMap(value reflect.Value, path string, isSecret bool) {
+	if value.Type().Key().Kind() != reflect.String || value.IsNil() {
+		return
+	}
+
+	keys := value.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	for _, key := range keys {
+		// the map values are not addressable, an addressable copy is walked and written back after the overrides
+		entry := reflect.New(value.Type().Elem()).Elem()
+		entry.Set(value.MapIndex(key))
+		w.walk(entry, joinPath(path, key.String()), isSecret)
+
+		mapKey := key
+		w.commits = append(w.commits, func() {
+			value.SetMapIndex(mapKey, entry)
+		})
+	}
+}
+
+func fieldName(structField reflect.StructField) string {
+	tag := structField.Tag.Get(tomlTag)
+	name := strings.Split(tag, listSeparator)[0]
+	if name == "-" {
+		return ""
+	}
+	if len(name) > 0 {
+		return name
+	}
+
+	return structField.Name
+}
+
+func joinPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+
+	return path + pathSeparator + name
+}
+
+func isLeaf(valueType reflect.Type) bool {
+	if valueType.Kind() == reflect.Slice {
+		return isScalar(valueType.Elem().Kind())
+	}
+
+	return isScalar(valueType.Kind())
+}
+
+func isScalar(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func (field *Field) set(raw string) error {
+	if field.value.Kind() != reflect.Slice {
+		return setScalar(field.value, raw)
+	}
+
+	items := make([]string, 0)
+	for _, item := range strings.Split(raw, listSeparator) {
+		item = strings.TrimSpace(item)
+		if len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	slice := reflect.MakeSlice(field.value.Type(), len(items), len(items))
+	for i, item := range items {
+		err := setScalar(slice.Index(i), item)
+		if err != nil {
+			return err
+		}
+	}
+	field.value.Set(slice)
+
+	return nil
+}
+
+func setScalar(value reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("%w %q, expected a bool", ErrInvalidValue, raw)
+		}
+		value.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(raw, 10, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w %q, expected an integer", ErrInvalidValue, raw)
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(raw, 10, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w %q, expected an unsigned integer", ErrInvalidValue, raw)
+		}
+		value.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(raw, value.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("%w %q, expected a number", ErrInvalidValue, raw)
+		}
+		value.SetFloat(parsed)
+	}
+
+	return nil
+}
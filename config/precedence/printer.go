@@ -0,0 +1,74 @@
+package precedence
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+const maskedValue = "****"
+
+// PrintEffectiveConfig writes the provided fields, one per line, together with their sources and environment
+// variable names. The secret fields and the credentials embedded in URLs are masked
+func PrintEffectiveConfig(writer io.Writer, fields []*Field) error {
+	for _, field := range fields {
+		_, err := fmt.Fprintf(writer, "%s = %s # source: %s, env: %s\n",
+			field.Path, field.displayValue(), field.Source, field.EnvName)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (field *Field) displayValue() string {
+	if field.IsSecret && !field.value.IsZero() {
+		return fmt.Sprintf("%q", maskedValue)
+	}
+	if field.value.Kind() != reflect.Slice {
+		return displayScalar(field.value)
+	}
+
+	items := make([]string, 0, field.value.Len())
+	for i := 0; i < field.value.Len(); i++ {
+		items = append(items, displayScalar(field.value.Index(i)))
+	}
+
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+func displayScalar(value reflect.Value) string {
+	if value.Kind() == reflect.String {
+		return fmt.Sprintf("%q", maskURLCredentials(value.String()))
+	}
+
+	return fmt.Sprintf("%v", value.Interface())
+}
+
+// maskURLCredentials masks the user info and the query values of the URLs as they might hold API keys
+func maskURLCredentials(value string) string {
+	if !strings.Contains(value, "://") {
+		return value
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return value
+	}
+
+	if parsed.User != nil {
+		parsed.User = url.User(maskedValue)
+	}
+	if len(parsed.RawQuery) > 0 {
+		query := parsed.Query()
+		for key := range query {
+			query.Set(key, maskedValue)
+		}
+		parsed.RawQuery = query.Encode()
+	}
+
+	return parsed.String()
+}
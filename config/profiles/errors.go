@@ -0,0 +1,12 @@
+package profiles
+
+import "errors"
+
+// ErrInvalidProfileName signals that the profile name contains other characters than letters, digits, '-' and '_'
+var ErrInvalidProfileName = errors.New("invalid profile name")
+
+// ErrUnknownProfile signals that the profiles directory does not contain the requested profile
+var ErrUnknownProfile = errors.New("unknown profile")
+
+// ErrMissingProfileConfig signals that the profile does not provide the main configuration file
+var ErrMissingProfileConfig = errors.New("missing profile configuration file")
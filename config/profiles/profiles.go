@@ -0,0 +1,89 @@
+package profiles
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// ConfigFileName is the name of the main configuration file of a profile
+	ConfigFileName = "config.toml"
+	// ApiConfigFileName is the name of the optional api routes configuration file of a profile
+	ApiConfigFileName = "api.toml"
+)
+
+var profileNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// Profile is a named set of configuration files, kept in its own directory, so a whole deployment (contracts, tokens,
+// chains and branding) can be selected at once
+type Profile struct {
+	Name                 string
+	Directory            string
+	ConfigurationFile    string
+	ConfigurationApiFile string
+}
+
+// Load returns the profile with the provided name from the profiles directory. The profile should contain the main
+// configuration file, the api routes configuration file is optional and left empty if not provided
+func Load(directory string, name string) (*Profile, error) {
+	if !profileNameRegex.MatchString(name) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidProfileName, name)
+	}
+
+	profileDirectory := filepath.Join(directory, name)
+	info, err := os.Stat(profileDirectory)
+	if err != nil || !info.IsDir() {
+		available, _ := List(directory)
+		return nil, fmt.Errorf("%w %s in %s, available profiles: [%s]",
+			ErrUnknownProfile, name, directory, strings.Join(available, ", "))
+	}
+
+	profile := &Profile{
+		Name:              name,
+		Directory:         profileDirectory,
+		ConfigurationFile: filepath.Join(profileDirectory, ConfigFileName),
+	}
+	if !fileExists(profile.ConfigurationFile) {
+		return nil, fmt.Errorf("%w %s for profile %s", ErrMissingProfileConfig, profile.ConfigurationFile, name)
+	}
+
+	apiConfigFile := filepath.Join(profileDirectory, ApiConfigFileName)
+	if fileExists(apiConfigFile) {
+		profile.ConfigurationApiFile = apiConfigFile
+	}
+
+	return profile, nil
+}
+
+// List returns the sorted names of the profiles found in the profiles directory
+func List(directory string) ([]string, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() || !profileNameRegex.MatchString(entry.Name()) {
+			continue
+		}
+		if !fileExists(filepath.Join(directory, entry.Name(), ConfigFileName)) {
+			continue
+		}
+
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && !info.IsDir()
+}
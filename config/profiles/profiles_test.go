@@ -0,0 +1,104 @@
+package profiles
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createProfile(t *testing.T, directory string, name string, files ...string) {
+	profileDirectory := filepath.Join(directory, name)
+	require.Nil(t, os.MkdirAll(profileDirectory, os.ModePerm))
+	for _, file := range files {
+		require.Nil(t, os.WriteFile(filepath.Join(profileDirectory, file), []byte("# "+file), 0644))
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid name should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, name := range []string{"", "../mainnet", "main net", "mainnet/"} {
+			profile, err := Load(t.TempDir(), name)
+			assert.Nil(t, profile)
+			assert.True(t, errors.Is(err, ErrInvalidProfileName), name)
+		}
+	})
+	t.Run("unknown profile should error and list the available profiles", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		createProfile(t, directory, "partner-b", ConfigFileName)
+		createProfile(t, directory, "partner-a", ConfigFileName, ApiConfigFileName)
+
+		profile, err := Load(directory, "partner-c")
+		assert.Nil(t, profile)
+		assert.True(t, errors.Is(err, ErrUnknownProfile))
+		assert.True(t, strings.Contains(err.Error(), "[partner-a, partner-b]"))
+	})
+	t.Run("missing configuration file should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		createProfile(t, directory, "partner", ApiConfigFileName)
+
+		profile, err := Load(directory, "partner")
+		assert.Nil(t, profile)
+		assert.True(t, errors.Is(err, ErrMissingProfileConfig))
+	})
+	t.Run("should work without the api configuration file", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		createProfile(t, directory, "partner", ConfigFileName)
+
+		profile, err := Load(directory, "partner")
+		require.Nil(t, err)
+		assert.Equal(t, "partner", profile.Name)
+		assert.Equal(t, filepath.Join(directory, "partner", ConfigFileName), profile.ConfigurationFile)
+		assert.Empty(t, profile.ConfigurationApiFile)
+	})
+	t.Run("should work with the api configuration file", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		createProfile(t, directory, "partner_1", ConfigFileName, ApiConfigFileName)
+
+		profile, err := Load(directory, "partner_1")
+		require.Nil(t, err)
+		assert.Equal(t, filepath.Join(directory, "partner_1"), profile.Directory)
+		assert.Equal(t, filepath.Join(directory, "partner_1", ApiConfigFileName), profile.ConfigurationApiFile)
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		names, err := List(filepath.Join(t.TempDir(), "missing"))
+		assert.NotNil(t, err)
+		assert.Nil(t, names)
+	})
+	t.Run("should only list the directories with a configuration file", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		createProfile(t, directory, "mainnet", ConfigFileName)
+		createProfile(t, directory, "devnet", ConfigFileName, ApiConfigFileName)
+		createProfile(t, directory, "incomplete", ApiConfigFileName)
+		require.Nil(t, os.WriteFile(filepath.Join(directory, "notes.txt"), []byte("notes"), 0644))
+
+		names, err := List(directory)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"devnet", "mainnet"}, names)
+	})
+}
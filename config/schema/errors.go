@@ -0,0 +1,9 @@
+package schema
+
+import "errors"
+
+// ErrInvalidConfig signals that the provided config is not a struct or a pointer to a struct
+var ErrInvalidConfig = errors.New("the config should be a struct or a pointer to a struct")
+
+// ErrSchemaViolation signals that a configuration file does not match the schema of the config
+var ErrSchemaViolation = errors.New("the configuration does not match the schema")
@@ -0,0 +1,291 @@
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+const (
+	// Draft is the JSON Schema version of the generated schemas
+	Draft = "http://json-schema.org/draft-07/schema#"
+
+	typeObject  = "object"
+	typeArray   = "array"
+	typeString  = "string"
+	typeBoolean = "boolean"
+	typeInteger = "integer"
+	typeNumber  = "number"
+
+	tomlTag       = "toml"
+	tagSeparator  = ","
+	pathSeparator = "."
+)
+
+// Schema is a JSON Schema node describing a config value. The structs are described as objects not accepting other
+// properties than their fields, so the misspelled keys of a configuration file are reported
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties interface{}        `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Minimum              json.Number        `json:"minimum,omitempty"`
+	Maximum              json.Number        `json:"maximum,omitempty"`
+}
+
+// Generate returns the JSON Schema of the provided config, a struct or a pointer to a struct. The keys are named as
+// in the TOML files: the toml tag of the field if provided, the field name otherwise
+func Generate(cfg interface{}) (*Schema, error) {
+	configType := reflect.TypeOf(cfg)
+	if configType != nil && configType.Kind() == reflect.Ptr {
+		configType = configType.Elem()
+	}
+	if configType == nil || configType.Kind() != reflect.Struct {
+		return nil, ErrInvalidConfig
+	}
+
+	root := generate(configType)
+	root.Schema = Draft
+	root.Title = configType.Name()
+
+	return root, nil
+}
+
+func generate(valueType reflect.Type) *Schema {
+	switch valueType.Kind() {
+	case reflect.Ptr:
+		return generate(valueType.Elem())
+	case reflect.Struct:
+		return generateStruct(valueType)
+	case reflect.Map:
+		if valueType.Key().Kind() != reflect.String {
+			return &Schema{}
+		}
+		return &Schema{
+			Type:                 typeObject,
+			AdditionalProperties: generate(valueType.Elem()),
+		}
+	case reflect.Slice, reflect.Array:
+		return &Schema{
+			Type:  typeArray,
+			Items: generate(valueType.Elem()),
+		}
+	case reflect.String:
+		return &Schema{Type: typeString}
+	case reflect.Bool:
+		return &Schema{Type: typeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		shift := 64 - valueType.Bits()
+		return &Schema{
+			Type:    typeInteger,
+			Minimum: json.Number(strconv.FormatInt(math.MinInt64>>shift, 10)),
+			Maximum: json.Number(strconv.FormatInt(math.MaxInt64>>shift, 10)),
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{
+			Type:    typeInteger,
+			Minimum: "0",
+			Maximum: json.Number(strconv.FormatUint(math.MaxUint64>>(64-valueType.Bits()), 10)),
+		}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: typeNumber}
+	default:
+		return &Schema{}
+	}
+}
+
+func generateStruct(structType reflect.Type) *Schema {
+	properties := make(map[string]*Schema)
+	for i := 0; i < structType.NumField(); i++ {
+		structField := structType.Field(i)
+		if len(structField.PkgPath) > 0 {
+			// unexported field
+			continue
+		}
+
+		name := fieldName(structField)
+		if len(name) == 0 {
+			continue
+		}
+
+		properties[name] = generate(structField.Type)
+	}
+
+	return &Schema{
+		Type:                 typeObject,
+		Properties:           properties,
+		AdditionalProperties: false,
+	}
+}
+
+func fieldName(structField reflect.StructField) string {
+	tag := structField.Tag.Get(tomlTag)
+	name := strings.Split(tag, tagSeparator)[0]
+	if name == "-" {
+		return ""
+	}
+	if len(name) > 0 {
+		return name
+	}
+
+	return structField.Name
+}
+
+// ValidateFile checks the provided TOML configuration file against the schema
+func (schema *Schema) ValidateFile(filePath string) error {
+	tree, err := toml.LoadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate(tree.ToMap())
+}
+
+// Validate checks the provided document, as decoded from a TOML or a JSON file, against the schema. All the
+// violations are reported, sorted by their path. The keys are matched case-insensitively, as the TOML decoder does
+func (schema *Schema) Validate(document map[string]interface{}) error {
+	violations := make([]string, 0)
+	schema.validate(document, "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	sort.Strings(violations)
+
+	return fmt.Errorf("%w: %s", ErrSchemaViolation, strings.Join(violations, "; "))
+}
+
+func (schema *Schema) validate(value interface{}, path string, violations *[]string) {
+	switch schema.Type {
+	case typeObject:
+		schema.validateObject(value, path, violations)
+	case typeArray:
+		items, ok := toSlice(value)
+		if !ok {
+			*violations = append(*violations, fmt.Sprintf("%s should be an array", displayPath(path)))
+			return
+		}
+		for i, item := range items {
+			schema.Items.validate(item, joinPath(path, strconv.Itoa(i)), violations)
+		}
+	case typeString:
+		_, ok := value.(string)
+		if !ok {
+			*violations = append(*violations, fmt.Sprintf("%s should be a string", displayPath(path)))
+		}
+	case typeBoolean:
+		_, ok := value.(bool)
+		if !ok {
+			*violations = append(*violations, fmt.Sprintf("%s should be a boolean", displayPath(path)))
+		}
+	case typeInteger:
+		schema.validateInteger(value, path, violations)
+	case typeNumber:
+		switch value.(type) {
+		case int64, uint64, float64:
+		default:
+			*violations = append(*violations, fmt.Sprintf("%s should be a number", displayPath(path)))
+		}
+	}
+}
+
+func (schema *Schema) validateObject(value interface{}, path string, violations *[]string) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		*violations = append(*violations, fmt.Sprintf("%s should be a table", displayPath(path)))
+		return
+	}
+
+	for key, item := range object {
+		property := schema.findProperty(key)
+		if property != nil {
+			property.validate(item, joinPath(path, key), violations)
+			continue
+		}
+
+		additional, isSchema := schema.AdditionalProperties.(*Schema)
+		if isSchema {
+			additional.validate(item, joinPath(path, key), violations)
+			continue
+		}
+
+		*violations = append(*violations, fmt.Sprintf("%s is not a known key", joinPath(path, key)))
+	}
+}
+
+func (schema *Schema) findProperty(key string) *Schema {
+	property, found := schema.Properties[key]
+	if found {
+		return property
+	}
+
+	for name, property := range schema.Properties {
+		if strings.EqualFold(name, key) {
+			return property
+		}
+	}
+
+	return nil
+}
+
+func (schema *Schema) validateInteger(value interface{}, path string, violations *[]string) {
+	number := big.NewInt(0)
+	switch typed := value.(type) {
+	case int64:
+		number.SetInt64(typed)
+	case uint64:
+		number.SetUint64(typed)
+	default:
+		*violations = append(*violations, fmt.Sprintf("%s should be an integer", displayPath(path)))
+		return
+	}
+
+	minimum, ok := big.NewInt(0).SetString(schema.Minimum.String(), 10)
+	if ok && number.Cmp(minimum) < 0 {
+		*violations = append(*violations, fmt.Sprintf("%s should be at least %s", displayPath(path), minimum.String()))
+	}
+	maximum, ok := big.NewInt(0).SetString(schema.Maximum.String(), 10)
+	if ok && number.Cmp(maximum) > 0 {
+		*violations = append(*violations, fmt.Sprintf("%s should be at most %s", displayPath(path), maximum.String()))
+	}
+}
+
+func toSlice(value interface{}) ([]interface{}, bool) {
+	switch typed := value.(type) {
+	case []interface{}:
+		return typed, true
+	case []map[string]interface{}:
+		items := make([]interface{}, 0, len(typed))
+		for _, item := range typed {
+			items = append(items, item)
+		}
+		return items, true
+	default:
+		return nil, false
+	}
+}
+
+func joinPath(path string, name string) string {
+	if len(path) == 0 {
+		return name
+	}
+
+	return path + pathSeparator + name
+}
+
+func displayPath(path string) string {
+	if len(path) == 0 {
+		return "the configuration"
+	}
+
+	return path
+}
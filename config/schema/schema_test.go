@@ -0,0 +1,200 @@
+package schema
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRouteConfig struct {
+	Name string
+	Open bool
+}
+
+type testSectionConfig struct {
+	Address   string
+	Interval  uint64
+	Retries   int8
+	Threshold float64
+	Peers     []string
+	Ignored   string `toml:"-"`
+	Renamed   string `toml:"renamed_value"`
+	internal  string
+}
+
+type testConfig struct {
+	Section  testSectionConfig
+	Enabled  bool
+	Machines map[string]testRouteConfig
+	Routes   []testRouteConfig
+}
+
+const testConfigFile = `
+Enabled = true
+
+[Section]
+    Address = "http://127.0.0.1:8080"
+    Interval = 10
+    Threshold = 1
+    Peers = ["a", "b"]
+    renamed_value = "renamed"
+
+[Machines.EthToMultiversX]
+    Name = "first"
+
+[[Routes]]
+    name = "route"
+    Open = true
+`
+
+func createTestSchema(t *testing.T) *Schema {
+	schema, err := Generate(&testConfig{})
+	require.Nil(t, err)
+
+	return schema
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid config should error", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := Generate(nil)
+		assert.Nil(t, schema)
+		assert.Equal(t, ErrInvalidConfig, err)
+
+		schema, err = Generate("config")
+		assert.Nil(t, schema)
+		assert.Equal(t, ErrInvalidConfig, err)
+	})
+	t.Run("should describe the config", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := Generate(testConfig{})
+		require.Nil(t, err)
+		assert.Equal(t, Draft, schema.Schema)
+		assert.Equal(t, "testConfig", schema.Title)
+		assert.Equal(t, typeObject, schema.Type)
+		assert.Equal(t, false, schema.AdditionalProperties)
+
+		section := schema.Properties["Section"]
+		require.NotNil(t, section)
+		assert.Equal(t, []string{"Address", "Interval", "Peers", "Retries", "Threshold", "renamed_value"}, sortedKeys(section.Properties))
+		assert.Equal(t, &Schema{Type: typeInteger, Minimum: "0", Maximum: "18446744073709551615"}, section.Properties["Interval"])
+		assert.Equal(t, &Schema{Type: typeInteger, Minimum: "-128", Maximum: "127"}, section.Properties["Retries"])
+		assert.Equal(t, &Schema{Type: typeNumber}, section.Properties["Threshold"])
+		assert.Equal(t, &Schema{Type: typeArray, Items: &Schema{Type: typeString}}, section.Properties["Peers"])
+
+		machines := schema.Properties["Machines"]
+		require.NotNil(t, machines)
+		assert.Equal(t, typeObject, machines.Type)
+		assert.Equal(t, schema.Properties["Routes"].Items, machines.AdditionalProperties)
+	})
+	t.Run("should marshal as JSON Schema", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := json.Marshal(createTestSchema(t))
+		require.Nil(t, err)
+		assert.True(t, strings.Contains(string(buff), `"$schema":"`+Draft+`"`))
+		assert.True(t, strings.Contains(string(buff), `"additionalProperties":false`))
+		assert.True(t, strings.Contains(string(buff), `"maximum":127`))
+	})
+}
+
+func sortedKeys(properties map[string]*Schema) []string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func TestSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid document should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTestSchema(t).Validate(map[string]interface{}{
+			"enabled": true,
+			"Section": map[string]interface{}{
+				"Interval": int64(5),
+				"Retries":  int64(-3),
+				"Peers":    []interface{}{"a"},
+			},
+			"Routes": []map[string]interface{}{{"Name": "route"}},
+		})
+		assert.Nil(t, err)
+	})
+	t.Run("should report all the violations", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTestSchema(t).Validate(map[string]interface{}{
+			"Enabled": "yes",
+			"Section": map[string]interface{}{
+				"Adress":    "http://127.0.0.1:8080",
+				"Interval":  int64(-1),
+				"Retries":   int64(200),
+				"Threshold": "high",
+				"Peers":     "a",
+			},
+			"Machines": map[string]interface{}{
+				"EthToMultiversX": map[string]interface{}{"Open": int64(1)},
+			},
+			"Routes": []interface{}{"route"},
+		})
+		require.True(t, errors.Is(err, ErrSchemaViolation))
+		expectedViolations := []string{
+			"Enabled should be a boolean",
+			"Machines.EthToMultiversX.Open should be a boolean",
+			"Routes.0 should be a table",
+			"Section.Adress is not a known key",
+			"Section.Interval should be at least 0",
+			"Section.Peers should be an array",
+			"Section.Retries should be at most 127",
+			"Section.Threshold should be a number",
+		}
+		assert.Equal(t, ErrSchemaViolation.Error()+": "+strings.Join(expectedViolations, "; "), err.Error())
+	})
+}
+
+func TestSchema_ValidateFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTestSchema(t).ValidateFile(filepath.Join(t.TempDir(), "missing.toml"))
+		assert.NotNil(t, err)
+	})
+	t.Run("valid file should work", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "config.toml")
+		require.Nil(t, os.WriteFile(filePath, []byte(testConfigFile), os.ModePerm))
+
+		err := createTestSchema(t).ValidateFile(filePath)
+		assert.Nil(t, err)
+	})
+	t.Run("misspelled key should error", func(t *testing.T) {
+		t.Parallel()
+
+		filePath := filepath.Join(t.TempDir(), "config.toml")
+		content := strings.Replace(testConfigFile, "Interval = 10", "Intervall = 10", 1)
+		require.Nil(t, os.WriteFile(filePath, []byte(content), os.ModePerm))
+
+		err := createTestSchema(t).ValidateFile(filePath)
+		assert.True(t, errors.Is(err, ErrSchemaViolation))
+		assert.True(t, strings.Contains(err.Error(), "Section.Intervall is not a known key"))
+	})
+}
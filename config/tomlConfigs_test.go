@@ -406,9 +406,11 @@ func TestScCallsExecutorConfigs(t *testing.T) {
 
 	expectedConfig := ScCallsModuleConfig{
 		ScProxyBech32Address:            "erd1qqqqqqqqqqqqqpgqnef5f5aq32d63kljld8w5vnvz4gk5sy9hrrq2ld08s",
+		ScProxyBech32Addresses:          []string{"erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"},
 		ExtraGasToExecute:               50000000,
 		MaxGasLimitToUse:                249999999,
 		GasLimitForOutOfGasTransactions: 30000000,
+		MaxConcurrentExecutions:         4,
 		NetworkAddress:                  "127.0.0.1:8085",
 		ProxyMaxNoncesDelta:             7,
 		ProxyFinalityCheck:              true,
@@ -437,9 +439,11 @@ func TestScCallsExecutorConfigs(t *testing.T) {
 
 	testString := `
 ScProxyBech32Address = "erd1qqqqqqqqqqqqqpgqnef5f5aq32d63kljld8w5vnvz4gk5sy9hrrq2ld08s"
+ScProxyBech32Addresses = ["erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"]
 ExtraGasToExecute = 50000000
 MaxGasLimitToUse = 249999999 # this is a safe max gas limit to use both intra-shard & cross-shard
 GasLimitForOutOfGasTransactions = 30000000 # this value will be used when a transaction specified a gas limit > 249999999 
+MaxConcurrentExecutions = 4
 NetworkAddress = "127.0.0.1:8085"
 ProxyMaxNoncesDelta = 7
 ProxyFinalityCheck = true
@@ -0,0 +1,52 @@
+package configBundle
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+)
+
+// ApplyContent returns a copy of the provided config with all the bundle parameters applied. The provided config
+// is never altered so an invalid bundle leaves the relayer with its initial configuration
+func ApplyContent(cfg config.Config, content *Content) (config.Config, error) {
+	newConfig := cfg
+	newConfig.StateMachine = make(map[string]config.ConfigStateMachine, len(cfg.StateMachine))
+	for name, stateMachineConfig := range cfg.StateMachine {
+		newConfig.StateMachine[name] = stateMachineConfig
+	}
+
+	for name, stateMachineConfig := range content.StateMachine {
+		_, exists := newConfig.StateMachine[name]
+		if !exists {
+			return cfg, fmt.Errorf("%w: unknown state machine %s", ErrInvalidBundleContent, name)
+		}
+		if stateMachineConfig.StepDurationInMillis == 0 || stateMachineConfig.IntervalForLeaderInSeconds == 0 {
+			return cfg, fmt.Errorf("%w: zero durations for state machine %s", ErrInvalidBundleContent, name)
+		}
+
+		newConfig.StateMachine[name] = stateMachineConfig
+	}
+
+	if content.Eth != nil {
+		setIfProvided(&newConfig.Eth.GasLimitBase, content.Eth.GasLimitBase)
+		setIfProvided(&newConfig.Eth.GasLimitForEach, content.Eth.GasLimitForEach)
+		setIfProvided(&newConfig.Eth.MaxRetriesOnQuorumReached, content.Eth.MaxRetriesOnQuorumReached)
+		setIfProvided(&newConfig.Eth.IntervalToWaitForTransferInSeconds, content.Eth.IntervalToWaitForTransferInSeconds)
+	}
+
+	if content.MultiversX != nil {
+		if content.MultiversX.GasMap != nil {
+			newConfig.MultiversX.GasMap = *content.MultiversX.GasMap
+		}
+		setIfProvided(&newConfig.MultiversX.MaxRetriesOnQuorumReached, content.MultiversX.MaxRetriesOnQuorumReached)
+		setIfProvided(&newConfig.MultiversX.MaxRetriesOnWasTransferProposed, content.MultiversX.MaxRetriesOnWasTransferProposed)
+	}
+
+	return newConfig, nil
+}
+
+func setIfProvided(destination *uint64, value *uint64) {
+	if value != nil {
+		*destination = *value
+	}
+}
@@ -0,0 +1,97 @@
+package configBundle
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestConfig() config.Config {
+	return config.Config{
+		Eth: config.EthereumConfig{
+			GasLimitBase:              350000,
+			GasLimitForEach:           30000,
+			MaxRetriesOnQuorumReached: 3,
+		},
+		MultiversX: config.MultiversXConfig{
+			MaxRetriesOnQuorumReached:       3,
+			MaxRetriesOnWasTransferProposed: 3,
+		},
+		StateMachine: map[string]config.ConfigStateMachine{
+			"EthereumToMultiversX": {
+				StepDurationInMillis:       12000,
+				IntervalForLeaderInSeconds: 120,
+			},
+		},
+	}
+}
+
+func TestApplyContent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown state machine should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		content := &Content{
+			StateMachine: map[string]config.ConfigStateMachine{
+				"unknown": {StepDurationInMillis: 1, IntervalForLeaderInSeconds: 1},
+			},
+		}
+
+		newConfig, err := ApplyContent(cfg, content)
+		assert.True(t, errors.Is(err, ErrInvalidBundleContent))
+		assert.Equal(t, cfg, newConfig)
+	})
+	t.Run("zero durations should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		content := &Content{
+			StateMachine: map[string]config.ConfigStateMachine{
+				"EthereumToMultiversX": {StepDurationInMillis: 1000},
+			},
+		}
+
+		_, err := ApplyContent(cfg, content)
+		assert.True(t, errors.Is(err, ErrInvalidBundleContent))
+		assert.Equal(t, uint64(12000), cfg.StateMachine["EthereumToMultiversX"].StepDurationInMillis)
+	})
+	t.Run("should apply only the provided fields", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		gasLimitBase := uint64(400000)
+		maxRetries := uint64(10)
+		content := &Content{
+			StateMachine: map[string]config.ConfigStateMachine{
+				"EthereumToMultiversX": {StepDurationInMillis: 6000, IntervalForLeaderInSeconds: 60},
+			},
+			Eth: &EthereumParameters{
+				GasLimitBase: &gasLimitBase,
+			},
+			MultiversX: &MultiversXParameters{
+				GasMap: &config.MultiversXGasMapConfig{
+					Sign: 1000,
+				},
+				MaxRetriesOnWasTransferProposed: &maxRetries,
+			},
+		}
+
+		newConfig, err := ApplyContent(cfg, content)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(400000), newConfig.Eth.GasLimitBase)
+		assert.Equal(t, uint64(30000), newConfig.Eth.GasLimitForEach)
+		assert.Equal(t, uint64(1000), newConfig.MultiversX.GasMap.Sign)
+		assert.Equal(t, uint64(3), newConfig.MultiversX.MaxRetriesOnQuorumReached)
+		assert.Equal(t, uint64(10), newConfig.MultiversX.MaxRetriesOnWasTransferProposed)
+		assert.Equal(t, uint64(6000), newConfig.StateMachine["EthereumToMultiversX"].StepDurationInMillis)
+
+		// the initial config should not be altered
+		assert.Equal(t, createTestConfig(), cfg)
+	})
+}
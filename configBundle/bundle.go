@@ -0,0 +1,121 @@
+package configBundle
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+)
+
+const (
+	signatureLength = 65
+	recoveryIDIndex = 64
+	recoveryIDBase  = 27
+)
+
+// SignedBundle is the document served to the relayers. The signature is an EIP-191 (personal_sign) signature
+// over the compacted JSON encoding of the content field, so the document can be freely indented
+type SignedBundle struct {
+	Content   json.RawMessage `json:"content"`
+	Signature string          `json:"signature"`
+}
+
+// Content holds the parameters distributed through a configuration bundle. Only the provided fields are applied
+type Content struct {
+	Version      uint64                               `json:"version"`
+	StateMachine map[string]config.ConfigStateMachine `json:"stateMachine,omitempty"`
+	Eth          *EthereumParameters                  `json:"eth,omitempty"`
+	MultiversX   *MultiversXParameters                `json:"multiversx,omitempty"`
+}
+
+// EthereumParameters holds the Ethereum parameters that can be changed through a configuration bundle
+type EthereumParameters struct {
+	GasLimitBase                       *uint64 `json:"gasLimitBase,omitempty"`
+	GasLimitForEach                    *uint64 `json:"gasLimitForEach,omitempty"`
+	MaxRetriesOnQuorumReached          *uint64 `json:"maxRetriesOnQuorumReached,omitempty"`
+	IntervalToWaitForTransferInSeconds *uint64 `json:"intervalToWaitForTransferInSeconds,omitempty"`
+}
+
+// MultiversXParameters holds the MultiversX parameters that can be changed through a configuration bundle
+type MultiversXParameters struct {
+	GasMap                          *config.MultiversXGasMapConfig `json:"gasMap,omitempty"`
+	MaxRetriesOnQuorumReached       *uint64                        `json:"maxRetriesOnQuorumReached,omitempty"`
+	MaxRetriesOnWasTransferProposed *uint64                        `json:"maxRetriesOnWasTransferProposed,omitempty"`
+}
+
+// SignBundle signs the provided content with the governance private key
+func SignBundle(content []byte, privateKey *ecdsa.PrivateKey) (*SignedBundle, error) {
+	compacted, err := compactContent(content)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := ethCrypto.Sign(accounts.TextHash(compacted), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	signature[recoveryIDIndex] += recoveryIDBase
+
+	return &SignedBundle{
+		Content:   compacted,
+		Signature: hex.EncodeToString(signature),
+	}, nil
+}
+
+// VerifyBundle checks that the bundle was signed by the governance address and returns the decoded content
+func VerifyBundle(bundle *SignedBundle, governanceAddress common.Address) (*Content, error) {
+	signature, err := hex.DecodeString(trimHexPrefix(bundle.Signature))
+	if err != nil || len(signature) != signatureLength {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+	if signature[recoveryIDIndex] >= recoveryIDBase {
+		signature[recoveryIDIndex] -= recoveryIDBase
+	}
+
+	compacted, err := compactContent(bundle.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := ethCrypto.SigToPub(accounts.TextHash(compacted), signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidSignature, err.Error())
+	}
+
+	signer := ethCrypto.PubkeyToAddress(*publicKey)
+	if signer != governanceAddress {
+		return nil, fmt.Errorf("%w: signed by %s, expected %s", ErrInvalidSignature, signer.Hex(), governanceAddress.Hex())
+	}
+
+	content := &Content{}
+	err = json.Unmarshal(compacted, content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBundleContent, err.Error())
+	}
+
+	return content, nil
+}
+
+func compactContent(content []byte) ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	err := json.Compact(buff, content)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBundleContent, err.Error())
+	}
+
+	return buff.Bytes(), nil
+}
+
+func trimHexPrefix(value string) string {
+	if len(value) >= 2 && value[0] == '0' && (value[1] == 'x' || value[1] == 'X') {
+		return value[2:]
+	}
+
+	return value
+}
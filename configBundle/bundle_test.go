@@ -0,0 +1,106 @@
+package configBundle
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContent = `{
+	"version": 2,
+	"eth": {
+		"gasLimitBase": 400000
+	}
+}`
+
+func TestSignBundle_VerifyBundle(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := ethCrypto.GenerateKey()
+	require.Nil(t, err)
+	governanceAddress := ethCrypto.PubkeyToAddress(privateKey.PublicKey)
+
+	t.Run("invalid JSON content should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle, errSign := SignBundle([]byte("not a JSON"), privateKey)
+		assert.Nil(t, bundle)
+		assert.True(t, errors.Is(errSign, ErrInvalidBundleContent))
+	})
+	t.Run("signed by the governance should work", func(t *testing.T) {
+		t.Parallel()
+
+		bundle, errSign := SignBundle([]byte(testContent), privateKey)
+		require.Nil(t, errSign)
+
+		content, errVerify := VerifyBundle(bundle, governanceAddress)
+		require.Nil(t, errVerify)
+		assert.Equal(t, uint64(2), content.Version)
+		assert.Equal(t, uint64(400000), *content.Eth.GasLimitBase)
+		assert.Nil(t, content.Eth.GasLimitForEach)
+		assert.Nil(t, content.MultiversX)
+	})
+	t.Run("re-indented bundle should still verify", func(t *testing.T) {
+		t.Parallel()
+
+		bundle, errSign := SignBundle([]byte(testContent), privateKey)
+		require.Nil(t, errSign)
+
+		buff, errMarshal := json.MarshalIndent(bundle, "", "    ")
+		require.Nil(t, errMarshal)
+		recovered := &SignedBundle{}
+		require.Nil(t, json.Unmarshal(buff, recovered))
+
+		_, errVerify := VerifyBundle(recovered, governanceAddress)
+		assert.Nil(t, errVerify)
+	})
+	t.Run("signed by another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherKey, errGenerate := ethCrypto.GenerateKey()
+		require.Nil(t, errGenerate)
+
+		bundle, errSign := SignBundle([]byte(testContent), otherKey)
+		require.Nil(t, errSign)
+
+		content, errVerify := VerifyBundle(bundle, governanceAddress)
+		assert.Nil(t, content)
+		assert.True(t, errors.Is(errVerify, ErrInvalidSignature))
+	})
+	t.Run("tampered content should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle, errSign := SignBundle([]byte(testContent), privateKey)
+		require.Nil(t, errSign)
+		bundle.Content = []byte(`{"version":2,"eth":{"gasLimitBase":1}}`)
+
+		content, errVerify := VerifyBundle(bundle, governanceAddress)
+		assert.Nil(t, content)
+		assert.True(t, errors.Is(errVerify, ErrInvalidSignature))
+	})
+	t.Run("malformed signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		bundle, errSign := SignBundle([]byte(testContent), privateKey)
+		require.Nil(t, errSign)
+		bundle.Signature = "0xabcd"
+
+		content, errVerify := VerifyBundle(bundle, governanceAddress)
+		assert.Nil(t, content)
+		assert.True(t, errors.Is(errVerify, ErrInvalidSignature))
+	})
+	t.Run("0x prefixed signature should work", func(t *testing.T) {
+		t.Parallel()
+
+		bundle, errSign := SignBundle([]byte(testContent), privateKey)
+		require.Nil(t, errSign)
+		bundle.Signature = "0x" + bundle.Signature
+
+		_, errVerify := VerifyBundle(bundle, governanceAddress)
+		assert.Nil(t, errVerify)
+	})
+}
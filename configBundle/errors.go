@@ -0,0 +1,21 @@
+package configBundle
+
+import "errors"
+
+// ErrNilHTTPClient signals that a nil HTTP client was provided
+var ErrNilHTTPClient = errors.New("nil HTTP client")
+
+// ErrEmptyURL signals that an empty URL was provided
+var ErrEmptyURL = errors.New("empty URL")
+
+// ErrInvalidGovernanceAddress signals that an invalid governance address was provided
+var ErrInvalidGovernanceAddress = errors.New("invalid governance address")
+
+// ErrInvalidSignature signals that the bundle signature is malformed or was not produced by the governance key
+var ErrInvalidSignature = errors.New("invalid bundle signature")
+
+// ErrUnexpectedHTTPStatus signals that the bundle URL responded with an unexpected HTTP status
+var ErrUnexpectedHTTPStatus = errors.New("unexpected HTTP status")
+
+// ErrInvalidBundleContent signals that the bundle content can not be applied
+var ErrInvalidBundleContent = errors.New("invalid bundle content")
@@ -0,0 +1,103 @@
+package configBundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("configBundle")
+
+// ArgsBundleFetcher is the DTO used to create a new bundle fetcher
+type ArgsBundleFetcher struct {
+	HTTPClient        HTTPClient
+	URL               string
+	GovernanceAddress string
+}
+
+type bundleFetcher struct {
+	httpClient        HTTPClient
+	url               string
+	governanceAddress common.Address
+}
+
+// NewBundleFetcher creates a component able to fetch, verify and apply signed configuration bundles
+func NewBundleFetcher(args ArgsBundleFetcher) (*bundleFetcher, error) {
+	if check.IfNilReflect(args.HTTPClient) {
+		return nil, ErrNilHTTPClient
+	}
+	if len(args.URL) == 0 {
+		return nil, ErrEmptyURL
+	}
+	if !common.IsHexAddress(args.GovernanceAddress) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidGovernanceAddress, args.GovernanceAddress)
+	}
+
+	return &bundleFetcher{
+		httpClient:        args.HTTPClient,
+		url:               args.URL,
+		governanceAddress: common.HexToAddress(args.GovernanceAddress),
+	}, nil
+}
+
+// FetchAndApply fetches the signed bundle, verifies its governance signature and returns a copy of the provided
+// config with the bundle applied. On any error, the provided config should be used as it is
+func (fetcher *bundleFetcher) FetchAndApply(ctx context.Context, cfg config.Config) (config.Config, error) {
+	bundle, err := fetcher.fetch(ctx)
+	if err != nil {
+		return cfg, err
+	}
+
+	content, err := VerifyBundle(bundle, fetcher.governanceAddress)
+	if err != nil {
+		return cfg, err
+	}
+
+	newConfig, err := ApplyContent(cfg, content)
+	if err != nil {
+		return cfg, err
+	}
+
+	log.Info("applied the configuration bundle", "version", content.Version, "url", fetcher.url)
+
+	return newConfig, nil
+}
+
+func (fetcher *bundleFetcher) fetch(ctx context.Context) (*SignedBundle, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fetcher.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := fetcher.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w %d while fetching the configuration bundle", ErrUnexpectedHTTPStatus, response.StatusCode)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle := &SignedBundle{}
+	err = json.Unmarshal(body, bundle)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidBundleContent, err.Error())
+	}
+
+	return bundle, nil
+}
@@ -0,0 +1,141 @@
+package configBundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGovernanceAddress = "0x3FE464Ac5aa562F7948322F92020F2b668D543d8"
+
+func createMockArgsBundleFetcher() ArgsBundleFetcher {
+	return ArgsBundleFetcher{
+		HTTPClient:        http.DefaultClient,
+		URL:               "http://localhost/bundle.json",
+		GovernanceAddress: testGovernanceAddress,
+	}
+}
+
+func TestNewBundleFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil HTTP client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBundleFetcher()
+		args.HTTPClient = nil
+
+		fetcher, err := NewBundleFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.Equal(t, ErrNilHTTPClient, err)
+	})
+	t.Run("empty URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBundleFetcher()
+		args.URL = ""
+
+		fetcher, err := NewBundleFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.Equal(t, ErrEmptyURL, err)
+	})
+	t.Run("invalid governance address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBundleFetcher()
+		args.GovernanceAddress = "not an address"
+
+		fetcher, err := NewBundleFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.True(t, errors.Is(err, ErrInvalidGovernanceAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := NewBundleFetcher(createMockArgsBundleFetcher())
+		assert.Nil(t, err)
+		assert.NotNil(t, fetcher)
+	})
+}
+
+func TestBundleFetcher_FetchAndApply(t *testing.T) {
+	t.Parallel()
+
+	privateKey, err := ethCrypto.GenerateKey()
+	require.Nil(t, err)
+	bundle, err := SignBundle([]byte(testContent), privateKey)
+	require.Nil(t, err)
+	bundleBuff, err := json.Marshal(bundle)
+	require.Nil(t, err)
+
+	createServer := func(statusCode int, body []byte) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			writer.WriteHeader(statusCode)
+			_, _ = writer.Write(body)
+		}))
+	}
+	createFetcher := func(url string) *bundleFetcher {
+		args := createMockArgsBundleFetcher()
+		args.URL = url
+		args.GovernanceAddress = ethCrypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+		fetcher, errCreate := NewBundleFetcher(args)
+		require.Nil(t, errCreate)
+
+		return fetcher
+	}
+
+	t.Run("unexpected HTTP status should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer(http.StatusNotFound, nil)
+		defer server.Close()
+
+		cfg := createTestConfig()
+		newConfig, errFetch := createFetcher(server.URL).FetchAndApply(context.Background(), cfg)
+		assert.True(t, errors.Is(errFetch, ErrUnexpectedHTTPStatus))
+		assert.Equal(t, cfg, newConfig)
+	})
+	t.Run("malformed bundle should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer(http.StatusOK, []byte("not a JSON"))
+		defer server.Close()
+
+		_, errFetch := createFetcher(server.URL).FetchAndApply(context.Background(), createTestConfig())
+		assert.True(t, errors.Is(errFetch, ErrInvalidBundleContent))
+	})
+	t.Run("bundle not signed by the governance should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer(http.StatusOK, bundleBuff)
+		defer server.Close()
+
+		args := createMockArgsBundleFetcher()
+		args.URL = server.URL
+		fetcher, errCreate := NewBundleFetcher(args)
+		require.Nil(t, errCreate)
+
+		cfg := createTestConfig()
+		newConfig, errFetch := fetcher.FetchAndApply(context.Background(), cfg)
+		assert.True(t, errors.Is(errFetch, ErrInvalidSignature))
+		assert.Equal(t, cfg, newConfig)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer(http.StatusOK, bundleBuff)
+		defer server.Close()
+
+		newConfig, errFetch := createFetcher(server.URL).FetchAndApply(context.Background(), createTestConfig())
+		require.Nil(t, errFetch)
+		assert.Equal(t, uint64(400000), newConfig.Eth.GasLimitBase)
+	})
+}
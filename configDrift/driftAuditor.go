@@ -0,0 +1,82 @@
+package configDrift
+
+import (
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/precedence"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsDriftAuditor is the DTO used to create a new drift auditor
+type ArgsDriftAuditor struct {
+	ConfigFile    string
+	RunningConfig RunningConfigHolder
+	Loggers       LoggersHolder
+	// LoadConfigFile loads the config file as it would be loaded on a restart, e.g. with the environment variables and
+	// the flag overrides applied, so these are not reported as drift
+	LoadConfigFile func() (config.Config, error)
+}
+
+type driftAuditor struct {
+	configFile     string
+	runningConfig  RunningConfigHolder
+	loggers        LoggersHolder
+	loadConfigFile func() (config.Config, error)
+}
+
+// NewDriftAuditor creates a component able to compare the running config, altered by the config reloads and the
+// admin API calls, with the config file
+func NewDriftAuditor(args ArgsDriftAuditor) (*driftAuditor, error) {
+	if check.IfNil(args.RunningConfig) {
+		return nil, ErrNilRunningConfigHolder
+	}
+	if check.IfNil(args.Loggers) {
+		return nil, ErrNilLoggersHolder
+	}
+	if args.LoadConfigFile == nil {
+		return nil, ErrNilConfigLoader
+	}
+
+	return &driftAuditor{
+		configFile:     args.ConfigFile,
+		runningConfig:  args.RunningConfig,
+		loggers:        args.Loggers,
+		loadConfigFile: args.LoadConfigFile,
+	}, nil
+}
+
+// GetConfigDrift re-reads the config file and returns the values the relayer is running with that differ from it,
+// together with the loggers having the level changed through the admin API. A drift is either a change not persisted
+// in the config file or a change of the config file not applied yet, e.g. a setting needing a restart
+func (auditor *driftAuditor) GetConfigDrift() (*core.ConfigDriftReport, error) {
+	fileConfig, err := auditor.loadConfigFile()
+	if err != nil {
+		return nil, err
+	}
+	runningConfig := auditor.runningConfig.RunningConfig()
+
+	drifts, err := precedence.Diff(&runningConfig, &fileConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &core.ConfigDriftReport{
+		ConfigFile:     auditor.configFile,
+		Drifts:         make([]*core.ConfigDrift, 0, len(drifts)),
+		ChangedLoggers: auditor.loggers.ChangedLoggers(),
+	}
+	for _, drift := range drifts {
+		report.Drifts = append(report.Drifts, &core.ConfigDrift{
+			Path:         drift.Path,
+			RunningValue: drift.RunningValue,
+			FileValue:    drift.FileValue,
+		})
+	}
+
+	return report, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (auditor *driftAuditor) IsInterfaceNil() bool {
+	return auditor == nil
+}
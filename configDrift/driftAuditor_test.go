@@ -0,0 +1,164 @@
+package configDrift
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestConfig() config.Config {
+	return config.Config{
+		Eth: config.EthereumConfig{
+			GasLimitBase: 350000,
+			GasStation: config.GasStationConfig{
+				URL:                    "https://api.etherscan.io/api?module=gastracker&apikey=secret",
+				MaximumAllowedGasPrice: 300,
+			},
+		},
+		StateMachine: map[string]config.ConfigStateMachine{
+			"EthereumToMultiversX": {
+				StepDurationInMillis:       12000,
+				IntervalForLeaderInSeconds: 120,
+			},
+		},
+		Logs: config.LogsConfig{
+			LogLevel: "*:INFO",
+		},
+	}
+}
+
+func createMockArgsDriftAuditor() ArgsDriftAuditor {
+	return ArgsDriftAuditor{
+		ConfigFile: "config.toml",
+		RunningConfig: &testsCommon.RunningConfigHolderStub{
+			RunningConfigCalled: createTestConfig,
+		},
+		Loggers: &testsCommon.LoggersHolderStub{},
+		LoadConfigFile: func() (config.Config, error) {
+			return createTestConfig(), nil
+		},
+	}
+}
+
+func TestNewDriftAuditor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil running config holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriftAuditor()
+		args.RunningConfig = nil
+		auditor, err := NewDriftAuditor(args)
+		assert.Equal(t, ErrNilRunningConfigHolder, err)
+		assert.True(t, check.IfNil(auditor))
+	})
+	t.Run("nil loggers holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriftAuditor()
+		args.Loggers = nil
+		auditor, err := NewDriftAuditor(args)
+		assert.Equal(t, ErrNilLoggersHolder, err)
+		assert.True(t, check.IfNil(auditor))
+	})
+	t.Run("nil config loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDriftAuditor()
+		args.LoadConfigFile = nil
+		auditor, err := NewDriftAuditor(args)
+		assert.Equal(t, ErrNilConfigLoader, err)
+		assert.True(t, check.IfNil(auditor))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		auditor, err := NewDriftAuditor(createMockArgsDriftAuditor())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(auditor))
+	})
+}
+
+func TestDriftAuditor_GetConfigDrift(t *testing.T) {
+	t.Parallel()
+
+	t.Run("config loader errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsDriftAuditor()
+		args.LoadConfigFile = func() (config.Config, error) {
+			return config.Config{}, expectedErr
+		}
+		auditor, _ := NewDriftAuditor(args)
+
+		report, err := auditor.GetConfigDrift()
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, report)
+	})
+	t.Run("no drift should return an empty report", func(t *testing.T) {
+		t.Parallel()
+
+		auditor, _ := NewDriftAuditor(createMockArgsDriftAuditor())
+
+		report, err := auditor.GetConfigDrift()
+		require.Nil(t, err)
+		assert.Equal(t, "config.toml", report.ConfigFile)
+		assert.Empty(t, report.Drifts)
+		assert.Empty(t, report.ChangedLoggers)
+	})
+	t.Run("should report the drift and the changed loggers", func(t *testing.T) {
+		t.Parallel()
+
+		changedLoggers := []core.LoggerInfo{{Identifier: "EthereumMultiversX-EthereumClient", Level: "TRACE"}}
+		args := createMockArgsDriftAuditor()
+		args.RunningConfig = &testsCommon.RunningConfigHolderStub{
+			RunningConfigCalled: func() config.Config {
+				cfg := createTestConfig()
+				cfg.Eth.GasStation.URL = "https://api.etherscan.io/api?module=gastracker&apikey=other"
+				cfg.Eth.GasStation.MaximumAllowedGasPrice = 500
+				cfg.StateMachine["EthereumToMultiversX"] = config.ConfigStateMachine{
+					StepDurationInMillis:       6000,
+					IntervalForLeaderInSeconds: 120,
+				}
+
+				return cfg
+			},
+		}
+		args.Loggers = &testsCommon.LoggersHolderStub{
+			ChangedLoggersCalled: func() []core.LoggerInfo {
+				return changedLoggers
+			},
+		}
+		auditor, _ := NewDriftAuditor(args)
+
+		report, err := auditor.GetConfigDrift()
+		require.Nil(t, err)
+
+		expectedDrifts := []*core.ConfigDrift{
+			{
+				Path:         "Eth.GasStation.MaximumAllowedGasPrice",
+				RunningValue: "500",
+				FileValue:    "300",
+			},
+			{
+				Path:         "Eth.GasStation.URL",
+				RunningValue: "\"https://api.etherscan.io/api?apikey=%2A%2A%2A%2A&module=%2A%2A%2A%2A\"",
+				FileValue:    "\"https://api.etherscan.io/api?apikey=%2A%2A%2A%2A&module=%2A%2A%2A%2A\"",
+			},
+			{
+				Path:         "StateMachine.EthereumToMultiversX.StepDurationInMillis",
+				RunningValue: "6000",
+				FileValue:    "12000",
+			},
+		}
+		assert.Equal(t, expectedDrifts, report.Drifts)
+		assert.Equal(t, changedLoggers, report.ChangedLoggers)
+	})
+}
@@ -0,0 +1,12 @@
+package configDrift
+
+import "errors"
+
+// ErrNilRunningConfigHolder signals that a nil running config holder was provided
+var ErrNilRunningConfigHolder = errors.New("nil running config holder")
+
+// ErrNilLoggersHolder signals that a nil loggers holder was provided
+var ErrNilLoggersHolder = errors.New("nil loggers holder")
+
+// ErrNilConfigLoader signals that a nil config loader was provided
+var ErrNilConfigLoader = errors.New("nil config loader")
@@ -0,0 +1,18 @@
+package configDrift
+
+import (
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// RunningConfigHolder defines the component able to return the config the relayer is running with
+type RunningConfigHolder interface {
+	RunningConfig() config.Config
+	IsInterfaceNil() bool
+}
+
+// LoggersHolder defines the component able to return the loggers having the level changed at runtime
+type LoggersHolder interface {
+	ChangedLoggers() []core.LoggerInfo
+	IsInterfaceNil() bool
+}
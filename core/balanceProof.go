@@ -0,0 +1,36 @@
+package core
+
+// BalanceProof is the view of the bridge reserves: for each token, the balances held by the bridge contracts of both
+// chains, the amounts of the batches still in flight and the delta of the bridge invariant. The Ethereum block number
+// and the MultiversX nonce are read before the balances, so the balances were queried at these heights or later
+type BalanceProof struct {
+	Timestamp           int64                `json:"timestamp"`
+	EthereumBlockNumber uint64               `json:"ethereumBlockNumber"`
+	MultiversXNonce     uint64               `json:"multiversXNonce"`
+	Tokens              []*TokenBalanceProof `json:"tokens"`
+}
+
+// TokenBalanceProof holds the balances of a token pair. The circulating amounts are the ones the bridge is accountable
+// for on each chain, excluding the in-flight amounts, and the invariant delta (Ethereum minus MultiversX) is 0 when the
+// reserves fully back the wrapped tokens. All the amounts are expressed in the tokens base units. A token that could
+// not be queried only has the Error field set
+type TokenBalanceProof struct {
+	ERC20Address          string `json:"erc20Address"`
+	ESDTTokenID           string `json:"esdtTokenId,omitempty"`
+	EthereumNative        bool   `json:"ethereumNative"`
+	EthereumMintBurn      bool   `json:"ethereumMintBurn"`
+	EthereumLocked        string `json:"ethereumLocked,omitempty"`
+	EthereumMinted        string `json:"ethereumMinted,omitempty"`
+	EthereumBurned        string `json:"ethereumBurned,omitempty"`
+	MultiversXNative      bool   `json:"multiversXNative"`
+	MultiversXMintBurn    bool   `json:"multiversXMintBurn"`
+	MultiversXLocked      string `json:"multiversXLocked,omitempty"`
+	MultiversXMinted      string `json:"multiversXMinted,omitempty"`
+	MultiversXBurned      string `json:"multiversXBurned,omitempty"`
+	InFlightToMultiversX  string `json:"inFlightToMultiversX,omitempty"`
+	InFlightToEthereum    string `json:"inFlightToEthereum,omitempty"`
+	EthereumCirculating   string `json:"ethereumCirculating,omitempty"`
+	MultiversXCirculating string `json:"multiversXCirculating,omitempty"`
+	InvariantDelta        string `json:"invariantDelta,omitempty"`
+	Error                 string `json:"error,omitempty"`
+}
@@ -77,6 +77,9 @@ type DepositTransfer struct {
 	Amount                *big.Int `json:"amount"`
 	Data                  []byte   `json:"-"`
 	DisplayableData       string   `json:"data"`
+	TxHash                string   `json:"txHash,omitempty"`
+	BlockNumber           uint64   `json:"blockNumber,omitempty"`
+	Timestamp             uint64   `json:"timestamp,omitempty"`
 }
 
 // String will convert the deposit transfer to a string
@@ -99,6 +102,9 @@ func (dt *DepositTransfer) Clone() *DepositTransfer {
 		Amount:                big.NewInt(0),
 		Data:                  make([]byte, len(dt.Data)),
 		DisplayableData:       dt.DisplayableData,
+		TxHash:                dt.TxHash,
+		BlockNumber:           dt.BlockNumber,
+		Timestamp:             dt.Timestamp,
 	}
 
 	copy(cloned.ToBytes, dt.ToBytes)
@@ -0,0 +1,28 @@
+package core
+
+// DepositPending is the status of a deposit not yet executed on the destination chain
+const DepositPending = "pending"
+
+// BatchLiveView is the view of a batch while it is processed by a state machine of the relayer: the deposits with their
+// statuses, the action ID and the signatures collected so far. The step is the one the state machine will execute next
+type BatchLiveView struct {
+	Direction     string             `json:"direction"`
+	BatchID       uint64             `json:"batchId"`
+	Step          string             `json:"step"`
+	ActionID      uint64             `json:"actionId"`
+	MessageHash   string             `json:"messageHash,omitempty"`
+	NumSignatures int                `json:"numSignatures"`
+	Deposits      []*DepositLiveView `json:"deposits"`
+	Timestamp     int64              `json:"timestamp"`
+}
+
+// DepositLiveView is the view of a deposit of a batch processed by the relayer
+type DepositLiveView struct {
+	Nonce  uint64 `json:"nonce"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Token  string `json:"token"`
+	Amount string `json:"amount"`
+	TxHash string `json:"txHash,omitempty"`
+	Status string `json:"status"`
+}
@@ -1,6 +1,7 @@
 package batchProcessor
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,8 +29,13 @@ type ArgListsBatch struct {
 }
 
 // ExtractListMvxToEth will extract the batch data into a format that is easy to use
-// The transfer is from MultiversX to Ethereum
-func ExtractListMvxToEth(batch *bridgeCore.TransferBatch) *ArgListsBatch {
+// The transfer is from MultiversX to Ethereum. Errors if the batch is malformed, see ValidateBatch
+func ExtractListMvxToEth(batch *bridgeCore.TransferBatch) (*ArgListsBatch, error) {
+	err := ValidateBatch(batch)
+	if err != nil {
+		return nil, err
+	}
+
 	arg := &ArgListsBatch{
 		Direction: FromMultiversX,
 	}
@@ -50,12 +56,17 @@ func ExtractListMvxToEth(batch *bridgeCore.TransferBatch) *ArgListsBatch {
 		arg.MvxTokenBytes = append(arg.MvxTokenBytes, dt.SourceTokenBytes)
 	}
 
-	return arg
+	return arg, nil
 }
 
 // ExtractListEthToMvx will extract the batch data into a format that is easy to use
-// The transfer is from Ehtereum to MultiversX
-func ExtractListEthToMvx(batch *bridgeCore.TransferBatch) *ArgListsBatch {
+// The transfer is from Ehtereum to MultiversX. Errors if the batch is malformed, see ValidateBatch
+func ExtractListEthToMvx(batch *bridgeCore.TransferBatch) (*ArgListsBatch, error) {
+	err := ValidateBatch(batch)
+	if err != nil {
+		return nil, err
+	}
+
 	arg := &ArgListsBatch{
 		Direction: ToMultiversX,
 	}
@@ -76,5 +87,67 @@ func ExtractListEthToMvx(batch *bridgeCore.TransferBatch) *ArgListsBatch {
 		arg.MvxTokenBytes = append(arg.MvxTokenBytes, dt.DestinationTokenBytes)
 	}
 
-	return arg
+	return arg, nil
+}
+
+// ValidateBatch checks that the deposits of the batch are sorted by strictly increasing nonces, so all the relayers
+// sign the same lists, and that each deposit has a positive amount and a non-zero recipient. The returned error for an
+// invalid deposit is a *DepositError
+func ValidateBatch(batch *bridgeCore.TransferBatch) error {
+	if batch == nil {
+		return ErrNilBatch
+	}
+
+	for index, deposit := range batch.Deposits {
+		err := validateDeposit(batch, index)
+		if err != nil {
+			nonce := uint64(0)
+			if deposit != nil {
+				nonce = deposit.Nonce
+			}
+
+			return &DepositError{
+				BatchID: batch.ID,
+				Index:   index,
+				Nonce:   nonce,
+				Err:     err,
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateDeposit(batch *bridgeCore.TransferBatch, index int) error {
+	deposit := batch.Deposits[index]
+	if deposit == nil {
+		return ErrNilDeposit
+	}
+	if index > 0 && batch.Deposits[index-1] != nil {
+		previousNonce := batch.Deposits[index-1].Nonce
+		if deposit.Nonce == previousNonce {
+			return ErrDuplicateNonce
+		}
+		if deposit.Nonce < previousNonce {
+			return fmt.Errorf("%w, previous nonce: %d", ErrUnorderedNonces, previousNonce)
+		}
+	}
+	if deposit.Amount == nil || deposit.Amount.Sign() <= 0 {
+		return ErrZeroAmount
+	}
+	if isZeroAddress(deposit.ToBytes) {
+		return ErrZeroAddress
+	}
+
+	return nil
+}
+
+func isZeroAddress(address []byte) bool {
+	for _, b := range address {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
 }
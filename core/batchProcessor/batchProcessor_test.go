@@ -1,12 +1,14 @@
 package batchProcessor
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExtractListEthToMvx(t *testing.T) {
@@ -35,7 +37,8 @@ func TestExtractListEthToMvx(t *testing.T) {
 		Statuses: nil,
 	}
 
-	args := ExtractListEthToMvx(testBatch)
+	args, err := ExtractListEthToMvx(testBatch)
+	require.Nil(t, err)
 
 	expectedEthTokens := []common.Address{
 		common.BytesToAddress([]byte("source token 1")),
@@ -94,7 +97,8 @@ func TestExtractListMvxToEth(t *testing.T) {
 		Statuses: nil,
 	}
 
-	args := ExtractListMvxToEth(testBatch)
+	args, err := ExtractListMvxToEth(testBatch)
+	require.Nil(t, err)
 
 	expectedEthTokens := []common.Address{
 		common.BytesToAddress([]byte("destination token 1")),
@@ -126,3 +130,120 @@ func TestExtractListMvxToEth(t *testing.T) {
 	}
 	assert.Equal(t, expectedNonces, args.Nonces)
 }
+
+func createDeposit(nonce uint64, amount int64) *bridgeCore.DepositTransfer {
+	return &bridgeCore.DepositTransfer{
+		Nonce:                 nonce,
+		ToBytes:               []byte("to"),
+		SourceTokenBytes:      []byte("source token"),
+		DestinationTokenBytes: []byte("destination token"),
+		Amount:                big.NewInt(amount),
+	}
+}
+
+func TestValidateBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ErrNilBatch, ValidateBatch(nil))
+	})
+	t.Run("nil deposit should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID:       37,
+			Deposits: []*bridgeCore.DepositTransfer{createDeposit(1, 10), nil},
+		}
+
+		err := ValidateBatch(batch)
+		assert.True(t, errors.Is(err, ErrNilDeposit))
+	})
+	t.Run("duplicate nonces should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID:       37,
+			Deposits: []*bridgeCore.DepositTransfer{createDeposit(1, 10), createDeposit(2, 20), createDeposit(2, 30)},
+		}
+
+		err := ValidateBatch(batch)
+		assert.True(t, errors.Is(err, ErrDuplicateNonce))
+
+		depositErr := &DepositError{}
+		require.True(t, errors.As(err, &depositErr))
+		assert.Equal(t, &DepositError{BatchID: 37, Index: 2, Nonce: 2, Err: ErrDuplicateNonce}, depositErr)
+		assert.Equal(t, "duplicate deposit nonce for the deposit with nonce 2 at index 2 of the batch 37", err.Error())
+	})
+	t.Run("unordered nonces should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			ID:       37,
+			Deposits: []*bridgeCore.DepositTransfer{createDeposit(2, 10), createDeposit(1, 20)},
+		}
+
+		err := ValidateBatch(batch)
+		assert.True(t, errors.Is(err, ErrUnorderedNonces))
+
+		depositErr := &DepositError{}
+		require.True(t, errors.As(err, &depositErr))
+		assert.Equal(t, 1, depositErr.Index)
+		assert.Equal(t, uint64(1), depositErr.Nonce)
+	})
+	t.Run("zero, negative or missing amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, amount := range []*big.Int{nil, big.NewInt(0), big.NewInt(-1)} {
+			deposit := createDeposit(1, 0)
+			deposit.Amount = amount
+			batch := &bridgeCore.TransferBatch{
+				Deposits: []*bridgeCore.DepositTransfer{deposit},
+			}
+
+			err := ValidateBatch(batch)
+			assert.True(t, errors.Is(err, ErrZeroAmount))
+		}
+	})
+	t.Run("zero or missing recipient should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, recipient := range [][]byte{nil, make([]byte, 20), make([]byte, 32)} {
+			deposit := createDeposit(1, 10)
+			deposit.ToBytes = recipient
+			batch := &bridgeCore.TransferBatch{
+				Deposits: []*bridgeCore.DepositTransfer{deposit},
+			}
+
+			err := ValidateBatch(batch)
+			assert.True(t, errors.Is(err, ErrZeroAddress))
+		}
+	})
+	t.Run("valid batch should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &bridgeCore.TransferBatch{
+			Deposits: []*bridgeCore.DepositTransfer{createDeposit(1, 10), createDeposit(3, 20), createDeposit(7, 30)},
+		}
+
+		assert.Nil(t, ValidateBatch(batch))
+		assert.Nil(t, ValidateBatch(&bridgeCore.TransferBatch{}))
+	})
+}
+
+func TestExtractList_MalformedBatchShouldError(t *testing.T) {
+	t.Parallel()
+
+	batch := &bridgeCore.TransferBatch{
+		Deposits: []*bridgeCore.DepositTransfer{createDeposit(2, 10), createDeposit(2, 20)},
+	}
+
+	args, err := ExtractListMvxToEth(batch)
+	assert.Nil(t, args)
+	assert.True(t, errors.Is(err, ErrDuplicateNonce))
+
+	args, err = ExtractListEthToMvx(batch)
+	assert.Nil(t, args)
+	assert.True(t, errors.Is(err, ErrDuplicateNonce))
+}
@@ -0,0 +1,43 @@
+package batchProcessor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNilBatch signals that a nil batch has been provided
+var ErrNilBatch = errors.New("nil batch")
+
+// ErrNilDeposit signals that a batch contains a nil deposit
+var ErrNilDeposit = errors.New("nil deposit")
+
+// ErrDuplicateNonce signals that a batch contains two deposits with the same nonce
+var ErrDuplicateNonce = errors.New("duplicate deposit nonce")
+
+// ErrUnorderedNonces signals that the deposits of a batch are not sorted by nonce
+var ErrUnorderedNonces = errors.New("deposits not sorted by nonce")
+
+// ErrZeroAmount signals that a deposit has a missing, zero or negative amount
+var ErrZeroAmount = errors.New("zero amount")
+
+// ErrZeroAddress signals that a deposit has a missing or zero recipient address
+var ErrZeroAddress = errors.New("zero recipient address")
+
+// DepositError is the structured error returned for an invalid deposit of a batch. The validation reason can be checked
+// with errors.Is against the exported errors of this package
+type DepositError struct {
+	BatchID uint64
+	Index   int
+	Nonce   uint64
+	Err     error
+}
+
+// Error returns the error message
+func (err *DepositError) Error() string {
+	return fmt.Sprintf("%s for the deposit with nonce %d at index %d of the batch %d", err.Err.Error(), err.Nonce, err.Index, err.BatchID)
+}
+
+// Unwrap returns the validation reason
+func (err *DepositError) Unwrap() error {
+	return err.Err
+}
@@ -0,0 +1,24 @@
+package core
+
+// The possible outcomes of a deposit executed on the destination chain
+const (
+	DepositExecuted = "executed"
+	DepositRejected = "rejected"
+	DepositUnknown  = "unknown"
+)
+
+// DepositResult holds the outcome of a single deposit executed on the destination chain
+type DepositResult struct {
+	Nonce  uint64   `json:"nonce"`
+	Status string   `json:"status"`
+	Reason string   `json:"reason,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+}
+
+// BatchResults holds the per-deposit outcomes of a batch, decoded from the transaction that executed it
+type BatchResults struct {
+	BatchID  uint64           `json:"batchId"`
+	TxHash   string           `json:"txHash"`
+	Deposits []*DepositResult `json:"deposits"`
+	Tags     []string         `json:"tags,omitempty"`
+}
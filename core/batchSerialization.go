@@ -0,0 +1,92 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// CanonicalBatchSerializationVersion is the version of the canonical batch serialization, written as its first byte
+const CanonicalBatchSerializationVersion = byte(1)
+
+// CanonicalSerialization returns the canonical serialization of the batch, meant to be reproduced by the alternative
+// relayer implementations and the auditing tools. The layout, with all integers encoded as big endian, is:
+//
+//	version (1 byte) | batch ID (8 bytes) | number of deposits (4 bytes) | deposits | statuses
+//
+// where each deposit is serialized, in the batch order, as:
+//
+//	nonce (8 bytes) | from | to | source token | destination token | amount | data
+//
+// The byte fields and the statuses are prefixed with their length on 4 bytes. The amount is serialized as its
+// minimal unsigned big endian representation, prefixed with its length, the zero amount having the length 0.
+// The block numbers, the timestamps, the transaction hashes and the displayable fields are not serialized
+func (tb *TransferBatch) CanonicalSerialization() ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	buff.WriteByte(CanonicalBatchSerializationVersion)
+	writeUint64(buff, tb.ID)
+	writeUint32(buff, uint32(len(tb.Deposits)))
+
+	for i, deposit := range tb.Deposits {
+		if deposit.Amount == nil || deposit.Amount.Sign() < 0 {
+			return nil, fmt.Errorf("%w for deposit index %d, nonce %d, amount %v",
+				ErrInvalidDepositAmount, i, deposit.Nonce, deposit.Amount)
+		}
+
+		writeUint64(buff, deposit.Nonce)
+		writeBytesWithLength(buff, deposit.FromBytes)
+		writeBytesWithLength(buff, deposit.ToBytes)
+		writeBytesWithLength(buff, deposit.SourceTokenBytes)
+		writeBytesWithLength(buff, deposit.DestinationTokenBytes)
+		writeBytesWithLength(buff, deposit.Amount.Bytes())
+		writeBytesWithLength(buff, deposit.Data)
+	}
+	writeBytesWithLength(buff, tb.Statuses)
+
+	return buff.Bytes(), nil
+}
+
+// CanonicalHash returns the SHA-256 hash of the batch canonical serialization
+func (tb *TransferBatch) CanonicalHash() ([]byte, error) {
+	serialized, err := tb.CanonicalSerialization()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(serialized)
+
+	return hash[:], nil
+}
+
+func writeUint64(buff *bytes.Buffer, value uint64) {
+	_ = binary.Write(buff, binary.BigEndian, value)
+}
+
+func writeUint32(buff *bytes.Buffer, value uint32) {
+	_ = binary.Write(buff, binary.BigEndian, value)
+}
+
+func writeBytesWithLength(buff *bytes.Buffer, value []byte) {
+	writeUint32(buff, uint32(len(value)))
+	buff.Write(value)
+}
+
+// IdempotencyKey returns the hex encoded SHA-256 hash of the provided direction, prefixed with its length, followed by
+// the batch canonical serialization. The key identifies an action taken on the batch in that direction, the same on all
+// relayers and across restarts. As the statuses are serialized, the set status actions get a different key than the
+// transfer of the same batch
+func (tb *TransferBatch) IdempotencyKey(direction string) (string, error) {
+	serialized, err := tb.CanonicalSerialization()
+	if err != nil {
+		return "", err
+	}
+
+	buff := bytes.NewBuffer(nil)
+	writeBytesWithLength(buff, []byte(direction))
+	buff.Write(serialized)
+	hash := sha256.Sum256(buff.Bytes())
+
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBatchForCanonicalSerialization() *TransferBatch {
+	return &TransferBatch{
+		ID:          2,
+		BlockNumber: 1000,
+		Deposits: []*DepositTransfer{
+			{
+				Nonce:                 3,
+				FromBytes:             []byte{0xaa},
+				ToBytes:               []byte{0xbb, 0xcc},
+				SourceTokenBytes:      []byte("src"),
+				DestinationTokenBytes: []byte("dst"),
+				Amount:                big.NewInt(256),
+				Data:                  []byte{0x00},
+				DisplayableTo:         "to",
+				TxHash:                "0x1a2b",
+			},
+		},
+		Statuses: []byte{0x03},
+	}
+}
+
+func TestTransferBatch_CanonicalSerialization(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createBatchForCanonicalSerialization()
+		batch.Deposits[0].Amount = nil
+
+		serialized, err := batch.CanonicalSerialization()
+		assert.True(t, errors.Is(err, ErrInvalidDepositAmount))
+		assert.Nil(t, serialized)
+	})
+	t.Run("negative amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createBatchForCanonicalSerialization()
+		batch.Deposits[0].Amount = big.NewInt(-1)
+
+		hash, err := batch.CanonicalHash()
+		assert.True(t, errors.Is(err, ErrInvalidDepositAmount))
+		assert.Nil(t, hash)
+	})
+	t.Run("empty batch should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &TransferBatch{
+			ID: 1,
+		}
+
+		serialized, err := batch.CanonicalSerialization()
+		require.Nil(t, err)
+		assert.Equal(t, "01"+"0000000000000001"+"00000000"+"00000000", hex.EncodeToString(serialized))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createBatchForCanonicalSerialization()
+
+		serialized, err := batch.CanonicalSerialization()
+		require.Nil(t, err)
+
+		expected := "01" + // version
+			"0000000000000002" + // batch ID
+			"00000001" + // number of deposits
+			"0000000000000003" + // nonce
+			"00000001" + "aa" + // from
+			"00000002" + "bbcc" + // to
+			"00000003" + "737263" + // source token
+			"00000003" + "647374" + // destination token
+			"00000002" + "0100" + // amount
+			"00000001" + "00" + // data
+			"00000001" + "03" // statuses
+		assert.Equal(t, expected, hex.EncodeToString(serialized))
+	})
+	t.Run("zero amount and non-serialized fields", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createBatchForCanonicalSerialization()
+		batch.Deposits[0].Amount = big.NewInt(0)
+		serialized, err := batch.CanonicalSerialization()
+		require.Nil(t, err)
+		assert.Contains(t, hex.EncodeToString(serialized), "00000003647374"+"00000000"+"0000000100")
+
+		otherBatch := batch.Clone()
+		otherBatch.BlockNumber++
+		otherBatch.Deposits[0].DisplayableTo = "other"
+		otherBatch.Deposits[0].TxHash = "0x3c4d"
+		otherSerialized, err := otherBatch.CanonicalSerialization()
+		require.Nil(t, err)
+		assert.Equal(t, serialized, otherSerialized)
+	})
+}
+
+func TestTransferBatch_CanonicalHash(t *testing.T) {
+	t.Parallel()
+
+	batch := &TransferBatch{
+		ID: 1,
+	}
+
+	hash, err := batch.CanonicalHash()
+	require.Nil(t, err)
+	assert.Equal(t, "37c7b9ecab59eb071e290b49fb91fbecf6924643fb5aacdc28cb74c8fba89b80", hex.EncodeToString(hash))
+
+	batch.ID = 2
+	otherHash, err := batch.CanonicalHash()
+	require.Nil(t, err)
+	assert.NotEqual(t, hash, otherHash)
+}
+
+func TestTransferBatch_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createBatchForCanonicalSerialization()
+		batch.Deposits[0].Amount = nil
+
+		key, err := batch.IdempotencyKey("EthereumToMultiversX")
+		assert.True(t, errors.Is(err, ErrInvalidDepositAmount))
+		assert.Empty(t, key)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch := &TransferBatch{
+			ID: 1,
+		}
+
+		key, err := batch.IdempotencyKey("EthereumToMultiversX")
+		require.Nil(t, err)
+		assert.Equal(t, "2527b2002ae2ed50f71797ad50fabc81d55d33451b15fd15b1ef901f36102c4e", key)
+
+		otherDirectionKey, err := batch.IdempotencyKey("MultiversXToEthereum")
+		require.Nil(t, err)
+		assert.NotEqual(t, key, otherDirectionKey)
+
+		batch.Statuses = []byte{0x03}
+		otherStatusesKey, err := batch.IdempotencyKey("EthereumToMultiversX")
+		require.Nil(t, err)
+		assert.NotEqual(t, key, otherStatusesKey)
+	})
+}
@@ -21,6 +21,9 @@ func TestDepositTransfer_Clone(t *testing.T) {
 		Amount:                big.NewInt(7463),
 		DestinationTokenBytes: []byte("destination token"),
 		Data:                  []byte("tx data"),
+		TxHash:                "0x1a2b",
+		BlockNumber:           4455,
+		Timestamp:             1700000000,
 	}
 
 	cloned := dt.Clone()
@@ -0,0 +1,16 @@
+package core
+
+// ConfigDrift is a config value the relayer is running with that differs from the one in the config file
+type ConfigDrift struct {
+	Path         string `json:"path"`
+	RunningValue string `json:"runningValue"`
+	FileValue    string `json:"fileValue"`
+}
+
+// ConfigDriftReport holds the differences between the running config and the config file, together with the loggers
+// having the level changed through the admin API
+type ConfigDriftReport struct {
+	ConfigFile     string         `json:"configFile"`
+	Drifts         []*ConfigDrift `json:"drifts"`
+	ChangedLoggers []LoggerInfo   `json:"changedLoggers"`
+}
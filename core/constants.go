@@ -43,6 +43,10 @@ const (
 	// MetricCurrentStateMachineStep represents the metric used to store the current running machine step
 	MetricCurrentStateMachineStep = "current state machine step"
 
+	// MetricLastStepDurationInMillis represents the metric used to store the duration of the last executed state machine
+	// step, in milliseconds
+	MetricLastStepDurationInMillis = "last step duration in millis"
+
 	// MetricNumEthClientRequests represents the metric used to count the number of ethereum client requests
 	MetricNumEthClientRequests = "num ethereum client requests"
 
@@ -75,14 +79,152 @@ const (
 	// MetricConnectedP2PAddresses represents the metric used to store all the P2P addresses the messenger has connected to
 	MetricConnectedP2PAddresses = "connected P2P addresses"
 
+	// MetricP2PTopicConnectedPeersTemplate represents the template of the metric used to store the number of peers
+	// connected on a P2P topic
+	MetricP2PTopicConnectedPeersTemplate = "P2P topic %s connected peers"
+
+	// MetricP2PTopicReceivedPerMinuteTemplate represents the template of the metric used to store the number of
+	// messages received per minute on a P2P topic
+	MetricP2PTopicReceivedPerMinuteTemplate = "P2P topic %s received messages per minute"
+
+	// MetricP2PTopicSentPerMinuteTemplate represents the template of the metric used to store the number of messages
+	// sent per minute on a P2P topic
+	MetricP2PTopicSentPerMinuteTemplate = "P2P topic %s sent messages per minute"
+
+	// MetricP2PTopicNumRejectedTemplate represents the template of the metric used to store the number of messages
+	// rejected on a P2P topic because their signers are not whitelisted
+	MetricP2PTopicNumRejectedTemplate = "P2P topic %s num rejected messages"
+
+	// MetricP2PTopicLastReceivedTimestampTemplate represents the template of the metric used to store the timestamp
+	// of the last message received on a P2P topic
+	MetricP2PTopicLastReceivedTimestampTemplate = "P2P topic %s last received timestamp"
+
 	// MetricLastBlockNonce represents the last block nonce queried
 	MetricLastBlockNonce = "last block nonce"
+
+	// MetricNumAsyncStorerWrites represents the metric used to count the writes saved by the async status storer
+	MetricNumAsyncStorerWrites = "num async storer writes"
+
+	// MetricNumAsyncStorerOverflows represents the metric used to count the writes dropped by the async status storer
+	// because its buffer was full
+	MetricNumAsyncStorerOverflows = "num async storer overflows"
+
+	// MetricAsyncStorerPendingWrites represents the metric used to store the number of writes not yet saved by the
+	// async status storer
+	MetricAsyncStorerPendingWrites = "async storer pending writes"
+
+	// MetricRuntimeNumGoroutines represents the metric used to store the number of goroutines of the relayer process
+	MetricRuntimeNumGoroutines = "runtime num goroutines"
+
+	// MetricRuntimeNumOpenFileDescriptors represents the metric used to store the number of open file descriptors of
+	// the relayer process. It is -1 if the platform does not expose them
+	MetricRuntimeNumOpenFileDescriptors = "runtime num open file descriptors"
+
+	// MetricRuntimeHeapInUse represents the metric used to store the heap in use of the relayer process, in bytes
+	MetricRuntimeHeapInUse = "runtime heap in use"
+
+	// MetricRuntimeAlert represents the metric set to 1 while the runtime stats trend beyond the configured baselines
+	MetricRuntimeAlert = "runtime alert"
+
+	// MetricScCallsNumPendingOperations represents the metric used to store the number of pending SC call operations
+	// seen on the last SC proxy query
+	MetricScCallsNumPendingOperations = "sc calls num pending operations"
+
+	// MetricScCallsNumSentTransactions represents the metric used to count the SC call execution transactions sent
+	MetricScCallsNumSentTransactions = "sc calls num sent transactions"
+
+	// MetricScCallsNumExecuted represents the metric used to count the SC call execution transactions successfully processed
+	MetricScCallsNumExecuted = "sc calls num executed"
+
+	// MetricScCallsNumFailed represents the metric used to count the SC call execution transactions that failed on chain
+	MetricScCallsNumFailed = "sc calls num failed"
+
+	// MetricScCallsGasUsed represents the metric used to sum the gas used by the processed SC call execution transactions
+	MetricScCallsGasUsed = "sc calls gas used"
+
+	// MetricScCallsLastExecutedTimestamp represents the metric used to store the unix timestamp of the last SC call
+	// execution transaction successfully processed
+	MetricScCallsLastExecutedTimestamp = "sc calls last executed timestamp"
+
+	// MetricScCallsLastFailedTimestamp represents the metric used to store the unix timestamp of the last SC call
+	// execution transaction that failed on chain
+	MetricScCallsLastFailedTimestamp = "sc calls last failed timestamp"
+
+	// MetricDestinationQuorum represents the metric used to store the quorum set on the multisig contract of the chain
+	// where the transfers of a bridge direction are executed
+	MetricDestinationQuorum = "destination quorum"
+
+	// MetricGovernancePaused represents the metric set to 1 while the bridge processing is halted by a governance pause
+	MetricGovernancePaused = "governance paused"
+
+	// MetricGovernancePausedChains represents the metric used to store the chains on which the governance pause is set
+	MetricGovernancePausedChains = "governance paused chains"
+
+	// MetricOperatorPausedHalfBridges represents the metric used to store the half-bridges paused by an operator
+	MetricOperatorPausedHalfBridges = "operator paused half-bridges"
+
+	// MetricDeadLettersDepth represents the metric used to store the number of dead letter deposits not yet resolved
+	MetricDeadLettersDepth = "dead letters depth"
+
+	// MetricDeadLettersNumRefunded represents the metric used to store the number of dead letter deposits resolved
+	// with a refund
+	MetricDeadLettersNumRefunded = "dead letters num refunded"
+
+	// MetricNumDirectMessagesSent represents the metric used to store the number of direct messages sent to the other
+	// relayers
+	MetricNumDirectMessagesSent = "num direct messages sent"
+
+	// MetricNumDirectMessagesReceived represents the metric used to store the number of direct messages received from
+	// the other relayers
+	MetricNumDirectMessagesReceived = "num direct messages received"
+
+	// MetricNumDirectMessagesDropped represents the metric used to store the number of received direct messages that
+	// were invalid or addressed to another relayer
+	MetricNumDirectMessagesDropped = "num direct messages dropped"
+
+	// MetricNumTransferReceiptsGenerated represents the metric used to store the number of signed transfer receipts
+	// generated for the batches executed by this relayer
+	MetricNumTransferReceiptsGenerated = "num transfer receipts generated"
+
+	// MetricNumTransferReceiptsFailed represents the metric used to store the number of executed batches for which the
+	// transfer receipts could not be generated
+	MetricNumTransferReceiptsFailed = "num transfer receipts failed"
+
+	// MetricNumContextDeadlineOverruns represents the metric used to store the number of calls that returned later than
+	// the deadline of their context, i.e. that ignored the context
+	MetricNumContextDeadlineOverruns = "num context deadline overruns"
+
+	// MetricMaxContextDeadlineOverrunInMillis represents the metric used to store the largest delay, after the deadline
+	// of their context, with which the audited calls returned
+	MetricMaxContextDeadlineOverrunInMillis = "max context deadline overrun in millis"
+
+	// MetricLastContextDeadlineOverrun represents the metric used to store the name of the last call that ignored the
+	// deadline of its context
+	MetricLastContextDeadlineOverrun = "last context deadline overrun"
+
+	// MetricNumExecutionCostsRecorded represents the metric used to store the number of batch executions paid by this
+	// relayer with their cost recorded in the accounting ledger
+	MetricNumExecutionCostsRecorded = "num execution costs recorded"
+
+	// MetricNumPendingExecutionCosts represents the metric used to store the number of batch executions paid by this
+	// relayer waiting for their transactions to be final before their cost is recorded
+	MetricNumPendingExecutionCosts = "num pending execution costs"
+
+	// MetricGasPriceDeferredBatch represents the metric used to store the ID of the batch whose execution on Ethereum is
+	// deferred because of the gas price being above the maximum allowed, 0 if none
+	MetricGasPriceDeferredBatch = "gas price deferred batch"
+
+	// MetricGasPriceDeferralInSeconds represents the metric used to store for how long the execution of the deferred
+	// batch is waiting for the gas price to drop below the maximum allowed
+	MetricGasPriceDeferralInSeconds = "gas price deferral in seconds"
 )
 
 // PersistedMetrics represents the array of metrics that should be persisted
 var PersistedMetrics = []string{MetricNumBatches, MetricNumEthClientRequests, MetricNumEthClientTransactions,
 	MetricLastQueriedEthereumBlockNumber, MetricLastQueriedMultiversXBlockNumber, MetricEthereumClientStatus,
-	MetricMultiversXClientStatus, MetricLastEthereumClientError, MetricLastMultiversXClientError, MetricLastBlockNonce}
+	MetricMultiversXClientStatus, MetricLastEthereumClientError, MetricLastMultiversXClientError, MetricLastBlockNonce,
+	MetricScCallsNumSentTransactions, MetricScCallsNumExecuted, MetricScCallsNumFailed, MetricScCallsGasUsed,
+	MetricScCallsLastExecutedTimestamp, MetricScCallsLastFailedTimestamp}
 
 const (
 	// EthClientStatusHandlerName is the Ethereum client status handler name
@@ -90,4 +232,61 @@ const (
 
 	// MultiversXClientStatusHandlerName is the MultiversX client status handler name
 	MultiversXClientStatusHandlerName = "multiversx-client"
+
+	// StatusStorerStatusHandlerName is the async status storer status handler name
+	StatusStorerStatusHandlerName = "status-storer"
+
+	// BalanceMonitorStatusHandlerName is the relayer accounts balance monitor status handler name
+	BalanceMonitorStatusHandlerName = "balance-monitor"
+
+	// RuntimeMonitorStatusHandlerName is the relayer process runtime monitor status handler name
+	RuntimeMonitorStatusHandlerName = "runtime-monitor"
+
+	// ScCallsModuleStatusHandlerName is the SC calls executor module status handler name
+	ScCallsModuleStatusHandlerName = "sc-calls-module"
+
+	// GovernancePauseStatusHandlerName is the governance pause watcher status handler name
+	GovernancePauseStatusHandlerName = "governance-pause"
+
+	// GasUsageTrackerStatusHandlerName is the gas usage regression tracker status handler name
+	GasUsageTrackerStatusHandlerName = "gas-usage-tracker"
+
+	// DeadLettersStatusHandlerName is the dead letters store status handler name
+	DeadLettersStatusHandlerName = "dead-letters"
+
+	// TokenMetadataStatusHandlerName is the bridged tokens metadata monitor status handler name
+	TokenMetadataStatusHandlerName = "token-metadata"
+
+	// HeartbeatStatusHandlerName is the relayer heartbeat publisher status handler name
+	HeartbeatStatusHandlerName = "heartbeat"
+
+	// P2PStatusHandlerName is the p2p network status handler name
+	P2PStatusHandlerName = "p2p"
+
+	// DirectMessagesStatusHandlerName is the relayers direct messages channel status handler name
+	DirectMessagesStatusHandlerName = "direct-messages"
+
+	// TransferReceiptsStatusHandlerName is the signed transfer receipts generator status handler name
+	TransferReceiptsStatusHandlerName = "transfer-receipts"
+
+	// OperatorPauseStatusHandlerName is the operator pause of the half-bridges status handler name
+	OperatorPauseStatusHandlerName = "operator-pause"
+
+	// ContextAuditStatusHandlerName is the audit of the calls ignoring their context status handler name
+	ContextAuditStatusHandlerName = "context-audit"
+
+	// CostAccountingStatusHandlerName is the batch execution costs ledger status handler name
+	CostAccountingStatusHandlerName = "cost-accounting"
+
+	// SignerAuditLogName is the name under which the signer audit log entries are stored
+	SignerAuditLogName = "signer-audit-log"
 )
+
+// StatusHandlersNames represents the names of the status handlers persisting their metrics, besides the state machines
+// ones that depend on the bridged chains
+var StatusHandlersNames = []string{EthClientStatusHandlerName, MultiversXClientStatusHandlerName,
+	StatusStorerStatusHandlerName, BalanceMonitorStatusHandlerName, RuntimeMonitorStatusHandlerName,
+	ScCallsModuleStatusHandlerName, GovernancePauseStatusHandlerName, GasUsageTrackerStatusHandlerName,
+	DeadLettersStatusHandlerName, TokenMetadataStatusHandlerName, HeartbeatStatusHandlerName, P2PStatusHandlerName,
+	DirectMessagesStatusHandlerName, TransferReceiptsStatusHandlerName, OperatorPauseStatusHandlerName,
+	ContextAuditStatusHandlerName, CostAccountingStatusHandlerName}
@@ -0,0 +1,39 @@
+package core
+
+const (
+	// DeadLetterRetry is the resolution action that puts a dead letter deposit back in the normal processing
+	DeadLetterRetry = "retry"
+
+	// DeadLetterRefund is the resolution action that rejects the batch of a dead letter deposit on the destination
+	// chain, so all the batch deposits get refunded on the source chain
+	DeadLetterRefund = "refund"
+
+	// DeadLetterIgnore is the resolution action that closes a dead letter without changing the processing
+	DeadLetterIgnore = "ignore"
+)
+
+// DeadLetter is a deposit that repeatedly failed to be validated or executed. It stays in the dead letters store until
+// an operator resolves it or its batch is eventually processed
+type DeadLetter struct {
+	Direction     string                `json:"direction"`
+	BatchID       uint64                `json:"batchId"`
+	DepositNonce  uint64                `json:"depositNonce"`
+	Token         string                `json:"token"`
+	Recipient     string                `json:"recipient"`
+	Amount        string                `json:"amount"`
+	Reason        string                `json:"reason"`
+	NumFailures   uint64                `json:"numFailures"`
+	FirstFailedAt int64                 `json:"firstFailedAt"`
+	LastFailedAt  int64                 `json:"lastFailedAt"`
+	Resolution    *DeadLetterResolution `json:"resolution,omitempty"`
+}
+
+// DeadLetterResolution records the action taken by an operator on a dead letter, the address the request came from
+// and the moment of the resolution
+type DeadLetterResolution struct {
+	Action        string `json:"action"`
+	Operator      string `json:"operator"`
+	RemoteAddress string `json:"remoteAddress"`
+	Comment       string `json:"comment,omitempty"`
+	Timestamp     int64  `json:"timestamp"`
+}
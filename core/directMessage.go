@@ -0,0 +1,30 @@
+package core
+
+const (
+	// DirectMessageMaintenance is the kind of the direct messages announcing a maintenance of the sender's relayer
+	DirectMessageMaintenance = "maintenance"
+
+	// DirectMessageExecutionClaim is the kind of the direct messages claiming the execution of a batch
+	DirectMessageExecutionClaim = "executionClaim"
+
+	// DirectMessageVeto is the kind of the direct messages explaining why the sender refuses to sign a batch
+	DirectMessageVeto = "veto"
+
+	// DirectMessageSent is the direction of the direct messages sent by this relayer
+	DirectMessageSent = "sent"
+
+	// DirectMessageReceived is the direction of the direct messages received by this relayer
+	DirectMessageReceived = "received"
+)
+
+// DirectMessage is an operational coordination message exchanged between two whitelisted relayers, outside the
+// broadcast topics. The addresses are the bech32 MultiversX addresses of the relayers
+type DirectMessage struct {
+	Kind      string `json:"kind"`
+	Text      string `json:"text"`
+	BatchID   uint64 `json:"batchId,omitempty"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Direction string `json:"direction"`
+	Timestamp int64  `json:"timestamp"`
+}
@@ -0,0 +1,9 @@
+package core
+
+import "errors"
+
+// ErrUnknownLoggerIdentifier signals that no logger was created with the provided identifier
+var ErrUnknownLoggerIdentifier = errors.New("unknown logger identifier")
+
+// ErrInvalidDepositAmount signals that a deposit with a nil or negative amount can not be serialized
+var ErrInvalidDepositAmount = errors.New("invalid deposit amount")
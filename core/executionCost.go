@@ -0,0 +1,15 @@
+package core
+
+// ExecutionCost is the cost of a transaction sent by a relayer to execute a batch on the destination chain. The fee is
+// in the base units of the destination chain native token and is paid by the relayer, whether the transaction
+// succeeded or not
+type ExecutionCost struct {
+	Relayer   string `json:"relayer"`
+	Chain     string `json:"chain"`
+	Direction string `json:"direction"`
+	BatchID   uint64 `json:"batchId"`
+	TxHash    string `json:"txHash"`
+	GasUsed   uint64 `json:"gasUsed"`
+	Fee       string `json:"fee"`
+	Timestamp int64  `json:"timestamp"`
+}
@@ -0,0 +1,11 @@
+package core
+
+// ExportedTransaction is a fully signed Ethereum transaction that the relayer exported instead of broadcasting it, so
+// the operator can submit it through its own transaction infrastructure
+type ExportedTransaction struct {
+	BatchID        uint64 `json:"batchId"`
+	Nonce          uint64 `json:"nonce"`
+	TxHash         string `json:"txHash"`
+	RawTransaction string `json:"rawTransaction"`
+	Timestamp      int64  `json:"timestamp"`
+}
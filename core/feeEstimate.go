@@ -0,0 +1,23 @@
+package core
+
+// FeeEstimate is the quote of a deposit of the provided amount: the fee charged by the source chain safe contract, the
+// amount received on the destination chain after the decimals conversion, the dust left by the token rounding mode, the
+// deposit limits and the expected delay, estimated from the number of batches waiting to be executed in the same
+// direction. All the amounts are expressed in the base units of the token on the chain they refer to
+type FeeEstimate struct {
+	Direction                 string `json:"direction"`
+	SourceToken               string `json:"sourceToken"`
+	DestinationToken          string `json:"destinationToken"`
+	Amount                    string `json:"amount"`
+	Fee                       string `json:"fee"`
+	DestinationAmount         string `json:"destinationAmount"`
+	Dust                      string `json:"dust"`
+	RoundingMode              string `json:"roundingMode"`
+	SourceDecimals            uint8  `json:"sourceDecimals"`
+	DestinationDecimals       uint8  `json:"destinationDecimals"`
+	MinAmount                 string `json:"minAmount"`
+	MaxAmount                 string `json:"maxAmount"`
+	WithinLimits              bool   `json:"withinLimits"`
+	PendingBatches            uint64 `json:"pendingBatches"`
+	EstimatedLatencyInSeconds uint64 `json:"estimatedLatencyInSeconds"`
+}
@@ -0,0 +1,22 @@
+package core
+
+// Incident is an event that halted the bridge processing. While an incident is not acknowledged by an operator, the
+// processing is not resumed automatically, even if the condition that halted it is no longer observed
+type Incident struct {
+	ID             uint64                  `json:"id"`
+	Source         string                  `json:"source"`
+	Description    string                  `json:"description"`
+	RaisedAt       int64                   `json:"raisedAt"`
+	LastRaisedAt   int64                   `json:"lastRaisedAt"`
+	NumOccurrences uint64                  `json:"numOccurrences"`
+	Acknowledgment *IncidentAcknowledgment `json:"acknowledgment,omitempty"`
+}
+
+// IncidentAcknowledgment records the operator that acknowledged an incident, the address the request came from and
+// the moment of the acknowledgment
+type IncidentAcknowledgment struct {
+	Operator      string `json:"operator"`
+	RemoteAddress string `json:"remoteAddress"`
+	Comment       string `json:"comment,omitempty"`
+	Timestamp     int64  `json:"timestamp"`
+}
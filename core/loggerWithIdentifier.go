@@ -20,6 +20,7 @@ func NewLoggerWithIdentifier(logger logger.Logger, identifier string) *loggerWit
 		logger:     logger,
 		identifier: identifier,
 	}
+	defaultLoggersRegistry.register(identifier, logger)
 
 	return log
 }
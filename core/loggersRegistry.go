@@ -0,0 +1,123 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// LoggerInfo holds the identifier and the current level of a logger
+type LoggerInfo struct {
+	Identifier string `json:"identifier"`
+	Level      string `json:"level"`
+}
+
+// loggersRegistry keeps track of all the loggers created through NewLoggerWithIdentifier so their levels can be
+// listed and changed at runtime
+type loggersRegistry struct {
+	mut           sync.RWMutex
+	loggers       map[string][]logger.Logger
+	changedLevels map[string]logger.LogLevel
+}
+
+var defaultLoggersRegistry = newLoggersRegistry()
+
+func newLoggersRegistry() *loggersRegistry {
+	return &loggersRegistry{
+		loggers:       make(map[string][]logger.Logger),
+		changedLevels: make(map[string]logger.LogLevel),
+	}
+}
+
+// GetLoggersRegistry returns the registry holding the loggers created through NewLoggerWithIdentifier
+func GetLoggersRegistry() *loggersRegistry {
+	return defaultLoggersRegistry
+}
+
+func (registry *loggersRegistry) register(identifier string, log logger.Logger) {
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+
+	for _, existing := range registry.loggers[identifier] {
+		if existing == log {
+			return
+		}
+	}
+
+	registry.loggers[identifier] = append(registry.loggers[identifier], log)
+}
+
+// Loggers returns the registered logger identifiers and their current levels, sorted by identifier
+func (registry *loggersRegistry) Loggers() []LoggerInfo {
+	registry.mut.RLock()
+	defer registry.mut.RUnlock()
+
+	result := make([]LoggerInfo, 0, len(registry.loggers))
+	for identifier, loggers := range registry.loggers {
+		result = append(result, LoggerInfo{
+			Identifier: identifier,
+			Level:      loggers[0].GetLevel().String(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Identifier < result[j].Identifier
+	})
+
+	return result
+}
+
+// SetLoggerLevel changes the level of the loggers created with the provided identifier. The loggers sharing the
+// same underlying logger (created with the same name) will also be affected
+func (registry *loggersRegistry) SetLoggerLevel(identifier string, level string) error {
+	logLevel, err := logger.GetLogLevel(level)
+	if err != nil {
+		return err
+	}
+
+	registry.mut.Lock()
+	defer registry.mut.Unlock()
+
+	loggers, found := registry.loggers[identifier]
+	if !found {
+		return fmt.Errorf("%w: %s", ErrUnknownLoggerIdentifier, identifier)
+	}
+
+	for _, log := range loggers {
+		log.SetLevel(logLevel)
+	}
+	registry.changedLevels[identifier] = logLevel
+
+	return nil
+}
+
+// ChangedLoggers returns the loggers having the level set through SetLoggerLevel, sorted by identifier. The loggers
+// whose level was changed afterwards, e.g. by applying a log level pattern, are not returned
+func (registry *loggersRegistry) ChangedLoggers() []LoggerInfo {
+	registry.mut.RLock()
+	defer registry.mut.RUnlock()
+
+	result := make([]LoggerInfo, 0, len(registry.changedLevels))
+	for identifier, logLevel := range registry.changedLevels {
+		currentLevel := registry.loggers[identifier][0].GetLevel()
+		if currentLevel != logLevel {
+			continue
+		}
+
+		result = append(result, LoggerInfo{
+			Identifier: identifier,
+			Level:      logLevel.String(),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Identifier < result[j].Identifier
+	})
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (registry *loggersRegistry) IsInterfaceNil() bool {
+	return registry == nil
+}
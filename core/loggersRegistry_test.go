@@ -0,0 +1,69 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggersRegistry_SetLoggerLevel(t *testing.T) {
+	t.Parallel()
+
+	registry := newLoggersRegistry()
+	assert.False(t, check.IfNil(registry))
+
+	log := logger.GetOrCreate("core/test-registry")
+	registry.register("identifier", log)
+	registry.register("identifier", log)
+	registry.register("another identifier", logger.GetOrCreate("core/test-registry-another"))
+
+	loggers := registry.Loggers()
+	require.Equal(t, 2, len(loggers))
+	assert.Equal(t, "another identifier", loggers[0].Identifier)
+	assert.Equal(t, "identifier", loggers[1].Identifier)
+	assert.Equal(t, 1, len(registry.loggers["identifier"]))
+
+	err := registry.SetLoggerLevel("identifier", "TRACE")
+	assert.Nil(t, err)
+	assert.Equal(t, logger.LogTrace, log.GetLevel())
+	assert.Equal(t, logger.LogTrace.String(), registry.Loggers()[1].Level)
+
+	err = registry.SetLoggerLevel("missing", "TRACE")
+	assert.True(t, errors.Is(err, ErrUnknownLoggerIdentifier))
+
+	err = registry.SetLoggerLevel("identifier", "NOT A LEVEL")
+	assert.NotNil(t, err)
+	assert.Equal(t, logger.LogTrace, log.GetLevel())
+}
+
+func TestLoggersRegistry_ChangedLoggers(t *testing.T) {
+	t.Parallel()
+
+	registry := newLoggersRegistry()
+	log := logger.GetOrCreate("core/test-changed-loggers")
+	registry.register("identifier", log)
+	registry.register("another identifier", logger.GetOrCreate("core/test-changed-loggers-another"))
+	assert.Empty(t, registry.ChangedLoggers())
+
+	err := registry.SetLoggerLevel("identifier", "TRACE")
+	require.Nil(t, err)
+	assert.Equal(t, []LoggerInfo{{Identifier: "identifier", Level: logger.LogTrace.String()}}, registry.ChangedLoggers())
+
+	log.SetLevel(logger.LogInfo)
+	assert.Empty(t, registry.ChangedLoggers())
+}
+
+func TestNewLoggerWithIdentifier_ShouldRegister(t *testing.T) {
+	t.Parallel()
+
+	log := NewLoggerWithIdentifier(logger.GetOrCreate("core/test-identifier"), "test-identifier")
+	require.NotNil(t, log)
+
+	err := GetLoggersRegistry().SetLoggerLevel("test-identifier", "DEBUG")
+	assert.Nil(t, err)
+	assert.Equal(t, logger.LogDebug, log.GetLevel())
+}
@@ -17,8 +17,41 @@ func (msg *SignedMessage) UniqueID() string {
 	return fmt.Sprintf("%s%s", string(msg.PublicKeyBytes), string(msg.Payload))
 }
 
+// SignedMessagesBatch is the message used to send, in pages, the stored signed messages to a relayer that just joined
+type SignedMessagesBatch struct {
+	Messages []*SignedMessage `json:"messages"`
+	Page     uint32           `json:"page"`
+	NumPages uint32           `json:"numPages"`
+}
+
 // EthereumSignature is the message used when the relayers will send an ethereum signature
 type EthereumSignature struct {
 	Signature   []byte `json:"sig"`
 	MessageHash []byte `json:"msg"`
 }
+
+// PreAgreementMessage is the message used in the pre-agreement round: the leader announces the view of the batch it
+// intends to propose and the other relayers answer with their own view of the same batch. The view is the batch's
+// idempotency key, so it covers the deposits and the statuses
+type PreAgreementMessage struct {
+	Kind      string `json:"kind"`
+	Direction string `json:"direction"`
+	BatchID   uint64 `json:"batchId"`
+	View      string `json:"view"`
+}
+
+// HeartbeatMessage is the liveness proof periodically published by a relayer. The sequence is reset on restart, so a
+// monitor can tell the restarts apart from the missed heartbeats
+type HeartbeatMessage struct {
+	Address   string `json:"address"`
+	Timestamp int64  `json:"timestamp"`
+	Sequence  uint64 `json:"sequence"`
+}
+
+// SignedHeartbeat is the heartbeat message posted to a collector, signed with the relayer's MultiversX key. The
+// signature covers the JSON encoding of the message
+type SignedHeartbeat struct {
+	Message   *HeartbeatMessage `json:"message"`
+	PublicKey string            `json:"publicKey"`
+	Signature string            `json:"signature"`
+}
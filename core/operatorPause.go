@@ -0,0 +1,20 @@
+package core
+
+const (
+	// HalfBridgeEthToMvx identifies, in the operator pause requests, the half-bridge transferring the Ethereum deposits
+	// to MultiversX
+	HalfBridgeEthToMvx = "ethToMvx"
+
+	// HalfBridgeMvxToEth identifies, in the operator pause requests, the half-bridge transferring the MultiversX
+	// deposits to Ethereum
+	HalfBridgeMvxToEth = "mvxToEth"
+)
+
+// HalfBridgePause holds the operator pause state of a half-bridge, together with the address of the last request that
+// changed it
+type HalfBridgePause struct {
+	HalfBridge    string `json:"halfBridge"`
+	Paused        bool   `json:"paused"`
+	RemoteAddress string `json:"remoteAddress,omitempty"`
+	Timestamp     int64  `json:"timestamp,omitempty"`
+}
@@ -0,0 +1,78 @@
+package passphrase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ErrNoPassphraseSource signals that no passphrase source is available
+var ErrNoPassphraseSource = errors.New("no passphrase source available: set the environment variable, the passphrase file or run in an interactive terminal")
+
+// Source defines where a keystore passphrase can be read from. The sources are tried in this order: the environment
+// variable, the passphrase file and, as a last resort, an interactive prompt on the terminal
+type Source struct {
+	EnvVariable string
+	File        string
+}
+
+type prompter interface {
+	isInteractive() bool
+	readPassphrase(message string) (string, error)
+}
+
+type terminalPrompter struct {
+	input  *os.File
+	output io.Writer
+}
+
+func (prompter *terminalPrompter) isInteractive() bool {
+	return term.IsTerminal(int(prompter.input.Fd()))
+}
+
+func (prompter *terminalPrompter) readPassphrase(message string) (string, error) {
+	_, _ = fmt.Fprint(prompter.output, message)
+	buff, err := term.ReadPassword(int(prompter.input.Fd()))
+	_, _ = fmt.Fprintln(prompter.output)
+	if err != nil {
+		return "", err
+	}
+
+	return string(buff), nil
+}
+
+// Read returns the passphrase of the named key from the first available source
+func Read(keyName string, source Source) (string, error) {
+	return readPassphrase(keyName, source, os.Getenv, &terminalPrompter{
+		input:  os.Stdin,
+		output: os.Stderr,
+	})
+}
+
+func readPassphrase(keyName string, source Source, getEnv func(key string) string, prompter prompter) (string, error) {
+	if len(source.EnvVariable) > 0 {
+		value := getEnv(source.EnvVariable)
+		if len(value) > 0 {
+			return value, nil
+		}
+	}
+
+	if len(source.File) > 0 {
+		buff, err := os.ReadFile(source.File)
+		if err != nil {
+			return "", fmt.Errorf("%w while reading the passphrase file for the %s key", err, keyName)
+		}
+
+		return strings.TrimRight(string(buff), "\r\n"), nil
+	}
+
+	if !prompter.isInteractive() {
+		return "", fmt.Errorf("%w for the %s key", ErrNoPassphraseSource, keyName)
+	}
+
+	return prompter.readPassphrase(fmt.Sprintf("Enter the passphrase for the %s key: ", keyName))
+}
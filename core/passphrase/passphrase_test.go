@@ -0,0 +1,110 @@
+package passphrase
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type prompterStub struct {
+	interactive bool
+	passphrase  string
+	err         error
+	message     string
+}
+
+func (stub *prompterStub) isInteractive() bool {
+	return stub.interactive
+}
+
+func (stub *prompterStub) readPassphrase(message string) (string, error) {
+	stub.message = message
+	return stub.passphrase, stub.err
+}
+
+func createEnv(values map[string]string) func(key string) string {
+	return func(key string) string {
+		return values[key]
+	}
+}
+
+func TestReadPassphrase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should read from the environment variable", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "passphrase")
+		require.Nil(t, os.WriteFile(filename, []byte("from file"), 0600))
+		source := Source{
+			EnvVariable: "PASSPHRASE",
+			File:        filename,
+		}
+
+		value, err := readPassphrase("test", source, createEnv(map[string]string{"PASSPHRASE": "from env"}), &prompterStub{})
+		assert.Nil(t, err)
+		assert.Equal(t, "from env", value)
+	})
+	t.Run("empty environment variable should read from the file", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "passphrase")
+		require.Nil(t, os.WriteFile(filename, []byte("from file\n"), 0600))
+		source := Source{
+			EnvVariable: "PASSPHRASE",
+			File:        filename,
+		}
+
+		value, err := readPassphrase("test", source, createEnv(nil), &prompterStub{})
+		assert.Nil(t, err)
+		assert.Equal(t, "from file", value)
+	})
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		source := Source{
+			File: filepath.Join(t.TempDir(), "missing"),
+		}
+
+		value, err := readPassphrase("test", source, createEnv(nil), &prompterStub{interactive: true})
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+		assert.Empty(t, value)
+	})
+	t.Run("should prompt if no other source is available", func(t *testing.T) {
+		t.Parallel()
+
+		prompter := &prompterStub{
+			interactive: true,
+			passphrase:  "from prompt",
+		}
+
+		value, err := readPassphrase("test", Source{EnvVariable: "PASSPHRASE"}, createEnv(nil), prompter)
+		assert.Nil(t, err)
+		assert.Equal(t, "from prompt", value)
+		assert.Equal(t, "Enter the passphrase for the test key: ", prompter.message)
+	})
+	t.Run("prompt error should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		prompter := &prompterStub{
+			interactive: true,
+			err:         expectedErr,
+		}
+
+		value, err := readPassphrase("test", Source{}, createEnv(nil), prompter)
+		assert.Equal(t, expectedErr, err)
+		assert.Empty(t, value)
+	})
+	t.Run("no source available should error", func(t *testing.T) {
+		t.Parallel()
+
+		value, err := readPassphrase("test", Source{}, createEnv(nil), &prompterStub{})
+		assert.True(t, errors.Is(err, ErrNoPassphraseSource))
+		assert.Empty(t, value)
+	})
+}
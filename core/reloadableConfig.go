@@ -0,0 +1,30 @@
+package core
+
+import "time"
+
+// ReloadableConfig holds the settings, re-read from the configuration file, that can be applied at runtime without
+// restarting the relayer
+type ReloadableConfig struct {
+	GasStation GasStationSettings
+	// PollingIntervals holds the new intervals of the polling handlers, keyed by the polling handler name. The step
+	// durations of the state machines are the intervals of their polling handlers
+	PollingIntervals map[string]time.Duration
+}
+
+// GasStationSettings holds the reloadable settings of the Ethereum gas station
+type GasStationSettings struct {
+	RequestURL             string
+	RequestPollingInterval time.Duration
+	RequestRetryDelay      time.Duration
+	MaximumFetchRetries    int
+	RequestTime            time.Duration
+	MaximumGasPrice        int
+	GasPriceSelector       EthGasPriceSelector
+	GasPriceMultiplier     int
+}
+
+// ReloadableConfigHandler defines a component, already built, able to apply the reloadable settings at runtime
+type ReloadableConfigHandler interface {
+	ReloadConfig(cfg ReloadableConfig) error
+	IsInterfaceNil() bool
+}
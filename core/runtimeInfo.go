@@ -0,0 +1,21 @@
+package core
+
+// ChainRuntimeInfo holds the chain related information the relayer is running with
+type ChainRuntimeInfo struct {
+	ChainID                 string `json:"chainId"`
+	MultisigContractAddress string `json:"multisigContractAddress"`
+	SafeContractAddress     string `json:"safeContractAddress"`
+	RelayerAddress          string `json:"relayerAddress"`
+	Quorum                  string `json:"quorum"`
+}
+
+// RuntimeInfo holds the structured information about the running relayer, as exposed to the inventory tooling
+type RuntimeInfo struct {
+	AppVersion      string           `json:"appVersion"`
+	GitCommit       string           `json:"gitCommit"`
+	Profile         string           `json:"profile"`
+	Branding        string           `json:"branding"`
+	Ethereum        ChainRuntimeInfo `json:"ethereum"`
+	MultiversX      ChainRuntimeInfo `json:"multiversx"`
+	EnabledFeatures []string         `json:"enabledFeatures"`
+}
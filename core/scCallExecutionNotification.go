@@ -0,0 +1,38 @@
+package core
+
+// ScCallExecutionStatus is the status of a bridged SC call execution, as reported in the webhook notifications
+type ScCallExecutionStatus string
+
+const (
+	// ScCallExecuted marks a SC call execution transaction that was successfully processed
+	ScCallExecuted ScCallExecutionStatus = "executed"
+	// ScCallFailed marks a SC call execution transaction that failed on chain
+	ScCallFailed ScCallExecutionStatus = "failed"
+	// ScCallSent marks a SC call execution transaction that was sent but whose result was not checked
+	ScCallSent ScCallExecutionStatus = "sent"
+	// ScCallUnknown marks a SC call execution transaction whose result could not be fetched
+	ScCallUnknown ScCallExecutionStatus = "unknown"
+)
+
+// ScCallExecutionResult holds the decoded result of a SC call execution transaction
+type ScCallExecutionResult struct {
+	ReturnData   []string `json:"returnData,omitempty"`
+	ErrorMessage string   `json:"errorMessage,omitempty"`
+	Events       []string `json:"events,omitempty"`
+}
+
+// ScCallExecutionNotification is the payload posted to the webhook for every executed or failed bridged SC call
+type ScCallExecutionNotification struct {
+	ScProxy      string                 `json:"scProxy"`
+	ID           uint64                 `json:"id"`
+	TxHash       string                 `json:"txHash"`
+	Status       ScCallExecutionStatus  `json:"status"`
+	From         string                 `json:"from"`
+	To           string                 `json:"to"`
+	Token        string                 `json:"token"`
+	Amount       string                 `json:"amount"`
+	DepositNonce uint64                 `json:"depositNonce"`
+	Result       *ScCallExecutionResult `json:"result,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	Timestamp    int64                  `json:"timestamp"`
+}
@@ -0,0 +1,23 @@
+package core
+
+// SyncReport is the consolidated view, produced at startup, of where the bridge believes it is on both chains
+type SyncReport struct {
+	Timestamp            int64             `json:"timestamp"`
+	EthereumToMultiversX *BridgeSyncReport `json:"ethereumToMultiversX"`
+	MultiversXToEthereum *BridgeSyncReport `json:"multiversXToEthereum"`
+}
+
+// BridgeSyncReport holds, for one bridge direction, the on-chain progress, the pending batch and the locally persisted
+// state. A 0 pending batch ID means that no batch is pending
+type BridgeSyncReport struct {
+	LastExecutedBatchID          uint64   `json:"lastExecutedBatchId"`
+	PendingBatchID               uint64   `json:"pendingBatchId"`
+	NumPendingDeposits           int      `json:"numPendingDeposits"`
+	PendingBatchFinal            bool     `json:"pendingBatchFinal"`
+	PendingExecutedOnDestination bool     `json:"pendingExecutedOnDestination"`
+	PersistedNumBatches          int      `json:"persistedNumBatches"`
+	PersistedStep                string   `json:"persistedStep"`
+	PersistedLastError           string   `json:"persistedLastError"`
+	Discrepancies                []string `json:"discrepancies,omitempty"`
+	Errors                       []string `json:"errors,omitempty"`
+}
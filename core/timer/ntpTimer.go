@@ -1,6 +1,8 @@
 package timer
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/ntp"
 )
@@ -24,11 +26,26 @@ func NewNTPTimer() *ntpTimer {
 	}
 }
 
+// Now will return the current time
+func (n *ntpTimer) Now() time.Time {
+	return n.ntpSyncTimer.CurrentTime()
+}
+
 // NowUnix will return the Unix time
 func (n *ntpTimer) NowUnix() int64 {
 	return n.ntpSyncTimer.CurrentTime().Unix()
 }
 
+// After waits for the duration to elapse and then sends the current time on the returned channel
+func (n *ntpTimer) After(duration time.Duration) <-chan time.Time {
+	return time.After(duration)
+}
+
+// Sleep pauses the current go routine for the provided duration
+func (n *ntpTimer) Sleep(duration time.Duration) {
+	time.Sleep(duration)
+}
+
 // Start will start the inner NTP timer
 func (n *ntpTimer) Start() {
 	n.ntpSyncTimer.StartSyncingTime()
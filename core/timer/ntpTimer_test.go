@@ -54,6 +54,21 @@ func TestNtpTimer_Start(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNtpTimer_Now(t *testing.T) {
+	t.Parallel()
+
+	timeValue := time.Unix(16438253, 500)
+	ntpSyncer := &mock.SyncTimerStub{
+		CurrentTimeCalled: func() time.Time {
+			return timeValue
+		},
+	}
+
+	timer := newNTPTimerWithInnerSyncTimer(ntpSyncer)
+
+	assert.Equal(t, timeValue, timer.Now())
+}
+
 func TestNtpTimer_NowUnix(t *testing.T) {
 	t.Parallel()
 
@@ -69,3 +84,27 @@ func TestNtpTimer_NowUnix(t *testing.T) {
 	unixTime := timer.NowUnix()
 	assert.Equal(t, timeValue.Unix(), unixTime)
 }
+
+func TestNtpTimer_After(t *testing.T) {
+	t.Parallel()
+
+	timer := newNTPTimerWithInnerSyncTimer(&mock.SyncTimerStub{})
+
+	start := time.Now()
+	select {
+	case <-timer.After(time.Millisecond * 10):
+		assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*10)
+	case <-time.After(time.Second):
+		assert.Fail(t, "timeout")
+	}
+}
+
+func TestNtpTimer_Sleep(t *testing.T) {
+	t.Parallel()
+
+	timer := newNTPTimerWithInnerSyncTimer(&mock.SyncTimerStub{})
+
+	start := time.Now()
+	timer.Sleep(time.Millisecond * 10)
+	assert.GreaterOrEqual(t, time.Since(start), time.Millisecond*10)
+}
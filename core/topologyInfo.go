@@ -0,0 +1,26 @@
+package core
+
+// LeaderSlot is an entry of the leader schedule. The slot is the seed used by the leader selector, the timestamp
+// divided by the interval for leader
+type LeaderSlot struct {
+	Slot        uint64 `json:"slot"`
+	StartTime   int64  `json:"startTime"`
+	LeaderIndex int    `json:"leaderIndex"`
+	Leader      string `json:"leader"`
+	IsSelf      bool   `json:"isSelf"`
+}
+
+// TopologyInfo holds the relayers set of a bridge direction as seen by the local relayer, together with the upcoming
+// leader schedule, so external monitors can predict and verify the leaders behavior. The self index is -1 if the
+// local relayer is not part of the set. The relayers on probation keep their place in the schedule but skip their slots
+type TopologyInfo struct {
+	PublicKeys                 []string     `json:"publicKeys"`
+	SelfAddress                string       `json:"selfAddress"`
+	SelfIndex                  int          `json:"selfIndex"`
+	Strategy                   string       `json:"strategy"`
+	IntervalForLeaderInSeconds int64        `json:"intervalForLeaderInSeconds"`
+	CurrentSlot                uint64       `json:"currentSlot"`
+	Schedule                   []LeaderSlot `json:"schedule"`
+	OnProbation                []string     `json:"onProbation,omitempty"`
+	SelfOnProbation            bool         `json:"selfOnProbation"`
+}
@@ -0,0 +1,27 @@
+package core
+
+// TransferReceipt is the machine-readable proof that a deposit was bridged, generated by the relayer that executed the
+// batch on the destination chain. The amount and the fee are in the base units of the source token
+type TransferReceipt struct {
+	Direction         string `json:"direction"`
+	BatchID           uint64 `json:"batchId"`
+	DepositNonce      uint64 `json:"depositNonce"`
+	SourceTxHash      string `json:"sourceTxHash"`
+	DestinationTxHash string `json:"destinationTxHash"`
+	From              string `json:"from"`
+	To                string `json:"to"`
+	Token             string `json:"token"`
+	Amount            string `json:"amount"`
+	Fee               string `json:"fee"`
+	Status            string `json:"status"`
+	Timestamp         int64  `json:"timestamp"`
+}
+
+// SignedTransferReceipt is the transfer receipt endorsed with the MultiversX key of the relayer that generated it. The
+// signature covers the JSON encoding of the receipt
+type SignedTransferReceipt struct {
+	Receipt   *TransferReceipt `json:"receipt"`
+	Relayer   string           `json:"relayer"`
+	PublicKey string           `json:"publicKey"`
+	Signature string           `json:"signature"`
+}
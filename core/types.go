@@ -3,6 +3,10 @@ package core
 import (
 	"context"
 	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 // StepIdentifier defines a step name
@@ -18,12 +22,40 @@ type Step interface {
 	IsInterfaceNil() bool
 }
 
+// StepHook defines a component notified around each state machine step execution. It can be used for custom
+// telemetry, policy checks or integrations without altering the steps themselves. The calls are blocking so
+// the implementations should return fast
+type StepHook interface {
+	BeforeStep(ctx context.Context, stateMachineName string, step StepIdentifier)
+	AfterStep(ctx context.Context, stateMachineName string, step StepIdentifier, nextStep StepIdentifier, duration time.Duration)
+	OnError(stateMachineName string, step StepIdentifier, err error)
+	IsInterfaceNil() bool
+}
+
+// PanicHook is an optional extension of the StepHook, notified when a state machine step panics. The panic is
+// propagated after all the panic hooks were notified
+type PanicHook interface {
+	OnPanic(stateMachineName string, step StepIdentifier, recovered interface{}, stack []byte)
+}
+
+// ResumableState defines the state of a state machine executor (the stored batch, the action ID, the message hash)
+// that is persisted after each step, so a restarted relayer can resume the flow from the last executed step
+type ResumableState interface {
+	SaveState() ([]byte, error)
+	RestoreState(buff []byte) error
+	IsInterfaceNil() bool
+}
+
 // EthGasPriceSelector defines the ethereum gas price selector
 type EthGasPriceSelector string
 
-// Timer defines operations related to time
+// Timer defines operations related to time. The waits are done through it, so the components can be tested without
+// actually waiting
 type Timer interface {
+	Now() time.Time
 	NowUnix() int64
+	After(duration time.Duration) <-chan time.Time
+	Sleep(duration time.Duration)
 	Start()
 	Close() error
 	IsInterfaceNil() bool
@@ -47,6 +79,20 @@ type BroadcastClient interface {
 	IsInterfaceNil() bool
 }
 
+// PreAgreementClient defines a client notified by the broadcaster about the pre-agreement messages sent by the
+// whitelisted relayers
+type PreAgreementClient interface {
+	ProcessPreAgreementMessage(msg *SignedMessage)
+	IsInterfaceNil() bool
+}
+
+// DirectMessageClient defines a client notified by the broadcaster about the direct messages sent to this relayer by
+// the whitelisted relayers
+type DirectMessageClient interface {
+	ProcessDirectMessage(msg *SignedMessage)
+	IsInterfaceNil() bool
+}
+
 // StatusHandler is able to keep metrics
 type StatusHandler interface {
 	SetIntMetric(metric string, value int)
@@ -65,6 +111,111 @@ type MetricsHolder interface {
 	IsInterfaceNil() bool
 }
 
+// MetricsExporter defines the component exporting the metrics of all the status handlers in an external format
+type MetricsExporter interface {
+	Export() []byte
+	IsInterfaceNil() bool
+}
+
+// LoggersRegistry defines the component able to list the loggers and change their levels at runtime
+type LoggersRegistry interface {
+	Loggers() []LoggerInfo
+	SetLoggerLevel(identifier string, level string) error
+	IsInterfaceNil() bool
+}
+
+// ConfigDriftAuditor defines the component able to compare the running config with the config file
+type ConfigDriftAuditor interface {
+	GetConfigDrift() (*ConfigDriftReport, error)
+	IsInterfaceNil() bool
+}
+
+// BatchResultsHolder defines the component able to return the per-deposit results and the signed transfer receipts of
+// the executed batches
+type BatchResultsHolder interface {
+	GetBatchResults(batchID uint64) (*BatchResults, error)
+	GetTransferReceipts(direction string, batchID uint64) ([]*SignedTransferReceipt, error)
+	IsInterfaceNil() bool
+}
+
+// TopologyInfoHolder defines a component able to export the relayers set topology of each bridge direction
+type TopologyInfoHolder interface {
+	GetTopologyInfo(numSlots int) map[string]*TopologyInfo
+	IsInterfaceNil() bool
+}
+
+// SyncReportHolder defines the component able to provide the sync report produced at startup
+type SyncReportHolder interface {
+	GetSyncReport() *SyncReport
+	IsInterfaceNil() bool
+}
+
+// BalanceProofProvider defines the component able to compute the view of the bridge reserves
+type BalanceProofProvider interface {
+	GetBalanceProof(ctx context.Context) (*BalanceProof, error)
+	IsInterfaceNil() bool
+}
+
+// FeeEstimator defines the component able to quote the deposit of a token amount before it is made
+type FeeEstimator interface {
+	EstimateFee(ctx context.Context, token string, amount *big.Int) (*FeeEstimate, error)
+	IsInterfaceNil() bool
+}
+
+// IncidentsHolder defines the component recording the incidents that halted the bridge processing and their
+// acknowledgments
+type IncidentsHolder interface {
+	GetIncidents() []*Incident
+	AcknowledgeIncident(id uint64, acknowledgment IncidentAcknowledgment) (*Incident, error)
+	IsInterfaceNil() bool
+}
+
+// DeadLettersHolder defines the component recording the deposits that repeatedly failed to be validated or executed
+// and their resolutions
+type DeadLettersHolder interface {
+	GetDeadLetters() []*DeadLetter
+	ResolveDeadLetter(direction string, depositNonce uint64, resolution DeadLetterResolution) (*DeadLetter, error)
+	IsInterfaceNil() bool
+}
+
+// DirectMessagesHolder defines the component able to send direct coordination messages to another relayer and to
+// return the last messages sent and received
+type DirectMessagesHolder interface {
+	GetDirectMessages() []*DirectMessage
+	SendDirectMessage(to string, kind string, text string, batchID uint64) (*DirectMessage, error)
+	IsInterfaceNil() bool
+}
+
+// OperatorPauseHandler defines a component able to suspend and resume, at the request of an operator, the processing
+// of each half-bridge
+type OperatorPauseHandler interface {
+	PauseHalfBridge(halfBridge string, remoteAddress string) (*HalfBridgePause, error)
+	ResumeHalfBridge(halfBridge string, remoteAddress string) (*HalfBridgePause, error)
+	GetHalfBridgePauses() []*HalfBridgePause
+	IsInterfaceNil() bool
+}
+
+// BatchExplorer defines a component able to return the live view of the batches processed by the relayer
+type BatchExplorer interface {
+	GetPendingBatches() []*BatchLiveView
+	GetBatchLiveViews(batchID uint64) []*BatchLiveView
+	GetDepositLiveViews(nonce uint64) []*BatchLiveView
+	IsInterfaceNil() bool
+}
+
+// ExportedTransactionsHolder defines a component able to return the last signed transactions exported instead of
+// being broadcast
+type ExportedTransactionsHolder interface {
+	GetExportedTransactions() []*ExportedTransaction
+	IsInterfaceNil() bool
+}
+
+// RelayedClaimsHandler defines a component able to relay the claim transactions signed by the users, paying their gas
+type RelayedClaimsHandler interface {
+	SubmitClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error)
+	IsInterfaceNil() bool
+}
+
 // Storer defines a component able to store and load data
 type Storer interface {
 	Put(key, data []byte) error
@@ -73,6 +224,12 @@ type Storer interface {
 	IsInterfaceNil() bool
 }
 
+// RemovableStorer defines a storer able to also remove the saved keys
+type RemovableStorer interface {
+	Storer
+	Remove(key []byte) error
+}
+
 // GeneralMetrics represents an objects metrics map
 type GeneralMetrics map[string]interface{}
 
@@ -0,0 +1,42 @@
+package errorReporting
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+type dsn struct {
+	storeURL  string
+	publicKey string
+}
+
+// parseDSN parses a Sentry DSN ({scheme}://{public key}@{host}{path}/{project ID}) and returns the store endpoint
+// together with the public key used in the authentication header
+func parseDSN(value string) (*dsn, error) {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidDSN, err.Error())
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("%w: unsupported scheme %q", ErrInvalidDSN, parsed.Scheme)
+	}
+	if parsed.User == nil || len(parsed.User.Username()) == 0 {
+		return nil, fmt.Errorf("%w: missing public key", ErrInvalidDSN)
+	}
+	if len(parsed.Host) == 0 {
+		return nil, fmt.Errorf("%w: missing host", ErrInvalidDSN)
+	}
+
+	path := strings.TrimSuffix(parsed.Path, "/")
+	lastSlash := strings.LastIndex(path, "/")
+	projectID := path[lastSlash+1:]
+	if lastSlash < 0 || len(projectID) == 0 {
+		return nil, fmt.Errorf("%w: missing project ID", ErrInvalidDSN)
+	}
+
+	return &dsn{
+		storeURL:  fmt.Sprintf("%s://%s%s/api/%s/store/", parsed.Scheme, parsed.Host, path[:lastSlash], projectID),
+		publicKey: parsed.User.Username(),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package errorReporting
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDSN(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid DSNs should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidDSNs := []string{
+			"",
+			"ftp://key@sentry.example.com/1",
+			"https://sentry.example.com/1",
+			"https://key@/1",
+			"https://key@sentry.example.com",
+			"https://key@sentry.example.com/",
+		}
+		for _, value := range invalidDSNs {
+			parsed, err := parseDSN(value)
+			assert.Nil(t, parsed, value)
+			assert.True(t, errors.Is(err, ErrInvalidDSN), value)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parseDSN("https://public@sentry.example.com/42")
+		assert.Nil(t, err)
+		assert.Equal(t, "https://sentry.example.com/api/42/store/", parsed.storeURL)
+		assert.Equal(t, "public", parsed.publicKey)
+
+		parsed, err = parseDSN("http://public@localhost:9000/sentry/7")
+		assert.Nil(t, err)
+		assert.Equal(t, "http://localhost:9000/sentry/api/7/store/", parsed.storeURL)
+	})
+}
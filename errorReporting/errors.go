@@ -0,0 +1,14 @@
+package errorReporting
+
+import "errors"
+
+// ErrInvalidDSN signals that an invalid DSN was provided
+var ErrInvalidDSN = errors.New("invalid DSN")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout was provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidQueueSize signals that an invalid queue size was provided
+var ErrInvalidQueueSize = errors.New("invalid queue size")
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
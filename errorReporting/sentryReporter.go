@@ -0,0 +1,278 @@
+package errorReporting
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minRequestTimeout = time.Second
+	sentryVersion     = 7
+	sentryClient      = "mx-bridge-eth-go"
+	sentryPlatform    = "go"
+	sentryLogger      = "relayer"
+	authHeader        = "X-Sentry-Auth"
+	maxTagKeyLength   = 32
+	maxTagValueLength = 200
+	eventIDLength     = 16
+	directionTag      = "direction"
+	stepTag           = "step"
+)
+
+var log = logger.GetOrCreate("errorReporting")
+
+// ArgsSentryReporter is the DTO used to create a new Sentry reporter
+type ArgsSentryReporter struct {
+	DSN            string
+	Release        string
+	Environment    string
+	ServerName     string
+	RequestTimeout time.Duration
+	QueueSize      int
+}
+
+type sentryReporter struct {
+	dsn            *dsn
+	release        string
+	environment    string
+	serverName     string
+	requestTimeout time.Duration
+	httpClient     *http.Client
+
+	mutQueue sync.Mutex
+	chEvents chan *event
+	isClosed bool
+	chDone   chan struct{}
+
+	mutSteps     sync.RWMutex
+	currentSteps map[string]core.StepIdentifier
+}
+
+// NewSentryReporter creates an error reporter that sends the critical errors and the state machine panics to a
+// Sentry-compatible store endpoint. The errors are queued and sent on a separate go routine, the ones exceeding the
+// queue size are dropped. The panics are sent synchronously, as the process is about to crash.
+// The reporter is also a state machine hook, so it can add the current step of each direction to the reported errors
+func NewSentryReporter(args ArgsSentryReporter) (*sentryReporter, error) {
+	parsedDSN, err := parseDSN(args.DSN)
+	if err != nil {
+		return nil, err
+	}
+	if args.RequestTimeout < minRequestTimeout {
+		return nil, fmt.Errorf("%w: %v, minimum: %v", ErrInvalidRequestTimeout, args.RequestTimeout, minRequestTimeout)
+	}
+	if args.QueueSize < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidQueueSize, args.QueueSize)
+	}
+
+	reporter := &sentryReporter{
+		dsn:            parsedDSN,
+		release:        args.Release,
+		environment:    args.Environment,
+		serverName:     args.ServerName,
+		requestTimeout: args.RequestTimeout,
+		httpClient:     &http.Client{Timeout: args.RequestTimeout},
+		chEvents:       make(chan *event, args.QueueSize),
+		chDone:         make(chan struct{}),
+		currentSteps:   make(map[string]core.StepIdentifier),
+	}
+	go reporter.processLoop()
+
+	return reporter, nil
+}
+
+// ReportError queues the error for sending. It never blocks
+func (reporter *sentryReporter) ReportError(direction string, message string, tags map[string]string) {
+	ev := reporter.createEvent(levelError, direction, message, tags)
+
+	reporter.mutQueue.Lock()
+	defer reporter.mutQueue.Unlock()
+
+	if reporter.isClosed {
+		return
+	}
+
+	select {
+	case reporter.chEvents <- ev:
+	default:
+		log.Warn("sentryReporter: queue full, error report dropped", "direction", direction, "message", message)
+	}
+}
+
+// BeforeStep records the current step of the state machine
+func (reporter *sentryReporter) BeforeStep(_ context.Context, stateMachineName string, step core.StepIdentifier) {
+	reporter.mutSteps.Lock()
+	reporter.currentSteps[stateMachineName] = step
+	reporter.mutSteps.Unlock()
+}
+
+// AfterStep does nothing
+func (reporter *sentryReporter) AfterStep(_ context.Context, _ string, _ core.StepIdentifier, _ core.StepIdentifier, _ time.Duration) {
+}
+
+// OnError reports the state machine error
+func (reporter *sentryReporter) OnError(stateMachineName string, step core.StepIdentifier, err error) {
+	reporter.ReportError(stateMachineName, "state machine error", map[string]string{
+		stepTag: string(step),
+		"error": err.Error(),
+	})
+}
+
+// OnPanic sends the panic synchronously, together with the stack trace
+func (reporter *sentryReporter) OnPanic(stateMachineName string, step core.StepIdentifier, recovered interface{}, stack []byte) {
+	value := fmt.Sprintf("%v", recovered)
+	ev := reporter.createEvent(levelFatal, stateMachineName, "panic: "+value, map[string]string{
+		stepTag: string(step),
+	})
+	ev.Exception = &exceptions{
+		Values: []exception{
+			{
+				Type:  "panic",
+				Value: value,
+			},
+		},
+	}
+	ev.Extra["stack"] = string(stack)
+
+	err := reporter.send(ev)
+	if err != nil {
+		log.Error("sentryReporter: can not report the panic", "error", err)
+	}
+}
+
+func (reporter *sentryReporter) createEvent(level string, direction string, message string, tags map[string]string) *event {
+	ev := &event{
+		EventID:     newEventID(),
+		Timestamp:   time.Now().Unix(),
+		Level:       level,
+		Logger:      sentryLogger,
+		Platform:    sentryPlatform,
+		Message:     message,
+		Release:     reporter.release,
+		Environment: reporter.environment,
+		ServerName:  reporter.serverName,
+		Tags:        make(map[string]string),
+		Extra:       make(map[string]interface{}),
+	}
+
+	for key, value := range tags {
+		ev.Tags[sanitizeTagKey(key)] = truncate(value, maxTagValueLength)
+		ev.Extra[key] = value
+	}
+	ev.Tags[directionTag] = direction
+
+	_, hasStep := ev.Tags[stepTag]
+	if !hasStep {
+		reporter.mutSteps.RLock()
+		step, found := reporter.currentSteps[direction]
+		reporter.mutSteps.RUnlock()
+		if found {
+			ev.Tags[stepTag] = string(step)
+		}
+	}
+
+	return ev
+}
+
+func (reporter *sentryReporter) processLoop() {
+	defer close(reporter.chDone)
+
+	for ev := range reporter.chEvents {
+		err := reporter.send(ev)
+		if err != nil {
+			log.Debug("sentryReporter: can not report the error", "message", ev.Message, "error", err)
+		}
+	}
+}
+
+func (reporter *sentryReporter) send(ev *event) error {
+	buff, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), reporter.requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, reporter.dsn.storeURL, bytes.NewReader(buff))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(authHeader, fmt.Sprintf("Sentry sentry_version=%d, sentry_client=%s/%s, sentry_key=%s",
+		sentryVersion, sentryClient, reporter.release, reporter.dsn.publicKey))
+
+	response, err := reporter.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %d", errUnexpectedStatusCode, response.StatusCode)
+	}
+
+	return nil
+}
+
+func newEventID() string {
+	buff := make([]byte, eventIDLength)
+	_, _ = rand.Read(buff)
+
+	return hex.EncodeToString(buff)
+}
+
+// sanitizeTagKey converts the key to the characters allowed by Sentry in the tag keys
+func sanitizeTagKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	key = strings.Map(func(r rune) rune {
+		isAllowed := (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '.' || r == ':' || r == '-'
+		if isAllowed {
+			return r
+		}
+		return '_'
+	}, key)
+
+	return truncate(key, maxTagKeyLength)
+}
+
+func truncate(value string, maxLength int) string {
+	if len(value) <= maxLength {
+		return value
+	}
+
+	return value[:maxLength]
+}
+
+// Close stops accepting new errors and sends the queued ones, waiting at most the request timeout for each of them
+func (reporter *sentryReporter) Close() error {
+	reporter.mutQueue.Lock()
+	if reporter.isClosed {
+		reporter.mutQueue.Unlock()
+		return nil
+	}
+	reporter.isClosed = true
+	close(reporter.chEvents)
+	reporter.mutQueue.Unlock()
+
+	<-reporter.chDone
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reporter *sentryReporter) IsInterfaceNil() bool {
+	return reporter == nil
+}
@@ -0,0 +1,189 @@
+package errorReporting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type receivedEvents struct {
+	mut    sync.Mutex
+	events []*event
+}
+
+func (received *receivedEvents) get() []*event {
+	received.mut.Lock()
+	defer received.mut.Unlock()
+
+	return append(make([]*event, 0, len(received.events)), received.events...)
+}
+
+func createTestServer(t *testing.T, received *receivedEvents) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		assert.Equal(t, "/api/1/store/", req.URL.Path)
+		assert.True(t, strings.Contains(req.Header.Get(authHeader), "sentry_key=public"))
+
+		ev := &event{}
+		err := json.NewDecoder(req.Body).Decode(ev)
+		require.Nil(t, err)
+
+		received.mut.Lock()
+		received.events = append(received.events, ev)
+		received.mut.Unlock()
+	}))
+}
+
+func createMockArgsSentryReporter(serverURL string) ArgsSentryReporter {
+	return ArgsSentryReporter{
+		DSN:            strings.Replace(serverURL, "http://", "http://public@", 1) + "/1",
+		Release:        "v1.0.0",
+		Environment:    "test",
+		ServerName:     "relayer-0",
+		RequestTimeout: time.Second,
+		QueueSize:      10,
+	}
+}
+
+func TestNewSentryReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid DSN should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSentryReporter("http://localhost")
+		args.DSN = "invalid"
+
+		reporter, err := NewSentryReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.True(t, errors.Is(err, ErrInvalidDSN))
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSentryReporter("http://localhost")
+		args.RequestTimeout = minRequestTimeout - 1
+
+		reporter, err := NewSentryReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.True(t, errors.Is(err, ErrInvalidRequestTimeout))
+	})
+	t.Run("invalid queue size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSentryReporter("http://localhost")
+		args.QueueSize = 0
+
+		reporter, err := NewSentryReporter(args)
+		assert.True(t, check.IfNil(reporter))
+		assert.True(t, errors.Is(err, ErrInvalidQueueSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		reporter, err := NewSentryReporter(createMockArgsSentryReporter("http://localhost"))
+		assert.False(t, check.IfNil(reporter))
+		assert.Nil(t, err)
+		assert.Nil(t, reporter.Close())
+	})
+}
+
+func TestSentryReporter_ReportErrorShouldSendTheBridgeContext(t *testing.T) {
+	t.Parallel()
+
+	received := &receivedEvents{}
+	server := createTestServer(t, received)
+	defer server.Close()
+
+	reporter, _ := NewSentryReporter(createMockArgsSentryReporter(server.URL))
+	reporter.BeforeStep(context.Background(), "EthToMultiversX", "sign proposed transfer")
+	reporter.ReportError("EthToMultiversX", "error signing", map[string]string{
+		"batch ID": "37",
+		"error":    "expected error",
+	})
+	assert.Nil(t, reporter.Close())
+
+	events := received.get()
+	require.Equal(t, 1, len(events))
+	ev := events[0]
+	assert.Equal(t, levelError, ev.Level)
+	assert.Equal(t, "error signing", ev.Message)
+	assert.Equal(t, "v1.0.0", ev.Release)
+	assert.Equal(t, "test", ev.Environment)
+	assert.Equal(t, "relayer-0", ev.ServerName)
+	assert.Equal(t, 32, len(ev.EventID))
+	assert.Equal(t, "EthToMultiversX", ev.Tags[directionTag])
+	assert.Equal(t, "sign proposed transfer", ev.Tags[stepTag])
+	assert.Equal(t, "37", ev.Tags["batch_id"])
+	assert.Equal(t, "expected error", ev.Tags["error"])
+}
+
+func TestSentryReporter_OnErrorShouldReport(t *testing.T) {
+	t.Parallel()
+
+	received := &receivedEvents{}
+	server := createTestServer(t, received)
+	defer server.Close()
+
+	reporter, _ := NewSentryReporter(createMockArgsSentryReporter(server.URL))
+	reporter.OnError("MultiversXToEth", "step0", errors.New("step not found"))
+	assert.Nil(t, reporter.Close())
+
+	events := received.get()
+	require.Equal(t, 1, len(events))
+	assert.Equal(t, "step0", events[0].Tags[stepTag])
+	assert.Equal(t, "step not found", events[0].Tags["error"])
+}
+
+func TestSentryReporter_OnPanicShouldSendSynchronously(t *testing.T) {
+	t.Parallel()
+
+	received := &receivedEvents{}
+	server := createTestServer(t, received)
+	defer server.Close()
+
+	reporter, _ := NewSentryReporter(createMockArgsSentryReporter(server.URL))
+	defer func() {
+		_ = reporter.Close()
+	}()
+
+	reporter.OnPanic("EthToMultiversX", "step0", "nil pointer dereference", []byte("stack trace"))
+
+	events := received.get()
+	require.Equal(t, 1, len(events))
+	ev := events[0]
+	assert.Equal(t, levelFatal, ev.Level)
+	assert.Equal(t, "step0", ev.Tags[stepTag])
+	require.NotNil(t, ev.Exception)
+	assert.Equal(t, "nil pointer dereference", ev.Exception.Values[0].Value)
+	assert.Equal(t, "stack trace", ev.Extra["stack"])
+}
+
+func TestSentryReporter_ReportErrorAfterCloseShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	reporter, _ := NewSentryReporter(createMockArgsSentryReporter("http://localhost"))
+	assert.Nil(t, reporter.Close())
+	assert.Nil(t, reporter.Close())
+
+	assert.NotPanics(t, func() {
+		reporter.ReportError("EthToMultiversX", "message", nil)
+	})
+}
+
+func TestSanitizeTagKey(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "batch_id", sanitizeTagKey("Batch ID"))
+	assert.Equal(t, "tx.hash:0-1", sanitizeTagKey("tx.hash:0-1"))
+	assert.Equal(t, maxTagKeyLength, len(sanitizeTagKey(strings.Repeat("a", 100))))
+}
@@ -0,0 +1,31 @@
+package errorReporting
+
+const (
+	levelError = "error"
+	levelFatal = "fatal"
+)
+
+type exception struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type exceptions struct {
+	Values []exception `json:"values"`
+}
+
+// event is the subset of the Sentry event payload used by the relayer
+type event struct {
+	EventID     string                 `json:"event_id"`
+	Timestamp   int64                  `json:"timestamp"`
+	Level       string                 `json:"level"`
+	Logger      string                 `json:"logger"`
+	Platform    string                 `json:"platform"`
+	Message     string                 `json:"message"`
+	Release     string                 `json:"release,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	ServerName  string                 `json:"server_name,omitempty"`
+	Exception   *exceptions            `json:"exception,omitempty"`
+	Tags        map[string]string      `json:"tags,omitempty"`
+	Extra       map[string]interface{} `json:"extra,omitempty"`
+}
@@ -8,6 +8,7 @@ var (
 	errNilErc20ContractsHolder       = errors.New("nil ERC20 contracts holder")
 	errWrongERC20AddressResponse     = errors.New("wrong ERC20 address response")
 	errNilLogger                     = errors.New("nil logger")
+	errNilTimer                      = errors.New("nil timer")
 	errNilCryptoHandler              = errors.New("nil crypto handler")
 	errNilEthereumChainWrapper       = errors.New("nil Ethereum chain wrapper")
 	errQuorumNotReached              = errors.New("quorum not reached")
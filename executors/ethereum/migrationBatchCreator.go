@@ -9,6 +9,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -25,6 +26,7 @@ type ArgsMigrationBatchCreator struct {
 	SafeContractAddress  common.Address
 	EthereumChainWrapper EthereumChainWrapper
 	Logger               logger.Logger
+	Timer                core.Timer
 }
 
 type migrationBatchCreator struct {
@@ -33,6 +35,7 @@ type migrationBatchCreator struct {
 	safeContractAddress  common.Address
 	ethereumChainWrapper EthereumChainWrapper
 	logger               logger.Logger
+	timer                core.Timer
 }
 
 // NewMigrationBatchCreator creates a new instance of type migrationBatchCreator that is able to generate the migration batch output file
@@ -49,6 +52,9 @@ func NewMigrationBatchCreator(args ArgsMigrationBatchCreator) (*migrationBatchCr
 	if check.IfNil(args.Logger) {
 		return nil, errNilLogger
 	}
+	if check.IfNil(args.Timer) {
+		return nil, errNilTimer
+	}
 
 	return &migrationBatchCreator{
 		mvxDataGetter:        args.MvxDataGetter,
@@ -56,6 +62,7 @@ func NewMigrationBatchCreator(args ArgsMigrationBatchCreator) (*migrationBatchCr
 		safeContractAddress:  args.SafeContractAddress,
 		logger:               args.Logger,
 		ethereumChainWrapper: args.EthereumChainWrapper,
+		timer:                args.Timer,
 	}, nil
 }
 
@@ -165,7 +172,7 @@ func (creator *migrationBatchCreator) checkAvailableBatch(
 		return nil
 	}
 
-	time.Sleep(timeBetweenChecks)
+	creator.timer.Sleep(timeBetweenChecks)
 	wasExecuted, err := creator.ethereumChainWrapper.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(batchID))
 	if err != nil {
 		return err
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -46,6 +47,7 @@ func createMockArgsForMigrationBatchCreator() ArgsMigrationBatchCreator {
 		SafeContractAddress:  safeContractAddress,
 		Logger:               &testscommon.LoggerStub{},
 		EthereumChainWrapper: &bridge.EthereumClientWrapperStub{},
+		Timer:                &testsCommon.TimerMock{},
 	}
 }
 
@@ -82,6 +84,16 @@ func TestNewMigrationBatchCreator(t *testing.T) {
 		assert.Nil(t, creator)
 		assert.Equal(t, errNilLogger, err)
 	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsForMigrationBatchCreator()
+		args.Timer = nil
+
+		creator, err := NewMigrationBatchCreator(args)
+		assert.Nil(t, creator)
+		assert.Equal(t, errNilTimer, err)
+	})
 	t.Run("nil Ethereum chain wrapper should error", func(t *testing.T) {
 		t.Parallel()
 
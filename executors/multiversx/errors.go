@@ -15,4 +15,9 @@ var (
 	errNilCloseAppChannel                = errors.New("nil close application channel")
 	errTransactionFailed                 = errors.New("transaction failed")
 	errGasLimitIsLessThanAbsoluteMinimum = errors.New("provided gas limit is less than absolute minimum required")
+	errNilWebhookNotifier                = errors.New("nil webhook notifier")
+	errNilTransferTagger                 = errors.New("nil transfer tagger")
+	errNilStatusHandler                  = errors.New("nil status handler")
+	errNilTimer                          = errors.New("nil timer")
+	errEmptyScProxyAddresses             = errors.New("empty SC proxy addresses")
 )
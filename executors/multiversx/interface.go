@@ -2,7 +2,9 @@ package multiversx
 
 import (
 	"context"
+	"math/big"
 
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -45,3 +47,16 @@ type Codec interface {
 	ExtractGasLimitFromRawCallData(buff []byte) (uint64, error)
 	IsInterfaceNil() bool
 }
+
+// WebhookNotifier defines the operations supported by a component able to notify the SC call execution results
+type WebhookNotifier interface {
+	IsEnabled() bool
+	Notify(notification *bridgeCore.ScCallExecutionNotification)
+	IsInterfaceNil() bool
+}
+
+// TransferTagger defines the component computing the operator-defined tags of a bridged transfer
+type TransferTagger interface {
+	TagTransfer(from string, to string, token string, amount *big.Int) []string
+	IsInterfaceNil() bool
+}
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/core"
 )
@@ -25,3 +26,10 @@ type executor interface {
 	GetNumSentTransaction() uint32
 	IsInterfaceNil() bool
 }
+
+type webhookNotifier interface {
+	IsEnabled() bool
+	Notify(notification *bridgeCore.ScCallExecutionNotification)
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -1,11 +1,19 @@
 package module
 
 import (
+	"bytes"
+	"os"
 	"time"
 
+	bridgeDisabled "github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchTags"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/timer"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/filters"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/webhook"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/webhook/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
@@ -26,10 +34,17 @@ type scCallsModule struct {
 	nonceTxsHandler  nonceTransactionsHandler
 	pollingHandler   pollingHandler
 	executorInstance executor
+	webhookNotifier  webhookNotifier
+	timer            core.Timer
 }
 
-// NewScCallsModule creates a starts a new scCallsModule instance
-func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chCloseApp chan struct{}) (*scCallsModule, error) {
+// NewScCallsModule creates a starts a new scCallsModule instance. The execution metrics are set in the provided status handler
+func NewScCallsModule(
+	cfg config.ScCallsModuleConfig,
+	log logger.Logger,
+	chCloseApp chan struct{},
+	statusHandler core.StatusHandler,
+) (*scCallsModule, error) {
 	filter, err := filters.NewPendingOperationFilter(cfg.Filter, log)
 	if err != nil {
 		return nil, err
@@ -50,7 +65,9 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		return nil, err
 	}
 
-	module := &scCallsModule{}
+	module := &scCallsModule{
+		timer: timer.NewNTPTimer(),
+	}
 
 	argNonceHandler := nonceHandlerV2.ArgsNonceTransactionsHandlerV2{
 		Proxy:            proxy,
@@ -72,8 +89,18 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		return nil, err
 	}
 
+	module.webhookNotifier, err = createWebhookNotifier(cfg.Webhook, log)
+	if err != nil {
+		return nil, err
+	}
+
+	transferTagger, err := createTransferTagger(cfg.BatchTags)
+	if err != nil {
+		return nil, err
+	}
+
 	argsExecutor := multiversx.ArgsScCallExecutor{
-		ScProxyBech32Address:            cfg.ScProxyBech32Address,
+		ScProxyBech32Addresses:          getScProxyAddresses(cfg),
 		Proxy:                           proxy,
 		Codec:                           &parsers.MultiversxCodec{},
 		Filter:                          filter,
@@ -81,11 +108,16 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		ExtraGasToExecute:               cfg.ExtraGasToExecute,
 		MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
 		GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
+		MaxConcurrentExecutions:         cfg.MaxConcurrentExecutions,
 		NonceTxHandler:                  module.nonceTxsHandler,
 		PrivateKey:                      privateKey,
 		SingleSigner:                    singleSigner,
 		CloseAppChan:                    chCloseApp,
 		TransactionChecks:               cfg.TransactionChecks,
+		WebhookNotifier:                 module.webhookNotifier,
+		TransferTagger:                  transferTagger,
+		StatusHandler:                   statusHandler,
+		Timer:                           module.timer,
 	}
 	module.executorInstance, err = multiversx.NewScCallExecutor(argsExecutor)
 	if err != nil {
@@ -113,6 +145,58 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 	return module, nil
 }
 
+// getScProxyAddresses returns the SC proxy addresses served by this module, without duplicates
+func getScProxyAddresses(cfg config.ScCallsModuleConfig) []string {
+	allAddresses := append([]string{cfg.ScProxyBech32Address}, cfg.ScProxyBech32Addresses...)
+	addresses := make([]string, 0, len(allAddresses))
+	seen := make(map[string]struct{})
+	for _, address := range allAddresses {
+		_, found := seen[address]
+		if len(address) == 0 || found {
+			continue
+		}
+
+		seen[address] = struct{}{}
+		addresses = append(addresses, address)
+	}
+
+	return addresses
+}
+
+func createWebhookNotifier(cfg config.WebhookConfig, log logger.Logger) (webhookNotifier, error) {
+	if !cfg.Enabled {
+		return &disabled.DisabledWebhookNotifier{}, nil
+	}
+
+	secretKey, err := os.ReadFile(cfg.SecretKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	argsWebhookNotifier := webhook.ArgsWebhookNotifier{
+		Log:            log,
+		URL:            cfg.URL,
+		SecretKey:      bytes.TrimSpace(secretKey),
+		RequestTimeout: time.Second * time.Duration(cfg.RequestTimeoutInSeconds),
+		QueueSize:      cfg.QueueSize,
+	}
+
+	return webhook.NewWebhookNotifier(argsWebhookNotifier)
+}
+
+func createTransferTagger(cfg config.BatchTagsConfig) (multiversx.TransferTagger, error) {
+	if !cfg.Enabled {
+		return bridgeDisabled.NewDisabledBatchTagger(), nil
+	}
+
+	argsBatchTagger := batchTags.ArgsBatchTagger{
+		StaticTags: cfg.StaticTags,
+		Rules:      cfg.Rules,
+	}
+
+	return batchTags.NewBatchTagger(argsBatchTagger)
+}
+
 // GetNumSentTransaction returns the total sent transactions
 func (module *scCallsModule) GetNumSentTransaction() uint32 {
 	return module.executorInstance.GetNumSentTransaction()
@@ -122,9 +206,17 @@ func (module *scCallsModule) GetNumSentTransaction() uint32 {
 func (module *scCallsModule) Close() error {
 	errPollingHandler := module.pollingHandler.Close()
 	errNonceTxsHandler := module.nonceTxsHandler.Close()
+	errWebhookNotifier := module.webhookNotifier.Close()
+	errTimer := module.timer.Close()
 
 	if errPollingHandler != nil {
 		return errPollingHandler
 	}
-	return errNonceTxsHandler
+	if errNonceTxsHandler != nil {
+		return errNonceTxsHandler
+	}
+	if errWebhookNotifier != nil {
+		return errWebhookNotifier
+	}
+	return errTimer
 }
@@ -3,7 +3,9 @@ package module
 import (
 	"testing"
 
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchTags"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/webhook"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +17,7 @@ func createTestConfigs() config.ScCallsModuleConfig {
 		ExtraGasToExecute:               6000000,
 		MaxGasLimitToUse:                249999999,
 		GasLimitForOutOfGasTransactions: 30000000,
+		MaxConcurrentExecutions:         1,
 		NetworkAddress:                  "http://127.0.0.1:8079",
 		ProxyMaxNoncesDelta:             5,
 		ProxyFinalityCheck:              false,
@@ -34,6 +37,16 @@ func createTestConfigs() config.ScCallsModuleConfig {
 	}
 }
 
+func createTestWebhookConfig() config.WebhookConfig {
+	return config.WebhookConfig{
+		Enabled:                 true,
+		URL:                     "http://127.0.0.1:8090/sc-calls",
+		SecretKeyFile:           "testdata/webhook.key",
+		RequestTimeoutInSeconds: 5,
+		QueueSize:               100,
+	}
+}
+
 func TestNewScCallsModule(t *testing.T) {
 	t.Parallel()
 
@@ -43,7 +56,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.Filter.DeniedTokens = []string{"*"}
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "unsupported marker * on item at index 0 in list DeniedTokens")
 		assert.Nil(t, module)
@@ -54,7 +67,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.ProxyCacherExpirationSeconds = 0
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid caching duration, provided: 0s, minimum: 1s")
 		assert.Nil(t, module)
@@ -65,7 +78,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.IntervalToResendTxsInSeconds = 0
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid value for intervalToResend in NewNonceTransactionHandlerV2")
 		assert.Nil(t, module)
@@ -76,7 +89,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.PrivateKeyFile = ""
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
 		assert.NotNil(t, err)
 		assert.Nil(t, module)
 	})
@@ -86,16 +99,60 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg := createTestConfigs()
 		cfg.PollingIntervalInMillis = 0
 
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "invalid value for PollingInterval")
 		assert.Nil(t, module)
 	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "nil status handler")
+		assert.Nil(t, module)
+	})
+	t.Run("invalid webhook secret key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Webhook = createTestWebhookConfig()
+		cfg.Webhook.SecretKeyFile = "testdata/missing.key"
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Nil(t, module)
+	})
+	t.Run("invalid webhook URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Webhook = createTestWebhookConfig()
+		cfg.Webhook.URL = ""
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
+		assert.ErrorIs(t, err, webhook.ErrInvalidURL)
+		assert.Nil(t, module)
+	})
+	t.Run("invalid batch tags should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.BatchTags = config.BatchTagsConfig{
+			Enabled: true,
+		}
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
+		assert.ErrorIs(t, err, batchTags.ErrNoTagsConfigured)
+		assert.Nil(t, module)
+	})
 	t.Run("should work with nil close app chan", func(t *testing.T) {
 		t.Parallel()
 
 		cfg := createTestConfigs()
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
 		assert.Nil(t, err)
 		assert.NotNil(t, module)
 
@@ -112,7 +169,7 @@ func TestNewScCallsModule(t *testing.T) {
 		cfg.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		cfg.TransactionChecks.ExecutionTimeoutInSeconds = 1
 		cfg.TransactionChecks.CloseAppOnError = true
-		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, make(chan struct{}, 1))
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, make(chan struct{}, 1), &testsCommon.StatusHandlerStub{})
 		assert.Nil(t, err)
 		assert.NotNil(t, module)
 
@@ -121,4 +178,56 @@ func TestNewScCallsModule(t *testing.T) {
 		err = module.Close()
 		assert.Nil(t, err)
 	})
+	t.Run("empty SC proxy addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.ScProxyBech32Address = ""
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "empty SC proxy addresses")
+		assert.Nil(t, module)
+	})
+	t.Run("should work with multiple SC proxy addresses", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.ScProxyBech32Addresses = []string{"erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"}
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should work with the webhook enabled", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Webhook = createTestWebhookConfig()
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil, &testsCommon.StatusHandlerStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+		assert.True(t, module.webhookNotifier.IsEnabled())
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetScProxyAddresses(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.ScCallsModuleConfig{}
+	assert.Empty(t, getScProxyAddresses(cfg))
+
+	cfg.ScProxyBech32Address = "address1"
+	assert.Equal(t, []string{"address1"}, getScProxyAddresses(cfg))
+
+	cfg.ScProxyBech32Addresses = []string{"address2", "address1", "", "address3", "address2"}
+	assert.Equal(t, []string{"address1", "address2", "address3"}, getScProxyAddresses(cfg))
+
+	cfg.ScProxyBech32Address = ""
+	assert.Equal(t, []string{"address2", "address1", "address3"}, getScProxyAddresses(cfg))
 }
@@ -0,0 +1,59 @@
+package multiversx
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type pendingOperation struct {
+	id       uint64
+	callData parsers.ProxySCCompleteCallData
+}
+
+// groupOperationsByDestination splits the pending operations by their destination contract. Each group is sorted by
+// the operations IDs and the groups are sorted by their first operation ID
+func groupOperationsByDestination(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) [][]pendingOperation {
+	groupsIndexes := make(map[string]int)
+	groups := make([][]pendingOperation, 0)
+	for _, id := range sortedOperationsIDs(pendingOperations) {
+		callData := pendingOperations[id]
+		destination := getDestination(callData)
+
+		index, found := groupsIndexes[destination]
+		if !found {
+			index = len(groups)
+			groupsIndexes[destination] = index
+			groups = append(groups, make([]pendingOperation, 0, 1))
+		}
+
+		groups[index] = append(groups[index], pendingOperation{
+			id:       id,
+			callData: callData,
+		})
+	}
+
+	return groups
+}
+
+func sortedOperationsIDs(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) []uint64 {
+	ids := make([]uint64, 0, len(pendingOperations))
+	for id := range pendingOperations {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return ids
+}
+
+func getDestination(callData parsers.ProxySCCompleteCallData) string {
+	if check.IfNil(callData.To) {
+		return ""
+	}
+
+	return string(callData.To.AddressBytes())
+}
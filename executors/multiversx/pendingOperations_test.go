@@ -0,0 +1,49 @@
+package multiversx
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupOperationsByDestination(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty pending operations should return empty groups", func(t *testing.T) {
+		t.Parallel()
+
+		groups := groupOperationsByDestination(make(map[uint64]parsers.ProxySCCompleteCallData))
+		assert.Empty(t, groups)
+	})
+	t.Run("should group by destination and sort by ID", func(t *testing.T) {
+		t.Parallel()
+
+		firstContract, _ := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqnf2w270lhxhlj57jvthxw4tqsunrwnq0anaqm4d4fn")
+		secondContract, _ := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e")
+		pendingOperations := map[uint64]parsers.ProxySCCompleteCallData{
+			7: {To: firstContract, Nonce: 7},
+			2: {To: secondContract, Nonce: 2},
+			5: {Nonce: 5},
+			3: {To: firstContract, Nonce: 3},
+			9: {To: secondContract, Nonce: 9},
+		}
+
+		groups := groupOperationsByDestination(pendingOperations)
+		expectedGroups := [][]pendingOperation{
+			{
+				{id: 2, callData: pendingOperations[2]},
+				{id: 9, callData: pendingOperations[9]},
+			},
+			{
+				{id: 3, callData: pendingOperations[3]},
+				{id: 7, callData: pendingOperations[7]},
+			},
+			{
+				{id: 5, callData: pendingOperations[5]},
+			},
+		}
+		assert.Equal(t, expectedGroups, groups)
+	})
+}
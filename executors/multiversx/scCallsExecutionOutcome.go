@@ -0,0 +1,172 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	okReturnDataPrefix    = "@6f6b"
+	returnDataSeparator   = "@"
+	signalErrorEvent      = "signalError"
+	internalVMErrorsEvent = "internalVMErrors"
+	errorMessageTopic     = 1
+)
+
+// processExecutionOutcome updates the metrics and notifies the webhook with the outcome of the SC call execution
+// transaction. The processed transaction is only fetched if its result was checked
+func (executor *scCallExecutor) processExecutionOutcome(
+	ctx context.Context,
+	scProxyBech32Address string,
+	id uint64,
+	callData parsers.ProxySCCompleteCallData,
+	hash string,
+	executionErr error,
+) {
+	status := getExecutionStatus(executor.checkTransactionResults, executionErr)
+
+	var txInfo *data.TransactionInfo
+	if status == bridgeCore.ScCallExecuted || status == bridgeCore.ScCallFailed {
+		txInfo = executor.fetchTransactionInfo(ctx, hash)
+	}
+
+	executor.updateMetrics(status, txInfo)
+	executor.notifyExecution(scProxyBech32Address, id, callData, hash, status, txInfo)
+}
+
+func getExecutionStatus(checkTransactionResults bool, executionErr error) bridgeCore.ScCallExecutionStatus {
+	switch {
+	case !checkTransactionResults:
+		return bridgeCore.ScCallSent
+	case executionErr == nil:
+		return bridgeCore.ScCallExecuted
+	case errors.Is(executionErr, errTransactionFailed):
+		return bridgeCore.ScCallFailed
+	default:
+		return bridgeCore.ScCallUnknown
+	}
+}
+
+func (executor *scCallExecutor) fetchTransactionInfo(ctx context.Context, hash string) *data.TransactionInfo {
+	txInfo, err := executor.proxy.GetTransactionInfoWithResults(ctx, hash)
+	if err != nil {
+		executor.log.Debug("scCallExecutor: can not fetch the transaction results", "hash", hash, "error", err)
+		return nil
+	}
+
+	return txInfo
+}
+
+func (executor *scCallExecutor) updateMetrics(status bridgeCore.ScCallExecutionStatus, txInfo *data.TransactionInfo) {
+	switch status {
+	case bridgeCore.ScCallExecuted:
+		executor.statusHandler.AddIntMetric(bridgeCore.MetricScCallsNumExecuted, 1)
+		executor.statusHandler.SetIntMetric(bridgeCore.MetricScCallsLastExecutedTimestamp, int(time.Now().Unix()))
+	case bridgeCore.ScCallFailed:
+		executor.statusHandler.AddIntMetric(bridgeCore.MetricScCallsNumFailed, 1)
+		executor.statusHandler.SetIntMetric(bridgeCore.MetricScCallsLastFailedTimestamp, int(time.Now().Unix()))
+	default:
+		return
+	}
+
+	if txInfo != nil {
+		executor.statusHandler.AddIntMetric(bridgeCore.MetricScCallsGasUsed, int(txInfo.Data.Transaction.GasUsed))
+	}
+}
+
+func (executor *scCallExecutor) notifyExecution(
+	scProxyBech32Address string,
+	id uint64,
+	callData parsers.ProxySCCompleteCallData,
+	hash string,
+	status bridgeCore.ScCallExecutionStatus,
+	txInfo *data.TransactionInfo,
+) {
+	if !executor.webhookNotifier.IsEnabled() {
+		return
+	}
+
+	from := callData.From.Hex()
+	to, _ := callData.To.AddressAsBech32String()
+	amount := "0"
+	if callData.Amount != nil {
+		amount = callData.Amount.String()
+	}
+
+	notification := &bridgeCore.ScCallExecutionNotification{
+		ScProxy:      scProxyBech32Address,
+		ID:           id,
+		TxHash:       hash,
+		Status:       status,
+		From:         from,
+		To:           to,
+		Token:        callData.Token,
+		Amount:       amount,
+		DepositNonce: callData.Nonce,
+		Timestamp:    time.Now().Unix(),
+		Tags:         executor.transferTagger.TagTransfer(from, to, callData.Token, callData.Amount),
+	}
+	if txInfo != nil {
+		notification.Result = decodeExecutionResult(txInfo)
+	}
+
+	executor.webhookNotifier.Notify(notification)
+}
+
+// decodeExecutionResult extracts the return data, the error message and the emitted events of a processed transaction
+func decodeExecutionResult(txInfo *data.TransactionInfo) *bridgeCore.ScCallExecutionResult {
+	result := &bridgeCore.ScCallExecutionResult{
+		ReturnData: make([]string, 0),
+		Events:     make([]string, 0),
+	}
+
+	tx := txInfo.Data.Transaction
+	events := make([]*transaction.Events, 0)
+	if tx.Logs != nil {
+		events = append(events, tx.Logs.Events...)
+	}
+	for _, scr := range tx.ScResults {
+		if scr == nil {
+			continue
+		}
+		if scr.Logs != nil {
+			events = append(events, scr.Logs.Events...)
+		}
+		if strings.HasPrefix(scr.Data, okReturnDataPrefix) {
+			result.ReturnData = append(result.ReturnData, splitReturnData(scr.Data[len(okReturnDataPrefix):])...)
+		}
+		if len(result.ErrorMessage) == 0 {
+			result.ErrorMessage = scr.ReturnMessage
+		}
+	}
+
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+
+		result.Events = append(result.Events, event.Identifier)
+		isErrorEvent := event.Identifier == signalErrorEvent || event.Identifier == internalVMErrorsEvent
+		if isErrorEvent && len(event.Topics) > errorMessageTopic {
+			result.ErrorMessage = string(event.Topics[errorMessageTopic])
+		}
+	}
+
+	return result
+}
+
+// splitReturnData returns the hex encoded values following the ok code, keeping the empty values on their positions
+func splitReturnData(returnData string) []string {
+	if len(returnData) == 0 {
+		return make([]string, 0)
+	}
+
+	return strings.Split(strings.TrimPrefix(returnData, returnDataSeparator), returnDataSeparator)
+}
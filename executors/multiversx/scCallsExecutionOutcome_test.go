@@ -0,0 +1,253 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTransactionInfo() *data.TransactionInfo {
+	txInfo := &data.TransactionInfo{}
+	txInfo.Data.Transaction.GasUsed = 1500000
+	txInfo.Data.Transaction.Logs = &transaction.ApiLogs{
+		Events: []*transaction.Events{
+			{
+				Identifier: "ESDTTransfer",
+			},
+			{
+				Identifier: signalErrorEvent,
+				Topics:     [][]byte{[]byte("address"), []byte("error signalled by smartcontract")},
+			},
+		},
+	}
+	txInfo.Data.Transaction.ScResults = []*transaction.ApiSmartContractResult{
+		nil,
+		{
+			Data: "@6f6b@0a@@01",
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Identifier: "completedTxEvent",
+					},
+				},
+			},
+		},
+	}
+
+	return txInfo
+}
+
+func TestScCallExecutor_processExecutionOutcome(t *testing.T) {
+	t.Parallel()
+
+	testHash := "test hash"
+	testScProxy := "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"
+	callData := createTestProxySCCompleteCallData("tkn")
+	t.Run("disabled notifier should only update the metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.Proxy = &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return createTestTransactionInfo(), nil
+			},
+		}
+		args.WebhookNotifier = &testsCommon.WebhookNotifierStub{
+			NotifyCalled: func(notification *bridgeCore.ScCallExecutionNotification) {
+				assert.Fail(t, "should have not called Notify")
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		executor, _ := NewScCallExecutor(args)
+
+		executor.processExecutionOutcome(context.Background(), testScProxy, 1, callData, testHash, nil)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumExecuted))
+		assert.Equal(t, 1500000, statusHandler.GetIntMetric(bridgeCore.MetricScCallsGasUsed))
+		assert.NotZero(t, statusHandler.GetIntMetric(bridgeCore.MetricScCallsLastExecutedTimestamp))
+		assert.Zero(t, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumFailed))
+	})
+	t.Run("transaction checks disabled should notify the sent transaction", func(t *testing.T) {
+		t.Parallel()
+
+		var notification *bridgeCore.ScCallExecutionNotification
+		args := createMockArgsScCallExecutor()
+		args.Proxy = &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				assert.Fail(t, "should have not called GetTransactionInfoWithResults")
+				return nil, nil
+			},
+		}
+		args.WebhookNotifier = &testsCommon.WebhookNotifierStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			NotifyCalled: func(n *bridgeCore.ScCallExecutionNotification) {
+				notification = n
+			},
+		}
+		args.TransferTagger = &testsCommon.BatchTaggerStub{
+			TagTransferCalled: func(from string, to string, token string, amount *big.Int) []string {
+				assert.Equal(t, callData.From.Hex(), from)
+				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqnf2w270lhxhlj57jvthxw4tqsunrwnq0anaqm4d4fn", to)
+				assert.Equal(t, "tkn", token)
+				assert.Equal(t, callData.Amount, amount)
+				return []string{"institutional"}
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		executor, _ := NewScCallExecutor(args)
+
+		executor.processExecutionOutcome(context.Background(), testScProxy, 1, callData, testHash, nil)
+		require.NotNil(t, notification)
+		assert.Equal(t, []string{"institutional"}, notification.Tags)
+		assert.Equal(t, testScProxy, notification.ScProxy)
+		assert.Equal(t, uint64(1), notification.ID)
+		assert.Equal(t, testHash, notification.TxHash)
+		assert.Equal(t, bridgeCore.ScCallSent, notification.Status)
+		assert.Equal(t, callData.From.Hex(), notification.From)
+		assert.Equal(t, "erd1qqqqqqqqqqqqqpgqnf2w270lhxhlj57jvthxw4tqsunrwnq0anaqm4d4fn", notification.To)
+		assert.Equal(t, "tkn", notification.Token)
+		assert.Equal(t, "37", notification.Amount)
+		assert.Equal(t, uint64(1), notification.DepositNonce)
+		assert.Nil(t, notification.Result)
+		assert.Zero(t, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumExecuted))
+	})
+	t.Run("failed transaction should notify the decoded result", func(t *testing.T) {
+		t.Parallel()
+
+		var notification *bridgeCore.ScCallExecutionNotification
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.Proxy = &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				assert.Equal(t, testHash, hash)
+				return createTestTransactionInfo(), nil
+			},
+		}
+		args.WebhookNotifier = &testsCommon.WebhookNotifierStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			NotifyCalled: func(n *bridgeCore.ScCallExecutionNotification) {
+				notification = n
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		executor, _ := NewScCallExecutor(args)
+
+		executionErr := fmt.Errorf("%w for tx hash %s", errTransactionFailed, testHash)
+		executor.processExecutionOutcome(context.Background(), testScProxy, 1, callData, testHash, executionErr)
+		require.NotNil(t, notification)
+		assert.Equal(t, bridgeCore.ScCallFailed, notification.Status)
+		require.NotNil(t, notification.Result)
+		assert.Equal(t, "error signalled by smartcontract", notification.Result.ErrorMessage)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumFailed))
+		assert.NotZero(t, statusHandler.GetIntMetric(bridgeCore.MetricScCallsLastFailedTimestamp))
+		assert.Zero(t, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumExecuted))
+	})
+	t.Run("unknown result should not fetch the results", func(t *testing.T) {
+		t.Parallel()
+
+		var notification *bridgeCore.ScCallExecutionNotification
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.Proxy = &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				assert.Fail(t, "should have not called GetTransactionInfoWithResults")
+				return nil, nil
+			},
+		}
+		args.WebhookNotifier = &testsCommon.WebhookNotifierStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			NotifyCalled: func(n *bridgeCore.ScCallExecutionNotification) {
+				notification = n
+			},
+		}
+		executor, _ := NewScCallExecutor(args)
+
+		executor.processExecutionOutcome(context.Background(), testScProxy, 1, callData, testHash, context.DeadlineExceeded)
+		require.NotNil(t, notification)
+		assert.Equal(t, bridgeCore.ScCallUnknown, notification.Status)
+		assert.Nil(t, notification.Result)
+	})
+	t.Run("fetching the results errors should notify without the result", func(t *testing.T) {
+		t.Parallel()
+
+		var notification *bridgeCore.ScCallExecutionNotification
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.Proxy = &interactors.ProxyStub{
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, hash string) (*data.TransactionInfo, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		args.WebhookNotifier = &testsCommon.WebhookNotifierStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			NotifyCalled: func(n *bridgeCore.ScCallExecutionNotification) {
+				notification = n
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		executor, _ := NewScCallExecutor(args)
+
+		executor.processExecutionOutcome(context.Background(), testScProxy, 1, callData, testHash, nil)
+		require.NotNil(t, notification)
+		assert.Equal(t, bridgeCore.ScCallExecuted, notification.Status)
+		assert.Nil(t, notification.Result)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumExecuted))
+		assert.Zero(t, statusHandler.GetIntMetric(bridgeCore.MetricScCallsGasUsed))
+	})
+}
+
+func TestDecodeExecutionResult(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty transaction should return an empty result", func(t *testing.T) {
+		t.Parallel()
+
+		result := decodeExecutionResult(&data.TransactionInfo{})
+		assert.Empty(t, result.ReturnData)
+		assert.Empty(t, result.Events)
+		assert.Empty(t, result.ErrorMessage)
+	})
+	t.Run("should decode the return data, the events and the error message", func(t *testing.T) {
+		t.Parallel()
+
+		result := decodeExecutionResult(createTestTransactionInfo())
+		assert.Equal(t, []string{"0a", "", "01"}, result.ReturnData)
+		assert.Equal(t, []string{"ESDTTransfer", signalErrorEvent, "completedTxEvent"}, result.Events)
+		assert.Equal(t, "error signalled by smartcontract", result.ErrorMessage)
+	})
+	t.Run("should use the return message if no error event was emitted", func(t *testing.T) {
+		t.Parallel()
+
+		txInfo := &data.TransactionInfo{}
+		txInfo.Data.Transaction.ScResults = []*transaction.ApiSmartContractResult{
+			{
+				ReturnMessage: "out of gas",
+			},
+		}
+
+		result := decodeExecutionResult(txInfo)
+		assert.Equal(t, "out of gas", result.ErrorMessage)
+	})
+}
@@ -6,10 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/errors"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -26,6 +28,7 @@ const (
 	okCodeAfterExecution           = "ok"
 	scProxyCallFunction            = "execute"
 	minCheckValues                 = 1
+	minConcurrentExecutions        = 1
 	transactionNotFoundErrString   = "transaction not found"
 	minGasToExecuteSCCalls         = 2010000 // the absolut minimum gas limit to do a SC call
 	contractMaxGasLimit            = 249999999
@@ -33,7 +36,7 @@ const (
 
 // ArgsScCallExecutor represents the DTO struct for creating a new instance of type scCallExecutor
 type ArgsScCallExecutor struct {
-	ScProxyBech32Address            string
+	ScProxyBech32Addresses          []string
 	Proxy                           Proxy
 	Codec                           Codec
 	Filter                          ScCallsExecuteFilter
@@ -41,15 +44,20 @@ type ArgsScCallExecutor struct {
 	ExtraGasToExecute               uint64
 	MaxGasLimitToUse                uint64
 	GasLimitForOutOfGasTransactions uint64
+	MaxConcurrentExecutions         int
 	NonceTxHandler                  NonceTransactionsHandler
 	PrivateKey                      crypto.PrivateKey
 	SingleSigner                    crypto.SingleSigner
 	TransactionChecks               config.TransactionChecksConfig
 	CloseAppChan                    chan struct{}
+	WebhookNotifier                 WebhookNotifier
+	TransferTagger                  TransferTagger
+	StatusHandler                   bridgeCore.StatusHandler
+	Timer                           bridgeCore.Timer
 }
 
 type scCallExecutor struct {
-	scProxyBech32Address            string
+	scProxyBech32Addresses          []string
 	proxy                           Proxy
 	codec                           Codec
 	filter                          ScCallsExecuteFilter
@@ -57,6 +65,7 @@ type scCallExecutor struct {
 	extraGasToExecute               uint64
 	maxGasLimitToUse                uint64
 	gasLimitForOutOfGasTransactions uint64
+	maxConcurrentExecutions         int
 	nonceTxHandler                  NonceTransactionsHandler
 	privateKey                      crypto.PrivateKey
 	singleSigner                    crypto.SingleSigner
@@ -68,6 +77,10 @@ type scCallExecutor struct {
 	closeAppOnError                 bool
 	extraDelayOnError               time.Duration
 	closeAppChan                    chan struct{}
+	webhookNotifier                 WebhookNotifier
+	transferTagger                  TransferTagger
+	statusHandler                   bridgeCore.StatusHandler
+	timer                           bridgeCore.Timer
 }
 
 // NewScCallExecutor creates a new instance of type scCallExecutor
@@ -85,7 +98,7 @@ func NewScCallExecutor(args ArgsScCallExecutor) (*scCallExecutor, error) {
 	senderAddress := data.NewAddressFromBytes(publicKeyBytes)
 
 	return &scCallExecutor{
-		scProxyBech32Address:            args.ScProxyBech32Address,
+		scProxyBech32Addresses:          args.ScProxyBech32Addresses,
 		proxy:                           args.Proxy,
 		codec:                           args.Codec,
 		filter:                          args.Filter,
@@ -93,6 +106,7 @@ func NewScCallExecutor(args ArgsScCallExecutor) (*scCallExecutor, error) {
 		extraGasToExecute:               args.ExtraGasToExecute,
 		maxGasLimitToUse:                args.MaxGasLimitToUse,
 		gasLimitForOutOfGasTransactions: args.GasLimitForOutOfGasTransactions,
+		maxConcurrentExecutions:         args.MaxConcurrentExecutions,
 		nonceTxHandler:                  args.NonceTxHandler,
 		privateKey:                      args.PrivateKey,
 		singleSigner:                    args.SingleSigner,
@@ -103,6 +117,10 @@ func NewScCallExecutor(args ArgsScCallExecutor) (*scCallExecutor, error) {
 		closeAppOnError:                 args.TransactionChecks.CloseAppOnError,
 		extraDelayOnError:               time.Second * time.Duration(args.TransactionChecks.ExtraDelayInSecondsOnError),
 		closeAppChan:                    args.CloseAppChan,
+		webhookNotifier:                 args.WebhookNotifier,
+		transferTagger:                  args.TransferTagger,
+		statusHandler:                   args.StatusHandler,
+		timer:                           args.Timer,
 	}, nil
 }
 
@@ -128,20 +146,48 @@ func checkArgs(args ArgsScCallExecutor) error {
 	if check.IfNil(args.SingleSigner) {
 		return errNilSingleSigner
 	}
+	if check.IfNil(args.WebhookNotifier) {
+		return errNilWebhookNotifier
+	}
+	if check.IfNil(args.TransferTagger) {
+		return errNilTransferTagger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return errNilStatusHandler
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
 	if args.MaxGasLimitToUse < minGasToExecuteSCCalls {
 		return fmt.Errorf("%w for MaxGasLimitToUse: provided: %d, absolute minimum required: %d", errGasLimitIsLessThanAbsoluteMinimum, args.MaxGasLimitToUse, minGasToExecuteSCCalls)
 	}
 	if args.GasLimitForOutOfGasTransactions < minGasToExecuteSCCalls {
 		return fmt.Errorf("%w for GasLimitForOutOfGasTransactions: provided: %d, absolute minimum required: %d", errGasLimitIsLessThanAbsoluteMinimum, args.GasLimitForOutOfGasTransactions, minGasToExecuteSCCalls)
 	}
+	if args.MaxConcurrentExecutions < minConcurrentExecutions {
+		return fmt.Errorf("%w for MaxConcurrentExecutions, minimum: %d, got: %d", errInvalidValue, minConcurrentExecutions, args.MaxConcurrentExecutions)
+	}
 	err := checkTransactionChecksConfig(args)
 	if err != nil {
 		return err
 	}
 
-	_, err = data.NewAddressFromBech32String(args.ScProxyBech32Address)
+	return checkScProxyAddresses(args.ScProxyBech32Addresses)
+}
 
-	return err
+func checkScProxyAddresses(scProxyBech32Addresses []string) error {
+	if len(scProxyBech32Addresses) == 0 {
+		return errEmptyScProxyAddresses
+	}
+
+	for _, scProxyBech32Address := range scProxyBech32Addresses {
+		_, err := data.NewAddressFromBech32String(scProxyBech32Address)
+		if err != nil {
+			return fmt.Errorf("%w for SC proxy address %s", err, scProxyBech32Address)
+		}
+	}
+
+	return nil
 }
 
 func checkTransactionChecksConfig(args ArgsScCallExecutor) error {
@@ -165,21 +211,39 @@ func checkTransactionChecksConfig(args ArgsScCallExecutor) error {
 	return nil
 }
 
-// Execute will execute one step: get all pending operations, call the filter and send execution transactions
+// Execute will execute one step for each SC proxy: get all pending operations, call the filter and send execution
+// transactions. An error on one SC proxy does not prevent the execution of the other SC proxies pending operations
 func (executor *scCallExecutor) Execute(ctx context.Context) error {
-	pendingOperations, err := executor.getPendingOperations(ctx)
+	var lastErr error
+	numPendingOperations := 0
+	for _, scProxyBech32Address := range executor.scProxyBech32Addresses {
+		numPending, err := executor.executeForScProxy(ctx, scProxyBech32Address)
+		numPendingOperations += numPending
+		if err != nil {
+			executor.log.Error("scCallExecutor.Execute", "SC proxy", scProxyBech32Address, "error", err)
+			lastErr = err
+		}
+	}
+
+	executor.statusHandler.SetIntMetric(bridgeCore.MetricScCallsNumPendingOperations, numPendingOperations)
+
+	return lastErr
+}
+
+func (executor *scCallExecutor) executeForScProxy(ctx context.Context, scProxyBech32Address string) (int, error) {
+	pendingOperations, err := executor.getPendingOperations(ctx, scProxyBech32Address)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	filteredPendingOperations := executor.filterOperations(pendingOperations)
 
-	return executor.executeOperations(ctx, filteredPendingOperations)
+	return len(pendingOperations), executor.executeOperations(ctx, scProxyBech32Address, filteredPendingOperations)
 }
 
-func (executor *scCallExecutor) getPendingOperations(ctx context.Context) (map[uint64]parsers.ProxySCCompleteCallData, error) {
+func (executor *scCallExecutor) getPendingOperations(ctx context.Context, scProxyBech32Address string) (map[uint64]parsers.ProxySCCompleteCallData, error) {
 	request := &data.VmValueRequest{
-		Address:  executor.scProxyBech32Address,
+		Address:  scProxyBech32Address,
 		FuncName: getPendingTransactionsFunction,
 	}
 
@@ -236,22 +300,74 @@ func (executor *scCallExecutor) filterOperations(pendingOperations map[uint64]pa
 	return result
 }
 
-func (executor *scCallExecutor) executeOperations(ctx context.Context, pendingOperations map[uint64]parsers.ProxySCCompleteCallData) error {
+// executeOperations executes the pending operations concurrently, using at most maxConcurrentExecutions workers. The
+// operations having the same destination contract are executed by the same worker, in the ascending order of their
+// IDs. An error stops the execution of the remaining operations of the same destination contract only
+func (executor *scCallExecutor) executeOperations(
+	ctx context.Context,
+	scProxyBech32Address string,
+	pendingOperations map[uint64]parsers.ProxySCCompleteCallData,
+) error {
 	networkConfig, err := executor.proxy.GetNetworkConfig(ctx)
 	if err != nil {
 		return fmt.Errorf("%w while fetching network configs", err)
 	}
 
-	for id, callData := range pendingOperations {
+	operationsGroups := groupOperationsByDestination(pendingOperations)
+	chOperationsGroups := make(chan []pendingOperation, len(operationsGroups))
+	for _, operationsGroup := range operationsGroups {
+		chOperationsGroups <- operationsGroup
+	}
+	close(chOperationsGroups)
+
+	numWorkers := executor.maxConcurrentExecutions
+	if numWorkers > len(operationsGroups) {
+		numWorkers = len(operationsGroups)
+	}
+
+	var firstErr error
+	mutErr := sync.Mutex{}
+	wg := sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for i := 0; i < numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+
+			for operationsGroup := range chOperationsGroups {
+				errExecute := executor.executeOperationsGroup(ctx, scProxyBech32Address, operationsGroup, networkConfig)
+				if errExecute == nil {
+					continue
+				}
+
+				mutErr.Lock()
+				if firstErr == nil {
+					firstErr = errExecute
+				}
+				mutErr.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+func (executor *scCallExecutor) executeOperationsGroup(
+	ctx context.Context,
+	scProxyBech32Address string,
+	operationsGroup []pendingOperation,
+	networkConfig *data.NetworkConfig,
+) error {
+	for _, operation := range operationsGroup {
 		workingCtx, cancel := context.WithTimeout(ctx, executor.executionTimeout)
 
-		executor.log.Debug("scCallExecutor.executeOperations", "executing ID", id, "call data", callData,
-			"maximum timeout", executor.executionTimeout)
-		err = executor.executeOperation(workingCtx, id, callData, networkConfig)
+		executor.log.Debug("scCallExecutor.executeOperations", "SC proxy", scProxyBech32Address, "executing ID", operation.id,
+			"call data", operation.callData, "maximum timeout", executor.executionTimeout)
+		err := executor.executeOperation(workingCtx, scProxyBech32Address, operation.id, operation.callData, networkConfig)
 		cancel()
 
 		if err != nil {
-			return fmt.Errorf("%w for call data: %s", err, callData)
+			return fmt.Errorf("%w for call data: %s", err, operation.callData)
 		}
 	}
 
@@ -260,6 +376,7 @@ func (executor *scCallExecutor) executeOperations(ctx context.Context, pendingOp
 
 func (executor *scCallExecutor) executeOperation(
 	ctx context.Context,
+	scProxyBech32Address string,
 	id uint64,
 	callData parsers.ProxySCCompleteCallData,
 	networkConfig *data.NetworkConfig,
@@ -290,7 +407,7 @@ func (executor *scCallExecutor) executeOperation(
 		GasLimit: gasLimit + executor.extraGasToExecute,
 		Data:     dataBytes,
 		Sender:   bech32Address,
-		Receiver: executor.scProxyBech32Address,
+		Receiver: scProxyBech32Address,
 		Value:    "0",
 	}
 
@@ -343,6 +460,7 @@ func (executor *scCallExecutor) executeOperation(
 
 	executor.log.Info("scCallExecutor.executeOperation: sent transaction from executor",
 		"hash", hash,
+		"SC proxy", scProxyBech32Address,
 		"tx ID", id,
 		"call data", callData.String(),
 		"extra gas", executor.extraGasToExecute,
@@ -350,8 +468,12 @@ func (executor *scCallExecutor) executeOperation(
 		"to", to)
 
 	atomic.AddUint32(&executor.numSentTransactions, 1)
+	executor.statusHandler.AddIntMetric(bridgeCore.MetricScCallsNumSentTransactions, 1)
+
+	err = executor.handleResults(ctx, hash)
+	executor.processExecutionOutcome(ctx, scProxyBech32Address, id, callData, hash, err)
 
-	return executor.handleResults(ctx, hash)
+	return err
 }
 
 func (executor *scCallExecutor) handleResults(ctx context.Context, hash string) error {
@@ -383,16 +505,11 @@ func (executor *scCallExecutor) signTransactionWithPrivateKey(tx *transaction.Fr
 }
 
 func (executor *scCallExecutor) checkResultsUntilDone(ctx context.Context, hash string) error {
-	timer := time.NewTimer(executor.timeBetweenChecks)
-	defer timer.Stop()
-
 	for {
-		timer.Reset(executor.timeBetweenChecks)
-
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case <-timer.C:
+		case <-executor.timer.After(executor.timeBetweenChecks):
 			err, shouldStop := executor.checkResults(ctx, hash)
 			if shouldStop {
 				executor.handleError(ctx, err)
@@ -462,10 +579,9 @@ func (executor *scCallExecutor) waitForExtraDelay(ctx context.Context, err error
 		return
 	}
 
-	timer := time.NewTimer(executor.extraDelayOnError)
 	select {
 	case <-ctx.Done():
-	case <-timer.C:
+	case <-executor.timer.After(executor.extraDelayOnError):
 	}
 }
 
@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	testCrypto "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
@@ -29,7 +31,7 @@ var testCodec = &testsCommon.TestMultiversXCodec{}
 
 func createMockArgsScCallExecutor() ArgsScCallExecutor {
 	return ArgsScCallExecutor{
-		ScProxyBech32Address:            "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e",
+		ScProxyBech32Addresses:          []string{"erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"},
 		Proxy:                           &interactors.ProxyStub{},
 		Codec:                           &testsCommon.MultiversxCodecStub{},
 		Filter:                          &testsCommon.ScCallsExecuteFilterStub{},
@@ -37,10 +39,15 @@ func createMockArgsScCallExecutor() ArgsScCallExecutor {
 		ExtraGasToExecute:               100,
 		MaxGasLimitToUse:                minGasToExecuteSCCalls,
 		GasLimitForOutOfGasTransactions: minGasToExecuteSCCalls,
+		MaxConcurrentExecutions:         1,
 		NonceTxHandler:                  &testsCommon.TxNonceHandlerV2Stub{},
 		PrivateKey:                      testCrypto.NewPrivateKeyMock(),
 		SingleSigner:                    &testCrypto.SingleSignerStub{},
 		CloseAppChan:                    make(chan struct{}),
+		WebhookNotifier:                 &testsCommon.WebhookNotifierStub{},
+		TransferTagger:                  &testsCommon.BatchTaggerStub{},
+		StatusHandler:                   &testsCommon.StatusHandlerStub{},
+		Timer:                           &testsCommon.TimerMock{},
 	}
 }
 
@@ -146,15 +153,66 @@ func TestNewScCallExecutor(t *testing.T) {
 		assert.Nil(t, executor)
 		assert.Equal(t, errNilSingleSigner, err)
 	})
+	t.Run("nil webhook notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.WebhookNotifier = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilWebhookNotifier, err)
+	})
+	t.Run("nil transfer tagger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.TransferTagger = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilTransferTagger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.StatusHandler = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Timer = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilTimer, err)
+	})
 	t.Run("invalid sc proxy bech32 address should error", func(t *testing.T) {
 		t.Parallel()
 
 		args := createMockArgsScCallExecutor()
-		args.ScProxyBech32Address = "not a valid bech32 address"
+		args.ScProxyBech32Addresses = append(args.ScProxyBech32Addresses, "not a valid bech32 address")
 
 		executor, err := NewScCallExecutor(args)
 		assert.Nil(t, executor)
 		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for SC proxy address not a valid bech32 address")
+	})
+	t.Run("empty sc proxy addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.ScProxyBech32Addresses = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errEmptyScProxyAddresses, err)
 	})
 	t.Run("invalid value for TimeInSecondsBetweenChecks should error", func(t *testing.T) {
 		t.Parallel()
@@ -218,6 +276,17 @@ func TestNewScCallExecutor(t *testing.T) {
 		assert.Contains(t, err.Error(), "provided: 2009999, absolute minimum required: 2010000")
 		assert.Contains(t, err.Error(), "GasLimitForOutOfGasTransactions")
 	})
+	t.Run("invalid MaxConcurrentExecutions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.MaxConcurrentExecutions = 0
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "for MaxConcurrentExecutions, minimum: 1, got: 0")
+	})
 	t.Run("should work without transaction checks", func(t *testing.T) {
 		t.Parallel()
 
@@ -541,6 +610,8 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		txHash := "tx hash"
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
 		processTransactionStatusCalled := false
 
 		nonceCounter := uint64(100)
@@ -548,7 +619,7 @@ func TestScCallExecutor_Execute(t *testing.T) {
 
 		args.Proxy = &interactors.ProxyStub{
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
-				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
+				assert.Equal(t, args.ScProxyBech32Addresses[0], vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
 
 				return &data.VmValuesResponseData{
@@ -639,6 +710,9 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		assert.True(t, sendWasCalled)
 		assert.Equal(t, uint32(1), executor.GetNumSentTransaction())
 		assert.True(t, processTransactionStatusCalled)
+		assert.Equal(t, 2, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumPendingOperations))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumSentTransactions))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumExecuted))
 	})
 	t.Run("should work even if the gas limit decode errors", func(t *testing.T) {
 		t.Parallel()
@@ -650,7 +724,7 @@ func TestScCallExecutor_Execute(t *testing.T) {
 
 		args.Proxy = &interactors.ProxyStub{
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
-				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
+				assert.Equal(t, args.ScProxyBech32Addresses[0], vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
 
 				return &data.VmValuesResponseData{
@@ -743,7 +817,7 @@ func TestScCallExecutor_Execute(t *testing.T) {
 
 		args.Proxy = &interactors.ProxyStub{
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
-				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
+				assert.Equal(t, args.ScProxyBech32Addresses[0], vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
 
 				return &data.VmValuesResponseData{
@@ -833,7 +907,7 @@ func TestScCallExecutor_Execute(t *testing.T) {
 
 		args.Proxy = &interactors.ProxyStub{
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
-				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
+				assert.Equal(t, args.ScProxyBech32Addresses[0], vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
 
 				return &data.VmValuesResponseData{
@@ -898,6 +972,180 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint32(0), executor.GetNumSentTransaction())
 	})
+	t.Run("should execute the pending operations of all SC proxies", func(t *testing.T) {
+		t.Parallel()
+
+		firstScProxy := "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e"
+		secondScProxy := "erd1qqqqqqqqqqqqqpgqnf2w270lhxhlj57jvthxw4tqsunrwnq0anaqm4d4fn"
+		args := createMockArgsScCallExecutor()
+		args.ScProxyBech32Addresses = []string{firstScProxy, secondScProxy}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				if vmRequest.Address == firstScProxy {
+					return nil, expectedError
+				}
+
+				assert.Equal(t, secondScProxy, vmRequest.Address)
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{
+							{0x01},
+							[]byte("ProxySCCompleteCallData 1"),
+						},
+					},
+				}, nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{
+					ChainID:               "TEST",
+					MinTransactionVersion: 111,
+				}, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn1"), nil
+			},
+		}
+		receivers := make([]string, 0)
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				receivers = append(receivers, tx.Receiver)
+				return "tx hash", nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Equal(t, expectedError, err)
+		assert.Equal(t, []string{secondScProxy}, receivers)
+		assert.Equal(t, uint32(1), executor.GetNumSentTransaction())
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricScCallsNumPendingOperations))
+	})
+}
+
+func TestScCallExecutor_ExecuteConcurrently(t *testing.T) {
+	t.Parallel()
+
+	expectedError := errors.New("expected error")
+	firstContract, _ := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqnf2w270lhxhlj57jvthxw4tqsunrwnq0anaqm4d4fn")
+	secondContract, _ := data.NewAddressFromBech32String("erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e")
+	createArgs := func() ArgsScCallExecutor {
+		args := createMockArgsScCallExecutor()
+		args.MaxConcurrentExecutions = 2
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{
+							{0x01}, []byte("first"),
+							{0x02}, []byte("second"),
+							{0x03}, []byte("first"),
+							{0x04}, []byte("second"),
+						},
+					},
+				}, nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{}, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				callData := createTestProxySCCompleteCallData("tkn")
+				callData.To = firstContract
+				if string(buff) == "second" {
+					callData.To = secondContract
+				}
+
+				return callData, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("should preserve the ordering of each destination contract", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		chSecondContractSent := make(chan struct{})
+		mutSent := sync.Mutex{}
+		sentData := make([]string, 0)
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				if string(tx.Data) == scProxyCallFunction+"@01" {
+					// the first contract operation waits for the second contract operation, sent by the other worker
+					select {
+					case <-chSecondContractSent:
+					case <-time.After(time.Second * 5):
+						assert.Fail(t, "the operations were not executed concurrently")
+					}
+				}
+
+				mutSent.Lock()
+				sentData = append(sentData, string(tx.Data))
+				mutSent.Unlock()
+
+				if string(tx.Data) == scProxyCallFunction+"@02" {
+					close(chSecondContractSent)
+				}
+
+				return "tx hash", nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, uint32(4), executor.GetNumSentTransaction())
+		assert.Equal(t, scProxyCallFunction+"@02", sentData[0])
+		assert.Less(t, indexOf(sentData, scProxyCallFunction+"@01"), indexOf(sentData, scProxyCallFunction+"@03"))
+		assert.Less(t, indexOf(sentData, scProxyCallFunction+"@02"), indexOf(sentData, scProxyCallFunction+"@04"))
+	})
+	t.Run("an error should only stop the operations of the same destination contract", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		mutSent := sync.Mutex{}
+		sentData := make([]string, 0)
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				if string(tx.Data) == scProxyCallFunction+"@01" {
+					return "", expectedError
+				}
+
+				mutSent.Lock()
+				sentData = append(sentData, string(tx.Data))
+				mutSent.Unlock()
+
+				return "tx hash", nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, expectedError)
+		assert.Equal(t, []string{scProxyCallFunction + "@02", scProxyCallFunction + "@04"}, sentData)
+		assert.Equal(t, uint32(2), executor.GetNumSentTransaction())
+	})
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
 }
 
 func TestScCallExecutor_handleResults(t *testing.T) {
@@ -1022,15 +1270,15 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		args.TransactionChecks.ExtraDelayInSecondsOnError = 6
+		timer := testsCommon.NewTimeTravelTimerMock(time.Now())
+		args.Timer = timer
 
 		executor, _ := NewScCallExecutor(args)
 
-		start := time.Now()
 		err := executor.handleResults(context.Background(), testHash)
 		assert.Equal(t, expectedErr, err)
-		end := time.Now()
 
-		assert.GreaterOrEqual(t, end.Sub(start), time.Second*6)
+		assert.Equal(t, []time.Duration{time.Second, time.Second * 6}, timer.WaitRequests())
 		select {
 		case <-args.CloseAppChan:
 		default:
@@ -1051,6 +1299,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
+		args.Timer = testsCommon.NewTimeTravelTimerMock(time.Now())
 		args.TransactionChecks.CloseAppOnError = false
 
 		executor, _ := NewScCallExecutor(args)
@@ -1080,6 +1329,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
+		args.Timer = testsCommon.NewTimeTravelTimerMock(time.Now())
 
 		executor, _ := NewScCallExecutor(args)
 
@@ -1115,6 +1365,7 @@ func TestScCallExecutor_handleResults(t *testing.T) {
 		args.TransactionChecks = createMockCheckConfigs()
 		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
 		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
+		args.Timer = testsCommon.NewTimeTravelTimerMock(time.Now())
 
 		executor, _ := NewScCallExecutor(args)
 
@@ -0,0 +1,25 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// DisabledWebhookNotifier implementation in case no webhook is configured
+type DisabledWebhookNotifier struct{}
+
+// IsEnabled returns false
+func (notifier *DisabledWebhookNotifier) IsEnabled() bool {
+	return false
+}
+
+// Notify does nothing
+func (notifier *DisabledWebhookNotifier) Notify(_ *core.ScCallExecutionNotification) {
+}
+
+// Close returns nil and does nothing
+func (notifier *DisabledWebhookNotifier) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *DisabledWebhookNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
@@ -0,0 +1,22 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledWebhookNotifier(t *testing.T) {
+	notifier := &DisabledWebhookNotifier{}
+
+	assert.False(t, check.IfNil(notifier))
+	assert.False(t, notifier.IsEnabled())
+
+	notifier.Notify(nil)
+	notifier.Notify(&core.ScCallExecutionNotification{})
+
+	err := notifier.Close()
+	assert.Nil(t, err)
+}
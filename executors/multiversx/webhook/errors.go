@@ -0,0 +1,20 @@
+package webhook
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrInvalidURL signals that an invalid webhook URL was provided
+var ErrInvalidURL = errors.New("invalid webhook URL")
+
+// ErrEmptySecretKey signals that an empty secret key was provided
+var ErrEmptySecretKey = errors.New("empty secret key")
+
+// ErrInvalidRequestTimeout signals that an invalid request timeout was provided
+var ErrInvalidRequestTimeout = errors.New("invalid request timeout")
+
+// ErrInvalidQueueSize signals that an invalid queue size was provided
+var ErrInvalidQueueSize = errors.New("invalid queue size")
+
+var errUnexpectedStatusCode = errors.New("unexpected status code")
@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minRequestTimeout = time.Second
+	// SignatureHeader is the HTTP header holding the hex encoded HMAC-SHA256 signature of the request body
+	SignatureHeader = "X-Bridge-Signature"
+	signaturePrefix = "sha256="
+)
+
+// ArgsWebhookNotifier is the DTO used to create a new webhook notifier
+type ArgsWebhookNotifier struct {
+	Log            logger.Logger
+	URL            string
+	SecretKey      []byte
+	RequestTimeout time.Duration
+	QueueSize      int
+}
+
+type webhookNotifier struct {
+	log            logger.Logger
+	url            string
+	secretKey      []byte
+	requestTimeout time.Duration
+	httpClient     *http.Client
+
+	mutQueue        sync.Mutex
+	chNotifications chan *core.ScCallExecutionNotification
+	isClosed        bool
+	chDone          chan struct{}
+}
+
+// NewWebhookNotifier creates a component that posts the SC call execution results to the configured URL. Each request
+// body is signed with HMAC-SHA256 so the receiver can authenticate it. The notifications are queued and sent on a
+// separate go routine, the ones exceeding the queue size are dropped
+func NewWebhookNotifier(args ArgsWebhookNotifier) (*webhookNotifier, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	notifier := &webhookNotifier{
+		log:             args.Log,
+		url:             args.URL,
+		secretKey:       args.SecretKey,
+		requestTimeout:  args.RequestTimeout,
+		httpClient:      &http.Client{Timeout: args.RequestTimeout},
+		chNotifications: make(chan *core.ScCallExecutionNotification, args.QueueSize),
+		chDone:          make(chan struct{}),
+	}
+	go notifier.processLoop()
+
+	return notifier, nil
+}
+
+func checkArgs(args ArgsWebhookNotifier) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if !strings.HasPrefix(args.URL, "http://") && !strings.HasPrefix(args.URL, "https://") {
+		return fmt.Errorf("%w: %q", ErrInvalidURL, args.URL)
+	}
+	if len(args.SecretKey) == 0 {
+		return ErrEmptySecretKey
+	}
+	if args.RequestTimeout < minRequestTimeout {
+		return fmt.Errorf("%w: %v, minimum: %v", ErrInvalidRequestTimeout, args.RequestTimeout, minRequestTimeout)
+	}
+	if args.QueueSize < 1 {
+		return fmt.Errorf("%w: %d", ErrInvalidQueueSize, args.QueueSize)
+	}
+
+	return nil
+}
+
+// IsEnabled returns true
+func (notifier *webhookNotifier) IsEnabled() bool {
+	return true
+}
+
+// Notify queues the notification for sending. It never blocks
+func (notifier *webhookNotifier) Notify(notification *core.ScCallExecutionNotification) {
+	if notification == nil {
+		return
+	}
+
+	notifier.mutQueue.Lock()
+	defer notifier.mutQueue.Unlock()
+
+	if notifier.isClosed {
+		return
+	}
+
+	select {
+	case notifier.chNotifications <- notification:
+	default:
+		notifier.log.Warn("webhookNotifier: queue full, notification dropped", "ID", notification.ID, "tx hash", notification.TxHash)
+	}
+}
+
+func (notifier *webhookNotifier) processLoop() {
+	defer close(notifier.chDone)
+
+	for notification := range notifier.chNotifications {
+		err := notifier.send(notification)
+		if err != nil {
+			notifier.log.Warn("webhookNotifier: can not send the notification", "ID", notification.ID,
+				"tx hash", notification.TxHash, "error", err)
+			continue
+		}
+
+		notifier.log.Debug("webhookNotifier: notification sent", "ID", notification.ID, "tx hash", notification.TxHash,
+			"status", notification.Status)
+	}
+}
+
+func (notifier *webhookNotifier) send(notification *core.ScCallExecutionNotification) error {
+	buff, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), notifier.requestTimeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, notifier.url, bytes.NewReader(buff))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(SignatureHeader, signaturePrefix+ComputeSignature(notifier.secretKey, buff))
+
+	response, err := notifier.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %d", errUnexpectedStatusCode, response.StatusCode)
+	}
+
+	return nil
+}
+
+// ComputeSignature returns the hex encoded HMAC-SHA256 of the body, computed with the provided secret key. The webhook
+// receivers should compare it, in constant time, with the value of the signature header
+func ComputeSignature(secretKey []byte, body []byte) string {
+	mac := hmac.New(sha256.New, secretKey)
+	_, _ = mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Close stops accepting new notifications and sends the queued ones, waiting at most the request timeout for each of them
+func (notifier *webhookNotifier) Close() error {
+	notifier.mutQueue.Lock()
+	if notifier.isClosed {
+		notifier.mutQueue.Unlock()
+		return nil
+	}
+	notifier.isClosed = true
+	close(notifier.chNotifications)
+	notifier.mutQueue.Unlock()
+
+	<-notifier.chDone
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (notifier *webhookNotifier) IsInterfaceNil() bool {
+	return notifier == nil
+}
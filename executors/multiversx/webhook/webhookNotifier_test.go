@@ -0,0 +1,193 @@
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecretKey = []byte("secret")
+
+type receivedNotifications struct {
+	mut           sync.Mutex
+	notifications []*core.ScCallExecutionNotification
+}
+
+func (received *receivedNotifications) get() []*core.ScCallExecutionNotification {
+	received.mut.Lock()
+	defer received.mut.Unlock()
+
+	return append(make([]*core.ScCallExecutionNotification, 0, len(received.notifications)), received.notifications...)
+}
+
+func createTestServer(t *testing.T, received *receivedNotifications) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		require.Nil(t, err)
+		if req.Header.Get(SignatureHeader) != signaturePrefix+ComputeSignature(testSecretKey, body) {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		notification := &core.ScCallExecutionNotification{}
+		err = json.Unmarshal(body, notification)
+		require.Nil(t, err)
+
+		received.mut.Lock()
+		received.notifications = append(received.notifications, notification)
+		received.mut.Unlock()
+	}))
+}
+
+func createMockArgsWebhookNotifier(url string) ArgsWebhookNotifier {
+	return ArgsWebhookNotifier{
+		Log:            &testsCommon.LoggerStub{},
+		URL:            url,
+		SecretKey:      testSecretKey,
+		RequestTimeout: time.Second,
+		QueueSize:      10,
+	}
+}
+
+func TestNewWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier("http://localhost")
+		args.Log = nil
+
+		notifier, err := NewWebhookNotifier(args)
+		assert.True(t, check.IfNil(notifier))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("invalid URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier("localhost")
+
+		notifier, err := NewWebhookNotifier(args)
+		assert.True(t, check.IfNil(notifier))
+		assert.True(t, errors.Is(err, ErrInvalidURL))
+	})
+	t.Run("empty secret key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier("http://localhost")
+		args.SecretKey = nil
+
+		notifier, err := NewWebhookNotifier(args)
+		assert.True(t, check.IfNil(notifier))
+		assert.Equal(t, ErrEmptySecretKey, err)
+	})
+	t.Run("invalid request timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier("http://localhost")
+		args.RequestTimeout = minRequestTimeout - 1
+
+		notifier, err := NewWebhookNotifier(args)
+		assert.True(t, check.IfNil(notifier))
+		assert.True(t, errors.Is(err, ErrInvalidRequestTimeout))
+	})
+	t.Run("invalid queue size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWebhookNotifier("http://localhost")
+		args.QueueSize = 0
+
+		notifier, err := NewWebhookNotifier(args)
+		assert.True(t, check.IfNil(notifier))
+		assert.True(t, errors.Is(err, ErrInvalidQueueSize))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		notifier, err := NewWebhookNotifier(createMockArgsWebhookNotifier("http://localhost"))
+		assert.False(t, check.IfNil(notifier))
+		assert.Nil(t, err)
+		assert.True(t, notifier.IsEnabled())
+		assert.Nil(t, notifier.Close())
+	})
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should send the signed notifications", func(t *testing.T) {
+		t.Parallel()
+
+		received := &receivedNotifications{}
+		server := createTestServer(t, received)
+		defer server.Close()
+
+		notifier, _ := NewWebhookNotifier(createMockArgsWebhookNotifier(server.URL))
+		notifier.Notify(nil)
+		notifier.Notify(&core.ScCallExecutionNotification{
+			ID:     1,
+			TxHash: "hash1",
+			Status: core.ScCallExecuted,
+			Result: &core.ScCallExecutionResult{
+				ReturnData: []string{"01"},
+			},
+		})
+		notifier.Notify(&core.ScCallExecutionNotification{
+			ID:     2,
+			TxHash: "hash2",
+			Status: core.ScCallFailed,
+			Result: &core.ScCallExecutionResult{
+				ErrorMessage: "error signalled by smartcontract",
+			},
+		})
+		assert.Nil(t, notifier.Close())
+
+		notifications := received.get()
+		require.Equal(t, 2, len(notifications))
+		assert.Equal(t, "hash1", notifications[0].TxHash)
+		assert.Equal(t, []string{"01"}, notifications[0].Result.ReturnData)
+		assert.Equal(t, core.ScCallFailed, notifications[1].Status)
+		assert.Equal(t, "error signalled by smartcontract", notifications[1].Result.ErrorMessage)
+	})
+	t.Run("wrong secret key should be rejected by the receiver", func(t *testing.T) {
+		t.Parallel()
+
+		received := &receivedNotifications{}
+		server := createTestServer(t, received)
+		defer server.Close()
+
+		args := createMockArgsWebhookNotifier(server.URL)
+		args.SecretKey = []byte("other secret")
+		notifier, _ := NewWebhookNotifier(args)
+
+		err := notifier.send(&core.ScCallExecutionNotification{ID: 1})
+		assert.True(t, errors.Is(err, errUnexpectedStatusCode))
+		assert.Nil(t, notifier.Close())
+		assert.Empty(t, received.get())
+	})
+	t.Run("closed notifier should not send", func(t *testing.T) {
+		t.Parallel()
+
+		received := &receivedNotifications{}
+		server := createTestServer(t, received)
+		defer server.Close()
+
+		notifier, _ := NewWebhookNotifier(createMockArgsWebhookNotifier(server.URL))
+		assert.Nil(t, notifier.Close())
+		assert.Nil(t, notifier.Close())
+
+		notifier.Notify(&core.ScCallExecutionNotification{ID: 1})
+		assert.Empty(t, received.get())
+	})
+}
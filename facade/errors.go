@@ -4,3 +4,54 @@ import "errors"
 
 // ErrNilMetricsHolder signals that a nil metrics holder was provided
 var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilMetricsExporter signals that a nil metrics exporter was provided
+var ErrNilMetricsExporter = errors.New("nil metrics exporter")
+
+// ErrNilLoggersRegistry signals that a nil loggers registry was provided
+var ErrNilLoggersRegistry = errors.New("nil loggers registry")
+
+// ErrNilBatchResultsHolder signals that a nil batch results holder was provided
+var ErrNilBatchResultsHolder = errors.New("nil batch results holder")
+
+// ErrNilRuntimeInfo signals that a nil runtime info was provided
+var ErrNilRuntimeInfo = errors.New("nil runtime info")
+
+// ErrNilTopologyInfoHolder signals that a nil topology info holder was provided
+var ErrNilTopologyInfoHolder = errors.New("nil topology info holder")
+
+// ErrNilExportedTransactionsHolder signals that a nil exported transactions holder was provided
+var ErrNilExportedTransactionsHolder = errors.New("nil exported transactions holder")
+
+// ErrNilSyncReportHolder signals that a nil sync report holder was provided
+var ErrNilSyncReportHolder = errors.New("nil sync report holder")
+
+// ErrNilRelayedClaimsHandler signals that a nil relayed claims handler was provided
+var ErrNilRelayedClaimsHandler = errors.New("nil relayed claims handler")
+
+// ErrNilBalanceProofProvider signals that a nil balance proof provider was provided
+var ErrNilBalanceProofProvider = errors.New("nil balance proof provider")
+
+// ErrNilFeeEstimator signals that a nil fee estimator was provided
+var ErrNilFeeEstimator = errors.New("nil fee estimator")
+
+// ErrNilIncidentsHolder signals that a nil incidents holder was provided
+var ErrNilIncidentsHolder = errors.New("nil incidents holder")
+
+// ErrNilDeadLettersHolder signals that a nil dead letters holder was provided
+var ErrNilDeadLettersHolder = errors.New("nil dead letters holder")
+
+// ErrNilDirectMessagesHolder signals that a nil direct messages holder was provided
+var ErrNilDirectMessagesHolder = errors.New("nil direct messages holder")
+
+// ErrNilOperatorPauseHandler signals that a nil operator pause handler was provided
+var ErrNilOperatorPauseHandler = errors.New("nil operator pause handler")
+
+// ErrNilConfigSchema signals that a nil config schema was provided
+var ErrNilConfigSchema = errors.New("nil config schema")
+
+// ErrNilBatchExplorer signals that a nil batch explorer was provided
+var ErrNilBatchExplorer = errors.New("nil batch explorer")
+
+// ErrNilConfigDriftAuditor signals that a nil config drift auditor was provided
+var ErrNilConfigDriftAuditor = errors.New("nil config drift auditor")
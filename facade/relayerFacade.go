@@ -1,23 +1,68 @@
 package facade
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
-const availableMetrics = "available metrics"
+const (
+	availableMetrics       = "available metrics"
+	nodeStatusMetricPrefix = "relayer"
+)
 
 // ArgsRelayerFacade represents the DTO struct used in the relayer facade constructor
 type ArgsRelayerFacade struct {
-	MetricsHolder core.MetricsHolder
-	ApiInterface  string
-	PprofEnabled  bool
+	MetricsHolder   core.MetricsHolder
+	MetricsExporter core.MetricsExporter
+	Loggers         core.LoggersRegistry
+	BatchResults    core.BatchResultsHolder
+	RuntimeInfo     *core.RuntimeInfo
+	Topology        core.TopologyInfoHolder
+	ExportedTxs     core.ExportedTransactionsHolder
+	SyncReport      core.SyncReportHolder
+	RelayedClaims   core.RelayedClaimsHandler
+	BalanceProof    core.BalanceProofProvider
+	FeeEstimator    core.FeeEstimator
+	Incidents       core.IncidentsHolder
+	DeadLetters     core.DeadLettersHolder
+	DirectMessages  core.DirectMessagesHolder
+	OperatorPause   core.OperatorPauseHandler
+	BatchExplorer   core.BatchExplorer
+	ConfigDrift     core.ConfigDriftAuditor
+	ConfigSchema    *schema.Schema
+	ApiInterface    string
+	PprofEnabled    bool
 }
 
 type relayerFacade struct {
-	metricsHolder core.MetricsHolder
-	apiInterface  string
-	pprofEnabled  bool
+	metricsHolder   core.MetricsHolder
+	metricsExporter core.MetricsExporter
+	loggers         core.LoggersRegistry
+	batchResults    core.BatchResultsHolder
+	runtimeInfo     *core.RuntimeInfo
+	topology        core.TopologyInfoHolder
+	exportedTxs     core.ExportedTransactionsHolder
+	syncReport      core.SyncReportHolder
+	relayedClaims   core.RelayedClaimsHandler
+	balanceProof    core.BalanceProofProvider
+	feeEstimator    core.FeeEstimator
+	incidents       core.IncidentsHolder
+	deadLetters     core.DeadLettersHolder
+	directMessages  core.DirectMessagesHolder
+	operatorPause   core.OperatorPauseHandler
+	batchExplorer   core.BatchExplorer
+	configDrift     core.ConfigDriftAuditor
+	configSchema    *schema.Schema
+	apiInterface    string
+	pprofEnabled    bool
 }
 
 // NewRelayerFacade is the implementation of the relayer facade
@@ -25,17 +70,86 @@ func NewRelayerFacade(args ArgsRelayerFacade) (*relayerFacade, error) {
 	if check.IfNil(args.MetricsHolder) {
 		return nil, ErrNilMetricsHolder
 	}
+	if check.IfNil(args.MetricsExporter) {
+		return nil, ErrNilMetricsExporter
+	}
+	if check.IfNil(args.Loggers) {
+		return nil, ErrNilLoggersRegistry
+	}
+	if check.IfNil(args.BatchResults) {
+		return nil, ErrNilBatchResultsHolder
+	}
+	if args.RuntimeInfo == nil {
+		return nil, ErrNilRuntimeInfo
+	}
+	if check.IfNil(args.Topology) {
+		return nil, ErrNilTopologyInfoHolder
+	}
+	if check.IfNil(args.ExportedTxs) {
+		return nil, ErrNilExportedTransactionsHolder
+	}
+	if check.IfNil(args.SyncReport) {
+		return nil, ErrNilSyncReportHolder
+	}
+	if check.IfNil(args.RelayedClaims) {
+		return nil, ErrNilRelayedClaimsHandler
+	}
+	if check.IfNil(args.BalanceProof) {
+		return nil, ErrNilBalanceProofProvider
+	}
+	if check.IfNil(args.FeeEstimator) {
+		return nil, ErrNilFeeEstimator
+	}
+	if check.IfNil(args.Incidents) {
+		return nil, ErrNilIncidentsHolder
+	}
+	if check.IfNil(args.DeadLetters) {
+		return nil, ErrNilDeadLettersHolder
+	}
+	if check.IfNil(args.DirectMessages) {
+		return nil, ErrNilDirectMessagesHolder
+	}
+	if check.IfNil(args.OperatorPause) {
+		return nil, ErrNilOperatorPauseHandler
+	}
+	if check.IfNil(args.BatchExplorer) {
+		return nil, ErrNilBatchExplorer
+	}
+	if check.IfNil(args.ConfigDrift) {
+		return nil, ErrNilConfigDriftAuditor
+	}
+	if args.ConfigSchema == nil {
+		return nil, ErrNilConfigSchema
+	}
 
 	return &relayerFacade{
-		apiInterface:  args.ApiInterface,
-		pprofEnabled:  args.PprofEnabled,
-		metricsHolder: args.MetricsHolder,
+		apiInterface:    args.ApiInterface,
+		pprofEnabled:    args.PprofEnabled,
+		metricsHolder:   args.MetricsHolder,
+		metricsExporter: args.MetricsExporter,
+		loggers:         args.Loggers,
+		batchResults:    args.BatchResults,
+		runtimeInfo:     args.RuntimeInfo,
+		topology:        args.Topology,
+		exportedTxs:     args.ExportedTxs,
+		syncReport:      args.SyncReport,
+		relayedClaims:   args.RelayedClaims,
+		balanceProof:    args.BalanceProof,
+		feeEstimator:    args.FeeEstimator,
+		incidents:       args.Incidents,
+		deadLetters:     args.DeadLetters,
+		directMessages:  args.DirectMessages,
+		operatorPause:   args.OperatorPause,
+		batchExplorer:   args.BatchExplorer,
+		configDrift:     args.ConfigDrift,
+		configSchema:    args.ConfigSchema,
 	}, nil
 }
 
 // RestApiInterface returns the interface on which the rest API should start on, based on the flags provided.
 // The API will start on the DefaultRestInterface value unless a correct value is passed or
-//  the value is explicitly set to off, in which case it will not start at all
+//
+//	the value is explicitly set to off, in which case it will not start at all
 func (rf *relayerFacade) RestApiInterface() string {
 	return rf.apiInterface
 }
@@ -59,6 +173,170 @@ func (rf *relayerFacade) GetMetricsList() core.GeneralMetrics {
 	return result
 }
 
+// GetNodeStatusMetrics returns the metrics of all status handlers as a flat map, using the MultiversX node's
+// snake case naming (e.g. relayer_eth_client_num_ethereum_client_requests)
+func (rf *relayerFacade) GetNodeStatusMetrics() core.GeneralMetrics {
+	result := make(core.GeneralMetrics)
+	for _, name := range rf.metricsHolder.GetAvailableStatusHandlers() {
+		metrics, err := rf.metricsHolder.GetAllMetrics(name)
+		if err != nil {
+			continue
+		}
+
+		for metric, value := range metrics {
+			result[toNodeStatusMetricKey(name, metric)] = value
+		}
+	}
+
+	return result
+}
+
+// GetPrometheusMetrics returns the metrics of all status handlers in the Prometheus text exposition format
+func (rf *relayerFacade) GetPrometheusMetrics() []byte {
+	return rf.metricsExporter.Export()
+}
+
+func toNodeStatusMetricKey(statusHandlerName string, metric string) string {
+	key := fmt.Sprintf("%s_%s_%s", nodeStatusMetricPrefix, statusHandlerName, metric)
+
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return '_'
+	}, key)
+}
+
+// GetLoggers returns the logger identifiers and their current levels
+func (rf *relayerFacade) GetLoggers() []core.LoggerInfo {
+	return rf.loggers.Loggers()
+}
+
+// SetLoggerLevel changes, at runtime, the level of the loggers created with the provided identifier
+func (rf *relayerFacade) SetLoggerLevel(identifier string, level string) error {
+	return rf.loggers.SetLoggerLevel(identifier, level)
+}
+
+// GetBatchResults returns the per-deposit results of the provided batch, as decoded from the transaction that
+// executed it on MultiversX
+func (rf *relayerFacade) GetBatchResults(batchID uint64) (*core.BatchResults, error) {
+	return rf.batchResults.GetBatchResults(batchID)
+}
+
+// GetTransferReceipts returns the signed transfer receipts of the provided batch, generated by this relayer after
+// executing it
+func (rf *relayerFacade) GetTransferReceipts(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+	return rf.batchResults.GetTransferReceipts(direction, batchID)
+}
+
+// GetRuntimeInfo returns the structured information about the running relayer
+func (rf *relayerFacade) GetRuntimeInfo() *core.RuntimeInfo {
+	return rf.runtimeInfo
+}
+
+// GetTopologyInfo returns, for each bridge direction, the sorted relayers set, the position of the local relayer and
+// the leaders of the next numSlots slots
+func (rf *relayerFacade) GetTopologyInfo(numSlots int) map[string]*core.TopologyInfo {
+	return rf.topology.GetTopologyInfo(numSlots)
+}
+
+// GetExportedTransactions returns the last signed Ethereum execution transactions exported instead of being broadcast
+func (rf *relayerFacade) GetExportedTransactions() []*core.ExportedTransaction {
+	return rf.exportedTxs.GetExportedTransactions()
+}
+
+// GetSyncReport returns the report produced at startup about where the bridge believes it is on both chains
+func (rf *relayerFacade) GetSyncReport() *core.SyncReport {
+	return rf.syncReport.GetSyncReport()
+}
+
+// SubmitRelayedClaim relays the claim transaction signed by a user, the gas being paid by the sponsor account.
+// Returns the hash of the relayed transaction
+func (rf *relayerFacade) SubmitRelayedClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+	return rf.relayedClaims.SubmitClaim(ctx, claimTx)
+}
+
+// GetBalanceProof returns the view of the bridge reserves for the bridged tokens
+func (rf *relayerFacade) GetBalanceProof(ctx context.Context) (*core.BalanceProof, error) {
+	return rf.balanceProof.GetBalanceProof(ctx)
+}
+
+// EstimateFee quotes the deposit of the provided token amount: the fee, the amount received on the destination chain,
+// the deposit limits and the expected latency
+func (rf *relayerFacade) EstimateFee(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+	return rf.feeEstimator.EstimateFee(ctx, token, amount)
+}
+
+// GetIncidents returns the log of the incidents that halted the bridge processing
+func (rf *relayerFacade) GetIncidents() []*core.Incident {
+	return rf.incidents.GetIncidents()
+}
+
+// AcknowledgeIncident records the operator's acknowledgment of the provided incident
+func (rf *relayerFacade) AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+	return rf.incidents.AcknowledgeIncident(id, acknowledgment)
+}
+
+// GetConfigSchema returns the JSON Schema of the config file
+func (rf *relayerFacade) GetConfigSchema() *schema.Schema {
+	return rf.configSchema
+}
+
+// GetConfigDrift returns the differences between the config the relayer is running with and the config file
+func (rf *relayerFacade) GetConfigDrift() (*core.ConfigDriftReport, error) {
+	return rf.configDrift.GetConfigDrift()
+}
+
+// GetDeadLetters returns the deposits that repeatedly failed to be validated or executed
+func (rf *relayerFacade) GetDeadLetters() []*core.DeadLetter {
+	return rf.deadLetters.GetDeadLetters()
+}
+
+// ResolveDeadLetter records the operator's resolution of the provided dead letter
+func (rf *relayerFacade) ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+	return rf.deadLetters.ResolveDeadLetter(direction, depositNonce, resolution)
+}
+
+// GetDirectMessages returns the last direct messages sent to and received from the other relayers
+func (rf *relayerFacade) GetDirectMessages() []*core.DirectMessage {
+	return rf.directMessages.GetDirectMessages()
+}
+
+// SendDirectMessage sends a coordination message to the provided relayer
+func (rf *relayerFacade) SendDirectMessage(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+	return rf.directMessages.SendDirectMessage(to, kind, text, batchID)
+}
+
+// PauseHalfBridge suspends the processing of the provided half-bridge at the request of an operator
+func (rf *relayerFacade) PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	return rf.operatorPause.PauseHalfBridge(halfBridge, remoteAddress)
+}
+
+// ResumeHalfBridge resumes the processing of the provided half-bridge at the request of an operator
+func (rf *relayerFacade) ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	return rf.operatorPause.ResumeHalfBridge(halfBridge, remoteAddress)
+}
+
+// GetHalfBridgePauses returns the operator pause state of each half-bridge
+func (rf *relayerFacade) GetHalfBridgePauses() []*core.HalfBridgePause {
+	return rf.operatorPause.GetHalfBridgePauses()
+}
+
+// GetPendingBatches returns the live view of the batches currently processed by the relayer
+func (rf *relayerFacade) GetPendingBatches() []*core.BatchLiveView {
+	return rf.batchExplorer.GetPendingBatches()
+}
+
+// GetBatchLiveViews returns the live view of the processed batches with the provided ID
+func (rf *relayerFacade) GetBatchLiveViews(batchID uint64) []*core.BatchLiveView {
+	return rf.batchExplorer.GetBatchLiveViews(batchID)
+}
+
+// GetDepositLiveViews returns the live view of the processed batches holding the deposit with the provided nonce
+func (rf *relayerFacade) GetDepositLiveViews(nonce uint64) []*core.BatchLiveView {
+	return rf.batchExplorer.GetDepositLiveViews(nonce)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rf *relayerFacade) IsInterfaceNil() bool {
 	return rf == nil
@@ -1,22 +1,43 @@
 package facade
 
 import (
+	"context"
 	"errors"
+	"math/big"
 	"testing"
 
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func createMockArguments() ArgsRelayerFacade {
 	return ArgsRelayerFacade{
-		MetricsHolder: status.NewMetricsHolder(),
-		ApiInterface:  core.WebServerOffString,
-		PprofEnabled:  true,
+		MetricsHolder:   status.NewMetricsHolder(),
+		MetricsExporter: &testsCommon.MetricsExporterStub{},
+		Loggers:         &testsCommon.LoggersRegistryStub{},
+		BatchResults:    &testsCommon.BatchResultsStorerStub{},
+		RuntimeInfo:     &core.RuntimeInfo{AppVersion: "v1.0.0"},
+		Topology:        &testsCommon.TopologyInfoHolderStub{},
+		ExportedTxs:     &testsCommon.RawTransactionsExporterStub{},
+		SyncReport:      &testsCommon.SyncReportHolderStub{},
+		RelayedClaims:   &testsCommon.RelayedClaimsHandlerStub{},
+		BalanceProof:    &testsCommon.BalanceProofProviderStub{},
+		FeeEstimator:    &testsCommon.FeeEstimatorStub{},
+		Incidents:       &testsCommon.IncidentsQueueStub{},
+		DeadLetters:     &testsCommon.DeadLettersStub{},
+		DirectMessages:  &testsCommon.DirectMessagesStub{},
+		OperatorPause:   &testsCommon.OperatorPauseStub{},
+		BatchExplorer:   &testsCommon.BatchExplorerStub{},
+		ConfigDrift:     &testsCommon.ConfigDriftAuditorStub{},
+		ConfigSchema:    &schema.Schema{Title: "Config"},
+		ApiInterface:    core.WebServerOffString,
+		PprofEnabled:    true,
 	}
 }
 
@@ -31,6 +52,142 @@ func TestNewRelayerFacade(t *testing.T) {
 		assert.True(t, check.IfNil(facade))
 		assert.True(t, errors.Is(err, ErrNilMetricsHolder))
 	})
+	t.Run("nil metrics exporter should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.MetricsExporter = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilMetricsExporter))
+	})
+	t.Run("nil loggers registry should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.Loggers = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilLoggersRegistry))
+	})
+	t.Run("nil batch results holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.BatchResults = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilBatchResultsHolder))
+	})
+	t.Run("nil runtime info should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.RuntimeInfo = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilRuntimeInfo))
+	})
+	t.Run("nil topology info holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.Topology = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilTopologyInfoHolder))
+	})
+	t.Run("nil exported transactions holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.ExportedTxs = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilExportedTransactionsHolder))
+	})
+	t.Run("nil sync report holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.SyncReport = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilSyncReportHolder))
+	})
+	t.Run("nil relayed claims handler should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.RelayedClaims = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilRelayedClaimsHandler))
+	})
+	t.Run("nil balance proof provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.BalanceProof = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilBalanceProofProvider))
+	})
+	t.Run("nil fee estimator should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.FeeEstimator = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilFeeEstimator))
+	})
+	t.Run("nil incidents holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.Incidents = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilIncidentsHolder))
+	})
+	t.Run("nil dead letters holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.DeadLetters = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilDeadLettersHolder))
+	})
+	t.Run("nil direct messages holder should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.DirectMessages = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilDirectMessagesHolder))
+	})
+	t.Run("nil operator pause handler should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.OperatorPause = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilOperatorPauseHandler))
+	})
+	t.Run("nil batch explorer should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.BatchExplorer = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilBatchExplorer))
+	})
+	t.Run("nil config drift auditor should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.ConfigDrift = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilConfigDriftAuditor))
+	})
+	t.Run("nil config schema should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.ConfigSchema = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilConfigSchema))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArguments()
 
@@ -48,6 +205,7 @@ func TestRelayerFacade_Getters(t *testing.T) {
 
 	assert.Equal(t, args.ApiInterface, facade.RestApiInterface())
 	assert.Equal(t, args.PprofEnabled, facade.PprofEnabled())
+	assert.True(t, args.RuntimeInfo == facade.GetRuntimeInfo())
 }
 
 func TestRelayerFacade_GetMetrics(t *testing.T) {
@@ -103,3 +261,371 @@ func TestRelayerFacade_GetMetricsList(t *testing.T) {
 	expected[availableMetrics] = []string{"mock1", "mock2"}
 	assert.Equal(t, expected, response)
 }
+
+func TestRelayerFacade_GetNodeStatusMetrics(t *testing.T) {
+	t.Parallel()
+
+	sh1 := testsCommon.NewStatusHandlerMock(core.EthClientStatusHandlerName)
+	sh1.SetIntMetric(core.MetricNumEthClientRequests, 37)
+	sh2 := testsCommon.NewStatusHandlerMock("EthToMultiversX")
+	sh2.SetStringMetric(core.MetricCurrentStateMachineStep, "step")
+	metricHolder := status.NewMetricsHolder()
+	require.Nil(t, metricHolder.AddStatusHandler(sh1))
+	require.Nil(t, metricHolder.AddStatusHandler(sh2))
+
+	args := createMockArguments()
+	args.MetricsHolder = metricHolder
+	facade, _ := NewRelayerFacade(args)
+
+	expectedMetrics := core.GeneralMetrics{
+		"relayer_eth_client_num_ethereum_client_requests":    37,
+		"relayer_ethtomultiversx_current_state_machine_step": "step",
+	}
+	assert.Equal(t, expectedMetrics, facade.GetNodeStatusMetrics())
+}
+
+func TestRelayerFacade_GetPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	providedMetrics := []byte("relayer_num_batches{handler=\"EthToMultiversX\"} 37\n")
+	args := createMockArguments()
+	args.MetricsExporter = &testsCommon.MetricsExporterStub{
+		ExportCalled: func() []byte {
+			return providedMetrics
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedMetrics, facade.GetPrometheusMetrics())
+}
+
+func TestRelayerFacade_Loggers(t *testing.T) {
+	t.Parallel()
+
+	providedLoggers := []core.LoggerInfo{{Identifier: "EthereumMultiversX-EthereumClient", Level: "INFO"}}
+	setLevelCalled := false
+	args := createMockArguments()
+	args.Loggers = &testsCommon.LoggersRegistryStub{
+		LoggersCalled: func() []core.LoggerInfo {
+			return providedLoggers
+		},
+		SetLoggerLevelCalled: func(identifier string, level string) error {
+			setLevelCalled = true
+			assert.Equal(t, "EthereumMultiversX-EthereumClient", identifier)
+			assert.Equal(t, "TRACE", level)
+			return nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedLoggers, facade.GetLoggers())
+	assert.Nil(t, facade.SetLoggerLevel("EthereumMultiversX-EthereumClient", "TRACE"))
+	assert.True(t, setLevelCalled)
+}
+
+func TestRelayerFacade_GetBatchResults(t *testing.T) {
+	t.Parallel()
+
+	providedResults := &core.BatchResults{BatchID: 37}
+	args := createMockArguments()
+	args.BatchResults = &testsCommon.BatchResultsStorerStub{
+		GetBatchResultsCalled: func(batchID uint64) (*core.BatchResults, error) {
+			assert.Equal(t, uint64(37), batchID)
+			return providedResults, nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	results, err := facade.GetBatchResults(37)
+	assert.Nil(t, err)
+	assert.True(t, providedResults == results)
+}
+
+func TestRelayerFacade_GetTransferReceipts(t *testing.T) {
+	t.Parallel()
+
+	providedReceipts := []*core.SignedTransferReceipt{
+		{
+			Receipt:   &core.TransferReceipt{BatchID: 37},
+			Signature: "signature",
+		},
+	}
+	args := createMockArguments()
+	args.BatchResults = &testsCommon.BatchResultsStorerStub{
+		GetTransferReceiptsCalled: func(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+			assert.Equal(t, "MultiversXToEthereum", direction)
+			assert.Equal(t, uint64(37), batchID)
+			return providedReceipts, nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	receipts, err := facade.GetTransferReceipts("MultiversXToEthereum", 37)
+	assert.Nil(t, err)
+	assert.Equal(t, providedReceipts, receipts)
+}
+
+func TestRelayerFacade_GetTopologyInfo(t *testing.T) {
+	t.Parallel()
+
+	providedInfo := map[string]*core.TopologyInfo{
+		"EthereumToMultiversX": {SelfIndex: 1},
+	}
+	args := createMockArguments()
+	args.Topology = &testsCommon.TopologyInfoHolderStub{
+		GetTopologyInfoCalled: func(numSlots int) map[string]*core.TopologyInfo {
+			assert.Equal(t, 20, numSlots)
+			return providedInfo
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedInfo, facade.GetTopologyInfo(20))
+}
+
+func TestRelayerFacade_GetExportedTransactions(t *testing.T) {
+	t.Parallel()
+
+	providedTxs := []*core.ExportedTransaction{
+		{BatchID: 37, Nonce: 4, TxHash: "0xhash", RawTransaction: "0xraw"},
+	}
+	args := createMockArguments()
+	args.ExportedTxs = &testsCommon.RawTransactionsExporterStub{
+		GetExportedTransactionsCalled: func() []*core.ExportedTransaction {
+			return providedTxs
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedTxs, facade.GetExportedTransactions())
+}
+
+func TestRelayerFacade_GetSyncReport(t *testing.T) {
+	t.Parallel()
+
+	providedReport := &core.SyncReport{Timestamp: 1700000000}
+	args := createMockArguments()
+	args.SyncReport = &testsCommon.SyncReportHolderStub{
+		GetSyncReportCalled: func() *core.SyncReport {
+			return providedReport
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.True(t, providedReport == facade.GetSyncReport())
+}
+
+func TestRelayerFacade_GetConfigSchema(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArguments()
+	facade, _ := NewRelayerFacade(args)
+
+	assert.True(t, args.ConfigSchema == facade.GetConfigSchema())
+}
+
+func TestRelayerFacade_SubmitRelayedClaim(t *testing.T) {
+	t.Parallel()
+
+	providedTx := &transaction.FrontendTransaction{Nonce: 37}
+	args := createMockArguments()
+	args.RelayedClaims = &testsCommon.RelayedClaimsHandlerStub{
+		SubmitClaimCalled: func(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+			assert.True(t, providedTx == claimTx)
+			return "hash", nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	hash, err := facade.SubmitRelayedClaim(context.Background(), providedTx)
+	assert.Equal(t, "hash", hash)
+	assert.Nil(t, err)
+}
+
+func TestRelayerFacade_GetBalanceProof(t *testing.T) {
+	t.Parallel()
+
+	providedProof := &core.BalanceProof{EthereumBlockNumber: 37}
+	args := createMockArguments()
+	args.BalanceProof = &testsCommon.BalanceProofProviderStub{
+		GetBalanceProofCalled: func(ctx context.Context) (*core.BalanceProof, error) {
+			return providedProof, nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	proof, err := facade.GetBalanceProof(context.Background())
+	assert.True(t, providedProof == proof)
+	assert.Nil(t, err)
+}
+
+func TestRelayerFacade_EstimateFee(t *testing.T) {
+	t.Parallel()
+
+	providedEstimate := &core.FeeEstimate{Fee: "37"}
+	args := createMockArguments()
+	args.FeeEstimator = &testsCommon.FeeEstimatorStub{
+		EstimateFeeCalled: func(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+			assert.Equal(t, "USDC-abcdef", token)
+			assert.Equal(t, big.NewInt(1000), amount)
+			return providedEstimate, nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	estimate, err := facade.EstimateFee(context.Background(), "USDC-abcdef", big.NewInt(1000))
+	assert.True(t, providedEstimate == estimate)
+	assert.Nil(t, err)
+}
+
+func TestRelayerFacade_Incidents(t *testing.T) {
+	t.Parallel()
+
+	providedIncidents := []*core.Incident{{ID: 1, Source: "GovernancePause"}}
+	providedAcknowledgment := core.IncidentAcknowledgment{Operator: "alice", RemoteAddress: "10.0.0.1"}
+	args := createMockArguments()
+	args.Incidents = &testsCommon.IncidentsQueueStub{
+		GetIncidentsCalled: func() []*core.Incident {
+			return providedIncidents
+		},
+		AcknowledgeIncidentCalled: func(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+			assert.Equal(t, uint64(1), id)
+			assert.Equal(t, providedAcknowledgment, acknowledgment)
+			return providedIncidents[0], nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedIncidents, facade.GetIncidents())
+	incident, err := facade.AcknowledgeIncident(1, providedAcknowledgment)
+	assert.True(t, providedIncidents[0] == incident)
+	assert.Nil(t, err)
+}
+
+func TestRelayerFacade_DeadLetters(t *testing.T) {
+	t.Parallel()
+
+	providedDeadLetters := []*core.DeadLetter{{Direction: "MultiversXToEthereum", BatchID: 1, DepositNonce: 37}}
+	providedResolution := core.DeadLetterResolution{Action: core.DeadLetterIgnore, Operator: "alice", RemoteAddress: "10.0.0.1"}
+	args := createMockArguments()
+	args.DeadLetters = &testsCommon.DeadLettersStub{
+		GetDeadLettersCalled: func() []*core.DeadLetter {
+			return providedDeadLetters
+		},
+		ResolveDeadLetterCalled: func(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+			assert.Equal(t, "MultiversXToEthereum", direction)
+			assert.Equal(t, uint64(37), depositNonce)
+			assert.Equal(t, providedResolution, resolution)
+			return providedDeadLetters[0], nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedDeadLetters, facade.GetDeadLetters())
+	deadLetter, err := facade.ResolveDeadLetter("MultiversXToEthereum", 37, providedResolution)
+	assert.True(t, providedDeadLetters[0] == deadLetter)
+	assert.Nil(t, err)
+}
+
+func TestRelayerFacade_DirectMessages(t *testing.T) {
+	t.Parallel()
+
+	providedMessages := []*core.DirectMessage{{Kind: core.DirectMessageMaintenance, Text: "restarting", Direction: core.DirectMessageReceived}}
+	args := createMockArguments()
+	args.DirectMessages = &testsCommon.DirectMessagesStub{
+		GetDirectMessagesCalled: func() []*core.DirectMessage {
+			return providedMessages
+		},
+		SendDirectMessageCalled: func(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+			assert.Equal(t, "erd1relayer", to)
+			assert.Equal(t, core.DirectMessageVeto, kind)
+			assert.Equal(t, "blacklisted recipient", text)
+			assert.Equal(t, uint64(12), batchID)
+			return providedMessages[0], nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedMessages, facade.GetDirectMessages())
+	message, err := facade.SendDirectMessage("erd1relayer", core.DirectMessageVeto, "blacklisted recipient", 12)
+	assert.True(t, providedMessages[0] == message)
+	assert.Nil(t, err)
+}
+
+func TestRelayerFacade_OperatorPause(t *testing.T) {
+	t.Parallel()
+
+	providedPauses := []*core.HalfBridgePause{{HalfBridge: core.HalfBridgeEthToMvx, Paused: true, RemoteAddress: "127.0.0.1"}}
+	numResumeCalls := 0
+	args := createMockArguments()
+	args.OperatorPause = &testsCommon.OperatorPauseStub{
+		PauseHalfBridgeCalled: func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+			assert.Equal(t, core.HalfBridgeEthToMvx, halfBridge)
+			assert.Equal(t, "127.0.0.1", remoteAddress)
+			return providedPauses[0], nil
+		},
+		ResumeHalfBridgeCalled: func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+			assert.Equal(t, core.HalfBridgeMvxToEth, halfBridge)
+			numResumeCalls++
+			return &core.HalfBridgePause{HalfBridge: halfBridge}, nil
+		},
+		GetHalfBridgePausesCalled: func() []*core.HalfBridgePause {
+			return providedPauses
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	state, err := facade.PauseHalfBridge(core.HalfBridgeEthToMvx, "127.0.0.1")
+	assert.True(t, providedPauses[0] == state)
+	assert.Nil(t, err)
+
+	_, err = facade.ResumeHalfBridge(core.HalfBridgeMvxToEth, "127.0.0.1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numResumeCalls)
+	assert.Equal(t, providedPauses, facade.GetHalfBridgePauses())
+}
+
+func TestRelayerFacade_BatchExplorer(t *testing.T) {
+	t.Parallel()
+
+	providedViews := []*core.BatchLiveView{{Direction: "EthereumToMultiversX", BatchID: 12}}
+	args := createMockArguments()
+	args.BatchExplorer = &testsCommon.BatchExplorerStub{
+		GetPendingBatchesCalled: func() []*core.BatchLiveView {
+			return providedViews
+		},
+		GetBatchLiveViewsCalled: func(batchID uint64) []*core.BatchLiveView {
+			assert.Equal(t, uint64(12), batchID)
+			return providedViews
+		},
+		GetDepositLiveViewsCalled: func(nonce uint64) []*core.BatchLiveView {
+			assert.Equal(t, uint64(74), nonce)
+			return providedViews
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, providedViews, facade.GetPendingBatches())
+	assert.Equal(t, providedViews, facade.GetBatchLiveViews(12))
+	assert.Equal(t, providedViews, facade.GetDepositLiveViews(74))
+}
+
+func TestRelayerFacade_GetConfigDrift(t *testing.T) {
+	t.Parallel()
+
+	providedReport := &core.ConfigDriftReport{
+		ConfigFile: "config.toml",
+		Drifts:     []*core.ConfigDrift{{Path: "Eth.GasLimitBase", RunningValue: "350000", FileValue: "400000"}},
+	}
+	args := createMockArguments()
+	args.ConfigDrift = &testsCommon.ConfigDriftAuditorStub{
+		GetConfigDriftCalled: func() (*core.ConfigDriftReport, error) {
+			return providedReport, nil
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	report, err := facade.GetConfigDrift()
+	assert.Nil(t, err)
+	assert.Equal(t, providedReport, report)
+}
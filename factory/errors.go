@@ -12,4 +12,9 @@ var (
 	errInvalidValue            = errors.New("invalid value")
 	errNilMetricsHolder        = errors.New("nil metrics holder")
 	errNilStatusHandler        = errors.New("nil status handler")
+	errNilSignerAuditLog       = errors.New("nil signer audit log")
+	errNilSLATracker           = errors.New("nil SLA tracker")
+	errNilErrorReporter        = errors.New("nil error reporter")
+	errNilBatchResultsStorer   = errors.New("nil batch results storer")
+	errUnknownHeartbeatMode    = errors.New("unknown heartbeat mode")
 )
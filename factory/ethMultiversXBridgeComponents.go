@@ -2,35 +2,74 @@ package factory
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
+	"math/big"
+	"net/http"
+	"path"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/accounting"
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX"
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/steps/ethToMultiversX"
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/steps/multiversxToEth"
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/topology"
 	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/clients/balanceMonitor"
+	balanceProofManagement "github.com/multiversx/mx-bridge-eth-go/clients/balanceProof"
 	balanceValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/balanceValidator"
+	batchExplorerManagement "github.com/multiversx/mx-bridge-eth-go/clients/batchExplorer"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchTags"
+	batchValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/batchValidator"
+	batchValidatorFactory "github.com/multiversx/mx-bridge-eth-go/clients/batchValidator/factory"
 	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	confirmationPolicyManagement "github.com/multiversx/mx-bridge-eth-go/clients/confirmationPolicy"
+	contextAuditManagement "github.com/multiversx/mx-bridge-eth-go/clients/contextAudit"
+	deadLettersManagement "github.com/multiversx/mx-bridge-eth-go/clients/deadLetters"
+	directMessagesManagement "github.com/multiversx/mx-bridge-eth-go/clients/directMessages"
+	esdtRolesManagement "github.com/multiversx/mx-bridge-eth-go/clients/esdtRoles"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/faucet"
+	feeEstimatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/feeEstimator"
 	"github.com/multiversx/mx-bridge-eth-go/clients/gasManagement"
 	"github.com/multiversx/mx-bridge-eth-go/clients/gasManagement/factory"
+	"github.com/multiversx/mx-bridge-eth-go/clients/gasUsageTracker"
+	governancePauseManagement "github.com/multiversx/mx-bridge-eth-go/clients/governancePause"
+	heartbeatManagement "github.com/multiversx/mx-bridge-eth-go/clients/heartbeat"
+	"github.com/multiversx/mx-bridge-eth-go/clients/idempotency"
+	incidentsManagement "github.com/multiversx/mx-bridge-eth-go/clients/incidents"
 	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
 	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx/mappers"
+	networkValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/networkValidator"
+	operatorPauseManagement "github.com/multiversx/mx-bridge-eth-go/clients/operatorPause"
+	preAgreementManagement "github.com/multiversx/mx-bridge-eth-go/clients/preAgreement"
+	recipientAllowlistManagement "github.com/multiversx/mx-bridge-eth-go/clients/recipientAllowlist"
+	recipientValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/recipientValidator"
+	"github.com/multiversx/mx-bridge-eth-go/clients/relayedClaims"
+	"github.com/multiversx/mx-bridge-eth-go/clients/reloadablePolling"
 	"github.com/multiversx/mx-bridge-eth-go/clients/roleProviders"
+	roundingPolicyManagement "github.com/multiversx/mx-bridge-eth-go/clients/roundingPolicy"
+	syncReporterManagement "github.com/multiversx/mx-bridge-eth-go/clients/syncReporter"
+	timingJitterManagement "github.com/multiversx/mx-bridge-eth-go/clients/timingJitter"
+	tokenMetadataManagement "github.com/multiversx/mx-bridge-eth-go/clients/tokenMetadata"
+	transferReceiptsManagement "github.com/multiversx/mx-bridge-eth-go/clients/transferReceipts"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
 	"github.com/multiversx/mx-bridge-eth-go/core/converters"
 	"github.com/multiversx/mx-bridge-eth-go/core/timer"
 	"github.com/multiversx/mx-bridge-eth-go/p2p"
+	"github.com/multiversx/mx-bridge-eth-go/postmortem"
+	"github.com/multiversx/mx-bridge-eth-go/runtimeMonitor"
 	"github.com/multiversx/mx-bridge-eth-go/stateMachine"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
@@ -42,12 +81,36 @@ import (
 	"github.com/multiversx/mx-sdk-go/core/polling"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/multiversx/mx-sdk-go/interactors/nonceHandlerV2"
 )
 
 const (
 	minTimeForBootstrap     = time.Millisecond * 100
 	minTimeBeforeRepeatJoin = time.Second * 30
 	pollingDurationOnError  = time.Second * 5
+	networkCheckTimeout     = time.Second * 30
+	syncReportTimeout       = time.Second * 30
+
+	nativeCurrencyDecimals    = 18
+	bytesInMB                 = 1024 * 1024
+	multiversXChainName       = "MultiversX"
+	balanceMonitorLogIdSuffix = "-BalanceMonitor"
+	gasUsageLogIdSuffix       = "-GasUsageTracker"
+	runtimeMonitorLogId       = "RuntimeMonitor"
+	governancePauseLogId      = "GovernancePause"
+	operatorPauseLogId        = "OperatorPause"
+	contextAuditLogId         = "ContextAudit"
+	incidentsLogId            = "Incidents"
+	tokenMetadataLogId        = "TokenMetadata"
+	deadLettersLogId          = "DeadLetters"
+	directMessagesLogId       = "DirectMessages"
+	transferReceiptsLogId     = "TransferReceipts"
+	idempotencyGuardLogId     = "IdempotencyGuard"
+	relayedClaimsLogId        = "RelayedClaims"
+	heartbeatLogId            = "Heartbeat"
+	costAccountingLogId       = "CostAccounting"
+	faucetLogIdSuffix         = "-Faucet"
+	stakesProviderLogIdSuffix = "-MultiversXStakesProvider"
 )
 
 var suite = ed25519.NewEd25519()
@@ -67,6 +130,10 @@ type ArgsEthereumToMultiversXBridge struct {
 	TimeBeforeRepeatJoin          time.Duration
 	MetricsHolder                 core.MetricsHolder
 	AppStatusHandler              chainCore.AppStatusHandler
+	SignerAuditLog                ethmultiversx.SignerAuditLog
+	SLATracker                    SLATracker
+	ErrorReporter                 ErrorReporter
+	BatchResultsStorer            BatchResultsStorer
 }
 
 type ethMultiversXBridgeComponents struct {
@@ -74,12 +141,15 @@ type ethMultiversXBridgeComponents struct {
 	messenger                         p2p.NetMessenger
 	statusStorer                      core.Storer
 	multiversXClient                  ethmultiversx.MultiversXClient
+	multiversXLeftoverTxsHandler      leftoverTransactionsHandler
 	ethClient                         ethmultiversx.EthereumClient
 	evmCompatibleChain                chain.Chain
 	multiversXMultisigContractAddress sdkCore.AddressHandler
 	multiversXSafeContractAddress     sdkCore.AddressHandler
 	multiversXRelayerPrivateKey       crypto.PrivateKey
 	multiversXRelayerAddress          sdkCore.AddressHandler
+	multiversXGasPayerPrivateKey      crypto.PrivateKey
+	maxTimingJitter                   time.Duration
 	ethereumRelayerAddress            common.Address
 	mxDataGetter                      dataGetter
 	proxy                             multiversx.Proxy
@@ -90,21 +160,61 @@ type ethMultiversXBridgeComponents struct {
 	timeForBootstrap                  time.Duration
 	metricsHolder                     core.MetricsHolder
 	addressConverter                  core.AddressConverter
+	signerAuditLog                    ethmultiversx.SignerAuditLog
+	postmortemCapturer                ethmultiversx.PostmortemCapturer
+	slaTracker                        SLATracker
+	errorReporter                     ErrorReporter
+	batchResultsStorer                BatchResultsStorer
+	topologyInfoProviders             map[string]topologyInfoProvider
+	leaderSelector                    topology.LeaderSelector
+	knownPeersHolder                  knownPeersHolder
+	networkValidator                  networkValidator
+	rawTransactionsExporter           rawTransactionsExporter
+	confirmationPolicy                confirmationPolicy
+	roundingPolicy                    roundingPolicy
+	syncReporter                      syncReporter
+	relayedClaimsHandler              relayedClaimsHandler
+	balanceProofProvider              core.BalanceProofProvider
+	feeEstimator                      core.FeeEstimator
+	governancePause                   governancePauseManagement.PauseChecker
+	operatorPause                     operatorPause
+	contextAudit                      contextAuditManagement.Auditor
+	batchExplorer                     batchExplorer
+	stepTimeout                       time.Duration
+	incidentsQueue                    incidentsQueue
+	deadLetters                       deadLetters
+	directMessages                    core.DirectMessagesHolder
+	transferReceipts                  ethmultiversx.TransferReceipts
+	idempotencyGuard                  ethmultiversx.IdempotencyGuard
+	batchTagger                       ethmultiversx.BatchTagger
+	costAccounting                    ethmultiversx.CostAccounting
+	ethereumGasUsageTracker           ethereum.GasUsageTracker
+	multiversXGasUsageTracker         multiversx.GasUsageTracker
 
 	ethToMultiversXMachineStates    core.MachineStates
 	ethToMultiversXStepDuration     time.Duration
 	ethToMultiversXStatusHandler    core.StatusHandler
 	ethToMultiversXStateMachine     StateMachine
 	ethToMultiversXSignaturesHolder ethmultiversx.SignaturesHolder
+	ethToMultiversXResumableState   core.ResumableState
+	ethToMultiversXLiveViewProvider batchExplorerManagement.LiveViewProvider
 
-	multiversXToEthMachineStates core.MachineStates
-	multiversXToEthStepDuration  time.Duration
-	multiversXToEthStatusHandler core.StatusHandler
-	multiversXToEthStateMachine  StateMachine
+	multiversXToEthMachineStates    core.MachineStates
+	multiversXToEthStepDuration     time.Duration
+	multiversXToEthStatusHandler    core.StatusHandler
+	multiversXToEthStateMachine     StateMachine
+	multiversXToEthResumableState   core.ResumableState
+	multiversXToEthLiveViewProvider batchExplorerManagement.LiveViewProvider
 
 	mutClosableHandlers sync.RWMutex
 	closableHandlers    []io.Closer
 
+	mutReloadableHandlers sync.RWMutex
+	reloadableHandlers    []core.ReloadableConfigHandler
+
+	mutRunningConfig sync.RWMutex
+	runningConfig    config.Config
+
 	pollingHandlers []PollingHandler
 
 	timeBeforeRepeatJoin time.Duration
@@ -122,17 +232,23 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 	ethToMultiversXName := evmCompatibleChain.EvmCompatibleChainToMultiversXName()
 	baseLogId := evmCompatibleChain.BaseLogId()
 	components := &ethMultiversXBridgeComponents{
-		baseLogger:           core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToMultiversXName), baseLogId),
-		evmCompatibleChain:   evmCompatibleChain,
-		messenger:            args.Messenger,
-		statusStorer:         args.StatusStorer,
-		closableHandlers:     make([]io.Closer, 0),
-		proxy:                args.Proxy,
-		timer:                timer.NewNTPTimer(),
-		timeForBootstrap:     args.TimeForBootstrap,
-		timeBeforeRepeatJoin: args.TimeBeforeRepeatJoin,
-		metricsHolder:        args.MetricsHolder,
-		appStatusHandler:     args.AppStatusHandler,
+		baseLogger:            core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToMultiversXName), baseLogId),
+		evmCompatibleChain:    evmCompatibleChain,
+		messenger:             args.Messenger,
+		statusStorer:          args.StatusStorer,
+		closableHandlers:      make([]io.Closer, 0),
+		proxy:                 args.Proxy,
+		timer:                 timer.NewNTPTimer(),
+		timeForBootstrap:      args.TimeForBootstrap,
+		timeBeforeRepeatJoin:  args.TimeBeforeRepeatJoin,
+		metricsHolder:         args.MetricsHolder,
+		appStatusHandler:      args.AppStatusHandler,
+		signerAuditLog:        args.SignerAuditLog,
+		slaTracker:            args.SLATracker,
+		errorReporter:         args.ErrorReporter,
+		batchResultsStorer:    args.BatchResultsStorer,
+		topologyInfoProviders: make(map[string]topologyInfoProvider),
+		runningConfig:         args.Configs.GeneralConfig,
 	}
 
 	addressConverter, err := converters.NewAddressConverter()
@@ -143,12 +259,17 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 
 	components.addClosableComponent(components.timer)
 
+	err = components.createTimingJitter(args.Configs.GeneralConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createMultiversXKeysAndAddresses(args.Configs.GeneralConfig.MultiversX)
 	if err != nil {
 		return nil, err
 	}
 
-	err = components.createDataGetter()
+	err = components.createDataGetter(args.Configs.GeneralConfig.MultiversX.Proxy)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +279,16 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 		return nil, err
 	}
 
+	err = components.createLeaderSelector(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createGasUsageTrackers(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createMultiversXClient(args)
 	if err != nil {
 		return nil, err
@@ -168,17 +299,102 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 		return nil, err
 	}
 
+	err = components.createKnownPeersHolder(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumClient(args)
 	if err != nil {
 		return nil, err
 	}
 
+	err = components.createNetworkValidator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createBalanceMonitors(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createRuntimeMonitor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createFaucetRequesters(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createPostmortemCapturer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createIncidentsQueue(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createGovernancePause(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createOperatorPause()
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createContextAudit(args.Configs.GeneralConfig.Relayer.ContextAudit)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createTokenMetadataMonitor(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createDeadLetters(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createDirectMessages(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createTransferReceipts(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createIdempotencyGuard(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createBatchTagger(args.Configs.GeneralConfig.Relayer.BatchTags)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createCostAccounting(args)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumToMultiversXBridge(args)
 	if err != nil {
 		return nil, err
 	}
 
-	err = components.createEthereumToMultiversXStateMachine()
+	err = components.createEthereumToMultiversXStateMachine(args.Configs.GeneralConfig.Relayer.StateMachineRecovery)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +404,60 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 		return nil, err
 	}
 
-	err = components.createMultiversXToEthereumStateMachine()
+	err = components.createMultiversXToEthereumStateMachine(args.Configs.GeneralConfig.Relayer.StateMachineRecovery)
+	if err != nil {
+		return nil, err
+	}
+
+	stepHook, isStepHook := components.postmortemCapturer.(core.StepHook)
+	if isStepHook {
+		err = components.RegisterStepHook(stepHook)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = components.RegisterStepHook(components.slaTracker)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.RegisterStepHook(components.errorReporter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createBatchExplorer()
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createSLAHeartbeat(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createSyncReporter()
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createRelayedClaimsHandler(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createHeartbeatPublisher(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createBalanceProofProvider(args)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createFeeEstimator(args)
 	if err != nil {
 		return nil, err
 	}
@@ -202,6 +471,12 @@ func (components *ethMultiversXBridgeComponents) addClosableComponent(closable i
 	components.mutClosableHandlers.Unlock()
 }
 
+func (components *ethMultiversXBridgeComponents) addReloadableComponent(reloadable core.ReloadableConfigHandler) {
+	components.mutReloadableHandlers.Lock()
+	components.reloadableHandlers = append(components.reloadableHandlers, reloadable)
+	components.mutReloadableHandlers.Unlock()
+}
+
 func checkArgsEthereumToMultiversXBridge(args ArgsEthereumToMultiversXBridge) error {
 	if check.IfNil(args.Proxy) {
 		return errNilProxy
@@ -230,13 +505,25 @@ func checkArgsEthereumToMultiversXBridge(args ArgsEthereumToMultiversXBridge) er
 	if check.IfNil(args.AppStatusHandler) {
 		return errNilStatusHandler
 	}
+	if check.IfNil(args.SignerAuditLog) {
+		return errNilSignerAuditLog
+	}
+	if check.IfNil(args.SLATracker) {
+		return errNilSLATracker
+	}
+	if check.IfNil(args.ErrorReporter) {
+		return errNilErrorReporter
+	}
+	if check.IfNil(args.BatchResultsStorer) {
+		return errNilBatchResultsStorer
+	}
 
 	return nil
 }
 
 func (components *ethMultiversXBridgeComponents) createMultiversXKeysAndAddresses(chainConfigs config.MultiversXConfig) error {
 	wallet := interactors.NewWallet()
-	multiversXPrivateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(chainConfigs.PrivateKeyFile)
+	multiversXPrivateKeyBytes, err := loadMultiversXPrivateKey(chainConfigs)
 	if err != nil {
 		return err
 	}
@@ -251,6 +538,18 @@ func (components *ethMultiversXBridgeComponents) createMultiversXKeysAndAddresse
 		return err
 	}
 
+	if chainConfigs.GasPayer.Enabled {
+		gasPayerPrivateKeyBytes, errLoad := wallet.LoadPrivateKeyFromPemFile(chainConfigs.GasPayer.PrivateKeyFile)
+		if errLoad != nil {
+			return fmt.Errorf("%w for chainConfigs.GasPayer.PrivateKeyFile", errLoad)
+		}
+
+		components.multiversXGasPayerPrivateKey, err = keyGen.PrivateKeyFromByteArray(gasPayerPrivateKeyBytes)
+		if err != nil {
+			return err
+		}
+	}
+
 	components.multiversXMultisigContractAddress, err = data.NewAddressFromBech32String(chainConfigs.MultisigContractAddress)
 	if err != nil {
 		return fmt.Errorf("%w for chainConfigs.MultisigContractAddress", err)
@@ -264,14 +563,16 @@ func (components *ethMultiversXBridgeComponents) createMultiversXKeysAndAddresse
 	return nil
 }
 
-func (components *ethMultiversXBridgeComponents) createDataGetter() error {
+func (components *ethMultiversXBridgeComponents) createDataGetter(proxyConfig config.ProxyConfig) error {
 	multiversXDataGetterLogId := components.evmCompatibleChain.MultiversXDataGetterLogId()
 	argsMXClientDataGetter := multiversx.ArgsMXClientDataGetter{
-		MultisigContractAddress: components.multiversXMultisigContractAddress,
-		SafeContractAddress:     components.multiversXSafeContractAddress,
-		RelayerAddress:          components.multiversXRelayerAddress,
-		Proxy:                   components.proxy,
-		Log:                     core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXDataGetterLogId), multiversXDataGetterLogId),
+		MultisigContractAddress:          components.multiversXMultisigContractAddress,
+		SafeContractAddress:              components.multiversXSafeContractAddress,
+		RelayerAddress:                   components.multiversXRelayerAddress,
+		Proxy:                            components.proxy,
+		Log:                              core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXDataGetterLogId), multiversXDataGetterLogId),
+		MaxParallelVMQueries:             proxyConfig.MaxParallelVMQueries,
+		QueriesCacheNonceRefreshInterval: time.Duration(proxyConfig.QueriesCacheNonceRefreshIntervalInMillis) * time.Millisecond,
 	}
 
 	var err error
@@ -280,32 +581,78 @@ func (components *ethMultiversXBridgeComponents) createDataGetter() error {
 	return err
 }
 
+func (components *ethMultiversXBridgeComponents) createTokensMapper(
+	cfg config.TokensMapperConfig,
+	direction batchProcessor.Direction,
+	blockNumberGetter mappers.BlockNumberGetter,
+) (mappers.TokensMapper, error) {
+	argsTokensMapper := mappers.ArgsTokensMapperFactory{
+		Direction:  direction,
+		DataGetter: components.mxDataGetter,
+		Parameters: cfg.Parameters,
+	}
+
+	tokensMapper, err := mappers.CreateTokensMapper(cfg.Type, argsTokensMapper)
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.Migrations) == 0 {
+		return tokensMapper, nil
+	}
+
+	migrations, err := CreateTokenMigrations(cfg.Migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappers.NewMigrationTokensMapper(mappers.ArgsMigrationTokensMapper{
+		Mapper:            tokensMapper,
+		Direction:         direction,
+		BlockNumberGetter: blockNumberGetter,
+		Migrations:        migrations,
+	})
+}
+
 func (components *ethMultiversXBridgeComponents) createMultiversXClient(args ArgsEthereumToMultiversXBridge) error {
 	chainConfigs := args.Configs.GeneralConfig.MultiversX
-	tokensMapper, err := mappers.NewMultiversXToErc20Mapper(components.mxDataGetter)
+	tokensMapper, err := components.createTokensMapper(args.Configs.GeneralConfig.TokensMapper, batchProcessor.FromMultiversX, args.ClientWrapper)
 	if err != nil {
 		return err
 	}
 	multiversXClientLogId := components.evmCompatibleChain.MultiversXClientLogId()
 
 	clientArgs := multiversx.ClientArgs{
-		GasMapConfig:                 chainConfigs.GasMap,
-		Proxy:                        args.Proxy,
-		Log:                          core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXClientLogId), multiversXClientLogId),
-		RelayerPrivateKey:            components.multiversXRelayerPrivateKey,
-		MultisigContractAddress:      components.multiversXMultisigContractAddress,
-		SafeContractAddress:          components.multiversXSafeContractAddress,
-		IntervalToResendTxsInSeconds: chainConfigs.IntervalToResendTxsInSeconds,
-		TokensMapper:                 tokensMapper,
-		RoleProvider:                 components.multiversXRoleProvider,
-		StatusHandler:                args.MultiversXClientStatusHandler,
-		ClientAvailabilityAllowDelta: chainConfigs.ClientAvailabilityAllowDelta,
-	}
-
-	components.multiversXClient, err = multiversx.NewClient(clientArgs)
+		GasMapConfig:                     chainConfigs.GasMap,
+		Proxy:                            args.Proxy,
+		Log:                              core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXClientLogId), multiversXClientLogId),
+		RelayerPrivateKey:                components.multiversXRelayerPrivateKey,
+		MultisigContractAddress:          components.multiversXMultisigContractAddress,
+		SafeContractAddress:              components.multiversXSafeContractAddress,
+		IntervalToResendTxsInSeconds:     chainConfigs.IntervalToResendTxsInSeconds,
+		MaxParallelVMQueries:             chainConfigs.Proxy.MaxParallelVMQueries,
+		QueriesCacheNonceRefreshInterval: time.Duration(chainConfigs.Proxy.QueriesCacheNonceRefreshIntervalInMillis) * time.Millisecond,
+		TokensMapper:                     tokensMapper,
+		RoleProvider:                     components.multiversXRoleProvider,
+		StatusHandler:                    args.MultiversXClientStatusHandler,
+		ClientAvailabilityAllowDelta:     chainConfigs.ClientAvailabilityAllowDelta,
+		SentTransactionsStorer:           args.StatusStorer,
+		LeftoverTransactionsTimeout:      time.Duration(chainConfigs.LeftoverTxsTimeoutInSeconds) * time.Second,
+		GasUsageTracker:                  components.multiversXGasUsageTracker,
+		StuckTransactions:                chainConfigs.StuckTransactions,
+		Timer:                            components.timer,
+		GasPayerPrivateKey:               components.multiversXGasPayerPrivateKey,
+	}
+
+	multiversXClient, err := multiversx.NewClient(clientArgs)
+	if err != nil {
+		return err
+	}
+
+	components.multiversXClient = multiversXClient
+	components.multiversXLeftoverTxsHandler = multiversXClient
 	components.addClosableComponent(components.multiversXClient)
 
-	return err
+	return nil
 }
 
 func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsEthereumToMultiversXBridge) error {
@@ -329,6 +676,10 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 	}
 
 	components.addClosableComponent(gs)
+	reloadableGasStation, isReloadable := gs.(core.ReloadableConfigHandler)
+	if isReloadable {
+		components.addReloadableComponent(reloadableGasStation)
+	}
 
 	antifloodComponents, err := components.createAntifloodComponents(args.Configs.GeneralConfig.P2P.AntifloodConfig)
 	if err != nil {
@@ -344,95 +695,1461 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 		return err
 	}
 
+	err = components.createConfirmationPolicy(args)
+	if err != nil {
+		return err
+	}
+
+	err = components.createRoundingPolicy(args)
+	if err != nil {
+		return err
+	}
+
+	relayerConfig := args.Configs.GeneralConfig.Relayer
+	recipientRulesHash, err := recipientAllowlistManagement.RulesHash(relayerConfig.TransferAllowlist, relayerConfig.RecipientValidation)
+	if err != nil {
+		return err
+	}
+
+	topicsMetrics := p2p.NewTopicsMetrics()
 	broadcasterLogId := components.evmCompatibleChain.BroadcasterLogId()
 	ethToMultiversXName := components.evmCompatibleChain.EvmCompatibleChainToMultiversXName()
 	argsBroadcaster := p2p.ArgsBroadcaster{
-		Messenger:              args.Messenger,
-		Log:                    core.NewLoggerWithIdentifier(logger.GetOrCreate(broadcasterLogId), broadcasterLogId),
-		MultiversXRoleProvider: components.multiversXRoleProvider,
-		SignatureProcessor:     components.ethereumRoleProvider,
-		KeyGen:                 keyGen,
-		SingleSigner:           singleSigner,
-		PrivateKey:             components.multiversXRelayerPrivateKey,
-		Name:                   ethToMultiversXName,
-		AntifloodComponents:    antifloodComponents,
+		Messenger:                   args.Messenger,
+		Log:                         core.NewLoggerWithIdentifier(logger.GetOrCreate(broadcasterLogId), broadcasterLogId),
+		MultiversXRoleProvider:      components.multiversXRoleProvider,
+		SignatureProcessor:          components.ethereumRoleProvider,
+		KeyGen:                      keyGen,
+		SingleSigner:                singleSigner,
+		PrivateKey:                  components.multiversXRelayerPrivateKey,
+		Name:                        ethToMultiversXName,
+		AntifloodComponents:         antifloodComponents,
+		PeersRecorder:               components.knownPeersHolder,
+		TopicsMetrics:               topicsMetrics,
+		CompressionType:             args.Configs.GeneralConfig.P2P.MessageCompression.Type,
+		CompressionThresholdInBytes: args.Configs.GeneralConfig.P2P.MessageCompression.ThresholdInBytes,
+		NumVerificationWorkers:      args.Configs.GeneralConfig.P2P.SignaturesVerification.NumWorkers,
+		VerifiedSignaturesCacheSize: args.Configs.GeneralConfig.P2P.SignaturesVerification.CacheSize,
+		PolicyHash:                  components.confirmationPolicy.Hash(),
+		RoundingPolicyHash:          components.roundingPolicy.Hash(),
+		RecipientRulesHash:          recipientRulesHash,
+	}
+
+	components.broadcaster, err = p2p.NewBroadcaster(argsBroadcaster)
+	if err != nil {
+		return err
+	}
+
+	err = components.createP2PStatusHandler(args, topicsMetrics)
+	if err != nil {
+		return err
+	}
+
+	cryptoHandler, err := createEthereumCryptoHandler(ethereumConfigs)
+	if err != nil {
+		return err
+	}
+
+	components.ethereumRelayerAddress = cryptoHandler.GetAddress()
+
+	tokensMapper, err := components.createTokensMapper(args.Configs.GeneralConfig.TokensMapper, batchProcessor.ToMultiversX, args.ClientWrapper)
+	if err != nil {
+		return err
+	}
+
+	signaturesHolder := ethmultiversx.NewSignatureHolder()
+	components.ethToMultiversXSignaturesHolder = signaturesHolder
+	err = components.broadcaster.AddBroadcastClient(signaturesHolder)
+	if err != nil {
+		return err
+	}
+
+	safeContractAddress := common.HexToAddress(ethereumConfigs.SafeContractAddress)
+
+	err = components.createRawTransactionsExporter(args)
+	if err != nil {
+		return err
+	}
+
+	ethClientLogId := components.evmCompatibleChain.EvmCompatibleChainClientLogId()
+	argsEthClient := ethereum.ArgsEthereumClient{
+		ClientWrapper:                 args.ClientWrapper,
+		Erc20ContractsHandler:         args.Erc20ContractsHolder,
+		Log:                           core.NewLoggerWithIdentifier(logger.GetOrCreate(ethClientLogId), ethClientLogId),
+		AddressConverter:              components.addressConverter,
+		Broadcaster:                   components.broadcaster,
+		CryptoHandler:                 cryptoHandler,
+		TokensMapper:                  tokensMapper,
+		SignatureHolder:               signaturesHolder,
+		SafeContractAddress:           safeContractAddress,
+		GasHandler:                    gs,
+		RawTransactionsExporter:       components.rawTransactionsExporter,
+		GasUsageTracker:               components.ethereumGasUsageTracker,
+		ConfirmationPolicy:            components.confirmationPolicy,
+		TransferGasLimitBase:          ethereumConfigs.GasLimitBase,
+		TransferGasLimitForEach:       ethereumConfigs.GasLimitForEach,
+		ClientAvailabilityAllowDelta:  ethereumConfigs.ClientAvailabilityAllowDelta,
+		EventsBlockRangeFrom:          ethereumConfigs.EventsBlockRangeFrom,
+		EventsBlockRangeTo:            ethereumConfigs.EventsBlockRangeTo,
+		ExecutionEventsLookbackBlocks: ethereumConfigs.ExecutionEventsLookbackBlocks,
+	}
+
+	components.ethClient, err = ethereum.NewEthereumClient(argsEthClient)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createRawTransactionsExporter(args ArgsEthereumToMultiversXBridge) error {
+	exportConfig := args.Configs.GeneralConfig.Eth.RawTransactionsExport
+	if !exportConfig.Enabled {
+		components.rawTransactionsExporter = disabled.NewDisabledRawTransactionsExporter()
+		return nil
+	}
+
+	directory := ""
+	if len(exportConfig.Directory) > 0 {
+		directory = path.Join(args.Configs.FlagsConfig.WorkingDir, exportConfig.Directory)
+	}
+
+	argsExporter := ethereum.ArgsRawTransactionsExporter{
+		Log:             components.baseLogger,
+		Directory:       directory,
+		MaxTransactions: exportConfig.MaxTransactions,
+	}
+
+	var err error
+	components.rawTransactionsExporter, err = ethereum.NewRawTransactionsExporter(argsExporter)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createConfirmationPolicy(args ArgsEthereumToMultiversXBridge) error {
+	policyConfig := args.Configs.GeneralConfig.Eth.ConfirmationPolicy
+	if !policyConfig.Enabled {
+		components.confirmationPolicy = disabled.NewDisabledConfirmationPolicy()
+		return nil
+	}
+
+	policy, err := confirmationPolicyManagement.NewConfirmationPolicy(policyConfig)
+	if err != nil {
+		return err
+	}
+
+	components.confirmationPolicy = policy
+	components.baseLogger.Info("confirmation policy enabled",
+		"default confirmations", policyConfig.DefaultNumConfirmations, "num tokens", len(policyConfig.Tokens),
+		"policy hash", hex.EncodeToString(policy.Hash()))
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createRoundingPolicy(args ArgsEthereumToMultiversXBridge) error {
+	policyConfig := args.Configs.GeneralConfig.Relayer.RoundingPolicy
+	if !policyConfig.Enabled {
+		components.roundingPolicy = disabled.NewDisabledRoundingPolicy()
+		return nil
+	}
+
+	policy, err := roundingPolicyManagement.NewRoundingPolicy(policyConfig)
+	if err != nil {
+		return err
+	}
+
+	components.roundingPolicy = policy
+	components.baseLogger.Info("rounding policy enabled",
+		"default mode", policyConfig.DefaultMode, "num tokens", len(policyConfig.Tokens),
+		"policy hash", hex.EncodeToString(policy.Hash()))
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createMultiversXRoleProvider(args ArgsEthereumToMultiversXBridge) error {
+	configs := args.Configs.GeneralConfig
+	multiversXRoleProviderLogId := components.evmCompatibleChain.MultiversXRoleProviderLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXRoleProviderLogId), multiversXRoleProviderLogId)
+
+	argsRoleProvider := roleproviders.ArgsMultiversXRoleProvider{
+		DataGetter:         components.mxDataGetter,
+		Log:                log,
+		Timer:              components.timer,
+		ProbationPeriod:    time.Duration(configs.Relayer.RoleProvider.ProbationPeriodInSeconds) * time.Second,
+		ProbationAddresses: configs.Relayer.RoleProvider.ProbationAddresses,
+	}
+
+	var err error
+	components.multiversXRoleProvider, err = roleproviders.NewMultiversXRoleProvider(argsRoleProvider)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "MultiversX role provider",
+		PollingInterval:  time.Duration(configs.Relayer.RoleProvider.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         components.multiversXRoleProvider,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createLeaderSelector(args ArgsEthereumToMultiversXBridge) error {
+	configs := args.Configs.GeneralConfig
+	switch configs.Relayer.LeaderSelection.Strategy {
+	case "", topology.UniformStrategy:
+		components.leaderSelector = topology.NewHashRandomSelector()
+		return nil
+	case topology.StakeWeightedStrategy:
+	default:
+		return fmt.Errorf("%w for Relayer.LeaderSelection.Strategy: %q", errInvalidValue, configs.Relayer.LeaderSelection.Strategy)
+	}
+
+	stakesProviderLogId := components.evmCompatibleChain.BaseLogId() + stakesProviderLogIdSuffix
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(stakesProviderLogId), stakesProviderLogId)
+	stakesProvider, err := roleproviders.NewMultiversXStakesProvider(roleproviders.ArgsMultiversXStakesProvider{
+		DataGetter: components.mxDataGetter,
+		Log:        log,
+	})
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "MultiversX stakes provider",
+		PollingInterval:  time.Duration(configs.Relayer.RoleProvider.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         stakesProvider,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	components.leaderSelector, err = topology.NewStakeWeightedSelector(topology.ArgsStakeWeightedSelector{
+		StakesProvider: stakesProvider,
+	})
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createEthereumRoleProvider(args ArgsEthereumToMultiversXBridge) error {
+	configs := args.Configs.GeneralConfig
+	ethRoleProviderLogId := components.evmCompatibleChain.EvmCompatibleChainRoleProviderLogId()
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethRoleProviderLogId), ethRoleProviderLogId)
+	argsRoleProvider := roleproviders.ArgsEthereumRoleProvider{
+		EthereumChainInteractor: args.ClientWrapper,
+		Log:                     log,
+	}
+
+	var err error
+	components.ethereumRoleProvider, err = roleproviders.NewEthereumRoleProvider(argsRoleProvider)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             string(components.evmCompatibleChain) + " role provider",
+		PollingInterval:  time.Duration(configs.Relayer.RoleProvider.PollingIntervalInMillis) * time.Millisecond,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         components.ethereumRoleProvider,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createBalanceMonitors(args ArgsEthereumToMultiversXBridge) error {
+	monitorConfig := args.Configs.GeneralConfig.Relayer.BalanceMonitor
+	if !monitorConfig.Enabled {
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.BalanceMonitorStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	ethBalanceGetter, mvxBalanceGetter, err := components.createBalanceGetters(args)
+	if err != nil {
+		return err
+	}
+
+	err = components.createBalanceMonitor(monitorConfig, monitorConfig.Ethereum, string(components.evmCompatibleChain), ethBalanceGetter, statusHandler)
+	if err != nil {
+		return err
+	}
+
+	return components.createBalanceMonitor(monitorConfig, monitorConfig.MultiversX, multiversXChainName, mvxBalanceGetter, statusHandler)
+}
+
+func (components *ethMultiversXBridgeComponents) createGasUsageTrackers(args ArgsEthereumToMultiversXBridge) error {
+	trackerConfig := args.Configs.GeneralConfig.Relayer.GasUsageTracker
+	if !trackerConfig.Enabled {
+		components.ethereumGasUsageTracker = disabled.NewDisabledGasUsageTracker()
+		components.multiversXGasUsageTracker = disabled.NewDisabledGasUsageTracker()
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.GasUsageTrackerStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	ethGasUsedGetter, err := gasUsageTracker.NewEthereumGasUsedGetter(args.ClientWrapper)
+	if err != nil {
+		return err
+	}
+	components.ethereumGasUsageTracker, err = components.createGasUsageTracker(trackerConfig, string(components.evmCompatibleChain), ethGasUsedGetter, statusHandler)
+	if err != nil {
+		return err
+	}
+
+	mvxGasUsedGetter, err := gasUsageTracker.NewMultiversXGasUsedGetter(args.Proxy)
+	if err != nil {
+		return err
+	}
+	components.multiversXGasUsageTracker, err = components.createGasUsageTracker(trackerConfig, multiversXChainName, mvxGasUsedGetter, statusHandler)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createGasUsageTracker(
+	trackerConfig config.GasUsageTrackerConfig,
+	name string,
+	gasUsedGetter gasUsageTracker.GasUsedGetter,
+	statusHandler core.StatusHandler,
+) (gasUsageTrackerHandler, error) {
+	gasUsageLogId := name + gasUsageLogIdSuffix
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(gasUsageLogId), gasUsageLogId)
+	argsTracker := gasUsageTracker.ArgsGasUsageTracker{
+		Name:                   name,
+		GasUsedGetter:          gasUsedGetter,
+		Storer:                 components.statusStorer,
+		StatusHandler:          statusHandler,
+		Log:                    log,
+		WindowSize:             trackerConfig.WindowSize,
+		ThresholdPercent:       trackerConfig.ThresholdPercent,
+		MaxPendingTransactions: trackerConfig.MaxPendingTransactions,
+	}
+	tracker, err := gasUsageTracker.NewGasUsageTracker(argsTracker)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the %s gas usage tracker", err, name)
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             name + " gas usage tracker",
+		PollingInterval:  time.Duration(trackerConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         tracker,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return tracker, nil
+}
+
+func (components *ethMultiversXBridgeComponents) createP2PStatusHandler(args ArgsEthereumToMultiversXBridge, topicsMetrics p2p.TopicsMetrics) error {
+	metricsConfig := args.Configs.GeneralConfig.P2P.TopicsMetrics
+	if !metricsConfig.Enabled {
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.P2PStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	adapter, err := p2p.NewStatusHandlerAdapter(p2p.ArgsStatusHandlerAdapter{
+		StatusHandler: statusHandler,
+		Messenger:     components.messenger,
+		TopicsMetrics: topicsMetrics,
+	})
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              components.baseLogger,
+		Name:             "p2p status handler",
+		PollingInterval:  time.Duration(metricsConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         adapter,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createRuntimeMonitor(args ArgsEthereumToMultiversXBridge) error {
+	monitorConfig := args.Configs.GeneralConfig.Relayer.RuntimeMonitor
+	if !monitorConfig.Enabled {
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.RuntimeMonitorStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(runtimeMonitorLogId), runtimeMonitorLogId)
+	argsRuntimeMonitor := runtimeMonitor.ArgsRuntimeMonitor{
+		StatsProvider:          runtimeMonitor.NewStatsProvider(),
+		StatusHandler:          statusHandler,
+		Log:                    log,
+		Timer:                  components.timer,
+		TrendWindow:            time.Duration(monitorConfig.TrendWindowInMinutes) * time.Minute,
+		MaxGoroutines:          monitorConfig.MaxGoroutines,
+		MaxOpenFileDescriptors: monitorConfig.MaxOpenFileDescriptors,
+		MaxHeapInUse:           monitorConfig.MaxHeapInUseInMB * bytesInMB,
+		MaxGrowthPercent:       monitorConfig.MaxGrowthPercent,
+		ProfilesDirectory:      monitorConfig.ProfilesDirectory,
+	}
+	monitor, err := runtimeMonitor.NewRuntimeMonitor(argsRuntimeMonitor)
+	if err != nil {
+		return fmt.Errorf("%w for the runtime monitor", err)
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "runtime monitor",
+		PollingInterval:  time.Duration(monitorConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         monitor,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createBalanceGetters(args ArgsEthereumToMultiversXBridge) (balanceMonitor.BalanceGetter, balanceMonitor.BalanceGetter, error) {
+	ethBalanceGetter, err := balanceMonitor.NewEthereumBalanceGetter(args.ClientWrapper, components.ethereumRelayerAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mvxBalanceGetter, err := balanceMonitor.NewMultiversXBalanceGetter(args.Proxy, components.multiversXRelayerAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ethBalanceGetter, mvxBalanceGetter, nil
+}
+
+func (components *ethMultiversXBridgeComponents) createBalanceMonitor(
+	monitorConfig config.BalanceMonitorConfig,
+	thresholds config.BalanceThresholdsConfig,
+	name string,
+	balanceGetter balanceMonitor.BalanceGetter,
+	statusHandler core.StatusHandler,
+) error {
+	minimumBalance, err := balanceMonitor.ParseDenominatedAmount(thresholds.MinimumBalance, nativeCurrencyDecimals)
+	if err != nil {
+		return fmt.Errorf("%w for the %s balance monitor", err, name)
+	}
+
+	balanceMonitorLogId := name + balanceMonitorLogIdSuffix
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(balanceMonitorLogId), balanceMonitorLogId)
+	argsBalanceMonitor := balanceMonitor.ArgsBalanceMonitor{
+		Name:               name,
+		BalanceGetter:      balanceGetter,
+		Decimals:           nativeCurrencyDecimals,
+		MinimumBalance:     minimumBalance,
+		MinimumDaysToEmpty: thresholds.MinimumDaysToEmpty,
+		BurnRateWindow:     time.Duration(monitorConfig.BurnRateWindowInHours) * time.Hour,
+		StatusHandler:      statusHandler,
+		Log:                log,
+		Timer:              components.timer,
+	}
+	monitor, err := balanceMonitor.NewBalanceMonitor(argsBalanceMonitor)
+	if err != nil {
+		return fmt.Errorf("%w for the %s balance monitor", err, name)
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             name + " balance monitor",
+		PollingInterval:  time.Duration(monitorConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         monitor,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createFaucetRequesters(args ArgsEthereumToMultiversXBridge) error {
+	faucetConfig := args.Configs.GeneralConfig.Relayer.Faucet
+	if !faucetConfig.Enabled {
+		return nil
+	}
+
+	ethBalanceGetter, mvxBalanceGetter, err := components.createBalanceGetters(args)
+	if err != nil {
+		return err
+	}
+
+	httpClient := &http.Client{Timeout: time.Duration(faucetConfig.RequestTimeoutInSeconds) * time.Second}
+	err = components.createFaucetRequester(faucetConfig, faucetConfig.Ethereum, string(components.evmCompatibleChain),
+		components.ethereumRelayerAddress.Hex(), ethBalanceGetter, httpClient)
+	if err != nil {
+		return err
+	}
+
+	mvxAddress, err := components.multiversXRelayerAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+
+	return components.createFaucetRequester(faucetConfig, faucetConfig.MultiversX, multiversXChainName,
+		mvxAddress, mvxBalanceGetter, httpClient)
+}
+
+func (components *ethMultiversXBridgeComponents) createFaucetRequester(
+	faucetConfig config.FaucetConfig,
+	endpointConfig config.FaucetEndpointConfig,
+	name string,
+	address string,
+	balanceGetter faucet.BalanceGetter,
+	httpClient faucet.HTTPClient,
+) error {
+	if len(endpointConfig.URL) == 0 {
+		return nil
+	}
+
+	minimumBalance, err := balanceMonitor.ParseDenominatedAmount(endpointConfig.MinimumBalance, nativeCurrencyDecimals)
+	if err != nil {
+		return fmt.Errorf("%w for the %s faucet", err, name)
+	}
+
+	faucetLogId := name + faucetLogIdSuffix
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(faucetLogId), faucetLogId)
+	argsFaucetRequester := faucet.ArgsFaucetRequester{
+		Name:           name,
+		BalanceGetter:  balanceGetter,
+		HTTPClient:     httpClient,
+		URL:            endpointConfig.URL,
+		Method:         endpointConfig.Method,
+		Body:           endpointConfig.Body,
+		Address:        address,
+		MinimumBalance: minimumBalance,
+		Cooldown:       time.Duration(faucetConfig.CooldownInSeconds) * time.Second,
+		Log:            log,
+		Timer:          components.timer,
+	}
+	requester, err := faucet.NewFaucetRequester(argsFaucetRequester)
+	if err != nil {
+		return fmt.Errorf("%w for the %s faucet", err, name)
+	}
+
+	log.Warn("faucet requester enabled, this should only be used on test networks", "URL", endpointConfig.URL)
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             name + " faucet requester",
+		PollingInterval:  time.Duration(faucetConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         requester,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createPostmortemCapturer(args ArgsEthereumToMultiversXBridge) error {
+	postmortemConfig := args.Configs.GeneralConfig.Relayer.Postmortem
+	if !postmortemConfig.Enabled {
+		components.postmortemCapturer = disabled.NewDisabledPostmortemCapturer()
+		return nil
+	}
+
+	argsCapturer := postmortem.ArgsPostmortemCapturer{
+		Directory:     path.Join(args.Configs.FlagsConfig.WorkingDir, postmortemConfig.Directory),
+		MinInterval:   time.Duration(postmortemConfig.MinIntervalInSeconds) * time.Second,
+		MaxSnapshots:  postmortemConfig.MaxSnapshots,
+		MetricsHolder: components.metricsHolder,
+		Timer:         components.timer,
+	}
+
+	var err error
+	components.postmortemCapturer, err = postmortem.NewPostmortemCapturer(argsCapturer)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createIncidentsQueue(args ArgsEthereumToMultiversXBridge) error {
+	incidentsConfig := args.Configs.GeneralConfig.Relayer.Incidents
+	if !incidentsConfig.Enabled {
+		components.incidentsQueue = disabled.NewDisabledIncidentsQueue()
+		return nil
+	}
+
+	argsIncidentsQueue := incidentsManagement.ArgsIncidentsQueue{
+		Log:          core.NewLoggerWithIdentifier(logger.GetOrCreate(incidentsLogId), incidentsLogId),
+		FilePath:     incidentsConfig.FilePath,
+		MaxIncidents: incidentsConfig.MaxIncidents,
+	}
+
+	var err error
+	components.incidentsQueue, err = incidentsManagement.NewIncidentsQueue(argsIncidentsQueue)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createDeadLetters(args ArgsEthereumToMultiversXBridge) error {
+	deadLettersConfig := args.Configs.GeneralConfig.Relayer.DeadLetters
+	if !deadLettersConfig.Enabled {
+		components.deadLetters = disabled.NewDisabledDeadLetters()
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.DeadLettersStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsDeadLetters := deadLettersManagement.ArgsDeadLetters{
+		Log:            core.NewLoggerWithIdentifier(logger.GetOrCreate(deadLettersLogId), deadLettersLogId),
+		StatusHandler:  statusHandler,
+		FilePath:       deadLettersConfig.FilePath,
+		MaxFailures:    deadLettersConfig.MaxFailures,
+		MaxDeadLetters: deadLettersConfig.MaxDeadLetters,
+		// only the MultiversX batches can be rejected by the relayers, the Ethereum deposits can not be refunded
+		RefundableDirections: []string{components.evmCompatibleChain.MultiversXToEvmCompatibleChainName()},
+	}
+
+	components.deadLetters, err = deadLettersManagement.NewDeadLetters(argsDeadLetters)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createDirectMessages(args ArgsEthereumToMultiversXBridge) error {
+	directMessagesConfig := args.Configs.GeneralConfig.Relayer.DirectMessages
+	if !directMessagesConfig.Enabled {
+		components.directMessages = disabled.NewDisabledDirectMessages()
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.DirectMessagesStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsDirectMessages := directMessagesManagement.ArgsDirectMessages{
+		Log:           core.NewLoggerWithIdentifier(logger.GetOrCreate(directMessagesLogId), directMessagesLogId),
+		StatusHandler: statusHandler,
+		Sender:        components.broadcaster,
+		PublicKey:     components.multiversXRelayerAddress.AddressBytes(),
+		MaxMessages:   directMessagesConfig.MaxMessages,
+	}
+
+	directMessages, err := directMessagesManagement.NewDirectMessages(argsDirectMessages)
+	if err != nil {
+		return err
+	}
+
+	err = components.broadcaster.AddDirectMessageClient(directMessages)
+	if err != nil {
+		return err
+	}
+
+	components.directMessages = directMessages
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createTransferReceipts(args ArgsEthereumToMultiversXBridge) error {
+	if !args.Configs.GeneralConfig.Relayer.TransferReceipts.Enabled {
+		components.transferReceipts = disabled.NewDisabledTransferReceipts()
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.TransferReceiptsStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsTransferReceipts := transferReceiptsManagement.ArgsTransferReceipts{
+		Log:                      core.NewLoggerWithIdentifier(logger.GetOrCreate(transferReceiptsLogId), transferReceiptsLogId),
+		StatusHandler:            statusHandler,
+		Storer:                   components.batchResultsStorer,
+		DataGetter:               components.mxDataGetter,
+		PrivateKey:               components.multiversXRelayerPrivateKey,
+		SingleSigner:             singleSigner,
+		Timer:                    components.timer,
+		MultiversXToEthDirection: components.evmCompatibleChain.MultiversXToEvmCompatibleChainName(),
+	}
+
+	components.transferReceipts, err = transferReceiptsManagement.NewTransferReceipts(argsTransferReceipts)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createCostAccounting(args ArgsEthereumToMultiversXBridge) error {
+	costAccountingConfig := args.Configs.GeneralConfig.Relayer.CostAccounting
+	if !costAccountingConfig.Enabled {
+		components.costAccounting = disabled.NewDisabledCostAccounting()
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.CostAccountingStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	ethCostGetter, err := accounting.NewEthereumCostGetter(args.ClientWrapper)
+	if err != nil {
+		return err
+	}
+	mvxCostGetter, err := accounting.NewMultiversXCostGetter(args.Proxy)
+	if err != nil {
+		return err
+	}
+	relayer, err := components.multiversXRelayerAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(costAccountingLogId), costAccountingLogId)
+	argsCostLedger := accounting.ArgsCostLedger{
+		Log:           log,
+		StatusHandler: statusHandler,
+		Storer:        components.batchResultsStorer,
+		Timer:         components.timer,
+		Relayer:       relayer,
+		Destinations: map[string]accounting.Destination{
+			components.evmCompatibleChain.EvmCompatibleChainToMultiversXName(): {
+				Chain:      multiversXChainName,
+				CostGetter: mvxCostGetter,
+			},
+			components.evmCompatibleChain.MultiversXToEvmCompatibleChainName(): {
+				Chain:      string(components.evmCompatibleChain),
+				CostGetter: ethCostGetter,
+			},
+		},
+		MaxPendingExecutions: costAccountingConfig.MaxPendingExecutions,
+	}
+	costLedger, err := accounting.NewCostLedger(argsCostLedger)
+	if err != nil {
+		return err
+	}
+	components.costAccounting = costLedger
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "cost accounting",
+		PollingInterval:  time.Duration(costAccountingConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         costLedger,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createIdempotencyGuard(args ArgsEthereumToMultiversXBridge) error {
+	if !args.Configs.GeneralConfig.Relayer.Idempotency.Enabled {
+		components.idempotencyGuard = disabled.NewDisabledIdempotencyGuard()
+		return nil
+	}
+
+	argsIdempotencyGuard := idempotency.ArgsIdempotencyGuard{
+		Log:    core.NewLoggerWithIdentifier(logger.GetOrCreate(idempotencyGuardLogId), idempotencyGuardLogId),
+		Storer: components.statusStorer,
+		Timer:  components.timer,
+	}
+
+	var err error
+	components.idempotencyGuard, err = idempotency.NewIdempotencyGuard(argsIdempotencyGuard)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createBatchTagger(cfg config.BatchTagsConfig) error {
+	if !cfg.Enabled {
+		components.batchTagger = disabled.NewDisabledBatchTagger()
+		return nil
+	}
+
+	argsBatchTagger := batchTags.ArgsBatchTagger{
+		StaticTags: cfg.StaticTags,
+		Rules:      cfg.Rules,
+	}
+
+	var err error
+	components.batchTagger, err = batchTags.NewBatchTagger(argsBatchTagger)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createPreAgreement(cfg config.PreAgreementConfig, direction string, log logger.Logger) (ethmultiversx.PreAgreement, error) {
+	if !cfg.Enabled {
+		return disabled.NewDisabledPreAgreement(), nil
+	}
+
+	argsPreAgreement := preAgreementManagement.ArgsPreAgreement{
+		Log:              log,
+		Direction:        direction,
+		Broadcaster:      components.broadcaster,
+		RelayersProvider: components.multiversXRoleProvider,
+		PublicKey:        components.multiversXRelayerAddress.AddressBytes(),
+		Timeout:          time.Duration(cfg.TimeoutInMillis) * time.Millisecond,
+	}
+
+	preAgreement, err := preAgreementManagement.NewPreAgreement(argsPreAgreement)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.broadcaster.AddPreAgreementClient(preAgreement)
+	if err != nil {
+		return nil, err
+	}
+
+	return preAgreement, nil
+}
+
+func (components *ethMultiversXBridgeComponents) createTimingJitter(configs config.Config) error {
+	jitterConfig := configs.Relayer.TimingJitter
+	if !jitterConfig.Enabled {
+		return nil
+	}
+
+	maxJitter := time.Duration(jitterConfig.MaxJitterInMillis) * time.Millisecond
+	if maxJitter == 0 {
+		return fmt.Errorf("%w for Relayer.TimingJitter.MaxJitterInMillis, got: 0", errInvalidValue)
+	}
+	for name, stateMachineConfig := range configs.StateMachine {
+		// a jittered step must not be delayed past the next one, so the step keeps the leader slot it was scheduled in
+		stepDuration := time.Duration(stateMachineConfig.StepDurationInMillis) * time.Millisecond
+		if maxJitter >= stepDuration {
+			return fmt.Errorf("%w for Relayer.TimingJitter.MaxJitterInMillis, got: %v, must be lower than the %s step duration: %v",
+				errInvalidValue, maxJitter, name, stepDuration)
+		}
+	}
+
+	components.maxTimingJitter = maxJitter
+
+	return nil
+}
+
+// newPollingHandler creates a polling handler whose executions are delayed by a random jitter, if enabled
+func (components *ethMultiversXBridgeComponents) newPollingHandler(args polling.ArgsPollingHandler) (closablePollingHandler, error) {
+	if components.maxTimingJitter > 0 {
+		executor, err := timingJitterManagement.NewJitteredExecutor(timingJitterManagement.ArgsJitteredExecutor{
+			Executor:  args.Executor,
+			MaxJitter: components.maxTimingJitter,
+			Log:       args.Log,
+			Timer:     components.timer,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w for the %s polling handler", err, args.Name)
+		}
+
+		args.Executor = executor
+	}
+
+	pollingHandler, err := reloadablePolling.NewReloadablePollingHandler(reloadablePolling.ArgsReloadablePollingHandler{
+		Log:              args.Log,
+		Name:             args.Name,
+		PollingInterval:  args.PollingInterval,
+		PollingWhenError: args.PollingWhenError,
+		Executor:         args.Executor,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w for the %s polling handler", err, args.Name)
+	}
+	components.addReloadableComponent(pollingHandler)
+
+	return pollingHandler, nil
+}
+
+func (components *ethMultiversXBridgeComponents) createGovernancePause(args ArgsEthereumToMultiversXBridge) error {
+	pauseConfig := args.Configs.GeneralConfig.Relayer.GovernancePause
+	if !pauseConfig.Enabled {
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.GovernancePauseStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(governancePauseLogId), governancePauseLogId)
+	argsGovernancePause := governancePauseManagement.ArgsGovernancePause{
+		EthereumPauseGetter:   args.ClientWrapper,
+		MultiversXPauseGetter: components.mxDataGetter,
+		StatusHandler:         statusHandler,
+		Incidents:             components.incidentsQueue,
+		Log:                   log,
+	}
+	watcher, err := governancePauseManagement.NewGovernancePause(argsGovernancePause)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "governance pause",
+		PollingInterval:  time.Duration(pauseConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         watcher,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+	components.governancePause = watcher
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createTokenMetadataMonitor(args ArgsEthereumToMultiversXBridge) error {
+	tokenMetadataConfig := args.Configs.GeneralConfig.Relayer.TokenMetadata
+	if !tokenMetadataConfig.Enabled {
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.TokenMetadataStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(tokenMetadataLogId), tokenMetadataLogId)
+	argsTokenMetadataMonitor := tokenMetadataManagement.ArgsTokenMetadataMonitor{
+		ERC20Tokens:    tokenMetadataConfig.ERC20Tokens,
+		MetadataGetter: args.Erc20ContractsHolder,
+		DataGetter:     components.mxDataGetter,
+		Storer:         components.statusStorer,
+		StatusHandler:  statusHandler,
+		Incidents:      components.incidentsQueue,
+		Log:            log,
+	}
+	monitor, err := tokenMetadataManagement.NewTokenMetadataMonitor(argsTokenMetadataMonitor)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "token metadata monitor",
+		PollingInterval:  time.Duration(tokenMetadataConfig.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         monitor,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createOperatorPause() error {
+	statusHandler, err := status.NewStatusHandler(core.OperatorPauseStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsOperatorPause := operatorPauseManagement.ArgsOperatorPause{
+		Log:           core.NewLoggerWithIdentifier(logger.GetOrCreate(operatorPauseLogId), operatorPauseLogId),
+		StatusHandler: statusHandler,
+	}
+
+	components.operatorPause, err = operatorPauseManagement.NewOperatorPause(argsOperatorPause)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createContextAudit(cfg config.ContextAuditConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.ContextAuditStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsContextAudit := contextAuditManagement.ArgsContextAudit{
+		Log:              core.NewLoggerWithIdentifier(logger.GetOrCreate(contextAuditLogId), contextAuditLogId),
+		StatusHandler:    statusHandler,
+		OverrunTolerance: time.Duration(cfg.OverrunToleranceInMillis) * time.Millisecond,
+	}
+
+	components.contextAudit, err = contextAuditManagement.NewContextAudit(argsContextAudit)
+	components.stepTimeout = time.Duration(cfg.StepTimeoutInSeconds) * time.Second
+
+	return err
+}
+
+// createStateMachineExecutor wraps the state machine so each step runs with the audited step deadline and so its steps
+// are skipped while the provided half-bridge is paused by an operator or while the governance pause is observed
+func (components *ethMultiversXBridgeComponents) createStateMachineExecutor(sm StateMachine, halfBridge string, log logger.Logger) (governancePauseManagement.Executor, error) {
+	var stepExecutor governancePauseManagement.Executor = sm
+	if !check.IfNil(components.contextAudit) {
+		argsAuditedExecutor := contextAuditManagement.ArgsAuditedExecutor{
+			Executor:    sm,
+			Auditor:     components.contextAudit,
+			Name:        halfBridge,
+			StepTimeout: components.stepTimeout,
+		}
+		auditedExecutor, err := contextAuditManagement.NewAuditedExecutor(argsAuditedExecutor)
+		if err != nil {
+			return nil, err
+		}
+		stepExecutor = auditedExecutor
+	}
+
+	argsOperatorPausableExecutor := operatorPauseManagement.ArgsPausableExecutor{
+		Executor:     stepExecutor,
+		PauseChecker: components.operatorPause,
+		HalfBridge:   halfBridge,
+		Log:          log,
+	}
+	operatorPausableExecutor, err := operatorPauseManagement.NewPausableExecutor(argsOperatorPausableExecutor)
+	if err != nil {
+		return nil, err
+	}
+
+	if check.IfNil(components.governancePause) {
+		return operatorPausableExecutor, nil
+	}
+
+	argsPausableExecutor := governancePauseManagement.ArgsPausableExecutor{
+		Executor:     operatorPausableExecutor,
+		PauseChecker: components.governancePause,
+		Log:          log,
+	}
+
+	return governancePauseManagement.NewPausableExecutor(argsPausableExecutor)
+}
+
+func (components *ethMultiversXBridgeComponents) createNetworkValidator(args ArgsEthereumToMultiversXBridge) error {
+	if !args.Configs.GeneralConfig.Relayer.NetworkCheck.Enabled {
+		components.networkValidator = disabled.NewDisabledNetworkValidator()
+		return nil
+	}
+
+	multisigAddress, err := components.multiversXMultisigContractAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+	safeAddress, err := components.multiversXSafeContractAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+
+	ethereumConfigs := args.Configs.GeneralConfig.Eth
+	argsNetworkValidator := networkValidatorManagement.ArgsNetworkValidator{
+		Log:                       components.baseLogger,
+		EthereumClient:            args.ClientWrapper,
+		MultiversXDataGetter:      components.mxDataGetter,
+		EthereumMultisigAddress:   common.HexToAddress(ethereumConfigs.MultisigContractAddress),
+		EthereumSafeAddress:       common.HexToAddress(ethereumConfigs.SafeContractAddress),
+		MultiversXMultisigAddress: multisigAddress,
+		MultiversXSafeAddress:     safeAddress,
+	}
+
+	components.networkValidator, err = networkValidatorManagement.NewNetworkValidator(argsNetworkValidator)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createSyncReporter() error {
+	argsSyncReporter := syncReporterManagement.ArgsSyncReporter{
+		Log:                          components.baseLogger,
+		EthereumClient:               components.ethClient,
+		MultiversXClient:             components.multiversXClient,
+		EthToMultiversXStatusHandler: components.ethToMultiversXStatusHandler,
+		MultiversXToEthStatusHandler: components.multiversXToEthStatusHandler,
+	}
+
+	var err error
+	components.syncReporter, err = syncReporterManagement.NewSyncReporter(argsSyncReporter)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createRelayedClaimsHandler(args ArgsEthereumToMultiversXBridge) error {
+	claimsConfig := args.Configs.GeneralConfig.MultiversX.RelayedClaims
+	if !claimsConfig.Enabled {
+		components.relayedClaimsHandler = disabled.NewDisabledRelayedClaimsHandler()
+		return nil
+	}
+
+	wallet := interactors.NewWallet()
+	sponsorPrivateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(claimsConfig.SponsorPrivateKeyFile)
+	if err != nil {
+		return err
+	}
+	sponsorPrivateKey, err := keyGen.PrivateKeyFromByteArray(sponsorPrivateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	minimumFees, err := parseRelayedClaimsMinimumFees(claimsConfig.Tokens)
+	if err != nil {
+		return err
+	}
+
+	argsNonceHandler := nonceHandlerV2.ArgsNonceTransactionsHandlerV2{
+		Proxy:            components.proxy,
+		IntervalToResend: time.Second * time.Duration(claimsConfig.IntervalToResendTxsInSeconds),
+	}
+	nonceTxHandler, err := nonceHandlerV2.NewNonceTransactionHandlerV2(argsNonceHandler)
+	if err != nil {
+		return err
+	}
+
+	argsRelayedClaimsHandler := relayedClaims.ArgsRelayedClaimsHandler{
+		Log:                  core.NewLoggerWithIdentifier(logger.GetOrCreate(relayedClaimsLogId), relayedClaimsLogId),
+		Proxy:                components.proxy,
+		NonceTxHandler:       nonceTxHandler,
+		SponsorPrivateKey:    sponsorPrivateKey,
+		SingleSigner:         singleSigner,
+		KeyGen:               keyGen,
+		ClaimContractAddress: claimsConfig.ClaimContractAddress,
+		ClaimFunction:        claimsConfig.ClaimFunction,
+		ClaimGasLimit:        claimsConfig.ClaimGasLimit,
+		MinimumFees:          minimumFees,
+		MaxClaimsPerUser:     claimsConfig.MaxClaimsPerUser,
+		RateLimitWindow:      time.Second * time.Duration(claimsConfig.RateLimitWindowInSeconds),
+	}
+
+	handler, err := relayedClaims.NewRelayedClaimsHandler(argsRelayedClaimsHandler)
+	if err != nil {
+		_ = nonceTxHandler.Close()
+		return err
+	}
+
+	components.relayedClaimsHandler = handler
+	components.addClosableComponent(handler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createHeartbeatPublisher(args ArgsEthereumToMultiversXBridge) error {
+	heartbeatConfig := args.Configs.GeneralConfig.Relayer.Heartbeat
+	if !heartbeatConfig.Enabled {
+		return nil
+	}
+
+	sender, err := components.createHeartbeatSender(heartbeatConfig)
+	if err != nil {
+		return err
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.HeartbeatStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	address, err := components.multiversXRelayerAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(heartbeatLogId), heartbeatLogId)
+	argsHeartbeatPublisher := heartbeatManagement.ArgsHeartbeatPublisher{
+		Address:       address,
+		Sender:        sender,
+		Interval:      time.Duration(heartbeatConfig.IntervalInSeconds) * time.Second,
+		StatusHandler: statusHandler,
+		Log:           log,
+		Timer:         components.timer,
+	}
+	publisher, err := heartbeatManagement.NewHeartbeatPublisher(argsHeartbeatPublisher)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "heartbeat publisher",
+		PollingInterval:  time.Duration(heartbeatConfig.IntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         publisher,
+	}
+
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createHeartbeatSender(heartbeatConfig config.HeartbeatConfig) (heartbeatManagement.Sender, error) {
+	switch heartbeatConfig.Mode {
+	case heartbeatManagement.CollectorMode:
+		argsCollectorSender := heartbeatManagement.ArgsCollectorSender{
+			HTTPClient:   &http.Client{Timeout: time.Duration(heartbeatConfig.RequestTimeoutInSeconds) * time.Second},
+			URL:          heartbeatConfig.CollectorURL,
+			PrivateKey:   components.multiversXRelayerPrivateKey,
+			SingleSigner: singleSigner,
+		}
+
+		return heartbeatManagement.NewCollectorSender(argsCollectorSender)
+	case heartbeatManagement.ContractMode:
+		maxDailyCost, err := balanceMonitor.ParseDenominatedAmount(heartbeatConfig.MaxDailyCost, nativeCurrencyDecimals)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the heartbeat MaxDailyCost", err)
+		}
+
+		argsNonceHandler := nonceHandlerV2.ArgsNonceTransactionsHandlerV2{
+			Proxy:            components.proxy,
+			IntervalToResend: time.Second * time.Duration(heartbeatConfig.IntervalToResendTxsInSeconds),
+		}
+		nonceTxHandler, err := nonceHandlerV2.NewNonceTransactionHandlerV2(argsNonceHandler)
+		if err != nil {
+			return nil, err
+		}
+
+		argsContractSender := heartbeatManagement.ArgsContractSender{
+			Proxy:           components.proxy,
+			NonceTxHandler:  nonceTxHandler,
+			PrivateKey:      components.multiversXRelayerPrivateKey,
+			SingleSigner:    singleSigner,
+			ContractAddress: heartbeatConfig.ContractAddress,
+			Function:        heartbeatConfig.ContractFunction,
+			GasLimit:        heartbeatConfig.GasLimit,
+			MaxDailyCost:    maxDailyCost,
+			Timer:           components.timer,
+		}
+		sender, err := heartbeatManagement.NewContractSender(argsContractSender)
+		if err != nil {
+			_ = nonceTxHandler.Close()
+			return nil, err
+		}
+		components.addClosableComponent(sender)
+
+		return sender, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownHeartbeatMode, heartbeatConfig.Mode)
 	}
+}
 
-	components.broadcaster, err = p2p.NewBroadcaster(argsBroadcaster)
-	if err != nil {
-		return err
+func (components *ethMultiversXBridgeComponents) createBalanceProofProvider(args ArgsEthereumToMultiversXBridge) error {
+	balanceProofConfig := args.Configs.GeneralConfig.Relayer.BalanceProof
+	if !balanceProofConfig.Enabled {
+		components.balanceProofProvider = disabled.NewDisabledBalanceProofProvider()
+		return nil
 	}
 
-	cryptoHandler, err := ethereum.NewCryptoHandler(ethereumConfigs.PrivateKeyFile)
-	if err != nil {
-		return err
+	argsBalanceProof := balanceProofManagement.ArgsBalanceProof{
+		Log:              components.baseLogger,
+		EthereumClient:   components.ethClient,
+		EthereumChain:    args.ClientWrapper,
+		MultiversXClient: components.multiversXClient,
+		DataGetter:       components.mxDataGetter,
+		ERC20Tokens:      balanceProofConfig.ERC20Tokens,
+		CacheDuration:    time.Second * time.Duration(balanceProofConfig.CacheDurationInSeconds),
+		QueryTimeout:     time.Second * time.Duration(balanceProofConfig.QueryTimeoutInSeconds),
 	}
 
-	components.ethereumRelayerAddress = cryptoHandler.GetAddress()
+	var err error
+	components.balanceProofProvider, err = balanceProofManagement.NewBalanceProof(argsBalanceProof)
 
-	tokensMapper, err := mappers.NewErc20ToMultiversXMapper(components.mxDataGetter)
-	if err != nil {
-		return err
-	}
+	return err
+}
 
-	signaturesHolder := ethmultiversx.NewSignatureHolder()
-	components.ethToMultiversXSignaturesHolder = signaturesHolder
-	err = components.broadcaster.AddBroadcastClient(signaturesHolder)
-	if err != nil {
-		return err
+func (components *ethMultiversXBridgeComponents) createFeeEstimator(args ArgsEthereumToMultiversXBridge) error {
+	feeEstimationConfig := args.Configs.GeneralConfig.Relayer.FeeEstimation
+	if !feeEstimationConfig.Enabled {
+		components.feeEstimator = disabled.NewDisabledFeeEstimator()
+		return nil
 	}
 
-	safeContractAddress := common.HexToAddress(ethereumConfigs.SafeContractAddress)
-
-	ethClientLogId := components.evmCompatibleChain.EvmCompatibleChainClientLogId()
-	argsEthClient := ethereum.ArgsEthereumClient{
-		ClientWrapper:                args.ClientWrapper,
-		Erc20ContractsHandler:        args.Erc20ContractsHolder,
-		Log:                          core.NewLoggerWithIdentifier(logger.GetOrCreate(ethClientLogId), ethClientLogId),
-		AddressConverter:             components.addressConverter,
-		Broadcaster:                  components.broadcaster,
-		CryptoHandler:                cryptoHandler,
-		TokensMapper:                 tokensMapper,
-		SignatureHolder:              signaturesHolder,
-		SafeContractAddress:          safeContractAddress,
-		GasHandler:                   gs,
-		TransferGasLimitBase:         ethereumConfigs.GasLimitBase,
-		TransferGasLimitForEach:      ethereumConfigs.GasLimitForEach,
-		ClientAvailabilityAllowDelta: ethereumConfigs.ClientAvailabilityAllowDelta,
-		EventsBlockRangeFrom:         ethereumConfigs.EventsBlockRangeFrom,
-		EventsBlockRangeTo:           ethereumConfigs.EventsBlockRangeTo,
+	argsFeeEstimator := feeEstimatorManagement.ArgsFeeEstimator{
+		Log:                  components.baseLogger,
+		Chain:                components.evmCompatibleChain,
+		EthereumClient:       components.ethClient,
+		Erc20ContractsHolder: args.Erc20ContractsHolder,
+		MultiversXClient:     components.multiversXClient,
+		DataGetter:           components.mxDataGetter,
+		RoundingPolicy:       components.roundingPolicy,
+		BatchLatency:         time.Second * time.Duration(feeEstimationConfig.BatchLatencyInSeconds),
+		CacheDuration:        time.Second * time.Duration(feeEstimationConfig.CacheDurationInSeconds),
+		QueryTimeout:         time.Second * time.Duration(feeEstimationConfig.QueryTimeoutInSeconds),
 	}
 
-	components.ethClient, err = ethereum.NewEthereumClient(argsEthClient)
+	var err error
+	components.feeEstimator, err = feeEstimatorManagement.NewFeeEstimator(argsFeeEstimator)
 
 	return err
 }
 
-func (components *ethMultiversXBridgeComponents) createMultiversXRoleProvider(args ArgsEthereumToMultiversXBridge) error {
-	configs := args.Configs.GeneralConfig
-	multiversXRoleProviderLogId := components.evmCompatibleChain.MultiversXRoleProviderLogId()
-	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXRoleProviderLogId), multiversXRoleProviderLogId)
+func parseRelayedClaimsMinimumFees(tokens []config.RelayedClaimTokenConfig) (map[string]*big.Int, error) {
+	minimumFees := make(map[string]*big.Int, len(tokens))
+	for _, token := range tokens {
+		minimumFee, ok := big.NewInt(0).SetString(token.MinimumFee, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w for the minimum fee of token %s: %s", errInvalidValue, token.Token, token.MinimumFee)
+		}
 
-	argsRoleProvider := roleproviders.ArgsMultiversXRoleProvider{
-		DataGetter: components.mxDataGetter,
-		Log:        log,
+		minimumFees[token.Token] = minimumFee
 	}
 
-	var err error
-	components.multiversXRoleProvider, err = roleproviders.NewMultiversXRoleProvider(argsRoleProvider)
+	return minimumFees, nil
+}
+
+func (components *ethMultiversXBridgeComponents) createKnownPeersHolder(args ArgsEthereumToMultiversXBridge) error {
+	knownPeersConfig := args.Configs.GeneralConfig.P2P.KnownPeers
+	if !knownPeersConfig.Enabled {
+		components.knownPeersHolder = disabled.NewDisabledKnownPeersHolder()
+		return nil
+	}
+
+	argsKnownPeersHolder := p2p.ArgsKnownPeersHolder{
+		Messenger: args.Messenger,
+		Log:       components.baseLogger,
+		FilePath:  path.Join(args.Configs.FlagsConfig.WorkingDir, knownPeersConfig.FilePath),
+		MaxPeers:  knownPeersConfig.MaxPeers,
+	}
+
+	holder, err := p2p.NewKnownPeersHolder(argsKnownPeersHolder)
 	if err != nil {
 		return err
 	}
 
+	components.knownPeersHolder = holder
+	components.addClosableComponent(holder)
+
 	argsPollingHandler := polling.ArgsPollingHandler{
-		Log:              log,
-		Name:             "MultiversX role provider",
-		PollingInterval:  time.Duration(configs.Relayer.RoleProvider.PollingIntervalInMillis) * time.Millisecond,
+		Log:              components.baseLogger,
+		Name:             "known peers saver",
+		PollingInterval:  time.Duration(knownPeersConfig.SaveIntervalInSeconds) * time.Second,
 		PollingWhenError: pollingDurationOnError,
-		Executor:         components.multiversXRoleProvider,
+		Executor:         holder,
 	}
 
-	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
 	if err != nil {
 		return err
 	}
@@ -443,30 +2160,21 @@ func (components *ethMultiversXBridgeComponents) createMultiversXRoleProvider(ar
 	return nil
 }
 
-func (components *ethMultiversXBridgeComponents) createEthereumRoleProvider(args ArgsEthereumToMultiversXBridge) error {
-	configs := args.Configs.GeneralConfig
-	ethRoleProviderLogId := components.evmCompatibleChain.EvmCompatibleChainRoleProviderLogId()
-	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethRoleProviderLogId), ethRoleProviderLogId)
-	argsRoleProvider := roleproviders.ArgsEthereumRoleProvider{
-		EthereumChainInteractor: args.ClientWrapper,
-		Log:                     log,
-	}
-
-	var err error
-	components.ethereumRoleProvider, err = roleproviders.NewEthereumRoleProvider(argsRoleProvider)
-	if err != nil {
-		return err
+func (components *ethMultiversXBridgeComponents) createSLAHeartbeat(args ArgsEthereumToMultiversXBridge) error {
+	slaConfig := args.Configs.GeneralConfig.Relayer.SLA
+	if !slaConfig.Enabled {
+		return nil
 	}
 
 	argsPollingHandler := polling.ArgsPollingHandler{
-		Log:              log,
-		Name:             string(components.evmCompatibleChain) + " role provider",
-		PollingInterval:  time.Duration(configs.Relayer.RoleProvider.PollingIntervalInMillis) * time.Millisecond,
+		Log:              components.baseLogger,
+		Name:             "SLA heartbeat",
+		PollingInterval:  time.Duration(slaConfig.HeartbeatIntervalInSeconds) * time.Second,
 		PollingWhenError: pollingDurationOnError,
-		Executor:         components.ethereumRoleProvider,
+		Executor:         components.slaTracker,
 	}
 
-	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
 	if err != nil {
 		return err
 	}
@@ -495,12 +2203,14 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXBridg
 		AddressBytes:       components.multiversXRelayerAddress.AddressBytes(),
 		Log:                log,
 		AddressConverter:   components.addressConverter,
+		LeaderSelector:     components.leaderSelector,
 	}
 
 	topologyHandler, err := topology.NewTopologyHandler(argsTopologyHandler)
 	if err != nil {
 		return err
 	}
+	components.topologyInfoProviders[ethToMultiversXName] = topologyHandler
 
 	components.ethToMultiversXStatusHandler, err = status.NewStatusHandler(ethToMultiversXName, components.statusStorer)
 	if err != nil {
@@ -519,18 +2229,60 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXBridg
 		return err
 	}
 
+	batchValidator, err := components.createBatchValidator(args.Configs.GeneralConfig.Relayer.BatchValidator, batchProcessor.ToMultiversX)
+	if err != nil {
+		return err
+	}
+
+	recipientAllowlist, err := components.createRecipientAllowlist(args.Configs.GeneralConfig.Relayer.TransferAllowlist, batchProcessor.ToMultiversX)
+	if err != nil {
+		return err
+	}
+
+	recipientValidator, err := components.createRecipientValidator(args, batchProcessor.ToMultiversX)
+	if err != nil {
+		return err
+	}
+
+	esdtRolesChecker, err := components.createESDTRolesChecker(args)
+	if err != nil {
+		return err
+	}
+
+	preAgreement, err := components.createPreAgreement(args.Configs.GeneralConfig.Relayer.PreAgreement, ethToMultiversXName, log)
+	if err != nil {
+		return err
+	}
+
 	argsBridgeExecutor := ethmultiversx.ArgsBridgeExecutor{
 		Log:                          log,
 		TopologyProvider:             topologyHandler,
 		MultiversXClient:             components.multiversXClient,
 		EthereumClient:               components.ethClient,
 		StatusHandler:                components.ethToMultiversXStatusHandler,
+		Timer:                        components.timer,
 		TimeForWaitOnEthereum:        timeForTransferExecution,
 		SignaturesHolder:             disabled.NewDisabledSignaturesHolder(),
 		BalanceValidator:             balanceValidator,
+		BatchValidator:               batchValidator,
+		SignerAuditLog:               components.signerAuditLog,
+		PostmortemCapturer:           components.postmortemCapturer,
+		SLATracker:                   components.slaTracker,
+		ErrorReporter:                components.errorReporter,
+		BatchResultsStorer:           components.batchResultsStorer,
+		TransferReceipts:             components.transferReceipts,
+		RecipientAllowlist:           recipientAllowlist,
+		RecipientValidator:           recipientValidator,
+		ESDTRolesChecker:             esdtRolesChecker,
+		DeadLetters:                  components.deadLetters,
+		IdempotencyGuard:             components.idempotencyGuard,
+		BatchTagger:                  components.batchTagger,
+		CostAccounting:               components.costAccounting,
+		PreAgreement:                 preAgreement,
 		MaxQuorumRetriesOnEthereum:   args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
-		MaxQuorumRetriesOnMultiversX: args.Configs.GeneralConfig.MultiversX.MaxRetriesOnQuorumReached,
+		MaxQuorumRetriesOnMultiversX: getMaxQuorumRetries(configs, args.Configs.GeneralConfig.MultiversX.MaxRetriesOnQuorumReached),
 		MaxRestriesOnWasProposed:     args.Configs.GeneralConfig.MultiversX.MaxRetriesOnWasTransferProposed,
+		ExecutionDeadline:            time.Duration(configs.ExecutionDeadlineInSeconds) * time.Second,
 	}
 
 	bridge, err := ethmultiversx.NewBridgeExecutor(argsBridgeExecutor)
@@ -538,6 +2290,8 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXBridg
 		return err
 	}
 
+	components.ethToMultiversXResumableState = bridge
+	components.ethToMultiversXLiveViewProvider = bridge
 	components.ethToMultiversXMachineStates, err = ethtomultiversx.CreateSteps(bridge)
 	if err != nil {
 		return err
@@ -563,12 +2317,14 @@ func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumBridg
 		AddressBytes:       components.multiversXRelayerAddress.AddressBytes(),
 		Log:                log,
 		AddressConverter:   components.addressConverter,
+		LeaderSelector:     components.leaderSelector,
 	}
 
 	topologyHandler, err := topology.NewTopologyHandler(argsTopologyHandler)
 	if err != nil {
 		return err
 	}
+	components.topologyInfoProviders[multiversXToEthName] = topologyHandler
 
 	components.multiversXToEthStatusHandler, err = status.NewStatusHandler(multiversXToEthName, components.statusStorer)
 	if err != nil {
@@ -587,18 +2343,57 @@ func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumBridg
 		return err
 	}
 
+	batchValidator, err := components.createBatchValidator(args.Configs.GeneralConfig.Relayer.BatchValidator, batchProcessor.FromMultiversX)
+	if err != nil {
+		return err
+	}
+
+	recipientAllowlist, err := components.createRecipientAllowlist(args.Configs.GeneralConfig.Relayer.TransferAllowlist, batchProcessor.FromMultiversX)
+	if err != nil {
+		return err
+	}
+
+	recipientValidator, err := components.createRecipientValidator(args, batchProcessor.FromMultiversX)
+	if err != nil {
+		return err
+	}
+
+	preAgreement, err := components.createPreAgreement(args.Configs.GeneralConfig.Relayer.PreAgreement, multiversXToEthName, log)
+	if err != nil {
+		return err
+	}
+
 	argsBridgeExecutor := ethmultiversx.ArgsBridgeExecutor{
 		Log:                          log,
 		TopologyProvider:             topologyHandler,
 		MultiversXClient:             components.multiversXClient,
 		EthereumClient:               components.ethClient,
 		StatusHandler:                components.multiversXToEthStatusHandler,
+		Timer:                        components.timer,
 		TimeForWaitOnEthereum:        timeForWaitOnEthereum,
 		SignaturesHolder:             components.ethToMultiversXSignaturesHolder,
 		BalanceValidator:             balanceValidator,
-		MaxQuorumRetriesOnEthereum:   args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
+		BatchValidator:               batchValidator,
+		SignerAuditLog:               components.signerAuditLog,
+		PostmortemCapturer:           components.postmortemCapturer,
+		SLATracker:                   components.slaTracker,
+		ErrorReporter:                components.errorReporter,
+		BatchResultsStorer:           components.batchResultsStorer,
+		TransferReceipts:             components.transferReceipts,
+		RecipientAllowlist:           recipientAllowlist,
+		RecipientValidator:           recipientValidator,
+		ESDTRolesChecker:             disabled.NewDisabledESDTRolesChecker(),
+		DeadLetters:                  components.deadLetters,
+		IdempotencyGuard:             components.idempotencyGuard,
+		BatchTagger:                  components.batchTagger,
+		CostAccounting:               components.costAccounting,
+		PreAgreement:                 preAgreement,
+		MaxQuorumRetriesOnEthereum:   getMaxQuorumRetries(configs, args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached),
 		MaxQuorumRetriesOnMultiversX: args.Configs.GeneralConfig.MultiversX.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:     args.Configs.GeneralConfig.MultiversX.MaxRetriesOnWasTransferProposed,
+		ExecutionDeadline:            time.Duration(configs.ExecutionDeadlineInSeconds) * time.Second,
+		GasPriceDeferral:             time.Duration(configs.GasPriceDeferralInSeconds) * time.Second,
+		RejectionDeadlineInBlocks:    configs.RejectionDeadlineInBlocks,
 	}
 
 	bridge, err := ethmultiversx.NewBridgeExecutor(argsBridgeExecutor)
@@ -606,6 +2401,8 @@ func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumBridg
 		return err
 	}
 
+	components.multiversXToEthResumableState = bridge
+	components.multiversXToEthLiveViewProvider = bridge
 	components.multiversXToEthMachineStates, err = multiversxtoeth.CreateSteps(bridge)
 	if err != nil {
 		return err
@@ -625,15 +2422,35 @@ func (components *ethMultiversXBridgeComponents) startPollingHandlers() error {
 	return nil
 }
 
-// Start will start the bridge
-func (components *ethMultiversXBridgeComponents) Start() error {
-	err := components.messenger.Bootstrap()
+// Start will start the bridge. The provided context only bounds the startup sequence, e.g. it is cancelled on a close
+// signal received while waiting for the leftover transactions, the started components being stopped by Close
+func (components *ethMultiversXBridgeComponents) Start(ctx context.Context) error {
+	requestsCtx, cancel := context.WithTimeout(ctx, networkCheckTimeout)
+	err := components.networkValidator.Validate(requestsCtx)
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	requestsCtx, cancel = context.WithTimeout(ctx, networkCheckTimeout)
+	components.setDestinationQuorumMetrics(requestsCtx)
+	cancel()
+
+	// the report is produced before any step runs, so the persisted state is the one found at startup
+	requestsCtx, cancel = context.WithTimeout(ctx, syncReportTimeout)
+	components.syncReporter.Generate(requestsCtx)
+	cancel()
+
+	// the known peers are connected first so the relayer regains the quorum connectivity without waiting for the DHT
+	components.knownPeersHolder.ReconnectKnownPeers()
+
+	err = components.messenger.Bootstrap()
 	if err != nil {
 		return err
 	}
 
 	components.baseLogger.Info("waiting for p2p bootstrap", "time", components.timeForBootstrap)
-	time.Sleep(components.timeForBootstrap)
+	components.timer.Sleep(components.timeForBootstrap)
 
 	err = components.broadcaster.RegisterOnTopics()
 	if err != nil {
@@ -642,18 +2459,52 @@ func (components *ethMultiversXBridgeComponents) Start() error {
 
 	components.broadcaster.BroadcastJoinTopic()
 
+	err = components.multiversXLeftoverTxsHandler.WaitForLeftoverTransactions(ctx)
+	if err != nil {
+		return err
+	}
+
 	err = components.startPollingHandlers()
 	if err != nil {
 		return err
 	}
 
-	var ctx context.Context
-	ctx, components.cancelFunc = context.WithCancel(context.Background())
-	go components.startBroadcastJoinRetriesLoop(ctx)
+	// the loop outlives the startup sequence, it is stopped by Close
+	var loopCtx context.Context
+	loopCtx, components.cancelFunc = context.WithCancel(context.Background())
+	go components.startBroadcastJoinRetriesLoop(loopCtx)
 
 	return nil
 }
 
+// getMaxQuorumRetries returns the retries configured for the direction, used while waiting for the quorum on its
+// destination chain, falling back to the retries configured for that chain
+func getMaxQuorumRetries(stateMachineConfig config.ConfigStateMachine, chainMaxRetries uint64) uint64 {
+	if stateMachineConfig.MaxRetriesOnQuorumReached > 0 {
+		return stateMachineConfig.MaxRetriesOnQuorumReached
+	}
+
+	return chainMaxRetries
+}
+
+// setDestinationQuorumMetrics exposes, for each direction, the quorum of the chain where its transfers are executed.
+// The two quorums are read separately as the multisig contracts are not required to have the same quorum
+func (components *ethMultiversXBridgeComponents) setDestinationQuorumMetrics(ctx context.Context) {
+	ethereumQuorum, err := components.ethClient.GetQuorumSize(ctx)
+	if err != nil {
+		components.baseLogger.Warn("can not fetch the Ethereum quorum", "error", err)
+	} else {
+		components.multiversXToEthStatusHandler.SetIntMetric(core.MetricDestinationQuorum, int(ethereumQuorum.Int64()))
+	}
+
+	multiversXQuorum, err := components.mxDataGetter.GetQuorum(ctx)
+	if err != nil {
+		components.baseLogger.Warn("can not fetch the MultiversX quorum", "error", err)
+	} else {
+		components.ethToMultiversXStatusHandler.SetIntMetric(core.MetricDestinationQuorum, int(multiversXQuorum))
+	}
+}
+
 func (components *ethMultiversXBridgeComponents) createBalanceValidator() (ethmultiversx.BalanceValidator, error) {
 	argsBalanceValidator := balanceValidatorManagement.ArgsBalanceValidator{
 		Log:              components.baseLogger,
@@ -664,7 +2515,83 @@ func (components *ethMultiversXBridgeComponents) createBalanceValidator() (ethmu
 	return balanceValidatorManagement.NewBalanceValidator(argsBalanceValidator)
 }
 
-func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXStateMachine() error {
+func (components *ethMultiversXBridgeComponents) createBatchValidator(
+	cfg config.BatchValidatorConfig,
+	direction batchProcessor.Direction,
+) (ethmultiversx.BatchValidator, error) {
+	argsBatchValidator := batchValidatorManagement.ArgsBatchValidator{
+		Direction:     direction,
+		RequestURL:    cfg.URL,
+		RequestTime:   time.Second * time.Duration(cfg.RequestTimeInSeconds),
+		FailurePolicy: cfg.FailurePolicy,
+	}
+
+	return batchValidatorFactory.CreateBatchValidator(argsBatchValidator, cfg.Enabled)
+}
+
+func (components *ethMultiversXBridgeComponents) createRecipientAllowlist(
+	cfg config.TransferAllowlistConfig,
+	direction batchProcessor.Direction,
+) (ethmultiversx.RecipientAllowlist, error) {
+	if !cfg.Enabled {
+		return disabled.NewDisabledRecipientAllowlist(), nil
+	}
+
+	recipients := cfg.MultiversXRecipients
+	if direction == batchProcessor.FromMultiversX {
+		recipients = cfg.EthereumRecipients
+	}
+
+	argsRecipientAllowlist := recipientAllowlistManagement.ArgsRecipientAllowlist{
+		Direction:  direction,
+		Recipients: recipients,
+	}
+
+	return recipientAllowlistManagement.NewRecipientAllowlist(argsRecipientAllowlist)
+}
+
+func (components *ethMultiversXBridgeComponents) createRecipientValidator(
+	args ArgsEthereumToMultiversXBridge,
+	direction batchProcessor.Direction,
+) (ethmultiversx.RecipientValidator, error) {
+	cfg := args.Configs.GeneralConfig.Relayer.RecipientValidation
+	if !cfg.Enabled {
+		return disabled.NewDisabledRecipientValidator(), nil
+	}
+
+	// the bridge contracts can not receive the transfers, the tokens would be locked
+	argsRecipientValidator := recipientValidatorManagement.ArgsRecipientValidator{
+		Direction:        direction,
+		EthereumDenylist: cfg.EthereumDenylist,
+		EthereumBridgeContracts: [][]byte{
+			common.HexToAddress(args.Configs.GeneralConfig.Eth.SafeContractAddress).Bytes(),
+			common.HexToAddress(args.Configs.GeneralConfig.Eth.MultisigContractAddress).Bytes(),
+		},
+		NumShards:                cfg.NumShards,
+		MultiversXBridgeContract: components.multiversXMultisigContractAddress.AddressBytes(),
+	}
+
+	return recipientValidatorManagement.NewRecipientValidator(argsRecipientValidator)
+}
+
+func (components *ethMultiversXBridgeComponents) createESDTRolesChecker(args ArgsEthereumToMultiversXBridge) (ethmultiversx.ESDTRolesChecker, error) {
+	esdtRolesCheckConfig := args.Configs.GeneralConfig.MultiversX.ESDTRolesCheck
+	if !esdtRolesCheckConfig.Enabled {
+		return disabled.NewDisabledESDTRolesChecker(), nil
+	}
+
+	argsESDTRolesChecker := esdtRolesManagement.ArgsESDTRolesChecker{
+		Log:                 components.baseLogger,
+		DataGetter:          components.mxDataGetter,
+		MultiversXClient:    components.multiversXClient,
+		SafeContractAddress: components.multiversXSafeContractAddress,
+		CacheDuration:       time.Second * time.Duration(esdtRolesCheckConfig.CacheDurationInSeconds),
+	}
+
+	return esdtRolesManagement.NewESDTRolesChecker(argsESDTRolesChecker)
+}
+
+func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXStateMachine(recoveryConfig config.StateMachineRecoveryConfig) error {
 	ethToMultiversXName := components.evmCompatibleChain.EvmCompatibleChainToMultiversXName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToMultiversXName), ethToMultiversXName)
 
@@ -674,10 +2601,28 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXState
 		StartStateIdentifier: ethtomultiversx.GettingPendingBatchFromEthereum,
 		Log:                  log,
 		StatusHandler:        components.ethToMultiversXStatusHandler,
+		Timer:                components.timer,
+	}
+	if recoveryConfig.Enabled {
+		argsStateMachine.CheckpointStorer = status.NewSynchronousStorer(components.statusStorer)
+		argsStateMachine.ResumableState = components.ethToMultiversXResumableState
+		argsStateMachine.CheckpointMaxAge = time.Duration(recoveryConfig.MaxCheckpointAgeInSeconds) * time.Second
 	}
 
-	var err error
-	components.ethToMultiversXStateMachine, err = stateMachine.NewStateMachine(argsStateMachine)
+	sm, err := stateMachine.NewStateMachine(argsStateMachine)
+	if err != nil {
+		return err
+	}
+	components.ethToMultiversXStateMachine = sm
+
+	if recoveryConfig.Enabled {
+		err = sm.Restore()
+		if err != nil {
+			log.Warn("can not restore the state machine checkpoint, starting from the first step", "error", err)
+		}
+	}
+
+	executor, err := components.createStateMachineExecutor(components.ethToMultiversXStateMachine, core.HalfBridgeEthToMvx, log)
 	if err != nil {
 		return err
 	}
@@ -687,10 +2632,10 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXState
 		Name:             ethToMultiversXName + " State machine",
 		PollingInterval:  components.ethToMultiversXStepDuration,
 		PollingWhenError: pollingDurationOnError,
-		Executor:         components.ethToMultiversXStateMachine,
+		Executor:         executor,
 	}
 
-	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
 	if err != nil {
 		return err
 	}
@@ -701,7 +2646,7 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXState
 	return nil
 }
 
-func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumStateMachine() error {
+func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumStateMachine(recoveryConfig config.StateMachineRecoveryConfig) error {
 	multiversXToEthName := components.evmCompatibleChain.MultiversXToEvmCompatibleChainName()
 	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXToEthName), multiversXToEthName)
 
@@ -711,10 +2656,28 @@ func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumState
 		StartStateIdentifier: multiversxtoeth.GettingPendingBatchFromMultiversX,
 		Log:                  log,
 		StatusHandler:        components.multiversXToEthStatusHandler,
+		Timer:                components.timer,
+	}
+	if recoveryConfig.Enabled {
+		argsStateMachine.CheckpointStorer = status.NewSynchronousStorer(components.statusStorer)
+		argsStateMachine.ResumableState = components.multiversXToEthResumableState
+		argsStateMachine.CheckpointMaxAge = time.Duration(recoveryConfig.MaxCheckpointAgeInSeconds) * time.Second
 	}
 
-	var err error
-	components.multiversXToEthStateMachine, err = stateMachine.NewStateMachine(argsStateMachine)
+	sm, err := stateMachine.NewStateMachine(argsStateMachine)
+	if err != nil {
+		return err
+	}
+	components.multiversXToEthStateMachine = sm
+
+	if recoveryConfig.Enabled {
+		err = sm.Restore()
+		if err != nil {
+			log.Warn("can not restore the state machine checkpoint, starting from the first step", "error", err)
+		}
+	}
+
+	executor, err := components.createStateMachineExecutor(components.multiversXToEthStateMachine, core.HalfBridgeMvxToEth, log)
 	if err != nil {
 		return err
 	}
@@ -724,10 +2687,10 @@ func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumState
 		Name:             multiversXToEthName + " State machine",
 		PollingInterval:  components.multiversXToEthStepDuration,
 		PollingWhenError: pollingDurationOnError,
-		Executor:         components.multiversXToEthStateMachine,
+		Executor:         executor,
 	}
 
-	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	pollingHandler, err := components.newPollingHandler(argsPollingHandler)
 	if err != nil {
 		return err
 	}
@@ -758,14 +2721,9 @@ func (components *ethMultiversXBridgeComponents) createAntifloodComponents(antif
 }
 
 func (components *ethMultiversXBridgeComponents) startBroadcastJoinRetriesLoop(ctx context.Context) {
-	broadcastTimer := time.NewTimer(components.timeBeforeRepeatJoin)
-	defer broadcastTimer.Stop()
-
 	for {
-		broadcastTimer.Reset(components.timeBeforeRepeatJoin)
-
 		select {
-		case <-broadcastTimer.C:
+		case <-components.timer.After(components.timeBeforeRepeatJoin):
 			components.baseLogger.Info("broadcast again join topic")
 			components.broadcaster.BroadcastJoinTopic()
 		case <-ctx.Done():
@@ -776,6 +2734,122 @@ func (components *ethMultiversXBridgeComponents) startBroadcastJoinRetriesLoop(c
 	}
 }
 
+// ReloadConfig applies, to the already built components, the reloadable settings of a re-read configuration: the gas
+// station settings, the polling intervals and the state machines step durations. The other settings need a restart.
+// All the components are updated, the last error being returned
+func (components *ethMultiversXBridgeComponents) ReloadConfig(cfg config.Config) error {
+	reloadableConfig := components.createReloadableConfig(cfg)
+
+	components.mutRunningConfig.Lock()
+	components.runningConfig = applyReloadableSettings(components.runningConfig, cfg)
+	components.mutRunningConfig.Unlock()
+
+	components.mutReloadableHandlers.RLock()
+	defer components.mutReloadableHandlers.RUnlock()
+
+	var lastError error
+	for _, reloadable := range components.reloadableHandlers {
+		err := reloadable.ReloadConfig(reloadableConfig)
+		if err != nil {
+			components.baseLogger.Warn("can not apply the reloaded config", "error", err)
+			lastError = err
+		}
+	}
+
+	return lastError
+}
+
+func (components *ethMultiversXBridgeComponents) createReloadableConfig(cfg config.Config) core.ReloadableConfig {
+	gasStationConfig := cfg.Eth.GasStation
+	evmCompatibleChainName := string(components.evmCompatibleChain)
+	roleProviderInterval := time.Duration(cfg.Relayer.RoleProvider.PollingIntervalInMillis) * time.Millisecond
+	gasUsageTrackerInterval := time.Duration(cfg.Relayer.GasUsageTracker.PollingIntervalInSeconds) * time.Second
+	balanceMonitorInterval := time.Duration(cfg.Relayer.BalanceMonitor.PollingIntervalInSeconds) * time.Second
+
+	// the keys are the names of the polling handlers, as given when they were created
+	pollingIntervals := map[string]time.Duration{
+		"MultiversX role provider":                    roleProviderInterval,
+		"MultiversX stakes provider":                  roleProviderInterval,
+		evmCompatibleChainName + " role provider":     roleProviderInterval,
+		evmCompatibleChainName + " gas usage tracker": gasUsageTrackerInterval,
+		multiversXChainName + " gas usage tracker":    gasUsageTrackerInterval,
+		evmCompatibleChainName + " balance monitor":   balanceMonitorInterval,
+		multiversXChainName + " balance monitor":      balanceMonitorInterval,
+		"p2p status handler":                          time.Duration(cfg.P2P.TopicsMetrics.PollingIntervalInSeconds) * time.Second,
+		"runtime monitor":                             time.Duration(cfg.Relayer.RuntimeMonitor.PollingIntervalInSeconds) * time.Second,
+		"governance pause":                            time.Duration(cfg.Relayer.GovernancePause.PollingIntervalInSeconds) * time.Second,
+		"token metadata monitor":                      time.Duration(cfg.Relayer.TokenMetadata.PollingIntervalInSeconds) * time.Second,
+		"cost accounting":                             time.Duration(cfg.Relayer.CostAccounting.PollingIntervalInSeconds) * time.Second,
+	}
+	stateMachinesNames := []string{
+		components.evmCompatibleChain.EvmCompatibleChainToMultiversXName(),
+		components.evmCompatibleChain.MultiversXToEvmCompatibleChainName(),
+	}
+	for _, name := range stateMachinesNames {
+		stateMachineConfig, found := cfg.StateMachine[name]
+		if found {
+			pollingIntervals[name+" State machine"] = time.Duration(stateMachineConfig.StepDurationInMillis) * time.Millisecond
+		}
+	}
+
+	return core.ReloadableConfig{
+		GasStation: core.GasStationSettings{
+			RequestURL:             gasStationConfig.URL,
+			RequestPollingInterval: time.Duration(gasStationConfig.PollingIntervalInSeconds) * time.Second,
+			RequestRetryDelay:      time.Duration(gasStationConfig.RequestRetryDelayInSeconds) * time.Second,
+			MaximumFetchRetries:    gasStationConfig.MaxFetchRetries,
+			RequestTime:            time.Duration(gasStationConfig.RequestTimeInSeconds) * time.Second,
+			MaximumGasPrice:        gasStationConfig.MaximumAllowedGasPrice,
+			GasPriceSelector:       core.EthGasPriceSelector(gasStationConfig.GasPriceSelector),
+			GasPriceMultiplier:     gasStationConfig.GasPriceMultiplier,
+		},
+		PollingIntervals: pollingIntervals,
+	}
+}
+
+// applyReloadableSettings returns a copy of the running config having the settings applied by a reload taken from the
+// re-read config. The log level, applied by the caller of the reload, is taken as well
+func applyReloadableSettings(running config.Config, reloaded config.Config) config.Config {
+	newConfig := running
+
+	gasStationEnabled := running.Eth.GasStation.Enabled
+	newConfig.Eth.GasStation = reloaded.Eth.GasStation
+	newConfig.Eth.GasStation.Enabled = gasStationEnabled
+
+	newConfig.Relayer.RoleProvider.PollingIntervalInMillis = reloaded.Relayer.RoleProvider.PollingIntervalInMillis
+	newConfig.Relayer.GasUsageTracker.PollingIntervalInSeconds = reloaded.Relayer.GasUsageTracker.PollingIntervalInSeconds
+	newConfig.Relayer.BalanceMonitor.PollingIntervalInSeconds = reloaded.Relayer.BalanceMonitor.PollingIntervalInSeconds
+	newConfig.P2P.TopicsMetrics.PollingIntervalInSeconds = reloaded.P2P.TopicsMetrics.PollingIntervalInSeconds
+	newConfig.Relayer.RuntimeMonitor.PollingIntervalInSeconds = reloaded.Relayer.RuntimeMonitor.PollingIntervalInSeconds
+	newConfig.Relayer.GovernancePause.PollingIntervalInSeconds = reloaded.Relayer.GovernancePause.PollingIntervalInSeconds
+	newConfig.Relayer.TokenMetadata.PollingIntervalInSeconds = reloaded.Relayer.TokenMetadata.PollingIntervalInSeconds
+	newConfig.Relayer.CostAccounting.PollingIntervalInSeconds = reloaded.Relayer.CostAccounting.PollingIntervalInSeconds
+	if len(reloaded.Logs.LogLevel) > 0 {
+		newConfig.Logs.LogLevel = reloaded.Logs.LogLevel
+	}
+
+	// the map is copied so the previously returned running configs are not altered
+	newConfig.StateMachine = make(map[string]config.ConfigStateMachine, len(running.StateMachine))
+	for name, stateMachineConfig := range running.StateMachine {
+		reloadedStateMachineConfig, found := reloaded.StateMachine[name]
+		if found {
+			stateMachineConfig.StepDurationInMillis = reloadedStateMachineConfig.StepDurationInMillis
+		}
+		newConfig.StateMachine[name] = stateMachineConfig
+	}
+
+	return newConfig
+}
+
+// RunningConfig returns the config the components are running with: the initial one with the settings applied by the
+// config reloads
+func (components *ethMultiversXBridgeComponents) RunningConfig() config.Config {
+	components.mutRunningConfig.RLock()
+	defer components.mutRunningConfig.RUnlock()
+
+	return components.runningConfig
+}
+
 // Close will close any sub-components started
 func (components *ethMultiversXBridgeComponents) Close() error {
 	components.mutClosableHandlers.RLock()
@@ -803,6 +2877,33 @@ func (components *ethMultiversXBridgeComponents) Close() error {
 	return lastError
 }
 
+func (components *ethMultiversXBridgeComponents) createBatchExplorer() error {
+	argsBatchExplorer := batchExplorerManagement.ArgsBatchExplorer{
+		Providers: map[string]batchExplorerManagement.LiveViewProvider{
+			components.evmCompatibleChain.EvmCompatibleChainToMultiversXName(): components.ethToMultiversXLiveViewProvider,
+			components.evmCompatibleChain.MultiversXToEvmCompatibleChainName(): components.multiversXToEthLiveViewProvider,
+		},
+	}
+
+	var err error
+	components.batchExplorer, err = batchExplorerManagement.NewBatchExplorer(argsBatchExplorer)
+	if err != nil {
+		return err
+	}
+
+	return components.RegisterStepHook(components.batchExplorer)
+}
+
+// RegisterStepHook registers the provided hook on both state machines. Should be called before Start
+func (components *ethMultiversXBridgeComponents) RegisterStepHook(hook core.StepHook) error {
+	err := components.ethToMultiversXStateMachine.RegisterStepHook(hook)
+	if err != nil {
+		return err
+	}
+
+	return components.multiversXToEthStateMachine.RegisterStepHook(hook)
+}
+
 // MultiversXRelayerAddress returns the MultiversX's address associated to this relayer
 func (components *ethMultiversXBridgeComponents) MultiversXRelayerAddress() sdkCore.AddressHandler {
 	return components.multiversXRelayerAddress
@@ -812,3 +2913,108 @@ func (components *ethMultiversXBridgeComponents) MultiversXRelayerAddress() sdkC
 func (components *ethMultiversXBridgeComponents) EthereumRelayerAddress() common.Address {
 	return components.ethereumRelayerAddress
 }
+
+// GetMultiversXQuorum returns the quorum set on the MultiversX multisig contract
+func (components *ethMultiversXBridgeComponents) GetMultiversXQuorum(ctx context.Context) (uint64, error) {
+	return components.mxDataGetter.GetQuorum(ctx)
+}
+
+// GetTopologyInfo returns, for each bridge direction, the relayers set and the leader schedule of the next numSlots slots
+func (components *ethMultiversXBridgeComponents) GetTopologyInfo(numSlots int) map[string]*core.TopologyInfo {
+	result := make(map[string]*core.TopologyInfo, len(components.topologyInfoProviders))
+	for name, provider := range components.topologyInfoProviders {
+		result[name] = provider.TopologyInfo(numSlots)
+	}
+
+	return result
+}
+
+// GetExportedTransactions returns the last signed Ethereum execution transactions exported instead of being broadcast
+func (components *ethMultiversXBridgeComponents) GetExportedTransactions() []*core.ExportedTransaction {
+	return components.rawTransactionsExporter.GetExportedTransactions()
+}
+
+// GetSyncReport returns the report produced at startup about where the bridge believes it is on both chains
+func (components *ethMultiversXBridgeComponents) GetSyncReport() *core.SyncReport {
+	return components.syncReporter.GetSyncReport()
+}
+
+// SubmitClaim relays the claim transaction signed by a user, the gas being paid by the sponsor account
+func (components *ethMultiversXBridgeComponents) SubmitClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+	return components.relayedClaimsHandler.SubmitClaim(ctx, claimTx)
+}
+
+// GetBalanceProof returns the view of the bridge reserves for the bridged tokens
+func (components *ethMultiversXBridgeComponents) GetBalanceProof(ctx context.Context) (*core.BalanceProof, error) {
+	return components.balanceProofProvider.GetBalanceProof(ctx)
+}
+
+// EstimateFee quotes the deposit of the provided token amount before it is made
+func (components *ethMultiversXBridgeComponents) EstimateFee(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+	return components.feeEstimator.EstimateFee(ctx, token, amount)
+}
+
+// GetIncidents returns the incidents log, the oldest incident first
+func (components *ethMultiversXBridgeComponents) GetIncidents() []*core.Incident {
+	return components.incidentsQueue.GetIncidents()
+}
+
+// AcknowledgeIncident records the operator's acknowledgment of the provided incident
+func (components *ethMultiversXBridgeComponents) AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+	return components.incidentsQueue.AcknowledgeIncident(id, acknowledgment)
+}
+
+// GetDeadLetters returns the deposits that repeatedly failed to be validated or executed, the oldest one first
+func (components *ethMultiversXBridgeComponents) GetDeadLetters() []*core.DeadLetter {
+	return components.deadLetters.GetDeadLetters()
+}
+
+// ResolveDeadLetter records the operator's resolution of the provided dead letter
+func (components *ethMultiversXBridgeComponents) ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+	return components.deadLetters.ResolveDeadLetter(direction, depositNonce, resolution)
+}
+
+// GetDirectMessages returns the last direct messages sent to and received from the other relayers, the oldest one first
+func (components *ethMultiversXBridgeComponents) GetDirectMessages() []*core.DirectMessage {
+	return components.directMessages.GetDirectMessages()
+}
+
+// SendDirectMessage sends a coordination message to the provided relayer, outside the broadcast topics
+func (components *ethMultiversXBridgeComponents) SendDirectMessage(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+	return components.directMessages.SendDirectMessage(to, kind, text, batchID)
+}
+
+// PauseHalfBridge suspends the processing of the provided half-bridge at the next step boundary of its state machine
+func (components *ethMultiversXBridgeComponents) PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	return components.operatorPause.PauseHalfBridge(halfBridge, remoteAddress)
+}
+
+// ResumeHalfBridge resumes the processing of the provided half-bridge
+func (components *ethMultiversXBridgeComponents) ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	return components.operatorPause.ResumeHalfBridge(halfBridge, remoteAddress)
+}
+
+// GetHalfBridgePauses returns the operator pause state of each half-bridge
+func (components *ethMultiversXBridgeComponents) GetHalfBridgePauses() []*core.HalfBridgePause {
+	return components.operatorPause.GetHalfBridgePauses()
+}
+
+// GetPendingBatches returns the live view of the batches currently processed by the state machines
+func (components *ethMultiversXBridgeComponents) GetPendingBatches() []*core.BatchLiveView {
+	return components.batchExplorer.GetPendingBatches()
+}
+
+// GetBatchLiveViews returns the live view of the processed batches with the provided ID
+func (components *ethMultiversXBridgeComponents) GetBatchLiveViews(batchID uint64) []*core.BatchLiveView {
+	return components.batchExplorer.GetBatchLiveViews(batchID)
+}
+
+// GetDepositLiveViews returns the live view of the processed batches holding the deposit with the provided nonce
+func (components *ethMultiversXBridgeComponents) GetDepositLiveViews(nonce uint64) []*core.BatchLiveView {
+	return components.batchExplorer.GetDepositLiveViews(nonce)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (components *ethMultiversXBridgeComponents) IsInterfaceNil() bool {
+	return components == nil
+}
@@ -1,16 +1,37 @@
 package factory
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"math/big"
+	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-bridge-eth-go/accounting"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/topology"
+	balanceProofManagement "github.com/multiversx/mx-bridge-eth-go/clients/balanceProof"
+	"github.com/multiversx/mx-bridge-eth-go/clients/batchTags"
 	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	confirmationPolicyManagement "github.com/multiversx/mx-bridge-eth-go/clients/confirmationPolicy"
+	contextAuditManagement "github.com/multiversx/mx-bridge-eth-go/clients/contextAudit"
+	deadLettersManagement "github.com/multiversx/mx-bridge-eth-go/clients/deadLetters"
+	directMessagesManagement "github.com/multiversx/mx-bridge-eth-go/clients/directMessages"
+	feeEstimatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/feeEstimator"
+	incidentsManagement "github.com/multiversx/mx-bridge-eth-go/clients/incidents"
+	networkValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/networkValidator"
+	preAgreementManagement "github.com/multiversx/mx-bridge-eth-go/clients/preAgreement"
+	recipientAllowlistManagement "github.com/multiversx/mx-bridge-eth-go/clients/recipientAllowlist"
+	recipientValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/recipientValidator"
+	"github.com/multiversx/mx-bridge-eth-go/clients/reloadablePolling"
+	roundingPolicyManagement "github.com/multiversx/mx-bridge-eth-go/clients/roundingPolicy"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
@@ -64,6 +85,7 @@ func createMockEthMultiversXBridgeArgs() ArgsEthereumToMultiversXBridge {
 			MaxRetriesOnQuorumReached:       1,
 			MaxRetriesOnWasTransferProposed: 1,
 			ClientAvailabilityAllowDelta:    10,
+			LeftoverTxsTimeoutInSeconds:     1,
 			Proxy: config.ProxyConfig{
 				CacherExpirationSeconds: 600,
 				RestAPIEntityType:       "observer",
@@ -107,6 +129,10 @@ func createMockEthMultiversXBridgeArgs() ArgsEthereumToMultiversXBridge {
 		TimeBeforeRepeatJoin:          minTimeBeforeRepeatJoin,
 		MetricsHolder:                 status.NewMetricsHolder(),
 		AppStatusHandler:              &statusHandler.AppStatusHandlerStub{},
+		SignerAuditLog:                &testsCommon.SignerAuditLogStub{},
+		SLATracker:                    disabled.NewDisabledSLATracker(),
+		ErrorReporter:                 disabled.NewDisabledErrorReporter(),
+		BatchResultsStorer:            disabled.NewDisabledBatchResultsStorer(),
 	}
 }
 
@@ -122,6 +148,42 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.Equal(t, errNilProxy, err)
 		assert.Nil(t, components)
 	})
+	t.Run("nil SignerAuditLog", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.SignerAuditLog = nil
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.Equal(t, errNilSignerAuditLog, err)
+		assert.Nil(t, components)
+	})
+	t.Run("nil SLATracker", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.SLATracker = nil
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.Equal(t, errNilSLATracker, err)
+		assert.Nil(t, components)
+	})
+	t.Run("nil ErrorReporter", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.ErrorReporter = nil
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.Equal(t, errNilErrorReporter, err)
+		assert.Nil(t, components)
+	})
+	t.Run("nil BatchResultsStorer", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.BatchResultsStorer = nil
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.Equal(t, errNilBatchResultsStorer, err)
+		assert.Nil(t, components)
+	})
 	t.Run("nil Messenger", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
@@ -167,6 +229,19 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, components)
 	})
+	t.Run("err on createMultiversXKeysAndAddresses, missing gas payer pk file", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.MultiversX.GasPayer = config.MultiversXGasPayerConfig{
+			Enabled:        true,
+			PrivateKeyFile: "testdata/missing.pem",
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "for chainConfigs.GasPayer.PrivateKeyFile"))
+		assert.Nil(t, components)
+	})
 	t.Run("err on createMultiversXKeysAndAddresses, empty multisig address", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
@@ -176,6 +251,53 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, components)
 	})
+	t.Run("err on createTimingJitter, zero jitter", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.TimingJitter = config.TimingJitterConfig{
+			Enabled: true,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("err on createTimingJitter, jitter not lower than the step duration", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.TimingJitter = config.TimingJitterConfig{
+			Enabled:           true,
+			MaxJitterInMillis: 1000,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "must be lower than the"))
+		assert.Nil(t, components)
+	})
+	t.Run("err on createBatchTagger", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.BatchTags = config.BatchTagsConfig{
+			Enabled: true,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.Equal(t, batchTags.ErrNoTagsConfigured, err)
+		assert.Nil(t, components)
+	})
+	t.Run("err on createPreAgreement", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.PreAgreement = config.PreAgreementConfig{
+			Enabled:         true,
+			TimeoutInMillis: 0,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, preAgreementManagement.ErrInvalidTimeout))
+		assert.Nil(t, components)
+	})
 	t.Run("err on createMultiversXClient", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
@@ -194,6 +316,15 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, components)
 	})
+	t.Run("err on createLeaderSelector, unknown strategy", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.LeaderSelection.Strategy = "unknown"
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.Nil(t, components)
+	})
 	t.Run("err on createEthereumClient, empty eth config", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
@@ -222,6 +353,31 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), args.Configs.GeneralConfig.Eth.Chain.EvmCompatibleChainToMultiversXName()))
 		assert.Nil(t, components)
 	})
+	t.Run("unknown tokens mapper type", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.TokensMapper.Type = "unknown"
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "unknown tokens mapper type"))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid token migration", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.TokensMapper.Migrations = []config.TokenMigrationConfig{
+			{
+				OldERC20Address: "not an address",
+				NewERC20Address: "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+			},
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "token migration at index 0"))
+		assert.Nil(t, components)
+	})
 	t.Run("invalid time for bootstrap", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
@@ -262,6 +418,566 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		require.False(t, check.IfNil(components.ethToMultiversXStatusHandler))
 		require.False(t, check.IfNil(components.multiversXToEthStatusHandler))
 	})
+	t.Run("should work with the timing jitter enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.TimingJitter = config.TimingJitterConfig{
+			Enabled:           true,
+			MaxJitterInMillis: 200,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 7, len(components.closableHandlers))
+		require.Equal(t, time.Millisecond*200, components.maxTimingJitter)
+	})
+	t.Run("should work with the p2p topics metrics enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.P2P.TopicsMetrics = config.TopicsMetricsConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 60,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 8, len(components.closableHandlers))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.P2PStatusHandlerName)
+	})
+	t.Run("invalid balance monitor threshold", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.BalanceMonitor = createBalanceMonitorConfig()
+		args.Configs.GeneralConfig.Relayer.BalanceMonitor.MultiversX.MinimumBalance = "not a number"
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "for the MultiversX balance monitor"))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the balance monitor enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.BalanceMonitor = createBalanceMonitorConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 9, len(components.closableHandlers))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.BalanceMonitorStatusHandlerName)
+	})
+	t.Run("should work with the stake-weighted leader selection", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.LeaderSelection.Strategy = topology.StakeWeightedStrategy
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 8, len(components.closableHandlers))
+		require.Equal(t, topology.StakeWeightedStrategy, components.leaderSelector.Strategy())
+	})
+	t.Run("should work with the runtime monitor enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.RuntimeMonitor = config.RuntimeMonitorConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 1,
+			TrendWindowInMinutes:     60,
+			MaxGoroutines:            1000,
+			MaxGrowthPercent:         100,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 8, len(components.closableHandlers))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.RuntimeMonitorStatusHandlerName)
+	})
+	t.Run("should work with the governance pause enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.GovernancePause = config.GovernancePauseConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 1,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 8, len(components.closableHandlers))
+		require.False(t, check.IfNil(components.governancePause))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.GovernancePauseStatusHandlerName)
+	})
+	t.Run("invalid incidents log", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.Incidents = createIncidentsConfig(t)
+		args.Configs.GeneralConfig.Relayer.Incidents.MaxIncidents = 0
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, incidentsManagement.ErrInvalidMaxIncidents))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the incidents enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.GovernancePause = config.GovernancePauseConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 1,
+		}
+		args.Configs.GeneralConfig.Relayer.Incidents = createIncidentsConfig(t)
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Empty(t, components.GetIncidents())
+
+		_, err = components.AcknowledgeIncident(1, core.IncidentAcknowledgment{Operator: "operator"})
+		require.True(t, errors.Is(err, incidentsManagement.ErrIncidentNotFound))
+	})
+	t.Run("invalid dead letters store", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.DeadLetters = createDeadLettersConfig(t)
+		args.Configs.GeneralConfig.Relayer.DeadLetters.MaxFailures = 0
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, deadLettersManagement.ErrInvalidMaxFailures))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the dead letters store enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.DeadLetters = createDeadLettersConfig(t)
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Empty(t, components.GetDeadLetters())
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.DeadLettersStatusHandlerName)
+
+		resolution := core.DeadLetterResolution{
+			Action:   core.DeadLetterRefund,
+			Operator: "operator",
+		}
+		_, err = components.ResolveDeadLetter("MultiversXToEthereum", 1, resolution)
+		require.True(t, errors.Is(err, deadLettersManagement.ErrDeadLetterNotFound))
+		_, err = components.ResolveDeadLetter("EthereumToMultiversX", 1, resolution)
+		require.True(t, errors.Is(err, deadLettersManagement.ErrRefundNotSupported))
+	})
+	t.Run("invalid direct messages channel", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.DirectMessages = config.DirectMessagesConfig{
+			Enabled:     true,
+			MaxMessages: 0,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, directMessagesManagement.ErrInvalidMaxMessages))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the direct messages channel enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.DirectMessages = config.DirectMessagesConfig{
+			Enabled:     true,
+			MaxMessages: 10,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Empty(t, components.GetDirectMessages())
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.DirectMessagesStatusHandlerName)
+
+		_, err = components.SendDirectMessage("erd1invalid", core.DirectMessageMaintenance, "restarting", 0)
+		require.True(t, errors.Is(err, directMessagesManagement.ErrInvalidRecipient))
+	})
+	t.Run("invalid context audit step timeout", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.ContextAudit = config.ContextAuditConfig{
+			Enabled:              true,
+			StepTimeoutInSeconds: 0,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, contextAuditManagement.ErrInvalidStepTimeout))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the context audit enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.ContextAudit = config.ContextAuditConfig{
+			Enabled:                  true,
+			StepTimeoutInSeconds:     120,
+			OverrunToleranceInMillis: 500,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(components.contextAudit))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.ContextAuditStatusHandlerName)
+	})
+	t.Run("should work with the transfer receipts enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.TransferReceipts = config.TransferReceiptsConfig{
+			Enabled: true,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(components.transferReceipts))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.TransferReceiptsStatusHandlerName)
+	})
+	t.Run("invalid cost accounting max pending executions", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.CostAccounting = config.CostAccountingConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 30,
+			MaxPendingExecutions:     0,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, accounting.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the cost accounting enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.CostAccounting = config.CostAccountingConfig{
+			Enabled:                  true,
+			PollingIntervalInSeconds: 30,
+			MaxPendingExecutions:     1000,
+			SplitRule:                accounting.EqualSplit,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(components.costAccounting))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.CostAccountingStatusHandlerName)
+	})
+	t.Run("invalid faucet minimum balance", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.Faucet = createFaucetConfig()
+		args.Configs.GeneralConfig.Relayer.Faucet.Ethereum.MinimumBalance = "not a number"
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "faucet"))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the faucet enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.Faucet = createFaucetConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 9, len(components.closableHandlers))
+	})
+	t.Run("should skip the faucet with an empty URL", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.Faucet = createFaucetConfig()
+		args.Configs.GeneralConfig.Relayer.Faucet.MultiversX.URL = ""
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 8, len(components.closableHandlers))
+	})
+	t.Run("should work with the SLA heartbeat enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.SLA = config.SLAConfig{
+			Enabled:                    true,
+			HeartbeatIntervalInSeconds: 1,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 8, len(components.closableHandlers))
+	})
+	t.Run("invalid gas usage tracker window size", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.GasUsageTracker = createGasUsageTrackerConfig()
+		args.Configs.GeneralConfig.Relayer.GasUsageTracker.WindowSize = 0
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "gas usage tracker"))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the gas usage tracker enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.GasUsageTracker = createGasUsageTrackerConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 9, len(components.closableHandlers))
+		require.Contains(t, args.MetricsHolder.GetAvailableStatusHandlers(), core.GasUsageTrackerStatusHandlerName)
+	})
+	t.Run("invalid known peers max peers", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.P2P.KnownPeers = createKnownPeersConfig(t)
+		args.Configs.GeneralConfig.P2P.KnownPeers.MaxPeers = 0
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, p2p.ErrInvalidMaxPeers))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the known peers enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.P2P.KnownPeers = createKnownPeersConfig(t)
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, 9, len(components.closableHandlers))
+	})
+	t.Run("invalid transfer allowlist recipient", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.TransferAllowlist = config.TransferAllowlistConfig{
+			Enabled:              true,
+			EthereumRecipients:   []string{"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"},
+			MultiversXRecipients: []string{"not a bech32 address"},
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, recipientAllowlistManagement.ErrInvalidRecipient))
+		assert.Nil(t, components)
+	})
+	t.Run("invalid recipient validation denylist entry", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.RecipientValidation = createRecipientValidationConfig()
+		args.Configs.GeneralConfig.Relayer.RecipientValidation.EthereumDenylist = []string{"not an address"}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, recipientValidatorManagement.ErrInvalidDenylistEntry))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the recipient validation enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.RecipientValidation = createRecipientValidationConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+	})
+	t.Run("should work with the ESDT roles check enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.MultiversX.ESDTRolesCheck = config.ESDTRolesCheckConfig{
+			Enabled:                true,
+			CacheDurationInSeconds: 300,
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+	})
+	t.Run("invalid confirmation policy tiers", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Eth.ConfirmationPolicy = createConfirmationPolicyConfig()
+		args.Configs.GeneralConfig.Eth.ConfirmationPolicy.Tokens[0].Tiers = nil
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, confirmationPolicyManagement.ErrEmptyTiers))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the confirmation policy enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Eth.ConfirmationPolicy = createConfirmationPolicyConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotEmpty(t, components.confirmationPolicy.Hash())
+	})
+	t.Run("invalid rounding mode", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.RoundingPolicy = createRoundingPolicyConfig()
+		args.Configs.GeneralConfig.Relayer.RoundingPolicy.Tokens[0].Mode = "ceil"
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, roundingPolicyManagement.ErrInvalidMode))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the rounding policy enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.RoundingPolicy = createRoundingPolicyConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotEmpty(t, components.roundingPolicy.Hash())
+		assert.Equal(t, roundingPolicyManagement.ModeRejectOnDust, components.roundingPolicy.Mode("WETH-abcdef"))
+	})
+	t.Run("invalid balance proof token", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.BalanceProof = createBalanceProofConfig()
+		args.Configs.GeneralConfig.Relayer.BalanceProof.ERC20Tokens = []string{"not an address"}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, balanceProofManagement.ErrInvalidTokenAddress))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the balance proof enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.BalanceProof = createBalanceProofConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, "*balanceProof.balanceProof", fmt.Sprintf("%T", components.balanceProofProvider))
+	})
+	t.Run("invalid fee estimation batch latency", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.FeeEstimation = createFeeEstimationConfig()
+		args.Configs.GeneralConfig.Relayer.FeeEstimation.BatchLatencyInSeconds = 0
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, feeEstimatorManagement.ErrInvalidBatchLatency))
+		assert.Nil(t, components)
+	})
+	t.Run("should work with the fee estimation enabled", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.FeeEstimation = createFeeEstimationConfig()
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.Equal(t, "*feeEstimator.feeEstimator", fmt.Sprintf("%T", components.feeEstimator))
+	})
+}
+
+func createFeeEstimationConfig() config.FeeEstimationConfig {
+	return config.FeeEstimationConfig{
+		Enabled:                true,
+		BatchLatencyInSeconds:  300,
+		CacheDurationInSeconds: 30,
+		QueryTimeoutInSeconds:  30,
+	}
+}
+
+func createBalanceProofConfig() config.BalanceProofConfig {
+	return config.BalanceProofConfig{
+		Enabled:                true,
+		ERC20Tokens:            []string{"0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c"},
+		CacheDurationInSeconds: 30,
+		QueryTimeoutInSeconds:  60,
+	}
+}
+
+func createRecipientValidationConfig() config.RecipientValidationConfig {
+	return config.RecipientValidationConfig{
+		Enabled:          true,
+		EthereumDenylist: []string{"0x3009d97ffed62e57d444e552a9edf9ee6bc8644c"},
+		NumShards:        3,
+	}
+}
+
+func createConfirmationPolicyConfig() config.ConfirmationPolicyConfig {
+	return config.ConfirmationPolicyConfig{
+		Enabled:                 true,
+		DefaultNumConfirmations: 2,
+		Tokens: []config.TokenConfirmationTiersConfig{
+			{
+				ERC20Address: "0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+				Tiers: []config.ConfirmationTierConfig{
+					{MinimumAmount: "1000000", NumConfirmations: 64},
+				},
+			},
+		},
+	}
+}
+
+func createRoundingPolicyConfig() config.RoundingPolicyConfig {
+	return config.RoundingPolicyConfig{
+		Enabled:     true,
+		DefaultMode: roundingPolicyManagement.ModeFloor,
+		Tokens: []config.TokenRoundingConfig{
+			{Token: "WETH-abcdef", Mode: roundingPolicyManagement.ModeRejectOnDust},
+		},
+	}
+}
+
+func createIncidentsConfig(tb testing.TB) config.IncidentsConfig {
+	return config.IncidentsConfig{
+		Enabled:      true,
+		FilePath:     filepath.Join(tb.TempDir(), "incidents.json"),
+		MaxIncidents: 10,
+	}
+}
+
+func createDeadLettersConfig(tb testing.TB) config.DeadLettersConfig {
+	return config.DeadLettersConfig{
+		Enabled:        true,
+		FilePath:       filepath.Join(tb.TempDir(), "deadLetters.json"),
+		MaxFailures:    20,
+		MaxDeadLetters: 10,
+	}
+}
+
+func createKnownPeersConfig(tb testing.TB) config.KnownPeersConfig {
+	return config.KnownPeersConfig{
+		Enabled:               true,
+		FilePath:              filepath.Join(tb.TempDir(), "knownPeers.json"),
+		MaxPeers:              10,
+		SaveIntervalInSeconds: 1,
+	}
+}
+
+func createBalanceMonitorConfig() config.BalanceMonitorConfig {
+	return config.BalanceMonitorConfig{
+		Enabled:                  true,
+		PollingIntervalInSeconds: 1,
+		BurnRateWindowInHours:    1,
+		Ethereum: config.BalanceThresholdsConfig{
+			MinimumBalance:     "0.5",
+			MinimumDaysToEmpty: 7,
+		},
+		MultiversX: config.BalanceThresholdsConfig{
+			MinimumBalance:     "1",
+			MinimumDaysToEmpty: 7,
+		},
+	}
+}
+
+func createGasUsageTrackerConfig() config.GasUsageTrackerConfig {
+	return config.GasUsageTrackerConfig{
+		Enabled:                  true,
+		PollingIntervalInSeconds: 1,
+		WindowSize:               10,
+		ThresholdPercent:         20,
+		MaxPendingTransactions:   100,
+	}
+}
+
+func createFaucetConfig() config.FaucetConfig {
+	return config.FaucetConfig{
+		Enabled:                  true,
+		PollingIntervalInSeconds: 1,
+		CooldownInSeconds:        1,
+		RequestTimeoutInSeconds:  1,
+		Ethereum: config.FaucetEndpointConfig{
+			URL:            "http://localhost/faucet/{address}",
+			MinimumBalance: "0.1",
+		},
+		MultiversX: config.FaucetEndpointConfig{
+			URL:            "http://localhost/faucet",
+			Body:           `{"address":"{address}"}`,
+			MinimumBalance: "1",
+		},
+	}
 }
 
 func TestEthMultiversXBridgeComponents_StartAndCloseShouldWork(t *testing.T) {
@@ -271,9 +987,20 @@ func TestEthMultiversXBridgeComponents_StartAndCloseShouldWork(t *testing.T) {
 	components, err := NewEthMultiversXBridgeComponents(args)
 	assert.Nil(t, err)
 
-	err = components.Start()
+	assert.Nil(t, components.GetSyncReport())
+	proof, err := components.GetBalanceProof(context.Background())
+	assert.Nil(t, proof)
+	assert.Equal(t, disabled.ErrBalanceProofDisabled, err)
+	estimate, err := components.EstimateFee(context.Background(), "USDC-abcdef", big.NewInt(1000))
+	assert.Nil(t, estimate)
+	assert.Equal(t, disabled.ErrFeeEstimationDisabled, err)
+	assert.Empty(t, components.GetIncidents())
+	assert.Empty(t, components.GetDeadLetters())
+
+	err = components.Start(context.Background())
 	assert.Nil(t, err)
 	assert.Equal(t, 7, len(components.closableHandlers))
+	assert.NotNil(t, components.GetSyncReport())
 
 	time.Sleep(time.Second * 2) // allow go routines to start
 
@@ -296,9 +1023,27 @@ func TestEthMultiversXBridgeComponents_Start(t *testing.T) {
 		}
 		components, _ := NewEthMultiversXBridgeComponents(args)
 
-		err := components.Start()
+		err := components.Start(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("wrong network configuration should error before bootstrap", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.NetworkCheck.Enabled = true
+		args.Messenger = &p2pMocks.MessengerStub{
+			BootstrapCalled: func() error {
+				assert.Fail(t, "should have not bootstrapped the messenger")
+				return nil
+			},
+		}
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+
+		err = components.Start(context.Background())
+		assert.True(t, errors.Is(err, networkValidatorManagement.ErrWrongNetwork))
+		assert.True(t, strings.Contains(err.Error(), "Eth.MultisigContractAddress"))
+	})
 	t.Run("broadcaster errors on RegisterOnTopics", func(t *testing.T) {
 		t.Parallel()
 
@@ -311,7 +1056,7 @@ func TestEthMultiversXBridgeComponents_Start(t *testing.T) {
 			},
 		}
 
-		err := components.Start()
+		err := components.Start(context.Background())
 		assert.Equal(t, expectedErr, err)
 	})
 }
@@ -388,7 +1133,7 @@ func TestEthMultiversXBridgeComponents_startBroadcastJoinRetriesLoop(t *testing.
 			},
 		}
 
-		err := components.Start()
+		err := components.Start(context.Background())
 		assert.Nil(t, err)
 		time.Sleep(time.Second * 3)
 
@@ -409,7 +1154,7 @@ func TestEthMultiversXBridgeComponents_startBroadcastJoinRetriesLoop(t *testing.
 			},
 		}
 
-		err := components.Start()
+		err := components.Start(context.Background())
 		assert.Nil(t, err)
 		time.Sleep(time.Second * 7)
 
@@ -429,3 +1174,119 @@ func TestEthMultiversXBridgeComponents_RelayerAddresses(t *testing.T) {
 	assert.Equal(t, "erd1r69gk66fmedhhcg24g2c5kn2f2a5k4kvpr6jfw67dn2lyydd8cfswy6ede", bech32Address)
 	assert.Equal(t, "0x3FE464Ac5aa562F7948322F92020F2b668D543d8", components.EthereumRelayerAddress().String())
 }
+
+func TestEthMultiversXBridgeComponents_GetTopologyInfo(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthMultiversXBridgeArgs()
+	components, _ := NewEthMultiversXBridgeComponents(args)
+
+	topologyInfo := components.GetTopologyInfo(5)
+	require.Equal(t, 2, len(topologyInfo))
+	for _, name := range []string{"EthereumToMultiversX", "MultiversXToEthereum"} {
+		info := topologyInfo[name]
+		require.NotNil(t, info, name)
+		assert.Equal(t, "erd1r69gk66fmedhhcg24g2c5kn2f2a5k4kvpr6jfw67dn2lyydd8cfswy6ede", info.SelfAddress)
+		assert.Equal(t, int64(60), info.IntervalForLeaderInSeconds)
+	}
+}
+
+func TestEthMultiversXBridgeComponents_RegisterStepHook(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthMultiversXBridgeArgs()
+	components, _ := NewEthMultiversXBridgeComponents(args)
+
+	err := components.RegisterStepHook(nil)
+	assert.NotNil(t, err)
+
+	err = components.RegisterStepHook(&testsCommon.StepHookStub{})
+	assert.Nil(t, err)
+}
+
+func TestEthMultiversXBridgeComponents_BatchExplorer(t *testing.T) {
+	t.Parallel()
+
+	args := createMockEthMultiversXBridgeArgs()
+	components, err := NewEthMultiversXBridgeComponents(args)
+	require.Nil(t, err)
+	require.NotNil(t, components.batchExplorer)
+
+	components.batchExplorer.AfterStep(context.Background(), "EthereumToMultiversX", "GettingPendingBatchFromEthereum", "GettingPendingBatchFromEthereum", time.Second)
+	assert.Empty(t, components.GetPendingBatches())
+	assert.Empty(t, components.GetBatchLiveViews(1))
+	assert.Empty(t, components.GetDepositLiveViews(1))
+}
+
+func TestEthMultiversXBridgeComponents_ReloadConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid step duration should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthMultiversXBridgeArgs()
+		components, _ := NewEthMultiversXBridgeComponents(args)
+
+		cfg := args.Configs.GeneralConfig
+		cfg.StateMachine = map[string]config.ConfigStateMachine{
+			"EthereumToMultiversX": {StepDurationInMillis: 0},
+		}
+		err := components.ReloadConfig(cfg)
+		assert.True(t, errors.Is(err, reloadablePolling.ErrInvalidPollingInterval))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthMultiversXBridgeArgs()
+		components, _ := NewEthMultiversXBridgeComponents(args)
+
+		cfg := args.Configs.GeneralConfig
+		cfg.StateMachine = map[string]config.ConfigStateMachine{
+			"EthereumToMultiversX": {StepDurationInMillis: 2000},
+			"MultiversXToEthereum": {StepDurationInMillis: 3000},
+		}
+		reloadableConfig := components.createReloadableConfig(cfg)
+		assert.Equal(t, time.Second*2, reloadableConfig.PollingIntervals["EthereumToMultiversX State machine"])
+		assert.Equal(t, time.Second*3, reloadableConfig.PollingIntervals["MultiversXToEthereum State machine"])
+
+		err := components.ReloadConfig(cfg)
+		assert.Nil(t, err)
+	})
+	t.Run("should record the applied settings in the running config", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthMultiversXBridgeArgs()
+		components, _ := NewEthMultiversXBridgeComponents(args)
+		initialConfig := components.RunningConfig()
+		initialStepDuration := initialConfig.StateMachine["EthereumToMultiversX"].StepDurationInMillis
+
+		cfg := args.Configs.GeneralConfig
+		cfg.Eth.GasStation.Enabled = !initialConfig.Eth.GasStation.Enabled
+		cfg.Eth.GasStation.MaximumAllowedGasPrice = initialConfig.Eth.GasStation.MaximumAllowedGasPrice + 100
+		cfg.Eth.GasLimitBase = initialConfig.Eth.GasLimitBase + 1
+		cfg.Logs.LogLevel = "*:DEBUG"
+		cfg.StateMachine = map[string]config.ConfigStateMachine{
+			"EthereumToMultiversX": {StepDurationInMillis: 2000, IntervalForLeaderInSeconds: 1000},
+			"MultiversXToEthereum": {StepDurationInMillis: 3000},
+		}
+		_ = components.ReloadConfig(cfg)
+
+		runningConfig := components.RunningConfig()
+		assert.Equal(t, initialConfig.Eth.GasStation.Enabled, runningConfig.Eth.GasStation.Enabled)
+		assert.Equal(t, cfg.Eth.GasStation.MaximumAllowedGasPrice, runningConfig.Eth.GasStation.MaximumAllowedGasPrice)
+		assert.Equal(t, initialConfig.Eth.GasLimitBase, runningConfig.Eth.GasLimitBase)
+		assert.Equal(t, "*:DEBUG", runningConfig.Logs.LogLevel)
+		assert.Equal(t, uint64(2000), runningConfig.StateMachine["EthereumToMultiversX"].StepDurationInMillis)
+		assert.Equal(t, initialConfig.StateMachine["EthereumToMultiversX"].IntervalForLeaderInSeconds,
+			runningConfig.StateMachine["EthereumToMultiversX"].IntervalForLeaderInSeconds)
+		assert.Equal(t, uint64(3000), runningConfig.StateMachine["MultiversXToEthereum"].StepDurationInMillis)
+		assert.Equal(t, initialStepDuration, args.Configs.GeneralConfig.StateMachine["EthereumToMultiversX"].StepDurationInMillis)
+	})
+}
+
+func TestGetMaxQuorumRetries(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, uint64(3), getMaxQuorumRetries(config.ConfigStateMachine{}, 3))
+	assert.Equal(t, uint64(10), getMaxQuorumRetries(config.ConfigStateMachine{MaxRetriesOnQuorumReached: 10}, 3))
+}
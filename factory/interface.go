@@ -2,22 +2,44 @@ package factory
 
 import (
 	"context"
+	"io"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/core/types"
+	ethmultiversx "github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
 )
 
 type dataGetter interface {
 	GetTokenIdForErc20Address(ctx context.Context, erc20Address []byte) ([][]byte, error)
 	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
 	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
+	GetAmountStaked(ctx context.Context, relayerAddress []byte) (*big.Int, error)
+	IsPaused(ctx context.Context) (bool, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetLastMvxBatchID(ctx context.Context) (uint64, error)
+	GetQuorum(ctx context.Context) (uint64, error)
+	ExecuteQueryReturningBytes(ctx context.Context, request *data.VmValueRequest) ([][]byte, error)
+	GetRequiredFee(ctx context.Context, token []byte) (*big.Int, error)
+	GetTokenMinLimit(ctx context.Context, token []byte) (*big.Int, error)
+	GetTokenMaxLimit(ctx context.Context, token []byte) (*big.Int, error)
 	IsInterfaceNil() bool
 }
 
+type topologyInfoProvider interface {
+	TopologyInfo(numSlots int) *core.TopologyInfo
+}
+
 // MultiversXRoleProvider defines the operations for the MultiversX role provider
 type MultiversXRoleProvider interface {
 	Execute(ctx context.Context) error
 	IsWhitelisted(address sdkCore.AddressHandler) bool
+	IsOnProbation(address []byte) bool
 	SortedPublicKeys() [][]byte
 	IsInterfaceNil() bool
 }
@@ -36,6 +58,10 @@ type Broadcaster interface {
 	SortedPublicKeys() [][]byte
 	RegisterOnTopics() error
 	AddBroadcastClient(client core.BroadcastClient) error
+	BroadcastPreAgreementMessage(payload []byte)
+	AddPreAgreementClient(client core.PreAgreementClient) error
+	SendDirectMessage(payload []byte, publicKey []byte) error
+	AddDirectMessageClient(client core.DirectMessageClient) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -43,6 +69,7 @@ type Broadcaster interface {
 // StateMachine defines a state machine component
 type StateMachine interface {
 	Execute(ctx context.Context) error
+	RegisterStepHook(hook core.StepHook) error
 	IsInterfaceNil() bool
 }
 
@@ -51,3 +78,115 @@ type PollingHandler interface {
 	StartProcessingLoop() error
 	IsInterfaceNil() bool
 }
+
+type closablePollingHandler interface {
+	PollingHandler
+	io.Closer
+}
+
+// SLATracker defines the component recording the relayer's SLA data. It is notified by the bridge executors and
+// the state machines, while its Execute method is called periodically as a heartbeat
+type SLATracker interface {
+	ethmultiversx.SLATracker
+	core.StepHook
+	Execute(ctx context.Context) error
+}
+
+// ErrorReporter defines the component sending the critical errors to an external error tracking service. It is
+// notified by the bridge executors and the state machines, including the steps panics
+type ErrorReporter interface {
+	ethmultiversx.ErrorReporter
+	core.StepHook
+	core.PanicHook
+}
+
+// BatchResultsStorer defines the component saving the per-deposit results and the signed transfer receipts of the
+// executed batches
+type BatchResultsStorer interface {
+	ethmultiversx.BatchResultsStorer
+	StoreTransferReceipts(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error
+	StoreExecutionCosts(period string, costs []*core.ExecutionCost) error
+	GetExecutionCosts(period string) ([]*core.ExecutionCost, error)
+}
+
+type leftoverTransactionsHandler interface {
+	WaitForLeftoverTransactions(ctx context.Context) error
+}
+
+type knownPeersHolder interface {
+	AddPeer(pid chainCore.PeerID)
+	ReconnectKnownPeers() int
+	IsInterfaceNil() bool
+}
+
+type networkValidator interface {
+	Validate(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+type syncReporter interface {
+	Generate(ctx context.Context) *core.SyncReport
+	GetSyncReport() *core.SyncReport
+	IsInterfaceNil() bool
+}
+
+type relayedClaimsHandler interface {
+	SubmitClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+type rawTransactionsExporter interface {
+	IsEnabled() bool
+	ExportTransaction(batchID uint64, tx *types.Transaction) error
+	GetExportedTransactions() []*core.ExportedTransaction
+	IsInterfaceNil() bool
+}
+
+type confirmationPolicy interface {
+	RequiredConfirmations(batch *core.TransferBatch) uint64
+	Hash() []byte
+	IsInterfaceNil() bool
+}
+
+type roundingPolicy interface {
+	Mode(token string) string
+	Convert(token string, amount *big.Int, sourceDecimals uint8, destinationDecimals uint8) (*big.Int, *big.Int, error)
+	Hash() []byte
+	IsInterfaceNil() bool
+}
+
+type gasUsageTrackerHandler interface {
+	Track(hash string, function string)
+	IsInterfaceNil() bool
+}
+
+type deadLetters interface {
+	RecordFailure(direction string, batch *core.TransferBatch, reason string)
+	RecordSuccess(direction string, batch *core.TransferBatch)
+	IsRefunded(direction string, depositNonce uint64) bool
+	GetDeadLetters() []*core.DeadLetter
+	ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error)
+	IsInterfaceNil() bool
+}
+
+type incidentsQueue interface {
+	Raise(source string, description string) uint64
+	HasUnacknowledgedIncidents() bool
+	GetIncidents() []*core.Incident
+	AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error)
+	IsInterfaceNil() bool
+}
+
+type batchExplorer interface {
+	core.StepHook
+	core.BatchExplorer
+}
+
+type operatorPause interface {
+	PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	GetHalfBridgePauses() []*core.HalfBridgePause
+	IsHalfBridgePaused(halfBridge string) bool
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,90 @@
+package factory
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core/passphrase"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/interactors"
+)
+
+const (
+	ethereumKeyName   = "Ethereum"
+	multiversXKeyName = "MultiversX"
+)
+
+// LoadMultiversXRelayerAddress returns the address of the MultiversX key configured for the relayer
+func LoadMultiversXRelayerAddress(chainConfigs config.MultiversXConfig) (sdkCore.AddressHandler, error) {
+	privateKeyBytes, err := loadMultiversXPrivateKey(chainConfigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return interactors.NewWallet().GetAddressFromPrivateKey(privateKeyBytes)
+}
+
+func loadMultiversXPrivateKey(chainConfigs config.MultiversXConfig) ([]byte, error) {
+	mnemonicConfig := chainConfigs.PrivateKeyMnemonic
+	keystoreConfig := chainConfigs.PrivateKeyKeystore
+	wallet := interactors.NewWallet()
+
+	switch {
+	case len(mnemonicConfig.MnemonicFile) > 0:
+		return multiversx.LoadPrivateKeyFromMnemonicFile(mnemonicConfig.MnemonicFile, mnemonicConfig.Account, mnemonicConfig.AddressIndex)
+	case len(keystoreConfig.KeystoreFile) > 0:
+		password, err := readKeystorePassphrase(multiversXKeyName, keystoreConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return wallet.LoadPrivateKeyFromJsonFile(keystoreConfig.KeystoreFile, password)
+	default:
+		return wallet.LoadPrivateKeyFromPemFile(chainConfigs.PrivateKeyFile)
+	}
+}
+
+// LoadEthereumRelayerAddress returns the address of the Ethereum key configured for the relayer
+func LoadEthereumRelayerAddress(ethereumConfigs config.EthereumConfig) (common.Address, error) {
+	cryptoHandler, err := createEthereumCryptoHandler(ethereumConfigs)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return cryptoHandler.GetAddress(), nil
+}
+
+func createEthereumCryptoHandler(ethereumConfigs config.EthereumConfig) (ethereum.CryptoHandler, error) {
+	mnemonicConfig := ethereumConfigs.PrivateKeyMnemonic
+	keystoreConfig := ethereumConfigs.PrivateKeyKeystore
+
+	switch {
+	case len(mnemonicConfig.MnemonicFile) > 0:
+		derivationPath := mnemonicConfig.DerivationPath
+		if len(derivationPath) == 0 {
+			derivationPath = ethereum.DefaultDerivationPath
+		}
+
+		return ethereum.NewCryptoHandlerFromMnemonic(mnemonicConfig.MnemonicFile, derivationPath)
+	case len(keystoreConfig.KeystoreFile) > 0:
+		password, err := readKeystorePassphrase(ethereumKeyName, keystoreConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return ethereum.NewCryptoHandlerFromKeystore(keystoreConfig.KeystoreFile, password)
+	default:
+		// the private key file can also be a keystore, decrypted with the passphrase sources of the keystore section
+		return ethereum.NewCryptoHandlerFromKeyFile(ethereumConfigs.PrivateKeyFile, func() (string, error) {
+			return readKeystorePassphrase(ethereumKeyName, keystoreConfig)
+		})
+	}
+}
+
+func readKeystorePassphrase(keyName string, keystoreConfig config.KeystoreConfig) (string, error) {
+	return passphrase.Read(keyName, passphrase.Source{
+		EnvVariable: keystoreConfig.PassphraseEnvVariable,
+		File:        keystoreConfig.PassphraseFile,
+	})
+}
@@ -0,0 +1,242 @@
+package factory
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPassphraseFile(t *testing.T, passphrase string) string {
+	filename := filepath.Join(t.TempDir(), "passphrase")
+	require.Nil(t, os.WriteFile(filename, []byte(passphrase), 0600))
+
+	return filename
+}
+
+func TestLoadMultiversXPrivateKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should load from the PEM file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MultiversXConfig{
+			PrivateKeyFile: "testdata/grace.pem",
+		}
+		privateKey, err := loadMultiversXPrivateKey(cfg)
+		assert.Nil(t, err)
+
+		expectedPrivateKey, err := interactors.NewWallet().LoadPrivateKeyFromPemFile("testdata/grace.pem")
+		require.Nil(t, err)
+		assert.Equal(t, expectedPrivateKey, privateKey)
+	})
+	t.Run("should derive from the mnemonic file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MultiversXConfig{
+			PrivateKeyFile: "testdata/grace.pem",
+			PrivateKeyMnemonic: config.MultiversXMnemonicConfig{
+				MnemonicFile: "testdata/relayer-mnemonic",
+				AddressIndex: 1,
+			},
+		}
+		privateKey, err := loadMultiversXPrivateKey(cfg)
+		assert.Nil(t, err)
+
+		expectedPrivateKey, err := interactors.NewWallet().LoadPrivateKeyFromPemFile("testdata/grace.pem")
+		require.Nil(t, err)
+		assert.NotEqual(t, expectedPrivateKey, privateKey)
+		assert.Equal(t, 32, len(privateKey))
+	})
+	t.Run("should load from the keystore file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MultiversXConfig{
+			PrivateKeyKeystore: config.KeystoreConfig{
+				KeystoreFile:   "testdata/grace.json",
+				PassphraseFile: createPassphraseFile(t, "password"),
+			},
+		}
+		privateKey, err := loadMultiversXPrivateKey(cfg)
+		assert.Nil(t, err)
+
+		expectedPrivateKey, err := interactors.NewWallet().LoadPrivateKeyFromPemFile("testdata/grace.pem")
+		require.Nil(t, err)
+		assert.Equal(t, expectedPrivateKey, privateKey)
+	})
+	t.Run("wrong keystore passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MultiversXConfig{
+			PrivateKeyKeystore: config.KeystoreConfig{
+				KeystoreFile:   "testdata/grace.json",
+				PassphraseFile: createPassphraseFile(t, "wrong password"),
+			},
+		}
+		privateKey, err := loadMultiversXPrivateKey(cfg)
+		assert.NotNil(t, err)
+		assert.Nil(t, privateKey)
+	})
+	t.Run("missing mnemonic file should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.MultiversXConfig{
+			PrivateKeyMnemonic: config.MultiversXMnemonicConfig{
+				MnemonicFile: "testdata/missing-mnemonic",
+			},
+		}
+		privateKey, err := loadMultiversXPrivateKey(cfg)
+		assert.NotNil(t, err)
+		assert.Nil(t, privateKey)
+	})
+}
+
+func TestLoadMultiversXRelayerAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := LoadMultiversXRelayerAddress(config.MultiversXConfig{PrivateKeyFile: "testdata/missing.pem"})
+		assert.NotNil(t, err)
+		assert.Nil(t, address)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := LoadMultiversXRelayerAddress(config.MultiversXConfig{PrivateKeyFile: "testdata/grace.pem"})
+		require.Nil(t, err)
+
+		bech32Address, _ := address.AddressAsBech32String()
+		assert.Equal(t, "erd1r69gk66fmedhhcg24g2c5kn2f2a5k4kvpr6jfw67dn2lyydd8cfswy6ede", bech32Address)
+	})
+}
+
+func TestLoadEthereumRelayerAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing key file should error", func(t *testing.T) {
+		t.Parallel()
+
+		address, err := LoadEthereumRelayerAddress(config.EthereumConfig{PrivateKeyFile: "testdata/missing.sk"})
+		assert.NotNil(t, err)
+		assert.Equal(t, common.Address{}, address)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyFile: "testdata/grace.sk",
+		}
+		address, err := LoadEthereumRelayerAddress(cfg)
+		require.Nil(t, err)
+
+		cryptoHandler, _ := createEthereumCryptoHandler(cfg)
+		assert.Equal(t, cryptoHandler.GetAddress(), address)
+		assert.NotEqual(t, common.Address{}, address)
+	})
+}
+
+func TestCreateEthereumCryptoHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should load from the private key file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyFile: "testdata/grace.sk",
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.Nil(t, err)
+		assert.False(t, cryptoHandler.IsInterfaceNil())
+	})
+	t.Run("should derive from the mnemonic file using the default derivation path", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyMnemonic: config.EthereumMnemonicConfig{
+				MnemonicFile: "testdata/relayer-mnemonic",
+			},
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.Nil(t, err)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", cryptoHandler.GetAddress().Hex())
+	})
+	t.Run("should derive from the mnemonic file using the provided derivation path", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyMnemonic: config.EthereumMnemonicConfig{
+				MnemonicFile:   "testdata/relayer-mnemonic",
+				DerivationPath: "m/44'/60'/0'/0/1",
+			},
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.Nil(t, err)
+		assert.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", cryptoHandler.GetAddress().Hex())
+	})
+	t.Run("should load from the keystore file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyKeystore: config.KeystoreConfig{
+				KeystoreFile:   "testdata/eth-keystore.json",
+				PassphraseFile: createPassphraseFile(t, "password"),
+			},
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.Nil(t, err)
+
+		expectedCryptoHandler, err := ethereum.NewCryptoHandler("testdata/grace.sk")
+		require.Nil(t, err)
+		assert.Equal(t, expectedCryptoHandler.GetAddress(), cryptoHandler.GetAddress())
+	})
+	t.Run("should load the keystore set as private key file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyFile: "testdata/eth-keystore.json",
+			PrivateKeyKeystore: config.KeystoreConfig{
+				PassphraseFile: createPassphraseFile(t, "password"),
+			},
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.Nil(t, err)
+
+		expectedCryptoHandler, err := ethereum.NewCryptoHandler("testdata/grace.sk")
+		require.Nil(t, err)
+		assert.Equal(t, expectedCryptoHandler.GetAddress(), cryptoHandler.GetAddress())
+	})
+	t.Run("wrong keystore passphrase should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyKeystore: config.KeystoreConfig{
+				KeystoreFile:   "testdata/eth-keystore.json",
+				PassphraseFile: createPassphraseFile(t, "wrong password"),
+			},
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.NotNil(t, err)
+		assert.Nil(t, cryptoHandler)
+	})
+	t.Run("invalid derivation path should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := config.EthereumConfig{
+			PrivateKeyMnemonic: config.EthereumMnemonicConfig{
+				MnemonicFile:   "testdata/relayer-mnemonic",
+				DerivationPath: "m/invalid",
+			},
+		}
+		cryptoHandler, err := createEthereumCryptoHandler(cfg)
+		assert.NotNil(t, err)
+		assert.Nil(t, cryptoHandler)
+	})
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // CreateUnitStorer based on the config and the working directory
-func CreateUnitStorer(config config.StorageConfig, workingDir string) (core.Storer, error) {
+func CreateUnitStorer(config config.StorageConfig, workingDir string) (core.RemovableStorer, error) {
 	dbConfigHandler := factory.NewDBConfigHandler(config.DB)
 	persisterCreator, err := factory.NewPersisterFactory(dbConfigHandler)
 	if err != nil {
@@ -0,0 +1,34 @@
+package factory
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx/mappers"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+)
+
+// CreateTokenMigrations converts and checks the configured ERC20 contracts migrations
+func CreateTokenMigrations(cfg []config.TokenMigrationConfig) ([]mappers.TokenMigration, error) {
+	migrations := make([]mappers.TokenMigration, 0, len(cfg))
+	for i, migrationConfig := range cfg {
+		if !common.IsHexAddress(migrationConfig.OldERC20Address) || !common.IsHexAddress(migrationConfig.NewERC20Address) {
+			return nil, fmt.Errorf("%w for the token migration at index %d, old address %s, new address %s",
+				errInvalidValue, i, migrationConfig.OldERC20Address, migrationConfig.NewERC20Address)
+		}
+
+		migrations = append(migrations, mappers.TokenMigration{
+			OldERC20Address: common.HexToAddress(migrationConfig.OldERC20Address).Bytes(),
+			NewERC20Address: common.HexToAddress(migrationConfig.NewERC20Address).Bytes(),
+			EffectiveBlock:  migrationConfig.EffectiveBlock,
+			ExpiryBlock:     migrationConfig.ExpiryBlock,
+		})
+	}
+
+	err := mappers.CheckTokenMigrations(migrations)
+	if err != nil {
+		return nil, err
+	}
+
+	return migrations, nil
+}
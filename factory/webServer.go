@@ -5,16 +5,62 @@ import (
 
 	"github.com/multiversx/mx-bridge-eth-go/api/gin"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/facade"
+	"github.com/multiversx/mx-bridge-eth-go/status"
 )
 
-// StartWebServer creates and starts a web server able to respond with the metrics holder information
-func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (io.Closer, error) {
+// ArgsWebServer is the DTO used in the StartWebServer function
+type ArgsWebServer struct {
+	Configs        config.Configs
+	MetricsHolder  core.MetricsHolder
+	BatchResults   core.BatchResultsHolder
+	RuntimeInfo    *core.RuntimeInfo
+	Topology       core.TopologyInfoHolder
+	ExportedTxs    core.ExportedTransactionsHolder
+	SyncReport     core.SyncReportHolder
+	RelayedClaims  core.RelayedClaimsHandler
+	BalanceProof   core.BalanceProofProvider
+	FeeEstimator   core.FeeEstimator
+	Incidents      core.IncidentsHolder
+	DeadLetters    core.DeadLettersHolder
+	DirectMessages core.DirectMessagesHolder
+	OperatorPause  core.OperatorPauseHandler
+	BatchExplorer  core.BatchExplorer
+	ConfigDrift    core.ConfigDriftAuditor
+	ConfigSchema   *schema.Schema
+}
+
+// StartWebServer creates and starts a web server able to respond with the metrics holder information, also in the
+// Prometheus format, and with the information of the other provided components
+func StartWebServer(args ArgsWebServer) (io.Closer, error) {
+	metricsExporter, err := status.NewPrometheusExporter(args.MetricsHolder)
+	if err != nil {
+		return nil, err
+	}
+
 	argsFacade := facade.ArgsRelayerFacade{
-		MetricsHolder: metricsHolder,
-		ApiInterface:  configs.FlagsConfig.RestApiInterface,
-		PprofEnabled:  configs.FlagsConfig.EnablePprof,
+		MetricsHolder:   args.MetricsHolder,
+		MetricsExporter: metricsExporter,
+		Loggers:         core.GetLoggersRegistry(),
+		BatchResults:    args.BatchResults,
+		RuntimeInfo:     args.RuntimeInfo,
+		Topology:        args.Topology,
+		ExportedTxs:     args.ExportedTxs,
+		SyncReport:      args.SyncReport,
+		RelayedClaims:   args.RelayedClaims,
+		BalanceProof:    args.BalanceProof,
+		FeeEstimator:    args.FeeEstimator,
+		Incidents:       args.Incidents,
+		DeadLetters:     args.DeadLetters,
+		DirectMessages:  args.DirectMessages,
+		OperatorPause:   args.OperatorPause,
+		BatchExplorer:   args.BatchExplorer,
+		ConfigDrift:     args.ConfigDrift,
+		ConfigSchema:    args.ConfigSchema,
+		ApiInterface:    args.Configs.FlagsConfig.RestApiInterface,
+		PprofEnabled:    args.Configs.FlagsConfig.EnablePprof,
 	}
 
 	relayerFacade, err := facade.NewRelayerFacade(argsFacade)
@@ -24,8 +70,8 @@ func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (i
 
 	httpServerArgs := gin.ArgsNewWebServer{
 		Facade:          relayerFacade,
-		ApiConfig:       configs.ApiRoutesConfig,
-		AntiFloodConfig: configs.GeneralConfig.WebAntiflood,
+		ApiConfig:       args.Configs.ApiRoutesConfig,
+		AntiFloodConfig: args.Configs.GeneralConfig.WebAntiflood,
 	}
 
 	httpServerWrapper, err := gin.NewWebServerHandler(httpServerArgs)
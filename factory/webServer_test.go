@@ -3,9 +3,12 @@ package factory
 import (
 	"testing"
 
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/status"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,7 +23,25 @@ func TestStartWebServer(t *testing.T) {
 		},
 	}
 
-	webServer, err := StartWebServer(cfg, status.NewMetricsHolder())
+	webServer, err := StartWebServer(ArgsWebServer{
+		Configs:        cfg,
+		MetricsHolder:  status.NewMetricsHolder(),
+		BatchResults:   disabled.NewDisabledBatchResultsStorer(),
+		RuntimeInfo:    &core.RuntimeInfo{},
+		Topology:       &testsCommon.TopologyInfoHolderStub{},
+		ExportedTxs:    disabled.NewDisabledRawTransactionsExporter(),
+		SyncReport:     &testsCommon.SyncReportHolderStub{},
+		RelayedClaims:  disabled.NewDisabledRelayedClaimsHandler(),
+		BalanceProof:   disabled.NewDisabledBalanceProofProvider(),
+		FeeEstimator:   disabled.NewDisabledFeeEstimator(),
+		Incidents:      disabled.NewDisabledIncidentsQueue(),
+		DeadLetters:    disabled.NewDisabledDeadLetters(),
+		DirectMessages: disabled.NewDisabledDirectMessages(),
+		OperatorPause:  disabled.NewDisabledOperatorPause(),
+		BatchExplorer:  disabled.NewDisabledBatchExplorer(),
+		ConfigDrift:    disabled.NewDisabledConfigDriftAuditor(),
+		ConfigSchema:   &schema.Schema{},
+	})
 	assert.Nil(t, err)
 	assert.NotNil(t, webServer)
 
@@ -13,9 +13,9 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	p2pMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/p2p"
 	mockRoleProviders "github.com/multiversx/mx-bridge-eth-go/testsCommon/roleProviders"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	chainConfig "github.com/multiversx/mx-chain-go/config"
-	chainP2P "github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	"github.com/multiversx/mx-sdk-go/core"
@@ -25,8 +25,9 @@ import (
 func TestNetworkOfBroadcastersShouldPassTheSignatures(t *testing.T) {
 	numBroadcasters := 5
 
-	integrationTests.Log.Info("creating & linking network messengers...")
-	messengers := integrationTests.CreateLinkedMessengers(numBroadcasters)
+	integrationTests.Log.Info("creating the simulated network messengers...")
+	network := p2pMocks.NewNetworkSimulator()
+	messengers := network.CreateMessengers(numBroadcasters)
 	defer func() {
 		for _, messenger := range messengers {
 			_ = messenger.Close()
@@ -50,14 +51,12 @@ func TestNetworkOfBroadcastersShouldPassTheSignatures(t *testing.T) {
 	integrationTests.Log.Info("creating broadcasters...")
 	broadcasters, signaturesHolders := createBroadcasters(t, numBroadcasters, messengers, roleProvider, privateKeys)
 
-	time.Sleep(time.Second)
-
 	expectedPkInOrder := copyAndSortBytesSlices(publicKeysBytes)
 
 	messageHash := []byte("message hash")
-	joinBroadcasters(broadcasters)
+	joinBroadcasters(network, broadcasters)
 	signatures := createSignatures(numBroadcasters, "mock signature - try 1")
-	sendSignatures(broadcasters, signatures, messageHash)
+	sendSignatures(network, broadcasters, signatures, messageHash)
 	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
 
 	// clear test
@@ -66,21 +65,22 @@ func TestNetworkOfBroadcastersShouldPassTheSignatures(t *testing.T) {
 
 	messageHash = []byte("message hash 1")
 	signatures = createSignatures(numBroadcasters, "mock signature - try 2")
-	sendSignatures(broadcasters, signatures, messageHash)
+	sendSignatures(network, broadcasters, signatures, messageHash)
 	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
 
 	// overwrite test
 	messageHash = []byte("message hash 2")
 	signatures = createSignatures(numBroadcasters, "mock signature - try 3")
-	sendSignatures(broadcasters, signatures, messageHash)
+	sendSignatures(network, broadcasters, signatures, messageHash)
 	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
 }
 
 func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenNotJoining(t *testing.T) {
 	numBroadcasters := 5
 
-	integrationTests.Log.Info("creating & linking network messengers...")
-	messengers := integrationTests.CreateLinkedMessengers(numBroadcasters)
+	integrationTests.Log.Info("creating the simulated network messengers...")
+	network := p2pMocks.NewNetworkSimulator()
+	messengers := network.CreateMessengers(numBroadcasters)
 	defer func() {
 		for _, messenger := range messengers {
 			_ = messenger.Close()
@@ -104,14 +104,12 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenNotJoining(t *
 	integrationTests.Log.Info("creating broadcasters...")
 	broadcasters, signaturesHolders := createBroadcasters(t, numBroadcasters, messengers, roleProvider, privateKeys)
 
-	time.Sleep(time.Second)
-
 	expectedPkInOrder := copyAndSortBytesSlices(publicKeysBytes[1:])
 	messageHash := []byte("message hash")
 	joiningBroadcasters := broadcasters[1:]
-	joinBroadcasters(joiningBroadcasters)
+	joinBroadcasters(network, joiningBroadcasters)
 	signatures := createSignatures(numBroadcasters, "mock signature - try 1")
-	sendSignatures(joiningBroadcasters, signatures[1:], messageHash)
+	sendSignatures(network, joiningBroadcasters, signatures[1:], messageHash)
 	checkBroadcasterState(t, joiningBroadcasters, signaturesHolders, signatures[1:], expectedPkInOrder, messageHash)
 
 	lateBroadcasters := []integrationTests.Broadcaster{broadcasters[0]}
@@ -121,8 +119,9 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenNotJoining(t *
 func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting(t *testing.T) {
 	numBroadcasters := 5
 
-	integrationTests.Log.Info("creating & linking network messengers...")
-	messengers := integrationTests.CreateLinkedMessengers(numBroadcasters)
+	integrationTests.Log.Info("creating the simulated network messengers...")
+	network := p2pMocks.NewNetworkSimulator()
+	messengers := network.CreateMessengers(numBroadcasters)
 	defer func() {
 		for _, messenger := range messengers {
 			_ = messenger.Close()
@@ -146,14 +145,12 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting
 	integrationTests.Log.Info("creating broadcasters...")
 	broadcasters, signaturesHolders := createBroadcasters(t, numBroadcasters-1, messengers, roleProvider, privateKeys)
 
-	time.Sleep(time.Second)
-
 	expectedPkInOrder := copyAndSortBytesSlices(publicKeysBytes[:len(publicKeysBytes)-1])
 	messageHash := []byte("message hash")
 
-	joinBroadcasters(broadcasters)
+	joinBroadcasters(network, broadcasters)
 	signatures := createSignatures(numBroadcasters-1, "mock signature - try 1")
-	sendSignatures(broadcasters, signatures, messageHash)
+	sendSignatures(network, broadcasters, signatures, messageHash)
 	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
 
 	expectedPkInOrder = copyAndSortBytesSlices(publicKeysBytes)
@@ -161,9 +158,8 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting
 	integrationTests.Log.Info("creating the late broadcaster")
 	lateBroadcaster, lateSigHolder := createBroadcaster(t, messengers[len(messengers)-1], roleProvider, privateKeys[len(privateKeys)-1])
 
-	time.Sleep(time.Second)
 	lateBroadcaster.BroadcastJoinTopic()
-	time.Sleep(time.Second)
+	network.DeliverAll()
 
 	lateBroadcasters := []integrationTests.Broadcaster{lateBroadcaster}
 	lateSigHolders := []*testsCommon.SignaturesHolderMock{lateSigHolder}
@@ -171,10 +167,64 @@ func TestNetworkOfBroadcastersShouldBootstrapOnLateBroadcasterWhenLateConnecting
 	checkBroadcasterState(t, broadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
 }
 
+func TestNetworkOfBroadcastersShouldCatchUpAfterThePartitionHeals(t *testing.T) {
+	numBroadcasters := 5
+
+	integrationTests.Log.Info("creating the simulated network messengers...")
+	network := p2pMocks.NewNetworkSimulator()
+	network.SetDefaultLatency(time.Millisecond * 100)
+	messengers := network.CreateMessengers(numBroadcasters)
+	defer func() {
+		for _, messenger := range messengers {
+			_ = messenger.Close()
+		}
+	}()
+
+	privateKeys, publicKeysBytes := createKeys(t, numBroadcasters)
+
+	roleProvider := &mockRoleProviders.MultiversXRoleProviderStub{
+		IsWhitelistedCalled: func(address core.AddressHandler) bool {
+			for _, pkBytes := range publicKeysBytes {
+				if bytes.Equal(address.AddressBytes(), pkBytes) {
+					return true
+				}
+			}
+
+			return false
+		},
+	}
+
+	integrationTests.Log.Info("creating broadcasters...")
+	broadcasters, signaturesHolders := createBroadcasters(t, numBroadcasters, messengers, roleProvider, privateKeys)
+
+	isolatedPeer := messengers[numBroadcasters-1].ID()
+	network.Partition([]chainCore.PeerID{isolatedPeer})
+
+	connectedBroadcasters := broadcasters[:numBroadcasters-1]
+	expectedPkInOrder := copyAndSortBytesSlices(publicKeysBytes[:numBroadcasters-1])
+	messageHash := []byte("message hash")
+	joinBroadcasters(network, connectedBroadcasters)
+	signatures := createSignatures(numBroadcasters-1, "mock signature - try 1")
+	sendSignatures(network, connectedBroadcasters, signatures, messageHash)
+	checkBroadcasterState(t, connectedBroadcasters, signaturesHolders, signatures, expectedPkInOrder, messageHash)
+	require.Empty(t, signaturesHolders[numBroadcasters-1].Signatures(messageHash))
+
+	network.Heal()
+
+	isolatedBroadcasters := []integrationTests.Broadcaster{broadcasters[numBroadcasters-1]}
+	isolatedSigHolders := []*testsCommon.SignaturesHolderMock{signaturesHolders[numBroadcasters-1]}
+	isolatedBroadcasters[0].BroadcastJoinTopic()
+	network.Advance(time.Millisecond * 99)
+	require.Empty(t, isolatedSigHolders[0].Signatures(messageHash))
+
+	network.DeliverAll()
+	checkBroadcasterState(t, isolatedBroadcasters, isolatedSigHolders, signatures, expectedPkInOrder, messageHash)
+}
+
 func createBroadcasters(
 	t *testing.T,
 	numBroadcasters int,
-	messengers []chainP2P.Messenger,
+	messengers []*p2pMocks.SimulatedMessenger,
 	roleProvider *mockRoleProviders.MultiversXRoleProviderStub,
 	privateKeys []crypto.PrivateKey,
 ) ([]integrationTests.Broadcaster, []*testsCommon.SignaturesHolderMock) {
@@ -192,7 +242,7 @@ func createBroadcasters(
 
 func createBroadcaster(
 	t *testing.T,
-	messenger chainP2P.Messenger,
+	messenger p2p.NetMessenger,
 	roleProvider *mockRoleProviders.MultiversXRoleProviderStub,
 	privateKey crypto.PrivateKey,
 ) (integrationTests.Broadcaster, *testsCommon.SignaturesHolderMock) {
@@ -212,6 +262,7 @@ func createBroadcaster(
 		SignatureProcessor:     &testsCommon.SignatureProcessorStub{},
 		Name:                   "test",
 		AntifloodComponents:    ac,
+		PeersRecorder:          &p2pMocks.PeersRecorderStub{},
 	}
 
 	b, err := p2p.NewBroadcaster(args)
@@ -256,13 +307,13 @@ func copyAndSortBytesSlices(src [][]byte) [][]byte {
 	return dst
 }
 
-func joinBroadcasters(broadcasters []integrationTests.Broadcaster) {
+func joinBroadcasters(network *p2pMocks.NetworkSimulator, broadcasters []integrationTests.Broadcaster) {
 	integrationTests.Log.Info("joining the broadcasters...")
 	for _, b := range broadcasters {
 		b.BroadcastJoinTopic()
 	}
 
-	time.Sleep(time.Second)
+	network.DeliverAll()
 }
 
 func createSignatures(numSignatures int, suffix string) [][]byte {
@@ -275,13 +326,18 @@ func createSignatures(numSignatures int, suffix string) [][]byte {
 	return signatures
 }
 
-func sendSignatures(broadcasters []integrationTests.Broadcaster, signatures [][]byte, messageHash []byte) {
+func sendSignatures(
+	network *p2pMocks.NetworkSimulator,
+	broadcasters []integrationTests.Broadcaster,
+	signatures [][]byte,
+	messageHash []byte,
+) {
 	integrationTests.Log.Info("sending signatures...")
 	for i, b := range broadcasters {
 		b.BroadcastSignature(signatures[i], messageHash)
 	}
 
-	time.Sleep(time.Second)
+	network.DeliverAll()
 }
 
 func checkBroadcasterState(
@@ -330,6 +386,4 @@ func clearSignatures(signatureHolders []*testsCommon.SignaturesHolderMock) {
 	for _, sh := range signatureHolders {
 		sh.ClearStoredSignatures()
 	}
-
-	time.Sleep(time.Second)
 }
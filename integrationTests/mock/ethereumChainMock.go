@@ -46,6 +46,9 @@ type EthereumChainMock struct {
 	ProposeMultiTransferEsdtBatchCalled func()
 	BalanceAtCalled                     func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	FilterLogsCalled                    func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumberCalled                func(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAtCalled                        func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionReceiptCalled            func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	finalNonce                          uint64
 }
 
@@ -287,6 +290,33 @@ func (mock *EthereumChainMock) FilterLogs(ctx context.Context, q ethereum.Filter
 	return []types.Log{}, nil
 }
 
+// HeaderByNumber -
+func (mock *EthereumChainMock) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if mock.HeaderByNumberCalled != nil {
+		return mock.HeaderByNumberCalled(ctx, number)
+	}
+
+	return &types.Header{Number: number}, nil
+}
+
+// CodeAt -
+func (mock *EthereumChainMock) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if mock.CodeAtCalled != nil {
+		return mock.CodeAtCalled(ctx, account, blockNumber)
+	}
+
+	return []byte("contract code"), nil
+}
+
+// TransactionReceipt -
+func (mock *EthereumChainMock) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if mock.TransactionReceiptCalled != nil {
+		return mock.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return &types.Receipt{}, nil
+}
+
 // IsPaused -
 func (mock *EthereumChainMock) IsPaused(_ context.Context) (bool, error) {
 	return false, nil
@@ -382,6 +412,16 @@ func (mock *EthereumChainMock) UpdateWhitelistedTokens(account common.Address, v
 	mock.mutState.Unlock()
 }
 
+// TokenMinLimits -
+func (mock *EthereumChainMock) TokenMinLimits(_ context.Context, _ common.Address) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// TokenMaxLimits -
+func (mock *EthereumChainMock) TokenMaxLimits(_ context.Context, _ common.Address) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
 // SetFinalNonce -
 func (mock *EthereumChainMock) SetFinalNonce(nonce uint64) {
 	atomic.StoreUint64(&mock.finalNonce, nonce)
@@ -99,6 +99,7 @@ func CreateBridgeComponentsConfig(index int, workingDir string, gasStationURL st
 			MaxRetriesOnQuorumReached:       1,
 			MaxRetriesOnWasTransferProposed: 3,
 			ClientAvailabilityAllowDelta:    5,
+			LeftoverTxsTimeoutInSeconds:     1,
 			Proxy: config.ProxyConfig{
 				CacherExpirationSeconds: 600,
 				RestAPIEntityType:       "observer",
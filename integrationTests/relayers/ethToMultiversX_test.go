@@ -12,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
@@ -158,7 +159,7 @@ func testRelayersShouldExecuteTransfersFromEthToMultiversX(t *testing.T, withNat
 		ethereumChainMock.AddRelayer(relayer.EthereumRelayerAddress())
 
 		go func() {
-			err = relayer.Start()
+			err = relayer.Start(context.Background())
 			integrationTests.Log.LogIfError(err)
 			require.Nil(t, err)
 		}()
@@ -296,15 +297,20 @@ func testRelayersShouldExecuteTransferFromEthToMultiversXHavingTxsWithSCcalls(t
 	ethereumChainMock.UpdateTotalBalances(token3Erc20, value3)
 
 	ethereumChainMock.FilterLogsCalled = func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+		scExecAbi, err := contract.ERC20SafeMetaData.GetAbi()
+		require.Nil(t, err)
+
+		if q.Topics[0][0] != scExecAbi.Events["ERC20SCDeposit"].ID {
+			// the deposits originating transactions are not tested here
+			return nil, nil
+		}
+
 		expectedBatchNonceHash := []common.Hash{
 			common.BytesToHash(big.NewInt(int64(batchNonceOnEthereum + 1)).Bytes()),
 		}
 		require.Equal(t, 2, len(q.Topics))
 		assert.Equal(t, expectedBatchNonceHash, q.Topics[1])
 
-		scExecAbi, err := contract.ERC20SafeMetaData.GetAbi()
-		require.Nil(t, err)
-
 		eventInputs := scExecAbi.Events["ERC20SCDeposit"].Inputs.NonIndexed()
 		packedArgs, err := eventInputs.Pack(big.NewInt(0).SetUint64(txNonceOnEthereum+3), args.providedScCallData)
 		require.Nil(t, err)
@@ -354,7 +360,7 @@ func testRelayersShouldExecuteTransferFromEthToMultiversXHavingTxsWithSCcalls(t
 		ethereumChainMock.AddRelayer(relayer.EthereumRelayerAddress())
 
 		go func() {
-			err = relayer.Start()
+			err = relayer.Start(context.Background())
 			integrationTests.Log.LogIfError(err)
 			require.Nil(t, err)
 		}()
@@ -417,6 +423,10 @@ func createMockBridgeComponentsArgs(
 		TimeBeforeRepeatJoin:          time.Second * 30,
 		MetricsHolder:                 status.NewMetricsHolder(),
 		AppStatusHandler:              &statusHandler.AppStatusHandlerStub{},
+		SignerAuditLog:                &testsCommon.SignerAuditLogStub{},
+		SLATracker:                    disabled.NewDisabledSLATracker(),
+		ErrorReporter:                 disabled.NewDisabledErrorReporter(),
+		BatchResultsStorer:            disabled.NewDisabledBatchResultsStorer(),
 		MultiversXClientStatusHandler: &testsCommon.StatusHandlerStub{},
 	}
 }
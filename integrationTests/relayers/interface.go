@@ -1,6 +1,8 @@
 package relayers
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 )
@@ -8,6 +10,6 @@ import (
 type bridgeComponents interface {
 	MultiversXRelayerAddress() sdkCore.AddressHandler
 	EthereumRelayerAddress() common.Address
-	Start() error
+	Start(ctx context.Context) error
 	Close() error
 }
@@ -98,7 +98,7 @@ func TestRelayersShouldExecuteSimpleTransfersFromMultiversXToEth(t *testing.T) {
 		ethereumChainMock.AddRelayer(relayer.EthereumRelayerAddress())
 
 		go func() {
-			err = relayer.Start()
+			err = relayer.Start(context.Background())
 			integrationTests.Log.LogIfError(err)
 			require.Nil(t, err)
 		}()
@@ -210,7 +210,7 @@ func testRelayersShouldExecuteTransfersFromMultiversXToEthIfTransactionsAppearIn
 		ethereumChainMock.AddRelayer(relayer.EthereumRelayerAddress())
 
 		go func() {
-			err = relayer.Start()
+			err = relayer.Start(context.Background())
 			integrationTests.Log.LogIfError(err)
 			require.Nil(t, err)
 		}()
@@ -150,6 +150,21 @@ func TestRelayersShouldExecuteTransfersWithInitSupply(t *testing.T) {
 }
 
 func testRelayersWithChainSimulatorAndTokens(tb testing.TB, manualStopChan chan error, tokens ...framework.TestTokenParams) *framework.TestSetup {
+	setupFunc, processFunc := createTransfersSetupAndProcessFuncs(tb, tokens...)
+
+	return testRelayersWithChainSimulator(tb,
+		setupFunc,
+		processFunc,
+		manualStopChan,
+	)
+}
+
+// createTransfersSetupAndProcessFuncs creates the functions issuing the tokens and creating the first batches, then
+// driving the transfers in both directions until all of them are done
+func createTransfersSetupAndProcessFuncs(
+	tb testing.TB,
+	tokens ...framework.TestTokenParams,
+) (func(tb testing.TB, setup *framework.TestSetup), func(tb testing.TB, setup *framework.TestSetup) bool) {
 	startsFromEthFlow, startsFromMvXFlow := createFlowsBasedOnToken(tb, tokens...)
 
 	setupFunc := func(tb testing.TB, setup *framework.TestSetup) {
@@ -182,11 +197,7 @@ func testRelayersWithChainSimulatorAndTokens(tb testing.TB, manualStopChan chan
 		return false
 	}
 
-	return testRelayersWithChainSimulator(tb,
-		setupFunc,
-		processFunc,
-		manualStopChan,
-	)
+	return setupFunc, processFunc
 }
 
 func createFlowsBasedOnToken(tb testing.TB, tokens ...framework.TestTokenParams) (*startsFromEthereumFlow, *startsFromMultiversXFlow) {
@@ -220,6 +231,15 @@ func testRelayersWithChainSimulator(tb testing.TB,
 	setupFunc func(tb testing.TB, setup *framework.TestSetup),
 	processLoopFunc func(tb testing.TB, setup *framework.TestSetup) bool,
 	stopChan chan error,
+) *framework.TestSetup {
+	return testRelayersWithChainSimulatorAndSetup(tb, framework.NewTestSetup, setupFunc, processLoopFunc, stopChan)
+}
+
+func testRelayersWithChainSimulatorAndSetup(tb testing.TB,
+	createTestSetupFunc func(tb testing.TB) *framework.TestSetup,
+	setupFunc func(tb testing.TB, setup *framework.TestSetup),
+	processLoopFunc func(tb testing.TB, setup *framework.TestSetup) bool,
+	stopChan chan error,
 ) *framework.TestSetup {
 	defer func() {
 		r := recover()
@@ -228,7 +248,7 @@ func testRelayersWithChainSimulator(tb testing.TB,
 		}
 	}()
 
-	testSetup := framework.NewTestSetup(tb)
+	testSetup := createTestSetupFunc(tb)
 	log.Info(fmt.Sprintf(framework.LogStepMarker, "calling setupFunc"))
 	setupFunc(tb, testSetup)
 
@@ -1,18 +1,21 @@
 package framework
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/factory"
 	"github.com/multiversx/mx-bridge-eth-go/integrationTests"
 	testsRelayers "github.com/multiversx/mx-bridge-eth-go/integrationTests/relayers"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
@@ -42,6 +45,8 @@ func NewBridgeComponents(
 	ethSafeContractAddress string,
 	mvxSafeAddress *MvxAddress,
 	mvxMultisigAddress *MvxAddress,
+	relayersKeys []KeysHolder,
+	maliciousBehaviors map[int]MaliciousBehavior,
 ) *BridgeComponents {
 	bridge := &BridgeComponents{
 		TB:                 tb,
@@ -58,6 +63,13 @@ func NewBridgeComponents(
 	wg.Add(numRelayers)
 
 	for i := 0; i < numRelayers; i++ {
+		var messenger p2p.NetMessenger = messengers[i]
+		behavior := maliciousBehaviors[i]
+		if !behavior.IsHonest() {
+			log.Info("relayer will act maliciously", "index", i, "behavior", fmt.Sprintf("%+v", behavior))
+			messenger = newMaliciousMessenger(tb, messenger, behavior, relayersKeys[i])
+		}
+
 		generalConfigs := testsRelayers.CreateBridgeComponentsConfig(i, workingDir, gasStationURL)
 		generalConfigs.Eth.PrivateKeyFile = fmt.Sprintf(relayerETHKeyPathFormat, i)
 		argsBridgeComponents := factory.ArgsEthereumToMultiversXBridge{
@@ -70,12 +82,16 @@ func NewBridgeComponents(
 			},
 			Proxy:                         chainSimulator.Proxy(),
 			ClientWrapper:                 ethereumChain,
-			Messenger:                     messengers[i],
+			Messenger:                     messenger,
 			StatusStorer:                  testsCommon.NewStorerMock(),
 			TimeForBootstrap:              time.Second * 5,
 			TimeBeforeRepeatJoin:          time.Second * 30,
 			MetricsHolder:                 status.NewMetricsHolder(),
 			AppStatusHandler:              &statusHandler.AppStatusHandlerStub{},
+			SignerAuditLog:                &testsCommon.SignerAuditLogStub{},
+			SLATracker:                    disabled.NewDisabledSLATracker(),
+			ErrorReporter:                 disabled.NewDisabledErrorReporter(),
+			BatchResultsStorer:            disabled.NewDisabledBatchResultsStorer(),
 			MultiversXClientStatusHandler: &testsCommon.StatusHandlerStub{},
 		}
 		argsBridgeComponents.Configs.GeneralConfig.Eth.SafeContractAddress = ethSafeContractAddress
@@ -98,7 +114,7 @@ func NewBridgeComponents(
 		require.Nil(bridge, err)
 
 		go func() {
-			err = relayer.Start()
+			err = relayer.Start(context.Background())
 			log.LogIfError(err)
 			require.Nil(bridge, err)
 			wg.Done()
@@ -168,6 +168,17 @@ func (instance *chainSimulatorWrapper) GetTransactionResult(ctx context.Context,
 	return &txResult.Data.Transaction
 }
 
+// GetTransactionStatus returns the status of the provided transaction without requiring it to be successful.
+// It may wait a few blocks
+func (instance *chainSimulatorWrapper) GetTransactionStatus(ctx context.Context, hash string) transaction.TxStatus {
+	instance.GenerateBlocksUntilTxProcessed(ctx, hash)
+
+	txStatus, err := instance.proxyInstance.ProcessTransactionStatus(ctx, hash)
+	require.Nil(instance, err)
+
+	return txStatus
+}
+
 // GenerateBlocks calls the chain simulator generate block endpoint
 func (instance *chainSimulatorWrapper) GenerateBlocks(ctx context.Context, numBlocks int) {
 	if numBlocks <= 0 {
@@ -12,3 +12,8 @@ var (
 	addressPubkeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
 	zeroValueBigInt           = big.NewInt(0)
 )
+
+// TransferFee returns the fee deducted by the MultiversX safe from each deposit towards Ethereum
+func TransferFee() *big.Int {
+	return big.NewInt(0).Set(feeInt)
+}
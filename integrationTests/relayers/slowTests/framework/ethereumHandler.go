@@ -11,7 +11,6 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
@@ -77,12 +76,7 @@ func NewEthereumHandler(
 		Quorum:         quorum,
 	}
 
-	walletsToFundOnEthereum := handler.WalletsToFundOnEthereum()
-	addr := make(map[common.Address]types.Account, len(walletsToFundOnEthereum))
-	for _, address := range walletsToFundOnEthereum {
-		addr[address] = types.Account{Balance: new(big.Int).Lsh(big.NewInt(1), 100)}
-	}
-	alloc := types.GenesisAlloc(addr)
+	alloc := handler.EthGenesisAlloc(new(big.Int).Lsh(big.NewInt(1), 100))
 	handler.SimulatedChain = simulated.NewBackend(alloc,
 		simulated.WithBlockGasLimit(ethSimulatedGasLimit),
 	)
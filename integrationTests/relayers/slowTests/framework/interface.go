@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 )
@@ -23,7 +24,7 @@ type httpClientWrapper interface {
 type Relayer interface {
 	MultiversXRelayerAddress() sdkCore.AddressHandler
 	EthereumRelayerAddress() common.Address
-	Start() error
+	Start(ctx context.Context) error
 	Close() error
 }
 
@@ -42,6 +43,7 @@ type ChainSimulatorWrapper interface {
 	GetESDTBalance(ctx context.Context, address *MvxAddress, token string) string
 	GetBlockchainTimeStamp(ctx context.Context) uint64
 	GetTransactionResult(ctx context.Context, hash string) *data.TransactionOnNetwork
+	GetTransactionStatus(ctx context.Context, hash string) transaction.TxStatus
 	ExecuteVMQuery(ctx context.Context, scAddress *MvxAddress, function string, hexParams []string) [][]byte
 }
 
@@ -52,6 +54,9 @@ type EthereumBlockchainClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	FilterLogs(ctx context.Context, q goEthereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
 
 // ERC20Contract defines the operations of an ERC20 contract
@@ -1,19 +1,17 @@
 package framework
 
 import (
-	"bytes"
 	"crypto/ecdsa"
-	"crypto/rand"
-	"encoding/hex"
-	"encoding/pem"
 	"fmt"
+	"math/big"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
-	mxCrypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/keys"
 	"github.com/stretchr/testify/require"
 )
 
@@ -44,37 +42,36 @@ type KeysStore struct {
 	DepositorKeys  KeysHolder
 	TestKeys       KeysHolder
 	workingDir     string
+	generator      *keys.Generator
 }
 
-const (
-	ethOwnerSK     = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
-	ethDepositorSK = "9bb971db41e3815a669a71c3f1bcb24e0b81f21e04bf11faa7a34b9b40e7cfb1"
-	ethTestSk      = "dafea2c94bfe5d25f1a508808c2bc2c2e6c6f18b6b010fc841d8eb80755ba27a"
-)
-
-// NewKeysStore will create a KeysStore instance and generate all keys
+// NewKeysStore will create a KeysStore instance and generate all keys. The same seed generates the same keys, except
+// the relayers' Ethereum keys which are read from the test data
 func NewKeysStore(
 	tb testing.TB,
 	workingDir string,
 	numRelayers int,
 	numOracles int,
+	seed int64,
 ) *KeysStore {
 	keysStore := &KeysStore{
 		TB:             tb,
 		RelayersKeys:   make([]KeysHolder, 0, numRelayers),
 		SCExecutorKeys: KeysHolder{},
 		workingDir:     workingDir,
+		generator:      keys.NewGenerator(seed),
 	}
+	log.Info("generating the test keys", "seed", seed)
 
 	keysStore.generateRelayersKeys(numRelayers)
 	keysStore.OraclesKeys = keysStore.generateKeys(numOracles, "generated oracle", projectedShardForBridgeSetup)
 	keysStore.SCExecutorKeys = keysStore.generateKey("", projectedShardForBridgeSetup)
-	keysStore.OwnerKeys = keysStore.generateKey(ethOwnerSK, projectedShardForBridgeSetup)
+	keysStore.OwnerKeys = keysStore.generateKey(keys.EthOwnerSK, projectedShardForBridgeSetup)
 	log.Info("generated owner",
 		"MvX address", keysStore.OwnerKeys.MvxAddress.Bech32(),
 		"Eth address", keysStore.OwnerKeys.EthAddress.String())
-	keysStore.DepositorKeys = keysStore.generateKey(ethDepositorSK, projectedShardForDepositor)
-	keysStore.TestKeys = keysStore.generateKey(ethTestSk, projectedShardForTestKeys)
+	keysStore.DepositorKeys = keysStore.generateKey(keys.EthDepositorSK, projectedShardForDepositor)
+	keysStore.TestKeys = keysStore.generateKey(keys.EthTestSK, projectedShardForTestKeys)
 
 	filename := path.Join(keysStore.workingDir, SCCallerFilename)
 	SaveMvxKey(keysStore, filename, keysStore.SCExecutorKeys)
@@ -101,38 +98,36 @@ func (keyStore *KeysStore) generateRelayersKeys(numKeys int) {
 }
 
 func (keyStore *KeysStore) generateKeys(numKeys int, message string, projectedShard byte) []KeysHolder {
-	keys := make([]KeysHolder, 0, numKeys)
+	generatedKeys := make([]KeysHolder, 0, numKeys)
 
 	for i := 0; i < numKeys; i++ {
-		ethPrivateKeyBytes := make([]byte, 32)
-		_, _ = rand.Read(ethPrivateKeyBytes)
+		ethPrivateKey, err := keyStore.generator.GenerateEthKey()
+		require.Nil(keyStore, err)
 
-		key := keyStore.generateKey(hex.EncodeToString(ethPrivateKeyBytes), projectedShard)
+		key := keyStore.generateKey(keys.EthHex(ethPrivateKey), projectedShard)
 		log.Info(message, "index", i,
 			"MvX address", key.MvxAddress.Bech32(),
 			"Eth address", key.EthAddress.String())
 
-		keys = append(keys, key)
+		generatedKeys = append(generatedKeys, key)
 	}
 
-	return keys
+	return generatedKeys
 }
 
 func (keyStore *KeysStore) generateKey(ethSkHex string, projectedShard byte) KeysHolder {
-	var err error
-
-	keys := GenerateMvxPrivatePublicKey(keyStore, projectedShard)
 	if len(ethSkHex) == 0 {
 		// eth keys not required
-		return keys
+		keyPair, err := keyStore.generator.GenerateMvxKey(projectedShard)
+		require.Nil(keyStore, err)
+
+		return newKeysHolder(keyStore, keyPair)
 	}
 
-	keys.EthSK, err = crypto.HexToECDSA(ethSkHex)
+	keyPair, err := keyStore.generator.GenerateKeyPair(ethSkHex, projectedShard)
 	require.Nil(keyStore, err)
 
-	keys.EthAddress = crypto.PubkeyToAddress(keys.EthSK.PublicKey)
-
-	return keys
+	return newKeysHolder(keyStore, keyPair)
 }
 
 func (keyStore *KeysStore) getAllKeys() []KeysHolder {
@@ -144,20 +139,19 @@ func (keyStore *KeysStore) getAllKeys() []KeysHolder {
 	return allKeys
 }
 
-// WalletsToFundOnEthereum will return the wallets to fund on Ethereum
-func (keyStore *KeysStore) WalletsToFundOnEthereum() []common.Address {
+func (keyStore *KeysStore) getAllKeyPairs() []keys.KeyPair {
 	allKeys := keyStore.getAllKeys()
-	walletsToFund := make([]common.Address, 0, len(allKeys))
-
+	keyPairs := make([]keys.KeyPair, 0, len(allKeys))
 	for _, key := range allKeys {
-		if len(key.MvxSk) == 0 {
-			continue
-		}
-
-		walletsToFund = append(walletsToFund, key.EthAddress)
+		keyPairs = append(keyPairs, key.KeyPair())
 	}
 
-	return walletsToFund
+	return keyPairs
+}
+
+// EthGenesisAlloc will return the genesis allocation funding the wallets on Ethereum with the provided balance
+func (keyStore *KeysStore) EthGenesisAlloc(balance *big.Int) types.GenesisAlloc {
+	return keys.EthGenesisAlloc(balance, keyStore.getAllKeyPairs()...)
 }
 
 // WalletsToFundOnMultiversX will return the wallets to fund on MultiversX
@@ -172,46 +166,36 @@ func (keyStore *KeysStore) WalletsToFundOnMultiversX() []string {
 	return walletsToFund
 }
 
-// GenerateMvxPrivatePublicKey will generate a new keys holder instance that will hold only the MultiversX generated keys
+// GenerateMvxPrivatePublicKey will generate a new random keys holder instance that will hold only the MultiversX
+// generated keys
 func GenerateMvxPrivatePublicKey(tb testing.TB, projectedShard byte) KeysHolder {
-	sk, pkBytes := generateSkPkInShard(tb, projectedShard)
-
-	skBytes, err := sk.ToByteArray()
+	keyPair, err := keys.NewGenerator(time.Now().UnixNano()).GenerateMvxKey(projectedShard)
 	require.Nil(tb, err)
 
+	return newKeysHolder(tb, keyPair)
+}
+
+func newKeysHolder(tb testing.TB, keyPair keys.KeyPair) KeysHolder {
 	return KeysHolder{
-		MvxSk:      skBytes,
-		MvxAddress: NewMvxAddressFromBytes(tb, pkBytes),
+		MvxAddress: NewMvxAddressFromBytes(tb, keyPair.MvxPk),
+		MvxSk:      keyPair.MvxSk,
+		EthSK:      keyPair.EthSK,
+		EthAddress: keyPair.EthAddress,
 	}
 }
 
-func generateSkPkInShard(tb testing.TB, projectedShard byte) (mxCrypto.PrivateKey, []byte) {
-	var sk mxCrypto.PrivateKey
-	var pk mxCrypto.PublicKey
-
-	for {
-		sk, pk = keyGenerator.GeneratePair()
-
-		pkBytes, err := pk.ToByteArray()
-		require.Nil(tb, err)
-
-		if pkBytes[len(pkBytes)-1] == projectedShard {
-			return sk, pkBytes
-		}
+// KeyPair returns the keys in the format used by the keys package
+func (holder KeysHolder) KeyPair() keys.KeyPair {
+	return keys.KeyPair{
+		MvxSk:      holder.MvxSk,
+		MvxPk:      holder.MvxAddress.Bytes(),
+		EthSK:      holder.EthSK,
+		EthAddress: holder.EthAddress,
 	}
 }
 
 // SaveMvxKey will save the MultiversX key
 func SaveMvxKey(tb testing.TB, filename string, key KeysHolder) {
-	blk := pem.Block{
-		Type:  "PRIVATE KEY for " + key.MvxAddress.Bech32(),
-		Bytes: []byte(hex.EncodeToString(key.MvxSk)),
-	}
-
-	buff := bytes.NewBuffer(make([]byte, 0))
-	err := pem.Encode(buff, &blk)
-	require.Nil(tb, err)
-
-	err = os.WriteFile(filename, buff.Bytes(), os.ModePerm)
+	err := key.KeyPair().SaveMvxPem(filename)
 	require.Nil(tb, err)
 }
@@ -0,0 +1,170 @@
+package framework
+
+import (
+	"encoding/binary"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	mxCrypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	joinTopicSuffix       = "_join"
+	signTopicSuffix       = "_sign"
+	catchUpTopicSuffix    = "_catchup"
+	wrongHashMessageExtra = "malicious relayer"
+)
+
+// MaliciousBehavior defines the adversarial behaviors a relayer can exhibit during a test
+type MaliciousBehavior struct {
+	// SignWrongHash replaces the broadcast Ethereum signatures with valid signatures on a different message hash
+	SignWrongHash bool
+	// WithholdSignatures drops all the Ethereum signatures the relayer should send to its peers
+	WithholdSignatures bool
+	// ExecuteEarly makes the relayer try to perform the MultiversX actions before the quorum is reached
+	ExecuteEarly bool
+	// NumSpamJoins is the number of extra join messages sent along each legit join message
+	NumSpamJoins int
+}
+
+// IsHonest returns true if no malicious behavior is set
+func (behavior MaliciousBehavior) IsHonest() bool {
+	return behavior == MaliciousBehavior{}
+}
+
+// maliciousMessenger intercepts the messages a relayer sends on the p2p network and alters them according to the
+// configured behavior. All outgoing messages are re-signed using a local nonce counter so the extra join messages
+// won't invalidate the relayer's legit messages
+type maliciousMessenger struct {
+	p2p.NetMessenger
+	tb          testing.TB
+	behavior    MaliciousBehavior
+	keys        KeysHolder
+	privateKey  mxCrypto.PrivateKey
+	marshalizer marshal.Marshalizer
+	mutNonce    sync.Mutex
+	nonce       uint64
+}
+
+func newMaliciousMessenger(tb testing.TB, messenger p2p.NetMessenger, behavior MaliciousBehavior, keys KeysHolder) *maliciousMessenger {
+	privateKey, err := keyGenerator.PrivateKeyFromByteArray(keys.MvxSk)
+	require.Nil(tb, err)
+
+	return &maliciousMessenger{
+		NetMessenger: messenger,
+		tb:           tb,
+		behavior:     behavior,
+		keys:         keys,
+		privateKey:   privateKey,
+		marshalizer:  &marshal.JsonMarshalizer{},
+		nonce:        uint64(time.Now().UnixNano()),
+	}
+}
+
+// Broadcast alters the message according to the malicious behavior before broadcasting it
+func (messenger *maliciousMessenger) Broadcast(topic string, buff []byte) {
+	msg, shouldSend := messenger.alterMessage(topic, buff)
+	if !shouldSend {
+		return
+	}
+
+	messenger.NetMessenger.Broadcast(topic, messenger.resign(msg))
+
+	if !strings.HasSuffix(topic, joinTopicSuffix) {
+		return
+	}
+	for i := 0; i < messenger.behavior.NumSpamJoins; i++ {
+		messenger.NetMessenger.Broadcast(topic, messenger.resign(msg))
+	}
+	log.Debug("malicious relayer spammed join messages", "address", messenger.keys.MvxAddress.Bech32(),
+		"num messages", messenger.behavior.NumSpamJoins)
+}
+
+// SendToConnectedPeer alters the message according to the malicious behavior before sending it
+func (messenger *maliciousMessenger) SendToConnectedPeer(topic string, buff []byte, peerID chainCore.PeerID) error {
+	if strings.HasSuffix(topic, catchUpTopicSuffix) {
+		// the catch-up messages hold the already signed messages, these are either withheld or sent as they are
+		if messenger.behavior.WithholdSignatures {
+			return nil
+		}
+
+		return messenger.NetMessenger.SendToConnectedPeer(topic, buff, peerID)
+	}
+
+	msg, shouldSend := messenger.alterMessage(topic, buff)
+	if !shouldSend {
+		return nil
+	}
+
+	return messenger.NetMessenger.SendToConnectedPeer(topic, messenger.resign(msg), peerID)
+}
+
+func (messenger *maliciousMessenger) alterMessage(topic string, buff []byte) (*bridgeCore.SignedMessage, bool) {
+	msg := &bridgeCore.SignedMessage{}
+	err := messenger.marshalizer.Unmarshal(msg, buff)
+	require.Nil(messenger.tb, err)
+
+	if !strings.HasSuffix(topic, signTopicSuffix) {
+		return msg, true
+	}
+	if messenger.behavior.WithholdSignatures {
+		log.Debug("malicious relayer withheld signature", "address", messenger.keys.MvxAddress.Bech32())
+		return nil, false
+	}
+	if messenger.behavior.SignWrongHash {
+		msg.Payload = messenger.signWrongHash(msg.Payload)
+	}
+
+	return msg, true
+}
+
+func (messenger *maliciousMessenger) signWrongHash(payload []byte) []byte {
+	ethSignature := &bridgeCore.EthereumSignature{}
+	err := messenger.marshalizer.Unmarshal(ethSignature, payload)
+	require.Nil(messenger.tb, err)
+
+	wrongHash := crypto.Keccak256(ethSignature.MessageHash, []byte(wrongHashMessageExtra))
+	ethSignature.Signature, err = crypto.Sign(wrongHash, messenger.keys.EthSK)
+	require.Nil(messenger.tb, err)
+	ethSignature.MessageHash = wrongHash
+
+	log.Debug("malicious relayer signed wrong hash", "address", messenger.keys.MvxAddress.Bech32())
+
+	newPayload, err := messenger.marshalizer.Marshal(ethSignature)
+	require.Nil(messenger.tb, err)
+
+	return newPayload
+}
+
+func (messenger *maliciousMessenger) resign(msg *bridgeCore.SignedMessage) []byte {
+	messenger.mutNonce.Lock()
+	messenger.nonce++
+	nonce := messenger.nonce
+	messenger.mutNonce.Unlock()
+
+	buffNonce := make([]byte, 8)
+	binary.BigEndian.PutUint64(buffNonce, nonce)
+	msgWithNonce := append(append(make([]byte, 0, len(msg.Payload)+len(buffNonce)), msg.Payload...), buffNonce...)
+
+	sig, err := signer.Sign(messenger.privateKey, msgWithNonce)
+	require.Nil(messenger.tb, err)
+
+	newMsg := &bridgeCore.SignedMessage{
+		Payload:        msg.Payload,
+		PublicKeyBytes: msg.PublicKeyBytes,
+		Signature:      sig,
+		Nonce:          nonce,
+	}
+	buff, err := messenger.marshalizer.Marshal(newMsg)
+	require.Nil(messenger.tb, err)
+
+	return buff
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/stretchr/testify/require"
 )
@@ -76,6 +77,9 @@ const (
 	getTransactionFeesFunction                           = "getTransactionFees"
 	initSupplyMintBurnEsdtSafe                           = "initSupplyMintBurnEsdtSafe"
 	initSupplyEsdtSafe                                   = "initSupplyEsdtSafe"
+	performActionFunction                                = "performAction"
+	quorumReachedFunction                                = "quorumReached"
+	wasActionExecutedFunction                            = "wasActionExecuted"
 )
 
 var (
@@ -1059,6 +1063,42 @@ func (handler *MultiversxHandler) TransferToken(ctx context.Context, source Keys
 		"hash", hash, "status", txResult.Status)
 }
 
+// TryPerformActionBeforeQuorum will try to execute the provided action on the multisig contract using the provided
+// relayer keys, only if the action was not executed and the quorum was not yet reached. Returns false if no attempt was made
+func (handler *MultiversxHandler) TryPerformActionBeforeQuorum(ctx context.Context, relayerKeys KeysHolder, actionID uint64) (bool, transaction.TxStatus) {
+	hexActionID := hex.EncodeToString(big.NewInt(0).SetUint64(actionID).Bytes())
+	if handler.queryBool(ctx, wasActionExecutedFunction, hexActionID) {
+		return false, ""
+	}
+	if handler.queryBool(ctx, quorumReachedFunction, hexActionID) {
+		return false, ""
+	}
+
+	hash := handler.ChainSimulator.ScCallWithoutGenerateBlocks(
+		ctx,
+		relayerKeys.MvxSk,
+		handler.MultisigAddress,
+		zeroStringValue,
+		generalSCCallGasLimit,
+		performActionFunction,
+		[]string{hexActionID},
+	)
+	txStatus := handler.ChainSimulator.GetTransactionStatus(ctx, hash)
+	log.Info("malicious relayer tried to perform action before quorum", "action ID", actionID,
+		"transaction hash", hash, "status", txStatus)
+
+	return true, txStatus
+}
+
+func (handler *MultiversxHandler) queryBool(ctx context.Context, function string, hexParams ...string) bool {
+	response := handler.ChainSimulator.ExecuteVMQuery(ctx, handler.MultisigAddress, function, hexParams)
+	if len(response) == 0 {
+		return false
+	}
+
+	return big.NewInt(0).SetBytes(response[0]).Uint64() != 0
+}
+
 func getHexBool(input bool) string {
 	if input {
 		return hexTrue
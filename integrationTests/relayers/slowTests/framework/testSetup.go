@@ -9,9 +9,12 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/module"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/stretchr/testify/require"
 )
@@ -38,6 +41,7 @@ type TestSetup struct {
 	ChainSimulator         ChainSimulatorWrapper
 	ScCallerKeys           KeysHolder
 	ScCallerModuleInstance SCCallerModule
+	MaliciousBehaviors     map[int]MaliciousBehavior
 
 	ctxCancel             func()
 	Ctx                   context.Context
@@ -49,25 +53,31 @@ type TestSetup struct {
 
 // NewTestSetup creates a new e2e test setup
 func NewTestSetup(tb testing.TB) *TestSetup {
-	log.Info(fmt.Sprintf(LogStepMarker, "starting setup"))
+	return NewTestSetupWithQuorum(tb, quorum)
+}
+
+// NewTestSetupWithQuorum creates a new e2e test setup that will use the provided quorum on both chains
+func NewTestSetupWithQuorum(tb testing.TB, quorumValue string) *TestSetup {
+	log.Info(fmt.Sprintf(LogStepMarker, "starting setup"), "quorum", quorumValue)
 
 	setup := &TestSetup{
 		TB:                    tb,
 		TokensRegistry:        NewTokenRegistry(tb),
 		WorkingDir:            tb.TempDir(),
+		MaliciousBehaviors:    make(map[int]MaliciousBehavior),
 		esdtBalanceForSafe:    make(map[string]*big.Int),
 		ethBalanceTestAddress: make(map[string]*big.Int),
 	}
-	setup.KeysStore = NewKeysStore(tb, setup.WorkingDir, NumRelayers, NumOracles)
+	setup.KeysStore = NewKeysStore(tb, setup.WorkingDir, NumRelayers, NumOracles, time.Now().UnixNano())
 
 	// create a test context
 	setup.Ctx, setup.ctxCancel = context.WithCancel(context.Background())
 
-	setup.EthereumHandler = NewEthereumHandler(tb, setup.Ctx, setup.KeysStore, setup.TokensRegistry, quorum)
+	setup.EthereumHandler = NewEthereumHandler(tb, setup.Ctx, setup.KeysStore, setup.TokensRegistry, quorumValue)
 	setup.EthereumHandler.DeployContracts(setup.Ctx)
 
 	setup.createChainSimulatorWrapper()
-	setup.MultiversxHandler = NewMultiversxHandler(tb, setup.Ctx, setup.KeysStore, setup.TokensRegistry, setup.ChainSimulator, quorum)
+	setup.MultiversxHandler = NewMultiversxHandler(tb, setup.Ctx, setup.KeysStore, setup.TokensRegistry, setup.ChainSimulator, quorumValue)
 	setup.MultiversxHandler.DeployAndSetContracts(setup.Ctx)
 
 	return setup
@@ -105,6 +115,8 @@ func (setup *TestSetup) StartRelayersAndScModule() {
 		setup.EthereumHandler.SafeAddress.Hex(),
 		setup.MultiversxHandler.SafeAddress,
 		setup.MultiversxHandler.MultisigAddress,
+		setup.RelayersKeys,
+		setup.MaliciousBehaviors,
 	)
 
 	setup.startScCallerModule()
@@ -116,6 +128,7 @@ func (setup *TestSetup) startScCallerModule() {
 		ExtraGasToExecute:               60_000_000,  // 60 million: this ensures that a SC call with 0 gas limit is refunded
 		MaxGasLimitToUse:                249_999_999, // max cross shard limit
 		GasLimitForOutOfGasTransactions: 30_000_000,  // gas to use when a higher than max allowed is encountered
+		MaxConcurrentExecutions:         4,
 		NetworkAddress:                  setup.ChainSimulator.GetNetworkAddress(),
 		ProxyMaxNoncesDelta:             5,
 		ProxyFinalityCheck:              false,
@@ -138,7 +151,8 @@ func (setup *TestSetup) startScCallerModule() {
 	}
 
 	var err error
-	setup.ScCallerModuleInstance, err = module.NewScCallsModule(cfg, log, nil)
+	statusHandler := testsCommon.NewStatusHandlerMock(core.ScCallsModuleStatusHandlerName)
+	setup.ScCallerModuleInstance, err = module.NewScCallsModule(cfg, log, nil, statusHandler)
 	require.Nil(setup, err)
 	log.Info("started SC calls module", "monitoring SC proxy address", setup.MultiversxHandler.ScProxyAddress)
 }
@@ -293,6 +307,21 @@ func (setup *TestSetup) isTransferDoneFromMultiversXForToken(params TestTokenPar
 	return isTransferDoneFromMultiversX && isSafeContractOnCorrectBalance
 }
 
+// IsTransferHaltedFromMultiversX returns true if none of the provided tokens reached the Ethereum test address
+func (setup *TestSetup) IsTransferHaltedFromMultiversX(tokens ...TestTokenParams) bool {
+	setup.mutBalances.RLock()
+	defer setup.mutBalances.RUnlock()
+
+	for _, params := range tokens {
+		ethTestBalance := setup.EthereumHandler.GetBalance(setup.TestKeys.EthAddress, params.AbstractTokenIdentifier)
+		if ethTestBalance.Cmp(setup.ethBalanceTestAddress[params.AbstractTokenIdentifier]) != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // CreateBatchOnMultiversX will create deposits that will be gathered in a batch on MultiversX
 func (setup *TestSetup) CreateBatchOnMultiversX(tokensParams ...TestTokenParams) {
 	for _, params := range tokensParams {
@@ -0,0 +1,216 @@
+//go:build slow
+
+// To run these slow tests, simply add the slow tag on the go test command. Also, provide a chain simulator instance on the 8085 port
+// example: go test -tags slow
+
+package slowTests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/integrationTests/relayers/slowTests/framework"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	maliciousRelayerIndex          = framework.NumRelayers - 1
+	quorumWithOneMaliciousRelayer  = "02"
+	quorumWithAllRelayers          = "03"
+	numSpamJoins                   = 20
+	maxActionIDToExecuteEarly      = 5
+	numIterationsToCheckSafeHalt   = 300
+	numIterationsBetweenEarlyCalls = 10
+)
+
+type maliciousRelayerScenario struct {
+	name     string
+	behavior framework.MaliciousBehavior
+}
+
+func createMaliciousRelayerScenarios() []maliciousRelayerScenario {
+	return []maliciousRelayerScenario{
+		{
+			name:     "sign wrong hash",
+			behavior: framework.MaliciousBehavior{SignWrongHash: true},
+		},
+		{
+			name:     "withhold signatures",
+			behavior: framework.MaliciousBehavior{WithholdSignatures: true},
+		},
+		{
+			name:     "execute early",
+			behavior: framework.MaliciousBehavior{ExecuteEarly: true},
+		},
+		{
+			name:     "spam joins",
+			behavior: framework.MaliciousBehavior{NumSpamJoins: numSpamJoins},
+		},
+		{
+			name: "all behaviors",
+			behavior: framework.MaliciousBehavior{
+				SignWrongHash: true,
+				ExecuteEarly:  true,
+				NumSpamJoins:  numSpamJoins,
+			},
+		},
+	}
+}
+
+func TestRelayersShouldExecuteTransfersWithOneMaliciousRelayer(t *testing.T) {
+	for _, scenario := range createMaliciousRelayerScenarios() {
+		t.Run(scenario.name, func(t *testing.T) {
+			testRelayersWithMaliciousRelayerAndTokens(
+				t,
+				quorumWithOneMaliciousRelayer,
+				scenario.behavior,
+				GenerateTestUSDCToken(),
+				GenerateTestMEMEToken(),
+			)
+		})
+	}
+}
+
+func TestRelayersShouldSafelyHaltWhenQuorumCanNotBeReached(t *testing.T) {
+	behaviors := map[string]framework.MaliciousBehavior{
+		"sign wrong hash":     {SignWrongHash: true},
+		"withhold signatures": {WithholdSignatures: true},
+	}
+
+	for name, behavior := range behaviors {
+		t.Run(name, func(t *testing.T) {
+			testRelayersShouldHaltWithMaliciousRelayer(t, behavior, GenerateTestMEMEToken())
+		})
+	}
+}
+
+func testRelayersWithMaliciousRelayerAndTokens(
+	tb testing.TB,
+	quorum string,
+	behavior framework.MaliciousBehavior,
+	tokens ...framework.TestTokenParams,
+) {
+	startsFromEthFlow, startsFromMvXFlow := createFlowsBasedOnToken(tb, tokens...)
+	numRejectedEarlyExecutions := 0
+	numIterations := 0
+
+	createTestSetupFunc := func(tb testing.TB) *framework.TestSetup {
+		setup := framework.NewTestSetupWithQuorum(tb, quorum)
+		setup.MaliciousBehaviors[maliciousRelayerIndex] = behavior
+
+		return setup
+	}
+
+	setupFunc := func(tb testing.TB, setup *framework.TestSetup) {
+		startsFromMvXFlow.setup = setup
+		startsFromEthFlow.setup = setup
+
+		setup.IssueAndConfigureTokens(tokens...)
+		setup.MultiversxHandler.CheckForZeroBalanceOnReceivers(setup.Ctx, tokens...)
+		if len(startsFromEthFlow.tokens) > 0 {
+			setup.EthereumHandler.CreateBatchOnEthereum(setup.Ctx, setup.MultiversxHandler.TestCallerAddress, startsFromEthFlow.tokens...)
+		}
+		if len(startsFromMvXFlow.tokens) > 0 {
+			setup.CreateBatchOnMultiversX(startsFromMvXFlow.tokens...)
+		}
+	}
+
+	processFunc := func(tb testing.TB, setup *framework.TestSetup) bool {
+		if startsFromEthFlow.process() && startsFromMvXFlow.process() {
+			setup.TestWithdrawTotalFeesOnEthereumForTokens(startsFromMvXFlow.tokens...)
+			setup.TestWithdrawTotalFeesOnEthereumForTokens(startsFromEthFlow.tokens...)
+			if behavior.ExecuteEarly {
+				require.Greater(tb, numRejectedEarlyExecutions, 0)
+			}
+
+			return true
+		}
+
+		numIterations++
+		if behavior.ExecuteEarly && numIterations%numIterationsBetweenEarlyCalls == 0 {
+			numRejectedEarlyExecutions += executeActionsEarly(setup, maliciousRelayerIndex)
+		}
+
+		// commit blocks in order to execute incoming txs from relayers
+		setup.EthereumHandler.SimulatedChain.Commit()
+		setup.ChainSimulator.GenerateBlocks(setup.Ctx, 1)
+		require.LessOrEqual(tb, setup.ScCallerModuleInstance.GetNumSentTransaction(), setup.GetNumScCallsOperations())
+
+		return false
+	}
+
+	_ = testRelayersWithChainSimulatorAndSetup(tb,
+		createTestSetupFunc,
+		setupFunc,
+		processFunc,
+		make(chan error),
+	)
+}
+
+// executeActionsEarly makes the malicious relayer try to perform the not yet executed actions and returns the number
+// of attempts rejected by the multisig contract
+func executeActionsEarly(setup *framework.TestSetup, relayerIndex int) int {
+	maliciousKeys := setup.RelayersKeys[relayerIndex]
+
+	numRejected := 0
+	for actionID := uint64(1); actionID <= maxActionIDToExecuteEarly; actionID++ {
+		attempted, status := setup.MultiversxHandler.TryPerformActionBeforeQuorum(setup.Ctx, maliciousKeys, actionID)
+		if !attempted {
+			continue
+		}
+		if status == transaction.TxStatusSuccess {
+			// the honest relayers' signatures were included in the same block, so the quorum was reached in the meantime
+			log.Warn("early action execution succeeded, quorum was reached in the same block", "action ID", actionID)
+			continue
+		}
+
+		numRejected++
+	}
+
+	return numRejected
+}
+
+func testRelayersShouldHaltWithMaliciousRelayer(
+	tb testing.TB,
+	behavior framework.MaliciousBehavior,
+	tokens ...framework.TestTokenParams,
+) {
+	numIterations := 0
+
+	createTestSetupFunc := func(tb testing.TB) *framework.TestSetup {
+		setup := framework.NewTestSetupWithQuorum(tb, quorumWithAllRelayers)
+		setup.MaliciousBehaviors[maliciousRelayerIndex] = behavior
+
+		return setup
+	}
+
+	setupFunc := func(tb testing.TB, setup *framework.TestSetup) {
+		setup.IssueAndConfigureTokens(tokens...)
+		setup.MultiversxHandler.CheckForZeroBalanceOnReceivers(setup.Ctx, tokens...)
+		setup.CreateBatchOnMultiversX(tokens...)
+	}
+
+	processFunc := func(tb testing.TB, setup *framework.TestSetup) bool {
+		require.True(tb, setup.IsTransferHaltedFromMultiversX(tokens...),
+			"funds should not be released on Ethereum without the quorum of valid signatures")
+
+		numIterations++
+		if numIterations >= numIterationsToCheckSafeHalt {
+			log.Info(fmt.Sprintf(framework.LogStepMarker, "MultiversX->Ethereum transfer safely halted"))
+			return true
+		}
+
+		setup.EthereumHandler.SimulatedChain.Commit()
+		setup.ChainSimulator.GenerateBlocks(setup.Ctx, 1)
+
+		return false
+	}
+
+	_ = testRelayersWithChainSimulatorAndSetup(tb,
+		createTestSetupFunc,
+		setupFunc,
+		processFunc,
+		make(chan error),
+	)
+}
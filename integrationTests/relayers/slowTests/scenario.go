@@ -0,0 +1,292 @@
+//go:build slow
+
+package slowTests
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/multiversx/mx-bridge-eth-go/integrationTests/relayers/slowTests/framework"
+	"gopkg.in/yaml.v3"
+)
+
+const scenarioFilesPattern = "*.yaml"
+
+var errInvalidScenario = errors.New("invalid scenario")
+
+// Scenario is the YAML descriptor of an end-to-end test executed against the simulators: the tokens to be issued, the
+// deposits done in each direction, the faults injected in the relayers and the expected balances
+type Scenario struct {
+	Name        string          `yaml:"name"`
+	Description string          `yaml:"description"`
+	Quorum      string          `yaml:"quorum"`
+	Tokens      []ScenarioToken `yaml:"tokens"`
+	Faults      []ScenarioFault `yaml:"faults"`
+}
+
+// ScenarioToken describes a token issued on both chains along with its deposits and expected balances
+type ScenarioToken struct {
+	Identifier             string                   `yaml:"identifier"`
+	NumOfDecimals          int                      `yaml:"numOfDecimals"`
+	MvxUniversalTicker     string                   `yaml:"mvxUniversalTicker"`
+	MvxChainSpecificTicker string                   `yaml:"mvxChainSpecificTicker"`
+	HasChainSpecificToken  bool                     `yaml:"hasChainSpecificToken"`
+	IsMintBurnOnMvX        bool                     `yaml:"isMintBurnOnMvX"`
+	IsNativeOnMvX          bool                     `yaml:"isNativeOnMvX"`
+	ValueToMintOnMvx       string                   `yaml:"valueToMintOnMvx"`
+	EthTokenSymbol         string                   `yaml:"ethTokenSymbol"`
+	IsMintBurnOnEth        bool                     `yaml:"isMintBurnOnEth"`
+	IsNativeOnEth          bool                     `yaml:"isNativeOnEth"`
+	ValueToMintOnEth       string                   `yaml:"valueToMintOnEth"`
+	InitialSupply          string                   `yaml:"initialSupply"`
+	Deposits               []ScenarioDeposit        `yaml:"deposits"`
+	ExpectedBalances       ScenarioExpectedBalances `yaml:"expectedBalances"`
+}
+
+// ScenarioDeposit describes the amounts transferred towards MultiversX and sent back from MultiversX. An empty amount
+// means no deposit in that direction
+type ScenarioDeposit struct {
+	ToMultiversX   string          `yaml:"toMultiversX"`
+	FromMultiversX string          `yaml:"fromMultiversX"`
+	SCCall         *ScenarioSCCall `yaml:"scCall"`
+	FaultySCCall   bool            `yaml:"faultySCCall"`
+	ForceSCCall    bool            `yaml:"forceSCCall"`
+}
+
+// ScenarioSCCall describes the smart contract call done on MultiversX with the deposited tokens
+type ScenarioSCCall struct {
+	Function  string   `yaml:"function"`
+	GasLimit  uint64   `yaml:"gasLimit"`
+	Arguments []string `yaml:"arguments"`
+}
+
+// ScenarioExpectedBalances holds the extra balances expected at the end of the scenario, on top of the deposited
+// amounts: the tokens left in the MultiversX safe and the balance change of the Ethereum test address
+type ScenarioExpectedBalances struct {
+	ESDTSafeExtra    string `yaml:"esdtSafeExtra"`
+	EthTestAddrExtra string `yaml:"ethTestAddrExtra"`
+}
+
+// ScenarioFault describes the malicious behavior injected in one of the relayers
+type ScenarioFault struct {
+	Relayer            int  `yaml:"relayer"`
+	SignWrongHash      bool `yaml:"signWrongHash"`
+	WithholdSignatures bool `yaml:"withholdSignatures"`
+	ExecuteEarly       bool `yaml:"executeEarly"`
+	NumSpamJoins       int  `yaml:"numSpamJoins"`
+}
+
+// loadScenarios reads and validates all the scenario descriptors from the provided directory, sorted by file name
+func loadScenarios(directory string) ([]*Scenario, error) {
+	files, err := filepath.Glob(filepath.Join(directory, scenarioFilesPattern))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	scenarios := make([]*Scenario, 0, len(files))
+	for _, file := range files {
+		scenario, errLoad := loadScenario(file)
+		if errLoad != nil {
+			return nil, fmt.Errorf("%w in file %s", errLoad, file)
+		}
+
+		scenarios = append(scenarios, scenario)
+	}
+
+	return scenarios, nil
+}
+
+func loadScenario(file string) (*Scenario, error) {
+	reader, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	// unknown fields are rejected so the typos in the descriptors do not go unnoticed
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+
+	scenario := &Scenario{}
+	err = decoder.Decode(scenario)
+	if err != nil {
+		return nil, err
+	}
+
+	err = scenario.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return scenario, nil
+}
+
+func (scenario *Scenario) validate() error {
+	if len(scenario.Name) == 0 {
+		return fmt.Errorf("%w: empty name", errInvalidScenario)
+	}
+	if len(scenario.Tokens) == 0 {
+		return fmt.Errorf("%w: no tokens", errInvalidScenario)
+	}
+	for _, fault := range scenario.Faults {
+		if fault.Relayer < 0 || fault.Relayer >= framework.NumRelayers {
+			return fmt.Errorf("%w: fault relayer index %d, number of relayers %d",
+				errInvalidScenario, fault.Relayer, framework.NumRelayers)
+		}
+	}
+
+	_, err := scenario.tokenParams()
+
+	return err
+}
+
+// tokenParams converts the tokens of the scenario into the parameters used by the test framework
+func (scenario *Scenario) tokenParams() ([]framework.TestTokenParams, error) {
+	tokens := make([]framework.TestTokenParams, 0, len(scenario.Tokens))
+	for _, token := range scenario.Tokens {
+		params, err := token.toTestTokenParams()
+		if err != nil {
+			return nil, fmt.Errorf("%w for token %s", err, token.Identifier)
+		}
+
+		tokens = append(tokens, params)
+	}
+
+	return tokens, nil
+}
+
+// maliciousBehaviors returns the behaviors of the faulty relayers, mapped by the relayer index
+func (scenario *Scenario) maliciousBehaviors() map[int]framework.MaliciousBehavior {
+	behaviors := make(map[int]framework.MaliciousBehavior, len(scenario.Faults))
+	for _, fault := range scenario.Faults {
+		behaviors[fault.Relayer] = framework.MaliciousBehavior{
+			SignWrongHash:      fault.SignWrongHash,
+			WithholdSignatures: fault.WithholdSignatures,
+			ExecuteEarly:       fault.ExecuteEarly,
+			NumSpamJoins:       fault.NumSpamJoins,
+		}
+	}
+
+	return behaviors
+}
+
+func (token *ScenarioToken) toTestTokenParams() (framework.TestTokenParams, error) {
+	if len(token.Identifier) == 0 {
+		return framework.TestTokenParams{}, fmt.Errorf("%w: empty token identifier", errInvalidScenario)
+	}
+	if len(token.Deposits) == 0 {
+		return framework.TestTokenParams{}, fmt.Errorf("%w: no deposits", errInvalidScenario)
+	}
+
+	operations := make([]framework.TokenOperations, 0, len(token.Deposits))
+	for i, deposit := range token.Deposits {
+		operation, err := deposit.toTokenOperations()
+		if err != nil {
+			return framework.TestTokenParams{}, fmt.Errorf("%w for deposit %d", err, i)
+		}
+
+		operations = append(operations, operation)
+	}
+
+	esdtSafeExtraBalance, err := parseScenarioAmount(token.ExpectedBalances.ESDTSafeExtra)
+	if err != nil {
+		return framework.TestTokenParams{}, fmt.Errorf("%w for the expected ESDT safe extra balance", err)
+	}
+	ethTestAddrExtraBalance, err := parseScenarioAmount(token.ExpectedBalances.EthTestAddrExtra)
+	if err != nil {
+		return framework.TestTokenParams{}, fmt.Errorf("%w for the expected Ethereum test address extra balance", err)
+	}
+
+	mvxChainSpecificTicker := token.MvxChainSpecificTicker
+	if !token.HasChainSpecificToken {
+		mvxChainSpecificTicker = token.MvxUniversalTicker
+	}
+
+	return framework.TestTokenParams{
+		IssueTokenParams: framework.IssueTokenParams{
+			InitialSupplyParams: framework.InitialSupplyParams{
+				InitialSupplyValue: token.InitialSupply,
+			},
+			AbstractTokenIdentifier:          token.Identifier,
+			NumOfDecimalsUniversal:           token.NumOfDecimals,
+			NumOfDecimalsChainSpecific:       byte(token.NumOfDecimals),
+			MvxUniversalTokenTicker:          token.MvxUniversalTicker,
+			MvxChainSpecificTokenTicker:      mvxChainSpecificTicker,
+			MvxUniversalTokenDisplayName:     "Wrapped" + token.Identifier,
+			MvxChainSpecificTokenDisplayName: "EthereumWrapped" + token.Identifier,
+			ValueToMintOnMvx:                 token.ValueToMintOnMvx,
+			IsMintBurnOnMvX:                  token.IsMintBurnOnMvX,
+			IsNativeOnMvX:                    token.IsNativeOnMvX,
+			HasChainSpecificToken:            token.HasChainSpecificToken,
+			EthTokenName:                     "Eth" + token.Identifier,
+			EthTokenSymbol:                   token.EthTokenSymbol,
+			ValueToMintOnEth:                 token.ValueToMintOnEth,
+			IsMintBurnOnEth:                  token.IsMintBurnOnEth,
+			IsNativeOnEth:                    token.IsNativeOnEth,
+		},
+		TestOperations:          operations,
+		ESDTSafeExtraBalance:    esdtSafeExtraBalance,
+		EthTestAddrExtraBalance: ethTestAddrExtraBalance,
+	}, nil
+}
+
+func (deposit *ScenarioDeposit) toTokenOperations() (framework.TokenOperations, error) {
+	valueToTransferToMvx, err := parseOptionalScenarioAmount(deposit.ToMultiversX)
+	if err != nil {
+		return framework.TokenOperations{}, err
+	}
+	valueToSendFromMvX, err := parseOptionalScenarioAmount(deposit.FromMultiversX)
+	if err != nil {
+		return framework.TokenOperations{}, err
+	}
+
+	operation := framework.TokenOperations{
+		ValueToTransferToMvx: valueToTransferToMvx,
+		ValueToSendFromMvX:   valueToSendFromMvX,
+		MvxFaultySCCall:      deposit.FaultySCCall,
+		MvxForceSCCall:       deposit.ForceSCCall,
+	}
+	if deposit.SCCall != nil {
+		operation.MvxSCCallData = createScCallData(deposit.SCCall.Function, deposit.SCCall.GasLimit, deposit.SCCall.Arguments...)
+	}
+
+	return operation, nil
+}
+
+// parseScenarioAmount parses a base 10 amount, the empty string meaning 0. The expected balances can be negative
+func parseScenarioAmount(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid amount %s", errInvalidScenario, value)
+	}
+
+	return amount, nil
+}
+
+// parseOptionalScenarioAmount parses a positive base 10 amount, the empty string meaning no deposit
+func parseOptionalScenarioAmount(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	amount, err := parseScenarioAmount(value)
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: deposit amount %s should be positive", errInvalidScenario, value)
+	}
+
+	return amount, nil
+}
@@ -0,0 +1,77 @@
+//go:build slow
+
+// To run these slow tests, simply add the slow tag on the go test command. Also, provide a chain simulator instance on the 8085 port
+// example: go test -tags slow -run TestRelayersShouldExecuteScenarios
+
+package slowTests
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/integrationTests/relayers/slowTests/framework"
+	"github.com/stretchr/testify/require"
+)
+
+const scenariosDirectory = "testdata/scenarios"
+
+func TestRelayersShouldExecuteScenarios(t *testing.T) {
+	scenarios, err := loadScenarios(scenariosDirectory)
+	require.Nil(t, err)
+	require.NotEmpty(t, scenarios)
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.Name, func(t *testing.T) {
+			testRelayersWithScenario(t, scenario)
+		})
+	}
+}
+
+func testRelayersWithScenario(tb testing.TB, scenario *Scenario) {
+	log.Info("running scenario", "name", scenario.Name, "description", scenario.Description)
+
+	tokens, err := scenario.tokenParams()
+	require.Nil(tb, err)
+
+	createTestSetupFunc := func(tb testing.TB) *framework.TestSetup {
+		setup := framework.NewTestSetup(tb)
+		if len(scenario.Quorum) > 0 {
+			setup = framework.NewTestSetupWithQuorum(tb, scenario.Quorum)
+		}
+		for relayerIndex, behavior := range scenario.maliciousBehaviors() {
+			setup.MaliciousBehaviors[relayerIndex] = behavior
+		}
+
+		return setup
+	}
+
+	setupFunc, transfersProcessFunc := createTransfersSetupAndProcessFuncs(tb, tokens...)
+
+	// the relayers configured to execute early try to perform the MultiversX actions before the quorum is reached
+	numRejectedEarlyExecutions := make(map[int]int)
+	numIterations := 0
+	processFunc := func(tb testing.TB, setup *framework.TestSetup) bool {
+		if transfersProcessFunc(tb, setup) {
+			for _, fault := range scenario.Faults {
+				if fault.ExecuteEarly {
+					require.Greater(tb, numRejectedEarlyExecutions[fault.Relayer], 0)
+				}
+			}
+
+			return true
+		}
+
+		numIterations++
+		if numIterations%numIterationsBetweenEarlyCalls != 0 {
+			return false
+		}
+		for _, fault := range scenario.Faults {
+			if fault.ExecuteEarly {
+				numRejectedEarlyExecutions[fault.Relayer] += executeActionsEarly(setup, fault.Relayer)
+			}
+		}
+
+		return false
+	}
+
+	_ = testRelayersWithChainSimulatorAndSetup(tb, createTestSetupFunc, setupFunc, processFunc, make(chan error))
+}
@@ -0,0 +1,116 @@
+//go:build slow && soak
+
+package slowTests
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"time"
+)
+
+const bytesInMegabyte = 1024 * 1024
+
+// soakMetrics records the metrics tracked during a soak run: the transfer latencies for each direction and the heap
+// size, sampled after each round
+type soakMetrics struct {
+	mut          sync.Mutex
+	latencies    map[string][]time.Duration
+	initialHeap  uint64
+	maxHeap      uint64
+	currentHeap  uint64
+	numRounds    int
+	numTransfers int
+	startedAt    time.Time
+}
+
+func newSoakMetrics() *soakMetrics {
+	return &soakMetrics{
+		latencies: make(map[string][]time.Duration),
+		startedAt: time.Now(),
+	}
+}
+
+// addLatency records the time a transfer round took to complete in the provided direction
+func (metrics *soakMetrics) addLatency(direction string, latency time.Duration, numTransfers int) {
+	metrics.mut.Lock()
+	defer metrics.mut.Unlock()
+
+	metrics.latencies[direction] = append(metrics.latencies[direction], latency)
+	metrics.numTransfers += numTransfers
+}
+
+// sampleHeap forces a garbage collection and records the heap size. The first sample is the reference used to compute
+// the heap growth
+func (metrics *soakMetrics) sampleHeap() {
+	runtime.GC()
+	memStats := runtime.MemStats{}
+	runtime.ReadMemStats(&memStats)
+
+	metrics.mut.Lock()
+	defer metrics.mut.Unlock()
+
+	metrics.numRounds++
+	metrics.currentHeap = memStats.HeapAlloc
+	if metrics.initialHeap == 0 {
+		metrics.initialHeap = memStats.HeapAlloc
+	}
+	if memStats.HeapAlloc > metrics.maxHeap {
+		metrics.maxHeap = memStats.HeapAlloc
+	}
+}
+
+// heapGrowthInMB returns the growth of the heap size since the first sample
+func (metrics *soakMetrics) heapGrowthInMB() float64 {
+	metrics.mut.Lock()
+	defer metrics.mut.Unlock()
+
+	if metrics.currentHeap < metrics.initialHeap {
+		return 0
+	}
+
+	return float64(metrics.currentHeap-metrics.initialHeap) / bytesInMegabyte
+}
+
+// logSummary logs the latency distribution of each direction and the heap usage
+func (metrics *soakMetrics) logSummary() {
+	metrics.mut.Lock()
+	defer metrics.mut.Unlock()
+
+	log.Info("soak test summary",
+		"elapsed", time.Since(metrics.startedAt).Round(time.Second),
+		"rounds", metrics.numRounds,
+		"transfers", metrics.numTransfers,
+		"initial heap (MB)", metrics.initialHeap/bytesInMegabyte,
+		"current heap (MB)", metrics.currentHeap/bytesInMegabyte,
+		"max heap (MB)", metrics.maxHeap/bytesInMegabyte)
+
+	for direction, latencies := range metrics.latencies {
+		sorted := make([]time.Duration, len(latencies))
+		copy(sorted, latencies)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i] < sorted[j]
+		})
+
+		log.Info("soak test latencies", "direction", direction,
+			"samples", len(sorted),
+			"p50", percentile(sorted, 50),
+			"p90", percentile(sorted, 90),
+			"p99", percentile(sorted, 99),
+			"max", sorted[len(sorted)-1])
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted durations
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
@@ -0,0 +1,258 @@
+//go:build slow && soak
+
+// The soak test continuously generates randomized transfers in both directions and checks that no funds are lost.
+// To run it, add both the slow and the soak tags on the go test command and provide a chain simulator instance on the
+// 8085 port. The run duration and the other settings are provided as flags
+// example: go test -tags "slow soak" -run TestRelayersSoak -timeout 0 -soak.duration 4h
+
+package slowTests
+
+import (
+	"flag"
+	"fmt"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/integrationTests/relayers/slowTests/framework"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	soakEthToMvxDirection     = "Ethereum->MultiversX"
+	soakMvxToEthDirection     = "MultiversX->Ethereum"
+	soakMaxDepositsPerToken   = 3
+	soakMinAmount             = 100
+	soakMaxAmount             = 10000
+	soakNoReturnProbabilityIn = 4 // one in this many deposits is not sent back from MultiversX
+)
+
+var (
+	soakDuration          = flag.Duration("soak.duration", time.Hour, "the duration of the soak test")
+	soakSeed              = flag.Int64("soak.seed", 0, "the seed of the transfers randomizer, 0 meaning the current time")
+	soakRoundTimeout      = flag.Duration("soak.roundTimeout", time.Minute*10, "the maximum duration of a transfer, after which the funds are considered lost")
+	soakSummaryInterval   = flag.Duration("soak.summaryInterval", time.Minute*10, "the interval between the metrics summaries")
+	soakMaxHeapGrowthInMB = flag.Uint64("soak.maxHeapGrowthMB", 0, "the maximum heap growth since the first round, 0 disabling the check")
+)
+
+// soakSnapshot holds the balances of a token, taken at the start of a round
+type soakSnapshot struct {
+	ethTestAddress *big.Int
+	mvxTestAddress *big.Int
+	mvxSafe        *big.Int
+}
+
+// soakRoundTotals holds the amounts transferred for a token in a round
+type soakRoundTotals struct {
+	toMultiversX   *big.Int
+	fromMultiversX *big.Int
+	fees           *big.Int
+}
+
+func TestRelayersSoak(t *testing.T) {
+	seed := *soakSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	log.Info(fmt.Sprintf(framework.LogStepMarker, "starting the soak test"), "duration", *soakDuration, "seed", seed)
+	randomizer := rand.New(rand.NewSource(seed))
+
+	// the tokens are native on Ethereum, so each round starts with the deposits from Ethereum and sends back part of
+	// the received amounts
+	tokens := []framework.TestTokenParams{
+		createSoakToken(GenerateTestUSDCToken()),
+		createSoakToken(GenerateTestEUROCToken()),
+	}
+
+	setup := framework.NewTestSetup(t)
+	setup.IssueAndConfigureTokens(tokens...)
+	setup.StartRelayersAndScModule()
+	defer setup.Close()
+
+	metrics := newSoakMetrics()
+	deadline := time.Now().Add(*soakDuration)
+	lastSummary := time.Now()
+	for round := 1; time.Now().Before(deadline); round++ {
+		roundTokens := generateSoakRound(randomizer, tokens)
+		runSoakRound(t, setup, metrics, round, roundTokens)
+
+		metrics.sampleHeap()
+		if *soakMaxHeapGrowthInMB > 0 {
+			require.LessOrEqual(t, metrics.heapGrowthInMB(), float64(*soakMaxHeapGrowthInMB),
+				"heap growth exceeded in round %d", round)
+		}
+		if time.Since(lastSummary) >= *soakSummaryInterval {
+			metrics.logSummary()
+			lastSummary = time.Now()
+		}
+	}
+
+	metrics.logSummary()
+}
+
+func createSoakToken(token framework.TestTokenParams) framework.TestTokenParams {
+	token.TestOperations = nil
+	token.ESDTSafeExtraBalance = big.NewInt(0)
+	token.EthTestAddrExtraBalance = big.NewInt(0)
+
+	return token
+}
+
+// generateSoakRound returns the tokens with randomized operations. Each deposit towards MultiversX is optionally
+// followed by a deposit back to Ethereum, not greater than the received amount
+func generateSoakRound(randomizer *rand.Rand, tokens []framework.TestTokenParams) []framework.TestTokenParams {
+	roundTokens := make([]framework.TestTokenParams, 0, len(tokens))
+	for _, token := range tokens {
+		numDeposits := 1 + randomizer.Intn(soakMaxDepositsPerToken)
+		token.TestOperations = make([]framework.TokenOperations, 0, numDeposits)
+		for i := 0; i < numDeposits; i++ {
+			valueToTransferToMvx := soakMinAmount + randomizer.Int63n(soakMaxAmount-soakMinAmount+1)
+			operation := framework.TokenOperations{
+				ValueToTransferToMvx: big.NewInt(valueToTransferToMvx),
+			}
+			if randomizer.Intn(soakNoReturnProbabilityIn) != 0 {
+				operation.ValueToSendFromMvX = big.NewInt(soakMinAmount + randomizer.Int63n(valueToTransferToMvx-soakMinAmount+1))
+			}
+
+			token.TestOperations = append(token.TestOperations, operation)
+		}
+
+		roundTokens = append(roundTokens, token)
+	}
+
+	return roundTokens
+}
+
+func runSoakRound(tb testing.TB, setup *framework.TestSetup, metrics *soakMetrics, round int, tokens []framework.TestTokenParams) {
+	snapshots := make(map[string]*soakSnapshot, len(tokens))
+	totals := make(map[string]*soakRoundTotals, len(tokens))
+	numToMultiversX, numFromMultiversX := 0, 0
+	for _, token := range tokens {
+		snapshots[token.AbstractTokenIdentifier] = takeSoakSnapshot(setup, token.AbstractTokenIdentifier)
+		roundTotals := computeSoakRoundTotals(token)
+		totals[token.AbstractTokenIdentifier] = roundTotals
+
+		for _, operation := range token.TestOperations {
+			numToMultiversX++
+			if operation.ValueToSendFromMvX != nil {
+				numFromMultiversX++
+			}
+		}
+	}
+	log.Info(fmt.Sprintf(framework.LogStepMarker, fmt.Sprintf("soak round %d", round)),
+		"deposits towards MultiversX", numToMultiversX, "deposits towards Ethereum", numFromMultiversX)
+
+	startedAt := time.Now()
+	setup.EthereumHandler.CreateBatchOnEthereum(setup.Ctx, setup.MultiversxHandler.TestCallerAddress, tokens...)
+	waitSoakTransfers(tb, setup, round, soakEthToMvxDirection, func() error {
+		for _, token := range tokens {
+			snapshot := snapshots[token.AbstractTokenIdentifier]
+			roundTotals := totals[token.AbstractTokenIdentifier]
+
+			expected := big.NewInt(0).Add(snapshot.mvxTestAddress, roundTotals.toMultiversX)
+			actual := setup.MultiversxHandler.GetESDTUniversalTokenBalance(setup.Ctx, setup.TestKeys.MvxAddress, token.AbstractTokenIdentifier)
+			err := checkSoakBalance(token.AbstractTokenIdentifier, "MultiversX test address", expected, actual)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	metrics.addLatency(soakEthToMvxDirection, time.Since(startedAt), numToMultiversX)
+
+	startedAt = time.Now()
+	setup.SendFromMultiversxToEthereum(tokens...)
+	waitSoakTransfers(tb, setup, round, soakMvxToEthDirection, func() error {
+		for _, token := range tokens {
+			err := checkSoakFinalBalances(setup, token.AbstractTokenIdentifier, snapshots[token.AbstractTokenIdentifier], totals[token.AbstractTokenIdentifier])
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	metrics.addLatency(soakMvxToEthDirection, time.Since(startedAt), numFromMultiversX)
+}
+
+func takeSoakSnapshot(setup *framework.TestSetup, token string) *soakSnapshot {
+	return &soakSnapshot{
+		ethTestAddress: setup.EthereumHandler.GetBalance(setup.TestKeys.EthAddress, token),
+		mvxTestAddress: setup.MultiversxHandler.GetESDTUniversalTokenBalance(setup.Ctx, setup.TestKeys.MvxAddress, token),
+		mvxSafe:        setup.MultiversxHandler.GetESDTChainSpecificTokenBalance(setup.Ctx, setup.MultiversxHandler.SafeAddress, token),
+	}
+}
+
+func computeSoakRoundTotals(token framework.TestTokenParams) *soakRoundTotals {
+	roundTotals := &soakRoundTotals{
+		toMultiversX:   big.NewInt(0),
+		fromMultiversX: big.NewInt(0),
+		fees:           big.NewInt(0),
+	}
+	for _, operation := range token.TestOperations {
+		roundTotals.toMultiversX.Add(roundTotals.toMultiversX, operation.ValueToTransferToMvx)
+		if operation.ValueToSendFromMvX != nil {
+			roundTotals.fromMultiversX.Add(roundTotals.fromMultiversX, operation.ValueToSendFromMvX)
+			roundTotals.fees.Add(roundTotals.fees, framework.TransferFee())
+		}
+	}
+
+	return roundTotals
+}
+
+// checkSoakFinalBalances checks the no lost funds invariant at the end of a round: the Ethereum test address paid the
+// deposits towards MultiversX and received the deposits back, minus the fees, the MultiversX test address kept the
+// difference and the MultiversX safe collected the fees
+func checkSoakFinalBalances(setup *framework.TestSetup, token string, snapshot *soakSnapshot, roundTotals *soakRoundTotals) error {
+	expectedEth := big.NewInt(0).Sub(snapshot.ethTestAddress, roundTotals.toMultiversX)
+	expectedEth.Add(expectedEth, roundTotals.fromMultiversX)
+	expectedEth.Sub(expectedEth, roundTotals.fees)
+	actualEth := setup.EthereumHandler.GetBalance(setup.TestKeys.EthAddress, token)
+	err := checkSoakBalance(token, "Ethereum test address", expectedEth, actualEth)
+	if err != nil {
+		return err
+	}
+
+	expectedMvx := big.NewInt(0).Add(snapshot.mvxTestAddress, roundTotals.toMultiversX)
+	expectedMvx.Sub(expectedMvx, roundTotals.fromMultiversX)
+	actualMvx := setup.MultiversxHandler.GetESDTUniversalTokenBalance(setup.Ctx, setup.TestKeys.MvxAddress, token)
+	err = checkSoakBalance(token, "MultiversX test address", expectedMvx, actualMvx)
+	if err != nil {
+		return err
+	}
+
+	expectedSafe := big.NewInt(0).Add(snapshot.mvxSafe, roundTotals.fees)
+	actualSafe := setup.MultiversxHandler.GetESDTChainSpecificTokenBalance(setup.Ctx, setup.MultiversxHandler.SafeAddress, token)
+
+	return checkSoakBalance(token, "MultiversX safe", expectedSafe, actualSafe)
+}
+
+func checkSoakBalance(token string, holder string, expected *big.Int, actual *big.Int) error {
+	if expected.Cmp(actual) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("token %s, %s: expected balance %s, actual balance %s", token, holder, expected, actual)
+}
+
+// waitSoakTransfers generates blocks on both chains until the balances check passes. The funds not delivered in the
+// round timeout are considered lost and the test fails
+func waitSoakTransfers(tb testing.TB, setup *framework.TestSetup, round int, direction string, checkBalances func() error) {
+	deadline := time.Now().Add(*soakRoundTimeout)
+	for {
+		err := checkBalances()
+		if err == nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			require.Fail(tb, fmt.Sprintf("funds lost in round %d, direction %s: %v", round, direction, err))
+			return
+		}
+
+		// commit blocks in order to execute incoming txs from relayers
+		setup.EthereumHandler.SimulatedChain.Commit()
+		setup.ChainSimulator.GenerateBlocks(setup.Ctx, 1)
+	}
+}
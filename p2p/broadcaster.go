@@ -1,8 +1,11 @@
 package p2p
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,10 +21,20 @@ import (
 )
 
 const (
-	joinTopicSuffix        = "_join"
-	signTopicSuffix        = "_sign"
-	defaultTopicIdentifier = "default"
-	joinTopicMessage       = "join topic"
+	joinTopicSuffix         = "_join"
+	signTopicSuffix         = "_sign"
+	catchUpTopicSuffix      = "_catchup"
+	preAgreementTopicSuffix = "_preagreement"
+	directTopicSuffix       = "_direct"
+	defaultTopicIdentifier  = "default"
+	joinTopicMessage        = "join topic"
+	// joinTopicBatchedMessage is sent by the relayers able to process the stored signatures in batched catch-up
+	// messages. The relayers sending the legacy join message receive one message per stored signature
+	joinTopicBatchedMessage = "join topic batched"
+	// policyHashSeparator separates the batched join message and the hex encoded confirmation policy, rounding policy
+	// and recipient rules hashes. The hashes are appended only when the relayer uses the policies
+	policyHashSeparator = ":"
+	numJoinPayloadParts = 4
 )
 
 // ArgsBroadcaster is the DTO used in the broadcaster constructor
@@ -35,6 +48,26 @@ type ArgsBroadcaster struct {
 	PrivateKey             crypto.PrivateKey
 	Name                   string
 	AntifloodComponents    *factory.AntiFloodComponents
+	PeersRecorder          PeersRecorder
+	TopicsMetrics          TopicsMetrics
+	// CompressionType and CompressionThresholdInBytes define the compression of the sent messages. All the relayers
+	// can decode the compressed messages, regardless of their own compression settings
+	CompressionType             string
+	CompressionThresholdInBytes int
+	// NumVerificationWorkers is the number of workers verifying the signatures of the catch-up messages in parallel,
+	// 0 meaning GOMAXPROCS. VerifiedSignaturesCacheSize is the number of successful verifications remembered, 0
+	// meaning the default size
+	NumVerificationWorkers      int
+	VerifiedSignaturesCacheSize int
+	// PolicyHash is the hash of the confirmation policy, sent in the join messages so the relayers using a different
+	// policy are reported. Empty when no confirmation policy is used
+	PolicyHash []byte
+	// RoundingPolicyHash is the hash of the rounding policy, sent in the join messages after the confirmation policy
+	// hash. Empty when no rounding policy is used
+	RoundingPolicyHash []byte
+	// RecipientRulesHash is the hash of the transfer allowlist and recipients validation rules, sent in the join
+	// messages after the rounding policy hash. Empty when no recipient rules are used
+	RecipientRulesHash []byte
 }
 
 type broadcaster struct {
@@ -44,11 +77,28 @@ type broadcaster struct {
 	log                   logger.Logger
 	multiversRoleProvider MultiversXRoleProvider
 	signatureProcessor    SignatureProcessor
+	peersRecorder         PeersRecorder
+	topicsMetrics         TopicsMetrics
 	name                  string
 	mutClients            sync.RWMutex
 	clients               []core.BroadcastClient
+	preAgreementClients   []core.PreAgreementClient
+	directMessageClients  []core.DirectMessageClient
 	joinTopicName         string
 	signTopicName         string
+	catchUpTopicName      string
+	preAgreementTopicName string
+	directTopicName       string
+	compressor            *messageCompressor
+	policyHash            []byte
+	roundingPolicyHash    []byte
+	recipientRulesHash    []byte
+
+	// the direct messages have their own nonces, so a direct message can not make the broadcast messages sent
+	// before it by the same relayer look replayed, and the other way around
+	directNonces   *noncesOfPublicKeys
+	mutPeers       sync.RWMutex
+	peersOfRelayer map[string]chainCore.PeerID
 }
 
 // NewBroadcaster will create a new broadcaster able to pass messages and signatures
@@ -58,6 +108,16 @@ func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
 		return nil, err
 	}
 
+	compressor, err := newMessageCompressor(args.CompressionType, args.CompressionThresholdInBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := newSignaturesVerifier(args.NumVerificationWorkers, args.VerifiedSignaturesCacheSize)
+	if err != nil {
+		return nil, err
+	}
+
 	b := &broadcaster{
 		name:                  args.Name,
 		messenger:             args.Messenger,
@@ -65,6 +125,8 @@ func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
 		log:                   args.Log,
 		multiversRoleProvider: args.MultiversXRoleProvider,
 		signatureProcessor:    args.SignatureProcessor,
+		peersRecorder:         args.PeersRecorder,
+		topicsMetrics:         args.TopicsMetrics,
 		relayerMessageHandler: &relayerMessageHandler{
 			marshalizer:         &marshal.JsonMarshalizer{},
 			keyGen:              args.KeyGen,
@@ -72,14 +134,27 @@ func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
 			counter:             uint64(time.Now().UnixNano()),
 			privateKey:          args.PrivateKey,
 			antifloodComponents: args.AntifloodComponents,
+			verifier:            verifier,
 		},
-		clients:       make([]core.BroadcastClient, 0),
-		joinTopicName: args.Name + joinTopicSuffix,
-		signTopicName: args.Name + signTopicSuffix,
+		clients:               make([]core.BroadcastClient, 0),
+		preAgreementClients:   make([]core.PreAgreementClient, 0),
+		directMessageClients:  make([]core.DirectMessageClient, 0),
+		joinTopicName:         args.Name + joinTopicSuffix,
+		signTopicName:         args.Name + signTopicSuffix,
+		catchUpTopicName:      args.Name + catchUpTopicSuffix,
+		preAgreementTopicName: args.Name + preAgreementTopicSuffix,
+		directTopicName:       args.Name + directTopicSuffix,
+		directNonces:          newNoncesOfPublicKeys(),
+		peersOfRelayer:        make(map[string]chainCore.PeerID),
+		compressor:            compressor,
+		policyHash:            args.PolicyHash,
+		roundingPolicyHash:    args.RoundingPolicyHash,
+		recipientRulesHash:    args.RecipientRulesHash,
 	}
 	pk := b.privateKey.GeneratePublic()
 	b.publicKeyBytes, err = pk.ToByteArray()
 	if err != nil {
+		verifier.close()
 		return nil, err
 	}
 
@@ -114,13 +189,19 @@ func checkArgs(args ArgsBroadcaster) error {
 	if args.AntifloodComponents == nil {
 		return ErrNilAntifloodComponents
 	}
+	if check.IfNil(args.PeersRecorder) {
+		return ErrNilPeersRecorder
+	}
+	if check.IfNil(args.TopicsMetrics) {
+		return ErrNilTopicsMetrics
+	}
 
 	return nil
 }
 
 // RegisterOnTopics will register the messenger on all required topics
 func (b *broadcaster) RegisterOnTopics() error {
-	topics := []string{b.joinTopicName, b.signTopicName}
+	topics := []string{b.joinTopicName, b.signTopicName, b.catchUpTopicName, b.preAgreementTopicName, b.directTopicName}
 	for _, topic := range topics {
 		err := b.messenger.CreateTopic(topic, true)
 		if err != nil {
@@ -132,6 +213,7 @@ func (b *broadcaster) RegisterOnTopics() error {
 			return err
 		}
 
+		b.topicsMetrics.RegisterTopic(topic)
 		b.log.Info("registered", "topic", topic)
 	}
 
@@ -140,6 +222,16 @@ func (b *broadcaster) RegisterOnTopics() error {
 
 // ProcessReceivedMessage will be called by the network messenger whenever a new message is received
 func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID, _ p2p.MessageHandler) error {
+	b.topicsMetrics.AddReceived(message.Topic())
+	if message.Topic() == b.catchUpTopicName {
+		return b.processCatchUpMessage(message, fromConnectedPeer)
+	}
+	if message.Topic() == b.directTopicName && message.Peer() != fromConnectedPeer {
+		// the direct messages are sent on a stream to the connected peer, a relayed one was gossiped by someone else
+		b.topicsMetrics.AddRejected(message.Topic())
+		return fmt.Errorf("%w from peer %s, originator %s", ErrRelayedDirectMessage, fromConnectedPeer.Pretty(), message.Peer().Pretty())
+	}
+
 	msg, err := b.preProcessMessage(message, fromConnectedPeer)
 	if err != nil {
 		b.log.Debug("got message", "topic", message.Topic(), "error", err)
@@ -149,6 +241,7 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 	addr := data.NewAddressFromBytes(msg.PublicKeyBytes)
 	hexPkBytes := hex.EncodeToString(msg.PublicKeyBytes)
 	if !b.multiversRoleProvider.IsWhitelisted(addr) {
+		b.topicsMetrics.AddRejected(message.Topic())
 		return fmt.Errorf("%w for peer: %s", ErrPeerNotWhitelisted, hexPkBytes)
 	}
 
@@ -156,7 +249,11 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 	b.log.Trace("got message", "topic", message.Topic(),
 		"msg.Payload", msg.Payload, "msg.Nonce", msg.Nonce, "msg.PublicKey", address)
 
-	err = b.processNonce(msg)
+	if message.Topic() == b.directTopicName {
+		err = b.directNonces.processNonce(msg)
+	} else {
+		err = b.processNonce(msg)
+	}
 	if err != nil {
 		// someone might try to send old, already seen by the network, messages
 		// drop the message and do not resend-it to other relayers
@@ -170,23 +267,154 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 		return err
 	}
 
+	b.peersRecorder.AddPeer(message.Peer())
+	b.recordPeerOfRelayer(msg.PublicKeyBytes, message.Peer())
+
 	switch message.Topic() {
 	case b.joinTopicName:
-		b.processJoinMessage(message)
+		b.processJoinMessage(message, msg)
 	case b.signTopicName:
 		b.processSignMessage(msg)
+	case b.preAgreementTopicName:
+		b.notifyPreAgreementClients(msg)
+	case b.directTopicName:
+		b.notifyDirectMessageClients(msg)
 	}
 
 	return nil
 }
 
-func (b *broadcaster) processJoinMessage(message p2p.MessageP2P) {
+func (b *broadcaster) processJoinMessage(message p2p.MessageP2P, msg *core.SignedMessage) {
+	payload, policyHash, roundingPolicyHash, recipientRulesHash := splitJoinPayload(string(msg.Payload))
+	if payload == joinTopicBatchedMessage {
+		b.checkPolicyHash(message.Peer(), policyHash)
+		b.checkRoundingPolicyHash(message.Peer(), roundingPolicyHash)
+		b.checkRecipientRulesHash(message.Peer(), recipientRulesHash)
+		b.sendCurrentSignaturesInBatches(message.Peer())
+		return
+	}
+
 	err := b.broadcastCurrentSignatures(message.Peer())
 	if err != nil {
 		b.log.Error(err.Error())
 	}
 }
 
+// splitJoinPayload returns the join message and the hex encoded confirmation policy, rounding policy and recipient
+// rules hashes appended to it, if any
+func splitJoinPayload(payload string) (string, string, string, string) {
+	parts := strings.SplitN(payload, policyHashSeparator, numJoinPayloadParts)
+	for len(parts) < numJoinPayloadParts {
+		parts = append(parts, "")
+	}
+
+	return parts[0], parts[1], parts[2], parts[3]
+}
+
+// checkPolicyHash reports the relayers using a confirmation policy different from this relayer's, as they would
+// consider different batches final
+func (b *broadcaster) checkPolicyHash(peer chainCore.PeerID, policyHash string) {
+	ownPolicyHash := hex.EncodeToString(b.policyHash)
+	if policyHash == ownPolicyHash {
+		return
+	}
+
+	b.log.Warn("relayer with a different confirmation policy joined",
+		"peer", peer.Pretty(), "policy hash", policyHash, "own policy hash", ownPolicyHash)
+}
+
+// checkRoundingPolicyHash reports the relayers using a rounding policy different from this relayer's, as they would
+// compute different amounts for the same conversions
+func (b *broadcaster) checkRoundingPolicyHash(peer chainCore.PeerID, roundingPolicyHash string) {
+	ownRoundingPolicyHash := hex.EncodeToString(b.roundingPolicyHash)
+	if roundingPolicyHash == ownRoundingPolicyHash {
+		return
+	}
+
+	b.log.Warn("relayer with a different rounding policy joined",
+		"peer", peer.Pretty(), "rounding policy hash", roundingPolicyHash, "own rounding policy hash", ownRoundingPolicyHash)
+}
+
+// checkRecipientRulesHash reports the relayers using transfer allowlist or recipients validation rules different from
+// this relayer's, as they would reject different deposits and sign different batches
+func (b *broadcaster) checkRecipientRulesHash(peer chainCore.PeerID, recipientRulesHash string) {
+	ownRecipientRulesHash := hex.EncodeToString(b.recipientRulesHash)
+	if recipientRulesHash == ownRecipientRulesHash {
+		return
+	}
+
+	b.log.Warn("relayer with different recipient rules joined",
+		"peer", peer.Pretty(), "recipient rules hash", recipientRulesHash, "own recipient rules hash", ownRecipientRulesHash)
+}
+
+func (b *broadcaster) processCatchUpMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) error {
+	batch, err := b.preProcessCatchUpMessage(message, fromConnectedPeer)
+	if err != nil {
+		b.log.Debug("got catch-up message", "topic", message.Topic(), "error", err)
+		return err
+	}
+
+	err = b.canProcessMessage(message, fromConnectedPeer)
+	if err != nil {
+		b.log.Debug("can't process catch-up message", "peer", fromConnectedPeer, "error", err)
+		return err
+	}
+
+	b.log.Debug("got catch-up message", "peer", message.Peer().Pretty(), "page", batch.Page,
+		"num pages", batch.NumPages, "num messages", len(batch.Messages))
+
+	// the signatures are verified in parallel, the verified messages are then processed in order
+	ethSignatures := make([]*core.EthereumSignature, len(batch.Messages))
+	verifications := make([]func() error, 0, len(batch.Messages))
+	for i := range batch.Messages {
+		index := i
+		verifications = append(verifications, func() error {
+			var errVerify error
+			ethSignatures[index], errVerify = b.verifyCatchUpSignedMessage(batch.Messages[index], message, fromConnectedPeer)
+			return errVerify
+		})
+	}
+	errs := b.verifier.verifyAll(verifications)
+
+	for i, msg := range batch.Messages {
+		err = errs[i]
+		if err == nil {
+			err = b.processCatchUpSignedMessage(msg, ethSignatures[i])
+		}
+		if err != nil {
+			b.log.Trace("dropped catch-up signed message", "msg.Nonce", msg.Nonce, "error", err)
+		}
+	}
+
+	return nil
+}
+
+func (b *broadcaster) verifyCatchUpSignedMessage(msg *core.SignedMessage, message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) (*core.EthereumSignature, error) {
+	err := b.verifySignedMessage(msg, message, fromConnectedPeer)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.getEthereumSignature(msg)
+}
+
+func (b *broadcaster) processCatchUpSignedMessage(msg *core.SignedMessage, ethSignature *core.EthereumSignature) error {
+	addr := data.NewAddressFromBytes(msg.PublicKeyBytes)
+	if !b.multiversRoleProvider.IsWhitelisted(addr) {
+		b.topicsMetrics.AddRejected(b.catchUpTopicName)
+		return fmt.Errorf("%w for peer: %s", ErrPeerNotWhitelisted, hex.EncodeToString(msg.PublicKeyBytes))
+	}
+
+	err := b.processNonce(msg)
+	if err != nil {
+		return err
+	}
+
+	b.notifyClients(msg, ethSignature)
+
+	return nil
+}
+
 func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.EthereumSignature, error) {
 	ethSignature := &core.EthereumSignature{}
 	err := b.marshalizer.Unmarshal(ethSignature, msg.Payload)
@@ -194,7 +422,10 @@ func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.Ether
 		return nil, err
 	}
 
-	err = b.signatureProcessor.VerifyEthSignature(ethSignature.Signature, ethSignature.MessageHash)
+	key := createVerificationKey(ethereumSignatureKind, ethSignature.MessageHash, ethSignature.Signature)
+	err = b.verifier.verify(key, func() error {
+		return b.signatureProcessor.VerifyEthSignature(ethSignature.Signature, ethSignature.MessageHash)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -213,6 +444,13 @@ func (b *broadcaster) processSignMessage(msg *core.SignedMessage) {
 }
 
 func (b *broadcaster) notifyClients(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+	if b.multiversRoleProvider.IsOnProbation(msg.PublicKeyBytes) {
+		// the signature was verified but it is not counted towards the quorum
+		b.log.Debug("dropped the signature of a relayer on probation",
+			"public key", hex.EncodeToString(msg.PublicKeyBytes), "message hash", hex.EncodeToString(ethMsg.MessageHash))
+		return
+	}
+
 	b.mutClients.RLock()
 	defer b.mutClients.RUnlock()
 
@@ -221,6 +459,32 @@ func (b *broadcaster) notifyClients(msg *core.SignedMessage, ethMsg *core.Ethere
 	}
 }
 
+func (b *broadcaster) notifyPreAgreementClients(msg *core.SignedMessage) {
+	b.mutClients.RLock()
+	defer b.mutClients.RUnlock()
+
+	for _, client := range b.preAgreementClients {
+		client.ProcessPreAgreementMessage(msg)
+	}
+}
+
+func (b *broadcaster) notifyDirectMessageClients(msg *core.SignedMessage) {
+	b.mutClients.RLock()
+	defer b.mutClients.RUnlock()
+
+	for _, client := range b.directMessageClients {
+		client.ProcessDirectMessage(msg)
+	}
+}
+
+// recordPeerOfRelayer remembers the peer that originated the last valid message signed by the provided relayer, so
+// the direct messages can be sent to that relayer
+func (b *broadcaster) recordPeerOfRelayer(publicKey []byte, peer chainCore.PeerID) {
+	b.mutPeers.Lock()
+	b.peersOfRelayer[string(publicKey)] = peer
+	b.mutPeers.Unlock()
+}
+
 func (b *broadcaster) broadcastCurrentSignatures(peerId chainCore.PeerID) error {
 	allMessages := b.retrieveUniqueMessages()
 
@@ -235,6 +499,67 @@ func (b *broadcaster) broadcastCurrentSignatures(peerId chainCore.PeerID) error
 	return nil
 }
 
+// sendCurrentSignaturesInBatches sends all the stored signatures to the provided peer in pages of at most
+// maxMessagesInCatchUpPage messages. The messages are sorted by public key and nonce so the receiver processes the
+// nonces of each relayer in ascending order
+func (b *broadcaster) sendCurrentSignaturesInBatches(peerId chainCore.PeerID) {
+	allMessages := b.retrieveUniqueMessages()
+	messages := make([]*core.SignedMessage, 0, len(allMessages))
+	for _, msg := range allMessages {
+		messages = append(messages, msg)
+	}
+	if len(messages) == 0 {
+		return
+	}
+
+	sort.Slice(messages, func(i, j int) bool {
+		compareResult := bytes.Compare(messages[i].PublicKeyBytes, messages[j].PublicKeyBytes)
+		if compareResult != 0 {
+			return compareResult < 0
+		}
+
+		return messages[i].Nonce < messages[j].Nonce
+	})
+
+	numPages := (len(messages) + maxMessagesInCatchUpPage - 1) / maxMessagesInCatchUpPage
+	for page := 0; page < numPages; page++ {
+		end := (page + 1) * maxMessagesInCatchUpPage
+		if end > len(messages) {
+			end = len(messages)
+		}
+
+		batch := &core.SignedMessagesBatch{
+			Messages: messages[page*maxMessagesInCatchUpPage : end],
+			Page:     uint32(page),
+			NumPages: uint32(numPages),
+		}
+		err := b.sendCatchUpMessageToPeer(batch, peerId)
+		if err != nil {
+			b.log.Debug("error sending current stored signatures in batches",
+				"error", err.Error(), "peer", peerId.Pretty(), "page", page)
+		}
+	}
+
+	b.log.Debug("sent the stored signatures in batches", "peer", peerId.Pretty(),
+		"num messages", len(messages), "num pages", numPages)
+}
+
+func (b *broadcaster) sendCatchUpMessageToPeer(batch *core.SignedMessagesBatch, peerId chainCore.PeerID) error {
+	buff, err := b.marshalMessage(batch)
+	if err != nil {
+		return err
+	}
+
+	err = b.messenger.SendToConnectedPeer(b.catchUpTopicName, buff, peerId)
+	if err != nil {
+		return err
+	}
+
+	b.topicsMetrics.AddSent(b.catchUpTopicName)
+
+	return nil
+}
+
 func (b *broadcaster) retrieveUniqueMessages() map[string]*core.SignedMessage {
 	allMessages := make(map[string]*core.SignedMessage)
 	for _, client := range b.clients {
@@ -248,12 +573,19 @@ func (b *broadcaster) retrieveUniqueMessages() map[string]*core.SignedMessage {
 }
 
 func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId chainCore.PeerID) error {
-	buff, err := b.marshalizer.Marshal(msg)
+	buff, err := b.marshalMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	err = b.messenger.SendToConnectedPeer(b.signTopicName, buff, peerId)
 	if err != nil {
 		return err
 	}
 
-	return b.messenger.SendToConnectedPeer(b.signTopicName, buff, peerId)
+	b.topicsMetrics.AddSent(b.signTopicName)
+
+	return nil
 }
 
 // BroadcastSignature will send the provided signature as payload in a wrapped signed message to the other peers.
@@ -278,28 +610,89 @@ func (b *broadcaster) BroadcastSignature(signature []byte, messageHash []byte) {
 // BroadcastJoinTopic will send the provided signature as payload in a wrapped signed message to the other peers.
 // It will broadcast the message to all available peers
 func (b *broadcaster) BroadcastJoinTopic() {
-	err := b.broadcastMessage([]byte(joinTopicMessage), b.joinTopicName)
+	hashes := [][]byte{b.policyHash, b.roundingPolicyHash, b.recipientRulesHash}
+	numHashes := len(hashes)
+	for numHashes > 0 && len(hashes[numHashes-1]) == 0 {
+		numHashes--
+	}
+
+	payload := joinTopicBatchedMessage
+	for _, hash := range hashes[:numHashes] {
+		payload += policyHashSeparator + hex.EncodeToString(hash)
+	}
+
+	err := b.broadcastMessage([]byte(payload), b.joinTopicName)
 	if err != nil {
 		b.log.Error("error sending signature", "error", err)
 	}
 }
 
+// BroadcastPreAgreementMessage will send the provided pre-agreement payload in a wrapped signed message to the other
+// peers
+func (b *broadcaster) BroadcastPreAgreementMessage(payload []byte) {
+	err := b.broadcastMessage(payload, b.preAgreementTopicName)
+	if err != nil {
+		b.log.Error("error sending pre-agreement message", "error", err)
+	}
+}
+
+// SendDirectMessage will send the provided payload in a wrapped signed message only to the relayer owning the provided
+// public key, on a direct stream to its peer. The relayer must be connected and must have sent at least one message
+// since this relayer started
+func (b *broadcaster) SendDirectMessage(payload []byte, publicKey []byte) error {
+	b.mutPeers.RLock()
+	peer, found := b.peersOfRelayer[string(publicKey)]
+	b.mutPeers.RUnlock()
+	if !found {
+		return fmt.Errorf("%w for public key %s", ErrUnknownRelayerPeer, hex.EncodeToString(publicKey))
+	}
+
+	msg, err := b.createMessage(payload)
+	if err != nil {
+		return err
+	}
+
+	buff, err := b.marshalMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	err = b.messenger.SendToConnectedPeer(b.directTopicName, buff, peer)
+	if err != nil {
+		return err
+	}
+
+	b.topicsMetrics.AddSent(b.directTopicName)
+
+	return nil
+}
+
 func (b *broadcaster) broadcastMessage(payload []byte, topic string) error {
 	msg, err := b.createMessage(payload)
 	if err != nil {
 		return err
 	}
 
-	buff, err := b.marshalizer.Marshal(msg)
+	buff, err := b.marshalMessage(msg)
 	if err != nil {
 		return err
 	}
 
 	b.messenger.Broadcast(topic, buff)
+	b.topicsMetrics.AddSent(topic)
 
 	return nil
 }
 
+func (b *broadcaster) marshalMessage(msg interface{}) ([]byte, error) {
+	buff, err := b.marshalizer.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.compressor.encode(buff)
+}
+
 // AddBroadcastClient will add a client to the list so it can be notified of the newly received
 // messages
 func (b *broadcaster) AddBroadcastClient(client core.BroadcastClient) error {
@@ -314,8 +707,37 @@ func (b *broadcaster) AddBroadcastClient(client core.BroadcastClient) error {
 	return nil
 }
 
+// AddPreAgreementClient will add a client to the list so it can be notified of the newly received pre-agreement
+// messages
+func (b *broadcaster) AddPreAgreementClient(client core.PreAgreementClient) error {
+	if check.IfNil(client) {
+		return ErrNilPreAgreementClient
+	}
+
+	b.mutClients.Lock()
+	b.preAgreementClients = append(b.preAgreementClients, client)
+	b.mutClients.Unlock()
+
+	return nil
+}
+
+// AddDirectMessageClient will add a client to the list so it can be notified of the newly received direct messages
+func (b *broadcaster) AddDirectMessageClient(client core.DirectMessageClient) error {
+	if check.IfNil(client) {
+		return ErrNilDirectMessageClient
+	}
+
+	b.mutClients.Lock()
+	b.directMessageClients = append(b.directMessageClients, client)
+	b.mutClients.Unlock()
+
+	return nil
+}
+
 // Close will close any containing members and clean any go routines associated
 func (b *broadcaster) Close() error {
+	b.verifier.close()
+
 	return b.messenger.Close()
 }
 
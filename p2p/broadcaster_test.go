@@ -1,10 +1,13 @@
 package p2p
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"errors"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
@@ -40,6 +43,8 @@ func createMockArgsBroadcaster() ArgsBroadcaster {
 		SignatureProcessor:     &testsCommon.SignatureProcessorStub{},
 		Name:                   "test",
 		AntifloodComponents:    ac,
+		PeersRecorder:          &p2pMocks.PeersRecorderStub{},
+		TopicsMetrics:          NewTopicsMetrics(),
 	}
 }
 
@@ -135,6 +140,30 @@ func TestNewBroadcaster(t *testing.T) {
 		assert.True(t, check.IfNil(b))
 		assert.Equal(t, ErrNilAntifloodComponents, err)
 	})
+	t.Run("nil peers recorder should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.PeersRecorder = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilPeersRecorder, err)
+	})
+	t.Run("nil topics metrics should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.TopicsMetrics = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilTopicsMetrics, err)
+	})
+	t.Run("invalid number of verification workers should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.NumVerificationWorkers = -1
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.True(t, errors.Is(err, ErrInvalidNumVerificationWorkers))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 
@@ -194,7 +223,8 @@ func TestBroadcaster_RegisterOnTopics(t *testing.T) {
 		err := b.RegisterOnTopics()
 
 		require.Nil(t, err)
-		topics := []string{args.Name + joinTopicSuffix, args.Name + signTopicSuffix}
+		topics := []string{args.Name + joinTopicSuffix, args.Name + signTopicSuffix, args.Name + catchUpTopicSuffix,
+			args.Name + preAgreementTopicSuffix, args.Name + directTopicSuffix}
 		for _, topic := range topics {
 			assert.Equal(t, 1, createTopics[topic])
 			assert.Equal(t, 1, register[topic])
@@ -231,12 +261,123 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 
 		b, _ := NewBroadcaster(args)
 		p2pMsg := &p2pMocks.P2PMessageMock{
-			DataField: buff,
+			DataField:  buff,
+			TopicField: b.signTopicName,
 		}
 
 		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
 		assert.True(t, errors.Is(err, ErrPeerNotWhitelisted))
 		assert.True(t, isWhiteListedCalled)
+
+		counters := args.TopicsMetrics.GetCounters()[b.signTopicName]
+		assert.Equal(t, uint64(1), counters.NumReceived)
+		assert.Equal(t, uint64(1), counters.NumRejected)
+	})
+	t.Run("valid message should record the originator peer", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		_, buff := createSignedMessageForEthSig(0)
+		recordedPeers := make([]chainCore.PeerID, 0)
+		args.PeersRecorder = &p2pMocks.PeersRecorderStub{
+			AddPeerCalled: func(pid chainCore.PeerID) {
+				recordedPeers = append(recordedPeers, pid)
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + signTopicSuffix,
+			PeerField:  "originator",
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []chainCore.PeerID{"originator"}, recordedPeers)
+	})
+	t.Run("pre-agreement message should notify the pre-agreement clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg, buff := createSignedMessageAndMarshaledBytes(0)
+		notifiedMessages := make([]*core.SignedMessage, 0)
+		client := &testsCommon.PreAgreementClientStub{
+			ProcessPreAgreementMessageCalled: func(m *core.SignedMessage) {
+				notifiedMessages = append(notifiedMessages, m)
+			},
+		}
+		broadcastClient := &testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				assert.Fail(t, "should have not called ProcessNewMessage")
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		err := b.AddPreAgreementClient(client)
+		require.Nil(t, err)
+		err = b.AddBroadcastClient(broadcastClient)
+		require.Nil(t, err)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + preAgreementTopicSuffix,
+			PeerField:  "originator",
+		}
+
+		err = b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.SignedMessage{msg}, notifiedMessages)
+	})
+	t.Run("direct message should notify the direct message clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg, buff := createSignedMessageAndMarshaledBytes(0)
+		notifiedMessages := make([]*core.SignedMessage, 0)
+		client := &testsCommon.DirectMessageClientStub{
+			ProcessDirectMessageCalled: func(m *core.SignedMessage) {
+				notifiedMessages = append(notifiedMessages, m)
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		err := b.AddDirectMessageClient(client)
+		require.Nil(t, err)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + directTopicSuffix,
+			PeerField:  "originator",
+		}
+
+		err = b.ProcessReceivedMessage(p2pMsg, "originator", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.SignedMessage{msg}, notifiedMessages)
+
+		// the direct messages nonces do not interfere with the broadcast messages nonces
+		p2pMsg.TopicField = args.Name + preAgreementTopicSuffix
+		err = b.ProcessReceivedMessage(p2pMsg, "originator", nil)
+		assert.Nil(t, err)
+
+		p2pMsg.TopicField = args.Name + directTopicSuffix
+		err = b.ProcessReceivedMessage(p2pMsg, "originator", nil)
+		assert.Equal(t, ErrNonceTooLowInReceivedMessage, err)
+		assert.Equal(t, 1, len(notifiedMessages))
+	})
+	t.Run("relayed direct message should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		_, buff := createSignedMessageAndMarshaledBytes(0)
+		client := &testsCommon.DirectMessageClientStub{
+			ProcessDirectMessageCalled: func(m *core.SignedMessage) {
+				assert.Fail(t, "should have not called ProcessDirectMessage")
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		err := b.AddDirectMessageClient(client)
+		require.Nil(t, err)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + directTopicSuffix,
+			PeerField:  "originator",
+		}
+
+		err = b.ProcessReceivedMessage(p2pMsg, "relayer", nil)
+		assert.True(t, errors.Is(err, ErrRelayedDirectMessage))
+		assert.Equal(t, uint64(1), args.TopicsMetrics.GetCounters()[args.Name+directTopicSuffix].NumRejected)
 	})
 	t.Run("invalid nonce should error", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
@@ -316,6 +457,316 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 
 		assert.Equal(t, [][]byte{msg1.PublicKeyBytes, msg2.PublicKeyBytes}, b.SortedPublicKeys())
 	})
+	t.Run("joined topic with the batched message should send stored messages in pages", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		storedMessages := make([]*core.SignedMessage, 0)
+		for i := 0; i < maxMessagesInCatchUpPage+10; i++ {
+			msg, _ := createSignedMessageForEthSig(i)
+			storedMessages = append(storedMessages, msg)
+		}
+
+		client := &testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return storedMessages
+			},
+		}
+
+		sentBatches := make([]*core.SignedMessagesBatch, 0)
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				assert.Equal(t, args.Name+catchUpTopicSuffix, topic)
+				assert.Equal(t, pid, peerID)
+
+				batch := &core.SignedMessagesBatch{}
+				err := marshalizer.Unmarshal(batch, buff)
+				require.Nil(t, err)
+				sentBatches = append(sentBatches, batch)
+
+				return nil
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		err := b.AddBroadcastClient(client)
+		require.Nil(t, err)
+
+		joinMsg := &core.SignedMessage{
+			Payload:        []byte(joinTopicBatchedMessage),
+			PublicKeyBytes: []byte("pk join"),
+			Signature:      []byte("sig join"),
+			Nonce:          34,
+		}
+		buff, _ := marshalizer.Marshal(joinMsg)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + joinTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err = b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		require.Equal(t, 2, len(sentBatches))
+		assert.Equal(t, maxMessagesInCatchUpPage, len(sentBatches[0].Messages))
+		assert.Equal(t, 10, len(sentBatches[1].Messages))
+		assert.Equal(t, uint32(0), sentBatches[0].Page)
+		assert.Equal(t, uint32(1), sentBatches[1].Page)
+		assert.Equal(t, uint32(2), sentBatches[1].NumPages)
+		assert.Equal(t, []byte("pk 0"), sentBatches[0].Messages[0].PublicKeyBytes)
+	})
+	t.Run("joined topic with a different policy hash should warn and send the stored messages", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.PolicyHash = []byte("own policy hash")
+		numWarnings := 0
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				numWarnings++
+			},
+		}
+		storedMessage, _ := createSignedMessageForEthSig(0)
+		client := &testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return []*core.SignedMessage{storedMessage}
+			},
+		}
+		numSentBatches := 0
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				numSentBatches++
+				return nil
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		err := b.AddBroadcastClient(client)
+		require.Nil(t, err)
+
+		sendJoin := func(payload string, nonce uint64) {
+			joinMsg := &core.SignedMessage{
+				Payload:        []byte(payload),
+				PublicKeyBytes: []byte("pk join"),
+				Signature:      []byte("sig join"),
+				Nonce:          nonce,
+			}
+			buff, _ := marshalizer.Marshal(joinMsg)
+			p2pMsg := &p2pMocks.P2PMessageMock{
+				DataField:  buff,
+				TopicField: args.Name + joinTopicSuffix,
+				PeerField:  pid,
+			}
+
+			err = b.ProcessReceivedMessage(p2pMsg, "", nil)
+			assert.Nil(t, err)
+		}
+
+		sendJoin(joinTopicBatchedMessage+policyHashSeparator+hex.EncodeToString(args.PolicyHash), 34)
+		assert.Equal(t, 0, numWarnings)
+		assert.Equal(t, 1, numSentBatches)
+
+		sendJoin(joinTopicBatchedMessage+policyHashSeparator+hex.EncodeToString([]byte("other policy hash")), 35)
+		assert.Equal(t, 1, numWarnings)
+		assert.Equal(t, 2, numSentBatches)
+
+		sendJoin(joinTopicBatchedMessage, 36)
+		assert.Equal(t, 2, numWarnings)
+		assert.Equal(t, 3, numSentBatches)
+	})
+	t.Run("joined topic with a different rounding policy hash should warn and send the stored messages", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.PolicyHash = []byte("own policy hash")
+		args.RoundingPolicyHash = []byte("own rounding policy hash")
+		warnings := make([]string, 0)
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				warnings = append(warnings, message)
+			},
+		}
+		client := &testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return make([]*core.SignedMessage, 0)
+			},
+		}
+		b, _ := NewBroadcaster(args)
+		err := b.AddBroadcastClient(client)
+		require.Nil(t, err)
+
+		sendJoin := func(roundingPolicyHash []byte, nonce uint64) {
+			payload := joinTopicBatchedMessage + policyHashSeparator + hex.EncodeToString(args.PolicyHash) +
+				policyHashSeparator + hex.EncodeToString(roundingPolicyHash)
+			joinMsg := &core.SignedMessage{
+				Payload:        []byte(payload),
+				PublicKeyBytes: []byte("pk join"),
+				Signature:      []byte("sig join"),
+				Nonce:          nonce,
+			}
+			buff, _ := marshalizer.Marshal(joinMsg)
+			p2pMsg := &p2pMocks.P2PMessageMock{
+				DataField:  buff,
+				TopicField: args.Name + joinTopicSuffix,
+				PeerField:  pid,
+			}
+
+			err = b.ProcessReceivedMessage(p2pMsg, "", nil)
+			assert.Nil(t, err)
+		}
+
+		sendJoin(args.RoundingPolicyHash, 34)
+		assert.Empty(t, warnings)
+
+		sendJoin([]byte("other rounding policy hash"), 35)
+		assert.Equal(t, []string{"relayer with a different rounding policy joined"}, warnings)
+	})
+	t.Run("joined topic with different recipient rules hash should warn and send the stored messages", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.RecipientRulesHash = []byte("own recipient rules hash")
+		warnings := make([]string, 0)
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				warnings = append(warnings, message)
+			},
+		}
+		numSentBatches := 0
+		client := &testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				numSentBatches++
+				return make([]*core.SignedMessage, 0)
+			},
+		}
+		b, _ := NewBroadcaster(args)
+		err := b.AddBroadcastClient(client)
+		require.Nil(t, err)
+
+		sendJoin := func(recipientRulesHash []byte, nonce uint64) {
+			payload := joinTopicBatchedMessage + policyHashSeparator + policyHashSeparator +
+				policyHashSeparator + hex.EncodeToString(recipientRulesHash)
+			joinMsg := &core.SignedMessage{
+				Payload:        []byte(payload),
+				PublicKeyBytes: []byte("pk join"),
+				Signature:      []byte("sig join"),
+				Nonce:          nonce,
+			}
+			buff, _ := marshalizer.Marshal(joinMsg)
+			p2pMsg := &p2pMocks.P2PMessageMock{
+				DataField:  buff,
+				TopicField: args.Name + joinTopicSuffix,
+				PeerField:  pid,
+			}
+
+			err = b.ProcessReceivedMessage(p2pMsg, "", nil)
+			assert.Nil(t, err)
+		}
+
+		sendJoin(args.RecipientRulesHash, 34)
+		assert.Empty(t, warnings)
+		assert.Equal(t, 1, numSentBatches)
+
+		sendJoin([]byte("other recipient rules hash"), 35)
+		assert.Equal(t, []string{"relayer with different recipient rules joined"}, warnings)
+		assert.Equal(t, 2, numSentBatches)
+	})
+	t.Run("catch-up message should process all the signed messages", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, _ := createSignedMessageForEthSig(0)
+		msg2, _ := createSignedMessageForEthSig(1)
+		notWhitelistedMsg, _ := createSignedMessageForEthSig(2)
+		args.MultiversXRoleProvider = &roleProvidersMock.MultiversXRoleProviderStub{
+			IsWhitelistedCalled: func(address sdkCore.AddressHandler) bool {
+				return string(address.AddressBytes()) != string(notWhitelistedMsg.PublicKeyBytes)
+			},
+		}
+
+		processedMessages := make([]*core.SignedMessage, 0)
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				processedMessages = append(processedMessages, msg)
+			},
+		})
+
+		buff, _ := marshalizer.Marshal(&core.SignedMessagesBatch{
+			Messages: []*core.SignedMessage{msg1, notWhitelistedMsg, msg2},
+			NumPages: 1,
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + catchUpTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.SignedMessage{msg1, msg2}, processedMessages)
+	})
+	t.Run("catch-up message should drop the messages with invalid Ethereum signatures", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, _ := createSignedMessageForEthSig(0)
+		msg2, _ := createSignedMessageForEthSig(1)
+		numVerifications := uint32(0)
+		args.SignatureProcessor = &testsCommon.SignatureProcessorStub{
+			VerifyEthSignatureCalled: func(signature []byte, messageHash []byte) error {
+				atomic.AddUint32(&numVerifications, 1)
+				if string(signature) == "eth sig 0" {
+					return errors.New("invalid signature")
+				}
+
+				return nil
+			},
+		}
+
+		processedMessages := make([]*core.SignedMessage, 0)
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				processedMessages = append(processedMessages, msg)
+			},
+		})
+
+		buff, _ := marshalizer.Marshal(&core.SignedMessagesBatch{
+			Messages: []*core.SignedMessage{msg1, msg2},
+			NumPages: 1,
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + catchUpTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.SignedMessage{msg2}, processedMessages)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numVerifications))
+	})
+	t.Run("catch-up message with too many messages should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		messages := make([]*core.SignedMessage, 0)
+		for i := 0; i <= maxMessagesInCatchUpPage; i++ {
+			msg, _ := createSignedMessageAndMarshaledBytes(i)
+			messages = append(messages, msg)
+		}
+
+		b, _ := NewBroadcaster(args)
+		buff, _ := marshalizer.Marshal(&core.SignedMessagesBatch{
+			Messages: messages,
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + catchUpTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.True(t, errors.Is(err, ErrInvalidSize))
+	})
+	t.Run("catch-up message with invalid data should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+
+		b, _ := NewBroadcaster(args)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  []byte("gibberish"),
+			TopicField: args.Name + catchUpTopicSuffix,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.NotNil(t, err)
+	})
 	t.Run("not a valid signature as payload (unmarshalled failed) should add the message's nonce", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		_, buff1 := createSignedMessageAndMarshaledBytes(0)
@@ -465,6 +916,37 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		assert.Equal(t, [][]byte{msg1.PublicKeyBytes, msg2.PublicKeyBytes}, b.SortedPublicKeys())
 		assert.Equal(t, []*core.SignedMessage{msg2, msg1}, processedMessages)
 	})
+	t.Run("sign from a relayer on probation should not notify the clients", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, buff1 := createSignedMessageForEthSig(0)
+		msg2, buff2 := createSignedMessageForEthSig(1)
+		args.Messenger = &p2pMocks.MessengerStub{}
+		args.MultiversXRoleProvider = &roleProvidersMock.MultiversXRoleProviderStub{
+			IsOnProbationCalled: func(address []byte) bool {
+				return bytes.Equal(address, msg1.PublicKeyBytes)
+			},
+		}
+
+		processedMessages := make([]*core.SignedMessage, 0)
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				processedMessages = append(processedMessages, msg)
+			},
+		})
+
+		for _, buff := range [][]byte{buff1, buff2} {
+			p2pMsg := &p2pMocks.P2PMessageMock{
+				DataField:  buff,
+				TopicField: args.Name + signTopicSuffix,
+			}
+
+			err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+			assert.Nil(t, err)
+		}
+
+		assert.Equal(t, []*core.SignedMessage{msg2}, processedMessages)
+	})
 }
 
 func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
@@ -487,7 +969,89 @@ func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
 			err := marshalizer.Unmarshal(msg, buff)
 			require.Nil(t, err)
 			assert.Equal(t, sig, msg.Signature)
-			assert.Equal(t, []byte(joinTopicMessage), msg.Payload)
+			assert.Equal(t, []byte(joinTopicBatchedMessage), msg.Payload)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.BroadcastJoinTopic()
+	assert.True(t, broadcastCalled)
+	assert.Equal(t, uint64(1), args.TopicsMetrics.GetCounters()[args.Name+joinTopicSuffix].NumSent)
+}
+
+func TestBroadcaster_BroadcastJoinTopicWithPolicyHash(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	args := createMockArgsBroadcaster()
+	args.PolicyHash = []byte("policy hash")
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+
+			payload, policyHash, roundingPolicyHash, recipientRulesHash := splitJoinPayload(string(msg.Payload))
+			assert.Equal(t, joinTopicBatchedMessage, payload)
+			assert.Equal(t, hex.EncodeToString(args.PolicyHash), policyHash)
+			assert.Empty(t, roundingPolicyHash)
+			assert.Empty(t, recipientRulesHash)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.BroadcastJoinTopic()
+	assert.True(t, broadcastCalled)
+}
+
+func TestBroadcaster_BroadcastJoinTopicWithRoundingPolicyHash(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	args := createMockArgsBroadcaster()
+	args.RoundingPolicyHash = []byte("rounding policy hash")
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+
+			payload, policyHash, roundingPolicyHash, recipientRulesHash := splitJoinPayload(string(msg.Payload))
+			assert.Equal(t, joinTopicBatchedMessage, payload)
+			assert.Empty(t, policyHash)
+			assert.Equal(t, hex.EncodeToString(args.RoundingPolicyHash), roundingPolicyHash)
+			assert.Empty(t, recipientRulesHash)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.BroadcastJoinTopic()
+	assert.True(t, broadcastCalled)
+}
+
+func TestBroadcaster_BroadcastJoinTopicWithRecipientRulesHash(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	args := createMockArgsBroadcaster()
+	args.RecipientRulesHash = []byte("recipient rules hash")
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+
+			payload, policyHash, roundingPolicyHash, recipientRulesHash := splitJoinPayload(string(msg.Payload))
+			assert.Equal(t, joinTopicBatchedMessage, payload)
+			assert.Empty(t, policyHash)
+			assert.Empty(t, roundingPolicyHash)
+			assert.Equal(t, hex.EncodeToString(args.RecipientRulesHash), recipientRulesHash)
 		},
 	}
 	b, _ := NewBroadcaster(args)
@@ -533,6 +1097,98 @@ func TestBroadcaster_BroadcastSignature(t *testing.T) {
 	assert.True(t, broadcastCalled)
 }
 
+func TestBroadcaster_BroadcastPreAgreementMessage(t *testing.T) {
+	t.Parallel()
+
+	broadcastCalled := false
+	payload := []byte("pre-agreement payload")
+	args := createMockArgsBroadcaster()
+	args.Messenger = &p2pMocks.MessengerStub{
+		BroadcastCalled: func(topic string, buff []byte) {
+			broadcastCalled = true
+			assert.Equal(t, args.Name+preAgreementTopicSuffix, topic)
+
+			msg := &core.SignedMessage{}
+			err := marshalizer.Unmarshal(msg, buff)
+			require.Nil(t, err)
+			assert.Equal(t, payload, msg.Payload)
+		},
+	}
+	b, _ := NewBroadcaster(args)
+
+	b.BroadcastPreAgreementMessage(payload)
+	assert.True(t, broadcastCalled)
+	assert.Equal(t, uint64(1), args.TopicsMetrics.GetCounters()[args.Name+preAgreementTopicSuffix].NumSent)
+}
+
+func TestBroadcaster_SendDirectMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown peer of the relayer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				assert.Fail(t, "should have not called SendToConnectedPeer")
+				return nil
+			},
+		}
+		b, _ := NewBroadcaster(args)
+
+		err := b.SendDirectMessage([]byte("payload"), []byte("pk 0"))
+		assert.True(t, errors.Is(err, ErrUnknownRelayerPeer))
+	})
+	t.Run("send errors should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		expectedErr := errors.New("expected error")
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				return expectedErr
+			},
+		}
+		b, _ := NewBroadcaster(args)
+		b.recordPeerOfRelayer([]byte("pk 0"), "originator")
+
+		err := b.SendDirectMessage([]byte("payload"), []byte("pk 0"))
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, uint64(0), args.TopicsMetrics.GetCounters()[args.Name+directTopicSuffix].NumSent)
+	})
+	t.Run("should send to the peer of the relayer", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		payload := []byte("direct payload")
+		sendCalled := false
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				sendCalled = true
+				assert.Equal(t, args.Name+directTopicSuffix, topic)
+				assert.Equal(t, chainCore.PeerID("originator"), peerID)
+
+				msg := &core.SignedMessage{}
+				err := marshalizer.Unmarshal(msg, buff)
+				require.Nil(t, err)
+				assert.Equal(t, payload, msg.Payload)
+
+				return nil
+			},
+		}
+		b, _ := NewBroadcaster(args)
+
+		// the peer of the relayer is learned from its messages
+		_, buff := createSignedMessageAndMarshaledBytes(0)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + preAgreementTopicSuffix,
+			PeerField:  "originator",
+		}
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		require.Nil(t, err)
+
+		err = b.SendDirectMessage(payload, []byte("pk 0"))
+		assert.Nil(t, err)
+		assert.True(t, sendCalled)
+		assert.Equal(t, uint64(1), args.TopicsMetrics.GetCounters()[args.Name+directTopicSuffix].NumSent)
+	})
+}
+
 func TestBroadcaster_Close(t *testing.T) {
 	t.Parallel()
 
@@ -561,6 +1217,26 @@ func TestBroadcaster_AddBroadcastClientNilClient(t *testing.T) {
 	assert.Equal(t, ErrNilBroadcastClient, err)
 }
 
+func TestBroadcaster_AddPreAgreementClientNilClient(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBroadcaster()
+	b, _ := NewBroadcaster(args)
+
+	err := b.AddPreAgreementClient(nil)
+	assert.Equal(t, ErrNilPreAgreementClient, err)
+}
+
+func TestBroadcaster_AddDirectMessageClientNilClient(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsBroadcaster()
+	b, _ := NewBroadcaster(args)
+
+	err := b.AddDirectMessageClient(nil)
+	assert.Equal(t, ErrNilDirectMessageClient, err)
+}
+
 func TestBroadcaster_ShouldFilterIdenticalMessages(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +38,9 @@ var ErrEmptyName = errors.New("empty name")
 // ErrNilBroadcastClient signals that a nil broadcast client was provided
 var ErrNilBroadcastClient = errors.New("nil broadcast client")
 
+// ErrNilPreAgreementClient signals that a nil pre-agreement client was provided
+var ErrNilPreAgreementClient = errors.New("nil pre-agreement client")
+
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
 
@@ -52,3 +55,48 @@ var ErrNilBlackListIDsCache = errors.New("nil blacklist cache")
 
 // ErrNilBlackListedPublicKeysCache signals that a nil blacklist public keys cache was provided
 var ErrNilBlackListedPublicKeysCache = errors.New("nil blacklist public keys cache")
+
+// ErrNoTransportEnabled signals that no P2P transport was enabled
+var ErrNoTransportEnabled = errors.New("no P2P transport enabled")
+
+// ErrInvalidTransportAddress signals that an invalid P2P transport listen address was provided
+var ErrInvalidTransportAddress = errors.New("invalid P2P transport listen address")
+
+// ErrInvalidCompressionType signals that an invalid messages compression type was provided
+var ErrInvalidCompressionType = errors.New("invalid messages compression type")
+
+// ErrInvalidCompressionThreshold signals that an invalid messages compression threshold was provided
+var ErrInvalidCompressionThreshold = errors.New("invalid messages compression threshold")
+
+// ErrInvalidEnvelope signals that a received message envelope can not be decoded
+var ErrInvalidEnvelope = errors.New("invalid message envelope")
+
+// ErrNilPeersRecorder signals that a nil peers recorder was provided
+var ErrNilPeersRecorder = errors.New("nil peers recorder")
+
+// ErrEmptyFilePath signals that an empty file path was provided
+var ErrEmptyFilePath = errors.New("empty file path")
+
+// ErrInvalidMaxPeers signals that an invalid maximum number of peers was provided
+var ErrInvalidMaxPeers = errors.New("invalid maximum number of peers")
+
+// ErrInvalidNumVerificationWorkers signals that an invalid number of signatures verification workers was provided
+var ErrInvalidNumVerificationWorkers = errors.New("invalid number of signatures verification workers")
+
+// ErrInvalidVerifiedSignaturesCacheSize signals that an invalid verified signatures cache size was provided
+var ErrInvalidVerifiedSignaturesCacheSize = errors.New("invalid verified signatures cache size")
+
+// ErrSignaturesVerifierClosed signals that the signatures verifier was closed
+var ErrSignaturesVerifierClosed = errors.New("signatures verifier closed")
+
+// ErrNilTopicsMetrics signals that a nil topics metrics component was provided
+var ErrNilTopicsMetrics = errors.New("nil topics metrics")
+
+// ErrNilDirectMessageClient signals that a nil direct message client was provided
+var ErrNilDirectMessageClient = errors.New("nil direct message client")
+
+// ErrRelayedDirectMessage signals that a direct message was not received from the peer that originated it
+var ErrRelayedDirectMessage = errors.New("relayed direct message")
+
+// ErrUnknownRelayerPeer signals that no peer is known for the relayer a direct message should be sent to
+var ErrUnknownRelayerPeer = errors.New("unknown peer of the relayer")
@@ -20,6 +20,9 @@ type NetMessenger interface {
 	SendToConnectedPeer(topic string, buff []byte, peerID chainCore.PeerID) error
 	SetPeerDenialEvaluator(handler p2p.PeerDenialEvaluator) error
 	ConnectedAddresses() []string
+	ConnectedPeersOnTopic(topic string) []chainCore.PeerID
+	PeerAddresses(pid chainCore.PeerID) []string
+	ConnectToPeer(address string) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -27,6 +30,7 @@ type NetMessenger interface {
 // MultiversXRoleProvider defines the operations for an MultiversX role provider
 type MultiversXRoleProvider interface {
 	IsWhitelisted(address sdkCore.AddressHandler) bool
+	IsOnProbation(address []byte) bool
 	IsInterfaceNil() bool
 }
 
@@ -42,3 +46,19 @@ type PeerDenialEvaluator interface {
 	UpsertPeerID(pid chainCore.PeerID, duration time.Duration) error
 	IsInterfaceNil() bool
 }
+
+// PeersRecorder defines the component notified about the peers sending valid messages from whitelisted relayers
+type PeersRecorder interface {
+	AddPeer(pid chainCore.PeerID)
+	IsInterfaceNil() bool
+}
+
+// TopicsMetrics defines the component counting the messages sent, received and rejected on each p2p topic
+type TopicsMetrics interface {
+	RegisterTopic(topic string)
+	AddReceived(topic string)
+	AddSent(topic string)
+	AddRejected(topic string)
+	GetCounters() map[string]TopicCounters
+	IsInterfaceNil() bool
+}
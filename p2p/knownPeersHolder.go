@@ -0,0 +1,247 @@
+package p2p
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	knownPeersFilePermissions = 0644
+	knownPeersDirPermissions  = 0755
+	peerIDAddressComponent    = "/p2p/"
+)
+
+// ArgsKnownPeersHolder is the DTO used to create a new instance of type knownPeersHolder
+type ArgsKnownPeersHolder struct {
+	Messenger NetMessenger
+	Log       logger.Logger
+	FilePath  string
+	MaxPeers  int
+}
+
+type knownPeer struct {
+	PeerID    string   `json:"peerID"`
+	Addresses []string `json:"addresses"`
+	LastSeen  int64    `json:"lastSeen"`
+}
+
+type knownPeersHolder struct {
+	messenger NetMessenger
+	log       logger.Logger
+	filePath  string
+	maxPeers  int
+	getTime   func() int64
+
+	mut   sync.Mutex
+	peers map[string]*knownPeer
+	dirty bool
+}
+
+// NewKnownPeersHolder creates a component that persists the addresses of the peers that sent valid messages from
+// whitelisted relayers. After a restart, these peers are reconnected before the DHT discovery starts
+func NewKnownPeersHolder(args ArgsKnownPeersHolder) (*knownPeersHolder, error) {
+	if check.IfNil(args.Messenger) {
+		return nil, ErrNilMessenger
+	}
+	if check.IfNil(args.Log) {
+		return nil, ErrNilLogger
+	}
+	if len(args.FilePath) == 0 {
+		return nil, ErrEmptyFilePath
+	}
+	if args.MaxPeers < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxPeers, args.MaxPeers)
+	}
+
+	return &knownPeersHolder{
+		messenger: args.Messenger,
+		log:       args.Log,
+		filePath:  args.FilePath,
+		maxPeers:  args.MaxPeers,
+		getTime: func() int64 {
+			return time.Now().Unix()
+		},
+		peers: make(map[string]*knownPeer),
+	}, nil
+}
+
+// AddPeer records the current addresses of the provided peer as known-good addresses
+func (holder *knownPeersHolder) AddPeer(pid chainCore.PeerID) {
+	if len(pid) == 0 || pid == holder.messenger.ID() {
+		return
+	}
+
+	addresses := createDialAddresses(pid, holder.messenger.PeerAddresses(pid))
+	if len(addresses) == 0 {
+		return
+	}
+
+	holder.mut.Lock()
+	holder.peers[pid.Pretty()] = &knownPeer{
+		PeerID:    pid.Pretty(),
+		Addresses: addresses,
+		LastSeen:  holder.getTime(),
+	}
+	holder.dirty = true
+	holder.mut.Unlock()
+}
+
+// createDialAddresses appends the peer ID component to the addresses, as required when connecting to a peer
+func createDialAddresses(pid chainCore.PeerID, addresses []string) []string {
+	suffix := peerIDAddressComponent + pid.Pretty()
+	dialAddresses := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		if !strings.HasSuffix(address, suffix) {
+			address += suffix
+		}
+		dialAddresses = append(dialAddresses, address)
+	}
+
+	return dialAddresses
+}
+
+// ReconnectKnownPeers loads the persisted peers and tries to connect to them, the most recently seen first. It returns
+// the number of peers connected
+func (holder *knownPeersHolder) ReconnectKnownPeers() int {
+	peers, err := holder.load()
+	if err != nil {
+		holder.log.Warn("unable to load the known peers", "file", holder.filePath, "error", err)
+		return 0
+	}
+
+	holder.mut.Lock()
+	for _, peer := range peers {
+		if len(peer.PeerID) == 0 {
+			continue
+		}
+		holder.peers[peer.PeerID] = peer
+	}
+	sortedPeers := holder.sortedPeers()
+	holder.mut.Unlock()
+
+	numConnected := 0
+	for _, peer := range sortedPeers {
+		if holder.connectToPeer(peer) {
+			numConnected++
+		}
+	}
+
+	holder.log.Info("reconnected to the known peers", "num known", len(sortedPeers), "num connected", numConnected)
+
+	return numConnected
+}
+
+func (holder *knownPeersHolder) connectToPeer(peer *knownPeer) bool {
+	for _, address := range peer.Addresses {
+		err := holder.messenger.ConnectToPeer(address)
+		if err == nil {
+			holder.log.Debug("connected to known peer", "peer", peer.PeerID, "address", address)
+			return true
+		}
+
+		holder.log.Debug("unable to connect to known peer", "peer", peer.PeerID, "address", address, "error", err)
+	}
+
+	return false
+}
+
+func (holder *knownPeersHolder) load() ([]*knownPeer, error) {
+	buff, err := os.ReadFile(holder.filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return make([]*knownPeer, 0), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*knownPeer, 0)
+	err = json.Unmarshal(buff, &peers)
+	if err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+// sortedPeers returns the maximum number of peers, the most recently seen first. The mutex should be held
+func (holder *knownPeersHolder) sortedPeers() []*knownPeer {
+	peers := make([]*knownPeer, 0, len(holder.peers))
+	for _, peer := range holder.peers {
+		peers = append(peers, peer)
+	}
+
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].LastSeen != peers[j].LastSeen {
+			return peers[i].LastSeen > peers[j].LastSeen
+		}
+
+		return peers[i].PeerID < peers[j].PeerID
+	})
+	if len(peers) > holder.maxPeers {
+		peers = peers[:holder.maxPeers]
+	}
+
+	return peers
+}
+
+// Execute saves the known peers if new peers were recorded since the last save
+func (holder *knownPeersHolder) Execute(_ context.Context) error {
+	return holder.save()
+}
+
+func (holder *knownPeersHolder) save() error {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	if !holder.dirty {
+		return nil
+	}
+
+	buff, err := json.MarshalIndent(holder.sortedPeers(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(holder.filePath), knownPeersDirPermissions)
+	if err != nil {
+		return err
+	}
+
+	// the file is replaced atomically so a crash while saving does not lose the previously known peers
+	tempFilePath := holder.filePath + ".tmp"
+	err = os.WriteFile(tempFilePath, buff, knownPeersFilePermissions)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tempFilePath, holder.filePath)
+	if err != nil {
+		return err
+	}
+
+	holder.dirty = false
+
+	return nil
+}
+
+// Close saves the known peers
+func (holder *knownPeersHolder) Close() error {
+	return holder.save()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *knownPeersHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
@@ -0,0 +1,171 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	p2pMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/p2p"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsKnownPeersHolder(tb testing.TB) ArgsKnownPeersHolder {
+	return ArgsKnownPeersHolder{
+		Messenger: &p2pMocks.MessengerStub{
+			IDCalled: func() chainCore.PeerID {
+				return "self"
+			},
+			PeerAddressesCalled: func(pid chainCore.PeerID) []string {
+				return []string{"/ip4/10.0.0.1/tcp/10000"}
+			},
+		},
+		Log:      logger.GetOrCreate("test"),
+		FilePath: filepath.Join(tb.TempDir(), "knownPeers.json"),
+		MaxPeers: 10,
+	}
+}
+
+func TestNewKnownPeersHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil messenger should error", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		args.Messenger = nil
+
+		holder, err := NewKnownPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrNilMessenger, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		args.Log = nil
+
+		holder, err := NewKnownPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("empty file path should error", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		args.FilePath = ""
+
+		holder, err := NewKnownPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.Equal(t, ErrEmptyFilePath, err)
+	})
+	t.Run("invalid max peers should error", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		args.MaxPeers = 0
+
+		holder, err := NewKnownPeersHolder(args)
+		assert.True(t, check.IfNil(holder))
+		assert.True(t, errors.Is(err, ErrInvalidMaxPeers))
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+
+		holder, err := NewKnownPeersHolder(args)
+		assert.False(t, check.IfNil(holder))
+		assert.Nil(t, err)
+	})
+}
+
+func TestKnownPeersHolder_AddPeer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("own peer ID should not be recorded", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		holder, _ := NewKnownPeersHolder(args)
+
+		holder.AddPeer("self")
+		holder.AddPeer("")
+		assert.Empty(t, holder.peers)
+		assert.False(t, holder.dirty)
+	})
+	t.Run("peer without addresses should not be recorded", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		args.Messenger = &p2pMocks.MessengerStub{}
+		holder, _ := NewKnownPeersHolder(args)
+
+		holder.AddPeer("peer")
+		assert.Empty(t, holder.peers)
+	})
+	t.Run("should append the peer ID to the addresses", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		holder, _ := NewKnownPeersHolder(args)
+
+		pid := chainCore.PeerID("peer")
+		holder.AddPeer(pid)
+		expectedAddresses := []string{"/ip4/10.0.0.1/tcp/10000/p2p/" + pid.Pretty()}
+		assert.Equal(t, expectedAddresses, holder.peers[pid.Pretty()].Addresses)
+		assert.True(t, holder.dirty)
+	})
+}
+
+func TestKnownPeersHolder_SaveAndReconnect(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should not connect", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		holder, _ := NewKnownPeersHolder(args)
+
+		assert.Equal(t, 0, holder.ReconnectKnownPeers())
+	})
+	t.Run("corrupted file should not connect", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		require.Nil(t, os.WriteFile(args.FilePath, []byte("gibberish"), 0644))
+		holder, _ := NewKnownPeersHolder(args)
+
+		assert.Equal(t, 0, holder.ReconnectKnownPeers())
+	})
+	t.Run("not dirty should not write the file", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		holder, _ := NewKnownPeersHolder(args)
+
+		assert.Nil(t, holder.Execute(context.Background()))
+		_, err := os.Stat(args.FilePath)
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+	t.Run("should reconnect the most recently seen peers first", func(t *testing.T) {
+		args := createMockArgsKnownPeersHolder(t)
+		args.MaxPeers = 2
+		holder, _ := NewKnownPeersHolder(args)
+
+		currentTime := int64(0)
+		holder.getTime = func() int64 {
+			currentTime++
+			return currentTime
+		}
+		holder.AddPeer("peer1")
+		holder.AddPeer("peer2")
+		holder.AddPeer("peer3")
+		assert.Nil(t, holder.Close())
+
+		connectedAddresses := make([]string, 0)
+		args.Messenger = &p2pMocks.MessengerStub{
+			ConnectToPeerCalled: func(address string) error {
+				connectedAddresses = append(connectedAddresses, address)
+				if address == createDialAddresses("peer2", []string{"/ip4/10.0.0.1/tcp/10000"})[0] {
+					return errors.New("unreachable")
+				}
+
+				return nil
+			},
+		}
+		restartedHolder, _ := NewKnownPeersHolder(args)
+
+		numConnected := restartedHolder.ReconnectKnownPeers()
+		assert.Equal(t, 1, numConnected)
+		expectedAddresses := []string{
+			createDialAddresses("peer3", []string{"/ip4/10.0.0.1/tcp/10000"})[0],
+			createDialAddresses("peer2", []string{"/ip4/10.0.0.1/tcp/10000"})[0],
+		}
+		assert.Equal(t, expectedAddresses, connectedAddresses)
+		assert.Len(t, restartedHolder.peers, 2)
+	})
+}
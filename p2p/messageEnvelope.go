@@ -0,0 +1,161 @@
+package p2p
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+)
+
+const (
+	// CompressionNone disables the messages compression
+	CompressionNone = "none"
+	// CompressionGzip compresses the messages with gzip
+	CompressionGzip = "gzip"
+	// CompressionSnappy compresses the messages with snappy
+	CompressionSnappy = "snappy"
+
+	envelopeVersion       = byte(1)
+	codecGzip             = byte(1)
+	codecSnappy           = byte(2)
+	maxDecodedMessageSize = 1024 * 1024
+)
+
+// envelopeMagic prefixes the enveloped messages. It can not be the start of a legacy, JSON encoded, message so the
+// relayers can receive both formats
+var envelopeMagic = []byte{0x00, 'm', 'x', 'b'}
+
+var envelopeHeaderSize = len(envelopeMagic) + 2
+
+// messageCompressor wraps the marshalled messages larger than the threshold in a versioned envelope holding the
+// compressed message and the codec used. The smaller messages are sent as they are
+type messageCompressor struct {
+	codec            byte
+	thresholdInBytes int
+}
+
+func newMessageCompressor(compressionType string, thresholdInBytes int) (*messageCompressor, error) {
+	if thresholdInBytes < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCompressionThreshold, thresholdInBytes)
+	}
+
+	compressor := &messageCompressor{
+		thresholdInBytes: thresholdInBytes,
+	}
+	switch compressionType {
+	case "", CompressionNone:
+	case CompressionGzip:
+		compressor.codec = codecGzip
+	case CompressionSnappy:
+		compressor.codec = codecSnappy
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCompressionType, compressionType)
+	}
+
+	return compressor, nil
+}
+
+func (compressor *messageCompressor) encode(buff []byte) ([]byte, error) {
+	if compressor.codec == 0 || len(buff) < compressor.thresholdInBytes {
+		return buff, nil
+	}
+
+	compressed, err := compress(compressor.codec, buff)
+	if err != nil {
+		return nil, err
+	}
+	if len(compressed)+envelopeHeaderSize >= len(buff) {
+		return buff, nil
+	}
+
+	envelope := make([]byte, 0, envelopeHeaderSize+len(compressed))
+	envelope = append(envelope, envelopeMagic...)
+	envelope = append(envelope, envelopeVersion, compressor.codec)
+
+	return append(envelope, compressed...), nil
+}
+
+func compress(codec byte, buff []byte) ([]byte, error) {
+	if codec == codecSnappy {
+		return snappy.Encode(nil, buff), nil
+	}
+
+	output := &bytes.Buffer{}
+	writer := gzip.NewWriter(output)
+	_, err := writer.Write(buff)
+	if err != nil {
+		return nil, err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Bytes(), nil
+}
+
+// decodeMessage returns the marshalled message from the received buffer, which is either a legacy message or an
+// envelope holding a compressed message
+func decodeMessage(buff []byte) ([]byte, error) {
+	if !bytes.HasPrefix(buff, envelopeMagic) {
+		return buff, nil
+	}
+	if len(buff) < envelopeHeaderSize {
+		return nil, fmt.Errorf("%w: truncated header", ErrInvalidEnvelope)
+	}
+
+	version := buff[len(envelopeMagic)]
+	if version != envelopeVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrInvalidEnvelope, version)
+	}
+
+	codec := buff[len(envelopeMagic)+1]
+	compressed := buff[envelopeHeaderSize:]
+	switch codec {
+	case codecGzip:
+		return decompressGzip(compressed)
+	case codecSnappy:
+		return decompressSnappy(compressed)
+	default:
+		return nil, fmt.Errorf("%w: unsupported codec %d", ErrInvalidEnvelope, codec)
+	}
+}
+
+func decompressGzip(compressed []byte) ([]byte, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEnvelope, err.Error())
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	decompressed, err := io.ReadAll(io.LimitReader(reader, maxDecodedMessageSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEnvelope, err.Error())
+	}
+	if len(decompressed) > maxDecodedMessageSize {
+		return nil, fmt.Errorf("%w for the decompressed message", ErrInvalidSize)
+	}
+
+	return decompressed, nil
+}
+
+func decompressSnappy(compressed []byte) ([]byte, error) {
+	decodedLen, err := snappy.DecodedLen(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEnvelope, err.Error())
+	}
+	if decodedLen > maxDecodedMessageSize {
+		return nil, fmt.Errorf("%w for the decompressed message", ErrInvalidSize)
+	}
+
+	decompressed, err := snappy.Decode(nil, compressed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEnvelope, err.Error())
+	}
+
+	return decompressed, nil
+}
@@ -0,0 +1,141 @@
+package p2p
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLargeMessage() []byte {
+	return []byte(`{"payload":"` + string(bytes.Repeat([]byte("signature"), 200)) + `"}`)
+}
+
+func TestNewMessageCompressor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid type should error", func(t *testing.T) {
+		t.Parallel()
+
+		compressor, err := newMessageCompressor("zip", 0)
+		assert.Nil(t, compressor)
+		assert.True(t, errors.Is(err, ErrInvalidCompressionType))
+	})
+	t.Run("negative threshold should error", func(t *testing.T) {
+		t.Parallel()
+
+		compressor, err := newMessageCompressor(CompressionGzip, -1)
+		assert.Nil(t, compressor)
+		assert.True(t, errors.Is(err, ErrInvalidCompressionThreshold))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compressionType := range []string{"", CompressionNone, CompressionGzip, CompressionSnappy} {
+			compressor, err := newMessageCompressor(compressionType, 0)
+			assert.NotNil(t, compressor)
+			assert.Nil(t, err)
+		}
+	})
+}
+
+func TestMessageCompressor_EncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled compression should not alter the message", func(t *testing.T) {
+		t.Parallel()
+
+		compressor, _ := newMessageCompressor(CompressionNone, 0)
+		message := createLargeMessage()
+		encoded, err := compressor.encode(message)
+		require.Nil(t, err)
+		assert.Equal(t, message, encoded)
+
+		decoded, err := decodeMessage(encoded)
+		require.Nil(t, err)
+		assert.Equal(t, message, decoded)
+	})
+	t.Run("messages under the threshold should not be compressed", func(t *testing.T) {
+		t.Parallel()
+
+		compressor, _ := newMessageCompressor(CompressionGzip, 1024)
+		message := []byte(`{"payload":"small"}`)
+		encoded, err := compressor.encode(message)
+		require.Nil(t, err)
+		assert.Equal(t, message, encoded)
+	})
+	t.Run("incompressible messages should not be enveloped", func(t *testing.T) {
+		t.Parallel()
+
+		compressor, _ := newMessageCompressor(CompressionSnappy, 0)
+		message := []byte(`{}`)
+		encoded, err := compressor.encode(message)
+		require.Nil(t, err)
+		assert.Equal(t, message, encoded)
+	})
+	t.Run("should compress and decompress", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compressionType := range []string{CompressionGzip, CompressionSnappy} {
+			compressor, _ := newMessageCompressor(compressionType, 1024)
+			message := createLargeMessage()
+			encoded, err := compressor.encode(message)
+			require.Nil(t, err)
+			assert.True(t, bytes.HasPrefix(encoded, envelopeMagic))
+			assert.Less(t, len(encoded), len(message))
+
+			decoded, err := decodeMessage(encoded)
+			require.Nil(t, err)
+			assert.Equal(t, message, decoded)
+		}
+	})
+}
+
+func TestDecodeMessage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("truncated header should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := decodeMessage(append(envelopeMagic, envelopeVersion))
+		assert.True(t, errors.Is(err, ErrInvalidEnvelope))
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := append(append([]byte{}, envelopeMagic...), envelopeVersion+1, codecGzip)
+		_, err := decodeMessage(buff)
+		assert.True(t, errors.Is(err, ErrInvalidEnvelope))
+	})
+	t.Run("unsupported codec should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := append(append([]byte{}, envelopeMagic...), envelopeVersion, 0xFF)
+		_, err := decodeMessage(buff)
+		assert.True(t, errors.Is(err, ErrInvalidEnvelope))
+	})
+	t.Run("corrupted data should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, codec := range []byte{codecGzip, codecSnappy} {
+			buff := append(append([]byte{}, envelopeMagic...), envelopeVersion, codec, 0xFF, 0xFF, 0xFF)
+			_, err := decodeMessage(buff)
+			assert.True(t, errors.Is(err, ErrInvalidEnvelope))
+		}
+	})
+	t.Run("too large decompressed message should error", func(t *testing.T) {
+		t.Parallel()
+
+		output := &bytes.Buffer{}
+		writer := gzip.NewWriter(output)
+		_, _ = writer.Write(make([]byte, maxDecodedMessageSize+1))
+		_ = writer.Close()
+
+		buff := append(append([]byte{}, envelopeMagic...), envelopeVersion, codecGzip)
+		_, err := decodeMessage(append(buff, output.Bytes()...))
+		assert.True(t, errors.Is(err, ErrInvalidSize))
+	})
+}
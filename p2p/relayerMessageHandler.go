@@ -15,7 +15,10 @@ import (
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 )
 
-const absolutMaxSliceSize = 1024
+const (
+	absolutMaxSliceSize      = 1024
+	maxMessagesInCatchUpPage = 50
+)
 
 type relayerMessageHandler struct {
 	marshalizer         marshal.Marshalizer
@@ -25,6 +28,7 @@ type relayerMessageHandler struct {
 	publicKeyBytes      []byte
 	privateKey          crypto.PrivateKey
 	antifloodComponents *factory.AntiFloodComponents
+	verifier            *signaturesVerifier
 }
 
 // canProcessMessage will check if a specific message can be processed
@@ -46,7 +50,10 @@ func (rmh *relayerMessageHandler) canProcessMessage(message p2p.MessageP2P, from
 // preProcessMessage is able to preprocess the received p2p message
 func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) (*core.SignedMessage, error) {
 	msg := &core.SignedMessage{}
-	err := rmh.marshalizer.Unmarshal(msg, message.Data())
+	buff, err := decodeMessage(message.Data())
+	if err == nil {
+		err = rmh.marshalizer.Unmarshal(msg, buff)
+	}
 	if err != nil {
 		reason := "unmarshalable data got on request topic " + message.Topic()
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
@@ -54,29 +61,69 @@ func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, from
 		return nil, err
 	}
 
-	err = checkLengths(msg)
+	err = rmh.verifySignedMessage(msg, message, fromConnectedPeer)
 	if err != nil {
 		return nil, err
 	}
 
-	pk, err := rmh.keyGen.PublicKeyFromByteArray(msg.PublicKeyBytes)
+	return msg, nil
+}
+
+// preProcessCatchUpMessage is able to preprocess the received p2p message holding a page of stored signed messages.
+// The signed messages are checked one by one, when processed
+func (rmh *relayerMessageHandler) preProcessCatchUpMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) (*core.SignedMessagesBatch, error) {
+	batch := &core.SignedMessagesBatch{}
+	buff, err := decodeMessage(message.Data())
+	if err == nil {
+		err = rmh.marshalizer.Unmarshal(batch, buff)
+	}
 	if err != nil {
+		reason := "unmarshalable data got on request topic " + message.Topic()
+		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
+		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
 		return nil, err
 	}
 
+	if len(batch.Messages) > maxMessagesInCatchUpPage {
+		return nil, fmt.Errorf("%w for Messages field", ErrInvalidSize)
+	}
+	for _, msg := range batch.Messages {
+		if msg == nil {
+			return nil, ErrNilMessage
+		}
+	}
+
+	return batch, nil
+}
+
+// verifySignedMessage checks the sizes and the signature of the provided signed message
+func (rmh *relayerMessageHandler) verifySignedMessage(msg *core.SignedMessage, message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) error {
+	err := checkLengths(msg)
+	if err != nil {
+		return err
+	}
+
+	pk, err := rmh.keyGen.PublicKeyFromByteArray(msg.PublicKeyBytes)
+	if err != nil {
+		return err
+	}
+
 	buffNonce := make([]byte, 8)
 	binary.BigEndian.PutUint64(buffNonce, msg.Nonce)
 	msgWithNonce := append(msg.Payload, buffNonce...)
 
-	err = rmh.singleSigner.Verify(pk, msgWithNonce, msg.Signature)
+	key := createVerificationKey(relayerSignatureKind, msg.PublicKeyBytes, msgWithNonce, msg.Signature)
+	err = rmh.verifier.verify(key, func() error {
+		return rmh.singleSigner.Verify(pk, msgWithNonce, msg.Signature)
+	})
 	if err != nil {
 		reason := "unverifiable signature on request topic " + message.Topic()
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
 		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
-		return nil, err
+		return err
 	}
 
-	return msg, nil
+	return nil
 }
 
 func checkLengths(msg *core.SignedMessage) error {
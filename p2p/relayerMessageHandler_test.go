@@ -240,6 +240,7 @@ func preProcessVerifyFails(t *testing.T) {
 				},
 			},
 		},
+		verifier: createTestSignaturesVerifier(t),
 	}
 	_, buff := createSignedMessageAndMarshaledBytes(0)
 
@@ -279,7 +280,8 @@ func preProcessShouldWork(t *testing.T) {
 				return nil
 			},
 		},
-		keyGen: &cryptoMocks.KeyGenStub{},
+		keyGen:   &cryptoMocks.KeyGenStub{},
+		verifier: createTestSignaturesVerifier(t),
 	}
 
 	p2pmsg := &p2pMocks.P2PMessageMock{
@@ -290,6 +292,12 @@ func preProcessShouldWork(t *testing.T) {
 	assert.Equal(t, originalMsg, msg)
 	assert.Nil(t, err)
 	assert.True(t, verifyCalled)
+
+	verifyCalled = false
+	msg, err = rmh.preProcessMessage(p2pmsg, fromPeer)
+	assert.Equal(t, originalMsg, msg)
+	assert.Nil(t, err)
+	assert.False(t, verifyCalled, "an already verified signature should not be verified again")
 }
 
 func TestRelayerMessageHandler_createMessage(t *testing.T) {
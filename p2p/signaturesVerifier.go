@@ -0,0 +1,144 @@
+package p2p
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"runtime"
+
+	"github.com/multiversx/mx-chain-go/storage/cache"
+)
+
+const (
+	defaultVerifiedSignaturesCacheSize = 10000
+	relayerSignatureKind               = "relayer"
+	ethereumSignatureKind              = "ethereum"
+)
+
+// verifiedSignaturesCache holds the keys of the successfully verified signatures
+type verifiedSignaturesCache interface {
+	Has(key []byte) bool
+	Put(key []byte, value interface{}, sizeInBytes int) (evicted bool)
+}
+
+type verificationTask struct {
+	verify func() error
+	result chan error
+}
+
+// signaturesVerifier runs the signatures verifications on a pool of workers. The successful verifications are cached,
+// so the same (message, public key, signature) tuple, received again in a join catch-up or from another peer, is not
+// verified twice
+type signaturesVerifier struct {
+	tasks  chan *verificationTask
+	cache  verifiedSignaturesCache
+	ctx    context.Context
+	cancel func()
+}
+
+// newSignaturesVerifier creates a verifier with the provided number of workers, defaulting to GOMAXPROCS. A 0 cache
+// size uses the default cache size
+func newSignaturesVerifier(numWorkers int, cacheSize int) (*signaturesVerifier, error) {
+	if numWorkers < 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidNumVerificationWorkers, numWorkers)
+	}
+	if numWorkers == 0 {
+		numWorkers = runtime.GOMAXPROCS(0)
+	}
+	if cacheSize < 0 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidVerifiedSignaturesCacheSize, cacheSize)
+	}
+	if cacheSize == 0 {
+		cacheSize = defaultVerifiedSignaturesCacheSize
+	}
+
+	verifiedSignatures, err := cache.NewLRUCache(cacheSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	verifier := &signaturesVerifier{
+		tasks:  make(chan *verificationTask),
+		cache:  verifiedSignatures,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	for i := 0; i < numWorkers; i++ {
+		go verifier.processTasks()
+	}
+
+	return verifier, nil
+}
+
+func (verifier *signaturesVerifier) processTasks() {
+	for {
+		select {
+		case <-verifier.ctx.Done():
+			return
+		case task := <-verifier.tasks:
+			task.result <- task.verify()
+		}
+	}
+}
+
+// verify calls the provided verification function, unless the same key was already verified successfully
+func (verifier *signaturesVerifier) verify(key []byte, verification func() error) error {
+	if verifier.cache.Has(key) {
+		return nil
+	}
+
+	err := verification()
+	if err != nil {
+		return err
+	}
+
+	verifier.cache.Put(key, struct{}{}, 0)
+
+	return nil
+}
+
+// verifyAll runs the provided verifications on the workers pool and returns their errors, in the same order
+func (verifier *signaturesVerifier) verifyAll(verifications []func() error) []error {
+	results := make([]chan error, 0, len(verifications))
+	for _, verification := range verifications {
+		task := &verificationTask{
+			verify: verification,
+			result: make(chan error, 1),
+		}
+
+		select {
+		case verifier.tasks <- task:
+		case <-verifier.ctx.Done():
+			task.result <- ErrSignaturesVerifierClosed
+		}
+		results = append(results, task.result)
+	}
+
+	errs := make([]error, 0, len(results))
+	for _, result := range results {
+		errs = append(errs, <-result)
+	}
+
+	return errs
+}
+
+// close stops the workers
+func (verifier *signaturesVerifier) close() {
+	verifier.cancel()
+}
+
+// createVerificationKey hashes the kind and the length-prefixed parts of a signature verification
+func createVerificationKey(kind string, parts ...[]byte) []byte {
+	hasher := sha256.New()
+	hasher.Write([]byte(kind))
+	lenBuff := make([]byte, 8)
+	for _, part := range parts {
+		binary.BigEndian.PutUint64(lenBuff, uint64(len(part)))
+		hasher.Write(lenBuff)
+		hasher.Write(part)
+	}
+
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,148 @@
+package p2p
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSignaturesVerifier(tb testing.TB) *signaturesVerifier {
+	verifier, err := newSignaturesVerifier(2, 10)
+	require.Nil(tb, err)
+	tb.Cleanup(verifier.close)
+
+	return verifier
+}
+
+func TestNewSignaturesVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of workers should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := newSignaturesVerifier(-1, 10)
+		assert.Nil(t, verifier)
+		assert.True(t, errors.Is(err, ErrInvalidNumVerificationWorkers))
+	})
+	t.Run("invalid cache size should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := newSignaturesVerifier(1, -1)
+		assert.Nil(t, verifier)
+		assert.True(t, errors.Is(err, ErrInvalidVerifiedSignaturesCacheSize))
+	})
+	t.Run("0 values should use the defaults", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := newSignaturesVerifier(0, 0)
+		require.Nil(t, err)
+		verifier.close()
+	})
+}
+
+func TestSignaturesVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failed verifications should not be cached", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestSignaturesVerifier(t)
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		key := createVerificationKey(relayerSignatureKind, []byte("pk"), []byte("msg"), []byte("sig"))
+		for i := 0; i < 2; i++ {
+			err := verifier.verify(key, func() error {
+				numCalls++
+				return expectedErr
+			})
+			assert.Equal(t, expectedErr, err)
+		}
+		assert.Equal(t, 2, numCalls)
+	})
+	t.Run("successful verifications should be cached", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestSignaturesVerifier(t)
+		numCalls := 0
+		key := createVerificationKey(relayerSignatureKind, []byte("pk"), []byte("msg"), []byte("sig"))
+		for i := 0; i < 2; i++ {
+			err := verifier.verify(key, func() error {
+				numCalls++
+				return nil
+			})
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, 1, numCalls)
+
+		otherKey := createVerificationKey(relayerSignatureKind, []byte("pk"), []byte("msgs"), []byte("ig"))
+		_ = verifier.verify(otherKey, func() error {
+			numCalls++
+			return nil
+		})
+		assert.Equal(t, 2, numCalls)
+	})
+}
+
+func TestSignaturesVerifier_VerifyAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the errors in order", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestSignaturesVerifier(t)
+		expectedErr := errors.New("expected error")
+		verifications := []func() error{
+			func() error {
+				time.Sleep(time.Millisecond * 50)
+				return expectedErr
+			},
+			func() error {
+				return nil
+			},
+			func() error {
+				return expectedErr
+			},
+		}
+
+		errs := verifier.verifyAll(verifications)
+		assert.Equal(t, []error{expectedErr, nil, expectedErr}, errs)
+	})
+	t.Run("should verify in parallel", func(t *testing.T) {
+		t.Parallel()
+
+		verifier := createTestSignaturesVerifier(t)
+		numRunning := int32(0)
+		maxRunning := int32(0)
+		verification := func() error {
+			running := atomic.AddInt32(&numRunning, 1)
+			for {
+				current := atomic.LoadInt32(&maxRunning)
+				if running <= current || atomic.CompareAndSwapInt32(&maxRunning, current, running) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond * 100)
+			atomic.AddInt32(&numRunning, -1)
+
+			return nil
+		}
+
+		errs := verifier.verifyAll([]func() error{verification, verification, verification, verification})
+		assert.Equal(t, []error{nil, nil, nil, nil}, errs)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&maxRunning))
+	})
+	t.Run("closed verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := newSignaturesVerifier(1, 10)
+		verifier.close()
+		time.Sleep(time.Millisecond * 10)
+
+		errs := verifier.verifyAll([]func() error{func() error { return nil }})
+		assert.Equal(t, []error{ErrSignaturesVerifierClosed}, errs)
+	})
+}
@@ -2,7 +2,9 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -12,11 +14,16 @@ import (
 type ArgsStatusHandlerAdapter struct {
 	StatusHandler core.StatusHandler
 	Messenger     NetMessenger
+	TopicsMetrics TopicsMetrics
 }
 
 type statusHandlerAdapter struct {
 	core.StatusHandler
-	messenger NetMessenger
+	messenger        NetMessenger
+	topicsMetrics    TopicsMetrics
+	getTime          func() time.Time
+	previousCounters map[string]TopicCounters
+	previousTime     time.Time
 }
 
 // NewStatusHandlerAdapter creates a new instance of statusHandlerAdapter able to gather p2p status metrics
@@ -27,10 +34,17 @@ func NewStatusHandlerAdapter(args ArgsStatusHandlerAdapter) (*statusHandlerAdapt
 	if check.IfNil(args.Messenger) {
 		return nil, ErrNilMessenger
 	}
+	if check.IfNil(args.TopicsMetrics) {
+		return nil, ErrNilTopicsMetrics
+	}
 
 	return &statusHandlerAdapter{
-		StatusHandler: args.StatusHandler,
-		messenger:     args.Messenger,
+		StatusHandler:    args.StatusHandler,
+		messenger:        args.Messenger,
+		topicsMetrics:    args.TopicsMetrics,
+		getTime:          time.Now,
+		previousCounters: make(map[string]TopicCounters),
+		previousTime:     time.Now(),
 	}, nil
 }
 
@@ -42,9 +56,48 @@ func (adapter *statusHandlerAdapter) Execute(_ context.Context) error {
 	connectedAddresses := adapter.messenger.ConnectedAddresses()
 	adapter.SetStringMetric(core.MetricConnectedP2PAddresses, strings.Join(connectedAddresses, " "))
 
+	adapter.updateTopicsMetrics()
+
 	return nil
 }
 
+// updateTopicsMetrics sets, for each topic, the connected peers, the message rates since the previous call, the
+// number of rejected messages and the timestamp of the last received message
+func (adapter *statusHandlerAdapter) updateTopicsMetrics() {
+	now := adapter.getTime()
+	elapsed := now.Sub(adapter.previousTime)
+	counters := adapter.topicsMetrics.GetCounters()
+
+	for topic, topicCounters := range counters {
+		previous := adapter.previousCounters[topic]
+
+		adapter.SetIntMetric(fmt.Sprintf(core.MetricP2PTopicConnectedPeersTemplate, topic),
+			len(adapter.messenger.ConnectedPeersOnTopic(topic)))
+		adapter.SetIntMetric(fmt.Sprintf(core.MetricP2PTopicReceivedPerMinuteTemplate, topic),
+			perMinute(topicCounters.NumReceived-previous.NumReceived, elapsed))
+		adapter.SetIntMetric(fmt.Sprintf(core.MetricP2PTopicSentPerMinuteTemplate, topic),
+			perMinute(topicCounters.NumSent-previous.NumSent, elapsed))
+		adapter.SetIntMetric(fmt.Sprintf(core.MetricP2PTopicNumRejectedTemplate, topic), int(topicCounters.NumRejected))
+
+		lastReceived := 0
+		if !topicCounters.LastReceived.IsZero() {
+			lastReceived = int(topicCounters.LastReceived.Unix())
+		}
+		adapter.SetIntMetric(fmt.Sprintf(core.MetricP2PTopicLastReceivedTimestampTemplate, topic), lastReceived)
+	}
+
+	adapter.previousCounters = counters
+	adapter.previousTime = now
+}
+
+func perMinute(numMessages uint64, elapsed time.Duration) int {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return int(float64(numMessages) * float64(time.Minute) / float64(elapsed))
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (adapter *statusHandlerAdapter) IsInterfaceNil() bool {
 	return adapter == nil
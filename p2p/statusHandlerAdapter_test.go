@@ -2,12 +2,15 @@ package p2p
 
 import (
 	"context"
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	p2pMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/p2p"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/stretchr/testify/assert"
 )
@@ -16,6 +19,7 @@ func createMockArgsStatusHandlerAdapter() ArgsStatusHandlerAdapter {
 	return ArgsStatusHandlerAdapter{
 		StatusHandler: testsCommon.NewStatusHandlerMock("test"),
 		Messenger:     &p2pMocks.MessengerStub{},
+		TopicsMetrics: NewTopicsMetrics(),
 	}
 }
 
@@ -38,6 +42,14 @@ func TestNewStatusHandlerAdapter(t *testing.T) {
 		assert.Equal(t, ErrNilMessenger, err)
 		assert.True(t, check.IfNil(adapter))
 	})
+	t.Run("nil topics metrics", func(t *testing.T) {
+		args := createMockArgsStatusHandlerAdapter()
+		args.TopicsMetrics = nil
+
+		adapter, err := NewStatusHandlerAdapter(args)
+		assert.Equal(t, ErrNilTopicsMetrics, err)
+		assert.True(t, check.IfNil(adapter))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArgsStatusHandlerAdapter()
 
@@ -75,3 +87,64 @@ func TestStatusHandlerAdapter_Execute(t *testing.T) {
 	assert.Equal(t, 2, len(metrics))
 	assert.Equal(t, expectedMetric, metrics)
 }
+
+func TestStatusHandlerAdapter_ExecuteShouldSetTheTopicsMetrics(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Unix(1700000000, 0)
+	lastReceived := startTime.Add(time.Second * 10)
+	topicsMetrics := NewTopicsMetrics()
+	topicsMetrics.getTime = func() time.Time {
+		return lastReceived
+	}
+	topicsMetrics.RegisterTopic("join")
+	topicsMetrics.RegisterTopic("sign")
+
+	args := createMockArgsStatusHandlerAdapter()
+	args.TopicsMetrics = topicsMetrics
+	args.Messenger = &p2pMocks.MessengerStub{
+		ConnectedPeersOnTopicCalled: func(topic string) []chainCore.PeerID {
+			if topic == "sign" {
+				return []chainCore.PeerID{"peer1", "peer2", "peer3"}
+			}
+
+			return []chainCore.PeerID{"peer1"}
+		},
+	}
+	adapter, _ := NewStatusHandlerAdapter(args)
+	adapter.previousTime = startTime
+	currentTime := startTime.Add(time.Second * 30)
+	adapter.getTime = func() time.Time {
+		return currentTime
+	}
+
+	for i := 0; i < 6; i++ {
+		topicsMetrics.AddReceived("sign")
+	}
+	topicsMetrics.AddSent("sign")
+	topicsMetrics.AddRejected("sign")
+
+	err := adapter.Execute(context.Background())
+	assert.Nil(t, err)
+
+	metrics := adapter.GetAllMetrics()
+	assert.Equal(t, 3, metrics[fmt.Sprintf(core.MetricP2PTopicConnectedPeersTemplate, "sign")])
+	assert.Equal(t, 12, metrics[fmt.Sprintf(core.MetricP2PTopicReceivedPerMinuteTemplate, "sign")])
+	assert.Equal(t, 2, metrics[fmt.Sprintf(core.MetricP2PTopicSentPerMinuteTemplate, "sign")])
+	assert.Equal(t, 1, metrics[fmt.Sprintf(core.MetricP2PTopicNumRejectedTemplate, "sign")])
+	assert.Equal(t, int(lastReceived.Unix()), metrics[fmt.Sprintf(core.MetricP2PTopicLastReceivedTimestampTemplate, "sign")])
+	assert.Equal(t, 1, metrics[fmt.Sprintf(core.MetricP2PTopicConnectedPeersTemplate, "join")])
+	assert.Equal(t, 0, metrics[fmt.Sprintf(core.MetricP2PTopicReceivedPerMinuteTemplate, "join")])
+	assert.Equal(t, 0, metrics[fmt.Sprintf(core.MetricP2PTopicLastReceivedTimestampTemplate, "join")])
+
+	// the rates are computed from the messages since the previous execution
+	currentTime = currentTime.Add(time.Minute)
+	topicsMetrics.AddReceived("sign")
+	err = adapter.Execute(context.Background())
+	assert.Nil(t, err)
+
+	metrics = adapter.GetAllMetrics()
+	assert.Equal(t, 1, metrics[fmt.Sprintf(core.MetricP2PTopicReceivedPerMinuteTemplate, "sign")])
+	assert.Equal(t, 0, metrics[fmt.Sprintf(core.MetricP2PTopicSentPerMinuteTemplate, "sign")])
+	assert.Equal(t, 1, metrics[fmt.Sprintf(core.MetricP2PTopicNumRejectedTemplate, "sign")])
+}
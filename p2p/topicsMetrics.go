@@ -0,0 +1,88 @@
+package p2p
+
+import (
+	"sync"
+	"time"
+)
+
+// TopicCounters holds the number of messages received, sent and rejected on a p2p topic and the time of the last
+// received message
+type TopicCounters struct {
+	NumReceived  uint64
+	NumSent      uint64
+	NumRejected  uint64
+	LastReceived time.Time
+}
+
+type topicsMetrics struct {
+	mut      sync.RWMutex
+	counters map[string]*TopicCounters
+	getTime  func() time.Time
+}
+
+// NewTopicsMetrics creates a new instance able to count the messages sent and received on each p2p topic
+func NewTopicsMetrics() *topicsMetrics {
+	return &topicsMetrics{
+		counters: make(map[string]*TopicCounters),
+		getTime:  time.Now,
+	}
+}
+
+// RegisterTopic adds the topic with zero counters, so it is reported before any message is received or sent on it
+func (metrics *topicsMetrics) RegisterTopic(topic string) {
+	metrics.mut.Lock()
+	metrics.getCounters(topic)
+	metrics.mut.Unlock()
+}
+
+// AddReceived counts a message received on the topic
+func (metrics *topicsMetrics) AddReceived(topic string) {
+	metrics.mut.Lock()
+	counters := metrics.getCounters(topic)
+	counters.NumReceived++
+	counters.LastReceived = metrics.getTime()
+	metrics.mut.Unlock()
+}
+
+// AddSent counts a message sent on the topic
+func (metrics *topicsMetrics) AddSent(topic string) {
+	metrics.mut.Lock()
+	metrics.getCounters(topic).NumSent++
+	metrics.mut.Unlock()
+}
+
+// AddRejected counts a message received on the topic and rejected because its signer is not whitelisted
+func (metrics *topicsMetrics) AddRejected(topic string) {
+	metrics.mut.Lock()
+	metrics.getCounters(topic).NumRejected++
+	metrics.mut.Unlock()
+}
+
+// getCounters returns the counters of the topic, creating them if missing. Should be called under mutex
+func (metrics *topicsMetrics) getCounters(topic string) *TopicCounters {
+	counters, found := metrics.counters[topic]
+	if !found {
+		counters = &TopicCounters{}
+		metrics.counters[topic] = counters
+	}
+
+	return counters
+}
+
+// GetCounters returns a copy of the counters of all the topics
+func (metrics *topicsMetrics) GetCounters() map[string]TopicCounters {
+	metrics.mut.RLock()
+	defer metrics.mut.RUnlock()
+
+	result := make(map[string]TopicCounters, len(metrics.counters))
+	for topic, counters := range metrics.counters {
+		result[topic] = *counters
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (metrics *topicsMetrics) IsInterfaceNil() bool {
+	return metrics == nil
+}
@@ -0,0 +1,89 @@
+package p2p
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTopicsMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := NewTopicsMetrics()
+	assert.False(t, check.IfNil(metrics))
+	assert.Empty(t, metrics.GetCounters())
+}
+
+func TestTopicsMetrics_Counters(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1700000000, 0)
+	metrics := NewTopicsMetrics()
+	metrics.getTime = func() time.Time {
+		return currentTime
+	}
+
+	metrics.RegisterTopic("join")
+	metrics.RegisterTopic("sign")
+	metrics.AddReceived("sign")
+	metrics.AddReceived("sign")
+	metrics.AddRejected("sign")
+	metrics.AddSent("sign")
+	metrics.AddSent("catch-up")
+
+	expected := map[string]TopicCounters{
+		"join": {},
+		"sign": {
+			NumReceived:  2,
+			NumSent:      1,
+			NumRejected:  1,
+			LastReceived: currentTime,
+		},
+		"catch-up": {
+			NumSent: 1,
+		},
+	}
+	assert.Equal(t, expected, metrics.GetCounters())
+
+	// the returned counters are copies
+	counters := metrics.GetCounters()
+	signCounters := counters["sign"]
+	signCounters.NumReceived = 100
+	assert.Equal(t, uint64(2), metrics.GetCounters()["sign"].NumReceived)
+}
+
+func TestTopicsMetrics_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	metrics := NewTopicsMetrics()
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 5 {
+			case 0:
+				metrics.RegisterTopic("topic")
+			case 1:
+				metrics.AddReceived("topic")
+			case 2:
+				metrics.AddSent("topic")
+			case 3:
+				metrics.AddRejected("topic")
+			default:
+				_ = metrics.GetCounters()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	counters := metrics.GetCounters()["topic"]
+	assert.Equal(t, uint64(numCalls/5), counters.NumReceived)
+	assert.Equal(t, uint64(numCalls/5), counters.NumSent)
+	assert.Equal(t, uint64(numCalls/5), counters.NumRejected)
+}
@@ -0,0 +1,116 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+
+	p2pConfig "github.com/multiversx/mx-chain-go/p2p/config"
+)
+
+const (
+	// TransportTCP is the name of the plain TCP transport
+	TransportTCP = "tcp"
+	// TransportWebSocket is the name of the WebSocket transport
+	TransportWebSocket = "ws"
+	// TransportQUIC is the name of the QUIC transport
+	TransportQUIC = "quic"
+	// TransportWebTransport is the name of the WebTransport transport
+	TransportWebTransport = "webtransport"
+
+	portPlaceholder = "%d"
+)
+
+type transportAddress struct {
+	name    string
+	address string
+	check   func(address string) bool
+}
+
+func getTransportAddresses(transports p2pConfig.P2PTransportConfig) []transportAddress {
+	return []transportAddress{
+		{
+			name:    TransportTCP,
+			address: transports.TCP.ListenAddress,
+			check: func(address string) bool {
+				return strings.Contains(address, "/tcp/") && !strings.Contains(address, "/ws")
+			},
+		},
+		{
+			name:    TransportWebSocket,
+			address: transports.WebSocketAddress,
+			check: func(address string) bool {
+				return strings.Contains(address, "/tcp/") && strings.HasSuffix(address, "/ws")
+			},
+		},
+		{
+			name:    TransportQUIC,
+			address: transports.QUICAddress,
+			check: func(address string) bool {
+				return strings.Contains(address, "/udp/") && strings.HasSuffix(address, "/quic-v1")
+			},
+		},
+		{
+			name:    TransportWebTransport,
+			address: transports.WebTransportAddress,
+			check: func(address string) bool {
+				return strings.Contains(address, "/udp/") && strings.HasSuffix(address, "/quic-v1/webtransport")
+			},
+		},
+	}
+}
+
+// CheckTransportsConfig checks that at least one P2P transport is enabled and that each enabled transport has a
+// listen address matching its protocol. All the listen addresses share the P2P port, provided through the %d
+// placeholder, so the TCP and the WebSocket transports can not be both enabled on the same interface
+func CheckTransportsConfig(transports p2pConfig.P2PTransportConfig) error {
+	enabled := make(map[string]string)
+	for _, transport := range getTransportAddresses(transports) {
+		if len(transport.address) == 0 {
+			continue
+		}
+
+		if strings.Count(transport.address, portPlaceholder) != 1 {
+			return fmt.Errorf("%w for the %s transport: %s should contain the port placeholder %s exactly once",
+				ErrInvalidTransportAddress, transport.name, transport.address, portPlaceholder)
+		}
+		if !strings.HasPrefix(transport.address, "/") || !transport.check(transport.address) {
+			return fmt.Errorf("%w for the %s transport: %s", ErrInvalidTransportAddress, transport.name, transport.address)
+		}
+
+		enabled[transport.name] = transport.address
+	}
+
+	if len(enabled) == 0 {
+		return ErrNoTransportEnabled
+	}
+
+	tcpAddress, isTCPEnabled := enabled[TransportTCP]
+	webSocketAddress, isWebSocketEnabled := enabled[TransportWebSocket]
+	if isTCPEnabled && isWebSocketEnabled && listenHost(tcpAddress) == listenHost(webSocketAddress) {
+		return fmt.Errorf("%w: the tcp and ws transports can not listen on the same port of %s",
+			ErrInvalidTransportAddress, listenHost(tcpAddress))
+	}
+
+	return nil
+}
+
+// EnabledTransports returns the names of the enabled P2P transports
+func EnabledTransports(transports p2pConfig.P2PTransportConfig) []string {
+	names := make([]string, 0)
+	for _, transport := range getTransportAddresses(transports) {
+		if len(transport.address) > 0 {
+			names = append(names, transport.name)
+		}
+	}
+
+	return names
+}
+
+func listenHost(address string) string {
+	index := strings.Index(address, "/tcp/")
+	if index < 0 {
+		return address
+	}
+
+	return address[:index]
+}
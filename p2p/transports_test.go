@@ -0,0 +1,95 @@
+package p2p
+
+import (
+	"errors"
+	"testing"
+
+	p2pConfig "github.com/multiversx/mx-chain-go/p2p/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func createTransportsConfig() p2pConfig.P2PTransportConfig {
+	return p2pConfig.P2PTransportConfig{
+		TCP: p2pConfig.P2PTCPTransport{
+			ListenAddress: "/ip4/0.0.0.0/tcp/%d",
+		},
+	}
+}
+
+func TestCheckTransportsConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no transport should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckTransportsConfig(p2pConfig.P2PTransportConfig{})
+		assert.Equal(t, ErrNoTransportEnabled, err)
+	})
+	t.Run("missing port placeholder should error", func(t *testing.T) {
+		t.Parallel()
+
+		transports := createTransportsConfig()
+		transports.TCP.ListenAddress = "/ip4/0.0.0.0/tcp/10000"
+
+		err := CheckTransportsConfig(transports)
+		assert.True(t, errors.Is(err, ErrInvalidTransportAddress))
+	})
+	t.Run("address not matching the protocol should error", func(t *testing.T) {
+		t.Parallel()
+
+		transports := createTransportsConfig()
+		transports.WebSocketAddress = "/ip4/0.0.0.0/udp/%d/quic-v1"
+		err := CheckTransportsConfig(transports)
+		assert.True(t, errors.Is(err, ErrInvalidTransportAddress))
+
+		transports = createTransportsConfig()
+		transports.QUICAddress = "/ip4/0.0.0.0/tcp/%d/ws"
+		err = CheckTransportsConfig(transports)
+		assert.True(t, errors.Is(err, ErrInvalidTransportAddress))
+
+		transports = createTransportsConfig()
+		transports.TCP.ListenAddress = "/ip4/0.0.0.0/tcp/%d/ws"
+		err = CheckTransportsConfig(transports)
+		assert.True(t, errors.Is(err, ErrInvalidTransportAddress))
+	})
+	t.Run("tcp and ws on the same port should error", func(t *testing.T) {
+		t.Parallel()
+
+		transports := createTransportsConfig()
+		transports.WebSocketAddress = "/ip4/0.0.0.0/tcp/%d/ws"
+
+		err := CheckTransportsConfig(transports)
+		assert.True(t, errors.Is(err, ErrInvalidTransportAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		err := CheckTransportsConfig(createTransportsConfig())
+		assert.Nil(t, err)
+
+		transports := p2pConfig.P2PTransportConfig{
+			WebSocketAddress: "/ip4/0.0.0.0/tcp/%d/ws",
+		}
+		err = CheckTransportsConfig(transports)
+		assert.Nil(t, err)
+
+		transports = createTransportsConfig()
+		transports.WebSocketAddress = "/ip6/::/tcp/%d/ws"
+		transports.QUICAddress = "/ip4/0.0.0.0/udp/%d/quic-v1"
+		transports.WebTransportAddress = "/ip4/0.0.0.0/udp/%d/quic-v1/webtransport"
+		err = CheckTransportsConfig(transports)
+		assert.Nil(t, err)
+	})
+}
+
+func TestEnabledTransports(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{TransportTCP}, EnabledTransports(createTransportsConfig()))
+
+	transports := p2pConfig.P2PTransportConfig{
+		WebSocketAddress: "/ip4/0.0.0.0/tcp/%d/ws",
+		QUICAddress:      "/ip4/0.0.0.0/udp/%d/quic-v1",
+	}
+	assert.Equal(t, []string{TransportWebSocket, TransportQUIC}, EnabledTransports(transports))
+}
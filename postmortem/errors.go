@@ -0,0 +1,15 @@
+package postmortem
+
+import "errors"
+
+// ErrEmptyDirectory signals that an empty directory was provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidMaxSnapshots signals that an invalid maximum number of snapshots was provided
+var ErrInvalidMaxSnapshots = errors.New("invalid maximum number of snapshots")
+
+// ErrNilMetricsHolder signals that a nil metrics holder was provided
+var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
@@ -0,0 +1,224 @@
+package postmortem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	snapshotFileName   = "snapshot.json"
+	goroutinesFileName = "goroutines.txt"
+	snapshotDirFormat  = "2006-01-02T15-04-05Z"
+	goroutinesDebug    = 2
+	filesPermissions   = 0644
+	dirsPermissions    = 0755
+)
+
+var log = logger.GetOrCreate("postmortem")
+
+// ArgsPostmortemCapturer is the DTO used to create a new postmortem capturer
+type ArgsPostmortemCapturer struct {
+	Directory     string
+	MinInterval   time.Duration
+	MaxSnapshots  int
+	MetricsHolder core.MetricsHolder
+	Timer         core.Timer
+}
+
+type snapshot struct {
+	Reason    string                         `json:"reason"`
+	Timestamp int64                          `json:"timestamp"`
+	Data      map[string]interface{}         `json:"data,omitempty"`
+	Metrics   map[string]core.GeneralMetrics `json:"metrics"`
+}
+
+type postmortemCapturer struct {
+	mut           sync.Mutex
+	directory     string
+	minInterval   time.Duration
+	maxSnapshots  int
+	metricsHolder core.MetricsHolder
+	timer         core.Timer
+	lastCapture   int64
+	numCaptures   int
+}
+
+// NewPostmortemCapturer creates a component that writes a snapshot of the relayer state in a timestamped directory
+// each time a critical error occurs
+func NewPostmortemCapturer(args ArgsPostmortemCapturer) (*postmortemCapturer, error) {
+	if len(args.Directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.MaxSnapshots < 1 {
+		return nil, fmt.Errorf("%w, got %d", ErrInvalidMaxSnapshots, args.MaxSnapshots)
+	}
+	if check.IfNil(args.MetricsHolder) {
+		return nil, ErrNilMetricsHolder
+	}
+	if check.IfNil(args.Timer) {
+		return nil, ErrNilTimer
+	}
+
+	return &postmortemCapturer{
+		directory:     args.Directory,
+		minInterval:   args.MinInterval,
+		maxSnapshots:  args.MaxSnapshots,
+		metricsHolder: args.MetricsHolder,
+		timer:         args.Timer,
+	}, nil
+}
+
+// Capture writes a new snapshot containing the provided data, all the relayer metrics and a goroutine dump.
+// Captures closer than the minimum interval are skipped
+func (capturer *postmortemCapturer) Capture(reason string, data map[string]interface{}) {
+	snapshotDir, err := capturer.capture(reason, data)
+	if err != nil {
+		log.Error("failed to capture the postmortem snapshot", "reason", reason, "error", err)
+		return
+	}
+	if len(snapshotDir) == 0 {
+		log.Debug("postmortem snapshot skipped, the previous one is too recent", "reason", reason)
+		return
+	}
+
+	log.Warn("captured postmortem snapshot", "reason", reason, "directory", snapshotDir)
+}
+
+func (capturer *postmortemCapturer) capture(reason string, data map[string]interface{}) (string, error) {
+	capturer.mut.Lock()
+	defer capturer.mut.Unlock()
+
+	now := capturer.timer.NowUnix()
+	if capturer.numCaptures > 0 && time.Duration(now-capturer.lastCapture)*time.Second < capturer.minInterval {
+		return "", nil
+	}
+	capturer.lastCapture = now
+	capturer.numCaptures++
+
+	dirName := fmt.Sprintf("%s-%d", time.Unix(now, 0).UTC().Format(snapshotDirFormat), capturer.numCaptures)
+	snapshotDir := filepath.Join(capturer.directory, dirName)
+	err := os.MkdirAll(snapshotDir, dirsPermissions)
+	if err != nil {
+		return "", err
+	}
+
+	err = capturer.writeSnapshot(snapshotDir, reason, now, data)
+	if err != nil {
+		return "", err
+	}
+
+	err = writeGoroutinesDump(snapshotDir)
+	if err != nil {
+		return "", err
+	}
+
+	capturer.removeOldSnapshots()
+
+	return snapshotDir, nil
+}
+
+func (capturer *postmortemCapturer) writeSnapshot(snapshotDir string, reason string, timestamp int64, data map[string]interface{}) error {
+	snap := &snapshot{
+		Reason:    reason,
+		Timestamp: timestamp,
+		Data:      data,
+		Metrics:   make(map[string]core.GeneralMetrics),
+	}
+	for _, name := range capturer.metricsHolder.GetAvailableStatusHandlers() {
+		metrics, err := capturer.metricsHolder.GetAllMetrics(name)
+		if err != nil {
+			log.Debug("postmortemCapturer: can not get the metrics", "status handler", name, "error", err)
+			continue
+		}
+		snap.Metrics[name] = metrics
+	}
+
+	buff, err := json.MarshalIndent(snap, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(snapshotDir, snapshotFileName), buff, filesPermissions)
+}
+
+func writeGoroutinesDump(snapshotDir string) error {
+	file, err := os.Create(filepath.Join(snapshotDir, goroutinesFileName))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return pprof.Lookup("goroutine").WriteTo(file, goroutinesDebug)
+}
+
+func (capturer *postmortemCapturer) removeOldSnapshots() {
+	entries, err := os.ReadDir(capturer.directory)
+	if err != nil {
+		log.Debug("postmortemCapturer: can not read the snapshots directory", "error", err)
+		return
+	}
+
+	dirs := make([]os.DirEntry, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			dirs = append(dirs, entry)
+		}
+	}
+	if len(dirs) <= capturer.maxSnapshots {
+		return
+	}
+
+	sort.Slice(dirs, func(i, j int) bool {
+		infoI, errI := dirs[i].Info()
+		infoJ, errJ := dirs[j].Info()
+		if errI != nil || errJ != nil {
+			return dirs[i].Name() < dirs[j].Name()
+		}
+
+		return infoI.ModTime().Before(infoJ.ModTime())
+	})
+
+	for _, dir := range dirs[:len(dirs)-capturer.maxSnapshots] {
+		err = os.RemoveAll(filepath.Join(capturer.directory, dir.Name()))
+		if err != nil {
+			log.Debug("postmortemCapturer: can not remove old snapshot", "directory", dir.Name(), "error", err)
+		}
+	}
+}
+
+// BeforeStep does nothing
+func (capturer *postmortemCapturer) BeforeStep(_ context.Context, _ string, _ core.StepIdentifier) {
+}
+
+// AfterStep does nothing
+func (capturer *postmortemCapturer) AfterStep(_ context.Context, _ string, _ core.StepIdentifier, _ core.StepIdentifier, _ time.Duration) {
+}
+
+// OnError captures a snapshot when a state machine step fails
+func (capturer *postmortemCapturer) OnError(stateMachineName string, step core.StepIdentifier, err error) {
+	data := map[string]interface{}{
+		"stateMachine": stateMachineName,
+		"step":         string(step),
+		"error":        err.Error(),
+	}
+
+	capturer.Capture(fmt.Sprintf("%s state machine error on step %s", stateMachineName, step), data)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (capturer *postmortemCapturer) IsInterfaceNil() bool {
+	return capturer == nil
+}
@@ -0,0 +1,177 @@
+package postmortem
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/status"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgs(tb testing.TB) ArgsPostmortemCapturer {
+	return ArgsPostmortemCapturer{
+		Directory:     tb.TempDir(),
+		MinInterval:   time.Minute,
+		MaxSnapshots:  2,
+		MetricsHolder: status.NewMetricsHolder(),
+		Timer:         &testsCommon.TimerMock{},
+	}
+}
+
+func readSnapshotDirs(tb testing.TB, directory string) []string {
+	entries, err := os.ReadDir(directory)
+	require.Nil(tb, err)
+
+	dirs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		dirs = append(dirs, entry.Name())
+	}
+
+	return dirs
+}
+
+func TestNewPostmortemCapturer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Directory = ""
+		capturer, err := NewPostmortemCapturer(args)
+		assert.Equal(t, ErrEmptyDirectory, err)
+		assert.True(t, check.IfNil(capturer))
+	})
+	t.Run("invalid max snapshots should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.MaxSnapshots = 0
+		capturer, err := NewPostmortemCapturer(args)
+		assert.True(t, errors.Is(err, ErrInvalidMaxSnapshots))
+		assert.True(t, check.IfNil(capturer))
+	})
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.MetricsHolder = nil
+		capturer, err := NewPostmortemCapturer(args)
+		assert.Equal(t, ErrNilMetricsHolder, err)
+		assert.True(t, check.IfNil(capturer))
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.Timer = nil
+		capturer, err := NewPostmortemCapturer(args)
+		assert.Equal(t, ErrNilTimer, err)
+		assert.True(t, check.IfNil(capturer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		capturer, err := NewPostmortemCapturer(createMockArgs(t))
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(capturer))
+	})
+}
+
+func TestPostmortemCapturer_Capture(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should write the snapshot and the goroutines dump", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		statusHandler.SetStringMetric(core.MetricLastError, "last error")
+		_ = args.MetricsHolder.AddStatusHandler(statusHandler)
+		capturer, _ := NewPostmortemCapturer(args)
+
+		capturer.Capture("critical error", map[string]interface{}{"actionID": 37})
+
+		dirs := readSnapshotDirs(t, args.Directory)
+		require.Equal(t, 1, len(dirs))
+
+		buff, err := os.ReadFile(filepath.Join(args.Directory, dirs[0], snapshotFileName))
+		require.Nil(t, err)
+		snap := &snapshot{}
+		err = json.Unmarshal(buff, snap)
+		require.Nil(t, err)
+		assert.Equal(t, "critical error", snap.Reason)
+		assert.Equal(t, float64(37), snap.Data["actionID"])
+		assert.Equal(t, "last error", snap.Metrics["test"][core.MetricLastError])
+
+		buff, err = os.ReadFile(filepath.Join(args.Directory, dirs[0], goroutinesFileName))
+		require.Nil(t, err)
+		assert.True(t, strings.Contains(string(buff), "goroutine"))
+	})
+	t.Run("should skip the captures closer than the minimum interval", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := int64(1000)
+		args := createMockArgs(t)
+		timer := testsCommon.NewTimerStub()
+		timer.NowUnixCalled = func() int64 {
+			return currentTime
+		}
+		args.Timer = timer
+		capturer, _ := NewPostmortemCapturer(args)
+
+		capturer.Capture("error 1", nil)
+		currentTime += 59
+		capturer.Capture("error 2", nil)
+		assert.Equal(t, 1, len(readSnapshotDirs(t, args.Directory)))
+
+		currentTime += 1
+		capturer.Capture("error 3", nil)
+		assert.Equal(t, 2, len(readSnapshotDirs(t, args.Directory)))
+	})
+	t.Run("should remove the oldest snapshots", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs(t)
+		args.MinInterval = 0
+		capturer, _ := NewPostmortemCapturer(args)
+
+		for i := 0; i < 4; i++ {
+			capturer.Capture("error", nil)
+			time.Sleep(time.Millisecond * 10)
+		}
+
+		dirs := readSnapshotDirs(t, args.Directory)
+		require.Equal(t, 2, len(dirs))
+		assert.True(t, strings.HasSuffix(dirs[0], "-3") || strings.HasSuffix(dirs[0], "-4"))
+		assert.True(t, strings.HasSuffix(dirs[1], "-3") || strings.HasSuffix(dirs[1], "-4"))
+	})
+}
+
+func TestPostmortemCapturer_OnError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs(t)
+	capturer, _ := NewPostmortemCapturer(args)
+
+	capturer.BeforeStep(nil, "test", "step")
+	capturer.AfterStep(nil, "test", "step", "next step", time.Second)
+	assert.Equal(t, 0, len(readSnapshotDirs(t, args.Directory)))
+
+	capturer.OnError("test", "step", errors.New("step not found"))
+
+	dirs := readSnapshotDirs(t, args.Directory)
+	require.Equal(t, 1, len(dirs))
+	buff, err := os.ReadFile(filepath.Join(args.Directory, dirs[0], snapshotFileName))
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(string(buff), "step not found"))
+}
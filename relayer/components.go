@@ -0,0 +1,331 @@
+package relayer
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/audit"
+	ethmultiversx "github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/clients/dependenciesWaiter"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/configDrift"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/timer"
+	"github.com/multiversx/mx-bridge-eth-go/errorReporting"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-bridge-eth-go/retention"
+	"github.com/multiversx/mx-bridge-eth-go/sla"
+	"github.com/multiversx/mx-bridge-eth-go/status"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+)
+
+type closableSignerAuditLog struct {
+	ethmultiversx.SignerAuditLog
+	closers []func() error
+}
+
+// Close closes the inner components of the signer audit log
+func (auditLog *closableSignerAuditLog) Close() error {
+	return closeAll(auditLog.closers)
+}
+
+func createSignerAuditLog(log logger.Logger, cfg config.SignerAuditLogConfig, dbFullPath string) (*closableSignerAuditLog, error) {
+	if !cfg.Enabled {
+		log.Debug("signer audit log is disabled")
+		return &closableSignerAuditLog{
+			SignerAuditLog: disabled.NewDisabledSignerAuditLog(),
+		}, nil
+	}
+
+	storer, err := factory.CreateUnitStorer(cfg.Storage, dbFullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ntpTimer := timer.NewNTPTimer()
+	ntpTimer.Start()
+
+	auditLog, err := audit.NewSignerAuditLog(audit.ArgsSignerAuditLog{
+		Name:   core.SignerAuditLogName,
+		Storer: storer,
+		Timer:  ntpTimer,
+	})
+	if err != nil {
+		_ = ntpTimer.Close()
+		_ = storer.Close()
+		return nil, err
+	}
+
+	return &closableSignerAuditLog{
+		SignerAuditLog: auditLog,
+		closers:        []func() error{ntpTimer.Close, storer.Close},
+	}, nil
+}
+
+type closableSLATracker struct {
+	factory.SLATracker
+	closers []func() error
+}
+
+// Close closes the inner components of the SLA tracker
+func (tracker *closableSLATracker) Close() error {
+	return closeAll(tracker.closers)
+}
+
+func createSLATracker(log logger.Logger, cfg config.SLAConfig, dbFullPath string) (*closableSLATracker, error) {
+	if !cfg.Enabled {
+		log.Debug("SLA tracking is disabled")
+		return &closableSLATracker{
+			SLATracker: disabled.NewDisabledSLATracker(),
+		}, nil
+	}
+
+	storer, err := factory.CreateUnitStorer(cfg.Storage, dbFullPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ntpTimer := timer.NewNTPTimer()
+	ntpTimer.Start()
+
+	tracker, err := sla.NewSLATracker(sla.ArgsSLATracker{
+		Storer:            storer,
+		Timer:             ntpTimer,
+		HeartbeatInterval: time.Duration(cfg.HeartbeatIntervalInSeconds) * time.Second,
+	})
+	if err != nil {
+		_ = ntpTimer.Close()
+		_ = storer.Close()
+		return nil, err
+	}
+
+	return &closableSLATracker{
+		SLATracker: tracker,
+		closers:    []func() error{tracker.Close, ntpTimer.Close, storer.Close},
+	}, nil
+}
+
+func closeAll(closers []func() error) error {
+	var lastErr error
+	for _, closer := range closers {
+		err := closer()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+type closableErrorReporter interface {
+	factory.ErrorReporter
+	io.Closer
+}
+
+// createErrorReporter creates the reporter of the critical errors, a disabled one unless enabled from the config
+func createErrorReporter(log logger.Logger, cfg config.ErrorReportingConfig, appVersion string) (closableErrorReporter, error) {
+	if !cfg.Enabled {
+		log.Debug("error reporting is disabled")
+		return disabled.NewDisabledErrorReporter(), nil
+	}
+
+	serverName, err := os.Hostname()
+	if err != nil {
+		log.Warn("can not get the host name for the error reports", "error", err)
+	}
+
+	return errorReporting.NewSentryReporter(errorReporting.ArgsSentryReporter{
+		DSN:            cfg.DSN,
+		Release:        appVersion,
+		Environment:    cfg.Environment,
+		ServerName:     serverName,
+		RequestTimeout: time.Duration(cfg.RequestTimeoutInSeconds) * time.Second,
+		QueueSize:      cfg.QueueSize,
+	})
+}
+
+// createConfigDriftAuditor creates the component comparing the running config with the config file, a disabled one if
+// the config file can not be loaded
+func createConfigDriftAuditor(args ArgsRelayer, runningConfig configDrift.RunningConfigHolder) (core.ConfigDriftAuditor, error) {
+	if args.LoadConfigFile == nil {
+		args.Log.Debug("the config drift is not reported as no config file loader was provided")
+		return disabled.NewDisabledConfigDriftAuditor(), nil
+	}
+
+	return configDrift.NewDriftAuditor(configDrift.ArgsDriftAuditor{
+		ConfigFile:     args.Configs.FlagsConfig.ConfigurationFile,
+		RunningConfig:  runningConfig,
+		Loggers:        core.GetLoggersRegistry(),
+		LoadConfigFile: args.LoadConfigFile,
+	})
+}
+
+type closableMultiversXProxy struct {
+	multiversx.Proxy
+	closers []func() error
+}
+
+// Close closes the inner components of the MultiversX proxy
+func (proxy *closableMultiversXProxy) Close() error {
+	return closeAll(proxy.closers)
+}
+
+// createMultiversXProxy creates the proxy of the MultiversX.NetworkAddress. If backup proxies are configured, the
+// proxies are wrapped so the requests fail over to the next proxy when the active one errors or is out of sync
+func createMultiversXProxy(log logger.Logger, cfg config.MultiversXConfig) (*closableMultiversXProxy, error) {
+	networkAddresses := append([]string{cfg.NetworkAddress}, cfg.Proxy.Failover.NetworkAddresses...)
+	proxies := make([]multiversx.Proxy, 0, len(networkAddresses))
+	for _, networkAddress := range networkAddresses {
+		argsProxy := blockchain.ArgsProxy{
+			ProxyURL:            networkAddress,
+			SameScState:         false,
+			ShouldBeSynced:      false,
+			FinalityCheck:       cfg.Proxy.FinalityCheck,
+			AllowedDeltaToFinal: cfg.Proxy.MaxNoncesDelta,
+			CacheExpirationTime: time.Second * time.Duration(cfg.Proxy.CacherExpirationSeconds),
+			EntityType:          sdkCore.RestAPIEntityType(cfg.Proxy.RestAPIEntityType),
+		}
+		proxy, err := blockchain.NewProxy(argsProxy)
+		if err != nil {
+			return nil, err
+		}
+
+		proxies = append(proxies, proxy)
+	}
+
+	if len(proxies) == 1 {
+		return &closableMultiversXProxy{
+			Proxy: proxies[0],
+		}, nil
+	}
+
+	ntpTimer := timer.NewNTPTimer()
+	ntpTimer.Start()
+
+	proxy, err := multiversx.NewFailoverProxy(multiversx.ArgsFailoverProxy{
+		Proxies:         proxies,
+		Names:           networkAddresses,
+		Log:             log,
+		Timer:           ntpTimer,
+		CheckInterval:   time.Second * time.Duration(cfg.Proxy.Failover.CheckIntervalInSeconds),
+		MaxNoncesBehind: cfg.Proxy.Failover.MaxNoncesBehind,
+	})
+	if err != nil {
+		_ = ntpTimer.Close()
+		return nil, err
+	}
+
+	log.Debug("MultiversX proxy failover enabled", "num proxies", len(proxies))
+
+	return &closableMultiversXProxy{
+		Proxy:   proxy,
+		closers: []func() error{proxy.Close, ntpTimer.Close},
+	}, nil
+}
+
+// waitForDependencies blocks, if enabled, until the Ethereum RPC node and the MultiversX proxy are reachable. The
+// dialed Ethereum client is used as the light mode probes, done while creating the Ethereum backend, need the node.
+// The wait is interrupted when the provided context is done
+func waitForDependencies(
+	ctx context.Context,
+	log logger.Logger,
+	cfg config.DependenciesWaitConfig,
+	ethClient dependenciesWaiter.EthereumClient,
+	proxy dependenciesWaiter.MultiversXProxy,
+) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	argsDependenciesWaiter := dependenciesWaiter.ArgsDependenciesWaiter{
+		Log:             log,
+		EthereumClient:  ethClient,
+		MultiversXProxy: proxy,
+		InitialBackoff:  time.Millisecond * time.Duration(cfg.InitialBackoffInMillis),
+		MaxBackoff:      time.Second * time.Duration(cfg.MaxBackoffInSeconds),
+		MaxWait:         time.Second * time.Duration(cfg.MaxWaitInSeconds),
+		RequestTimeout:  time.Second * time.Duration(cfg.RequestTimeoutInSeconds),
+	}
+	waiter, err := dependenciesWaiter.NewDependenciesWaiter(argsDependenciesWaiter)
+	if err != nil {
+		return err
+	}
+
+	return waiter.WaitForDependencies(ctx)
+}
+
+// createStatusStorer creates the storer of the status metrics, migrating the keys persisted by an older relayer version.
+// Unless disabled from the config, the writes are saved on a separate go routine and the storer's own metrics are added
+// in the metrics holder
+func createStatusStorer(
+	log logger.Logger,
+	cfg config.ConfigRelayer,
+	dbFullPath string,
+	metricsHolder core.MetricsHolder,
+	statusHandlersNames []string,
+) (core.Storer, error) {
+	unitStorer, err := factory.CreateUnitStorer(cfg.StatusMetricsStorage, dbFullPath)
+	if err != nil {
+		return nil, err
+	}
+	statusStorer, err := applyStorageRetention(log, unitStorer, "status-metrics", cfg.StorageRetention)
+	if err != nil {
+		return nil, err
+	}
+	err = status.MigrateStatusKeys(statusStorer, statusHandlersNames)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.StatusWriteBuffer == 0 {
+		log.Debug("the status metrics are saved synchronously")
+		return statusStorer, nil
+	}
+
+	storerStatusHandler, err := status.NewStatusHandler(core.StatusStorerStatusHandlerName, statusStorer)
+	if err != nil {
+		return nil, err
+	}
+	err = metricsHolder.AddStatusHandler(storerStatusHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	return status.NewAsyncStorer(status.ArgsAsyncStorer{
+		Storer:        statusStorer,
+		StatusHandler: storerStatusHandler,
+		BufferSize:    cfg.StatusWriteBuffer,
+	})
+}
+
+// getStatusHandlersNames returns the names of all the status handlers the relayer might create, the state machines
+// included
+func getStatusHandlersNames(cfg config.Config) []string {
+	names := make([]string, 0, len(core.StatusHandlersNames)+len(cfg.StateMachine))
+	names = append(names, core.StatusHandlersNames...)
+	for name := range cfg.StateMachine {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// applyStorageRetention wraps the provided storer in a retention storer, unless the retention is disabled from the config
+func applyStorageRetention(log logger.Logger, storer core.RemovableStorer, name string, cfg config.StorageRetentionConfig) (core.RemovableStorer, error) {
+	if cfg.RetentionInDays == 0 {
+		log.Debug("the storage retention is disabled", "storage", name)
+		return storer, nil
+	}
+
+	return retention.NewRetentionStorer(retention.ArgsRetentionStorer{
+		Storer:          storer,
+		Name:            name,
+		RetentionPeriod: time.Duration(cfg.RetentionInDays) * time.Hour * 24,
+		SweepInterval:   time.Duration(cfg.SweepIntervalInMinutes) * time.Minute,
+	})
+}
@@ -0,0 +1,12 @@
+package relayer
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrEmptyDBPath signals that an empty database directory was provided
+var ErrEmptyDBPath = errors.New("empty database path")
+
+// ErrEmptyMultiversXNetworkAddress signals that the MultiversX proxy address is missing from the config
+var ErrEmptyMultiversXNetworkAddress = errors.New("empty MultiversX.NetworkAddress in config file")
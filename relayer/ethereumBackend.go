@@ -0,0 +1,50 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const lightModeProbeTimeout = time.Second * 30
+
+// CreateEthereumBackend returns the ethereum client wrapped according to the configured RPC mode. In light mode, the
+// JSON-RPC methods used by the relayer are probed once, at startup
+func CreateEthereumBackend(log logger.Logger, cfg config.EthereumConfig, ethClient *ethclient.Client) (wrappers.EthereumBackend, error) {
+	switch cfg.RPCMode {
+	case "", wrappers.FullRPCMode:
+		return ethClient, nil
+	case wrappers.LightRPCMode:
+	default:
+		return nil, fmt.Errorf("invalid Eth.RPCMode %s, the available options are %s and %s",
+			cfg.RPCMode, wrappers.FullRPCMode, wrappers.LightRPCMode)
+	}
+
+	err := wrappers.CheckLightModeNetworkAddress(cfg.NetworkAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	backend, err := wrappers.NewLightModeBackend(ethClient)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lightModeProbeTimeout)
+	defer cancel()
+
+	err = wrappers.ProbeLightModeCapabilities(ctx, backend, ethCommon.HexToAddress(cfg.MultisigContractAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("using the ethereum client in light mode", "network address", cfg.NetworkAddress)
+
+	return backend, nil
+}
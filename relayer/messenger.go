@@ -0,0 +1,112 @@
+package relayer
+
+import (
+	"strings"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
+	"github.com/multiversx/mx-chain-communication-go/p2p/libp2p"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1"
+	"github.com/multiversx/mx-chain-crypto-go/signing/secp256k1/singlesig"
+	p2pConfig "github.com/multiversx/mx-chain-go/p2p/config"
+	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	"github.com/multiversx/mx-chain-go/update/disabled"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	p2pPeerNetworkDiscoverer = "optimized"
+	nilListSharderType       = "NilListSharder"
+	disabledWatcher          = "disabled"
+)
+
+// BuildNetMessenger creates the libp2p messenger used by the relayer to talk with the other relayers
+func BuildNetMessenger(log logger.Logger, cfg config.Config, marshalizer marshal.Marshalizer) (p2p.NetMessenger, error) {
+	args, err := CreateNetMessengerArgs(log, cfg, marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	return libp2p.NewNetworkMessenger(args)
+}
+
+// CreateNetMessengerArgs creates the arguments of the libp2p messenger from the P2P section of the config
+func CreateNetMessengerArgs(log logger.Logger, cfg config.Config, marshalizer marshal.Marshalizer) (libp2p.ArgsNetworkMessenger, error) {
+	err := p2p.CheckTransportsConfig(cfg.P2P.Transports)
+	if err != nil {
+		return libp2p.ArgsNetworkMessenger{}, err
+	}
+	log.Debug("P2P transports", "enabled", strings.Join(p2p.EnabledTransports(cfg.P2P.Transports), ", "),
+		"port", cfg.P2P.Port)
+
+	nodeConfig := p2pConfig.NodeConfig{
+		Port:                       cfg.P2P.Port,
+		MaximumExpectedPeerCount:   0,
+		ThresholdMinConnectedPeers: 0,
+		Transports:                 cfg.P2P.Transports,
+		ResourceLimiter:            cfg.P2P.ResourceLimiter,
+	}
+	peerDiscoveryConfig := p2pConfig.KadDhtPeerDiscoveryConfig{
+		Enabled:                          true,
+		RefreshIntervalInSec:             5,
+		ProtocolID:                       cfg.P2P.ProtocolID,
+		InitialPeerList:                  cfg.P2P.InitialPeerList,
+		BucketSize:                       0,
+		RoutingTableRefreshIntervalInSec: 300,
+		Type:                             p2pPeerNetworkDiscoverer,
+	}
+
+	p2pCfg := p2pConfig.P2PConfig{
+		Node:                nodeConfig,
+		KadDhtPeerDiscovery: peerDiscoveryConfig,
+		Sharding: p2pConfig.ShardingConfig{
+			TargetPeerCount:         0,
+			MaxIntraShardValidators: 0,
+			MaxCrossShardValidators: 0,
+			MaxIntraShardObservers:  0,
+			MaxCrossShardObservers:  0,
+			Type:                    nilListSharderType,
+		},
+	}
+
+	p2pLog := logger.GetOrCreate("p2p")
+	topRatedCache, err := cache.NewLRUCache(cfg.PeersRatingConfig.TopRatedCacheCapacity)
+	if err != nil {
+		return libp2p.ArgsNetworkMessenger{}, err
+	}
+	badRatedCache, err := cache.NewLRUCache(cfg.PeersRatingConfig.BadRatedCacheCapacity)
+	if err != nil {
+		return libp2p.ArgsNetworkMessenger{}, err
+	}
+	argsPeersRatingHandler := p2pFactory.ArgPeersRatingHandler{
+		TopRatedCache: topRatedCache,
+		BadRatedCache: badRatedCache,
+		Logger:        p2pLog,
+	}
+	peersRatingHandler, err := p2pFactory.NewPeersRatingHandler(argsPeersRatingHandler)
+	if err != nil {
+		return libp2p.ArgsNetworkMessenger{}, err
+	}
+
+	p2pSingleSigner := &singlesig.Secp256k1Signer{}
+	p2pKeyGen := signing.NewKeyGenerator(secp256k1.NewSecp256k1())
+	p2pPrivKey, _ := p2pKeyGen.GeneratePair()
+
+	args := libp2p.ArgsNetworkMessenger{
+		Marshaller:            marshalizer,
+		P2pConfig:             p2pCfg,
+		SyncTimer:             &libp2p.LocalSyncTimer{},
+		PreferredPeersHolder:  disabled.NewPreferredPeersHolder(),
+		PeersRatingHandler:    peersRatingHandler,
+		ConnectionWatcherType: disabledWatcher,
+		P2pPrivateKey:         p2pPrivKey,
+		P2pSingleSigner:       p2pSingleSigner,
+		P2pKeyGenerator:       p2pKeyGen,
+		Logger:                p2pLog,
+	}
+
+	return args, nil
+}
@@ -0,0 +1,383 @@
+package relayer
+
+import (
+	"context"
+	"io"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
+	"github.com/multiversx/mx-bridge-eth-go/results"
+	"github.com/multiversx/mx-bridge-eth-go/status"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/statusHandler"
+	"github.com/multiversx/mx-chain-go/statusHandler/persister"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	timeForBootstrap     = time.Second * 20
+	timeBeforeRepeatJoin = time.Minute * 5
+)
+
+// ArgsRelayer is the DTO used to create a new relayer. The messenger and the storers are optional, the ones not
+// provided being created from the config. The provided ones are not closed by the relayer
+type ArgsRelayer struct {
+	Configs config.Configs
+	// DBPath is the directory holding the databases created by the relayer
+	DBPath     string
+	AppVersion string
+	GitCommit  string
+	Log        logger.Logger
+	Messenger  p2p.NetMessenger
+	// StatusStorer holds the status metrics, created with the migration of the old keys and the write buffer if nil
+	StatusStorer core.Storer
+	// BatchResultsStorer holds the batch results, the transfer receipts and the execution costs
+	BatchResultsStorer core.Storer
+	// LoadConfigFile loads the config file as it would be loaded on a restart, used to report the drift of the running
+	// config. The config drift is not reported if nil
+	LoadConfigFile func() (config.Config, error)
+}
+
+type bridgeComponents interface {
+	Start(ctx context.Context) error
+	ReloadConfig(cfg config.Config) error
+	Close() error
+}
+
+type relayer struct {
+	log        logger.Logger
+	components bridgeComponents
+	webServer  io.Closer
+	closers    []io.Closer
+}
+
+// New creates a relayer, with all its components, that can be embedded in other Go services. The relayer connects to
+// the configured chains, so the Ethereum RPC node and the MultiversX proxy should be reachable. The provided context
+// interrupts the startup requests, the handling of the process signals being left to the embedding service
+func New(ctx context.Context, args ArgsRelayer) (*relayer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	instance := &relayer{
+		log:     args.Log,
+		closers: make([]io.Closer, 0),
+	}
+	err = instance.createComponents(ctx, args)
+	if err != nil {
+		// the components created so far are released, the error being the one that stopped the creation
+		_ = instance.Close()
+		return nil, err
+	}
+
+	return instance, nil
+}
+
+func checkArgs(args ArgsRelayer) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if len(args.DBPath) == 0 {
+		return ErrEmptyDBPath
+	}
+	if len(args.Configs.GeneralConfig.MultiversX.NetworkAddress) == 0 {
+		return ErrEmptyMultiversXNetworkAddress
+	}
+
+	return nil
+}
+
+func (instance *relayer) createComponents(ctx context.Context, args ArgsRelayer) error {
+	cfg := args.Configs.GeneralConfig
+
+	signerAuditLog, err := createSignerAuditLog(instance.log, cfg.Relayer.SignerAuditLog, args.DBPath)
+	if err != nil {
+		return err
+	}
+	instance.addCloser(signerAuditLog)
+
+	slaTracker, err := createSLATracker(instance.log, cfg.Relayer.SLA, args.DBPath)
+	if err != nil {
+		return err
+	}
+	instance.addCloser(slaTracker)
+
+	errorReporter, err := createErrorReporter(instance.log, cfg.Relayer.ErrorReporting, args.AppVersion)
+	if err != nil {
+		return err
+	}
+	instance.addCloser(errorReporter)
+
+	batchResultsStorer := args.BatchResultsStorer
+	if check.IfNil(batchResultsStorer) {
+		batchResultsStorer, err = instance.createBatchResultsStorer(cfg.Relayer, args.DBPath)
+		if err != nil {
+			return err
+		}
+	}
+	batchResults, err := results.NewResultsStorer(results.ArgsResultsStorer{
+		Storer: batchResultsStorer,
+	})
+	if err != nil {
+		return err
+	}
+
+	metricsHolder := status.NewMetricsHolder()
+	statusStorer := args.StatusStorer
+	if check.IfNil(statusStorer) {
+		statusStorer, err = createStatusStorer(instance.log, cfg.Relayer, args.DBPath, metricsHolder, getStatusHandlersNames(cfg))
+		if err != nil {
+			return err
+		}
+		instance.addCloser(statusStorer)
+	}
+
+	ethClientStatusHandler, err := status.NewStatusHandler(core.EthClientStatusHandlerName, statusStorer)
+	if err != nil {
+		return err
+	}
+	err = metricsHolder.AddStatusHandler(ethClientStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	multiversXClientStatusHandler, err := status.NewStatusHandler(core.MultiversXClientStatusHandlerName, statusStorer)
+	if err != nil {
+		return err
+	}
+	err = metricsHolder.AddStatusHandler(multiversXClientStatusHandler)
+	if err != nil {
+		return err
+	}
+
+	marshaller, err := factoryMarshaller.NewMarshalizer(cfg.Relayer.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	messenger := args.Messenger
+	if check.IfNil(messenger) {
+		messenger, err = BuildNetMessenger(instance.log, cfg, marshaller)
+		if err != nil {
+			return err
+		}
+	}
+
+	proxy, err := createMultiversXProxy(instance.log, cfg.MultiversX)
+	if err != nil {
+		return err
+	}
+	instance.addCloser(proxy)
+
+	dialedEthClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	if err != nil {
+		return err
+	}
+
+	err = waitForDependencies(ctx, instance.log, cfg.Relayer.DependenciesWait, dialedEthClient, proxy)
+	if err != nil {
+		return err
+	}
+
+	ethClient, err := CreateEthereumBackend(instance.log, cfg.Eth, dialedEthClient)
+	if err != nil {
+		return err
+	}
+
+	bridgeEthAddress := ethCommon.HexToAddress(cfg.Eth.MultisigContractAddress)
+	multiSigInstance, err := contract.NewBridge(bridgeEthAddress, ethClient)
+	if err != nil {
+		return err
+	}
+
+	safeEthAddress := ethCommon.HexToAddress(cfg.Eth.SafeContractAddress)
+	safeInstance, err := contract.NewERC20Safe(safeEthAddress, ethClient)
+	if err != nil {
+		return err
+	}
+
+	argsContractsHolder := ethereum.ArgsErc20SafeContractsHolder{
+		EthClient:              ethClient,
+		EthClientStatusHandler: ethClientStatusHandler,
+	}
+	erc20ContractsHolder, err := ethereum.NewErc20SafeContractsHolder(argsContractsHolder)
+	if err != nil {
+		return err
+	}
+
+	argsClientWrapper := wrappers.ArgsEthereumChainWrapper{
+		StatusHandler:    ethClientStatusHandler,
+		MultiSigContract: multiSigInstance,
+		SafeContract:     safeInstance,
+		BlockchainClient: ethClient,
+	}
+
+	clientWrapper, err := wrappers.NewEthereumChainWrapper(argsClientWrapper)
+	if err != nil {
+		return err
+	}
+
+	var appStatusHandlers []chainCore.AppStatusHandler
+	statusMetrics := statusHandler.NewStatusMetrics()
+	appStatusHandlers = append(appStatusHandlers, statusMetrics)
+
+	persistentHandler, err := persister.NewPersistentStatusHandler(marshaller, uint64ByteSlice.NewBigEndianConverter())
+	if err != nil {
+		return err
+	}
+	appStatusHandlers = append(appStatusHandlers, persistentHandler)
+	appStatusHandler, err := statusHandler.NewAppStatusFacadeWithHandlers(appStatusHandlers...)
+	if err != nil {
+		return err
+	}
+
+	argsBridge := factory.ArgsEthereumToMultiversXBridge{
+		Configs:                       args.Configs,
+		Messenger:                     messenger,
+		StatusStorer:                  statusStorer,
+		Proxy:                         proxy,
+		Erc20ContractsHolder:          erc20ContractsHolder,
+		ClientWrapper:                 clientWrapper,
+		TimeForBootstrap:              timeForBootstrap,
+		TimeBeforeRepeatJoin:          timeBeforeRepeatJoin,
+		MetricsHolder:                 metricsHolder,
+		AppStatusHandler:              appStatusHandler,
+		MultiversXClientStatusHandler: multiversXClientStatusHandler,
+		SignerAuditLog:                signerAuditLog,
+		SLATracker:                    slaTracker,
+		ErrorReporter:                 errorReporter,
+		BatchResultsStorer:            batchResults,
+	}
+
+	ethToMultiversXComponents, err := factory.NewEthMultiversXBridgeComponents(argsBridge)
+	if err != nil {
+		return err
+	}
+	instance.components = ethToMultiversXComponents
+
+	runtimeInfo := createRuntimeInfo(instance.log, args, clientWrapper, proxy, ethToMultiversXComponents)
+	logRuntimeInfo(instance.log, runtimeInfo)
+
+	configSchema, err := schema.Generate(config.Config{})
+	if err != nil {
+		return err
+	}
+
+	configDriftAuditor, err := createConfigDriftAuditor(args, ethToMultiversXComponents)
+	if err != nil {
+		return err
+	}
+
+	argsWebServer := factory.ArgsWebServer{
+		Configs:        args.Configs,
+		MetricsHolder:  metricsHolder,
+		BatchResults:   batchResults,
+		RuntimeInfo:    runtimeInfo,
+		Topology:       ethToMultiversXComponents,
+		ExportedTxs:    ethToMultiversXComponents,
+		SyncReport:     ethToMultiversXComponents,
+		RelayedClaims:  ethToMultiversXComponents,
+		BalanceProof:   ethToMultiversXComponents,
+		FeeEstimator:   ethToMultiversXComponents,
+		Incidents:      ethToMultiversXComponents,
+		DeadLetters:    ethToMultiversXComponents,
+		DirectMessages: ethToMultiversXComponents,
+		OperatorPause:  ethToMultiversXComponents,
+		BatchExplorer:  ethToMultiversXComponents,
+		ConfigDrift:    configDriftAuditor,
+		ConfigSchema:   configSchema,
+	}
+	webServer, err := factory.StartWebServer(argsWebServer)
+	if err != nil {
+		return err
+	}
+	instance.webServer = webServer
+
+	return nil
+}
+
+func (instance *relayer) createBatchResultsStorer(cfg config.ConfigRelayer, dbPath string) (core.Storer, error) {
+	unitStorer, err := factory.CreateUnitStorer(cfg.BatchResultsStorage, dbPath)
+	if err != nil {
+		return nil, err
+	}
+	batchResultsStorer, err := applyStorageRetention(instance.log, unitStorer, "batch-results", cfg.StorageRetention)
+	if err != nil {
+		_ = unitStorer.Close()
+		return nil, err
+	}
+	instance.addCloser(batchResultsStorer)
+
+	return batchResultsStorer, nil
+}
+
+func (instance *relayer) addCloser(closer io.Closer) {
+	instance.closers = append(instance.closers, closer)
+}
+
+// Run starts the relayer and blocks until the provided context is done. The context also interrupts the startup
+// sequence, e.g. the p2p bootstrap. The relayer should be closed after Run returns
+func (instance *relayer) Run(ctx context.Context) error {
+	instance.log.Info("starting relay")
+
+	err := instance.components.Start(ctx)
+	if err != nil {
+		return err
+	}
+
+	<-ctx.Done()
+	instance.log.Info("relay stopped", "reason", ctx.Err())
+
+	return nil
+}
+
+// ReloadConfig applies the reloadable settings of the provided config to the running relayer: the gas station
+// settings, the polling intervals and the state machines step durations
+func (instance *relayer) ReloadConfig(cfg config.Config) error {
+	return instance.components.ReloadConfig(cfg)
+}
+
+// Close closes all the components created by the relayer, the bridge components and the web server being closed before
+// the storers they use
+func (instance *relayer) Close() error {
+	closers := make([]io.Closer, 0, len(instance.closers)+2)
+	if instance.components != nil {
+		closers = append(closers, instance.components)
+	}
+	if instance.webServer != nil {
+		closers = append(closers, instance.webServer)
+	}
+	closers = append(closers, instance.closers...)
+
+	var lastErr error
+	for _, closer := range closers {
+		err := closer.Close()
+		if err != nil {
+			instance.log.Error("error closing relayer component", "error", err)
+			lastErr = err
+		}
+	}
+	instance.components = nil
+	instance.webServer = nil
+	instance.closers = nil
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (instance *relayer) IsInterfaceNil() bool {
+	return instance == nil
+}
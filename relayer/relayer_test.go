@@ -0,0 +1,171 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsRelayer() ArgsRelayer {
+	return ArgsRelayer{
+		Configs: config.Configs{
+			GeneralConfig: config.Config{
+				MultiversX: config.MultiversXConfig{
+					NetworkAddress: "http://127.0.0.1:8079",
+				},
+			},
+		},
+		DBPath: "db",
+		Log:    &testsCommon.LoggerStub{},
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayer()
+		args.Log = nil
+
+		instance, err := New(context.Background(), args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("empty database path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayer()
+		args.DBPath = ""
+
+		instance, err := New(context.Background(), args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrEmptyDBPath, err)
+	})
+	t.Run("empty MultiversX network address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayer()
+		args.Configs.GeneralConfig.MultiversX.NetworkAddress = ""
+
+		instance, err := New(context.Background(), args)
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrEmptyMultiversXNetworkAddress, err)
+	})
+	t.Run("invalid marshaller should error and release the provided storers", func(t *testing.T) {
+		t.Parallel()
+
+		statusStorerClosed := false
+		statusStorer := &testsCommon.StorerStub{
+			CloseCalled: func() error {
+				statusStorerClosed = true
+				return nil
+			},
+		}
+		args := createMockArgsRelayer()
+		args.StatusStorer = statusStorer
+		args.BatchResultsStorer = testsCommon.NewStorerMock()
+		args.Configs.GeneralConfig.Relayer.Marshalizer.Type = "unknown"
+
+		instance, err := New(context.Background(), args)
+		assert.True(t, check.IfNil(instance))
+		assert.NotNil(t, err)
+		assert.False(t, statusStorerClosed, "the provided storers are owned by the caller")
+	})
+}
+
+func TestRelayer_Close(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	closedOrder := make([]string, 0)
+	createCloser := func(name string, err error) io.Closer {
+		return &testsCommon.StorerStub{
+			CloseCalled: func() error {
+				closedOrder = append(closedOrder, name)
+				return err
+			},
+		}
+	}
+	instance := &relayer{
+		log:       &testsCommon.LoggerStub{},
+		closers:   []io.Closer{createCloser("storer", nil)},
+		webServer: createCloser("web server", expectedErr),
+	}
+
+	err := instance.Close()
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, []string{"web server", "storer"}, closedOrder)
+
+	err = instance.Close()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"web server", "storer"}, closedOrder)
+}
+
+func TestGetStatusHandlersNames(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{
+		StateMachine: map[string]config.ConfigStateMachine{
+			"EthereumToMultiversX": {},
+		},
+	}
+
+	names := getStatusHandlersNames(cfg)
+	assert.Equal(t, len(core.StatusHandlersNames)+1, len(names))
+	assert.Contains(t, names, "EthereumToMultiversX")
+	assert.Contains(t, names, core.EthClientStatusHandlerName)
+}
+
+func TestApplyStorageRetention(t *testing.T) {
+	t.Parallel()
+
+	storer := testsCommon.NewStorerMock()
+	result, err := applyStorageRetention(&testsCommon.LoggerStub{}, storer, "test", config.StorageRetentionConfig{})
+	assert.Nil(t, err)
+	assert.True(t, storer == result)
+}
+
+func TestCreateConfigDriftAuditor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without config loader should return a disabled auditor", func(t *testing.T) {
+		t.Parallel()
+
+		auditor, err := createConfigDriftAuditor(createMockArgsRelayer(), &testsCommon.RunningConfigHolderStub{})
+		assert.Nil(t, err)
+		assert.Equal(t, "*disabled.disabledConfigDriftAuditor", fmt.Sprintf("%T", auditor))
+	})
+	t.Run("with config loader should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRelayer()
+		args.Configs.FlagsConfig.ConfigurationFile = "config.toml"
+		args.LoadConfigFile = func() (config.Config, error) {
+			return args.Configs.GeneralConfig, nil
+		}
+		runningConfig := &testsCommon.RunningConfigHolderStub{
+			RunningConfigCalled: func() config.Config {
+				return args.Configs.GeneralConfig
+			},
+		}
+
+		auditor, err := createConfigDriftAuditor(args, runningConfig)
+		assert.Nil(t, err)
+		assert.Equal(t, "*configDrift.driftAuditor", fmt.Sprintf("%T", auditor))
+
+		report, err := auditor.GetConfigDrift()
+		assert.Nil(t, err)
+		assert.Equal(t, "config.toml", report.ConfigFile)
+		assert.Empty(t, report.Drifts)
+	})
+}
@@ -0,0 +1,194 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/topology"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const runtimeInfoRequestsTimeout = time.Second * 10
+
+type runtimeInfoEthereumChain interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	Quorum(ctx context.Context) (*big.Int, error)
+}
+
+type runtimeInfoMultiversXChain interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+}
+
+type runtimeInfoBridgeComponents interface {
+	MultiversXRelayerAddress() sdkCore.AddressHandler
+	EthereumRelayerAddress() ethCommon.Address
+	GetMultiversXQuorum(ctx context.Context) (uint64, error)
+}
+
+// createRuntimeInfo gathers the structured information about the running relayer. The values that need to be fetched
+// from the chains are left empty if they can not be fetched at startup, the relayer must not be stopped by them
+func createRuntimeInfo(
+	log logger.Logger,
+	args ArgsRelayer,
+	ethereumChain runtimeInfoEthereumChain,
+	multiversXChain runtimeInfoMultiversXChain,
+	components runtimeInfoBridgeComponents,
+) *core.RuntimeInfo {
+	ctx, cancel := context.WithTimeout(context.Background(), runtimeInfoRequestsTimeout)
+	defer cancel()
+
+	configs := args.Configs
+	info := &core.RuntimeInfo{
+		AppVersion: args.AppVersion,
+		GitCommit:  args.GitCommit,
+		Profile:    configs.FlagsConfig.Profile,
+		Branding:   configs.GeneralConfig.Branding.Name,
+		Ethereum: core.ChainRuntimeInfo{
+			MultisigContractAddress: configs.GeneralConfig.Eth.MultisigContractAddress,
+			SafeContractAddress:     configs.GeneralConfig.Eth.SafeContractAddress,
+			RelayerAddress:          components.EthereumRelayerAddress().String(),
+		},
+		MultiversX: core.ChainRuntimeInfo{
+			MultisigContractAddress: configs.GeneralConfig.MultiversX.MultisigContractAddress,
+			SafeContractAddress:     configs.GeneralConfig.MultiversX.SafeContractAddress,
+		},
+		EnabledFeatures: getEnabledFeatures(configs),
+	}
+
+	multiversXRelayerAddress, err := components.MultiversXRelayerAddress().AddressAsBech32String()
+	if err != nil {
+		log.Warn("runtime info: can not encode the MultiversX relayer address", "error", err)
+	}
+	info.MultiversX.RelayerAddress = multiversXRelayerAddress
+
+	ethereumChainID, err := ethereumChain.ChainID(ctx)
+	if err != nil {
+		log.Warn("runtime info: can not fetch the Ethereum chain ID", "error", err)
+	} else {
+		info.Ethereum.ChainID = ethereumChainID.String()
+	}
+
+	networkConfig, err := multiversXChain.GetNetworkConfig(ctx)
+	if err != nil {
+		log.Warn("runtime info: can not fetch the MultiversX network config", "error", err)
+	} else {
+		info.MultiversX.ChainID = networkConfig.ChainID
+	}
+
+	ethereumQuorum, err := ethereumChain.Quorum(ctx)
+	if err != nil {
+		log.Warn("runtime info: can not fetch the Ethereum quorum", "error", err)
+	} else {
+		info.Ethereum.Quorum = ethereumQuorum.String()
+	}
+
+	multiversXQuorum, err := components.GetMultiversXQuorum(ctx)
+	if err != nil {
+		log.Warn("runtime info: can not fetch the MultiversX quorum", "error", err)
+	} else {
+		info.MultiversX.Quorum = strconv.FormatUint(multiversXQuorum, 10)
+	}
+
+	return info
+}
+
+func getEnabledFeatures(configs config.Configs) []string {
+	cfg := configs.GeneralConfig
+	features := []struct {
+		name    string
+		enabled bool
+	}{
+		{"GasStation", cfg.Eth.GasStation.Enabled},
+		{"ExecutionEvents", cfg.Eth.ExecutionEventsLookbackBlocks > 0},
+		{"EthereumLightMode", cfg.Eth.RPCMode == wrappers.LightRPCMode},
+		{"RawTransactionsExport", cfg.Eth.RawTransactionsExport.Enabled},
+		{"ConfirmationPolicy", cfg.Eth.ConfirmationPolicy.Enabled},
+		{"RelayedClaims", cfg.MultiversX.RelayedClaims.Enabled},
+		{"ESDTRolesCheck", cfg.MultiversX.ESDTRolesCheck.Enabled},
+		{"StuckTransactionsResend", cfg.MultiversX.StuckTransactions.Enabled},
+		{"MultiversXGasPayer", cfg.MultiversX.GasPayer.Enabled},
+		{"SignerAuditLog", cfg.Relayer.SignerAuditLog.Enabled},
+		{"BalanceMonitor", cfg.Relayer.BalanceMonitor.Enabled},
+		{"RuntimeMonitor", cfg.Relayer.RuntimeMonitor.Enabled},
+		{"GasUsageTracker", cfg.Relayer.GasUsageTracker.Enabled},
+		{"BalanceProof", cfg.Relayer.BalanceProof.Enabled},
+		{"FeeEstimation", cfg.Relayer.FeeEstimation.Enabled},
+		{"RoundingPolicy", cfg.Relayer.RoundingPolicy.Enabled},
+		{"TimingJitter", cfg.Relayer.TimingJitter.Enabled},
+		{"P2PTopicsMetrics", cfg.P2P.TopicsMetrics.Enabled},
+		{"BatchTags", cfg.Relayer.BatchTags.Enabled},
+		{"PreAgreement", cfg.Relayer.PreAgreement.Enabled},
+		{"RelayersProbation", cfg.Relayer.RoleProvider.ProbationPeriodInSeconds > 0},
+		{"TokenMetadataMonitor", cfg.Relayer.TokenMetadata.Enabled},
+		{"Heartbeat", cfg.Relayer.Heartbeat.Enabled},
+		{"StateMachineRecovery", cfg.Relayer.StateMachineRecovery.Enabled},
+		{"DirectMessages", cfg.Relayer.DirectMessages.Enabled},
+		{"TransferReceipts", cfg.Relayer.TransferReceipts.Enabled},
+		{"Faucet", cfg.Relayer.Faucet.Enabled},
+		{"Postmortem", cfg.Relayer.Postmortem.Enabled},
+		{"SLA", cfg.Relayer.SLA.Enabled},
+		{"ErrorReporting", cfg.Relayer.ErrorReporting.Enabled},
+		{"GovernancePause", cfg.Relayer.GovernancePause.Enabled},
+		{"Incidents", cfg.Relayer.Incidents.Enabled},
+		{"DeadLetters", cfg.Relayer.DeadLetters.Enabled},
+		{"Idempotency", cfg.Relayer.Idempotency.Enabled},
+		{"NetworkCheck", cfg.Relayer.NetworkCheck.Enabled},
+		{"DependenciesWait", cfg.Relayer.DependenciesWait.Enabled},
+		{"TransferAllowlist", cfg.Relayer.TransferAllowlist.Enabled},
+		{"RecipientValidation", cfg.Relayer.RecipientValidation.Enabled},
+		{"StakeWeightedLeaders", cfg.Relayer.LeaderSelection.Strategy == topology.StakeWeightedStrategy},
+		{"ConfigBundle", cfg.ConfigBundle.Enabled},
+		{"TokenMigrations", len(cfg.TokensMapper.Migrations) > 0},
+		{"P2PWebSocket", len(cfg.P2P.Transports.WebSocketAddress) > 0},
+		{"P2PQUIC", len(cfg.P2P.Transports.QUICAddress) > 0},
+		{"P2PKnownPeers", cfg.P2P.KnownPeers.Enabled},
+		{"PublicReadMode", configs.ApiRoutesConfig.PublicReadMode.Enabled},
+		{"Pprof", configs.FlagsConfig.EnablePprof},
+	}
+
+	enabledFeatures := make([]string, 0, len(features))
+	for _, feature := range features {
+		if feature.enabled {
+			enabledFeatures = append(enabledFeatures, feature.name)
+		}
+	}
+
+	return enabledFeatures
+}
+
+// logRuntimeInfo prints the startup banner, both human-readable and as a single JSON line for the log collectors
+func logRuntimeInfo(log logger.Logger, info *core.RuntimeInfo) {
+	log.Info("relayer runtime info",
+		"app version", info.AppVersion,
+		"git commit", info.GitCommit,
+		"profile", info.Profile,
+		"branding", info.Branding,
+		"Ethereum chain ID", info.Ethereum.ChainID,
+		"Ethereum multisig", info.Ethereum.MultisigContractAddress,
+		"Ethereum safe", info.Ethereum.SafeContractAddress,
+		"Ethereum relayer", info.Ethereum.RelayerAddress,
+		"Ethereum quorum", info.Ethereum.Quorum,
+		"MultiversX chain ID", info.MultiversX.ChainID,
+		"MultiversX multisig", info.MultiversX.MultisigContractAddress,
+		"MultiversX safe", info.MultiversX.SafeContractAddress,
+		"MultiversX relayer", info.MultiversX.RelayerAddress,
+		"MultiversX quorum", info.MultiversX.Quorum,
+		"enabled features", info.EnabledFeatures,
+	)
+
+	buff, err := json.Marshal(info)
+	if err != nil {
+		log.Warn("runtime info: can not marshal", "error", err)
+		return
+	}
+	log.Info("relayer runtime info JSON", "info", string(buff))
+}
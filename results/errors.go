@@ -0,0 +1,18 @@
+package results
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilBatchResults signals that nil batch results were provided
+var ErrNilBatchResults = errors.New("nil batch results")
+
+// ErrBatchResultsNotFound signals that no results were saved for the provided batch
+var ErrBatchResultsNotFound = errors.New("batch results not found")
+
+// ErrEmptyTransferReceipts signals that no transfer receipts were provided
+var ErrEmptyTransferReceipts = errors.New("empty transfer receipts")
+
+// ErrTransferReceiptsNotFound signals that no transfer receipts were saved for the provided batch
+var ErrTransferReceiptsNotFound = errors.New("transfer receipts not found")
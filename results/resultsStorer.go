@@ -0,0 +1,141 @@
+package results
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	batchResultsKeyPrefix     = "batch_results_"
+	transferReceiptsKeyPrefix = "transfer_receipts_"
+	executionCostsKeyPrefix   = "execution_costs_"
+)
+
+// ArgsResultsStorer is the DTO used to create a new results storer
+type ArgsResultsStorer struct {
+	Storer core.Storer
+}
+
+type resultsStorer struct {
+	storer core.Storer
+}
+
+// NewResultsStorer creates a component able to save and load the per-deposit results, the signed transfer receipts and
+// the execution costs of the executed batches
+func NewResultsStorer(args ArgsResultsStorer) (*resultsStorer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	return &resultsStorer{
+		storer: args.Storer,
+	}, nil
+}
+
+// StoreBatchResults saves the provided batch results, overwriting the existing ones for the same batch ID
+func (rs *resultsStorer) StoreBatchResults(results *core.BatchResults) error {
+	if results == nil {
+		return ErrNilBatchResults
+	}
+
+	buff, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+
+	return rs.storer.Put(batchResultsKey(results.BatchID), buff)
+}
+
+// GetBatchResults returns the saved results of the provided batch ID
+func (rs *resultsStorer) GetBatchResults(batchID uint64) (*core.BatchResults, error) {
+	buff, err := rs.storer.Get(batchResultsKey(batchID))
+	if err != nil {
+		return nil, fmt.Errorf("%w for batch ID %d", ErrBatchResultsNotFound, batchID)
+	}
+
+	results := &core.BatchResults{}
+	err = json.Unmarshal(buff, results)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// StoreTransferReceipts saves the signed transfer receipts of the provided batch. The batch IDs of the two bridge
+// directions overlap, so the receipts are saved under the direction name as well
+func (rs *resultsStorer) StoreTransferReceipts(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error {
+	if len(receipts) == 0 {
+		return ErrEmptyTransferReceipts
+	}
+
+	buff, err := json.Marshal(receipts)
+	if err != nil {
+		return err
+	}
+
+	return rs.storer.Put(transferReceiptsKey(direction, batchID), buff)
+}
+
+// GetTransferReceipts returns the saved signed transfer receipts of the provided direction and batch ID
+func (rs *resultsStorer) GetTransferReceipts(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+	buff, err := rs.storer.Get(transferReceiptsKey(direction, batchID))
+	if err != nil {
+		return nil, fmt.Errorf("%w for direction %s, batch ID %d", ErrTransferReceiptsNotFound, direction, batchID)
+	}
+
+	receipts := make([]*core.SignedTransferReceipt, 0)
+	err = json.Unmarshal(buff, &receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	return receipts, nil
+}
+
+// StoreExecutionCosts saves the execution costs of the provided period, overwriting the existing ones
+func (rs *resultsStorer) StoreExecutionCosts(period string, costs []*core.ExecutionCost) error {
+	buff, err := json.Marshal(costs)
+	if err != nil {
+		return err
+	}
+
+	return rs.storer.Put(executionCostsKey(period), buff)
+}
+
+// GetExecutionCosts returns the saved execution costs of the provided period. A period without saved costs returns an
+// empty slice
+func (rs *resultsStorer) GetExecutionCosts(period string) ([]*core.ExecutionCost, error) {
+	costs := make([]*core.ExecutionCost, 0)
+	buff, err := rs.storer.Get(executionCostsKey(period))
+	if err != nil {
+		return costs, nil
+	}
+
+	err = json.Unmarshal(buff, &costs)
+	if err != nil {
+		return nil, err
+	}
+
+	return costs, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *resultsStorer) IsInterfaceNil() bool {
+	return rs == nil
+}
+
+func batchResultsKey(batchID uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", batchResultsKeyPrefix, batchID))
+}
+
+func transferReceiptsKey(direction string, batchID uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s_%d", transferReceiptsKeyPrefix, direction, batchID))
+}
+
+func executionCostsKey(period string) []byte {
+	return []byte(executionCostsKeyPrefix + period)
+}
@@ -0,0 +1,145 @@
+package results
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResultsStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewResultsStorer(ArgsResultsStorer{})
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(storer))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := NewResultsStorer(ArgsResultsStorer{
+			Storer: testsCommon.NewStorerMock(),
+		})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(storer))
+	})
+}
+
+func TestResultsStorer_StoreAndGetBatchResults(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := NewResultsStorer(ArgsResultsStorer{
+		Storer: testsCommon.NewStorerMock(),
+	})
+
+	err := storer.StoreBatchResults(nil)
+	assert.Equal(t, ErrNilBatchResults, err)
+
+	_, err = storer.GetBatchResults(1)
+	assert.True(t, errors.Is(err, ErrBatchResultsNotFound))
+
+	batchResults := &core.BatchResults{
+		BatchID: 1,
+		TxHash:  "hash",
+		Deposits: []*core.DepositResult{
+			{
+				Nonce:  10,
+				Status: core.DepositExecuted,
+			},
+			{
+				Nonce:  11,
+				Status: core.DepositRejected,
+				Reason: "invalid destination",
+			},
+		},
+	}
+	err = storer.StoreBatchResults(batchResults)
+	require.Nil(t, err)
+
+	loaded, err := storer.GetBatchResults(1)
+	require.Nil(t, err)
+	assert.Equal(t, batchResults, loaded)
+}
+
+func TestResultsStorer_StoreAndGetTransferReceipts(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := NewResultsStorer(ArgsResultsStorer{
+		Storer: testsCommon.NewStorerMock(),
+	})
+
+	err := storer.StoreTransferReceipts("EthereumToMultiversX", 1, nil)
+	assert.Equal(t, ErrEmptyTransferReceipts, err)
+
+	_, err = storer.GetTransferReceipts("EthereumToMultiversX", 1)
+	assert.True(t, errors.Is(err, ErrTransferReceiptsNotFound))
+
+	receipts := []*core.SignedTransferReceipt{
+		{
+			Receipt: &core.TransferReceipt{
+				Direction:         "EthereumToMultiversX",
+				BatchID:           1,
+				DepositNonce:      10,
+				SourceTxHash:      "source hash",
+				DestinationTxHash: "destination hash",
+				Amount:            "1000",
+				Fee:               "0",
+				Status:            core.DepositExecuted,
+			},
+			Relayer:   "erd1relayer",
+			PublicKey: "public key",
+			Signature: "signature",
+		},
+	}
+	err = storer.StoreTransferReceipts("EthereumToMultiversX", 1, receipts)
+	require.Nil(t, err)
+
+	loaded, err := storer.GetTransferReceipts("EthereumToMultiversX", 1)
+	require.Nil(t, err)
+	assert.Equal(t, receipts, loaded)
+
+	_, err = storer.GetTransferReceipts("MultiversXToEthereum", 1)
+	assert.True(t, errors.Is(err, ErrTransferReceiptsNotFound))
+}
+
+func TestResultsStorer_StoreAndGetExecutionCosts(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := NewResultsStorer(ArgsResultsStorer{
+		Storer: testsCommon.NewStorerMock(),
+	})
+
+	loaded, err := storer.GetExecutionCosts("2026-09")
+	require.Nil(t, err)
+	assert.Empty(t, loaded)
+
+	costs := []*core.ExecutionCost{
+		{
+			Relayer:   "erd1relayer",
+			Chain:     "Ethereum",
+			Direction: "MultiversXToEthereum",
+			BatchID:   1,
+			TxHash:    "hash",
+			GasUsed:   250000,
+			Fee:       "5000000000000000",
+			Timestamp: 1790000000,
+		},
+	}
+	err = storer.StoreExecutionCosts("2026-09", costs)
+	require.Nil(t, err)
+
+	loaded, err = storer.GetExecutionCosts("2026-09")
+	require.Nil(t, err)
+	assert.Equal(t, costs, loaded)
+
+	loaded, err = storer.GetExecutionCosts("2026-10")
+	require.Nil(t, err)
+	assert.Empty(t, loaded)
+}
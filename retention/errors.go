@@ -0,0 +1,18 @@
+package retention
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyName signals that an empty name was provided
+var ErrEmptyName = errors.New("empty name")
+
+// ErrInvalidRetentionPeriod signals that an invalid retention period was provided
+var ErrInvalidRetentionPeriod = errors.New("invalid retention period")
+
+// ErrInvalidSweepInterval signals that an invalid sweep interval was provided
+var ErrInvalidSweepInterval = errors.New("invalid sweep interval")
+
+// ErrReservedKey signals that the key used to save the retention index was provided
+var ErrReservedKey = errors.New("reserved key")
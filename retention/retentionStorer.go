@@ -0,0 +1,239 @@
+package retention
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	retentionIndexKey = "retention_index"
+	minSweepInterval  = time.Second
+)
+
+var log = logger.GetOrCreate("retention")
+
+// ArgsRetentionStorer is the DTO used to create a new retention storer
+type ArgsRetentionStorer struct {
+	Storer          core.RemovableStorer
+	Name            string
+	RetentionPeriod time.Duration
+	SweepInterval   time.Duration
+}
+
+type retentionStorer struct {
+	storer          core.RemovableStorer
+	name            string
+	retentionPeriod time.Duration
+	getTime         func() time.Time
+	cancel          func()
+	chDone          chan struct{}
+	closeOnce       sync.Once
+
+	mutIndex   sync.Mutex
+	lastWrites map[string]int64
+	indexDirty bool
+}
+
+// NewRetentionStorer creates a storer that remembers when each key was last written and periodically removes the keys
+// not written in the retention period. The keys written before the retention was enabled are never removed.
+// The index of the last writes is saved in the wrapped storer on each sweep and on Close
+func NewRetentionStorer(args ArgsRetentionStorer) (*retentionStorer, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	rs := &retentionStorer{
+		storer:          args.Storer,
+		name:            args.Name,
+		retentionPeriod: args.RetentionPeriod,
+		getTime:         time.Now,
+		cancel:          cancel,
+		chDone:          make(chan struct{}),
+		lastWrites:      make(map[string]int64),
+	}
+	rs.tryLoadIndex()
+
+	go rs.processLoop(ctx, args.SweepInterval)
+
+	return rs, nil
+}
+
+func checkArgs(args ArgsRetentionStorer) error {
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if len(args.Name) == 0 {
+		return ErrEmptyName
+	}
+	if args.RetentionPeriod <= 0 {
+		return ErrInvalidRetentionPeriod
+	}
+	if args.SweepInterval < minSweepInterval {
+		return ErrInvalidSweepInterval
+	}
+
+	return nil
+}
+
+func (rs *retentionStorer) tryLoadIndex() {
+	buff, err := rs.storer.Get([]byte(retentionIndexKey))
+	if err != nil {
+		log.Debug("retentionStorer: no retention index found", "name", rs.name)
+		return
+	}
+
+	index := make(map[string]int64)
+	err = json.Unmarshal(buff, &index)
+	if err != nil {
+		log.Warn("retentionStorer: invalid retention index, starting a new one", "name", rs.name, "error", err)
+		return
+	}
+
+	rs.lastWrites = index
+	log.Debug("retentionStorer: loaded the retention index", "name", rs.name, "num keys", len(index))
+}
+
+// Put saves the data in the wrapped storer and records the time of the write
+func (rs *retentionStorer) Put(key, data []byte) error {
+	if string(key) == retentionIndexKey {
+		return ErrReservedKey
+	}
+
+	err := rs.storer.Put(key, data)
+	if err != nil {
+		return err
+	}
+
+	rs.mutIndex.Lock()
+	rs.lastWrites[hex.EncodeToString(key)] = rs.getTime().Unix()
+	rs.indexDirty = true
+	rs.mutIndex.Unlock()
+
+	return nil
+}
+
+// Get returns the data from the wrapped storer
+func (rs *retentionStorer) Get(key []byte) ([]byte, error) {
+	return rs.storer.Get(key)
+}
+
+// Remove removes the key from the wrapped storer and from the retention index
+func (rs *retentionStorer) Remove(key []byte) error {
+	if string(key) == retentionIndexKey {
+		return ErrReservedKey
+	}
+
+	rs.mutIndex.Lock()
+	delete(rs.lastWrites, hex.EncodeToString(key))
+	rs.indexDirty = true
+	rs.mutIndex.Unlock()
+
+	return rs.storer.Remove(key)
+}
+
+func (rs *retentionStorer) processLoop(ctx context.Context, sweepInterval time.Duration) {
+	defer close(rs.chDone)
+
+	timer := time.NewTimer(sweepInterval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("retentionStorer's processing loop is closing...", "name", rs.name)
+			return
+		case <-timer.C:
+			rs.sweep()
+			timer.Reset(sweepInterval)
+		}
+	}
+}
+
+// sweep removes the keys not written in the retention period. The space used by the removed keys is reclaimed by
+// the database during its background compactions
+func (rs *retentionStorer) sweep() {
+	rs.mutIndex.Lock()
+	defer rs.mutIndex.Unlock()
+
+	oldestAllowed := rs.getTime().Add(-rs.retentionPeriod).Unix()
+	numRemoved := 0
+	for hexKey, lastWrite := range rs.lastWrites {
+		if lastWrite >= oldestAllowed {
+			continue
+		}
+
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			delete(rs.lastWrites, hexKey)
+			continue
+		}
+
+		err = rs.storer.Remove(key)
+		if err != nil {
+			log.Debug("retentionStorer.sweep removing key", "name", rs.name, "key", string(key), "error", err)
+			continue
+		}
+
+		delete(rs.lastWrites, hexKey)
+		rs.indexDirty = true
+		numRemoved++
+	}
+
+	rs.saveIndex()
+	if numRemoved > 0 {
+		log.Debug("retentionStorer.sweep removed expired keys", "name", rs.name, "num removed", numRemoved,
+			"num remaining", len(rs.lastWrites))
+	}
+}
+
+// saveIndex should be called under mutex protection
+func (rs *retentionStorer) saveIndex() {
+	if !rs.indexDirty {
+		return
+	}
+
+	buff, err := json.Marshal(rs.lastWrites)
+	if err != nil {
+		log.Warn("retentionStorer: can not marshal the retention index", "name", rs.name, "error", err)
+		return
+	}
+
+	err = rs.storer.Put([]byte(retentionIndexKey), buff)
+	if err != nil {
+		log.Warn("retentionStorer: can not save the retention index", "name", rs.name, "error", err)
+		return
+	}
+
+	rs.indexDirty = false
+}
+
+// Close stops the sweeps, saves the retention index and closes the wrapped storer
+func (rs *retentionStorer) Close() error {
+	var err error
+	rs.closeOnce.Do(func() {
+		rs.cancel()
+		<-rs.chDone
+
+		rs.mutIndex.Lock()
+		rs.saveIndex()
+		rs.mutIndex.Unlock()
+
+		err = rs.storer.Close()
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rs *retentionStorer) IsInterfaceNil() bool {
+	return rs == nil
+}
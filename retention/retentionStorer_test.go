@@ -0,0 +1,158 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsRetentionStorer() ArgsRetentionStorer {
+	return ArgsRetentionStorer{
+		Storer:          testsCommon.NewStorerMock(),
+		Name:            "test",
+		RetentionPeriod: time.Hour,
+		SweepInterval:   time.Hour,
+	}
+}
+
+func TestNewRetentionStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		args := createMockArgsRetentionStorer()
+		args.Storer = nil
+
+		storer, err := NewRetentionStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("empty name should error", func(t *testing.T) {
+		args := createMockArgsRetentionStorer()
+		args.Name = ""
+
+		storer, err := NewRetentionStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrEmptyName, err)
+	})
+	t.Run("invalid retention period should error", func(t *testing.T) {
+		args := createMockArgsRetentionStorer()
+		args.RetentionPeriod = 0
+
+		storer, err := NewRetentionStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrInvalidRetentionPeriod, err)
+	})
+	t.Run("invalid sweep interval should error", func(t *testing.T) {
+		args := createMockArgsRetentionStorer()
+		args.SweepInterval = minSweepInterval - 1
+
+		storer, err := NewRetentionStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrInvalidSweepInterval, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		storer, err := NewRetentionStorer(createMockArgsRetentionStorer())
+		assert.False(t, check.IfNil(storer))
+		assert.Nil(t, err)
+
+		assert.Nil(t, storer.Close())
+	})
+}
+
+func TestRetentionStorer_ReservedKeyShouldError(t *testing.T) {
+	t.Parallel()
+
+	storer, _ := NewRetentionStorer(createMockArgsRetentionStorer())
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	assert.Equal(t, ErrReservedKey, storer.Put([]byte(retentionIndexKey), []byte("value")))
+	assert.Equal(t, ErrReservedKey, storer.Remove([]byte(retentionIndexKey)))
+}
+
+func TestRetentionStorer_SweepShouldRemoveTheExpiredKeys(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRetentionStorer()
+	wrapped := testsCommon.NewStorerMock()
+	_ = wrapped.Put([]byte("legacy key"), []byte("legacy value"))
+	args.Storer = wrapped
+	storer, _ := NewRetentionStorer(args)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	now := time.Now()
+	storer.getTime = func() time.Time {
+		return now
+	}
+	_ = storer.Put([]byte("old key"), []byte("old value"))
+
+	now = now.Add(time.Minute * 40)
+	_ = storer.Put([]byte("new key"), []byte("new value"))
+
+	now = now.Add(time.Minute * 40)
+	storer.sweep()
+
+	_, err := storer.Get([]byte("old key"))
+	assert.NotNil(t, err)
+	data, err := storer.Get([]byte("new key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("new value"), data)
+	data, err = storer.Get([]byte("legacy key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("legacy value"), data)
+}
+
+func TestRetentionStorer_IndexShouldBeRestored(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRetentionStorer()
+	wrapped := testsCommon.NewStorerMock()
+	args.Storer = wrapped
+
+	now := time.Now()
+	storer, _ := NewRetentionStorer(args)
+	storer.getTime = func() time.Time {
+		return now
+	}
+	_ = storer.Put([]byte("key"), []byte("value"))
+	assert.Nil(t, storer.Close())
+
+	_, err := wrapped.Get([]byte(retentionIndexKey))
+	assert.Nil(t, err)
+
+	storer, _ = NewRetentionStorer(args)
+	defer func() {
+		_ = storer.Close()
+	}()
+	storer.getTime = func() time.Time {
+		return now.Add(time.Hour * 2)
+	}
+	storer.sweep()
+
+	_, err = storer.Get([]byte("key"))
+	assert.NotNil(t, err)
+}
+
+func TestRetentionStorer_CloseShouldCloseOnce(t *testing.T) {
+	t.Parallel()
+
+	numClose := 0
+	args := createMockArgsRetentionStorer()
+	args.Storer = &testsCommon.StorerStub{
+		CloseCalled: func() error {
+			numClose++
+			return nil
+		},
+	}
+	storer, _ := NewRetentionStorer(args)
+
+	assert.Nil(t, storer.Close())
+	assert.Nil(t, storer.Close())
+	assert.Equal(t, 1, numClose)
+}
@@ -0,0 +1,11 @@
+package runtimeMonitor
+
+import "errors"
+
+var (
+	errNilStatsProvider = errors.New("nil runtime stats provider")
+	errNilStatusHandler = errors.New("nil status handler")
+	errNilLogger        = errors.New("nil logger")
+	errNilTimer         = errors.New("nil timer")
+	errInvalidValue     = errors.New("invalid value")
+)
@@ -0,0 +1,7 @@
+package runtimeMonitor
+
+// StatsProvider is able to sample the current runtime stats of the process
+type StatsProvider interface {
+	GetRuntimeStats() RuntimeStats
+	IsInterfaceNil() bool
+}
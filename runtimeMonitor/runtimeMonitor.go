@@ -0,0 +1,243 @@
+package runtimeMonitor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minTrendWindow     = time.Minute
+	profileFileFormat  = "%s-%d.pprof"
+	profilesDirPerm    = 0755
+	goroutinesResource = "goroutines"
+	fdsResource        = "open file descriptors"
+	heapResource       = "heap in use"
+)
+
+// ArgsRuntimeMonitor is the DTO used to create a new runtime monitor
+type ArgsRuntimeMonitor struct {
+	StatsProvider          StatsProvider
+	StatusHandler          core.StatusHandler
+	Log                    logger.Logger
+	Timer                  core.Timer
+	TrendWindow            time.Duration
+	MaxGoroutines          int
+	MaxOpenFileDescriptors int
+	MaxHeapInUse           uint64
+	MaxGrowthPercent       uint64
+	ProfilesDirectory      string
+}
+
+type runtimeSample struct {
+	timestamp int64
+	values    map[string]float64
+}
+
+type runtimeMonitor struct {
+	statsProvider     StatsProvider
+	statusHandler     core.StatusHandler
+	log               logger.Logger
+	timer             core.Timer
+	trendWindow       int64
+	baselines         map[string]float64
+	maxGrowthPercent  float64
+	profilesDirectory string
+
+	mut        sync.Mutex
+	samples    []*runtimeSample
+	isAlerting bool
+}
+
+// NewRuntimeMonitor creates a component that periodically samples the number of goroutines, the open file descriptors
+// and the heap in use, exposes them as metrics and alerts when they exceed the configured baselines or when they grow
+// more than the allowed percent in the trend window. A 0 baseline or growth percent disables the corresponding check.
+// If a profiles directory is provided, the goroutine and heap pprof profiles are written there when an alert is raised
+func NewRuntimeMonitor(args ArgsRuntimeMonitor) (*runtimeMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runtimeMonitor{
+		statsProvider: args.StatsProvider,
+		statusHandler: args.StatusHandler,
+		log:           args.Log,
+		timer:         args.Timer,
+		trendWindow:   int64(args.TrendWindow.Seconds()),
+		baselines: map[string]float64{
+			goroutinesResource: float64(args.MaxGoroutines),
+			fdsResource:        float64(args.MaxOpenFileDescriptors),
+			heapResource:       float64(args.MaxHeapInUse),
+		},
+		maxGrowthPercent:  float64(args.MaxGrowthPercent),
+		profilesDirectory: args.ProfilesDirectory,
+		samples:           make([]*runtimeSample, 0),
+	}, nil
+}
+
+func checkArgs(args ArgsRuntimeMonitor) error {
+	if check.IfNil(args.StatsProvider) {
+		return errNilStatsProvider
+	}
+	if check.IfNil(args.StatusHandler) {
+		return errNilStatusHandler
+	}
+	if check.IfNil(args.Log) {
+		return errNilLogger
+	}
+	if check.IfNil(args.Timer) {
+		return errNilTimer
+	}
+	if args.TrendWindow < minTrendWindow {
+		return fmt.Errorf("%w for TrendWindow: %v, minimum: %v", errInvalidValue, args.TrendWindow, minTrendWindow)
+	}
+	if args.MaxGoroutines < 0 {
+		return fmt.Errorf("%w for MaxGoroutines: %d", errInvalidValue, args.MaxGoroutines)
+	}
+	if args.MaxOpenFileDescriptors < 0 {
+		return fmt.Errorf("%w for MaxOpenFileDescriptors: %d", errInvalidValue, args.MaxOpenFileDescriptors)
+	}
+
+	return nil
+}
+
+// Execute samples the runtime stats, updates the metrics and raises the alerts
+func (monitor *runtimeMonitor) Execute(_ context.Context) error {
+	stats := monitor.statsProvider.GetRuntimeStats()
+	values := map[string]float64{
+		goroutinesResource: float64(stats.NumGoroutines),
+		heapResource:       float64(stats.HeapInUse),
+	}
+	if stats.NumOpenFileDescriptors != unknownValue {
+		values[fdsResource] = float64(stats.NumOpenFileDescriptors)
+	}
+
+	monitor.statusHandler.SetIntMetric(core.MetricRuntimeNumGoroutines, stats.NumGoroutines)
+	monitor.statusHandler.SetIntMetric(core.MetricRuntimeNumOpenFileDescriptors, stats.NumOpenFileDescriptors)
+	monitor.statusHandler.SetStringMetric(core.MetricRuntimeHeapInUse, fmt.Sprintf("%d", stats.HeapInUse))
+
+	growths, isTrendAvailable := monitor.addSampleAndComputeGrowths(monitor.timer.NowUnix(), values)
+	alerts := make([]interface{}, 0)
+	for _, resource := range []string{goroutinesResource, fdsResource, heapResource} {
+		value, found := values[resource]
+		if !found {
+			continue
+		}
+
+		baseline := monitor.baselines[resource]
+		if baseline > 0 && value > baseline {
+			alerts = append(alerts, resource, fmt.Sprintf("%.0f above the baseline of %.0f", value, baseline))
+			continue
+		}
+
+		growth := growths[resource]
+		if isTrendAvailable && monitor.maxGrowthPercent > 0 && growth > monitor.maxGrowthPercent {
+			alerts = append(alerts, resource, fmt.Sprintf("%.0f, grew %.1f%% in the trend window", value, growth))
+		}
+	}
+
+	wasAlerting := monitor.isAlerting
+	monitor.isAlerting = len(alerts) > 0
+	if !monitor.isAlerting {
+		monitor.statusHandler.SetIntMetric(core.MetricRuntimeAlert, 0)
+		monitor.log.Debug("runtime stats", "goroutines", stats.NumGoroutines,
+			"open file descriptors", stats.NumOpenFileDescriptors, "heap in use", stats.HeapInUse)
+		return nil
+	}
+
+	monitor.statusHandler.SetIntMetric(core.MetricRuntimeAlert, 1)
+	monitor.log.Error("runtime stats trend beyond the configured baselines, possible resource leak", alerts...)
+	if !wasAlerting {
+		monitor.writeProfiles()
+	}
+
+	return nil
+}
+
+// addSampleAndComputeGrowths stores the new sample, discards the ones older than the window and returns the growth
+// percent of each resource, compared with the oldest sample. The growths are available only if the samples span at
+// least half of the trend window
+func (monitor *runtimeMonitor) addSampleAndComputeGrowths(timestamp int64, values map[string]float64) (map[string]float64, bool) {
+	monitor.mut.Lock()
+	defer monitor.mut.Unlock()
+
+	monitor.samples = append(monitor.samples, &runtimeSample{
+		timestamp: timestamp,
+		values:    values,
+	})
+
+	firstIndex := 0
+	for firstIndex < len(monitor.samples)-1 && monitor.samples[firstIndex].timestamp < timestamp-monitor.trendWindow {
+		firstIndex++
+	}
+	monitor.samples = monitor.samples[firstIndex:]
+
+	oldest := monitor.samples[0]
+	growths := make(map[string]float64)
+	for resource, value := range values {
+		oldestValue, found := oldest.values[resource]
+		if !found || oldestValue == 0 {
+			continue
+		}
+
+		growths[resource] = (value - oldestValue) * 100 / oldestValue
+	}
+
+	timeSpan := timestamp - oldest.timestamp
+
+	return growths, timeSpan*2 >= monitor.trendWindow
+}
+
+func (monitor *runtimeMonitor) writeProfiles() {
+	if len(monitor.profilesDirectory) == 0 {
+		return
+	}
+
+	err := os.MkdirAll(monitor.profilesDirectory, profilesDirPerm)
+	if err != nil {
+		monitor.log.Warn("runtimeMonitor: can not create the profiles directory",
+			"directory", monitor.profilesDirectory, "error", err)
+		return
+	}
+
+	timestamp := monitor.timer.NowUnix()
+	for _, profileName := range []string{"goroutine", "heap"} {
+		filePath := filepath.Join(monitor.profilesDirectory, fmt.Sprintf(profileFileFormat, profileName, timestamp))
+		err = writeProfile(profileName, filePath)
+		if err != nil {
+			monitor.log.Warn("runtimeMonitor: can not write the profile", "profile", profileName, "error", err)
+			continue
+		}
+
+		monitor.log.Info("runtimeMonitor: profile written", "profile", profileName, "file", filePath)
+	}
+}
+
+func writeProfile(profileName string, filePath string) error {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+
+	err = pprof.Lookup(profileName).WriteTo(file, 0)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (monitor *runtimeMonitor) IsInterfaceNil() bool {
+	return monitor == nil
+}
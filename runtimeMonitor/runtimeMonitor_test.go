@@ -0,0 +1,238 @@
+package runtimeMonitor
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type statsProviderStub struct {
+	GetRuntimeStatsCalled func() RuntimeStats
+}
+
+func (stub *statsProviderStub) GetRuntimeStats() RuntimeStats {
+	return stub.GetRuntimeStatsCalled()
+}
+
+func (stub *statsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
+
+func createMockArgsRuntimeMonitor() ArgsRuntimeMonitor {
+	return ArgsRuntimeMonitor{
+		StatsProvider: &statsProviderStub{
+			GetRuntimeStatsCalled: func() RuntimeStats {
+				return RuntimeStats{
+					NumGoroutines:          100,
+					NumOpenFileDescriptors: 20,
+					HeapInUse:              1000,
+				}
+			},
+		},
+		StatusHandler:          testsCommon.NewStatusHandlerMock("runtime-monitor"),
+		Log:                    &testsCommon.LoggerStub{},
+		Timer:                  &testsCommon.TimerMock{},
+		TrendWindow:            time.Hour,
+		MaxGoroutines:          1000,
+		MaxOpenFileDescriptors: 100,
+		MaxHeapInUse:           10000,
+		MaxGrowthPercent:       50,
+	}
+}
+
+func TestNewRuntimeMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil stats provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.StatsProvider = nil
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilStatsProvider, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.StatusHandler = nil
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilStatusHandler, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.Log = nil
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.Timer = nil
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, errNilTimer, err)
+	})
+	t.Run("invalid trend window should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.TrendWindow = time.Second
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "TrendWindow"))
+	})
+	t.Run("negative max goroutines should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.MaxGoroutines = -1
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxGoroutines"))
+	})
+	t.Run("negative max open file descriptors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.MaxOpenFileDescriptors = -1
+
+		monitor, err := NewRuntimeMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.True(t, errors.Is(err, errInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "MaxOpenFileDescriptors"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		monitor, err := NewRuntimeMonitor(createMockArgsRuntimeMonitor())
+		assert.False(t, check.IfNil(monitor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRuntimeMonitor_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stats within the baselines should not alert", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		statusHandler := testsCommon.NewStatusHandlerMock("runtime-monitor")
+		args.StatusHandler = statusHandler
+		args.Log = &testsCommon.LoggerStub{
+			ErrorCalled: func(message string, args ...interface{}) {
+				assert.Fail(t, "should not have alerted")
+			},
+		}
+		monitor, _ := NewRuntimeMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 100, statusHandler.GetIntMetric(core.MetricRuntimeNumGoroutines))
+		assert.Equal(t, 20, statusHandler.GetIntMetric(core.MetricRuntimeNumOpenFileDescriptors))
+		assert.Equal(t, "1000", statusHandler.GetStringMetric(core.MetricRuntimeHeapInUse))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricRuntimeAlert))
+	})
+	t.Run("goroutines above the baseline should alert and write the profiles once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		args.StatsProvider = &statsProviderStub{
+			GetRuntimeStatsCalled: func() RuntimeStats {
+				return RuntimeStats{
+					NumGoroutines:          2000,
+					NumOpenFileDescriptors: unknownValue,
+					HeapInUse:              1000,
+				}
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("runtime-monitor")
+		args.StatusHandler = statusHandler
+		numAlerts := 0
+		args.Log = &testsCommon.LoggerStub{
+			ErrorCalled: func(message string, args ...interface{}) {
+				numAlerts++
+			},
+		}
+		args.ProfilesDirectory = t.TempDir()
+		monitor, _ := NewRuntimeMonitor(args)
+
+		_ = monitor.Execute(context.Background())
+		_ = monitor.Execute(context.Background())
+		assert.Equal(t, 2, numAlerts)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricRuntimeAlert))
+		assert.Equal(t, unknownValue, statusHandler.GetIntMetric(core.MetricRuntimeNumOpenFileDescriptors))
+
+		entries, err := os.ReadDir(args.ProfilesDirectory)
+		require.Nil(t, err)
+		assert.Equal(t, 2, len(entries))
+	})
+	t.Run("growth in the trend window should alert", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRuntimeMonitor()
+		currentTime := int64(1000)
+		timerStub := testsCommon.NewTimerStub()
+		timerStub.NowUnixCalled = func() int64 {
+			return currentTime
+		}
+		args.Timer = timerStub
+		heapInUse := uint64(1000)
+		args.StatsProvider = &statsProviderStub{
+			GetRuntimeStatsCalled: func() RuntimeStats {
+				return RuntimeStats{
+					NumGoroutines:          100,
+					NumOpenFileDescriptors: 20,
+					HeapInUse:              heapInUse,
+				}
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("runtime-monitor")
+		args.StatusHandler = statusHandler
+		monitor, _ := NewRuntimeMonitor(args)
+
+		_ = monitor.Execute(context.Background())
+
+		// the trend is not yet available
+		currentTime += 60
+		heapInUse = 2000
+		_ = monitor.Execute(context.Background())
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricRuntimeAlert))
+
+		currentTime += 1800
+		heapInUse = 3000
+		_ = monitor.Execute(context.Background())
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricRuntimeAlert))
+
+		// the first samples leave the trend window, the heap is stable
+		currentTime += 3600
+		_ = monitor.Execute(context.Background())
+		currentTime += 1800
+		_ = monitor.Execute(context.Background())
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricRuntimeAlert))
+	})
+}
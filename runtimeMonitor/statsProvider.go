@@ -0,0 +1,53 @@
+package runtimeMonitor
+
+import (
+	"os"
+	"runtime"
+)
+
+const (
+	fileDescriptorsDirectory = "/proc/self/fd"
+	unknownValue             = -1
+)
+
+// RuntimeStats holds a sample of the process runtime stats. The number of open file descriptors is -1 on the
+// platforms that do not expose them
+type RuntimeStats struct {
+	NumGoroutines          int
+	NumOpenFileDescriptors int
+	HeapInUse              uint64
+}
+
+type statsProvider struct {
+}
+
+// NewStatsProvider creates a stats provider that samples the Go runtime and the process file descriptors
+func NewStatsProvider() *statsProvider {
+	return &statsProvider{}
+}
+
+// GetRuntimeStats returns the current runtime stats
+func (provider *statsProvider) GetRuntimeStats() RuntimeStats {
+	memStats := runtime.MemStats{}
+	runtime.ReadMemStats(&memStats)
+
+	return RuntimeStats{
+		NumGoroutines:          runtime.NumGoroutine(),
+		NumOpenFileDescriptors: numOpenFileDescriptors(),
+		HeapInUse:              memStats.HeapInuse,
+	}
+}
+
+func numOpenFileDescriptors() int {
+	entries, err := os.ReadDir(fileDescriptorsDirectory)
+	if err != nil {
+		return unknownValue
+	}
+
+	return len(entries)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *statsProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,18 @@
+package sla
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrInvalidHeartbeatInterval signals that an invalid heartbeat interval was provided
+var ErrInvalidHeartbeatInterval = errors.New("invalid heartbeat interval")
+
+// ErrInvalidMonth signals that an invalid month was provided
+var ErrInvalidMonth = errors.New("invalid month")
+
+// ErrUnknownReportFormat signals that an unknown report format was provided
+var ErrUnknownReportFormat = errors.New("unknown report format")
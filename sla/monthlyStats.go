@@ -0,0 +1,60 @@
+package sla
+
+import (
+	"time"
+)
+
+// MonthFormat is the layout used to identify the months of the SLA reports
+const MonthFormat = "2006-01"
+
+// monthlyStats holds the raw SLA data recorded by the relayer during a month
+type monthlyStats struct {
+	Month      string                     `json:"month"`
+	UpSeconds  int64                      `json:"upSeconds"`
+	Directions map[string]*directionStats `json:"directions"`
+}
+
+// directionStats holds the raw SLA data of a bridge direction (a state machine)
+type directionStats struct {
+	NumSteps             uint64  `json:"numSteps"`
+	NumFailedSteps       uint64  `json:"numFailedSteps"`
+	NumMissedLeaderSlots uint64  `json:"numMissedLeaderSlots"`
+	LatenciesInSeconds   []int64 `json:"latenciesInSeconds"`
+}
+
+func newMonthlyStats(month string) *monthlyStats {
+	return &monthlyStats{
+		Month:      month,
+		Directions: make(map[string]*directionStats),
+	}
+}
+
+func (stats *monthlyStats) direction(name string) *directionStats {
+	dirStats, found := stats.Directions[name]
+	if !found {
+		dirStats = &directionStats{
+			LatenciesInSeconds: make([]int64, 0),
+		}
+		stats.Directions[name] = dirStats
+	}
+
+	return dirStats
+}
+
+func monthOf(unixTimestamp int64) string {
+	return time.Unix(unixTimestamp, 0).UTC().Format(MonthFormat)
+}
+
+// monthBounds returns the first second of the month and the first second of the next month
+func monthBounds(month string) (int64, int64, error) {
+	start, err := time.Parse(MonthFormat, month)
+	if err != nil {
+		return 0, 0, ErrInvalidMonth
+	}
+
+	return start.Unix(), start.AddDate(0, 1, 0).Unix(), nil
+}
+
+func statsKey(month string) []byte {
+	return []byte(statsKeyPrefix + month)
+}
@@ -0,0 +1,172 @@
+package sla
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	"sort"
+)
+
+const (
+	// JSONFormat is the format used to export the SLA reports as JSON documents
+	JSONFormat = "json"
+	// HTMLFormat is the format used to export the SLA reports as HTML pages
+	HTMLFormat = "html"
+)
+
+// Report is the monthly SLA report of a relayer
+type Report struct {
+	Month           string            `json:"month"`
+	PeriodInSeconds int64             `json:"periodInSeconds"`
+	UpSeconds       int64             `json:"upSeconds"`
+	UptimePercent   float64           `json:"uptimePercent"`
+	Directions      []DirectionReport `json:"directions"`
+}
+
+// DirectionReport holds the SLA figures of a bridge direction
+type DirectionReport struct {
+	Name                    string  `json:"name"`
+	NumSteps                uint64  `json:"numSteps"`
+	NumFailedSteps          uint64  `json:"numFailedSteps"`
+	AvailabilityPercent     float64 `json:"availabilityPercent"`
+	NumTransfers            int     `json:"numTransfers"`
+	AverageLatencyInSeconds float64 `json:"averageLatencyInSeconds"`
+	P50LatencyInSeconds     int64   `json:"p50LatencyInSeconds"`
+	P90LatencyInSeconds     int64   `json:"p90LatencyInSeconds"`
+	P99LatencyInSeconds     int64   `json:"p99LatencyInSeconds"`
+	NumMissedLeaderSlots    uint64  `json:"numMissedLeaderSlots"`
+}
+
+func newReport(stats *monthlyStats, periodInSeconds int64) *Report {
+	report := &Report{
+		Month:           stats.Month,
+		PeriodInSeconds: periodInSeconds,
+		UpSeconds:       stats.UpSeconds,
+		UptimePercent:   percent(uint64(stats.UpSeconds), uint64(periodInSeconds)),
+		Directions:      make([]DirectionReport, 0, len(stats.Directions)),
+	}
+
+	for name, dirStats := range stats.Directions {
+		report.Directions = append(report.Directions, newDirectionReport(name, dirStats))
+	}
+	sort.Slice(report.Directions, func(i, j int) bool {
+		return report.Directions[i].Name < report.Directions[j].Name
+	})
+
+	return report
+}
+
+func newDirectionReport(name string, stats *directionStats) DirectionReport {
+	dirReport := DirectionReport{
+		Name:                 name,
+		NumSteps:             stats.NumSteps,
+		NumFailedSteps:       stats.NumFailedSteps,
+		AvailabilityPercent:  100,
+		NumTransfers:         len(stats.LatenciesInSeconds),
+		NumMissedLeaderSlots: stats.NumMissedLeaderSlots,
+	}
+	if stats.NumSteps > 0 {
+		numSucceeded := uint64(0)
+		if stats.NumSteps > stats.NumFailedSteps {
+			numSucceeded = stats.NumSteps - stats.NumFailedSteps
+		}
+		dirReport.AvailabilityPercent = percent(numSucceeded, stats.NumSteps)
+	}
+	if dirReport.NumTransfers == 0 {
+		return dirReport
+	}
+
+	latencies := append(make([]int64, 0, len(stats.LatenciesInSeconds)), stats.LatenciesInSeconds...)
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	sum := int64(0)
+	for _, latency := range latencies {
+		sum += latency
+	}
+	dirReport.AverageLatencyInSeconds = float64(sum) / float64(len(latencies))
+	dirReport.P50LatencyInSeconds = percentile(latencies, 50)
+	dirReport.P90LatencyInSeconds = percentile(latencies, 90)
+	dirReport.P99LatencyInSeconds = percentile(latencies, 99)
+
+	return dirReport
+}
+
+func percent(value uint64, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return math.Min(100, float64(value)*100/float64(total))
+}
+
+// percentile uses the nearest-rank method on the sorted values
+func percentile(sortedValues []int64, p float64) int64 {
+	rank := int(math.Ceil(p / 100 * float64(len(sortedValues))))
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sortedValues[rank-1]
+}
+
+// Export writes the report in the provided format
+func (report *Report) Export(writer io.Writer, format string) error {
+	switch format {
+	case JSONFormat:
+		buff, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = writer.Write(buff)
+		return err
+	case HTMLFormat:
+		return htmlReportTemplate.Execute(writer, report)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownReportFormat, format)
+	}
+}
+
+var htmlReportTemplate = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Relayer SLA report {{.Month}}</title>
+</head>
+<body>
+<h1>Relayer SLA report {{.Month}}</h1>
+<p>Uptime: {{printf "%.3f" .UptimePercent}}% ({{.UpSeconds}} out of {{.PeriodInSeconds}} seconds)</p>
+<table border="1">
+<tr>
+<th>Direction</th>
+<th>Availability</th>
+<th>Steps</th>
+<th>Failed steps</th>
+<th>Transfers</th>
+<th>Average latency (s)</th>
+<th>p50 latency (s)</th>
+<th>p90 latency (s)</th>
+<th>p99 latency (s)</th>
+<th>Missed leader slots</th>
+</tr>
+{{- range .Directions}}
+<tr>
+<td>{{.Name}}</td>
+<td>{{printf "%.3f" .AvailabilityPercent}}%</td>
+<td>{{.NumSteps}}</td>
+<td>{{.NumFailedSteps}}</td>
+<td>{{.NumTransfers}}</td>
+<td>{{printf "%.1f" .AverageLatencyInSeconds}}</td>
+<td>{{.P50LatencyInSeconds}}</td>
+<td>{{.P90LatencyInSeconds}}</td>
+<td>{{.P99LatencyInSeconds}}</td>
+<td>{{.NumMissedLeaderSlots}}</td>
+</tr>
+{{- end}}
+</table>
+</body>
+</html>
+`))
@@ -0,0 +1,73 @@
+package sla
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestReport() *Report {
+	stats := newMonthlyStats("2026-10")
+	stats.UpSeconds = 900
+	dirStats := stats.direction(testDirection)
+	dirStats.NumSteps = 4
+	dirStats.NumFailedSteps = 1
+	dirStats.LatenciesInSeconds = []int64{50, 10, 40, 20, 30}
+	stats.direction("MultiversXToEthereum")
+
+	return newReport(stats, 1000)
+}
+
+func TestNewReport(t *testing.T) {
+	t.Parallel()
+
+	report := createTestReport()
+	assert.Equal(t, float64(90), report.UptimePercent)
+	require.Equal(t, 2, len(report.Directions))
+	assert.Equal(t, testDirection, report.Directions[0].Name)
+	assert.Equal(t, float64(75), report.Directions[0].AvailabilityPercent)
+	assert.Equal(t, float64(30), report.Directions[0].AverageLatencyInSeconds)
+	assert.Equal(t, int64(30), report.Directions[0].P50LatencyInSeconds)
+	assert.Equal(t, int64(50), report.Directions[0].P90LatencyInSeconds)
+
+	assert.Equal(t, "MultiversXToEthereum", report.Directions[1].Name)
+	assert.Equal(t, float64(100), report.Directions[1].AvailabilityPercent)
+	assert.Equal(t, 0, report.Directions[1].NumTransfers)
+}
+
+func TestReport_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		err := createTestReport().Export(buff, JSONFormat)
+		require.Nil(t, err)
+
+		report := &Report{}
+		err = json.Unmarshal(buff.Bytes(), report)
+		require.Nil(t, err)
+		assert.Equal(t, createTestReport(), report)
+	})
+	t.Run("html", func(t *testing.T) {
+		t.Parallel()
+
+		buff := bytes.NewBuffer(nil)
+		err := createTestReport().Export(buff, HTMLFormat)
+		require.Nil(t, err)
+		assert.True(t, strings.Contains(buff.String(), "<td>"+testDirection+"</td>"))
+		assert.True(t, strings.Contains(buff.String(), "Uptime: 90.000%"))
+	})
+	t.Run("unknown format should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := createTestReport().Export(bytes.NewBuffer(nil), "pdf")
+		assert.True(t, errors.Is(err, ErrUnknownReportFormat))
+	})
+}
@@ -0,0 +1,227 @@
+package sla
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("sla")
+
+const (
+	statsKeyPrefix = "sla_"
+	// maxHeartbeatGapFactor limits the time credited as uptime between two heartbeats so the periods when the
+	// relayer was stopped are not counted
+	maxHeartbeatGapFactor = 2
+)
+
+// ArgsSLATracker is the DTO used to create a new SLA tracker
+type ArgsSLATracker struct {
+	Storer            core.Storer
+	Timer             core.Timer
+	HeartbeatInterval time.Duration
+}
+
+type slaTracker struct {
+	mut               sync.Mutex
+	storer            core.Storer
+	timer             core.Timer
+	heartbeatInterval time.Duration
+	stats             *monthlyStats
+	pendingTransfers  map[string]map[uint64]int64
+	lastHeartbeat     int64
+}
+
+// NewSLATracker creates a new SLA tracker that aggregates the relayer's uptime, availability and transfer latencies
+// per month and saves them in the provided storer
+func NewSLATracker(args ArgsSLATracker) (*slaTracker, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.Timer) {
+		return nil, ErrNilTimer
+	}
+	if args.HeartbeatInterval <= 0 {
+		return nil, ErrInvalidHeartbeatInterval
+	}
+
+	tracker := &slaTracker{
+		storer:            args.Storer,
+		timer:             args.Timer,
+		heartbeatInterval: args.HeartbeatInterval,
+		pendingTransfers:  make(map[string]map[uint64]int64),
+	}
+	tracker.stats = tracker.loadStats(monthOf(args.Timer.NowUnix()))
+
+	return tracker, nil
+}
+
+func (tracker *slaTracker) loadStats(month string) *monthlyStats {
+	buff, err := tracker.storer.Get(statsKey(month))
+	if err != nil {
+		log.Debug("slaTracker: no stats found, starting a new month", "month", month)
+		return newMonthlyStats(month)
+	}
+
+	stats := newMonthlyStats(month)
+	err = json.Unmarshal(buff, stats)
+	if err != nil {
+		log.Warn("slaTracker: corrupted stats, starting a new month", "month", month, "error", err)
+		return newMonthlyStats(month)
+	}
+
+	return stats
+}
+
+func (tracker *slaTracker) saveStats() error {
+	buff, err := json.Marshal(tracker.stats)
+	if err != nil {
+		return err
+	}
+
+	return tracker.storer.Put(statsKey(tracker.stats.Month), buff)
+}
+
+// currentStats returns the stats of the current month, saving and replacing the stats of the previous month
+// if a new one started. Should be called under mutex protection
+func (tracker *slaTracker) currentStats(now int64) *monthlyStats {
+	month := monthOf(now)
+	if tracker.stats.Month == month {
+		return tracker.stats
+	}
+
+	err := tracker.saveStats()
+	if err != nil {
+		log.Error("slaTracker: error saving the stats", "month", tracker.stats.Month, "error", err)
+	}
+	tracker.stats = tracker.loadStats(month)
+
+	return tracker.stats
+}
+
+// Execute records a heartbeat, crediting the time passed since the previous one as uptime, and saves the stats
+func (tracker *slaTracker) Execute(_ context.Context) error {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	now := tracker.timer.NowUnix()
+	stats := tracker.currentStats(now)
+
+	maxGap := int64(tracker.heartbeatInterval.Seconds() * maxHeartbeatGapFactor)
+	gap := now - tracker.lastHeartbeat
+	if tracker.lastHeartbeat > 0 && gap > 0 && gap <= maxGap {
+		stats.UpSeconds += gap
+	}
+	tracker.lastHeartbeat = now
+
+	return tracker.saveStats()
+}
+
+// BeforeStep does nothing
+func (tracker *slaTracker) BeforeStep(_ context.Context, _ string, _ core.StepIdentifier) {
+}
+
+// AfterStep counts the executed step for the provided state machine
+func (tracker *slaTracker) AfterStep(_ context.Context, stateMachineName string, _ core.StepIdentifier, _ core.StepIdentifier, _ time.Duration) {
+	tracker.mut.Lock()
+	tracker.currentStats(tracker.timer.NowUnix()).direction(stateMachineName).NumSteps++
+	tracker.mut.Unlock()
+}
+
+// OnError counts a failed step for the provided state machine
+func (tracker *slaTracker) OnError(stateMachineName string, _ core.StepIdentifier, _ error) {
+	tracker.StepFailed(stateMachineName)
+}
+
+// StepFailed counts a failed step for the provided direction
+func (tracker *slaTracker) StepFailed(direction string) {
+	tracker.mut.Lock()
+	tracker.currentStats(tracker.timer.NowUnix()).direction(direction).NumFailedSteps++
+	tracker.mut.Unlock()
+}
+
+// LeaderSlotMissed counts a leader slot in which the relayer failed to perform the action for the provided direction
+func (tracker *slaTracker) LeaderSlotMissed(direction string) {
+	tracker.mut.Lock()
+	tracker.currentStats(tracker.timer.NowUnix()).direction(direction).NumMissedLeaderSlots++
+	tracker.mut.Unlock()
+}
+
+// TransferStarted records the moment the batch was first seen. Subsequent calls for the same batch are ignored
+func (tracker *slaTracker) TransferStarted(direction string, batchID uint64) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	pending, found := tracker.pendingTransfers[direction]
+	if !found {
+		pending = make(map[uint64]int64)
+		tracker.pendingTransfers[direction] = pending
+	}
+
+	_, found = pending[batchID]
+	if found {
+		return
+	}
+	pending[batchID] = tracker.timer.NowUnix()
+}
+
+// TransferCompleted records the latency of the batch, if it was previously started
+func (tracker *slaTracker) TransferCompleted(direction string, batchID uint64) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	startTime, found := tracker.pendingTransfers[direction][batchID]
+	if !found {
+		return
+	}
+	delete(tracker.pendingTransfers[direction], batchID)
+
+	now := tracker.timer.NowUnix()
+	dirStats := tracker.currentStats(now).direction(direction)
+	dirStats.LatenciesInSeconds = append(dirStats.LatenciesInSeconds, now-startTime)
+
+	log.Debug("slaTracker: transfer completed", "direction", direction, "batch ID", batchID,
+		"latency in seconds", now-startTime)
+}
+
+// Report returns the SLA report for the provided month, formatted as 2006-01
+func (tracker *slaTracker) Report(month string) (*Report, error) {
+	start, end, err := monthBounds(month)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	now := tracker.timer.NowUnix()
+	stats := tracker.currentStats(now)
+	if stats.Month != month {
+		stats = tracker.loadStats(month)
+	}
+
+	periodEnd := end
+	if now < periodEnd {
+		periodEnd = now
+	}
+
+	return newReport(stats, periodEnd-start), nil
+}
+
+// Close saves the stats
+func (tracker *slaTracker) Close() error {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	return tracker.saveStats()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracker *slaTracker) IsInterfaceNil() bool {
+	return tracker == nil
+}
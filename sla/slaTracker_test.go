@@ -0,0 +1,180 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDirection = "EthereumToMultiversX"
+
+// 2026-10-01 00:00:00 UTC
+var octoberStart = time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC).Unix()
+
+func createMockArgs() (ArgsSLATracker, *int64) {
+	currentTime := octoberStart
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return currentTime
+	}
+
+	return ArgsSLATracker{
+		Storer:            testsCommon.NewStorerMock(),
+		Timer:             timer,
+		HeartbeatInterval: time.Minute,
+	}, &currentTime
+}
+
+func TestNewSLATracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgs()
+		args.Storer = nil
+		tracker, err := NewSLATracker(args)
+		assert.Equal(t, ErrNilStorer, err)
+		assert.True(t, check.IfNil(tracker))
+	})
+	t.Run("nil timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgs()
+		args.Timer = nil
+		tracker, err := NewSLATracker(args)
+		assert.Equal(t, ErrNilTimer, err)
+		assert.True(t, check.IfNil(tracker))
+	})
+	t.Run("invalid heartbeat interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgs()
+		args.HeartbeatInterval = 0
+		tracker, err := NewSLATracker(args)
+		assert.Equal(t, ErrInvalidHeartbeatInterval, err)
+		assert.True(t, check.IfNil(tracker))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgs()
+		tracker, err := NewSLATracker(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tracker))
+	})
+}
+
+func TestSLATracker_Uptime(t *testing.T) {
+	t.Parallel()
+
+	args, currentTime := createMockArgs()
+	tracker, _ := NewSLATracker(args)
+
+	for i := 0; i < 10; i++ {
+		_ = tracker.Execute(context.Background())
+		*currentTime += 60
+	}
+	// the relayer was stopped for 10 minutes, this gap should not be credited
+	*currentTime += 600
+	_ = tracker.Execute(context.Background())
+	*currentTime += 60
+	_ = tracker.Execute(context.Background())
+
+	report, err := tracker.Report("2026-10")
+	require.Nil(t, err)
+	assert.Equal(t, int64(600), report.UpSeconds)
+	assert.Equal(t, int64(1260), report.PeriodInSeconds)
+	assert.InDelta(t, 47.619, report.UptimePercent, 0.001)
+}
+
+func TestSLATracker_DirectionsStats(t *testing.T) {
+	t.Parallel()
+
+	args, currentTime := createMockArgs()
+	tracker, _ := NewSLATracker(args)
+
+	for i := 0; i < 10; i++ {
+		tracker.AfterStep(context.Background(), testDirection, "step", "next step", time.Second)
+	}
+	tracker.StepFailed(testDirection)
+	tracker.OnError(testDirection, "step", errors.New("step not found"))
+	tracker.LeaderSlotMissed(testDirection)
+
+	tracker.TransferStarted(testDirection, 1)
+	*currentTime += 10
+	tracker.TransferStarted(testDirection, 1)
+	tracker.TransferStarted(testDirection, 2)
+	*currentTime += 30
+	tracker.TransferCompleted(testDirection, 1)
+	tracker.TransferCompleted(testDirection, 1)
+	tracker.TransferCompleted(testDirection, 3)
+	*currentTime += 20
+	tracker.TransferCompleted(testDirection, 2)
+
+	report, err := tracker.Report("2026-10")
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.Directions))
+	dirReport := report.Directions[0]
+	assert.Equal(t, testDirection, dirReport.Name)
+	assert.Equal(t, uint64(10), dirReport.NumSteps)
+	assert.Equal(t, uint64(2), dirReport.NumFailedSteps)
+	assert.Equal(t, float64(80), dirReport.AvailabilityPercent)
+	assert.Equal(t, uint64(1), dirReport.NumMissedLeaderSlots)
+	assert.Equal(t, 2, dirReport.NumTransfers)
+	assert.Equal(t, float64(45), dirReport.AverageLatencyInSeconds)
+	assert.Equal(t, int64(40), dirReport.P50LatencyInSeconds)
+	assert.Equal(t, int64(50), dirReport.P99LatencyInSeconds)
+}
+
+func TestSLATracker_NewMonth(t *testing.T) {
+	t.Parallel()
+
+	args, currentTime := createMockArgs()
+	tracker, _ := NewSLATracker(args)
+
+	tracker.AfterStep(context.Background(), testDirection, "step", "next step", time.Second)
+	_ = tracker.Execute(context.Background())
+
+	*currentTime = time.Date(2026, time.November, 2, 0, 0, 0, 0, time.UTC).Unix()
+	tracker.AfterStep(context.Background(), testDirection, "step", "next step", time.Second)
+	tracker.AfterStep(context.Background(), testDirection, "step", "next step", time.Second)
+	_ = tracker.Execute(context.Background())
+
+	report, err := tracker.Report("2026-10")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), report.Directions[0].NumSteps)
+	assert.Equal(t, int64(31*24*3600), report.PeriodInSeconds)
+
+	report, err = tracker.Report("2026-11")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), report.Directions[0].NumSteps)
+
+	report, err = tracker.Report("2026-09")
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.Directions))
+
+	_, err = tracker.Report("October")
+	assert.Equal(t, ErrInvalidMonth, err)
+}
+
+func TestSLATracker_ShouldLoadTheSavedStats(t *testing.T) {
+	t.Parallel()
+
+	args, _ := createMockArgs()
+	tracker, _ := NewSLATracker(args)
+	tracker.AfterStep(context.Background(), testDirection, "step", "next step", time.Second)
+	err := tracker.Close()
+	require.Nil(t, err)
+
+	tracker, _ = NewSLATracker(args)
+	report, err := tracker.Report("2026-10")
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), report.Directions[0].NumSteps)
+}
@@ -0,0 +1,94 @@
+package stateMachine
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/status"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// checkpoint is the persisted position of a state machine: the step to be executed next and the executor state
+type checkpoint struct {
+	Step      core.StepIdentifier `json:"step"`
+	State     []byte              `json:"state"`
+	Timestamp int64               `json:"timestamp"`
+}
+
+func (sm *stateMachine) checkpointKey() []byte {
+	return status.CheckpointKey(sm.stateMachineName)
+}
+
+func (sm *stateMachine) saveCheckpoint(nextStep core.StepIdentifier) {
+	if check.IfNil(sm.checkpointStorer) {
+		return
+	}
+
+	state, err := sm.resumableState.SaveState()
+	if err != nil {
+		sm.log.Warn(fmt.Sprintf("%s: can not save the executor state", sm.stateMachineName), "error", err)
+		return
+	}
+
+	buff, err := json.Marshal(&checkpoint{
+		Step:      nextStep,
+		State:     state,
+		Timestamp: sm.timer.NowUnix(),
+	})
+	if err != nil {
+		sm.log.Warn(fmt.Sprintf("%s: can not marshal the checkpoint", sm.stateMachineName), "error", err)
+		return
+	}
+
+	err = sm.checkpointStorer.Put(sm.checkpointKey(), buff)
+	if err != nil {
+		sm.log.Warn(fmt.Sprintf("%s: can not persist the checkpoint", sm.stateMachineName), "error", err)
+	}
+}
+
+// Restore moves the state machine to the step persisted before the relayer restart and restores the executor state, so
+// the flow resumes mid-way instead of starting again from the first step. A missing checkpoint or one older than the
+// maximum age is ignored. On error, the state machine stays on its start step. Should be called before the first
+// execution
+func (sm *stateMachine) Restore() error {
+	if check.IfNil(sm.checkpointStorer) {
+		return ErrCheckpointingDisabled
+	}
+
+	buff, err := sm.checkpointStorer.Get(sm.checkpointKey())
+	if err != nil {
+		sm.log.Debug(fmt.Sprintf("%s: no checkpoint to restore", sm.stateMachineName))
+		return nil
+	}
+
+	persisted := &checkpoint{}
+	err = json.Unmarshal(buff, persisted)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the checkpoint of %s", err, sm.stateMachineName)
+	}
+
+	age := time.Duration(sm.timer.NowUnix()-persisted.Timestamp) * time.Second
+	if sm.checkpointMaxAge > 0 && age > sm.checkpointMaxAge {
+		sm.log.Info(fmt.Sprintf("%s: the checkpoint is too old, starting from the first step", sm.stateMachineName),
+			"step", persisted.Step, "age", age, "maximum age", sm.checkpointMaxAge)
+		return nil
+	}
+
+	step, err := sm.getNextStep(persisted.Step)
+	if err != nil {
+		return err
+	}
+
+	err = sm.resumableState.RestoreState(persisted.State)
+	if err != nil {
+		return fmt.Errorf("%w while restoring the executor state of %s", err, sm.stateMachineName)
+	}
+
+	sm.currentStep = step
+	sm.log.Info(fmt.Sprintf("%s: resumed from the checkpoint", sm.stateMachineName),
+		"step", persisted.Step, "age", age)
+
+	return nil
+}
@@ -16,3 +16,15 @@ var ErrNilLogger = errors.New("nil logger")
 
 // ErrNilStatusHandler signals that a nil status handler was provided
 var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilStepHook signals that a nil step hook was provided
+var ErrNilStepHook = errors.New("nil step hook")
+
+// ErrNilResumableState signals that a nil resumable state was provided along with a checkpoint storer
+var ErrNilResumableState = errors.New("nil resumable state")
+
+// ErrNilTimer signals that a nil timer was provided
+var ErrNilTimer = errors.New("nil timer")
+
+// ErrCheckpointingDisabled signals that the state machine was created without a checkpoint storer
+var ErrCheckpointingDisabled = errors.New("checkpointing disabled")
@@ -3,6 +3,9 @@ package stateMachine
 import (
 	"context"
 	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -16,6 +19,11 @@ type ArgsStateMachine struct {
 	StartStateIdentifier core.StepIdentifier
 	Log                  logger.Logger
 	StatusHandler        core.StatusHandler
+	StepHooks            []core.StepHook
+	CheckpointStorer     core.Storer
+	ResumableState       core.ResumableState
+	CheckpointMaxAge     time.Duration
+	Timer                core.Timer
 }
 
 type stateMachine struct {
@@ -24,9 +32,16 @@ type stateMachine struct {
 	currentStep      core.Step
 	log              logger.Logger
 	statusHandler    core.StatusHandler
+	mutHooks         sync.RWMutex
+	hooks            []core.StepHook
+	checkpointStorer core.Storer
+	resumableState   core.ResumableState
+	checkpointMaxAge time.Duration
+	timer            core.Timer
 }
 
-// NewStateMachine creates a state machine able to execute all provided steps
+// NewStateMachine creates a state machine able to execute all provided steps. If a checkpoint storer is provided, the
+// next step and the executor state are persisted after each step, see Restore
 func NewStateMachine(args ArgsStateMachine) (*stateMachine, error) {
 	err := checkArgs(args)
 	if err != nil {
@@ -38,6 +53,11 @@ func NewStateMachine(args ArgsStateMachine) (*stateMachine, error) {
 		steps:            args.Steps,
 		log:              args.Log,
 		statusHandler:    args.StatusHandler,
+		hooks:            append(make([]core.StepHook, 0, len(args.StepHooks)), args.StepHooks...),
+		checkpointStorer: args.CheckpointStorer,
+		resumableState:   args.ResumableState,
+		checkpointMaxAge: args.CheckpointMaxAge,
+		timer:            args.Timer,
 	}
 	sm.currentStep, err = sm.getNextStep(args.StartStateIdentifier)
 	if err != nil {
@@ -62,6 +82,20 @@ func checkArgs(args ArgsStateMachine) error {
 	if check.IfNil(args.StatusHandler) {
 		return ErrNilStatusHandler
 	}
+	for i, hook := range args.StepHooks {
+		if check.IfNil(hook) {
+			return fmt.Errorf("%w at index %d", ErrNilStepHook, i)
+		}
+	}
+	if check.IfNil(args.Timer) {
+		return ErrNilTimer
+	}
+	if check.IfNil(args.CheckpointStorer) {
+		return nil
+	}
+	if check.IfNil(args.ResumableState) {
+		return ErrNilResumableState
+	}
 
 	return nil
 }
@@ -71,16 +105,77 @@ func (sm *stateMachine) Execute(ctx context.Context) error {
 	return sm.executeStep(ctx)
 }
 
+// RegisterStepHook adds a new hook that will be notified around each step execution
+func (sm *stateMachine) RegisterStepHook(hook core.StepHook) error {
+	if check.IfNil(hook) {
+		return ErrNilStepHook
+	}
+
+	sm.mutHooks.Lock()
+	sm.hooks = append(sm.hooks, hook)
+	sm.mutHooks.Unlock()
+
+	return nil
+}
+
 func (sm *stateMachine) executeStep(ctx context.Context) error {
+	stepIdentifier := sm.currentStep.Identifier()
 	sm.log.Debug(fmt.Sprintf("%s: executing step", sm.stateMachineName),
-		"step", sm.currentStep.Identifier())
-	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, string(sm.currentStep.Identifier()))
+		"step", stepIdentifier)
+	sm.statusHandler.SetStringMetric(core.MetricCurrentStateMachineStep, string(stepIdentifier))
+
+	hooks := sm.getHooks()
+	defer sm.notifyPanic(hooks, stepIdentifier)
+	for _, hook := range hooks {
+		hook.BeforeStep(ctx, sm.stateMachineName, stepIdentifier)
+	}
+
+	startTime := sm.timer.Now()
 	nextStepIdentifier := sm.currentStep.Execute(ctx)
+	duration := sm.timer.Now().Sub(startTime)
+	sm.statusHandler.SetIntMetric(core.MetricLastStepDurationInMillis, int(duration.Milliseconds()))
+
+	for _, hook := range hooks {
+		hook.AfterStep(ctx, sm.stateMachineName, stepIdentifier, nextStepIdentifier, duration)
+	}
 
 	currentStep, err := sm.getNextStep(nextStepIdentifier)
 	sm.currentStep = currentStep
+	if err != nil {
+		for _, hook := range hooks {
+			hook.OnError(sm.stateMachineName, stepIdentifier, err)
+		}
+		return err
+	}
+
+	sm.saveCheckpoint(nextStepIdentifier)
+
+	return nil
+}
+
+// notifyPanic should be deferred. It notifies the hooks that implement core.PanicHook and re-panics
+func (sm *stateMachine) notifyPanic(hooks []core.StepHook, step core.StepIdentifier) {
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+
+	stack := debug.Stack()
+	for _, hook := range hooks {
+		panicHook, isPanicHook := hook.(core.PanicHook)
+		if isPanicHook {
+			panicHook.OnPanic(sm.stateMachineName, step, recovered, stack)
+		}
+	}
+
+	panic(recovered)
+}
+
+func (sm *stateMachine) getHooks() []core.StepHook {
+	sm.mutHooks.RLock()
+	defer sm.mutHooks.RUnlock()
 
-	return err
+	return sm.hooks
 }
 
 func (sm *stateMachine) getNextStep(identifier core.StepIdentifier) (core.Step, error) {
@@ -3,15 +3,21 @@ package stateMachine_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/stateMachine"
+	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+var expectedErr = errors.New("expected error")
+
 func createMockArgs() stateMachine.ArgsStateMachine {
 	return stateMachine.ArgsStateMachine{
 		Steps: core.MachineStates{
@@ -24,6 +30,7 @@ func createMockArgs() stateMachine.ArgsStateMachine {
 		StartStateIdentifier: "mock",
 		Log:                  logger.GetOrCreate("test"),
 		StatusHandler:        testsCommon.NewStatusHandlerMock("mock"),
+		Timer:                &testsCommon.TimerMock{},
 	}
 }
 
@@ -80,6 +87,36 @@ func TestNewStateMachine(t *testing.T) {
 		assert.Nil(t, sm)
 		assert.True(t, errors.Is(err, stateMachine.ErrNilStatusHandler))
 	})
+	t.Run("nil step hook", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StepHooks = []core.StepHook{&testsCommon.StepHookStub{}, nil}
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.True(t, errors.Is(err, stateMachine.ErrNilStepHook))
+	})
+	t.Run("checkpoint storer without resumable state", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.CheckpointStorer = testsCommon.NewStorerMock()
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilResumableState, err)
+	})
+	t.Run("nil timer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Timer = nil
+		sm, err := stateMachine.NewStateMachine(args)
+
+		assert.Nil(t, sm)
+		assert.Equal(t, stateMachine.ErrNilTimer, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -145,3 +182,306 @@ func TestExecute(t *testing.T) {
 		assert.Equal(t, providedIdentifier2, sm.GetCurrentStepIdentifier())
 	})
 }
+
+func TestExecute_ShouldSetTheStepMetrics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgs()
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	timer := testsCommon.NewTimeTravelTimerMock(time.Now())
+	args.Timer = timer
+	args.Steps = core.MachineStates{
+		"mock": &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				timer.Sleep(time.Millisecond * 20)
+				return "mock"
+			},
+			IdentifierCalled: func() core.StepIdentifier {
+				return "mock"
+			},
+		},
+	}
+	sm, _ := stateMachine.NewStateMachine(args)
+
+	err := sm.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "mock", statusHandler.GetStringMetric(core.MetricCurrentStateMachineStep))
+	assert.Equal(t, 20, statusHandler.GetIntMetric(core.MetricLastStepDurationInMillis))
+}
+
+func TestStateMachine_RegisterStepHook(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil hook should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := stateMachine.NewStateMachine(createMockArgs())
+		err := sm.RegisterStepHook(nil)
+		assert.Equal(t, stateMachine.ErrNilStepHook, err)
+	})
+	t.Run("hooks should be called around the step execution", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.StateMachineName = "test"
+		executed := false
+		calls := make([]string, 0)
+		args.Steps = core.MachineStates{
+			"step0": &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					executed = true
+					calls = append(calls, "execute")
+					return "step0"
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return "step0"
+				},
+			},
+		}
+		args.StartStateIdentifier = "step0"
+		args.StepHooks = []core.StepHook{
+			&testsCommon.StepHookStub{
+				BeforeStepCalled: func(ctx context.Context, stateMachineName string, step core.StepIdentifier) {
+					assert.False(t, executed)
+					assert.Equal(t, "test", stateMachineName)
+					assert.Equal(t, core.StepIdentifier("step0"), step)
+					calls = append(calls, "before hook 1")
+				},
+			},
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.RegisterStepHook(&testsCommon.StepHookStub{
+			AfterStepCalled: func(ctx context.Context, stateMachineName string, step core.StepIdentifier, nextStep core.StepIdentifier, duration time.Duration) {
+				assert.True(t, executed)
+				assert.Equal(t, core.StepIdentifier("step0"), step)
+				assert.Equal(t, core.StepIdentifier("step0"), nextStep)
+				calls = append(calls, "after hook 2")
+			},
+			OnErrorCalled: func(stateMachineName string, step core.StepIdentifier, err error) {
+				assert.Fail(t, "should have not called OnError")
+			},
+		})
+		assert.Nil(t, err)
+
+		err = sm.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"before hook 1", "execute", "after hook 2"}, calls)
+	})
+	t.Run("unknown next step should call OnError", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Steps = core.MachineStates{
+			"step0": &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					return "not found"
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return "step0"
+				},
+			},
+		}
+		args.StartStateIdentifier = "step0"
+		var hookError error
+		args.StepHooks = []core.StepHook{
+			&testsCommon.StepHookStub{
+				OnErrorCalled: func(stateMachineName string, step core.StepIdentifier, err error) {
+					assert.Equal(t, core.StepIdentifier("step0"), step)
+					hookError = err
+				},
+			},
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Execute(context.Background())
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+		assert.Equal(t, err, hookError)
+	})
+	t.Run("panicking step should call OnPanic and re-panic", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgs()
+		args.Steps = core.MachineStates{
+			"step0": &testsCommon.StepMock{
+				ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+					panic("step panic")
+				},
+				IdentifierCalled: func() core.StepIdentifier {
+					return "step0"
+				},
+			},
+		}
+		args.StartStateIdentifier = "step0"
+		var hookRecovered interface{}
+		args.StepHooks = []core.StepHook{
+			&testsCommon.StepHookStub{
+				OnPanicCalled: func(stateMachineName string, step core.StepIdentifier, recovered interface{}, stack []byte) {
+					assert.Equal(t, core.StepIdentifier("step0"), step)
+					assert.True(t, len(stack) > 0)
+					hookRecovered = recovered
+				},
+			},
+		}
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		assert.PanicsWithValue(t, "step panic", func() {
+			_ = sm.Execute(context.Background())
+		})
+		assert.Equal(t, "step panic", hookRecovered)
+	})
+}
+
+const checkpointNow = int64(1700000000)
+
+func createCheckpointArgs(storer core.Storer, resumableState core.ResumableState) stateMachine.ArgsStateMachine {
+	args := createMockArgs()
+	args.Steps = core.MachineStates{
+		"step0": &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				return "step1"
+			},
+		},
+		"step1": &testsCommon.StepMock{
+			ExecuteCalled: func(ctx context.Context) core.StepIdentifier {
+				return "step0"
+			},
+		},
+	}
+	args.StartStateIdentifier = "step0"
+	args.StateMachineName = "mock"
+	args.CheckpointStorer = storer
+	args.ResumableState = resumableState
+	args.CheckpointMaxAge = time.Hour
+	timer := testsCommon.NewTimerStub()
+	timer.NowUnixCalled = func() int64 {
+		return checkpointNow
+	}
+	args.Timer = timer
+
+	return args
+}
+
+func TestStateMachine_Restore(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without checkpoint storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		sm, _ := stateMachine.NewStateMachine(createMockArgs())
+		err := sm.Restore()
+		assert.Equal(t, stateMachine.ErrCheckpointingDisabled, err)
+	})
+	t.Run("missing checkpoint should start from the first step", func(t *testing.T) {
+		t.Parallel()
+
+		args := createCheckpointArgs(testsCommon.NewStorerMock(), &testsCommon.ResumableStateStub{
+			RestoreStateCalled: func(buff []byte) error {
+				assert.Fail(t, "should have not called RestoreState")
+				return nil
+			},
+		})
+		sm, _ := stateMachine.NewStateMachine(args)
+
+		err := sm.Restore()
+		assert.Nil(t, err)
+		assert.Equal(t, core.StepIdentifier("step0"), sm.GetCurrentStepIdentifier())
+	})
+	t.Run("corrupted checkpoint should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		_ = storer.Put(status.CheckpointKey("mock"), []byte("corrupted"))
+		sm, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{}))
+
+		err := sm.Restore()
+		assert.NotNil(t, err)
+		assert.Equal(t, core.StepIdentifier("step0"), sm.GetCurrentStepIdentifier())
+	})
+	t.Run("unknown step should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		checkpoint := fmt.Sprintf(`{"step":"removed step","timestamp":%d}`, checkpointNow)
+		_ = storer.Put(status.CheckpointKey("mock"), []byte(checkpoint))
+		sm, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{}))
+
+		err := sm.Restore()
+		assert.True(t, errors.Is(err, stateMachine.ErrStepNotFound))
+		assert.Equal(t, core.StepIdentifier("step0"), sm.GetCurrentStepIdentifier())
+	})
+	t.Run("restore state errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		sm, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{}))
+		require.Nil(t, sm.Execute(context.Background()))
+
+		restartedSM, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{
+			RestoreStateCalled: func(buff []byte) error {
+				return expectedErr
+			},
+		}))
+		err := restartedSM.Restore()
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Equal(t, core.StepIdentifier("step0"), restartedSM.GetCurrentStepIdentifier())
+	})
+	t.Run("too old checkpoint should start from the first step", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		checkpoint := fmt.Sprintf(`{"step":"step1","timestamp":%d}`, checkpointNow-int64(2*time.Hour.Seconds()))
+		_ = storer.Put(status.CheckpointKey("mock"), []byte(checkpoint))
+		sm, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{
+			RestoreStateCalled: func(buff []byte) error {
+				assert.Fail(t, "should have not called RestoreState")
+				return nil
+			},
+		}))
+
+		err := sm.Restore()
+		assert.Nil(t, err)
+		assert.Equal(t, core.StepIdentifier("step0"), sm.GetCurrentStepIdentifier())
+	})
+	t.Run("should resume from the persisted step", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		sm, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{
+			SaveStateCalled: func() ([]byte, error) {
+				return []byte("executor state"), nil
+			},
+		}))
+		require.Nil(t, sm.Execute(context.Background()))
+		assert.Equal(t, core.StepIdentifier("step1"), sm.GetCurrentStepIdentifier())
+
+		var restoredState []byte
+		restartedSM, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{
+			RestoreStateCalled: func(buff []byte) error {
+				restoredState = buff
+				return nil
+			},
+		}))
+		assert.Equal(t, core.StepIdentifier("step0"), restartedSM.GetCurrentStepIdentifier())
+
+		err := restartedSM.Restore()
+		assert.Nil(t, err)
+		assert.Equal(t, core.StepIdentifier("step1"), restartedSM.GetCurrentStepIdentifier())
+		assert.Equal(t, []byte("executor state"), restoredState)
+	})
+	t.Run("save state errors should keep the previous checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		sm, _ := stateMachine.NewStateMachine(createCheckpointArgs(storer, &testsCommon.ResumableStateStub{
+			SaveStateCalled: func() ([]byte, error) {
+				return nil, expectedErr
+			},
+		}))
+		require.Nil(t, sm.Execute(context.Background()))
+
+		_, err := storer.Get(status.CheckpointKey("mock"))
+		assert.NotNil(t, err)
+	})
+}
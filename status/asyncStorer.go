@@ -0,0 +1,236 @@
+package status
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const minBufferSize = 1
+
+// ArgsAsyncStorer is the DTO used to create a new async storer
+type ArgsAsyncStorer struct {
+	Storer        core.Storer
+	StatusHandler core.StatusHandler
+	BufferSize    int
+}
+
+type asyncStorer struct {
+	storer        core.Storer
+	statusHandler core.StatusHandler
+	bufferSize    int
+	mutPending    sync.RWMutex
+	pending       map[string][]byte
+	inFlight      map[string][]byte
+	condWrite     *sync.Cond
+	writingKey    string
+	isWriting     bool
+	chWrite       chan struct{}
+	cancel        func()
+	chDone        chan struct{}
+	closeOnce     sync.Once
+}
+
+// NewAsyncStorer creates a storer that moves the writes of the wrapped storer on a separate go routine, so a slow
+// disk can not stall the callers. The writes made on the same key, not yet saved, are coalesced and only the latest
+// value is saved. If the buffer is full, the writes on new keys are dropped and counted as overflows.
+// The pending writes are flushed on Close
+func NewAsyncStorer(args ArgsAsyncStorer) (*asyncStorer, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return nil, ErrNilStatusHandler
+	}
+	if args.BufferSize < minBufferSize {
+		return nil, ErrInvalidBufferSize
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	storer := &asyncStorer{
+		storer:        args.Storer,
+		statusHandler: args.StatusHandler,
+		bufferSize:    args.BufferSize,
+		pending:       make(map[string][]byte),
+		inFlight:      make(map[string][]byte),
+		chWrite:       make(chan struct{}, 1),
+		cancel:        cancel,
+		chDone:        make(chan struct{}),
+	}
+	storer.condWrite = sync.NewCond(&storer.mutPending)
+	go storer.processLoop(ctx)
+
+	return storer, nil
+}
+
+// Put schedules the write of the provided data. It never blocks on the wrapped storer
+func (storer *asyncStorer) Put(key, data []byte) error {
+	storer.mutPending.Lock()
+	_, exists := storer.pending[string(key)]
+	if !exists && len(storer.pending) >= storer.bufferSize {
+		storer.mutPending.Unlock()
+
+		storer.statusHandler.AddIntMetric(core.MetricNumAsyncStorerOverflows, 1)
+		log.Warn("asyncStorer.Put: buffer full, write dropped", "key", string(key), "buffer size", storer.bufferSize)
+		return nil
+	}
+
+	storer.pending[string(key)] = data
+	numPending := len(storer.pending)
+	storer.mutPending.Unlock()
+
+	storer.statusHandler.SetIntMetric(core.MetricAsyncStorerPendingWrites, numPending)
+
+	select {
+	case storer.chWrite <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Get returns the value of the key not yet saved, if any, otherwise it reads it from the wrapped storer
+func (storer *asyncStorer) Get(key []byte) ([]byte, error) {
+	storer.mutPending.RLock()
+	data, exists := storer.pending[string(key)]
+	if !exists {
+		data, exists = storer.inFlight[string(key)]
+	}
+	storer.mutPending.RUnlock()
+	if exists {
+		return data, nil
+	}
+
+	return storer.storer.Get(key)
+}
+
+func (storer *asyncStorer) processLoop(ctx context.Context) {
+	defer close(storer.chDone)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("asyncStorer's processing loop is closing...")
+			return
+		case <-storer.chWrite:
+			storer.writePending()
+		}
+	}
+}
+
+func (storer *asyncStorer) writePending() {
+	storer.mutPending.Lock()
+	pending := storer.pending
+	storer.inFlight = pending
+	storer.pending = make(map[string][]byte)
+	storer.mutPending.Unlock()
+
+	for key, data := range pending {
+		if !storer.startWriting(key) {
+			continue
+		}
+
+		err := storer.storer.Put([]byte(key), data)
+		storer.endWriting()
+		if err != nil {
+			log.Debug("asyncStorer.writePending writing to storer", "key", key, "error", err)
+			continue
+		}
+
+		storer.statusHandler.AddIntMetric(core.MetricNumAsyncStorerWrites, 1)
+	}
+
+	storer.mutPending.Lock()
+	storer.inFlight = make(map[string][]byte)
+	numPending := len(storer.pending)
+	storer.mutPending.Unlock()
+	storer.statusHandler.SetIntMetric(core.MetricAsyncStorerPendingWrites, numPending)
+}
+
+// startWriting marks the provided key as being written, unless its in flight value was replaced by a synchronous write
+func (storer *asyncStorer) startWriting(key string) bool {
+	storer.mutPending.Lock()
+	defer storer.mutPending.Unlock()
+
+	_, exists := storer.inFlight[key]
+	if !exists {
+		return false
+	}
+
+	storer.writingKey = key
+	storer.isWriting = true
+
+	return true
+}
+
+func (storer *asyncStorer) endWriting() {
+	storer.mutPending.Lock()
+	storer.isWriting = false
+	storer.condWrite.Broadcast()
+	storer.mutPending.Unlock()
+}
+
+// Close stops the processing loop, flushes the pending writes and closes the wrapped storer
+func (storer *asyncStorer) Close() error {
+	var err error
+	storer.closeOnce.Do(func() {
+		storer.cancel()
+		<-storer.chDone
+
+		storer.writePending()
+		err = storer.storer.Close()
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *asyncStorer) IsInterfaceNil() bool {
+	return storer == nil
+}
+
+// synchronousStorer writes directly on the storer wrapped by an async storer, the wrapped storer being closed by the
+// async storer
+type synchronousStorer struct {
+	*asyncStorer
+}
+
+// NewSynchronousStorer returns a storer whose writes are saved before Put returns, for the data that must not be lost
+// on a crash. If the provided storer is an async storer, the writes skip its buffer, otherwise the provided storer is
+// returned as it is
+func NewSynchronousStorer(storer core.Storer) core.Storer {
+	async, ok := storer.(*asyncStorer)
+	if !ok {
+		return storer
+	}
+
+	return &synchronousStorer{
+		asyncStorer: async,
+	}
+}
+
+// Put saves the provided data on the wrapped storer, replacing the write on the same key not yet saved, if any. If the
+// async storer is writing the same key, Put waits for that write to end, so an older value can not overwrite the data
+func (storer *synchronousStorer) Put(key, data []byte) error {
+	storer.mutPending.Lock()
+	for storer.isWriting && storer.writingKey == string(key) {
+		storer.condWrite.Wait()
+	}
+	delete(storer.pending, string(key))
+	delete(storer.inFlight, string(key))
+	storer.mutPending.Unlock()
+
+	return storer.storer.Put(key, data)
+}
+
+// Close does nothing, the wrapped storer being closed by the async storer
+func (storer *synchronousStorer) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (storer *synchronousStorer) IsInterfaceNil() bool {
+	return storer == nil || storer.asyncStorer == nil
+}
@@ -0,0 +1,321 @@
+package status
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsAsyncStorer() ArgsAsyncStorer {
+	return ArgsAsyncStorer{
+		Storer:        testsCommon.NewStorerMock(),
+		StatusHandler: testsCommon.NewStatusHandlerMock("test"),
+		BufferSize:    10,
+	}
+}
+
+func TestNewAsyncStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		args := createMockArgsAsyncStorer()
+		args.Storer = nil
+
+		storer, err := NewAsyncStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsAsyncStorer()
+		args.StatusHandler = nil
+
+		storer, err := NewAsyncStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("invalid buffer size should error", func(t *testing.T) {
+		args := createMockArgsAsyncStorer()
+		args.BufferSize = 0
+
+		storer, err := NewAsyncStorer(args)
+		assert.True(t, check.IfNil(storer))
+		assert.Equal(t, ErrInvalidBufferSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		storer, err := NewAsyncStorer(createMockArgsAsyncStorer())
+		assert.False(t, check.IfNil(storer))
+		assert.Nil(t, err)
+
+		assert.Nil(t, storer.Close())
+	})
+}
+
+func TestAsyncStorer_PutShouldNotBlockOnTheWrappedStorer(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	mutWritten := sync.Mutex{}
+	written := make(map[string][]byte)
+	args := createMockArgsAsyncStorer()
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			<-chRelease
+
+			mutWritten.Lock()
+			written[string(key)] = data
+			mutWritten.Unlock()
+
+			return nil
+		},
+	}
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	storer, _ := NewAsyncStorer(args)
+
+	chPutDone := make(chan struct{})
+	go func() {
+		_ = storer.Put([]byte("key"), []byte("value 1"))
+		_ = storer.Put([]byte("key"), []byte("value 2"))
+		close(chPutDone)
+	}()
+
+	select {
+	case <-chPutDone:
+	case <-time.After(time.Second):
+		require.Fail(t, "Put should not block")
+	}
+
+	data, err := storer.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value 2"), data)
+
+	close(chRelease)
+	assert.Nil(t, storer.Close())
+
+	mutWritten.Lock()
+	assert.Equal(t, []byte("value 2"), written["key"])
+	mutWritten.Unlock()
+	assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricAsyncStorerPendingWrites))
+}
+
+func TestAsyncStorer_PutOnFullBufferShouldCountOverflows(t *testing.T) {
+	t.Parallel()
+
+	chRelease := make(chan struct{})
+	args := createMockArgsAsyncStorer()
+	args.BufferSize = 1
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			<-chRelease
+			return nil
+		},
+	}
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	storer, _ := NewAsyncStorer(args)
+
+	// the first write might be already taken by the processing loop, the second one will be pending
+	_ = storer.Put([]byte("key 1"), []byte("value"))
+	time.Sleep(time.Millisecond * 100)
+	_ = storer.Put([]byte("key 2"), []byte("value"))
+	_ = storer.Put([]byte("key 3"), []byte("value"))
+
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumAsyncStorerOverflows))
+
+	close(chRelease)
+	assert.Nil(t, storer.Close())
+}
+
+func TestAsyncStorer_GetShouldReadFromTheWrappedStorer(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	args := createMockArgsAsyncStorer()
+	args.Storer = &testsCommon.StorerStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+	storer, _ := NewAsyncStorer(args)
+	defer func() {
+		_ = storer.Close()
+	}()
+
+	data, err := storer.Get([]byte("key"))
+	assert.Nil(t, data)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestAsyncStorer_CloseShouldFlushAndCloseOnce(t *testing.T) {
+	t.Parallel()
+
+	numPut := 0
+	numClose := 0
+	args := createMockArgsAsyncStorer()
+	args.Storer = &testsCommon.StorerStub{
+		PutCalled: func(key, data []byte) error {
+			numPut++
+			return nil
+		},
+		CloseCalled: func() error {
+			numClose++
+			return nil
+		},
+	}
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	storer, _ := NewAsyncStorer(args)
+
+	_ = storer.Put([]byte("key"), []byte("value"))
+	assert.Nil(t, storer.Close())
+	assert.Nil(t, storer.Close())
+
+	assert.Equal(t, 1, numPut)
+	assert.Equal(t, 1, numClose)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumAsyncStorerWrites))
+}
+
+func TestNewSynchronousStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("other storers should be returned as they are", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		assert.True(t, storer == NewSynchronousStorer(storer))
+	})
+	t.Run("should write on the wrapped storer before returning", func(t *testing.T) {
+		t.Parallel()
+
+		chRelease := make(chan struct{})
+		wrappedStorer := testsCommon.NewStorerMock()
+		numClose := 0
+		args := createMockArgsAsyncStorer()
+		args.Storer = &testsCommon.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				if string(key) == "async key" {
+					<-chRelease
+				}
+
+				return wrappedStorer.Put(key, data)
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				return wrappedStorer.Get(key)
+			},
+			CloseCalled: func() error {
+				numClose++
+				return nil
+			},
+		}
+		asyncStorerInstance, _ := NewAsyncStorer(args)
+		storer := NewSynchronousStorer(asyncStorerInstance)
+		assert.False(t, check.IfNil(storer))
+
+		_ = asyncStorerInstance.Put([]byte("async key"), []byte("async value"))
+		err := storer.Put([]byte("key"), []byte("value"))
+		assert.Nil(t, err)
+
+		data, err := wrappedStorer.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), data)
+		data, err = storer.Get([]byte("async key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("async value"), data)
+
+		assert.Nil(t, storer.Close())
+		assert.Equal(t, 0, numClose)
+
+		close(chRelease)
+		assert.Nil(t, asyncStorerInstance.Close())
+		assert.Equal(t, 1, numClose)
+	})
+	t.Run("should wait for the async write of the same key and keep the latest value", func(t *testing.T) {
+		t.Parallel()
+
+		chWriteStarted := make(chan struct{})
+		chRelease := make(chan struct{})
+		wrappedStorer := testsCommon.NewStorerMock()
+		args := createMockArgsAsyncStorer()
+		args.Storer = &testsCommon.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				if string(data) == "async value" {
+					close(chWriteStarted)
+					<-chRelease
+				}
+
+				return wrappedStorer.Put(key, data)
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				return wrappedStorer.Get(key)
+			},
+		}
+		asyncStorerInstance, _ := NewAsyncStorer(args)
+		storer := NewSynchronousStorer(asyncStorerInstance)
+
+		_ = asyncStorerInstance.Put([]byte("key"), []byte("async value"))
+		<-chWriteStarted
+
+		chPutDone := make(chan struct{})
+		go func() {
+			_ = storer.Put([]byte("key"), []byte("sync value"))
+			close(chPutDone)
+		}()
+
+		select {
+		case <-chPutDone:
+			require.Fail(t, "Put should wait for the async write of the same key")
+		case <-time.After(time.Millisecond * 100):
+		}
+
+		close(chRelease)
+		select {
+		case <-chPutDone:
+		case <-time.After(time.Second):
+			require.Fail(t, "Put should end after the async write")
+		}
+
+		assert.Nil(t, asyncStorerInstance.Close())
+		data, err := wrappedStorer.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sync value"), data)
+	})
+	t.Run("should replace the async write of the same key not yet started", func(t *testing.T) {
+		t.Parallel()
+
+		chRelease := make(chan struct{})
+		wrappedStorer := testsCommon.NewStorerMock()
+		args := createMockArgsAsyncStorer()
+		args.Storer = &testsCommon.StorerStub{
+			PutCalled: func(key, data []byte) error {
+				if string(key) == "blocking key" {
+					<-chRelease
+				}
+
+				return wrappedStorer.Put(key, data)
+			},
+			GetCalled: func(key []byte) ([]byte, error) {
+				return wrappedStorer.Get(key)
+			},
+		}
+		asyncStorerInstance, _ := NewAsyncStorer(args)
+		storer := NewSynchronousStorer(asyncStorerInstance)
+
+		_ = asyncStorerInstance.Put([]byte("blocking key"), []byte("value"))
+		_ = asyncStorerInstance.Put([]byte("key"), []byte("async value"))
+		err := storer.Put([]byte("key"), []byte("sync value"))
+		assert.Nil(t, err)
+
+		close(chRelease)
+		assert.Nil(t, asyncStorerInstance.Close())
+		data, err := wrappedStorer.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("sync value"), data)
+	})
+}
@@ -13,3 +13,15 @@ var ErrMissingStatusHandler = errors.New("missing status handler")
 
 // ErrNilStorer signals that a nil storer was provided
 var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilStatusHandler signals that a nil status handler was provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrInvalidBufferSize signals that an invalid buffer size was provided
+var ErrInvalidBufferSize = errors.New("invalid buffer size")
+
+// ErrInvalidStatusKeysVersion signals that an invalid status keys version was persisted
+var ErrInvalidStatusKeysVersion = errors.New("invalid status keys version")
+
+// ErrNilMetricsHolder signals that a nil metrics holder was provided
+var ErrNilMetricsHolder = errors.New("nil metrics holder")
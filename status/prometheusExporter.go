@@ -0,0 +1,108 @@
+package status
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const (
+	prometheusMetricPrefix = "relayer"
+	prometheusInfoSuffix   = "info"
+	prometheusHandlerLabel = "handler"
+	prometheusValueLabel   = "value"
+)
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+type prometheusExporter struct {
+	metricsHolder core.MetricsHolder
+}
+
+// NewPrometheusExporter creates the component exporting the metrics of all the status handlers in the Prometheus text
+// exposition format. The int metrics are exported as gauges and the string metrics as info gauges holding the value
+// in a label, all of them labeled with the name of their status handler
+func NewPrometheusExporter(metricsHolder core.MetricsHolder) (*prometheusExporter, error) {
+	if check.IfNil(metricsHolder) {
+		return nil, ErrNilMetricsHolder
+	}
+
+	return &prometheusExporter{
+		metricsHolder: metricsHolder,
+	}, nil
+}
+
+// Export returns the current metrics of all the status handlers in the Prometheus text exposition format, e.g.
+// relayer_num_batches{handler="EthToMultiversX"} 37
+func (exporter *prometheusExporter) Export() []byte {
+	families := make(map[string][]string)
+	for _, name := range exporter.metricsHolder.GetAvailableStatusHandlers() {
+		metrics, err := exporter.metricsHolder.GetAllMetrics(name)
+		if err != nil {
+			continue
+		}
+
+		handlerLabel := fmt.Sprintf(`%s="%s"`, prometheusHandlerLabel, escapeLabelValue(name))
+		for metric, value := range metrics {
+			switch typedValue := value.(type) {
+			case int:
+				family := toPrometheusName(metric)
+				sample := fmt.Sprintf("%s{%s} %d", family, handlerLabel, typedValue)
+				families[family] = append(families[family], sample)
+			case string:
+				family := toPrometheusName(metric + " " + prometheusInfoSuffix)
+				sample := fmt.Sprintf(`%s{%s,%s="%s"} 1`, family, handlerLabel, prometheusValueLabel, escapeLabelValue(typedValue))
+				families[family] = append(families[family], sample)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(families))
+	for family := range families {
+		names = append(names, family)
+	}
+	sort.Strings(names)
+
+	buff := bytes.Buffer{}
+	for _, family := range names {
+		samples := families[family]
+		sort.Strings(samples)
+
+		_, _ = fmt.Fprintf(&buff, "# TYPE %s gauge\n", family)
+		for _, sample := range samples {
+			buff.WriteString(sample)
+			buff.WriteByte('\n')
+		}
+	}
+
+	return buff.Bytes()
+}
+
+// toPrometheusName converts a status metric to a valid Prometheus metric name, using the snake case naming
+func toPrometheusName(metric string) string {
+	name := fmt.Sprintf("%s_%s", prometheusMetricPrefix, metric)
+
+	return strings.Map(func(r rune) rune {
+		isValid := (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9')
+		if isValid {
+			return r
+		}
+		if r >= 'A' && r <= 'Z' {
+			return r - 'A' + 'a'
+		}
+		return '_'
+	}, name)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueReplacer.Replace(value)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (exporter *prometheusExporter) IsInterfaceNil() bool {
+	return exporter == nil
+}
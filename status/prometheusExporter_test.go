@@ -0,0 +1,76 @@
+package status
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPrometheusExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewPrometheusExporter(nil)
+		assert.True(t, check.IfNil(exporter))
+		assert.Equal(t, ErrNilMetricsHolder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := NewPrometheusExporter(NewMetricsHolder())
+		assert.False(t, check.IfNil(exporter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestPrometheusExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no status handlers should return empty", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, _ := NewPrometheusExporter(NewMetricsHolder())
+		assert.Empty(t, exporter.Export())
+	})
+	t.Run("should export all the metrics", func(t *testing.T) {
+		t.Parallel()
+
+		ethToMvx := testsCommon.NewStatusHandlerMock("EthToMultiversX")
+		ethToMvx.SetIntMetric(core.MetricNumBatches, 37)
+		ethToMvx.SetStringMetric(core.MetricCurrentStateMachineStep, "get pending")
+		mvxToEth := testsCommon.NewStatusHandlerMock("MultiversXToEth")
+		mvxToEth.SetIntMetric(core.MetricNumBatches, 12)
+		ethClient := testsCommon.NewStatusHandlerMock(core.EthClientStatusHandlerName)
+		ethClient.SetStringMetric(core.MetricLastEthereumClientError, "block \"5\" fetched\nagain")
+
+		metricsHolder := NewMetricsHolder()
+		require.Nil(t, metricsHolder.AddStatusHandler(ethToMvx))
+		require.Nil(t, metricsHolder.AddStatusHandler(mvxToEth))
+		require.Nil(t, metricsHolder.AddStatusHandler(ethClient))
+
+		exporter, _ := NewPrometheusExporter(metricsHolder)
+		expected := `# TYPE relayer_current_state_machine_step_info gauge
+relayer_current_state_machine_step_info{handler="EthToMultiversX",value="get pending"} 1
+# TYPE relayer_ethereum_client_last_encountered_error_info gauge
+relayer_ethereum_client_last_encountered_error_info{handler="eth-client",value="block \"5\" fetched\nagain"} 1
+# TYPE relayer_num_batches gauge
+relayer_num_batches{handler="EthToMultiversX"} 37
+relayer_num_batches{handler="MultiversXToEth"} 12
+`
+		assert.Equal(t, expected, string(exporter.Export()))
+	})
+}
+
+func TestToPrometheusName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "relayer_num_batches", toPrometheusName(core.MetricNumBatches))
+	assert.Equal(t, "relayer_p2p_topic__preagreement_connected_peers", toPrometheusName("P2P topic _preagreement connected peers"))
+	assert.Equal(t, "relayer_step_d__lay", toPrometheusName("step dé-lay"))
+}
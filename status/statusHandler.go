@@ -120,7 +120,7 @@ func (sh *statusHandler) tryLoadPersistedData() {
 		return
 	}
 
-	data, err := sh.storer.Get([]byte(sh.name))
+	data, err := sh.storer.Get(statusKey(sh.name))
 	if err != nil {
 		log.Debug("statusHandler.tryLoadPersistedData reading from storer", "name", sh.name, "error", err)
 		return
@@ -161,7 +161,7 @@ func (sh *statusHandler) persistChanges(metric string) {
 		return
 	}
 
-	err = sh.storer.Put([]byte(sh.name), buff)
+	err = sh.storer.Put(statusKey(sh.name), buff)
 	if err != nil {
 		log.Debug("statusHandler.persistChanges writing to storer", "name", sh.name, "error", err)
 		return
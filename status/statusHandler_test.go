@@ -40,7 +40,7 @@ func TestNewStatusHandler(t *testing.T) {
 		name := "test"
 		storer := testsCommon.NewStorerMock()
 
-		_ = storer.Put([]byte(name), []byte("garbage"))
+		_ = storer.Put(statusKey(name), []byte("garbage"))
 
 		sh, err := NewStatusHandler(name, storer)
 		require.Nil(t, err)
@@ -67,7 +67,7 @@ func TestNewStatusHandler(t *testing.T) {
 		buffExistent, err := marshaller.Marshal(existent)
 		require.Nil(t, err)
 
-		_ = storer.Put([]byte(name), buffExistent)
+		_ = storer.Put(statusKey(name), buffExistent)
 
 		sh, err := NewStatusHandler(name, storer)
 		require.Nil(t, err)
@@ -154,18 +154,18 @@ func TestStatusHandler_SetMetricsWithStorer(t *testing.T) {
 	sh, _ := NewStatusHandler(name, storer)
 
 	sh.AddIntMetric("not persistent", 1)
-	buff, err := storer.Get([]byte(name))
+	buff, err := storer.Get(statusKey(name))
 	assert.NotNil(t, err)
 	assert.Nil(t, buff)
 
 	sh.SetStringMetric("not persistent", "22")
-	buff, err = storer.Get([]byte(name))
+	buff, err = storer.Get(statusKey(name))
 	assert.NotNil(t, err)
 	assert.Nil(t, buff)
 
 	sh.AddIntMetric(core.MetricNumBatches, 1)
 	sh.SetStringMetric(core.MetricNumEthClientRequests, "22")
-	buff, err = storer.Get([]byte(name))
+	buff, err = storer.Get(statusKey(name))
 	assert.Nil(t, err)
 
 	persistence := &statusHandlerPersistenceData{}
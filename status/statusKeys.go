@@ -0,0 +1,107 @@
+package status
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// StatusKeysVersion is the version of the keys under which the status handlers persist their metrics. It must be
+// increased, and a migration added in keysMigrations, each time the keys or the persisted data change
+const StatusKeysVersion = 1
+
+const (
+	statusKeyTemplate     = "status/v%d/%s"
+	checkpointKeyTemplate = "status/v%d/checkpoint/%s"
+	statusKeysVersionKey  = "status/keys-version"
+)
+
+// keysMigration upgrades the data persisted by a status handler from the previous keys version
+type keysMigration func(storer core.RemovableStorer, name string) error
+
+// keysMigrations holds, on position i, the migration from the version i to the version i+1
+var keysMigrations = []keysMigration{
+	migrateUnversionedKey,
+}
+
+func statusKey(name string) []byte {
+	return []byte(fmt.Sprintf(statusKeyTemplate, StatusKeysVersion, name))
+}
+
+// CheckpointKey returns the key under which the state machine with the provided name persists its checkpoint
+func CheckpointKey(stateMachineName string) []byte {
+	return []byte(fmt.Sprintf(checkpointKeyTemplate, StatusKeysVersion, stateMachineName))
+}
+
+// MigrateStatusKeys upgrades the keys of the provided status handlers, persisted by an older relayer version, to the
+// current version, so the metrics history survives the upgrade. Should be called before the status handlers are created
+func MigrateStatusKeys(storer core.RemovableStorer, names []string) error {
+	if check.IfNil(storer) {
+		return ErrNilStorer
+	}
+
+	version, err := getStatusKeysVersion(storer)
+	if err != nil {
+		return err
+	}
+	if version > StatusKeysVersion {
+		log.Warn("the status keys were persisted by a newer relayer version, the metrics history will not be loaded",
+			"persisted version", version, "current version", StatusKeysVersion)
+		return nil
+	}
+	if version == StatusKeysVersion {
+		return nil
+	}
+
+	for ; version < StatusKeysVersion; version++ {
+		for _, name := range names {
+			err = keysMigrations[version](storer, name)
+			if err != nil {
+				return fmt.Errorf("%w while migrating the %s status keys from version %d", err, name, version)
+			}
+		}
+	}
+
+	log.Info("migrated the status keys", "version", StatusKeysVersion, "num status handlers", len(names))
+
+	return storer.Put([]byte(statusKeysVersionKey), []byte(strconv.Itoa(StatusKeysVersion)))
+}
+
+func getStatusKeysVersion(storer core.RemovableStorer) (int, error) {
+	buff, err := storer.Get([]byte(statusKeysVersionKey))
+	if err != nil {
+		// the relayer versions prior to the keys versioning did not save the version key
+		return 0, nil
+	}
+
+	version, err := strconv.Atoi(string(buff))
+	if err != nil {
+		return 0, fmt.Errorf("%w for the persisted status keys version %q", ErrInvalidStatusKeysVersion, buff)
+	}
+
+	return version, nil
+}
+
+// migrateUnversionedKey moves the data saved under the status handler's name, by the relayer versions prior to the
+// keys versioning, under the version 1 key
+func migrateUnversionedKey(storer core.RemovableStorer, name string) error {
+	oldKey := []byte(name)
+	buff, err := storer.Get(oldKey)
+	if err != nil {
+		// nothing persisted by this status handler
+		return nil
+	}
+
+	newKey := []byte(fmt.Sprintf(statusKeyTemplate, 1, name))
+	_, err = storer.Get(newKey)
+	if err != nil {
+		err = storer.Put(newKey, buff)
+		if err != nil {
+			return err
+		}
+	}
+
+	return storer.Remove(oldKey)
+}
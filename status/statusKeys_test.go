@@ -0,0 +1,110 @@
+package status
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrateStatusKeys(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := MigrateStatusKeys(nil, []string{"test"})
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("invalid persisted version should error", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		_ = storer.Put([]byte(statusKeysVersionKey), []byte("not a number"))
+
+		err := MigrateStatusKeys(storer, []string{"test"})
+		assert.True(t, errors.Is(err, ErrInvalidStatusKeysVersion))
+	})
+	t.Run("should migrate the unversioned keys", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		_ = storer.Put([]byte("test1"), []byte("data1"))
+		_ = storer.Put([]byte("test2"), []byte("data2"))
+
+		err := MigrateStatusKeys(storer, []string{"test1", "test2", "test3"})
+		require.Nil(t, err)
+
+		buff, err := storer.Get(statusKey("test1"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("data1"), buff)
+		buff, err = storer.Get(statusKey("test2"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("data2"), buff)
+		_, err = storer.Get(statusKey("test3"))
+		assert.NotNil(t, err)
+
+		_, err = storer.Get([]byte("test1"))
+		assert.NotNil(t, err)
+		_, err = storer.Get([]byte("test2"))
+		assert.NotNil(t, err)
+
+		buff, err = storer.Get([]byte(statusKeysVersionKey))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("1"), buff)
+	})
+	t.Run("should not overwrite the versioned keys", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		_ = storer.Put([]byte("test"), []byte("old data"))
+		_ = storer.Put(statusKey("test"), []byte("new data"))
+
+		err := MigrateStatusKeys(storer, []string{"test"})
+		require.Nil(t, err)
+
+		buff, _ := storer.Get(statusKey("test"))
+		assert.Equal(t, []byte("new data"), buff)
+		_, err = storer.Get([]byte("test"))
+		assert.NotNil(t, err)
+	})
+	t.Run("current or newer version should not migrate", func(t *testing.T) {
+		t.Parallel()
+
+		for _, version := range []string{"1", "2"} {
+			storer := testsCommon.NewStorerMock()
+			_ = storer.Put([]byte(statusKeysVersionKey), []byte(version))
+			_ = storer.Put([]byte("test"), []byte("data"))
+
+			err := MigrateStatusKeys(storer, []string{"test"})
+			require.Nil(t, err)
+
+			buff, err := storer.Get([]byte("test"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("data"), buff)
+			_, err = storer.Get(statusKey("test"))
+			assert.NotNil(t, err)
+		}
+	})
+	t.Run("migrated metrics should be loaded by the status handler", func(t *testing.T) {
+		t.Parallel()
+
+		storer := testsCommon.NewStorerMock()
+		buff, _, _ := convertToBuff(&statusHandlerPersistenceData{
+			IntMetrics: map[string]int{
+				core.MetricNumBatches: 37,
+			},
+		})
+		_ = storer.Put([]byte(core.EthClientStatusHandlerName), buff)
+
+		err := MigrateStatusKeys(storer, core.StatusHandlersNames)
+		require.Nil(t, err)
+
+		sh, err := NewStatusHandler(core.EthClientStatusHandlerName, storer)
+		require.Nil(t, err)
+		assert.Equal(t, 37, sh.GetIntMetrics()[core.MetricNumBatches])
+	})
+}
@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// BalanceProofProviderStub -
+type BalanceProofProviderStub struct {
+	GetBalanceProofCalled func(ctx context.Context) (*core.BalanceProof, error)
+}
+
+// GetBalanceProof -
+func (stub *BalanceProofProviderStub) GetBalanceProof(ctx context.Context) (*core.BalanceProof, error) {
+	if stub.GetBalanceProofCalled != nil {
+		return stub.GetBalanceProofCalled(ctx)
+	}
+
+	return &core.BalanceProof{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *BalanceProofProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// BatchExplorerStub -
+type BatchExplorerStub struct {
+	GetPendingBatchesCalled   func() []*core.BatchLiveView
+	GetBatchLiveViewsCalled   func(batchID uint64) []*core.BatchLiveView
+	GetDepositLiveViewsCalled func(nonce uint64) []*core.BatchLiveView
+}
+
+// GetPendingBatches -
+func (stub *BatchExplorerStub) GetPendingBatches() []*core.BatchLiveView {
+	if stub.GetPendingBatchesCalled != nil {
+		return stub.GetPendingBatchesCalled()
+	}
+
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetBatchLiveViews -
+func (stub *BatchExplorerStub) GetBatchLiveViews(batchID uint64) []*core.BatchLiveView {
+	if stub.GetBatchLiveViewsCalled != nil {
+		return stub.GetBatchLiveViewsCalled(batchID)
+	}
+
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetDepositLiveViews -
+func (stub *BatchExplorerStub) GetDepositLiveViews(nonce uint64) []*core.BatchLiveView {
+	if stub.GetDepositLiveViewsCalled != nil {
+		return stub.GetDepositLiveViewsCalled(nonce)
+	}
+
+	return make([]*core.BatchLiveView, 0)
+}
+
+// IsInterfaceNil -
+func (stub *BatchExplorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,74 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// BatchResultsStorerStub -
+type BatchResultsStorerStub struct {
+	StoreBatchResultsCalled func(results *core.BatchResults) error
+	GetBatchResultsCalled   func(batchID uint64) (*core.BatchResults, error)
+
+	StoreTransferReceiptsCalled func(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error
+	GetTransferReceiptsCalled   func(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error)
+
+	StoreExecutionCostsCalled func(period string, costs []*core.ExecutionCost) error
+	GetExecutionCostsCalled   func(period string) ([]*core.ExecutionCost, error)
+}
+
+// StoreBatchResults -
+func (stub *BatchResultsStorerStub) StoreBatchResults(results *core.BatchResults) error {
+	if stub.StoreBatchResultsCalled != nil {
+		return stub.StoreBatchResultsCalled(results)
+	}
+
+	return nil
+}
+
+// GetBatchResults -
+func (stub *BatchResultsStorerStub) GetBatchResults(batchID uint64) (*core.BatchResults, error) {
+	if stub.GetBatchResultsCalled != nil {
+		return stub.GetBatchResultsCalled(batchID)
+	}
+
+	return &core.BatchResults{}, nil
+}
+
+// StoreTransferReceipts -
+func (stub *BatchResultsStorerStub) StoreTransferReceipts(direction string, batchID uint64, receipts []*core.SignedTransferReceipt) error {
+	if stub.StoreTransferReceiptsCalled != nil {
+		return stub.StoreTransferReceiptsCalled(direction, batchID, receipts)
+	}
+
+	return nil
+}
+
+// GetTransferReceipts -
+func (stub *BatchResultsStorerStub) GetTransferReceipts(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+	if stub.GetTransferReceiptsCalled != nil {
+		return stub.GetTransferReceiptsCalled(direction, batchID)
+	}
+
+	return make([]*core.SignedTransferReceipt, 0), nil
+}
+
+// StoreExecutionCosts -
+func (stub *BatchResultsStorerStub) StoreExecutionCosts(period string, costs []*core.ExecutionCost) error {
+	if stub.StoreExecutionCostsCalled != nil {
+		return stub.StoreExecutionCostsCalled(period, costs)
+	}
+
+	return nil
+}
+
+// GetExecutionCosts -
+func (stub *BatchResultsStorerStub) GetExecutionCosts(period string) ([]*core.ExecutionCost, error) {
+	if stub.GetExecutionCostsCalled != nil {
+		return stub.GetExecutionCostsCalled(period)
+	}
+
+	return make([]*core.ExecutionCost, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *BatchResultsStorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
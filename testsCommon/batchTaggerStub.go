@@ -0,0 +1,46 @@
+package testsCommon
+
+import (
+	"math/big"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// BatchTaggerStub -
+type BatchTaggerStub struct {
+	TagBatchCalled    func(batch *bridgeCore.TransferBatch) []string
+	TagDepositCalled  func(deposit *bridgeCore.DepositTransfer) []string
+	TagTransferCalled func(from string, to string, token string, amount *big.Int) []string
+}
+
+// TagBatch -
+func (stub *BatchTaggerStub) TagBatch(batch *bridgeCore.TransferBatch) []string {
+	if stub.TagBatchCalled != nil {
+		return stub.TagBatchCalled(batch)
+	}
+
+	return nil
+}
+
+// TagDeposit -
+func (stub *BatchTaggerStub) TagDeposit(deposit *bridgeCore.DepositTransfer) []string {
+	if stub.TagDepositCalled != nil {
+		return stub.TagDepositCalled(deposit)
+	}
+
+	return nil
+}
+
+// TagTransfer -
+func (stub *BatchTaggerStub) TagTransfer(from string, to string, token string, amount *big.Int) []string {
+	if stub.TagTransferCalled != nil {
+		return stub.TagTransferCalled(from, to, token, amount)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *BatchTaggerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// BatchValidatorStub -
+type BatchValidatorStub struct {
+	ValidateBatchCalled func(ctx context.Context, batch *core.TransferBatch) error
+}
+
+// ValidateBatch -
+func (stub *BatchValidatorStub) ValidateBatch(ctx context.Context, batch *core.TransferBatch) error {
+	if stub.ValidateBatchCalled != nil {
+		return stub.ValidateBatchCalled(ctx, batch)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *BatchValidatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
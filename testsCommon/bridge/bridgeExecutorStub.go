@@ -41,6 +41,7 @@ type BridgeExecutorStub struct {
 	ProcessQuorumReachedOnMultiversXCalled                     func(ctx context.Context) (bool, error)
 	WasActionPerformedOnMultiversXCalled                       func(ctx context.Context) (bool, error)
 	PerformActionOnMultiversXCalled                            func(ctx context.Context) error
+	StoreBatchResultsFromMultiversXCalled                      func(ctx context.Context)
 	ResolveNewDepositsStatusesCalled                           func(numDeposits uint64)
 	ProcessMaxQuorumRetriesOnMultiversXCalled                  func() bool
 	ResetRetriesCountOnMultiversXCalled                        func()
@@ -52,12 +53,14 @@ type BridgeExecutorStub struct {
 	WaitForTransferConfirmationCalled                          func(ctx context.Context)
 	WaitAndReturnFinalBatchStatusesCalled                      func(ctx context.Context) []byte
 	GetBatchStatusesFromEthereumCalled                         func(ctx context.Context) ([]byte, error)
+	ShouldRejectStoredBatchCalled                              func(ctx context.Context) bool
 	ProcessMaxQuorumRetriesOnEthereumCalled                    func() bool
 	ResetRetriesCountOnEthereumCalled                          func()
 	ClearStoredP2PSignaturesForEthereumCalled                  func()
 	CheckMultiversXClientAvailabilityCalled                    func(ctx context.Context) error
 	CheckEthereumClientAvailabilityCalled                      func(ctx context.Context) error
 	CheckAvailableTokensCalled                                 func(ctx context.Context, ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int, direction batchProcessor.Direction) error
+	ValidateBatchCalled                                        func(ctx context.Context) error
 }
 
 // NewBridgeExecutorStub creates a new BridgeExecutorStub instance
@@ -255,6 +258,14 @@ func (stub *BridgeExecutorStub) PerformActionOnMultiversX(ctx context.Context) e
 	return notImplemented
 }
 
+// StoreBatchResultsFromMultiversX -
+func (stub *BridgeExecutorStub) StoreBatchResultsFromMultiversX(ctx context.Context) {
+	stub.incrementFunctionCounter()
+	if stub.StoreBatchResultsFromMultiversXCalled != nil {
+		stub.StoreBatchResultsFromMultiversXCalled(ctx)
+	}
+}
+
 // ResolveNewDepositsStatuses -
 func (stub *BridgeExecutorStub) ResolveNewDepositsStatuses(numDeposits uint64) {
 	stub.incrementFunctionCounter()
@@ -351,6 +362,15 @@ func (stub *BridgeExecutorStub) GetBatchStatusesFromEthereum(ctx context.Context
 	return nil, notImplemented
 }
 
+// ShouldRejectStoredBatch -
+func (stub *BridgeExecutorStub) ShouldRejectStoredBatch(ctx context.Context) bool {
+	stub.incrementFunctionCounter()
+	if stub.ShouldRejectStoredBatchCalled != nil {
+		return stub.ShouldRejectStoredBatchCalled(ctx)
+	}
+	return false
+}
+
 // ProcessMaxQuorumRetriesOnEthereum -
 func (stub *BridgeExecutorStub) ProcessMaxQuorumRetriesOnEthereum() bool {
 	stub.incrementFunctionCounter()
@@ -425,3 +445,12 @@ func (stub *BridgeExecutorStub) CheckAvailableTokens(ctx context.Context, ethTok
 
 	return nil
 }
+
+// ValidateBatch -
+func (stub *BridgeExecutorStub) ValidateBatch(ctx context.Context) error {
+	if stub.ValidateBatchCalled != nil {
+		return stub.ValidateBatchCalled(ctx)
+	}
+
+	return nil
+}
@@ -2,14 +2,31 @@ package bridge
 
 import (
 	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-sdk-go/data"
 )
 
 // DataGetterStub -
 type DataGetterStub struct {
-	GetTokenIdForErc20AddressCalled func(ctx context.Context, erc20Address []byte) ([][]byte, error)
-	GetERC20AddressForTokenIdCalled func(ctx context.Context, tokenId []byte) ([][]byte, error)
-	GetAllStakedRelayersCalled      func(ctx context.Context) ([][]byte, error)
-	GetAllKnownTokensCalled         func(ctx context.Context) ([][]byte, error)
+	GetTokenIdForErc20AddressCalled  func(ctx context.Context, erc20Address []byte) ([][]byte, error)
+	GetERC20AddressForTokenIdCalled  func(ctx context.Context, tokenId []byte) ([][]byte, error)
+	GetAllStakedRelayersCalled       func(ctx context.Context) ([][]byte, error)
+	GetAllKnownTokensCalled          func(ctx context.Context) ([][]byte, error)
+	GetAmountStakedCalled            func(ctx context.Context, relayerAddress []byte) (*big.Int, error)
+	IsPausedCalled                   func(ctx context.Context) (bool, error)
+	GetLastExecutedEthBatchIDCalled  func(ctx context.Context) (uint64, error)
+	GetLastMvxBatchIDCalled          func(ctx context.Context) (uint64, error)
+	GetQuorumCalled                  func(ctx context.Context) (uint64, error)
+	GetBatchAsDataBytesCalled        func(ctx context.Context, batchID uint64) ([][]byte, error)
+	GetCurrentBatchAsDataBytesCalled func(ctx context.Context) ([][]byte, error)
+	WasExecutedCalled                func(ctx context.Context, actionID uint64) (bool, error)
+	WasSignedByCalled                func(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error)
+	ExecuteQueryReturningBytesCalled func(ctx context.Context, request *data.VmValueRequest) ([][]byte, error)
+	GetActionLastIndexCalled         func(ctx context.Context) (uint64, error)
+	GetRequiredFeeCalled             func(ctx context.Context, token []byte) (*big.Int, error)
+	GetTokenMinLimitCalled           func(ctx context.Context, token []byte) (*big.Int, error)
+	GetTokenMaxLimitCalled           func(ctx context.Context, token []byte) (*big.Int, error)
 }
 
 // GetTokenIdForErc20Address -
@@ -37,6 +54,15 @@ func (stub *DataGetterStub) GetAllStakedRelayers(ctx context.Context) ([][]byte,
 	return make([][]byte, 0), nil
 }
 
+// GetAmountStaked -
+func (stub *DataGetterStub) GetAmountStaked(ctx context.Context, relayerAddress []byte) (*big.Int, error) {
+	if stub.GetAmountStakedCalled != nil {
+		return stub.GetAmountStakedCalled(ctx, relayerAddress)
+	}
+
+	return big.NewInt(0), nil
+}
+
 // GetAllKnownTokens -
 func (stub *DataGetterStub) GetAllKnownTokens(ctx context.Context) ([][]byte, error) {
 	if stub.GetAllKnownTokensCalled != nil {
@@ -46,6 +72,123 @@ func (stub *DataGetterStub) GetAllKnownTokens(ctx context.Context) ([][]byte, er
 	return make([][]byte, 0), nil
 }
 
+// IsPaused -
+func (stub *DataGetterStub) IsPaused(ctx context.Context) (bool, error) {
+	if stub.IsPausedCalled != nil {
+		return stub.IsPausedCalled(ctx)
+	}
+
+	return false, nil
+}
+
+// GetLastExecutedEthBatchID -
+func (stub *DataGetterStub) GetLastExecutedEthBatchID(ctx context.Context) (uint64, error) {
+	if stub.GetLastExecutedEthBatchIDCalled != nil {
+		return stub.GetLastExecutedEthBatchIDCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetLastMvxBatchID -
+func (stub *DataGetterStub) GetLastMvxBatchID(ctx context.Context) (uint64, error) {
+	if stub.GetLastMvxBatchIDCalled != nil {
+		return stub.GetLastMvxBatchIDCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetQuorum -
+func (stub *DataGetterStub) GetQuorum(ctx context.Context) (uint64, error) {
+	if stub.GetQuorumCalled != nil {
+		return stub.GetQuorumCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetBatchAsDataBytes -
+func (stub *DataGetterStub) GetBatchAsDataBytes(ctx context.Context, batchID uint64) ([][]byte, error) {
+	if stub.GetBatchAsDataBytesCalled != nil {
+		return stub.GetBatchAsDataBytesCalled(ctx, batchID)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// GetCurrentBatchAsDataBytes -
+func (stub *DataGetterStub) GetCurrentBatchAsDataBytes(ctx context.Context) ([][]byte, error) {
+	if stub.GetCurrentBatchAsDataBytesCalled != nil {
+		return stub.GetCurrentBatchAsDataBytesCalled(ctx)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// WasExecuted -
+func (stub *DataGetterStub) WasExecuted(ctx context.Context, actionID uint64) (bool, error) {
+	if stub.WasExecutedCalled != nil {
+		return stub.WasExecutedCalled(ctx, actionID)
+	}
+
+	return false, nil
+}
+
+// WasSignedBy -
+func (stub *DataGetterStub) WasSignedBy(ctx context.Context, actionID uint64, relayerAddress []byte) (bool, error) {
+	if stub.WasSignedByCalled != nil {
+		return stub.WasSignedByCalled(ctx, actionID, relayerAddress)
+	}
+
+	return false, nil
+}
+
+// ExecuteQueryReturningBytes -
+func (stub *DataGetterStub) ExecuteQueryReturningBytes(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
+	if stub.ExecuteQueryReturningBytesCalled != nil {
+		return stub.ExecuteQueryReturningBytesCalled(ctx, request)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// GetActionLastIndex -
+func (stub *DataGetterStub) GetActionLastIndex(ctx context.Context) (uint64, error) {
+	if stub.GetActionLastIndexCalled != nil {
+		return stub.GetActionLastIndexCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetRequiredFee -
+func (stub *DataGetterStub) GetRequiredFee(ctx context.Context, token []byte) (*big.Int, error) {
+	if stub.GetRequiredFeeCalled != nil {
+		return stub.GetRequiredFeeCalled(ctx, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetTokenMinLimit -
+func (stub *DataGetterStub) GetTokenMinLimit(ctx context.Context, token []byte) (*big.Int, error) {
+	if stub.GetTokenMinLimitCalled != nil {
+		return stub.GetTokenMinLimitCalled(ctx, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetTokenMaxLimit -
+func (stub *DataGetterStub) GetTokenMaxLimit(ctx context.Context, token []byte) (*big.Int, error) {
+	if stub.GetTokenMaxLimitCalled != nil {
+		return stub.GetTokenMaxLimitCalled(ctx, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
 // IsInterfaceNil -
 func (stub *DataGetterStub) IsInterfaceNil() bool {
 	return stub == nil
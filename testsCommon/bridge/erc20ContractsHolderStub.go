@@ -11,6 +11,7 @@ import (
 type ERC20ContractsHolderStub struct {
 	BalanceOfCalled func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error)
 	DecimalsCalled  func(ctx context.Context, erc20Address common.Address) (uint8, error)
+	SymbolCalled    func(ctx context.Context, erc20Address common.Address) (string, error)
 }
 
 // BalanceOf -
@@ -31,6 +32,15 @@ func (stub *ERC20ContractsHolderStub) Decimals(ctx context.Context, erc20Address
 	return 0, nil
 }
 
+// Symbol -
+func (stub *ERC20ContractsHolderStub) Symbol(ctx context.Context, erc20Address common.Address) (string, error) {
+	if stub.SymbolCalled != nil {
+		return stub.SymbolCalled(ctx, erc20Address)
+	}
+
+	return "", nil
+}
+
 // IsInterfaceNil -
 func (stub *ERC20ContractsHolderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,65 @@
+package bridge
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EthereumBackendStub -
+type EthereumBackendStub struct {
+	ContractBackendStub
+	BlockNumberCalled func(ctx context.Context) (uint64, error)
+	NonceAtCalled     func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	ChainIDCalled     func(ctx context.Context) (*big.Int, error)
+	BalanceAtCalled   func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+
+	TransactionReceiptCalled func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// BlockNumber -
+func (stub *EthereumBackendStub) BlockNumber(ctx context.Context) (uint64, error) {
+	if stub.BlockNumberCalled != nil {
+		return stub.BlockNumberCalled(ctx)
+	}
+
+	return 0, notImplemented
+}
+
+// NonceAt -
+func (stub *EthereumBackendStub) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	if stub.NonceAtCalled != nil {
+		return stub.NonceAtCalled(ctx, account, blockNumber)
+	}
+
+	return 0, notImplemented
+}
+
+// ChainID -
+func (stub *EthereumBackendStub) ChainID(ctx context.Context) (*big.Int, error) {
+	if stub.ChainIDCalled != nil {
+		return stub.ChainIDCalled(ctx)
+	}
+
+	return nil, notImplemented
+}
+
+// BalanceAt -
+func (stub *EthereumBackendStub) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	if stub.BalanceAtCalled != nil {
+		return stub.BalanceAtCalled(ctx, account, blockNumber)
+	}
+
+	return nil, notImplemented
+}
+
+// TransactionReceipt -
+func (stub *EthereumBackendStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if stub.TransactionReceiptCalled != nil {
+		return stub.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return nil, notImplemented
+}
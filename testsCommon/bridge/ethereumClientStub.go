@@ -12,23 +12,27 @@ import (
 
 // EthereumClientStub -
 type EthereumClientStub struct {
-	GetBatchCalled                         func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error)
-	WasExecutedCalled                      func(ctx context.Context, batchID uint64) (bool, error)
-	GenerateMessageHashCalled              func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error)
-	BroadcastSignatureForMessageHashCalled func(msgHash common.Hash)
-	ExecuteTransferCalled                  func(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error)
-	CheckClientAvailabilityCalled          func(ctx context.Context) error
-	GetTransactionsStatusesCalled          func(ctx context.Context, batchId uint64) ([]byte, error)
-	GetQuorumSizeCalled                    func(ctx context.Context) (*big.Int, error)
-	IsQuorumReachedCalled                  func(ctx context.Context, msgHash common.Hash) (bool, error)
-	GetBatchSCMetadataCalled               func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error)
-	CheckRequiredBalanceCalled             func(ctx context.Context, erc20Address common.Address, value *big.Int) error
-	TotalBalancesCalled                    func(ctx context.Context, account common.Address) (*big.Int, error)
-	MintBalancesCalled                     func(ctx context.Context, account common.Address) (*big.Int, error)
-	BurnBalancesCalled                     func(ctx context.Context, account common.Address) (*big.Int, error)
-	MintBurnTokensCalled                   func(ctx context.Context, account common.Address) (bool, error)
-	NativeTokensCalled                     func(ctx context.Context, account common.Address) (bool, error)
-	WhitelistedTokensCalled                func(ctx context.Context, account common.Address) (bool, error)
+	GetBatchCalled                          func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error)
+	WasExecutedCalled                       func(ctx context.Context, batchID uint64) (bool, error)
+	IsTransactionPendingCalled              func(ctx context.Context, txHash string) (bool, error)
+	GenerateMessageHashCalled               func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error)
+	BroadcastSignatureForMessageHashCalled  func(msgHash common.Hash)
+	ExecuteTransferCalled                   func(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error)
+	CheckClientAvailabilityCalled           func(ctx context.Context) error
+	GetTransactionsStatusesCalled           func(ctx context.Context, batchId uint64) ([]byte, error)
+	GetTransactionsStatusesFromEventsCalled func(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error)
+	GetQuorumSizeCalled                     func(ctx context.Context) (*big.Int, error)
+	IsQuorumReachedCalled                   func(ctx context.Context, msgHash common.Hash) (bool, error)
+	GetBatchSCMetadataCalled                func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error)
+	CheckRequiredBalanceCalled              func(ctx context.Context, erc20Address common.Address, value *big.Int) error
+	TotalBalancesCalled                     func(ctx context.Context, account common.Address) (*big.Int, error)
+	MintBalancesCalled                      func(ctx context.Context, account common.Address) (*big.Int, error)
+	BurnBalancesCalled                      func(ctx context.Context, account common.Address) (*big.Int, error)
+	MintBurnTokensCalled                    func(ctx context.Context, account common.Address) (bool, error)
+	NativeTokensCalled                      func(ctx context.Context, account common.Address) (bool, error)
+	WhitelistedTokensCalled                 func(ctx context.Context, account common.Address) (bool, error)
+	TokenMinLimitsCalled                    func(ctx context.Context, account common.Address) (*big.Int, error)
+	TokenMaxLimitsCalled                    func(ctx context.Context, account common.Address) (*big.Int, error)
 }
 
 // GetBatch -
@@ -49,6 +53,15 @@ func (stub *EthereumClientStub) WasExecuted(ctx context.Context, batchID uint64)
 	return false, errNotImplemented
 }
 
+// IsTransactionPending -
+func (stub *EthereumClientStub) IsTransactionPending(ctx context.Context, txHash string) (bool, error) {
+	if stub.IsTransactionPendingCalled != nil {
+		return stub.IsTransactionPendingCalled(ctx, txHash)
+	}
+
+	return false, errNotImplemented
+}
+
 // GenerateMessageHash -
 func (stub *EthereumClientStub) GenerateMessageHash(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error) {
 	if stub.GenerateMessageHashCalled != nil {
@@ -92,6 +105,15 @@ func (stub *EthereumClientStub) GetTransactionsStatuses(ctx context.Context, bat
 	return nil, errNotImplemented
 }
 
+// GetTransactionsStatusesFromEvents -
+func (stub *EthereumClientStub) GetTransactionsStatusesFromEvents(ctx context.Context, batch *bridgeCore.TransferBatch) ([]byte, error) {
+	if stub.GetTransactionsStatusesFromEventsCalled != nil {
+		return stub.GetTransactionsStatusesFromEventsCalled(ctx, batch)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetQuorumSize -
 func (stub *EthereumClientStub) GetQuorumSize(ctx context.Context) (*big.Int, error) {
 	if stub.GetQuorumSizeCalled != nil {
@@ -182,6 +204,24 @@ func (stub *EthereumClientStub) WhitelistedTokens(ctx context.Context, account c
 	return false, errNotImplemented
 }
 
+// TokenMinLimits -
+func (stub *EthereumClientStub) TokenMinLimits(ctx context.Context, account common.Address) (*big.Int, error) {
+	if stub.TokenMinLimitsCalled != nil {
+		return stub.TokenMinLimitsCalled(ctx, account)
+	}
+
+	return nil, errNotImplemented
+}
+
+// TokenMaxLimits -
+func (stub *EthereumClientStub) TokenMaxLimits(ctx context.Context, account common.Address) (*big.Int, error) {
+	if stub.TokenMaxLimitsCalled != nil {
+		return stub.TokenMaxLimitsCalled(ctx, account)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsInterfaceNil -
 func (stub *EthereumClientStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -34,6 +34,8 @@ type EthereumClientWrapperStub struct {
 	MintBurnTokensCalled            func(ctx context.Context, account common.Address) (bool, error)
 	NativeTokensCalled              func(ctx context.Context, account common.Address) (bool, error)
 	WhitelistedTokensCalled         func(ctx context.Context, account common.Address) (bool, error)
+	TokenMinLimitsCalled            func(ctx context.Context, account common.Address) (*big.Int, error)
+	TokenMaxLimitsCalled            func(ctx context.Context, account common.Address) (*big.Int, error)
 
 	SetIntMetricCalled    func(metric string, value int)
 	AddIntMetricCalled    func(metric string, delta int)
@@ -42,6 +44,10 @@ type EthereumClientWrapperStub struct {
 	NameCalled            func() string
 	IsPausedCalled        func(ctx context.Context) (bool, error)
 	FilterLogsCalled      func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumberCalled  func(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAtCalled          func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+
+	TransactionReceiptCalled func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
 
 // SetIntMetric -
@@ -206,6 +212,33 @@ func (stub *EthereumClientWrapperStub) FilterLogs(ctx context.Context, q ethereu
 	return []types.Log{}, nil
 }
 
+// HeaderByNumber -
+func (stub *EthereumClientWrapperStub) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if stub.HeaderByNumberCalled != nil {
+		return stub.HeaderByNumberCalled(ctx, number)
+	}
+
+	return &types.Header{}, nil
+}
+
+// CodeAt -
+func (stub *EthereumClientWrapperStub) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if stub.CodeAtCalled != nil {
+		return stub.CodeAtCalled(ctx, account, blockNumber)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// TransactionReceipt -
+func (stub *EthereumClientWrapperStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if stub.TransactionReceiptCalled != nil {
+		return stub.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return &types.Receipt{}, nil
+}
+
 // IsPaused -
 func (stub *EthereumClientWrapperStub) IsPaused(ctx context.Context) (bool, error) {
 	if stub.IsPausedCalled != nil {
@@ -269,6 +302,24 @@ func (stub *EthereumClientWrapperStub) WhitelistedTokens(ctx context.Context, ac
 	return false, nil
 }
 
+// TokenMinLimits -
+func (stub *EthereumClientWrapperStub) TokenMinLimits(ctx context.Context, account common.Address) (*big.Int, error) {
+	if stub.TokenMinLimitsCalled != nil {
+		return stub.TokenMinLimitsCalled(ctx, account)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// TokenMaxLimits -
+func (stub *EthereumClientWrapperStub) TokenMaxLimits(ctx context.Context, account common.Address) (*big.Int, error) {
+	if stub.TokenMaxLimitsCalled != nil {
+		return stub.TokenMaxLimitsCalled(ctx, account)
+	}
+
+	return big.NewInt(0), nil
+}
+
 // IsInterfaceNil -
 func (stub *EthereumClientWrapperStub) IsInterfaceNil() bool {
 	return stub == nil
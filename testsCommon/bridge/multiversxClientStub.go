@@ -16,10 +16,12 @@ type MultiversXClientStub struct {
 	GetBatchCalled                                 func(ctx context.Context, batchID uint64) (*bridgeCore.TransferBatch, error)
 	GetCurrentBatchAsDataBytesCalled               func(ctx context.Context) ([][]byte, error)
 	WasProposedTransferCalled                      func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error)
+	WasProposedTransferWithActionIDCalled          func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error)
 	QuorumReachedCalled                            func(ctx context.Context, actionID uint64) (bool, error)
 	WasExecutedCalled                              func(ctx context.Context, actionID uint64) (bool, error)
 	GetActionIDForProposeTransferCalled            func(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error)
 	WasProposedSetStatusCalled                     func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error)
+	WasProposedSetStatusWithActionIDCalled         func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error)
 	GetTransactionsStatusesCalled                  func(ctx context.Context, batchID uint64) ([]byte, error)
 	GetActionIDForSetStatusOnPendingTransferCalled func(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error)
 	GetLastExecutedEthBatchIDCalled                func(ctx context.Context) (uint64, error)
@@ -31,6 +33,7 @@ type MultiversXClientStub struct {
 	SignCalled                                     func(ctx context.Context, actionID uint64) (string, error)
 	WasSignedCalled                                func(ctx context.Context, actionID uint64) (bool, error)
 	PerformActionCalled                            func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error)
+	GetBatchResultsCalled                          func(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error)
 	CheckClientAvailabilityCalled                  func(ctx context.Context) error
 	IsMintBurnTokenCalled                          func(ctx context.Context, token []byte) (bool, error)
 	IsNativeTokenCalled                            func(ctx context.Context, token []byte) (bool, error)
@@ -78,6 +81,15 @@ func (stub *MultiversXClientStub) WasProposedTransfer(ctx context.Context, batch
 	return false, nil
 }
 
+// WasProposedTransferWithActionID -
+func (stub *MultiversXClientStub) WasProposedTransferWithActionID(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
+	if stub.WasProposedTransferWithActionIDCalled != nil {
+		return stub.WasProposedTransferWithActionIDCalled(ctx, batch)
+	}
+
+	return false, 0, nil
+}
+
 // QuorumReached -
 func (stub *MultiversXClientStub) QuorumReached(ctx context.Context, actionID uint64) (bool, error) {
 	if stub.QuorumReachedCalled != nil {
@@ -114,6 +126,15 @@ func (stub *MultiversXClientStub) WasProposedSetStatus(ctx context.Context, batc
 	return false, nil
 }
 
+// WasProposedSetStatusWithActionID -
+func (stub *MultiversXClientStub) WasProposedSetStatusWithActionID(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, uint64, error) {
+	if stub.WasProposedSetStatusWithActionIDCalled != nil {
+		return stub.WasProposedSetStatusWithActionIDCalled(ctx, batch)
+	}
+
+	return false, 0, nil
+}
+
 // GetTransactionsStatuses -
 func (stub *MultiversXClientStub) GetTransactionsStatuses(ctx context.Context, batchID uint64) ([]byte, error) {
 	if stub.GetTransactionsStatusesCalled != nil {
@@ -204,6 +225,15 @@ func (stub *MultiversXClientStub) PerformAction(ctx context.Context, actionID ui
 	return "", nil
 }
 
+// GetBatchResults -
+func (stub *MultiversXClientStub) GetBatchResults(ctx context.Context, txHash string, batch *bridgeCore.TransferBatch) (*bridgeCore.BatchResults, error) {
+	if stub.GetBatchResultsCalled != nil {
+		return stub.GetBatchResultsCalled(ctx, txHash, batch)
+	}
+
+	return &bridgeCore.BatchResults{}, nil
+}
+
 // CheckClientAvailability -
 func (stub *MultiversXClientStub) CheckClientAvailability(ctx context.Context) error {
 	if stub.CheckClientAvailabilityCalled != nil {
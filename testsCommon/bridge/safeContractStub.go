@@ -15,6 +15,8 @@ type SafeContractStub struct {
 	MintBurnTokensCalled    func(opts *bind.CallOpts, arg0 common.Address) (bool, error)
 	NativeTokensCalled      func(opts *bind.CallOpts, arg0 common.Address) (bool, error)
 	WhitelistedTokensCalled func(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	TokenMinLimitsCalled    func(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	TokenMaxLimitsCalled    func(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
 }
 
 // TotalBalances -
@@ -70,3 +72,21 @@ func (stub *SafeContractStub) WhitelistedTokens(opts *bind.CallOpts, arg0 common
 
 	return false, nil
 }
+
+// TokenMinLimits -
+func (stub *SafeContractStub) TokenMinLimits(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	if stub.TokenMinLimitsCalled != nil {
+		return stub.TokenMinLimitsCalled(opts, arg0)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// TokenMaxLimits -
+func (stub *SafeContractStub) TokenMaxLimits(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	if stub.TokenMaxLimitsCalled != nil {
+		return stub.TokenMaxLimitsCalled(opts, arg0)
+	}
+
+	return big.NewInt(0), nil
+}
@@ -2,7 +2,8 @@ package bridge
 
 // TopologyProviderStub -
 type TopologyProviderStub struct {
-	MyTurnAsLeaderCalled func() bool
+	MyTurnAsLeaderCalled    func() bool
+	IsSelfOnProbationCalled func() bool
 }
 
 // MyTurnAsLeader -
@@ -14,6 +15,15 @@ func (stub *TopologyProviderStub) MyTurnAsLeader() bool {
 	return false
 }
 
+// IsSelfOnProbation -
+func (stub *TopologyProviderStub) IsSelfOnProbation() bool {
+	if stub.IsSelfOnProbationCalled != nil {
+		return stub.IsSelfOnProbationCalled()
+	}
+
+	return false
+}
+
 // IsInterfaceNil -
 func (stub *TopologyProviderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -7,9 +7,16 @@ type BroadcasterStub struct {
 	BroadcastSignatureCalled func(signature []byte, messageHash []byte)
 	BroadcastJoinTopicCalled func()
 	SortedPublicKeysCalled   func() [][]byte
+	IsOnProbationCalled      func(address []byte) bool
 	RegisterOnTopicsCalled   func() error
 	AddBroadcastClientCalled func(client core.BroadcastClient) error
 	CloseCalled              func() error
+
+	BroadcastPreAgreementMessageCalled func(payload []byte)
+	AddPreAgreementClientCalled        func(client core.PreAgreementClient) error
+
+	SendDirectMessageCalled      func(payload []byte, publicKey []byte) error
+	AddDirectMessageClientCalled func(client core.DirectMessageClient) error
 }
 
 // BroadcastSignature -
@@ -35,6 +42,15 @@ func (bs *BroadcasterStub) SortedPublicKeys() [][]byte {
 	return make([][]byte, 0)
 }
 
+// IsOnProbation -
+func (bs *BroadcasterStub) IsOnProbation(address []byte) bool {
+	if bs.IsOnProbationCalled != nil {
+		return bs.IsOnProbationCalled(address)
+	}
+
+	return false
+}
+
 // RegisterOnTopics -
 func (bs *BroadcasterStub) RegisterOnTopics() error {
 	if bs.RegisterOnTopicsCalled != nil {
@@ -53,6 +69,40 @@ func (bs *BroadcasterStub) AddBroadcastClient(client core.BroadcastClient) error
 	return nil
 }
 
+// BroadcastPreAgreementMessage -
+func (bs *BroadcasterStub) BroadcastPreAgreementMessage(payload []byte) {
+	if bs.BroadcastPreAgreementMessageCalled != nil {
+		bs.BroadcastPreAgreementMessageCalled(payload)
+	}
+}
+
+// AddPreAgreementClient -
+func (bs *BroadcasterStub) AddPreAgreementClient(client core.PreAgreementClient) error {
+	if bs.AddPreAgreementClientCalled != nil {
+		return bs.AddPreAgreementClientCalled(client)
+	}
+
+	return nil
+}
+
+// SendDirectMessage -
+func (bs *BroadcasterStub) SendDirectMessage(payload []byte, publicKey []byte) error {
+	if bs.SendDirectMessageCalled != nil {
+		return bs.SendDirectMessageCalled(payload, publicKey)
+	}
+
+	return nil
+}
+
+// AddDirectMessageClient -
+func (bs *BroadcasterStub) AddDirectMessageClient(client core.DirectMessageClient) error {
+	if bs.AddDirectMessageClientCalled != nil {
+		return bs.AddDirectMessageClientCalled(client)
+	}
+
+	return nil
+}
+
 // Close -
 func (bs *BroadcasterStub) Close() error {
 	if bs.CloseCalled() != nil {
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// ConfigDriftAuditorStub -
+type ConfigDriftAuditorStub struct {
+	GetConfigDriftCalled func() (*core.ConfigDriftReport, error)
+}
+
+// GetConfigDrift -
+func (stub *ConfigDriftAuditorStub) GetConfigDrift() (*core.ConfigDriftReport, error) {
+	if stub.GetConfigDriftCalled != nil {
+		return stub.GetConfigDriftCalled()
+	}
+
+	return &core.ConfigDriftReport{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *ConfigDriftAuditorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// ConfirmationPolicyStub -
+type ConfirmationPolicyStub struct {
+	RequiredConfirmationsCalled func(batch *core.TransferBatch) uint64
+}
+
+// RequiredConfirmations -
+func (stub *ConfirmationPolicyStub) RequiredConfirmations(batch *core.TransferBatch) uint64 {
+	if stub.RequiredConfirmationsCalled != nil {
+		return stub.RequiredConfirmationsCalled(batch)
+	}
+
+	return 0
+}
+
+// IsInterfaceNil -
+func (stub *ConfirmationPolicyStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "context"
+
+// ContextAuditorStub -
+type ContextAuditorStub struct {
+	CheckCallCalled func(ctx context.Context, call string)
+}
+
+// CheckCall -
+func (stub *ContextAuditorStub) CheckCall(ctx context.Context, call string) {
+	if stub.CheckCallCalled != nil {
+		stub.CheckCallCalled(ctx, call)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ContextAuditorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
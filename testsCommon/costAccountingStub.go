@@ -0,0 +1,18 @@
+package testsCommon
+
+// CostAccountingStub -
+type CostAccountingStub struct {
+	RecordExecutionCalled func(direction string, batchID uint64, hash string)
+}
+
+// RecordExecution -
+func (stub *CostAccountingStub) RecordExecution(direction string, batchID uint64, hash string) {
+	if stub.RecordExecutionCalled != nil {
+		stub.RecordExecutionCalled(direction, batchID, hash)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *CostAccountingStub) IsInterfaceNil() bool {
+	return stub == nil
+}
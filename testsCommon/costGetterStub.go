@@ -0,0 +1,25 @@
+package testsCommon
+
+import (
+	"context"
+	"math/big"
+)
+
+// CostGetterStub -
+type CostGetterStub struct {
+	GetCostCalled func(ctx context.Context, hash string) (uint64, *big.Int, bool, error)
+}
+
+// GetCost -
+func (stub *CostGetterStub) GetCost(ctx context.Context, hash string) (uint64, *big.Int, bool, error) {
+	if stub.GetCostCalled != nil {
+		return stub.GetCostCalled(ctx, hash)
+	}
+
+	return 0, big.NewInt(0), true, nil
+}
+
+// IsInterfaceNil -
+func (stub *CostGetterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,58 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// DeadLettersStub -
+type DeadLettersStub struct {
+	RecordFailureCalled     func(direction string, batch *core.TransferBatch, reason string)
+	RecordSuccessCalled     func(direction string, batch *core.TransferBatch)
+	IsRefundedCalled        func(direction string, depositNonce uint64) bool
+	GetDeadLettersCalled    func() []*core.DeadLetter
+	ResolveDeadLetterCalled func(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error)
+}
+
+// RecordFailure -
+func (stub *DeadLettersStub) RecordFailure(direction string, batch *core.TransferBatch, reason string) {
+	if stub.RecordFailureCalled != nil {
+		stub.RecordFailureCalled(direction, batch, reason)
+	}
+}
+
+// RecordSuccess -
+func (stub *DeadLettersStub) RecordSuccess(direction string, batch *core.TransferBatch) {
+	if stub.RecordSuccessCalled != nil {
+		stub.RecordSuccessCalled(direction, batch)
+	}
+}
+
+// IsRefunded -
+func (stub *DeadLettersStub) IsRefunded(direction string, depositNonce uint64) bool {
+	if stub.IsRefundedCalled != nil {
+		return stub.IsRefundedCalled(direction, depositNonce)
+	}
+
+	return false
+}
+
+// GetDeadLetters -
+func (stub *DeadLettersStub) GetDeadLetters() []*core.DeadLetter {
+	if stub.GetDeadLettersCalled != nil {
+		return stub.GetDeadLettersCalled()
+	}
+
+	return make([]*core.DeadLetter, 0)
+}
+
+// ResolveDeadLetter -
+func (stub *DeadLettersStub) ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+	if stub.ResolveDeadLetterCalled != nil {
+		return stub.ResolveDeadLetterCalled(direction, depositNonce, resolution)
+	}
+
+	return &core.DeadLetter{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *DeadLettersStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// DirectMessageClientStub -
+type DirectMessageClientStub struct {
+	ProcessDirectMessageCalled func(msg *core.SignedMessage)
+}
+
+// ProcessDirectMessage -
+func (stub *DirectMessageClientStub) ProcessDirectMessage(msg *core.SignedMessage) {
+	if stub.ProcessDirectMessageCalled != nil {
+		stub.ProcessDirectMessageCalled(msg)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *DirectMessageClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
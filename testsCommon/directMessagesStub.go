@@ -0,0 +1,32 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// DirectMessagesStub -
+type DirectMessagesStub struct {
+	GetDirectMessagesCalled func() []*core.DirectMessage
+	SendDirectMessageCalled func(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error)
+}
+
+// GetDirectMessages -
+func (stub *DirectMessagesStub) GetDirectMessages() []*core.DirectMessage {
+	if stub.GetDirectMessagesCalled != nil {
+		return stub.GetDirectMessagesCalled()
+	}
+
+	return make([]*core.DirectMessage, 0)
+}
+
+// SendDirectMessage -
+func (stub *DirectMessagesStub) SendDirectMessage(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+	if stub.SendDirectMessageCalled != nil {
+		return stub.SendDirectMessageCalled(to, kind, text, batchID)
+	}
+
+	return &core.DirectMessage{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *DirectMessagesStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,18 @@
+package testsCommon
+
+// ErrorReporterStub -
+type ErrorReporterStub struct {
+	ReportErrorCalled func(direction string, message string, tags map[string]string)
+}
+
+// ReportError -
+func (stub *ErrorReporterStub) ReportError(direction string, message string, tags map[string]string) {
+	if stub.ReportErrorCalled != nil {
+		stub.ReportErrorCalled(direction, message, tags)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ErrorReporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
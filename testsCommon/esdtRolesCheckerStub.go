@@ -0,0 +1,22 @@
+package testsCommon
+
+import "context"
+
+// ESDTRolesCheckerStub -
+type ESDTRolesCheckerStub struct {
+	CheckRolesCalled func(ctx context.Context, token []byte) error
+}
+
+// CheckRoles -
+func (stub *ESDTRolesCheckerStub) CheckRoles(ctx context.Context, token []byte) error {
+	if stub.CheckRolesCalled != nil {
+		return stub.CheckRolesCalled(ctx, token)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ESDTRolesCheckerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
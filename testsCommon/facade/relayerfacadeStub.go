@@ -1,15 +1,47 @@
 package facade
 
 import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/config/schema"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 )
 
 // RelayerFacadeStub -
 type RelayerFacadeStub struct {
-	GetMetricsCalled       func(name string) (core.GeneralMetrics, error)
-	GetMetricsListCalled   func() core.GeneralMetrics
-	RestApiInterfaceCalled func() string
-	PprofEnabledCalled     func() bool
+	GetMetricsCalled              func(name string) (core.GeneralMetrics, error)
+	GetMetricsListCalled          func() core.GeneralMetrics
+	GetNodeStatusMetricsCalled    func() core.GeneralMetrics
+	GetPrometheusMetricsCalled    func() []byte
+	GetLoggersCalled              func() []core.LoggerInfo
+	SetLoggerLevelCalled          func(identifier string, level string) error
+	GetBatchResultsCalled         func(batchID uint64) (*core.BatchResults, error)
+	GetTransferReceiptsCalled     func(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error)
+	GetRuntimeInfoCalled          func() *core.RuntimeInfo
+	GetTopologyInfoCalled         func(numSlots int) map[string]*core.TopologyInfo
+	GetExportedTransactionsCalled func() []*core.ExportedTransaction
+	GetSyncReportCalled           func() *core.SyncReport
+	SubmitRelayedClaimCalled      func(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error)
+	GetBalanceProofCalled         func(ctx context.Context) (*core.BalanceProof, error)
+	EstimateFeeCalled             func(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error)
+	GetIncidentsCalled            func() []*core.Incident
+	AcknowledgeIncidentCalled     func(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error)
+	GetDeadLettersCalled          func() []*core.DeadLetter
+	ResolveDeadLetterCalled       func(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error)
+	GetDirectMessagesCalled       func() []*core.DirectMessage
+	SendDirectMessageCalled       func(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error)
+	PauseHalfBridgeCalled         func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	ResumeHalfBridgeCalled        func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	GetHalfBridgePausesCalled     func() []*core.HalfBridgePause
+	GetPendingBatchesCalled       func() []*core.BatchLiveView
+	GetBatchLiveViewsCalled       func(batchID uint64) []*core.BatchLiveView
+	GetDepositLiveViewsCalled     func(nonce uint64) []*core.BatchLiveView
+	GetConfigSchemaCalled         func() *schema.Schema
+	GetConfigDriftCalled          func() (*core.ConfigDriftReport, error)
+	RestApiInterfaceCalled        func() string
+	PprofEnabledCalled            func() bool
 }
 
 // GetMetrics -
@@ -30,6 +62,249 @@ func (stub *RelayerFacadeStub) GetMetricsList() core.GeneralMetrics {
 	return make(core.GeneralMetrics)
 }
 
+// GetNodeStatusMetrics -
+func (stub *RelayerFacadeStub) GetNodeStatusMetrics() core.GeneralMetrics {
+	if stub.GetNodeStatusMetricsCalled != nil {
+		return stub.GetNodeStatusMetricsCalled()
+	}
+
+	return make(core.GeneralMetrics)
+}
+
+// GetPrometheusMetrics -
+func (stub *RelayerFacadeStub) GetPrometheusMetrics() []byte {
+	if stub.GetPrometheusMetricsCalled != nil {
+		return stub.GetPrometheusMetricsCalled()
+	}
+
+	return nil
+}
+
+// GetLoggers -
+func (stub *RelayerFacadeStub) GetLoggers() []core.LoggerInfo {
+	if stub.GetLoggersCalled != nil {
+		return stub.GetLoggersCalled()
+	}
+
+	return make([]core.LoggerInfo, 0)
+}
+
+// SetLoggerLevel -
+func (stub *RelayerFacadeStub) SetLoggerLevel(identifier string, level string) error {
+	if stub.SetLoggerLevelCalled != nil {
+		return stub.SetLoggerLevelCalled(identifier, level)
+	}
+
+	return nil
+}
+
+// GetBatchResults -
+func (stub *RelayerFacadeStub) GetBatchResults(batchID uint64) (*core.BatchResults, error) {
+	if stub.GetBatchResultsCalled != nil {
+		return stub.GetBatchResultsCalled(batchID)
+	}
+
+	return &core.BatchResults{}, nil
+}
+
+// GetTransferReceipts -
+func (stub *RelayerFacadeStub) GetTransferReceipts(direction string, batchID uint64) ([]*core.SignedTransferReceipt, error) {
+	if stub.GetTransferReceiptsCalled != nil {
+		return stub.GetTransferReceiptsCalled(direction, batchID)
+	}
+
+	return make([]*core.SignedTransferReceipt, 0), nil
+}
+
+// GetRuntimeInfo -
+func (stub *RelayerFacadeStub) GetRuntimeInfo() *core.RuntimeInfo {
+	if stub.GetRuntimeInfoCalled != nil {
+		return stub.GetRuntimeInfoCalled()
+	}
+
+	return &core.RuntimeInfo{}
+}
+
+// GetTopologyInfo -
+func (stub *RelayerFacadeStub) GetTopologyInfo(numSlots int) map[string]*core.TopologyInfo {
+	if stub.GetTopologyInfoCalled != nil {
+		return stub.GetTopologyInfoCalled(numSlots)
+	}
+
+	return make(map[string]*core.TopologyInfo)
+}
+
+// GetExportedTransactions -
+func (stub *RelayerFacadeStub) GetExportedTransactions() []*core.ExportedTransaction {
+	if stub.GetExportedTransactionsCalled != nil {
+		return stub.GetExportedTransactionsCalled()
+	}
+
+	return make([]*core.ExportedTransaction, 0)
+}
+
+// GetSyncReport -
+func (stub *RelayerFacadeStub) GetSyncReport() *core.SyncReport {
+	if stub.GetSyncReportCalled != nil {
+		return stub.GetSyncReportCalled()
+	}
+
+	return nil
+}
+
+// SubmitRelayedClaim -
+func (stub *RelayerFacadeStub) SubmitRelayedClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+	if stub.SubmitRelayedClaimCalled != nil {
+		return stub.SubmitRelayedClaimCalled(ctx, claimTx)
+	}
+
+	return "", nil
+}
+
+// GetBalanceProof -
+func (stub *RelayerFacadeStub) GetBalanceProof(ctx context.Context) (*core.BalanceProof, error) {
+	if stub.GetBalanceProofCalled != nil {
+		return stub.GetBalanceProofCalled(ctx)
+	}
+
+	return &core.BalanceProof{}, nil
+}
+
+// EstimateFee -
+func (stub *RelayerFacadeStub) EstimateFee(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+	if stub.EstimateFeeCalled != nil {
+		return stub.EstimateFeeCalled(ctx, token, amount)
+	}
+
+	return &core.FeeEstimate{}, nil
+}
+
+// GetIncidents -
+func (stub *RelayerFacadeStub) GetIncidents() []*core.Incident {
+	if stub.GetIncidentsCalled != nil {
+		return stub.GetIncidentsCalled()
+	}
+
+	return make([]*core.Incident, 0)
+}
+
+// AcknowledgeIncident -
+func (stub *RelayerFacadeStub) AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+	if stub.AcknowledgeIncidentCalled != nil {
+		return stub.AcknowledgeIncidentCalled(id, acknowledgment)
+	}
+
+	return &core.Incident{ID: id}, nil
+}
+
+// GetDeadLetters -
+func (stub *RelayerFacadeStub) GetDeadLetters() []*core.DeadLetter {
+	if stub.GetDeadLettersCalled != nil {
+		return stub.GetDeadLettersCalled()
+	}
+
+	return make([]*core.DeadLetter, 0)
+}
+
+// ResolveDeadLetter -
+func (stub *RelayerFacadeStub) ResolveDeadLetter(direction string, depositNonce uint64, resolution core.DeadLetterResolution) (*core.DeadLetter, error) {
+	if stub.ResolveDeadLetterCalled != nil {
+		return stub.ResolveDeadLetterCalled(direction, depositNonce, resolution)
+	}
+
+	return &core.DeadLetter{Direction: direction, DepositNonce: depositNonce}, nil
+}
+
+// GetDirectMessages -
+func (stub *RelayerFacadeStub) GetDirectMessages() []*core.DirectMessage {
+	if stub.GetDirectMessagesCalled != nil {
+		return stub.GetDirectMessagesCalled()
+	}
+
+	return make([]*core.DirectMessage, 0)
+}
+
+// SendDirectMessage -
+func (stub *RelayerFacadeStub) SendDirectMessage(to string, kind string, text string, batchID uint64) (*core.DirectMessage, error) {
+	if stub.SendDirectMessageCalled != nil {
+		return stub.SendDirectMessageCalled(to, kind, text, batchID)
+	}
+
+	return &core.DirectMessage{To: to, Kind: kind, Text: text, BatchID: batchID}, nil
+}
+
+// PauseHalfBridge -
+func (stub *RelayerFacadeStub) PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	if stub.PauseHalfBridgeCalled != nil {
+		return stub.PauseHalfBridgeCalled(halfBridge, remoteAddress)
+	}
+
+	return &core.HalfBridgePause{HalfBridge: halfBridge, Paused: true, RemoteAddress: remoteAddress}, nil
+}
+
+// ResumeHalfBridge -
+func (stub *RelayerFacadeStub) ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	if stub.ResumeHalfBridgeCalled != nil {
+		return stub.ResumeHalfBridgeCalled(halfBridge, remoteAddress)
+	}
+
+	return &core.HalfBridgePause{HalfBridge: halfBridge, RemoteAddress: remoteAddress}, nil
+}
+
+// GetHalfBridgePauses -
+func (stub *RelayerFacadeStub) GetHalfBridgePauses() []*core.HalfBridgePause {
+	if stub.GetHalfBridgePausesCalled != nil {
+		return stub.GetHalfBridgePausesCalled()
+	}
+
+	return make([]*core.HalfBridgePause, 0)
+}
+
+// GetPendingBatches -
+func (stub *RelayerFacadeStub) GetPendingBatches() []*core.BatchLiveView {
+	if stub.GetPendingBatchesCalled != nil {
+		return stub.GetPendingBatchesCalled()
+	}
+
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetBatchLiveViews -
+func (stub *RelayerFacadeStub) GetBatchLiveViews(batchID uint64) []*core.BatchLiveView {
+	if stub.GetBatchLiveViewsCalled != nil {
+		return stub.GetBatchLiveViewsCalled(batchID)
+	}
+
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetDepositLiveViews -
+func (stub *RelayerFacadeStub) GetDepositLiveViews(nonce uint64) []*core.BatchLiveView {
+	if stub.GetDepositLiveViewsCalled != nil {
+		return stub.GetDepositLiveViewsCalled(nonce)
+	}
+
+	return make([]*core.BatchLiveView, 0)
+}
+
+// GetConfigSchema -
+func (stub *RelayerFacadeStub) GetConfigSchema() *schema.Schema {
+	if stub.GetConfigSchemaCalled != nil {
+		return stub.GetConfigSchemaCalled()
+	}
+
+	return &schema.Schema{}
+}
+
+// GetConfigDrift -
+func (stub *RelayerFacadeStub) GetConfigDrift() (*core.ConfigDriftReport, error) {
+	if stub.GetConfigDriftCalled != nil {
+		return stub.GetConfigDriftCalled()
+	}
+
+	return &core.ConfigDriftReport{}, nil
+}
+
 // RestApiInterface -
 func (stub *RelayerFacadeStub) RestApiInterface() string {
 	if stub.RestApiInterfaceCalled != nil {
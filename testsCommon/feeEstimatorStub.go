@@ -0,0 +1,27 @@
+package testsCommon
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// FeeEstimatorStub -
+type FeeEstimatorStub struct {
+	EstimateFeeCalled func(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error)
+}
+
+// EstimateFee -
+func (stub *FeeEstimatorStub) EstimateFee(ctx context.Context, token string, amount *big.Int) (*core.FeeEstimate, error) {
+	if stub.EstimateFeeCalled != nil {
+		return stub.EstimateFeeCalled(ctx, token, amount)
+	}
+
+	return &core.FeeEstimate{}, nil
+}
+
+// IsInterfaceNil -
+func (stub *FeeEstimatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,18 @@
+package testsCommon
+
+// GasUsageTrackerStub -
+type GasUsageTrackerStub struct {
+	TrackCalled func(hash string, function string)
+}
+
+// Track -
+func (stub *GasUsageTrackerStub) Track(hash string, function string) {
+	if stub.TrackCalled != nil {
+		stub.TrackCalled(hash, function)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *GasUsageTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
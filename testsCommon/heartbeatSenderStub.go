@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// HeartbeatSenderStub -
+type HeartbeatSenderStub struct {
+	SendCalled func(ctx context.Context, message *core.HeartbeatMessage) (string, error)
+}
+
+// Send -
+func (stub *HeartbeatSenderStub) Send(ctx context.Context, message *core.HeartbeatMessage) (string, error) {
+	if stub.SendCalled != nil {
+		return stub.SendCalled(ctx, message)
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (stub *HeartbeatSenderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
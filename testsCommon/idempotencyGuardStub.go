@@ -0,0 +1,30 @@
+package testsCommon
+
+// IdempotencyGuardStub -
+type IdempotencyGuardStub struct {
+	IsCompletedCalled   func(key string) (bool, error)
+	MarkCompletedCalled func(key string, direction string, batchID uint64) error
+}
+
+// IsCompleted -
+func (stub *IdempotencyGuardStub) IsCompleted(key string) (bool, error) {
+	if stub.IsCompletedCalled != nil {
+		return stub.IsCompletedCalled(key)
+	}
+
+	return false, nil
+}
+
+// MarkCompleted -
+func (stub *IdempotencyGuardStub) MarkCompleted(key string, direction string, batchID uint64) error {
+	if stub.MarkCompletedCalled != nil {
+		return stub.MarkCompletedCalled(key, direction, batchID)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *IdempotencyGuardStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,52 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// IncidentsQueueStub -
+type IncidentsQueueStub struct {
+	RaiseCalled                      func(source string, description string) uint64
+	HasUnacknowledgedIncidentsCalled func() bool
+	GetIncidentsCalled               func() []*core.Incident
+	AcknowledgeIncidentCalled        func(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error)
+}
+
+// Raise -
+func (stub *IncidentsQueueStub) Raise(source string, description string) uint64 {
+	if stub.RaiseCalled != nil {
+		return stub.RaiseCalled(source, description)
+	}
+
+	return 0
+}
+
+// HasUnacknowledgedIncidents -
+func (stub *IncidentsQueueStub) HasUnacknowledgedIncidents() bool {
+	if stub.HasUnacknowledgedIncidentsCalled != nil {
+		return stub.HasUnacknowledgedIncidentsCalled()
+	}
+
+	return false
+}
+
+// GetIncidents -
+func (stub *IncidentsQueueStub) GetIncidents() []*core.Incident {
+	if stub.GetIncidentsCalled != nil {
+		return stub.GetIncidentsCalled()
+	}
+
+	return make([]*core.Incident, 0)
+}
+
+// AcknowledgeIncident -
+func (stub *IncidentsQueueStub) AcknowledgeIncident(id uint64, acknowledgment core.IncidentAcknowledgment) (*core.Incident, error) {
+	if stub.AcknowledgeIncidentCalled != nil {
+		return stub.AcknowledgeIncidentCalled(id, acknowledgment)
+	}
+
+	return &core.Incident{ID: id}, nil
+}
+
+// IsInterfaceNil -
+func (stub *IncidentsQueueStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -11,11 +11,14 @@ import (
 
 // BlockchainClientStub -
 type BlockchainClientStub struct {
-	BlockNumberCalled func(ctx context.Context) (uint64, error)
-	NonceAtCalled     func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
-	ChainIDCalled     func(ctx context.Context) (*big.Int, error)
-	BalanceAtCalled   func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
-	FilterLogsCalled  func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	BlockNumberCalled        func(ctx context.Context) (uint64, error)
+	NonceAtCalled            func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	ChainIDCalled            func(ctx context.Context) (*big.Int, error)
+	BalanceAtCalled          func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	FilterLogsCalled         func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	HeaderByNumberCalled     func(ctx context.Context, number *big.Int) (*types.Header, error)
+	CodeAtCalled             func(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+	TransactionReceiptCalled func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 }
 
 // BlockNumber -
@@ -63,6 +66,33 @@ func (bcs *BlockchainClientStub) FilterLogs(ctx context.Context, q ethereum.Filt
 	return nil, nil
 }
 
+// HeaderByNumber -
+func (bcs *BlockchainClientStub) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if bcs.HeaderByNumberCalled != nil {
+		return bcs.HeaderByNumberCalled(ctx, number)
+	}
+
+	return &types.Header{}, nil
+}
+
+// CodeAt -
+func (bcs *BlockchainClientStub) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	if bcs.CodeAtCalled != nil {
+		return bcs.CodeAtCalled(ctx, account, blockNumber)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// TransactionReceipt -
+func (bcs *BlockchainClientStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if bcs.TransactionReceiptCalled != nil {
+		return bcs.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return &types.Receipt{}, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bcs *BlockchainClientStub) IsInterfaceNil() bool {
 	return bcs == nil
@@ -12,6 +12,7 @@ import (
 type GenericErc20ContractStub struct {
 	BalanceOfCalled func(account common.Address) (*big.Int, error)
 	DecimalsCalled  func() (uint8, error)
+	SymbolCalled    func() (string, error)
 }
 
 // BalanceOf -
@@ -31,3 +32,12 @@ func (stub *GenericErc20ContractStub) Decimals(_ *bind.CallOpts) (uint8, error)
 
 	return 0, errors.New("GenericErc20ContractStub.Decimals not implemented")
 }
+
+// Symbol -
+func (stub *GenericErc20ContractStub) Symbol(_ *bind.CallOpts) (string, error) {
+	if stub.SymbolCalled != nil {
+		return stub.SymbolCalled()
+	}
+
+	return "", errors.New("GenericErc20ContractStub.Symbol not implemented")
+}
@@ -0,0 +1,77 @@
+package keys
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/pem"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	mvxPemTypePrefix = "PRIVATE KEY for "
+	filePermissions  = 0644
+)
+
+// MvxBech32Address returns the bech32 MultiversX address of the key pair
+func (keyPair KeyPair) MvxBech32Address() (string, error) {
+	return data.NewAddressFromBytes(keyPair.MvxPk).AddressAsBech32String()
+}
+
+// MvxPem returns the MultiversX key pair in the PEM format used by the wallets and the relayers
+func (keyPair KeyPair) MvxPem() ([]byte, error) {
+	bech32Address, err := keyPair.MvxBech32Address()
+	if err != nil {
+		return nil, err
+	}
+
+	blk := pem.Block{
+		Type:  mvxPemTypePrefix + bech32Address,
+		Bytes: []byte(hex.EncodeToString(keyPair.MvxSk)),
+	}
+
+	buff := bytes.NewBuffer(make([]byte, 0))
+	err = pem.Encode(buff, &blk)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// SaveMvxPem writes the MultiversX key pair in the provided PEM file
+func (keyPair KeyPair) SaveMvxPem(filename string) error {
+	buff, err := keyPair.MvxPem()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, filePermissions)
+}
+
+// EthHex returns the hex encoded Ethereum private key, the format of the relayers' Ethereum private key file
+func EthHex(sk *ecdsa.PrivateKey) string {
+	return hex.EncodeToString(crypto.FromECDSA(sk))
+}
+
+// SaveEthHex writes the hex encoded Ethereum private key in the provided file
+func SaveEthHex(filename string, sk *ecdsa.PrivateKey) error {
+	return os.WriteFile(filename, []byte(EthHex(sk)), filePermissions)
+}
+
+// SaveEthKeystore encrypts the Ethereum private key with the provided password and writes it as a keystore file in the
+// provided directory. The light scrypt parameters are used, so it should only be used for the test keys.
+// Returns the path of the created file
+func SaveEthKeystore(directory string, sk *ecdsa.PrivateKey, password string) (string, error) {
+	store := keystore.NewKeyStore(directory, keystore.LightScryptN, keystore.LightScryptP)
+	account, err := store.ImportECDSA(sk, password)
+	if err != nil {
+		return "", err
+	}
+
+	return account.URL.Path, nil
+}
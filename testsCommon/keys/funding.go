@@ -0,0 +1,76 @@
+package keys
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// MvxAddressState is the account state accepted by the set state endpoints of the MultiversX chain simulator
+type MvxAddressState struct {
+	Address string  `json:"address"`
+	Nonce   *uint64 `json:"nonce,omitempty"`
+	Balance string  `json:"balance,omitempty"`
+}
+
+// EthGenesisAlloc returns the genesis allocation of the Ethereum simulated backend funding each of the key pairs
+// holding an Ethereum key with the provided balance
+func EthGenesisAlloc(balance *big.Int, keyPairs ...KeyPair) types.GenesisAlloc {
+	alloc := make(types.GenesisAlloc, len(keyPairs))
+	for _, keyPair := range keyPairs {
+		if keyPair.EthSK == nil {
+			continue
+		}
+
+		alloc[keyPair.EthAddress] = types.Account{Balance: big.NewInt(0).Set(balance)}
+	}
+
+	return alloc
+}
+
+// EthAddresses returns the Ethereum addresses of the key pairs holding an Ethereum key
+func EthAddresses(keyPairs ...KeyPair) []common.Address {
+	addresses := make([]common.Address, 0, len(keyPairs))
+	for _, keyPair := range keyPairs {
+		if keyPair.EthSK == nil {
+			continue
+		}
+
+		addresses = append(addresses, keyPair.EthAddress)
+	}
+
+	return addresses
+}
+
+// MvxFundingStates returns the chain simulator account states funding each of the key pairs with the provided balance,
+// denominated in the smallest unit, and resetting their nonces
+func MvxFundingStates(balance string, keyPairs ...KeyPair) ([]*MvxAddressState, error) {
+	states := make([]*MvxAddressState, 0, len(keyPairs))
+	for _, keyPair := range keyPairs {
+		bech32Address, err := keyPair.MvxBech32Address()
+		if err != nil {
+			return nil, err
+		}
+
+		states = append(states, &MvxAddressState{
+			Address: bech32Address,
+			Nonce:   new(uint64),
+			Balance: balance,
+		})
+	}
+
+	return states, nil
+}
+
+// MvxFundingPayload returns the JSON payload to be posted on the set state endpoint of the chain simulator in order to
+// fund the key pairs
+func MvxFundingPayload(balance string, keyPairs ...KeyPair) ([]byte, error) {
+	states, err := MvxFundingStates(balance, keyPairs...)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(states)
+}
@@ -0,0 +1,108 @@
+package keys
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"errors"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The Ethereum owner deploys and configures the contracts while the Ethereum depositor only creates the deposits, so
+// the tests can check that the two roles are not mixed
+const (
+	// EthOwnerSK is the hex encoded private key of the Ethereum contracts owner
+	EthOwnerSK = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+	// EthDepositorSK is the hex encoded private key of the Ethereum depositor
+	EthDepositorSK = "9bb971db41e3815a669a71c3f1bcb24e0b81f21e04bf11faa7a34b9b40e7cfb1"
+	// EthTestSK is the hex encoded private key of the Ethereum test address, receiving and sending the test transfers
+	EthTestSK = "dafea2c94bfe5d25f1a508808c2bc2c2e6c6f18b6b010fc841d8eb80755ba27a"
+)
+
+const (
+	seedLength       = 32
+	maxShardAttempts = 100000
+)
+
+var errShardNotReached = errors.New("could not generate a key in the projected shard")
+
+// KeyPair holds the keys of an actor on both chains. The MultiversX private key is the 64 bytes ed25519 key
+// (the seed followed by the public key), the Ethereum keys are optional
+type KeyPair struct {
+	MvxSk      []byte
+	MvxPk      []byte
+	EthSK      *ecdsa.PrivateKey
+	EthAddress common.Address
+}
+
+// Generator generates deterministic keys: two generators created with the same seed return the same keys, in the same
+// order. It is not concurrent safe
+type Generator struct {
+	randomizer *rand.Rand
+}
+
+// NewGenerator creates a keys generator based on the provided seed
+func NewGenerator(seed int64) *Generator {
+	return &Generator{
+		randomizer: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// GenerateMvxKey returns the next MultiversX key pair whose public key ends with the projected shard byte
+func (generator *Generator) GenerateMvxKey(projectedShard byte) (KeyPair, error) {
+	for i := 0; i < maxShardAttempts; i++ {
+		sk := ed25519.NewKeyFromSeed(generator.nextSeed())
+		pk := sk.Public().(ed25519.PublicKey)
+		if pk[len(pk)-1] != projectedShard {
+			continue
+		}
+
+		return KeyPair{
+			MvxSk: sk,
+			MvxPk: pk,
+		}, nil
+	}
+
+	return KeyPair{}, errShardNotReached
+}
+
+// GenerateEthKey returns the next Ethereum private key
+func (generator *Generator) GenerateEthKey() (*ecdsa.PrivateKey, error) {
+	for {
+		sk, err := crypto.ToECDSA(generator.nextSeed())
+		if err == nil {
+			return sk, nil
+		}
+		// the seed is not a valid secp256k1 scalar, extremely unlikely, try the next one
+	}
+}
+
+// GenerateKeyPair returns the next MultiversX key pair in the projected shard along with the Ethereum key decoded from
+// the provided hex string. An empty string generates the next Ethereum key
+func (generator *Generator) GenerateKeyPair(ethSkHex string, projectedShard byte) (KeyPair, error) {
+	keyPair, err := generator.GenerateMvxKey(projectedShard)
+	if err != nil {
+		return KeyPair{}, err
+	}
+
+	if len(ethSkHex) == 0 {
+		keyPair.EthSK, err = generator.GenerateEthKey()
+	} else {
+		keyPair.EthSK, err = crypto.HexToECDSA(ethSkHex)
+	}
+	if err != nil {
+		return KeyPair{}, err
+	}
+	keyPair.EthAddress = crypto.PubkeyToAddress(keyPair.EthSK.PublicKey)
+
+	return keyPair, nil
+}
+
+func (generator *Generator) nextSeed() []byte {
+	seed := make([]byte, seedLength)
+	_, _ = generator.randomizer.Read(seed)
+
+	return seed
+}
@@ -0,0 +1,159 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_ShouldBeDeterministic(t *testing.T) {
+	t.Parallel()
+
+	generator1 := NewGenerator(37)
+	generator2 := NewGenerator(37)
+	for i := 0; i < 3; i++ {
+		keyPair1, err := generator1.GenerateKeyPair("", byte(i))
+		require.Nil(t, err)
+		keyPair2, err := generator2.GenerateKeyPair("", byte(i))
+		require.Nil(t, err)
+
+		assert.Equal(t, keyPair1, keyPair2)
+	}
+
+	keyPair1, _ := NewGenerator(37).GenerateMvxKey(0)
+	keyPair2, _ := NewGenerator(38).GenerateMvxKey(0)
+	assert.NotEqual(t, keyPair1.MvxSk, keyPair2.MvxSk)
+}
+
+func TestGenerator_GenerateMvxKey(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(1)
+	for shard := byte(0); shard < 3; shard++ {
+		keyPair, err := generator.GenerateMvxKey(shard)
+		require.Nil(t, err)
+
+		assert.Len(t, keyPair.MvxSk, ed25519.PrivateKeySize)
+		assert.Equal(t, shard, keyPair.MvxPk[len(keyPair.MvxPk)-1])
+		assert.Equal(t, []byte(ed25519.PrivateKey(keyPair.MvxSk).Public().(ed25519.PublicKey)), keyPair.MvxPk)
+		assert.Nil(t, keyPair.EthSK)
+	}
+}
+
+func TestGenerator_GenerateKeyPair(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid Ethereum key should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewGenerator(1).GenerateKeyPair("not hex", 0)
+		assert.NotNil(t, err)
+	})
+	t.Run("owner and depositor keys should be separated", func(t *testing.T) {
+		t.Parallel()
+
+		generator := NewGenerator(1)
+		owner, err := generator.GenerateKeyPair(EthOwnerSK, 0)
+		require.Nil(t, err)
+		depositor, err := generator.GenerateKeyPair(EthDepositorSK, 1)
+		require.Nil(t, err)
+
+		assert.Equal(t, EthOwnerSK, EthHex(owner.EthSK))
+		assert.Equal(t, EthDepositorSK, EthHex(depositor.EthSK))
+		assert.NotEqual(t, owner.EthAddress, depositor.EthAddress)
+		assert.NotEqual(t, owner.MvxPk, depositor.MvxPk)
+	})
+}
+
+func TestKeyPair_Export(t *testing.T) {
+	t.Parallel()
+
+	keyPair, err := NewGenerator(1).GenerateKeyPair("", 0)
+	require.Nil(t, err)
+	directory := t.TempDir()
+
+	t.Run("PEM", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(directory, "key.pem")
+		err := keyPair.SaveMvxPem(filename)
+		require.Nil(t, err)
+
+		buff, err := os.ReadFile(filename)
+		require.Nil(t, err)
+		blk, _ := pem.Decode(buff)
+		require.NotNil(t, blk)
+
+		bech32Address, err := keyPair.MvxBech32Address()
+		require.Nil(t, err)
+		assert.True(t, strings.HasPrefix(bech32Address, "erd1"))
+		assert.Equal(t, "PRIVATE KEY for "+bech32Address, blk.Type)
+		assert.Equal(t, hex.EncodeToString(keyPair.MvxSk), string(blk.Bytes))
+	})
+	t.Run("hex", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(directory, "ethereum.sk")
+		err := SaveEthHex(filename, keyPair.EthSK)
+		require.Nil(t, err)
+
+		buff, err := os.ReadFile(filename)
+		require.Nil(t, err)
+		sk, err := crypto.HexToECDSA(string(buff))
+		require.Nil(t, err)
+		assert.Equal(t, keyPair.EthAddress, crypto.PubkeyToAddress(sk.PublicKey))
+	})
+	t.Run("keystore", func(t *testing.T) {
+		t.Parallel()
+
+		filename, err := SaveEthKeystore(filepath.Join(directory, "keystore"), keyPair.EthSK, "password")
+		require.Nil(t, err)
+
+		buff, err := os.ReadFile(filename)
+		require.Nil(t, err)
+		key, err := keystore.DecryptKey(buff, "password")
+		require.Nil(t, err)
+		assert.Equal(t, keyPair.EthAddress, key.Address)
+	})
+}
+
+func TestFunding(t *testing.T) {
+	t.Parallel()
+
+	generator := NewGenerator(1)
+	withEthKey, err := generator.GenerateKeyPair("", 0)
+	require.Nil(t, err)
+	mvxOnly, err := generator.GenerateMvxKey(1)
+	require.Nil(t, err)
+
+	balance := big.NewInt(1000)
+	alloc := EthGenesisAlloc(balance, withEthKey, mvxOnly)
+	require.Len(t, alloc, 1)
+	assert.Equal(t, balance, alloc[withEthKey.EthAddress].Balance)
+	assert.Equal(t, []common.Address{withEthKey.EthAddress}, EthAddresses(withEthKey, mvxOnly))
+
+	payload, err := MvxFundingPayload("5000", withEthKey, mvxOnly)
+	require.Nil(t, err)
+	states := make([]*MvxAddressState, 0)
+	err = json.Unmarshal(payload, &states)
+	require.Nil(t, err)
+	require.Len(t, states, 2)
+	for i, keyPair := range []KeyPair{withEthKey, mvxOnly} {
+		bech32Address, _ := keyPair.MvxBech32Address()
+		assert.Equal(t, bech32Address, states[i].Address)
+		assert.Equal(t, "5000", states[i].Balance)
+		assert.Equal(t, uint64(0), *states[i].Nonce)
+	}
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// LiveViewProviderStub -
+type LiveViewProviderStub struct {
+	GetLiveViewCalled func() *core.BatchLiveView
+}
+
+// GetLiveView -
+func (stub *LiveViewProviderStub) GetLiveView() *core.BatchLiveView {
+	if stub.GetLiveViewCalled != nil {
+		return stub.GetLiveViewCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *LiveViewProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
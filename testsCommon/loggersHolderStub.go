@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// LoggersHolderStub -
+type LoggersHolderStub struct {
+	ChangedLoggersCalled func() []core.LoggerInfo
+}
+
+// ChangedLoggers -
+func (stub *LoggersHolderStub) ChangedLoggers() []core.LoggerInfo {
+	if stub.ChangedLoggersCalled != nil {
+		return stub.ChangedLoggersCalled()
+	}
+
+	return make([]core.LoggerInfo, 0)
+}
+
+// IsInterfaceNil -
+func (stub *LoggersHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
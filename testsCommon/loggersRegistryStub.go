@@ -0,0 +1,32 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// LoggersRegistryStub -
+type LoggersRegistryStub struct {
+	LoggersCalled        func() []core.LoggerInfo
+	SetLoggerLevelCalled func(identifier string, level string) error
+}
+
+// Loggers -
+func (stub *LoggersRegistryStub) Loggers() []core.LoggerInfo {
+	if stub.LoggersCalled != nil {
+		return stub.LoggersCalled()
+	}
+
+	return make([]core.LoggerInfo, 0)
+}
+
+// SetLoggerLevel -
+func (stub *LoggersRegistryStub) SetLoggerLevel(identifier string, level string) error {
+	if stub.SetLoggerLevelCalled != nil {
+		return stub.SetLoggerLevelCalled(identifier, level)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *LoggersRegistryStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+// MetricsExporterStub -
+type MetricsExporterStub struct {
+	ExportCalled func() []byte
+}
+
+// Export -
+func (stub *MetricsExporterStub) Export() []byte {
+	if stub.ExportCalled != nil {
+		return stub.ExportCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *MetricsExporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// OperatorPauseStub -
+type OperatorPauseStub struct {
+	PauseHalfBridgeCalled     func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	ResumeHalfBridgeCalled    func(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error)
+	GetHalfBridgePausesCalled func() []*core.HalfBridgePause
+}
+
+// PauseHalfBridge -
+func (stub *OperatorPauseStub) PauseHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	if stub.PauseHalfBridgeCalled != nil {
+		return stub.PauseHalfBridgeCalled(halfBridge, remoteAddress)
+	}
+
+	return &core.HalfBridgePause{HalfBridge: halfBridge, Paused: true, RemoteAddress: remoteAddress}, nil
+}
+
+// ResumeHalfBridge -
+func (stub *OperatorPauseStub) ResumeHalfBridge(halfBridge string, remoteAddress string) (*core.HalfBridgePause, error) {
+	if stub.ResumeHalfBridgeCalled != nil {
+		return stub.ResumeHalfBridgeCalled(halfBridge, remoteAddress)
+	}
+
+	return &core.HalfBridgePause{HalfBridge: halfBridge, RemoteAddress: remoteAddress}, nil
+}
+
+// GetHalfBridgePauses -
+func (stub *OperatorPauseStub) GetHalfBridgePauses() []*core.HalfBridgePause {
+	if stub.GetHalfBridgePausesCalled != nil {
+		return stub.GetHalfBridgePausesCalled()
+	}
+
+	return make([]*core.HalfBridgePause, 0)
+}
+
+// IsInterfaceNil -
+func (stub *OperatorPauseStub) IsInterfaceNil() bool {
+	return stub == nil
+}
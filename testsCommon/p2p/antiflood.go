@@ -34,6 +34,10 @@ func CreateAntifloodConfig() chainConfig.AntifloodConfig {
 					Topic:             "test_sign",
 					NumMessagesPerSec: 10,
 				},
+				{
+					Topic:             "test_catchup",
+					NumMessagesPerSec: 10,
+				},
 			},
 		},
 	}
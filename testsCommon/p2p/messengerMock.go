@@ -152,6 +152,16 @@ func (mock *MessengerMock) ConnectedAddresses() []string {
 	return make([]string, 0)
 }
 
+// PeerAddresses -
+func (mock *MessengerMock) PeerAddresses(_ core.PeerID) []string {
+	return make([]string, 0)
+}
+
+// ConnectToPeer -
+func (mock *MessengerMock) ConnectToPeer(_ string) error {
+	return nil
+}
+
 // Close -
 func (mock *MessengerMock) Close() error {
 	return nil
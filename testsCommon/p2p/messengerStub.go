@@ -17,6 +17,9 @@ type MessengerStub struct {
 	SendToConnectedPeerCalled      func(topic string, buff []byte, peerID core.PeerID) error
 	SetPeerDenialEvaluatorCalled   func(handler p2p.PeerDenialEvaluator) error
 	ConnectedAddressesCalled       func() []string
+	ConnectedPeersOnTopicCalled    func(topic string) []core.PeerID
+	PeerAddressesCalled            func(pid core.PeerID) []string
+	ConnectToPeerCalled            func(address string) error
 	CloseCalled                    func() error
 }
 
@@ -108,6 +111,33 @@ func (stub *MessengerStub) ConnectedAddresses() []string {
 	return make([]string, 0)
 }
 
+// ConnectedPeersOnTopic -
+func (stub *MessengerStub) ConnectedPeersOnTopic(topic string) []core.PeerID {
+	if stub.ConnectedPeersOnTopicCalled != nil {
+		return stub.ConnectedPeersOnTopicCalled(topic)
+	}
+
+	return make([]core.PeerID, 0)
+}
+
+// PeerAddresses -
+func (stub *MessengerStub) PeerAddresses(pid core.PeerID) []string {
+	if stub.PeerAddressesCalled != nil {
+		return stub.PeerAddressesCalled(pid)
+	}
+
+	return make([]string, 0)
+}
+
+// ConnectToPeer -
+func (stub *MessengerStub) ConnectToPeer(address string) error {
+	if stub.ConnectToPeerCalled != nil {
+		return stub.ConnectToPeerCalled(address)
+	}
+
+	return nil
+}
+
 // Close -
 func (stub *MessengerStub) Close() error {
 	if stub.CloseCalled != nil {
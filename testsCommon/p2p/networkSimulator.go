@@ -0,0 +1,308 @@
+package p2p
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+const maxDeliveriesPerCall = 1000000
+
+var (
+	errPeerNotConnected    = errors.New("peer not connected")
+	errNilMessageProcessor = errors.New("nil message processor")
+)
+
+type link struct {
+	from core.PeerID
+	to   core.PeerID
+}
+
+type simulatedDelivery struct {
+	deliverAt time.Duration
+	message   *P2PMessageMock
+	from      core.PeerID
+	to        core.PeerID
+}
+
+// NetworkSimulator is an in-memory network of messengers that can be used instead of the libp2p messengers so the
+// components depending on the broadcaster can be tested deterministically. The sent messages are queued and are
+// delivered, on the caller's go routine, only when the network time is advanced. The messages are delivered in the
+// order of their delivery time (the send time plus the latency of the link) and then in the order they were sent.
+// As with libp2p, the broadcast messages are also delivered to the sender
+type NetworkSimulator struct {
+	mut             sync.Mutex
+	startTime       time.Time
+	currentTime     time.Duration
+	defaultLatency  time.Duration
+	latencies       map[link]time.Duration
+	partitions      map[core.PeerID]int
+	messengers      map[core.PeerID]*SimulatedMessenger
+	sortedPeers     []core.PeerID
+	queue           []*simulatedDelivery
+	numSentMessages uint64
+}
+
+// NewNetworkSimulator creates a new network simulator with no latency and no partitions
+func NewNetworkSimulator() *NetworkSimulator {
+	return &NetworkSimulator{
+		startTime:  time.Now(),
+		latencies:  make(map[link]time.Duration),
+		partitions: make(map[core.PeerID]int),
+		messengers: make(map[core.PeerID]*SimulatedMessenger),
+	}
+}
+
+// CreateMessengers creates the provided number of messengers, all connected to each other
+func (network *NetworkSimulator) CreateMessengers(numMessengers int) []*SimulatedMessenger {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	messengers := make([]*SimulatedMessenger, 0, numMessengers)
+	for i := 0; i < numMessengers; i++ {
+		id := core.PeerID(fmt.Sprintf("peer%d", len(network.sortedPeers)))
+		messenger := &SimulatedMessenger{
+			network:    network,
+			peerID:     id,
+			topics:     make(map[string]struct{}),
+			processors: make(map[string]map[string]p2p.MessageProcessor),
+		}
+
+		network.messengers[id] = messenger
+		network.sortedPeers = append(network.sortedPeers, id)
+		messengers = append(messengers, messenger)
+	}
+
+	return messengers
+}
+
+// SetDefaultLatency sets the latency of the links that do not have a specific latency
+func (network *NetworkSimulator) SetDefaultLatency(latency time.Duration) {
+	network.mut.Lock()
+	network.defaultLatency = latency
+	network.mut.Unlock()
+}
+
+// SetLatency sets the latency of the messages sent from a peer to another peer
+func (network *NetworkSimulator) SetLatency(from core.PeerID, to core.PeerID, latency time.Duration) {
+	network.mut.Lock()
+	network.latencies[link{from: from, to: to}] = latency
+	network.mut.Unlock()
+}
+
+// Partition splits the network in the provided groups of peers. The peers not found in any group form a separate
+// group. The messages, including the ones already sent, are not delivered between the groups until Heal is called
+func (network *NetworkSimulator) Partition(groups ...[]core.PeerID) {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	network.partitions = make(map[core.PeerID]int)
+	for index, group := range groups {
+		for _, id := range group {
+			network.partitions[id] = index + 1
+		}
+	}
+}
+
+// Heal removes the partitions
+func (network *NetworkSimulator) Heal() {
+	network.mut.Lock()
+	network.partitions = make(map[core.PeerID]int)
+	network.mut.Unlock()
+}
+
+// Advance moves the network time with the provided duration and delivers the messages due in this interval, including
+// the ones sent while processing the delivered messages. Returns the number of delivered messages
+func (network *NetworkSimulator) Advance(duration time.Duration) int {
+	network.mut.Lock()
+	targetTime := network.currentTime + duration
+	network.mut.Unlock()
+
+	numDelivered := network.deliverUntil(targetTime)
+
+	network.mut.Lock()
+	if network.currentTime < targetTime {
+		network.currentTime = targetTime
+	}
+	network.mut.Unlock()
+
+	return numDelivered
+}
+
+// DeliverAll delivers all the queued messages, including the ones sent while processing the delivered messages,
+// advancing the network time as needed. Returns the number of delivered messages
+func (network *NetworkSimulator) DeliverAll() int {
+	return network.deliverUntil(time.Duration(math.MaxInt64))
+}
+
+// NumPendingMessages returns the number of messages not yet delivered
+func (network *NetworkSimulator) NumPendingMessages() int {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	return len(network.queue)
+}
+
+// ElapsedTime returns the network time elapsed since the simulator was created
+func (network *NetworkSimulator) ElapsedTime() time.Duration {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	return network.currentTime
+}
+
+func (network *NetworkSimulator) deliverUntil(targetTime time.Duration) int {
+	numDelivered := 0
+	for numDelivered < maxDeliveriesPerCall {
+		delivery, processors := network.popDelivery(targetTime)
+		if delivery == nil {
+			return numDelivered
+		}
+
+		numDelivered++
+		for _, processor := range processors {
+			_ = processor.ProcessReceivedMessage(delivery.message, delivery.from, nil)
+		}
+	}
+
+	return numDelivered
+}
+
+// popDelivery removes the next due message from the queue and returns it together with the processors of its
+// destination. The processors are called without holding the lock, so they can send messages
+func (network *NetworkSimulator) popDelivery(targetTime time.Duration) (*simulatedDelivery, []p2p.MessageProcessor) {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	for len(network.queue) > 0 {
+		delivery := network.queue[0]
+		if delivery.deliverAt > targetTime {
+			return nil, nil
+		}
+
+		network.queue = network.queue[1:]
+		network.currentTime = delivery.deliverAt
+		if !network.isReachable(delivery.from, delivery.to) {
+			continue
+		}
+
+		return delivery, network.messengers[delivery.to].getProcessors(delivery.message.TopicField)
+	}
+
+	return nil, nil
+}
+
+func (network *NetworkSimulator) isReachable(from core.PeerID, to core.PeerID) bool {
+	messenger, found := network.messengers[to]
+	if !found || messenger.isClosed() {
+		return false
+	}
+
+	return network.partitions[from] == network.partitions[to]
+}
+
+func (network *NetworkSimulator) broadcast(from core.PeerID, topic string, buff []byte) {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	message := network.createMessage(from, topic, buff)
+	for _, to := range network.sortedPeers {
+		network.enqueue(message, from, to)
+	}
+}
+
+func (network *NetworkSimulator) sendToPeer(from core.PeerID, topic string, buff []byte, to core.PeerID) error {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	if !network.isReachable(from, to) {
+		return fmt.Errorf("%w: %s", errPeerNotConnected, to.Pretty())
+	}
+
+	network.enqueue(network.createMessage(from, topic, buff), from, to)
+
+	return nil
+}
+
+func (network *NetworkSimulator) createMessage(from core.PeerID, topic string, buff []byte) *P2PMessageMock {
+	network.numSentMessages++
+	seqNo := make([]byte, 8)
+	binary.BigEndian.PutUint64(seqNo, network.numSentMessages)
+
+	return &P2PMessageMock{
+		FromField:      []byte(from),
+		DataField:      buff,
+		SeqNoField:     seqNo,
+		TopicField:     topic,
+		PeerField:      from,
+		TimestampField: network.startTime.Add(network.currentTime).Unix(),
+	}
+}
+
+func (network *NetworkSimulator) enqueue(message *P2PMessageMock, from core.PeerID, to core.PeerID) {
+	delivery := &simulatedDelivery{
+		deliverAt: network.currentTime + network.getLatency(from, to),
+		message:   message,
+		from:      from,
+		to:        to,
+	}
+
+	position := sort.Search(len(network.queue), func(i int) bool {
+		return network.queue[i].deliverAt > delivery.deliverAt
+	})
+	network.queue = append(network.queue, nil)
+	copy(network.queue[position+1:], network.queue[position:])
+	network.queue[position] = delivery
+}
+
+func (network *NetworkSimulator) getLatency(from core.PeerID, to core.PeerID) time.Duration {
+	if from == to {
+		return 0
+	}
+
+	latency, found := network.latencies[link{from: from, to: to}]
+	if found {
+		return latency
+	}
+
+	return network.defaultLatency
+}
+
+func (network *NetworkSimulator) connectedAddresses(id core.PeerID) []string {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	addresses := make([]string, 0, len(network.sortedPeers))
+	for _, peer := range network.sortedPeers {
+		if peer != id && network.isReachable(id, peer) {
+			addresses = append(addresses, peerAddress(peer))
+		}
+	}
+
+	return addresses
+}
+
+func (network *NetworkSimulator) connectedPeersOnTopic(id core.PeerID, topic string) []core.PeerID {
+	network.mut.Lock()
+	defer network.mut.Unlock()
+
+	peers := make([]core.PeerID, 0, len(network.sortedPeers))
+	for _, peer := range network.sortedPeers {
+		if peer != id && network.isReachable(id, peer) && network.messengers[peer].HasTopic(topic) {
+			peers = append(peers, peer)
+		}
+	}
+
+	return peers
+}
+
+func peerAddress(id core.PeerID) string {
+	return "/memory/" + string(id)
+}
@@ -0,0 +1,20 @@
+package p2p
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+// PeersRecorderStub -
+type PeersRecorderStub struct {
+	AddPeerCalled func(pid core.PeerID)
+}
+
+// AddPeer -
+func (stub *PeersRecorderStub) AddPeer(pid core.PeerID) {
+	if stub.AddPeerCalled != nil {
+		stub.AddPeerCalled(pid)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PeersRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,157 @@
+package p2p
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/p2p"
+)
+
+// SimulatedMessenger is a network messenger connected to the other messengers of a NetworkSimulator
+type SimulatedMessenger struct {
+	network    *NetworkSimulator
+	peerID     core.PeerID
+	mut        sync.RWMutex
+	topics     map[string]struct{}
+	processors map[string]map[string]p2p.MessageProcessor
+	closed     bool
+}
+
+// ID -
+func (messenger *SimulatedMessenger) ID() core.PeerID {
+	return messenger.peerID
+}
+
+// Bootstrap -
+func (messenger *SimulatedMessenger) Bootstrap() error {
+	return nil
+}
+
+// Addresses -
+func (messenger *SimulatedMessenger) Addresses() []string {
+	return []string{peerAddress(messenger.peerID)}
+}
+
+// RegisterMessageProcessor -
+func (messenger *SimulatedMessenger) RegisterMessageProcessor(topic string, identifier string, processor p2p.MessageProcessor) error {
+	if check.IfNil(processor) {
+		return errNilMessageProcessor
+	}
+
+	messenger.mut.Lock()
+	defer messenger.mut.Unlock()
+
+	topicProcessors, found := messenger.processors[topic]
+	if !found {
+		topicProcessors = make(map[string]p2p.MessageProcessor)
+		messenger.processors[topic] = topicProcessors
+	}
+	topicProcessors[identifier] = processor
+
+	return nil
+}
+
+// getProcessors returns the processors registered on the topic, sorted by their identifier
+func (messenger *SimulatedMessenger) getProcessors(topic string) []p2p.MessageProcessor {
+	messenger.mut.RLock()
+	defer messenger.mut.RUnlock()
+
+	topicProcessors := messenger.processors[topic]
+	identifiers := make([]string, 0, len(topicProcessors))
+	for identifier := range topicProcessors {
+		identifiers = append(identifiers, identifier)
+	}
+	sort.Strings(identifiers)
+
+	processors := make([]p2p.MessageProcessor, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		processors = append(processors, topicProcessors[identifier])
+	}
+
+	return processors
+}
+
+// HasTopic -
+func (messenger *SimulatedMessenger) HasTopic(name string) bool {
+	messenger.mut.RLock()
+	defer messenger.mut.RUnlock()
+
+	_, found := messenger.topics[name]
+
+	return found
+}
+
+// CreateTopic -
+func (messenger *SimulatedMessenger) CreateTopic(name string, _ bool) error {
+	messenger.mut.Lock()
+	messenger.topics[name] = struct{}{}
+	messenger.mut.Unlock()
+
+	return nil
+}
+
+// Broadcast queues the message for all the messengers of the network, including this one
+func (messenger *SimulatedMessenger) Broadcast(topic string, buff []byte) {
+	if messenger.isClosed() {
+		return
+	}
+
+	messenger.network.broadcast(messenger.peerID, topic, buff)
+}
+
+// SendToConnectedPeer queues the message for the provided peer. Errors if the peer is not reachable
+func (messenger *SimulatedMessenger) SendToConnectedPeer(topic string, buff []byte, peerID core.PeerID) error {
+	if messenger.isClosed() {
+		return errPeerNotConnected
+	}
+
+	return messenger.network.sendToPeer(messenger.peerID, topic, buff, peerID)
+}
+
+// SetPeerDenialEvaluator -
+func (messenger *SimulatedMessenger) SetPeerDenialEvaluator(_ p2p.PeerDenialEvaluator) error {
+	return nil
+}
+
+// ConnectedAddresses returns the addresses of the reachable messengers
+func (messenger *SimulatedMessenger) ConnectedAddresses() []string {
+	return messenger.network.connectedAddresses(messenger.peerID)
+}
+
+// ConnectedPeersOnTopic returns the reachable messengers that created the topic
+func (messenger *SimulatedMessenger) ConnectedPeersOnTopic(topic string) []core.PeerID {
+	return messenger.network.connectedPeersOnTopic(messenger.peerID, topic)
+}
+
+// PeerAddresses -
+func (messenger *SimulatedMessenger) PeerAddresses(pid core.PeerID) []string {
+	return []string{peerAddress(pid)}
+}
+
+// ConnectToPeer -
+func (messenger *SimulatedMessenger) ConnectToPeer(_ string) error {
+	return nil
+}
+
+// Close disconnects the messenger from the network, the messages not yet delivered to it are dropped
+func (messenger *SimulatedMessenger) Close() error {
+	messenger.mut.Lock()
+	messenger.closed = true
+	messenger.mut.Unlock()
+
+	return nil
+}
+
+func (messenger *SimulatedMessenger) isClosed() bool {
+	messenger.mut.RLock()
+	defer messenger.mut.RUnlock()
+
+	return messenger.closed
+}
+
+// IsInterfaceNil -
+func (messenger *SimulatedMessenger) IsInterfaceNil() bool {
+	return messenger == nil
+}
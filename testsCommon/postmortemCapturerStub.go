@@ -0,0 +1,18 @@
+package testsCommon
+
+// PostmortemCapturerStub -
+type PostmortemCapturerStub struct {
+	CaptureCalled func(reason string, data map[string]interface{})
+}
+
+// Capture -
+func (stub *PostmortemCapturerStub) Capture(reason string, data map[string]interface{}) {
+	if stub.CaptureCalled != nil {
+		stub.CaptureCalled(reason, data)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PostmortemCapturerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
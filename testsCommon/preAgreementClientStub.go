@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// PreAgreementClientStub -
+type PreAgreementClientStub struct {
+	ProcessPreAgreementMessageCalled func(msg *core.SignedMessage)
+}
+
+// ProcessPreAgreementMessage -
+func (stub *PreAgreementClientStub) ProcessPreAgreementMessage(msg *core.SignedMessage) {
+	if stub.ProcessPreAgreementMessageCalled != nil {
+		stub.ProcessPreAgreementMessageCalled(msg)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *PreAgreementClientStub) IsInterfaceNil() bool {
+	return stub == nil
+}
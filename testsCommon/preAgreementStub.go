@@ -0,0 +1,34 @@
+package testsCommon
+
+import (
+	"context"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// PreAgreementStub -
+type PreAgreementStub struct {
+	SetLocalViewCalled     func(batch *bridgeCore.TransferBatch)
+	RequestAgreementCalled func(ctx context.Context, batch *bridgeCore.TransferBatch) error
+}
+
+// SetLocalView -
+func (stub *PreAgreementStub) SetLocalView(batch *bridgeCore.TransferBatch) {
+	if stub.SetLocalViewCalled != nil {
+		stub.SetLocalViewCalled(batch)
+	}
+}
+
+// RequestAgreement -
+func (stub *PreAgreementStub) RequestAgreement(ctx context.Context, batch *bridgeCore.TransferBatch) error {
+	if stub.RequestAgreementCalled != nil {
+		return stub.RequestAgreementCalled(ctx, batch)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PreAgreementStub) IsInterfaceNil() bool {
+	return stub == nil
+}
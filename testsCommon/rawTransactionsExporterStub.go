@@ -0,0 +1,45 @@
+package testsCommon
+
+import (
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// RawTransactionsExporterStub -
+type RawTransactionsExporterStub struct {
+	IsEnabledCalled               func() bool
+	ExportTransactionCalled       func(batchID uint64, tx *types.Transaction) error
+	GetExportedTransactionsCalled func() []*core.ExportedTransaction
+}
+
+// IsEnabled -
+func (stub *RawTransactionsExporterStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// ExportTransaction -
+func (stub *RawTransactionsExporterStub) ExportTransaction(batchID uint64, tx *types.Transaction) error {
+	if stub.ExportTransactionCalled != nil {
+		return stub.ExportTransactionCalled(batchID, tx)
+	}
+
+	return nil
+}
+
+// GetExportedTransactions -
+func (stub *RawTransactionsExporterStub) GetExportedTransactions() []*core.ExportedTransaction {
+	if stub.GetExportedTransactionsCalled != nil {
+		return stub.GetExportedTransactionsCalled()
+	}
+
+	return make([]*core.ExportedTransaction, 0)
+}
+
+// IsInterfaceNil -
+func (stub *RawTransactionsExporterStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+// RecipientAllowlistStub -
+type RecipientAllowlistStub struct {
+	IsAllowedCalled func(recipient []byte) bool
+}
+
+// IsAllowed -
+func (stub *RecipientAllowlistStub) IsAllowed(recipient []byte) bool {
+	if stub.IsAllowedCalled != nil {
+		return stub.IsAllowedCalled(recipient)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (stub *RecipientAllowlistStub) IsInterfaceNil() bool {
+	return stub == nil
+}
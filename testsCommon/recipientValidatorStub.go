@@ -0,0 +1,20 @@
+package testsCommon
+
+// RecipientValidatorStub -
+type RecipientValidatorStub struct {
+	ValidateCalled func(recipient []byte) error
+}
+
+// Validate -
+func (stub *RecipientValidatorStub) Validate(recipient []byte) error {
+	if stub.ValidateCalled != nil {
+		return stub.ValidateCalled(recipient)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RecipientValidatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
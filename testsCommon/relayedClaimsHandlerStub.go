@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// RelayedClaimsHandlerStub -
+type RelayedClaimsHandlerStub struct {
+	SubmitClaimCalled func(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error)
+}
+
+// SubmitClaim -
+func (stub *RelayedClaimsHandlerStub) SubmitClaim(ctx context.Context, claimTx *transaction.FrontendTransaction) (string, error) {
+	if stub.SubmitClaimCalled != nil {
+		return stub.SubmitClaimCalled(ctx, claimTx)
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (stub *RelayedClaimsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,30 @@
+package testsCommon
+
+// ResumableStateStub -
+type ResumableStateStub struct {
+	SaveStateCalled    func() ([]byte, error)
+	RestoreStateCalled func(buff []byte) error
+}
+
+// SaveState -
+func (stub *ResumableStateStub) SaveState() ([]byte, error) {
+	if stub.SaveStateCalled != nil {
+		return stub.SaveStateCalled()
+	}
+
+	return make([]byte, 0), nil
+}
+
+// RestoreState -
+func (stub *ResumableStateStub) RestoreState(buff []byte) error {
+	if stub.RestoreStateCalled != nil {
+		return stub.RestoreStateCalled(buff)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ResumableStateStub) IsInterfaceNil() bool {
+	return stub == nil
+}
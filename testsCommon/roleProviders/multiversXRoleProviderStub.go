@@ -5,6 +5,7 @@ import "github.com/multiversx/mx-sdk-go/core"
 // MultiversXRoleProviderStub -
 type MultiversXRoleProviderStub struct {
 	IsWhitelistedCalled func(address core.AddressHandler) bool
+	IsOnProbationCalled func(address []byte) bool
 }
 
 // IsWhitelisted -
@@ -16,6 +17,15 @@ func (stub *MultiversXRoleProviderStub) IsWhitelisted(address core.AddressHandle
 	return true
 }
 
+// IsOnProbation -
+func (stub *MultiversXRoleProviderStub) IsOnProbation(address []byte) bool {
+	if stub.IsOnProbationCalled != nil {
+		return stub.IsOnProbationCalled(address)
+	}
+
+	return false
+}
+
 // IsInterfaceNil -
 func (stub *MultiversXRoleProviderStub) IsInterfaceNil() bool {
 	return stub == nil
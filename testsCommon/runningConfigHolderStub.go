@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/config"
+
+// RunningConfigHolderStub -
+type RunningConfigHolderStub struct {
+	RunningConfigCalled func() config.Config
+}
+
+// RunningConfig -
+func (stub *RunningConfigHolderStub) RunningConfig() config.Config {
+	if stub.RunningConfigCalled != nil {
+		return stub.RunningConfigCalled()
+	}
+
+	return config.Config{}
+}
+
+// IsInterfaceNil -
+func (stub *RunningConfigHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
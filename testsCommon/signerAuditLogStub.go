@@ -0,0 +1,20 @@
+package testsCommon
+
+// SignerAuditLogStub -
+type SignerAuditLogStub struct {
+	RecordSignatureCalled func(purpose string, batchID uint64, messageHash []byte) error
+}
+
+// RecordSignature -
+func (stub *SignerAuditLogStub) RecordSignature(purpose string, batchID uint64, messageHash []byte) error {
+	if stub.RecordSignatureCalled != nil {
+		return stub.RecordSignatureCalled(purpose, batchID, messageHash)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SignerAuditLogStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,42 @@
+package testsCommon
+
+// SLATrackerStub -
+type SLATrackerStub struct {
+	TransferStartedCalled   func(direction string, batchID uint64)
+	TransferCompletedCalled func(direction string, batchID uint64)
+	LeaderSlotMissedCalled  func(direction string)
+	StepFailedCalled        func(direction string)
+}
+
+// TransferStarted -
+func (stub *SLATrackerStub) TransferStarted(direction string, batchID uint64) {
+	if stub.TransferStartedCalled != nil {
+		stub.TransferStartedCalled(direction, batchID)
+	}
+}
+
+// TransferCompleted -
+func (stub *SLATrackerStub) TransferCompleted(direction string, batchID uint64) {
+	if stub.TransferCompletedCalled != nil {
+		stub.TransferCompletedCalled(direction, batchID)
+	}
+}
+
+// LeaderSlotMissed -
+func (stub *SLATrackerStub) LeaderSlotMissed(direction string) {
+	if stub.LeaderSlotMissedCalled != nil {
+		stub.LeaderSlotMissedCalled(direction)
+	}
+}
+
+// StepFailed -
+func (stub *SLATrackerStub) StepFailed(direction string) {
+	if stub.StepFailedCalled != nil {
+		stub.StepFailedCalled(direction)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *SLATrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
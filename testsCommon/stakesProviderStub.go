@@ -0,0 +1,22 @@
+package testsCommon
+
+import "math/big"
+
+// StakesProviderStub -
+type StakesProviderStub struct {
+	StakeCalled func(publicKey []byte) *big.Int
+}
+
+// Stake -
+func (stub *StakesProviderStub) Stake(publicKey []byte) *big.Int {
+	if stub.StakeCalled != nil {
+		return stub.StakeCalled(publicKey)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *StakesProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,49 @@
+package testsCommon
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// StepHookStub -
+type StepHookStub struct {
+	BeforeStepCalled func(ctx context.Context, stateMachineName string, step core.StepIdentifier)
+	AfterStepCalled  func(ctx context.Context, stateMachineName string, step core.StepIdentifier, nextStep core.StepIdentifier, duration time.Duration)
+	OnErrorCalled    func(stateMachineName string, step core.StepIdentifier, err error)
+	OnPanicCalled    func(stateMachineName string, step core.StepIdentifier, recovered interface{}, stack []byte)
+}
+
+// BeforeStep -
+func (stub *StepHookStub) BeforeStep(ctx context.Context, stateMachineName string, step core.StepIdentifier) {
+	if stub.BeforeStepCalled != nil {
+		stub.BeforeStepCalled(ctx, stateMachineName, step)
+	}
+}
+
+// AfterStep -
+func (stub *StepHookStub) AfterStep(ctx context.Context, stateMachineName string, step core.StepIdentifier, nextStep core.StepIdentifier, duration time.Duration) {
+	if stub.AfterStepCalled != nil {
+		stub.AfterStepCalled(ctx, stateMachineName, step, nextStep, duration)
+	}
+}
+
+// OnError -
+func (stub *StepHookStub) OnError(stateMachineName string, step core.StepIdentifier, err error) {
+	if stub.OnErrorCalled != nil {
+		stub.OnErrorCalled(stateMachineName, step, err)
+	}
+}
+
+// OnPanic -
+func (stub *StepHookStub) OnPanic(stateMachineName string, step core.StepIdentifier, recovered interface{}, stack []byte) {
+	if stub.OnPanicCalled != nil {
+		stub.OnPanicCalled(stateMachineName, step, recovered, stack)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *StepHookStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,50 @@
+package testsCommon
+
+// StorerStub -
+type StorerStub struct {
+	PutCalled    func(key, data []byte) error
+	GetCalled    func(key []byte) ([]byte, error)
+	RemoveCalled func(key []byte) error
+	CloseCalled  func() error
+}
+
+// Put -
+func (stub *StorerStub) Put(key, data []byte) error {
+	if stub.PutCalled != nil {
+		return stub.PutCalled(key, data)
+	}
+
+	return nil
+}
+
+// Get -
+func (stub *StorerStub) Get(key []byte) ([]byte, error) {
+	if stub.GetCalled != nil {
+		return stub.GetCalled(key)
+	}
+
+	return nil, nil
+}
+
+// Remove -
+func (stub *StorerStub) Remove(key []byte) error {
+	if stub.RemoveCalled != nil {
+		return stub.RemoveCalled(key)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *StorerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *StorerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
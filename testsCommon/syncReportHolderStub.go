@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// SyncReportHolderStub -
+type SyncReportHolderStub struct {
+	GetSyncReportCalled func() *core.SyncReport
+}
+
+// GetSyncReport -
+func (stub *SyncReportHolderStub) GetSyncReport() *core.SyncReport {
+	if stub.GetSyncReportCalled != nil {
+		return stub.GetSyncReportCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SyncReportHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,100 @@
+package testsCommon
+
+import (
+	"sync"
+	"time"
+)
+
+// TimeTravelTimerMock is a timer that never waits: each wait moves its clock forward with the waited duration and
+// returns immediately. Useful to test the wait and retry logic fast and deterministically
+type TimeTravelTimerMock struct {
+	mut          sync.RWMutex
+	currentTime  time.Time
+	totalWaited  time.Duration
+	waitRequests []time.Duration
+}
+
+// NewTimeTravelTimerMock -
+func NewTimeTravelTimerMock(startTime time.Time) *TimeTravelTimerMock {
+	return &TimeTravelTimerMock{
+		currentTime:  startTime,
+		waitRequests: make([]time.Duration, 0),
+	}
+}
+
+// Now -
+func (mock *TimeTravelTimerMock) Now() time.Time {
+	mock.mut.RLock()
+	defer mock.mut.RUnlock()
+
+	return mock.currentTime
+}
+
+// NowUnix -
+func (mock *TimeTravelTimerMock) NowUnix() int64 {
+	mock.mut.RLock()
+	defer mock.mut.RUnlock()
+
+	return mock.currentTime.Unix()
+}
+
+// After moves the clock forward and returns a channel that already holds the new time
+func (mock *TimeTravelTimerMock) After(duration time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- mock.travel(duration)
+
+	return ch
+}
+
+// Sleep moves the clock forward
+func (mock *TimeTravelTimerMock) Sleep(duration time.Duration) {
+	_ = mock.travel(duration)
+}
+
+// Travel moves the clock forward without recording a wait
+func (mock *TimeTravelTimerMock) Travel(duration time.Duration) {
+	mock.mut.Lock()
+	mock.currentTime = mock.currentTime.Add(duration)
+	mock.mut.Unlock()
+}
+
+func (mock *TimeTravelTimerMock) travel(duration time.Duration) time.Time {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	mock.currentTime = mock.currentTime.Add(duration)
+	mock.totalWaited += duration
+	mock.waitRequests = append(mock.waitRequests, duration)
+
+	return mock.currentTime
+}
+
+// TotalWaited returns the sum of all the waited durations
+func (mock *TimeTravelTimerMock) TotalWaited() time.Duration {
+	mock.mut.RLock()
+	defer mock.mut.RUnlock()
+
+	return mock.totalWaited
+}
+
+// WaitRequests returns the waited durations, in order
+func (mock *TimeTravelTimerMock) WaitRequests() []time.Duration {
+	mock.mut.RLock()
+	defer mock.mut.RUnlock()
+
+	return append(make([]time.Duration, 0, len(mock.waitRequests)), mock.waitRequests...)
+}
+
+// Start -
+func (mock *TimeTravelTimerMock) Start() {
+}
+
+// Close -
+func (mock *TimeTravelTimerMock) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (mock *TimeTravelTimerMock) IsInterfaceNil() bool {
+	return mock == nil
+}
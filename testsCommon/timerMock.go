@@ -9,11 +9,26 @@ type TimerMock struct {
 	OverrideTimeAfter time.Duration
 }
 
+// Now -
+func (tm *TimerMock) Now() time.Time {
+	return time.Now()
+}
+
 // NowUnix -
 func (tm *TimerMock) NowUnix() int64 {
 	return time.Now().Unix()
 }
 
+// After -
+func (tm *TimerMock) After(duration time.Duration) <-chan time.Time {
+	return time.After(duration)
+}
+
+// Sleep -
+func (tm *TimerMock) Sleep(duration time.Duration) {
+	time.Sleep(duration)
+}
+
 // Start -
 func (tm *TimerMock) Start() {
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"time"
 )
 
 var fullPathTimerStub = "github.com/multiversx/mx-bridge-eth-go/testsCommon.(*TimerStub)."
@@ -13,7 +14,10 @@ type TimerStub struct {
 	functionCalledCounter map[string]int
 	mut                   sync.RWMutex
 
+	NowCalled     func() time.Time
 	NowUnixCalled func() int64
+	AfterCalled   func(duration time.Duration) <-chan time.Time
+	SleepCalled   func(duration time.Duration)
 	StartCalled   func()
 	CloseCalled   func() error
 }
@@ -25,6 +29,16 @@ func NewTimerStub() *TimerStub {
 	}
 }
 
+// Now -
+func (stub *TimerStub) Now() time.Time {
+	stub.incrementFunctionCounter()
+	if stub.NowCalled != nil {
+		return stub.NowCalled()
+	}
+
+	return time.Time{}
+}
+
 // NowUnix -
 func (stub *TimerStub) NowUnix() int64 {
 	stub.incrementFunctionCounter()
@@ -35,6 +49,24 @@ func (stub *TimerStub) NowUnix() int64 {
 	return 0
 }
 
+// After -
+func (stub *TimerStub) After(duration time.Duration) <-chan time.Time {
+	stub.incrementFunctionCounter()
+	if stub.AfterCalled != nil {
+		return stub.AfterCalled(duration)
+	}
+
+	return time.After(duration)
+}
+
+// Sleep -
+func (stub *TimerStub) Sleep(duration time.Duration) {
+	stub.incrementFunctionCounter()
+	if stub.SleepCalled != nil {
+		stub.SleepCalled(duration)
+	}
+}
+
 // Start -
 func (stub *TimerStub) Start() {
 	stub.incrementFunctionCounter()
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// TopologyInfoHolderStub -
+type TopologyInfoHolderStub struct {
+	GetTopologyInfoCalled func(numSlots int) map[string]*core.TopologyInfo
+}
+
+// GetTopologyInfo -
+func (stub *TopologyInfoHolderStub) GetTopologyInfo(numSlots int) map[string]*core.TopologyInfo {
+	if stub.GetTopologyInfoCalled != nil {
+		return stub.GetTopologyInfoCalled(numSlots)
+	}
+
+	return make(map[string]*core.TopologyInfo)
+}
+
+// IsInterfaceNil -
+func (stub *TopologyInfoHolderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,24 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// TransferReceiptsStub -
+type TransferReceiptsStub struct {
+	GenerateReceiptsCalled func(ctx context.Context, direction string, batch *core.TransferBatch, destinationTxHash string, statuses map[uint64]string)
+}
+
+// GenerateReceipts -
+func (stub *TransferReceiptsStub) GenerateReceipts(ctx context.Context, direction string, batch *core.TransferBatch, destinationTxHash string, statuses map[uint64]string) {
+	if stub.GenerateReceiptsCalled != nil {
+		stub.GenerateReceiptsCalled(ctx, direction, batch, destinationTxHash, statuses)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TransferReceiptsStub) IsInterfaceNil() bool {
+	return stub == nil
+}
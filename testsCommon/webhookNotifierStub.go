@@ -0,0 +1,30 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// WebhookNotifierStub -
+type WebhookNotifierStub struct {
+	IsEnabledCalled func() bool
+	NotifyCalled    func(notification *core.ScCallExecutionNotification)
+}
+
+// IsEnabled -
+func (stub *WebhookNotifierStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// Notify -
+func (stub *WebhookNotifierStub) Notify(notification *core.ScCallExecutionNotification) {
+	if stub.NotifyCalled != nil {
+		stub.NotifyCalled(notification)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *WebhookNotifierStub) IsInterfaceNil() bool {
+	return stub == nil
+}
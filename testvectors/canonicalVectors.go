@@ -0,0 +1,72 @@
+package testvectors
+
+import "github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+
+const (
+	ethAddress1  = "3fa5b6c5b8c7e3ed1e4e1bc4a2e8e8bd2c0c5b3e"
+	ethAddress2  = "f39fd6e51aad88f6f4ce6ab8827279cfffb92266"
+	mvxAddress1  = "1e8a8b6b49de5b7be10aaa158a5a6a4abb4b56cc08f524bb5e6cd5f211ad3e13"
+	mvxAddress2  = "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+	ethToken1    = "a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	ethToken2    = "dac17f958d2ee523a2206206994597c13d831ec7"
+	mvxToken1    = "455448555344432d616661363839" // ETHUSDC-afa689
+	mvxToken2    = "455448555344542d396235363263" // ETHUSDT-9b562c
+	noData       = "00"
+	scCallData   = "01000000047465737400000000000f424001000000010000000461626364"
+	executed     = "03"
+	rejected     = "04"
+	directionIn  = string(batchProcessor.ToMultiversX)
+	directionOut = string(batchProcessor.FromMultiversX)
+)
+
+// CanonicalVectors returns the canonical batches, without the expected encodings
+func CanonicalVectors() []*Vector {
+	return []*Vector{
+		{
+			Name:      "eth to mvx, single deposit",
+			Direction: directionIn,
+			BatchID:   1,
+			Deposits: []*VectorDeposit{
+				{Nonce: 1, From: ethAddress1, To: mvxAddress1, SourceToken: ethToken1, DestinationToken: mvxToken1, Amount: "1000000", Data: noData},
+			},
+		},
+		{
+			Name:      "eth to mvx, multiple deposits and tokens",
+			Direction: directionIn,
+			BatchID:   4294967296,
+			Deposits: []*VectorDeposit{
+				{Nonce: 10, From: ethAddress1, To: mvxAddress1, SourceToken: ethToken1, DestinationToken: mvxToken1, Amount: "1", Data: noData},
+				{Nonce: 11, From: ethAddress2, To: mvxAddress2, SourceToken: ethToken2, DestinationToken: mvxToken2, Amount: "340282366920938463463374607431768211455", Data: noData},
+				{Nonce: 12, From: ethAddress2, To: mvxAddress1, SourceToken: ethToken1, DestinationToken: mvxToken1, Amount: "0", Data: noData},
+			},
+		},
+		{
+			Name:      "eth to mvx, deposit with SC call data",
+			Direction: directionIn,
+			BatchID:   37,
+			Deposits: []*VectorDeposit{
+				{Nonce: 255, From: ethAddress1, To: mvxAddress2, SourceToken: ethToken2, DestinationToken: mvxToken2, Amount: "5000000000000000000", Data: scCallData},
+			},
+		},
+		{
+			Name:      "mvx to eth, single deposit",
+			Direction: directionOut,
+			BatchID:   1,
+			Deposits: []*VectorDeposit{
+				{Nonce: 1, From: mvxAddress1, To: ethAddress1, SourceToken: mvxToken1, DestinationToken: ethToken1, Amount: "1000000", Data: noData},
+			},
+			Statuses: executed,
+		},
+		{
+			Name:      "mvx to eth, multiple deposits with mixed statuses",
+			Direction: directionOut,
+			BatchID:   65536,
+			Deposits: []*VectorDeposit{
+				{Nonce: 100, From: mvxAddress1, To: ethAddress1, SourceToken: mvxToken1, DestinationToken: ethToken1, Amount: "123456789", Data: noData},
+				{Nonce: 101, From: mvxAddress2, To: ethAddress2, SourceToken: mvxToken2, DestinationToken: ethToken2, Amount: "115792089237316195423570985008687907853269984665640564039457584007913129639935", Data: noData},
+				{Nonce: 102, From: mvxAddress1, To: ethAddress2, SourceToken: mvxToken1, DestinationToken: ethToken1, Amount: "1", Data: noData},
+			},
+			Statuses: executed + rejected + executed,
+		},
+	}
+}
@@ -0,0 +1,9 @@
+package testvectors
+
+import "errors"
+
+var (
+	errUnknownDirection = errors.New("unknown direction")
+	errInvalidAmount    = errors.New("invalid amount")
+	errVectorMismatch   = errors.New("vector mismatch")
+)
@@ -0,0 +1,230 @@
+package testvectors
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+)
+
+// Vector is a canonical batch together with the encodings the relayers and the contracts should agree upon.
+// All byte fields are hex encoded, amounts are decimal strings
+type Vector struct {
+	Name                 string           `json:"name"`
+	Direction            string           `json:"direction"`
+	BatchID              uint64           `json:"batchId"`
+	Deposits             []*VectorDeposit `json:"deposits"`
+	Statuses             string           `json:"statuses,omitempty"`
+	EthMessageHash       string           `json:"ethMessageHash,omitempty"`
+	ProposeTransferData  string           `json:"mvxProposeTransferData,omitempty"`
+	ProposeSetStatusData string           `json:"mvxProposeSetStatusData,omitempty"`
+	CanonicalBatch       string           `json:"canonicalBatch,omitempty"`
+	CanonicalBatchHash   string           `json:"canonicalBatchHash,omitempty"`
+}
+
+// VectorDeposit is a deposit contained in a canonical batch
+type VectorDeposit struct {
+	Nonce            uint64 `json:"nonce"`
+	From             string `json:"from"`
+	To               string `json:"to"`
+	SourceToken      string `json:"sourceToken"`
+	DestinationToken string `json:"destinationToken"`
+	Amount           string `json:"amount"`
+	Data             string `json:"data"`
+}
+
+// ComputeExpectations will (re)compute the expected encodings of the vector based on its batch content
+func ComputeExpectations(vector *Vector) error {
+	batch, err := vector.toTransferBatch()
+	if err != nil {
+		return fmt.Errorf("%w for vector %s", err, vector.Name)
+	}
+
+	vector.EthMessageHash = ""
+	vector.ProposeTransferData = ""
+	vector.ProposeSetStatusData = ""
+	canonicalBatch, err := batch.CanonicalSerialization()
+	if err != nil {
+		return fmt.Errorf("%w for vector %s", err, vector.Name)
+	}
+	canonicalBatchHash, err := batch.CanonicalHash()
+	if err != nil {
+		return fmt.Errorf("%w for vector %s", err, vector.Name)
+	}
+	vector.CanonicalBatch = hex.EncodeToString(canonicalBatch)
+	vector.CanonicalBatchHash = hex.EncodeToString(canonicalBatchHash)
+
+	switch batchProcessor.Direction(vector.Direction) {
+	case batchProcessor.ToMultiversX:
+		vector.ProposeTransferData, err = multiversx.CreateProposeTransferTxDataBuilder(batch).ToDataString()
+		if err != nil {
+			return fmt.Errorf("%w for vector %s", err, vector.Name)
+		}
+	case batchProcessor.FromMultiversX:
+		argLists, errExtract := batchProcessor.ExtractListMvxToEth(batch)
+		if errExtract != nil {
+			return fmt.Errorf("%w for vector %s", errExtract, vector.Name)
+		}
+		hash, errHash := ethereum.GenerateMessageHash(argLists, batch.ID)
+		if errHash != nil {
+			return fmt.Errorf("%w for vector %s", errHash, vector.Name)
+		}
+		vector.EthMessageHash = hash.Hex()
+
+		vector.ProposeSetStatusData, err = multiversx.CreateProposeSetStatusTxDataBuilder(batch).ToDataString()
+		if err != nil {
+			return fmt.Errorf("%w for vector %s", err, vector.Name)
+		}
+	default:
+		return fmt.Errorf("%w %s for vector %s", errUnknownDirection, vector.Direction, vector.Name)
+	}
+
+	return nil
+}
+
+// Verify recomputes the expected encodings for all provided vectors and returns an error on the first mismatch
+func Verify(vectors []*Vector) error {
+	for _, vector := range vectors {
+		recomputed := *vector
+		err := ComputeExpectations(&recomputed)
+		if err != nil {
+			return err
+		}
+
+		err = checkField(vector.Name, "ethMessageHash", vector.EthMessageHash, recomputed.EthMessageHash)
+		if err != nil {
+			return err
+		}
+		err = checkField(vector.Name, "mvxProposeTransferData", vector.ProposeTransferData, recomputed.ProposeTransferData)
+		if err != nil {
+			return err
+		}
+		err = checkField(vector.Name, "mvxProposeSetStatusData", vector.ProposeSetStatusData, recomputed.ProposeSetStatusData)
+		if err != nil {
+			return err
+		}
+		err = checkField(vector.Name, "canonicalBatch", vector.CanonicalBatch, recomputed.CanonicalBatch)
+		if err != nil {
+			return err
+		}
+		err = checkField(vector.Name, "canonicalBatchHash", vector.CanonicalBatchHash, recomputed.CanonicalBatchHash)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkField(vectorName string, fieldName string, stored string, computed string) error {
+	if stored == computed {
+		return nil
+	}
+
+	return fmt.Errorf("%w for vector %s, field %s: stored %s, computed %s",
+		errVectorMismatch, vectorName, fieldName, stored, computed)
+}
+
+// Generate returns the canonical vectors with all their expected encodings computed
+func Generate() ([]*Vector, error) {
+	vectors := CanonicalVectors()
+	for _, vector := range vectors {
+		err := ComputeExpectations(vector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vectors, nil
+}
+
+// LoadFromFile loads the vectors from the provided JSON file
+func LoadFromFile(filename string) ([]*Vector, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([]*Vector, 0)
+	err = json.Unmarshal(buff, &vectors)
+	if err != nil {
+		return nil, err
+	}
+
+	return vectors, nil
+}
+
+// SaveToFile writes the vectors in the provided JSON file
+func SaveToFile(filename string, vectors []*Vector) error {
+	buff, err := json.MarshalIndent(vectors, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(buff, '\n'), 0644)
+}
+
+func (vector *Vector) toTransferBatch() (*bridgeCore.TransferBatch, error) {
+	statuses, err := hex.DecodeString(vector.Statuses)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the statuses", err)
+	}
+
+	batch := &bridgeCore.TransferBatch{
+		ID:       vector.BatchID,
+		Deposits: make([]*bridgeCore.DepositTransfer, 0, len(vector.Deposits)),
+		Statuses: statuses,
+	}
+	for i, deposit := range vector.Deposits {
+		transfer, errConvert := deposit.toDepositTransfer()
+		if errConvert != nil {
+			return nil, fmt.Errorf("%w for deposit index %d", errConvert, i)
+		}
+
+		batch.Deposits = append(batch.Deposits, transfer)
+	}
+
+	return batch, nil
+}
+
+func (deposit *VectorDeposit) toDepositTransfer() (*bridgeCore.DepositTransfer, error) {
+	amount, ok := big.NewInt(0).SetString(deposit.Amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errInvalidAmount, deposit.Amount)
+	}
+
+	transfer := &bridgeCore.DepositTransfer{
+		Nonce:  deposit.Nonce,
+		Amount: amount,
+	}
+
+	var err error
+	transfer.FromBytes, err = hex.DecodeString(deposit.From)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the from field", err)
+	}
+	transfer.ToBytes, err = hex.DecodeString(deposit.To)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the to field", err)
+	}
+	transfer.SourceTokenBytes, err = hex.DecodeString(deposit.SourceToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the source token field", err)
+	}
+	transfer.DestinationTokenBytes, err = hex.DecodeString(deposit.DestinationToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the destination token field", err)
+	}
+	transfer.Data, err = hex.DecodeString(deposit.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the data field", err)
+	}
+
+	return transfer, nil
+}
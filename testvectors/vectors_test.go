@@ -0,0 +1,117 @@
+package testvectors
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const vectorsFile = "testdata/vectors.json"
+
+func TestVectorsFileShouldMatchTheCurrentEncoding(t *testing.T) {
+	t.Parallel()
+
+	storedVectors, err := LoadFromFile(vectorsFile)
+	require.Nil(t, err)
+
+	err = Verify(storedVectors)
+	assert.Nil(t, err, "the encoding changed, re-generate the vectors only if the change is intended")
+
+	generatedVectors, err := Generate()
+	require.Nil(t, err)
+	assert.Equal(t, generatedVectors, storedVectors, "the canonical vectors changed, re-generate the vectors file")
+}
+
+func TestComputeExpectations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		vector := CanonicalVectors()[0]
+		vector.Direction = "unknown"
+
+		err := ComputeExpectations(vector)
+		assert.True(t, errors.Is(err, errUnknownDirection))
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		vector := CanonicalVectors()[0]
+		vector.Deposits[0].Amount = "not a number"
+
+		err := ComputeExpectations(vector)
+		assert.True(t, errors.Is(err, errInvalidAmount))
+		assert.True(t, strings.Contains(err.Error(), "deposit index 0"))
+	})
+	t.Run("invalid hex field should error", func(t *testing.T) {
+		t.Parallel()
+
+		vector := CanonicalVectors()[0]
+		vector.Deposits[0].To = "not hex"
+
+		err := ComputeExpectations(vector)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "while decoding the to field"))
+	})
+	t.Run("should fill only the fields of the vector's direction", func(t *testing.T) {
+		t.Parallel()
+
+		vectors, err := Generate()
+		require.Nil(t, err)
+
+		for _, vector := range vectors {
+			assert.NotEmpty(t, vector.CanonicalBatch)
+			assert.NotEmpty(t, vector.CanonicalBatchHash)
+			if vector.Direction == directionIn {
+				assert.NotEmpty(t, vector.ProposeTransferData)
+				assert.Empty(t, vector.EthMessageHash)
+				assert.Empty(t, vector.ProposeSetStatusData)
+				continue
+			}
+
+			assert.Empty(t, vector.ProposeTransferData)
+			assert.NotEmpty(t, vector.EthMessageHash)
+			assert.NotEmpty(t, vector.ProposeSetStatusData)
+		}
+	})
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("altered expectation should error", func(t *testing.T) {
+		t.Parallel()
+
+		vectors, err := Generate()
+		require.Nil(t, err)
+
+		vectors[3].EthMessageHash = "0x00"
+		err = Verify(vectors)
+		assert.True(t, errors.Is(err, errVectorMismatch))
+		assert.True(t, strings.Contains(err.Error(), "ethMessageHash"))
+	})
+	t.Run("altered batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		vectors, err := Generate()
+		require.Nil(t, err)
+
+		vectors[1].Deposits[1].Amount = "2"
+		err = Verify(vectors)
+		assert.True(t, errors.Is(err, errVectorMismatch))
+		assert.True(t, strings.Contains(err.Error(), "mvxProposeTransferData"))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		vectors, err := Generate()
+		require.Nil(t, err)
+
+		err = Verify(vectors)
+		assert.Nil(t, err)
+	})
+}